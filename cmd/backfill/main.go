@@ -0,0 +1,87 @@
+// Command backfill populates newly added product columns for existing rows.
+//
+// Usage:
+//
+//	backfill -list
+//	backfill -filler <name> [-partitions 4] [-chunk-size 200] [-dry-run] [-updated-before <RFC3339>]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/backfill"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/config"
+)
+
+func main() {
+	var (
+		fillerName = flag.String("filler", "", "name of the filler to run")
+		list       = flag.Bool("list", false, "list the available fillers and exit")
+		partitions = flag.Int("partitions", backfill.DefaultPartitions, "number of key ranges scanned concurrently")
+		chunkSize  = flag.Int("chunk-size", backfill.DefaultChunkSize, "rows read and committed per chunk")
+		dryRun     = flag.Bool("dry-run", false, "compute updates without writing them")
+		before     = flag.String("updated-before", "", "only repair rows last updated before this RFC 3339 time (discount_percent)")
+	)
+	flag.Parse()
+
+	var updatedBefore time.Time
+	if *before != "" {
+		t, err := time.Parse(time.RFC3339, *before)
+		if err != nil {
+			log.Fatalf("Invalid -updated-before: %v", err)
+		}
+		updatedBefore = t
+	}
+
+	// Fillers are registered here as new columns are introduced.
+	registry := backfill.NewRegistry(
+		backfill.NewDiscountPercentFiller(updatedBefore),
+		backfill.NewDiscountPhaseFiller(time.Now()),
+		backfill.NewCurrencyFiller(),
+	)
+
+	if *list {
+		for _, name := range registry.Names() {
+			fmt.Println(name)
+		}
+		return
+	}
+
+	filler, err := registry.Get(*fillerName)
+	if err != nil {
+		log.Fatalf("%v (use -list to see the available fillers)", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	cfg := config.Load()
+	spannerClient, err := spanner.NewClient(ctx, cfg.DatabasePath())
+	if err != nil {
+		log.Fatalf("Failed to create Spanner client: %v", err)
+	}
+	defer spannerClient.Close()
+
+	runner := backfill.NewRunner(spannerClient, committer.NewCommitter(spannerClient))
+
+	log.Printf("Running backfill %q (partitions=%d, chunk-size=%d, dry-run=%t)", filler.Name(), *partitions, *chunkSize, *dryRun)
+
+	stats, err := runner.Run(ctx, filler, backfill.Options{
+		Partitions: *partitions,
+		ChunkSize:  *chunkSize,
+		DryRun:     *dryRun,
+	})
+	log.Printf("Scanned %d rows, updated %d, failed %d", stats.Scanned, stats.Updated, stats.Failed)
+	if err != nil {
+		log.Fatalf("Backfill failed: %v", err)
+	}
+}
@@ -0,0 +1,429 @@
+// Command catalogctl provides operational tooling for the product catalog.
+//
+// Usage:
+//
+//	catalogctl validate [-fix] [-format json|text]
+//	catalogctl repair-discounts [-dry-run] [-format json|text]
+//	catalogctl project-prices [-dry-run]
+//	catalogctl project-summaries [-dry-run]
+//	catalogctl score-quality [-dry-run]
+//	catalogctl create-api-key -client <id>
+//	catalogctl seed
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/apikey"
+	"github.com/product-catalog-service/internal/audit"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/config"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/quality"
+	"github.com/product-catalog-service/internal/repository"
+	"github.com/product-catalog-service/internal/seed"
+	"github.com/product-catalog-service/internal/usecase"
+	"google.golang.org/api/iterator"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	var err error
+	switch os.Args[1] {
+	case "validate":
+		err = runValidate(ctx, os.Args[2:])
+	case "repair-discounts":
+		err = runRepairDiscounts(ctx, os.Args[2:])
+	case "project-prices":
+		err = runProjectPrices(ctx, os.Args[2:])
+	case "project-summaries":
+		err = runProjectSummaries(ctx, os.Args[2:])
+	case "score-quality":
+		err = runScoreQuality(ctx, os.Args[2:])
+	case "create-api-key":
+		err = runCreateAPIKey(ctx, os.Args[2:])
+	case "seed":
+		err = runSeed(ctx, os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: catalogctl <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	fmt.Fprintln(os.Stderr, "  validate           scan the catalog against the current domain rules")
+	fmt.Fprintln(os.Stderr, "  repair-discounts   clear partial discounts and discounts left on archived products")
+	fmt.Fprintln(os.Stderr, "  project-prices     rewrite the effective price projection of every product")
+	fmt.Fprintln(os.Stderr, "  project-summaries  rewrite the listing summary of every product")
+	fmt.Fprintln(os.Stderr, "  score-quality      rescore the content quality of every product")
+	fmt.Fprintln(os.Stderr, "  create-api-key     issue an API key to a client, e.g. its first one")
+	fmt.Fprintln(os.Stderr, "  seed               load the golden dataset with fixed IDs and a fixed clock")
+}
+
+func runValidate(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	fix := fs.Bool("fix", false, "automatically fix safe issues")
+	format := fs.String("format", "json", "report format: json or text")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	spannerClient, err := newSpannerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer spannerClient.Close()
+
+	clk := clock.NewRealClock()
+	useCases := usecase.NewProductUseCases(
+		repository.NewProductRepo(spannerClient),
+		repository.NewOutboxRepo(spannerClient),
+		committer.NewCommitter(spannerClient),
+		clk,
+	)
+
+	repairer := audit.NewSpannerDiscountRepairer(spannerClient, clk)
+	validator := audit.NewValidator(spannerClient, clk, audit.DefaultRules(useCases, repairer)...)
+	report, err := validator.Run(ctx, audit.Options{Fix: *fix})
+	if err != nil {
+		return err
+	}
+
+	if err := writeReport(report, *format); err != nil {
+		return err
+	}
+	if report.HasErrors() {
+		os.Exit(1)
+	}
+	return nil
+}
+
+func runRepairDiscounts(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("repair-discounts", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "report invalid discounts without clearing them")
+	format := fs.String("format", "text", "report format: json or text")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	spannerClient, err := newSpannerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer spannerClient.Close()
+
+	clk := clock.NewRealClock()
+	repairer := audit.NewSpannerDiscountRepairer(spannerClient, clk)
+	validator := audit.NewValidator(spannerClient, clk,
+		audit.NewPartialDiscountRule(repairer),
+		audit.NewArchivedDiscountRule(repairer),
+	)
+
+	report, err := validator.Run(ctx, audit.Options{Fix: !*dryRun})
+	if err != nil {
+		return err
+	}
+	return writeReport(report, *format)
+}
+
+func runProjectPrices(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("project-prices", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "count the price periods without writing them")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	spannerClient, err := newSpannerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer spannerClient.Close()
+
+	ids, err := productIDs(ctx, spannerClient)
+	if err != nil {
+		return err
+	}
+
+	repo := repository.NewProductRepo(spannerClient)
+	comm := committer.NewCommitter(spannerClient)
+	periods := 0
+	for _, id := range ids {
+		product, err := repo.FindByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("load product %s: %w", id, err)
+		}
+		muts := repository.ProjectEffectivePricesMuts(product)
+		// The first mutation clears the previous rows; the others insert one period each.
+		periods += len(muts) - 1
+		if *dryRun {
+			continue
+		}
+		plan := committer.NewPlanFor("project-prices")
+		plan.AddAll(muts...)
+		if err := comm.ApplyLowPriority(ctx, plan); err != nil {
+			return fmt.Errorf("project prices of product %s: %w", id, err)
+		}
+	}
+
+	verb := "projected"
+	if *dryRun {
+		verb = "would project"
+	}
+	fmt.Printf("%s %d price periods of %d products\n", verb, periods, len(ids))
+	return nil
+}
+
+func runProjectSummaries(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("project-summaries", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "count the products without writing their summaries")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	spannerClient, err := newSpannerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer spannerClient.Close()
+
+	ids, err := productIDs(ctx, spannerClient)
+	if err != nil {
+		return err
+	}
+
+	if *dryRun {
+		fmt.Printf("would summarize %d products\n", len(ids))
+		return nil
+	}
+
+	repo := repository.NewProductRepo(spannerClient)
+	comm := committer.NewCommitter(spannerClient)
+	clk := clock.NewRealClock()
+	for _, id := range ids {
+		product, err := repo.FindByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("load product %s: %w", id, err)
+		}
+		plan := committer.NewPlanFor("project-summaries")
+		plan.Add(repository.ProjectSummaryMut(product, clk.Now()))
+		if err := comm.ApplyLowPriority(ctx, plan); err != nil {
+			return fmt.Errorf("summarize product %s: %w", id, err)
+		}
+	}
+	fmt.Printf("summarized %d products\n", len(ids))
+	return nil
+}
+
+// runScoreQuality rescores every product under the current attribute schemas and
+// QUALITY_LOCALES, e.g. after either changed.
+func runScoreQuality(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("score-quality", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "count the products without scoring them")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	policy, err := domain.ParseQualityPolicy(config.Load().QualityLocales)
+	if err != nil {
+		return fmt.Errorf("invalid QUALITY_LOCALES: %w", err)
+	}
+
+	spannerClient, err := newSpannerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer spannerClient.Close()
+
+	tenants, err := productTenants(ctx, spannerClient)
+	if err != nil {
+		return err
+	}
+
+	if *dryRun {
+		fmt.Printf("would score %d products\n", len(tenants))
+		return nil
+	}
+
+	scorer := quality.NewScorer(repository.NewProductReadModel(spannerClient),
+		repository.NewAttributeSchemaRepo(spannerClient), repository.NewQualityScoreRepo(spannerClient),
+		policy, clock.NewRealClock())
+	for _, t := range tenants {
+		if err := scorer.Score(ctx, t.productID, t.tenantID); err != nil {
+			return fmt.Errorf("score product %s: %w", t.productID, err)
+		}
+	}
+	fmt.Printf("scored %d products\n", len(tenants))
+	return nil
+}
+
+// runCreateAPIKey issues an API key to a client. Clients manage their further keys through
+// the API, which needs a key to begin with.
+func runCreateAPIKey(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("create-api-key", flag.ExitOnError)
+	client := fs.String("client", "", "ID of the client to issue the key to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	spannerClient, err := newSpannerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer spannerClient.Close()
+
+	manager := apikey.NewManager(repository.NewAPIKeyRepo(spannerClient), clock.NewRealClock())
+	issued, err := manager.Create(ctx, *client)
+	if err != nil {
+		return fmt.Errorf("create API key: %w", err)
+	}
+
+	// The secret is not stored; this is the only time it is shown.
+	fmt.Printf("key_id: %s\nsecret: %s\n", issued.Key.ID(), issued.Secret)
+	return nil
+}
+
+// runSeed loads the golden dataset into a database that does not hold it yet, e.g. a
+// fresh emulator database for contract tests.
+func runSeed(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("seed", flag.ExitOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	spannerClient, err := newSpannerClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer spannerClient.Close()
+
+	repo := repository.NewProductRepo(spannerClient)
+	first := seed.Expected().Products[seed.Golden[0].Key]
+	if _, err := repo.FindByID(ctx, first); err == nil {
+		return fmt.Errorf("golden dataset already loaded (product %s exists)", first)
+	} else if !errors.Is(err, domain.ErrProductNotFound) {
+		return err
+	}
+
+	useCases := usecase.NewProductUseCases(repo, repository.NewOutboxRepo(spannerClient),
+		committer.NewCommitter(spannerClient), seed.NewClock(), usecase.WithIDGenerator(seed.NewIDGenerator()))
+	dataset, err := seed.Load(ctx, useCases)
+	if err != nil {
+		return fmt.Errorf("seed: %w", err)
+	}
+
+	for _, p := range seed.Golden {
+		fmt.Printf("%-20s %s\n", p.Key, dataset.Products[p.Key])
+		for _, d := range p.Discounts {
+			fmt.Printf("%-20s %s\n", d.Key, dataset.Discounts[d.Key])
+		}
+	}
+	return nil
+}
+
+// productIDs returns the IDs of every product, archived ones included.
+func productIDs(ctx context.Context, client *spanner.Client) ([]string, error) {
+	iter := client.Single().Query(ctx, spanner.Statement{SQL: "SELECT product_id FROM products ORDER BY product_id"})
+	defer iter.Stop()
+
+	var ids []string
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return ids, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+}
+
+// productTenant is a product and the tenant that owns it.
+type productTenant struct {
+	productID string
+	tenantID  string
+}
+
+// productTenants returns every product with its tenant, archived ones included.
+func productTenants(ctx context.Context, client *spanner.Client) ([]productTenant, error) {
+	iter := client.Single().Query(ctx, spanner.Statement{SQL: "SELECT product_id, tenant_id FROM products ORDER BY product_id"})
+	defer iter.Stop()
+
+	var products []productTenant
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return products, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var id string
+		var tenantID spanner.NullString
+		if err := row.Columns(&id, &tenantID); err != nil {
+			return nil, err
+		}
+		products = append(products, productTenant{productID: id, tenantID: tenantID.StringVal})
+	}
+}
+
+func writeReport(report *audit.Report, format string) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	case "text":
+		for _, issue := range report.Issues {
+			status := ""
+			switch {
+			case issue.Fixed:
+				status = " (fixed)"
+			case issue.FixError != "":
+				status = " (fix failed: " + issue.FixError + ")"
+			}
+			fmt.Printf("%-7s %-20s %s: %s%s\n", issue.Severity, issue.Rule, issue.ProductID, issue.Message, status)
+		}
+		fmt.Printf("\nscanned %d products, found %d issues\n", report.Scanned, len(report.Issues))
+		return nil
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func newSpannerClient(ctx context.Context) (*spanner.Client, error) {
+	cfg := config.Load()
+	client, err := spanner.NewClient(ctx, cfg.DatabasePath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Spanner client: %w", err)
+	}
+	return client, nil
+}
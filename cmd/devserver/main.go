@@ -0,0 +1,207 @@
+// Command devserver runs the catalog against the Spanner emulator for manual testing. It
+// creates the emulator instance and database if needed, applies the pending migrations,
+// loads the golden dataset (see package seed) into an empty database and serves the gRPC
+// API, with reflection, and the REST API. Outbox events are printed to stdout.
+//
+// It refuses to start without SPANNER_EMULATOR_HOST, so it never touches a real database.
+//
+// Usage:
+//
+//	devserver [-seed=false] [-events=false]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"cloud.google.com/go/spanner"
+	database "cloud.google.com/go/spanner/admin/database/apiv1"
+	instance "cloud.google.com/go/spanner/admin/instance/apiv1"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/config"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/handler"
+	"github.com/product-catalog-service/internal/migrate"
+	"github.com/product-catalog-service/internal/outbox"
+	"github.com/product-catalog-service/internal/query"
+	"github.com/product-catalog-service/internal/repository"
+	"github.com/product-catalog-service/internal/rest"
+	"github.com/product-catalog-service/internal/seed"
+	"github.com/product-catalog-service/internal/selftest"
+	"github.com/product-catalog-service/internal/usecase"
+	"github.com/product-catalog-service/migrations"
+	pb "github.com/product-catalog-service/proto/product/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// defaultHTTPPort serves the REST API when HTTP_PORT is not set.
+const defaultHTTPPort = "8080"
+
+func main() {
+	var (
+		seedData = flag.Bool("seed", true, "load the golden dataset into an empty database")
+		events   = flag.Bool("events", true, "print outbox events to stdout")
+	)
+	flag.Parse()
+
+	if os.Getenv("SPANNER_EMULATOR_HOST") == "" {
+		log.Fatalf("SPANNER_EMULATOR_HOST is not set: devserver only runs against the Spanner emulator")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config.Load()
+	httpPort := cfg.HTTPPort
+	if httpPort == "" {
+		httpPort = defaultHTTPPort
+	}
+
+	if err := prepareDatabase(ctx, cfg); err != nil {
+		log.Fatalf("Failed to prepare the emulator database: %v", err)
+	}
+
+	spannerClient, err := spanner.NewClient(ctx, cfg.DatabasePath())
+	if err != nil {
+		log.Fatalf("Failed to create Spanner client: %v", err)
+	}
+	defer spannerClient.Close()
+
+	clk := clock.NewRealClock()
+	comm := committer.NewCommitter(spannerClient)
+	productRepo := repository.NewProductRepo(spannerClient)
+	outboxRepo := repository.NewOutboxRepo(spannerClient)
+	priceLists := repository.NewPriceListRepo(spannerClient)
+
+	if *seedData {
+		if err := loadGolden(ctx, productRepo, outboxRepo, comm); err != nil {
+			log.Fatalf("Failed to load the golden dataset: %v", err)
+		}
+	}
+
+	useCases := usecase.NewProductUseCases(productRepo, outboxRepo, comm, clk,
+		usecase.WithNotifications(repository.NewNotificationSubscriptionRepo(spannerClient)),
+		usecase.WithFreezeWindows(repository.NewFreezeWindowRepo(spannerClient)),
+		usecase.WithDiscountBlackouts(repository.NewDiscountBlackoutRepo(spannerClient)),
+		usecase.WithCampaigns(repository.NewCampaignRepo(spannerClient)),
+		usecase.WithPriceLists(priceLists),
+		usecase.WithVariants(repository.NewProductVariantRepo(spannerClient)),
+		usecase.WithStock(repository.NewStockRepo(spannerClient)),
+		usecase.WithImages(repository.NewProductImageRepo(spannerClient)),
+		usecase.WithAttachments(repository.NewProductAttachmentRepo(spannerClient)),
+		usecase.WithRelations(repository.NewProductRelationRepo(spannerClient)),
+		usecase.WithTranslations(repository.NewProductTranslationRepo(spannerClient)),
+	)
+	queries := query.NewProductQueries(repository.NewProductReadModel(spannerClient), clk,
+		query.WithPriceLists(priceLists))
+
+	if *events {
+		dispatcher := outbox.NewDispatcher(spannerClient, outbox.NewWriterPublisher(os.Stdout), clk, outbox.DispatcherOptions{})
+		go dispatcher.Run(ctx)
+	}
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(handler.CallerInterceptor()))
+	pb.RegisterProductServiceServer(grpcServer, handler.NewHandler(useCases, queries))
+	reflection.Register(grpcServer)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	listener, err := net.Listen("tcp", ":"+cfg.Port)
+	if err != nil {
+		log.Fatalf("Failed to listen on port %s: %v", cfg.Port, err)
+	}
+
+	httpServer := &http.Server{Addr: ":" + httpPort, Handler: rest.NewHandler(queries)}
+	go func() {
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to serve REST API: %v", err)
+		}
+	}()
+
+	go func() {
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+		<-sigCh
+
+		if err := httpServer.Shutdown(ctx); err != nil {
+			log.Printf("REST API shutdown: %v", err)
+		}
+		healthServer.Shutdown()
+		grpcServer.GracefulStop()
+		cancel()
+	}()
+
+	log.Printf("Dev server ready: gRPC on port %s, REST API on port %s", cfg.Port, httpPort)
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
+}
+
+// prepareDatabase creates the emulator instance and database and applies the pending
+// migrations. The admin clients connect to SPANNER_EMULATOR_HOST on their own.
+func prepareDatabase(ctx context.Context, cfg config.Config) error {
+	instances, err := instance.NewInstanceAdminClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer instances.Close()
+	databases, err := database.NewDatabaseAdminClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer databases.Close()
+
+	if err := migrate.CreateEmulatorDatabase(ctx, instances, databases, cfg); err != nil {
+		return err
+	}
+
+	migrationFiles, err := selftest.ParseMigrations(migrations.Files)
+	if err != nil {
+		return err
+	}
+	client, err := spanner.NewClient(ctx, cfg.DatabasePath())
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	applied, err := migrate.Apply(ctx, migrationFiles, selftest.SpannerSchema(client),
+		migrate.AdminUpdate(databases, cfg), migrate.PartialIndex)
+	for _, name := range applied {
+		log.Printf("Applied migration %s", name)
+	}
+	return err
+}
+
+// loadGolden loads the golden dataset unless its first product already exists.
+func loadGolden(ctx context.Context, productRepo *repository.ProductRepo, outboxRepo *repository.OutboxRepo,
+	comm *committer.Committer) error {
+	ids := seed.Expected().ProductIDs()
+	_, err := productRepo.FindByID(ctx, ids[0])
+	if err == nil {
+		log.Printf("Golden dataset already loaded")
+		return nil
+	}
+	if !errors.Is(err, domain.ErrProductNotFound) {
+		return err
+	}
+
+	useCases := usecase.NewProductUseCases(productRepo, outboxRepo, comm, seed.NewClock(),
+		usecase.WithIDGenerator(seed.NewIDGenerator()))
+	if _, err := seed.Load(ctx, useCases); err != nil {
+		return err
+	}
+	log.Printf("Loaded %d golden products", len(ids))
+	return nil
+}
@@ -0,0 +1,115 @@
+// Command replay re-publishes historical outbox events to rebuild downstream projections.
+//
+// Usage:
+//
+//	replay [-aggregate <id>] [-event-types a,b] [-from <RFC3339>] [-to <RFC3339>] [-filter <expression>] [-publisher stdout|nats|pubsub|opensearch]
+//
+// -publisher opensearch indexes the products the events are about in the OpenSearch index
+// configured by OPENSEARCH_URL, e.g. to fill a new index with -event-types product.created.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/config"
+	"github.com/product-catalog-service/internal/eventfilter"
+	"github.com/product-catalog-service/internal/outbox"
+	"github.com/product-catalog-service/internal/repository"
+	"github.com/product-catalog-service/internal/searchindex"
+)
+
+func main() {
+	var (
+		aggregateID   = flag.String("aggregate", "", "replay events of a single aggregate")
+		eventTypes    = flag.String("event-types", "", "comma-separated event types to replay")
+		from          = flag.String("from", "", "inclusive lower bound on created_at (RFC3339)")
+		to            = flag.String("to", "", "exclusive upper bound on created_at (RFC3339)")
+		expression    = flag.String("filter", "", "only publish events matching this filter expression")
+		publisherName = flag.String("publisher", "stdout", "publisher to replay into: stdout, nats, pubsub or opensearch")
+	)
+	flag.Parse()
+
+	filter := outbox.ReplayFilter{AggregateID: *aggregateID}
+	if *eventTypes != "" {
+		filter.EventTypes = strings.Split(*eventTypes, ",")
+	}
+	var err error
+	if filter.From, err = parseTime(*from); err != nil {
+		log.Fatalf("Invalid -from: %v", err)
+	}
+	if filter.To, err = parseTime(*to); err != nil {
+		log.Fatalf("Invalid -to: %v", err)
+	}
+	var eventFilter *eventfilter.Filter
+	if *expression != "" {
+		if eventFilter, err = eventfilter.Parse(*expression); err != nil {
+			log.Fatalf("Invalid -filter: %v", err)
+		}
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	cfg := config.Load()
+	spannerClient, err := spanner.NewClient(ctx, cfg.DatabasePath())
+	if err != nil {
+		log.Fatalf("Failed to create Spanner client: %v", err)
+	}
+	defer spannerClient.Close()
+
+	var publisher outbox.Publisher
+	if *publisherName == "opensearch" {
+		publisher, err = newIndexer(ctx, spannerClient, cfg)
+	} else {
+		publisher, err = outbox.NewPublisher(ctx, *publisherName, cfg)
+	}
+	if err != nil {
+		log.Fatalf("Failed to create publisher: %v", err)
+	}
+	defer publisher.Close()
+	if eventFilter != nil {
+		publisher = outbox.NewFilteredPublisher(publisher, eventFilter)
+	}
+
+	replayer := outbox.NewReplayer(spannerClient, publisher)
+
+	n, err := replayer.Replay(ctx, filter)
+	log.Printf("Replayed %d events", n)
+	if err != nil {
+		log.Fatalf("Replay failed: %v", err)
+	}
+}
+
+// newIndexer creates a search indexer for the OpenSearch index of cfg, creating the index
+// if it does not exist.
+func newIndexer(ctx context.Context, spannerClient *spanner.Client, cfg config.Config) (*searchindex.Indexer, error) {
+	client, err := searchindex.NewClient(searchindex.Options{
+		URL:      cfg.OpenSearchURL,
+		Index:    cfg.OpenSearchIndex,
+		Username: cfg.OpenSearchUsername,
+		Password: cfg.OpenSearchPassword,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.EnsureIndex(ctx); err != nil {
+		return nil, err
+	}
+	return searchindex.NewIndexer(client, repository.NewProductReadModel(spannerClient), clock.NewRealClock()), nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
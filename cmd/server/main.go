@@ -2,42 +2,77 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/admin"
+	"github.com/product-catalog-service/internal/apikey"
+	"github.com/product-catalog-service/internal/availability"
 	"github.com/product-catalog-service/internal/clock"
 	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/config"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/diagnostics"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/eventbus"
+	"github.com/product-catalog-service/internal/eventfilter"
+	"github.com/product-catalog-service/internal/experiment"
+	"github.com/product-catalog-service/internal/flashsale"
 	"github.com/product-catalog-service/internal/handler"
+	"github.com/product-catalog-service/internal/logging"
+	"github.com/product-catalog-service/internal/outbox"
+	"github.com/product-catalog-service/internal/pagetoken"
+	"github.com/product-catalog-service/internal/pricelock"
+	"github.com/product-catalog-service/internal/productcache"
+	"github.com/product-catalog-service/internal/purge"
+	"github.com/product-catalog-service/internal/quality"
 	"github.com/product-catalog-service/internal/query"
 	"github.com/product-catalog-service/internal/repository"
+	"github.com/product-catalog-service/internal/rest"
+	"github.com/product-catalog-service/internal/scheduler"
+	"github.com/product-catalog-service/internal/searchindex"
+	"github.com/product-catalog-service/internal/selftest"
+	"github.com/product-catalog-service/internal/shadow"
+	"github.com/product-catalog-service/internal/staleness"
 	"github.com/product-catalog-service/internal/usecase"
+	"github.com/product-catalog-service/migrations"
 	pb "github.com/product-catalog-service/proto/product/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
-const (
-	defaultPort     = "50051"
-	defaultProject  = "test-project"
-	defaultInstance = "test-instance"
-	defaultDatabase = "test-database"
-)
+// diagnosticsReportingPeriod is how often the Spanner client reports session pool statistics.
+const diagnosticsReportingPeriod = 10 * time.Second
 
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	port := getEnv("PORT", defaultPort)
-	project := getEnv("SPANNER_PROJECT", defaultProject)
-	instance := getEnv("SPANNER_INSTANCE", defaultInstance)
-	database := getEnv("SPANNER_DATABASE", defaultDatabase)
+	cfg := config.Load()
+	port := cfg.Port
+	dbPath := cfg.DatabasePath()
+
+	logLevel, debugFeatures := loggingSettings(cfg)
+	logging.Configure(logLevel, debugFeatures)
 
-	dbPath := fmt.Sprintf("projects/%s/instances/%s/databases/%s", project, instance, database)
+	// Session pool statistics must be enabled before the Spanner client is created.
+	var sessionStats *diagnostics.SessionStats
+	if cfg.DiagnosticsPort != "" {
+		stats, err := diagnostics.EnableSessionStats(diagnosticsReportingPeriod)
+		if err != nil {
+			log.Printf("Spanner session pool statistics unavailable: %v", err)
+		}
+		sessionStats = stats
+	}
 
 	log.Printf("Connecting to Spanner: %s", dbPath)
 
@@ -47,23 +82,254 @@ func main() {
 	}
 	defer spannerClient.Close()
 
-	productHandler := wireServices(spannerClient)
+	var monitor *availability.Monitor
+	if cfg.DegradationEnabled {
+		monitor = availability.NewMonitor(availability.SpannerProbe(spannerClient), availability.MonitorOptions{
+			CheckInterval:    cfg.HealthCheckInterval,
+			CheckTimeout:     cfg.HealthCheckTimeout,
+			FailureThreshold: cfg.HealthCheckFailureThreshold,
+		})
+		go monitor.Run(ctx)
+		log.Printf("Degradation mode enabled; checking Spanner every %s", cfg.HealthCheckInterval)
+	}
+
+	var handlerOpts []handler.Option
+	interceptors := []grpc.UnaryServerInterceptor{handler.CallerInterceptor()}
+	streamInterceptors := []grpc.StreamServerInterceptor{handler.CallerStreamInterceptor()}
+	if cfg.APIKeyAuth {
+		apiKeys := repository.NewAPIKeyRepo(spannerClient)
+		auth := apikey.NewAuthenticator(apiKeys, clock.NewRealClock(), cfg.APIKeyCacheTTL)
+		interceptors = append(interceptors, handler.APIKeyInterceptor(auth))
+		streamInterceptors = append(streamInterceptors, handler.APIKeyStreamInterceptor(auth))
+		handlerOpts = append(handlerOpts, handler.WithAPIKeys(
+			apikey.NewManager(apiKeys, clock.NewRealClock(), apikey.WithRotationGrace(cfg.APIKeyRotationGrace))))
+		log.Printf("API key auth enabled")
+	}
+	var searchClient *searchindex.Client
+	if cfg.OpenSearchURL != "" {
+		searchClient, err = searchindex.NewClient(searchindex.Options{
+			URL:      cfg.OpenSearchURL,
+			Index:    cfg.OpenSearchIndex,
+			Username: cfg.OpenSearchUsername,
+			Password: cfg.OpenSearchPassword,
+		}, nil)
+		if err != nil {
+			log.Fatalf("Invalid OPENSEARCH_URL: %v", err)
+		}
+		if err := searchClient.EnsureIndex(ctx); err != nil {
+			log.Fatalf("Failed to create OpenSearch index %s: %v", searchClient.Index(), err)
+		}
+		log.Printf("Searching products in OpenSearch index %s", searchClient.Index())
+	}
+	productHandler, useCases, queries := wireServices(spannerClient, monitor, searchClient, cfg, handlerOpts...)
+
+	if cfg.DiscountSchedulerEnabled {
+		discountScheduler := scheduler.NewDiscountScheduler(
+			repository.NewProductRepo(spannerClient), useCases, clock.NewRealClock(),
+			scheduler.DiscountSchedulerOptions{PollInterval: cfg.DiscountSchedulerInterval},
+		)
+		go discountScheduler.Run(ctx)
+		log.Printf("Discount scheduler polling every %s", cfg.DiscountSchedulerInterval)
+	}
+
+	if cfg.PriceChangeSchedulerEnabled {
+		priceChangeScheduler := scheduler.NewPriceChangeScheduler(
+			repository.NewProductRepo(spannerClient), useCases, clock.NewRealClock(),
+			scheduler.PriceChangeSchedulerOptions{PollInterval: cfg.PriceChangeSchedulerInterval},
+		)
+		go priceChangeScheduler.Run(ctx)
+		log.Printf("Price change scheduler polling every %s", cfg.PriceChangeSchedulerInterval)
+	}
+
+	if cfg.StatusSchedulerEnabled {
+		statusScheduler := scheduler.NewStatusScheduler(
+			repository.NewProductRepo(spannerClient), useCases, clock.NewRealClock(),
+			scheduler.StatusSchedulerOptions{PollInterval: cfg.StatusSchedulerInterval},
+		)
+		go statusScheduler.Run(ctx)
+		log.Printf("Status scheduler polling every %s", cfg.StatusSchedulerInterval)
+	}
+
+	if cfg.PurgeEnabled {
+		purger := purge.NewPurger(
+			repository.NewProductRepo(spannerClient), useCases, clock.NewRealClock(),
+			purge.Options{
+				Retention:    time.Duration(cfg.PurgeRetentionDays) * 24 * time.Hour,
+				PollInterval: cfg.PurgeInterval,
+				DryRun:       cfg.PurgeDryRun,
+			},
+		)
+		go purger.Run(ctx)
+		log.Printf("Purger deleting products archived more than %d days ago every %s (dry run: %t)",
+			cfg.PurgeRetentionDays, cfg.PurgeInterval, cfg.PurgeDryRun)
+	}
+
+	if cfg.DigestEnabled {
+		digestScheduler := scheduler.NewDigestScheduler(
+			repository.NewDigestRepo(spannerClient), repository.NewOutboxRepo(spannerClient), clock.NewRealClock(),
+			scheduler.DigestSchedulerOptions{
+				PollInterval:     cfg.DigestInterval,
+				PriceDropPercent: cfg.DigestPriceDropPercent,
+			},
+		)
+		go digestScheduler.Run(ctx)
+		log.Printf("Catalog digest scheduler checking every %s", cfg.DigestInterval)
+	}
+
+	// Every publisher gets each event; the search indexer keeps the OpenSearch index in
+	// sync even if events are not published downstream.
+	var publishers []outbox.Publisher
+	if cfg.OutboxPublisher != outbox.PublisherNone {
+		publisher, err := outbox.NewPublisher(ctx, cfg.OutboxPublisher, cfg)
+		if err != nil {
+			log.Fatalf("Failed to create outbox publisher: %v", err)
+		}
+		defer publisher.Close()
+		if cfg.OutboxFilter != "" {
+			filter, err := eventfilter.Parse(cfg.OutboxFilter)
+			if err != nil {
+				log.Fatalf("Invalid OUTBOX_FILTER: %v", err)
+			}
+			publisher = outbox.NewFilteredPublisher(publisher, filter)
+			log.Printf("Outbox dispatcher publishing events matching %s", filter)
+		}
+		publishers = append(publishers, publisher)
+		log.Printf("Outbox dispatcher publishing to %s", cfg.OutboxPublisher)
+	}
+	if searchClient != nil {
+		publishers = append(publishers, searchindex.NewIndexer(searchClient,
+			repository.NewProductReadModel(spannerClient), clock.NewRealClock()))
+		log.Printf("Outbox dispatcher indexing products in OpenSearch index %s", searchClient.Index())
+	}
+	if cfg.QualityScoringEnabled {
+		qualityPolicy, err := domain.ParseQualityPolicy(cfg.QualityLocales)
+		if err != nil {
+			log.Fatalf("Invalid QUALITY_LOCALES: %v", err)
+		}
+		publishers = append(publishers, quality.NewScorer(repository.NewProductReadModel(spannerClient),
+			repository.NewAttributeSchemaRepo(spannerClient), repository.NewQualityScoreRepo(spannerClient),
+			qualityPolicy, clock.NewRealClock()))
+		log.Printf("Outbox dispatcher scoring product quality")
+	}
+	if len(publishers) > 0 {
+		publisher := publishers[0]
+		if len(publishers) > 1 {
+			publisher = outbox.NewTeePublisher(publishers...)
+		}
+		dispatcher := outbox.NewDispatcher(spannerClient, publisher, clock.NewRealClock(), outbox.DispatcherOptions{
+			MaxBatchSize:  cfg.OutboxMaxBatchSize,
+			FlushInterval: cfg.OutboxFlushInterval,
+			Compact:       cfg.OutboxCompactUpdates,
+		})
+		go dispatcher.Run(ctx)
+	}
 
-	grpcServer := grpc.NewServer()
+	if monitor != nil {
+		interceptors = append(interceptors, handler.FastFailWritesInterceptor(monitor))
+	}
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...), grpc.ChainStreamInterceptor(streamInterceptors...))
 	pb.RegisterProductServiceServer(grpcServer, productHandler)
 	reflection.Register(grpcServer)
 
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	if cfg.SelfTestEnabled {
+		setServingStatus(healthServer, healthpb.HealthCheckResponse_NOT_SERVING)
+		checks := selfTestChecks(spannerClient, cfg)
+		go selftest.RunUntilPassed(ctx, checks, selftest.Options{
+			CheckTimeout:  cfg.SelfTestTimeout,
+			RetryInterval: cfg.SelfTestRetryInterval,
+			Output:        os.Stdout,
+		}, func() {
+			setServingStatus(healthServer, healthpb.HealthCheckResponse_SERVING)
+			log.Println("Self-test passed; reporting ready")
+		})
+	} else {
+		setServingStatus(healthServer, healthpb.HealthCheckResponse_SERVING)
+	}
+
 	listener, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		log.Fatalf("Failed to listen on port %s: %v", port, err)
 	}
 
+	var httpServer *http.Server
+	if cfg.HTTPPort != "" {
+		httpServer = &http.Server{Addr: ":" + cfg.HTTPPort, Handler: rest.NewHandler(queries)}
+		go func() {
+			if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalf("Failed to serve REST API: %v", err)
+			}
+		}()
+		log.Printf("REST API listening on port %s", cfg.HTTPPort)
+	}
+
+	var adminServer *http.Server
+	if cfg.AdminPort != "" {
+		adminHandler, err := admin.NewHandler(cfg.AdminToken,
+			admin.WithMerchandising(repository.NewMerchandisingRepo(spannerClient)),
+			admin.WithFreezeWindows(repository.NewFreezeWindowRepo(spannerClient), clock.NewRealClock()),
+			admin.WithDiscountBlackouts(repository.NewDiscountBlackoutRepo(spannerClient), clock.NewRealClock()),
+			admin.WithAttributeSchemas(repository.NewAttributeSchemaRepo(spannerClient)),
+			admin.WithQualityScores(repository.NewQualityScoreRepo(spannerClient)),
+		)
+		if err != nil {
+			log.Fatalf("Failed to configure admin endpoints (set ADMIN_TOKEN): %v", err)
+		}
+		adminServer = &http.Server{Addr: ":" + cfg.AdminPort, Handler: adminHandler}
+		go func() {
+			if err := adminServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalf("Failed to serve admin endpoints: %v", err)
+			}
+		}()
+		log.Printf("Admin endpoints listening on port %s", cfg.AdminPort)
+	}
+
+	var diagnosticsServer *http.Server
+	if cfg.DiagnosticsPort != "" {
+		addr := net.JoinHostPort(cfg.DiagnosticsHost, cfg.DiagnosticsPort)
+		diagnosticsServer = &http.Server{Addr: addr, Handler: diagnostics.NewHandler(sessionStats)}
+		go func() {
+			if err := diagnosticsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalf("Failed to serve diagnostics endpoints: %v", err)
+			}
+		}()
+		log.Printf("Diagnostics endpoints listening on %s", addr)
+	}
+
+	// SIGHUP restores the configured log level and debug features after runtime changes.
+	go func() {
+		hupCh := make(chan os.Signal, 1)
+		signal.Notify(hupCh, syscall.SIGHUP)
+		for range hupCh {
+			logging.Configure(logLevel, debugFeatures)
+			log.Printf("Logging reset to level=%s features=%v", logLevel, debugFeatures)
+		}
+	}()
+
 	go func() {
 		sigCh := make(chan os.Signal, 1)
 		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 		<-sigCh
 
+		if httpServer != nil {
+			log.Println("Shutting down REST API...")
+			if err := httpServer.Shutdown(ctx); err != nil {
+				log.Printf("REST API shutdown: %v", err)
+			}
+		}
+		if adminServer != nil {
+			if err := adminServer.Shutdown(ctx); err != nil {
+				log.Printf("Admin endpoints shutdown: %v", err)
+			}
+		}
+		if diagnosticsServer != nil {
+			if err := diagnosticsServer.Shutdown(ctx); err != nil {
+				log.Printf("Diagnostics endpoints shutdown: %v", err)
+			}
+		}
 		log.Println("Shutting down gRPC server...")
+		healthServer.Shutdown()
 		grpcServer.GracefulStop()
 		cancel()
 	}()
@@ -77,23 +343,201 @@ func main() {
 	log.Println("Server stopped")
 }
 
-func wireServices(spannerClient *spanner.Client) *handler.Handler {
+// loggingSettings parses the configured log level and debug features.
+func loggingSettings(cfg config.Config) (logging.Level, []logging.Feature) {
+	level, err := logging.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("Invalid LOG_LEVEL: %v", err)
+	}
+	features, err := logging.ParseFeatures(cfg.DebugFeatures)
+	if err != nil {
+		log.Fatalf("Invalid DEBUG_FEATURES: %v", err)
+	}
+	return level, features
+}
+
+// selfTestChecks returns the startup checks of the service's dependencies.
+func selfTestChecks(spannerClient *spanner.Client, cfg config.Config) []selftest.Check {
+	migrationFiles, err := selftest.ParseMigrations(migrations.Files)
+	if err != nil {
+		log.Fatalf("Failed to parse migrations: %v", err)
+	}
+	schema := selftest.SpannerSchema(spannerClient)
+
+	return []selftest.Check{
+		selftest.SpannerCheck(spannerClient),
+		selftest.MigrationsCheck(migrationFiles, schema),
+		selftest.SchemaCheck(migrationFiles, schema),
+		selftest.PubSubTopicCheck(cfg.OutboxPublisher, outbox.PubSubOptions{
+			Project:      cfg.PubSubProject,
+			Topic:        cfg.PubSubTopic,
+			EmulatorHost: cfg.PubSubEmulatorHost,
+		}),
+		selftest.KMSKeyCheck(cfg.KMSKeyName),
+	}
+}
+
+// setServingStatus sets the health of the server as a whole and of the product service.
+func setServingStatus(healthServer *health.Server, status healthpb.HealthCheckResponse_ServingStatus) {
+	healthServer.SetServingStatus("", status)
+	healthServer.SetServingStatus(pb.ProductService_ServiceDesc.ServiceName, status)
+}
+
+// wireServices creates the handler, use cases and queries. monitor is nil unless
+// degradation mode is enabled, searchClient unless OpenSearch is configured.
+func wireServices(spannerClient *spanner.Client, monitor *availability.Monitor, searchClient *searchindex.Client,
+	cfg config.Config, handlerOpts ...handler.Option) (*handler.Handler, *usecase.ProductUseCases, *query.ProductQueries) {
 	clk := clock.NewRealClock()
 	comm := committer.NewCommitter(spannerClient)
 
 	productRepo := repository.NewProductRepo(spannerClient)
-	outboxRepo := repository.NewOutboxRepo()
-	readModel := repository.NewProductReadModel(spannerClient)
+	outboxRepo := repository.NewOutboxRepo(spannerClient)
+	readStaleness, err := staleness.Parse(cfg.ReadStaleness)
+	if err != nil {
+		log.Fatalf("Invalid READ_STALENESS: %v", err)
+	}
+	readModelOpts := []repository.ReadModelOption{repository.WithReadStaleness(readStaleness)}
+	if cfg.ListProductSummaries {
+		readModelOpts = append(readModelOpts, repository.WithSummaryListing())
+	}
+	var readModel contract.ProductReadModel = repository.NewProductReadModel(spannerClient, readModelOpts...)
+	if cfg.PriceReadSource != shadow.SourceComputed || cfg.PriceShadowSampleRate > 0 {
+		shadowReadModel, err := shadow.NewReadModel(readModel, repository.NewProjectedPriceReader(spannerClient),
+			cfg.PriceReadSource, shadow.WithSampleRate(cfg.PriceShadowSampleRate))
+		if err != nil {
+			log.Fatalf("Invalid PRICE_READ_SOURCE: %v", err)
+		}
+		readModel = shadowReadModel
+	}
+	if monitor != nil {
+		readModel = availability.NewReadModel(readModel, monitor, clk, cfg.DegradationCacheSize)
+	}
+	var productCache *productcache.ReadModel
+	switch {
+	case cfg.RedisURL != "" && cfg.ProductCacheSize > 0:
+		log.Fatalf("Set at most one of REDIS_URL and PRODUCT_CACHE_SIZE")
+	case cfg.RedisURL != "":
+		redisClient, err := productcache.NewClient(cfg.RedisURL, productcache.DefaultTimeout)
+		if err != nil {
+			log.Fatalf("Invalid REDIS_URL: %v", err)
+		}
+		productCache = productcache.NewReadModel(readModel, redisClient, clk, cfg.ProductCacheTTL,
+			productcache.WithReadStaleness(readStaleness))
+		log.Printf("Caching products in Redis for at most %s", cfg.ProductCacheTTL)
+	case cfg.ProductCacheSize > 0:
+		store := productcache.NewMemoryStore(cfg.ProductCacheSize, clk)
+		productCache = productcache.NewReadModel(readModel, store, clk, cfg.ProductCacheTTL,
+			productcache.WithReadStaleness(readStaleness))
+		log.Printf("Caching %d products in memory for at most %s", cfg.ProductCacheSize, cfg.ProductCacheTTL)
+	}
+	if productCache != nil {
+		readModel = productCache
+	}
+	subscriptions := repository.NewNotificationSubscriptionRepo(spannerClient)
+	priceLists := repository.NewPriceListRepo(spannerClient)
 
-	useCases := usecase.NewProductUseCases(productRepo, outboxRepo, comm, clk)
-	queries := query.NewProductQueries(readModel, clk)
+	bus := eventbus.NewBus()
+	bus.Subscribe(eventbus.AllEvents, eventbus.CountEvents)
+	if productCache != nil {
+		bus.Subscribe(eventbus.AllEvents, productCache.Invalidate)
+	}
+	hookURLs, err := flashsale.ParseHookURLs(cfg.FlashSaleHookURLs)
+	if err != nil {
+		log.Fatalf("Invalid FLASH_SALE_HOOK_URLS: %v", err)
+	}
+	if len(hookURLs) > 0 {
+		bus.Subscribe(eventbus.AllEvents, flashsale.NewHooks(hookURLs, nil).Handle)
+		log.Printf("Notifying %d flash sale hooks", len(hookURLs))
+	}
 
-	return handler.NewHandler(useCases, queries)
-}
+	marginGuard, err := domain.ParseMarginGuard(cfg.MarginGuard)
+	if err != nil {
+		log.Fatalf("Invalid MARGIN_GUARD: %v", err)
+	}
+	composition, err := domain.ParseDiscountComposition(cfg.DiscountComposition, cfg.DiscountCaps)
+	if err != nil {
+		log.Fatalf("Invalid DISCOUNT_COMPOSITION or DISCOUNT_CAPS: %v", err)
+	}
+	approvalThreshold, err := domain.ParseApprovalThreshold(cfg.DiscountApprovalThreshold)
+	if err != nil {
+		log.Fatalf("Invalid DISCOUNT_APPROVAL_THRESHOLD: %v", err)
+	}
+	compliancePolicy, err := domain.ParseCompliancePolicy(cfg.AgeRestrictedCategories)
+	if err != nil {
+		log.Fatalf("Invalid AGE_RESTRICTED_CATEGORIES: %v", err)
+	}
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+	useCases := usecase.NewProductUseCases(productRepo, outboxRepo, comm, clk,
+		usecase.WithEventSnapshots(cfg.OutboxEventSnapshots),
+		usecase.WithMarginGuard(marginGuard),
+		usecase.WithDiscountApprovalThreshold(approvalThreshold),
+		usecase.WithProductReview(cfg.ProductReviewRequired),
+		usecase.WithCompliancePolicy(compliancePolicy),
+		usecase.WithEventPublisher(bus),
+		usecase.WithNotifications(subscriptions),
+		usecase.WithFreezeWindows(repository.NewFreezeWindowRepo(spannerClient)),
+		usecase.WithDiscountBlackouts(repository.NewDiscountBlackoutRepo(spannerClient)),
+		usecase.WithCampaigns(repository.NewCampaignRepo(spannerClient)),
+		usecase.WithPriceLists(priceLists),
+		usecase.WithVariants(repository.NewProductVariantRepo(spannerClient)),
+		usecase.WithStock(repository.NewStockRepo(spannerClient)),
+		usecase.WithImages(repository.NewProductImageRepo(spannerClient)),
+		usecase.WithAttachments(repository.NewProductAttachmentRepo(spannerClient)),
+		usecase.WithRelations(repository.NewProductRelationRepo(spannerClient)),
+		usecase.WithTranslations(repository.NewProductTranslationRepo(spannerClient)),
+		usecase.WithAttributeSchemas(repository.NewAttributeSchemaRepo(spannerClient)),
+		usecase.WithRatings(repository.NewRatingRepo(spannerClient)),
+	)
+	queryOpts := []query.Option{query.WithPriceLists(priceLists)}
+	if cfg.PriceLockSecret != "" {
+		signer, err := pricelock.NewSigner([]byte(cfg.PriceLockSecret), cfg.PriceLockTTL)
+		if err != nil {
+			log.Fatalf("Failed to configure price locks: %v", err)
+		}
+		queryOpts = append(queryOpts, query.WithPriceLocks(signer))
+	} else {
+		log.Printf("PRICE_LOCK_SECRET not set; price locks are disabled")
+	}
+	if cfg.PageTokenSecret != "" {
+		pageTokens, err := pagetoken.NewSigner([]byte(cfg.PageTokenSecret))
+		if err != nil {
+			log.Fatalf("Failed to configure page tokens: %v", err)
+		}
+		queryOpts = append(queryOpts, query.WithPageTokens(pageTokens))
+	} else {
+		log.Printf("PAGE_TOKEN_SECRET not set; page tokens are unsigned")
+	}
+	if cfg.CurrencyRates != "" {
+		rates, err := domain.ParseCurrencyRates(cfg.BaseCurrency, cfg.CurrencyRates)
+		if err != nil {
+			log.Fatalf("Invalid CURRENCY_RATES: %v", err)
+		}
+		queryOpts = append(queryOpts, query.WithCurrencyRates(rates))
+	}
+	rounding, err := domain.ParseRoundingPolicy(cfg.RoundingPolicy)
+	if err != nil {
+		log.Fatalf("Invalid ROUNDING_POLICY: %v", err)
 	}
-	return defaultValue
+	queryOpts = append(queryOpts, query.WithRoundingPolicy(rounding))
+	queryOpts = append(queryOpts, query.WithDiscountComposition(composition))
+	if cfg.PricingExperimentsFile != "" {
+		experiments, err := experiment.Load(cfg.PricingExperimentsFile)
+		if err != nil {
+			log.Fatalf("Invalid PRICING_EXPERIMENTS_FILE: %v", err)
+		}
+		queryOpts = append(queryOpts, query.WithExperiments(experiments, outboxRepo))
+	}
+	if cfg.TaxRates != "" {
+		rates, err := domain.ParseTaxRates(cfg.TaxRates)
+		if err != nil {
+			log.Fatalf("Invalid TAX_RATES: %v", err)
+		}
+		queryOpts = append(queryOpts, query.WithTaxRates(rates))
+	}
+	if searchClient != nil {
+		queryOpts = append(queryOpts, query.WithSearchEngine(searchindex.NewEngine(searchClient)))
+	}
+	queries := query.NewProductQueries(readModel, clk, queryOpts...)
+
+	return handler.NewHandler(useCases, queries, handlerOpts...), useCases, queries
 }
@@ -0,0 +1,124 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// MaxSchemaCategoryLength is the size of the category column of
+// category_attribute_schemas.
+const MaxSchemaCategoryLength = 100
+
+// attributeSchema is the attribute schema of a category as stored and listed through the
+// admin endpoints.
+type attributeSchema struct {
+	Category   string                `json:"category"`
+	Attributes []attributeDefinition `json:"attributes"`
+}
+
+type attributeDefinition struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Required      bool     `json:"required,omitempty"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+}
+
+func attributeSchemaJSON(s *domain.CategoryAttributeSchema) attributeSchema {
+	attributes := make([]attributeDefinition, 0, len(s.Attributes()))
+	for _, d := range s.Attributes() {
+		attributes = append(attributes, attributeDefinition{
+			Name:          d.Name(),
+			Type:          string(d.Type()),
+			Required:      d.Required(),
+			AllowedValues: d.AllowedValues(),
+		})
+	}
+	return attributeSchema{Category: s.Category(), Attributes: attributes}
+}
+
+func (h *Handler) listAttributeSchemas(w http.ResponseWriter, r *http.Request) {
+	schemas, err := h.schemas.List(r.Context())
+	if err != nil {
+		logging.Errorf("admin: failed to list attribute schemas: %v", err)
+		writeError(w, http.StatusInternalServerError, "failed to list attribute schemas")
+		return
+	}
+
+	list := make([]attributeSchema, len(schemas))
+	for i, schema := range schemas {
+		list[i] = attributeSchemaJSON(schema)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"schemas": list})
+}
+
+// putAttributeSchema stores the attribute schema of the category, replacing any it had.
+// Existing products are not checked; they are validated when they are next changed.
+func (h *Handler) putAttributeSchema(w http.ResponseWriter, r *http.Request) {
+	category := r.PathValue("category")
+
+	var body attributeSchema
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	schema, err := validateAttributeSchema(category, body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.schemas.Save(r.Context(), schema); err != nil {
+		logging.Errorf("admin: failed to store attribute schema of %q: %v", category, err)
+		writeError(w, http.StatusInternalServerError, "failed to store attribute schema")
+		return
+	}
+
+	log.Printf("admin: attribute schema of category %q (%d attributes) stored by %s",
+		category, len(schema.Attributes()), r.RemoteAddr)
+	writeJSON(w, http.StatusOK, attributeSchemaJSON(schema))
+}
+
+func (h *Handler) deleteAttributeSchema(w http.ResponseWriter, r *http.Request) {
+	category := r.PathValue("category")
+	if err := h.schemas.Delete(r.Context(), category); err != nil {
+		if errors.Is(err, domain.ErrAttributeSchemaNotFound) {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		logging.Errorf("admin: failed to delete attribute schema of %q: %v", category, err)
+		writeError(w, http.StatusInternalServerError, "failed to delete attribute schema")
+		return
+	}
+
+	log.Printf("admin: attribute schema of category %q deleted by %s", category, r.RemoteAddr)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateAttributeSchema checks the attribute schema to store for a category. The
+// category of the body, if set, must match the path.
+func validateAttributeSchema(category string, body attributeSchema) (*domain.CategoryAttributeSchema, error) {
+	switch {
+	case len(category) > MaxSchemaCategoryLength:
+		return nil, fmt.Errorf("category must be at most %d characters", MaxSchemaCategoryLength)
+	case body.Category != "" && body.Category != category:
+		return nil, errors.New("category of the body does not match the path")
+	}
+
+	definitions := make([]*domain.AttributeDefinition, 0, len(body.Attributes))
+	for _, a := range body.Attributes {
+		d, err := domain.NewAttributeDefinition(a.Name, domain.AttributeType(a.Type), a.Required, a.AllowedValues)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", a.Name, err)
+		}
+		definitions = append(definitions, d)
+	}
+	return domain.NewCategoryAttributeSchema(category, definitions)
+}
@@ -0,0 +1,113 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSchemas keeps the attribute schemas stored through the admin endpoints in memory.
+type fakeSchemas struct {
+	schemas map[string]*domain.CategoryAttributeSchema
+	err     error
+}
+
+func (f *fakeSchemas) Save(_ context.Context, schema *domain.CategoryAttributeSchema) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.schemas[schema.Category()] = schema
+	return nil
+}
+
+func (f *fakeSchemas) Delete(_ context.Context, category string) error {
+	if _, ok := f.schemas[category]; !ok {
+		return domain.ErrAttributeSchemaNotFound
+	}
+	delete(f.schemas, category)
+	return nil
+}
+
+func (f *fakeSchemas) Find(context.Context, string) (*domain.CategoryAttributeSchema, error) {
+	return nil, errors.New("not used by the admin endpoints")
+}
+
+func (f *fakeSchemas) List(context.Context) ([]*domain.CategoryAttributeSchema, error) {
+	var schemas []*domain.CategoryAttributeSchema
+	for _, s := range f.schemas {
+		schemas = append(schemas, s)
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Category() < schemas[j].Category() })
+	return schemas, f.err
+}
+
+func TestHandler_AttributeSchemas(t *testing.T) {
+	repo := &fakeSchemas{schemas: make(map[string]*domain.CategoryAttributeSchema)}
+	h, err := NewHandler("secret", WithAttributeSchemas(repo))
+	require.NoError(t, err)
+
+	rec := freezeRequest(t, h, http.MethodPut, "/admin/attribute-schemas/Furniture", `{"attributes": [
+		{"name": "material", "type": "string", "required": true, "allowed_values": ["oak", "pine"]},
+		{"name": "legs", "type": "integer"}]}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var stored attributeSchema
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &stored))
+	assert.Equal(t, "Furniture", stored.Category)
+	require.Len(t, stored.Attributes, 2)
+	assert.Equal(t, "legs", stored.Attributes[0].Name)
+	require.Contains(t, repo.schemas, "Furniture")
+	assert.True(t, repo.schemas["Furniture"].Attribute("material").Required())
+
+	rec = freezeRequest(t, h, http.MethodGet, "/admin/attribute-schemas", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var listed struct {
+		Schemas []attributeSchema `json:"schemas"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &listed))
+	assert.Equal(t, []attributeSchema{stored}, listed.Schemas)
+
+	rec = freezeRequest(t, h, http.MethodDelete, "/admin/attribute-schemas/Furniture", "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, repo.schemas)
+
+	rec = freezeRequest(t, h, http.MethodDelete, "/admin/attribute-schemas/Furniture", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	repo.err = errors.New("spanner unavailable")
+	rec = freezeRequest(t, h, http.MethodPut, "/admin/attribute-schemas/Furniture", `{"attributes": []}`)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestHandler_InvalidAttributeSchemas(t *testing.T) {
+	repo := &fakeSchemas{schemas: make(map[string]*domain.CategoryAttributeSchema)}
+	h, err := NewHandler("secret", WithAttributeSchemas(repo))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "malformed body", body: `{"attributes":`},
+		{name: "unknown field", body: `{"attributes": [{"name": "legs", "type": "integer", "unit": "pcs"}]}`},
+		{name: "category mismatch", body: `{"category": "Toys", "attributes": []}`},
+		{name: "unknown type", body: `{"attributes": [{"name": "legs", "type": "date"}]}`},
+		{name: "invalid name", body: `{"attributes": [{"name": "Legs", "type": "integer"}]}`},
+		{name: "allowed value of another type", body: `{"attributes": [{"name": "legs", "type": "integer", "allowed_values": ["four"]}]}`},
+		{name: "duplicate attribute", body: `{"attributes": [{"name": "legs", "type": "integer"}, {"name": "legs", "type": "string"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := freezeRequest(t, h, http.MethodPut, "/admin/attribute-schemas/Furniture", tt.body)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Empty(t, repo.schemas)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// MaxBlackoutCategoryLength is the size of the category column of discount_blackouts.
+const MaxBlackoutCategoryLength = 100
+
+// discountBlackout is a discount blackout as scheduled and listed through the admin
+// endpoints.
+type discountBlackout struct {
+	ID       string    `json:"id,omitempty"`
+	Category string    `json:"category"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Reason   string    `json:"reason,omitempty"`
+}
+
+func discountBlackoutJSON(b *domain.DiscountBlackout) discountBlackout {
+	return discountBlackout{
+		ID:       b.ID(),
+		Category: b.Category(),
+		Start:    b.Start(),
+		End:      b.End(),
+		Reason:   b.Reason(),
+	}
+}
+
+func (h *Handler) listBlackouts(w http.ResponseWriter, r *http.Request) {
+	blackouts, err := h.blackouts.List(r.Context(), h.clock.Now())
+	if err != nil {
+		logging.Errorf("admin: failed to list discount blackouts: %v", err)
+		writeError(w, http.StatusInternalServerError, "failed to list discount blackouts")
+		return
+	}
+
+	list := make([]discountBlackout, len(blackouts))
+	for i, blackout := range blackouts {
+		list[i] = discountBlackoutJSON(blackout)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"blackouts": list})
+}
+
+func (h *Handler) scheduleBlackout(w http.ResponseWriter, r *http.Request) {
+	var body discountBlackout
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	blackout, err := h.validateBlackout(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.blackouts.Schedule(r.Context(), blackout); err != nil {
+		logging.Errorf("admin: failed to schedule discount blackout: %v", err)
+		writeError(w, http.StatusInternalServerError, "failed to schedule discount blackout")
+		return
+	}
+
+	log.Printf("admin: discount blackout %s (%s to %s, category %q) scheduled by %s",
+		blackout.ID(), blackout.Start().Format(time.RFC3339), blackout.End().Format(time.RFC3339), blackout.Category(), r.RemoteAddr)
+	writeJSON(w, http.StatusCreated, discountBlackoutJSON(blackout))
+}
+
+func (h *Handler) cancelBlackout(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if err := h.blackouts.Cancel(r.Context(), id); err != nil {
+		if errors.Is(err, domain.ErrDiscountBlackoutNotFound) {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		logging.Errorf("admin: failed to cancel discount blackout %s: %v", id, err)
+		writeError(w, http.StatusInternalServerError, "failed to cancel discount blackout")
+		return
+	}
+
+	log.Printf("admin: discount blackout %s cancelled by %s", id, r.RemoteAddr)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateBlackout checks a discount blackout to schedule and assigns it an ID. Blackouts
+// must not have ended yet.
+func (h *Handler) validateBlackout(body discountBlackout) (*domain.DiscountBlackout, error) {
+	switch {
+	case body.ID != "":
+		return nil, errors.New("id is assigned by the server")
+	case body.Category == "":
+		return nil, errors.New("category is required")
+	case len(body.Category) > MaxBlackoutCategoryLength:
+		return nil, fmt.Errorf("category must be at most %d characters", MaxBlackoutCategoryLength)
+	case !body.End.After(h.clock.Now()):
+		return nil, errors.New("end must be in the future")
+	}
+	return domain.NewDiscountBlackout(uuid.New().String(), body.Category, body.Start, body.End, body.Reason)
+}
@@ -0,0 +1,121 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBlackouts keeps the discount blackouts scheduled through the admin endpoints in
+// memory.
+type fakeBlackouts struct {
+	blackouts []*domain.DiscountBlackout
+	err       error
+}
+
+func (f *fakeBlackouts) Schedule(_ context.Context, blackout *domain.DiscountBlackout) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.blackouts = append(f.blackouts, blackout)
+	return nil
+}
+
+func (f *fakeBlackouts) Cancel(_ context.Context, id string) error {
+	for i, b := range f.blackouts {
+		if b.ID() == id {
+			f.blackouts = append(f.blackouts[:i], f.blackouts[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrDiscountBlackoutNotFound
+}
+
+func (f *fakeBlackouts) List(_ context.Context, at time.Time) ([]*domain.DiscountBlackout, error) {
+	var blackouts []*domain.DiscountBlackout
+	for _, b := range f.blackouts {
+		if b.End().After(at) {
+			blackouts = append(blackouts, b)
+		}
+	}
+	return blackouts, f.err
+}
+
+func (f *fakeBlackouts) FindOverlapping(context.Context, time.Time, time.Time) ([]*domain.DiscountBlackout, error) {
+	return nil, errors.New("not used by the admin endpoints")
+}
+
+func TestHandler_DiscountBlackouts(t *testing.T) {
+	now := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	repo := &fakeBlackouts{}
+	h, err := NewHandler("secret", WithDiscountBlackouts(repo, clock.NewFixedClock(now)))
+	require.NoError(t, err)
+
+	rec := freezeRequest(t, h, http.MethodPost, "/admin/blackouts", `{"category": "Electronics",
+		"start": "2024-11-25T00:00:00Z", "end": "2024-12-02T00:00:00Z", "reason": "MAP week"}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var scheduled discountBlackout
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &scheduled))
+	assert.NotEmpty(t, scheduled.ID)
+	assert.Equal(t, "MAP week", scheduled.Reason)
+	require.Len(t, repo.blackouts, 1)
+	assert.Equal(t, "Electronics", repo.blackouts[0].Category())
+
+	rec = freezeRequest(t, h, http.MethodGet, "/admin/blackouts", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var listed struct {
+		Blackouts []discountBlackout `json:"blackouts"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &listed))
+	assert.Equal(t, []discountBlackout{scheduled}, listed.Blackouts)
+
+	rec = freezeRequest(t, h, http.MethodDelete, "/admin/blackouts/"+scheduled.ID, "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, repo.blackouts)
+
+	rec = freezeRequest(t, h, http.MethodDelete, "/admin/blackouts/"+scheduled.ID, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	repo.err = errors.New("spanner unavailable")
+	rec = freezeRequest(t, h, http.MethodPost, "/admin/blackouts",
+		`{"category": "Electronics", "start": "2024-11-25T00:00:00Z", "end": "2024-12-02T00:00:00Z"}`)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestHandler_InvalidDiscountBlackouts(t *testing.T) {
+	now := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	repo := &fakeBlackouts{}
+	h, err := NewHandler("secret", WithDiscountBlackouts(repo, clock.NewFixedClock(now)))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "malformed body", body: `{"category":`},
+		{name: "unknown field", body: `{"category": "Electronics", "tenant": "acme", "start": "2024-11-25T00:00:00Z", "end": "2024-12-02T00:00:00Z"}`},
+		{name: "ID set", body: `{"id": "b-1", "category": "Electronics", "start": "2024-11-25T00:00:00Z", "end": "2024-12-02T00:00:00Z"}`},
+		{name: "missing category", body: `{"start": "2024-11-25T00:00:00Z", "end": "2024-12-02T00:00:00Z"}`},
+		{name: "category too long", body: `{"category": "` + strings.Repeat("c", MaxBlackoutCategoryLength+1) + `",
+			"start": "2024-11-25T00:00:00Z", "end": "2024-12-02T00:00:00Z"}`},
+		{name: "end before start", body: `{"category": "Electronics", "start": "2024-12-02T00:00:00Z", "end": "2024-11-25T00:00:00Z"}`},
+		{name: "already ended", body: `{"category": "Electronics", "start": "2024-10-01T00:00:00Z", "end": "2024-10-02T00:00:00Z"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := freezeRequest(t, h, http.MethodPost, "/admin/blackouts", tt.body)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Empty(t, repo.blackouts)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// Limits of a freeze window, matching the freeze_windows columns.
+const (
+	MaxFreezeTenantLength = 100
+	MaxFreezeRoleLength   = 50
+	MaxFreezeExemptRoles  = 20
+)
+
+// freezeWindow is a freeze window as scheduled and listed through the admin endpoints.
+type freezeWindow struct {
+	ID          string    `json:"id,omitempty"`
+	Tenant      string    `json:"tenant,omitempty"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	ExemptRoles []string  `json:"exempt_roles,omitempty"`
+	Reason      string    `json:"reason,omitempty"`
+}
+
+func freezeWindowJSON(w *domain.FreezeWindow) freezeWindow {
+	return freezeWindow{
+		ID:          w.ID(),
+		Tenant:      w.Tenant(),
+		Start:       w.Start(),
+		End:         w.End(),
+		ExemptRoles: w.ExemptRoles(),
+		Reason:      w.Reason(),
+	}
+}
+
+func (h *Handler) listFreezes(w http.ResponseWriter, r *http.Request) {
+	windows, err := h.freezes.List(r.Context(), h.clock.Now())
+	if err != nil {
+		logging.Errorf("admin: failed to list freeze windows: %v", err)
+		writeError(w, http.StatusInternalServerError, "failed to list freeze windows")
+		return
+	}
+
+	freezes := make([]freezeWindow, len(windows))
+	for i, window := range windows {
+		freezes[i] = freezeWindowJSON(window)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"freezes": freezes})
+}
+
+func (h *Handler) scheduleFreeze(w http.ResponseWriter, r *http.Request) {
+	var body freezeWindow
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	window, err := h.validateFreeze(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.freezes.Schedule(r.Context(), window); err != nil {
+		logging.Errorf("admin: failed to schedule freeze window: %v", err)
+		writeError(w, http.StatusInternalServerError, "failed to schedule freeze window")
+		return
+	}
+
+	log.Printf("admin: freeze window %s (%s to %s, tenant %q) scheduled by %s",
+		window.ID(), window.Start().Format(time.RFC3339), window.End().Format(time.RFC3339), window.Tenant(), r.RemoteAddr)
+	writeJSON(w, http.StatusCreated, freezeWindowJSON(window))
+}
+
+func (h *Handler) cancelFreeze(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if err := h.freezes.Cancel(r.Context(), id); err != nil {
+		if errors.Is(err, domain.ErrFreezeWindowNotFound) {
+			writeError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		logging.Errorf("admin: failed to cancel freeze window %s: %v", id, err)
+		writeError(w, http.StatusInternalServerError, "failed to cancel freeze window")
+		return
+	}
+
+	log.Printf("admin: freeze window %s cancelled by %s", id, r.RemoteAddr)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateFreeze checks a freeze window to schedule and assigns it an ID. Windows must
+// not have ended yet.
+func (h *Handler) validateFreeze(body freezeWindow) (*domain.FreezeWindow, error) {
+	switch {
+	case body.ID != "":
+		return nil, errors.New("id is assigned by the server")
+	case len(body.Tenant) > MaxFreezeTenantLength:
+		return nil, fmt.Errorf("tenant must be at most %d characters", MaxFreezeTenantLength)
+	case len(body.ExemptRoles) > MaxFreezeExemptRoles:
+		return nil, fmt.Errorf("at most %d roles can be exempt", MaxFreezeExemptRoles)
+	case !body.End.After(h.clock.Now()):
+		return nil, errors.New("end must be in the future")
+	}
+	for i, role := range body.ExemptRoles {
+		if role == "" || len(role) > MaxFreezeRoleLength {
+			return nil, fmt.Errorf("exempt_roles[%d]: roles must be 1 to %d characters", i, MaxFreezeRoleLength)
+		}
+	}
+	return domain.NewFreezeWindow(uuid.New().String(), body.Tenant, body.Start, body.End, body.ExemptRoles, body.Reason)
+}
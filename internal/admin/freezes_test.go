@@ -0,0 +1,130 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeFreezes keeps the freeze windows scheduled through the admin endpoints in memory.
+type fakeFreezes struct {
+	windows []*domain.FreezeWindow
+	err     error
+}
+
+func (f *fakeFreezes) Schedule(_ context.Context, window *domain.FreezeWindow) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.windows = append(f.windows, window)
+	return nil
+}
+
+func (f *fakeFreezes) Cancel(_ context.Context, id string) error {
+	for i, w := range f.windows {
+		if w.ID() == id {
+			f.windows = append(f.windows[:i], f.windows[i+1:]...)
+			return nil
+		}
+	}
+	return domain.ErrFreezeWindowNotFound
+}
+
+func (f *fakeFreezes) List(_ context.Context, at time.Time) ([]*domain.FreezeWindow, error) {
+	var windows []*domain.FreezeWindow
+	for _, w := range f.windows {
+		if w.End().After(at) {
+			windows = append(windows, w)
+		}
+	}
+	return windows, f.err
+}
+
+func (f *fakeFreezes) FindActive(context.Context, time.Time) ([]*domain.FreezeWindow, error) {
+	return nil, errors.New("not used by the admin endpoints")
+}
+
+func freezeRequest(t *testing.T, h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer secret")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_FreezeWindows(t *testing.T) {
+	now := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	repo := &fakeFreezes{}
+	h, err := NewHandler("secret", WithFreezeWindows(repo, clock.NewFixedClock(now)))
+	require.NoError(t, err)
+
+	rec := freezeRequest(t, h, http.MethodPost, "/admin/freezes", `{"tenant": "acme",
+		"start": "2024-11-29T00:00:00Z", "end": "2024-12-02T00:00:00Z",
+		"exempt_roles": ["pricing-oncall"], "reason": "Black Friday"}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var scheduled freezeWindow
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &scheduled))
+	assert.NotEmpty(t, scheduled.ID)
+	assert.Equal(t, []string{"pricing-oncall"}, scheduled.ExemptRoles)
+	require.Len(t, repo.windows, 1)
+	assert.Equal(t, "acme", repo.windows[0].Tenant())
+
+	rec = freezeRequest(t, h, http.MethodGet, "/admin/freezes", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var listed struct {
+		Freezes []freezeWindow `json:"freezes"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &listed))
+	assert.Equal(t, []freezeWindow{scheduled}, listed.Freezes)
+
+	rec = freezeRequest(t, h, http.MethodDelete, "/admin/freezes/"+scheduled.ID, "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, repo.windows)
+
+	rec = freezeRequest(t, h, http.MethodDelete, "/admin/freezes/"+scheduled.ID, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	repo.err = errors.New("spanner unavailable")
+	rec = freezeRequest(t, h, http.MethodPost, "/admin/freezes", `{"start": "2024-11-29T00:00:00Z", "end": "2024-12-02T00:00:00Z"}`)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestHandler_InvalidFreezeWindows(t *testing.T) {
+	now := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	repo := &fakeFreezes{}
+	h, err := NewHandler("secret", WithFreezeWindows(repo, clock.NewFixedClock(now)))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "malformed body", body: `{"start":`},
+		{name: "unknown field", body: `{"begin": "2024-11-29T00:00:00Z", "end": "2024-12-02T00:00:00Z"}`},
+		{name: "ID set", body: `{"id": "f-1", "start": "2024-11-29T00:00:00Z", "end": "2024-12-02T00:00:00Z"}`},
+		{name: "end before start", body: `{"start": "2024-12-02T00:00:00Z", "end": "2024-11-29T00:00:00Z"}`},
+		{name: "already ended", body: `{"start": "2024-10-01T00:00:00Z", "end": "2024-10-02T00:00:00Z"}`},
+		{name: "empty role", body: `{"start": "2024-11-29T00:00:00Z", "end": "2024-12-02T00:00:00Z", "exempt_roles": [""]}`},
+		{name: "tenant too long", body: `{"tenant": "` + strings.Repeat("t", MaxFreezeTenantLength+1) + `",
+			"start": "2024-11-29T00:00:00Z", "end": "2024-12-02T00:00:00Z"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := freezeRequest(t, h, http.MethodPost, "/admin/freezes", tt.body)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Empty(t, repo.windows)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Package admin implements the operator-only HTTP endpoints of the service.
+//
+// Every request must carry the configured admin token as a bearer token. The endpoints are
+// served on their own port so they are never exposed together with the public REST API.
+package admin
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// ErrTokenRequired is returned when the handler is created without an admin token.
+var ErrTokenRequired = errors.New("admin token is required")
+
+// Handler serves the admin endpoints.
+type Handler struct {
+	token         []byte
+	mux           *http.ServeMux
+	merchandising contract.MerchandisingRepository
+	freezes       contract.FreezeWindowRepository
+	blackouts     contract.DiscountBlackoutRepository
+	schemas       contract.AttributeSchemaRepository
+	quality       contract.QualityScoreRepository
+	clock         clock.Clock
+}
+
+// Option configures optional admin endpoints.
+type Option func(*Handler)
+
+// WithMerchandising serves the endpoint the merchandising service pushes category
+// rankings to, storing them in repo.
+func WithMerchandising(repo contract.MerchandisingRepository) Option {
+	return func(h *Handler) {
+		h.merchandising = repo
+	}
+}
+
+// WithFreezeWindows serves the endpoints that schedule, list and cancel the freeze windows
+// stored in repo.
+func WithFreezeWindows(repo contract.FreezeWindowRepository, clock clock.Clock) Option {
+	return func(h *Handler) {
+		h.freezes = repo
+		h.clock = clock
+	}
+}
+
+// WithDiscountBlackouts serves the endpoints that schedule, list and cancel the discount
+// blackouts stored in repo.
+func WithDiscountBlackouts(repo contract.DiscountBlackoutRepository, clock clock.Clock) Option {
+	return func(h *Handler) {
+		h.blackouts = repo
+		h.clock = clock
+	}
+}
+
+// WithAttributeSchemas serves the endpoints that store, list and delete the attribute
+// schemas of categories stored in repo.
+func WithAttributeSchemas(repo contract.AttributeSchemaRepository) Option {
+	return func(h *Handler) {
+		h.schemas = repo
+	}
+}
+
+// WithQualityScores serves the endpoint that lists the quality scores of products stored
+// in repo.
+func WithQualityScores(repo contract.QualityScoreRepository) Option {
+	return func(h *Handler) {
+		h.quality = repo
+	}
+}
+
+// NewHandler creates an admin handler that accepts requests bearing the given token.
+func NewHandler(token string, opts ...Option) (*Handler, error) {
+	if token == "" {
+		return nil, ErrTokenRequired
+	}
+	h := &Handler{
+		token: []byte(token),
+		mux:   http.NewServeMux(),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	h.mux.HandleFunc("GET /admin/logging", h.getLogging)
+	h.mux.HandleFunc("PUT /admin/logging", h.putLogging)
+	if h.merchandising != nil {
+		h.mux.HandleFunc("PUT /admin/merchandising/{category}", h.putMerchandisingRanks)
+	}
+	if h.freezes != nil {
+		h.mux.HandleFunc("GET /admin/freezes", h.listFreezes)
+		h.mux.HandleFunc("POST /admin/freezes", h.scheduleFreeze)
+		h.mux.HandleFunc("DELETE /admin/freezes/{id}", h.cancelFreeze)
+	}
+	if h.blackouts != nil {
+		h.mux.HandleFunc("GET /admin/blackouts", h.listBlackouts)
+		h.mux.HandleFunc("POST /admin/blackouts", h.scheduleBlackout)
+		h.mux.HandleFunc("DELETE /admin/blackouts/{id}", h.cancelBlackout)
+	}
+	if h.schemas != nil {
+		h.mux.HandleFunc("GET /admin/attribute-schemas", h.listAttributeSchemas)
+		h.mux.HandleFunc("PUT /admin/attribute-schemas/{category}", h.putAttributeSchema)
+		h.mux.HandleFunc("DELETE /admin/attribute-schemas/{category}", h.deleteAttributeSchema)
+	}
+	if h.quality != nil {
+		h.mux.HandleFunc("GET /admin/quality-scores", h.listQualityScores)
+	}
+	return h, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+		writeError(w, http.StatusUnauthorized, "missing or invalid admin token")
+		return
+	}
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(token), h.token) == 1
+}
+
+func (h *Handler) getLogging(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, logging.Snapshot())
+}
+
+// loggingUpdate changes the log level and debug features; omitted settings are kept.
+type loggingUpdate struct {
+	Level    *string         `json:"level"`
+	Features map[string]bool `json:"features"`
+}
+
+func (h *Handler) putLogging(w http.ResponseWriter, r *http.Request) {
+	var update loggingUpdate
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&update); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	// Validate everything before changing anything, so a bad request has no effect.
+	var level logging.Level
+	if update.Level != nil {
+		var err error
+		if level, err = logging.ParseLevel(*update.Level); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	for name := range update.Features {
+		if _, err := logging.ParseFeatures(name); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	if update.Level != nil {
+		logging.SetLevel(level)
+	}
+	for name, on := range update.Features {
+		if err := logging.SetFeature(logging.Feature(strings.ToLower(name)), on); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	// Changes are always logged, whatever the new level.
+	state := logging.Snapshot()
+	log.Printf("admin: logging changed by %s: level=%s features=%v", r.RemoteAddr, state.Level, state.Features)
+	writeJSON(w, http.StatusOK, state)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logging.Warnf("admin: failed to write response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{"error": map[string]any{"status": status, "message": message}})
+}
@@ -0,0 +1,95 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/product-catalog-service/internal/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serve(t *testing.T, method, body, token string) *httptest.ResponseRecorder {
+	t.Helper()
+	h, err := NewHandler("secret")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(method, "/admin/logging", strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestNewHandler_RequiresToken(t *testing.T) {
+	_, err := NewHandler("")
+	assert.ErrorIs(t, err, ErrTokenRequired)
+}
+
+func TestHandler_Unauthorized(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "missing token", token: ""},
+		{name: "wrong token", token: "guess"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, http.MethodGet, "", tt.token)
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+			assert.NotEmpty(t, rec.Header().Get("WWW-Authenticate"))
+		})
+	}
+}
+
+func TestHandler_Logging(t *testing.T) {
+	t.Cleanup(func() { logging.Configure(logging.LevelInfo, nil) })
+
+	rec := serve(t, http.MethodGet, "", "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var state logging.State
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &state))
+	assert.Equal(t, "info", state.Level)
+
+	rec = serve(t, http.MethodPut, `{"level": "debug", "features": {"sql": true}}`, "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, logging.LevelDebug, logging.CurrentLevel())
+	assert.True(t, logging.FeatureEnabled(logging.FeatureSQL))
+	assert.False(t, logging.FeatureEnabled(logging.FeaturePayloads))
+
+	// Omitted settings are kept
+	rec = serve(t, http.MethodPut, `{"features": {"payloads": true}}`, "secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &state))
+	assert.Equal(t, logging.State{Level: "debug", Features: map[string]bool{"sql": true, "payloads": true}}, state)
+}
+
+func TestHandler_InvalidUpdate(t *testing.T) {
+	t.Cleanup(func() { logging.Configure(logging.LevelInfo, nil) })
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "malformed body", body: `{"level":`},
+		{name: "unknown field", body: `{"verbosity": "debug"}`},
+		{name: "unknown level", body: `{"level": "trace"}`},
+		{name: "unknown feature", body: `{"level": "debug", "features": {"profiling": true}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, http.MethodPut, tt.body, "secret")
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			// A rejected update changes nothing
+			assert.Equal(t, logging.LevelInfo, logging.CurrentLevel())
+		})
+	}
+}
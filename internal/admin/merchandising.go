@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// MaxMerchandisingRanks is the maximum number of products ranked in one category, which
+// keeps a ranking within a single Spanner commit.
+const MaxMerchandisingRanks = 10000
+
+// merchandisingRanks is the ranking of a category pushed by the merchandising service.
+// It replaces the previous ranking of the category; products left out become unranked.
+type merchandisingRanks struct {
+	Ranks []merchandisingRank `json:"ranks"`
+}
+
+type merchandisingRank struct {
+	ProductID string `json:"product_id"`
+	Rank      int64  `json:"rank"`
+}
+
+func (h *Handler) putMerchandisingRanks(w http.ResponseWriter, r *http.Request) {
+	category := r.PathValue("category")
+
+	var body merchandisingRanks
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+	ranks, err := validateRanks(body.Ranks)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.merchandising.ReplaceRanks(r.Context(), category, ranks); err != nil {
+		logging.Errorf("admin: failed to store merchandising ranks of %q: %v", category, err)
+		writeError(w, http.StatusInternalServerError, "failed to store merchandising ranks")
+		return
+	}
+
+	log.Printf("admin: %d merchandising ranks of category %q replaced by %s", len(ranks), category, r.RemoteAddr)
+	writeJSON(w, http.StatusOK, map[string]any{"category": category, "ranks": len(ranks)})
+}
+
+// validateRanks checks a ranking: up to MaxMerchandisingRanks distinct products, each with
+// a non-negative rank. Ranks need not be distinct or contiguous.
+func validateRanks(ranks []merchandisingRank) ([]contract.MerchandisingRank, error) {
+	if len(ranks) > MaxMerchandisingRanks {
+		return nil, fmt.Errorf("at most %d products can be ranked in a category", MaxMerchandisingRanks)
+	}
+
+	seen := make(map[string]bool, len(ranks))
+	valid := make([]contract.MerchandisingRank, len(ranks))
+	for i, rank := range ranks {
+		switch {
+		case rank.ProductID == "":
+			return nil, fmt.Errorf("ranks[%d]: product_id is required", i)
+		case seen[rank.ProductID]:
+			return nil, fmt.Errorf("ranks[%d]: product %s is ranked twice", i, rank.ProductID)
+		case rank.Rank < 0 || rank.Rank == math.MaxInt64:
+			return nil, fmt.Errorf("ranks[%d]: rank must be between 0 and %d", i, int64(math.MaxInt64-1))
+		}
+		seen[rank.ProductID] = true
+		valid[i] = contract.MerchandisingRank{ProductID: rank.ProductID, Rank: rank.Rank}
+	}
+	return valid, nil
+}
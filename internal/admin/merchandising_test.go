@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMerchandising records the rankings stored through the admin endpoint.
+type fakeMerchandising struct {
+	ranks map[string][]contract.MerchandisingRank
+	err   error
+}
+
+func (f *fakeMerchandising) ReplaceRanks(_ context.Context, category string, ranks []contract.MerchandisingRank) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.ranks[category] = ranks
+	return nil
+}
+
+func putRanks(t *testing.T, h http.Handler, category, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPut, "/admin/merchandising/"+category, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer secret")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_MerchandisingRanks(t *testing.T) {
+	repo := &fakeMerchandising{ranks: make(map[string][]contract.MerchandisingRank)}
+	h, err := NewHandler("secret", WithMerchandising(repo))
+	require.NoError(t, err)
+
+	rec := putRanks(t, h, "Tools", `{"ranks": [{"product_id": "p2", "rank": 1}, {"product_id": "p1", "rank": 2}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"category": "Tools", "ranks": 2}`, rec.Body.String())
+	assert.Equal(t, []contract.MerchandisingRank{{ProductID: "p2", Rank: 1}, {ProductID: "p1", Rank: 2}}, repo.ranks["Tools"])
+
+	// An empty ranking unranks the whole category.
+	rec = putRanks(t, h, "Tools", `{"ranks": []}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, repo.ranks["Tools"])
+
+	repo.err = errors.New("spanner unavailable")
+	rec = putRanks(t, h, "Tools", `{"ranks": [{"product_id": "p1", "rank": 1}]}`)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestHandler_InvalidMerchandisingRanks(t *testing.T) {
+	repo := &fakeMerchandising{ranks: make(map[string][]contract.MerchandisingRank)}
+	h, err := NewHandler("secret", WithMerchandising(repo))
+	require.NoError(t, err)
+
+	tooMany := make([]string, MaxMerchandisingRanks+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf(`{"product_id": "p%d", "rank": %d}`, i, i)
+	}
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "malformed body", body: `{"ranks":`},
+		{name: "unknown field", body: `{"ranking": []}`},
+		{name: "missing product ID", body: `{"ranks": [{"rank": 1}]}`},
+		{name: "duplicate product", body: `{"ranks": [{"product_id": "p1", "rank": 1}, {"product_id": "p1", "rank": 2}]}`},
+		{name: "negative rank", body: `{"ranks": [{"product_id": "p1", "rank": -1}]}`},
+		{name: "too many ranks", body: `{"ranks": [` + strings.Join(tooMany, ",") + `]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := putRanks(t, h, "Tools", tt.body)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Empty(t, repo.ranks)
+		})
+	}
+}
+
+func TestHandler_MerchandisingDisabled(t *testing.T) {
+	h, err := NewHandler("secret")
+	require.NoError(t, err)
+
+	rec := putRanks(t, h, "Tools", `{"ranks": []}`)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,127 @@
+package admin
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// Page sizes of the quality score listing.
+const (
+	DefaultQualityPageSize = 100
+	MaxQualityPageSize     = 1000
+)
+
+var errInvalidQualityPageToken = errors.New("invalid page_token")
+
+// productQuality is the quality score of a product as listed through the admin endpoints.
+type productQuality struct {
+	ProductID    string    `json:"product_id"`
+	Tenant       string    `json:"tenant,omitempty"`
+	Name         string    `json:"name"`
+	Category     string    `json:"category"`
+	Score        int       `json:"score"`
+	Description  int       `json:"description_score"`
+	Images       int       `json:"image_score"`
+	Attributes   int       `json:"attribute_score"`
+	Translations *int      `json:"translation_score,omitempty"`
+	ScoredAt     time.Time `json:"scored_at"`
+}
+
+func productQualityJSON(q *contract.ProductQualityDTO) productQuality {
+	return productQuality{
+		ProductID:    q.ProductID,
+		Tenant:       q.TenantID,
+		Name:         q.Name,
+		Category:     q.Category,
+		Score:        q.Score.Overall,
+		Description:  q.Score.Description,
+		Images:       q.Score.Images,
+		Attributes:   q.Score.Attributes,
+		Translations: q.Score.Translations,
+		ScoredAt:     q.ScoredAt,
+	}
+}
+
+// listQualityScores lists the quality scores of products, worst first or, with
+// order_by=score desc, best first, optionally of one tenant and category. A page ends
+// with the next_page_token to pass as page_token for the next page, if there is one.
+func (h *Handler) listQualityScores(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := contract.QualityListFilter{Category: query.Get("category"), Limit: DefaultQualityPageSize}
+	if query.Has("tenant") {
+		tenant := query.Get("tenant")
+		filter.TenantID = &tenant
+	}
+	switch query.Get("order_by") {
+	case "", "score":
+	case "score desc":
+		filter.Descending = true
+	default:
+		writeError(w, http.StatusBadRequest, `order_by must be "score" or "score desc"`)
+		return
+	}
+	if s := query.Get("page_size"); s != "" {
+		size, err := strconv.Atoi(s)
+		if err != nil || size < 1 || size > MaxQualityPageSize {
+			writeError(w, http.StatusBadRequest, "page_size must be 1 to "+strconv.Itoa(MaxQualityPageSize))
+			return
+		}
+		filter.Limit = size
+	}
+	if token := query.Get("page_token"); token != "" {
+		cursor, err := parseQualityPageToken(token)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.After = cursor
+	}
+
+	pageSize := filter.Limit
+	filter.Limit++
+	scores, err := h.quality.List(r.Context(), filter)
+	if err != nil {
+		logging.Errorf("admin: failed to list quality scores: %v", err)
+		writeError(w, http.StatusInternalServerError, "failed to list quality scores")
+		return
+	}
+
+	body := map[string]any{}
+	if len(scores) > pageSize {
+		scores = scores[:pageSize]
+		last := scores[pageSize-1]
+		body["next_page_token"] = qualityPageToken(contract.QualityCursor{Overall: last.Score.Overall, ProductID: last.ProductID})
+	}
+	list := make([]productQuality, len(scores))
+	for i, score := range scores {
+		list[i] = productQualityJSON(score)
+	}
+	body["scores"] = list
+	writeJSON(w, http.StatusOK, body)
+}
+
+// qualityPageToken encodes the position of the last score of a page.
+func qualityPageToken(cursor contract.QualityCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(cursor.Overall) + "/" + cursor.ProductID))
+}
+
+// parseQualityPageToken decodes a token returned by qualityPageToken.
+func parseQualityPageToken(token string) (*contract.QualityCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errInvalidQualityPageToken
+	}
+	score, productID, ok := strings.Cut(string(raw), "/")
+	overall, err := strconv.Atoi(score)
+	if !ok || err != nil || overall < 0 || overall > 100 || productID == "" {
+		return nil, errInvalidQualityPageToken
+	}
+	return &contract.QualityCursor{Overall: overall, ProductID: productID}, nil
+}
@@ -0,0 +1,110 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"testing"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeQualityScores lists quality scores kept in memory as the Spanner repository does.
+type fakeQualityScores struct {
+	contract.QualityScoreRepository
+	scores []*contract.ProductQualityDTO
+}
+
+func (f *fakeQualityScores) List(_ context.Context, filter contract.QualityListFilter) ([]*contract.ProductQualityDTO, error) {
+	before := func(a, b *contract.ProductQualityDTO) bool {
+		if a.Score.Overall != b.Score.Overall {
+			return a.Score.Overall < b.Score.Overall
+		}
+		return a.ProductID < b.ProductID
+	}
+	if filter.Descending {
+		ascending := before
+		before = func(a, b *contract.ProductQualityDTO) bool { return ascending(b, a) }
+	}
+	sorted := append([]*contract.ProductQualityDTO(nil), f.scores...)
+	sort.Slice(sorted, func(i, j int) bool { return before(sorted[i], sorted[j]) })
+
+	var after *contract.ProductQualityDTO
+	if filter.After != nil {
+		after = &contract.ProductQualityDTO{ProductID: filter.After.ProductID, Score: domain.QualityScore{Overall: filter.After.Overall}}
+	}
+	listed := make([]*contract.ProductQualityDTO, 0)
+	for _, q := range sorted {
+		if (filter.TenantID != nil && q.TenantID != *filter.TenantID) || (filter.Category != "" && q.Category != filter.Category) ||
+			(after != nil && !before(after, q)) {
+			continue
+		}
+		if len(listed) == filter.Limit {
+			break
+		}
+		listed = append(listed, q)
+	}
+	return listed, nil
+}
+
+type qualityPage struct {
+	Scores        []productQuality `json:"scores"`
+	NextPageToken string           `json:"next_page_token"`
+}
+
+func listQuality(t *testing.T, h http.Handler, query url.Values) qualityPage {
+	t.Helper()
+	rec := freezeRequest(t, h, http.MethodGet, "/admin/quality-scores?"+query.Encode(), "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var page qualityPage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	return page
+}
+
+func productIDsOf(page qualityPage) []string {
+	ids := make([]string, len(page.Scores))
+	for i, s := range page.Scores {
+		ids[i] = s.ProductID
+	}
+	return ids
+}
+
+func TestHandler_QualityScores(t *testing.T) {
+	translations := 50
+	repo := &fakeQualityScores{scores: []*contract.ProductQualityDTO{
+		{ProductID: "p1", Name: "Chair", Category: "Furniture", Score: domain.QualityScore{Overall: 80}},
+		{ProductID: "p2", Name: "Lamp", Category: "Lighting", Score: domain.QualityScore{Overall: 20}},
+		{ProductID: "p3", TenantID: "acme", Name: "Desk", Category: "Furniture",
+			Score: domain.QualityScore{Overall: 20, Description: 10, Images: 33, Attributes: 0, Translations: &translations}},
+		{ProductID: "p4", Name: "Sofa", Category: "Furniture", Score: domain.QualityScore{Overall: 95}},
+	}}
+	h, err := NewHandler("secret", WithQualityScores(repo))
+	require.NoError(t, err)
+
+	page := listQuality(t, h, url.Values{"page_size": {"3"}})
+	assert.Equal(t, []string{"p2", "p3", "p1"}, productIDsOf(page), "worst first")
+	assert.Equal(t, productQuality{ProductID: "p3", Tenant: "acme", Name: "Desk", Category: "Furniture",
+		Score: 20, Description: 10, Images: 33, Translations: &translations}, page.Scores[1])
+	require.NotEmpty(t, page.NextPageToken)
+
+	page = listQuality(t, h, url.Values{"page_size": {"3"}, "page_token": {page.NextPageToken}})
+	assert.Equal(t, []string{"p4"}, productIDsOf(page))
+	assert.Empty(t, page.NextPageToken)
+
+	page = listQuality(t, h, url.Values{"order_by": {"score desc"}, "category": {"Furniture"}})
+	assert.Equal(t, []string{"p4", "p1", "p3"}, productIDsOf(page))
+
+	page = listQuality(t, h, url.Values{"tenant": {""}, "category": {"Furniture"}})
+	assert.Equal(t, []string{"p1", "p4"}, productIDsOf(page), "an empty tenant is the default tenant")
+
+	outOfRange := qualityPageToken(contract.QualityCursor{Overall: 101, ProductID: "p1"})
+	for _, query := range []string{"order_by=name", "page_size=0", "page_size=1001", "page_token=%21%21", "page_token=" + outOfRange} {
+		rec := freezeRequest(t, h, http.MethodGet, "/admin/quality-scores?"+query, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+}
@@ -0,0 +1,165 @@
+// Package apikey issues API keys to clients and authenticates requests with them.
+//
+// A secret is "pck_" + key ID + "." + base64url(32 random bytes). Only the SHA-256 hash of
+// the secret is stored; the key ID it starts with locates the stored key, which the rest
+// of the secret must then match.
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// SecretPrefix starts every secret, so that leaked keys are easy to scan for.
+const SecretPrefix = "pck_"
+
+// DefaultRotationGrace is how long a rotated key keeps working by default, so that its
+// client can roll out the new key.
+const DefaultRotationGrace = 24 * time.Hour
+
+// secretBytes is the number of random bytes in a secret.
+const secretBytes = 32
+
+// API key errors.
+var (
+	// ErrInvalidKey is returned for a secret that is malformed, unknown, expired or
+	// revoked; callers are not told which.
+	ErrInvalidKey = errors.New("invalid API key")
+	// ErrDisabled is returned by the key management RPCs when API-key auth is off.
+	ErrDisabled = errors.New("API keys are not enabled")
+)
+
+// Issued is a newly issued key with its secret, which is not stored and cannot be
+// recovered.
+type Issued struct {
+	Key    *domain.APIKey
+	Secret string
+	// Replaces is the key a rotation issued Key in place of, nil for new keys.
+	Replaces *domain.APIKey
+}
+
+// issue generates a key of the client with a random secret.
+func issue(clientID string, now time.Time) (*Issued, error) {
+	random := make([]byte, secretBytes)
+	if _, err := rand.Read(random); err != nil {
+		return nil, err
+	}
+	id := uuid.New().String()
+	secret := SecretPrefix + id + "." + base64.RawURLEncoding.EncodeToString(random)
+	hash := sha256.Sum256([]byte(secret))
+	key, err := domain.NewAPIKey(id, clientID, hash[:], now)
+	if err != nil {
+		return nil, err
+	}
+	return &Issued{Key: key, Secret: secret}, nil
+}
+
+// keyID returns the ID of the key a secret claims to be.
+func keyID(secret string) (string, bool) {
+	rest, ok := strings.CutPrefix(secret, SecretPrefix)
+	if !ok {
+		return "", false
+	}
+	id, _, ok := strings.Cut(rest, ".")
+	if !ok || id == "" {
+		return "", false
+	}
+	return id, true
+}
+
+// Option configures a Manager.
+type Option func(*Manager)
+
+// WithRotationGrace sets how long a rotated key keeps working; it defaults to
+// DefaultRotationGrace.
+func WithRotationGrace(grace time.Duration) Option {
+	return func(m *Manager) {
+		m.grace = grace
+	}
+}
+
+// Manager creates, rotates and revokes the keys of clients.
+type Manager struct {
+	repo  contract.APIKeyRepository
+	clock clock.Clock
+	grace time.Duration
+}
+
+// NewManager creates a Manager that stores keys in repo.
+func NewManager(repo contract.APIKeyRepository, clk clock.Clock, opts ...Option) *Manager {
+	m := &Manager{repo: repo, clock: clk, grace: DefaultRotationGrace}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Create issues a new key to a client. The client's other keys keep working.
+func (m *Manager) Create(ctx context.Context, clientID string) (*Issued, error) {
+	issued, err := issue(clientID, m.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := m.repo.Save(ctx, issued.Key); err != nil {
+		return nil, err
+	}
+	return issued, nil
+}
+
+// Rotate issues a new key to a client in place of one of its keys, which keeps working
+// for the rotation grace period. Keys of other clients are reported as not found.
+func (m *Manager) Rotate(ctx context.Context, clientID, keyID string) (*Issued, error) {
+	old, err := m.find(ctx, clientID, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := m.clock.Now()
+	if err := old.Expire(now.Add(m.grace)); err != nil {
+		return nil, err
+	}
+	issued, err := issue(clientID, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.repo.Save(ctx, old, issued.Key); err != nil {
+		return nil, err
+	}
+	issued.Replaces = old
+	return issued, nil
+}
+
+// Revoke makes one of the keys of a client stop working. Keys of other clients are
+// reported as not found.
+func (m *Manager) Revoke(ctx context.Context, clientID, keyID string) error {
+	key, err := m.find(ctx, clientID, keyID)
+	if err != nil {
+		return err
+	}
+	if err := key.Revoke(m.clock.Now()); err != nil {
+		return err
+	}
+	return m.repo.Save(ctx, key)
+}
+
+// find returns the key with the given ID if it belongs to the client.
+func (m *Manager) find(ctx context.Context, clientID, keyID string) (*domain.APIKey, error) {
+	key, err := m.repo.FindByID(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if key.ClientID() != clientID {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	return key, nil
+}
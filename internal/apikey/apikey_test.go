@@ -0,0 +1,166 @@
+package apikey
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryRepo is an in-memory contract.APIKeyRepository that counts its reads.
+type memoryRepo struct {
+	mu    sync.Mutex
+	keys  map[string]*domain.APIKey
+	reads int
+}
+
+func newMemoryRepo() *memoryRepo {
+	return &memoryRepo{keys: make(map[string]*domain.APIKey)}
+}
+
+func (r *memoryRepo) FindByID(_ context.Context, id string) (*domain.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reads++
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+	// Callers get a copy, as if read from the database
+	return domain.ReconstructAPIKey(key.ID(), key.ClientID(), key.Hash(), key.CreatedAt(), key.ExpiresAt(), key.RevokedAt()), nil
+}
+
+func (r *memoryRepo) Save(_ context.Context, keys ...*domain.APIKey) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, key := range keys {
+		r.keys[key.ID()] = key
+	}
+	return nil
+}
+
+func TestKeyID(t *testing.T) {
+	tests := []struct {
+		secret string
+		wantID string
+		wantOK bool
+	}{
+		{"pck_key-1.c2VjcmV0", "key-1", true},
+		{"key-1.c2VjcmV0", "", false},
+		{"pck_key-1", "", false},
+		{"pck_.c2VjcmV0", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.secret, func(t *testing.T) {
+			id, ok := keyID(tt.secret)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantID, id)
+		})
+	}
+}
+
+func TestManager_Create(t *testing.T) {
+	clk := clock.NewFixedClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	repo := newMemoryRepo()
+	auth := NewAuthenticator(repo, clk, DefaultCacheTTL)
+
+	issued, err := NewManager(repo, clk).Create(context.Background(), "storefront")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(issued.Secret, SecretPrefix+issued.Key.ID()+"."))
+	assert.NotContains(t, string(issued.Key.Hash()), issued.Secret)
+
+	key, err := auth.Authenticate(context.Background(), issued.Secret)
+	require.NoError(t, err)
+	assert.Equal(t, "storefront", key.ClientID())
+
+	_, err = auth.Authenticate(context.Background(), issued.Secret+"x")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+	_, err = auth.Authenticate(context.Background(), "pck_unknown.c2VjcmV0")
+	assert.ErrorIs(t, err, ErrInvalidKey)
+
+	_, err = NewManager(repo, clk).Create(context.Background(), "")
+	assert.ErrorIs(t, err, domain.ErrInvalidClientID)
+}
+
+func TestManager_Rotate(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewFixedClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	repo := newMemoryRepo()
+	manager := NewManager(repo, clk, WithRotationGrace(time.Hour))
+	auth := NewAuthenticator(repo, clk, 0)
+
+	old, err := manager.Create(ctx, "storefront")
+	require.NoError(t, err)
+
+	_, err = manager.Rotate(ctx, "other-client", old.Key.ID())
+	assert.ErrorIs(t, err, domain.ErrAPIKeyNotFound)
+
+	rotated, err := manager.Rotate(ctx, "storefront", old.Key.ID())
+	require.NoError(t, err)
+	assert.NotEqual(t, old.Key.ID(), rotated.Key.ID())
+	assert.Equal(t, old.Key.ID(), rotated.Replaces.ID())
+	assert.Equal(t, clk.Now().Add(time.Hour), *rotated.Replaces.ExpiresAt())
+
+	// Both keys work during the grace period, only the new one after it
+	for _, secret := range []string{old.Secret, rotated.Secret} {
+		_, err := auth.Authenticate(ctx, secret)
+		assert.NoError(t, err)
+	}
+	clk.Advance(time.Hour)
+	_, err = auth.Authenticate(ctx, old.Secret)
+	assert.ErrorIs(t, err, ErrInvalidKey)
+	_, err = auth.Authenticate(ctx, rotated.Secret)
+	assert.NoError(t, err)
+}
+
+func TestManager_Revoke(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewFixedClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	repo := newMemoryRepo()
+	manager := NewManager(repo, clk)
+	auth := NewAuthenticator(repo, clk, time.Minute)
+
+	issued, err := manager.Create(ctx, "storefront")
+	require.NoError(t, err)
+	_, err = auth.Authenticate(ctx, issued.Secret)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, manager.Revoke(ctx, "other-client", issued.Key.ID()), domain.ErrAPIKeyNotFound)
+	require.NoError(t, manager.Revoke(ctx, "storefront", issued.Key.ID()))
+	assert.ErrorIs(t, manager.Revoke(ctx, "storefront", issued.Key.ID()), domain.ErrAPIKeyRevoked)
+
+	// The cached key keeps working until the cache entry expires
+	_, err = auth.Authenticate(ctx, issued.Secret)
+	assert.NoError(t, err)
+	clk.Advance(time.Minute)
+	_, err = auth.Authenticate(ctx, issued.Secret)
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
+
+func TestAuthenticator_Cache(t *testing.T) {
+	ctx := context.Background()
+	clk := clock.NewFixedClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	repo := newMemoryRepo()
+	issued, err := NewManager(repo, clk).Create(ctx, "storefront")
+	require.NoError(t, err)
+	auth := NewAuthenticator(repo, clk, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		_, err := auth.Authenticate(ctx, issued.Secret)
+		require.NoError(t, err)
+		_, err = auth.Authenticate(ctx, "pck_unknown.c2VjcmV0")
+		require.ErrorIs(t, err, ErrInvalidKey)
+	}
+	assert.Equal(t, 2, repo.reads, "known and unknown keys are both cached")
+
+	clk.Advance(time.Minute)
+	_, err = auth.Authenticate(ctx, issued.Secret)
+	require.NoError(t, err)
+	assert.Equal(t, 3, repo.reads)
+}
@@ -0,0 +1,106 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// DefaultCacheTTL is how long an Authenticator caches a key by default.
+const DefaultCacheTTL = 30 * time.Second
+
+// MaxCachedKeys is the most keys an Authenticator caches.
+const MaxCachedKeys = 10000
+
+// cachedKey is a key read at fetchedAt; key is nil if there was none with the ID.
+type cachedKey struct {
+	key       *domain.APIKey
+	fetchedAt time.Time
+}
+
+// Authenticator checks the secrets of requests against the stored keys. Keys are cached
+// for a TTL, so a revoked key may keep working on an instance until its cache entry
+// expires.
+type Authenticator struct {
+	repo  contract.APIKeyRepository
+	clock clock.Clock
+	ttl   time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedKey
+}
+
+// NewAuthenticator creates an Authenticator that reads keys from repo and caches them for
+// ttl.
+func NewAuthenticator(repo contract.APIKeyRepository, clk clock.Clock, ttl time.Duration) *Authenticator {
+	return &Authenticator{
+		repo:  repo,
+		clock: clk,
+		ttl:   ttl,
+		cache: make(map[string]cachedKey),
+	}
+}
+
+// Authenticate returns the key whose secret was given. It returns ErrInvalidKey if the
+// secret does not match a key that is valid now.
+func (a *Authenticator) Authenticate(ctx context.Context, secret string) (*domain.APIKey, error) {
+	id, ok := keyID(secret)
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+
+	now := a.clock.Now()
+	key, err := a.lookup(ctx, id, now)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil || !key.Matches(secret) || !key.ValidAt(now) {
+		return nil, ErrInvalidKey
+	}
+	return key, nil
+}
+
+// lookup returns the key with the given ID, or nil if there is none, from the cache if it
+// was read within the TTL.
+func (a *Authenticator) lookup(ctx context.Context, id string, now time.Time) (*domain.APIKey, error) {
+	a.mu.Lock()
+	cached, ok := a.cache[id]
+	a.mu.Unlock()
+	if ok && now.Sub(cached.fetchedAt) < a.ttl {
+		return cached.key, nil
+	}
+
+	key, err := a.repo.FindByID(ctx, id)
+	if errors.Is(err, domain.ErrAPIKeyNotFound) {
+		key, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.cache) >= MaxCachedKeys {
+		a.evict(now)
+	}
+	a.cache[id] = cachedKey{key: key, fetchedAt: now}
+	return key, nil
+}
+
+// evict drops the expired cache entries, or every entry if none has expired. The caller
+// holds mu.
+func (a *Authenticator) evict(now time.Time) {
+	for id, cached := range a.cache {
+		if now.Sub(cached.fetchedAt) >= a.ttl {
+			delete(a.cache, id)
+		}
+	}
+	if len(a.cache) >= MaxCachedKeys {
+		clear(a.cache)
+	}
+}
@@ -0,0 +1,196 @@
+// Package audit scans the stored catalog against the current domain rules
+// and produces a structured report, optionally fixing safe issues.
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/repository"
+	"google.golang.org/api/iterator"
+)
+
+// DefaultPageSize is the number of rows read per query.
+const DefaultPageSize = 500
+
+// Severity classifies an issue.
+type Severity string
+
+// Severity values.
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Issue is a single rule violation found on a product.
+type Issue struct {
+	ProductID string   `json:"product_id"`
+	Rule      string   `json:"rule"`
+	Severity  Severity `json:"severity"`
+	Message   string   `json:"message"`
+	Fixable   bool     `json:"fixable"`
+	Fixed     bool     `json:"fixed,omitempty"`
+	FixError  string   `json:"fix_error,omitempty"`
+}
+
+// Report is the structured result of a validation run.
+type Report struct {
+	StartedAt  time.Time      `json:"started_at"`
+	FinishedAt time.Time      `json:"finished_at"`
+	Scanned    int            `json:"scanned"`
+	ByRule     map[string]int `json:"by_rule"`
+	Issues     []Issue        `json:"issues"`
+}
+
+// HasErrors returns true if any issue has error severity.
+func (r *Report) HasErrors() bool {
+	for _, issue := range r.Issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Options controls a validation run.
+type Options struct {
+	// Fix applies the automatic fix of fixable rules.
+	Fix bool
+	// PageSize is the number of rows read per query.
+	PageSize int
+}
+
+// Validator runs rules over every stored product.
+type Validator struct {
+	client *spanner.Client
+	clock  clock.Clock
+	rules  []Rule
+}
+
+// NewValidator creates a new Validator.
+func NewValidator(client *spanner.Client, clock clock.Clock, rules ...Rule) *Validator {
+	return &Validator{
+		client: client,
+		clock:  clock,
+		rules:  rules,
+	}
+}
+
+// Run scans the catalog and returns the report.
+func (v *Validator) Run(ctx context.Context, opts Options) (*Report, error) {
+	if opts.PageSize <= 0 {
+		opts.PageSize = DefaultPageSize
+	}
+
+	now := v.clock.Now()
+	report := &Report{
+		StartedAt: now,
+		ByRule:    make(map[string]int),
+		Issues:    make([]Issue, 0),
+	}
+
+	after := ""
+	for {
+		rows, lastID, err := v.scanPage(ctx, after, opts.PageSize)
+		if err != nil {
+			return nil, fmt.Errorf("scan after %q: %w", after, err)
+		}
+
+		for _, data := range rows {
+			report.Scanned++
+			for _, issue := range v.CheckRow(data, now) {
+				if opts.Fix && issue.Fixable {
+					v.fix(ctx, &issue)
+				}
+				report.ByRule[issue.Rule]++
+				report.Issues = append(report.Issues, issue)
+			}
+		}
+
+		if len(rows) < opts.PageSize {
+			break
+		}
+		after = lastID
+	}
+
+	report.FinishedAt = v.clock.Now()
+	return report, nil
+}
+
+// CheckRow applies every rule to a single row.
+func (v *Validator) CheckRow(data *repository.ProductData, now time.Time) []Issue {
+	var issues []Issue
+	for _, rule := range v.rules {
+		msg := rule.Check(data, now)
+		if msg == "" {
+			continue
+		}
+		_, fixable := rule.(FixableRule)
+		issues = append(issues, Issue{
+			ProductID: data.ProductID,
+			Rule:      rule.Name(),
+			Severity:  rule.Severity(),
+			Message:   msg,
+			Fixable:   fixable,
+		})
+	}
+	return issues
+}
+
+// fix applies the rule's fix and records the outcome on the issue.
+func (v *Validator) fix(ctx context.Context, issue *Issue) {
+	for _, rule := range v.rules {
+		fixable, ok := rule.(FixableRule)
+		if !ok || rule.Name() != issue.Rule {
+			continue
+		}
+		if err := fixable.Fix(ctx, issue.ProductID); err != nil {
+			issue.FixError = err.Error()
+			return
+		}
+		issue.Fixed = true
+		return
+	}
+}
+
+// scanPage reads the next page of products in key order.
+func (v *Validator) scanPage(ctx context.Context, after string, limit int) ([]*repository.ProductData, string, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + strings.Join(repository.ProductAllColumns(), ", ") +
+			` FROM products WHERE product_id > @after ORDER BY product_id LIMIT @limit`,
+		Params: map[string]interface{}{
+			"after": after,
+			"limit": int64(limit),
+		},
+	}
+
+	iter := v.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var (
+		rows   []*repository.ProductData
+		lastID string
+	)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		data, err := repository.ProductDataFromRow(row)
+		if err != nil {
+			return nil, "", err
+		}
+		rows = append(rows, data)
+		lastID = data.ProductID
+	}
+
+	return rows, lastID, nil
+}
@@ -0,0 +1,281 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/repository"
+	"github.com/product-catalog-service/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRemover struct {
+	removed []string
+	err     error
+}
+
+func (f *fakeRemover) RemoveDiscount(_ context.Context, req usecase.RemoveDiscountRequest) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.removed = append(f.removed, req.ProductID)
+	return nil
+}
+
+type fakeRepairer struct {
+	repaired []string
+	cleared  []string
+}
+
+func (f *fakeRepairer) RepairDiscount(_ context.Context, productID string) error {
+	f.repaired = append(f.repaired, productID)
+	return nil
+}
+
+func (f *fakeRepairer) ClearArchivedDiscount(_ context.Context, productID string) error {
+	f.cleared = append(f.cleared, productID)
+	return nil
+}
+
+func validRow(now time.Time) *repository.ProductData {
+	return &repository.ProductData{
+		ProductID:            "product-123",
+		Name:                 "Widget",
+		Category:             "Electronics",
+		BasePriceNumerator:   1999,
+		BasePriceDenominator: 100,
+		Status:               "active",
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+}
+
+func withDiscount(data *repository.ProductData, pct *big.Rat, start, end time.Time) *repository.ProductData {
+	data.DiscountPercent = spanner.NullNumeric{Numeric: *pct, Valid: true}
+	data.DiscountStartDate = spanner.NullTime{Time: start, Valid: true}
+	data.DiscountEndDate = spanner.NullTime{Time: end, Valid: true}
+	return data
+}
+
+func TestRules(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		data     func() *repository.ProductData
+		expected []string
+	}{
+		{
+			name:     "valid row has no issues",
+			data:     func() *repository.ProductData { return validRow(now) },
+			expected: nil,
+		},
+		{
+			name: "unknown status",
+			data: func() *repository.ProductData {
+				d := validRow(now)
+				d.Status = "published"
+				return d
+			},
+			expected: []string{RuleInvalidStatus},
+		},
+		{
+			name: "zero price",
+			data: func() *repository.ProductData {
+				d := validRow(now)
+				d.BasePriceNumerator = 0
+				return d
+			},
+			expected: []string{RuleNonPositivePrice},
+		},
+		{
+			name: "zero denominator",
+			data: func() *repository.ProductData {
+				d := validRow(now)
+				d.BasePriceDenominator = 0
+				return d
+			},
+			expected: []string{RuleNonPositivePrice},
+		},
+		{
+			name: "active discount is valid",
+			data: func() *repository.ProductData {
+				return withDiscount(validRow(now), big.NewRat(20, 1), now.Add(-time.Hour), now.Add(time.Hour))
+			},
+			expected: nil,
+		},
+		{
+			name: "expired discount",
+			data: func() *repository.ProductData {
+				return withDiscount(validRow(now), big.NewRat(20, 1), now.Add(-48*time.Hour), now.Add(-time.Hour))
+			},
+			expected: []string{RuleExpiredDiscount},
+		},
+		{
+			name: "discount over 100 percent",
+			data: func() *repository.ProductData {
+				return withDiscount(validRow(now), big.NewRat(150, 1), now.Add(-time.Hour), now.Add(time.Hour))
+			},
+			expected: []string{RuleInvalidDiscount},
+		},
+		{
+			name: "discount period reversed",
+			data: func() *repository.ProductData {
+				return withDiscount(validRow(now), big.NewRat(10, 1), now.Add(2*time.Hour), now.Add(time.Hour))
+			},
+			expected: []string{RuleInvalidDiscount},
+		},
+		{
+			name: "percent without period",
+			data: func() *repository.ProductData {
+				d := validRow(now)
+				d.DiscountPercent = spanner.NullNumeric{Numeric: *big.NewRat(20, 1), Valid: true}
+				return d
+			},
+			expected: []string{RulePartialDiscount},
+		},
+		{
+			name: "expired end date without percent is only partial",
+			data: func() *repository.ProductData {
+				d := validRow(now)
+				d.DiscountStartDate = spanner.NullTime{Time: now.Add(-48 * time.Hour), Valid: true}
+				d.DiscountEndDate = spanner.NullTime{Time: now.Add(-time.Hour), Valid: true}
+				return d
+			},
+			expected: []string{RulePartialDiscount},
+		},
+		{
+			name: "archived with discount",
+			data: func() *repository.ProductData {
+				d := withDiscount(validRow(now), big.NewRat(20, 1), now.Add(-time.Hour), now.Add(time.Hour))
+				d.Status = "archived"
+				d.ArchivedAt = spanner.NullTime{Time: now, Valid: true}
+				return d
+			},
+			expected: []string{RuleArchivedDiscount},
+		},
+		{
+			name: "archived with expired discount is only archived_discount",
+			data: func() *repository.ProductData {
+				d := withDiscount(validRow(now), big.NewRat(20, 1), now.Add(-48*time.Hour), now.Add(-time.Hour))
+				d.Status = "archived"
+				d.ArchivedAt = spanner.NullTime{Time: now, Valid: true}
+				return d
+			},
+			expected: []string{RuleArchivedDiscount},
+		},
+		{
+			name: "archived with partial discount is only archived_discount",
+			data: func() *repository.ProductData {
+				d := validRow(now)
+				d.Status = "archived"
+				d.ArchivedAt = spanner.NullTime{Time: now, Valid: true}
+				d.DiscountPercent = spanner.NullNumeric{Numeric: *big.NewRat(20, 1), Valid: true}
+				return d
+			},
+			expected: []string{RuleArchivedDiscount},
+		},
+		{
+			name: "archived without timestamp",
+			data: func() *repository.ProductData {
+				d := validRow(now)
+				d.Status = "archived"
+				return d
+			},
+			expected: []string{RuleMissingArchivedAt},
+		},
+	}
+
+	v := NewValidator(nil, clock.NewFixedClock(now), DefaultRules(&fakeRemover{}, &fakeRepairer{})...)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := v.CheckRow(tt.data(), now)
+
+			var rules []string
+			for _, issue := range issues {
+				rules = append(rules, issue.Rule)
+				assert.Equal(t, "product-123", issue.ProductID)
+				assert.NotEmpty(t, issue.Message)
+			}
+			assert.Equal(t, tt.expected, rules)
+		})
+	}
+}
+
+func TestValidator_FixExpiredDiscount(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	remover := &fakeRemover{}
+	v := NewValidator(nil, clock.NewFixedClock(now), DefaultRules(remover, &fakeRepairer{})...)
+
+	data := withDiscount(validRow(now), big.NewRat(20, 1), now.Add(-48*time.Hour), now.Add(-time.Hour))
+	issues := v.CheckRow(data, now)
+	require.Len(t, issues, 1)
+	assert.True(t, issues[0].Fixable)
+
+	v.fix(context.Background(), &issues[0])
+	assert.True(t, issues[0].Fixed)
+	assert.Empty(t, issues[0].FixError)
+	assert.Equal(t, []string{"product-123"}, remover.removed)
+}
+
+func TestValidator_FixPartialDiscount(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repairer := &fakeRepairer{}
+	v := NewValidator(nil, clock.NewFixedClock(now), DefaultRules(&fakeRemover{}, repairer)...)
+
+	data := validRow(now)
+	data.DiscountEndDate = spanner.NullTime{Time: now.Add(time.Hour), Valid: true}
+	issues := v.CheckRow(data, now)
+	require.Len(t, issues, 1)
+	assert.Equal(t, RulePartialDiscount, issues[0].Rule)
+	assert.True(t, issues[0].Fixable)
+
+	v.fix(context.Background(), &issues[0])
+	assert.True(t, issues[0].Fixed)
+	assert.Equal(t, []string{"product-123"}, repairer.repaired)
+}
+
+func TestValidator_FixArchivedDiscount(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repairer := &fakeRepairer{}
+	v := NewValidator(nil, clock.NewFixedClock(now), DefaultRules(&fakeRemover{}, repairer)...)
+
+	data := withDiscount(validRow(now), big.NewRat(20, 1), now.Add(-time.Hour), now.Add(time.Hour))
+	data.Status = "archived"
+	data.ArchivedAt = spanner.NullTime{Time: now, Valid: true}
+	issues := v.CheckRow(data, now)
+	require.Len(t, issues, 1)
+	assert.Equal(t, RuleArchivedDiscount, issues[0].Rule)
+	assert.True(t, issues[0].Fixable)
+
+	v.fix(context.Background(), &issues[0])
+	assert.True(t, issues[0].Fixed)
+	assert.Equal(t, []string{"product-123"}, repairer.cleared)
+	assert.Empty(t, repairer.repaired)
+}
+
+func TestValidator_FixFailureIsRecorded(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	remover := &fakeRemover{err: errors.New("product is archived")}
+	v := NewValidator(nil, clock.NewFixedClock(now), DefaultRules(remover, &fakeRepairer{})...)
+
+	issue := Issue{ProductID: "product-123", Rule: RuleExpiredDiscount, Fixable: true}
+	v.fix(context.Background(), &issue)
+	assert.False(t, issue.Fixed)
+	assert.Equal(t, "product is archived", issue.FixError)
+}
+
+func TestReport_HasErrors(t *testing.T) {
+	report := &Report{Issues: []Issue{{Severity: SeverityWarning}}}
+	assert.False(t, report.HasErrors())
+
+	report.Issues = append(report.Issues, Issue{Severity: SeverityError})
+	assert.True(t, report.HasErrors())
+}
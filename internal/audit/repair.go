@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/repository"
+)
+
+// SpannerDiscountRepairer clears invalid discount columns directly in Spanner.
+type SpannerDiscountRepairer struct {
+	client     *spanner.Client
+	clock      clock.Clock
+	outboxRepo *repository.OutboxRepo
+}
+
+// NewSpannerDiscountRepairer creates a new SpannerDiscountRepairer.
+func NewSpannerDiscountRepairer(client *spanner.Client, clock clock.Clock) *SpannerDiscountRepairer {
+	return &SpannerDiscountRepairer{client: client, clock: clock, outboxRepo: repository.NewOutboxRepo(client)}
+}
+
+// RepairDiscount re-reads the row in a transaction and clears the discount columns only
+// if they are still partial, so a discount completed concurrently is left untouched.
+func (r *SpannerDiscountRepairer) RepairDiscount(ctx context.Context, productID string) error {
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, repository.ProductsTable, spanner.Key{productID}, repository.ProductAllColumns())
+		if err != nil {
+			return err
+		}
+		data, err := repository.ProductDataFromRow(row)
+		if err != nil {
+			return err
+		}
+		if data.DiscountConsistency() != repository.DiscountPartial {
+			return nil
+		}
+		return txn.BufferWrite([]*spanner.Mutation{repository.ClearDiscountMut(productID, r.clock.Now())})
+	})
+	return err
+}
+
+// ClearArchivedDiscount re-reads the row in a transaction and clears the discount columns
+// if the product is still archived. A product.discount_removed event is recorded when the
+// discount was complete; partial discounts were never visible, so clearing them is silent.
+func (r *SpannerDiscountRepairer) ClearArchivedDiscount(ctx context.Context, productID string) error {
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, repository.ProductsTable, spanner.Key{productID}, repository.ProductAllColumns())
+		if err != nil {
+			return err
+		}
+		data, err := repository.ProductDataFromRow(row)
+		if err != nil {
+			return err
+		}
+		consistency := data.DiscountConsistency()
+		if data.Status != string(domain.ProductStatusArchived) || consistency == repository.DiscountAbsent {
+			return nil
+		}
+
+		now := r.clock.Now()
+		muts := []*spanner.Mutation{repository.ClearDiscountMut(productID, now)}
+		if consistency == repository.DiscountComplete {
+			// A discount held in the legacy columns is identified by its product ID.
+			mut, err := r.outboxRepo.InsertTenantEventMut(data.TenantID.StringVal, domain.NewDiscountRemovedEvent(productID, productID, now))
+			if err != nil {
+				return err
+			}
+			muts = append(muts, mut)
+		}
+		return txn.BufferWrite(muts)
+	})
+	return err
+}
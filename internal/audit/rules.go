@@ -0,0 +1,250 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/repository"
+	"github.com/product-catalog-service/internal/usecase"
+)
+
+// Rule names.
+const (
+	RuleInvalidStatus     = "invalid_status"
+	RuleNonPositivePrice  = "non_positive_price"
+	RuleInvalidDiscount   = "invalid_discount"
+	RulePartialDiscount   = "partial_discount"
+	RuleExpiredDiscount   = "expired_discount"
+	RuleArchivedDiscount  = "archived_discount"
+	RuleMissingArchivedAt = "missing_archived_at"
+)
+
+// Rule checks a stored product row against a domain rule.
+// Rules work on the raw row because invalid data may not reconstruct into an aggregate.
+type Rule interface {
+	// Name identifies the rule in reports.
+	Name() string
+
+	// Severity classifies violations of the rule.
+	Severity() Severity
+
+	// Check returns a description of the violation, or an empty string if the row complies.
+	Check(data *repository.ProductData, now time.Time) string
+}
+
+// FixableRule is a Rule whose violations can be corrected automatically and safely.
+type FixableRule interface {
+	Rule
+
+	// Fix corrects the violation for the given product.
+	Fix(ctx context.Context, productID string) error
+}
+
+// DefaultRules returns the rules enforced by the current domain model.
+func DefaultRules(remover DiscountRemover, repairer DiscountRepairer) []Rule {
+	return []Rule{
+		InvalidStatusRule{},
+		NonPositivePriceRule{},
+		InvalidDiscountRule{},
+		NewPartialDiscountRule(repairer),
+		NewExpiredDiscountRule(remover),
+		NewArchivedDiscountRule(repairer),
+		MissingArchivedAtRule{},
+	}
+}
+
+// InvalidStatusRule flags rows whose status is not a known ProductStatus.
+type InvalidStatusRule struct{}
+
+// Name returns the rule name.
+func (InvalidStatusRule) Name() string { return RuleInvalidStatus }
+
+// Severity returns the rule severity.
+func (InvalidStatusRule) Severity() Severity { return SeverityError }
+
+// Check verifies the status value.
+func (InvalidStatusRule) Check(data *repository.ProductData, _ time.Time) string {
+	if domain.ProductStatus(data.Status).IsValid() {
+		return ""
+	}
+	return fmt.Sprintf("unknown status %q", data.Status)
+}
+
+// NonPositivePriceRule flags rows whose base price is zero, negative, or malformed.
+type NonPositivePriceRule struct{}
+
+// Name returns the rule name.
+func (NonPositivePriceRule) Name() string { return RuleNonPositivePrice }
+
+// Severity returns the rule severity.
+func (NonPositivePriceRule) Severity() Severity { return SeverityError }
+
+// Check verifies the base price.
+func (NonPositivePriceRule) Check(data *repository.ProductData, _ time.Time) string {
+	if data.BasePriceDenominator <= 0 {
+		return fmt.Sprintf("base price denominator is %d", data.BasePriceDenominator)
+	}
+	if data.BasePriceNumerator <= 0 {
+		return fmt.Sprintf("base price is %d/%d", data.BasePriceNumerator, data.BasePriceDenominator)
+	}
+	return ""
+}
+
+// InvalidDiscountRule flags stored discounts that the domain would reject.
+type InvalidDiscountRule struct{}
+
+// Name returns the rule name.
+func (InvalidDiscountRule) Name() string { return RuleInvalidDiscount }
+
+// Severity returns the rule severity.
+func (InvalidDiscountRule) Severity() Severity { return SeverityError }
+
+// Check verifies the discount percentage and period.
+func (InvalidDiscountRule) Check(data *repository.ProductData, _ time.Time) string {
+	if data.DiscountPercent.Valid {
+		pct := data.DiscountPercent.Numeric
+		if pct.Sign() <= 0 || pct.Cmp(big.NewRat(100, 1)) > 0 {
+			return fmt.Sprintf("discount percentage %s is outside (0, 100]", pct.FloatString(2))
+		}
+	}
+	if data.DiscountStartDate.Valid && data.DiscountEndDate.Valid &&
+		!data.DiscountEndDate.Time.After(data.DiscountStartDate.Time) {
+		return "discount end date is not after start date"
+	}
+	return ""
+}
+
+// DiscountRepairer clears discount columns that violate an invariant directly in storage.
+// The use cases cannot be used because they reject such products (e.g. archived ones).
+type DiscountRepairer interface {
+	// RepairDiscount clears the discount columns of a row whose discount is only partially stored.
+	RepairDiscount(ctx context.Context, productID string) error
+
+	// ClearArchivedDiscount clears the discount columns of an archived product, recording a
+	// product.discount_removed event if a complete discount was stored.
+	ClearArchivedDiscount(ctx context.Context, productID string) error
+}
+
+// PartialDiscountRule flags rows where only some discount columns are set.
+// Readers already ignore such discounts, so clearing the remaining columns does not
+// change any price and the rule is fixable.
+type PartialDiscountRule struct {
+	repairer DiscountRepairer
+}
+
+// NewPartialDiscountRule creates a new PartialDiscountRule.
+func NewPartialDiscountRule(repairer DiscountRepairer) *PartialDiscountRule {
+	return &PartialDiscountRule{repairer: repairer}
+}
+
+// Name returns the rule name.
+func (*PartialDiscountRule) Name() string { return RulePartialDiscount }
+
+// Severity returns the rule severity.
+func (*PartialDiscountRule) Severity() Severity { return SeverityWarning }
+
+// Check verifies that the discount columns are either all set or all NULL.
+// Archived rows are left to ArchivedDiscountRule.
+func (*PartialDiscountRule) Check(data *repository.ProductData, _ time.Time) string {
+	if data.DiscountConsistency() != repository.DiscountPartial || isArchived(data) {
+		return ""
+	}
+	return fmt.Sprintf("partial discount columns (percent=%t start=%t end=%t)",
+		data.DiscountPercent.Valid, data.DiscountStartDate.Valid, data.DiscountEndDate.Valid)
+}
+
+// Fix clears the discount columns.
+func (r *PartialDiscountRule) Fix(ctx context.Context, productID string) error {
+	return r.repairer.RepairDiscount(ctx, productID)
+}
+
+// DiscountRemover removes a discount through the normal use case, emitting the usual events.
+type DiscountRemover interface {
+	RemoveDiscount(ctx context.Context, req usecase.RemoveDiscountRequest) error
+}
+
+// ExpiredDiscountRule flags discounts whose period has ended but are still stored.
+// Removing them does not change any price, so the rule is fixable.
+type ExpiredDiscountRule struct {
+	remover DiscountRemover
+}
+
+// NewExpiredDiscountRule creates a new ExpiredDiscountRule.
+func NewExpiredDiscountRule(remover DiscountRemover) *ExpiredDiscountRule {
+	return &ExpiredDiscountRule{remover: remover}
+}
+
+// Name returns the rule name.
+func (*ExpiredDiscountRule) Name() string { return RuleExpiredDiscount }
+
+// Severity returns the rule severity.
+func (*ExpiredDiscountRule) Severity() Severity { return SeverityWarning }
+
+// Check verifies that any stored discount has not expired.
+// Partial discounts are left to PartialDiscountRule and archived rows to ArchivedDiscountRule.
+func (*ExpiredDiscountRule) Check(data *repository.ProductData, now time.Time) string {
+	if data.DiscountConsistency() != repository.DiscountComplete || isArchived(data) || now.Before(data.DiscountEndDate.Time) {
+		return ""
+	}
+	return fmt.Sprintf("discount expired at %s", data.DiscountEndDate.Time.Format(time.RFC3339))
+}
+
+// Fix removes the expired discount.
+func (r *ExpiredDiscountRule) Fix(ctx context.Context, productID string) error {
+	return r.remover.RemoveDiscount(ctx, usecase.RemoveDiscountRequest{ProductID: productID})
+}
+
+// ArchivedDiscountRule flags archived rows that still carry discount columns, written
+// before archiving started to remove the discount. Readers already ignore them, so
+// clearing the columns does not change any price and the rule is fixable.
+type ArchivedDiscountRule struct {
+	repairer DiscountRepairer
+}
+
+// NewArchivedDiscountRule creates a new ArchivedDiscountRule.
+func NewArchivedDiscountRule(repairer DiscountRepairer) *ArchivedDiscountRule {
+	return &ArchivedDiscountRule{repairer: repairer}
+}
+
+// Name returns the rule name.
+func (*ArchivedDiscountRule) Name() string { return RuleArchivedDiscount }
+
+// Severity returns the rule severity.
+func (*ArchivedDiscountRule) Severity() Severity { return SeverityWarning }
+
+// Check verifies that archived rows have no discount columns set.
+func (*ArchivedDiscountRule) Check(data *repository.ProductData, _ time.Time) string {
+	if !isArchived(data) || data.DiscountConsistency() == repository.DiscountAbsent {
+		return ""
+	}
+	return fmt.Sprintf("archived product retains a %s discount", data.DiscountConsistency())
+}
+
+// Fix clears the discount columns.
+func (r *ArchivedDiscountRule) Fix(ctx context.Context, productID string) error {
+	return r.repairer.ClearArchivedDiscount(ctx, productID)
+}
+
+func isArchived(data *repository.ProductData) bool {
+	return data.Status == string(domain.ProductStatusArchived)
+}
+
+// MissingArchivedAtRule flags archived rows without an archival timestamp.
+type MissingArchivedAtRule struct{}
+
+// Name returns the rule name.
+func (MissingArchivedAtRule) Name() string { return RuleMissingArchivedAt }
+
+// Severity returns the rule severity.
+func (MissingArchivedAtRule) Severity() Severity { return SeverityWarning }
+
+// Check verifies archived_at is set for archived rows.
+func (MissingArchivedAtRule) Check(data *repository.ProductData, _ time.Time) string {
+	if isArchived(data) && !data.ArchivedAt.Valid {
+		return "archived product has no archived_at timestamp"
+	}
+	return ""
+}
@@ -0,0 +1,152 @@
+// Package availability detects that Spanner is unavailable and degrades the service instead
+// of letting every request hang until its deadline.
+//
+// A Monitor probes Spanner in the background. While it reports Spanner as unavailable,
+// ReadModel serves product reads from the last results it saw, flagged with the time they
+// were cached, and fails every other read fast with an UnavailableError; write RPCs are
+// rejected by the gRPC interceptor of the handler package.
+package availability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// Default monitor settings.
+const (
+	DefaultCheckInterval    = 5 * time.Second
+	DefaultCheckTimeout     = 2 * time.Second
+	DefaultFailureThreshold = 2
+)
+
+// ErrUnavailable is matched by every UnavailableError.
+var ErrUnavailable = errors.New("catalog database is unavailable")
+
+// UnavailableError is returned instead of calling Spanner while it is unavailable.
+type UnavailableError struct {
+	// RetryAfter is when Spanner is probed again; retrying earlier fails the same way.
+	RetryAfter time.Duration
+}
+
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("%v, retry after %s", ErrUnavailable, e.RetryAfter)
+}
+
+// Is makes errors.Is(err, ErrUnavailable) match.
+func (e *UnavailableError) Is(target error) bool {
+	return target == ErrUnavailable
+}
+
+// Probe checks that the database answers.
+type Probe func(ctx context.Context) error
+
+// SpannerProbe runs a trivial query against the database of client.
+func SpannerProbe(client *spanner.Client) Probe {
+	return func(ctx context.Context) error {
+		iter := client.Single().Query(ctx, spanner.Statement{SQL: "SELECT 1"})
+		defer iter.Stop()
+		_, err := iter.Next()
+		return err
+	}
+}
+
+// MonitorOptions controls how a Monitor probes the database.
+type MonitorOptions struct {
+	// CheckInterval is the delay between probes.
+	CheckInterval time.Duration
+	// CheckTimeout bounds a single probe; a probe that times out has failed.
+	CheckTimeout time.Duration
+	// FailureThreshold is the number of consecutive failed probes after which the database
+	// is considered unavailable. A single successful probe makes it available again.
+	FailureThreshold int
+}
+
+// Monitor tracks whether the database is available by probing it periodically. The
+// database is considered available until probes fail.
+type Monitor struct {
+	probe Probe
+	opts  MonitorOptions
+
+	mu       sync.RWMutex
+	failures int
+}
+
+// NewMonitor creates a Monitor that probes with probe.
+func NewMonitor(probe Probe, opts MonitorOptions) *Monitor {
+	if opts.CheckInterval <= 0 {
+		opts.CheckInterval = DefaultCheckInterval
+	}
+	if opts.CheckTimeout <= 0 {
+		opts.CheckTimeout = DefaultCheckTimeout
+	}
+	if opts.FailureThreshold <= 0 {
+		opts.FailureThreshold = DefaultFailureThreshold
+	}
+	return &Monitor{probe: probe, opts: opts}
+}
+
+// Run probes the database until the context is cancelled.
+func (m *Monitor) Run(ctx context.Context) error {
+	for {
+		m.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(m.opts.CheckInterval):
+		}
+	}
+}
+
+// Check probes the database once and updates the availability.
+func (m *Monitor) Check(ctx context.Context) {
+	probeCtx, cancel := context.WithTimeout(ctx, m.opts.CheckTimeout)
+	err := m.probe(probeCtx)
+	cancel()
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	wasAvailable := m.available()
+	if err == nil {
+		m.failures = 0
+	} else {
+		m.failures++
+	}
+
+	switch available := m.available(); {
+	case wasAvailable && !available:
+		logging.Errorf("availability: database unavailable after %d failed checks, degrading: %v", m.failures, err)
+	case !wasAvailable && available:
+		logging.Infof("availability: database available again")
+	case err != nil:
+		logging.Warnf("availability: database check failed: %v", err)
+	}
+}
+
+// Available reports whether the database is considered available.
+func (m *Monitor) Available() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.available()
+}
+
+func (m *Monitor) available() bool {
+	return m.failures < m.opts.FailureThreshold
+}
+
+// Err returns an UnavailableError if the database is unavailable and nil otherwise.
+func (m *Monitor) Err() error {
+	if m.Available() {
+		return nil
+	}
+	return &UnavailableError{RetryAfter: m.opts.CheckInterval}
+}
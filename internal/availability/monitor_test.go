@@ -0,0 +1,68 @@
+package availability
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProbe fails while err is set.
+type fakeProbe struct {
+	err error
+}
+
+func (p *fakeProbe) probe(context.Context) error {
+	return p.err
+}
+
+func TestMonitor_Check(t *testing.T) {
+	probe := &fakeProbe{}
+	m := NewMonitor(probe.probe, MonitorOptions{CheckInterval: 3 * time.Second, FailureThreshold: 2})
+
+	m.Check(context.Background())
+	assert.True(t, m.Available())
+	assert.NoError(t, m.Err())
+
+	// A single failure is tolerated
+	probe.err = errors.New("connection refused")
+	m.Check(context.Background())
+	assert.True(t, m.Available())
+
+	m.Check(context.Background())
+	assert.False(t, m.Available())
+	err := m.Err()
+	require.ErrorIs(t, err, ErrUnavailable)
+	var unavailable *UnavailableError
+	require.ErrorAs(t, err, &unavailable)
+	assert.Equal(t, 3*time.Second, unavailable.RetryAfter)
+
+	// One successful check recovers
+	probe.err = nil
+	m.Check(context.Background())
+	assert.True(t, m.Available())
+}
+
+func TestMonitor_Check_Timeout(t *testing.T) {
+	hanging := func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	m := NewMonitor(hanging, MonitorOptions{CheckTimeout: time.Millisecond, FailureThreshold: 1})
+
+	m.Check(context.Background())
+	assert.False(t, m.Available())
+}
+
+func TestMonitor_Check_Cancelled(t *testing.T) {
+	m := NewMonitor(func(ctx context.Context) error { return ctx.Err() }, MonitorOptions{FailureThreshold: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Shutting down is not a database failure
+	m.Check(ctx)
+	assert.True(t, m.Available())
+}
@@ -0,0 +1,306 @@
+package availability
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+)
+
+// DefaultCacheSize is the default number of products and product lists kept for degraded reads.
+const DefaultCacheSize = 10000
+
+// currentTolerance is how far from now a read may be priced and still count as a read of
+// the current prices.
+const currentTolerance = 5 * time.Minute
+
+// ReadModel decorates a product read model for degradation. While the database is
+// available it reads through and remembers the latest result of each GetProduct and
+// ListProducts call priced at the current time, and of each FindProductIDBySlug and
+// FindProductIDBySKU call. While it is unavailable GetProduct and ListProducts return the
+// remembered results with CachedAt set, the FindProductIDBy methods return the remembered
+// ID, and every read without
+// one, including reads priced at another time, fails fast with an UnavailableError.
+// Products and product lists are remembered per tenant scope, as the read model only
+// returns the products of the caller's tenant; see package caller.
+type ReadModel struct {
+	next    contract.ProductReadModel
+	monitor *Monitor
+	clock   clock.Clock
+	cache   *lruCache
+}
+
+var _ contract.ProductReadModel = (*ReadModel)(nil)
+
+// NewReadModel creates a ReadModel that remembers up to cacheSize results.
+func NewReadModel(next contract.ProductReadModel, monitor *Monitor, clock clock.Clock, cacheSize int) *ReadModel {
+	if cacheSize <= 0 {
+		cacheSize = DefaultCacheSize
+	}
+	return &ReadModel{
+		next:    next,
+		monitor: monitor,
+		clock:   clock,
+		cache:   newLRUCache(cacheSize),
+	}
+}
+
+// GetProduct implements contract.ProductReadModel.
+func (rm *ReadModel) GetProduct(ctx context.Context, id string, at time.Time) (*contract.ProductDTO, error) {
+	if !rm.current(at) {
+		if err := rm.monitor.Err(); err != nil {
+			return nil, err
+		}
+		return rm.next.GetProduct(ctx, id, at)
+	}
+
+	key := tenantKey(ctx) + "product:" + id
+	if err := rm.monitor.Err(); err != nil {
+		entry, ok := rm.cache.get(key)
+		if !ok {
+			return nil, err
+		}
+		dto := *entry.value.(*contract.ProductDTO)
+		dto.CachedAt = &entry.cachedAt
+		return &dto, nil
+	}
+
+	dto, err := rm.next.GetProduct(ctx, id, at)
+	if err != nil {
+		return nil, err
+	}
+	rm.cache.put(key, dto, rm.clock.Now())
+	return dto, nil
+}
+
+// ListProducts implements contract.ProductReadModel.
+func (rm *ReadModel) ListProducts(ctx context.Context, filter contract.ListProductsFilter, pagination contract.Pagination, at time.Time) (*contract.ListProductsResult, error) {
+	if !rm.current(at) {
+		if err := rm.monitor.Err(); err != nil {
+			return nil, err
+		}
+		return rm.next.ListProducts(ctx, filter, pagination, at)
+	}
+
+	key := tenantKey(ctx) + fmt.Sprintf("list:%q:%q:%t:%t:%t:%t:%q:%q:%q:%q:%d:%q:%q:%t:%d", filter.Category, filter.Status, filter.ActiveOnly, filter.IncludeArchived, filter.InStockOnly, filter.HasActiveDiscount, filter.Tag, filter.Channel, filter.MinPrice, filter.MaxPrice, pagination.PageSize, pagination.PageToken, pagination.OrderBy, pagination.IncludeTotalCount, pagination.PageNumber)
+	if err := rm.monitor.Err(); err != nil {
+		entry, ok := rm.cache.get(key)
+		if !ok {
+			return nil, err
+		}
+		result := *entry.value.(*contract.ListProductsResult)
+		result.CachedAt = &entry.cachedAt
+		return &result, nil
+	}
+
+	result, err := rm.next.ListProducts(ctx, filter, pagination, at)
+	if err != nil {
+		return nil, err
+	}
+	rm.cache.put(key, result, rm.clock.Now())
+	return result, nil
+}
+
+// tenantKey returns the prefix of the cache keys of the reads scoped to the tenant of
+// ctx. Product IDs found by slug or SKU are not scoped.
+func tenantKey(ctx context.Context) string {
+	tenant, scoped := caller.Tenant(ctx)
+	return fmt.Sprintf("tenant:%q:%t:", tenant, scoped)
+}
+
+// current reports whether a read priced at at reads the current prices, whose results are
+// remembered for degraded reads.
+func (rm *ReadModel) current(at time.Time) bool {
+	d := at.Sub(rm.clock.Now())
+	return d >= -currentTolerance && d <= currentTolerance
+}
+
+// FindProductIDBySlug implements contract.ProductReadModel.
+func (rm *ReadModel) FindProductIDBySlug(ctx context.Context, slug string) (string, error) {
+	return rm.findProductID("slug:"+slug, func() (string, error) {
+		return rm.next.FindProductIDBySlug(ctx, slug)
+	})
+}
+
+// FindProductIDBySKU implements contract.ProductReadModel.
+func (rm *ReadModel) FindProductIDBySKU(ctx context.Context, sku string) (string, error) {
+	return rm.findProductID("sku:"+sku, func() (string, error) {
+		return rm.next.FindProductIDBySKU(ctx, sku)
+	})
+}
+
+// findProductID reads a product ID with find and remembers it under key, or returns the
+// ID remembered under key while the database is unavailable.
+func (rm *ReadModel) findProductID(key string, find func() (string, error)) (string, error) {
+	if err := rm.monitor.Err(); err != nil {
+		entry, ok := rm.cache.get(key)
+		if !ok {
+			return "", err
+		}
+		return entry.value.(string), nil
+	}
+
+	id, err := find()
+	if err != nil {
+		return "", err
+	}
+	rm.cache.put(key, id, rm.clock.Now())
+	return id, nil
+}
+
+// SearchProducts implements contract.ProductReadModel. Search results are never served
+// from the cache.
+func (rm *ReadModel) SearchProducts(ctx context.Context, filter contract.SearchProductsFilter, pagination contract.Pagination, at time.Time) (*contract.ListProductsResult, error) {
+	if err := rm.monitor.Err(); err != nil {
+		return nil, err
+	}
+	return rm.next.SearchProducts(ctx, filter, pagination, at)
+}
+
+// AllProducts implements contract.ProductReadModel. Products are not served from the
+// cache: while the database is unavailable the iteration yields only the error.
+func (rm *ReadModel) AllProducts(ctx context.Context, filter contract.ListProductsFilter, at time.Time) iter.Seq2[*contract.ProductDTO, error] {
+	if err := rm.monitor.Err(); err != nil {
+		return func(yield func(*contract.ProductDTO, error) bool) {
+			yield(nil, err)
+		}
+	}
+	return rm.next.AllProducts(ctx, filter, at)
+}
+
+// BatchGetProducts implements contract.ProductReadModel. Batches are not cached.
+func (rm *ReadModel) BatchGetProducts(ctx context.Context, ids []string, at time.Time) ([]*contract.ProductDTO, error) {
+	if err := rm.monitor.Err(); err != nil {
+		return nil, err
+	}
+	return rm.next.BatchGetProducts(ctx, ids, at)
+}
+
+// ListProductsByID implements contract.ProductReadModel.
+func (rm *ReadModel) ListProductsByID(ctx context.Context, ids []string, at time.Time) ([]*contract.ProductDTO, error) {
+	if err := rm.monitor.Err(); err != nil {
+		return nil, err
+	}
+	return rm.next.ListProductsByID(ctx, ids, at)
+}
+
+// ListByCategory implements contract.ProductReadModel.
+func (rm *ReadModel) ListByCategory(ctx context.Context, category string, pagination contract.Pagination, at time.Time) (*contract.ListProductsResult, error) {
+	if err := rm.monitor.Err(); err != nil {
+		return nil, err
+	}
+	return rm.next.ListByCategory(ctx, category, pagination, at)
+}
+
+// CountByCategory implements contract.ProductReadModel.
+func (rm *ReadModel) CountByCategory(ctx context.Context, category string) (int64, error) {
+	if err := rm.monitor.Err(); err != nil {
+		return 0, err
+	}
+	return rm.next.CountByCategory(ctx, category)
+}
+
+// GetEffectivePrices implements contract.ProductReadModel. Prices are never served from
+// the cache: checkout must not charge a price that may have changed.
+func (rm *ReadModel) GetEffectivePrices(ctx context.Context, ids []string, at time.Time) ([]*contract.PriceDTO, error) {
+	if err := rm.monitor.Err(); err != nil {
+		return nil, err
+	}
+	return rm.next.GetEffectivePrices(ctx, ids, at)
+}
+
+// GetPriceForQuantity implements contract.ProductReadModel.
+func (rm *ReadModel) GetPriceForQuantity(ctx context.Context, id string, quantity int64, at time.Time) (*contract.QuantityPriceDTO, error) {
+	if err := rm.monitor.Err(); err != nil {
+		return nil, err
+	}
+	return rm.next.GetPriceForQuantity(ctx, id, quantity, at)
+}
+
+// GetPriceHistory implements contract.ProductReadModel.
+func (rm *ReadModel) GetPriceHistory(ctx context.Context, id string, from, to time.Time) ([]*contract.PriceHistoryEntryDTO, error) {
+	if err := rm.monitor.Err(); err != nil {
+		return nil, err
+	}
+	return rm.next.GetPriceHistory(ctx, id, from, to)
+}
+
+// GetProductRevisions implements contract.ProductReadModel.
+func (rm *ReadModel) GetProductRevisions(ctx context.Context, id string, from, to time.Time) ([]*contract.ProductRevisionDTO, error) {
+	if err := rm.monitor.Err(); err != nil {
+		return nil, err
+	}
+	return rm.next.GetProductRevisions(ctx, id, from, to)
+}
+
+// GetProductRevision implements contract.ProductReadModel.
+func (rm *ReadModel) GetProductRevision(ctx context.Context, id, revisionID string) (*contract.ProductRevisionDTO, error) {
+	if err := rm.monitor.Err(); err != nil {
+		return nil, err
+	}
+	return rm.next.GetProductRevision(ctx, id, revisionID)
+}
+
+// GetRelatedProducts implements contract.ProductReadModel.
+func (rm *ReadModel) GetRelatedProducts(ctx context.Context, id string, relationType string, at time.Time) ([]*contract.RelatedProductDTO, error) {
+	if err := rm.monitor.Err(); err != nil {
+		return nil, err
+	}
+	return rm.next.GetRelatedProducts(ctx, id, relationType, at)
+}
+
+// cacheEntry is a remembered read result.
+type cacheEntry struct {
+	key      string
+	value    any
+	cachedAt time.Time
+}
+
+// lruCache keeps the most recently stored entries up to a fixed size.
+type lruCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func newLRUCache(size int) *lruCache {
+	return &lruCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (c *lruCache) get(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	c.order.MoveToFront(elem)
+	return *elem.Value.(*cacheEntry), true
+}
+
+func (c *lruCache) put(key string, value any, cachedAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = &cacheEntry{key: key, value: value, cachedAt: cachedAt}
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, value: value, cachedAt: cachedAt})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
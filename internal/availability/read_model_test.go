@@ -0,0 +1,248 @@
+package availability
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReadModel serves fixed products and counts the calls that reach it.
+type fakeReadModel struct {
+	contract.ProductReadModel
+	products map[string]*contract.ProductDTO
+	calls    int
+}
+
+func (rm *fakeReadModel) GetProduct(_ context.Context, id string, _ time.Time) (*contract.ProductDTO, error) {
+	rm.calls++
+	if p, ok := rm.products[id]; ok {
+		return p, nil
+	}
+	return nil, domain.ErrProductNotFound
+}
+
+func (rm *fakeReadModel) FindProductIDBySlug(_ context.Context, slug string) (string, error) {
+	rm.calls++
+	for _, p := range rm.products {
+		if p.Slug == slug {
+			return p.ID, nil
+		}
+	}
+	return "", domain.ErrProductNotFound
+}
+
+func (rm *fakeReadModel) FindProductIDBySKU(_ context.Context, sku string) (string, error) {
+	rm.calls++
+	for _, p := range rm.products {
+		if p.SKU == sku {
+			return p.ID, nil
+		}
+	}
+	return "", domain.ErrProductNotFound
+}
+
+func (rm *fakeReadModel) ListProducts(_ context.Context, filter contract.ListProductsFilter, _ contract.Pagination, _ time.Time) (*contract.ListProductsResult, error) {
+	rm.calls++
+	result := &contract.ListProductsResult{}
+	for _, p := range rm.products {
+		if filter.Category == "" || p.Category == filter.Category {
+			result.Products = append(result.Products, p)
+		}
+	}
+	result.TotalCount = int64(len(result.Products))
+	return result, nil
+}
+
+func (rm *fakeReadModel) GetEffectivePrices(_ context.Context, _ []string, _ time.Time) ([]*contract.PriceDTO, error) {
+	rm.calls++
+	return nil, nil
+}
+
+func newDegradableReadModel(t *testing.T, cacheSize int) (*ReadModel, *fakeReadModel, *fakeProbe, *Monitor, time.Time) {
+	t.Helper()
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	next := &fakeReadModel{products: map[string]*contract.ProductDTO{
+		"product-1": {ID: "product-1", Name: "Widget", Slug: "widget", SKU: "WDG-1", Category: "Tools"},
+		"product-2": {ID: "product-2", Name: "Gadget", Slug: "gadget", Category: "Toys"},
+	}}
+	probe := &fakeProbe{}
+	monitor := NewMonitor(probe.probe, MonitorOptions{FailureThreshold: 1})
+	return NewReadModel(next, monitor, clock.NewFixedClock(now), cacheSize), next, probe, monitor, now
+}
+
+func TestReadModel_GetProduct(t *testing.T) {
+	ctx := context.Background()
+	rm, next, probe, monitor, now := newDegradableReadModel(t, 10)
+
+	dto, err := rm.GetProduct(ctx, "product-1", now)
+	require.NoError(t, err)
+	assert.Nil(t, dto.CachedAt)
+
+	probe.err = errors.New("deadline exceeded")
+	monitor.Check(ctx)
+	calls := next.calls
+
+	// Cached products are served stale without calling the database
+	dto, err = rm.GetProduct(ctx, "product-1", now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, "Widget", dto.Name)
+	require.NotNil(t, dto.CachedAt)
+	assert.Equal(t, now, *dto.CachedAt)
+	assert.Equal(t, calls, next.calls)
+
+	// Products never read fail fast
+	_, err = rm.GetProduct(ctx, "product-2", now)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.Equal(t, calls, next.calls)
+
+	// Products read for one tenant are not served to another
+	_, err = rm.GetProduct(caller.NewContext(ctx, caller.Identity{Tenant: "acme"}), "product-1", now)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.Equal(t, calls, next.calls)
+
+	// The cached product itself is not marked
+	probe.err = nil
+	monitor.Check(ctx)
+	dto, err = rm.GetProduct(ctx, "product-1", now)
+	require.NoError(t, err)
+	assert.Nil(t, dto.CachedAt)
+}
+
+func TestReadModel_FindProductIDBySlug(t *testing.T) {
+	ctx := context.Background()
+	rm, next, probe, monitor, _ := newDegradableReadModel(t, 10)
+
+	id, err := rm.FindProductIDBySlug(ctx, "widget")
+	require.NoError(t, err)
+	assert.Equal(t, "product-1", id)
+
+	probe.err = errors.New("unavailable")
+	monitor.Check(ctx)
+	calls := next.calls
+
+	id, err = rm.FindProductIDBySlug(ctx, "widget")
+	require.NoError(t, err)
+	assert.Equal(t, "product-1", id)
+	assert.Equal(t, calls, next.calls)
+
+	_, err = rm.FindProductIDBySlug(ctx, "gadget")
+	assert.ErrorIs(t, err, ErrUnavailable)
+}
+
+func TestReadModel_FindProductIDBySKU(t *testing.T) {
+	ctx := context.Background()
+	rm, next, probe, monitor, _ := newDegradableReadModel(t, 10)
+
+	id, err := rm.FindProductIDBySKU(ctx, "WDG-1")
+	require.NoError(t, err)
+	assert.Equal(t, "product-1", id)
+
+	probe.err = errors.New("unavailable")
+	monitor.Check(ctx)
+	calls := next.calls
+
+	id, err = rm.FindProductIDBySKU(ctx, "WDG-1")
+	require.NoError(t, err)
+	assert.Equal(t, "product-1", id)
+	assert.Equal(t, calls, next.calls)
+
+	// A slug lookup of the same value is remembered apart.
+	_, err = rm.FindProductIDBySlug(ctx, "WDG-1")
+	assert.ErrorIs(t, err, ErrUnavailable)
+}
+
+func TestReadModel_ListProducts(t *testing.T) {
+	ctx := context.Background()
+	rm, _, probe, monitor, now := newDegradableReadModel(t, 10)
+	tools := contract.ListProductsFilter{Category: "Tools"}
+	page := contract.Pagination{PageSize: 20}
+
+	_, err := rm.ListProducts(ctx, tools, page, now)
+	require.NoError(t, err)
+
+	probe.err = errors.New("unavailable")
+	monitor.Check(ctx)
+
+	result, err := rm.ListProducts(ctx, tools, page, now)
+	require.NoError(t, err)
+	require.Len(t, result.Products, 1)
+	require.NotNil(t, result.CachedAt)
+
+	// Other filters and pages are separate entries
+	_, err = rm.ListProducts(ctx, contract.ListProductsFilter{Category: "Toys"}, page, now)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	_, err = rm.ListProducts(ctx, contract.ListProductsFilter{Category: "Tools", Channel: "web"}, page, now)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	_, err = rm.ListProducts(ctx, contract.ListProductsFilter{Category: "Tools", IncludeArchived: true}, page, now)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	_, err = rm.ListProducts(ctx, tools, contract.Pagination{PageSize: 20, PageToken: "next"}, now)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	_, err = rm.ListProducts(ctx, tools, contract.Pagination{PageSize: 20, IncludeTotalCount: true}, now)
+	assert.ErrorIs(t, err, ErrUnavailable)
+}
+
+func TestReadModel_PreviewsAreNotCached(t *testing.T) {
+	ctx := context.Background()
+	rm, next, probe, monitor, now := newDegradableReadModel(t, 10)
+	nextWeek := now.Add(7 * 24 * time.Hour)
+
+	_, err := rm.GetProduct(ctx, "product-1", nextWeek)
+	require.NoError(t, err)
+	_, err = rm.ListProducts(ctx, contract.ListProductsFilter{}, contract.Pagination{PageSize: 20}, nextWeek)
+	require.NoError(t, err)
+
+	probe.err = errors.New("unavailable")
+	monitor.Check(ctx)
+	calls := next.calls
+
+	// Neither the previews nor the current prices were remembered
+	_, err = rm.GetProduct(ctx, "product-1", now)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	_, err = rm.ListProducts(ctx, contract.ListProductsFilter{}, contract.Pagination{PageSize: 20}, now)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	_, err = rm.GetProduct(ctx, "product-1", nextWeek)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.Equal(t, calls, next.calls)
+}
+
+func TestReadModel_PricesFailFast(t *testing.T) {
+	ctx := context.Background()
+	rm, next, probe, monitor, now := newDegradableReadModel(t, 10)
+
+	_, err := rm.GetEffectivePrices(ctx, []string{"product-1"}, now)
+	require.NoError(t, err)
+
+	probe.err = errors.New("unavailable")
+	monitor.Check(ctx)
+	calls := next.calls
+
+	_, err = rm.GetEffectivePrices(ctx, []string{"product-1"}, now)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.Equal(t, calls, next.calls)
+}
+
+func TestReadModel_CacheEviction(t *testing.T) {
+	ctx := context.Background()
+	rm, _, probe, monitor, now := newDegradableReadModel(t, 1)
+
+	_, err := rm.GetProduct(ctx, "product-1", now)
+	require.NoError(t, err)
+	_, err = rm.GetProduct(ctx, "product-2", now)
+	require.NoError(t, err)
+
+	probe.err = errors.New("unavailable")
+	monitor.Check(ctx)
+
+	_, err = rm.GetProduct(ctx, "product-1", now)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	_, err = rm.GetProduct(ctx, "product-2", now)
+	assert.NoError(t, err)
+}
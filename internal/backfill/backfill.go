@@ -0,0 +1,286 @@
+// Package backfill populates newly added product columns for existing rows.
+// Rows are scanned in key-range partitions and written back in small, low-priority commits.
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/logging"
+	"github.com/product-catalog-service/internal/repository"
+	"google.golang.org/api/iterator"
+)
+
+// Default option values.
+const (
+	DefaultPartitions = 4
+	DefaultChunkSize  = 200
+	MaxPartitions     = 16
+	MaxChunkSize      = 1000
+)
+
+// ErrUnknownFiller is returned when a filler name is not registered.
+var ErrUnknownFiller = errors.New("unknown filler")
+
+// Filler computes the values of one or more columns for an existing product row.
+type Filler interface {
+	// Name identifies the filler on the command line.
+	Name() string
+
+	// Columns lists the product columns the filler needs to read.
+	// The product_id column is always read and does not need to be listed.
+	Columns() []string
+
+	// Fill returns the column updates for the row, or nil if the row is already populated.
+	Fill(row *spanner.Row) (map[string]interface{}, error)
+}
+
+// Registry holds the fillers available to the backfill command.
+type Registry struct {
+	fillers map[string]Filler
+}
+
+// NewRegistry creates a Registry with the given fillers.
+func NewRegistry(fillers ...Filler) *Registry {
+	r := &Registry{fillers: make(map[string]Filler)}
+	for _, f := range fillers {
+		r.Register(f)
+	}
+	return r
+}
+
+// Register adds a filler, replacing any filler with the same name.
+func (r *Registry) Register(f Filler) {
+	r.fillers[f.Name()] = f
+}
+
+// Get returns the filler registered under name.
+func (r *Registry) Get(name string) (Filler, error) {
+	f, ok := r.fillers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFiller, name)
+	}
+	return f, nil
+}
+
+// Names returns the registered filler names in sorted order.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.fillers))
+	for name := range r.fillers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Options controls how a backfill is executed.
+type Options struct {
+	// Partitions is the number of key ranges scanned concurrently.
+	Partitions int
+	// ChunkSize is the number of rows read, and mutations committed, per round trip.
+	ChunkSize int
+	// DryRun computes updates without writing them.
+	DryRun bool
+}
+
+func (o Options) normalized() Options {
+	if o.Partitions <= 0 {
+		o.Partitions = DefaultPartitions
+	}
+	if o.Partitions > MaxPartitions {
+		o.Partitions = MaxPartitions
+	}
+	if o.ChunkSize <= 0 {
+		o.ChunkSize = DefaultChunkSize
+	}
+	if o.ChunkSize > MaxChunkSize {
+		o.ChunkSize = MaxChunkSize
+	}
+	return o
+}
+
+// Stats summarizes a backfill run.
+type Stats struct {
+	Scanned int64
+	Updated int64
+	Failed  int64
+}
+
+// Partition is a half-open product_id key range. Empty bounds are unbounded.
+type Partition struct {
+	Start string
+	End   string
+}
+
+// Partitions splits the product_id key space into n ranges.
+// Product IDs are UUIDs, so the ranges are cut on the leading hex digit.
+func Partitions(n int) []Partition {
+	if n <= 1 {
+		return []Partition{{}}
+	}
+	if n > MaxPartitions {
+		n = MaxPartitions
+	}
+
+	const hexDigits = "0123456789abcdef"
+	parts := make([]Partition, n)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			parts[i].Start = string(hexDigits[i*MaxPartitions/n])
+		}
+		if i < n-1 {
+			parts[i].End = string(hexDigits[(i+1)*MaxPartitions/n])
+		}
+	}
+	return parts
+}
+
+// Runner executes fillers against the products table.
+type Runner struct {
+	client    *spanner.Client
+	committer *committer.Committer
+}
+
+// NewRunner creates a new Runner.
+func NewRunner(client *spanner.Client, committer *committer.Committer) *Runner {
+	return &Runner{
+		client:    client,
+		committer: committer,
+	}
+}
+
+// Run applies the filler to every product row.
+func (r *Runner) Run(ctx context.Context, filler Filler, opts Options) (Stats, error) {
+	opts = opts.normalized()
+
+	var (
+		stats    Stats
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	for _, part := range Partitions(opts.Partitions) {
+		wg.Add(1)
+		go func(part Partition) {
+			defer wg.Done()
+			if err := r.runPartition(ctx, filler, part, opts, &stats); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(part)
+	}
+	wg.Wait()
+
+	return stats, firstErr
+}
+
+// runPartition pages through a single key range using keyset pagination.
+func (r *Runner) runPartition(ctx context.Context, filler Filler, part Partition, opts Options, stats *Stats) error {
+	after := ""
+	for {
+		plan := committer.NewPlanFor("backfill " + filler.Name())
+		lastID, rows, err := r.scanChunk(ctx, filler, part, after, opts.ChunkSize, plan, stats)
+		if err != nil {
+			return fmt.Errorf("partition [%q, %q): %w", part.Start, part.End, err)
+		}
+
+		if !opts.DryRun {
+			if err := r.committer.ApplyLowPriority(ctx, plan); err != nil {
+				return fmt.Errorf("partition [%q, %q): commit after %q: %w", part.Start, part.End, after, err)
+			}
+		}
+		atomic.AddInt64(&stats.Updated, int64(plan.Count()))
+
+		if rows < opts.ChunkSize {
+			return nil
+		}
+		after = lastID
+	}
+}
+
+// scanChunk reads up to limit rows after the given key and adds the resulting updates to the plan.
+func (r *Runner) scanChunk(
+	ctx context.Context,
+	filler Filler,
+	part Partition,
+	after string,
+	limit int,
+	plan *committer.Plan,
+	stats *Stats,
+) (string, int, error) {
+	iter := r.client.Single().Query(ctx, buildScanQuery(filler, part, after, limit))
+	defer iter.Stop()
+
+	var (
+		lastID string
+		rows   int
+	)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return "", 0, err
+		}
+
+		rows++
+		atomic.AddInt64(&stats.Scanned, 1)
+
+		if err := row.ColumnByName(repository.ProductID, &lastID); err != nil {
+			return "", 0, err
+		}
+
+		updates, err := filler.Fill(row)
+		if err != nil {
+			atomic.AddInt64(&stats.Failed, 1)
+			logging.Errorf("backfill %s: product %s: %v", filler.Name(), lastID, err)
+			continue
+		}
+		if len(updates) == 0 {
+			continue
+		}
+
+		updates[repository.ProductID] = lastID
+		plan.Add(spanner.UpdateMap(repository.ProductsTable, updates))
+	}
+
+	return lastID, rows, nil
+}
+
+// buildScanQuery builds the keyset query for one chunk of a partition.
+func buildScanQuery(filler Filler, part Partition, after string, limit int) spanner.Statement {
+	columns := append([]string{repository.ProductID}, filler.Columns()...)
+	sql := `SELECT ` + strings.Join(columns, ", ") + ` FROM products WHERE 1=1`
+	params := map[string]interface{}{
+		"limit": int64(limit),
+	}
+
+	if part.Start != "" {
+		sql += ` AND product_id >= @start`
+		params["start"] = part.Start
+	}
+	if part.End != "" {
+		sql += ` AND product_id < @end`
+		params["end"] = part.End
+	}
+	if after != "" {
+		sql += ` AND product_id > @after`
+		params["after"] = after
+	}
+
+	sql += ` ORDER BY product_id LIMIT @limit`
+
+	return spanner.Statement{SQL: sql, Params: params}
+}
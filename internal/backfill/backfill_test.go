@@ -0,0 +1,114 @@
+package backfill
+
+import (
+	"testing"
+
+	"cloud.google.com/go/spanner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubFiller struct {
+	name    string
+	columns []string
+}
+
+func (f stubFiller) Name() string      { return f.name }
+func (f stubFiller) Columns() []string { return f.columns }
+func (f stubFiller) Fill(_ *spanner.Row) (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func TestPartitions(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected []Partition
+	}{
+		{
+			name:     "single partition is unbounded",
+			n:        1,
+			expected: []Partition{{}},
+		},
+		{
+			name:     "zero falls back to single partition",
+			n:        0,
+			expected: []Partition{{}},
+		},
+		{
+			name: "two partitions split at 8",
+			n:    2,
+			expected: []Partition{
+				{Start: "", End: "8"},
+				{Start: "8", End: ""},
+			},
+		},
+		{
+			name: "four partitions",
+			n:    4,
+			expected: []Partition{
+				{Start: "", End: "4"},
+				{Start: "4", End: "8"},
+				{Start: "8", End: "c"},
+				{Start: "c", End: ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Partitions(tt.n))
+		})
+	}
+}
+
+func TestPartitions_CapsAtMax(t *testing.T) {
+	parts := Partitions(100)
+	require.Len(t, parts, MaxPartitions)
+	assert.Equal(t, "", parts[0].Start)
+	assert.Equal(t, "1", parts[0].End)
+	assert.Equal(t, "f", parts[MaxPartitions-1].Start)
+	assert.Equal(t, "", parts[MaxPartitions-1].End)
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry(
+		stubFiller{name: "slug"},
+		stubFiller{name: "currency"},
+	)
+
+	assert.Equal(t, []string{"currency", "slug"}, registry.Names())
+
+	f, err := registry.Get("slug")
+	require.NoError(t, err)
+	assert.Equal(t, "slug", f.Name())
+
+	_, err = registry.Get("missing")
+	assert.ErrorIs(t, err, ErrUnknownFiller)
+}
+
+func TestOptions_Normalized(t *testing.T) {
+	opts := Options{}.normalized()
+	assert.Equal(t, DefaultPartitions, opts.Partitions)
+	assert.Equal(t, DefaultChunkSize, opts.ChunkSize)
+
+	opts = Options{Partitions: 64, ChunkSize: 5000}.normalized()
+	assert.Equal(t, MaxPartitions, opts.Partitions)
+	assert.Equal(t, MaxChunkSize, opts.ChunkSize)
+}
+
+func TestBuildScanQuery(t *testing.T) {
+	filler := stubFiller{name: "slug", columns: []string{"name"}}
+
+	stmt := buildScanQuery(filler, Partition{}, "", 100)
+	assert.Equal(t, `SELECT product_id, name FROM products WHERE 1=1 ORDER BY product_id LIMIT @limit`, stmt.SQL)
+	assert.Equal(t, int64(100), stmt.Params["limit"])
+
+	stmt = buildScanQuery(filler, Partition{Start: "4", End: "8"}, "4abc", 50)
+	assert.Contains(t, stmt.SQL, `product_id >= @start`)
+	assert.Contains(t, stmt.SQL, `product_id < @end`)
+	assert.Contains(t, stmt.SQL, `product_id > @after`)
+	assert.Equal(t, "4", stmt.Params["start"])
+	assert.Equal(t, "8", stmt.Params["end"])
+	assert.Equal(t, "4abc", stmt.Params["after"])
+}
@@ -0,0 +1,48 @@
+package backfill
+
+import (
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/repository"
+)
+
+// CurrencyFillerName is the command-line name of the CurrencyFiller.
+const CurrencyFillerName = "currency"
+
+// CurrencyFiller sets currency on rows created before the column existed. Every price was
+// in domain.DefaultCurrency then, which is also how NULL is read, so the backfill only makes
+// the currency explicit.
+type CurrencyFiller struct{}
+
+// NewCurrencyFiller creates a CurrencyFiller.
+func NewCurrencyFiller() *CurrencyFiller {
+	return &CurrencyFiller{}
+}
+
+// Name implements Filler.
+func (f *CurrencyFiller) Name() string {
+	return CurrencyFillerName
+}
+
+// Columns implements Filler.
+func (f *CurrencyFiller) Columns() []string {
+	return []string{repository.ProductCurrency}
+}
+
+// Fill implements Filler.
+func (f *CurrencyFiller) Fill(row *spanner.Row) (map[string]interface{}, error) {
+	var (
+		productID string
+		currency  spanner.NullString
+	)
+	if err := row.Columns(&productID, &currency); err != nil {
+		return nil, err
+	}
+	if currency.Valid {
+		return nil, nil
+	}
+
+	return map[string]interface{}{
+		repository.ProductCurrency: spanner.NullString{StringVal: domain.DefaultCurrency, Valid: true},
+	}, nil
+}
@@ -0,0 +1,38 @@
+package backfill
+
+import (
+	"testing"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyFiller_Fill(t *testing.T) {
+	tests := []struct {
+		name     string
+		currency spanner.NullString
+		expected map[string]interface{}
+	}{
+		{"already set", spanner.NullString{StringVal: "EUR", Valid: true}, nil},
+		{"null", spanner.NullString{}, map[string]interface{}{
+			repository.ProductCurrency: spanner.NullString{StringVal: "USD", Valid: true},
+		}},
+	}
+
+	filler := NewCurrencyFiller()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, err := spanner.NewRow(
+				[]string{repository.ProductID, repository.ProductCurrency},
+				[]interface{}{"product-123", tt.currency},
+			)
+			require.NoError(t, err)
+
+			updates, err := filler.Fill(row)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, updates)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package backfill
+
+import (
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/logging"
+	"github.com/product-catalog-service/internal/repository"
+)
+
+// DiscountPercentFillerName is the command-line name of the DiscountPercentFiller.
+const DiscountPercentFillerName = "discount_percent"
+
+// DiscountPercentFiller repairs discount percentages written by the legacy write path,
+// which converted the percentage to a float64 and truncated it to hundredths. Requested
+// values whose float64 product with 100 falls just below an integer, such as 0.29
+// (stored as 0.28) or 2.01 (stored as 2.00), were shifted down by one hundredth.
+//
+// A stored value is restored only when it cannot have been written as-is, i.e. its only
+// legacy preimage is the next hundredth. Values that are also a legacy preimage of
+// themselves (0.28 may have been requested as 0.28 or 0.29) are ambiguous; they are
+// logged for manual review and left unchanged.
+type DiscountPercentFiller struct {
+	updatedBefore time.Time
+}
+
+// NewDiscountPercentFiller creates a DiscountPercentFiller that only considers rows last
+// updated before updatedBefore, which should be the time the exact write path was
+// deployed. A zero time considers every row.
+func NewDiscountPercentFiller(updatedBefore time.Time) *DiscountPercentFiller {
+	return &DiscountPercentFiller{updatedBefore: updatedBefore}
+}
+
+// Name implements Filler.
+func (f *DiscountPercentFiller) Name() string {
+	return DiscountPercentFillerName
+}
+
+// Columns implements Filler.
+func (f *DiscountPercentFiller) Columns() []string {
+	return []string{repository.ProductDiscountPercent, repository.ProductUpdatedAt}
+}
+
+// Fill implements Filler.
+func (f *DiscountPercentFiller) Fill(row *spanner.Row) (map[string]interface{}, error) {
+	var (
+		productID string
+		pct       spanner.NullNumeric
+		updatedAt time.Time
+	)
+	if err := row.Columns(&productID, &pct, &updatedAt); err != nil {
+		return nil, err
+	}
+	if !pct.Valid {
+		return nil, nil
+	}
+	if !f.updatedBefore.IsZero() && !updatedAt.Before(f.updatedBefore) {
+		return nil, nil
+	}
+
+	hundredths := new(big.Rat).Mul(&pct.Numeric, big.NewRat(100, 1))
+	if !hundredths.IsInt() || !hundredths.Num().IsInt64() {
+		// Finer than hundredths, so not written by the legacy path.
+		return nil, nil
+	}
+	stored := hundredths.Num().Int64()
+
+	if legacyStoredHundredths(stored+1) != stored {
+		return nil, nil
+	}
+	if legacyStoredHundredths(stored) == stored {
+		logging.Warnf("backfill %s: product %s: discount %s%% may have been requested as %s%%; left unchanged",
+			DiscountPercentFillerName, productID, hundredthsString(stored), hundredthsString(stored+1))
+		return nil, nil
+	}
+
+	return map[string]interface{}{
+		repository.ProductDiscountPercent: spanner.NullNumeric{Numeric: *big.NewRat(stored+1, 100), Valid: true},
+	}, nil
+}
+
+// legacyStoredHundredths reproduces the legacy write path for a requested percentage of
+// the given number of hundredths and returns the hundredths it persisted.
+func legacyStoredHundredths(requested int64) int64 {
+	pct := float64(requested) / 100
+	return int64(pct * 100)
+}
+
+func hundredthsString(h int64) string {
+	return big.NewRat(h, 100).FloatString(2)
+}
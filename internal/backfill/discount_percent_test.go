@@ -0,0 +1,71 @@
+package backfill
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func discountPercentRow(t *testing.T, pct spanner.NullNumeric, updatedAt time.Time) *spanner.Row {
+	t.Helper()
+	row, err := spanner.NewRow(
+		[]string{repository.ProductID, repository.ProductDiscountPercent, repository.ProductUpdatedAt},
+		[]interface{}{"product-123", pct, updatedAt},
+	)
+	require.NoError(t, err)
+	return row
+}
+
+func numeric(num, denom int64) spanner.NullNumeric {
+	return spanner.NullNumeric{Numeric: *big.NewRat(num, denom), Valid: true}
+}
+
+func TestDiscountPercentFiller_Fill(t *testing.T) {
+	deployedAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	before := deployedAt.Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		pct       spanner.NullNumeric
+		updatedAt time.Time
+		expected  *big.Rat
+	}{
+		{"no discount", spanner.NullNumeric{}, before, nil},
+		{"stored exactly", numeric(25, 2), before, nil},
+		// 0.57 is stored as 0.56 and 0.58 as 0.57, so 0.57 can only come from 0.58.
+		{"unambiguous truncation is restored", numeric(57, 100), before, big.NewRat(58, 100)},
+		// 0.28 and 0.29 are both stored as 0.28.
+		{"ambiguous truncation is left unchanged", numeric(28, 100), before, nil},
+		{"finer than hundredths", numeric(12345, 1000), before, nil},
+		{"updated after deployment", numeric(57, 100), deployedAt, nil},
+	}
+
+	filler := NewDiscountPercentFiller(deployedAt)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates, err := filler.Fill(discountPercentRow(t, tt.pct, tt.updatedAt))
+			require.NoError(t, err)
+
+			if tt.expected == nil {
+				assert.Nil(t, updates)
+				return
+			}
+			got, ok := updates[repository.ProductDiscountPercent].(spanner.NullNumeric)
+			require.True(t, ok)
+			assert.True(t, got.Valid)
+			assert.Zero(t, tt.expected.Cmp(&got.Numeric), "got %s", got.Numeric.RatString())
+		})
+	}
+}
+
+func TestLegacyStoredHundredths(t *testing.T) {
+	assert.Equal(t, int64(1250), legacyStoredHundredths(1250))
+	assert.Equal(t, int64(3333), legacyStoredHundredths(3333))
+	assert.Equal(t, int64(200), legacyStoredHundredths(201))
+	assert.Equal(t, int64(28), legacyStoredHundredths(29))
+}
@@ -0,0 +1,63 @@
+package backfill
+
+import (
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/repository"
+)
+
+// DiscountPhaseFillerName is the command-line name of the DiscountPhaseFiller.
+const DiscountPhaseFillerName = "discount_phase"
+
+// DiscountPhaseFiller sets discount_phase on rows whose discount was applied before the
+// column existed. Without it the discount scheduler reads NULL as scheduled and announces
+// long-running discounts as started, and long-expired ones as ended, on its first pass.
+// The phase is derived from the discount period as of the given time, announcing nothing.
+type DiscountPhaseFiller struct {
+	at time.Time
+}
+
+// NewDiscountPhaseFiller creates a DiscountPhaseFiller that derives phases as of at.
+func NewDiscountPhaseFiller(at time.Time) *DiscountPhaseFiller {
+	return &DiscountPhaseFiller{at: at}
+}
+
+// Name implements Filler.
+func (f *DiscountPhaseFiller) Name() string {
+	return DiscountPhaseFillerName
+}
+
+// Columns implements Filler.
+func (f *DiscountPhaseFiller) Columns() []string {
+	return []string{repository.ProductDiscountStartDate, repository.ProductDiscountEndDate, repository.ProductDiscountPhase}
+}
+
+// Fill implements Filler.
+func (f *DiscountPhaseFiller) Fill(row *spanner.Row) (map[string]interface{}, error) {
+	var (
+		productID string
+		start     spanner.NullTime
+		end       spanner.NullTime
+		phase     spanner.NullString
+	)
+	if err := row.Columns(&productID, &start, &end, &phase); err != nil {
+		return nil, err
+	}
+	if phase.Valid || !start.Valid || !end.Valid {
+		return nil, nil
+	}
+
+	derived := domain.DiscountPhaseScheduled
+	switch {
+	case !f.at.Before(end.Time):
+		derived = domain.DiscountPhaseEnded
+	case !f.at.Before(start.Time):
+		derived = domain.DiscountPhaseStarted
+	}
+
+	return map[string]interface{}{
+		repository.ProductDiscountPhase: spanner.NullString{StringVal: string(derived), Valid: true},
+	}, nil
+}
@@ -0,0 +1,58 @@
+package backfill
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func discountPhaseRow(t *testing.T, start, end spanner.NullTime, phase spanner.NullString) *spanner.Row {
+	t.Helper()
+	row, err := spanner.NewRow(
+		[]string{repository.ProductID, repository.ProductDiscountStartDate, repository.ProductDiscountEndDate, repository.ProductDiscountPhase},
+		[]interface{}{"product-123", start, end, phase},
+	)
+	require.NoError(t, err)
+	return row
+}
+
+func TestDiscountPhaseFiller_Fill(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) spanner.NullTime {
+		return spanner.NullTime{Time: now.Add(d), Valid: true}
+	}
+
+	tests := []struct {
+		name     string
+		start    spanner.NullTime
+		end      spanner.NullTime
+		phase    spanner.NullString
+		expected string
+	}{
+		{"no discount", spanner.NullTime{}, spanner.NullTime{}, spanner.NullString{}, ""},
+		{"already set", at(-time.Hour), at(time.Hour), spanner.NullString{StringVal: "scheduled", Valid: true}, ""},
+		{"future discount", at(time.Hour), at(2 * time.Hour), spanner.NullString{}, "scheduled"},
+		{"running discount", at(-time.Hour), at(time.Hour), spanner.NullString{}, "started"},
+		{"expired discount", at(-2 * time.Hour), at(0), spanner.NullString{}, "ended"},
+	}
+
+	filler := NewDiscountPhaseFiller(now)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates, err := filler.Fill(discountPhaseRow(t, tt.start, tt.end, tt.phase))
+			require.NoError(t, err)
+
+			if tt.expected == "" {
+				assert.Nil(t, updates)
+				return
+			}
+			assert.Equal(t, map[string]interface{}{
+				repository.ProductDiscountPhase: spanner.NullString{StringVal: tt.expected, Valid: true},
+			}, updates)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Package caller carries the identity of the caller of a request: the tenant it acts for
+// and its role. The service does not authenticate callers itself; the API gateway does and
+// asserts the identity in request metadata, which the transport layer stores in the
+// request context. When API-key auth is enabled, the client the key was issued to is
+// added to the identity.
+package caller
+
+import "context"
+
+// Identity is the tenant and role of a caller, and the API client it authenticated as;
+// any of them may be empty.
+type Identity struct {
+	Tenant string
+	Role   string
+	Client string
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx that carries id.
+func NewContext(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the identity carried by ctx, or the zero Identity if none.
+func FromContext(ctx context.Context) Identity {
+	id, _ := ctx.Value(contextKey{}).(Identity)
+	return id
+}
+
+// Tenant returns the tenant of the caller carried by ctx and whether ctx carries an
+// identity at all. Every request served over the transport layer does, so its data is
+// scoped to the tenant; an empty tenant is the default tenant, which owns the products
+// created without one. Contexts without an identity, such as those of background jobs,
+// are not scoped and see the products of all tenants.
+func Tenant(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(contextKey{}).(Identity)
+	return id.Tenant, ok
+}
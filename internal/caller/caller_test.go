@@ -0,0 +1,29 @@
+package caller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext(t *testing.T) {
+	assert.Equal(t, Identity{}, FromContext(context.Background()))
+
+	id := Identity{Tenant: "acme", Role: "pricing-oncall"}
+	assert.Equal(t, id, FromContext(NewContext(context.Background(), id)))
+}
+
+func TestTenant(t *testing.T) {
+	tenant, scoped := Tenant(context.Background())
+	assert.False(t, scoped)
+	assert.Empty(t, tenant)
+
+	tenant, scoped = Tenant(NewContext(context.Background(), Identity{}))
+	assert.True(t, scoped)
+	assert.Empty(t, tenant)
+
+	tenant, scoped = Tenant(NewContext(context.Background(), Identity{Tenant: "acme"}))
+	assert.True(t, scoped)
+	assert.Equal(t, "acme", tenant)
+}
@@ -0,0 +1,209 @@
+package committer
+
+import (
+	"encoding/json"
+	"expvar"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// Spanner commit limits. A commit may change at most MaxCommitCells column values,
+// counting each column of each inserted or updated row once and each deleted key range
+// once, and may be at most MaxCommitBytes in size.
+const (
+	MaxCommitCells = 80000
+	MaxCommitBytes = 100 << 20
+)
+
+// BudgetWarnFraction is the fraction of a Spanner commit limit above which a commit is
+// logged as a warning.
+const BudgetWarnFraction = 0.8
+
+// unlabeledUseCase is the use case reported for plans created with NewPlan.
+const unlabeledUseCase = "unlabeled"
+
+// Histograms of the commits of each use case, published as expvars keyed by use case.
+var (
+	commitMutations = expvar.NewMap("committer_commit_mutations")
+	commitCells     = expvar.NewMap("committer_commit_cells")
+	commitBytes     = expvar.NewMap("committer_commit_bytes")
+)
+
+// Bucket upper bounds of the commit histograms.
+var (
+	countBuckets = []int64{1, 10, 100, 1000, 10000, MaxCommitCells}
+	byteBuckets  = []int64{1 << 10, 10 << 10, 100 << 10, 1 << 20, 10 << 20, MaxCommitBytes}
+)
+
+// Size is the approximate size of a commit. Bytes estimates the encoded size of the
+// mutations from their table and column names and values; index entries are not
+// counted in Cells or Bytes.
+type Size struct {
+	Mutations int
+	Cells     int
+	Bytes     int
+}
+
+// MeasureMutations returns the approximate size of committing mutations.
+func MeasureMutations(mutations []*spanner.Mutation) Size {
+	size := Size{Mutations: len(mutations)}
+	for _, mut := range mutations {
+		if mut == nil {
+			continue
+		}
+		v := reflect.ValueOf(mut).Elem()
+		if columns := v.FieldByName("columns").Len(); columns > 0 {
+			size.Cells += columns
+		} else {
+			size.Cells++
+		}
+		size.Bytes += approxBytes(v, 0)
+	}
+	return size
+}
+
+// record adds a commit of the use case to the histograms and logs a warning if it is
+// close to a Spanner limit.
+func record(useCase string, mutations []*spanner.Mutation) {
+	if useCase == "" {
+		useCase = unlabeledUseCase
+	}
+	size := MeasureMutations(mutations)
+	observe(commitMutations, useCase, int64(size.Mutations), countBuckets)
+	observe(commitCells, useCase, int64(size.Cells), countBuckets)
+	observe(commitBytes, useCase, int64(size.Bytes), byteBuckets)
+
+	if float64(size.Cells) >= BudgetWarnFraction*MaxCommitCells || float64(size.Bytes) >= BudgetWarnFraction*MaxCommitBytes {
+		logging.Warnf("committer: %s commit is close to the Spanner limits: %d mutations, %d of %d cells, ~%d of %d bytes",
+			useCase, size.Mutations, size.Cells, MaxCommitCells, size.Bytes, MaxCommitBytes)
+	}
+}
+
+// observe adds a value to the histogram of the use case in m.
+func observe(m *expvar.Map, useCase string, value int64, buckets []int64) {
+	h, ok := m.Get(useCase).(*histogram)
+	if !ok {
+		histogramsMu.Lock()
+		if h, ok = m.Get(useCase).(*histogram); !ok {
+			h = newHistogram(buckets)
+			m.Set(useCase, h)
+		}
+		histogramsMu.Unlock()
+	}
+	h.observe(value)
+}
+
+// histogramsMu serializes the creation of histograms.
+var histogramsMu sync.Mutex
+
+// histogram counts values in buckets by upper bound. It is an expvar.Var.
+type histogram struct {
+	mu      sync.Mutex
+	bounds  []int64
+	buckets []int64 // buckets[len(bounds)] counts the values above the last bound
+	count   int64
+	sum     int64
+	max     int64
+}
+
+func newHistogram(bounds []int64) *histogram {
+	return &histogram{bounds: bounds, buckets: make([]int64, len(bounds)+1)}
+}
+
+func (h *histogram) observe(value int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	i := 0
+	for i < len(h.bounds) && value > h.bounds[i] {
+		i++
+	}
+	h.buckets[i]++
+	h.count++
+	h.sum += value
+	if value > h.max {
+		h.max = value
+	}
+}
+
+// String returns the histogram as JSON, with buckets keyed "le_<bound>" and "inf".
+func (h *histogram) String() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	buckets := make(map[string]int64, len(h.buckets))
+	for i, n := range h.buckets {
+		key := "inf"
+		if i < len(h.bounds) {
+			key = "le_" + strconv.FormatInt(h.bounds[i], 10)
+		}
+		buckets[key] = n
+	}
+	b, _ := json.Marshal(map[string]interface{}{
+		"count":   h.count,
+		"sum":     h.sum,
+		"max":     h.max,
+		"buckets": buckets,
+	})
+	return string(b)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// maxSizeDepth bounds the recursion of approxBytes.
+const maxSizeDepth = 10
+
+// approxBytes estimates the encoded size of a value: the length of strings and byte
+// slices, and the in-memory size of other scalars. Unexported fields are read through
+// reflection, as spanner.Mutation exposes none.
+func approxBytes(v reflect.Value, depth int) int {
+	if depth > maxSizeDepth {
+		return 0
+	}
+	switch v.Kind() {
+	case reflect.Invalid:
+		return 0
+	case reflect.String:
+		return v.Len()
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Len()
+		}
+		n := 0
+		for i := 0; i < v.Len(); i++ {
+			n += approxBytes(v.Index(i), depth+1)
+		}
+		return n
+	case reflect.Map:
+		n := 0
+		iter := v.MapRange()
+		for iter.Next() {
+			n += approxBytes(iter.Key(), depth+1) + approxBytes(iter.Value(), depth+1)
+		}
+		return n
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		return approxBytes(v.Elem(), depth+1)
+	case reflect.Struct:
+		if v.Type() == timeType {
+			// A timestamp is sent as an RFC 3339 string.
+			return len(time.RFC3339Nano)
+		}
+		n := 0
+		for i := 0; i < v.NumField(); i++ {
+			n += approxBytes(v.Field(i), depth+1)
+		}
+		return n
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return 0
+	default:
+		return int(v.Type().Size())
+	}
+}
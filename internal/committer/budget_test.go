@@ -0,0 +1,73 @@
+package committer
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeasureMutations(t *testing.T) {
+	t.Parallel()
+
+	description := strings.Repeat("x", 1000)
+	mutations := []*spanner.Mutation{
+		spanner.InsertMap("products", map[string]interface{}{
+			"product_id":  "product-1",
+			"description": description,
+			"created_at":  time.Now(),
+			"discount":    spanner.NullNumeric{Numeric: *big.NewRat(25, 2), Valid: true},
+		}),
+		spanner.Update("products", []string{"product_id", "name"}, []interface{}{"product-1", "Widget"}),
+		spanner.Delete("product_discounts", spanner.Key{"product-1"}.AsPrefix()),
+	}
+
+	size := MeasureMutations(mutations)
+
+	assert.Equal(t, 3, size.Mutations)
+	assert.Equal(t, 4+2+1, size.Cells)
+	assert.Greater(t, size.Bytes, len(description)+len("product-1")*3)
+	assert.Less(t, size.Bytes, 2*len(description))
+}
+
+func TestHistogram(t *testing.T) {
+	t.Parallel()
+
+	h := newHistogram([]int64{1, 10})
+	for _, v := range []int64{1, 5, 10, 11, 100} {
+		h.observe(v)
+	}
+
+	var got struct {
+		Count   int64            `json:"count"`
+		Sum     int64            `json:"sum"`
+		Max     int64            `json:"max"`
+		Buckets map[string]int64 `json:"buckets"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(h.String()), &got))
+	assert.Equal(t, int64(5), got.Count)
+	assert.Equal(t, int64(127), got.Sum)
+	assert.Equal(t, int64(100), got.Max)
+	assert.Equal(t, map[string]int64{"le_1": 1, "le_10": 2, "inf": 2}, got.Buckets)
+}
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+
+	mutations := []*spanner.Mutation{spanner.Update("products", []string{"product_id", "name"}, []interface{}{"product-1", "Widget"})}
+	record("TestRecord", mutations)
+	record("TestRecord", mutations)
+
+	h, ok := commitCells.Get("TestRecord").(*histogram)
+	require.True(t, ok)
+	assert.Equal(t, int64(2), h.count)
+	assert.Equal(t, int64(4), h.sum)
+
+	record("", mutations)
+	assert.NotNil(t, commitMutations.Get(unlabeledUseCase))
+}
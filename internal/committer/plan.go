@@ -5,21 +5,43 @@ import (
 	"context"
 
 	"cloud.google.com/go/spanner"
+	sppb "cloud.google.com/go/spanner/apiv1/spannerpb"
 )
 
 // Plan collects Spanner mutations for atomic application.
 // This implements a simple version of the Unit of Work pattern.
 type Plan struct {
 	mutations []*spanner.Mutation
+	checks    []Check
+	useCase   string
 }
 
-// NewPlan creates a new empty Plan.
+// Check is a precondition of a plan, run in its read-write transaction before the
+// mutations are written. An error fails the commit and is returned by Apply; since the
+// reads lock what they read until the commit, a check can guard against concurrent
+// changes, e.g. by comparing a version, or buffer mutations for the rows it reads, e.g.
+// to delete them.
+type Check func(ctx context.Context, txn *spanner.ReadWriteTransaction) error
+
+// NewPlan creates a new empty Plan. Its commits are reported as unlabeled; see NewPlanFor.
 func NewPlan() *Plan {
+	return NewPlanFor("")
+}
+
+// NewPlanFor creates a new empty Plan for the named use case, e.g. "ApplyDiscount". The
+// size of its commits is reported under that name.
+func NewPlanFor(useCase string) *Plan {
 	return &Plan{
 		mutations: make([]*spanner.Mutation, 0),
+		useCase:   useCase,
 	}
 }
 
+// UseCase returns the use case the plan was created for, or "" if none.
+func (p *Plan) UseCase() string {
+	return p.useCase
+}
+
 // Add adds a mutation to the plan.
 // Nil mutations are ignored.
 func (p *Plan) Add(mut *spanner.Mutation) {
@@ -35,6 +57,14 @@ func (p *Plan) AddAll(muts ...*spanner.Mutation) {
 	}
 }
 
+// AddCheck adds a precondition to the plan.
+// Nil checks are ignored.
+func (p *Plan) AddCheck(check Check) {
+	if check != nil {
+		p.checks = append(p.checks, check)
+	}
+}
+
 // Mutations returns all collected mutations.
 func (p *Plan) Mutations() []*spanner.Mutation {
 	return p.mutations
@@ -50,9 +80,20 @@ func (p *Plan) Count() int {
 	return len(p.mutations)
 }
 
-// Clear removes all mutations from the plan.
+// Clear removes all mutations and checks from the plan.
 func (p *Plan) Clear() {
 	p.mutations = make([]*spanner.Mutation, 0)
+	p.checks = nil
+}
+
+// write runs the checks of the plan in txn, then buffers its mutations.
+func (p *Plan) write(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+	for _, check := range p.checks {
+		if err := check(ctx, txn); err != nil {
+			return err
+		}
+	}
+	return txn.BufferWrite(p.mutations)
 }
 
 // Committer applies plans to Spanner.
@@ -65,24 +106,39 @@ func NewCommitter(client *spanner.Client) *Committer {
 	return &Committer{client: client}
 }
 
-// Apply applies all mutations in the plan atomically within a read-write transaction.
+// Apply applies all mutations in the plan atomically within a read-write transaction,
+// after its checks have passed. The size of the commit is recorded under the use case of the plan.
 func (c *Committer) Apply(ctx context.Context, plan *Plan) error {
 	if plan == nil || plan.IsEmpty() {
 		return nil
 	}
+	record(plan.UseCase(), plan.Mutations())
 
-	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
-		return txn.BufferWrite(plan.Mutations())
-	})
+	_, err := c.client.ReadWriteTransaction(ctx, plan.write)
+
+	return err
+}
+
+// ApplyLowPriority applies all mutations in the plan atomically with a low commit priority.
+// Operational jobs such as backfills use it so that they yield to serving traffic.
+func (c *Committer) ApplyLowPriority(ctx context.Context, plan *Plan) error {
+	if plan == nil || plan.IsEmpty() {
+		return nil
+	}
+	record(plan.UseCase(), plan.Mutations())
+
+	_, err := c.client.ReadWriteTransactionWithOptions(ctx, plan.write, spanner.TransactionOptions{CommitPriority: sppb.RequestOptions_PRIORITY_LOW})
 
 	return err
 }
 
-// ApplyMutations applies the given mutations atomically.
+// ApplyMutations applies the given mutations atomically. Its commits are reported as
+// unlabeled.
 func (c *Committer) ApplyMutations(ctx context.Context, mutations []*spanner.Mutation) error {
 	if len(mutations) == 0 {
 		return nil
 	}
+	record("", mutations)
 
 	_, err := c.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
 		return txn.BufferWrite(mutations)
@@ -1,6 +1,8 @@
 package committer
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"cloud.google.com/go/spanner"
@@ -211,6 +213,35 @@ func TestPlan_Clear(t *testing.T) {
 	assert.Empty(t, plan.Mutations())
 }
 
+func TestPlan_AddCheck(t *testing.T) {
+	t.Parallel()
+
+	errConflict := errors.New("conflict")
+	var ran []string
+	plan := NewPlan()
+	plan.AddCheck(nil)
+	plan.AddCheck(func(context.Context, *spanner.ReadWriteTransaction) error {
+		ran = append(ran, "first")
+		return nil
+	})
+	plan.AddCheck(func(context.Context, *spanner.ReadWriteTransaction) error {
+		ran = append(ran, "second")
+		return errConflict
+	})
+	plan.AddCheck(func(context.Context, *spanner.ReadWriteTransaction) error {
+		ran = append(ran, "third")
+		return nil
+	})
+
+	// A failed check stops the plan before its mutations are written
+	assert.ErrorIs(t, plan.write(context.Background(), nil), errConflict)
+	assert.Equal(t, []string{"first", "second"}, ran)
+	assert.True(t, plan.IsEmpty())
+
+	plan.Clear()
+	assert.Empty(t, plan.checks)
+}
+
 func TestNewCommitter(t *testing.T) {
 	t.Parallel()
 
@@ -224,3 +255,10 @@ func TestCommitter_Client(t *testing.T) {
 	committer := NewCommitter(nil)
 	assert.Nil(t, committer.Client())
 }
+
+func TestNewPlanFor(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "ApplyDiscount", NewPlanFor("ApplyDiscount").UseCase())
+	assert.Empty(t, NewPlan().UseCase())
+}
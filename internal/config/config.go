@@ -0,0 +1,403 @@
+// Package config loads runtime configuration from environment variables.
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Default configuration values.
+const (
+	DefaultPort     = "50051"
+	DefaultProject  = "test-project"
+	DefaultInstance = "test-instance"
+	DefaultDatabase = "test-database"
+
+	DefaultOutboxPublisher   = "none"
+	DefaultNATSURL           = "nats://localhost:4222"
+	DefaultNATSStream        = "CATALOG"
+	DefaultNATSSubjectPrefix = "catalog"
+
+	DefaultPubSubTopic = "catalog-events"
+
+	DefaultOutboxMaxBatchSize  = 20
+	DefaultOutboxFlushInterval = 100 * time.Millisecond
+
+	DefaultPriceLockTTL = 15 * time.Minute
+
+	DefaultAPIKeyCacheTTL      = 30 * time.Second
+	DefaultAPIKeyRotationGrace = 24 * time.Hour
+
+	DefaultDiscountSchedulerInterval    = 30 * time.Second
+	DefaultPriceChangeSchedulerInterval = 30 * time.Second
+	DefaultStatusSchedulerInterval      = 30 * time.Second
+
+	DefaultPurgeRetentionDays = 365
+	DefaultPurgeInterval      = time.Hour
+
+	DefaultDigestInterval         = time.Hour
+	DefaultDigestPriceDropPercent = 20.0
+
+	DefaultLogLevel = "info"
+
+	DefaultDiagnosticsHost = "127.0.0.1"
+
+	DefaultHealthCheckInterval         = 5 * time.Second
+	DefaultHealthCheckTimeout          = 2 * time.Second
+	DefaultHealthCheckFailureThreshold = 2
+	DefaultDegradationCacheSize        = 10000
+
+	DefaultProductCacheTTL = 5 * time.Minute
+
+	DefaultBaseCurrency = "USD"
+
+	DefaultRoundingPolicy = "half_up"
+	DefaultReadStaleness  = "strong"
+
+	DefaultPriceReadSource = "computed"
+
+	DefaultMarginGuard = "warn"
+
+	DefaultDiscountComposition = "none"
+
+	DefaultOpenSearchIndex = "products"
+
+	DefaultSelfTestTimeout       = 10 * time.Second
+	DefaultSelfTestRetryInterval = 10 * time.Second
+)
+
+// Config holds the settings shared by the server and the operational commands.
+type Config struct {
+	Port            string
+	SpannerProject  string
+	SpannerInstance string
+	SpannerDatabase string
+
+	// HTTPPort serves the read-only REST/JSON API when set.
+	HTTPPort string
+
+	// OutboxPublisher selects the transport the outbox dispatcher publishes to: none, stdout, nats or pubsub.
+	OutboxPublisher string
+	// OutboxMaxBatchSize and OutboxFlushInterval control dispatcher batching per aggregate.
+	OutboxMaxBatchSize  int
+	OutboxFlushInterval time.Duration
+	// OutboxCompactUpdates coalesces consecutive product.updated events before publishing.
+	OutboxCompactUpdates bool
+	// OutboxEventSnapshots embeds the full product state in every outbox event.
+	OutboxEventSnapshots bool
+	// OutboxFilter is an eventfilter expression; only matching events are published.
+	OutboxFilter string
+
+	NATSURL           string
+	NATSStream        string
+	NATSSubjectPrefix string
+
+	// PubSubProject defaults to the Spanner project.
+	PubSubProject string
+	PubSubTopic   string
+	// PubSubEmulatorHost points the Pub/Sub publisher at an emulator when set.
+	PubSubEmulatorHost string
+
+	// PriceLockSecret is the HMAC key for price lock tokens; price locks are disabled if empty.
+	PriceLockSecret string
+	// PriceLockTTL is how long a price lock token is valid.
+	PriceLockTTL time.Duration
+	// PageTokenSecret is the HMAC key for page tokens; page tokens are unsigned if empty.
+	PageTokenSecret string
+
+	// APIKeyAuth requires a valid API key (x-api-key metadata) on every ProductService RPC
+	// and serves the RPCs that manage a client's keys. Validated keys are cached for
+	// APIKeyCacheTTL, so a revoked key can keep working for up to that long; a rotated
+	// key keeps working for APIKeyRotationGrace.
+	APIKeyAuth          bool
+	APIKeyCacheTTL      time.Duration
+	APIKeyRotationGrace time.Duration
+
+	// DiscountSchedulerEnabled runs the scheduler that records discount start and end events.
+	DiscountSchedulerEnabled bool
+	// DiscountSchedulerInterval is how often the scheduler polls for due discounts.
+	DiscountSchedulerInterval time.Duration
+	// PriceChangeSchedulerEnabled runs the scheduler that applies scheduled base price changes.
+	PriceChangeSchedulerEnabled bool
+	// PriceChangeSchedulerInterval is how often the scheduler polls for due price changes.
+	PriceChangeSchedulerInterval time.Duration
+	// StatusSchedulerEnabled runs the scheduler that makes scheduled product activations
+	// and deactivations.
+	StatusSchedulerEnabled bool
+	// StatusSchedulerInterval is how often the scheduler polls for due status changes.
+	StatusSchedulerInterval time.Duration
+
+	// PurgeEnabled runs the purger that permanently deletes products archived more than
+	// PurgeRetentionDays days ago, with their outbox history, every PurgeInterval. With
+	// PurgeDryRun it only logs the products it would delete.
+	PurgeEnabled       bool
+	PurgeRetentionDays int
+	PurgeInterval      time.Duration
+	PurgeDryRun        bool
+
+	// DigestEnabled runs the scheduler that publishes the daily catalog digest to the
+	// outbox. It checks every DigestInterval for a completed day and reports effective
+	// price drops of at least DigestPriceDropPercent.
+	DigestEnabled          bool
+	DigestInterval         time.Duration
+	DigestPriceDropPercent float64
+
+	// LogLevel and DebugFeatures (comma-separated, e.g. "sql,payloads") are applied at
+	// startup and restored on SIGHUP; the admin endpoint changes them at runtime.
+	LogLevel      string
+	DebugFeatures string
+	// AdminPort serves the admin endpoints when set; AdminToken is then required.
+	AdminPort  string
+	AdminToken string
+
+	// DiagnosticsPort serves pprof and runtime statistics when set. The endpoints are not
+	// authenticated, so they listen on DiagnosticsHost (localhost by default) only.
+	DiagnosticsPort string
+	DiagnosticsHost string
+
+	// DegradationEnabled probes Spanner every HealthCheckInterval. After
+	// HealthCheckFailureThreshold failed probes in a row, product reads are served from a
+	// cache of the last DegradationCacheSize results and other requests fail fast.
+	DegradationEnabled          bool
+	HealthCheckInterval         time.Duration
+	HealthCheckTimeout          time.Duration
+	HealthCheckFailureThreshold int
+	DegradationCacheSize        int
+
+	// RedisURL (redis://[[user]:password@]host[:port][/db]) caches the products read by
+	// GetProduct in Redis for at most ProductCacheTTL when set; commits of product
+	// events invalidate them. Single-instance deployments may cache the
+	// ProductCacheSize most recently read products in memory instead.
+	RedisURL         string
+	ProductCacheSize int
+	ProductCacheTTL  time.Duration
+
+	// ListProductSummaries makes ListProducts scan the slim product_summaries table for
+	// the listings it can answer from it. Enable it once `catalogctl project-summaries`
+	// has summarized the existing products.
+	ListProductSummaries bool
+
+	// CurrencyRates (e.g. "EUR=0.92,GBP=0.79") are the exchange rates against
+	// BaseCurrency used to price products in currencies missing from their price book.
+	// Such requests fail if it is empty.
+	BaseCurrency  string
+	CurrencyRates string
+	// RoundingPolicy (half_up, bankers or charm) rounds the display prices of read
+	// responses; the exact prices are never rounded.
+	RoundingPolicy string
+	// ReadStaleness is how stale the products read by GetProduct and ListProducts may be
+	// unless a request sets its own: "strong", a bound such as "15s" or an exact staleness
+	// such as "exact:15s"; see package staleness.
+	ReadStaleness string
+	// PricingExperimentsFile is a JSON file of A/B pricing experiments; empty disables
+	// them.
+	PricingExperimentsFile string
+	// TaxRates (e.g. "standard=20,reduced=5,DE:standard=19") are the tax rates in
+	// percent per tax class, optionally per region. Tax-inclusive prices fail for
+	// classes without a rate.
+	TaxRates string
+	// PriceReadSource (computed or projected) is where effective prices are read from:
+	// computed from the discounts of each product, or the effective_prices projection.
+	// PriceShadowSampleRate (0 to 1) is the share of reads also served by the other
+	// source and compared; 0 disables the comparison.
+	PriceReadSource       string
+	PriceShadowSampleRate float64
+	// MarginGuard (off, warn or block) is what happens when a discount would bring the
+	// price of a product below its cost price.
+	MarginGuard string
+	// DiscountComposition (none, additive or multiplicative) is how the pricing calculator
+	// combines discounts valid at the same time; DiscountCaps caps the total percentage
+	// off, e.g. "50,Electronics=30" for 50% by default and 30% in Electronics.
+	DiscountComposition string
+	DiscountCaps        string
+	// DiscountApprovalThreshold is the percentage above which ApplyDiscount holds a
+	// discount pending approval, e.g. "40"; empty applies every discount right away.
+	DiscountApprovalThreshold string
+	// ProductReviewRequired refuses to activate drafts that were not approved, so every
+	// product is reviewed by a second person before it first goes on sale.
+	ProductReviewRequired bool
+	// AgeRestrictedCategories is a comma-separated list of the categories age-restricted
+	// products may be sold in, e.g. "Wine,Spirits"; products are checked when they are
+	// activated. Empty allows none.
+	AgeRestrictedCategories string
+	// FlashSaleHookURLs is a comma-separated list of URLs, e.g. cache purge endpoints,
+	// notified with a POST when a flash sale opens or closes; empty disables the hooks.
+	FlashSaleHookURLs string
+	// OpenSearchURL is the base URL of an OpenSearch or Elasticsearch cluster, e.g.
+	// "https://search.example.com:9200", that SearchProducts searches for fuzzy matching
+	// and facets; the outbox dispatcher keeps its OpenSearchIndex in sync. Empty searches
+	// Spanner.
+	OpenSearchURL      string
+	OpenSearchIndex    string
+	OpenSearchUsername string
+	OpenSearchPassword string
+	// QualityScoringEnabled makes the outbox dispatcher score the content of products as
+	// they change, for the admin quality listing. QualityLocales is a comma-separated list
+	// of the locales products should be translated to, e.g. "de,fr"; empty leaves
+	// translations out of the score.
+	QualityScoringEnabled bool
+	QualityLocales        string
+
+	// SelfTestEnabled checks Spanner, the schema and migrations, the Pub/Sub topic and
+	// KMSKeyName at startup; the gRPC health service reports NOT_SERVING until they pass.
+	// Failed runs are retried every SelfTestRetryInterval.
+	SelfTestEnabled       bool
+	SelfTestTimeout       time.Duration
+	SelfTestRetryInterval time.Duration
+	// KMSKeyName is the Cloud KMS key (projects/.../cryptoKeys/...) the deployment's
+	// secrets are encrypted with; the self-test checks it is accessible when set.
+	KMSKeyName string
+}
+
+// Load reads the configuration from the environment, applying defaults.
+func Load() Config {
+	project := Getenv("SPANNER_PROJECT", DefaultProject)
+	return Config{
+		Port:            Getenv("PORT", DefaultPort),
+		SpannerProject:  project,
+		SpannerInstance: Getenv("SPANNER_INSTANCE", DefaultInstance),
+		SpannerDatabase: Getenv("SPANNER_DATABASE", DefaultDatabase),
+
+		HTTPPort: os.Getenv("HTTP_PORT"),
+
+		OutboxPublisher:      Getenv("OUTBOX_PUBLISHER", DefaultOutboxPublisher),
+		OutboxMaxBatchSize:   GetenvInt("OUTBOX_MAX_BATCH_SIZE", DefaultOutboxMaxBatchSize),
+		OutboxFlushInterval:  GetenvDuration("OUTBOX_FLUSH_INTERVAL", DefaultOutboxFlushInterval),
+		OutboxCompactUpdates: GetenvBool("OUTBOX_COMPACT_UPDATES", false),
+		OutboxEventSnapshots: GetenvBool("OUTBOX_EVENT_SNAPSHOTS", false),
+		OutboxFilter:         os.Getenv("OUTBOX_FILTER"),
+
+		NATSURL:           Getenv("NATS_URL", DefaultNATSURL),
+		NATSStream:        Getenv("NATS_STREAM", DefaultNATSStream),
+		NATSSubjectPrefix: Getenv("NATS_SUBJECT_PREFIX", DefaultNATSSubjectPrefix),
+
+		PubSubProject:      Getenv("PUBSUB_PROJECT", project),
+		PubSubTopic:        Getenv("PUBSUB_TOPIC", DefaultPubSubTopic),
+		PubSubEmulatorHost: os.Getenv("PUBSUB_EMULATOR_HOST"),
+
+		PriceLockSecret: os.Getenv("PRICE_LOCK_SECRET"),
+		PriceLockTTL:    GetenvDuration("PRICE_LOCK_TTL", DefaultPriceLockTTL),
+		PageTokenSecret: os.Getenv("PAGE_TOKEN_SECRET"),
+
+		APIKeyAuth:          GetenvBool("API_KEY_AUTH", false),
+		APIKeyCacheTTL:      GetenvDuration("API_KEY_CACHE_TTL", DefaultAPIKeyCacheTTL),
+		APIKeyRotationGrace: GetenvDuration("API_KEY_ROTATION_GRACE", DefaultAPIKeyRotationGrace),
+
+		DiscountSchedulerEnabled:  GetenvBool("DISCOUNT_SCHEDULER_ENABLED", true),
+		DiscountSchedulerInterval: GetenvDuration("DISCOUNT_SCHEDULER_INTERVAL", DefaultDiscountSchedulerInterval),
+
+		PriceChangeSchedulerEnabled:  GetenvBool("PRICE_CHANGE_SCHEDULER_ENABLED", true),
+		PriceChangeSchedulerInterval: GetenvDuration("PRICE_CHANGE_SCHEDULER_INTERVAL", DefaultPriceChangeSchedulerInterval),
+
+		StatusSchedulerEnabled:  GetenvBool("STATUS_SCHEDULER_ENABLED", true),
+		StatusSchedulerInterval: GetenvDuration("STATUS_SCHEDULER_INTERVAL", DefaultStatusSchedulerInterval),
+
+		PurgeEnabled:       GetenvBool("PURGE_ENABLED", false),
+		PurgeRetentionDays: GetenvInt("PURGE_RETENTION_DAYS", DefaultPurgeRetentionDays),
+		PurgeInterval:      GetenvDuration("PURGE_INTERVAL", DefaultPurgeInterval),
+		PurgeDryRun:        GetenvBool("PURGE_DRY_RUN", false),
+
+		DigestEnabled:          GetenvBool("DIGEST_ENABLED", false),
+		DigestInterval:         GetenvDuration("DIGEST_INTERVAL", DefaultDigestInterval),
+		DigestPriceDropPercent: GetenvFloat("DIGEST_PRICE_DROP_PERCENT", DefaultDigestPriceDropPercent),
+
+		LogLevel:      Getenv("LOG_LEVEL", DefaultLogLevel),
+		DebugFeatures: os.Getenv("DEBUG_FEATURES"),
+		AdminPort:     os.Getenv("ADMIN_PORT"),
+		AdminToken:    os.Getenv("ADMIN_TOKEN"),
+
+		DiagnosticsPort: os.Getenv("DIAGNOSTICS_PORT"),
+		DiagnosticsHost: Getenv("DIAGNOSTICS_HOST", DefaultDiagnosticsHost),
+
+		DegradationEnabled:          GetenvBool("DEGRADATION_ENABLED", true),
+		HealthCheckInterval:         GetenvDuration("SPANNER_HEALTH_CHECK_INTERVAL", DefaultHealthCheckInterval),
+		HealthCheckTimeout:          GetenvDuration("SPANNER_HEALTH_CHECK_TIMEOUT", DefaultHealthCheckTimeout),
+		HealthCheckFailureThreshold: GetenvInt("SPANNER_HEALTH_CHECK_FAILURES", DefaultHealthCheckFailureThreshold),
+		DegradationCacheSize:        GetenvInt("DEGRADATION_CACHE_SIZE", DefaultDegradationCacheSize),
+
+		RedisURL:         os.Getenv("REDIS_URL"),
+		ProductCacheSize: GetenvInt("PRODUCT_CACHE_SIZE", 0),
+		ProductCacheTTL:  GetenvDuration("PRODUCT_CACHE_TTL", DefaultProductCacheTTL),
+
+		ListProductSummaries: GetenvBool("LIST_PRODUCT_SUMMARIES", false),
+
+		BaseCurrency:  Getenv("BASE_CURRENCY", DefaultBaseCurrency),
+		CurrencyRates: os.Getenv("CURRENCY_RATES"),
+
+		RoundingPolicy:            Getenv("ROUNDING_POLICY", DefaultRoundingPolicy),
+		ReadStaleness:             Getenv("READ_STALENESS", DefaultReadStaleness),
+		PricingExperimentsFile:    os.Getenv("PRICING_EXPERIMENTS_FILE"),
+		TaxRates:                  os.Getenv("TAX_RATES"),
+		PriceReadSource:           Getenv("PRICE_READ_SOURCE", DefaultPriceReadSource),
+		PriceShadowSampleRate:     GetenvFloat("PRICE_SHADOW_SAMPLE_RATE", 0),
+		MarginGuard:               Getenv("MARGIN_GUARD", DefaultMarginGuard),
+		DiscountComposition:       Getenv("DISCOUNT_COMPOSITION", DefaultDiscountComposition),
+		DiscountCaps:              os.Getenv("DISCOUNT_CAPS"),
+		DiscountApprovalThreshold: os.Getenv("DISCOUNT_APPROVAL_THRESHOLD"),
+		ProductReviewRequired:     GetenvBool("PRODUCT_REVIEW_REQUIRED", false),
+		AgeRestrictedCategories:   os.Getenv("AGE_RESTRICTED_CATEGORIES"),
+		FlashSaleHookURLs:         os.Getenv("FLASH_SALE_HOOK_URLS"),
+		OpenSearchURL:             os.Getenv("OPENSEARCH_URL"),
+		OpenSearchIndex:           Getenv("OPENSEARCH_INDEX", DefaultOpenSearchIndex),
+		OpenSearchUsername:        os.Getenv("OPENSEARCH_USERNAME"),
+		OpenSearchPassword:        os.Getenv("OPENSEARCH_PASSWORD"),
+		QualityScoringEnabled:     GetenvBool("QUALITY_SCORING_ENABLED", false),
+		QualityLocales:            os.Getenv("QUALITY_LOCALES"),
+
+		SelfTestEnabled:       GetenvBool("SELF_TEST_ENABLED", true),
+		SelfTestTimeout:       GetenvDuration("SELF_TEST_TIMEOUT", DefaultSelfTestTimeout),
+		SelfTestRetryInterval: GetenvDuration("SELF_TEST_RETRY_INTERVAL", DefaultSelfTestRetryInterval),
+		KMSKeyName:            os.Getenv("KMS_KEY_NAME"),
+	}
+}
+
+// DatabasePath returns the fully qualified Spanner database path.
+func (c Config) DatabasePath() string {
+	return fmt.Sprintf("projects/%s/instances/%s/databases/%s", c.SpannerProject, c.SpannerInstance, c.SpannerDatabase)
+}
+
+// Getenv returns the value of the environment variable or the default if unset.
+func Getenv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// GetenvInt returns the integer value of the environment variable,
+// or the default if unset or not an integer.
+func GetenvInt(key string, defaultValue int) int {
+	if n, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return n
+	}
+	return defaultValue
+}
+
+// GetenvDuration returns the duration value (e.g. "250ms") of the environment variable,
+// or the default if unset or invalid.
+func GetenvDuration(key string, defaultValue time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil {
+		return d
+	}
+	return defaultValue
+}
+
+// GetenvFloat returns the floating-point value (e.g. "0.05") of the environment variable,
+// or the default if unset or invalid.
+func GetenvFloat(key string, defaultValue float64) float64 {
+	if f, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil {
+		return f
+	}
+	return defaultValue
+}
+
+// GetenvBool returns the boolean value (e.g. "true", "1") of the environment variable,
+// or the default if unset or invalid.
+func GetenvBool(key string, defaultValue bool) bool {
+	if b, err := strconv.ParseBool(os.Getenv(key)); err == nil {
+		return b
+	}
+	return defaultValue
+}
@@ -0,0 +1,17 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// APIKeyRepository stores the API keys of clients.
+type APIKeyRepository interface {
+	// FindByID retrieves a key by its ID. It returns domain.ErrAPIKeyNotFound if there is
+	// none.
+	FindByID(ctx context.Context, id string) (*domain.APIKey, error)
+
+	// Save stores the given keys, new or changed, in one transaction.
+	Save(ctx context.Context, keys ...*domain.APIKey) error
+}
@@ -0,0 +1,24 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// AttributeSchemaRepository stores the attribute schemas that the attributes of the
+// products of a category are validated against.
+type AttributeSchemaRepository interface {
+	// Save stores the attribute schema of a category, replacing any it had.
+	Save(ctx context.Context, schema *domain.CategoryAttributeSchema) error
+
+	// Delete deletes the attribute schema of a category. It returns
+	// domain.ErrAttributeSchemaNotFound if the category has none.
+	Delete(ctx context.Context, category string) error
+
+	// Find returns the attribute schema of a category, or nil if it has none.
+	Find(ctx context.Context, category string) (*domain.CategoryAttributeSchema, error)
+
+	// List returns the attribute schemas of every category, ordered by category.
+	List(ctx context.Context) ([]*domain.CategoryAttributeSchema, error)
+}
@@ -0,0 +1,22 @@
+package contract
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// CampaignRepository defines the interface for campaign persistence operations. Like
+// ProductRepository, it returns mutations for the use case to add to a Plan.
+type CampaignRepository interface {
+	// FindByID retrieves a campaign by its ID. It returns domain.ErrCampaignNotFound if
+	// there is none.
+	FindByID(ctx context.Context, id string) (*domain.Campaign, error)
+
+	// InsertMut returns a mutation for inserting a new campaign.
+	InsertMut(campaign *domain.Campaign) *spanner.Mutation
+
+	// UpdateStatusMut returns a mutation that persists the status of a campaign.
+	UpdateStatusMut(campaign *domain.Campaign) *spanner.Mutation
+}
@@ -0,0 +1,90 @@
+package contract
+
+import (
+	"context"
+	"time"
+)
+
+// DigestEventType is the outbox event type of the daily catalog digest. Its aggregate is
+// the UTC day the digest covers, e.g. "2025-06-01".
+const DigestEventType = "catalog.digest"
+
+// DigestProductDTO is a product created during the day of a digest.
+type DigestProductDTO struct {
+	ProductID      string
+	Name           string
+	Category       string
+	Status         string
+	BasePriceNum   int64
+	BasePriceDenom int64
+	Currency       string
+	CreatedAt      time.Time
+}
+
+// PriceMoveDTO is the effective price of an active product before and after the price
+// changes recorded in its price history during the day of a digest.
+type PriceMoveDTO struct {
+	ProductID     string
+	Name          string
+	Category      string
+	Currency      string
+	OldPriceNum   int64
+	OldPriceDenom int64
+	NewPriceNum   int64
+	NewPriceDenom int64
+}
+
+// ExpiringDiscountDTO is a discount of an active product whose period ends soon.
+type ExpiringDiscountDTO struct {
+	ProductID  string
+	Name       string
+	DiscountID string
+	Percentage float64
+	EndDate    time.Time
+	// Market is empty if the discount applies to the base price.
+	Market string
+}
+
+// DigestReader reads the catalog changes a digest summarizes.
+type DigestReader interface {
+	// FindNewProducts returns the products created in [from, to) that are not archived,
+	// oldest first.
+	FindNewProducts(ctx context.Context, from, to time.Time) ([]*DigestProductDTO, error)
+
+	// FindPriceMoves returns the active products whose price history records a change in
+	// [from, to) and a price before from, with their effective prices before from and
+	// after the last change. Products created in the range have no price before it.
+	FindPriceMoves(ctx context.Context, from, to time.Time) ([]*PriceMoveDTO, error)
+
+	// FindExpiringDiscounts returns the discounts of active products that are not
+	// suspended and end in [from, to), soonest first.
+	FindExpiringDiscounts(ctx context.Context, from, to time.Time) ([]*ExpiringDiscountDTO, error)
+}
+
+// PriceDrop is a price move of a digest whose effective price fell by at least the
+// configured percentage.
+type PriceDrop struct {
+	PriceMoveDTO
+	DropPercent float64
+}
+
+// Digest summarizes the catalog changes of one UTC day, from Day up to a day later, and
+// the discounts that end during the following day. Every list is capped; the counts are
+// those before capping.
+type Digest struct {
+	Day                   time.Time
+	GeneratedAt           time.Time
+	NewProducts           []*DigestProductDTO
+	NewProductCount       int
+	PriceDrops            []*PriceDrop
+	PriceDropCount        int
+	ExpiringDiscounts     []*ExpiringDiscountDTO
+	ExpiringDiscountCount int
+}
+
+// DigestPublisher publishes catalog digests.
+type DigestPublisher interface {
+	// PublishDigest publishes the digest of a day once: publishing the same day again is
+	// a no-op that returns nil.
+	PublishDigest(ctx context.Context, digest *Digest) error
+}
@@ -0,0 +1,27 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// DiscountBlackoutRepository stores the discount blackouts during which the products of a
+// category must not be discounted.
+type DiscountBlackoutRepository interface {
+	// Schedule stores a discount blackout.
+	Schedule(ctx context.Context, blackout *domain.DiscountBlackout) error
+
+	// Cancel deletes a discount blackout. It returns domain.ErrDiscountBlackoutNotFound if
+	// there is none with the ID.
+	Cancel(ctx context.Context, id string) error
+
+	// List returns the discount blackouts that have not ended by the given time, soonest
+	// first.
+	List(ctx context.Context, at time.Time) ([]*domain.DiscountBlackout, error)
+
+	// FindOverlapping returns the discount blackouts of every category that overlap
+	// [start, end).
+	FindOverlapping(ctx context.Context, start, end time.Time) ([]*domain.DiscountBlackout, error)
+}
@@ -0,0 +1,15 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// EventPublisher delivers domain events to in-process subscribers after they have been
+// committed.
+type EventPublisher interface {
+	// Publish delivers events in order. It does not return an error; the events are
+	// already committed and published through the outbox regardless of subscribers.
+	Publish(ctx context.Context, events ...domain.DomainEvent)
+}
@@ -0,0 +1,31 @@
+package contract
+
+import (
+	"context"
+	"time"
+)
+
+// ExposureEventType is the outbox event type of experiment exposures. Their aggregate is
+// the product whose price was shown.
+const ExposureEventType = "experiment.exposure"
+
+// Exposure records that a subject was shown the price of a product in a variant of a
+// pricing experiment.
+type Exposure struct {
+	ExperimentID string
+	Variant      string
+	SubjectID    string
+	ProductID    string
+	// Surface is the query that showed the price, e.g. "get_product".
+	Surface             string
+	EffectivePriceNum   int64
+	EffectivePriceDenom int64
+	Currency            string
+	At                  time.Time
+}
+
+// ExposureRecorder records experiment exposures for analysis.
+type ExposureRecorder interface {
+	// RecordExposures stores the exposures in a single commit.
+	RecordExposures(ctx context.Context, exposures []Exposure) error
+}
@@ -0,0 +1,26 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// FreezeWindowRepository stores the freeze windows during which price and discount
+// changes are refused.
+type FreezeWindowRepository interface {
+	// Schedule stores a freeze window.
+	Schedule(ctx context.Context, window *domain.FreezeWindow) error
+
+	// Cancel deletes a freeze window. It returns domain.ErrFreezeWindowNotFound if there
+	// is none with the ID.
+	Cancel(ctx context.Context, id string) error
+
+	// List returns the freeze windows that have not ended by the given time, soonest
+	// first.
+	List(ctx context.Context, at time.Time) ([]*domain.FreezeWindow, error)
+
+	// FindActive returns the freeze windows that contain the given time.
+	FindActive(ctx context.Context, at time.Time) ([]*domain.FreezeWindow, error)
+}
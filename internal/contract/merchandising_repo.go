@@ -0,0 +1,18 @@
+package contract
+
+import "context"
+
+// MerchandisingRank is the position of a product within its category, as decided by the
+// merchandising service. Lower ranks come first; ties are ordered by product ID.
+type MerchandisingRank struct {
+	ProductID string
+	Rank      int64
+}
+
+// MerchandisingRepository stores the merchandising ranks that order ListProducts with
+// OrderByMerchandised.
+type MerchandisingRepository interface {
+	// ReplaceRanks replaces every rank of a category with the given ones in a single
+	// transaction; an empty list removes them all.
+	ReplaceRanks(ctx context.Context, category string, ranks []MerchandisingRank) error
+}
@@ -0,0 +1,27 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// NotificationSubscriptionRepository defines the interface for persisting the
+// "notify me" subscriptions of customers to a product.
+type NotificationSubscriptionRepository interface {
+	// SubscribeMut returns a mutation that subscribes a customer to notifications of the
+	// given kind for a product. Subscribing twice is a no-op.
+	SubscribeMut(productID string, kind domain.NotificationKind, subscriberID string, at time.Time) *spanner.Mutation
+
+	// UnsubscribeMut returns a mutation that removes a subscription, if it exists.
+	UnsubscribeMut(productID string, kind domain.NotificationKind, subscriberID string) *spanner.Mutation
+
+	// DeleteAllMut returns a mutation that removes every subscription of a product.
+	DeleteAllMut(productID string) *spanner.Mutation
+
+	// FindSubscribers returns the IDs of the customers subscribed to notifications of the
+	// given kind for a product, sorted.
+	FindSubscribers(ctx context.Context, productID string, kind domain.NotificationKind) ([]string, error)
+}
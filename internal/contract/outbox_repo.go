@@ -2,6 +2,10 @@
 package contract
 
 import (
+	"context"
+	"encoding/json"
+	"time"
+
 	"cloud.google.com/go/spanner"
 	"github.com/product-catalog-service/internal/domain"
 )
@@ -11,14 +15,61 @@ type OutboxEvent struct {
 	EventID     string
 	EventType   string
 	AggregateID string
-	Payload     interface{}
+	// TenantID is the tenant that owns the product the event is about; empty for the
+	// default tenant and for events not about a product.
+	TenantID string
+	Payload  interface{}
+}
+
+// StoredOutboxEvent is an outbox event as read back from the outbox table.
+type StoredOutboxEvent struct {
+	EventID     string
+	EventType   string
+	AggregateID string
+	TenantID    string
+	// Payload is the JSON payload; "{}" if none was stored.
+	Payload     json.RawMessage
+	Status      string
+	CreatedAt   time.Time
+	ProcessedAt *time.Time
 }
 
 // OutboxRepository defines the interface for outbox event persistence.
 type OutboxRepository interface {
-	// InsertMut returns a mutation for inserting an outbox event.
-	InsertMut(event *OutboxEvent) *spanner.Mutation
+	// InsertMut returns a mutation for inserting an outbox event. It fails if the
+	// payload cannot be serialized or does not match the schema of the event type.
+	InsertMut(event *OutboxEvent) (*spanner.Mutation, error)
 
 	// InsertDomainEventMut converts a domain event to an outbox event and returns a mutation.
-	InsertDomainEventMut(event domain.DomainEvent) *spanner.Mutation
+	InsertDomainEventMut(event domain.DomainEvent) (*spanner.Mutation, error)
+
+	// InsertTenantEventMut is like InsertDomainEventMut for an event about a product of
+	// the given tenant.
+	InsertTenantEventMut(tenantID string, event domain.DomainEvent) (*spanner.Mutation, error)
+
+	// InsertDomainEventWithSnapshotMut is like InsertDomainEventMut, but the payload also
+	// carries the full state of the product as of the given time.
+	InsertDomainEventWithSnapshotMut(event domain.DomainEvent, product *domain.Product, at time.Time) (*spanner.Mutation, error)
+
+	// InsertNotificationMut returns a mutation for inserting a notification event that
+	// targets the given subscribers of a product, triggered by event.
+	InsertNotificationMut(kind domain.NotificationKind, event domain.DomainEvent, product *domain.Product, subscriberIDs []string) (*spanner.Mutation, error)
+
+	// InsertSubscriptionCancelledMut returns a mutation for inserting an event that tells
+	// the given subscribers of a product that their subscriptions of kind were cancelled,
+	// triggered by event.
+	InsertSubscriptionCancelledMut(kind domain.NotificationKind, event domain.DomainEvent, product *domain.Product, subscriberIDs []string) (*spanner.Mutation, error)
+
+	// UpdateStatusMut returns a mutation that records the delivery outcome of an event.
+	UpdateStatusMut(eventID, status string, at time.Time) *spanner.Mutation
+
+	// DeleteMut returns a mutation that deletes the events with the given IDs, or nil if
+	// there are none.
+	DeleteMut(eventIDs []string) *spanner.Mutation
+
+	// FindPending returns up to limit pending events, oldest first.
+	FindPending(ctx context.Context, limit int) ([]*StoredOutboxEvent, error)
+
+	// FindByAggregateID returns all events of an aggregate in creation order.
+	FindByAggregateID(ctx context.Context, aggregateID string) ([]*StoredOutboxEvent, error)
 }
@@ -0,0 +1,49 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// PriceListRepository defines the interface for price list persistence operations. Like
+// ProductRepository, it returns mutations for the use case to add to a Plan.
+type PriceListRepository interface {
+	// FindByID retrieves a price list with its entries by its ID. It returns
+	// domain.ErrPriceListNotFound if there is none.
+	FindByID(ctx context.Context, id string) (*domain.PriceList, error)
+
+	// InsertMut returns a mutation for inserting a new price list without entries.
+	InsertMut(list *domain.PriceList) *spanner.Mutation
+
+	// EntryMuts returns the mutations that replace the stored entries of a price list
+	// with its current ones, and persist its update time.
+	EntryMuts(list *domain.PriceList) []*spanner.Mutation
+
+	// ProductEntryDeleteCheck returns a check that deletes the entries of a product from
+	// every price list, as read in the commit transaction.
+	ProductEntryDeleteCheck(productID string) committer.Check
+}
+
+// PriceListReader reads the price of a product in a price list for queries.
+type PriceListReader interface {
+	// GetPriceListEntry returns the price list with the given ID and its entry for the
+	// product, if any. It returns domain.ErrPriceListNotFound if there is no such list.
+	GetPriceListEntry(ctx context.Context, priceListID, productID string) (*PriceListEntryDTO, error)
+}
+
+// PriceListEntryDTO describes a price list and the price of one product in it.
+type PriceListEntryDTO struct {
+	PriceListID string
+	Currency    string
+	ValidFrom   time.Time
+	// ValidUntil is nil if the price list stays valid indefinitely.
+	ValidUntil *time.Time
+	// HasEntry reports whether the list prices the product; the price is zero otherwise.
+	HasEntry   bool
+	PriceNum   int64
+	PriceDenom int64
+}
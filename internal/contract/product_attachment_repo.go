@@ -0,0 +1,21 @@
+package contract
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ProductAttachmentRepository defines the interface for product attachment persistence
+// operations. Like ProductRepository, it returns mutations for the use case to add to a
+// Plan.
+type ProductAttachmentRepository interface {
+	// FindByProductID retrieves the attachments of a product in display order. It returns
+	// no attachments if the product has none; it does not check that the product exists.
+	FindByProductID(ctx context.Context, productID string) (*domain.ProductAttachments, error)
+
+	// SaveMuts returns the mutations that replace the stored attachments of a product with
+	// attachments.
+	SaveMuts(attachments *domain.ProductAttachments) []*spanner.Mutation
+}
@@ -0,0 +1,20 @@
+package contract
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ProductImageRepository defines the interface for product image persistence operations.
+// Like ProductRepository, it returns mutations for the use case to add to a Plan.
+type ProductImageRepository interface {
+	// FindByProductID retrieves the images of a product in display order. It returns no
+	// images if the product has none; it does not check that the product exists.
+	FindByProductID(ctx context.Context, productID string) (*domain.ProductImages, error)
+
+	// SaveMuts returns the mutations that replace the stored images of a product with
+	// images.
+	SaveMuts(images *domain.ProductImages) []*spanner.Mutation
+}
@@ -0,0 +1,30 @@
+package contract
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ProductRelationRepository defines the interface for product relation persistence
+// operations. Like ProductRepository, it returns mutations for the use case to add to a
+// Plan.
+type ProductRelationRepository interface {
+	// FindRelatedIDs returns the IDs of the products each of the given products links to
+	// with relationType, sorted and keyed by product ID. Products without such links
+	// have no entry.
+	FindRelatedIDs(ctx context.Context, productIDs []string, relationType domain.RelationType) (map[string][]string, error)
+
+	// InsertMut returns a mutation for inserting a new link.
+	InsertMut(relation *domain.ProductRelation) *spanner.Mutation
+
+	// DeleteMut returns a mutation deleting the link of the given type from a product to a
+	// related product, if there is one.
+	DeleteMut(productID, relatedProductID string, relationType domain.RelationType) *spanner.Mutation
+
+	// InboundDeleteCheck returns a check that deletes the links from other products to a
+	// product, as read in the commit transaction.
+	InboundDeleteCheck(productID string) committer.Check
+}
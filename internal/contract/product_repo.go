@@ -2,6 +2,7 @@ package contract
 
 import (
 	"context"
+	"time"
 
 	"cloud.google.com/go/spanner"
 	"github.com/product-catalog-service/internal/domain"
@@ -10,7 +11,8 @@ import (
 // ProductRepository defines the interface for product persistence operations.
 // Following the pattern where repositories return mutations instead of applying them.
 type ProductRepository interface {
-	// FindByID retrieves a product by its ID.
+	// FindByID retrieves a product by its ID. A product of a tenant other than that of
+	// the caller in ctx is not found; see package caller.
 	FindByID(ctx context.Context, id string) (*domain.Product, error)
 
 	// InsertMut returns a mutation for inserting a new product.
@@ -24,4 +26,102 @@ type ProductRepository interface {
 
 	// ArchiveMut returns a mutation for archiving a product.
 	ArchiveMut(product *domain.Product) *spanner.Mutation
+
+	// ArchiveDependentsMuts returns the mutations that remove what depends on a product
+	// being archived: its merchandising rank. They are added to the Plan alongside
+	// ArchiveMut. Returns nil unless the product was archived.
+	ArchiveDependentsMuts(product *domain.Product) []*spanner.Mutation
+
+	// DeleteMut returns a mutation that deletes a product permanently, together with the
+	// rows that belong to it.
+	DeleteMut(product *domain.Product) *spanner.Mutation
+
+	// DiscountMuts returns the mutations that persist the discounts of a product.
+	// They are added to the Plan alongside UpdateMut or ArchiveMut.
+	// Returns nil if the discounts did not change.
+	DiscountMuts(product *domain.Product) []*spanner.Mutation
+
+	// PriceTierMuts returns the mutations that persist the price tiers of a product.
+	// They are added to the Plan alongside UpdateMut.
+	// Returns nil if the price tiers did not change.
+	PriceTierMuts(product *domain.Product) []*spanner.Mutation
+
+	// PriceBookMuts returns the mutations that persist the price book of a product.
+	// They are added to the Plan alongside UpdateMut.
+	// Returns nil if the price book did not change.
+	PriceBookMuts(product *domain.Product) []*spanner.Mutation
+
+	// SegmentPriceMuts returns the mutations that persist the customer segment prices of
+	// a product. They are added to the Plan alongside UpdateMut.
+	// Returns nil if the segment prices did not change.
+	SegmentPriceMuts(product *domain.Product) []*spanner.Mutation
+
+	// MarketPriceMuts returns the mutations that persist the market prices of a product.
+	// They are added to the Plan alongside UpdateMut.
+	// Returns nil if the market prices did not change.
+	MarketPriceMuts(product *domain.Product) []*spanner.Mutation
+
+	// EffectivePriceMuts returns the mutations that rewrite the effective price projection
+	// of a product. They are added to the Plan alongside DiscountMuts.
+	// Returns nil if neither the base price nor the discounts changed.
+	EffectivePriceMuts(product *domain.Product) []*spanner.Mutation
+
+	// PriceHistoryMut returns a mutation that records the prices of a product at the given
+	// time in its price history. It is added to the Plan of every command that may change
+	// the base price or discounts. Returns nil if the pending events changed neither.
+	PriceHistoryMut(product *domain.Product, at time.Time) *spanner.Mutation
+
+	// RevisionMut returns a mutation that records the state of the product after the
+	// pending changes and events as a revision made at the given time. Returns nil if
+	// there are neither.
+	RevisionMut(product *domain.Product, at time.Time) *spanner.Mutation
+
+	// SummaryMut returns a mutation that rewrites the summary listings scan of a product
+	// as of the given time, or deletes it if the product is being purged. It is added to
+	// the Plan of every command that writes the product, alongside RevisionMut. Returns
+	// nil if there are neither pending changes nor pending events.
+	SummaryMut(product *domain.Product, at time.Time) *spanner.Mutation
+
+	// FindIDBySlug returns the ID of the product with the slug, of any status and of any
+	// tenant, as slugs are unique across tenants. It fails with
+	// domain.ErrProductNotFound if no product has the slug.
+	FindIDBySlug(ctx context.Context, slug string) (string, error)
+
+	// FindSlugs returns the slugs of the products whose slug is base or starts with base
+	// followed by a hyphen, in any order.
+	FindSlugs(ctx context.Context, base string) ([]string, error)
+
+	// FindIDBySKU returns the ID of the product with the SKU, of any status. It fails
+	// with domain.ErrProductNotFound if no product has the SKU.
+	FindIDBySKU(ctx context.Context, sku string) (string, error)
+
+	// FindIDByGTIN returns the ID of the product with the GTIN, of any status. It fails
+	// with domain.ErrProductNotFound if no product has the GTIN.
+	FindIDByGTIN(ctx context.Context, gtin string) (string, error)
+
+	// FindIDs returns those of the given IDs that belong to products of the caller's
+	// tenant, of any status, ordered by ID.
+	FindIDs(ctx context.Context, ids []string) ([]string, error)
+
+	// FindIDsByCategory returns the IDs of the products of the caller's tenant in a
+	// category that are not archived, ordered by ID.
+	FindIDsByCategory(ctx context.Context, category string) ([]string, error)
+
+	// FindActiveIDsByCategory returns the IDs of up to limit active products of the
+	// caller's tenant in a category with an ID after afterID, ordered by ID, to page
+	// through the category.
+	FindActiveIDsByCategory(ctx context.Context, category, afterID string, limit int) ([]string, error)
+
+	// FindIDsByDiscountID returns the IDs of the products of the caller's tenant holding
+	// a discount with the given ID, ordered by ID.
+	FindIDsByDiscountID(ctx context.Context, discountID string) ([]string, error)
+
+	// FindPurgeable returns the IDs of up to limit products archived before the given
+	// time, longest archived first.
+	FindPurgeable(ctx context.Context, archivedBefore time.Time, limit int) ([]string, error)
+
+	// FindDiscountPhaseDue returns the IDs of up to limit active products whose discount
+	// period started or ended by the given time without having been announced, i.e. for
+	// which Product.AdvanceDiscountPhase has work to do.
+	FindDiscountPhaseDue(ctx context.Context, at time.Time, limit int) ([]string, error)
 }
@@ -0,0 +1,46 @@
+package contract
+
+import "context"
+
+// Search facets: the fields ProductSearchEngine counts the matching products by.
+const (
+	FacetCategory = "category"
+	FacetTag      = "tag"
+	FacetChannel  = "channel"
+)
+
+// ProductSearchEngine searches products in a search engine kept in sync with the catalog,
+// e.g. OpenSearch, for fuzzy matching the database cannot do. It only finds the products;
+// they are read from the ProductReadModel, so the results are never stale.
+type ProductSearchEngine interface {
+	// Search finds the products in the tenant scope of ctx matching filter, most relevant
+	// first, ties ordered by product ID. pagination.OrderBy is ignored; page tokens are
+	// only valid for the search that issued them.
+	Search(ctx context.Context, filter SearchProductsFilter, pagination Pagination) (*SearchResult, error)
+}
+
+// SearchResult represents a page of products found by a ProductSearchEngine.
+type SearchResult struct {
+	// ProductIDs are the IDs of the products of the page, most relevant first.
+	ProductIDs    []string
+	NextPageToken string
+	// TotalCount is the number of products matching the search.
+	TotalCount int64
+	// Facets count the products matching the search by FacetCategory, FacetTag and
+	// FacetChannel, in that order.
+	Facets []FacetDTO
+}
+
+// FacetDTO counts the products matching a search by the values of a field.
+type FacetDTO struct {
+	// Field is one of the Facet constants.
+	Field string
+	// Values are the most common values, most common first.
+	Values []FacetValueDTO
+}
+
+// FacetValueDTO is the number of products matching a search with a value of a field.
+type FacetValueDTO struct {
+	Value string
+	Count int64
+}
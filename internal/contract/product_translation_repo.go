@@ -0,0 +1,25 @@
+package contract
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ProductTranslationRepository defines the interface for product translation persistence
+// operations. Like ProductRepository, it returns mutations for the use case to add to a
+// Plan.
+type ProductTranslationRepository interface {
+	// FindByLocale retrieves the translation of a product in a locale. It returns nil if
+	// the product has no translation in the locale; it does not check that the product
+	// exists.
+	FindByLocale(ctx context.Context, productID, locale string) (*domain.ProductTranslation, error)
+
+	// SaveMut returns the mutation that stores a translation, replacing the translation of
+	// the product in its locale if any.
+	SaveMut(translation *domain.ProductTranslation) *spanner.Mutation
+
+	// DeleteMut returns the mutation that removes the translation of a product in a locale.
+	DeleteMut(productID, locale string) *spanner.Mutation
+}
@@ -0,0 +1,32 @@
+package contract
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ProductVariantRepository defines the interface for product variant persistence
+// operations. Like ProductRepository, it returns mutations for the use case to add to a
+// Plan.
+type ProductVariantRepository interface {
+	// FindByID retrieves a variant of a product by its ID. It returns
+	// domain.ErrVariantNotFound if the product has no such variant.
+	FindByID(ctx context.Context, productID, variantID string) (*domain.ProductVariant, error)
+
+	// FindByProductID retrieves the variants of a product, ordered by variant ID; none
+	// if the product does not exist.
+	FindByProductID(ctx context.Context, productID string) ([]*domain.ProductVariant, error)
+
+	// FindIDBySKU returns the ID of the variant with the given SKU, of any product. It
+	// returns domain.ErrVariantNotFound if there is none.
+	FindIDBySKU(ctx context.Context, sku string) (string, error)
+
+	// InsertMut returns a mutation for inserting a new variant.
+	InsertMut(variant *domain.ProductVariant) *spanner.Mutation
+
+	// UpdateMut returns a mutation persisting the SKU, attributes, price delta, status and
+	// update time of a variant.
+	UpdateMut(variant *domain.ProductVariant) *spanner.Mutation
+}
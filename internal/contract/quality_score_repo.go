@@ -0,0 +1,50 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ProductQualityDTO is the quality score of a product as stored for the admin listings.
+type ProductQualityDTO struct {
+	ProductID string
+	// TenantID is empty for the default tenant.
+	TenantID string
+	Name     string
+	Category string
+	Score    domain.QualityScore
+	ScoredAt time.Time
+}
+
+// QualityListFilter selects the quality scores listed, in overall score order and then by
+// product ID. Empty fields select every product.
+type QualityListFilter struct {
+	TenantID *string
+	Category string
+	// Descending lists the best scores first instead of the worst.
+	Descending bool
+	// After lists the scores after that of the given score and product ID, in list order,
+	// to page through the scores.
+	After *QualityCursor
+	Limit int
+}
+
+// QualityCursor is the position of a quality score in a listing.
+type QualityCursor struct {
+	Overall   int
+	ProductID string
+}
+
+// QualityScoreRepository stores the quality scores of products.
+type QualityScoreRepository interface {
+	// Save stores the quality score of a product, replacing any it had.
+	Save(ctx context.Context, quality *ProductQualityDTO) error
+
+	// Delete deletes the quality score of a product, if it has one.
+	Delete(ctx context.Context, productID string) error
+
+	// List returns up to filter.Limit of the quality scores filter selects, in its order.
+	List(ctx context.Context, filter QualityListFilter) ([]*ProductQualityDTO, error)
+}
@@ -0,0 +1,17 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// RatingRepository stores the ratings customer reviews give products, and the summary of
+// the ratings of each product that product reads return and ListProducts sorts by.
+type RatingRepository interface {
+	// Ingest records the rating a review gives a product, replacing the rating the review
+	// gave before, and updates the summary of the product in the same transaction. It
+	// returns the summary; it fails with domain.ErrProductNotFound if no product has the
+	// ID.
+	Ingest(ctx context.Context, productID, reviewID string, rating int64) (domain.RatingSummary, error)
+}
@@ -2,6 +2,9 @@ package contract
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
 	"time"
 )
 
@@ -9,19 +12,327 @@ import (
 type ProductDTO struct {
 	ID                 string
 	Name               string
+	// Slug is the URL slug of the product; empty if it has none.
+	Slug               string
+	// SKU and GTIN identify the product in warehouses and at checkouts; empty if unset.
+	SKU                string
+	GTIN               string
 	Description        string
 	Category           string
 	BasePriceNum       int64
 	BasePriceDenom     int64
+	// Currency is the ISO 4217 code of every price of the product.
+	Currency           string
+	// TaxClass names the tax treatment of the product, e.g. "standard".
+	TaxClass           string
 	DiscountPercent    *float64
 	DiscountStartDate  *time.Time
 	DiscountEndDate    *time.Time
+	DiscountSuspended  bool
 	EffectivePriceNum  int64
 	EffectivePriceDenom int64
 	Status             string
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 	HasActiveDiscount  bool
+	// Discounts lists every discount of the product, ordered by start date. The
+	// Discount* fields above describe the one that applies, or else the one running or
+	// starting next.
+	Discounts []DiscountDTO
+	// PriceTiers lists the volume price tiers of the product, ordered by minimum
+	// quantity. Only GetProduct fills it.
+	PriceTiers []PriceTierDTO
+	// PriceBook lists the base prices of the product in other currencies, ordered by
+	// currency. Discounts apply to them as to the base price.
+	PriceBook []PriceBookEntryDTO
+	// SegmentPrices lists the customer segment prices of the product, ordered by segment.
+	// Only GetProduct fills it.
+	SegmentPrices []SegmentPriceDTO
+	// MarketPrices lists the prices of the product in the markets it has a price for,
+	// ordered by market.
+	MarketPrices []MarketPriceDTO
+	// Variants lists the variants of the product, ordered by ID. Only GetProduct fills
+	// it.
+	Variants []VariantDTO
+	// MinimumPriceNum and MinimumPriceDenom are the price floor of the product in
+	// Currency; both are zero if it has none or its prices are in another currency or
+	// market.
+	MinimumPriceNum   int64
+	MinimumPriceDenom int64
+	// CostPriceNum and CostPriceDenom are what the product costs the merchant in
+	// Currency; both are zero if it is not recorded or its prices are in another
+	// currency or market.
+	CostPriceNum   int64
+	CostPriceDenom int64
+	// PendingPriceNum and PendingPriceDenom are the base price the product changes to at
+	// PendingPriceEffectiveAt, in Currency; PendingPriceEffectiveAt is nil if no change is
+	// scheduled or the prices are in another currency or market.
+	PendingPriceNum         int64
+	PendingPriceDenom       int64
+	PendingPriceEffectiveAt *time.Time
+	// ActivateAt and DeactivateAt are when the product is scheduled to be activated and
+	// deactivated; nil if no such change is scheduled.
+	ActivateAt   *time.Time
+	DeactivateAt *time.Time
+	// Review is the latest review of the product; nil if it was never submitted for
+	// review.
+	Review *ReviewDTO
+	// Kind is "physical" or "digital"; empty if unset.
+	Kind string
+	// Compliance holds the regulatory flags of the product; nil if it has none.
+	Compliance *ComplianceDTO
+	// Weight and Dimensions are what the product weighs and measures packed for
+	// shipping; nil if unset.
+	Weight     *WeightDTO
+	Dimensions *DimensionsDTO
+	// RatingCount is the number of customer ratings of the product and RatingAverage
+	// their average; both are zero if it has none.
+	RatingCount   int64
+	RatingAverage float64
+	// Channels are the sales channels the product is available on, in name order.
+	Channels []string
+	// InStock reports whether units of the product are available, or its stock is not
+	// tracked. StockLevel, StockReserved and StockVersion are zero unless StockTracked.
+	InStock       bool
+	StockTracked  bool
+	StockLevel    int64
+	StockReserved int64
+	StockVersion  int64
+	// Tags are the tags of the product, sorted; Attributes are its attributes.
+	Tags       []string
+	Attributes map[string]string
+	// Images lists the images of the product in display order.
+	Images []ImageDTO
+	// Attachments lists the support documents of the product in display order. They are
+	// read by GetProduct only.
+	Attachments []AttachmentDTO
+	// Translations lists the name and description of the product in the locales other
+	// than the default locale it has them in, ordered by locale.
+	Translations []TranslationDTO
+	// CachedAt is set when the product is served from a cache while the database is
+	// unavailable; the product may have changed since.
+	CachedAt *time.Time
+}
+
+// ReviewDTO represents the latest review of a product. ReviewedBy is empty while the
+// review is pending; RejectionReason is empty unless the product was rejected.
+type ReviewDTO struct {
+	SubmittedBy     string
+	ReviewedBy      string
+	RejectionReason string
+}
+
+// ComplianceDTO represents the compliance flags of a product. MinimumAge is zero if the
+// product is not age-restricted; RestrictedMarkets are sorted market codes.
+type ComplianceDTO struct {
+	MinimumAge        int
+	Hazardous         bool
+	RestrictedMarkets []string
+}
+
+// WeightDTO represents the weight of a product in the unit it was given in, and in grams.
+type WeightDTO struct {
+	Value float64
+	Unit  string
+	Grams float64
+}
+
+// DimensionsDTO represents the dimensions of a product in the unit they were given in,
+// and in millimetres.
+type DimensionsDTO struct {
+	Height   float64
+	Width    float64
+	Depth    float64
+	Unit     string
+	HeightMM float64
+	WidthMM  float64
+	DepthMM  float64
+}
+
+// PriceBookEntryDTO represents the base price of a product in another currency.
+type PriceBookEntryDTO struct {
+	Currency   string
+	PriceNum   int64
+	PriceDenom int64
+}
+
+// SegmentPriceDTO represents the price of a product for one customer segment. It has
+// either a fixed price or a percent off; the other is zero.
+type SegmentPriceDTO struct {
+	Segment    string
+	PriceNum   int64
+	PriceDenom int64
+	PercentOff float64
+}
+
+// MarketPriceDTO represents the prices of a product in one market, in the market's
+// currency. The discount fields are those of the product for the market: discounts
+// scoped to the market and those applying in every market.
+type MarketPriceDTO struct {
+	Market              string
+	Currency            string
+	PriceNum            int64
+	PriceDenom          int64
+	EffectivePriceNum   int64
+	EffectivePriceDenom int64
+	DiscountPercent     *float64
+	DiscountStartDate   *time.Time
+	DiscountEndDate     *time.Time
+	DiscountSuspended   bool
+	HasActiveDiscount   bool
+}
+
+// VariantDTO represents one variant of a product. Its prices are the base and effective
+// prices of the product plus the price delta, in the product's currency; discounts apply
+// to them in proportion.
+type VariantDTO struct {
+	ID                  string
+	SKU                 string
+	Attributes          map[string]string
+	PriceDeltaNum       int64
+	PriceDeltaDenom     int64
+	PriceNum            int64
+	PriceDenom          int64
+	EffectivePriceNum   int64
+	EffectivePriceDenom int64
+	Status              string
+}
+
+// RelatedProductDTO represents a product linked to from another product, with the type
+// of the link.
+type RelatedProductDTO struct {
+	RelationType string
+	Product      *ProductDTO
+}
+
+// ImageDTO represents one image of a product.
+type ImageDTO struct {
+	URL     string
+	AltText string
+	Primary bool
+}
+
+// AttachmentDTO represents one support document of a product, e.g. its manual.
+type AttachmentDTO struct {
+	Type   string
+	URL    string
+	Locale string
+}
+
+// TranslationDTO represents the name and description of a product in one locale.
+type TranslationDTO struct {
+	Locale      string
+	Name        string
+	Description string
+}
+
+// DiscountDTO represents one discount of a product for read operations.
+type DiscountDTO struct {
+	ID        string
+	Percent   float64
+	Priority  int
+	StartDate time.Time
+	EndDate   time.Time
+	Suspended bool
+	// Kind is "percentage" or "buy_x_get_y". BuyQuantity and GetQuantity are set for
+	// buy-X-get-Y promotions only, whose Percent is 100.
+	Kind        string
+	BuyQuantity int
+	GetQuantity int
+	// Market is the market the discount is scoped to; empty if it applies in every
+	// market.
+	Market string
+	// PendingApproval is set while the discount awaits approval and does not apply.
+	// ApprovedBy is who approved a discount that needed approval.
+	PendingApproval bool
+	ApprovedBy      string
+	// FlashSale is set if the discount is a flash sale.
+	FlashSale bool
+	// SalePriceNum and SalePriceDenom are set for a discount expressed as a sale price, in
+	// the currency of the product; Percent is then the implied percentage.
+	SalePriceNum   int64
+	SalePriceDenom int64
+}
+
+// PriceTierDTO represents one price tier of a product for read operations. A tier has
+// either a unit price or a percent off; the other is zero.
+type PriceTierDTO struct {
+	MinQuantity    int64
+	UnitPriceNum   int64
+	UnitPriceDenom int64
+	PercentOff     float64
+}
+
+// QuantityPriceDTO is the price of buying a quantity of a product.
+type QuantityPriceDTO struct {
+	ProductID       string
+	Quantity        int64
+	BasePriceNum    int64
+	BasePriceDenom  int64
+	UnitPriceNum    int64
+	UnitPriceDenom  int64
+	TotalPriceNum   int64
+	TotalPriceDenom int64
+	// TierMinQuantity is the minimum quantity of the tier applied, or 0 if none applied.
+	TierMinQuantity int64
+	// DiscountPercent is set only if a discount applies at the pricing time.
+	DiscountPercent *float64
+	Currency        string
+	Status          string
+}
+
+// PriceDTO is the minimal projection of a product needed to price it.
+type PriceDTO struct {
+	ProductID           string
+	BasePriceNum        int64
+	BasePriceDenom      int64
+	EffectivePriceNum   int64
+	EffectivePriceDenom int64
+	DiscountPercent     *float64
+	HasActiveDiscount   bool
+	Currency            string
+	Status              string
+	// Category is used to select the pricing experiments of the product.
+	Category string
+	TaxClass string
+	// CostPriceNum and CostPriceDenom are the cost price of the product in Currency;
+	// both are zero if it is not recorded.
+	CostPriceNum   int64
+	CostPriceDenom int64
+}
+
+// PriceHistoryEntryDTO represents the prices of a product right after a change of its
+// base price or discounts.
+type PriceHistoryEntryDTO struct {
+	ChangedAt time.Time
+	ChangeID  string
+	// ChangeType is the type of the event that changed the price, e.g.
+	// "product.discount_applied".
+	ChangeType          string
+	BasePriceNum        int64
+	BasePriceDenom      int64
+	EffectivePriceNum   int64
+	EffectivePriceDenom int64
+	Currency            string
+	// DiscountID and DiscountPercent describe the discount that applied after the
+	// change; they are empty if none did.
+	DiscountID      string
+	DiscountPercent *float64
+}
+
+// ProductRevisionDTO represents the state of a product right after a command changed it.
+type ProductRevisionDTO struct {
+	RevisionID string
+	RevisedAt  time.Time
+	// ChangedFields are the fields of the product the command changed, sorted; empty
+	// for a command that only raised events, such as a creation.
+	ChangedFields []string
+	// Snapshot is the full state of the product after the command, as in the snapshot
+	// of outbox events.
+	Snapshot json.RawMessage
+	// PreviousSnapshot is the snapshot of the revision before, nil for the first
+	// revision of the product.
+	PreviousSnapshot json.RawMessage
 }
 
 // ListProductsFilter defines filters for listing products.
@@ -29,19 +340,114 @@ type ListProductsFilter struct {
 	Category   string
 	Status     string
 	ActiveOnly bool
+	// IncludeArchived lists archived products too; without it, they are only listed when
+	// Status is archived.
+	IncludeArchived bool
+	// InStockOnly leaves out products whose stock is tracked and has no available units.
+	InStockOnly bool
+	// HasActiveDiscount lists only the products with a discount that applies to their
+	// base price at the pricing time.
+	HasActiveDiscount bool
+	// Tag lists only the products with the tag; empty lists products with any tags.
+	Tag string
+	// Channel lists only the products available on the sales channel; empty lists
+	// products on any channel.
+	Channel string
+	// MinPrice and MaxPrice list only the products whose effective price at the pricing
+	// time, before any market or currency conversion, is at least or at most the bound;
+	// nil does not bound it. Products priced in another currency than the bounds are left
+	// out. Both bounds are in the same currency.
+	MinPrice *PriceBound
+	MaxPrice *PriceBound
 }
 
+// PriceBound is a bound of a price range: an exact amount in a currency.
+type PriceBound struct {
+	Numerator   int64
+	Denominator int64
+	Currency    string
+}
+
+// String returns the bound as "numerator/denominator currency", or "" for nil.
+func (b *PriceBound) String() string {
+	if b == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d %s", b.Numerator, b.Denominator, b.Currency)
+}
+
+// SearchProductsFilter defines the text and filters of a product search.
+type SearchProductsFilter struct {
+	// Query is a full-text search query, e.g. "oak chair", matched against the names and
+	// descriptions of products.
+	Query string
+	// Category searches only the products of the category; empty searches all.
+	Category string
+	// ActiveOnly searches only active products.
+	ActiveOnly bool
+	// Tag searches only the products with the tag; empty searches products with any tags.
+	Tag string
+	// Channel searches only the products available on the sales channel; empty searches
+	// products on any channel.
+	Channel string
+}
+
+// List orderings. OrderByProductID is the default.
+const (
+	OrderByProductID = "product_id"
+	// OrderByMerchandised orders the products of each category by their merchandising
+	// rank, then the unranked ones by product ID.
+	OrderByMerchandised = "merchandised"
+	// OrderByRating orders products by their average rating, highest first, then the
+	// unrated ones, ties ordered by product ID.
+	OrderByRating = "rating"
+	// OrderByName and the orderings below order products by a column, ascending or,
+	// with a " desc" suffix, descending, ties ordered by product ID. OrderByPrice orders
+	// them by their effective price at the pricing time, before market and currency
+	// conversion, whatever their currency.
+	OrderByName          = "name"
+	OrderByNameDesc      = "name desc"
+	OrderByCreatedAt     = "created_at"
+	OrderByCreatedAtDesc = "created_at desc"
+	OrderByUpdatedAt     = "updated_at"
+	OrderByUpdatedAtDesc = "updated_at desc"
+	OrderByPrice         = "price"
+	OrderByPriceDesc     = "price desc"
+)
+
 // Pagination defines pagination parameters.
 type Pagination struct {
 	PageSize  int32
 	PageToken string
+	// OrderBy is one of the OrderBy constants; empty means OrderByProductID. Page tokens
+	// are only valid with the ordering that issued them.
+	OrderBy string
+	// IncludeTotalCount also counts the products passing the filter on all pages.
+	IncludeTotalCount bool
+	// PageNumber, if positive, lists the page with that 1-based number by offset, ignoring
+	// PageToken; the result has no NextPageToken.
+	PageNumber int32
 }
 
 // ListProductsResult represents the result of listing products.
 type ListProductsResult struct {
 	Products      []*ProductDTO
 	NextPageToken string
-	TotalCount    int64
+	// TotalCount is the number of products passing the filter on all pages; 0 unless
+	// Pagination.IncludeTotalCount is set.
+	TotalCount int64
+	// CachedAt is set when the result is served from a cache while the database is
+	// unavailable; the products may have changed since.
+	CachedAt *time.Time
+}
+
+// EffectivePriceReader prices products at a given time. ProductReadModel computes the
+// prices from the discounts of the products; other implementations may read them from a
+// projection.
+type EffectivePriceReader interface {
+	// GetEffectivePrices prices the products with the given IDs at the given time.
+	// Products that cannot be priced are omitted; the order is unspecified.
+	GetEffectivePrices(ctx context.Context, ids []string, at time.Time) ([]*PriceDTO, error)
 }
 
 // ProductReadModel defines the interface for product read operations (queries).
@@ -50,12 +456,66 @@ type ProductReadModel interface {
 	// GetProduct retrieves a product by ID with its current effective price.
 	GetProduct(ctx context.Context, id string, at time.Time) (*ProductDTO, error)
 
+	// BatchGetProducts retrieves the products with the given IDs, each as GetProduct does,
+	// in a single read. Products that do not exist are omitted; the order is unspecified.
+	BatchGetProducts(ctx context.Context, ids []string, at time.Time) ([]*ProductDTO, error)
+
+	// FindProductIDBySlug returns the ID of the product with the slug. It fails with
+	// domain.ErrProductNotFound if no product has the slug.
+	FindProductIDBySlug(ctx context.Context, slug string) (string, error)
+
+	// FindProductIDBySKU returns the ID of the product with the SKU. It fails with
+	// domain.ErrProductNotFound if no product has the SKU.
+	FindProductIDBySKU(ctx context.Context, sku string) (string, error)
+
 	// ListProducts lists products with optional filters and pagination.
 	ListProducts(ctx context.Context, filter ListProductsFilter, pagination Pagination, at time.Time) (*ListProductsResult, error)
 
+	// AllProducts returns an iterator over all the products ListProducts lists with
+	// filter, in product ID order, paging through them internally. The iteration stops at
+	// the first error, which it yields.
+	AllProducts(ctx context.Context, filter ListProductsFilter, at time.Time) iter.Seq2[*ProductDTO, error]
+
+	// SearchProducts searches products that are not archived by their names and
+	// descriptions, most relevant first, ties ordered by product ID. pagination.OrderBy is
+	// ignored; page tokens are only valid for the query that issued them.
+	SearchProducts(ctx context.Context, filter SearchProductsFilter, pagination Pagination, at time.Time) (*ListProductsResult, error)
+
+	// ListProductsByID returns the products with the given IDs that are not archived, as
+	// ListProducts does, in the order of ids. IDs without such a product are skipped.
+	ListProductsByID(ctx context.Context, ids []string, at time.Time) ([]*ProductDTO, error)
+
 	// ListByCategory lists products in a specific category.
 	ListByCategory(ctx context.Context, category string, pagination Pagination, at time.Time) (*ListProductsResult, error)
 
 	// CountByCategory returns the count of active products in a category.
 	CountByCategory(ctx context.Context, category string) (int64, error)
+
+	// GetEffectivePrices prices the products with the given IDs at the given time in a
+	// single read. Products that do not exist are omitted; the order is unspecified.
+	GetEffectivePrices(ctx context.Context, ids []string, at time.Time) ([]*PriceDTO, error)
+
+	// GetPriceForQuantity prices quantity units of a product at the given time, applying
+	// the price tier for the quantity and the discount that applies at that time.
+	GetPriceForQuantity(ctx context.Context, id string, quantity int64, at time.Time) (*QuantityPriceDTO, error)
+
+	// GetPriceHistory returns the price history entries of a product changed in
+	// [from, to), oldest first. A zero from or to leaves that end of the range open.
+	GetPriceHistory(ctx context.Context, id string, from, to time.Time) ([]*PriceHistoryEntryDTO, error)
+
+	// GetProductRevisions returns the revisions of a product made in [from, to), oldest
+	// first. A zero from or to leaves that end of the range open. It fails with
+	// domain.ErrProductNotFound if the product does not exist.
+	GetProductRevisions(ctx context.Context, id string, from, to time.Time) ([]*ProductRevisionDTO, error)
+
+	// GetProductRevision returns a revision of a product. It fails with
+	// domain.ErrProductNotFound if the product does not exist and with
+	// domain.ErrRevisionNotFound if it has no such revision.
+	GetProductRevision(ctx context.Context, id, revisionID string) (*ProductRevisionDTO, error)
+
+	// GetRelatedProducts returns the products a product links to that are not archived,
+	// priced at the given time, ordered by relation type and product ID. A non-empty
+	// relationType returns only the links of that type. It fails with
+	// domain.ErrProductNotFound if the product does not exist.
+	GetRelatedProducts(ctx context.Context, id string, relationType string, at time.Time) ([]*RelatedProductDTO, error)
 }
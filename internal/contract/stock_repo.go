@@ -0,0 +1,26 @@
+package contract
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// StockRepository defines the interface for product stock persistence operations. Like
+// ProductRepository, it returns mutations for the use case to add to a Plan, along with
+// the check that makes the change optimistic.
+type StockRepository interface {
+	// FindByProductID retrieves the stock of a product. It returns untracked stock at
+	// version 0 if the stock of the product was never set; it does not check that the
+	// product exists.
+	FindByProductID(ctx context.Context, productID string) (*domain.Stock, error)
+
+	// SaveMut returns a mutation persisting the stock, inserting it if it was not tracked.
+	SaveMut(stock *domain.Stock) *spanner.Mutation
+
+	// VersionCheck returns a check that fails the commit with domain.ErrStockConflict
+	// unless the stored stock of the product is still at version, 0 meaning untracked.
+	VersionCheck(productID string, version int64) committer.Check
+}
@@ -0,0 +1,118 @@
+// Package diagnostics serves profiling data and runtime statistics for investigating
+// production latency.
+//
+// The handler exposes net/http/pprof, the expvar variables and a runtime summary that
+// includes the Spanner session pool. It has no authentication, so it must only be served on
+// an internal port; the server binds it to localhost unless configured otherwise.
+package diagnostics
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// Handler serves the diagnostics endpoints.
+type Handler struct {
+	sessions *SessionStats
+	started  time.Time
+	mux      *http.ServeMux
+}
+
+// NewHandler creates a diagnostics handler. sessions may be nil, in which case the runtime
+// summary has no Spanner session pool statistics.
+func NewHandler(sessions *SessionStats) *Handler {
+	h := &Handler{
+		sessions: sessions,
+		started:  time.Now(),
+		mux:      http.NewServeMux(),
+	}
+	h.mux.HandleFunc("/debug/pprof/", pprof.Index)
+	h.mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	h.mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	h.mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	h.mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	h.mux.Handle("GET /debug/vars", expvar.Handler())
+	h.mux.HandleFunc("GET /debug/runtime", h.getRuntime)
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// RuntimeStats is the runtime summary served at /debug/runtime.
+type RuntimeStats struct {
+	GoVersion       string            `json:"go_version"`
+	NumCPU          int               `json:"num_cpu"`
+	GOMAXPROCS      int               `json:"gomaxprocs"`
+	Goroutines      int               `json:"goroutines"`
+	UptimeSeconds   float64           `json:"uptime_seconds"`
+	Heap            HeapStats         `json:"heap"`
+	GC              GCStats           `json:"gc"`
+	SpannerSessions *SessionPoolStats `json:"spanner_sessions,omitempty"`
+}
+
+// HeapStats summarizes the heap of the process.
+type HeapStats struct {
+	AllocBytes  uint64 `json:"alloc_bytes"`
+	InuseBytes  uint64 `json:"inuse_bytes"`
+	SysBytes    uint64 `json:"sys_bytes"`
+	Objects     uint64 `json:"objects"`
+	NextGCBytes uint64 `json:"next_gc_bytes"`
+}
+
+// GCStats summarizes garbage collection since the process started.
+type GCStats struct {
+	NumGC        uint32     `json:"num_gc"`
+	PauseTotalMs float64    `json:"pause_total_ms"`
+	LastGC       *time.Time `json:"last_gc,omitempty"`
+}
+
+// ReadRuntimeStats collects the runtime summary. Reading memory statistics briefly stops
+// the world, so it is only done on request.
+func (h *Handler) ReadRuntimeStats() RuntimeStats {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	stats := RuntimeStats{
+		GoVersion:     runtime.Version(),
+		NumCPU:        runtime.NumCPU(),
+		GOMAXPROCS:    runtime.GOMAXPROCS(0),
+		Goroutines:    runtime.NumGoroutine(),
+		UptimeSeconds: time.Since(h.started).Seconds(),
+		Heap: HeapStats{
+			AllocBytes:  mem.HeapAlloc,
+			InuseBytes:  mem.HeapInuse,
+			SysBytes:    mem.HeapSys,
+			Objects:     mem.HeapObjects,
+			NextGCBytes: mem.NextGC,
+		},
+		GC: GCStats{
+			NumGC:        mem.NumGC,
+			PauseTotalMs: float64(mem.PauseTotalNs) / float64(time.Millisecond),
+		},
+	}
+	if mem.LastGC != 0 {
+		last := time.Unix(0, int64(mem.LastGC)).UTC()
+		stats.GC.LastGC = &last
+	}
+	if h.sessions != nil {
+		sessions := h.sessions.Snapshot()
+		stats.SpannerSessions = &sessions
+	}
+	return stats
+}
+
+func (h *Handler) getRuntime(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(h.ReadRuntimeStats()); err != nil {
+		logging.Warnf("diagnostics: failed to write response: %v", err)
+	}
+}
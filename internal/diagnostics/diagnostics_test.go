@@ -0,0 +1,112 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+)
+
+func serve(t *testing.T, h *Handler, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_Endpoints(t *testing.T) {
+	h := NewHandler(nil)
+
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+	}{
+		{name: "pprof index", path: "/debug/pprof/", contentType: "text/html"},
+		{name: "goroutine profile", path: "/debug/pprof/goroutine?debug=1", contentType: "text/plain"},
+		{name: "expvar", path: "/debug/vars", contentType: "application/json"},
+		{name: "runtime", path: "/debug/runtime", contentType: "application/json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(t, h, tt.path)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Header().Get("Content-Type"), tt.contentType)
+		})
+	}
+
+	assert.Equal(t, http.StatusNotFound, serve(t, h, "/v1/products").Code)
+}
+
+func TestHandler_Runtime(t *testing.T) {
+	rec := serve(t, NewHandler(nil), "/debug/runtime")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var got RuntimeStats
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	assert.NotEmpty(t, got.GoVersion)
+	assert.Positive(t, got.Goroutines)
+	assert.Positive(t, got.Heap.SysBytes)
+	assert.Nil(t, got.SpannerSessions)
+
+	sessions := &SessionStats{}
+	rec = serve(t, NewHandler(sessions), "/debug/runtime")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.NotNil(t, got.SpannerSessions)
+	assert.Nil(t, got.SpannerSessions.UpdatedAt)
+}
+
+func TestSessionStats_ExportView(t *testing.T) {
+	typeKey := tag.MustNewKey(sessionTypeTag)
+	clientKey := tag.MustNewKey("client_id")
+	lastValue := func(v float64) view.AggregationData { return &view.LastValueData{Value: v} }
+	end := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	s := &SessionStats{}
+	s.ExportView(&view.Data{
+		View: &view.View{Name: viewOpenSessions, Measure: stats.Int64(viewOpenSessions, "", "")},
+		Rows: []*view.Row{
+			{Tags: []tag.Tag{{Key: clientKey, Value: "client-1"}}, Data: lastValue(25)},
+			{Tags: []tag.Tag{{Key: clientKey, Value: "client-2"}}, Data: lastValue(10)},
+		},
+		End: end,
+	})
+	s.ExportView(&view.Data{
+		View: &view.View{Name: viewSessionsInPool, Measure: stats.Int64(viewSessionsInPool, "", "")},
+		Rows: []*view.Row{
+			{Tags: []tag.Tag{{Key: typeKey, Value: "num_in_use_sessions"}}, Data: lastValue(4)},
+			{Tags: []tag.Tag{{Key: typeKey, Value: "num_sessions"}}, Data: lastValue(21)},
+		},
+		End: end,
+	})
+	s.ExportView(&view.Data{
+		View: &view.View{Name: viewAcquiredSessions, Measure: stats.Int64(viewAcquiredSessions, "", "")},
+		Rows: []*view.Row{{Data: &view.CountData{Value: 120}}},
+		End:  end,
+	})
+
+	got := s.Snapshot()
+	assert.Equal(t, int64(35), got.Open)
+	assert.Equal(t, int64(4), got.InUse)
+	assert.Equal(t, int64(21), got.Idle)
+	assert.Equal(t, int64(120), got.Acquired)
+	require.NotNil(t, got.UpdatedAt)
+	assert.Equal(t, end, *got.UpdatedAt)
+
+	// Views of other libraries are ignored
+	s.ExportView(&view.Data{
+		View: &view.View{Name: "grpc.io/client/roundtrip_latency"},
+		Rows: []*view.Row{{Data: lastValue(99)}},
+		End:  end.Add(time.Minute),
+	})
+	assert.Equal(t, end, *s.Snapshot().UpdatedAt)
+}
@@ -0,0 +1,121 @@
+package diagnostics
+
+import (
+	"sync"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"go.opencensus.io/stats/view"
+)
+
+// The Spanner client reports its session pool through OpenCensus views; these are their names.
+const (
+	viewPrefix           = "cloud.google.com/go/spanner/"
+	viewOpenSessions     = viewPrefix + "open_session_count"
+	viewMaxAllowed       = viewPrefix + "max_allowed_sessions"
+	viewSessionsInPool   = viewPrefix + "num_sessions_in_pool"
+	viewMaxInUse         = viewPrefix + "max_in_use_sessions"
+	viewGetTimeouts      = viewPrefix + "get_session_timeouts"
+	viewAcquiredSessions = viewPrefix + "num_acquired_sessions"
+	viewReleasedSessions = viewPrefix + "num_released_sessions"
+
+	// sessionTypeTag distinguishes in-use from idle sessions in num_sessions_in_pool.
+	sessionTypeTag = "type"
+)
+
+// SessionPoolStats are the Spanner session pool statistics, summed over all clients.
+type SessionPoolStats struct {
+	Open        int64 `json:"open"`
+	MaxAllowed  int64 `json:"max_allowed"`
+	InUse       int64 `json:"in_use"`
+	Idle        int64 `json:"idle"`
+	MaxInUse    int64 `json:"max_in_use"`
+	Acquired    int64 `json:"acquired"`
+	Released    int64 `json:"released"`
+	GetTimeouts int64 `json:"get_timeouts"`
+	// UpdatedAt is when the client last reported; the statistics are zero before that.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// SessionStats keeps the latest Spanner session pool statistics reported by the client.
+type SessionStats struct {
+	mu    sync.Mutex
+	stats SessionPoolStats
+}
+
+// EnableSessionStats turns on the session pool statistics of the Spanner client and starts
+// collecting them every reportingPeriod. It must be called before the client is created.
+func EnableSessionStats(reportingPeriod time.Duration) (*SessionStats, error) {
+	//nolint:staticcheck // the OpenCensus views are the only session pool stats v1.57 reports without an OpenTelemetry SDK
+	if err := spanner.EnableStatViews(); err != nil {
+		return nil, err
+	}
+	s := &SessionStats{}
+	view.SetReportingPeriod(reportingPeriod)
+	view.RegisterExporter(s)
+	return s, nil
+}
+
+// Snapshot returns the latest statistics.
+func (s *SessionStats) Snapshot() SessionPoolStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+// ExportView implements view.Exporter. Each view reports one row per client (and per
+// session type for num_sessions_in_pool); rows are summed.
+func (s *SessionStats) ExportView(data *view.Data) {
+	var total, inUse, idle int64
+	for _, row := range data.Rows {
+		value := rowValue(row)
+		total += value
+		for _, tag := range row.Tags {
+			if tag.Key.Name() != sessionTypeTag {
+				continue
+			}
+			switch tag.Value {
+			case "num_in_use_sessions":
+				inUse += value
+			case "num_sessions":
+				idle += value
+			}
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch data.View.Name {
+	case viewOpenSessions:
+		s.stats.Open = total
+	case viewMaxAllowed:
+		s.stats.MaxAllowed = total
+	case viewSessionsInPool:
+		s.stats.InUse = inUse
+		s.stats.Idle = idle
+	case viewMaxInUse:
+		s.stats.MaxInUse = total
+	case viewGetTimeouts:
+		s.stats.GetTimeouts = total
+	case viewAcquiredSessions:
+		s.stats.Acquired = total
+	case viewReleasedSessions:
+		s.stats.Released = total
+	default:
+		return
+	}
+	updatedAt := data.End
+	s.stats.UpdatedAt = &updatedAt
+}
+
+// rowValue returns the value of a last-value or count row.
+func rowValue(row *view.Row) int64 {
+	switch data := row.Data.(type) {
+	case *view.LastValueData:
+		return int64(data.Value)
+	case *view.CountData:
+		return data.Value
+	default:
+		return 0
+	}
+}
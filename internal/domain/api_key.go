@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"strings"
+	"time"
+)
+
+// MaxClientIDLength is the maximum length of the ID of an API client.
+const MaxClientIDLength = 100
+
+// APIKey is a credential of an API client. Only the SHA-256 hash of the secret is kept;
+// the secret itself is shown once, when the key is issued.
+type APIKey struct {
+	id        string
+	clientID  string
+	hash      []byte
+	createdAt time.Time
+	expiresAt *time.Time
+	revokedAt *time.Time
+}
+
+// NewAPIKey creates a key of the given client whose secret hashes to hash.
+func NewAPIKey(id, clientID string, hash []byte, now time.Time) (*APIKey, error) {
+	if strings.TrimSpace(id) == "" {
+		return nil, ErrInvalidID
+	}
+	if strings.TrimSpace(clientID) == "" || len(clientID) > MaxClientIDLength {
+		return nil, ErrInvalidClientID
+	}
+	if len(hash) != sha256.Size {
+		return nil, ErrInvalidAPIKey
+	}
+	return &APIKey{id: id, clientID: clientID, hash: hash, createdAt: now}, nil
+}
+
+// ReconstructAPIKey reconstructs an APIKey from persistence.
+func ReconstructAPIKey(id, clientID string, hash []byte, createdAt time.Time, expiresAt, revokedAt *time.Time) *APIKey {
+	return &APIKey{
+		id:        id,
+		clientID:  clientID,
+		hash:      hash,
+		createdAt: createdAt,
+		expiresAt: expiresAt,
+		revokedAt: revokedAt,
+	}
+}
+
+// Getters
+
+func (k *APIKey) ID() string            { return k.id }
+func (k *APIKey) ClientID() string      { return k.clientID }
+func (k *APIKey) Hash() []byte          { return k.hash }
+func (k *APIKey) CreatedAt() time.Time  { return k.createdAt }
+func (k *APIKey) ExpiresAt() *time.Time { return k.expiresAt }
+func (k *APIKey) RevokedAt() *time.Time { return k.revokedAt }
+
+// Matches reports whether secret is the secret of the key, in constant time.
+func (k *APIKey) Matches(secret string) bool {
+	sum := sha256.Sum256([]byte(secret))
+	return subtle.ConstantTimeCompare(sum[:], k.hash) == 1
+}
+
+// ValidAt reports whether the key authenticates requests at the given time.
+func (k *APIKey) ValidAt(at time.Time) bool {
+	if k.revokedAt != nil && !at.Before(*k.revokedAt) {
+		return false
+	}
+	return k.expiresAt == nil || at.Before(*k.expiresAt)
+}
+
+// Expire makes the key stop authenticating requests at the given time, as when it is
+// replaced by a rotation. A key that expires or is revoked earlier is left unchanged.
+func (k *APIKey) Expire(at time.Time) error {
+	if k.revokedAt != nil {
+		return ErrAPIKeyRevoked
+	}
+	if k.expiresAt == nil || at.Before(*k.expiresAt) {
+		k.expiresAt = &at
+	}
+	return nil
+}
+
+// Revoke makes the key stop authenticating requests from the given time on.
+func (k *APIKey) Revoke(at time.Time) error {
+	if k.revokedAt != nil {
+		return ErrAPIKeyRevoked
+	}
+	k.revokedAt = &at
+	return nil
+}
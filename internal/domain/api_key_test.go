@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	hash := sha256.Sum256([]byte("secret"))
+
+	tests := []struct {
+		name     string
+		id       string
+		clientID string
+		hash     []byte
+		wantErr  error
+	}{
+		{"valid", "key-1", "storefront", hash[:], nil},
+		{"missing id", "", "storefront", hash[:], ErrInvalidID},
+		{"missing client", "key-1", " ", hash[:], ErrInvalidClientID},
+		{"client too long", "key-1", strings.Repeat("c", MaxClientIDLength+1), hash[:], ErrInvalidClientID},
+		{"invalid hash", "key-1", "storefront", []byte("secret"), ErrInvalidAPIKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAPIKey(tt.id, tt.clientID, tt.hash, now)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAPIKey_Matches(t *testing.T) {
+	hash := sha256.Sum256([]byte("secret"))
+	key, err := NewAPIKey("key-1", "storefront", hash[:], time.Now())
+	require.NoError(t, err)
+
+	assert.True(t, key.Matches("secret"))
+	assert.False(t, key.Matches("Secret"))
+}
+
+func TestAPIKey_Lifecycle(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	hash := sha256.Sum256([]byte("secret"))
+	key, err := NewAPIKey("key-1", "storefront", hash[:], now)
+	require.NoError(t, err)
+	assert.True(t, key.ValidAt(now))
+
+	// Expiring again later does not extend the key
+	require.NoError(t, key.Expire(now.Add(time.Hour)))
+	require.NoError(t, key.Expire(now.Add(2*time.Hour)))
+	assert.True(t, key.ValidAt(now.Add(59*time.Minute)))
+	assert.False(t, key.ValidAt(now.Add(time.Hour)))
+
+	require.NoError(t, key.Revoke(now.Add(time.Minute)))
+	assert.False(t, key.ValidAt(now.Add(time.Minute)))
+	assert.ErrorIs(t, key.Revoke(now.Add(2*time.Minute)), ErrAPIKeyRevoked)
+	assert.ErrorIs(t, key.Expire(now.Add(2*time.Minute)), ErrAPIKeyRevoked)
+}
@@ -0,0 +1,251 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AttributeType is the type of the values of an attribute defined by an attribute schema.
+// Attribute values are stored as strings; the type restricts what they may hold.
+type AttributeType string
+
+const (
+	AttributeTypeString  AttributeType = "string"
+	AttributeTypeInteger AttributeType = "integer"
+	AttributeTypeDecimal AttributeType = "decimal"
+	AttributeTypeBoolean AttributeType = "boolean"
+)
+
+// attributeTypeDescriptions complete "must be ..." for values of the wrong type.
+var attributeTypeDescriptions = map[AttributeType]string{
+	AttributeTypeString:  "a string",
+	AttributeTypeInteger: "an integer",
+	AttributeTypeDecimal: "a decimal number",
+	AttributeTypeBoolean: "true or false",
+}
+
+// decimalPattern matches decimal numbers written without exponent, e.g. "-12.5".
+var decimalPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// IsValid reports whether t is a known attribute type.
+func (t AttributeType) IsValid() bool {
+	switch t {
+	case AttributeTypeString, AttributeTypeInteger, AttributeTypeDecimal, AttributeTypeBoolean:
+		return true
+	}
+	return false
+}
+
+// Accepts reports whether value is a value of the type: any string, a base-10 64-bit
+// integer, a decimal number without exponent, or "true" or "false".
+func (t AttributeType) Accepts(value string) bool {
+	switch t {
+	case AttributeTypeString:
+		return true
+	case AttributeTypeInteger:
+		_, err := strconv.ParseInt(value, 10, 64)
+		return err == nil
+	case AttributeTypeDecimal:
+		return decimalPattern.MatchString(value)
+	case AttributeTypeBoolean:
+		return value == "true" || value == "false"
+	}
+	return false
+}
+
+// AttributeDefinition defines an attribute of the products of a category: its name and
+// type, whether every product must have it, and optionally the only values it may have.
+type AttributeDefinition struct {
+	name          string
+	attrType      AttributeType
+	required      bool
+	allowedValues []string
+}
+
+// NewAttributeDefinition creates an attribute definition. The name must be a valid product
+// attribute name, and each allowed value a distinct value of the type.
+func NewAttributeDefinition(name string, attrType AttributeType, required bool, allowedValues []string) (*AttributeDefinition, error) {
+	name, err := ParseProductAttributeName(name)
+	if err != nil {
+		return nil, ErrInvalidAttributeSchema
+	}
+	if !attrType.IsValid() {
+		return nil, ErrInvalidAttributeSchema
+	}
+
+	var allowed []string
+	seen := make(map[string]bool, len(allowedValues))
+	for _, value := range allowedValues {
+		value = strings.TrimSpace(value)
+		if value == "" || len(value) > MaxProductAttributeValueLength || !attrType.Accepts(value) || seen[value] {
+			return nil, ErrInvalidAttributeSchema
+		}
+		seen[value] = true
+		allowed = append(allowed, value)
+	}
+
+	return &AttributeDefinition{
+		name:          name,
+		attrType:      attrType,
+		required:      required,
+		allowedValues: allowed,
+	}, nil
+}
+
+// Getters
+
+func (d *AttributeDefinition) Name() string        { return d.name }
+func (d *AttributeDefinition) Type() AttributeType { return d.attrType }
+func (d *AttributeDefinition) Required() bool      { return d.required }
+
+// AllowedValues returns the values the attribute is restricted to, or nil if it may have
+// any value of its type.
+func (d *AttributeDefinition) AllowedValues() []string {
+	return append([]string(nil), d.allowedValues...)
+}
+
+// check returns why value, which is not empty, is not a valid value of the attribute, or
+// "" if it is.
+func (d *AttributeDefinition) check(value string) string {
+	if !d.attrType.Accepts(value) {
+		return "must be " + attributeTypeDescriptions[d.attrType]
+	}
+	if len(d.allowedValues) > 0 {
+		for _, allowed := range d.allowedValues {
+			if value == allowed {
+				return ""
+			}
+		}
+		return "must be one of " + strings.Join(d.allowedValues, ", ")
+	}
+	return ""
+}
+
+// CategoryAttributeSchema defines the attributes the products of a category may have.
+// Products of a category without a schema may have any attributes.
+type CategoryAttributeSchema struct {
+	category   string
+	attributes []*AttributeDefinition
+}
+
+// NewCategoryAttributeSchema creates the attribute schema of a category, defining at most
+// MaxProductAttributes distinctly named attributes. The definitions are ordered by name.
+func NewCategoryAttributeSchema(category string, attributes []*AttributeDefinition) (*CategoryAttributeSchema, error) {
+	if strings.TrimSpace(category) == "" || len(attributes) > MaxProductAttributes {
+		return nil, ErrInvalidAttributeSchema
+	}
+
+	sorted := append([]*AttributeDefinition(nil), attributes...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].name < sorted[j].name })
+	for i, d := range sorted {
+		if d == nil || (i > 0 && sorted[i-1].name == d.name) {
+			return nil, ErrInvalidAttributeSchema
+		}
+	}
+
+	return &CategoryAttributeSchema{category: category, attributes: sorted}, nil
+}
+
+// Category returns the category the schema applies to.
+func (s *CategoryAttributeSchema) Category() string { return s.category }
+
+// Attributes returns the attribute definitions of the schema, ordered by name.
+func (s *CategoryAttributeSchema) Attributes() []*AttributeDefinition {
+	return append([]*AttributeDefinition(nil), s.attributes...)
+}
+
+// Attribute returns the definition of the named attribute, or nil if the schema does not
+// define it.
+func (s *CategoryAttributeSchema) Attribute(name string) *AttributeDefinition {
+	i := sort.Search(len(s.attributes), func(i int) bool { return s.attributes[i].name >= name })
+	if i < len(s.attributes) && s.attributes[i].name == name {
+		return s.attributes[i]
+	}
+	return nil
+}
+
+// Validate checks the attributes of a product against the schema: every attribute must be
+// defined by it with a valid value, and every required attribute must be present. It
+// returns an *AttributeSchemaError listing every violation, or nil.
+func (s *CategoryAttributeSchema) Validate(attributes map[string]string) error {
+	var violations []AttributeViolation
+	for name, value := range attributes {
+		if reason := s.check(name, value); reason != "" {
+			violations = append(violations, AttributeViolation{Attribute: name, Reason: reason})
+		}
+	}
+	for _, d := range s.attributes {
+		if _, ok := attributes[d.name]; d.required && !ok {
+			violations = append(violations, AttributeViolation{Attribute: d.name, Reason: "is required"})
+		}
+	}
+	return s.violationError(violations)
+}
+
+// ValidateAttribute checks setting a single attribute against the schema; an empty value
+// removes the attribute, which must then not be required. It returns an
+// *AttributeSchemaError, or nil. Other attributes of the product are not checked, so
+// products predating the schema can be brought in line one attribute at a time.
+func (s *CategoryAttributeSchema) ValidateAttribute(name, value string) error {
+	name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+	reason := s.check(name, value)
+	if value == "" {
+		reason = ""
+		if d := s.Attribute(name); d != nil && d.required {
+			reason = "is required"
+		}
+	}
+	if reason == "" {
+		return nil
+	}
+	return s.violationError([]AttributeViolation{{Attribute: name, Reason: reason}})
+}
+
+// check returns why name cannot have value, or "" if it can.
+func (s *CategoryAttributeSchema) check(name, value string) string {
+	d := s.Attribute(name)
+	if d == nil {
+		return "is not defined for the category"
+	}
+	return d.check(value)
+}
+
+func (s *CategoryAttributeSchema) violationError(violations []AttributeViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	sort.Slice(violations, func(i, j int) bool { return violations[i].Attribute < violations[j].Attribute })
+	return &AttributeSchemaError{Category: s.category, Violations: violations}
+}
+
+// AttributeViolation is an attribute of a product that violates the attribute schema of
+// its category, and why.
+type AttributeViolation struct {
+	Attribute string
+	// Reason completes a sentence starting with the attribute name, e.g. "is required".
+	Reason string
+}
+
+// AttributeSchemaError lists the attributes of a product that violate the attribute
+// schema of its category, ordered by attribute name. It matches
+// ErrAttributeSchemaViolation.
+type AttributeSchemaError struct {
+	Category   string
+	Violations []AttributeViolation
+}
+
+func (e *AttributeSchemaError) Error() string {
+	reasons := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		reasons[i] = v.Attribute + " " + v.Reason
+	}
+	return fmt.Sprintf("%v %q: %s", ErrAttributeSchemaViolation, e.Category, strings.Join(reasons, "; "))
+}
+
+// Is makes errors.Is(err, ErrAttributeSchemaViolation) match.
+func (e *AttributeSchemaError) Is(target error) bool {
+	return target == ErrAttributeSchemaViolation
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributeType_Accepts(t *testing.T) {
+	tests := []struct {
+		attrType AttributeType
+		value    string
+		want     bool
+	}{
+		{AttributeTypeString, "oak", true},
+		{AttributeTypeInteger, "-42", true},
+		{AttributeTypeInteger, "4.5", false},
+		{AttributeTypeInteger, "four", false},
+		{AttributeTypeDecimal, "4.5", true},
+		{AttributeTypeDecimal, "12", true},
+		{AttributeTypeDecimal, "1e3", false},
+		{AttributeTypeDecimal, ".5", false},
+		{AttributeTypeBoolean, "true", true},
+		{AttributeTypeBoolean, "yes", false},
+		{AttributeType("date"), "2025-01-01", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.attrType)+" "+tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.attrType.Accepts(tt.value))
+		})
+	}
+}
+
+func TestNewAttributeDefinition(t *testing.T) {
+	d, err := NewAttributeDefinition(" size ", AttributeTypeString, true, []string{" S ", "M", "L"})
+	require.NoError(t, err)
+	assert.Equal(t, "size", d.Name())
+	assert.Equal(t, AttributeTypeString, d.Type())
+	assert.True(t, d.Required())
+	assert.Equal(t, []string{"S", "M", "L"}, d.AllowedValues())
+
+	tests := []struct {
+		name     string
+		attrName string
+		attrType AttributeType
+		allowed  []string
+	}{
+		{"invalid name", "Size", AttributeTypeString, nil},
+		{"unknown type", "size", AttributeType("date"), nil},
+		{"allowed value of another type", "legs", AttributeTypeInteger, []string{"3", "four"}},
+		{"duplicate allowed value", "size", AttributeTypeString, []string{"S", "S"}},
+		{"empty allowed value", "size", AttributeTypeString, []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAttributeDefinition(tt.attrName, tt.attrType, false, tt.allowed)
+			assert.ErrorIs(t, err, ErrInvalidAttributeSchema)
+		})
+	}
+}
+
+func furnitureSchema(t *testing.T) *CategoryAttributeSchema {
+	t.Helper()
+	material, err := NewAttributeDefinition("material", AttributeTypeString, true, []string{"oak", "pine"})
+	require.NoError(t, err)
+	legs, err := NewAttributeDefinition("legs", AttributeTypeInteger, false, nil)
+	require.NoError(t, err)
+	schema, err := NewCategoryAttributeSchema("Furniture", []*AttributeDefinition{material, legs})
+	require.NoError(t, err)
+	return schema
+}
+
+func TestNewCategoryAttributeSchema(t *testing.T) {
+	schema := furnitureSchema(t)
+	assert.Equal(t, "Furniture", schema.Category())
+	require.Len(t, schema.Attributes(), 2)
+	assert.Equal(t, "legs", schema.Attributes()[0].Name())
+	assert.Equal(t, "material", schema.Attribute("material").Name())
+	assert.Nil(t, schema.Attribute("color"))
+
+	legs, err := NewAttributeDefinition("legs", AttributeTypeInteger, false, nil)
+	require.NoError(t, err)
+	_, err = NewCategoryAttributeSchema("Furniture", []*AttributeDefinition{legs, legs})
+	assert.ErrorIs(t, err, ErrInvalidAttributeSchema)
+	_, err = NewCategoryAttributeSchema(" ", []*AttributeDefinition{legs})
+	assert.ErrorIs(t, err, ErrInvalidAttributeSchema)
+}
+
+func TestCategoryAttributeSchema_Validate(t *testing.T) {
+	schema := furnitureSchema(t)
+
+	assert.NoError(t, schema.Validate(map[string]string{"material": "oak", "legs": "4"}))
+	assert.NoError(t, schema.Validate(map[string]string{"material": "pine"}))
+
+	err := schema.Validate(map[string]string{"legs": "four", "color": "red"})
+	assert.ErrorIs(t, err, ErrAttributeSchemaViolation)
+	var schemaErr *AttributeSchemaError
+	require.True(t, errors.As(err, &schemaErr))
+	assert.Equal(t, "Furniture", schemaErr.Category)
+	assert.Equal(t, []AttributeViolation{
+		{Attribute: "color", Reason: "is not defined for the category"},
+		{Attribute: "legs", Reason: "must be an integer"},
+		{Attribute: "material", Reason: "is required"},
+	}, schemaErr.Violations)
+
+	err = schema.Validate(map[string]string{"material": "steel"})
+	require.True(t, errors.As(err, &schemaErr))
+	assert.Equal(t, []AttributeViolation{{Attribute: "material", Reason: "must be one of oak, pine"}}, schemaErr.Violations)
+}
+
+func TestCategoryAttributeSchema_ValidateAttribute(t *testing.T) {
+	schema := furnitureSchema(t)
+
+	assert.NoError(t, schema.ValidateAttribute("legs", "3"))
+	assert.NoError(t, schema.ValidateAttribute("legs", ""), "optional attributes can be removed")
+	assert.NoError(t, schema.ValidateAttribute("color", ""), "undefined attributes can be removed")
+	assert.ErrorIs(t, schema.ValidateAttribute("material", ""), ErrAttributeSchemaViolation)
+	assert.ErrorIs(t, schema.ValidateAttribute("material", "steel"), ErrAttributeSchemaViolation)
+	assert.ErrorIs(t, schema.ValidateAttribute("color", "red"), ErrAttributeSchemaViolation)
+}
@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MaxCampaignProducts is the maximum number of products a campaign lists explicitly.
+const MaxCampaignProducts = 10000
+
+// CampaignStatus represents the lifecycle state of a campaign.
+type CampaignStatus string
+
+// Campaign statuses. A campaign is created as a draft, applies its discount to its
+// products when activated, and removes it when ended.
+const (
+	CampaignStatusDraft  CampaignStatus = "draft"
+	CampaignStatusActive CampaignStatus = "active"
+	CampaignStatusEnded  CampaignStatus = "ended"
+)
+
+// String returns the string representation of the status.
+func (s CampaignStatus) String() string {
+	return string(s)
+}
+
+// IsValid checks if the status is a known campaign status.
+func (s CampaignStatus) IsValid() bool {
+	switch s {
+	case CampaignStatusDraft, CampaignStatusActive, CampaignStatusEnded:
+		return true
+	default:
+		return false
+	}
+}
+
+// Campaign is the aggregate root of a sale that applies the same discount to many
+// products: every product of a category, or an explicit list of products. The discount
+// applied to each product has the campaign ID as its ID, so the campaign can find and
+// remove it again.
+type Campaign struct {
+	id         string
+	tenantID   string
+	name       string
+	category   string
+	productIDs []string
+	discount   *Discount
+	status     CampaignStatus
+	createdAt  time.Time
+	updatedAt  time.Time
+	events     []DomainEvent
+}
+
+// NewCampaign creates a draft campaign that targets either a category or a list of
+// products with a discount of the given percentage, priority and period.
+func NewCampaign(id, name, category string, productIDs []string, percentage *big.Rat, priority int, startDate, endDate, now time.Time) (*Campaign, error) {
+	if strings.TrimSpace(id) == "" {
+		return nil, ErrInvalidID
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, ErrInvalidCampaignName
+	}
+	category = strings.TrimSpace(category)
+	if err := validateCampaignTarget(category, productIDs); err != nil {
+		return nil, err
+	}
+	if priority < 0 || priority > MaxDiscountPriority {
+		return nil, ErrInvalidDiscountPriority
+	}
+	discount, err := NewDiscount(percentage, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	if discount.IsExpired(now) {
+		return nil, ErrInvalidDiscountPeriod
+	}
+
+	c := &Campaign{
+		id:         id,
+		name:       strings.TrimSpace(name),
+		category:   category,
+		productIDs: slices.Clone(productIDs),
+		discount:   discount.WithID(id).WithPriority(priority),
+		status:     CampaignStatusDraft,
+		createdAt:  now,
+		updatedAt:  now,
+	}
+	c.events = append(c.events, NewCampaignCreatedEvent(c, now))
+	return c, nil
+}
+
+// validateCampaignTarget checks that a campaign targets either a category or a list of
+// up to MaxCampaignProducts distinct product IDs.
+func validateCampaignTarget(category string, productIDs []string) error {
+	if (category == "") == (len(productIDs) == 0) || len(productIDs) > MaxCampaignProducts {
+		return ErrInvalidCampaignTarget
+	}
+	seen := make(map[string]bool, len(productIDs))
+	for _, id := range productIDs {
+		if strings.TrimSpace(id) == "" || seen[id] {
+			return ErrInvalidCampaignTarget
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// ReconstructCampaign reconstructs a Campaign from persistence.
+func ReconstructCampaign(id, tenantID, name, category string, productIDs []string, discount *Discount, status CampaignStatus, createdAt, updatedAt time.Time) *Campaign {
+	return &Campaign{
+		id:         id,
+		tenantID:   tenantID,
+		name:       name,
+		category:   category,
+		productIDs: productIDs,
+		discount:   discount.WithID(id),
+		status:     status,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+// ID returns the campaign identifier.
+func (c *Campaign) ID() string { return c.id }
+
+// AssignTenant sets the tenant that owns a new campaign before it is first saved; empty
+// is the default tenant. Only products of that tenant are discounted by the campaign.
+func (c *Campaign) AssignTenant(tenantID string) error {
+	if len(tenantID) > MaxTenantIDLength {
+		return ErrInvalidTenantID
+	}
+	c.tenantID = tenantID
+	return nil
+}
+
+// TenantID returns the tenant that owns the campaign; empty for the default tenant.
+func (c *Campaign) TenantID() string { return c.tenantID }
+
+// Name returns the campaign name.
+func (c *Campaign) Name() string { return c.name }
+
+// Category returns the targeted category, or "" if the campaign lists its products.
+func (c *Campaign) Category() string { return c.category }
+
+// ProductIDs returns the targeted products, or nil if the campaign targets a category.
+func (c *Campaign) ProductIDs() []string { return slices.Clone(c.productIDs) }
+
+// Discount returns the discount the campaign applies; its ID is the campaign ID.
+func (c *Campaign) Discount() *Discount { return c.discount }
+
+// Status returns the campaign status.
+func (c *Campaign) Status() CampaignStatus { return c.status }
+
+// CreatedAt returns the creation timestamp.
+func (c *Campaign) CreatedAt() time.Time { return c.createdAt }
+
+// UpdatedAt returns the last update timestamp.
+func (c *Campaign) UpdatedAt() time.Time { return c.updatedAt }
+
+// DomainEvents returns the events raised since the campaign was created or loaded.
+func (c *Campaign) DomainEvents() []DomainEvent { return c.events }
+
+// Activate records that the discount of the campaign was applied to applied products,
+// and could not be applied to skipped others.
+func (c *Campaign) Activate(applied, skipped int, now time.Time) error {
+	if c.status != CampaignStatusDraft {
+		return ErrCampaignNotDraft
+	}
+	if c.discount.IsExpired(now) {
+		return ErrInvalidDiscountPeriod
+	}
+	c.status = CampaignStatusActive
+	c.updatedAt = now
+	c.events = append(c.events, NewCampaignActivatedEvent(c.id, applied, skipped, now))
+	return nil
+}
+
+// End records that the discount of the campaign was removed from removed products.
+func (c *Campaign) End(removed int, now time.Time) error {
+	if c.status != CampaignStatusActive {
+		return ErrCampaignNotActive
+	}
+	c.status = CampaignStatusEnded
+	c.updatedAt = now
+	c.events = append(c.events, NewCampaignEndedEvent(c.id, removed, now))
+	return nil
+}
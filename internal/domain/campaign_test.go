@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCampaign(t *testing.T) {
+	now := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	start, end := now.Add(24*time.Hour), now.Add(72*time.Hour)
+	pct := big.NewRat(20, 1)
+
+	tests := []struct {
+		name       string
+		id         string
+		campaign   string
+		category   string
+		productIDs []string
+		priority   int
+		end        time.Time
+		wantErr    error
+	}{
+		{"category", "c1", "Black Friday", "electronics", nil, 10, end, nil},
+		{"products", "c1", "Black Friday", "", []string{"p1", "p2"}, 10, end, nil},
+		{"missing id", "", "Black Friday", "electronics", nil, 10, end, ErrInvalidID},
+		{"missing name", "c1", " ", "electronics", nil, 10, end, ErrInvalidCampaignName},
+		{"no target", "c1", "Black Friday", "", nil, 10, end, ErrInvalidCampaignTarget},
+		{"both targets", "c1", "Black Friday", "electronics", []string{"p1"}, 10, end, ErrInvalidCampaignTarget},
+		{"duplicate products", "c1", "Black Friday", "", []string{"p1", "p1"}, 10, end, ErrInvalidCampaignTarget},
+		{"empty product", "c1", "Black Friday", "", []string{""}, 10, end, ErrInvalidCampaignTarget},
+		{"invalid priority", "c1", "Black Friday", "electronics", nil, MaxDiscountPriority + 1, end, ErrInvalidDiscountPriority},
+		{"expired", "c1", "Black Friday", "electronics", nil, 10, now.Add(-time.Hour), ErrInvalidDiscountPeriod},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := start
+			if tt.end.Before(now) {
+				s = now.Add(-2 * time.Hour)
+			}
+			c, err := NewCampaign(tt.id, tt.campaign, tt.category, tt.productIDs, pct, tt.priority, s, tt.end, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, CampaignStatusDraft, c.Status())
+			assert.Equal(t, tt.id, c.Discount().ID())
+			assert.Equal(t, tt.priority, c.Discount().Priority())
+			require.Len(t, c.DomainEvents(), 1)
+			assert.Equal(t, "campaign.created", c.DomainEvents()[0].EventType())
+		})
+	}
+}
+
+func TestCampaign_Lifecycle(t *testing.T) {
+	now := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	c, err := NewCampaign("c1", "Black Friday", "electronics", nil, big.NewRat(20, 1), 10, now, now.Add(72*time.Hour), now)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, c.End(0, now), ErrCampaignNotActive)
+	require.NoError(t, c.Activate(3, 1, now))
+	assert.Equal(t, CampaignStatusActive, c.Status())
+	assert.ErrorIs(t, c.Activate(3, 1, now), ErrCampaignNotDraft)
+
+	require.NoError(t, c.End(3, now.Add(time.Hour)))
+	assert.Equal(t, CampaignStatusEnded, c.Status())
+	assert.Equal(t, now.Add(time.Hour), c.UpdatedAt())
+
+	events := c.DomainEvents()
+	require.Len(t, events, 3)
+	activated := events[1].(CampaignActivatedEvent)
+	assert.Equal(t, 3, activated.AppliedCount)
+	assert.Equal(t, 1, activated.SkippedCount)
+	assert.Equal(t, 3, events[2].(CampaignEndedEvent).RemovedCount)
+}
+
+func TestCampaign_ActivateExpired(t *testing.T) {
+	now := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	c, err := NewCampaign("c1", "Black Friday", "electronics", nil, big.NewRat(20, 1), 10, now, now.Add(time.Hour), now)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, c.Activate(0, 0, now.Add(2*time.Hour)), ErrInvalidDiscountPeriod)
+	assert.Equal(t, CampaignStatusDraft, c.Status())
+}
@@ -8,7 +8,34 @@ const (
 	FieldCategory    = "category"
 	FieldBasePrice   = "base_price"
 	FieldDiscount    = "discount"
-	FieldStatus      = "status"
+	// FieldDiscountPhase is marked when only the phase of the discount changed.
+	FieldDiscountPhase = "discount_phase"
+	FieldStatus        = "status"
+	FieldPriceTiers    = "price_tiers"
+	FieldPriceBook     = "price_book"
+	FieldTaxClass      = "tax_class"
+	FieldSegmentPrices = "segment_prices"
+	FieldMarketPrices  = "market_prices"
+	FieldMinimumPrice  = "minimum_price"
+	FieldCostPrice     = "cost_price"
+	FieldTags          = "tags"
+	FieldAttributes    = "attributes"
+	FieldSlug          = "slug"
+	FieldSKU           = "sku"
+	FieldGTIN          = "gtin"
+	FieldWeight        = "weight"
+	FieldDimensions    = "dimensions"
+	FieldChannels      = "channels"
+	FieldKind          = "kind"
+	FieldCompliance    = "compliance"
+	// FieldPendingPriceChange is marked when a base price change is scheduled, cancelled
+	// or applied.
+	FieldPendingPriceChange = "pending_price_change"
+	// FieldStatusSchedule is marked when an activation or deactivation is scheduled,
+	// cleared or applied.
+	FieldStatusSchedule = "status_schedule"
+	// FieldReview is marked when a product is submitted for review, approved or rejected.
+	FieldReview = "review"
 )
 
 // ChangeTracker tracks which fields have been modified on an aggregate.
@@ -0,0 +1,48 @@
+package domain
+
+import "math/big"
+
+// CharmPriceCents are the cents every charm price ends in: 19.99, 4.99, 0.99.
+const CharmPriceCents = 99
+
+// NearestCharmPrice returns the charm price nearest to price, in its currency: a whole
+// amount less one cent, ties going up. Prices below 0.49 get the lowest charm price, 0.99.
+// Unlike RoundCharm, which only changes how a price is displayed, it suggests a price to
+// set.
+func NearestCharmPrice(price *Money) *Money {
+	unit := big.NewRat(1, 1)
+	offset := big.NewRat(100-CharmPriceCents, 100)
+	whole := roundToMultiple(new(big.Rat).Add(price.Amount(), offset), unit, false)
+	if whole.Sign() <= 0 {
+		whole = unit
+	}
+	return price.withAmount(whole.Sub(whole, offset))
+}
+
+// SuggestCharmPriceForDiscount returns the charm price nearest to price less
+// discountPercent, which must be from 0 to 100.
+func (pc *PricingCalculator) SuggestCharmPriceForDiscount(price *Money, discountPercent *big.Rat) (*Money, error) {
+	if price == nil || !price.IsPositive() {
+		return nil, ErrInvalidBasePrice
+	}
+	if discountPercent == nil || discountPercent.Sign() < 0 || discountPercent.Cmp(big.NewRat(100, 1)) > 0 {
+		return nil, ErrInvalidDiscountPercentage
+	}
+	return NearestCharmPrice(pc.CalculateDiscountedPrice(price, discountPercent)), nil
+}
+
+// SuggestCharmPriceForMargin returns the charm price nearest to the price that earns
+// marginPercent of itself over cost (see Margin), which must be at least 0 and below 100.
+// Being the nearest, the suggestion may earn slightly less than the target margin.
+func (pc *PricingCalculator) SuggestCharmPriceForMargin(cost *Money, marginPercent *big.Rat) (*Money, error) {
+	if cost == nil {
+		return nil, ErrNoCostPrice
+	}
+	hundred := big.NewRat(100, 1)
+	if marginPercent == nil || marginPercent.Sign() < 0 || marginPercent.Cmp(hundred) >= 0 {
+		return nil, ErrInvalidTargetMargin
+	}
+	// price - cost = price * margin / 100, so price = cost * 100 / (100 - margin).
+	factor := new(big.Rat).Quo(hundred, new(big.Rat).Sub(hundred, marginPercent))
+	return NearestCharmPrice(cost.Multiply(factor)), nil
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNearestCharmPrice(t *testing.T) {
+	tests := []struct {
+		price int64
+		want  string
+	}{
+		{price: 2000, want: "19.99"},
+		{price: 1960, want: "19.99"},
+		{price: 1949, want: "19.99"},
+		{price: 1948, want: "18.99"},
+		{price: 1999, want: "19.99"},
+		{price: 2048, want: "19.99"},
+		{price: 2049, want: "20.99"},
+		{price: 120, want: "0.99"},
+		{price: 10, want: "0.99"},
+	}
+
+	for _, tt := range tests {
+		t.Run(NewMoney(tt.price, 100).String(), func(t *testing.T) {
+			assert.Equal(t, tt.want, NearestCharmPrice(NewMoney(tt.price, 100)).Amount().FloatString(2))
+		})
+	}
+
+	eur, err := NewMoneyInCurrency(1850, 100, "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", NearestCharmPrice(eur).Currency())
+}
+
+func TestPricingCalculator_SuggestCharmPriceForDiscount(t *testing.T) {
+	pc := NewPricingCalculator()
+
+	price, err := pc.SuggestCharmPriceForDiscount(NewMoney(4999, 100), big.NewRat(20, 1))
+	require.NoError(t, err)
+	assert.True(t, price.Equals(NewMoney(3999, 100)), "39.992 is nearest to 39.99")
+
+	price, err = pc.SuggestCharmPriceForDiscount(NewMoney(2500, 100), big.NewRat(0, 1))
+	require.NoError(t, err)
+	assert.True(t, price.Equals(NewMoney(2499, 100)))
+
+	_, err = pc.SuggestCharmPriceForDiscount(NewMoney(2500, 100), big.NewRat(101, 1))
+	assert.ErrorIs(t, err, ErrInvalidDiscountPercentage)
+	_, err = pc.SuggestCharmPriceForDiscount(NewMoney(0, 1), big.NewRat(10, 1))
+	assert.ErrorIs(t, err, ErrInvalidBasePrice)
+}
+
+func TestPricingCalculator_SuggestCharmPriceForMargin(t *testing.T) {
+	pc := NewPricingCalculator()
+
+	// A 40% margin over a cost of 12.00 needs a price of 20.00.
+	price, err := pc.SuggestCharmPriceForMargin(NewMoney(1200, 100), big.NewRat(40, 1))
+	require.NoError(t, err)
+	assert.True(t, price.Equals(NewMoney(1999, 100)))
+
+	price, err = pc.SuggestCharmPriceForMargin(NewMoney(1200, 100), big.NewRat(0, 1))
+	require.NoError(t, err)
+	assert.True(t, price.Equals(NewMoney(1199, 100)))
+
+	for _, margin := range []*big.Rat{big.NewRat(100, 1), big.NewRat(-1, 1), nil} {
+		_, err := pc.SuggestCharmPriceForMargin(NewMoney(1200, 100), margin)
+		assert.ErrorIs(t, err, ErrInvalidTargetMargin)
+	}
+	_, err = pc.SuggestCharmPriceForMargin(nil, big.NewRat(40, 1))
+	assert.ErrorIs(t, err, ErrNoCostPrice)
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+	"time"
+)
+
+// MarginGuard selects what happens when a discount would bring the price of a product
+// below its cost price.
+type MarginGuard string
+
+const (
+	// MarginGuardOff applies such discounts without a check.
+	MarginGuardOff MarginGuard = "off"
+	// MarginGuardWarn applies them and logs a warning.
+	MarginGuardWarn MarginGuard = "warn"
+	// MarginGuardBlock rejects them with ErrNegativeMargin.
+	MarginGuardBlock MarginGuard = "block"
+)
+
+// DefaultMarginGuard is the margin guard used when none is configured.
+const DefaultMarginGuard = MarginGuardWarn
+
+// ParseMarginGuard returns the margin guard with the given name. Names are
+// case-insensitive.
+func ParseMarginGuard(name string) (MarginGuard, error) {
+	switch MarginGuard(strings.ToLower(strings.TrimSpace(name))) {
+	case MarginGuardOff:
+		return MarginGuardOff, nil
+	case MarginGuardWarn:
+		return MarginGuardWarn, nil
+	case MarginGuardBlock:
+		return MarginGuardBlock, nil
+	}
+	return "", ErrInvalidMarginGuard
+}
+
+// Margin is what is left of a price once the cost price is paid, in the currency of both.
+type Margin struct {
+	Price  *Money
+	Cost   *Money
+	Amount *Money
+	// Percent is Amount as a percentage of Price; it is negative when selling at a loss.
+	Percent *big.Rat
+}
+
+// CostPrice returns what the product costs the merchant, or nil if it is not recorded.
+func (p *Product) CostPrice() *Money { return p.costPrice }
+
+// SetCostPrice records what the product costs the merchant, in its currency; nil removes
+// it. Setting the current cost price again is a no-op and raises no event.
+func (p *Product) SetCostPrice(price *Money, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if price != nil {
+		if !price.IsPositive() {
+			return ErrInvalidCostPrice
+		}
+		if price.Currency() != p.Currency() {
+			return ErrCurrencyMismatch
+		}
+	}
+	if p.costPrice.Equals(price) {
+		return nil
+	}
+
+	p.costPrice = price
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldCostPrice)
+
+	p.events = append(p.events, NewCostPriceChangedEvent(p.id, price, now))
+	return nil
+}
+
+// CheckMargin returns ErrNegativeMargin if discount would bring the base price of the
+// product below its cost price. Products without a cost price, discounts scoped to a
+// market, which apply to the separately set market price, and promotions, which do not
+// change the unit price, always pass.
+func (p *Product) CheckMargin(discount *Discount) error {
+	if p.costPrice == nil || discount == nil || discount.Market() != "" {
+		return nil
+	}
+	if discount.ApplyTo(p.basePrice).LessThan(p.costPrice) {
+		return ErrNegativeMargin
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMarginGuard(t *testing.T) {
+	guard, err := ParseMarginGuard(" Block ")
+	require.NoError(t, err)
+	assert.Equal(t, MarginGuardBlock, guard)
+
+	_, err = ParseMarginGuard("strict")
+	assert.ErrorIs(t, err, ErrInvalidMarginGuard)
+}
+
+func TestProduct_SetCostPrice(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	assert.Nil(t, product.CostPrice())
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	require.NoError(t, product.SetCostPrice(NewMoney(1200, 100), now))
+	assert.True(t, product.CostPrice().Equals(NewMoney(12, 1)))
+	assert.True(t, product.Changes().Dirty(FieldCostPrice))
+	require.Len(t, product.DomainEvents(), 1)
+	event, ok := product.DomainEvents()[0].(CostPriceChangedEvent)
+	require.True(t, ok)
+	assert.True(t, event.CostPrice.Equals(NewMoney(12, 1)))
+
+	// Setting the same price again is a no-op
+	product.ClearEvents()
+	product.Changes().Reset()
+	require.NoError(t, product.SetCostPrice(NewMoney(12, 1), now))
+	assert.Empty(t, product.DomainEvents())
+	assert.False(t, product.Changes().HasChanges())
+
+	// Removing the cost price
+	require.NoError(t, product.SetCostPrice(nil, now))
+	assert.Nil(t, product.CostPrice())
+	require.Len(t, product.DomainEvents(), 1)
+	assert.Nil(t, product.DomainEvents()[0].(CostPriceChangedEvent).CostPrice)
+
+	assert.ErrorIs(t, product.SetCostPrice(NewMoney(0, 1), now), ErrInvalidCostPrice)
+	eur, err := NewMoneyInCurrency(1200, 100, "EUR")
+	require.NoError(t, err)
+	assert.ErrorIs(t, product.SetCostPrice(eur, now), ErrCurrencyMismatch)
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.SetCostPrice(NewMoney(12, 1), now), ErrProductArchived)
+}
+
+func TestProduct_CheckMargin(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+
+	discount := func(percent int64) *Discount {
+		d, err := NewDiscount(big.NewRat(percent, 1), now, now.Add(time.Hour))
+		require.NoError(t, err)
+		return d
+	}
+
+	// Without a cost price every discount passes
+	assert.NoError(t, product.CheckMargin(discount(90)))
+
+	require.NoError(t, product.SetCostPrice(NewMoney(12, 1), now))
+	assert.NoError(t, product.CheckMargin(discount(40)))
+	assert.ErrorIs(t, product.CheckMargin(discount(41)), ErrNegativeMargin)
+	assert.NoError(t, product.CheckMargin(discount(41).WithMarket(MarketEU)))
+}
+
+func TestPricingCalculator_CalculateMargin(t *testing.T) {
+	pc := NewPricingCalculator()
+
+	margin, err := pc.CalculateMargin(NewMoney(20, 1), NewMoney(12, 1))
+	require.NoError(t, err)
+	assert.True(t, margin.Amount.Equals(NewMoney(8, 1)))
+	assert.Equal(t, big.NewRat(40, 1), margin.Percent)
+
+	margin, err = pc.CalculateMargin(NewMoney(10, 1), NewMoney(12, 1))
+	require.NoError(t, err)
+	assert.True(t, margin.Amount.Equals(NewMoney(-2, 1)))
+	assert.Equal(t, big.NewRat(-20, 1), margin.Percent)
+
+	_, err = pc.CalculateMargin(NewMoney(10, 1), nil)
+	assert.ErrorIs(t, err, ErrNoCostPrice)
+}
@@ -1,15 +1,133 @@
 package domain
 
 import (
+	"math"
 	"math/big"
+	"sort"
+	"strconv"
 	"time"
 )
 
-// Discount represents a percentage-based discount with a validity period.
+// MaxPercentageScale is the maximum number of decimal places of a discount percentage.
+// It matches the scale of the Spanner NUMERIC type, so every valid percentage is stored exactly.
+const MaxPercentageScale = 9
+
+// PercentageFromFloat converts a percentage received as a float64 (e.g. from an API request)
+// to an exact rational using its shortest decimal representation, so 33.33 becomes 3333/100
+// rather than the nearest binary fraction. It returns nil for NaN and infinities.
+func PercentageFromFloat(f float64) *big.Rat {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil
+	}
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+	if !ok {
+		return nil
+	}
+	return r
+}
+
+// MaxDiscountPriority is the highest discount priority. Priorities range from 0 to
+// MaxDiscountPriority; a higher priority takes precedence.
+const MaxDiscountPriority = 100
+
+// MaxPromotionQuantity is the largest buy or get quantity of a buy-X-get-Y promotion.
+const MaxPromotionQuantity = 1000
+
+// DiscountKind tells how a discount lowers what customers pay.
+type DiscountKind string
+
+// Discount kinds.
+const (
+	// DiscountKindPercentage lowers the unit price by the discount percentage.
+	DiscountKindPercentage DiscountKind = "percentage"
+	// DiscountKindBuyXGetY makes some of the units in a cart free; see BuyXGetY. It does
+	// not lower the unit price, so the catalog leaves it to the cart to apply.
+	DiscountKindBuyXGetY DiscountKind = "buy_x_get_y"
+)
+
+// BuyXGetY is the rule of a buy-X-get-Y promotion: of every BuyQuantity plus GetQuantity
+// units bought, GetQuantity units are free.
+type BuyXGetY struct {
+	buyQuantity int
+	getQuantity int
+}
+
+// NewBuyXGetY creates the rule "buy buyQuantity, get getQuantity free".
+func NewBuyXGetY(buyQuantity, getQuantity int) (*BuyXGetY, error) {
+	if buyQuantity < 1 || buyQuantity > MaxPromotionQuantity ||
+		getQuantity < 1 || getQuantity > MaxPromotionQuantity {
+		return nil, ErrInvalidPromotionQuantity
+	}
+	return &BuyXGetY{buyQuantity: buyQuantity, getQuantity: getQuantity}, nil
+}
+
+// BuyQuantity returns the number of units to pay for.
+func (b *BuyXGetY) BuyQuantity() int { return b.buyQuantity }
+
+// GetQuantity returns the number of units then given for free.
+func (b *BuyXGetY) GetQuantity() int { return b.getQuantity }
+
+// FreeQuantity returns how many of quantity units bought together are free.
+func (b *BuyXGetY) FreeQuantity(quantity int64) int64 {
+	if quantity <= 0 {
+		return 0
+	}
+	return quantity / int64(b.buyQuantity+b.getQuantity) * int64(b.getQuantity)
+}
+
+// Equals checks if two rules are equal.
+func (b *BuyXGetY) Equals(other *BuyXGetY) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	return b.buyQuantity == other.buyQuantity && b.getQuantity == other.getQuantity
+}
+
+// DiscountPhase records which boundaries of a discount period have been announced with a
+// DiscountStartedEvent or DiscountEndedEvent.
+type DiscountPhase string
+
+// Discount phases.
+const (
+	DiscountPhaseScheduled DiscountPhase = "scheduled"
+	DiscountPhaseStarted   DiscountPhase = "started"
+	DiscountPhaseEnded     DiscountPhase = "ended"
+)
+
+// IsValid checks if the phase is a known discount phase.
+func (p DiscountPhase) IsValid() bool {
+	switch p {
+	case DiscountPhaseScheduled, DiscountPhaseStarted, DiscountPhaseEnded:
+		return true
+	default:
+		return false
+	}
+}
+
+// Discount represents a percentage-based discount or a buy-X-get-Y promotion with a
+// validity period. A product can hold several discounts; see ApplicableDiscount for which
+// one applies to the price and ApplicablePromotion for which promotion applies to carts.
+// A discount scoped to a market only applies to the price of the product in that market.
 type Discount struct {
-	percentage *big.Rat
-	startDate  time.Time
-	endDate    time.Time
+	id          string
+	priority    int
+	market      Market
+	percentage  *big.Rat
+	promotion   *BuyXGetY
+	startDate   time.Time
+	endDate     time.Time
+	suspendedAt *time.Time
+	phase       DiscountPhase
+	// pendingApproval is set while a deep discount awaits approval; see RequireApproval.
+	pendingApproval bool
+	approvedBy      string
+	approvedAt      *time.Time
+	// flashSale marks a short promotion whose start and end are announced as soon as
+	// they pass; see AsFlashSale.
+	flashSale bool
+	// salePrice is set for a discount expressed as a sale price; percentage is then
+	// implied by the base price. See NewSalePriceDiscount.
+	salePrice *Money
 }
 
 // NewDiscount creates a new Discount value object.
@@ -30,6 +148,11 @@ func NewDiscount(percentage *big.Rat, startDate, endDate time.Time) (*Discount,
 		return nil, ErrInvalidDiscountPercentage
 	}
 
+	// Percentage must be representable with MaxPercentageScale decimal places
+	if !hasMaxScale(percentage, MaxPercentageScale) {
+		return nil, ErrInvalidDiscountPrecision
+	}
+
 	// End date must be after start date
 	if !endDate.After(startDate) {
 		return nil, ErrInvalidDiscountPeriod
@@ -39,9 +162,108 @@ func NewDiscount(percentage *big.Rat, startDate, endDate time.Time) (*Discount,
 		percentage: new(big.Rat).Set(percentage),
 		startDate:  startDate,
 		endDate:    endDate,
+		phase:      DiscountPhaseScheduled,
 	}, nil
 }
 
+// NewBuyXGetYDiscount creates a buy-X-get-Y promotion. Its percentage is 100: the free
+// units cost nothing.
+func NewBuyXGetYDiscount(promotion *BuyXGetY, startDate, endDate time.Time) (*Discount, error) {
+	if promotion == nil {
+		return nil, ErrInvalidPromotionQuantity
+	}
+	discount, err := NewDiscount(big.NewRat(100, 1), startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	discount.promotion = promotion
+	return discount, nil
+}
+
+// ID returns the discount identifier, unique within a product.
+func (d *Discount) ID() string {
+	if d == nil {
+		return ""
+	}
+	return d.id
+}
+
+// WithID returns a copy of the discount with the given identifier.
+func (d *Discount) WithID(id string) *Discount {
+	changed := *d
+	changed.id = id
+	return &changed
+}
+
+// Priority returns the priority of the discount; higher priorities take precedence.
+func (d *Discount) Priority() int {
+	if d == nil {
+		return 0
+	}
+	return d.priority
+}
+
+// WithPriority returns a copy of the discount with the given priority.
+func (d *Discount) WithPriority(priority int) *Discount {
+	changed := *d
+	changed.priority = priority
+	return &changed
+}
+
+// Market returns the market the discount is scoped to, or "" if it applies in every
+// market.
+func (d *Discount) Market() Market {
+	if d == nil {
+		return ""
+	}
+	return d.market
+}
+
+// WithMarket returns a copy of the discount scoped to the given market; "" applies it in
+// every market.
+func (d *Discount) WithMarket(market Market) *Discount {
+	changed := *d
+	changed.market = market
+	return &changed
+}
+
+// IsFlashSale returns true if the discount is a flash sale.
+func (d *Discount) IsFlashSale() bool {
+	return d != nil && d.flashSale
+}
+
+// AsFlashSale returns a copy of the discount marked as a flash sale. Its period is
+// otherwise like any other, but the scheduler wakes up at its boundaries instead of
+// finding them on its next poll, and its events are flagged so storefronts can flip
+// banners as the sale opens and closes.
+func (d *Discount) AsFlashSale() *Discount {
+	changed := *d
+	changed.flashSale = true
+	return &changed
+}
+
+// AppliesIn reports whether the discount applies to prices in the given market; "" is the
+// default market, the base price.
+func (d *Discount) AppliesIn(market Market) bool {
+	return d != nil && (d.market == "" || d.market == market)
+}
+
+// Kind returns whether the discount lowers the unit price or is a buy-X-get-Y promotion.
+func (d *Discount) Kind() DiscountKind {
+	if d != nil && d.promotion != nil {
+		return DiscountKindBuyXGetY
+	}
+	return DiscountKindPercentage
+}
+
+// Promotion returns the buy-X-get-Y rule of a promotion, or nil for a percentage discount.
+func (d *Discount) Promotion() *BuyXGetY {
+	if d == nil {
+		return nil
+	}
+	return d.promotion
+}
+
 // Percentage returns a copy of the discount percentage.
 func (d *Discount) Percentage() *big.Rat {
 	if d == nil || d.percentage == nil {
@@ -75,10 +297,55 @@ func (d *Discount) EndDate() time.Time {
 	return d.endDate
 }
 
+// SuspendedAt returns when the discount was suspended, or nil if it is not suspended.
+func (d *Discount) SuspendedAt() *time.Time {
+	if d == nil {
+		return nil
+	}
+	return d.suspendedAt
+}
+
+// IsSuspended returns true if the discount is suspended.
+func (d *Discount) IsSuspended() bool {
+	return d != nil && d.suspendedAt != nil
+}
+
+// Suspend returns a copy of the discount suspended at the given time.
+// A suspended discount keeps its period but is never valid until resumed.
+func (d *Discount) Suspend(at time.Time) *Discount {
+	suspended := *d
+	suspended.suspendedAt = &at
+	return &suspended
+}
+
+// Resume returns a copy of the discount that is no longer suspended.
+// The original period is kept; time spent suspended is not added back.
+func (d *Discount) Resume() *Discount {
+	resumed := *d
+	resumed.suspendedAt = nil
+	return &resumed
+}
+
+// Phase returns the last announced phase of the discount period.
+func (d *Discount) Phase() DiscountPhase {
+	if d == nil {
+		return ""
+	}
+	return d.phase
+}
+
+// WithPhase returns a copy of the discount in the given phase.
+func (d *Discount) WithPhase(phase DiscountPhase) *Discount {
+	changed := *d
+	changed.phase = phase
+	return &changed
+}
+
 // IsValidAt checks if the discount is valid at the given time.
-// A discount is valid if the time is within the start and end dates (inclusive of start, exclusive of end).
+// A discount is valid if the time is within the start and end dates (inclusive of start, exclusive of end)
+// and it is neither suspended nor pending approval.
 func (d *Discount) IsValidAt(t time.Time) bool {
-	if d == nil {
+	if d == nil || d.suspendedAt != nil || d.pendingApproval {
 		return false
 	}
 	return !t.Before(d.startDate) && t.Before(d.endDate)
@@ -105,9 +372,117 @@ func (d *Discount) HasStarted(t time.Time) bool {
 	return !t.Before(d.startDate)
 }
 
-// ApplyTo calculates the discounted price for a given Money value.
+// SharesMarket reports whether two discounts can apply to the same price: either is
+// unscoped, or both are scoped to the same market.
+func (d *Discount) SharesMarket(other *Discount) bool {
+	return d.market == "" || other.market == "" || d.market == other.market
+}
+
+// Overlaps checks if the periods of two discounts intersect.
+func (d *Discount) Overlaps(other *Discount) bool {
+	if d == nil || other == nil {
+		return false
+	}
+	return d.startDate.Before(other.endDate) && other.startDate.Before(d.endDate)
+}
+
+// TakesPrecedenceOver reports whether d applies rather than other when both are valid.
+// The higher priority wins; discounts of equal priority cannot overlap when applied
+// through Product.ApplyDiscount, but ties are broken by the later start date and then
+// the identifier so the choice is always deterministic.
+func (d *Discount) TakesPrecedenceOver(other *Discount) bool {
+	if d.priority != other.priority {
+		return d.priority > other.priority
+	}
+	if !d.startDate.Equal(other.startDate) {
+		return d.startDate.After(other.startDate)
+	}
+	return d.id > other.id
+}
+
+// ApplicableDiscount returns the discount that applies to the base price at the given
+// time: of the percentage discounts valid at t and not scoped to a market, the one that
+// takes precedence. It returns nil if none is valid.
+func ApplicableDiscount(discounts []*Discount, t time.Time) *Discount {
+	return applicableOfKind(discounts, DiscountKindPercentage, "", t)
+}
+
+// ApplicableDiscountIn is like ApplicableDiscount for the price in the given market, which
+// discounts scoped to that market also apply to.
+func ApplicableDiscountIn(discounts []*Discount, market Market, t time.Time) *Discount {
+	return applicableOfKind(discounts, DiscountKindPercentage, market, t)
+}
+
+// ApplicablePromotion returns the buy-X-get-Y promotion that applies at the given time: of
+// the promotions valid at t, the one that takes precedence. It returns nil if none is
+// valid.
+func ApplicablePromotion(discounts []*Discount, t time.Time) *Discount {
+	return applicableOfKind(discounts, DiscountKindBuyXGetY, "", t)
+}
+
+// applicableOfKind returns the discount of the given kind applying in market valid at t
+// that takes precedence, or nil if none is valid.
+func applicableOfKind(discounts []*Discount, kind DiscountKind, market Market, t time.Time) *Discount {
+	var applicable *Discount
+	for _, d := range discounts {
+		if d.Kind() == kind && d.AppliesIn(market) && d.IsValidAt(t) && (applicable == nil || d.TakesPrecedenceOver(applicable)) {
+			applicable = d
+		}
+	}
+	return applicable
+}
+
+// CurrentDiscount returns the percentage discount to present as the product's discount at
+// the given time: the one whose period contains t and that takes precedence, or else the
+// next one to start. Suspension is ignored, so a suspended discount is still presented
+// (flagged as suspended). Discounts scoped to a market and discounts pending approval are
+// left out. It returns nil if every percentage discount has expired.
+func CurrentDiscount(discounts []*Discount, t time.Time) *Discount {
+	return CurrentDiscountIn(discounts, "", t)
+}
+
+// CurrentDiscountIn is like CurrentDiscount for the price in the given market, which
+// discounts scoped to that market also apply to.
+func CurrentDiscountIn(discounts []*Discount, market Market, t time.Time) *Discount {
+	var current, next *Discount
+	for _, d := range discounts {
+		switch {
+		case d.Kind() != DiscountKindPercentage, !d.AppliesIn(market), d.IsExpired(t), d.pendingApproval:
+		case d.HasStarted(t):
+			if current == nil || d.TakesPrecedenceOver(current) {
+				current = d
+			}
+		case next == nil || d.startDate.Before(next.startDate):
+			next = d
+		}
+	}
+	if current != nil {
+		return current
+	}
+	return next
+}
+
+// SortDiscounts orders discounts by start date, then by identifier.
+func SortDiscounts(discounts []*Discount) {
+	sort.SliceStable(discounts, func(i, j int) bool {
+		if !discounts[i].startDate.Equal(discounts[j].startDate) {
+			return discounts[i].startDate.Before(discounts[j].startDate)
+		}
+		return discounts[i].id < discounts[j].id
+	})
+}
+
+// ApplyTo calculates the discounted price for a given Money value. Promotions do not
+// change the unit price. A sale price replaces prices above it in its currency, and never
+// raises a price; prices in other currencies are lowered by the implied percentage.
 func (d *Discount) ApplyTo(price *Money) *Money {
-	if d == nil || price == nil {
+	if d == nil || price == nil || d.promotion != nil {
+		return price
+	}
+	if d.salePrice != nil && d.salePrice.SameCurrency(price) {
+		if d.salePrice.LessThan(price) {
+			return d.salePrice
+		}
 		return price
 	}
 	return price.ApplyDiscount(d.percentage)
@@ -121,7 +496,22 @@ func (d *Discount) Equals(other *Discount) bool {
 	if d == nil || other == nil {
 		return false
 	}
-	return d.percentage.Cmp(other.percentage) == 0 &&
+	return d.id == other.id &&
+		d.priority == other.priority &&
+		d.market == other.market &&
+		d.percentage.Cmp(other.percentage) == 0 &&
+		d.promotion.Equals(other.promotion) &&
 		d.startDate.Equal(other.startDate) &&
-		d.endDate.Equal(other.endDate)
+		d.endDate.Equal(other.endDate) &&
+		d.IsSuspended() == other.IsSuspended() &&
+		d.pendingApproval == other.pendingApproval &&
+		d.flashSale == other.flashSale &&
+		d.salePrice.Equals(other.salePrice)
+}
+
+// hasMaxScale reports whether r can be written with at most scale decimal places,
+// i.e. whether its denominator divides 10^scale.
+func hasMaxScale(r *big.Rat, scale int64) bool {
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(scale), nil)
+	return new(big.Int).Mod(pow, r.Denom()).Sign() == 0
 }
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ParseApprovalThreshold parses the percentage above which applied discounts need
+// approval, e.g. "40". An empty string disables approvals and returns nil.
+func ParseApprovalThreshold(value string) (*big.Rat, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	threshold, ok := new(big.Rat).SetString(value)
+	if !ok || threshold.Sign() < 0 || threshold.Cmp(big.NewRat(100, 1)) >= 0 {
+		return nil, ErrInvalidApprovalThreshold
+	}
+	return threshold, nil
+}
+
+// ExceedsApprovalThreshold reports whether the discount is deep enough to need approval:
+// it is a percentage discount of more than threshold percent. A nil threshold disables
+// approvals. Buy-X-get-Y promotions never need approval.
+func (d *Discount) ExceedsApprovalThreshold(threshold *big.Rat) bool {
+	if d == nil || threshold == nil || d.Kind() != DiscountKindPercentage {
+		return false
+	}
+	return d.percentage.Cmp(threshold) > 0
+}
+
+// RequireApproval returns a copy of the discount pending approval. It is held by the
+// product but is not valid, and so does not affect the price, until approved.
+func (d *Discount) RequireApproval() *Discount {
+	pending := *d
+	pending.pendingApproval = true
+	return &pending
+}
+
+// IsPendingApproval returns true if the discount awaits approval.
+func (d *Discount) IsPendingApproval() bool {
+	return d != nil && d.pendingApproval
+}
+
+// Approve returns a copy of the discount approved by approver at the given time.
+func (d *Discount) Approve(approver string, at time.Time) *Discount {
+	approved := *d
+	approved.pendingApproval = false
+	approved.approvedBy = approver
+	approved.approvedAt = &at
+	return &approved
+}
+
+// ApprovedBy returns who approved the discount, or "" if it needed no approval or is
+// still pending.
+func (d *Discount) ApprovedBy() string {
+	if d == nil {
+		return ""
+	}
+	return d.approvedBy
+}
+
+// ApprovedAt returns when the discount was approved, or nil if it needed no approval or
+// is still pending.
+func (d *Discount) ApprovedAt() *time.Time {
+	if d == nil {
+		return nil
+	}
+	return d.approvedAt
+}
+
+// ApproveDiscount approves the discount with the given ID, which must be pending approval,
+// on behalf of approver. From then on the discount applies like any other; if its period
+// has already started, a DiscountStartedEvent announces the price change after the
+// DiscountApprovedEvent. Only active products can have discounts approved, and expired
+// discounts cannot be approved.
+func (p *Product) ApproveDiscount(id, approver string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if p.status != ProductStatusActive {
+		return ErrProductNotActive
+	}
+	approver = strings.TrimSpace(approver)
+	if approver == "" {
+		return ErrApproverRequired
+	}
+
+	for i, d := range p.discounts {
+		if d.ID() != id {
+			continue
+		}
+		if !d.IsPendingApproval() {
+			return ErrDiscountNotPendingApproval
+		}
+		if d.IsExpired(now) {
+			return ErrInvalidDiscountPeriod
+		}
+
+		approved := d.Approve(approver, now)
+		p.events = append(p.events, NewDiscountApprovedEvent(p.id, d.ID(), approver, now))
+		if approved.Phase() == DiscountPhaseScheduled && approved.HasStarted(now) {
+			approved = approved.WithPhase(DiscountPhaseStarted)
+			started := NewDiscountStartedEvent(p.id, d.ID(), d.Percentage(), d.StartDate(), d.EndDate(), now)
+			started.Promotion = d.Promotion()
+			started.FlashSale = d.IsFlashSale()
+			p.events = append(p.events, started)
+		}
+
+		p.discounts[i] = approved
+		p.updatedAt = now
+		p.changes.MarkDirty(FieldDiscount)
+		return nil
+	}
+	return ErrDiscountNotFound
+}
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseApprovalThreshold(t *testing.T) {
+	threshold, err := ParseApprovalThreshold(" 40 ")
+	require.NoError(t, err)
+	assert.Equal(t, big.NewRat(40, 1), threshold)
+
+	threshold, err = ParseApprovalThreshold("")
+	require.NoError(t, err)
+	assert.Nil(t, threshold)
+
+	for _, value := range []string{"-1", "100", "forty"} {
+		_, err := ParseApprovalThreshold(value)
+		assert.ErrorIs(t, err, ErrInvalidApprovalThreshold, value)
+	}
+}
+
+func TestDiscount_ExceedsApprovalThreshold(t *testing.T) {
+	now := time.Now()
+	threshold := big.NewRat(40, 1)
+	newDiscount := func(percent int64) *Discount {
+		d, err := NewDiscount(big.NewRat(percent, 1), now, now.Add(time.Hour))
+		require.NoError(t, err)
+		return d
+	}
+
+	assert.True(t, newDiscount(41).ExceedsApprovalThreshold(threshold))
+	assert.False(t, newDiscount(40).ExceedsApprovalThreshold(threshold), "the threshold itself needs no approval")
+	assert.False(t, newDiscount(90).ExceedsApprovalThreshold(nil))
+
+	promotion, err := NewBuyXGetY(1, 1)
+	require.NoError(t, err)
+	b1g1, err := NewBuyXGetYDiscount(promotion, now, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, b1g1.ExceedsApprovalThreshold(threshold))
+}
+
+func TestProduct_ApplyDiscountPendingApproval(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+	product.ClearEvents()
+
+	deep, err := NewDiscount(big.NewRat(60, 1), now.Add(-time.Hour), now.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(deep.WithID("deep").RequireApproval(), now))
+
+	held := product.FindDiscount("deep")
+	require.NotNil(t, held)
+	assert.True(t, held.IsPendingApproval())
+	assert.Equal(t, DiscountPhaseScheduled, held.Phase())
+	assert.Nil(t, product.ApplicableDiscount(now))
+	assert.True(t, product.EffectivePrice(now).Equals(NewMoney(20, 1)))
+	assert.Nil(t, CurrentDiscount(product.Discounts(), now))
+
+	require.Len(t, product.DomainEvents(), 1)
+	applied, ok := product.DomainEvents()[0].(DiscountAppliedEvent)
+	require.True(t, ok)
+	assert.True(t, applied.PendingApproval)
+	_, notified := NotifiedKind(applied)
+	assert.False(t, notified)
+
+	// The scheduler does not announce a discount that is not approved
+	product.ClearEvents()
+	assert.False(t, product.AdvanceDiscountPhase(now.Add(time.Minute)))
+
+	// Deactivation leaves it alone
+	require.NoError(t, product.Deactivate(now))
+	assert.False(t, product.FindDiscount("deep").IsSuspended())
+}
+
+func TestProduct_ApproveDiscount(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	setup := func(t *testing.T, start time.Time) *Product {
+		product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+		require.NoError(t, err)
+		require.NoError(t, product.Activate(now))
+		deep, err := NewDiscount(big.NewRat(60, 1), start, now.Add(24*time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, product.ApplyDiscount(deep.WithID("deep").RequireApproval(), now))
+		small, err := NewDiscount(big.NewRat(10, 1), now, now.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, product.ApplyDiscount(small.WithID("small").WithPriority(1), now))
+		product.ClearEvents()
+		product.Changes().Reset()
+		return product
+	}
+
+	t.Run("started", func(t *testing.T) {
+		product := setup(t, now.Add(-time.Hour))
+		at := now.Add(time.Minute)
+
+		require.NoError(t, product.ApproveDiscount("deep", " pricing-lead ", at))
+		approved := product.FindDiscount("deep")
+		assert.False(t, approved.IsPendingApproval())
+		assert.Equal(t, "pricing-lead", approved.ApprovedBy())
+		assert.Equal(t, at, *approved.ApprovedAt())
+		assert.Equal(t, DiscountPhaseStarted, approved.Phase())
+		assert.True(t, product.Changes().Dirty(FieldDiscount))
+		assert.Equal(t, "small", product.ApplicableDiscount(at).ID(), "the higher priority still applies")
+		assert.True(t, product.EffectivePrice(now.Add(2*time.Hour)).Equals(NewMoney(8, 1)))
+
+		require.Len(t, product.DomainEvents(), 2)
+		event, ok := product.DomainEvents()[0].(DiscountApprovedEvent)
+		require.True(t, ok)
+		assert.Equal(t, "deep", event.DiscountID)
+		assert.Equal(t, "pricing-lead", event.ApprovedBy)
+		assert.IsType(t, DiscountStartedEvent{}, product.DomainEvents()[1])
+
+		assert.ErrorIs(t, product.ApproveDiscount("deep", "pricing-lead", at), ErrDiscountNotPendingApproval)
+	})
+
+	t.Run("future-dated", func(t *testing.T) {
+		product := setup(t, now.Add(time.Hour))
+
+		require.NoError(t, product.ApproveDiscount("deep", "pricing-lead", now))
+		assert.Equal(t, DiscountPhaseScheduled, product.FindDiscount("deep").Phase())
+		require.Len(t, product.DomainEvents(), 1)
+		assert.IsType(t, DiscountApprovedEvent{}, product.DomainEvents()[0])
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		product := setup(t, now.Add(-time.Hour))
+
+		assert.ErrorIs(t, product.ApproveDiscount("deep", " ", now), ErrApproverRequired)
+		assert.ErrorIs(t, product.ApproveDiscount("missing", "pricing-lead", now), ErrDiscountNotFound)
+		assert.ErrorIs(t, product.ApproveDiscount("small", "pricing-lead", now), ErrDiscountNotPendingApproval)
+		assert.ErrorIs(t, product.ApproveDiscount("deep", "pricing-lead", now.Add(25*time.Hour)), ErrInvalidDiscountPeriod)
+
+		require.NoError(t, product.Deactivate(now))
+		assert.ErrorIs(t, product.ApproveDiscount("deep", "pricing-lead", now), ErrProductNotActive)
+	})
+}
+
+func TestProduct_ApplyDiscountDropsExpiredUnapproved(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+
+	deep, err := NewDiscount(big.NewRat(60, 1), now, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(deep.WithID("deep").RequireApproval(), now))
+
+	later := now.Add(2 * time.Hour)
+	next, err := NewDiscount(big.NewRat(10, 1), later, later.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(next.WithID("next"), later))
+	assert.Nil(t, product.FindDiscount("deep"))
+	assert.NotNil(t, product.FindDiscount("next"))
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// DiscountBlackout is a period during which the products of a category must not be
+// discounted, e.g. a week in which a manufacturer's minimum advertised price applies.
+type DiscountBlackout struct {
+	id       string
+	category string
+	start    time.Time
+	end      time.Time
+	reason   string
+}
+
+// NewDiscountBlackout creates a discount blackout of the category over [start, end).
+func NewDiscountBlackout(id, category string, start, end time.Time, reason string) (*DiscountBlackout, error) {
+	if strings.TrimSpace(id) == "" {
+		return nil, ErrInvalidID
+	}
+	if strings.TrimSpace(category) == "" || !end.After(start) {
+		return nil, ErrInvalidDiscountBlackout
+	}
+	return &DiscountBlackout{
+		id:       id,
+		category: category,
+		start:    start,
+		end:      end,
+		reason:   reason,
+	}, nil
+}
+
+// Getters
+
+func (b *DiscountBlackout) ID() string       { return b.id }
+func (b *DiscountBlackout) Category() string { return b.category }
+func (b *DiscountBlackout) Start() time.Time { return b.start }
+func (b *DiscountBlackout) End() time.Time   { return b.end }
+func (b *DiscountBlackout) Reason() string   { return b.reason }
+
+// Blocks reports whether the blackout refuses a discount on a product of the category:
+// the discount would be valid at some time during the blackout.
+func (b *DiscountBlackout) Blocks(category string, discount *Discount) bool {
+	if discount == nil || b.category != category {
+		return false
+	}
+	return discount.StartDate().Before(b.end) && discount.EndDate().After(b.start)
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDiscountBlackout(t *testing.T) {
+	start := time.Date(2024, 11, 25, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewDiscountBlackout("", "Electronics", start, start.Add(time.Hour), "")
+	assert.ErrorIs(t, err, ErrInvalidID)
+	_, err = NewDiscountBlackout("blackout-1", " ", start, start.Add(time.Hour), "")
+	assert.ErrorIs(t, err, ErrInvalidDiscountBlackout)
+	_, err = NewDiscountBlackout("blackout-1", "Electronics", start, start, "")
+	assert.ErrorIs(t, err, ErrInvalidDiscountBlackout)
+
+	b, err := NewDiscountBlackout("blackout-1", "Electronics", start, start.Add(time.Hour), "MAP week")
+	require.NoError(t, err)
+	assert.Equal(t, "Electronics", b.Category())
+	assert.Equal(t, "MAP week", b.Reason())
+}
+
+func TestDiscountBlackout_Blocks(t *testing.T) {
+	start := time.Date(2024, 11, 25, 0, 0, 0, 0, time.UTC)
+	end := start.Add(7 * 24 * time.Hour)
+	blackout, err := NewDiscountBlackout("blackout-1", "Electronics", start, end, "")
+	require.NoError(t, err)
+	discount := func(from, until time.Time) *Discount {
+		d, err := NewDiscount(big.NewRat(10, 1), from, until)
+		require.NoError(t, err)
+		return d
+	}
+
+	tests := []struct {
+		name     string
+		category string
+		discount *Discount
+		want     bool
+	}{
+		{"inside the blackout", "Electronics", discount(start.Add(time.Hour), start.Add(2*time.Hour)), true},
+		{"spanning the blackout", "Electronics", discount(start.Add(-time.Hour), end.Add(time.Hour)), true},
+		{"ending in the blackout", "Electronics", discount(start.Add(-time.Hour), start.Add(time.Second)), true},
+		{"ending at the start", "Electronics", discount(start.Add(-time.Hour), start), false},
+		{"starting at the end", "Electronics", discount(end, end.Add(time.Hour)), false},
+		{"other category", "Toys", discount(start.Add(time.Hour), start.Add(2*time.Hour)), false},
+		{"no discount", "Electronics", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, blackout.Blocks(tt.category, tt.discount))
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CompositionMode selects how the percentage discounts valid at the same time combine.
+type CompositionMode string
+
+const (
+	// CompositionNone applies only the discount that takes precedence.
+	CompositionNone CompositionMode = "none"
+	// CompositionAdditive adds the percentages up: 10% and 20% make 30%.
+	CompositionAdditive CompositionMode = "additive"
+	// CompositionMultiplicative applies each discount to the price left by the others:
+	// 10% and 20% make 28%.
+	CompositionMultiplicative CompositionMode = "multiplicative"
+)
+
+// DefaultCompositionMode is the composition mode used when none is configured.
+const DefaultCompositionMode = CompositionNone
+
+// ParseCompositionMode returns the composition mode with the given name. Names are
+// case-insensitive.
+func ParseCompositionMode(name string) (CompositionMode, error) {
+	switch CompositionMode(strings.ToLower(strings.TrimSpace(name))) {
+	case CompositionNone:
+		return CompositionNone, nil
+	case CompositionAdditive:
+		return CompositionAdditive, nil
+	case CompositionMultiplicative:
+		return CompositionMultiplicative, nil
+	}
+	return "", ErrInvalidCompositionMode
+}
+
+// DiscountComposition is a policy for combining discounts: a composition mode and caps on
+// the total percentage off a product, by default and per category. Categories without a
+// cap of their own use the default cap; without a default cap they are not capped.
+type DiscountComposition struct {
+	mode         CompositionMode
+	defaultCap   *big.Rat
+	categoryCaps map[string]*big.Rat
+}
+
+// NewDiscountComposition creates a policy with the given mode and no caps.
+func NewDiscountComposition(mode CompositionMode) *DiscountComposition {
+	return &DiscountComposition{mode: mode, categoryCaps: make(map[string]*big.Rat)}
+}
+
+// ParseDiscountComposition creates a policy from a composition mode name and a
+// comma-separated list of caps in percent: a bare percentage sets the default cap and
+// category=percentage the cap of a category, e.g. "50,Electronics=30".
+func ParseDiscountComposition(mode, caps string) (*DiscountComposition, error) {
+	m, err := ParseCompositionMode(mode)
+	if err != nil {
+		return nil, err
+	}
+	c := NewDiscountComposition(m)
+	for _, entry := range strings.Split(caps, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		category, value, perCategory := strings.Cut(entry, "=")
+		if !perCategory {
+			category, value = "", entry
+		}
+		category = strings.TrimSpace(category)
+		if perCategory && category == "" {
+			return nil, ErrInvalidDiscountCap
+		}
+		limit, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+		if !ok || limit.Sign() < 0 || limit.Cmp(big.NewRat(100, 1)) > 0 {
+			return nil, ErrInvalidDiscountCap
+		}
+		if perCategory {
+			c.categoryCaps[category] = limit
+		} else {
+			c.defaultCap = limit
+		}
+	}
+	return c, nil
+}
+
+// Mode returns the composition mode of the policy.
+func (c *DiscountComposition) Mode() CompositionMode {
+	if c == nil || c.mode == "" {
+		return DefaultCompositionMode
+	}
+	return c.mode
+}
+
+// IsDefault reports whether the policy prices as without one: only the discount that
+// takes precedence applies, and nothing is capped.
+func (c *DiscountComposition) IsDefault() bool {
+	return c.Mode() == CompositionNone && (c == nil || c.defaultCap == nil && len(c.categoryCaps) == 0)
+}
+
+// Cap returns the cap on the total percentage off a product of the category, or nil if it
+// is not capped.
+func (c *DiscountComposition) Cap(category string) *big.Rat {
+	if c == nil {
+		return nil
+	}
+	if limit, ok := c.categoryCaps[category]; ok {
+		return new(big.Rat).Set(limit)
+	}
+	if c.defaultCap != nil {
+		return new(big.Rat).Set(c.defaultCap)
+	}
+	return nil
+}
+
+// Combine returns the total percentage off of discounts with the given percentages,
+// ordered by precedence, for a product of the category. With CompositionNone only the
+// first percentage counts. The total is capped by the cap of the category and never
+// exceeds 100; it is zero without percentages.
+func (c *DiscountComposition) Combine(category string, percentages []*big.Rat) *big.Rat {
+	hundred := big.NewRat(100, 1)
+	total := new(big.Rat)
+	switch c.Mode() {
+	case CompositionAdditive:
+		for _, p := range percentages {
+			if p != nil {
+				total.Add(total, p)
+			}
+		}
+	case CompositionMultiplicative:
+		// The share of the price left is the product of the shares each discount leaves.
+		left := big.NewRat(1, 1)
+		for _, p := range percentages {
+			if p != nil {
+				share := new(big.Rat).Sub(hundred, p)
+				left.Mul(left, share.Quo(share, hundred))
+			}
+		}
+		total.Sub(big.NewRat(1, 1), left)
+		total.Mul(total, hundred)
+	default:
+		for _, p := range percentages {
+			if p != nil {
+				total.Set(p)
+				break
+			}
+		}
+	}
+
+	if limit := c.Cap(category); limit != nil && total.Cmp(limit) > 0 {
+		total = limit
+	}
+	if total.Cmp(hundred) > 0 {
+		total = hundred
+	}
+	return total
+}
+
+// ApplicableDiscounts returns the percentage discounts not scoped to a market that are
+// valid at the given time, the one that takes precedence first.
+func ApplicableDiscounts(discounts []*Discount, t time.Time) []*Discount {
+	var applicable []*Discount
+	for _, d := range discounts {
+		if d.Kind() == DiscountKindPercentage && d.AppliesIn("") && d.IsValidAt(t) {
+			applicable = append(applicable, d)
+		}
+	}
+	sort.SliceStable(applicable, func(i, j int) bool {
+		return applicable[i].TakesPrecedenceOver(applicable[j])
+	})
+	return applicable
+}
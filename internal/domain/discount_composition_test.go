@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDiscountComposition(t *testing.T) {
+	c, err := ParseDiscountComposition("Multiplicative", " 50, Electronics=30 ,Books=12.5")
+	require.NoError(t, err)
+	assert.Equal(t, CompositionMultiplicative, c.Mode())
+	assert.Equal(t, big.NewRat(50, 1), c.Cap("Tools"))
+	assert.Equal(t, big.NewRat(30, 1), c.Cap("Electronics"))
+	assert.Equal(t, big.NewRat(25, 2), c.Cap("Books"))
+
+	c, err = ParseDiscountComposition("additive", "")
+	require.NoError(t, err)
+	assert.Nil(t, c.Cap("Tools"))
+
+	for _, tt := range []struct {
+		mode, caps string
+		wantErr    error
+	}{
+		{"stacked", "", ErrInvalidCompositionMode},
+		{"additive", "101", ErrInvalidDiscountCap},
+		{"additive", "Electronics=-1", ErrInvalidDiscountCap},
+		{"additive", "=30", ErrInvalidDiscountCap},
+		{"additive", "Electronics=thirty", ErrInvalidDiscountCap},
+	} {
+		_, err := ParseDiscountComposition(tt.mode, tt.caps)
+		assert.ErrorIs(t, err, tt.wantErr, "%q %q", tt.mode, tt.caps)
+	}
+}
+
+func TestDiscountComposition_Combine(t *testing.T) {
+	percentages := []*big.Rat{big.NewRat(10, 1), big.NewRat(20, 1), big.NewRat(25, 2)}
+
+	tests := []struct {
+		name     string
+		mode     CompositionMode
+		caps     string
+		category string
+		want     *big.Rat
+	}{
+		{name: "none applies the first", mode: CompositionNone, want: big.NewRat(10, 1)},
+		{name: "additive", mode: CompositionAdditive, want: big.NewRat(85, 2)},
+		// 1 - 0.9 * 0.8 * 0.875 = 0.37
+		{name: "multiplicative", mode: CompositionMultiplicative, want: big.NewRat(37, 1)},
+		{name: "default cap", mode: CompositionAdditive, caps: "40", want: big.NewRat(40, 1)},
+		{name: "category cap", mode: CompositionMultiplicative, caps: "40,Electronics=100/3", category: "Electronics", want: big.NewRat(100, 3)},
+		{name: "cap above the total", mode: CompositionMultiplicative, caps: "50", want: big.NewRat(37, 1)},
+		{name: "cap with none", mode: CompositionNone, caps: "5", want: big.NewRat(5, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseDiscountComposition(string(tt.mode), tt.caps)
+			require.NoError(t, err)
+			got := c.Combine(tt.category, percentages)
+			assert.Zero(t, tt.want.Cmp(got), "got %s, want %s", got.RatString(), tt.want.RatString())
+		})
+	}
+
+	t.Run("additive never exceeds 100", func(t *testing.T) {
+		got := NewDiscountComposition(CompositionAdditive).Combine("", []*big.Rat{big.NewRat(60, 1), big.NewRat(70, 1)})
+		assert.Zero(t, big.NewRat(100, 1).Cmp(got))
+	})
+
+	t.Run("no discounts", func(t *testing.T) {
+		got := NewDiscountComposition(CompositionMultiplicative).Combine("", nil)
+		assert.Zero(t, got.Sign())
+	})
+
+	t.Run("nil policy", func(t *testing.T) {
+		var c *DiscountComposition
+		assert.Zero(t, big.NewRat(10, 1).Cmp(c.Combine("", percentages)))
+	})
+}
+
+func TestPricingCalculator_CalculateCompoundPrice(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Widget", "", "Electronics", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+
+	apply := func(id string, percent int64, priority int) {
+		d, err := NewDiscount(big.NewRat(percent, 1), now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, product.ApplyDiscount(d.WithID(id).WithPriority(priority), now))
+	}
+	apply("campaign", 10, 0)
+	apply("coupon", 20, 10)
+
+	tests := []struct {
+		name        string
+		composition *DiscountComposition
+		want        *Money
+	}{
+		{name: "default", want: NewMoney(16, 1)},
+		{name: "additive", composition: NewDiscountComposition(CompositionAdditive), want: NewMoney(14, 1)},
+		{name: "multiplicative", composition: NewDiscountComposition(CompositionMultiplicative), want: NewMoney(1440, 100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pc := NewPricingCalculator().WithComposition(tt.composition)
+			got := pc.CalculateCompoundPrice(product, now)
+			assert.True(t, got.Equals(tt.want), "got %s, want %s", got, tt.want)
+		})
+	}
+
+	t.Run("capped per category", func(t *testing.T) {
+		c, err := ParseDiscountComposition("additive", "50,Electronics=25")
+		require.NoError(t, err)
+		got := NewPricingCalculator().WithComposition(c).CalculateCompoundPrice(product, now)
+		assert.True(t, got.Equals(NewMoney(15, 1)), "got %s", got)
+	})
+
+	t.Run("matches the effective price by default", func(t *testing.T) {
+		assert.True(t, NewPricingCalculator().CalculateCompoundPrice(product, now).Equals(product.EffectivePrice(now)))
+	})
+}
+
+func TestPricingCalculator_CalculateCouponDiscount(t *testing.T) {
+	discounts := []*big.Rat{big.NewRat(20, 1), big.NewRat(10, 1)}
+	capped, err := ParseDiscountComposition("additive", "40")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		composition *DiscountComposition
+		coupon      *big.Rat
+		want        *big.Rat
+	}{
+		{name: "default without coupon", want: big.NewRat(20, 1)},
+		{name: "default", coupon: big.NewRat(50, 1), want: big.NewRat(60, 1)},
+		{name: "additive", composition: NewDiscountComposition(CompositionAdditive), coupon: big.NewRat(50, 1), want: big.NewRat(65, 1)},
+		{name: "coupon counts towards the cap", composition: capped, coupon: big.NewRat(50, 1), want: big.NewRat(40, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pc := NewPricingCalculator().WithComposition(tt.composition)
+			got := pc.CalculateCouponDiscount("Electronics", discounts, tt.coupon)
+			assert.Zero(t, got.Cmp(tt.want), "got %s, want %s", got.RatString(), tt.want.RatString())
+		})
+	}
+
+	assert.True(t, (*DiscountComposition)(nil).IsDefault())
+	assert.True(t, NewDiscountComposition(CompositionNone).IsDefault())
+	assert.False(t, NewDiscountComposition(CompositionAdditive).IsDefault())
+	none, err := ParseDiscountComposition("none", "Electronics=30")
+	require.NoError(t, err)
+	assert.False(t, none.IsDefault(), "a cap changes prices")
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"math"
 	"math/big"
 	"testing"
 	"time"
@@ -45,6 +46,61 @@ func TestNewDiscount_InvalidPercentage(t *testing.T) {
 	}
 }
 
+func TestNewDiscount_Precision(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		percentage *big.Rat
+		wantErr    error
+	}{
+		{"one decimal place", big.NewRat(125, 10), nil},
+		{"two decimal places", big.NewRat(3333, 100), nil},
+		{"nine decimal places", big.NewRat(1, 1_000_000_000), nil},
+		{"ten decimal places", big.NewRat(1, 10_000_000_000), ErrInvalidDiscountPrecision},
+		{"repeating decimal", big.NewRat(100, 3), ErrInvalidDiscountPrecision},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewDiscount(tt.percentage, start, end)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPercentageFromFloat(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    float64
+		expected *big.Rat
+	}{
+		{"whole percent", 20, big.NewRat(20, 1)},
+		{"half percent", 12.5, big.NewRat(25, 2)},
+		{"hundredths", 33.33, big.NewRat(3333, 100)},
+		{"hundredths truncated by float scaling", 0.29, big.NewRat(29, 100)},
+		{"not a number", math.NaN(), nil},
+		{"infinity", math.Inf(1), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PercentageFromFloat(tt.input)
+			if tt.expected == nil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Zero(t, tt.expected.Cmp(got), "got %s", got.RatString())
+		})
+	}
+}
+
 func TestNewDiscount_InvalidPeriod(t *testing.T) {
 	percentage := big.NewRat(20, 1)
 
@@ -121,6 +177,29 @@ func TestDiscount_IsValidAt(t *testing.T) {
 	}
 }
 
+func TestDiscount_SuspendResume(t *testing.T) {
+	start := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)
+	during := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	discount, err := NewDiscount(big.NewRat(15, 1), start, end)
+	require.NoError(t, err)
+
+	suspended := discount.Suspend(during)
+	assert.True(t, suspended.IsSuspended())
+	require.NotNil(t, suspended.SuspendedAt())
+	assert.Equal(t, during, *suspended.SuspendedAt())
+	assert.False(t, suspended.IsValidAt(during))
+	assert.False(t, discount.IsSuspended(), "Suspend must not modify the receiver")
+	assert.False(t, discount.Equals(suspended))
+
+	resumed := suspended.Resume()
+	assert.False(t, resumed.IsSuspended())
+	assert.Nil(t, resumed.SuspendedAt())
+	assert.True(t, resumed.IsValidAt(during))
+	assert.True(t, discount.Equals(resumed))
+}
+
 func TestDiscount_ApplyTo(t *testing.T) {
 	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
 	end := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
@@ -135,3 +214,170 @@ func TestDiscount_ApplyTo(t *testing.T) {
 	expected := NewMoney(7500, 100) // $75.00 (25% off)
 	assert.True(t, discountedPrice.Equals(expected))
 }
+
+func TestDiscount_Overlaps(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	discount, err := NewDiscount(big.NewRat(10, 1), day(10), day(20))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       bool
+	}{
+		{"before", day(1), day(5), false},
+		{"ends at start", day(1), day(10), false},
+		{"overlaps start", day(5), day(15), true},
+		{"inside", day(12), day(15), true},
+		{"contains", day(1), day(31), true},
+		{"starts at end", day(20), day(25), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other, err := NewDiscount(big.NewRat(10, 1), tt.start, tt.end)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, discount.Overlaps(other))
+			assert.Equal(t, tt.want, other.Overlaps(discount))
+		})
+	}
+}
+
+func TestApplicableDiscount(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	newDiscount := func(id string, pct int64, priority int, start, end time.Time) *Discount {
+		d, err := NewDiscount(big.NewRat(pct, 1), start, end)
+		require.NoError(t, err)
+		return d.WithID(id).WithPriority(priority)
+	}
+
+	season := newDiscount("season", 10, 0, day(1), day(31))
+	flash := newDiscount("flash", 30, 5, day(10), day(12))
+	later := newDiscount("later", 15, 0, day(20), day(25))
+	discounts := []*Discount{season, flash}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want *Discount
+	}{
+		{"before any", day(1).Add(-time.Hour), nil},
+		{"only season", day(5), season},
+		{"higher priority wins", day(11), flash},
+		{"back to season", day(12), season},
+		{"after all", day(31), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ApplicableDiscount(discounts, tt.at))
+		})
+	}
+
+	// Equal priority is broken by the later start date.
+	assert.Equal(t, later, ApplicableDiscount([]*Discount{season, later}, day(21)))
+	assert.Nil(t, ApplicableDiscount([]*Discount{flash.Suspend(day(9))}, day(11)))
+}
+
+func TestCurrentDiscount(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	newDiscount := func(id string, start, end time.Time) *Discount {
+		d, err := NewDiscount(big.NewRat(10, 1), start, end)
+		require.NoError(t, err)
+		return d.WithID(id)
+	}
+
+	first := newDiscount("first", day(5), day(10))
+	second := newDiscount("second", day(15), day(20))
+	discounts := []*Discount{second, first}
+
+	assert.Equal(t, first, CurrentDiscount(discounts, day(1)), "next to start")
+	assert.Equal(t, first, CurrentDiscount(discounts, day(6)), "in effect")
+	assert.Equal(t, second, CurrentDiscount(discounts, day(12)), "next after the first ended")
+	assert.Nil(t, CurrentDiscount(discounts, day(20)), "all expired")
+
+	suspended := first.Suspend(day(6))
+	assert.Equal(t, suspended, CurrentDiscount([]*Discount{suspended}, day(7)), "suspension is ignored")
+}
+
+func TestNewBuyXGetY(t *testing.T) {
+	tests := []struct {
+		name    string
+		buy     int
+		get     int
+		wantErr bool
+	}{
+		{"buy 2 get 1", 2, 1, false},
+		{"maximum", MaxPromotionQuantity, MaxPromotionQuantity, false},
+		{"zero buy", 0, 1, true},
+		{"zero get", 2, 0, true},
+		{"negative", -1, 1, true},
+		{"too many", MaxPromotionQuantity + 1, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := NewBuyXGetY(tt.buy, tt.get)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPromotionQuantity)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.buy, rule.BuyQuantity())
+			assert.Equal(t, tt.get, rule.GetQuantity())
+		})
+	}
+}
+
+func TestBuyXGetY_FreeQuantity(t *testing.T) {
+	rule, err := NewBuyXGetY(2, 1)
+	require.NoError(t, err)
+
+	tests := []struct {
+		quantity int64
+		want     int64
+	}{
+		{0, 0},
+		{2, 0},
+		{3, 1},
+		{5, 1},
+		{6, 2},
+		{-3, 0},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, rule.FreeQuantity(tt.quantity), "quantity %d", tt.quantity)
+	}
+}
+
+func TestBuyXGetYDiscount(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	rule, err := NewBuyXGetY(2, 1)
+	require.NoError(t, err)
+
+	promotion, err := NewBuyXGetYDiscount(rule, day(1), day(31))
+	require.NoError(t, err)
+	promotion = promotion.WithID("b2g1").WithPriority(10)
+	assert.Equal(t, DiscountKindBuyXGetY, promotion.Kind())
+	assert.Equal(t, rule, promotion.Promotion())
+	assert.True(t, promotion.ApplyTo(NewMoney(1000, 100)).Equals(NewMoney(1000, 100)), "unit price is unchanged")
+
+	season, err := NewDiscount(big.NewRat(10, 1), day(1), day(31))
+	require.NoError(t, err)
+	season = season.WithID("season")
+	assert.Equal(t, DiscountKindPercentage, season.Kind())
+	assert.Nil(t, season.Promotion())
+	assert.False(t, season.Equals(promotion.WithPriority(0)))
+
+	// Promotions never apply to the price, even with a higher priority
+	discounts := []*Discount{season, promotion}
+	assert.Equal(t, season, ApplicableDiscount(discounts, day(5)))
+	assert.Equal(t, season, CurrentDiscount(discounts, day(5)))
+	assert.Equal(t, promotion, ApplicablePromotion(discounts, day(5)))
+	assert.Nil(t, ApplicablePromotion(discounts, day(31)))
+	assert.Nil(t, ApplicableDiscount([]*Discount{promotion}, day(5)))
+
+	_, err = NewBuyXGetYDiscount(nil, day(1), day(31))
+	assert.ErrorIs(t, err, ErrInvalidPromotionQuantity)
+	_, err = NewBuyXGetYDiscount(rule, day(31), day(1))
+	assert.ErrorIs(t, err, ErrInvalidDiscountPeriod)
+}
@@ -5,21 +5,238 @@ import "errors"
 // Domain errors are sentinel values that represent business rule violations.
 var (
 	// Product errors
-	ErrProductNotFound    = errors.New("product not found")
-	ErrProductNotActive   = errors.New("product is not active")
-	ErrProductArchived    = errors.New("product is archived")
-	ErrProductAlreadyActive = errors.New("product is already active")
+	ErrProductNotFound        = errors.New("product not found")
+	ErrProductNotActive       = errors.New("product is not active")
+	ErrProductArchived        = errors.New("product is archived")
+	ErrProductAlreadyActive   = errors.New("product is already active")
 	ErrProductAlreadyInactive = errors.New("product is already inactive")
-	ErrInvalidProductName = errors.New("invalid product name")
+	ErrInvalidProductName     = errors.New("invalid product name")
 	ErrInvalidProductCategory = errors.New("invalid product category")
-	ErrInvalidBasePrice   = errors.New("base price must be positive")
+	ErrInvalidBasePrice       = errors.New("base price must be positive")
+	ErrInvalidPriceChangeTime = errors.New("scheduled price change must take effect in the future")
+	ErrNoPendingPriceChange   = errors.New("product has no pending price change")
+	ErrInvalidStatusSchedule  = errors.New("scheduled activation and deactivation must be in the future, with deactivation after activation")
+
+	// Review errors
+	ErrProductNotDraft         = errors.New("only draft products can be submitted for review")
+	ErrProductNotPendingReview = errors.New("product is not pending review")
+	ErrProductReviewRequired   = errors.New("product must be approved before it is first activated")
+	ErrSubmitterRequired       = errors.New("submitter is required")
+	ErrReviewerRequired        = errors.New("reviewer is required")
+	ErrRejectionReasonRequired = errors.New("rejection reason is required")
+	ErrReviewerIsSubmitter     = errors.New("product must be approved by someone other than its submitter")
+
+	// Attribute schema errors
+	ErrInvalidAttributeSchema   = errors.New("attribute schemas have a category and uniquely named attributes of type string, integer, decimal or boolean, with allowed values of that type")
+	ErrAttributeSchemaNotFound  = errors.New("attribute schema not found")
+	ErrAttributeSchemaViolation = errors.New("product attributes violate the attribute schema of its category")
+
+	// Rating errors
+	ErrInvalidRating   = errors.New("rating must be between 1 and 5 stars")
+	ErrInvalidReviewID = errors.New("review ID must be 1 to 100 characters")
+
+	// Purge errors
+	ErrProductNotPurgeable = errors.New("only products archived before the retention cutoff can be purged")
+
+	// Money errors
+	ErrInvalidCurrency  = errors.New("currency must be an ISO 4217 code")
+	ErrCurrencyMismatch = errors.New("amounts are in different currencies")
 
 	// Discount errors
-	ErrInvalidDiscountPercentage = errors.New("discount percentage must be between 0 and 100")
-	ErrInvalidDiscountPeriod     = errors.New("discount end date must be after start date")
-	ErrDiscountNotActive         = errors.New("discount is not active at the current time")
-	ErrDiscountAlreadyExists     = errors.New("product already has an active discount")
-	ErrNoDiscountToRemove        = errors.New("product has no discount to remove")
+	ErrInvalidDiscountPercentage  = errors.New("discount percentage must be between 0 and 100")
+	ErrInvalidDiscountPrecision   = errors.New("discount percentage must have at most 9 decimal places")
+	ErrInvalidDiscountPeriod      = errors.New("discount end date must be after start date")
+	ErrDiscountNotActive          = errors.New("discount is not active at the current time")
+	ErrDiscountAlreadyExists      = errors.New("product already has a discount with this ID")
+	ErrNoDiscountToRemove         = errors.New("product has no discount to remove")
+	ErrDiscountNotFound           = errors.New("discount not found")
+	ErrDiscountOverlap            = errors.New("discount overlaps another discount of the same priority")
+	ErrTooManyDiscounts           = errors.New("product has too many discounts")
+	ErrInvalidDiscountPriority    = errors.New("discount priority must be between 0 and 100")
+	ErrInvalidPromotionQuantity   = errors.New("promotion buy and get quantities must be between 1 and 1000")
+	ErrDiscountBelowMinimumPrice  = errors.New("discount would bring the price below the product's minimum price")
+	ErrInvalidMinimumPrice        = errors.New("minimum price must be positive")
+	ErrNegativeMargin             = errors.New("discount would bring the price below the product's cost price")
+	ErrInvalidCostPrice           = errors.New("cost price must be positive")
+	ErrNoCostPrice                = errors.New("product has no cost price")
+	ErrInvalidMarginGuard         = errors.New("margin guard must be off, warn or block")
+	ErrInvalidTargetMargin        = errors.New("target margin must be a percentage of at least 0 and below 100")
+	ErrInvalidCompositionMode     = errors.New("discount composition must be none, additive or multiplicative")
+	ErrInvalidDiscountCap         = errors.New("discount caps must be percentages between 0 and 100, optionally per category")
+	ErrInvalidApprovalThreshold   = errors.New("discount approval threshold must be a percentage between 0 and 100")
+	ErrDiscountNotPendingApproval = errors.New("discount is not pending approval")
+	ErrApproverRequired           = errors.New("approver is required")
+	ErrInvalidSalePrice           = errors.New("sale price must be below the base price and not negative")
+	ErrSalePriceMarket            = errors.New("sale prices apply to the base price and cannot be scoped to a market")
+	ErrBasePriceBelowSalePrice    = errors.New("base price must stay above the sale price of every discount that has not expired")
+
+	// Price tier errors
+	ErrInvalidPriceTierQuantity = errors.New("price tier minimum quantity must be at least 2")
+	ErrInvalidPriceTierPrice    = errors.New("price tier must have a positive unit price or a percent off between 0 and 100")
+	ErrDuplicatePriceTier       = errors.New("price tiers must have distinct minimum quantities")
+	ErrTooManyPriceTiers        = errors.New("product has too many price tiers")
+	ErrInvalidQuantity          = errors.New("quantity must be between 1 and 1000000")
+
+	// Price book errors
+	ErrInvalidPriceBookPrice      = errors.New("price book prices must be positive")
+	ErrPriceBookBaseCurrency      = errors.New("price book must not contain the product's own currency")
+	ErrDuplicatePriceBookCurrency = errors.New("price book prices must be in distinct currencies")
+	ErrTooManyPriceBookEntries    = errors.New("product has too many price book prices")
+	ErrInvalidExchangeRate        = errors.New("exchange rates must be positive decimals")
+	ErrNoExchangeRate             = errors.New("product has no price in the currency and no exchange rate is configured")
+
+	// Segment price errors
+	ErrInvalidSegment       = errors.New("segment must be lowercase letters, digits and underscores, at most 50 characters")
+	ErrInvalidSegmentPrice  = errors.New("segment price must have a positive price or a percent off between 0 and 100")
+	ErrDuplicateSegment     = errors.New("segment prices must be for distinct segments")
+	ErrTooManySegmentPrices = errors.New("product has too many segment prices")
+
+	// Market price errors
+	ErrInvalidMarket          = errors.New("market must be one of US, EU, UK")
+	ErrInvalidMarketPrice     = errors.New("market prices must be positive and in the currency of their market")
+	ErrDuplicateMarket        = errors.New("market prices must be for distinct markets")
+	ErrNoMarketPrice          = errors.New("product has no price in the discount's market")
+	ErrMarketPriceInUse       = errors.New("market price is referenced by a discount that has not expired")
+	ErrMarketCurrencyMismatch = errors.New("market prices are only available in the currency of the market")
+
+	// Tax errors
+	ErrInvalidTaxClass = errors.New("tax class must be lowercase letters, digits and underscores, at most 50 characters")
+	ErrInvalidTaxRate  = errors.New("tax rates must be decimal percentages between 0 and 100")
+	ErrNoTaxRate       = errors.New("no tax rate is configured for the product's tax class")
+
+	// Price history errors
+	ErrInvalidHistoryRange = errors.New("price history range must end after it starts")
+
+	// Revision errors
+	ErrRevisionNotFound     = errors.New("product revision not found")
+	ErrInvalidRevisionRange = errors.New("revision range must end after it starts")
+
+	// API key errors
+	ErrInvalidClientID = errors.New("client ID must be 1 to 100 characters")
+	ErrInvalidAPIKey   = errors.New("invalid API key")
+	ErrAPIKeyNotFound  = errors.New("API key not found")
+	ErrAPIKeyRevoked   = errors.New("API key has been revoked")
+
+	// Campaign errors
+	ErrInvalidCampaignName   = errors.New("invalid campaign name")
+	ErrInvalidCampaignTarget = errors.New("campaign must target either a category or up to 10000 distinct products")
+	ErrCampaignNotFound      = errors.New("campaign not found")
+	ErrCampaignNotDraft      = errors.New("campaign has already been activated")
+	ErrCampaignNotActive     = errors.New("campaign is not active")
+
+	// Price list errors
+	ErrInvalidPriceListName     = errors.New("invalid price list name")
+	ErrInvalidPriceListValidity = errors.New("price list must be valid until after it becomes valid")
+	ErrInvalidPriceListEntry    = errors.New("price list entries need a product ID and a positive price in the currency of the price list")
+	ErrDuplicatePriceListEntry  = errors.New("price list entries must be for distinct products")
+	ErrTooManyPriceListEntries  = errors.New("price list must not have more than 1000 entries")
+	ErrPriceListNotFound        = errors.New("price list not found")
+
+	// Variant errors
+	ErrInvalidSKU               = errors.New("SKU must be 1 to 64 letters, digits, hyphens and underscores")
+	ErrInvalidVariantAttributes = errors.New("variants have at most 10 attributes named with lowercase letters, digits and underscores, with values of 1 to 100 characters")
+	ErrInvalidVariantPrice      = errors.New("variant price delta must keep the variant price positive")
+	ErrDuplicateSKU             = errors.New("SKU is already used by another product or variant")
+	ErrVariantNotFound          = errors.New("variant not found")
+	ErrVariantDiscontinued      = errors.New("variant is discontinued")
+
+	// Tag and attribute errors
+	ErrInvalidTag               = errors.New("tag must be 1 to 50 lowercase letters, digits, hyphens and underscores")
+	ErrTooManyTags              = errors.New("product has too many tags")
+	ErrInvalidProductAttribute  = errors.New("product attributes are named with up to 50 lowercase letters, digits and underscores, with values of at most 500 characters")
+	ErrTooManyProductAttributes = errors.New("product has too many attributes")
+
+	// Slug errors
+	ErrInvalidSlug = errors.New("slug must be at most 100 lowercase letters and digits in words separated by hyphens")
+	ErrSlugTaken   = errors.New("slug is already used by another product")
+
+	// Identifier errors
+	ErrInvalidGTIN   = errors.New("GTIN must be 8, 12, 13 or 14 digits ending in a valid check digit")
+	ErrDuplicateGTIN = errors.New("GTIN is already used by another product")
+
+	// Sales channel errors
+	ErrInvalidSalesChannel = errors.New("sales channel must be web, retail or marketplace")
+
+	// Tenant errors
+	ErrInvalidTenantID = errors.New("tenant ID must be at most 100 characters")
+
+	// Dimension errors
+	ErrInvalidWeight     = errors.New("weight must be a positive value in g, kg, lb or oz")
+	ErrInvalidDimensions = errors.New("dimensions must be a positive height, width and depth in mm, cm, m or in")
+
+	// Kind errors
+	ErrInvalidProductKind    = errors.New("product kind must be physical or digital")
+	ErrMeasuresRequired      = errors.New("physical products must have a weight and dimensions")
+	ErrMeasuresNotApplicable = errors.New("digital products have no weight or dimensions")
+	ErrStockNotApplicable    = errors.New("digital products have no stock")
+
+	// Compliance errors
+	ErrInvalidCompliance         = errors.New("minimum age must be 0 to 99 and restricted markets must be known markets")
+	ErrInvalidCompliancePolicy   = errors.New("age-restricted categories must be a comma-separated list of categories")
+	ErrAgeRestrictedCategory     = errors.New("age-restricted products cannot be sold in this category")
+	ErrHazardousMeasuresRequired = errors.New("hazardous products must have a weight and dimensions")
+	ErrRestrictedMarketPrice     = errors.New("product has a price in a market it is restricted in")
+
+	// Image errors
+	ErrInvalidImageURL       = errors.New("image URL must be an absolute http or https URL of at most 2048 characters")
+	ErrInvalidImageAltText   = errors.New("image alt text must be at most 250 characters")
+	ErrTooManyImages         = errors.New("product has too many images")
+	ErrDuplicateImage        = errors.New("image URLs of a product must be distinct")
+	ErrMultiplePrimaryImages = errors.New("product can have only one primary image")
+	ErrInvalidImageOrder     = errors.New("image order must list the URL of every image of the product exactly once")
+
+	// Attachment errors
+	ErrInvalidAttachmentType = errors.New("attachment type must be spec_sheet, manual, safety_data_sheet, certificate or warranty")
+	ErrInvalidAttachmentURL  = errors.New("attachment URL must be an absolute http or https URL of at most 2048 characters")
+	ErrTooManyAttachments    = errors.New("product has too many attachments")
+	ErrDuplicateAttachment   = errors.New("attachment URLs of a product must be distinct")
+
+	// Relation errors
+	ErrInvalidRelationType = errors.New("relation type must be related, accessory or replacement")
+	ErrSelfRelation        = errors.New("a product cannot be linked to itself")
+	ErrRelationCycle       = errors.New("link would make a product its own accessory or replacement")
+	ErrTooManyRelations    = errors.New("product has too many links of the relation type")
+
+	// Translation errors
+	ErrInvalidLocale            = errors.New("locale must be a language tag such as de or pt-BR")
+	ErrDefaultLocaleTranslation = errors.New("the name and description of a product are in the default locale; update the product instead")
+	ErrInvalidTranslatedName    = errors.New("translated name must be 1 to 255 characters")
+
+	// Stock errors
+	ErrInvalidStock        = errors.New("stock level and reserved units must be between 0 and 1000000000, with no more units reserved than on hand")
+	ErrInsufficientStock   = errors.New("not enough units in stock for the adjustment")
+	ErrInvalidStockVersion = errors.New("expected stock version must not be negative")
+	ErrStockConflict       = errors.New("stock was changed concurrently")
+
+	// Freeze window errors
+	ErrInvalidFreezeWindow  = errors.New("freeze window must end after it starts")
+	ErrCatalogFrozen        = errors.New("price and discount changes are frozen")
+	ErrFreezeWindowNotFound = errors.New("freeze window not found")
+
+	// Discount blackout errors
+	ErrInvalidDiscountBlackout  = errors.New("discount blackout needs a category and must end after it starts")
+	ErrDiscountBlackedOut       = errors.New("discounts are blacked out for the category")
+	ErrDiscountBlackoutNotFound = errors.New("discount blackout not found")
+
+	// Listing errors
+	ErrInvalidOrderBy     = errors.New("order_by must be product_id, merchandised, rating, or name, created_at, updated_at or price, optionally followed by \" desc\"")
+	ErrInvalidPageToken   = errors.New("invalid page token")
+	ErrInvalidPageNumber  = errors.New("page_number must not be negative, set with a page token, or page past the first 10000 products")
+	ErrInvalidPriceAt     = errors.New("price_at must be at most 30 days in the past and 366 days in the future")
+	ErrInvalidSearchQuery = errors.New("search query must not be empty or longer than 200 characters")
+	ErrInvalidPriceRange  = errors.New("min_price and max_price must be non-negative prices in the same currency, min_price at most max_price")
+
+	// Read errors
+	ErrInvalidReadStaleness = errors.New("read staleness must be \"strong\", a duration of at most 1h such as \"15s\", or \"exact:\" followed by one")
+
+	// Rounding errors
+	ErrInvalidRoundingPolicy = errors.New("rounding policy must be half_up, bankers or charm")
+
+	// Experiment errors
+	ErrSubjectIDTooLong = errors.New("experiment subject ID must not be longer than 128 characters")
+
+	// Notification errors
+	ErrInvalidNotificationKind = errors.New("invalid notification kind")
 
 	// General errors
 	ErrInvalidID = errors.New("invalid ID")
@@ -84,6 +84,32 @@ func NewProductUpdatedEvent(productID, name, description, category string, occur
 	}
 }
 
+// ProductPriceChangedEvent is raised when the base price of a product changes.
+type ProductPriceChangedEvent struct {
+	BaseEvent
+	OldPrice *Money
+	NewPrice *Money
+	// EffectiveAt is when a scheduled change was due; it is zero for immediate changes.
+	EffectiveAt time.Time
+}
+
+// EventType returns the event type identifier.
+func (e ProductPriceChangedEvent) EventType() string {
+	return "product.price_changed"
+}
+
+// NewProductPriceChangedEvent creates a new ProductPriceChangedEvent.
+func NewProductPriceChangedEvent(productID string, oldPrice, newPrice *Money, occurredAt time.Time) ProductPriceChangedEvent {
+	return ProductPriceChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		OldPrice: oldPrice,
+		NewPrice: newPrice,
+	}
+}
+
 // ProductActivatedEvent is raised when a product is activated.
 type ProductActivatedEvent struct {
 	BaseEvent
@@ -147,9 +173,23 @@ func NewProductArchivedEvent(productID string, occurredAt time.Time) ProductArch
 // DiscountAppliedEvent is raised when a discount is applied to a product.
 type DiscountAppliedEvent struct {
 	BaseEvent
+	DiscountID         string
 	DiscountPercentage *big.Rat
+	Priority           int
 	StartDate          time.Time
 	EndDate            time.Time
+	// Promotion is the rule of a buy-X-get-Y promotion, nil for a percentage discount.
+	Promotion *BuyXGetY
+	// Market is the market the discount is scoped to, empty if it applies in every market.
+	Market Market
+	// PendingApproval is set if the discount does not apply until approved; see
+	// DiscountApprovedEvent.
+	PendingApproval bool
+	// FlashSale is set if the discount is a flash sale.
+	FlashSale bool
+	// SalePrice is the sale price of a discount expressed as one, nil otherwise.
+	// DiscountPercentage is then the implied percentage.
+	SalePrice *Money
 }
 
 // EventType returns the event type identifier.
@@ -158,21 +198,48 @@ func (e DiscountAppliedEvent) EventType() string {
 }
 
 // NewDiscountAppliedEvent creates a new DiscountAppliedEvent.
-func NewDiscountAppliedEvent(productID string, percentage *big.Rat, startDate, endDate, occurredAt time.Time) DiscountAppliedEvent {
+func NewDiscountAppliedEvent(productID, discountID string, percentage *big.Rat, priority int, startDate, endDate, occurredAt time.Time) DiscountAppliedEvent {
 	return DiscountAppliedEvent{
 		BaseEvent: BaseEvent{
 			aggregateID: productID,
 			occurredAt:  occurredAt,
 		},
+		DiscountID:         discountID,
 		DiscountPercentage: percentage,
+		Priority:           priority,
 		StartDate:          startDate,
 		EndDate:            endDate,
 	}
 }
 
+// DiscountApprovedEvent is raised when a discount pending approval is approved.
+type DiscountApprovedEvent struct {
+	BaseEvent
+	DiscountID string
+	ApprovedBy string
+}
+
+// EventType returns the event type identifier.
+func (e DiscountApprovedEvent) EventType() string {
+	return "product.discount_approved"
+}
+
+// NewDiscountApprovedEvent creates a new DiscountApprovedEvent.
+func NewDiscountApprovedEvent(productID, discountID, approvedBy string, occurredAt time.Time) DiscountApprovedEvent {
+	return DiscountApprovedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		DiscountID: discountID,
+		ApprovedBy: approvedBy,
+	}
+}
+
 // DiscountRemovedEvent is raised when a discount is removed from a product.
 type DiscountRemovedEvent struct {
 	BaseEvent
+	DiscountID string
 }
 
 // EventType returns the event type identifier.
@@ -181,11 +248,1070 @@ func (e DiscountRemovedEvent) EventType() string {
 }
 
 // NewDiscountRemovedEvent creates a new DiscountRemovedEvent.
-func NewDiscountRemovedEvent(productID string, occurredAt time.Time) DiscountRemovedEvent {
+func NewDiscountRemovedEvent(productID, discountID string, occurredAt time.Time) DiscountRemovedEvent {
 	return DiscountRemovedEvent{
 		BaseEvent: BaseEvent{
 			aggregateID: productID,
 			occurredAt:  occurredAt,
 		},
+		DiscountID: discountID,
+	}
+}
+
+// DiscountSuspendedEvent is raised when a product is deactivated while it has discounts
+// that have not expired. They are kept but do not apply until the product is activated.
+type DiscountSuspendedEvent struct {
+	BaseEvent
+}
+
+// EventType returns the event type identifier.
+func (e DiscountSuspendedEvent) EventType() string {
+	return "product.discount_suspended"
+}
+
+// NewDiscountSuspendedEvent creates a new DiscountSuspendedEvent.
+func NewDiscountSuspendedEvent(productID string, occurredAt time.Time) DiscountSuspendedEvent {
+	return DiscountSuspendedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+	}
+}
+
+// DiscountResumedEvent is raised when a product is activated and its suspended discounts
+// apply again.
+type DiscountResumedEvent struct {
+	BaseEvent
+}
+
+// EventType returns the event type identifier.
+func (e DiscountResumedEvent) EventType() string {
+	return "product.discount_resumed"
+}
+
+// NewDiscountResumedEvent creates a new DiscountResumedEvent.
+func NewDiscountResumedEvent(productID string, occurredAt time.Time) DiscountResumedEvent {
+	return DiscountResumedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+	}
+}
+
+// DiscountExpiredEvent is raised when a product is activated and one of its suspended
+// discounts ended while the product was inactive. The discount is removed.
+type DiscountExpiredEvent struct {
+	BaseEvent
+	DiscountID string
+}
+
+// EventType returns the event type identifier.
+func (e DiscountExpiredEvent) EventType() string {
+	return "product.discount_expired"
+}
+
+// NewDiscountExpiredEvent creates a new DiscountExpiredEvent.
+func NewDiscountExpiredEvent(productID, discountID string, occurredAt time.Time) DiscountExpiredEvent {
+	return DiscountExpiredEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		DiscountID: discountID,
+	}
+}
+
+// DiscountStartedEvent is raised when the period of a future-dated discount begins and
+// the discount starts to apply to the effective price.
+type DiscountStartedEvent struct {
+	BaseEvent
+	DiscountID         string
+	DiscountPercentage *big.Rat
+	StartDate          time.Time
+	EndDate            time.Time
+	// Promotion is the rule of a buy-X-get-Y promotion, nil for a percentage discount.
+	Promotion *BuyXGetY
+	// FlashSale is set if the discount is a flash sale.
+	FlashSale bool
+}
+
+// EventType returns the event type identifier.
+func (e DiscountStartedEvent) EventType() string {
+	return "product.discount_started"
+}
+
+// NewDiscountStartedEvent creates a new DiscountStartedEvent.
+func NewDiscountStartedEvent(productID, discountID string, percentage *big.Rat, startDate, endDate, occurredAt time.Time) DiscountStartedEvent {
+	return DiscountStartedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		DiscountID:         discountID,
+		DiscountPercentage: percentage,
+		StartDate:          startDate,
+		EndDate:            endDate,
+	}
+}
+
+// DiscountEndedEvent is raised when the period of a discount ends and it stops applying
+// to the effective price. The expired discount stays on the product until the next
+// discount is applied.
+type DiscountEndedEvent struct {
+	BaseEvent
+	DiscountID string
+	EndDate    time.Time
+	// FlashSale is set if the discount is a flash sale.
+	FlashSale bool
+}
+
+// EventType returns the event type identifier.
+func (e DiscountEndedEvent) EventType() string {
+	return "product.discount_ended"
+}
+
+// NewDiscountEndedEvent creates a new DiscountEndedEvent.
+func NewDiscountEndedEvent(productID, discountID string, endDate, occurredAt time.Time) DiscountEndedEvent {
+	return DiscountEndedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		DiscountID: discountID,
+		EndDate:    endDate,
+	}
+}
+
+// PriceTiersChangedEvent is raised when the price tiers of a product are replaced.
+// Tiers holds the complete new schedule, ordered by minimum quantity; unit prices are in
+// Currency, the currency of the product.
+type PriceTiersChangedEvent struct {
+	BaseEvent
+	Currency string
+	Tiers    []*PriceTier
+}
+
+// EventType returns the event type identifier.
+func (e PriceTiersChangedEvent) EventType() string {
+	return "product.price_tiers_changed"
+}
+
+// NewPriceTiersChangedEvent creates a new PriceTiersChangedEvent.
+func NewPriceTiersChangedEvent(productID, currency string, tiers []*PriceTier, occurredAt time.Time) PriceTiersChangedEvent {
+	return PriceTiersChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Currency: currency,
+		Tiers:    tiers,
+	}
+}
+
+// SegmentPricesChangedEvent is raised when the customer segment prices of a product are
+// replaced. Prices holds the complete new list, ordered by segment; fixed prices are in
+// Currency, the currency of the product.
+type SegmentPricesChangedEvent struct {
+	BaseEvent
+	Currency string
+	Prices   []*SegmentPrice
+}
+
+// EventType returns the event type identifier.
+func (e SegmentPricesChangedEvent) EventType() string {
+	return "product.segment_prices_changed"
+}
+
+// NewSegmentPricesChangedEvent creates a new SegmentPricesChangedEvent.
+func NewSegmentPricesChangedEvent(productID, currency string, prices []*SegmentPrice, occurredAt time.Time) SegmentPricesChangedEvent {
+	return SegmentPricesChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Currency: currency,
+		Prices:   prices,
+	}
+}
+
+// MarketPricesChangedEvent is raised when the market prices of a product are replaced.
+// Prices holds the complete new list, ordered by market; each price is in the currency of
+// its market.
+type MarketPricesChangedEvent struct {
+	BaseEvent
+	Prices []*MarketPrice
+}
+
+// EventType returns the event type identifier.
+func (e MarketPricesChangedEvent) EventType() string {
+	return "product.market_prices_changed"
+}
+
+// NewMarketPricesChangedEvent creates a new MarketPricesChangedEvent.
+func NewMarketPricesChangedEvent(productID string, prices []*MarketPrice, occurredAt time.Time) MarketPricesChangedEvent {
+	return MarketPricesChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Prices: prices,
+	}
+}
+
+// PriceBookChangedEvent is raised when the prices of a product in other currencies are
+// replaced. Prices holds the complete new price book, ordered by currency.
+type PriceBookChangedEvent struct {
+	BaseEvent
+	Prices []*Money
+}
+
+// EventType returns the event type identifier.
+func (e PriceBookChangedEvent) EventType() string {
+	return "product.price_book_changed"
+}
+
+// NewPriceBookChangedEvent creates a new PriceBookChangedEvent.
+func NewPriceBookChangedEvent(productID string, prices []*Money, occurredAt time.Time) PriceBookChangedEvent {
+	return PriceBookChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Prices: prices,
+	}
+}
+
+// TaxClassChangedEvent is raised when the tax class of a product changes.
+type TaxClassChangedEvent struct {
+	BaseEvent
+	OldTaxClass TaxClass
+	NewTaxClass TaxClass
+}
+
+// EventType returns the event type identifier.
+func (e TaxClassChangedEvent) EventType() string {
+	return "product.tax_class_changed"
+}
+
+// NewTaxClassChangedEvent creates a new TaxClassChangedEvent.
+func NewTaxClassChangedEvent(productID string, oldClass, newClass TaxClass, occurredAt time.Time) TaxClassChangedEvent {
+	return TaxClassChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		OldTaxClass: oldClass,
+		NewTaxClass: newClass,
+	}
+}
+
+// CampaignCreatedEvent is raised when a campaign is created.
+type CampaignCreatedEvent struct {
+	BaseEvent
+	Name               string
+	Category           string
+	ProductCount       int
+	DiscountPercentage *big.Rat
+	Priority           int
+	StartDate          time.Time
+	EndDate            time.Time
+}
+
+// EventType returns the event type identifier.
+func (e CampaignCreatedEvent) EventType() string {
+	return "campaign.created"
+}
+
+// NewCampaignCreatedEvent creates a new CampaignCreatedEvent.
+func NewCampaignCreatedEvent(c *Campaign, occurredAt time.Time) CampaignCreatedEvent {
+	return CampaignCreatedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: c.id,
+			occurredAt:  occurredAt,
+		},
+		Name:               c.name,
+		Category:           c.category,
+		ProductCount:       len(c.productIDs),
+		DiscountPercentage: c.discount.Percentage(),
+		Priority:           c.discount.Priority(),
+		StartDate:          c.discount.StartDate(),
+		EndDate:            c.discount.EndDate(),
+	}
+}
+
+// CampaignActivatedEvent is raised when the discount of a campaign has been applied to
+// its products.
+type CampaignActivatedEvent struct {
+	BaseEvent
+	AppliedCount int
+	SkippedCount int
+}
+
+// EventType returns the event type identifier.
+func (e CampaignActivatedEvent) EventType() string {
+	return "campaign.activated"
+}
+
+// NewCampaignActivatedEvent creates a new CampaignActivatedEvent.
+func NewCampaignActivatedEvent(campaignID string, applied, skipped int, occurredAt time.Time) CampaignActivatedEvent {
+	return CampaignActivatedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: campaignID,
+			occurredAt:  occurredAt,
+		},
+		AppliedCount: applied,
+		SkippedCount: skipped,
+	}
+}
+
+// CampaignEndedEvent is raised when the discount of a campaign has been removed from its
+// products.
+type CampaignEndedEvent struct {
+	BaseEvent
+	RemovedCount int
+}
+
+// EventType returns the event type identifier.
+func (e CampaignEndedEvent) EventType() string {
+	return "campaign.ended"
+}
+
+// NewCampaignEndedEvent creates a new CampaignEndedEvent.
+func NewCampaignEndedEvent(campaignID string, removed int, occurredAt time.Time) CampaignEndedEvent {
+	return CampaignEndedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: campaignID,
+			occurredAt:  occurredAt,
+		},
+		RemovedCount: removed,
+	}
+}
+
+// PriceListCreatedEvent is raised when a price list is created.
+type PriceListCreatedEvent struct {
+	BaseEvent
+	Name      string
+	Currency  string
+	ValidFrom time.Time
+	// ValidUntil is the zero time if the price list stays valid indefinitely.
+	ValidUntil time.Time
+}
+
+// EventType returns the event type identifier.
+func (e PriceListCreatedEvent) EventType() string {
+	return "price_list.created"
+}
+
+// NewPriceListCreatedEvent creates a new PriceListCreatedEvent.
+func NewPriceListCreatedEvent(l *PriceList, occurredAt time.Time) PriceListCreatedEvent {
+	return PriceListCreatedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: l.id,
+			occurredAt:  occurredAt,
+		},
+		Name:       l.name,
+		Currency:   l.currency,
+		ValidFrom:  l.validFrom,
+		ValidUntil: l.validUntil,
+	}
+}
+
+// PriceListEntriesChangedEvent is raised when the entries of a price list are replaced.
+type PriceListEntriesChangedEvent struct {
+	BaseEvent
+	Entries []*PriceListEntry
+}
+
+// EventType returns the event type identifier.
+func (e PriceListEntriesChangedEvent) EventType() string {
+	return "price_list.entries_changed"
+}
+
+// NewPriceListEntriesChangedEvent creates a new PriceListEntriesChangedEvent.
+func NewPriceListEntriesChangedEvent(priceListID string, entries []*PriceListEntry, occurredAt time.Time) PriceListEntriesChangedEvent {
+	return PriceListEntriesChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: priceListID,
+			occurredAt:  occurredAt,
+		},
+		Entries: entries,
+	}
+}
+
+// MinimumPriceChangedEvent is raised when the minimum price of a product is set or
+// removed.
+type MinimumPriceChangedEvent struct {
+	BaseEvent
+	// MinimumPrice is nil if the minimum price was removed.
+	MinimumPrice *Money
+}
+
+// EventType returns the event type identifier.
+func (e MinimumPriceChangedEvent) EventType() string {
+	return "product.minimum_price_changed"
+}
+
+// NewMinimumPriceChangedEvent creates a new MinimumPriceChangedEvent.
+func NewMinimumPriceChangedEvent(productID string, price *Money, occurredAt time.Time) MinimumPriceChangedEvent {
+	return MinimumPriceChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		MinimumPrice: price,
+	}
+}
+
+// CostPriceChangedEvent is raised when the cost price of a product is set or removed.
+type CostPriceChangedEvent struct {
+	BaseEvent
+	// CostPrice is nil if the cost price was removed.
+	CostPrice *Money
+}
+
+// EventType returns the event type identifier.
+func (e CostPriceChangedEvent) EventType() string {
+	return "product.cost_price_changed"
+}
+
+// NewCostPriceChangedEvent creates a new CostPriceChangedEvent.
+func NewCostPriceChangedEvent(productID string, price *Money, occurredAt time.Time) CostPriceChangedEvent {
+	return CostPriceChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		CostPrice: price,
+	}
+}
+
+// PriceChangeScheduledEvent is raised when a base price change is scheduled.
+type PriceChangeScheduledEvent struct {
+	BaseEvent
+	NewPrice    *Money
+	EffectiveAt time.Time
+}
+
+// EventType returns the event type identifier.
+func (e PriceChangeScheduledEvent) EventType() string {
+	return "product.price_change_scheduled"
+}
+
+// NewPriceChangeScheduledEvent creates a new PriceChangeScheduledEvent.
+func NewPriceChangeScheduledEvent(productID string, price *Money, effectiveAt, occurredAt time.Time) PriceChangeScheduledEvent {
+	return PriceChangeScheduledEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		NewPrice:    price,
+		EffectiveAt: effectiveAt,
+	}
+}
+
+// PriceChangeCancelledEvent is raised when a pending base price change is cancelled.
+type PriceChangeCancelledEvent struct {
+	BaseEvent
+}
+
+// EventType returns the event type identifier.
+func (e PriceChangeCancelledEvent) EventType() string {
+	return "product.price_change_cancelled"
+}
+
+// NewPriceChangeCancelledEvent creates a new PriceChangeCancelledEvent.
+func NewPriceChangeCancelledEvent(productID string, occurredAt time.Time) PriceChangeCancelledEvent {
+	return PriceChangeCancelledEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+	}
+}
+
+// ProductStatusScheduledEvent is raised when the scheduled activation and deactivation of
+// a product are set or cleared. It carries both; a nil time is not scheduled.
+type ProductStatusScheduledEvent struct {
+	BaseEvent
+	ActivateAt   *time.Time
+	DeactivateAt *time.Time
+}
+
+// EventType returns the event type identifier.
+func (e ProductStatusScheduledEvent) EventType() string {
+	return "product.status_scheduled"
+}
+
+// NewProductStatusScheduledEvent creates a new ProductStatusScheduledEvent.
+func NewProductStatusScheduledEvent(productID string, activateAt, deactivateAt *time.Time, occurredAt time.Time) ProductStatusScheduledEvent {
+	return ProductStatusScheduledEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		ActivateAt:   activateAt,
+		DeactivateAt: deactivateAt,
+	}
+}
+
+// ProductSubmittedForReviewEvent is raised when a draft product is submitted for review.
+type ProductSubmittedForReviewEvent struct {
+	BaseEvent
+	SubmittedBy string
+}
+
+// EventType returns the event type identifier.
+func (e ProductSubmittedForReviewEvent) EventType() string {
+	return "product.submitted_for_review"
+}
+
+// NewProductSubmittedForReviewEvent creates a new ProductSubmittedForReviewEvent.
+func NewProductSubmittedForReviewEvent(productID, submittedBy string, occurredAt time.Time) ProductSubmittedForReviewEvent {
+	return ProductSubmittedForReviewEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		SubmittedBy: submittedBy,
+	}
+}
+
+// ProductApprovedEvent is raised when a product pending review is approved. It is
+// followed by the ProductActivatedEvent of its activation.
+type ProductApprovedEvent struct {
+	BaseEvent
+	Reviewer string
+}
+
+// EventType returns the event type identifier.
+func (e ProductApprovedEvent) EventType() string {
+	return "product.approved"
+}
+
+// NewProductApprovedEvent creates a new ProductApprovedEvent.
+func NewProductApprovedEvent(productID, reviewer string, occurredAt time.Time) ProductApprovedEvent {
+	return ProductApprovedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Reviewer: reviewer,
+	}
+}
+
+// ProductRejectedEvent is raised when a product pending review is rejected and returns
+// to draft.
+type ProductRejectedEvent struct {
+	BaseEvent
+	Reviewer string
+	Reason   string
+}
+
+// EventType returns the event type identifier.
+func (e ProductRejectedEvent) EventType() string {
+	return "product.rejected"
+}
+
+// NewProductRejectedEvent creates a new ProductRejectedEvent.
+func NewProductRejectedEvent(productID, reviewer, reason string, occurredAt time.Time) ProductRejectedEvent {
+	return ProductRejectedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Reviewer: reviewer,
+		Reason:   reason,
+	}
+}
+
+// ProductTagsChangedEvent is raised when a tag is added to or removed from a product. It
+// carries all tags of the product.
+type ProductTagsChangedEvent struct {
+	BaseEvent
+	Tags []string
+}
+
+// EventType returns the event type identifier.
+func (e ProductTagsChangedEvent) EventType() string {
+	return "product.tags_changed"
+}
+
+// NewProductTagsChangedEvent creates a new ProductTagsChangedEvent.
+func NewProductTagsChangedEvent(productID string, tags []string, occurredAt time.Time) ProductTagsChangedEvent {
+	return ProductTagsChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Tags: tags,
+	}
+}
+
+// ProductChannelAvailabilityChangedEvent is raised when a product is made available on,
+// or withheld from, a sales channel. It carries all channels the product is available on.
+type ProductChannelAvailabilityChangedEvent struct {
+	BaseEvent
+	Channels []SalesChannel
+}
+
+// EventType returns the event type identifier.
+func (e ProductChannelAvailabilityChangedEvent) EventType() string {
+	return "product.channel_availability_changed"
+}
+
+// NewProductChannelAvailabilityChangedEvent creates a new ProductChannelAvailabilityChangedEvent.
+func NewProductChannelAvailabilityChangedEvent(productID string, channels []SalesChannel, occurredAt time.Time) ProductChannelAvailabilityChangedEvent {
+	return ProductChannelAvailabilityChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Channels: channels,
+	}
+}
+
+// ProductAttributeChangedEvent is raised when an attribute of a product is set or removed.
+type ProductAttributeChangedEvent struct {
+	BaseEvent
+	Name string
+	// Value is empty if the attribute was removed.
+	Value string
+}
+
+// EventType returns the event type identifier.
+func (e ProductAttributeChangedEvent) EventType() string {
+	return "product.attribute_changed"
+}
+
+// NewProductAttributeChangedEvent creates a new ProductAttributeChangedEvent.
+func NewProductAttributeChangedEvent(productID, name, value string, occurredAt time.Time) ProductAttributeChangedEvent {
+	return ProductAttributeChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Name:  name,
+		Value: value,
+	}
+}
+
+// ProductSlugChangedEvent is raised when the slug of a product is changed.
+type ProductSlugChangedEvent struct {
+	BaseEvent
+	Slug string
+	// PreviousSlug is empty if the product had no slug.
+	PreviousSlug string
+}
+
+// EventType returns the event type identifier.
+func (e ProductSlugChangedEvent) EventType() string {
+	return "product.slug_changed"
+}
+
+// NewProductSlugChangedEvent creates a new ProductSlugChangedEvent.
+func NewProductSlugChangedEvent(productID, slug, previousSlug string, occurredAt time.Time) ProductSlugChangedEvent {
+	return ProductSlugChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Slug:         slug,
+		PreviousSlug: previousSlug,
+	}
+}
+
+// ProductIdentifiersChangedEvent is raised when the SKU or GTIN of a product is set or
+// cleared. It carries both identifiers; an empty one is unset.
+type ProductIdentifiersChangedEvent struct {
+	BaseEvent
+	SKU  string
+	GTIN string
+}
+
+// EventType returns the event type identifier.
+func (e ProductIdentifiersChangedEvent) EventType() string {
+	return "product.identifiers_changed"
+}
+
+// NewProductIdentifiersChangedEvent creates a new ProductIdentifiersChangedEvent.
+func NewProductIdentifiersChangedEvent(productID, sku, gtin string, occurredAt time.Time) ProductIdentifiersChangedEvent {
+	return ProductIdentifiersChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		SKU:  sku,
+		GTIN: gtin,
+	}
+}
+
+// ProductDimensionsChangedEvent is raised when the weight or dimensions of a product are
+// set or cleared. It carries both; a nil one is unset.
+type ProductDimensionsChangedEvent struct {
+	BaseEvent
+	Weight     *Weight
+	Dimensions *Dimensions
+}
+
+// EventType returns the event type identifier.
+func (e ProductDimensionsChangedEvent) EventType() string {
+	return "product.dimensions_changed"
+}
+
+// NewProductDimensionsChangedEvent creates a new ProductDimensionsChangedEvent.
+func NewProductDimensionsChangedEvent(productID string, weight *Weight, dimensions *Dimensions, occurredAt time.Time) ProductDimensionsChangedEvent {
+	return ProductDimensionsChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Weight:     weight,
+		Dimensions: dimensions,
+	}
+}
+
+// ProductKindChangedEvent is raised when a product becomes physical or digital.
+type ProductKindChangedEvent struct {
+	BaseEvent
+	Kind ProductKind
+}
+
+// EventType returns the event type identifier.
+func (e ProductKindChangedEvent) EventType() string {
+	return "product.kind_changed"
+}
+
+// NewProductKindChangedEvent creates a new ProductKindChangedEvent.
+func NewProductKindChangedEvent(productID string, kind ProductKind, occurredAt time.Time) ProductKindChangedEvent {
+	return ProductKindChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Kind: kind,
+	}
+}
+
+// ProductComplianceChangedEvent is raised when the compliance flags of a product are set
+// or cleared. Compliance is nil once they are cleared.
+type ProductComplianceChangedEvent struct {
+	BaseEvent
+	Compliance *Compliance
+}
+
+// EventType returns the event type identifier.
+func (e ProductComplianceChangedEvent) EventType() string {
+	return "product.compliance_changed"
+}
+
+// NewProductComplianceChangedEvent creates a new ProductComplianceChangedEvent.
+func NewProductComplianceChangedEvent(productID string, compliance *Compliance, occurredAt time.Time) ProductComplianceChangedEvent {
+	return ProductComplianceChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Compliance: compliance,
+	}
+}
+
+// ProductAttachmentsChangedEvent is raised when the attachments of a product are replaced.
+// It carries all attachments of the product in display order.
+type ProductAttachmentsChangedEvent struct {
+	BaseEvent
+	Attachments []*ProductAttachment
+}
+
+// EventType returns the event type identifier.
+func (e ProductAttachmentsChangedEvent) EventType() string {
+	return "product.attachments_changed"
+}
+
+// NewProductAttachmentsChangedEvent creates a new ProductAttachmentsChangedEvent.
+func NewProductAttachmentsChangedEvent(productID string, attachments []*ProductAttachment, occurredAt time.Time) ProductAttachmentsChangedEvent {
+	return ProductAttachmentsChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Attachments: attachments,
+	}
+}
+
+// ProductImagesChangedEvent is raised when the images of a product are set or reordered.
+// It carries all images of the product in display order.
+type ProductImagesChangedEvent struct {
+	BaseEvent
+	Images []*ProductImage
+}
+
+// EventType returns the event type identifier.
+func (e ProductImagesChangedEvent) EventType() string {
+	return "product.images_changed"
+}
+
+// NewProductImagesChangedEvent creates a new ProductImagesChangedEvent.
+func NewProductImagesChangedEvent(productID string, images []*ProductImage, occurredAt time.Time) ProductImagesChangedEvent {
+	return ProductImagesChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Images: images,
+	}
+}
+
+// ProductTranslationChangedEvent is raised when the name and description of a product
+// in a locale are set or removed.
+type ProductTranslationChangedEvent struct {
+	BaseEvent
+	Locale string
+	// Translation is nil if the translation was removed.
+	Translation *ProductTranslation
+}
+
+// EventType returns the event type identifier.
+func (e ProductTranslationChangedEvent) EventType() string {
+	return "product.translation_changed"
+}
+
+// NewProductTranslationChangedEvent creates a new ProductTranslationChangedEvent;
+// translation is nil if the translation in the locale was removed.
+func NewProductTranslationChangedEvent(productID, locale string, translation *ProductTranslation, occurredAt time.Time) ProductTranslationChangedEvent {
+	return ProductTranslationChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Locale:      locale,
+		Translation: translation,
+	}
+}
+
+// ProductLinkedEvent is raised when a product is linked to a related product.
+type ProductLinkedEvent struct {
+	BaseEvent
+	RelatedProductID string
+	RelationType     RelationType
+}
+
+// EventType returns the event type identifier.
+func (e ProductLinkedEvent) EventType() string {
+	return "product.linked"
+}
+
+// NewProductLinkedEvent creates a new ProductLinkedEvent.
+func NewProductLinkedEvent(r *ProductRelation, occurredAt time.Time) ProductLinkedEvent {
+	return ProductLinkedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: r.productID,
+			occurredAt:  occurredAt,
+		},
+		RelatedProductID: r.relatedProductID,
+		RelationType:     r.relationType,
+	}
+}
+
+// ProductUnlinkedEvent is raised when the link from a product to a related product is
+// removed.
+type ProductUnlinkedEvent struct {
+	BaseEvent
+	RelatedProductID string
+	RelationType     RelationType
+}
+
+// EventType returns the event type identifier.
+func (e ProductUnlinkedEvent) EventType() string {
+	return "product.unlinked"
+}
+
+// NewProductUnlinkedEvent creates a new ProductUnlinkedEvent.
+func NewProductUnlinkedEvent(productID, relatedProductID string, relationType RelationType, occurredAt time.Time) ProductUnlinkedEvent {
+	return ProductUnlinkedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		RelatedProductID: relatedProductID,
+		RelationType:     relationType,
+	}
+}
+
+// VariantAddedEvent is raised when a variant is added to a product.
+type VariantAddedEvent struct {
+	BaseEvent
+	ProductID  string
+	SKU        string
+	Attributes map[string]string
+	PriceDelta *Money
+}
+
+// EventType returns the event type identifier.
+func (e VariantAddedEvent) EventType() string {
+	return "product_variant.added"
+}
+
+// NewVariantAddedEvent creates a new VariantAddedEvent.
+func NewVariantAddedEvent(v *ProductVariant, occurredAt time.Time) VariantAddedEvent {
+	return VariantAddedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: v.id,
+			occurredAt:  occurredAt,
+		},
+		ProductID:  v.productID,
+		SKU:        v.sku,
+		Attributes: v.Attributes(),
+		PriceDelta: v.priceDelta,
+	}
+}
+
+// VariantUpdatedEvent is raised when the SKU, attributes or price delta of a variant
+// change. It carries the new values.
+type VariantUpdatedEvent struct {
+	BaseEvent
+	ProductID  string
+	SKU        string
+	Attributes map[string]string
+	PriceDelta *Money
+}
+
+// EventType returns the event type identifier.
+func (e VariantUpdatedEvent) EventType() string {
+	return "product_variant.updated"
+}
+
+// NewVariantUpdatedEvent creates a new VariantUpdatedEvent.
+func NewVariantUpdatedEvent(v *ProductVariant, occurredAt time.Time) VariantUpdatedEvent {
+	return VariantUpdatedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: v.id,
+			occurredAt:  occurredAt,
+		},
+		ProductID:  v.productID,
+		SKU:        v.sku,
+		Attributes: v.Attributes(),
+		PriceDelta: v.priceDelta,
+	}
+}
+
+// VariantDiscontinuedEvent is raised when a variant is discontinued.
+type VariantDiscontinuedEvent struct {
+	BaseEvent
+	ProductID string
+	SKU       string
+}
+
+// EventType returns the event type identifier.
+func (e VariantDiscontinuedEvent) EventType() string {
+	return "product_variant.discontinued"
+}
+
+// NewVariantDiscontinuedEvent creates a new VariantDiscontinuedEvent.
+func NewVariantDiscontinuedEvent(variantID, productID, sku string, occurredAt time.Time) VariantDiscontinuedEvent {
+	return VariantDiscontinuedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: variantID,
+			occurredAt:  occurredAt,
+		},
+		ProductID: productID,
+		SKU:       sku,
+	}
+}
+
+// StockChangedEvent is raised when the stock of a product changes. It carries the new
+// values and version.
+type StockChangedEvent struct {
+	BaseEvent
+	Level     int64
+	Reserved  int64
+	Available int64
+	Version   int64
+}
+
+// EventType returns the event type identifier.
+func (e StockChangedEvent) EventType() string {
+	return "product.stock_changed"
+}
+
+// NewStockChangedEvent creates a new StockChangedEvent.
+func NewStockChangedEvent(s *Stock, occurredAt time.Time) StockChangedEvent {
+	return StockChangedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: s.productID,
+			occurredAt:  occurredAt,
+		},
+		Level:     s.level,
+		Reserved:  s.reserved,
+		Available: s.Available(),
+		Version:   s.version,
+	}
+}
+
+// OutOfStockEvent is raised when the last available unit of a product is sold or
+// reserved, or its stock is first set with none available.
+type OutOfStockEvent struct {
+	BaseEvent
+}
+
+// EventType returns the event type identifier.
+func (e OutOfStockEvent) EventType() string {
+	return "product.out_of_stock"
+}
+
+// NewOutOfStockEvent creates a new OutOfStockEvent.
+func NewOutOfStockEvent(productID string, occurredAt time.Time) OutOfStockEvent {
+	return OutOfStockEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+	}
+}
+
+// BackInStockEvent is raised when units of a product that was out of stock become
+// available again.
+type BackInStockEvent struct {
+	BaseEvent
+	Available int64
+}
+
+// EventType returns the event type identifier.
+func (e BackInStockEvent) EventType() string {
+	return "product.back_in_stock"
+}
+
+// NewBackInStockEvent creates a new BackInStockEvent.
+func NewBackInStockEvent(productID string, available int64, occurredAt time.Time) BackInStockEvent {
+	return BackInStockEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		Available: available,
+	}
+}
+
+// ProductPurgedEvent is raised when a long-archived product is deleted permanently,
+// together with its outbox history. It is the only event of the product left afterwards.
+type ProductPurgedEvent struct {
+	BaseEvent
+	ArchivedAt time.Time
+}
+
+// EventType returns the event type identifier.
+func (e ProductPurgedEvent) EventType() string {
+	return "product.purged"
+}
+
+// NewProductPurgedEvent creates a new ProductPurgedEvent.
+func NewProductPurgedEvent(productID string, archivedAt, occurredAt time.Time) ProductPurgedEvent {
+	return ProductPurgedEvent{
+		BaseEvent: BaseEvent{
+			aggregateID: productID,
+			occurredAt:  occurredAt,
+		},
+		ArchivedAt: archivedAt,
 	}
 }
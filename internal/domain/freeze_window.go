@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// FreezeWindow is a period during which price and discount changes are refused, e.g.
+// during a peak sales event, except for callers in one of its exempt roles.
+type FreezeWindow struct {
+	id          string
+	tenant      string
+	start       time.Time
+	end         time.Time
+	exemptRoles []string
+	reason      string
+}
+
+// NewFreezeWindow creates a freeze window over [start, end). An empty tenant freezes
+// every tenant.
+func NewFreezeWindow(id, tenant string, start, end time.Time, exemptRoles []string, reason string) (*FreezeWindow, error) {
+	if strings.TrimSpace(id) == "" {
+		return nil, ErrInvalidID
+	}
+	if !end.After(start) {
+		return nil, ErrInvalidFreezeWindow
+	}
+	return &FreezeWindow{
+		id:          id,
+		tenant:      tenant,
+		start:       start,
+		end:         end,
+		exemptRoles: slices.Clone(exemptRoles),
+		reason:      reason,
+	}, nil
+}
+
+// Getters
+
+func (w *FreezeWindow) ID() string            { return w.id }
+func (w *FreezeWindow) Tenant() string        { return w.tenant }
+func (w *FreezeWindow) Start() time.Time      { return w.start }
+func (w *FreezeWindow) End() time.Time        { return w.end }
+func (w *FreezeWindow) ExemptRoles() []string { return slices.Clone(w.exemptRoles) }
+func (w *FreezeWindow) Reason() string        { return w.reason }
+
+// Freezes reports whether the window refuses changes made at the given time by a caller
+// of the given tenant and role.
+func (w *FreezeWindow) Freezes(tenant, role string, at time.Time) bool {
+	if at.Before(w.start) || !at.Before(w.end) {
+		return false
+	}
+	if w.tenant != "" && w.tenant != tenant {
+		return false
+	}
+	return role == "" || !slices.Contains(w.exemptRoles, role)
+}
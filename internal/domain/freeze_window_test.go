@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFreezeWindow(t *testing.T) {
+	start := time.Date(2024, 11, 29, 0, 0, 0, 0, time.UTC)
+
+	_, err := NewFreezeWindow("", "", start, start.Add(time.Hour), nil, "")
+	assert.ErrorIs(t, err, ErrInvalidID)
+	_, err = NewFreezeWindow("freeze-1", "", start, start, nil, "")
+	assert.ErrorIs(t, err, ErrInvalidFreezeWindow)
+
+	roles := []string{"pricing-oncall"}
+	w, err := NewFreezeWindow("freeze-1", "acme", start, start.Add(time.Hour), roles, "Black Friday")
+	require.NoError(t, err)
+	roles[0] = "changed"
+	assert.Equal(t, []string{"pricing-oncall"}, w.ExemptRoles())
+	assert.Equal(t, "Black Friday", w.Reason())
+}
+
+func TestFreezeWindow_Freezes(t *testing.T) {
+	start := time.Date(2024, 11, 29, 0, 0, 0, 0, time.UTC)
+	end := start.Add(72 * time.Hour)
+	allTenants, err := NewFreezeWindow("freeze-1", "", start, end, []string{"pricing-oncall"}, "Black Friday")
+	require.NoError(t, err)
+	oneTenant, err := NewFreezeWindow("freeze-2", "acme", start, end, nil, "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		window *FreezeWindow
+		tenant string
+		role   string
+		at     time.Time
+		want   bool
+	}{
+		{"inside the window", allTenants, "acme", "merchant", start.Add(time.Hour), true},
+		{"at the start", allTenants, "acme", "merchant", start, true},
+		{"before the window", allTenants, "acme", "merchant", start.Add(-time.Second), false},
+		{"at the end", allTenants, "acme", "merchant", end, false},
+		{"exempt role", allTenants, "acme", "pricing-oncall", start.Add(time.Hour), false},
+		{"no role", allTenants, "", "", start.Add(time.Hour), true},
+		{"frozen tenant", oneTenant, "acme", "", start.Add(time.Hour), true},
+		{"other tenant", oneTenant, "globex", "", start.Add(time.Hour), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.window.Freezes(tt.tenant, tt.role, tt.at))
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Market is a sales region with its own base price, e.g. MarketEU. A product without a
+// price for a market is sold there at its base price, the price of the default market.
+type Market string
+
+// Markets.
+const (
+	MarketUS Market = "US"
+	MarketEU Market = "EU"
+	MarketUK Market = "UK"
+)
+
+// marketCurrencies maps each market to the ISO 4217 code its prices are in.
+var marketCurrencies = map[Market]string{
+	MarketUS: "USD",
+	MarketEU: "EUR",
+	MarketUK: "GBP",
+}
+
+// ParseMarket validates a market code; it is case-insensitive.
+func ParseMarket(market string) (Market, error) {
+	m := Market(strings.ToUpper(strings.TrimSpace(market)))
+	if _, ok := marketCurrencies[m]; !ok {
+		return "", ErrInvalidMarket
+	}
+	return m, nil
+}
+
+// Currency returns the ISO 4217 code of the prices of the market.
+func (m Market) Currency() string { return marketCurrencies[m] }
+
+// String returns the market code.
+func (m Market) String() string { return string(m) }
+
+// MarketPrice is the base price of a product in one market. Discounts apply to it as they
+// do to the base price.
+type MarketPrice struct {
+	market Market
+	price  *Money
+}
+
+// NewMarketPrice creates the price of a product in market. The price must be positive and
+// in the currency of the market.
+func NewMarketPrice(market Market, price *Money) (*MarketPrice, error) {
+	if _, ok := marketCurrencies[market]; !ok {
+		return nil, ErrInvalidMarket
+	}
+	if price == nil || !price.IsPositive() || price.Currency() != market.Currency() {
+		return nil, ErrInvalidMarketPrice
+	}
+	return &MarketPrice{market: market, price: price}, nil
+}
+
+// Market returns the market the price is for.
+func (m *MarketPrice) Market() Market { return m.market }
+
+// Price returns the base price of the product in the market.
+func (m *MarketPrice) Price() *Money { return m.price }
+
+// Equals checks if two market prices are equal.
+func (m *MarketPrice) Equals(other *MarketPrice) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	return m.market == other.market && m.price.Equals(other.price)
+}
+
+// SortMarketPrices orders market prices by market.
+func SortMarketPrices(prices []*MarketPrice) {
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].market < prices[j].market
+	})
+}
+
+// MarketPrices returns the market prices of the product, ordered by market.
+func (p *Product) MarketPrices() []*MarketPrice {
+	return append([]*MarketPrice(nil), p.marketPrices...)
+}
+
+// MarketPrice returns the price of the product in the given market, or nil if the market
+// falls back to the base price.
+func (p *Product) MarketPrice(market Market) *MarketPrice {
+	for _, m := range p.marketPrices {
+		if m.market == market {
+			return m
+		}
+	}
+	return nil
+}
+
+// ApplicableDiscountIn returns the discount that applies to the price in the given market
+// at the given time, if any; see ApplicableDiscountIn.
+func (p *Product) ApplicableDiscountIn(market Market, now time.Time) *Discount {
+	return ApplicableDiscountIn(p.discounts, market, now)
+}
+
+// SetMarketPrices replaces the market prices of the product; an empty list removes them
+// all. Markets must be distinct, and a market price cannot be removed while a discount
+// scoped to its market has not expired. Setting the current prices again is a no-op and
+// raises no event.
+func (p *Product) SetMarketPrices(prices []*MarketPrice, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+
+	sorted := make([]*MarketPrice, 0, len(prices))
+	priced := make(map[Market]bool, len(prices))
+	for _, m := range prices {
+		if m == nil {
+			return ErrInvalidMarketPrice
+		}
+		if priced[m.market] {
+			return ErrDuplicateMarket
+		}
+		priced[m.market] = true
+		sorted = append(sorted, m)
+	}
+	SortMarketPrices(sorted)
+
+	for _, d := range p.discounts {
+		if d.Market() != "" && !priced[d.Market()] && !d.IsExpired(now) {
+			return ErrMarketPriceInUse
+		}
+	}
+
+	if marketPricesEqual(p.marketPrices, sorted) {
+		return nil
+	}
+
+	p.marketPrices = sorted
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldMarketPrices)
+
+	p.events = append(p.events, NewMarketPricesChangedEvent(p.id, p.MarketPrices(), now))
+	return nil
+}
+
+func marketPricesEqual(a, b []*MarketPrice) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equals(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMarket(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Market
+		wantErr bool
+	}{
+		{"US", MarketUS, false},
+		{" eu ", MarketEU, false},
+		{"uk", MarketUK, false},
+		{"", "", true},
+		{"GB", "", true},
+		{"USD", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseMarket(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidMarket)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewMarketPrice(t *testing.T) {
+	eur, err := NewMoneyInCurrency(1849, 100, "EUR")
+	require.NoError(t, err)
+
+	price, err := NewMarketPrice(MarketEU, eur)
+	require.NoError(t, err)
+	assert.Equal(t, MarketEU, price.Market())
+	assert.Equal(t, "EUR", price.Market().Currency())
+
+	_, err = NewMarketPrice(MarketUK, eur)
+	assert.ErrorIs(t, err, ErrInvalidMarketPrice)
+	_, err = NewMarketPrice(MarketUS, NewMoney(0, 1))
+	assert.ErrorIs(t, err, ErrInvalidMarketPrice)
+	_, err = NewMarketPrice("JP", eur)
+	assert.ErrorIs(t, err, ErrInvalidMarket)
+}
+
+func TestProduct_SetMarketPrices(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	uk, err := NewMarketPrice(MarketUK, mustMoneyInCurrency(t, 1599, 100, "GBP"))
+	require.NoError(t, err)
+	eu, err := NewMarketPrice(MarketEU, mustMoneyInCurrency(t, 1849, 100, "EUR"))
+	require.NoError(t, err)
+	require.NoError(t, product.SetMarketPrices([]*MarketPrice{uk, eu}, now))
+
+	got := product.MarketPrices()
+	require.Len(t, got, 2)
+	assert.Equal(t, MarketEU, got[0].Market())
+	assert.Equal(t, MarketUK, got[1].Market())
+	assert.True(t, product.Changes().Dirty(FieldMarketPrices))
+	require.Len(t, product.DomainEvents(), 1)
+	event, ok := product.DomainEvents()[0].(MarketPricesChangedEvent)
+	require.True(t, ok)
+	assert.Len(t, event.Prices, 2)
+
+	assert.Same(t, uk, product.MarketPrice(MarketUK))
+	assert.Nil(t, product.MarketPrice(MarketUS))
+
+	// Setting the same prices again is a no-op
+	product.ClearEvents()
+	product.Changes().Reset()
+	require.NoError(t, product.SetMarketPrices([]*MarketPrice{eu, uk}, now))
+	assert.Empty(t, product.DomainEvents())
+	assert.False(t, product.Changes().HasChanges())
+
+	assert.ErrorIs(t, product.SetMarketPrices([]*MarketPrice{eu, eu}, now), ErrDuplicateMarket)
+	assert.ErrorIs(t, product.SetMarketPrices([]*MarketPrice{nil}, now), ErrInvalidMarketPrice)
+
+	// An empty list removes every market price
+	require.NoError(t, product.SetMarketPrices(nil, now))
+	assert.Empty(t, product.MarketPrices())
+	assert.Len(t, product.DomainEvents(), 1)
+}
+
+func TestProduct_ApplyDiscount_Market(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(10000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+
+	sale, err := NewDiscount(big.NewRat(20, 1), now, now.Add(24*time.Hour))
+	require.NoError(t, err)
+
+	// A discount scoped to a market needs a price in that market
+	assert.ErrorIs(t, product.ApplyDiscount(sale.WithID("eu-sale").WithMarket(MarketEU), now), ErrNoMarketPrice)
+
+	eu, err := NewMarketPrice(MarketEU, mustMoneyInCurrency(t, 9000, 100, "EUR"))
+	require.NoError(t, err)
+	uk, err := NewMarketPrice(MarketUK, mustMoneyInCurrency(t, 8000, 100, "GBP"))
+	require.NoError(t, err)
+	require.NoError(t, product.SetMarketPrices([]*MarketPrice{eu, uk}, now))
+
+	product.ClearEvents()
+	require.NoError(t, product.ApplyDiscount(sale.WithID("eu-sale").WithMarket(MarketEU), now))
+	applied, ok := product.DomainEvents()[0].(DiscountAppliedEvent)
+	require.True(t, ok)
+	assert.Equal(t, MarketEU, applied.Market)
+
+	// Discounts of the same priority only overlap when they can apply to the same price
+	require.NoError(t, product.ApplyDiscount(sale.WithID("uk-sale").WithMarket(MarketUK), now))
+	assert.ErrorIs(t, product.ApplyDiscount(sale.WithID("eu-sale-2").WithMarket(MarketEU), now), ErrDiscountOverlap)
+	assert.ErrorIs(t, product.ApplyDiscount(sale.WithID("global"), now), ErrDiscountOverlap)
+
+	// The default market ignores market-scoped discounts
+	assert.Nil(t, product.ApplicableDiscount(now))
+	assert.True(t, product.EffectivePrice(now).Equals(NewMoney(10000, 100)))
+	assert.Equal(t, "eu-sale", product.ApplicableDiscountIn(MarketEU, now).ID())
+	assert.Nil(t, product.ApplicableDiscountIn(MarketUS, now))
+
+	// A market price cannot be removed while a discount references it
+	assert.ErrorIs(t, product.SetMarketPrices([]*MarketPrice{uk}, now), ErrMarketPriceInUse)
+	require.NoError(t, product.SetMarketPrices([]*MarketPrice{uk}, now.Add(24*time.Hour)))
+}
@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// MinimumPrice returns the price floor of the product, or nil if it has none.
+func (p *Product) MinimumPrice() *Money { return p.minimumPrice }
+
+// SetMinimumPrice sets the price floor of the product, in its currency; nil removes it.
+// A discount that would bring the base price below the floor is rejected by
+// ApplyDiscount; discounts already applied are left as they are. Setting the current
+// floor again is a no-op and raises no event.
+func (p *Product) SetMinimumPrice(price *Money, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if price != nil {
+		if !price.IsPositive() {
+			return ErrInvalidMinimumPrice
+		}
+		if price.Currency() != p.Currency() {
+			return ErrCurrencyMismatch
+		}
+	}
+	if p.minimumPrice.Equals(price) {
+		return nil
+	}
+
+	p.minimumPrice = price
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldMinimumPrice)
+
+	p.events = append(p.events, NewMinimumPriceChangedEvent(p.id, price, now))
+	return nil
+}
+
+// checkMinimumPrice returns ErrDiscountBelowMinimumPrice if discount would bring the base
+// price of the product below its floor. Discounts scoped to a market, which apply to the
+// separately set market price, and promotions, which do not change the unit price, are
+// not checked.
+func (p *Product) checkMinimumPrice(discount *Discount) error {
+	if p.minimumPrice == nil || discount.Market() != "" {
+		return nil
+	}
+	if discount.ApplyTo(p.basePrice).LessThan(p.minimumPrice) {
+		return ErrDiscountBelowMinimumPrice
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_SetMinimumPrice(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	assert.Nil(t, product.MinimumPrice())
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	require.NoError(t, product.SetMinimumPrice(NewMoney(1500, 100), now))
+	assert.True(t, product.MinimumPrice().Equals(NewMoney(15, 1)))
+	assert.True(t, product.Changes().Dirty(FieldMinimumPrice))
+	require.Len(t, product.DomainEvents(), 1)
+	event, ok := product.DomainEvents()[0].(MinimumPriceChangedEvent)
+	require.True(t, ok)
+	assert.True(t, event.MinimumPrice.Equals(NewMoney(15, 1)))
+
+	// Setting the same price again is a no-op
+	product.ClearEvents()
+	product.Changes().Reset()
+	require.NoError(t, product.SetMinimumPrice(NewMoney(15, 1), now))
+	assert.Empty(t, product.DomainEvents())
+	assert.False(t, product.Changes().HasChanges())
+
+	// Removing the minimum price
+	require.NoError(t, product.SetMinimumPrice(nil, now))
+	assert.Nil(t, product.MinimumPrice())
+	require.Len(t, product.DomainEvents(), 1)
+	assert.Nil(t, product.DomainEvents()[0].(MinimumPriceChangedEvent).MinimumPrice)
+
+	assert.ErrorIs(t, product.SetMinimumPrice(NewMoney(0, 1), now), ErrInvalidMinimumPrice)
+	eur, err := NewMoneyInCurrency(1500, 100, "EUR")
+	require.NoError(t, err)
+	assert.ErrorIs(t, product.SetMinimumPrice(eur, now), ErrCurrencyMismatch)
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.SetMinimumPrice(NewMoney(15, 1), now), ErrProductArchived)
+}
+
+func TestProduct_ApplyDiscountMinimumPrice(t *testing.T) {
+	now := time.Now()
+	newProduct := func(t *testing.T) *Product {
+		product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+		require.NoError(t, err)
+		require.NoError(t, product.Activate(now))
+		require.NoError(t, product.SetMinimumPrice(NewMoney(15, 1), now))
+		return product
+	}
+
+	tests := []struct {
+		name    string
+		percent int64
+		wantErr error
+	}{
+		{name: "above the floor", percent: 20},
+		{name: "at the floor", percent: 25},
+		{name: "below the floor", percent: 26, wantErr: ErrDiscountBelowMinimumPrice},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discount, err := NewDiscount(big.NewRat(tt.percent, 1), now, now.Add(time.Hour))
+			require.NoError(t, err)
+
+			err = newProduct(t).ApplyDiscount(discount.WithID("d1"), now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("promotion", func(t *testing.T) {
+		promotion, err := NewBuyXGetY(1, 1)
+		require.NoError(t, err)
+		discount, err := NewBuyXGetYDiscount(promotion, now, now.Add(time.Hour))
+		require.NoError(t, err)
+
+		assert.NoError(t, newProduct(t).ApplyDiscount(discount.WithID("d1"), now))
+	})
+}
@@ -2,36 +2,90 @@ package domain
 
 import (
 	"math/big"
+	"strings"
 )
 
+// DefaultCurrency is the ISO 4217 code of money created without a currency, and of
+// products stored before their currency was recorded.
+const DefaultCurrency = "USD"
+
 // Money represents a monetary value with precise decimal arithmetic using rational numbers.
-// It stores values as numerator/denominator to avoid floating-point precision issues.
+// It stores values as numerator/denominator to avoid floating-point precision issues, along
+// with the ISO 4217 code of its currency. Amounts in different currencies are never added,
+// subtracted or compared.
 type Money struct {
-	amount *big.Rat
+	amount   *big.Rat
+	currency string
 }
 
-// NewMoney creates a new Money instance from numerator and denominator.
+// NewMoney creates a new Money instance in DefaultCurrency from numerator and denominator.
 // Example: NewMoney(1999, 100) represents $19.99
 func NewMoney(numerator, denominator int64) *Money {
 	if denominator == 0 {
 		denominator = 1
 	}
 	return &Money{
-		amount: big.NewRat(numerator, denominator),
+		amount:   big.NewRat(numerator, denominator),
+		currency: DefaultCurrency,
 	}
 }
 
-// NewMoneyFromRat creates a Money instance from an existing *big.Rat.
+// NewMoneyInCurrency creates a new Money instance in the given currency from numerator and
+// denominator. The currency code is case-insensitive; see ParseCurrency.
+func NewMoneyInCurrency(numerator, denominator int64, currency string) (*Money, error) {
+	code, err := ParseCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+	m := NewMoney(numerator, denominator)
+	m.currency = code
+	return m, nil
+}
+
+// NewMoneyFromRat creates a Money instance in DefaultCurrency from an existing *big.Rat.
 func NewMoneyFromRat(rat *big.Rat) *Money {
 	if rat == nil {
-		return &Money{amount: big.NewRat(0, 1)}
+		return &Money{amount: big.NewRat(0, 1), currency: DefaultCurrency}
 	}
-	return &Money{amount: new(big.Rat).Set(rat)}
+	return &Money{amount: new(big.Rat).Set(rat), currency: DefaultCurrency}
 }
 
-// Zero returns a Money instance representing zero.
+// Zero returns a Money instance representing zero in DefaultCurrency.
 func Zero() *Money {
-	return &Money{amount: big.NewRat(0, 1)}
+	return &Money{amount: big.NewRat(0, 1), currency: DefaultCurrency}
+}
+
+// ParseCurrency returns the upper-case form of an ISO 4217 currency code. Only the format
+// (three ASCII letters) is checked; the domain keeps no list of circulating currencies.
+func ParseCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if len(code) != 3 {
+		return "", ErrInvalidCurrency
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return "", ErrInvalidCurrency
+		}
+	}
+	return code, nil
+}
+
+// Currency returns the ISO 4217 code of the money value.
+func (m *Money) Currency() string {
+	if m == nil || m.currency == "" {
+		return DefaultCurrency
+	}
+	return m.currency
+}
+
+// SameCurrency returns true if m and other are in the same currency.
+func (m *Money) SameCurrency(other *Money) bool {
+	return m.Currency() == other.Currency()
+}
+
+// withAmount returns a Money of the given amount in the currency of m.
+func (m *Money) withAmount(amount *big.Rat) *Money {
+	return &Money{amount: amount, currency: m.Currency()}
 }
 
 // Amount returns a copy of the underlying rational number.
@@ -59,37 +113,42 @@ func (m *Money) Denominator() int64 {
 }
 
 // Add returns a new Money that is the sum of m and other.
-func (m *Money) Add(other *Money) *Money {
+// It returns ErrCurrencyMismatch if they are in different currencies.
+func (m *Money) Add(other *Money) (*Money, error) {
 	if other == nil {
-		return NewMoneyFromRat(m.Amount())
+		return m.withAmount(m.Amount()), nil
 	}
-	result := new(big.Rat).Add(m.Amount(), other.Amount())
-	return NewMoneyFromRat(result)
+	if !m.SameCurrency(other) {
+		return nil, ErrCurrencyMismatch
+	}
+	return m.withAmount(new(big.Rat).Add(m.Amount(), other.Amount())), nil
 }
 
 // Sub returns a new Money that is the difference of m and other.
-func (m *Money) Sub(other *Money) *Money {
+// It returns ErrCurrencyMismatch if they are in different currencies.
+func (m *Money) Sub(other *Money) (*Money, error) {
 	if other == nil {
-		return NewMoneyFromRat(m.Amount())
+		return m.withAmount(m.Amount()), nil
+	}
+	if !m.SameCurrency(other) {
+		return nil, ErrCurrencyMismatch
 	}
-	result := new(big.Rat).Sub(m.Amount(), other.Amount())
-	return NewMoneyFromRat(result)
+	return m.withAmount(new(big.Rat).Sub(m.Amount(), other.Amount())), nil
 }
 
 // Multiply returns a new Money multiplied by the given rational number.
 func (m *Money) Multiply(factor *big.Rat) *Money {
 	if factor == nil {
-		return NewMoneyFromRat(m.Amount())
+		return m.withAmount(m.Amount())
 	}
-	result := new(big.Rat).Mul(m.Amount(), factor)
-	return NewMoneyFromRat(result)
+	return m.withAmount(new(big.Rat).Mul(m.Amount(), factor))
 }
 
 // CalculatePercentage returns a new Money representing the given percentage of m.
 // percentage should be the percentage value (e.g., 20 for 20%).
 func (m *Money) CalculatePercentage(percentage *big.Rat) *Money {
 	if percentage == nil {
-		return Zero()
+		return m.withAmount(big.NewRat(0, 1))
 	}
 	// amount * (percentage / 100)
 	factor := new(big.Rat).Quo(percentage, big.NewRat(100, 1))
@@ -100,10 +159,11 @@ func (m *Money) CalculatePercentage(percentage *big.Rat) *Money {
 // percentage should be the discount percentage (e.g., 20 for 20% off).
 func (m *Money) ApplyDiscount(percentage *big.Rat) *Money {
 	if percentage == nil {
-		return NewMoneyFromRat(m.Amount())
+		return m.withAmount(m.Amount())
 	}
-	discountAmount := m.CalculatePercentage(percentage)
-	return m.Sub(discountAmount)
+	// The discount amount is in the currency of m, so the subtraction cannot fail.
+	discounted, _ := m.Sub(m.CalculatePercentage(percentage))
+	return discounted
 }
 
 // IsZero returns true if the money value is zero.
@@ -130,7 +190,7 @@ func (m *Money) IsNegative() bool {
 	return m.amount.Sign() < 0
 }
 
-// Equals returns true if two Money values are equal.
+// Equals returns true if two Money values have the same amount and currency.
 func (m *Money) Equals(other *Money) bool {
 	if m == nil && other == nil {
 		return true
@@ -138,23 +198,36 @@ func (m *Money) Equals(other *Money) bool {
 	if m == nil || other == nil {
 		return false
 	}
-	return m.Amount().Cmp(other.Amount()) == 0
+	return m.SameCurrency(other) && m.Amount().Cmp(other.Amount()) == 0
+}
+
+// Compare returns -1, 0 or +1 as m is less than, equal to or greater than other.
+// It returns ErrCurrencyMismatch if they are in different currencies.
+func (m *Money) Compare(other *Money) (int, error) {
+	if !m.SameCurrency(other) {
+		return 0, ErrCurrencyMismatch
+	}
+	return m.Amount().Cmp(other.Amount()), nil
 }
 
 // GreaterThan returns true if m is greater than other.
+// Amounts in different currencies are not comparable, so it then returns false.
 func (m *Money) GreaterThan(other *Money) bool {
 	if m == nil || other == nil {
 		return false
 	}
-	return m.Amount().Cmp(other.Amount()) > 0
+	cmp, err := m.Compare(other)
+	return err == nil && cmp > 0
 }
 
 // LessThan returns true if m is less than other.
+// Amounts in different currencies are not comparable, so it then returns false.
 func (m *Money) LessThan(other *Money) bool {
 	if m == nil || other == nil {
 		return false
 	}
-	return m.Amount().Cmp(other.Amount()) < 0
+	cmp, err := m.Compare(other)
+	return err == nil && cmp < 0
 }
 
 // String returns a string representation of the money value.
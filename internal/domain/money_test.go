@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewMoney(t *testing.T) {
@@ -52,7 +53,8 @@ func TestMoney_Add(t *testing.T) {
 	m1 := NewMoney(1999, 100) // $19.99
 	m2 := NewMoney(500, 100)  // $5.00
 
-	result := m1.Add(m2)
+	result, err := m1.Add(m2)
+	require.NoError(t, err)
 
 	expected := NewMoney(2499, 100) // $24.99
 	assert.True(t, result.Equals(expected))
@@ -62,7 +64,8 @@ func TestMoney_Sub(t *testing.T) {
 	m1 := NewMoney(2000, 100) // $20.00
 	m2 := NewMoney(500, 100)  // $5.00
 
-	result := m1.Sub(m2)
+	result, err := m1.Sub(m2)
+	require.NoError(t, err)
 
 	expected := NewMoney(1500, 100) // $15.00
 	assert.True(t, result.Equals(expected))
@@ -169,3 +172,73 @@ func TestMoney_Zero(t *testing.T) {
 	assert.False(t, z.IsPositive())
 	assert.False(t, z.IsNegative())
 }
+
+func TestParseCurrency(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		want    string
+		wantErr error
+	}{
+		{name: "upper case", code: "EUR", want: "EUR"},
+		{name: "lower case with spaces", code: " gbp ", want: "GBP"},
+		{name: "empty", code: "", wantErr: ErrInvalidCurrency},
+		{name: "too long", code: "EURO", wantErr: ErrInvalidCurrency},
+		{name: "digits", code: "978", wantErr: ErrInvalidCurrency},
+		{name: "symbol", code: "$", wantErr: ErrInvalidCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCurrency(tt.code)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoney_Currency(t *testing.T) {
+	usd := NewMoney(1000, 100)
+	eur, err := NewMoneyInCurrency(1000, 100, "eur")
+	require.NoError(t, err)
+
+	assert.Equal(t, DefaultCurrency, usd.Currency())
+	assert.Equal(t, "EUR", eur.Currency())
+	assert.Equal(t, DefaultCurrency, Zero().Currency())
+
+	// Derived amounts keep the currency
+	assert.Equal(t, "EUR", eur.Multiply(big.NewRat(3, 1)).Currency())
+	assert.Equal(t, "EUR", eur.ApplyDiscount(big.NewRat(10, 1)).Currency())
+	assert.Equal(t, "EUR", eur.CalculatePercentage(nil).Currency())
+	sum, err := eur.Add(eur)
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", sum.Currency())
+
+	_, err = NewMoneyInCurrency(1000, 100, "euro")
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+}
+
+func TestMoney_CurrencyMismatch(t *testing.T) {
+	usd := NewMoney(1000, 100)
+	eur, err := NewMoneyInCurrency(1000, 100, "EUR")
+	require.NoError(t, err)
+
+	_, err = usd.Add(eur)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = usd.Sub(eur)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = usd.Compare(eur)
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	assert.False(t, usd.Equals(eur))
+	assert.False(t, usd.GreaterThan(eur))
+	assert.False(t, usd.LessThan(eur))
+
+	cmp, err := usd.Compare(NewMoney(500, 100))
+	require.NoError(t, err)
+	assert.Equal(t, 1, cmp)
+}
@@ -0,0 +1,75 @@
+package domain
+
+// NotificationKind is the product change a customer asked to be notified about.
+type NotificationKind string
+
+// Notification kinds.
+const (
+	// NotificationKindDiscounted notifies when a discount takes effect: when it is applied
+	// with a period that has already started, when a future-dated discount starts, or when
+	// a suspended discount is resumed.
+	NotificationKindDiscounted NotificationKind = "discounted"
+	// NotificationKindBackInStock notifies when the product becomes available again: when
+	// it is activated, or when units of an active product are back in stock.
+	NotificationKindBackInStock NotificationKind = "back_in_stock"
+)
+
+// SubscriptionCancelledEventType is the type of the outbox events that tell subscribers
+// their subscriptions were cancelled because the product was archived.
+const SubscriptionCancelledEventType = "notification.subscription_cancelled"
+
+// NotificationKinds returns every notification kind.
+func NotificationKinds() []NotificationKind {
+	return []NotificationKind{NotificationKindDiscounted, NotificationKindBackInStock}
+}
+
+// String returns the string representation of the kind.
+func (k NotificationKind) String() string {
+	return string(k)
+}
+
+// EventType returns the type of the outbox events that notify subscribers of this kind.
+func (k NotificationKind) EventType() string {
+	return "notification." + string(k)
+}
+
+// IsValid checks if the kind is a known notification kind.
+func (k NotificationKind) IsValid() bool {
+	switch k {
+	case NotificationKindDiscounted, NotificationKindBackInStock:
+		return true
+	default:
+		return false
+	}
+}
+
+// NotifiedKind returns the kind of subscription that event should be fanned out to.
+func NotifiedKind(event DomainEvent) (NotificationKind, bool) {
+	switch e := event.(type) {
+	case DiscountAppliedEvent:
+		if e.PendingApproval {
+			// Announced by DiscountStartedEvent once approved.
+			return "", false
+		}
+		if e.StartDate.After(e.OccurredAt()) {
+			// Announced by DiscountStartedEvent once the period begins.
+			return "", false
+		}
+		if e.Promotion != nil {
+			// Promotions do not lower the price subscribers are waiting for.
+			return "", false
+		}
+		return NotificationKindDiscounted, true
+	case DiscountStartedEvent:
+		if e.Promotion != nil {
+			return "", false
+		}
+		return NotificationKindDiscounted, true
+	case DiscountResumedEvent:
+		return NotificationKindDiscounted, true
+	case ProductActivatedEvent, BackInStockEvent:
+		return NotificationKindBackInStock, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationKind_IsValid(t *testing.T) {
+	assert.True(t, NotificationKindDiscounted.IsValid())
+	assert.True(t, NotificationKindBackInStock.IsValid())
+	assert.False(t, NotificationKind("").IsValid())
+	assert.False(t, NotificationKind("price_drop").IsValid())
+}
+
+func TestNotifiedKind(t *testing.T) {
+	now := time.Now()
+	promotion := NewDiscountAppliedEvent("p", "d", big.NewRat(100, 1), 0, now, now.Add(time.Hour), now)
+	promotion.Promotion = &BuyXGetY{buyQuantity: 2, getQuantity: 1}
+	promotionStarted := NewDiscountStartedEvent("p", "d", big.NewRat(100, 1), now, now.Add(time.Hour), now)
+	promotionStarted.Promotion = promotion.Promotion
+
+	tests := []struct {
+		name     string
+		event    DomainEvent
+		wantKind NotificationKind
+		wantOK   bool
+	}{
+		{"discount applied", NewDiscountAppliedEvent("p", "d", big.NewRat(10, 1), 0, now, now.Add(time.Hour), now), NotificationKindDiscounted, true},
+		{"future discount applied", NewDiscountAppliedEvent("p", "d", big.NewRat(10, 1), 0, now.Add(time.Hour), now.Add(2*time.Hour), now), "", false},
+		{"discount started", NewDiscountStartedEvent("p", "d", big.NewRat(10, 1), now, now.Add(time.Hour), now), NotificationKindDiscounted, true},
+		{"promotion applied", promotion, "", false},
+		{"promotion started", promotionStarted, "", false},
+		{"discount ended", NewDiscountEndedEvent("p", "d", now, now), "", false},
+		{"discount resumed", NewDiscountResumedEvent("p", now), NotificationKindDiscounted, true},
+		{"product activated", NewProductActivatedEvent("p", now), NotificationKindBackInStock, true},
+		{"back in stock", NewBackInStockEvent("p", 3, now), NotificationKindBackInStock, true},
+		{"out of stock", NewOutOfStockEvent("p", now), "", false},
+		{"product deactivated", NewProductDeactivatedEvent("p", now), "", false},
+		{"discount removed", NewDiscountRemovedEvent("p", "d", now), "", false},
+		{"price changed", NewProductPriceChangedEvent("p", NewMoney(2, 1), NewMoney(1, 1), now), "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, ok := NotifiedKind(tt.event)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantKind, kind)
+		})
+	}
+}
+
+func TestNotificationKinds(t *testing.T) {
+	for _, kind := range NotificationKinds() {
+		assert.True(t, kind.IsValid(), kind)
+	}
+	assert.Len(t, NotificationKinds(), 2)
+}
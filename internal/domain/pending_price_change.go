@@ -0,0 +1,99 @@
+package domain
+
+import "time"
+
+// PendingPriceChange is a base price change scheduled to take effect at a later time.
+type PendingPriceChange struct {
+	price       *Money
+	effectiveAt time.Time
+}
+
+// NewPendingPriceChange creates a price change to price taking effect at effectiveAt.
+// It is validated when scheduled on a product.
+func NewPendingPriceChange(price *Money, effectiveAt time.Time) *PendingPriceChange {
+	return &PendingPriceChange{price: price, effectiveAt: effectiveAt}
+}
+
+// Price returns the base price the product changes to.
+func (c *PendingPriceChange) Price() *Money { return c.price }
+
+// EffectiveAt returns when the change takes effect.
+func (c *PendingPriceChange) EffectiveAt() time.Time { return c.effectiveAt }
+
+// IsDue reports whether the change takes effect by now.
+func (c *PendingPriceChange) IsDue(now time.Time) bool { return !now.Before(c.effectiveAt) }
+
+// PendingPriceChange returns the base price change scheduled for the product, or nil if
+// there is none.
+func (p *Product) PendingPriceChange() *PendingPriceChange { return p.pendingPriceChange }
+
+// SchedulePriceChange schedules a change of the base price to price at effectiveAt, which
+// must be after now. Like ChangeBasePrice, price must stay above the sale prices of the
+// discounts that have not expired. A product has at most one pending change; scheduling
+// another replaces it. The change is made by ApplyPendingPriceChange once it is due.
+func (p *Product) SchedulePriceChange(price *Money, effectiveAt, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if price == nil || !price.IsPositive() {
+		return ErrInvalidBasePrice
+	}
+	if !p.basePrice.SameCurrency(price) {
+		return ErrCurrencyMismatch
+	}
+	if !effectiveAt.After(now) {
+		return ErrInvalidPriceChangeTime
+	}
+	if err := p.checkBasePriceAboveSalePrices(price, now); err != nil {
+		return err
+	}
+
+	p.pendingPriceChange = NewPendingPriceChange(price, effectiveAt)
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldPendingPriceChange)
+
+	p.events = append(p.events, NewPriceChangeScheduledEvent(p.id, price, effectiveAt, now))
+	return nil
+}
+
+// CancelPriceChange removes the pending base price change of the product. It returns
+// ErrNoPendingPriceChange if there is none.
+func (p *Product) CancelPriceChange(now time.Time) error {
+	if p.pendingPriceChange == nil {
+		return ErrNoPendingPriceChange
+	}
+
+	p.pendingPriceChange = nil
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldPendingPriceChange)
+
+	p.events = append(p.events, NewPriceChangeCancelledEvent(p.id, now))
+	return nil
+}
+
+// ApplyPendingPriceChange makes the pending base price change once it is due and raises a
+// ProductPriceChangedEvent carrying its effective time. A change to the current base
+// price is dropped without an event. It reports whether the product changed.
+func (p *Product) ApplyPendingPriceChange(now time.Time) bool {
+	change := p.pendingPriceChange
+	if change == nil || !change.IsDue(now) || p.status == ProductStatusArchived {
+		return false
+	}
+
+	p.pendingPriceChange = nil
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldPendingPriceChange)
+
+	if p.basePrice.Equals(change.Price()) {
+		return true
+	}
+
+	event := NewProductPriceChangedEvent(p.id, p.basePrice, change.Price(), now)
+	event.EffectiveAt = change.EffectiveAt()
+	p.basePrice = change.Price()
+	p.changes.MarkDirty(FieldBasePrice)
+	p.repriceSaleDiscounts(now)
+
+	p.events = append(p.events, event)
+	return true
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_SchedulePriceChange(t *testing.T) {
+	now := time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+	midnight := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	assert.Nil(t, product.PendingPriceChange())
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	require.NoError(t, product.SchedulePriceChange(NewMoney(1800, 100), midnight, now))
+	change := product.PendingPriceChange()
+	require.NotNil(t, change)
+	assert.True(t, change.Price().Equals(NewMoney(18, 1)))
+	assert.Equal(t, midnight, change.EffectiveAt())
+	assert.True(t, product.BasePrice().Equals(NewMoney(20, 1)), "the base price changes only once the change is applied")
+	assert.True(t, product.Changes().Dirty(FieldPendingPriceChange))
+	assert.False(t, product.Changes().Dirty(FieldBasePrice))
+	require.Len(t, product.DomainEvents(), 1)
+	event, ok := product.DomainEvents()[0].(PriceChangeScheduledEvent)
+	require.True(t, ok)
+	assert.True(t, event.NewPrice.Equals(NewMoney(18, 1)))
+	assert.Equal(t, midnight, event.EffectiveAt)
+
+	// Scheduling again replaces the pending change
+	require.NoError(t, product.SchedulePriceChange(NewMoney(1700, 100), midnight.Add(time.Hour), now))
+	assert.True(t, product.PendingPriceChange().Price().Equals(NewMoney(17, 1)))
+	assert.Equal(t, midnight.Add(time.Hour), product.PendingPriceChange().EffectiveAt())
+
+	assert.ErrorIs(t, product.SchedulePriceChange(NewMoney(0, 1), midnight, now), ErrInvalidBasePrice)
+	assert.ErrorIs(t, product.SchedulePriceChange(nil, midnight, now), ErrInvalidBasePrice)
+	eur, err := NewMoneyInCurrency(1800, 100, "EUR")
+	require.NoError(t, err)
+	assert.ErrorIs(t, product.SchedulePriceChange(eur, midnight, now), ErrCurrencyMismatch)
+	assert.ErrorIs(t, product.SchedulePriceChange(NewMoney(1800, 100), now, now), ErrInvalidPriceChangeTime)
+	assert.ErrorIs(t, product.SchedulePriceChange(NewMoney(1800, 100), now.Add(-time.Hour), now), ErrInvalidPriceChangeTime)
+}
+
+func TestProduct_CancelPriceChange(t *testing.T) {
+	now := time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, product.CancelPriceChange(now), ErrNoPendingPriceChange)
+
+	require.NoError(t, product.SchedulePriceChange(NewMoney(1800, 100), now.Add(6*time.Hour), now))
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	require.NoError(t, product.CancelPriceChange(now))
+	assert.Nil(t, product.PendingPriceChange())
+	assert.True(t, product.Changes().Dirty(FieldPendingPriceChange))
+	require.Len(t, product.DomainEvents(), 1)
+	assert.IsType(t, PriceChangeCancelledEvent{}, product.DomainEvents()[0])
+	assert.False(t, product.ApplyPendingPriceChange(now.Add(24*time.Hour)))
+}
+
+func TestProduct_ApplyPendingPriceChange(t *testing.T) {
+	now := time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+	midnight := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("not due yet", func(t *testing.T) {
+		product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+		require.NoError(t, err)
+		require.NoError(t, product.SchedulePriceChange(NewMoney(1800, 100), midnight, now))
+		product.ClearEvents()
+		product.Changes().Reset()
+
+		assert.False(t, product.ApplyPendingPriceChange(midnight.Add(-time.Nanosecond)))
+		assert.NotNil(t, product.PendingPriceChange())
+		assert.Empty(t, product.DomainEvents())
+		assert.False(t, product.Changes().HasChanges())
+	})
+
+	t.Run("due", func(t *testing.T) {
+		product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+		require.NoError(t, err)
+		require.NoError(t, product.SchedulePriceChange(NewMoney(1800, 100), midnight, now))
+		product.ClearEvents()
+		product.Changes().Reset()
+
+		// The scheduler may run a little after the change was due.
+		at := midnight.Add(20 * time.Second)
+		assert.True(t, product.ApplyPendingPriceChange(at))
+		assert.Nil(t, product.PendingPriceChange())
+		assert.True(t, product.BasePrice().Equals(NewMoney(18, 1)))
+		assert.Equal(t, at, product.UpdatedAt())
+		assert.True(t, product.Changes().Dirty(FieldBasePrice))
+		assert.True(t, product.Changes().Dirty(FieldPendingPriceChange))
+		require.Len(t, product.DomainEvents(), 1)
+		event, ok := product.DomainEvents()[0].(ProductPriceChangedEvent)
+		require.True(t, ok)
+		assert.True(t, event.OldPrice.Equals(NewMoney(20, 1)))
+		assert.True(t, event.NewPrice.Equals(NewMoney(18, 1)))
+		assert.Equal(t, midnight, event.EffectiveAt)
+		assert.Equal(t, at, event.OccurredAt())
+
+		// Applying again does nothing
+		assert.False(t, product.ApplyPendingPriceChange(at))
+	})
+
+	t.Run("to the current price", func(t *testing.T) {
+		product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+		require.NoError(t, err)
+		require.NoError(t, product.SchedulePriceChange(NewMoney(20, 1), midnight, now))
+		product.ClearEvents()
+		product.Changes().Reset()
+
+		assert.True(t, product.ApplyPendingPriceChange(midnight))
+		assert.Nil(t, product.PendingPriceChange())
+		assert.Empty(t, product.DomainEvents())
+		assert.False(t, product.Changes().Dirty(FieldBasePrice))
+	})
+
+	t.Run("inactive product", func(t *testing.T) {
+		product := ReconstructProduct("123", "Widget", "", "", "Tools", "", "", NewMoney(2000, 100), nil, nil,
+			nil, nil, nil, nil, nil, NewPendingPriceChange(NewMoney(1800, 100), midnight), nil, nil, nil, nil, "", nil, nil, "", DefaultTaxClass, ProductStatusInactive, nil, nil, nil, now, now, nil)
+
+		assert.True(t, product.ApplyPendingPriceChange(midnight))
+		assert.True(t, product.BasePrice().Equals(NewMoney(18, 1)))
+	})
+}
+
+func TestProduct_ArchiveCancelsPriceChange(t *testing.T) {
+	now := time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.SchedulePriceChange(NewMoney(1800, 100), now.Add(6*time.Hour), now))
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	require.NoError(t, product.Archive(now))
+	assert.Nil(t, product.PendingPriceChange())
+	assert.True(t, product.Changes().Dirty(FieldPendingPriceChange))
+	require.Len(t, product.DomainEvents(), 2)
+	assert.IsType(t, PriceChangeCancelledEvent{}, product.DomainEvents()[0])
+	assert.IsType(t, ProductArchivedEvent{}, product.DomainEvents()[1])
+	assert.ErrorIs(t, product.SchedulePriceChange(NewMoney(1800, 100), now.Add(6*time.Hour), now), ErrProductArchived)
+}
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxPriceBookEntries is the maximum number of currencies a product is priced in besides
+// its own.
+const MaxPriceBookEntries = 20
+
+// SortPrices orders prices by currency code.
+func SortPrices(prices []*Money) {
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].Currency() < prices[j].Currency()
+	})
+}
+
+// PriceIn returns the base price of the product in the given currency: the base price
+// itself in the product's currency, the price book entry in another currency, or nil if
+// the product has no price in that currency.
+func (p *Product) PriceIn(currency string) *Money {
+	if currency == p.Currency() {
+		return p.basePrice
+	}
+	for _, price := range p.priceBook {
+		if price.Currency() == currency {
+			return price
+		}
+	}
+	return nil
+}
+
+// SetPriceBook replaces the prices of the product in currencies other than its own; an
+// empty list removes them all. Prices must be positive and in distinct currencies other
+// than the product's, and a product holds up to MaxPriceBookEntries of them. Discounts
+// apply to these prices as they do to the base price. Setting the current prices again is
+// a no-op and raises no event.
+func (p *Product) SetPriceBook(prices []*Money, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if len(prices) > MaxPriceBookEntries {
+		return ErrTooManyPriceBookEntries
+	}
+
+	sorted := make([]*Money, 0, len(prices))
+	for _, price := range prices {
+		if price == nil || !price.IsPositive() {
+			return ErrInvalidPriceBookPrice
+		}
+		if price.SameCurrency(p.basePrice) {
+			return ErrPriceBookBaseCurrency
+		}
+		sorted = append(sorted, price)
+	}
+	SortPrices(sorted)
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].SameCurrency(sorted[i-1]) {
+			return ErrDuplicatePriceBookCurrency
+		}
+	}
+
+	if pricesEqual(p.priceBook, sorted) {
+		return nil
+	}
+
+	p.priceBook = sorted
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldPriceBook)
+
+	p.events = append(p.events, NewPriceBookChangedEvent(p.id, p.PriceBook(), now))
+	return nil
+}
+
+func pricesEqual(a, b []*Money) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equals(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// CurrencyRates converts money between currencies through fixed exchange rates against a
+// base currency. A rate is the amount of a currency worth one unit of the base currency.
+type CurrencyRates struct {
+	base  string
+	rates map[string]*big.Rat
+}
+
+// NewCurrencyRates creates CurrencyRates from the rates of other currencies against base.
+func NewCurrencyRates(base string, rates map[string]*big.Rat) (*CurrencyRates, error) {
+	baseCode, err := ParseCurrency(base)
+	if err != nil {
+		return nil, err
+	}
+	r := &CurrencyRates{base: baseCode, rates: map[string]*big.Rat{baseCode: big.NewRat(1, 1)}}
+	for currency, rate := range rates {
+		code, err := ParseCurrency(currency)
+		if err != nil {
+			return nil, err
+		}
+		if rate == nil || rate.Sign() <= 0 {
+			return nil, ErrInvalidExchangeRate
+		}
+		if code != baseCode {
+			r.rates[code] = new(big.Rat).Set(rate)
+		}
+	}
+	return r, nil
+}
+
+// ParseCurrencyRates creates CurrencyRates from a comma-separated list of decimal rates
+// against base, e.g. "EUR=0.92,GBP=0.79".
+func ParseCurrencyRates(base, spec string) (*CurrencyRates, error) {
+	rates := make(map[string]*big.Rat)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		currency, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, ErrInvalidExchangeRate
+		}
+		rate, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+		if !ok {
+			return nil, ErrInvalidExchangeRate
+		}
+		rates[currency] = rate
+	}
+	return NewCurrencyRates(base, rates)
+}
+
+// Base returns the base currency of the rates.
+func (r *CurrencyRates) Base() string { return r.base }
+
+// Convert converts m to the given currency. It returns ErrNoExchangeRate if either
+// currency has no rate.
+func (r *CurrencyRates) Convert(m *Money, currency string) (*Money, error) {
+	if m.Currency() == currency {
+		return m.withAmount(m.Amount()), nil
+	}
+	from, ok := r.rates[m.Currency()]
+	if !ok {
+		return nil, ErrNoExchangeRate
+	}
+	to, ok := r.rates[currency]
+	if !ok {
+		return nil, ErrNoExchangeRate
+	}
+	amount := new(big.Rat).Mul(m.Amount(), to)
+	amount.Quo(amount, from)
+	return &Money{amount: amount, currency: currency}, nil
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_SetPriceBook(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	gbp := mustMoneyInCurrency(t, 1600, 100, "GBP")
+	eur := mustMoneyInCurrency(t, 1850, 100, "EUR")
+	require.NoError(t, product.SetPriceBook([]*Money{gbp, eur}, now))
+
+	got := product.PriceBook()
+	require.Len(t, got, 2)
+	assert.Equal(t, "EUR", got[0].Currency())
+	assert.Equal(t, "GBP", got[1].Currency())
+	assert.True(t, product.Changes().Dirty(FieldPriceBook))
+	require.Len(t, product.DomainEvents(), 1)
+	event, ok := product.DomainEvents()[0].(PriceBookChangedEvent)
+	require.True(t, ok)
+	assert.Len(t, event.Prices, 2)
+
+	assert.True(t, product.PriceIn("EUR").Equals(eur))
+	assert.True(t, product.PriceIn("USD").Equals(NewMoney(2000, 100)))
+	assert.Nil(t, product.PriceIn("JPY"))
+
+	// Setting the same prices again is a no-op
+	product.ClearEvents()
+	product.Changes().Reset()
+	require.NoError(t, product.SetPriceBook([]*Money{eur, gbp}, now))
+	assert.Empty(t, product.DomainEvents())
+	assert.False(t, product.Changes().HasChanges())
+
+	// An empty list removes every price
+	require.NoError(t, product.SetPriceBook(nil, now))
+	assert.Empty(t, product.PriceBook())
+	assert.Len(t, product.DomainEvents(), 1)
+}
+
+func TestProduct_SetPriceBook_Invalid(t *testing.T) {
+	now := time.Now()
+
+	tooMany := make([]*Money, MaxPriceBookEntries+1)
+	for i := range tooMany {
+		tooMany[i] = mustMoneyInCurrency(t, 100, 1, string([]byte{'A', 'A' + byte(i/26), 'A' + byte(i%26)}))
+	}
+
+	tests := []struct {
+		name    string
+		archive bool
+		prices  []*Money
+		wantErr error
+	}{
+		{"product currency", false, []*Money{NewMoney(1800, 100)}, ErrPriceBookBaseCurrency},
+		{"duplicate currency", false, []*Money{mustMoneyInCurrency(t, 1800, 100, "EUR"), mustMoneyInCurrency(t, 1700, 100, "EUR")}, ErrDuplicatePriceBookCurrency},
+		{"zero price", false, []*Money{mustMoneyInCurrency(t, 0, 100, "EUR")}, ErrInvalidPriceBookPrice},
+		{"nil price", false, []*Money{nil}, ErrInvalidPriceBookPrice},
+		{"too many prices", false, tooMany, ErrTooManyPriceBookEntries},
+		{"archived product", true, []*Money{mustMoneyInCurrency(t, 1800, 100, "EUR")}, ErrProductArchived},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+			require.NoError(t, err)
+			if tt.archive {
+				require.NoError(t, product.Archive(now))
+			}
+
+			assert.ErrorIs(t, product.SetPriceBook(tt.prices, now), tt.wantErr)
+			assert.Empty(t, product.PriceBook())
+		})
+	}
+}
+
+func TestParseCurrencyRates(t *testing.T) {
+	rates, err := ParseCurrencyRates("usd", " EUR=0.92, gbp=0.8 ,")
+	require.NoError(t, err)
+	assert.Equal(t, "USD", rates.Base())
+
+	for _, spec := range []string{"EUR", "EUR=abc", "EUR=0", "EUR=-1", "EURO=0.92"} {
+		_, err := ParseCurrencyRates("USD", spec)
+		assert.Error(t, err, spec)
+	}
+	_, err = ParseCurrencyRates("", "EUR=0.92")
+	assert.ErrorIs(t, err, ErrInvalidCurrency)
+}
+
+func TestCurrencyRates_Convert(t *testing.T) {
+	rates, err := NewCurrencyRates("USD", map[string]*big.Rat{"EUR": big.NewRat(92, 100), "GBP": big.NewRat(80, 100)})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		price    *Money
+		currency string
+		want     *Money
+	}{
+		{"from base", NewMoney(2000, 100), "EUR", mustMoneyInCurrency(t, 1840, 100, "EUR")},
+		{"to base", mustMoneyInCurrency(t, 1840, 100, "EUR"), "USD", NewMoney(2000, 100)},
+		{"between other currencies", mustMoneyInCurrency(t, 1600, 100, "GBP"), "EUR", mustMoneyInCurrency(t, 1840, 100, "EUR")},
+		{"same currency", NewMoney(2000, 100), "USD", NewMoney(2000, 100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rates.Convert(tt.price, tt.currency)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equals(got), "got %s", got)
+		})
+	}
+
+	_, err = rates.Convert(NewMoney(2000, 100), "JPY")
+	assert.ErrorIs(t, err, ErrNoExchangeRate)
+	_, err = rates.Convert(mustMoneyInCurrency(t, 2000, 1, "JPY"), "USD")
+	assert.ErrorIs(t, err, ErrNoExchangeRate)
+}
@@ -0,0 +1,27 @@
+package domain
+
+// ChangesPrice reports whether event changes the base price or the discounts of a
+// product, and so belongs in its price history. Tier and price book changes do not: the
+// history tracks the single-unit price in the product's own currency.
+func ChangesPrice(event DomainEvent) bool {
+	switch event.(type) {
+	case ProductCreatedEvent, ProductPriceChangedEvent,
+		DiscountAppliedEvent, DiscountRemovedEvent,
+		DiscountSuspendedEvent, DiscountResumedEvent, DiscountExpiredEvent,
+		DiscountStartedEvent, DiscountEndedEvent:
+		return true
+	default:
+		return false
+	}
+}
+
+// PriceChange returns the first event of events that changes the price of a product,
+// or nil if none does.
+func PriceChange(events []DomainEvent) DomainEvent {
+	for _, event := range events {
+		if ChangesPrice(event) {
+			return event
+		}
+	}
+	return nil
+}
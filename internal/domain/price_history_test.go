@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangesPrice(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name  string
+		event DomainEvent
+		want  bool
+	}{
+		{"product created", NewProductCreatedEvent("p", "Widget", "", "Tools", NewMoney(2, 1), now), true},
+		{"price changed", NewProductPriceChangedEvent("p", NewMoney(2, 1), NewMoney(1, 1), now), true},
+		{"discount applied", NewDiscountAppliedEvent("p", "d", big.NewRat(10, 1), 0, now, now.Add(time.Hour), now), true},
+		{"discount removed", NewDiscountRemovedEvent("p", "d", now), true},
+		{"discount suspended", NewDiscountSuspendedEvent("p", now), true},
+		{"discount resumed", NewDiscountResumedEvent("p", now), true},
+		{"discount expired", NewDiscountExpiredEvent("p", "d", now), true},
+		{"discount started", NewDiscountStartedEvent("p", "d", big.NewRat(10, 1), now, now.Add(time.Hour), now), true},
+		{"discount ended", NewDiscountEndedEvent("p", "d", now, now), true},
+		{"product updated", NewProductUpdatedEvent("p", "Widget", "", "Tools", now), false},
+		{"product activated", NewProductActivatedEvent("p", now), false},
+		{"price tiers changed", NewPriceTiersChangedEvent("p", "USD", nil, now), false},
+		{"price book changed", NewPriceBookChangedEvent("p", nil, now), false},
+		{"tax class changed", NewTaxClassChangedEvent("p", DefaultTaxClass, "reduced", now), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ChangesPrice(tt.event))
+		})
+	}
+}
+
+func TestPriceChange(t *testing.T) {
+	now := time.Now()
+	activated := NewProductActivatedEvent("p", now)
+	resumed := NewDiscountResumedEvent("p", now)
+
+	assert.Equal(t, resumed, PriceChange([]DomainEvent{activated, resumed}))
+	assert.Nil(t, PriceChange([]DomainEvent{activated}))
+	assert.Nil(t, PriceChange(nil))
+}
@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxPriceListEntries is the maximum number of products a price list prices.
+const MaxPriceListEntries = 1000
+
+// PriceList is the aggregate root of a set of negotiated prices, e.g. for a B2B customer,
+// that are kept apart from the base prices of the products. Every price of a list is in
+// the list's currency, and the list only prices products while it is valid.
+type PriceList struct {
+	id         string
+	tenantID   string
+	name       string
+	currency   string
+	validFrom  time.Time
+	validUntil time.Time
+	entries    []*PriceListEntry
+	createdAt  time.Time
+	updatedAt  time.Time
+	events     []DomainEvent
+}
+
+// PriceListEntry is the price of one product in a price list.
+type PriceListEntry struct {
+	productID string
+	price     *Money
+}
+
+// NewPriceListEntry creates the entry of a product. The price must be positive.
+func NewPriceListEntry(productID string, price *Money) (*PriceListEntry, error) {
+	if strings.TrimSpace(productID) == "" || price == nil || !price.IsPositive() {
+		return nil, ErrInvalidPriceListEntry
+	}
+	return &PriceListEntry{productID: productID, price: price}, nil
+}
+
+// ProductID returns the product the entry prices.
+func (e *PriceListEntry) ProductID() string { return e.productID }
+
+// Price returns the price of the product in the price list.
+func (e *PriceListEntry) Price() *Money { return e.price }
+
+// Equals checks if two entries are equal.
+func (e *PriceListEntry) Equals(other *PriceListEntry) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.productID == other.productID && e.price.Equals(other.price)
+}
+
+// NewPriceList creates an empty price list in currency that is valid from validFrom until
+// validUntil; a zero validUntil keeps it valid indefinitely.
+func NewPriceList(id, name, currency string, validFrom, validUntil, now time.Time) (*PriceList, error) {
+	if strings.TrimSpace(id) == "" {
+		return nil, ErrInvalidID
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, ErrInvalidPriceListName
+	}
+	currency, err := ParseCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+	if validFrom.IsZero() || (!validUntil.IsZero() && !validUntil.After(validFrom)) {
+		return nil, ErrInvalidPriceListValidity
+	}
+
+	l := &PriceList{
+		id:         id,
+		name:       strings.TrimSpace(name),
+		currency:   currency,
+		validFrom:  validFrom,
+		validUntil: validUntil,
+		createdAt:  now,
+		updatedAt:  now,
+	}
+	l.events = append(l.events, NewPriceListCreatedEvent(l, now))
+	return l, nil
+}
+
+// ReconstructPriceList reconstructs a PriceList from persistence. entries must be ordered
+// by product ID.
+func ReconstructPriceList(id, tenantID, name, currency string, validFrom, validUntil time.Time, entries []*PriceListEntry, createdAt, updatedAt time.Time) *PriceList {
+	return &PriceList{
+		id:         id,
+		tenantID:   tenantID,
+		name:       name,
+		currency:   currency,
+		validFrom:  validFrom,
+		validUntil: validUntil,
+		entries:    entries,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+// ID returns the price list identifier.
+func (l *PriceList) ID() string { return l.id }
+
+// AssignTenant sets the tenant that owns a new price list before it is first saved; empty
+// is the default tenant. Only products of that tenant can be priced on the list.
+func (l *PriceList) AssignTenant(tenantID string) error {
+	if len(tenantID) > MaxTenantIDLength {
+		return ErrInvalidTenantID
+	}
+	l.tenantID = tenantID
+	return nil
+}
+
+// TenantID returns the tenant that owns the price list; empty for the default tenant.
+func (l *PriceList) TenantID() string { return l.tenantID }
+
+// Name returns the price list name.
+func (l *PriceList) Name() string { return l.name }
+
+// Currency returns the ISO 4217 code of every price of the list.
+func (l *PriceList) Currency() string { return l.currency }
+
+// ValidFrom returns when the price list becomes valid.
+func (l *PriceList) ValidFrom() time.Time { return l.validFrom }
+
+// ValidUntil returns when the price list stops being valid, or the zero time if it stays
+// valid indefinitely.
+func (l *PriceList) ValidUntil() time.Time { return l.validUntil }
+
+// Entries returns the entries of the price list, ordered by product ID.
+func (l *PriceList) Entries() []*PriceListEntry {
+	return append([]*PriceListEntry(nil), l.entries...)
+}
+
+// CreatedAt returns the creation timestamp.
+func (l *PriceList) CreatedAt() time.Time { return l.createdAt }
+
+// UpdatedAt returns the last update timestamp.
+func (l *PriceList) UpdatedAt() time.Time { return l.updatedAt }
+
+// DomainEvents returns the events raised since the price list was created or loaded.
+func (l *PriceList) DomainEvents() []DomainEvent { return l.events }
+
+// IsValidAt reports whether the price list prices products at the given time.
+func (l *PriceList) IsValidAt(t time.Time) bool {
+	return !t.Before(l.validFrom) && (l.validUntil.IsZero() || t.Before(l.validUntil))
+}
+
+// Entry returns the entry of a product, or nil if the list does not price it.
+func (l *PriceList) Entry(productID string) *PriceListEntry {
+	i := sort.Search(len(l.entries), func(i int) bool { return l.entries[i].productID >= productID })
+	if i < len(l.entries) && l.entries[i].productID == productID {
+		return l.entries[i]
+	}
+	return nil
+}
+
+// SetEntries replaces the entries of the price list; an empty list removes them all.
+// Products must be distinct and prices in the currency of the list. Setting the current
+// entries again is a no-op and raises no event.
+func (l *PriceList) SetEntries(entries []*PriceListEntry, now time.Time) error {
+	if len(entries) > MaxPriceListEntries {
+		return ErrTooManyPriceListEntries
+	}
+
+	sorted := make([]*PriceListEntry, 0, len(entries))
+	priced := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		if e == nil || e.price.Currency() != l.currency {
+			return ErrInvalidPriceListEntry
+		}
+		if priced[e.productID] {
+			return ErrDuplicatePriceListEntry
+		}
+		priced[e.productID] = true
+		sorted = append(sorted, e)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].productID < sorted[j].productID })
+
+	if priceListEntriesEqual(l.entries, sorted) {
+		return nil
+	}
+
+	l.entries = sorted
+	l.updatedAt = now
+	l.events = append(l.events, NewPriceListEntriesChangedEvent(l.id, l.Entries(), now))
+	return nil
+}
+
+func priceListEntriesEqual(a, b []*PriceListEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equals(b[i]) {
+			return false
+		}
+	}
+	return true
+}
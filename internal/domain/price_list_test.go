@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPriceList(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := now.AddDate(1, 0, 0)
+
+	tests := []struct {
+		name       string
+		id         string
+		list       string
+		currency   string
+		validFrom  time.Time
+		validUntil time.Time
+		wantErr    error
+	}{
+		{"bounded", "pl1", "Acme 2025", "eur", now, until, nil},
+		{"open-ended", "pl1", "Acme", "USD", now, time.Time{}, nil},
+		{"missing id", "", "Acme", "USD", now, until, ErrInvalidID},
+		{"missing name", "pl1", " ", "USD", now, until, ErrInvalidPriceListName},
+		{"invalid currency", "pl1", "Acme", "euro", now, until, ErrInvalidCurrency},
+		{"missing valid from", "pl1", "Acme", "USD", time.Time{}, until, ErrInvalidPriceListValidity},
+		{"ends before it starts", "pl1", "Acme", "USD", until, now, ErrInvalidPriceListValidity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewPriceList(tt.id, tt.list, tt.currency, tt.validFrom, tt.validUntil, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, l.Currency(), 3)
+			assert.Empty(t, l.Entries())
+			require.Len(t, l.DomainEvents(), 1)
+			assert.Equal(t, "price_list.created", l.DomainEvents()[0].EventType())
+		})
+	}
+}
+
+func TestPriceList_IsValidAt(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := from.AddDate(1, 0, 0)
+
+	bounded, err := NewPriceList("pl1", "Acme 2025", "USD", from, until, from)
+	require.NoError(t, err)
+	assert.False(t, bounded.IsValidAt(from.Add(-time.Second)))
+	assert.True(t, bounded.IsValidAt(from))
+	assert.True(t, bounded.IsValidAt(until.Add(-time.Second)))
+	assert.False(t, bounded.IsValidAt(until))
+
+	open, err := NewPriceList("pl2", "Acme", "USD", from, time.Time{}, from)
+	require.NoError(t, err)
+	assert.True(t, open.IsValidAt(from.AddDate(10, 0, 0)))
+}
+
+func TestPriceList_SetEntries(t *testing.T) {
+	now := time.Now()
+	l, err := NewPriceList("pl1", "Acme", "EUR", now, time.Time{}, now)
+	require.NoError(t, err)
+	l.events = nil
+
+	widget, err := NewPriceListEntry("product-2", mustMoneyInCurrency(t, 1500, 100, "EUR"))
+	require.NoError(t, err)
+	gadget, err := NewPriceListEntry("product-1", mustMoneyInCurrency(t, 900, 100, "EUR"))
+	require.NoError(t, err)
+	require.NoError(t, l.SetEntries([]*PriceListEntry{widget, gadget}, now))
+
+	entries := l.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, "product-1", entries[0].ProductID())
+	assert.Same(t, widget, l.Entry("product-2"))
+	assert.Nil(t, l.Entry("product-3"))
+	require.Len(t, l.DomainEvents(), 1)
+	event, ok := l.DomainEvents()[0].(PriceListEntriesChangedEvent)
+	require.True(t, ok)
+	assert.Len(t, event.Entries, 2)
+
+	// Setting the same entries again is a no-op
+	l.events = nil
+	require.NoError(t, l.SetEntries([]*PriceListEntry{gadget, widget}, now))
+	assert.Empty(t, l.DomainEvents())
+
+	usd, err := NewPriceListEntry("product-3", NewMoney(1000, 100))
+	require.NoError(t, err)
+	assert.ErrorIs(t, l.SetEntries([]*PriceListEntry{usd}, now), ErrInvalidPriceListEntry)
+	assert.ErrorIs(t, l.SetEntries([]*PriceListEntry{widget, widget}, now), ErrDuplicatePriceListEntry)
+	assert.ErrorIs(t, l.SetEntries(make([]*PriceListEntry, MaxPriceListEntries+1), now), ErrTooManyPriceListEntries)
+
+	_, err = NewPriceListEntry("", mustMoneyInCurrency(t, 900, 100, "EUR"))
+	assert.ErrorIs(t, err, ErrInvalidPriceListEntry)
+	_, err = NewPriceListEntry("product-1", NewMoney(0, 1))
+	assert.ErrorIs(t, err, ErrInvalidPriceListEntry)
+
+	// An empty list removes every entry
+	require.NoError(t, l.SetEntries(nil, now))
+	assert.Empty(t, l.Entries())
+	assert.Len(t, l.DomainEvents(), 1)
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// PricePeriod is a span of time over which the effective price of a product does not
+// change: [From, Until), where a zero From or Until leaves that end open.
+type PricePeriod struct {
+	From  time.Time
+	Until time.Time
+	Price *Money
+	// Discount is the discount that applies over the period, or nil if none does.
+	Discount *Discount
+}
+
+// PricePeriods returns the effective price of the product over time as consecutive
+// periods, split where the applicable discount changes. Like EffectivePrice, it reflects
+// the product as it is now: a later price change, discount or suspension is not
+// anticipated, so the periods must be recomputed on every such change.
+func (p *Product) PricePeriods() []PricePeriod {
+	var boundaries []time.Time
+	for _, d := range p.discounts {
+		boundaries = append(boundaries, d.startDate, d.endDate)
+	}
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i].Before(boundaries[j]) })
+
+	periods := []PricePeriod{p.pricePeriodFrom(time.Time{})}
+	for _, at := range boundaries {
+		last := &periods[len(periods)-1]
+		if !at.After(last.From) {
+			continue
+		}
+		next := p.pricePeriodFrom(at)
+		if next.Discount == last.Discount {
+			continue
+		}
+		last.Until = at
+		periods = append(periods, next)
+	}
+	return periods
+}
+
+// pricePeriodFrom returns the open-ended price period starting at from.
+func (p *Product) pricePeriodFrom(from time.Time) PricePeriod {
+	return PricePeriod{From: from, Price: p.EffectivePrice(from), Discount: p.ApplicableDiscount(from)}
+}
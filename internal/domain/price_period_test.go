@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_PricePeriods(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	t1, t2, t3, t4 := now.Add(time.Hour), now.Add(2*time.Hour), now.Add(3*time.Hour), now.Add(4*time.Hour)
+	newDiscount := func(id string, pct int64, priority int, start, end time.Time) *Discount {
+		d, err := NewDiscount(big.NewRat(pct, 1), start, end)
+		require.NoError(t, err)
+		return d.WithID(id).WithPriority(priority)
+	}
+	newProduct := func(discounts ...*Discount) *Product {
+		return ReconstructProduct("product-1", "Widget", "", "", "Tools", "", "", NewMoney(20, 1), discounts, nil,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", DefaultTaxClass, ProductStatusActive, nil, nil, nil, now, now, nil)
+	}
+
+	t.Run("no discounts", func(t *testing.T) {
+		periods := newProduct().PricePeriods()
+		require.Len(t, periods, 1)
+		assert.True(t, periods[0].From.IsZero())
+		assert.True(t, periods[0].Until.IsZero())
+		assert.True(t, periods[0].Price.Equals(NewMoney(20, 1)))
+		assert.Nil(t, periods[0].Discount)
+	})
+
+	t.Run("overlapping discounts", func(t *testing.T) {
+		low := newDiscount("low", 10, 0, t1, t3)
+		high := newDiscount("high", 50, 1, t2, t4)
+		periods := newProduct(low, high).PricePeriods()
+
+		require.Len(t, periods, 4)
+		want := []struct {
+			from, until time.Time
+			price       *Money
+			discount    *Discount
+		}{
+			{time.Time{}, t1, NewMoney(20, 1), nil},
+			{t1, t2, NewMoney(18, 1), low},
+			{t2, t4, NewMoney(10, 1), high},
+			{t4, time.Time{}, NewMoney(20, 1), nil},
+		}
+		for i, w := range want {
+			assert.Equal(t, w.from, periods[i].From, "period %d", i)
+			assert.Equal(t, w.until, periods[i].Until, "period %d", i)
+			assert.True(t, w.price.Equals(periods[i].Price), "period %d: %s", i, periods[i].Price)
+			assert.Equal(t, w.discount, periods[i].Discount, "period %d", i)
+		}
+	})
+
+	t.Run("suspended discount", func(t *testing.T) {
+		suspended := newDiscount("d", 10, 0, t1, t3).Suspend(now)
+		periods := newProduct(suspended).PricePeriods()
+		require.Len(t, periods, 1)
+		assert.Nil(t, periods[0].Discount)
+	})
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"math/big"
+	"sort"
+	"time"
+)
+
+// MaxPriceTiersPerProduct is the maximum number of price tiers a product holds.
+const MaxPriceTiersPerProduct = 10
+
+// MaxPricedQuantity is the largest quantity GetPriceForQuantity prices, so the total
+// price stays within the int64 numerator of Money.
+const MaxPricedQuantity = 1_000_000
+
+// PriceTier is a volume price: buying at least MinQuantity units of a product lowers the
+// unit price, either to a fixed unit price or by a percentage of the base price.
+type PriceTier struct {
+	minQuantity int64
+	unitPrice   *Money
+	percentOff  *big.Rat
+}
+
+// NewUnitPriceTier creates a tier that sells each unit at unitPrice from minQuantity units on.
+// A tier for a single unit would just be another base price, so minQuantity must be at least 2.
+func NewUnitPriceTier(minQuantity int64, unitPrice *Money) (*PriceTier, error) {
+	if minQuantity < 2 {
+		return nil, ErrInvalidPriceTierQuantity
+	}
+	if unitPrice == nil || !unitPrice.IsPositive() {
+		return nil, ErrInvalidPriceTierPrice
+	}
+	return &PriceTier{minQuantity: minQuantity, unitPrice: unitPrice}, nil
+}
+
+// NewPercentOffTier creates a tier that takes percentOff percent off the base price of
+// each unit from minQuantity units on. minQuantity must be at least 2.
+func NewPercentOffTier(minQuantity int64, percentOff *big.Rat) (*PriceTier, error) {
+	if minQuantity < 2 {
+		return nil, ErrInvalidPriceTierQuantity
+	}
+	if percentOff == nil || percentOff.Sign() <= 0 || percentOff.Cmp(big.NewRat(100, 1)) > 0 {
+		return nil, ErrInvalidPriceTierPrice
+	}
+	if !hasMaxScale(percentOff, MaxPercentageScale) {
+		return nil, ErrInvalidDiscountPrecision
+	}
+	return &PriceTier{minQuantity: minQuantity, percentOff: new(big.Rat).Set(percentOff)}, nil
+}
+
+// MinQuantity returns the smallest quantity the tier applies to.
+func (t *PriceTier) MinQuantity() int64 { return t.minQuantity }
+
+// UnitPrice returns the fixed unit price of the tier, or nil for a percent-off tier.
+func (t *PriceTier) UnitPrice() *Money { return t.unitPrice }
+
+// PercentOff returns a copy of the percentage taken off the base price, or nil for a
+// unit-price tier.
+func (t *PriceTier) PercentOff() *big.Rat {
+	if t.percentOff == nil {
+		return nil
+	}
+	return new(big.Rat).Set(t.percentOff)
+}
+
+// ApplyTo returns the unit price of the tier for the given base price. A tier never
+// raises the price, so a unit price above the base price is capped at the base price.
+func (t *PriceTier) ApplyTo(basePrice *Money) *Money {
+	if t == nil || basePrice == nil {
+		return basePrice
+	}
+	if t.percentOff != nil {
+		return basePrice.ApplyDiscount(t.percentOff)
+	}
+	if t.unitPrice.GreaterThan(basePrice) {
+		return basePrice
+	}
+	return t.unitPrice
+}
+
+// Equals checks if two tiers are equal.
+func (t *PriceTier) Equals(other *PriceTier) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	if t.minQuantity != other.minQuantity || (t.unitPrice == nil) != (other.unitPrice == nil) {
+		return false
+	}
+	if t.unitPrice != nil {
+		return t.unitPrice.Equals(other.unitPrice)
+	}
+	return t.percentOff.Cmp(other.percentOff) == 0
+}
+
+// SortPriceTiers orders tiers by minimum quantity.
+func SortPriceTiers(tiers []*PriceTier) {
+	sort.SliceStable(tiers, func(i, j int) bool {
+		return tiers[i].minQuantity < tiers[j].minQuantity
+	})
+}
+
+// PriceTierFor returns the tier that applies to the given quantity: the one with the
+// highest minimum quantity not above it. It returns nil if the quantity is below every tier.
+func PriceTierFor(tiers []*PriceTier, quantity int64) *PriceTier {
+	var applicable *PriceTier
+	for _, t := range tiers {
+		if t.minQuantity <= quantity && (applicable == nil || t.minQuantity > applicable.minQuantity) {
+			applicable = t
+		}
+	}
+	return applicable
+}
+
+// QuantityPrice is the price of buying a quantity of a product at a given time.
+type QuantityPrice struct {
+	Quantity int64
+	// Tier is the price tier applied, or nil if the quantity is below every tier.
+	Tier *PriceTier
+	// Discount is the discount applied on top of the tier, or nil if none applies.
+	Discount  *Discount
+	UnitPrice *Money
+	Total     *Money
+}
+
+// PriceForQuantity prices quantity units of a product with the given base price,
+// discounts and tiers at the given time. The tier for the quantity sets the unit price
+// and the applicable discount is then taken off that price, so promotions stack with
+// volume pricing.
+func PriceForQuantity(basePrice *Money, discounts []*Discount, tiers []*PriceTier, quantity int64, at time.Time) (*QuantityPrice, error) {
+	if quantity < 1 || quantity > MaxPricedQuantity {
+		return nil, ErrInvalidQuantity
+	}
+
+	price := &QuantityPrice{
+		Quantity: quantity,
+		Tier:     PriceTierFor(tiers, quantity),
+		Discount: ApplicableDiscount(discounts, at),
+	}
+	price.UnitPrice = price.Tier.ApplyTo(basePrice)
+	price.UnitPrice = price.Discount.ApplyTo(price.UnitPrice)
+	price.Total = price.UnitPrice.Multiply(big.NewRat(quantity, 1))
+	return price, nil
+}
@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustUnitPriceTier(t *testing.T, minQuantity int64, price *Money) *PriceTier {
+	t.Helper()
+	tier, err := NewUnitPriceTier(minQuantity, price)
+	require.NoError(t, err)
+	return tier
+}
+
+func mustPercentOffTier(t *testing.T, minQuantity int64, percentOff int64) *PriceTier {
+	t.Helper()
+	tier, err := NewPercentOffTier(minQuantity, big.NewRat(percentOff, 1))
+	require.NoError(t, err)
+	return tier
+}
+
+func TestNewPriceTier_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		create  func() (*PriceTier, error)
+		wantErr error
+	}{
+		{"unit price for one unit", func() (*PriceTier, error) { return NewUnitPriceTier(1, NewMoney(900, 100)) }, ErrInvalidPriceTierQuantity},
+		{"percent off for one unit", func() (*PriceTier, error) { return NewPercentOffTier(1, big.NewRat(10, 1)) }, ErrInvalidPriceTierQuantity},
+		{"nil unit price", func() (*PriceTier, error) { return NewUnitPriceTier(10, nil) }, ErrInvalidPriceTierPrice},
+		{"zero unit price", func() (*PriceTier, error) { return NewUnitPriceTier(10, Zero()) }, ErrInvalidPriceTierPrice},
+		{"nil percent off", func() (*PriceTier, error) { return NewPercentOffTier(10, nil) }, ErrInvalidPriceTierPrice},
+		{"zero percent off", func() (*PriceTier, error) { return NewPercentOffTier(10, big.NewRat(0, 1)) }, ErrInvalidPriceTierPrice},
+		{"over 100 percent off", func() (*PriceTier, error) { return NewPercentOffTier(10, big.NewRat(101, 1)) }, ErrInvalidPriceTierPrice},
+		{"percent off too precise", func() (*PriceTier, error) { return NewPercentOffTier(10, big.NewRat(1, 3)) }, ErrInvalidDiscountPrecision},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.create()
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPriceForQuantity(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	base := NewMoney(2000, 100)
+	tiers := []*PriceTier{
+		mustUnitPriceTier(t, 10, NewMoney(1800, 100)),
+		mustPercentOffTier(t, 100, 25),
+		mustUnitPriceTier(t, 1000, NewMoney(2500, 100)),
+	}
+	discount, err := NewDiscount(big.NewRat(10, 1), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	discounts := []*Discount{discount.WithID("d1")}
+
+	tests := []struct {
+		name      string
+		discounts []*Discount
+		quantity  int64
+		tierMin   int64
+		unit      *Money
+		total     *Money
+	}{
+		{"below every tier", nil, 9, 0, NewMoney(2000, 100), NewMoney(18000, 100)},
+		{"unit price tier", nil, 10, 10, NewMoney(1800, 100), NewMoney(18000, 100)},
+		{"highest tier reached", nil, 150, 100, NewMoney(1500, 100), NewMoney(225000, 100)},
+		{"tier above base price is capped", nil, 1000, 1000, NewMoney(2000, 100), NewMoney(2000000, 100)},
+		{"discount stacks on tier", discounts, 10, 10, NewMoney(1620, 100), NewMoney(16200, 100)},
+		{"discount without tier", discounts, 1, 0, NewMoney(1800, 100), NewMoney(1800, 100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, err := PriceForQuantity(base, tt.discounts, tiers, tt.quantity, now)
+			require.NoError(t, err)
+
+			if tt.tierMin == 0 {
+				assert.Nil(t, price.Tier)
+			} else {
+				require.NotNil(t, price.Tier)
+				assert.Equal(t, tt.tierMin, price.Tier.MinQuantity())
+			}
+			assert.Equal(t, tt.discounts != nil, price.Discount != nil)
+			assert.True(t, tt.unit.Equals(price.UnitPrice), "unit price %s", price.UnitPrice)
+			assert.True(t, tt.total.Equals(price.Total), "total %s", price.Total)
+		})
+	}
+}
+
+func TestPriceForQuantity_InvalidQuantity(t *testing.T) {
+	for _, quantity := range []int64{0, -1, MaxPricedQuantity + 1} {
+		_, err := PriceForQuantity(NewMoney(2000, 100), nil, nil, quantity, time.Now())
+		assert.ErrorIs(t, err, ErrInvalidQuantity, "quantity %d", quantity)
+	}
+}
+
+func TestProduct_SetPriceTiers(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	tiers := []*PriceTier{mustPercentOffTier(t, 100, 25), mustUnitPriceTier(t, 10, NewMoney(1800, 100))}
+	require.NoError(t, product.SetPriceTiers(tiers, now))
+
+	got := product.PriceTiers()
+	require.Len(t, got, 2)
+	assert.Equal(t, int64(10), got[0].MinQuantity())
+	assert.Equal(t, int64(100), got[1].MinQuantity())
+	assert.True(t, product.Changes().Dirty(FieldPriceTiers))
+	require.Len(t, product.DomainEvents(), 1)
+	event, ok := product.DomainEvents()[0].(PriceTiersChangedEvent)
+	require.True(t, ok)
+	assert.Len(t, event.Tiers, 2)
+
+	// Setting the same tiers again is a no-op
+	product.ClearEvents()
+	product.Changes().Reset()
+	require.NoError(t, product.SetPriceTiers([]*PriceTier{tiers[1], tiers[0]}, now))
+	assert.Empty(t, product.DomainEvents())
+	assert.False(t, product.Changes().HasChanges())
+
+	// An empty list removes every tier
+	require.NoError(t, product.SetPriceTiers(nil, now))
+	assert.Empty(t, product.PriceTiers())
+	assert.Len(t, product.DomainEvents(), 1)
+}
+
+func TestProduct_SetPriceTiers_Invalid(t *testing.T) {
+	now := time.Now()
+
+	tooMany := make([]*PriceTier, MaxPriceTiersPerProduct+1)
+	for i := range tooMany {
+		tooMany[i] = mustPercentOffTier(t, int64(i+2), 10)
+	}
+
+	tests := []struct {
+		name    string
+		archive bool
+		tiers   []*PriceTier
+		wantErr error
+	}{
+		{"duplicate minimum quantity", false, []*PriceTier{mustPercentOffTier(t, 10, 5), mustUnitPriceTier(t, 10, NewMoney(1800, 100))}, ErrDuplicatePriceTier},
+		{"too many tiers", false, tooMany, ErrTooManyPriceTiers},
+		{"nil tier", false, []*PriceTier{nil}, ErrInvalidPriceTierPrice},
+		{"archived product", true, []*PriceTier{mustPercentOffTier(t, 10, 5)}, ErrProductArchived},
+		{"unit price in other currency", false, []*PriceTier{mustUnitPriceTier(t, 10, mustMoneyInCurrency(t, 1800, 100, "EUR"))}, ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+			require.NoError(t, err)
+			if tt.archive {
+				require.NoError(t, product.Archive(now))
+			}
+
+			assert.ErrorIs(t, product.SetPriceTiers(tt.tiers, now), tt.wantErr)
+			assert.Empty(t, product.PriceTiers())
+		})
+	}
+}
@@ -6,13 +6,24 @@ import (
 )
 
 // PricingCalculator is a domain service for pricing calculations.
-type PricingCalculator struct{}
+type PricingCalculator struct {
+	composition *DiscountComposition
+}
 
-// NewPricingCalculator creates a new PricingCalculator instance.
+// NewPricingCalculator creates a new PricingCalculator instance. It combines discounts
+// with CompositionNone and no caps unless configured WithComposition.
 func NewPricingCalculator() *PricingCalculator {
 	return &PricingCalculator{}
 }
 
+// WithComposition returns a copy of the calculator that combines discounts with the given
+// policy.
+func (pc *PricingCalculator) WithComposition(composition *DiscountComposition) *PricingCalculator {
+	c := *pc
+	c.composition = composition
+	return &c
+}
+
 // CalculateEffectivePrice calculates the effective price for a product at a given time.
 func (pc *PricingCalculator) CalculateEffectivePrice(product *Product, at time.Time) *Money {
 	if product == nil {
@@ -40,17 +51,96 @@ func (pc *PricingCalculator) CalculateDiscountAmount(basePrice *Money, discountP
 	return basePrice.CalculatePercentage(discountPercent)
 }
 
-// CalculateSavings calculates how much a customer saves with the current discount.
-func (pc *PricingCalculator) CalculateSavings(product *Product, at time.Time) *Money {
+// CalculateTaxedPrice calculates the tax on a net price and the gross price, with the
+// rate of the tax class in the region from rates. Amounts are exact; round them for
+// display only.
+func (pc *PricingCalculator) CalculateTaxedPrice(net *Money, class TaxClass, region string, rates TaxRateProvider) (*TaxedPrice, error) {
+	if net == nil {
+		return nil, ErrInvalidBasePrice
+	}
+	if rates == nil {
+		return nil, ErrNoTaxRate
+	}
+	rate, err := rates.TaxRate(class, region)
+	if err != nil {
+		return nil, err
+	}
+
+	tax := net.CalculatePercentage(rate)
+	gross, err := net.Add(tax)
+	if err != nil {
+		return nil, err
+	}
+	return &TaxedPrice{Net: net, Tax: tax, Gross: gross, RatePercent: rate}, nil
+}
+
+// CalculateMargin calculates the margin of selling at price with the given cost price.
+func (pc *PricingCalculator) CalculateMargin(price, cost *Money) (*Margin, error) {
+	if price == nil || !price.IsPositive() {
+		return nil, ErrInvalidBasePrice
+	}
+	if cost == nil {
+		return nil, ErrNoCostPrice
+	}
+	amount, err := price.Sub(cost)
+	if err != nil {
+		return nil, err
+	}
+	percent := new(big.Rat).Quo(amount.Amount(), price.Amount())
+	percent.Mul(percent, big.NewRat(100, 1))
+	return &Margin{Price: price, Cost: cost, Amount: amount, Percent: percent}, nil
+}
+
+// CalculateCompoundDiscount calculates the total percentage off of discounts, ordered by
+// precedence, for a product of the category under the composition policy.
+func (pc *PricingCalculator) CalculateCompoundDiscount(category string, discounts []*Discount) *big.Rat {
+	percentages := make([]*big.Rat, 0, len(discounts))
+	for _, d := range discounts {
+		percentages = append(percentages, d.Percentage())
+	}
+	return pc.composition.Combine(category, percentages)
+}
+
+// CalculateCouponDiscount calculates the total percentage off of discounts, ordered by
+// precedence and combined under the composition policy, and of a coupon taken off the
+// price they leave, for a product of the category. The coupon counts towards the cap.
+func (pc *PricingCalculator) CalculateCouponDiscount(category string, discounts []*big.Rat, coupon *big.Rat) *big.Rat {
+	total := pc.composition.Combine(category, discounts)
+	if coupon == nil || coupon.Sign() == 0 {
+		return total
+	}
+	hundred := big.NewRat(100, 1)
+	left := new(big.Rat).Sub(hundred, total)
+	left.Mul(left, new(big.Rat).Sub(hundred, coupon))
+	left.Quo(left, hundred)
+	// Combining a single percentage only caps it.
+	return pc.composition.Combine(category, []*big.Rat{left.Sub(hundred, left)})
+}
+
+// CalculateCompoundPrice calculates the price of a product at a given time with every
+// percentage discount valid then combined under the composition policy. With
+// CompositionNone and no cap it equals the effective price.
+func (pc *PricingCalculator) CalculateCompoundPrice(product *Product, at time.Time) *Money {
 	if product == nil {
 		return Zero()
 	}
-	if !product.HasActiveDiscount(at) {
+	discounts := ApplicableDiscounts(product.Discounts(), at)
+	if len(discounts) == 0 {
+		return product.BasePrice()
+	}
+	return product.BasePrice().ApplyDiscount(pc.CalculateCompoundDiscount(product.Category(), discounts))
+}
+
+// CalculateSavings calculates how much a customer saves with the current discount.
+func (pc *PricingCalculator) CalculateSavings(product *Product, at time.Time) *Money {
+	if product == nil {
 		return Zero()
 	}
-	discount := product.Discount()
+	discount := product.ApplicableDiscount(at)
 	if discount == nil {
 		return Zero()
 	}
-	return pc.CalculateDiscountAmount(product.BasePrice(), discount.Percentage())
+	// The effective price, not the percentage, so that a sale price is saved exactly.
+	savings, _ := product.BasePrice().Sub(discount.ApplyTo(product.BasePrice()))
+	return savings
 }
@@ -1,25 +1,64 @@
 package domain
 
 import (
+	"sort"
 	"strings"
 	"time"
 )
 
+// MaxDiscountsPerProduct is the maximum number of discounts a product holds at once,
+// counting expired discounts whose end has not been announced yet.
+const MaxDiscountsPerProduct = 10
+
 // Product is the aggregate root for product management.
 // It encapsulates all business logic related to products.
 type Product struct {
-	id          string
-	name        string
-	description string
-	category    string
-	basePrice   *Money
-	discount    *Discount
-	status      ProductStatus
-	createdAt   time.Time
-	updatedAt   time.Time
-	archivedAt  *time.Time
-	changes     *ChangeTracker
-	events      []DomainEvent
+	id string
+	// tenantID is the tenant that owns the product; empty for the default tenant.
+	tenantID string
+	name     string
+	slug     string
+	// sku and gtin identify the product in warehouses and at checkouts; empty if unset.
+	sku           string
+	gtin          string
+	description   string
+	category      string
+	basePrice     *Money
+	discounts     []*Discount
+	priceTiers    []*PriceTier
+	priceBook     []*Money
+	taxClass      TaxClass
+	segmentPrices []*SegmentPrice
+	marketPrices  []*MarketPrice
+	minimumPrice  *Money
+	costPrice     *Money
+	// weight and dimensions are what the product weighs and measures packed for
+	// shipping; nil if unset.
+	weight     *Weight
+	dimensions *Dimensions
+	// kind is whether the product is shipped or delivered digitally; empty if unset.
+	kind ProductKind
+	// compliance holds the regulatory flags of the product; nil if it has none.
+	compliance *Compliance
+	// unavailableChannels are the sales channels the product is withheld from, kept
+	// sorted; empty if it is available on every channel.
+	unavailableChannels []SalesChannel
+	// tags are kept sorted.
+	tags       []string
+	attributes map[string]string
+	// pendingPriceChange is the scheduled base price change, if any.
+	pendingPriceChange *PendingPriceChange
+	// activateAt and deactivateAt are the scheduled status changes, if any.
+	activateAt   *time.Time
+	deactivateAt *time.Time
+	// review is the latest review of the product; nil if it was never submitted.
+	review     *ProductReview
+	status     ProductStatus
+	createdAt  time.Time
+	updatedAt  time.Time
+	archivedAt *time.Time
+	changes    *ChangeTracker
+	events     []DomainEvent
 }
 
 // NewProduct creates a new Product aggregate.
@@ -43,6 +82,7 @@ func NewProduct(id, name, description, category string, basePrice *Money, now ti
 		description: strings.TrimSpace(description),
 		category:    strings.TrimSpace(category),
 		basePrice:   basePrice,
+		taxClass:    DefaultTaxClass,
 		status:      ProductStatusDraft,
 		createdAt:   now,
 		updatedAt:   now,
@@ -64,26 +104,81 @@ func NewProduct(id, name, description, category string, basePrice *Money, now ti
 // ReconstructProduct reconstructs a Product from persistence.
 // This is used by repositories to load existing products.
 func ReconstructProduct(
-	id, name, description, category string,
+	id, name, slug, description, category string,
+	sku, gtin string,
 	basePrice *Money,
-	discount *Discount,
+	discounts []*Discount,
+	priceTiers []*PriceTier,
+	priceBook []*Money,
+	segmentPrices []*SegmentPrice,
+	marketPrices []*MarketPrice,
+	minimumPrice *Money,
+	costPrice *Money,
+	pendingPriceChange *PendingPriceChange,
+	tags []string,
+	attributes map[string]string,
+	weight *Weight,
+	dimensions *Dimensions,
+	kind ProductKind,
+	compliance *Compliance,
+	unavailableChannels []SalesChannel,
+	tenantID string,
+	taxClass TaxClass,
 	status ProductStatus,
+	review *ProductReview,
+	activateAt, deactivateAt *time.Time,
 	createdAt, updatedAt time.Time,
 	archivedAt *time.Time,
 ) *Product {
+	discounts = append([]*Discount(nil), discounts...)
+	SortDiscounts(discounts)
+	priceTiers = append([]*PriceTier(nil), priceTiers...)
+	SortPriceTiers(priceTiers)
+	priceBook = append([]*Money(nil), priceBook...)
+	SortPrices(priceBook)
+	segmentPrices = append([]*SegmentPrice(nil), segmentPrices...)
+	SortSegmentPrices(segmentPrices)
+	marketPrices = append([]*MarketPrice(nil), marketPrices...)
+	SortMarketPrices(marketPrices)
+	tags = append([]string(nil), tags...)
+	sort.Strings(tags)
+	unavailableChannels = append([]SalesChannel(nil), unavailableChannels...)
+	sortSalesChannels(unavailableChannels)
 	return &Product{
-		id:          id,
-		name:        name,
-		description: description,
-		category:    category,
-		basePrice:   basePrice,
-		discount:    discount,
-		status:      status,
-		createdAt:   createdAt,
-		updatedAt:   updatedAt,
-		archivedAt:  archivedAt,
-		changes:     NewChangeTracker(),
-		events:      make([]DomainEvent, 0),
+		id:                  id,
+		tenantID:            tenantID,
+		name:                name,
+		slug:                slug,
+		sku:                 sku,
+		gtin:                gtin,
+		description:         description,
+		category:            category,
+		basePrice:           basePrice,
+		discounts:           discounts,
+		priceTiers:          priceTiers,
+		priceBook:           priceBook,
+		taxClass:            taxClass,
+		segmentPrices:       segmentPrices,
+		marketPrices:        marketPrices,
+		minimumPrice:        minimumPrice,
+		costPrice:           costPrice,
+		pendingPriceChange:  pendingPriceChange,
+		tags:                tags,
+		attributes:          attributes,
+		weight:              weight,
+		dimensions:          dimensions,
+		kind:                kind,
+		compliance:          compliance,
+		unavailableChannels: unavailableChannels,
+		status:              status,
+		review:              review,
+		activateAt:          activateAt,
+		deactivateAt:        deactivateAt,
+		createdAt:           createdAt,
+		updatedAt:           updatedAt,
+		archivedAt:          archivedAt,
+		changes:             NewChangeTracker(),
+		events:              make([]DomainEvent, 0),
 	}
 }
 
@@ -102,8 +197,45 @@ func (p *Product) Category() string { return p.category }
 // BasePrice returns the product base price.
 func (p *Product) BasePrice() *Money { return p.basePrice }
 
-// Discount returns the currently applied discount, if any.
-func (p *Product) Discount() *Discount { return p.discount }
+// Currency returns the ISO 4217 code of the product's prices.
+func (p *Product) Currency() string { return p.basePrice.Currency() }
+
+// Discounts returns the discounts of the product ordered by start date.
+func (p *Product) Discounts() []*Discount {
+	return append([]*Discount(nil), p.discounts...)
+}
+
+// FindDiscount returns the discount with the given ID, or nil.
+func (p *Product) FindDiscount(id string) *Discount {
+	for _, d := range p.discounts {
+		if d.ID() == id {
+			return d
+		}
+	}
+	return nil
+}
+
+// ApplicableDiscount returns the discount that applies to the price at the given time,
+// if any; see ApplicableDiscount.
+func (p *Product) ApplicableDiscount(now time.Time) *Discount {
+	return ApplicableDiscount(p.discounts, now)
+}
+
+// ApplicablePromotion returns the buy-X-get-Y promotion that applies to carts at the given
+// time, if any; see ApplicablePromotion.
+func (p *Product) ApplicablePromotion(now time.Time) *Discount {
+	return ApplicablePromotion(p.discounts, now)
+}
+
+// PriceTiers returns the price tiers of the product ordered by minimum quantity.
+func (p *Product) PriceTiers() []*PriceTier {
+	return append([]*PriceTier(nil), p.priceTiers...)
+}
+
+// PriceBook returns the prices of the product in other currencies, ordered by currency.
+func (p *Product) PriceBook() []*Money {
+	return append([]*Money(nil), p.priceBook...)
+}
 
 // Status returns the current product status.
 func (p *Product) Status() ProductStatus { return p.status }
@@ -128,17 +260,23 @@ func (p *Product) ClearEvents() {
 	p.events = make([]DomainEvent, 0)
 }
 
-// EffectivePrice calculates the current effective price considering any active discount.
+// EffectivePrice calculates the current effective price considering the applicable discount.
 func (p *Product) EffectivePrice(now time.Time) *Money {
-	if p.discount != nil && p.discount.IsActive(now) {
-		return p.discount.ApplyTo(p.basePrice)
+	if d := p.ApplicableDiscount(now); d != nil {
+		return d.ApplyTo(p.basePrice)
 	}
 	return p.basePrice
 }
 
 // HasActiveDiscount returns true if the product has an active discount at the given time.
 func (p *Product) HasActiveDiscount(now time.Time) bool {
-	return p.discount != nil && p.discount.IsActive(now)
+	return p.ApplicableDiscount(now) != nil
+}
+
+// PriceForQuantity prices quantity units of the product at the given time; see
+// PriceForQuantity.
+func (p *Product) PriceForQuantity(quantity int64, at time.Time) (*QuantityPrice, error) {
+	return PriceForQuantity(p.basePrice, p.discounts, p.priceTiers, quantity, at)
 }
 
 // Business Methods
@@ -189,7 +327,93 @@ func (p *Product) Update(name, description, category string, now time.Time) erro
 	return nil
 }
 
+// ChangeBasePrice changes the base price of the product.
+// The currency of a product is set when it is created, so the new price must be in it.
+// It must stay above the sale price of every discount that has not expired, whose
+// percentage is then derived from it. Setting the current price again is a no-op and
+// raises no event.
+func (p *Product) ChangeBasePrice(newPrice *Money, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if newPrice == nil || !newPrice.IsPositive() {
+		return ErrInvalidBasePrice
+	}
+	if !p.basePrice.SameCurrency(newPrice) {
+		return ErrCurrencyMismatch
+	}
+	if p.basePrice.Equals(newPrice) {
+		return nil
+	}
+	if err := p.checkBasePriceAboveSalePrices(newPrice, now); err != nil {
+		return err
+	}
+
+	oldPrice := p.basePrice
+	p.basePrice = newPrice
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldBasePrice)
+	p.repriceSaleDiscounts(now)
+
+	p.events = append(p.events, NewProductPriceChangedEvent(p.id, oldPrice, newPrice, now))
+	return nil
+}
+
+// SetPriceTiers replaces the price tiers of the product; an empty list removes them all.
+// Tiers must have distinct minimum quantities, unit prices must be in the currency of the
+// product, and a product holds up to MaxPriceTiersPerProduct of them. Setting the current
+// tiers again is a no-op and raises no event.
+func (p *Product) SetPriceTiers(tiers []*PriceTier, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if len(tiers) > MaxPriceTiersPerProduct {
+		return ErrTooManyPriceTiers
+	}
+
+	sorted := make([]*PriceTier, 0, len(tiers))
+	for _, t := range tiers {
+		if t == nil {
+			return ErrInvalidPriceTierPrice
+		}
+		if price := t.UnitPrice(); price != nil && !price.SameCurrency(p.basePrice) {
+			return ErrCurrencyMismatch
+		}
+		sorted = append(sorted, t)
+	}
+	SortPriceTiers(sorted)
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].MinQuantity() == sorted[i-1].MinQuantity() {
+			return ErrDuplicatePriceTier
+		}
+	}
+
+	if priceTiersEqual(p.priceTiers, sorted) {
+		return nil
+	}
+
+	p.priceTiers = sorted
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldPriceTiers)
+
+	p.events = append(p.events, NewPriceTiersChangedEvent(p.id, p.Currency(), p.PriceTiers(), now))
+	return nil
+}
+
+func priceTiersEqual(a, b []*PriceTier) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equals(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // Activate activates the product, making it available for sale.
+// Discounts suspended by Deactivate are resumed, or removed if they ended in the meantime.
 func (p *Product) Activate(now time.Time) error {
 	if p.status == ProductStatusArchived {
 		return ErrProductArchived
@@ -201,15 +425,49 @@ func (p *Product) Activate(now time.Time) error {
 		return ErrProductNotActive
 	}
 
+	p.activate(now)
+	return nil
+}
+
+// activate makes the product active and resumes its suspended discounts; see Activate.
+func (p *Product) activate(now time.Time) {
 	p.status = ProductStatusActive
 	p.updatedAt = now
 	p.changes.MarkDirty(FieldStatus)
 
 	p.events = append(p.events, NewProductActivatedEvent(p.id, now))
-	return nil
+
+	kept := make([]*Discount, 0, len(p.discounts))
+	resumed := false
+	for _, d := range p.discounts {
+		switch {
+		case !d.IsSuspended():
+		case d.IsExpired(now):
+			p.events = append(p.events, NewDiscountExpiredEvent(p.id, d.ID(), now))
+			p.changes.MarkDirty(FieldDiscount)
+			continue
+		default:
+			d = d.Resume()
+			if d.Phase() == DiscountPhaseScheduled && d.HasStarted(now) {
+				// The resumed event already announces the price change.
+				d = d.WithPhase(DiscountPhaseStarted)
+			}
+			resumed = true
+			p.changes.MarkDirty(FieldDiscount)
+		}
+		kept = append(kept, d)
+	}
+	p.discounts = kept
+
+	if resumed {
+		p.events = append(p.events, NewDiscountResumedEvent(p.id, now))
+	}
 }
 
 // Deactivate deactivates the product.
+// Discounts that have not expired are suspended so they do not apply while the product
+// is inactive; see Activate. Discounts pending approval do not apply anyway and are left
+// alone.
 func (p *Product) Deactivate(now time.Time) error {
 	if p.status == ProductStatusArchived {
 		return ErrProductArchived
@@ -221,6 +479,18 @@ func (p *Product) Deactivate(now time.Time) error {
 		return ErrProductNotActive
 	}
 
+	suspended := false
+	for i, d := range p.discounts {
+		if !d.IsSuspended() && !d.IsPendingApproval() && !d.IsExpired(now) {
+			p.discounts[i] = d.Suspend(now)
+			suspended = true
+		}
+	}
+	if suspended {
+		p.changes.MarkDirty(FieldDiscount)
+		p.events = append(p.events, NewDiscountSuspendedEvent(p.id, now))
+	}
+
 	p.status = ProductStatusInactive
 	p.updatedAt = now
 	p.changes.MarkDirty(FieldStatus)
@@ -230,11 +500,32 @@ func (p *Product) Deactivate(now time.Time) error {
 }
 
 // Archive archives the product (soft delete).
+// Archived products cannot retain discounts, so every discount is removed first and a
+// DiscountRemovedEvent is raised for each before the ProductArchivedEvent. A pending
+// price change is cancelled the same way. Scheduled status changes are dropped without an
+// event; the archived event implies them.
 func (p *Product) Archive(now time.Time) error {
 	if p.status == ProductStatusArchived {
 		return ErrProductArchived
 	}
 
+	if len(p.discounts) > 0 {
+		for _, d := range p.discounts {
+			p.events = append(p.events, NewDiscountRemovedEvent(p.id, d.ID(), now))
+		}
+		p.discounts = nil
+		p.changes.MarkDirty(FieldDiscount)
+	}
+	if p.pendingPriceChange != nil {
+		p.events = append(p.events, NewPriceChangeCancelledEvent(p.id, now))
+		p.pendingPriceChange = nil
+		p.changes.MarkDirty(FieldPendingPriceChange)
+	}
+	if p.activateAt != nil || p.deactivateAt != nil {
+		p.activateAt, p.deactivateAt = nil, nil
+		p.changes.MarkDirty(FieldStatusSchedule)
+	}
+
 	p.status = ProductStatusArchived
 	p.archivedAt = &now
 	p.updatedAt = now
@@ -244,7 +535,19 @@ func (p *Product) Archive(now time.Time) error {
 	return nil
 }
 
-// ApplyDiscount applies a discount to the product.
+// ApplyDiscount adds a discount to the product.
+//
+// A product holds up to MaxDiscountsPerProduct discounts. Discounts of the same priority
+// must not overlap; a discount of higher priority may overlap others and takes precedence
+// while it is valid (see ApplicableDiscount). Only discounts that can apply to the same
+// price must not overlap: a discount scoped to a market overlaps unscoped discounts and
+// those of its market, and requires the product to have a price in that market. A discount
+// must not bring the base price below the minimum price of the product, if it has one.
+// The sale price of a discount expressed as one must be below the base price, which its
+// percentage is derived from; see NewSalePriceDiscount. Expired discounts whose end has
+// been announced, or that were never approved, are dropped to make room. A discount
+// pending approval (see Discount.RequireApproval) is held, and counts towards overlaps,
+// but is not announced as started until approved.
 func (p *Product) ApplyDiscount(discount *Discount, now time.Time) error {
 	if p.status != ProductStatusActive {
 		return ErrProductNotActive
@@ -256,36 +559,140 @@ func (p *Product) ApplyDiscount(discount *Discount, now time.Time) error {
 	if discount == nil {
 		return ErrInvalidDiscountPercentage
 	}
+	if strings.TrimSpace(discount.ID()) == "" {
+		return ErrInvalidID
+	}
+	if discount.Priority() < 0 || discount.Priority() > MaxDiscountPriority {
+		return ErrInvalidDiscountPriority
+	}
 
 	// Validate discount is valid at the current time or starts in the future
 	if discount.IsExpired(now) {
 		return ErrInvalidDiscountPeriod
 	}
+	discount, err := p.priceSaleDiscount(discount)
+	if err != nil {
+		return err
+	}
+	if market := discount.Market(); market != "" && p.MarketPrice(market) == nil {
+		return ErrNoMarketPrice
+	}
+	if err := p.checkMinimumPrice(discount); err != nil {
+		return err
+	}
 
-	p.discount = discount
+	kept := make([]*Discount, 0, len(p.discounts)+1)
+	for _, d := range p.discounts {
+		if d.ID() == discount.ID() {
+			return ErrDiscountAlreadyExists
+		}
+		if d.IsExpired(now) {
+			if d.Phase() == DiscountPhaseEnded || d.IsPendingApproval() {
+				continue
+			}
+		} else if d.Kind() == discount.Kind() && d.Priority() == discount.Priority() &&
+			d.SharesMarket(discount) && d.Overlaps(discount) {
+			return ErrDiscountOverlap
+		}
+		kept = append(kept, d)
+	}
+	if len(kept) >= MaxDiscountsPerProduct {
+		return ErrTooManyDiscounts
+	}
+
+	// A discount that is in effect right away is announced by the applied event;
+	// a future-dated one is announced by AdvanceDiscountPhase when it starts, and one
+	// pending approval by ApproveDiscount or AdvanceDiscountPhase once approved.
+	if discount.HasStarted(now) && !discount.IsPendingApproval() {
+		discount = discount.WithPhase(DiscountPhaseStarted)
+	} else {
+		discount = discount.WithPhase(DiscountPhaseScheduled)
+	}
+
+	p.discounts = append(kept, discount)
+	SortDiscounts(p.discounts)
 	p.updatedAt = now
 	p.changes.MarkDirty(FieldDiscount)
 
-	p.events = append(p.events, NewDiscountAppliedEvent(
-		p.id, discount.Percentage(), discount.StartDate(), discount.EndDate(), now,
-	))
+	applied := NewDiscountAppliedEvent(
+		p.id, discount.ID(), discount.Percentage(), discount.Priority(), discount.StartDate(), discount.EndDate(), now,
+	)
+	applied.Promotion = discount.Promotion()
+	applied.Market = discount.Market()
+	applied.PendingApproval = discount.IsPendingApproval()
+	applied.FlashSale = discount.IsFlashSale()
+	applied.SalePrice = discount.SalePrice()
+	p.events = append(p.events, applied)
 	return nil
 }
 
-// RemoveDiscount removes the current discount from the product.
-func (p *Product) RemoveDiscount(now time.Time) error {
+// AdvanceDiscountPhase announces the discount period boundaries that have passed by now:
+// a DiscountStartedEvent once a future-dated discount takes effect and a DiscountEndedEvent
+// once it expires, so consumers learn about the price change without polling. A period
+// that passed both boundaries unannounced only raises the ended event. Suspended discounts,
+// discounts pending approval and products that are not active are left alone, since their
+// price does not change.
+// It reports whether the phase of any discount changed.
+func (p *Product) AdvanceDiscountPhase(now time.Time) bool {
+	if p.status != ProductStatusActive {
+		return false
+	}
+
+	advanced := false
+	for i, d := range p.discounts {
+		if d.IsSuspended() || d.IsPendingApproval() {
+			continue
+		}
+
+		switch {
+		case d.Phase() != DiscountPhaseEnded && d.IsExpired(now):
+			p.discounts[i] = d.WithPhase(DiscountPhaseEnded)
+			ended := NewDiscountEndedEvent(p.id, d.ID(), d.EndDate(), now)
+			ended.FlashSale = d.IsFlashSale()
+			p.events = append(p.events, ended)
+		case d.Phase() == DiscountPhaseScheduled && d.HasStarted(now):
+			p.discounts[i] = d.WithPhase(DiscountPhaseStarted)
+			started := NewDiscountStartedEvent(p.id, d.ID(), d.Percentage(), d.StartDate(), d.EndDate(), now)
+			started.Promotion = d.Promotion()
+			started.FlashSale = d.IsFlashSale()
+			p.events = append(p.events, started)
+		default:
+			continue
+		}
+		advanced = true
+	}
+
+	if advanced {
+		p.changes.MarkDirty(FieldDiscountPhase)
+	}
+	return advanced
+}
+
+// RemoveDiscount removes the discount with the given ID from the product, or every
+// discount if id is empty.
+func (p *Product) RemoveDiscount(id string, now time.Time) error {
 	if p.status == ProductStatusArchived {
 		return ErrProductArchived
 	}
-	if p.discount == nil {
+	if len(p.discounts) == 0 {
 		return ErrNoDiscountToRemove
 	}
 
-	p.discount = nil
+	kept := make([]*Discount, 0, len(p.discounts))
+	for _, d := range p.discounts {
+		if id != "" && d.ID() != id {
+			kept = append(kept, d)
+			continue
+		}
+		p.events = append(p.events, NewDiscountRemovedEvent(p.id, d.ID(), now))
+	}
+	if len(kept) == len(p.discounts) {
+		return ErrDiscountNotFound
+	}
+
+	p.discounts = kept
 	p.updatedAt = now
 	p.changes.MarkDirty(FieldDiscount)
-
-	p.events = append(p.events, NewDiscountRemovedEvent(p.id, now))
 	return nil
 }
 
@@ -0,0 +1,130 @@
+package domain
+
+import "time"
+
+// MaxProductAttachments is the maximum number of attachments of a product.
+const MaxProductAttachments = 50
+
+// AttachmentType is the kind of document an attachment is, e.g. AttachmentTypeManual.
+type AttachmentType string
+
+const (
+	AttachmentTypeSpecSheet       AttachmentType = "spec_sheet"
+	AttachmentTypeManual          AttachmentType = "manual"
+	AttachmentTypeSafetyDataSheet AttachmentType = "safety_data_sheet"
+	AttachmentTypeCertificate     AttachmentType = "certificate"
+	AttachmentTypeWarranty        AttachmentType = "warranty"
+)
+
+// IsValid reports whether the attachment type is known.
+func (t AttachmentType) IsValid() bool {
+	switch t {
+	case AttachmentTypeSpecSheet, AttachmentTypeManual, AttachmentTypeSafetyDataSheet,
+		AttachmentTypeCertificate, AttachmentTypeWarranty:
+		return true
+	}
+	return false
+}
+
+// ProductAttachment is a support document of a product, e.g. its manual: the type of the
+// document, the URL it is served from and the locale it is written in.
+type ProductAttachment struct {
+	attachmentType AttachmentType
+	url            string
+	locale         string
+}
+
+// NewProductAttachment creates a new ProductAttachment. The URL is validated like that of
+// an image; the locale is a language tag such as "de" or "pt-BR", or empty for a document
+// that is not written in any one language, e.g. a drawing.
+func NewProductAttachment(attachmentType AttachmentType, rawURL, locale string) (*ProductAttachment, error) {
+	if !attachmentType.IsValid() {
+		return nil, ErrInvalidAttachmentType
+	}
+	u, err := ParseImageURL(rawURL)
+	if err != nil {
+		return nil, ErrInvalidAttachmentURL
+	}
+	if locale != "" {
+		if locale, err = ParseLocale(locale); err != nil {
+			return nil, err
+		}
+	}
+	return &ProductAttachment{attachmentType: attachmentType, url: u, locale: locale}, nil
+}
+
+// Type returns the type of the document.
+func (a *ProductAttachment) Type() AttachmentType { return a.attachmentType }
+
+// URL returns the URL of the document.
+func (a *ProductAttachment) URL() string { return a.url }
+
+// Locale returns the locale the document is written in; empty if it is not written in
+// any one language.
+func (a *ProductAttachment) Locale() string { return a.locale }
+
+// ProductAttachments is the aggregate root of the attachments of a product, in display
+// order. Like images, attachments are kept apart from the product so that loading a
+// product for a price change does not read them.
+type ProductAttachments struct {
+	productID   string
+	attachments []*ProductAttachment
+	events      []DomainEvent
+}
+
+// NewProductAttachments returns the attachments of a product that has none.
+func NewProductAttachments(productID string) *ProductAttachments {
+	return &ProductAttachments{productID: productID}
+}
+
+// ReconstructProductAttachments reconstructs the attachments of a product from
+// persistence, in display order.
+func ReconstructProductAttachments(productID string, attachments []*ProductAttachment) *ProductAttachments {
+	return &ProductAttachments{productID: productID, attachments: attachments}
+}
+
+// ProductID returns the product the attachments are of.
+func (p *ProductAttachments) ProductID() string { return p.productID }
+
+// Attachments returns the attachments in display order.
+func (p *ProductAttachments) Attachments() []*ProductAttachment {
+	return append([]*ProductAttachment(nil), p.attachments...)
+}
+
+// DomainEvents returns the events raised since the attachments were loaded.
+func (p *ProductAttachments) DomainEvents() []DomainEvent { return p.events }
+
+// Set replaces the attachments of the product with attachments, in display order; no
+// attachments removes them all. URLs must be distinct. Setting the current attachments
+// again is a no-op and raises no event.
+func (p *ProductAttachments) Set(attachments []*ProductAttachment, now time.Time) error {
+	if len(attachments) > MaxProductAttachments {
+		return ErrTooManyAttachments
+	}
+	seen := make(map[string]bool, len(attachments))
+	for _, attachment := range attachments {
+		if seen[attachment.url] {
+			return ErrDuplicateAttachment
+		}
+		seen[attachment.url] = true
+	}
+
+	if sameAttachments(p.attachments, attachments) {
+		return nil
+	}
+	p.attachments = append([]*ProductAttachment(nil), attachments...)
+	p.events = append(p.events, NewProductAttachmentsChangedEvent(p.productID, p.Attachments(), now))
+	return nil
+}
+
+func sameAttachments(a, b []*ProductAttachment) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if *a[i] != *b[i] {
+			return false
+		}
+	}
+	return true
+}
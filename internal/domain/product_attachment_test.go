@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustAttachment(t *testing.T, attachmentType AttachmentType, url, locale string) *ProductAttachment {
+	t.Helper()
+	attachment, err := NewProductAttachment(attachmentType, url, locale)
+	require.NoError(t, err)
+	return attachment
+}
+
+func TestNewProductAttachment(t *testing.T) {
+	attachment, err := NewProductAttachment(AttachmentTypeManual, " https://docs.example.com/widget.pdf ", "pt_br")
+	require.NoError(t, err)
+	assert.Equal(t, AttachmentTypeManual, attachment.Type())
+	assert.Equal(t, "https://docs.example.com/widget.pdf", attachment.URL())
+	assert.Equal(t, "pt-BR", attachment.Locale())
+
+	attachment, err = NewProductAttachment(AttachmentTypeSpecSheet, "https://docs.example.com/drawing.pdf", "")
+	require.NoError(t, err)
+	assert.Empty(t, attachment.Locale(), "a document need not be in any one language")
+
+	_, err = NewProductAttachment("brochure", "https://docs.example.com/widget.pdf", "")
+	assert.ErrorIs(t, err, ErrInvalidAttachmentType)
+	_, err = NewProductAttachment(AttachmentTypeManual, "ftp://docs.example.com/widget.pdf", "")
+	assert.ErrorIs(t, err, ErrInvalidAttachmentURL)
+	_, err = NewProductAttachment(AttachmentTypeManual, "https://docs.example.com/widget.pdf", "english")
+	assert.ErrorIs(t, err, ErrInvalidLocale)
+}
+
+func TestProductAttachments_Set(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	manual := mustAttachment(t, AttachmentTypeManual, "https://docs.example.com/manual.pdf", "en")
+	sheet := mustAttachment(t, AttachmentTypeSpecSheet, "https://docs.example.com/sheet.pdf", "")
+
+	attachments := NewProductAttachments("p1")
+	require.NoError(t, attachments.Set([]*ProductAttachment{manual, sheet}, now))
+	assert.Equal(t, []*ProductAttachment{manual, sheet}, attachments.Attachments())
+	require.Len(t, attachments.DomainEvents(), 1)
+	event, ok := attachments.DomainEvents()[0].(ProductAttachmentsChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "p1", event.AggregateID())
+	assert.Len(t, event.Attachments, 2)
+
+	// Setting the same attachments again is a no-op
+	require.NoError(t, attachments.Set([]*ProductAttachment{
+		mustAttachment(t, AttachmentTypeManual, "https://docs.example.com/manual.pdf", "en"), sheet,
+	}, now))
+	assert.Len(t, attachments.DomainEvents(), 1)
+
+	require.NoError(t, attachments.Set([]*ProductAttachment{sheet, manual}, now))
+	assert.Len(t, attachments.DomainEvents(), 2, "reordering changes the attachments")
+
+	require.NoError(t, attachments.Set(nil, now))
+	assert.Empty(t, attachments.Attachments())
+	assert.Len(t, attachments.DomainEvents(), 3)
+
+	duplicate := mustAttachment(t, AttachmentTypeCertificate, "https://docs.example.com/manual.pdf", "")
+	assert.ErrorIs(t, attachments.Set([]*ProductAttachment{manual, duplicate}, now), ErrDuplicateAttachment)
+	tooMany := make([]*ProductAttachment, MaxProductAttachments+1)
+	for i := range tooMany {
+		tooMany[i] = manual
+	}
+	assert.ErrorIs(t, attachments.Set(tooMany, now), ErrTooManyAttachments)
+	assert.Len(t, attachments.DomainEvents(), 3)
+}
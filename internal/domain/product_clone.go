@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// CloneNameSuffix is appended to the name of a product to name its clone.
+const CloneNameSuffix = " (copy)"
+
+// MaxProductNameLength is the maximum length, in characters, of the name of a product the
+// products table stores.
+const MaxProductNameLength = 255
+
+// Clone creates a new draft product with the given ID from the name, description,
+// category, base price, attributes, weight, dimensions, kind and compliance flags of the
+// product, named with CloneNameSuffix, after cutting the name short if the clone's would
+// be longer than MaxProductNameLength, and owned by the same tenant. The clone raises its
+// own creation event; its attributes, weight, dimensions, kind and compliance flags are
+// part of the created product and raise no event. Nothing else is copied: the clone has
+// no slug, identifiers, discounts or other prices, and the product itself is left
+// unchanged.
+func (p *Product) Clone(id string, now time.Time) (*Product, error) {
+	clone, err := NewProduct(id, cloneName(p.name), p.description, p.category, p.basePrice, now)
+	if err != nil {
+		return nil, err
+	}
+	clone.tenantID = p.tenantID
+	if len(p.attributes) > 0 {
+		clone.attributes = p.Attributes()
+		clone.changes.MarkDirty(FieldAttributes)
+	}
+	if p.weight != nil || p.dimensions != nil {
+		clone.AssignDimensions(p.weight, p.dimensions)
+	}
+	if p.kind != "" {
+		clone.kind = p.kind
+		clone.changes.MarkDirty(FieldKind)
+	}
+	if p.compliance != nil {
+		clone.compliance = p.compliance
+		clone.changes.MarkDirty(FieldCompliance)
+	}
+	return clone, nil
+}
+
+// cloneName returns the name of a clone of a product named name.
+func cloneName(name string) string {
+	keep := MaxProductNameLength - utf8.RuneCountInString(CloneNameSuffix)
+	if utf8.RuneCountInString(name) > keep {
+		name = strings.TrimSpace(string([]rune(name)[:keep]))
+	}
+	return name + CloneNameSuffix
+}
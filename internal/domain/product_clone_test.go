@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_Clone(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	price, err := NewMoneyInCurrency(4500, 100, "EUR")
+	require.NoError(t, err)
+	product, err := NewProduct("product-1", "Oak Chair", "A chair", "Furniture", price, now)
+	require.NoError(t, err)
+	require.NoError(t, product.AssignSlug("oak-chair"))
+	require.NoError(t, product.AssignIdentifiers("CHAIR-OAK", ""))
+	require.NoError(t, product.SetAttribute("material", "oak", now))
+	require.NoError(t, product.AddTag("sale", now))
+	compliance, err := NewCompliance(0, false, []Market{MarketUK})
+	require.NoError(t, err)
+	require.NoError(t, product.ChangeCompliance(compliance, now))
+	require.NoError(t, product.Activate(now))
+	product.ClearEvents()
+
+	later := now.Add(time.Hour)
+	clone, err := product.Clone("product-2", later)
+	require.NoError(t, err)
+	assert.Equal(t, "product-2", clone.ID())
+	assert.Equal(t, "Oak Chair (copy)", clone.Name())
+	assert.Equal(t, "A chair", clone.Description())
+	assert.Equal(t, "Furniture", clone.Category())
+	assert.True(t, price.Equals(clone.BasePrice()))
+	assert.Equal(t, "EUR", clone.Currency())
+	assert.Equal(t, map[string]string{"material": "oak"}, clone.Attributes())
+	assert.True(t, clone.Changes().Dirty(FieldAttributes))
+	assert.Equal(t, compliance, clone.Compliance())
+	assert.True(t, clone.Changes().Dirty(FieldCompliance))
+	assert.Equal(t, ProductStatusDraft, clone.Status())
+	assert.Equal(t, later, clone.CreatedAt())
+	assert.Empty(t, clone.Slug())
+	assert.Empty(t, clone.SKU())
+	assert.Empty(t, clone.Tags())
+
+	require.Len(t, clone.DomainEvents(), 1)
+	event := clone.DomainEvents()[0].(ProductCreatedEvent)
+	assert.Equal(t, "product-2", event.AggregateID())
+	assert.Equal(t, "Oak Chair (copy)", event.Name)
+
+	// The clone has its own attributes, and the product is unchanged
+	require.NoError(t, clone.SetAttribute("material", "beech", later))
+	assert.Equal(t, "oak", product.Attributes()["material"])
+	assert.Empty(t, product.DomainEvents())
+}
+
+func TestProduct_Clone_LongName(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	prefix := strings.Repeat("é", MaxProductNameLength-12)
+	product, err := NewProduct("product-1", prefix+" Oak Chair", "", "Furniture", NewMoney(4500, 100), now)
+	require.NoError(t, err)
+
+	// The name is cut short at a character, and the space it is cut at is trimmed
+	clone, err := product.Clone("product-2", now)
+	require.NoError(t, err)
+	assert.Equal(t, prefix+" Oak"+CloneNameSuffix, clone.Name())
+	assert.LessOrEqual(t, utf8.RuneCountInString(clone.Name()), MaxProductNameLength)
+}
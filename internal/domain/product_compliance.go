@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxMinimumAge is the highest minimum age a product can be restricted to.
+const MaxMinimumAge = 99
+
+// Compliance holds the regulatory flags of a product: the minimum age of its buyers,
+// whether it is hazardous to ship, and the markets it must not be sold in.
+type Compliance struct {
+	// minimumAge is 0 if the product is not age-restricted.
+	minimumAge int
+	hazardous  bool
+	// restrictedMarkets are kept sorted.
+	restrictedMarkets []Market
+}
+
+// NewCompliance creates the compliance flags of a product. The minimum age is 0 for no
+// restriction or up to MaxMinimumAge, and each restricted market must be known; repeated
+// markets are kept once. It returns nil if no flag is set, as a product without
+// restrictions has no compliance flags.
+func NewCompliance(minimumAge int, hazardous bool, restrictedMarkets []Market) (*Compliance, error) {
+	if minimumAge < 0 || minimumAge > MaxMinimumAge {
+		return nil, ErrInvalidCompliance
+	}
+	var markets []Market
+	seen := make(map[Market]bool, len(restrictedMarkets))
+	for _, market := range restrictedMarkets {
+		if _, ok := marketCurrencies[market]; !ok {
+			return nil, ErrInvalidCompliance
+		}
+		if !seen[market] {
+			seen[market] = true
+			markets = append(markets, market)
+		}
+	}
+	if minimumAge == 0 && !hazardous && len(markets) == 0 {
+		return nil, nil
+	}
+	sort.Slice(markets, func(i, j int) bool { return markets[i] < markets[j] })
+	return &Compliance{minimumAge: minimumAge, hazardous: hazardous, restrictedMarkets: markets}, nil
+}
+
+// MinimumAge returns the minimum age of the buyers of the product, or 0 if it is not
+// age-restricted.
+func (c *Compliance) MinimumAge() int {
+	if c == nil {
+		return 0
+	}
+	return c.minimumAge
+}
+
+// Hazardous reports whether the product is hazardous to ship.
+func (c *Compliance) Hazardous() bool {
+	return c != nil && c.hazardous
+}
+
+// RestrictedMarkets returns the markets the product must not be sold in, in code order.
+func (c *Compliance) RestrictedMarkets() []Market {
+	if c == nil {
+		return nil
+	}
+	return append([]Market(nil), c.restrictedMarkets...)
+}
+
+// RestrictedIn reports whether the product must not be sold in a market.
+func (c *Compliance) RestrictedIn(market Market) bool {
+	for _, m := range c.RestrictedMarkets() {
+		if m == market {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal reports whether two compliance flags are both unset or the same.
+func (c *Compliance) Equal(other *Compliance) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if c.minimumAge != other.minimumAge || c.hazardous != other.hazardous ||
+		len(c.restrictedMarkets) != len(other.restrictedMarkets) {
+		return false
+	}
+	for i := range c.restrictedMarkets {
+		if c.restrictedMarkets[i] != other.restrictedMarkets[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// CompliancePolicy holds the deployment-wide rules the compliance flags of a product are
+// checked against when it is activated: the categories age-restricted products may be
+// sold in.
+type CompliancePolicy struct {
+	ageRestrictedCategories map[string]bool
+}
+
+// ParseCompliancePolicy parses the comma-separated categories age-restricted products may
+// be sold in, e.g. "Wine,Spirits". An empty string allows none, so no age-restricted
+// product can be activated.
+func ParseCompliancePolicy(ageRestrictedCategories string) (*CompliancePolicy, error) {
+	policy := &CompliancePolicy{ageRestrictedCategories: make(map[string]bool)}
+	if strings.TrimSpace(ageRestrictedCategories) == "" {
+		return policy, nil
+	}
+	for _, category := range strings.Split(ageRestrictedCategories, ",") {
+		category = strings.TrimSpace(category)
+		if category == "" {
+			return nil, ErrInvalidCompliancePolicy
+		}
+		policy.ageRestrictedCategories[category] = true
+	}
+	return policy, nil
+}
+
+// AllowsAgeRestricted reports whether age-restricted products may be sold in a category.
+// A nil policy allows none.
+func (cp *CompliancePolicy) AllowsAgeRestricted(category string) bool {
+	return cp != nil && cp.ageRestrictedCategories[category]
+}
+
+// Compliance returns the compliance flags of the product, or nil if it has none.
+func (p *Product) Compliance() *Compliance {
+	return p.compliance
+}
+
+// ChangeCompliance replaces the compliance flags of a product that is not archived; nil
+// clears them. The flags are checked against the rest of the product when it is
+// activated, see CheckCompliance. Setting the current flags is a no-op and raises no
+// event.
+func (p *Product) ChangeCompliance(compliance *Compliance, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if compliance.Equal(p.compliance) {
+		return nil
+	}
+
+	p.compliance = compliance
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldCompliance)
+	p.events = append(p.events, NewProductComplianceChangedEvent(p.id, compliance, now))
+	return nil
+}
+
+// CheckCompliance checks that the product may go on sale with its compliance flags: an
+// age-restricted product must be in a category the policy allows them in, a hazardous
+// product must have the weight and dimensions carriers classify its shipments by, and a
+// product must not have a price in a market it is restricted in.
+func (p *Product) CheckCompliance(policy *CompliancePolicy) error {
+	if p.compliance == nil {
+		return nil
+	}
+	if p.compliance.minimumAge > 0 && !policy.AllowsAgeRestricted(p.category) {
+		return ErrAgeRestrictedCategory
+	}
+	if p.compliance.hazardous && (p.weight == nil || p.dimensions == nil) {
+		return ErrHazardousMeasuresRequired
+	}
+	for _, mp := range p.marketPrices {
+		if p.compliance.RestrictedIn(mp.Market()) {
+			return ErrRestrictedMarketPrice
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCompliance(t *testing.T) {
+	compliance, err := NewCompliance(18, true, []Market{MarketUS, MarketEU, MarketUS})
+	require.NoError(t, err)
+	assert.Equal(t, 18, compliance.MinimumAge())
+	assert.True(t, compliance.Hazardous())
+	assert.Equal(t, []Market{MarketEU, MarketUS}, compliance.RestrictedMarkets(), "markets are sorted and kept once")
+	assert.True(t, compliance.RestrictedIn(MarketEU))
+	assert.False(t, compliance.RestrictedIn(MarketUK))
+
+	none, err := NewCompliance(0, false, nil)
+	require.NoError(t, err)
+	assert.Nil(t, none, "a product without restrictions has no compliance flags")
+	assert.Zero(t, none.MinimumAge())
+	assert.False(t, none.Hazardous())
+
+	_, err = NewCompliance(-1, false, nil)
+	assert.ErrorIs(t, err, ErrInvalidCompliance)
+	_, err = NewCompliance(MaxMinimumAge+1, false, nil)
+	assert.ErrorIs(t, err, ErrInvalidCompliance)
+	_, err = NewCompliance(0, false, []Market{"FR"})
+	assert.ErrorIs(t, err, ErrInvalidCompliance)
+}
+
+func TestParseCompliancePolicy(t *testing.T) {
+	policy, err := ParseCompliancePolicy(" Wine, Spirits ")
+	require.NoError(t, err)
+	assert.True(t, policy.AllowsAgeRestricted("Wine"))
+	assert.True(t, policy.AllowsAgeRestricted("Spirits"))
+	assert.False(t, policy.AllowsAgeRestricted("Toys"))
+
+	policy, err = ParseCompliancePolicy("")
+	require.NoError(t, err)
+	assert.False(t, policy.AllowsAgeRestricted("Wine"), "an empty policy allows no category")
+	assert.False(t, (*CompliancePolicy)(nil).AllowsAgeRestricted("Wine"))
+
+	_, err = ParseCompliancePolicy("Wine,,Spirits")
+	assert.ErrorIs(t, err, ErrInvalidCompliancePolicy)
+}
+
+func TestProduct_ChangeCompliance(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("product-1", "Red Wine", "", "Wine", NewMoney(1999, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	compliance, err := NewCompliance(18, false, []Market{MarketUK})
+	require.NoError(t, err)
+	require.NoError(t, product.ChangeCompliance(compliance, now))
+	assert.Equal(t, compliance, product.Compliance())
+	assert.True(t, product.Changes().Dirty(FieldCompliance))
+	require.Len(t, product.DomainEvents(), 1)
+	assert.Equal(t, compliance, product.DomainEvents()[0].(ProductComplianceChangedEvent).Compliance)
+	product.ClearEvents()
+
+	same, err := NewCompliance(18, false, []Market{MarketUK})
+	require.NoError(t, err)
+	require.NoError(t, product.ChangeCompliance(same, now))
+	assert.Empty(t, product.DomainEvents(), "setting the current flags is a no-op")
+
+	require.NoError(t, product.ChangeCompliance(nil, now))
+	assert.Nil(t, product.Compliance())
+	require.Len(t, product.DomainEvents(), 1)
+	assert.Nil(t, product.DomainEvents()[0].(ProductComplianceChangedEvent).Compliance)
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.ChangeCompliance(compliance, now), ErrProductArchived)
+}
+
+func TestProduct_CheckCompliance(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	policy, err := ParseCompliancePolicy("Wine")
+	require.NoError(t, err)
+	weight, err := NewWeight(1.2, WeightUnitKilogram)
+	require.NoError(t, err)
+	dimensions, err := NewDimensions(30, 10, 10, LengthUnitCentimetre)
+	require.NoError(t, err)
+	gbp, err := NewMoneyInCurrency(1599, 100, "GBP")
+	require.NoError(t, err)
+	ukPrice, err := NewMarketPrice(MarketUK, gbp)
+	require.NoError(t, err)
+
+	newProduct := func(category string) *Product {
+		product, err := NewProduct("product-1", "Product", "", category, NewMoney(1999, 100), now)
+		require.NoError(t, err)
+		return product
+	}
+	newCompliance := func(minimumAge int, hazardous bool, markets ...Market) *Compliance {
+		compliance, err := NewCompliance(minimumAge, hazardous, markets)
+		require.NoError(t, err)
+		return compliance
+	}
+
+	product := newProduct("Toys")
+	assert.NoError(t, product.CheckCompliance(nil), "a product without flags passes")
+
+	require.NoError(t, product.ChangeCompliance(newCompliance(18, false), now))
+	assert.ErrorIs(t, product.CheckCompliance(policy), ErrAgeRestrictedCategory)
+	product = newProduct("Wine")
+	require.NoError(t, product.ChangeCompliance(newCompliance(18, false), now))
+	assert.NoError(t, product.CheckCompliance(policy))
+	assert.ErrorIs(t, product.CheckCompliance(nil), ErrAgeRestrictedCategory)
+
+	require.NoError(t, product.ChangeCompliance(newCompliance(0, true), now))
+	assert.ErrorIs(t, product.CheckCompliance(policy), ErrHazardousMeasuresRequired)
+	require.NoError(t, product.ChangeDimensions(weight, dimensions, now))
+	assert.NoError(t, product.CheckCompliance(policy))
+
+	require.NoError(t, product.SetMarketPrices([]*MarketPrice{ukPrice}, now))
+	require.NoError(t, product.ChangeCompliance(newCompliance(0, false, MarketUS), now))
+	assert.NoError(t, product.CheckCompliance(policy))
+	require.NoError(t, product.ChangeCompliance(newCompliance(0, false, MarketUK), now))
+	assert.ErrorIs(t, product.CheckCompliance(policy), ErrRestrictedMarketPrice)
+}
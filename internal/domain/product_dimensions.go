@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// WeightUnit is a unit in which the weight of a product is given.
+type WeightUnit string
+
+const (
+	WeightUnitGram     WeightUnit = "g"
+	WeightUnitKilogram WeightUnit = "kg"
+	WeightUnitPound    WeightUnit = "lb"
+	WeightUnitOunce    WeightUnit = "oz"
+)
+
+// gramsPerWeightUnit converts weight units to grams.
+var gramsPerWeightUnit = map[WeightUnit]float64{
+	WeightUnitGram:     1,
+	WeightUnitKilogram: 1000,
+	WeightUnitPound:    453.59237,
+	WeightUnitOunce:    28.349523125,
+}
+
+// IsValid reports whether the unit is a known weight unit.
+func (u WeightUnit) IsValid() bool {
+	_, ok := gramsPerWeightUnit[u]
+	return ok
+}
+
+// LengthUnit is a unit in which the dimensions of a product are given.
+type LengthUnit string
+
+const (
+	LengthUnitMillimetre LengthUnit = "mm"
+	LengthUnitCentimetre LengthUnit = "cm"
+	LengthUnitMetre      LengthUnit = "m"
+	LengthUnitInch       LengthUnit = "in"
+)
+
+// millimetresPerLengthUnit converts length units to millimetres.
+var millimetresPerLengthUnit = map[LengthUnit]float64{
+	LengthUnitMillimetre: 1,
+	LengthUnitCentimetre: 10,
+	LengthUnitMetre:      1000,
+	LengthUnitInch:       25.4,
+}
+
+// IsValid reports whether the unit is a known length unit.
+func (u LengthUnit) IsValid() bool {
+	_, ok := millimetresPerLengthUnit[u]
+	return ok
+}
+
+// Weight is the shipping weight of a product, kept in the unit it was given in.
+type Weight struct {
+	value float64
+	unit  WeightUnit
+}
+
+// NewWeight creates a weight of a positive value in a known unit.
+func NewWeight(value float64, unit WeightUnit) (*Weight, error) {
+	if !unit.IsValid() || !positiveMeasure(value) {
+		return nil, ErrInvalidWeight
+	}
+	return &Weight{value: value, unit: unit}, nil
+}
+
+// Value returns the weight in its unit.
+func (w *Weight) Value() float64 {
+	return w.value
+}
+
+// Unit returns the unit the weight is given in.
+func (w *Weight) Unit() WeightUnit {
+	return w.unit
+}
+
+// Grams returns the weight in grams.
+func (w *Weight) Grams() float64 {
+	return w.value * gramsPerWeightUnit[w.unit]
+}
+
+// In returns the weight in the unit, which must be valid.
+func (w *Weight) In(unit WeightUnit) float64 {
+	return w.Grams() / gramsPerWeightUnit[unit]
+}
+
+// Equal reports whether both weights are nil, or have the same value in the same unit.
+func (w *Weight) Equal(other *Weight) bool {
+	if w == nil || other == nil {
+		return w == other
+	}
+	return *w == *other
+}
+
+// Dimensions are the height, width and depth of a packed product, kept in the unit they
+// were given in.
+type Dimensions struct {
+	height float64
+	width  float64
+	depth  float64
+	unit   LengthUnit
+}
+
+// NewDimensions creates dimensions of positive height, width and depth in a known unit.
+func NewDimensions(height, width, depth float64, unit LengthUnit) (*Dimensions, error) {
+	if !unit.IsValid() || !positiveMeasure(height) || !positiveMeasure(width) || !positiveMeasure(depth) {
+		return nil, ErrInvalidDimensions
+	}
+	return &Dimensions{height: height, width: width, depth: depth, unit: unit}, nil
+}
+
+// Height returns the height in the unit of the dimensions.
+func (d *Dimensions) Height() float64 {
+	return d.height
+}
+
+// Width returns the width in the unit of the dimensions.
+func (d *Dimensions) Width() float64 {
+	return d.width
+}
+
+// Depth returns the depth in the unit of the dimensions.
+func (d *Dimensions) Depth() float64 {
+	return d.depth
+}
+
+// Unit returns the unit the dimensions are given in.
+func (d *Dimensions) Unit() LengthUnit {
+	return d.unit
+}
+
+// In returns the height, width and depth in the unit, which must be valid.
+func (d *Dimensions) In(unit LengthUnit) (height, width, depth float64) {
+	factor := millimetresPerLengthUnit[d.unit] / millimetresPerLengthUnit[unit]
+	return d.height * factor, d.width * factor, d.depth * factor
+}
+
+// Equal reports whether both dimensions are nil, or have the same measures in the same
+// unit.
+func (d *Dimensions) Equal(other *Dimensions) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	return *d == *other
+}
+
+// positiveMeasure reports whether v is a positive, finite measure.
+func positiveMeasure(v float64) bool {
+	return v > 0 && !math.IsInf(v, 1)
+}
+
+// Weight returns the shipping weight of the product; nil if it has none.
+func (p *Product) Weight() *Weight {
+	return p.weight
+}
+
+// Dimensions returns the packed dimensions of the product; nil if it has none.
+func (p *Product) Dimensions() *Dimensions {
+	return p.dimensions
+}
+
+// AssignDimensions sets the weight and dimensions of a new product before it is first
+// saved; either may be nil for none. It raises no event: they are part of the created
+// product.
+func (p *Product) AssignDimensions(weight *Weight, dimensions *Dimensions) {
+	p.weight, p.dimensions = weight, dimensions
+	p.changes.MarkAllDirty(FieldWeight, FieldDimensions)
+}
+
+// ChangeDimensions replaces the weight and dimensions of a product that is not archived;
+// nil clears them. They must be allowed for the kind of the product: physical products
+// cannot clear them and digital products cannot set them. Setting the current weight
+// and dimensions is a no-op and raises no event.
+func (p *Product) ChangeDimensions(weight *Weight, dimensions *Dimensions, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if weight.Equal(p.weight) && dimensions.Equal(p.dimensions) {
+		return nil
+	}
+	if err := p.kind.checkMeasures(weight, dimensions); err != nil {
+		return err
+	}
+	p.setDimensions(weight, dimensions, now)
+	return nil
+}
+
+// setDimensions replaces the weight and dimensions of a product, at least one of which
+// differs from the current ones, and raises a dimensions changed event.
+func (p *Product) setDimensions(weight *Weight, dimensions *Dimensions, now time.Time) {
+	if !weight.Equal(p.weight) {
+		p.changes.MarkDirty(FieldWeight)
+	}
+	if !dimensions.Equal(p.dimensions) {
+		p.changes.MarkDirty(FieldDimensions)
+	}
+	p.weight, p.dimensions = weight, dimensions
+	p.updatedAt = now
+	p.events = append(p.events, NewProductDimensionsChangedEvent(p.id, weight, dimensions, now))
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWeight(t *testing.T) {
+	weight, err := NewWeight(2, WeightUnitPound)
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, weight.Value())
+	assert.Equal(t, WeightUnitPound, weight.Unit())
+	assert.InDelta(t, 907.18474, weight.Grams(), 1e-9)
+	assert.InDelta(t, 32, weight.In(WeightUnitOunce), 1e-9)
+
+	for _, tc := range []struct {
+		value float64
+		unit  WeightUnit
+	}{
+		{0, WeightUnitGram},
+		{-1, WeightUnitGram},
+		{math.NaN(), WeightUnitGram},
+		{math.Inf(1), WeightUnitGram},
+		{1, "stone"},
+		{1, ""},
+	} {
+		_, err := NewWeight(tc.value, tc.unit)
+		assert.ErrorIs(t, err, ErrInvalidWeight, "%v %q", tc.value, tc.unit)
+	}
+}
+
+func TestNewDimensions(t *testing.T) {
+	dimensions, err := NewDimensions(10, 20, 5, LengthUnitInch)
+	require.NoError(t, err)
+	height, width, depth := dimensions.In(LengthUnitMillimetre)
+	assert.InDelta(t, 254, height, 1e-9)
+	assert.InDelta(t, 508, width, 1e-9)
+	assert.InDelta(t, 127, depth, 1e-9)
+	height, _, _ = dimensions.In(LengthUnitMetre)
+	assert.InDelta(t, 0.254, height, 1e-9)
+
+	_, err = NewDimensions(10, 0, 5, LengthUnitCentimetre)
+	assert.ErrorIs(t, err, ErrInvalidDimensions)
+	_, err = NewDimensions(10, 20, 5, "ft")
+	assert.ErrorIs(t, err, ErrInvalidDimensions)
+}
+
+func TestProduct_ChangeDimensions(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1999, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	weight, err := NewWeight(1.5, WeightUnitKilogram)
+	require.NoError(t, err)
+	dimensions, err := NewDimensions(10, 20, 5, LengthUnitCentimetre)
+	require.NoError(t, err)
+
+	require.NoError(t, product.ChangeDimensions(weight, dimensions, now))
+	assert.True(t, product.Changes().Dirty(FieldWeight))
+	assert.True(t, product.Changes().Dirty(FieldDimensions))
+	require.Len(t, product.DomainEvents(), 1)
+	event := product.DomainEvents()[0].(ProductDimensionsChangedEvent)
+	assert.Equal(t, weight, event.Weight)
+	assert.Equal(t, dimensions, event.Dimensions)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	same, err := NewWeight(1.5, WeightUnitKilogram)
+	require.NoError(t, err)
+	require.NoError(t, product.ChangeDimensions(same, dimensions, now))
+	assert.Empty(t, product.DomainEvents(), "setting the current weight and dimensions is a no-op")
+
+	require.NoError(t, product.ChangeDimensions(nil, dimensions, now))
+	assert.Nil(t, product.Weight())
+	assert.True(t, product.Changes().Dirty(FieldWeight))
+	assert.False(t, product.Changes().Dirty(FieldDimensions))
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.ChangeDimensions(weight, nil, now), ErrProductArchived)
+}
+
+func TestProduct_CloneCopiesDimensions(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1999, 100), now)
+	require.NoError(t, err)
+	weight, err := NewWeight(250, WeightUnitGram)
+	require.NoError(t, err)
+	product.AssignDimensions(weight, nil)
+
+	clone, err := product.Clone("product-2", now)
+	require.NoError(t, err)
+	assert.Equal(t, weight, clone.Weight())
+	assert.Nil(t, clone.Dimensions())
+	assert.True(t, clone.Changes().Dirty(FieldWeight))
+}
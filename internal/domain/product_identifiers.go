@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// ParseGTIN validates a Global Trade Item Number, the number of a product barcode:
+// GTIN-8, GTIN-12 (UPC-A), GTIN-13 (EAN-13) or GTIN-14, whose last digit is the GS1
+// check digit of the others. The GTIN is kept as given, without padding.
+func ParseGTIN(gtin string) (string, error) {
+	gtin = strings.TrimSpace(gtin)
+	switch len(gtin) {
+	case 8, 12, 13, 14:
+	default:
+		return "", ErrInvalidGTIN
+	}
+
+	// From the right, the digits before the check digit are weighted 3, 1, 3, 1, ...
+	sum := 0
+	for i := len(gtin) - 1; i >= 0; i-- {
+		d := gtin[i]
+		if d < '0' || d > '9' {
+			return "", ErrInvalidGTIN
+		}
+		if i == len(gtin)-1 {
+			continue
+		}
+		weight := 1
+		if (len(gtin)-2-i)%2 == 0 {
+			weight = 3
+		}
+		sum += int(d-'0') * weight
+	}
+	if int(gtin[len(gtin)-1]-'0') != (10-sum%10)%10 {
+		return "", ErrInvalidGTIN
+	}
+	return gtin, nil
+}
+
+// ParseIdentifiers validates the SKU and GTIN of a product, either of which may be empty
+// for none.
+func ParseIdentifiers(sku, gtin string) (string, string, error) {
+	var err error
+	if strings.TrimSpace(sku) == "" {
+		sku = ""
+	} else if sku, err = ParseSKU(sku); err != nil {
+		return "", "", err
+	}
+	if strings.TrimSpace(gtin) == "" {
+		gtin = ""
+	} else if gtin, err = ParseGTIN(gtin); err != nil {
+		return "", "", err
+	}
+	return sku, gtin, nil
+}
+
+// SKU returns the stock keeping unit of the product, unique across the SKUs of products
+// and variants; empty if the product has none.
+func (p *Product) SKU() string {
+	return p.sku
+}
+
+// GTIN returns the barcode number of the product, unique across products; empty if the
+// product has none.
+func (p *Product) GTIN() string {
+	return p.gtin
+}
+
+// AssignIdentifiers sets the SKU and GTIN of a new product before it is first saved;
+// either may be empty for none. It raises no event: the identifiers are part of the
+// created product.
+func (p *Product) AssignIdentifiers(sku, gtin string) error {
+	sku, gtin, err := ParseIdentifiers(sku, gtin)
+	if err != nil {
+		return err
+	}
+	p.sku, p.gtin = sku, gtin
+	p.changes.MarkAllDirty(FieldSKU, FieldGTIN)
+	return nil
+}
+
+// ChangeIdentifiers replaces the SKU and GTIN of a product that is not archived; an empty
+// identifier clears it. Setting the current identifiers is a no-op and raises no event.
+func (p *Product) ChangeIdentifiers(sku, gtin string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	sku, gtin, err := ParseIdentifiers(sku, gtin)
+	if err != nil {
+		return err
+	}
+	if sku == p.sku && gtin == p.gtin {
+		return nil
+	}
+
+	if sku != p.sku {
+		p.changes.MarkDirty(FieldSKU)
+	}
+	if gtin != p.gtin {
+		p.changes.MarkDirty(FieldGTIN)
+	}
+	p.sku, p.gtin = sku, gtin
+	p.updatedAt = now
+	p.events = append(p.events, NewProductIdentifiersChangedEvent(p.id, sku, gtin, now))
+	return nil
+}
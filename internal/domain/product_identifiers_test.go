@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGTIN(t *testing.T) {
+	for _, valid := range []string{"96385074", "036000291452", "4006381333931", "00012345600012"} {
+		gtin, err := ParseGTIN(" " + valid + " ")
+		require.NoError(t, err, "GTIN %q", valid)
+		assert.Equal(t, valid, gtin)
+	}
+
+	for _, invalid := range []string{"", "4006381333932", "400638133393", "400638133393A", "12345", "000400638133393"} {
+		_, err := ParseGTIN(invalid)
+		assert.ErrorIs(t, err, ErrInvalidGTIN, "GTIN %q", invalid)
+	}
+}
+
+func TestParseIdentifiers(t *testing.T) {
+	sku, gtin, err := ParseIdentifiers("wdg-1", "")
+	require.NoError(t, err)
+	assert.Equal(t, "WDG-1", sku)
+	assert.Empty(t, gtin)
+
+	_, _, err = ParseIdentifiers("WDG/1", "")
+	assert.ErrorIs(t, err, ErrInvalidSKU)
+	_, _, err = ParseIdentifiers("", "4006381333932")
+	assert.ErrorIs(t, err, ErrInvalidGTIN)
+}
+
+func TestProduct_Identifiers(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.AssignIdentifiers("wdg-1", ""))
+	assert.Equal(t, "WDG-1", product.SKU())
+	assert.Empty(t, product.GTIN())
+	assert.True(t, product.Changes().Dirty(FieldSKU))
+	assert.Len(t, product.DomainEvents(), 1, "the identifiers are part of the created product")
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	later := now.Add(time.Hour)
+	require.NoError(t, product.ChangeIdentifiers("WDG-1", "4006381333931", later))
+	assert.Equal(t, "4006381333931", product.GTIN())
+	assert.Equal(t, later, product.UpdatedAt())
+	assert.False(t, product.Changes().Dirty(FieldSKU))
+	assert.True(t, product.Changes().Dirty(FieldGTIN))
+	require.Len(t, product.DomainEvents(), 1)
+	event := product.DomainEvents()[0].(ProductIdentifiersChangedEvent)
+	assert.Equal(t, "product.identifiers_changed", event.EventType())
+	assert.Equal(t, "WDG-1", event.SKU)
+	assert.Equal(t, "4006381333931", event.GTIN)
+	product.ClearEvents()
+
+	require.NoError(t, product.ChangeIdentifiers("wdg-1", "4006381333931", later))
+	assert.Empty(t, product.DomainEvents())
+
+	require.NoError(t, product.ChangeIdentifiers("", "4006381333931", later))
+	assert.Empty(t, product.SKU())
+	assert.ErrorIs(t, product.ChangeIdentifiers("WDG-1", "123", later), ErrInvalidGTIN)
+
+	require.NoError(t, product.Archive(later))
+	assert.ErrorIs(t, product.ChangeIdentifiers("WDG-2", "", later), ErrProductArchived)
+}
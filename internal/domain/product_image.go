@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxProductImages is the maximum number of images of a product.
+	MaxProductImages = 20
+	// MaxImageURLLength is the maximum length of the URL of an image.
+	MaxImageURLLength = 2048
+	// MaxImageAltTextLength is the maximum length of the alternative text of an image.
+	MaxImageAltTextLength = 250
+)
+
+// ParseImageURL validates the URL of an image: an absolute http or https URL with a host
+// and no spaces, at most MaxImageURLLength characters. Surrounding spaces are trimmed.
+func ParseImageURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || len(raw) > MaxImageURLLength || strings.ContainsAny(raw, " \t\r\n") {
+		return "", ErrInvalidImageURL
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", ErrInvalidImageURL
+	}
+	return raw, nil
+}
+
+// ProductImage is an image of a product: the URL it is served from and the alternative
+// text shown in its place. The primary image is the one shown in listings.
+type ProductImage struct {
+	url     string
+	altText string
+	primary bool
+}
+
+// NewProductImage creates a new ProductImage, validating its URL and alternative text.
+func NewProductImage(rawURL, altText string, primary bool) (*ProductImage, error) {
+	u, err := ParseImageURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	altText = strings.TrimSpace(altText)
+	if len(altText) > MaxImageAltTextLength {
+		return nil, ErrInvalidImageAltText
+	}
+	return &ProductImage{url: u, altText: altText, primary: primary}, nil
+}
+
+// URL returns the URL of the image.
+func (i *ProductImage) URL() string { return i.url }
+
+// AltText returns the alternative text of the image; empty if it has none.
+func (i *ProductImage) AltText() string { return i.altText }
+
+// Primary reports whether the image is the primary image of its product.
+func (i *ProductImage) Primary() bool { return i.primary }
+
+// ProductImages is the aggregate root of the images of a product, in display order. A
+// product with images has exactly one primary image. Images are kept apart from the
+// product so that loading a product for a price change does not read its gallery.
+type ProductImages struct {
+	productID string
+	images    []*ProductImage
+	events    []DomainEvent
+}
+
+// NewProductImages returns the images of a product that has none.
+func NewProductImages(productID string) *ProductImages {
+	return &ProductImages{productID: productID}
+}
+
+// ReconstructProductImages reconstructs the images of a product from persistence, in
+// display order.
+func ReconstructProductImages(productID string, images []*ProductImage) *ProductImages {
+	return &ProductImages{productID: productID, images: images}
+}
+
+// ProductID returns the product the images are of.
+func (p *ProductImages) ProductID() string { return p.productID }
+
+// Images returns the images in display order.
+func (p *ProductImages) Images() []*ProductImage {
+	return append([]*ProductImage(nil), p.images...)
+}
+
+// Primary returns the primary image, or nil if the product has no images.
+func (p *ProductImages) Primary() *ProductImage {
+	for _, image := range p.images {
+		if image.primary {
+			return image
+		}
+	}
+	return nil
+}
+
+// DomainEvents returns the events raised since the images were loaded.
+func (p *ProductImages) DomainEvents() []DomainEvent { return p.events }
+
+// Set replaces the images of the product with images, in display order; no images
+// removes them all. URLs must be distinct and at most one image may be primary; if none
+// is, the first one becomes primary. Setting the current images again is a no-op and
+// raises no event.
+func (p *ProductImages) Set(images []*ProductImage, now time.Time) error {
+	if len(images) > MaxProductImages {
+		return ErrTooManyImages
+	}
+
+	seen := make(map[string]bool, len(images))
+	primaries := 0
+	for _, image := range images {
+		if seen[image.url] {
+			return ErrDuplicateImage
+		}
+		seen[image.url] = true
+		if image.primary {
+			primaries++
+		}
+	}
+	if primaries > 1 {
+		return ErrMultiplePrimaryImages
+	}
+
+	set := append([]*ProductImage(nil), images...)
+	if primaries == 0 && len(set) > 0 {
+		first := *set[0]
+		first.primary = true
+		set[0] = &first
+	}
+	if sameImages(p.images, set) {
+		return nil
+	}
+	p.change(set, now)
+	return nil
+}
+
+// Reorder changes the display order of the images to that of urls, which must list the
+// URL of every image exactly once. The primary image stays primary. Reordering to the
+// current order is a no-op and raises no event.
+func (p *ProductImages) Reorder(urls []string, now time.Time) error {
+	if len(urls) != len(p.images) {
+		return ErrInvalidImageOrder
+	}
+
+	byURL := make(map[string]*ProductImage, len(p.images))
+	for _, image := range p.images {
+		byURL[image.url] = image
+	}
+	ordered := make([]*ProductImage, 0, len(urls))
+	for _, raw := range urls {
+		u, err := ParseImageURL(raw)
+		if err != nil {
+			return err
+		}
+		image, ok := byURL[u]
+		if !ok {
+			return ErrInvalidImageOrder
+		}
+		delete(byURL, u)
+		ordered = append(ordered, image)
+	}
+
+	if sameImages(p.images, ordered) {
+		return nil
+	}
+	p.change(ordered, now)
+	return nil
+}
+
+func (p *ProductImages) change(images []*ProductImage, now time.Time) {
+	p.images = images
+	p.events = append(p.events, NewProductImagesChangedEvent(p.productID, p.Images(), now))
+}
+
+func sameImages(a, b []*ProductImage) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if *a[i] != *b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustImage(t *testing.T, url string, primary bool) *ProductImage {
+	t.Helper()
+	image, err := NewProductImage(url, "", primary)
+	require.NoError(t, err)
+	return image
+}
+
+func imageURLs(images []*ProductImage) []string {
+	urls := make([]string, len(images))
+	for i, image := range images {
+		urls[i] = image.URL()
+	}
+	return urls
+}
+
+func TestNewProductImage(t *testing.T) {
+	image, err := NewProductImage(" https://cdn.example.com/widget.jpg ", " A widget ", true)
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/widget.jpg", image.URL())
+	assert.Equal(t, "A widget", image.AltText())
+	assert.True(t, image.Primary())
+
+	for _, invalid := range []string{
+		"",
+		"/widget.jpg",
+		"ftp://cdn.example.com/widget.jpg",
+		"https:///widget.jpg",
+		"https://cdn.example.com/my widget.jpg",
+		"https://cdn.example.com/" + strings.Repeat("a", MaxImageURLLength),
+	} {
+		_, err := NewProductImage(invalid, "", false)
+		assert.ErrorIs(t, err, ErrInvalidImageURL, "url %q", invalid)
+	}
+
+	_, err = NewProductImage("https://cdn.example.com/widget.jpg", strings.Repeat("a", MaxImageAltTextLength+1), false)
+	assert.ErrorIs(t, err, ErrInvalidImageAltText)
+}
+
+func TestProductImages_Set(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	front := mustImage(t, "https://cdn.example.com/front.jpg", false)
+	back := mustImage(t, "https://cdn.example.com/back.jpg", false)
+
+	images := NewProductImages("p1")
+	assert.Nil(t, images.Primary())
+
+	// Without a primary image, the first one becomes primary
+	require.NoError(t, images.Set([]*ProductImage{front, back}, now))
+	assert.Equal(t, []string{"https://cdn.example.com/front.jpg", "https://cdn.example.com/back.jpg"}, imageURLs(images.Images()))
+	assert.Equal(t, "https://cdn.example.com/front.jpg", images.Primary().URL())
+	assert.False(t, front.Primary(), "the given image is not changed")
+	require.Len(t, images.DomainEvents(), 1)
+	event, ok := images.DomainEvents()[0].(ProductImagesChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "p1", event.AggregateID())
+	assert.Len(t, event.Images, 2)
+
+	// Setting the same images again is a no-op
+	require.NoError(t, images.Set([]*ProductImage{mustImage(t, "https://cdn.example.com/front.jpg", true), back}, now))
+	assert.Len(t, images.DomainEvents(), 1)
+
+	require.NoError(t, images.Set([]*ProductImage{front, mustImage(t, "https://cdn.example.com/back.jpg", true)}, now))
+	assert.Equal(t, "https://cdn.example.com/back.jpg", images.Primary().URL())
+	assert.Len(t, images.DomainEvents(), 2)
+
+	require.NoError(t, images.Set(nil, now))
+	assert.Empty(t, images.Images())
+	assert.Len(t, images.DomainEvents(), 3)
+
+	assert.ErrorIs(t, images.Set([]*ProductImage{front, front}, now), ErrDuplicateImage)
+	assert.ErrorIs(t, images.Set([]*ProductImage{mustImage(t, "https://cdn.example.com/front.jpg", true),
+		mustImage(t, "https://cdn.example.com/back.jpg", true)}, now), ErrMultiplePrimaryImages)
+
+	tooMany := make([]*ProductImage, MaxProductImages+1)
+	for i := range tooMany {
+		tooMany[i] = mustImage(t, fmt.Sprintf("https://cdn.example.com/%d.jpg", i), false)
+	}
+	assert.ErrorIs(t, images.Set(tooMany, now), ErrTooManyImages)
+	assert.Len(t, images.DomainEvents(), 3)
+}
+
+func TestProductImages_Reorder(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	images := ReconstructProductImages("p1", []*ProductImage{
+		mustImage(t, "https://cdn.example.com/front.jpg", true),
+		mustImage(t, "https://cdn.example.com/back.jpg", false),
+		mustImage(t, "https://cdn.example.com/side.jpg", false),
+	})
+
+	require.NoError(t, images.Reorder([]string{"https://cdn.example.com/front.jpg", "https://cdn.example.com/back.jpg", "https://cdn.example.com/side.jpg"}, now))
+	assert.Empty(t, images.DomainEvents())
+
+	require.NoError(t, images.Reorder([]string{"https://cdn.example.com/side.jpg", "https://cdn.example.com/front.jpg", "https://cdn.example.com/back.jpg"}, now))
+	assert.Equal(t, []string{"https://cdn.example.com/side.jpg", "https://cdn.example.com/front.jpg", "https://cdn.example.com/back.jpg"}, imageURLs(images.Images()))
+	assert.Equal(t, "https://cdn.example.com/front.jpg", images.Primary().URL())
+	require.Len(t, images.DomainEvents(), 1)
+
+	for name, urls := range map[string][]string{
+		"missing":   {"https://cdn.example.com/side.jpg", "https://cdn.example.com/front.jpg"},
+		"unknown":   {"https://cdn.example.com/side.jpg", "https://cdn.example.com/front.jpg", "https://cdn.example.com/top.jpg"},
+		"duplicate": {"https://cdn.example.com/side.jpg", "https://cdn.example.com/side.jpg", "https://cdn.example.com/back.jpg"},
+	} {
+		assert.ErrorIs(t, images.Reorder(urls, now), ErrInvalidImageOrder, name)
+	}
+	assert.Len(t, images.DomainEvents(), 1)
+}
@@ -0,0 +1,95 @@
+package domain
+
+import "time"
+
+// ProductKind is whether a product is shipped or delivered digitally. The kind decides
+// which shipping and stock rules apply to the product.
+type ProductKind string
+
+const (
+	// ProductKindPhysical products are shipped: they must have a weight and dimensions,
+	// and their stock may be tracked.
+	ProductKindPhysical ProductKind = "physical"
+	// ProductKindDigital products are delivered digitally: they have no weight,
+	// dimensions or stock, and are always in stock.
+	ProductKindDigital ProductKind = "digital"
+)
+
+// IsValid reports whether the kind is a known product kind.
+func (k ProductKind) IsValid() bool {
+	return k == ProductKindPhysical || k == ProductKindDigital
+}
+
+// HasStock reports whether the stock of products of the kind may be tracked; it may be
+// for physical products and those whose kind is unset.
+func (k ProductKind) HasStock() bool {
+	return k != ProductKindDigital
+}
+
+// checkMeasures checks that a weight and dimensions are allowed for products of the
+// kind: physical products must have both and digital products neither. Products whose
+// kind is unset may have either.
+func (k ProductKind) checkMeasures(weight *Weight, dimensions *Dimensions) error {
+	switch k {
+	case ProductKindPhysical:
+		if weight == nil || dimensions == nil {
+			return ErrMeasuresRequired
+		}
+	case ProductKindDigital:
+		if weight != nil || dimensions != nil {
+			return ErrMeasuresNotApplicable
+		}
+	}
+	return nil
+}
+
+// Kind returns whether the product is shipped or delivered digitally; empty if unset.
+func (p *Product) Kind() ProductKind {
+	return p.kind
+}
+
+// AssignKind sets the kind of a new product before it is first saved, after its weight
+// and dimensions; empty leaves it unset. It raises no event: the kind is part of the
+// created product.
+func (p *Product) AssignKind(kind ProductKind) error {
+	if kind == "" {
+		return nil
+	}
+	if !kind.IsValid() {
+		return ErrInvalidProductKind
+	}
+	if err := kind.checkMeasures(p.weight, p.dimensions); err != nil {
+		return err
+	}
+	p.kind = kind
+	p.changes.MarkDirty(FieldKind)
+	return nil
+}
+
+// ChangeKind changes the kind of a product that is not archived together with its
+// weight and dimensions, which must be allowed for the new kind: a physical product needs
+// both and a digital product neither, so making a product digital clears them. A change
+// of kind raises a kind changed event and a change of measures a dimensions changed
+// event; setting the current kind and measures is a no-op and raises no event.
+func (p *Product) ChangeKind(kind ProductKind, weight *Weight, dimensions *Dimensions, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if !kind.IsValid() {
+		return ErrInvalidProductKind
+	}
+	if err := kind.checkMeasures(weight, dimensions); err != nil {
+		return err
+	}
+
+	if !weight.Equal(p.weight) || !dimensions.Equal(p.dimensions) {
+		p.setDimensions(weight, dimensions, now)
+	}
+	if kind != p.kind {
+		p.kind = kind
+		p.changes.MarkDirty(FieldKind)
+		p.updatedAt = now
+		p.events = append(p.events, NewProductKindChangedEvent(p.id, kind, now))
+	}
+	return nil
+}
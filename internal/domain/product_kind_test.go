@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_AssignKind(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	weight, err := NewWeight(250, WeightUnitGram)
+	require.NoError(t, err)
+	dimensions, err := NewDimensions(10, 20, 5, LengthUnitCentimetre)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		kind       ProductKind
+		weight     *Weight
+		dimensions *Dimensions
+		wantErr    error
+	}{
+		{"unset", "", nil, nil, nil},
+		{"unset with measures", "", weight, nil, nil},
+		{"physical", ProductKindPhysical, weight, dimensions, nil},
+		{"physical without dimensions", ProductKindPhysical, weight, nil, ErrMeasuresRequired},
+		{"physical without measures", ProductKindPhysical, nil, nil, ErrMeasuresRequired},
+		{"digital", ProductKindDigital, nil, nil, nil},
+		{"digital with weight", ProductKindDigital, weight, nil, ErrMeasuresNotApplicable},
+		{"unknown", ProductKind("service"), nil, nil, ErrInvalidProductKind},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1999, 100), now)
+			require.NoError(t, err)
+			if tt.weight != nil || tt.dimensions != nil {
+				product.AssignDimensions(tt.weight, tt.dimensions)
+			}
+
+			err = product.AssignKind(tt.kind)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, product.Kind())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.kind, product.Kind())
+			assert.Equal(t, tt.kind != "", product.Changes().Dirty(FieldKind))
+			require.Len(t, product.DomainEvents(), 1, "the kind is part of the created product")
+		})
+	}
+}
+
+func TestProduct_ChangeKind(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1999, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	weight, err := NewWeight(250, WeightUnitGram)
+	require.NoError(t, err)
+	dimensions, err := NewDimensions(10, 20, 5, LengthUnitCentimetre)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, product.ChangeKind(ProductKindPhysical, weight, nil, now), ErrMeasuresRequired)
+	assert.ErrorIs(t, product.ChangeKind(ProductKindDigital, weight, dimensions, now), ErrMeasuresNotApplicable)
+	assert.ErrorIs(t, product.ChangeKind("", nil, nil, now), ErrInvalidProductKind)
+
+	require.NoError(t, product.ChangeKind(ProductKindDigital, nil, nil, now))
+	assert.Equal(t, ProductKindDigital, product.Kind())
+	assert.True(t, product.Changes().Dirty(FieldKind))
+	require.Len(t, product.DomainEvents(), 1)
+	assert.Equal(t, ProductKindDigital, product.DomainEvents()[0].(ProductKindChangedEvent).Kind)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	require.NoError(t, product.ChangeKind(ProductKindDigital, nil, nil, now))
+	assert.Empty(t, product.DomainEvents(), "setting the current kind is a no-op")
+	assert.ErrorIs(t, product.ChangeDimensions(weight, dimensions, now), ErrMeasuresNotApplicable)
+
+	require.NoError(t, product.ChangeKind(ProductKindPhysical, weight, dimensions, now))
+	assert.Equal(t, ProductKindPhysical, product.Kind())
+	assert.True(t, weight.Equal(product.Weight()))
+	assert.True(t, product.Changes().Dirty(FieldDimensions))
+	require.Len(t, product.DomainEvents(), 2)
+	assert.IsType(t, ProductDimensionsChangedEvent{}, product.DomainEvents()[0])
+	assert.IsType(t, ProductKindChangedEvent{}, product.DomainEvents()[1])
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	require.NoError(t, product.ChangeKind(ProductKindDigital, nil, nil, now))
+	assert.Nil(t, product.Weight(), "becoming digital clears the weight")
+	assert.Nil(t, product.Dimensions())
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.ChangeKind(ProductKindDigital, nil, nil, now), ErrProductArchived)
+}
+
+func TestProduct_ChangeDimensions_Physical(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1999, 100), now)
+	require.NoError(t, err)
+	weight, err := NewWeight(250, WeightUnitGram)
+	require.NoError(t, err)
+	dimensions, err := NewDimensions(10, 20, 5, LengthUnitCentimetre)
+	require.NoError(t, err)
+	product.AssignDimensions(weight, dimensions)
+	require.NoError(t, product.AssignKind(ProductKindPhysical))
+
+	assert.ErrorIs(t, product.ChangeDimensions(nil, dimensions, now), ErrMeasuresRequired)
+	assert.Equal(t, weight, product.Weight())
+
+	heavier, err := NewWeight(2, WeightUnitKilogram)
+	require.NoError(t, err)
+	require.NoError(t, product.ChangeDimensions(heavier, dimensions, now))
+
+	clone, err := product.Clone("product-2", now)
+	require.NoError(t, err)
+	assert.Equal(t, ProductKindPhysical, clone.Kind())
+	assert.True(t, clone.Changes().Dirty(FieldKind))
+}
+
+func TestProductKind_HasStock(t *testing.T) {
+	assert.True(t, ProductKindPhysical.HasStock())
+	assert.True(t, ProductKind("").HasStock())
+	assert.False(t, ProductKindDigital.HasStock())
+}
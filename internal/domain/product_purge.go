@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// IsPurgeable reports whether the product was archived before cutoff, i.e. has been
+// archived for longer than the retention period ending at cutoff.
+func (p *Product) IsPurgeable(cutoff time.Time) bool {
+	return p.status == ProductStatusArchived && p.archivedAt != nil && p.archivedAt.Before(cutoff)
+}
+
+// Purge marks a product archived before cutoff for permanent deletion and raises a
+// ProductPurgedEvent. The product itself is unchanged; deleting it is up to the
+// repository.
+func (p *Product) Purge(cutoff, now time.Time) error {
+	if !p.IsPurgeable(cutoff) {
+		return ErrProductNotPurgeable
+	}
+
+	p.events = append(p.events, NewProductPurgedEvent(p.id, *p.archivedAt, now))
+	return nil
+}
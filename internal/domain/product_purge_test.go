@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_Purge(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1000, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+
+	// Only archived products can be purged
+	cutoff := now.Add(24 * time.Hour)
+	assert.False(t, product.IsPurgeable(cutoff))
+	assert.ErrorIs(t, product.Purge(cutoff, now), ErrProductNotPurgeable)
+
+	require.NoError(t, product.Archive(now))
+	product.ClearEvents()
+
+	// A product archived at the cutoff is kept
+	assert.False(t, product.IsPurgeable(now))
+	assert.ErrorIs(t, product.Purge(now, now), ErrProductNotPurgeable)
+	assert.Empty(t, product.DomainEvents())
+
+	// A product archived before the cutoff is purged
+	purgedAt := now.Add(48 * time.Hour)
+	assert.True(t, product.IsPurgeable(cutoff))
+	require.NoError(t, product.Purge(cutoff, purgedAt))
+	require.Len(t, product.DomainEvents(), 1)
+	purged := product.DomainEvents()[0].(ProductPurgedEvent)
+	assert.Equal(t, "product.purged", purged.EventType())
+	assert.Equal(t, "product-1", purged.AggregateID())
+	assert.Equal(t, now, purged.ArchivedAt)
+	assert.Equal(t, purgedAt, purged.OccurredAt())
+}
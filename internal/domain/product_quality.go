@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// Targets of the quality score: a product gets full marks for its description once it is
+// QualityDescriptionLength characters long, and for its images once it has
+// QualityImageCount of them.
+const (
+	QualityDescriptionLength = 200
+	QualityImageCount        = 3
+)
+
+// ProductContent is the content of a product that its quality score rates.
+type ProductContent struct {
+	Description string
+	ImageCount  int
+	Attributes  map[string]string
+	// Schema is the attribute schema of the category of the product, or nil if the
+	// category has none.
+	Schema *CategoryAttributeSchema
+	// Locales lists the locales the product has translations in.
+	Locales []string
+}
+
+// QualityScore rates how complete the content of a product is, from 0 (nothing) to 100
+// (complete): overall and for each aspect. Translations is nil if the quality policy
+// names no locales to translate to; the overall score is the mean of the other aspects
+// then.
+type QualityScore struct {
+	Overall      int
+	Description  int
+	Images       int
+	Attributes   int
+	Translations *int
+}
+
+// QualityPolicy is how the quality of products is scored: the locales every product
+// should be translated to, in addition to DefaultLocale.
+type QualityPolicy struct {
+	locales []string
+}
+
+// ParseQualityPolicy parses the comma-separated locales every product should be
+// translated to, e.g. "de,fr,pt-BR". An empty string names none, so translations are not
+// scored.
+func ParseQualityPolicy(locales string) (*QualityPolicy, error) {
+	policy := &QualityPolicy{}
+	if strings.TrimSpace(locales) == "" {
+		return policy, nil
+	}
+	seen := make(map[string]bool)
+	for _, s := range strings.Split(locales, ",") {
+		locale, err := ParseLocale(s)
+		if err != nil {
+			return nil, err
+		}
+		if locale == DefaultLocale {
+			return nil, ErrDefaultLocaleTranslation
+		}
+		if !seen[locale] {
+			seen[locale] = true
+			policy.locales = append(policy.locales, locale)
+		}
+	}
+	return policy, nil
+}
+
+// Locales returns the locales every product should be translated to.
+func (qp *QualityPolicy) Locales() []string {
+	if qp == nil {
+		return nil
+	}
+	return append([]string(nil), qp.locales...)
+}
+
+// Score rates the content of a product:
+//
+//   - Description: its length in characters, up to QualityDescriptionLength.
+//   - Images: the number of images, up to QualityImageCount.
+//   - Attributes: the share of the attributes of the schema of its category that the
+//     product has a valid value for. A product of a category without a schema, which
+//     defines no attributes, gets full marks if it has any attribute.
+//   - Translations: the share of the locales of the policy the product is translated
+//     to. A translation in the language of a locale, e.g. "pt" for "pt-BR", covers it,
+//     as reads in the locale fall back to it.
+//
+// A nil policy scores no translations.
+func (qp *QualityPolicy) Score(content ProductContent) QualityScore {
+	score := QualityScore{
+		Description: percentOf(utf8.RuneCountInString(strings.TrimSpace(content.Description)), QualityDescriptionLength),
+		Images:      percentOf(content.ImageCount, QualityImageCount),
+		Attributes:  attributeCompleteness(content.Attributes, content.Schema),
+	}
+	aspects := []int{score.Description, score.Images, score.Attributes}
+
+	if locales := qp.Locales(); len(locales) > 0 {
+		translated := make(map[string]bool, len(content.Locales))
+		for _, locale := range content.Locales {
+			translated[locale] = true
+		}
+		covered := 0
+		for _, locale := range locales {
+			if translated[locale] || translated[LocaleLanguage(locale)] {
+				covered++
+			}
+		}
+		translations := percentOf(covered, len(locales))
+		score.Translations = &translations
+		aspects = append(aspects, translations)
+	}
+
+	total := 0
+	for _, aspect := range aspects {
+		total += aspect
+	}
+	score.Overall = int(math.Round(float64(total) / float64(len(aspects))))
+	return score
+}
+
+// attributeCompleteness returns the percentage of the attributes of schema that
+// attributes holds a valid value for; without a schema, 100 if there is any attribute.
+func attributeCompleteness(attributes map[string]string, schema *CategoryAttributeSchema) int {
+	if schema == nil {
+		if len(attributes) > 0 {
+			return 100
+		}
+		return 0
+	}
+	definitions := schema.Attributes()
+	if len(definitions) == 0 {
+		return 100
+	}
+	valid := 0
+	for _, d := range definitions {
+		if value := attributes[d.Name()]; value != "" && d.check(value) == "" {
+			valid++
+		}
+	}
+	return percentOf(valid, len(definitions))
+}
+
+// percentOf returns n as a percentage of target, rounded down and at most 100.
+func percentOf(n, target int) int {
+	if n >= target {
+		return 100
+	}
+	return n * 100 / target
+}
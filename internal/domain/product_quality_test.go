@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQualityPolicy(t *testing.T) {
+	policy, err := ParseQualityPolicy(" de, pt_br ,de")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"de", "pt-BR"}, policy.Locales())
+
+	none, err := ParseQualityPolicy("")
+	require.NoError(t, err)
+	assert.Empty(t, none.Locales())
+
+	_, err = ParseQualityPolicy("de,,fr")
+	assert.ErrorIs(t, err, ErrInvalidLocale)
+	_, err = ParseQualityPolicy("de,en")
+	assert.ErrorIs(t, err, ErrDefaultLocaleTranslation)
+}
+
+func TestQualityPolicy_Score(t *testing.T) {
+	color, err := NewAttributeDefinition("color", AttributeTypeString, true, []string{"red", "blue"})
+	require.NoError(t, err)
+	watts, err := NewAttributeDefinition("watts", AttributeTypeInteger, false, nil)
+	require.NoError(t, err)
+	schema, err := NewCategoryAttributeSchema("Electronics", []*AttributeDefinition{color, watts})
+	require.NoError(t, err)
+	policy, err := ParseQualityPolicy("de,fr,pt-BR,ja")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		policy  *QualityPolicy
+		content ProductContent
+		want    QualityScore
+	}{
+		{
+			name:    "empty",
+			content: ProductContent{},
+			want:    QualityScore{},
+		},
+		{
+			name: "complete without a schema",
+			content: ProductContent{
+				Description: strings.Repeat("é", QualityDescriptionLength),
+				ImageCount:  QualityImageCount + 2,
+				Attributes:  map[string]string{"material": "steel"},
+			},
+			want: QualityScore{Overall: 100, Description: 100, Images: 100, Attributes: 100},
+		},
+		{
+			name: "partial",
+			content: ProductContent{
+				Description: strings.Repeat("x", QualityDescriptionLength/2),
+				ImageCount:  1,
+				Attributes:  map[string]string{"color": "green", "watts": "60"},
+				Schema:      schema,
+			},
+			want: QualityScore{Overall: 44, Description: 50, Images: 33, Attributes: 50},
+		},
+		{
+			name:   "translations",
+			policy: policy,
+			content: ProductContent{
+				Description: strings.Repeat("x", QualityDescriptionLength),
+				ImageCount:  QualityImageCount,
+				Attributes:  map[string]string{"color": "red", "watts": "60"},
+				Schema:      schema,
+				Locales:     []string{"de", "pt", "es"},
+			},
+			want: QualityScore{Overall: 88, Description: 100, Images: 100, Attributes: 100, Translations: intPtr(50)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.Score(tt.content))
+		})
+	}
+}
+
+func intPtr(n int) *int {
+	return &n
+}
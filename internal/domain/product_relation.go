@@ -0,0 +1,82 @@
+package domain
+
+import "time"
+
+// MaxProductRelations is the maximum number of products a product links to with one
+// relation type.
+const MaxProductRelations = 50
+
+// RelationType is the kind of link from a product to another product.
+type RelationType string
+
+const (
+	// RelationRelated links a product to a similar one, e.g. to suggest alternatives.
+	RelationRelated RelationType = "related"
+	// RelationAccessory links a product to an accessory of it, e.g. a phone to its case.
+	RelationAccessory RelationType = "accessory"
+	// RelationReplacement links a product to the one that replaces it, e.g. a newer model.
+	RelationReplacement RelationType = "replacement"
+)
+
+// RelationTypes lists the relation types in the order links are returned.
+var RelationTypes = []RelationType{RelationAccessory, RelationRelated, RelationReplacement}
+
+// ParseRelationType validates a relation type.
+func ParseRelationType(s string) (RelationType, error) {
+	for _, t := range RelationTypes {
+		if s == string(t) {
+			return t, nil
+		}
+	}
+	return "", ErrInvalidRelationType
+}
+
+// String returns the relation type as a string.
+func (t RelationType) String() string { return string(t) }
+
+// Acyclic reports whether links of the type must not form a cycle. A product can be
+// related to a product related to it, but a product cannot be an accessory of its own
+// accessory, nor be replaced by a product it replaces.
+func (t RelationType) Acyclic() bool {
+	return t == RelationAccessory || t == RelationReplacement
+}
+
+// ProductRelation is a typed link from a product to a related product.
+type ProductRelation struct {
+	productID        string
+	relatedProductID string
+	relationType     RelationType
+	createdAt        time.Time
+}
+
+// NewProductRelation creates a new link from a product to a related product. A product
+// cannot be linked to itself.
+func NewProductRelation(productID, relatedProductID string, relationType RelationType, now time.Time) (*ProductRelation, error) {
+	if productID == "" || relatedProductID == "" {
+		return nil, ErrInvalidID
+	}
+	if productID == relatedProductID {
+		return nil, ErrSelfRelation
+	}
+	if _, err := ParseRelationType(string(relationType)); err != nil {
+		return nil, err
+	}
+	return &ProductRelation{
+		productID:        productID,
+		relatedProductID: relatedProductID,
+		relationType:     relationType,
+		createdAt:        now,
+	}, nil
+}
+
+// ProductID returns the product the link is from.
+func (r *ProductRelation) ProductID() string { return r.productID }
+
+// RelatedProductID returns the product the link is to.
+func (r *ProductRelation) RelatedProductID() string { return r.relatedProductID }
+
+// Type returns the relation type of the link.
+func (r *ProductRelation) Type() RelationType { return r.relationType }
+
+// CreatedAt returns when the link was created.
+func (r *ProductRelation) CreatedAt() time.Time { return r.createdAt }
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRelationType(t *testing.T) {
+	for _, s := range []string{"related", "accessory", "replacement"} {
+		relationType, err := ParseRelationType(s)
+		require.NoError(t, err)
+		assert.Equal(t, s, relationType.String())
+	}
+
+	for _, invalid := range []string{"", "Related", "upsell"} {
+		_, err := ParseRelationType(invalid)
+		assert.ErrorIs(t, err, ErrInvalidRelationType, "type %q", invalid)
+	}
+
+	assert.False(t, RelationRelated.Acyclic())
+	assert.True(t, RelationAccessory.Acyclic())
+	assert.True(t, RelationReplacement.Acyclic())
+}
+
+func TestNewProductRelation(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	relation, err := NewProductRelation("phone", "case", RelationAccessory, now)
+	require.NoError(t, err)
+	assert.Equal(t, "phone", relation.ProductID())
+	assert.Equal(t, "case", relation.RelatedProductID())
+	assert.Equal(t, RelationAccessory, relation.Type())
+	assert.Equal(t, now, relation.CreatedAt())
+
+	event := NewProductLinkedEvent(relation, now)
+	assert.Equal(t, "product.linked", event.EventType())
+	assert.Equal(t, "phone", event.AggregateID())
+	assert.Equal(t, "case", event.RelatedProductID)
+
+	_, err = NewProductRelation("phone", "phone", RelationRelated, now)
+	assert.ErrorIs(t, err, ErrSelfRelation)
+	_, err = NewProductRelation("phone", "", RelationRelated, now)
+	assert.ErrorIs(t, err, ErrInvalidID)
+	_, err = NewProductRelation("phone", "case", "upsell", now)
+	assert.ErrorIs(t, err, ErrInvalidRelationType)
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// ProductReview is the latest review of a product: who submitted it and, once it was
+// reviewed, who approved or rejected it and why it was rejected.
+type ProductReview struct {
+	submittedBy     string
+	reviewedBy      string
+	rejectionReason string
+}
+
+// NewProductReview creates a ProductReview. reviewedBy is empty while the review is
+// pending, and rejectionReason is empty unless the product was rejected.
+func NewProductReview(submittedBy, reviewedBy, rejectionReason string) *ProductReview {
+	return &ProductReview{
+		submittedBy:     submittedBy,
+		reviewedBy:      reviewedBy,
+		rejectionReason: rejectionReason,
+	}
+}
+
+// SubmittedBy returns who submitted the product for review.
+func (r *ProductReview) SubmittedBy() string { return r.submittedBy }
+
+// ReviewedBy returns who approved or rejected the product, or "" while it is pending.
+func (r *ProductReview) ReviewedBy() string { return r.reviewedBy }
+
+// RejectionReason returns why the product was rejected, or "" if it was not.
+func (r *ProductReview) RejectionReason() string { return r.rejectionReason }
+
+// Review returns the latest review of the product, or nil if it was never submitted for
+// review.
+func (p *Product) Review() *ProductReview { return p.review }
+
+// SubmitForReview moves a draft product to pending review, recording who submitted it.
+// A product pending review is activated by approving it and returns to draft when it is
+// rejected.
+func (p *Product) SubmitForReview(submittedBy string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if p.status != ProductStatusDraft {
+		return ErrProductNotDraft
+	}
+	submittedBy = strings.TrimSpace(submittedBy)
+	if submittedBy == "" {
+		return ErrSubmitterRequired
+	}
+
+	p.review = NewProductReview(submittedBy, "", "")
+	p.status = ProductStatusPendingReview
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldStatus)
+	p.changes.MarkDirty(FieldReview)
+	p.events = append(p.events, NewProductSubmittedForReviewEvent(p.id, submittedBy, now))
+	return nil
+}
+
+// Approve approves a product pending review and activates it, raising
+// ProductApprovedEvent and then the events of Activate. The reviewer must not be the
+// submitter, so every product is seen by a second person before it goes on sale.
+func (p *Product) Approve(reviewer string, now time.Time) error {
+	reviewer = strings.TrimSpace(reviewer)
+	if err := p.checkReview(reviewer); err != nil {
+		return err
+	}
+	if strings.EqualFold(reviewer, p.review.submittedBy) {
+		return ErrReviewerIsSubmitter
+	}
+
+	p.review = NewProductReview(p.review.submittedBy, reviewer, "")
+	p.changes.MarkDirty(FieldReview)
+	p.events = append(p.events, NewProductApprovedEvent(p.id, reviewer, now))
+	p.activate(now)
+	return nil
+}
+
+// Reject returns a product pending review to draft, recording the reviewer and the
+// reason so the product can be corrected and submitted again. Scheduled status changes
+// are dropped without an event; the rejected event implies them.
+func (p *Product) Reject(reviewer, reason string, now time.Time) error {
+	reviewer = strings.TrimSpace(reviewer)
+	if err := p.checkReview(reviewer); err != nil {
+		return err
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return ErrRejectionReasonRequired
+	}
+
+	p.review = NewProductReview(p.review.submittedBy, reviewer, reason)
+	if p.activateAt != nil || p.deactivateAt != nil {
+		p.activateAt, p.deactivateAt = nil, nil
+		p.changes.MarkDirty(FieldStatusSchedule)
+	}
+	p.status = ProductStatusDraft
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldStatus)
+	p.changes.MarkDirty(FieldReview)
+	p.events = append(p.events, NewProductRejectedEvent(p.id, reviewer, reason, now))
+	return nil
+}
+
+// checkReview checks that the product is pending review and reviewer is set.
+func (p *Product) checkReview(reviewer string) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if p.status != ProductStatusPendingReview || p.review == nil {
+		return ErrProductNotPendingReview
+	}
+	if reviewer == "" {
+		return ErrReviewerRequired
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_ReviewWorkflow(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1000, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+
+	// Only the approval of a product pending review is accepted
+	assert.ErrorIs(t, product.Approve("bob", now), ErrProductNotPendingReview)
+	assert.ErrorIs(t, product.SubmitForReview("  ", now), ErrSubmitterRequired)
+
+	require.NoError(t, product.SubmitForReview(" alice ", now))
+	assert.Equal(t, ProductStatusPendingReview, product.Status())
+	assert.Equal(t, "alice", product.Review().SubmittedBy())
+	assert.Empty(t, product.Review().ReviewedBy())
+	assert.True(t, product.Changes().Dirty(FieldReview))
+	require.Len(t, product.DomainEvents(), 1)
+	assert.Equal(t, "alice", product.DomainEvents()[0].(ProductSubmittedForReviewEvent).SubmittedBy)
+	product.ClearEvents()
+
+	// A product pending review can be neither submitted again nor activated directly
+	assert.ErrorIs(t, product.SubmitForReview("alice", now), ErrProductNotDraft)
+	assert.ErrorIs(t, product.Activate(now), ErrProductNotActive)
+
+	// Rejection returns the product to draft with the reason
+	assert.ErrorIs(t, product.Reject("bob", " ", now), ErrRejectionReasonRequired)
+	assert.ErrorIs(t, product.Reject("", "Missing images", now), ErrReviewerRequired)
+	require.NoError(t, product.Reject("bob", "Missing images", now))
+	assert.Equal(t, ProductStatusDraft, product.Status())
+	assert.Equal(t, "bob", product.Review().ReviewedBy())
+	assert.Equal(t, "Missing images", product.Review().RejectionReason())
+	require.Len(t, product.DomainEvents(), 1)
+	rejected := product.DomainEvents()[0].(ProductRejectedEvent)
+	assert.Equal(t, "product.rejected", rejected.EventType())
+	assert.Equal(t, "Missing images", rejected.Reason)
+	product.ClearEvents()
+
+	// The submitter cannot approve their own product
+	require.NoError(t, product.SubmitForReview("alice", now))
+	assert.Empty(t, product.Review().RejectionReason())
+	product.ClearEvents()
+	assert.ErrorIs(t, product.Approve("Alice", now), ErrReviewerIsSubmitter)
+
+	// Approval activates the product
+	require.NoError(t, product.Approve("bob", now))
+	assert.Equal(t, ProductStatusActive, product.Status())
+	assert.Equal(t, "bob", product.Review().ReviewedBy())
+	require.Len(t, product.DomainEvents(), 2)
+	assert.Equal(t, "product.approved", product.DomainEvents()[0].EventType())
+	assert.Equal(t, "product.activated", product.DomainEvents()[1].EventType())
+}
+
+func TestProduct_SubmitForReviewRequiresDraft(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+
+	assert.ErrorIs(t, product.SubmitForReview("alice", now), ErrProductNotDraft)
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.SubmitForReview("alice", now), ErrProductArchived)
+	assert.ErrorIs(t, product.Reject("bob", "Duplicate", now), ErrProductArchived)
+}
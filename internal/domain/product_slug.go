@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxSlugLength is the maximum length of a product slug.
+	MaxSlugLength = 100
+	// maxGeneratedSlugLength is the maximum length of a slug built from a name, which
+	// leaves room for the suffix that tells it apart from the slugs of other products.
+	maxGeneratedSlugLength = 80
+)
+
+// defaultSlug is the slug of a product whose name has no letters or digits to build one
+// from.
+const defaultSlug = "product"
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// slugTransliterations spells common accented and special Latin letters in ASCII, so that
+// e.g. "Crème Brûlée" becomes "creme-brulee" rather than "cr-me-br-l-e".
+var slugTransliterations = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae",
+	'ç': "c", 'è': "e", 'é': "e", 'ê': "e", 'ë': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ñ': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'œ': "oe",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ý': "y", 'ÿ': "y", 'ß': "ss",
+	'&': "and",
+}
+
+// ParseSlug validates a slug: lowercase letters and digits in words separated by single
+// hyphens, at most MaxSlugLength characters. Uppercase letters are lower-cased.
+func ParseSlug(slug string) (string, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if len(slug) > MaxSlugLength || !slugPattern.MatchString(slug) {
+		return "", ErrInvalidSlug
+	}
+	return slug, nil
+}
+
+// Slugify builds a slug from a product name, e.g. "Crème Brûlée 2-Pack" becomes
+// "creme-brulee-2-pack". Letters without an ASCII spelling and punctuation separate words.
+// The slug is cut to 80 characters; a name without letters or digits gives "product".
+func Slugify(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		word := ""
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			word = string(r)
+		default:
+			word = slugTransliterations[r]
+		}
+		if word == "" {
+			hyphen = b.Len() > 0
+			continue
+		}
+		if hyphen {
+			b.WriteByte('-')
+			hyphen = false
+		}
+		b.WriteString(word)
+	}
+	slug := b.String()
+	if len(slug) > maxGeneratedSlugLength {
+		slug = strings.TrimRight(slug[:maxGeneratedSlugLength], "-")
+	}
+	if slug == "" {
+		return defaultSlug
+	}
+	return slug
+}
+
+// FreeSlug returns the first slug built from base, a slug returned by Slugify, that is
+// not among taken: base itself, then base suffixed with "-2", "-3" and so on.
+func FreeSlug(base string, taken []string) string {
+	used := make(map[string]bool, len(taken))
+	for _, slug := range taken {
+		used[slug] = true
+	}
+	slug := base
+	for n := 2; used[slug]; n++ {
+		slug = base + "-" + strconv.Itoa(n)
+	}
+	return slug
+}
+
+// Slug returns the URL slug of the product; empty for a product created before slugs
+// were introduced that has not been given one.
+func (p *Product) Slug() string {
+	return p.slug
+}
+
+// AssignSlug sets the slug of a new product before it is first saved. It raises no event:
+// the slug is part of the created product.
+func (p *Product) AssignSlug(slug string) error {
+	slug, err := ParseSlug(slug)
+	if err != nil {
+		return err
+	}
+	p.slug = slug
+	p.changes.MarkDirty(FieldSlug)
+	return nil
+}
+
+// ChangeSlug changes the slug of the product, or gives one to a product that has none.
+// Slugs are not derived from the name again when it changes, so links to the product
+// keep working; changing the slug breaks them. Setting the current slug is a no-op and
+// raises no event.
+func (p *Product) ChangeSlug(slug string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	slug, err := ParseSlug(slug)
+	if err != nil {
+		return err
+	}
+	if slug == p.slug {
+		return nil
+	}
+
+	previous := p.slug
+	p.slug = slug
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldSlug)
+	p.events = append(p.events, NewProductSlugChangedEvent(p.id, slug, previous, now))
+	return nil
+}
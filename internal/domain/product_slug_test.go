@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Blue Widget", "blue-widget"},
+		{"  Crème Brûlée 2-Pack!  ", "creme-brulee-2-pack"},
+		{"Salt & Pepper", "salt-and-pepper"},
+		{"Größe_XL", "grosse-xl"},
+		{"日本", "product"},
+		{"---", "product"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Slugify(tt.name), "name %q", tt.name)
+	}
+
+	long := Slugify(strings.Repeat("abcd ", 30))
+	assert.LessOrEqual(t, len(long), 80)
+	assert.False(t, strings.HasSuffix(long, "-"))
+	_, err := ParseSlug(long)
+	assert.NoError(t, err)
+}
+
+func TestFreeSlug(t *testing.T) {
+	assert.Equal(t, "widget", FreeSlug("widget", nil))
+	assert.Equal(t, "widget", FreeSlug("widget", []string{"widget-2", "widget-pro"}))
+	assert.Equal(t, "widget-2", FreeSlug("widget", []string{"widget"}))
+	assert.Equal(t, "widget-4", FreeSlug("widget", []string{"widget-3", "widget", "widget-2"}))
+}
+
+func TestParseSlug(t *testing.T) {
+	slug, err := ParseSlug(" Blue-Widget-2 ")
+	require.NoError(t, err)
+	assert.Equal(t, "blue-widget-2", slug)
+
+	for _, invalid := range []string{"", "blue widget", "-widget", "widget-", "blue--widget", "blue_widget", strings.Repeat("a", MaxSlugLength+1)} {
+		_, err := ParseSlug(invalid)
+		assert.ErrorIs(t, err, ErrInvalidSlug, "slug %q", invalid)
+	}
+}
+
+func TestProduct_Slug(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.AssignSlug("widget"))
+	assert.Equal(t, "widget", product.Slug())
+	assert.True(t, product.Changes().Dirty(FieldSlug))
+	assert.Len(t, product.DomainEvents(), 1, "the slug is part of the created product")
+	product.ClearEvents()
+
+	// Renaming keeps the slug
+	require.NoError(t, product.Update("Blue Widget", "", "Tools", now))
+	assert.Equal(t, "widget", product.Slug())
+	product.ClearEvents()
+
+	later := now.Add(time.Hour)
+	require.NoError(t, product.ChangeSlug("Blue-Widget", later))
+	assert.Equal(t, "blue-widget", product.Slug())
+	assert.Equal(t, later, product.UpdatedAt())
+	require.Len(t, product.DomainEvents(), 1)
+	event := product.DomainEvents()[0].(ProductSlugChangedEvent)
+	assert.Equal(t, "product.slug_changed", event.EventType())
+	assert.Equal(t, "blue-widget", event.Slug)
+	assert.Equal(t, "widget", event.PreviousSlug)
+	product.ClearEvents()
+
+	require.NoError(t, product.ChangeSlug("blue-widget", later))
+	assert.Empty(t, product.DomainEvents())
+	assert.ErrorIs(t, product.ChangeSlug("blue widget", later), ErrInvalidSlug)
+
+	require.NoError(t, product.Archive(later))
+	assert.ErrorIs(t, product.ChangeSlug("widget", later), ErrProductArchived)
+}
@@ -5,10 +5,11 @@ type ProductStatus string
 
 // Product status values.
 const (
-	ProductStatusDraft    ProductStatus = "draft"
-	ProductStatusActive   ProductStatus = "active"
-	ProductStatusInactive ProductStatus = "inactive"
-	ProductStatusArchived ProductStatus = "archived"
+	ProductStatusDraft         ProductStatus = "draft"
+	ProductStatusPendingReview ProductStatus = "pending_review"
+	ProductStatusActive        ProductStatus = "active"
+	ProductStatusInactive      ProductStatus = "inactive"
+	ProductStatusArchived      ProductStatus = "archived"
 )
 
 // String returns the string representation of the status.
@@ -19,14 +20,15 @@ func (s ProductStatus) String() string {
 // IsValid checks if the status is a valid product status.
 func (s ProductStatus) IsValid() bool {
 	switch s {
-	case ProductStatusDraft, ProductStatusActive, ProductStatusInactive, ProductStatusArchived:
+	case ProductStatusDraft, ProductStatusPendingReview, ProductStatusActive, ProductStatusInactive, ProductStatusArchived:
 		return true
 	default:
 		return false
 	}
 }
 
-// CanActivate returns true if a product with this status can be activated.
+// CanActivate returns true if a product with this status can be activated. A product
+// pending review is activated by approving it; see Product.Approve.
 func (s ProductStatus) CanActivate() bool {
 	return s == ProductStatusDraft || s == ProductStatusInactive
 }
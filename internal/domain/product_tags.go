@@ -0,0 +1,206 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxProductTags is the maximum number of tags of a product.
+	MaxProductTags = 20
+	// MaxTagLength is the maximum length of a tag.
+	MaxTagLength = 50
+	// MaxProductAttributes is the maximum number of attributes of a product.
+	MaxProductAttributes = 50
+	// MaxProductAttributeNameLength is the maximum length of a product attribute name.
+	MaxProductAttributeNameLength = 50
+	// MaxProductAttributeValueLength is the maximum length of a product attribute value.
+	MaxProductAttributeValueLength = 500
+)
+
+var (
+	tagPattern                  = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+	productAttributeNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_]*$`)
+)
+
+// ParseTag validates a tag: lowercase letters, digits, hyphens and underscores, at most
+// MaxTagLength characters. Uppercase letters are lower-cased.
+func ParseTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if len(tag) > MaxTagLength || !tagPattern.MatchString(tag) {
+		return "", ErrInvalidTag
+	}
+	return tag, nil
+}
+
+// ParseProductAttributeName validates the name of a product attribute, e.g. "material":
+// lowercase letters, digits and underscores, at most MaxProductAttributeNameLength
+// characters. Surrounding spaces are trimmed.
+func ParseProductAttributeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if len(name) > MaxProductAttributeNameLength || !productAttributeNamePattern.MatchString(name) {
+		return "", ErrInvalidProductAttribute
+	}
+	return name, nil
+}
+
+// Tags returns the tags of the product in order.
+func (p *Product) Tags() []string {
+	return append([]string(nil), p.tags...)
+}
+
+// HasTag reports whether the product is tagged with tag, which must be parsed.
+func (p *Product) HasTag(tag string) bool {
+	i := sort.SearchStrings(p.tags, tag)
+	return i < len(p.tags) && p.tags[i] == tag
+}
+
+// Attributes returns a copy of the attributes of the product.
+func (p *Product) Attributes() map[string]string {
+	attributes := make(map[string]string, len(p.attributes))
+	for name, value := range p.attributes {
+		attributes[name] = value
+	}
+	return attributes
+}
+
+// AddTag tags the product. Adding a tag the product already has is a no-op and raises no
+// event.
+func (p *Product) AddTag(tag string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	tag, err := ParseTag(tag)
+	if err != nil {
+		return err
+	}
+	if p.HasTag(tag) {
+		return nil
+	}
+	if len(p.tags) >= MaxProductTags {
+		return ErrTooManyTags
+	}
+
+	p.tags = append(p.tags, tag)
+	sort.Strings(p.tags)
+	p.tagsChanged(now)
+	return nil
+}
+
+// RemoveTag removes a tag from the product. Removing a tag the product does not have is a
+// no-op and raises no event.
+func (p *Product) RemoveTag(tag string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	tag, err := ParseTag(tag)
+	if err != nil {
+		return err
+	}
+	if !p.HasTag(tag) {
+		return nil
+	}
+
+	i := sort.SearchStrings(p.tags, tag)
+	p.tags = append(p.tags[:i:i], p.tags[i+1:]...)
+	p.tagsChanged(now)
+	return nil
+}
+
+func (p *Product) tagsChanged(now time.Time) {
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldTags)
+	p.events = append(p.events, NewProductTagsChangedEvent(p.id, p.Tags(), now))
+}
+
+// SetAttribute sets an attribute of the product, e.g. "material" to "oak"; an empty value
+// removes it. Setting an attribute to its current value is a no-op and raises no event.
+// Surrounding spaces of the value are trimmed.
+func (p *Product) SetAttribute(name, value string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	name, err := ParseProductAttributeName(name)
+	if err != nil {
+		return err
+	}
+	value = strings.TrimSpace(value)
+	if len(value) > MaxProductAttributeValueLength {
+		return ErrInvalidProductAttribute
+	}
+
+	current, ok := p.attributes[name]
+	switch {
+	case value == "" && !ok, ok && current == value:
+		return nil
+	case value == "":
+		delete(p.attributes, name)
+	default:
+		if !ok && len(p.attributes) >= MaxProductAttributes {
+			return ErrTooManyProductAttributes
+		}
+		if p.attributes == nil {
+			p.attributes = make(map[string]string)
+		}
+		p.attributes[name] = value
+	}
+
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldAttributes)
+	p.events = append(p.events, NewProductAttributeChangedEvent(p.id, name, value, now))
+	return nil
+}
+
+// ReplaceAttributes replaces the attributes of the product with attributes, raising a
+// ProductAttributeChangedEvent for each attribute set, changed or removed, by name.
+// Attributes with an empty value are left out. Names and values are validated as by
+// SetAttribute; nothing is changed if any is invalid.
+func (p *Product) ReplaceAttributes(attributes map[string]string, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+
+	replaced := make(map[string]string, len(attributes))
+	for name, value := range attributes {
+		name, err := ParseProductAttributeName(name)
+		if err != nil {
+			return err
+		}
+		value = strings.TrimSpace(value)
+		if len(value) > MaxProductAttributeValueLength {
+			return ErrInvalidProductAttribute
+		}
+		if value != "" {
+			replaced[name] = value
+		}
+	}
+	if len(replaced) > MaxProductAttributes {
+		return ErrTooManyProductAttributes
+	}
+
+	names := make([]string, 0, len(replaced)+len(p.attributes))
+	for name, value := range replaced {
+		if current, ok := p.attributes[name]; !ok || current != value {
+			names = append(names, name)
+		}
+	}
+	for name := range p.attributes {
+		if _, ok := replaced[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	sort.Strings(names)
+
+	p.attributes = replaced
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldAttributes)
+	for _, name := range names {
+		p.events = append(p.events, NewProductAttributeChangedEvent(p.id, name, replaced[name], now))
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTag(t *testing.T) {
+	tag, err := ParseTag(" Summer-Sale ")
+	require.NoError(t, err)
+	assert.Equal(t, "summer-sale", tag)
+
+	for _, invalid := range []string{"", "-sale", "summer sale", "sale!", strings.Repeat("a", MaxTagLength+1)} {
+		_, err := ParseTag(invalid)
+		assert.ErrorIs(t, err, ErrInvalidTag, "tag %q", invalid)
+	}
+}
+
+func TestProduct_Tags(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	assert.Empty(t, product.Tags())
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	require.NoError(t, product.AddTag("sale", now))
+	require.NoError(t, product.AddTag("Eco", now))
+	assert.Equal(t, []string{"eco", "sale"}, product.Tags())
+	assert.True(t, product.HasTag("eco"))
+	assert.True(t, product.Changes().Dirty(FieldTags))
+	require.Len(t, product.DomainEvents(), 2)
+	event, ok := product.DomainEvents()[1].(ProductTagsChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, []string{"eco", "sale"}, event.Tags)
+
+	// Adding a tag again or removing a missing one is a no-op
+	product.ClearEvents()
+	product.Changes().Reset()
+	require.NoError(t, product.AddTag("sale", now))
+	require.NoError(t, product.RemoveTag("clearance", now))
+	assert.Empty(t, product.DomainEvents())
+	assert.False(t, product.Changes().HasChanges())
+
+	require.NoError(t, product.RemoveTag("sale", now))
+	assert.Equal(t, []string{"eco"}, product.Tags())
+	require.Len(t, product.DomainEvents(), 1)
+	assert.Equal(t, []string{"eco"}, product.DomainEvents()[0].(ProductTagsChangedEvent).Tags)
+
+	assert.ErrorIs(t, product.AddTag("big sale", now), ErrInvalidTag)
+	for i := len(product.Tags()); i < MaxProductTags; i++ {
+		require.NoError(t, product.AddTag(fmt.Sprintf("tag-%d", i), now))
+	}
+	assert.ErrorIs(t, product.AddTag("one-more", now), ErrTooManyTags)
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.AddTag("sale", now), ErrProductArchived)
+	assert.ErrorIs(t, product.RemoveTag("eco", now), ErrProductArchived)
+}
+
+func TestProduct_SetAttribute(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Table", "", "Furniture", NewMoney(20000, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	require.NoError(t, product.SetAttribute(" material ", " oak ", now))
+	assert.Equal(t, map[string]string{"material": "oak"}, product.Attributes())
+	assert.True(t, product.Changes().Dirty(FieldAttributes))
+	require.Len(t, product.DomainEvents(), 1)
+	event, ok := product.DomainEvents()[0].(ProductAttributeChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "material", event.Name)
+	assert.Equal(t, "oak", event.Value)
+
+	// Setting the same value, or removing a missing attribute, is a no-op
+	product.ClearEvents()
+	product.Changes().Reset()
+	require.NoError(t, product.SetAttribute("material", "oak", now))
+	require.NoError(t, product.SetAttribute("color", "", now))
+	assert.Empty(t, product.DomainEvents())
+	assert.False(t, product.Changes().HasChanges())
+
+	// An empty value removes the attribute
+	require.NoError(t, product.SetAttribute("material", "", now))
+	assert.Empty(t, product.Attributes())
+	require.Len(t, product.DomainEvents(), 1)
+	assert.Empty(t, product.DomainEvents()[0].(ProductAttributeChangedEvent).Value)
+
+	assert.ErrorIs(t, product.SetAttribute("Material", "oak", now), ErrInvalidProductAttribute)
+	assert.ErrorIs(t, product.SetAttribute("material", strings.Repeat("a", MaxProductAttributeValueLength+1), now), ErrInvalidProductAttribute)
+	for i := 0; i < MaxProductAttributes; i++ {
+		require.NoError(t, product.SetAttribute(fmt.Sprintf("attr_%d", i), "x", now))
+	}
+	assert.ErrorIs(t, product.SetAttribute("one_more", "x", now), ErrTooManyProductAttributes)
+	require.NoError(t, product.SetAttribute("attr_0", "y", now), "existing attributes can still change")
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.SetAttribute("material", "oak", now), ErrProductArchived)
+}
+
+func TestProduct_ReplaceAttributes(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Table", "", "Furniture", NewMoney(20000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.SetAttribute("material", "oak", now))
+	require.NoError(t, product.SetAttribute("color", "brown", now))
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	require.NoError(t, product.ReplaceAttributes(map[string]string{"material": "oak", "color": " white ", "legs": "4", "finish": ""}, now))
+	assert.Equal(t, map[string]string{"material": "oak", "color": "white", "legs": "4"}, product.Attributes())
+	assert.True(t, product.Changes().Dirty(FieldAttributes))
+	var changed []string
+	for _, e := range product.DomainEvents() {
+		changed = append(changed, e.(ProductAttributeChangedEvent).Name)
+	}
+	assert.Equal(t, []string{"color", "legs"}, changed)
+
+	// Replacing with the same attributes is a no-op
+	product.ClearEvents()
+	product.Changes().Reset()
+	require.NoError(t, product.ReplaceAttributes(map[string]string{"material": "oak", "color": "white", "legs": "4"}, now))
+	assert.Empty(t, product.DomainEvents())
+	assert.False(t, product.Changes().HasChanges())
+
+	// Left out attributes are removed
+	require.NoError(t, product.ReplaceAttributes(nil, now))
+	assert.Empty(t, product.Attributes())
+	assert.Len(t, product.DomainEvents(), 3)
+
+	// Nothing changes if an attribute is invalid
+	product.ClearEvents()
+	assert.ErrorIs(t, product.ReplaceAttributes(map[string]string{"material": "oak", "Color": "red"}, now), ErrInvalidProductAttribute)
+	assert.Empty(t, product.Attributes())
+	assert.Empty(t, product.DomainEvents())
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.ReplaceAttributes(map[string]string{"material": "oak"}, now), ErrProductArchived)
+}
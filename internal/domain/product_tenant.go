@@ -0,0 +1,19 @@
+package domain
+
+// MaxTenantIDLength is the maximum length of a tenant ID.
+const MaxTenantIDLength = 100
+
+// TenantID returns the tenant that owns the product; empty for the default tenant.
+func (p *Product) TenantID() string {
+	return p.tenantID
+}
+
+// AssignTenant sets the tenant that owns a new product before it is first saved; empty
+// is the default tenant. A product never changes tenant, so it raises no event.
+func (p *Product) AssignTenant(tenantID string) error {
+	if len(tenantID) > MaxTenantIDLength {
+		return ErrInvalidTenantID
+	}
+	p.tenantID = tenantID
+	return nil
+}
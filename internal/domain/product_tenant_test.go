@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_AssignTenant(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	product, err := NewProduct("product-1", "Oak Chair", "A chair", "Furniture", NewMoney(4500, 100), now)
+	require.NoError(t, err)
+	assert.Empty(t, product.TenantID())
+
+	assert.ErrorIs(t, product.AssignTenant(strings.Repeat("a", MaxTenantIDLength+1)), ErrInvalidTenantID)
+	assert.Empty(t, product.TenantID())
+
+	require.NoError(t, product.AssignTenant("acme"))
+	assert.Equal(t, "acme", product.TenantID())
+	assert.Len(t, product.DomainEvents(), 1, "assigning the tenant raises no event")
+
+	clone, err := product.Clone("product-2", now)
+	require.NoError(t, err)
+	assert.Equal(t, "acme", clone.TenantID())
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 	"time"
@@ -22,7 +23,7 @@ func TestNewProduct_Valid(t *testing.T) {
 	assert.Equal(t, "Electronics", product.Category())
 	assert.Equal(t, ProductStatusDraft, product.Status())
 	assert.NotNil(t, product.BasePrice())
-	assert.Nil(t, product.Discount())
+	assert.Nil(t, product.FindDiscount("d1"))
 	assert.Len(t, product.DomainEvents(), 1)
 	assert.IsType(t, ProductCreatedEvent{}, product.DomainEvents()[0])
 }
@@ -135,6 +136,81 @@ func TestProduct_Deactivate(t *testing.T) {
 	assert.IsType(t, ProductDeactivatedEvent{}, product.DomainEvents()[0])
 }
 
+func TestProduct_Deactivate_SuspendsDiscount(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(10000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+	discount, err := NewDiscount(big.NewRat(20, 1), now, now.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(discount.WithID("d1"), now))
+	product.ClearEvents()
+
+	err = product.Deactivate(now.Add(time.Hour))
+
+	require.NoError(t, err)
+	require.NotNil(t, product.FindDiscount("d1"))
+	assert.True(t, product.FindDiscount("d1").IsSuspended())
+	assert.False(t, product.HasActiveDiscount(now.Add(time.Hour)))
+	assert.True(t, product.EffectivePrice(now.Add(time.Hour)).Equals(product.BasePrice()))
+	assert.True(t, product.Changes().Dirty(FieldDiscount))
+	require.Len(t, product.DomainEvents(), 2)
+	assert.IsType(t, DiscountSuspendedEvent{}, product.DomainEvents()[0])
+	assert.IsType(t, ProductDeactivatedEvent{}, product.DomainEvents()[1])
+}
+
+func TestProduct_Deactivate_ExpiredDiscountNotSuspended(t *testing.T) {
+	now := time.Now()
+	discount, err := NewDiscount(big.NewRat(20, 1), now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	product := ReconstructProduct("123", "Test", "", "Desc", "Cat", "", "", NewMoney(10000, 100), []*Discount{discount.WithID("d1")}, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", DefaultTaxClass, ProductStatusActive, nil, nil, nil, now, now, nil)
+
+	err = product.Deactivate(now)
+
+	require.NoError(t, err)
+	assert.False(t, product.FindDiscount("d1").IsSuspended())
+	assert.False(t, product.Changes().Dirty(FieldDiscount))
+	require.Len(t, product.DomainEvents(), 1)
+	assert.IsType(t, ProductDeactivatedEvent{}, product.DomainEvents()[0])
+}
+
+func TestProduct_Activate_SuspendedDiscount(t *testing.T) {
+	now := time.Now()
+	discount, err := NewDiscount(big.NewRat(20, 1), now.Add(-time.Hour), now.Add(24*time.Hour))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		activateAt    time.Time
+		expectedEvent DomainEvent
+		expectActive  bool
+	}{
+		{"resumed before end", now.Add(time.Hour), DiscountResumedEvent{}, true},
+		{"expired after end", now.Add(48 * time.Hour), DiscountExpiredEvent{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := ReconstructProduct("123", "Test", "", "Desc", "Cat", "", "", NewMoney(10000, 100), []*Discount{discount.WithID("d1").Suspend(now)}, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", DefaultTaxClass, ProductStatusInactive, nil, nil, nil, now, now, nil)
+
+			err := product.Activate(tt.activateAt)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectActive, product.HasActiveDiscount(tt.activateAt))
+			assert.False(t, product.FindDiscount("d1").IsSuspended())
+			assert.True(t, product.Changes().Dirty(FieldDiscount))
+			require.Len(t, product.DomainEvents(), 2)
+			assert.IsType(t, ProductActivatedEvent{}, product.DomainEvents()[0])
+			assert.IsType(t, tt.expectedEvent, product.DomainEvents()[1])
+			if !tt.expectActive {
+				assert.Nil(t, product.FindDiscount("d1"))
+			}
+		})
+	}
+}
+
 func TestProduct_Archive(t *testing.T) {
 	now := time.Now()
 	basePrice := NewMoney(1999, 100)
@@ -149,6 +225,28 @@ func TestProduct_Archive(t *testing.T) {
 	assert.NotNil(t, product.ArchivedAt())
 	assert.Len(t, product.DomainEvents(), 1)
 	assert.IsType(t, ProductArchivedEvent{}, product.DomainEvents()[0])
+	assert.False(t, product.Changes().Dirty(FieldDiscount))
+}
+
+func TestProduct_Archive_RemovesDiscount(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(10000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+	discount, err := NewDiscount(big.NewRat(20, 1), now, now.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(discount.WithID("d1"), now))
+	product.ClearEvents()
+
+	err = product.Archive(now.Add(time.Hour))
+
+	require.NoError(t, err)
+	assert.Nil(t, product.FindDiscount("d1"))
+	assert.False(t, product.HasActiveDiscount(now.Add(time.Hour)))
+	assert.True(t, product.Changes().Dirty(FieldDiscount))
+	require.Len(t, product.DomainEvents(), 2)
+	assert.IsType(t, DiscountRemovedEvent{}, product.DomainEvents()[0])
+	assert.IsType(t, ProductArchivedEvent{}, product.DomainEvents()[1])
 }
 
 func TestProduct_ApplyDiscount(t *testing.T) {
@@ -161,10 +259,10 @@ func TestProduct_ApplyDiscount(t *testing.T) {
 
 	discount, err := NewDiscount(big.NewRat(20, 1), now, now.Add(24*time.Hour))
 	require.NoError(t, err)
-	err = product.ApplyDiscount(discount, now)
+	err = product.ApplyDiscount(discount.WithID("d1"), now)
 
 	require.NoError(t, err)
-	assert.NotNil(t, product.Discount())
+	assert.NotNil(t, product.FindDiscount("d1"))
 	assert.True(t, product.HasActiveDiscount(now))
 	assert.Len(t, product.DomainEvents(), 1)
 	assert.IsType(t, DiscountAppliedEvent{}, product.DomainEvents()[0])
@@ -175,6 +273,261 @@ func TestProduct_ApplyDiscount(t *testing.T) {
 	assert.True(t, effectivePrice.Equals(expected))
 }
 
+func TestProduct_AdvanceDiscountPhase(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(10000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+
+	discount, err := NewDiscount(big.NewRat(20, 1), now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(discount.WithID("d1"), now))
+	assert.Equal(t, DiscountPhaseScheduled, product.FindDiscount("d1").Phase())
+	product.ClearEvents()
+
+	// Nothing to announce before the period starts
+	assert.False(t, product.AdvanceDiscountPhase(now.Add(30*time.Minute)))
+	assert.Empty(t, product.DomainEvents())
+
+	assert.True(t, product.AdvanceDiscountPhase(now.Add(time.Hour)))
+	assert.Equal(t, DiscountPhaseStarted, product.FindDiscount("d1").Phase())
+	assert.True(t, product.Changes().Dirty(FieldDiscountPhase))
+	require.Len(t, product.DomainEvents(), 1)
+	started, ok := product.DomainEvents()[0].(DiscountStartedEvent)
+	require.True(t, ok)
+	assert.Equal(t, 0, started.DiscountPercentage.Cmp(big.NewRat(20, 1)))
+	assert.Equal(t, now.Add(time.Hour), started.StartDate)
+
+	// Each boundary is announced once
+	assert.False(t, product.AdvanceDiscountPhase(now.Add(90*time.Minute)))
+
+	assert.True(t, product.AdvanceDiscountPhase(now.Add(2*time.Hour)))
+	assert.Equal(t, DiscountPhaseEnded, product.FindDiscount("d1").Phase())
+	require.Len(t, product.DomainEvents(), 2)
+	assert.IsType(t, DiscountEndedEvent{}, product.DomainEvents()[1])
+	assert.False(t, product.AdvanceDiscountPhase(now.Add(3*time.Hour)))
+}
+
+func TestProduct_AdvanceDiscountPhase_Skipped(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	newProduct := func(start time.Time) *Product {
+		product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(10000, 100), now)
+		require.NoError(t, err)
+		require.NoError(t, product.Activate(now))
+		discount, err := NewDiscount(big.NewRat(20, 1), start, now.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, product.ApplyDiscount(discount.WithID("d1"), now))
+		product.ClearEvents()
+		return product
+	}
+
+	// A discount in effect when applied is announced by the applied event
+	immediate := newProduct(now)
+	assert.Equal(t, DiscountPhaseStarted, immediate.FindDiscount("d1").Phase())
+	assert.False(t, immediate.AdvanceDiscountPhase(now.Add(time.Hour)))
+
+	// A period that passed unannounced only raises the ended event
+	missed := newProduct(now.Add(time.Hour))
+	assert.True(t, missed.AdvanceDiscountPhase(now.Add(3*time.Hour)))
+	require.Len(t, missed.DomainEvents(), 1)
+	assert.IsType(t, DiscountEndedEvent{}, missed.DomainEvents()[0])
+
+	// Suspended discounts do not change the price
+	suspended := newProduct(now.Add(time.Hour))
+	require.NoError(t, suspended.Deactivate(now))
+	suspended.ClearEvents()
+	assert.False(t, suspended.AdvanceDiscountPhase(now.Add(time.Hour)))
+	assert.Empty(t, suspended.DomainEvents())
+
+	// Resuming after the start announces the discount with the resumed event
+	require.NoError(t, suspended.Activate(now.Add(90*time.Minute)))
+	assert.Equal(t, DiscountPhaseStarted, suspended.FindDiscount("d1").Phase())
+	assert.False(t, suspended.AdvanceDiscountPhase(now.Add(90*time.Minute)))
+}
+
+func TestProduct_AdvanceDiscountPhase_FlashSale(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(10000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+	product.ClearEvents()
+
+	discount, err := NewDiscount(big.NewRat(50, 1), now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(discount.WithID("flash").AsFlashSale(), now))
+	assert.True(t, product.FindDiscount("flash").IsFlashSale())
+	require.Len(t, product.DomainEvents(), 1)
+	assert.True(t, product.DomainEvents()[0].(DiscountAppliedEvent).FlashSale)
+
+	require.True(t, product.AdvanceDiscountPhase(now.Add(time.Hour)))
+	require.True(t, product.AdvanceDiscountPhase(now.Add(2*time.Hour)))
+	require.Len(t, product.DomainEvents(), 3)
+	assert.True(t, product.DomainEvents()[1].(DiscountStartedEvent).FlashSale)
+	assert.True(t, product.DomainEvents()[2].(DiscountEndedEvent).FlashSale)
+
+	assert.False(t, discount.IsFlashSale())
+	assert.False(t, discount.Equals(discount.AsFlashSale()))
+}
+
+func TestProduct_ApplyDiscount_Multiple(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	newDiscount := func(id string, pct int64, priority int, start, end time.Time) *Discount {
+		d, err := NewDiscount(big.NewRat(pct, 1), start, end)
+		require.NoError(t, err)
+		return d.WithID(id).WithPriority(priority)
+	}
+	newProduct := func() *Product {
+		product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(10000, 100), now)
+		require.NoError(t, err)
+		require.NoError(t, product.Activate(now))
+		require.NoError(t, product.ApplyDiscount(newDiscount("base", 10, 0, now, now.Add(72*time.Hour)), now))
+		product.ClearEvents()
+		return product
+	}
+
+	tests := []struct {
+		name     string
+		discount *Discount
+		wantErr  error
+	}{
+		{"later period", newDiscount("next", 20, 0, now.Add(72*time.Hour), now.Add(96*time.Hour)), nil},
+		{"higher priority overlap", newDiscount("flash", 30, 5, now.Add(time.Hour), now.Add(2*time.Hour)), nil},
+		{"same priority overlap", newDiscount("other", 20, 0, now.Add(time.Hour), now.Add(2*time.Hour)), ErrDiscountOverlap},
+		{"duplicate ID", newDiscount("base", 20, 0, now.Add(72*time.Hour), now.Add(96*time.Hour)), ErrDiscountAlreadyExists},
+		{"missing ID", newDiscount("", 20, 1, now, now.Add(time.Hour)), ErrInvalidID},
+		{"negative priority", newDiscount("neg", 20, -1, now, now.Add(time.Hour)), ErrInvalidDiscountPriority},
+		{"priority too high", newDiscount("high", 20, MaxDiscountPriority+1, now, now.Add(time.Hour)), ErrInvalidDiscountPriority},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := newProduct()
+
+			err := product.ApplyDiscount(tt.discount, now)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Len(t, product.Discounts(), 1)
+				assert.Empty(t, product.DomainEvents())
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, product.Discounts(), 2)
+			require.Len(t, product.DomainEvents(), 1)
+			applied, ok := product.DomainEvents()[0].(DiscountAppliedEvent)
+			require.True(t, ok)
+			assert.Equal(t, tt.discount.ID(), applied.DiscountID)
+			assert.Equal(t, tt.discount.Priority(), applied.Priority)
+		})
+	}
+}
+
+func TestProduct_ApplyDiscount_Precedence(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(10000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+
+	season, err := NewDiscount(big.NewRat(10, 1), now, now.Add(72*time.Hour))
+	require.NoError(t, err)
+	flash, err := NewDiscount(big.NewRat(30, 1), now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(season.WithID("season"), now))
+	require.NoError(t, product.ApplyDiscount(flash.WithID("flash").WithPriority(5), now))
+
+	assert.True(t, product.EffectivePrice(now).Equals(NewMoney(9000, 100)))
+	assert.True(t, product.EffectivePrice(now.Add(90*time.Minute)).Equals(NewMoney(7000, 100)))
+	assert.True(t, product.EffectivePrice(now.Add(2*time.Hour)).Equals(NewMoney(9000, 100)))
+	assert.Equal(t, "flash", product.ApplicableDiscount(now.Add(90*time.Minute)).ID())
+}
+
+func TestProduct_ApplyDiscount_Promotion(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(10000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+
+	season, err := NewDiscount(big.NewRat(10, 1), now, now.Add(72*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(season.WithID("season"), now))
+
+	rule, err := NewBuyXGetY(2, 1)
+	require.NoError(t, err)
+	promotion, err := NewBuyXGetYDiscount(rule, now, now.Add(24*time.Hour))
+	require.NoError(t, err)
+
+	// A promotion may overlap a percentage discount of the same priority, but not
+	// another promotion
+	product.ClearEvents()
+	require.NoError(t, product.ApplyDiscount(promotion.WithID("b2g1"), now))
+	assert.ErrorIs(t, product.ApplyDiscount(promotion.WithID("b3g1"), now), ErrDiscountOverlap)
+
+	require.Len(t, product.DomainEvents(), 1)
+	applied, ok := product.DomainEvents()[0].(DiscountAppliedEvent)
+	require.True(t, ok)
+	assert.Equal(t, rule, applied.Promotion)
+
+	assert.True(t, product.EffectivePrice(now).Equals(NewMoney(9000, 100)))
+	assert.Equal(t, "season", product.ApplicableDiscount(now).ID())
+	assert.Equal(t, "b2g1", product.ApplicablePromotion(now).ID())
+	assert.Nil(t, product.ApplicablePromotion(now.Add(24*time.Hour)))
+}
+
+func TestProduct_ApplyDiscount_Limit(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(10000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+
+	apply := func(i int, at time.Time) error {
+		start := now.Add(time.Duration(i) * time.Hour)
+		d, err := NewDiscount(big.NewRat(10, 1), start, start.Add(time.Hour))
+		require.NoError(t, err)
+		return product.ApplyDiscount(d.WithID(fmt.Sprintf("d%d", i)), at)
+	}
+
+	for i := 0; i < MaxDiscountsPerProduct; i++ {
+		require.NoError(t, apply(i, now))
+	}
+	assert.ErrorIs(t, apply(MaxDiscountsPerProduct, now), ErrTooManyDiscounts)
+
+	// Expired discounts still count until their end has been announced.
+	later := now.Add(time.Duration(MaxDiscountsPerProduct) * time.Hour)
+	assert.ErrorIs(t, apply(MaxDiscountsPerProduct, later), ErrTooManyDiscounts)
+	require.True(t, product.AdvanceDiscountPhase(later))
+	require.NoError(t, apply(MaxDiscountsPerProduct, later))
+	assert.Len(t, product.Discounts(), 1)
+}
+
+func TestProduct_RemoveDiscount_ByID(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(10000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+	for i, id := range []string{"first", "second"} {
+		start := now.Add(time.Duration(i) * time.Hour)
+		d, err := NewDiscount(big.NewRat(10, 1), start, start.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, product.ApplyDiscount(d.WithID(id), now))
+	}
+	product.ClearEvents()
+
+	assert.ErrorIs(t, product.RemoveDiscount("missing", now), ErrDiscountNotFound)
+	assert.Empty(t, product.DomainEvents())
+
+	require.NoError(t, product.RemoveDiscount("first", now))
+	require.Len(t, product.Discounts(), 1)
+	assert.Equal(t, "second", product.Discounts()[0].ID())
+	require.Len(t, product.DomainEvents(), 1)
+	assert.Equal(t, "first", product.DomainEvents()[0].(DiscountRemovedEvent).DiscountID)
+
+	require.NoError(t, product.RemoveDiscount("", now))
+	assert.Empty(t, product.Discounts())
+	require.Len(t, product.DomainEvents(), 2)
+	assert.Equal(t, "second", product.DomainEvents()[1].(DiscountRemovedEvent).DiscountID)
+}
+
 func TestProduct_ApplyDiscount_NotActive(t *testing.T) {
 	now := time.Now()
 	basePrice := NewMoney(10000, 100)
@@ -183,7 +536,7 @@ func TestProduct_ApplyDiscount_NotActive(t *testing.T) {
 
 	discount, err := NewDiscount(big.NewRat(20, 1), now, now.Add(24*time.Hour))
 	require.NoError(t, err)
-	err = product.ApplyDiscount(discount, now)
+	err = product.ApplyDiscount(discount.WithID("d1"), now)
 
 	assert.ErrorIs(t, err, ErrProductNotActive)
 }
@@ -196,13 +549,13 @@ func TestProduct_RemoveDiscount(t *testing.T) {
 	require.NoError(t, product.Activate(now))
 	discount, err := NewDiscount(big.NewRat(20, 1), now, now.Add(24*time.Hour))
 	require.NoError(t, err)
-	require.NoError(t, product.ApplyDiscount(discount, now))
+	require.NoError(t, product.ApplyDiscount(discount.WithID("d1"), now))
 	product.ClearEvents()
 
-	err = product.RemoveDiscount(now.Add(time.Hour))
+	err = product.RemoveDiscount("", now.Add(time.Hour))
 
 	require.NoError(t, err)
-	assert.Nil(t, product.Discount())
+	assert.Nil(t, product.FindDiscount("d1"))
 	assert.False(t, product.HasActiveDiscount(now))
 	assert.Len(t, product.DomainEvents(), 1)
 	assert.IsType(t, DiscountRemovedEvent{}, product.DomainEvents()[0])
@@ -214,11 +567,87 @@ func TestProduct_RemoveDiscount_NoDiscount(t *testing.T) {
 	product, err := NewProduct("123", "Test", "Desc", "Cat", basePrice, now)
 	require.NoError(t, err)
 
-	err = product.RemoveDiscount(now)
+	err = product.RemoveDiscount("", now)
 
 	assert.ErrorIs(t, err, ErrNoDiscountToRemove)
 }
 
+func TestProduct_ChangeBasePrice(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(1999, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	err = product.ChangeBasePrice(NewMoney(2499, 100), now.Add(time.Hour))
+
+	require.NoError(t, err)
+	assert.True(t, product.BasePrice().Equals(NewMoney(2499, 100)))
+	assert.Equal(t, now.Add(time.Hour), product.UpdatedAt())
+	assert.True(t, product.Changes().Dirty(FieldBasePrice))
+	require.Len(t, product.DomainEvents(), 1)
+	event, ok := product.DomainEvents()[0].(ProductPriceChangedEvent)
+	require.True(t, ok)
+	assert.True(t, event.OldPrice.Equals(NewMoney(1999, 100)))
+	assert.True(t, event.NewPrice.Equals(NewMoney(2499, 100)))
+}
+
+func mustMoneyInCurrency(t *testing.T, num, denom int64, currency string) *Money {
+	t.Helper()
+	m, err := NewMoneyInCurrency(num, denom, currency)
+	require.NoError(t, err)
+	return m
+}
+
+func TestProduct_ChangeBasePrice_Invalid(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		archive bool
+		price   *Money
+		wantErr error
+	}{
+		{"nil price", false, nil, ErrInvalidBasePrice},
+		{"zero price", false, NewMoney(0, 100), ErrInvalidBasePrice},
+		{"negative price", false, NewMoney(-100, 100), ErrInvalidBasePrice},
+		{"archived product", true, NewMoney(2499, 100), ErrProductArchived},
+		{"other currency", false, mustMoneyInCurrency(t, 2499, 100, "EUR"), ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(1999, 100), now)
+			require.NoError(t, err)
+			if tt.archive {
+				require.NoError(t, product.Archive(now))
+			}
+			product.ClearEvents()
+
+			err = product.ChangeBasePrice(tt.price, now)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.True(t, product.BasePrice().Equals(NewMoney(1999, 100)))
+			assert.Empty(t, product.DomainEvents())
+		})
+	}
+}
+
+func TestProduct_ChangeBasePrice_SamePrice(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Test", "Desc", "Cat", NewMoney(1999, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	err = product.ChangeBasePrice(NewMoney(3998, 200), now.Add(time.Hour))
+
+	require.NoError(t, err)
+	assert.Equal(t, now, product.UpdatedAt())
+	assert.False(t, product.Changes().HasChanges())
+	assert.Empty(t, product.DomainEvents())
+}
+
 func TestProduct_Update(t *testing.T) {
 	now := time.Now()
 	basePrice := NewMoney(1999, 100)
@@ -271,7 +700,7 @@ func TestProduct_EffectivePrice_WithExpiredDiscount(t *testing.T) {
 
 	discount, err := NewDiscount(big.NewRat(20, 1), now.Add(-48*time.Hour), now.Add(-24*time.Hour))
 	require.NoError(t, err)
-	require.NoError(t, product.ApplyDiscount(discount, now.Add(-48*time.Hour)))
+	require.NoError(t, product.ApplyDiscount(discount.WithID("d1"), now.Add(-48*time.Hour)))
 
 	// Check effective price at current time (discount expired)
 	effectivePrice := product.EffectivePrice(now)
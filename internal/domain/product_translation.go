@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultLocale is the locale of the name and description of a product itself. A
+// product has translations of them in other locales.
+const DefaultLocale = "en"
+
+// MaxTranslatedNameLength is the maximum length, in characters, of the name of a product
+// in a locale.
+const MaxTranslatedNameLength = 255
+
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-([A-Z]{2}|[0-9]{3}))?$`)
+
+// ParseLocale validates a locale: a BCP 47 language tag with a two or three letter
+// language and an optional region, e.g. "de" or "pt-BR". Case and underscores are
+// normalized, so "pt_br" is "pt-BR".
+func ParseLocale(s string) (string, error) {
+	language, region, hasRegion := strings.Cut(strings.ReplaceAll(strings.TrimSpace(s), "_", "-"), "-")
+	locale := strings.ToLower(language)
+	if hasRegion {
+		locale += "-" + strings.ToUpper(region)
+	}
+	if !localePattern.MatchString(locale) {
+		return "", ErrInvalidLocale
+	}
+	return locale, nil
+}
+
+// LocaleLanguage returns the language of a valid locale, e.g. "pt" for "pt-BR".
+func LocaleLanguage(locale string) string {
+	language, _, _ := strings.Cut(locale, "-")
+	return language
+}
+
+// ProductTranslation is the name and description of a product in a locale other than
+// DefaultLocale.
+type ProductTranslation struct {
+	productID   string
+	locale      string
+	name        string
+	description string
+	updatedAt   time.Time
+}
+
+// NewProductTranslation creates a new translation of the name and description of a
+// product, validating its locale and name. The description may be empty.
+func NewProductTranslation(productID, locale, name, description string, now time.Time) (*ProductTranslation, error) {
+	if productID == "" {
+		return nil, ErrInvalidID
+	}
+	locale, err := ParseLocale(locale)
+	if err != nil {
+		return nil, err
+	}
+	if locale == DefaultLocale {
+		return nil, ErrDefaultLocaleTranslation
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxTranslatedNameLength {
+		return nil, ErrInvalidTranslatedName
+	}
+	return &ProductTranslation{
+		productID:   productID,
+		locale:      locale,
+		name:        name,
+		description: strings.TrimSpace(description),
+		updatedAt:   now,
+	}, nil
+}
+
+// ProductID returns the product the translation is of.
+func (t *ProductTranslation) ProductID() string { return t.productID }
+
+// Locale returns the locale of the translation.
+func (t *ProductTranslation) Locale() string { return t.locale }
+
+// Name returns the name of the product in the locale.
+func (t *ProductTranslation) Name() string { return t.name }
+
+// Description returns the description of the product in the locale; empty if it has none.
+func (t *ProductTranslation) Description() string { return t.description }
+
+// UpdatedAt returns when the translation was last set.
+func (t *ProductTranslation) UpdatedAt() time.Time { return t.updatedAt }
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"de", "de"},
+		{"pt-BR", "pt-BR"},
+		{"pt_br", "pt-BR"},
+		{" FR-fr ", "fr-FR"},
+		{"es-419", "es-419"},
+		{"fil", "fil"},
+	}
+	for _, tt := range tests {
+		locale, err := ParseLocale(tt.input)
+		require.NoError(t, err, "locale %q", tt.input)
+		assert.Equal(t, tt.want, locale)
+	}
+
+	for _, invalid := range []string{"", "d", "deutsch", "de-", "de-DEU", "zh-Hant-TW", "de DE"} {
+		_, err := ParseLocale(invalid)
+		assert.ErrorIs(t, err, ErrInvalidLocale, "locale %q", invalid)
+	}
+
+	assert.Equal(t, "pt", LocaleLanguage("pt-BR"))
+	assert.Equal(t, "de", LocaleLanguage("de"))
+}
+
+func TestNewProductTranslation(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	translation, err := NewProductTranslation("product-1", "de_de", " Stuhl ", " Aus Massivholz ", now)
+	require.NoError(t, err)
+	assert.Equal(t, "product-1", translation.ProductID())
+	assert.Equal(t, "de-DE", translation.Locale())
+	assert.Equal(t, "Stuhl", translation.Name())
+	assert.Equal(t, "Aus Massivholz", translation.Description())
+	assert.Equal(t, now, translation.UpdatedAt())
+
+	_, err = NewProductTranslation("product-1", "fr", "Chaise", "", now)
+	assert.NoError(t, err)
+	_, err = NewProductTranslation("product-1", "fr", strings.Repeat("é", MaxTranslatedNameLength), "", now)
+	assert.NoError(t, err)
+
+	_, err = NewProductTranslation("", "fr", "Chaise", "", now)
+	assert.ErrorIs(t, err, ErrInvalidID)
+	_, err = NewProductTranslation("product-1", "french", "Chaise", "", now)
+	assert.ErrorIs(t, err, ErrInvalidLocale)
+	_, err = NewProductTranslation("product-1", "EN", "Chair", "", now)
+	assert.ErrorIs(t, err, ErrDefaultLocaleTranslation)
+	_, err = NewProductTranslation("product-1", "fr", " ", "Une chaise", now)
+	assert.ErrorIs(t, err, ErrInvalidTranslatedName)
+	_, err = NewProductTranslation("product-1", "fr", strings.Repeat("é", MaxTranslatedNameLength+1), "", now)
+	assert.ErrorIs(t, err, ErrInvalidTranslatedName)
+}
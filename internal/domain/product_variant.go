@@ -0,0 +1,263 @@
+package domain
+
+import (
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxSKULength is the maximum length of a product or variant SKU.
+	MaxSKULength = 64
+	// MaxVariantAttributes is the maximum number of attributes of a variant.
+	MaxVariantAttributes = 10
+	// MaxVariantAttributeNameLength is the maximum length of a variant attribute name.
+	MaxVariantAttributeNameLength = 50
+	// MaxVariantAttributeValueLength is the maximum length of a variant attribute value.
+	MaxVariantAttributeValueLength = 100
+)
+
+var (
+	skuPattern                  = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_-]*$`)
+	variantAttributeNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_]*$`)
+)
+
+// VariantStatus represents the lifecycle status of a product variant.
+type VariantStatus string
+
+const (
+	VariantStatusActive       VariantStatus = "active"
+	VariantStatusDiscontinued VariantStatus = "discontinued"
+)
+
+// String returns the status name.
+func (s VariantStatus) String() string { return string(s) }
+
+// ProductVariant is the aggregate root of one sellable version of a product, e.g. a size
+// and color of a shirt, identified by its SKU. A variant is priced at the base price of its
+// product plus its price delta, which is in the product's currency and may be negative.
+// Discontinued variants are kept for reference but can no longer be changed.
+type ProductVariant struct {
+	id         string
+	productID  string
+	sku        string
+	attributes map[string]string
+	priceDelta *Money
+	status     VariantStatus
+	createdAt  time.Time
+	updatedAt  time.Time
+	events     []DomainEvent
+}
+
+// ParseSKU validates a SKU: uppercase letters, digits, hyphens and underscores, at most
+// MaxSKULength characters. Lowercase letters are upper-cased.
+func ParseSKU(sku string) (string, error) {
+	sku = strings.ToUpper(strings.TrimSpace(sku))
+	if len(sku) > MaxSKULength || !skuPattern.MatchString(sku) {
+		return "", ErrInvalidSKU
+	}
+	return sku, nil
+}
+
+// ParseVariantAttributes validates the attributes of a variant, e.g. {"size": "M"}: at
+// most MaxVariantAttributes, named with lowercase letters, digits and underscores, with
+// non-empty values. Surrounding spaces are trimmed. It returns a copy.
+func ParseVariantAttributes(attributes map[string]string) (map[string]string, error) {
+	if len(attributes) > MaxVariantAttributes {
+		return nil, ErrInvalidVariantAttributes
+	}
+	parsed := make(map[string]string, len(attributes))
+	for name, value := range attributes {
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if len(name) > MaxVariantAttributeNameLength || !variantAttributeNamePattern.MatchString(name) {
+			return nil, ErrInvalidVariantAttributes
+		}
+		if value == "" || len(value) > MaxVariantAttributeValueLength {
+			return nil, ErrInvalidVariantAttributes
+		}
+		if _, ok := parsed[name]; ok {
+			return nil, ErrInvalidVariantAttributes
+		}
+		parsed[name] = value
+	}
+	return parsed, nil
+}
+
+// NewProductVariant creates an active variant of a product that is not archived. The
+// price delta must be in the product's currency and keep the variant price positive.
+func NewProductVariant(id string, product *Product, sku string, attributes map[string]string, priceDelta *Money, now time.Time) (*ProductVariant, error) {
+	if strings.TrimSpace(id) == "" {
+		return nil, ErrInvalidID
+	}
+	if product.Status() == ProductStatusArchived {
+		return nil, ErrProductArchived
+	}
+	sku, err := ParseSKU(sku)
+	if err != nil {
+		return nil, err
+	}
+	attributes, err = ParseVariantAttributes(attributes)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVariantPrice(product, priceDelta); err != nil {
+		return nil, err
+	}
+
+	v := &ProductVariant{
+		id:         id,
+		productID:  product.ID(),
+		sku:        sku,
+		attributes: attributes,
+		priceDelta: priceDelta,
+		status:     VariantStatusActive,
+		createdAt:  now,
+		updatedAt:  now,
+	}
+	v.events = append(v.events, NewVariantAddedEvent(v, now))
+	return v, nil
+}
+
+// ReconstructProductVariant reconstructs a ProductVariant from persistence.
+func ReconstructProductVariant(id, productID, sku string, attributes map[string]string, priceDelta *Money,
+	status VariantStatus, createdAt, updatedAt time.Time) *ProductVariant {
+	return &ProductVariant{
+		id:         id,
+		productID:  productID,
+		sku:        sku,
+		attributes: attributes,
+		priceDelta: priceDelta,
+		status:     status,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
+	}
+}
+
+// ID returns the variant identifier.
+func (v *ProductVariant) ID() string { return v.id }
+
+// ProductID returns the product the variant belongs to.
+func (v *ProductVariant) ProductID() string { return v.productID }
+
+// SKU returns the stock keeping unit of the variant, unique across the catalog.
+func (v *ProductVariant) SKU() string { return v.sku }
+
+// Attributes returns a copy of the attributes of the variant, e.g. {"size": "M"}.
+func (v *ProductVariant) Attributes() map[string]string {
+	attributes := make(map[string]string, len(v.attributes))
+	for name, value := range v.attributes {
+		attributes[name] = value
+	}
+	return attributes
+}
+
+// AttributeNames returns the names of the attributes of the variant in order.
+func (v *ProductVariant) AttributeNames() []string {
+	names := make([]string, 0, len(v.attributes))
+	for name := range v.attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PriceDelta returns the amount added to the base price of the product.
+func (v *ProductVariant) PriceDelta() *Money { return v.priceDelta }
+
+// Status returns the variant status.
+func (v *ProductVariant) Status() VariantStatus { return v.status }
+
+// CreatedAt returns the creation timestamp.
+func (v *ProductVariant) CreatedAt() time.Time { return v.createdAt }
+
+// UpdatedAt returns the last update timestamp.
+func (v *ProductVariant) UpdatedAt() time.Time { return v.updatedAt }
+
+// DomainEvents returns the events raised since the variant was created or loaded.
+func (v *ProductVariant) DomainEvents() []DomainEvent { return v.events }
+
+// Price returns the price of the variant for a base price of its product. The base price
+// may have been lowered since the delta was set; the price is then never below zero.
+func (v *ProductVariant) Price(basePrice *Money) (*Money, error) {
+	price, err := basePrice.Add(v.priceDelta)
+	if err != nil {
+		return nil, err
+	}
+	if price.IsNegative() {
+		return basePrice.withAmount(new(big.Rat)), nil
+	}
+	return price, nil
+}
+
+// Update replaces the SKU, attributes and price delta of an active variant. Setting the
+// current values again is a no-op and raises no event.
+func (v *ProductVariant) Update(product *Product, sku string, attributes map[string]string, priceDelta *Money, now time.Time) error {
+	if v.status == VariantStatusDiscontinued {
+		return ErrVariantDiscontinued
+	}
+	if product.Status() == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	sku, err := ParseSKU(sku)
+	if err != nil {
+		return err
+	}
+	attributes, err = ParseVariantAttributes(attributes)
+	if err != nil {
+		return err
+	}
+	if err := checkVariantPrice(product, priceDelta); err != nil {
+		return err
+	}
+	if sku == v.sku && attributesEqual(attributes, v.attributes) && priceDelta.Equals(v.priceDelta) {
+		return nil
+	}
+
+	v.sku = sku
+	v.attributes = attributes
+	v.priceDelta = priceDelta
+	v.updatedAt = now
+	v.events = append(v.events, NewVariantUpdatedEvent(v, now))
+	return nil
+}
+
+// Discontinue takes the variant out of sale for good.
+func (v *ProductVariant) Discontinue(now time.Time) error {
+	if v.status == VariantStatusDiscontinued {
+		return ErrVariantDiscontinued
+	}
+	v.status = VariantStatusDiscontinued
+	v.updatedAt = now
+	v.events = append(v.events, NewVariantDiscontinuedEvent(v.id, v.productID, v.sku, now))
+	return nil
+}
+
+// checkVariantPrice checks that priceDelta is in the currency of the product and keeps
+// the variant price positive.
+func checkVariantPrice(product *Product, priceDelta *Money) error {
+	if priceDelta == nil {
+		return ErrInvalidVariantPrice
+	}
+	price, err := product.BasePrice().Add(priceDelta)
+	if err != nil {
+		return err
+	}
+	if !price.IsPositive() {
+		return ErrInvalidVariantPrice
+	}
+	return nil
+}
+
+func attributesEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, value := range a {
+		if other, ok := b[name]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
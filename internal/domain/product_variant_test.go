@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProductVariant(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	product, err := NewProduct("p1", "Shirt", "", "Apparel", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	eur, err := NewMoneyInCurrency(100, 100, "EUR")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		id         string
+		sku        string
+		attributes map[string]string
+		delta      *Money
+		wantErr    error
+	}{
+		{"with attributes", "v1", "shirt-m-blue", map[string]string{"size": " M ", "color": "blue"}, NewMoney(0, 1), nil},
+		{"cheaper", "v1", "SHIRT-S", nil, NewMoney(-500, 100), nil},
+		{"missing id", "", "SHIRT-S", nil, NewMoney(0, 1), ErrInvalidID},
+		{"missing sku", "v1", " ", nil, NewMoney(0, 1), ErrInvalidSKU},
+		{"invalid sku", "v1", "SHIRT S", nil, NewMoney(0, 1), ErrInvalidSKU},
+		{"long sku", "v1", strings.Repeat("S", MaxSKULength+1), nil, NewMoney(0, 1), ErrInvalidSKU},
+		{"invalid attribute name", "v1", "SHIRT-S", map[string]string{"Size": "S"}, NewMoney(0, 1), ErrInvalidVariantAttributes},
+		{"empty attribute value", "v1", "SHIRT-S", map[string]string{"size": " "}, NewMoney(0, 1), ErrInvalidVariantAttributes},
+		{"missing delta", "v1", "SHIRT-S", nil, nil, ErrInvalidVariantPrice},
+		{"free variant", "v1", "SHIRT-S", nil, NewMoney(-2000, 100), ErrInvalidVariantPrice},
+		{"other currency", "v1", "SHIRT-S", nil, eur, ErrCurrencyMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewProductVariant(tt.id, product, tt.sku, tt.attributes, tt.delta, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "p1", v.ProductID())
+			assert.Equal(t, strings.ToUpper(tt.sku), v.SKU())
+			assert.Equal(t, VariantStatusActive, v.Status())
+			require.Len(t, v.DomainEvents(), 1)
+			assert.Equal(t, "product_variant.added", v.DomainEvents()[0].EventType())
+		})
+	}
+
+	t.Run("trims attributes", func(t *testing.T) {
+		v, err := NewProductVariant("v1", product, "SHIRT-M", map[string]string{" size ": " M "}, NewMoney(0, 1), now)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"size": "M"}, v.Attributes())
+	})
+
+	t.Run("archived product", func(t *testing.T) {
+		archived, err := NewProduct("p2", "Shirt", "", "Apparel", NewMoney(2000, 100), now)
+		require.NoError(t, err)
+		require.NoError(t, archived.Archive(now))
+		_, err = NewProductVariant("v1", archived, "SHIRT-M", nil, NewMoney(0, 1), now)
+		assert.ErrorIs(t, err, ErrProductArchived)
+	})
+}
+
+func TestProductVariant_Update(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	product, err := NewProduct("p1", "Shirt", "", "Apparel", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	v, err := NewProductVariant("v1", product, "SHIRT-M", map[string]string{"size": "M"}, NewMoney(0, 1), now)
+	require.NoError(t, err)
+
+	// The current values again are a no-op
+	require.NoError(t, v.Update(product, "shirt-m", map[string]string{"size": "M"}, NewMoney(0, 1), later))
+	assert.Len(t, v.DomainEvents(), 1)
+	assert.Equal(t, now, v.UpdatedAt())
+
+	require.NoError(t, v.Update(product, "SHIRT-XL", map[string]string{"size": "XL"}, NewMoney(300, 100), later))
+	assert.Equal(t, "SHIRT-XL", v.SKU())
+	assert.Equal(t, map[string]string{"size": "XL"}, v.Attributes())
+	assert.Equal(t, later, v.UpdatedAt())
+	require.Len(t, v.DomainEvents(), 2)
+	updated, ok := v.DomainEvents()[1].(VariantUpdatedEvent)
+	require.True(t, ok)
+	assert.Equal(t, "SHIRT-XL", updated.SKU)
+
+	price, err := v.Price(product.BasePrice())
+	require.NoError(t, err)
+	assert.Equal(t, "23.00", price.Amount().FloatString(2))
+
+	assert.ErrorIs(t, v.Update(product, "SHIRT-XL", nil, NewMoney(-2500, 100), later), ErrInvalidVariantPrice)
+}
+
+func TestProductVariant_Discontinue(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	product, err := NewProduct("p1", "Shirt", "", "Apparel", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	v, err := NewProductVariant("v1", product, "SHIRT-M", nil, NewMoney(0, 1), now)
+	require.NoError(t, err)
+
+	require.NoError(t, v.Discontinue(now))
+	assert.Equal(t, VariantStatusDiscontinued, v.Status())
+	require.Len(t, v.DomainEvents(), 2)
+	assert.Equal(t, "product_variant.discontinued", v.DomainEvents()[1].EventType())
+
+	assert.ErrorIs(t, v.Discontinue(now), ErrVariantDiscontinued)
+	assert.ErrorIs(t, v.Update(product, "SHIRT-L", nil, NewMoney(0, 1), now), ErrVariantDiscontinued)
+}
+
+func TestProductVariant_Price(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	product, err := NewProduct("p1", "Shirt", "", "Apparel", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	v, err := NewProductVariant("v1", product, "SHIRT-S", nil, NewMoney(-500, 100), now)
+	require.NoError(t, err)
+
+	price, err := v.Price(NewMoney(1000, 100))
+	require.NoError(t, err)
+	assert.Equal(t, "5.00", price.Amount().FloatString(2))
+
+	// The base price dropped below the delta since
+	price, err = v.Price(NewMoney(400, 100))
+	require.NoError(t, err)
+	assert.True(t, price.IsZero())
+	assert.Equal(t, "USD", price.Currency())
+}
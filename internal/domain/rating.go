@@ -0,0 +1,65 @@
+package domain
+
+import "strings"
+
+// Customer ratings range from MinRating to MaxRating stars.
+const (
+	MinRating = 1
+	MaxRating = 5
+)
+
+// MaxReviewIDLength is the maximum length of the ID of a customer review.
+const MaxReviewIDLength = 100
+
+// ValidateRating checks a customer review of a product: the ID the reviews service gave it
+// and its star rating.
+func ValidateRating(reviewID string, rating int64) error {
+	if strings.TrimSpace(reviewID) == "" || len(reviewID) > MaxReviewIDLength {
+		return ErrInvalidReviewID
+	}
+	if rating < MinRating || rating > MaxRating {
+		return ErrInvalidRating
+	}
+	return nil
+}
+
+// RatingSummary summarizes the customer ratings of a product: how many there are and
+// their sum, from which the average is derived.
+type RatingSummary struct {
+	count int64
+	sum   int64
+}
+
+// NewRatingSummary creates a summary of count ratings adding up to sum.
+func NewRatingSummary(count, sum int64) RatingSummary {
+	return RatingSummary{count: count, sum: sum}
+}
+
+// Count returns the number of ratings.
+func (s RatingSummary) Count() int64 {
+	return s.count
+}
+
+// Sum returns the sum of the ratings.
+func (s RatingSummary) Sum() int64 {
+	return s.sum
+}
+
+// Average returns the average rating; 0 if there are no ratings.
+func (s RatingSummary) Average() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	return float64(s.sum) / float64(s.count)
+}
+
+// Rate returns the summary after a review rates the product. previous is the rating the
+// review gave before, or 0 if the review is new; a review that changes its rating is
+// counted once.
+func (s RatingSummary) Rate(previous, rating int64) RatingSummary {
+	if previous == 0 {
+		s.count++
+	}
+	s.sum += rating - previous
+	return s
+}
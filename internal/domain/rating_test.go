@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRating(t *testing.T) {
+	assert.NoError(t, ValidateRating("review-1", MinRating))
+	assert.NoError(t, ValidateRating("review-1", MaxRating))
+	assert.ErrorIs(t, ValidateRating("review-1", 0), ErrInvalidRating)
+	assert.ErrorIs(t, ValidateRating("review-1", 6), ErrInvalidRating)
+	assert.ErrorIs(t, ValidateRating(" ", 3), ErrInvalidReviewID)
+	assert.ErrorIs(t, ValidateRating(strings.Repeat("r", MaxReviewIDLength+1), 3), ErrInvalidReviewID)
+}
+
+func TestRatingSummary_Rate(t *testing.T) {
+	var summary RatingSummary
+	assert.Equal(t, 0.0, summary.Average())
+
+	summary = summary.Rate(0, 5)
+	summary = summary.Rate(0, 2)
+	assert.Equal(t, int64(2), summary.Count())
+	assert.Equal(t, int64(7), summary.Sum())
+	assert.Equal(t, 3.5, summary.Average())
+
+	summary = summary.Rate(2, 4)
+	assert.Equal(t, int64(2), summary.Count(), "a review changing its rating is counted once")
+	assert.Equal(t, 4.5, summary.Average())
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+)
+
+// DisplayDecimals is the number of decimal places prices are displayed with.
+const DisplayDecimals = 2
+
+// RoundingPolicy decides how exact prices are rounded for display. Prices are stored and
+// computed as exact rationals; rounding only happens when they are shown.
+type RoundingPolicy string
+
+// Rounding policies.
+const (
+	// RoundHalfUp rounds to the nearest cent, ties away from zero: 10.125 → 10.13.
+	RoundHalfUp RoundingPolicy = "half_up"
+	// RoundBankers rounds to the nearest cent, ties to the even cent: 10.125 → 10.12.
+	RoundBankers RoundingPolicy = "bankers"
+	// RoundCharm rounds to the nearest whole unit, ties up, and takes one cent off:
+	// 19.60 → 19.99, 19.20 → 18.99. Amounts that would not stay positive are rounded
+	// half-up instead.
+	RoundCharm RoundingPolicy = "charm"
+)
+
+// DefaultRoundingPolicy is the policy used when none is configured.
+const DefaultRoundingPolicy = RoundHalfUp
+
+// ParseRoundingPolicy returns the rounding policy with the given name; "half_even" is
+// accepted for RoundBankers. Names are case-insensitive.
+func ParseRoundingPolicy(name string) (RoundingPolicy, error) {
+	switch RoundingPolicy(strings.ToLower(strings.TrimSpace(name))) {
+	case RoundHalfUp:
+		return RoundHalfUp, nil
+	case RoundBankers, "half_even":
+		return RoundBankers, nil
+	case RoundCharm:
+		return RoundCharm, nil
+	}
+	return "", ErrInvalidRoundingPolicy
+}
+
+// Round returns amount rounded to DisplayDecimals places by the policy. An unknown policy
+// rounds half-up.
+func (p RoundingPolicy) Round(amount *big.Rat) *big.Rat {
+	cent := big.NewRat(1, 100)
+	if p == RoundCharm {
+		whole := roundToMultiple(amount, big.NewRat(1, 1), false)
+		charm := whole.Sub(whole, cent)
+		if charm.Sign() > 0 {
+			return charm
+		}
+	}
+	return roundToMultiple(amount, cent, p == RoundBankers)
+}
+
+// Format returns amount rounded by the policy as a decimal string such as "19.99".
+func (p RoundingPolicy) Format(amount *big.Rat) string {
+	return p.Round(amount).FloatString(DisplayDecimals)
+}
+
+// Round returns the money value rounded for display by policy, in the same currency.
+func (m *Money) Round(policy RoundingPolicy) *Money {
+	return m.withAmount(policy.Round(m.Amount()))
+}
+
+// roundToMultiple rounds amount to the nearest multiple of unit. Ties go to the even
+// multiple if halfEven is set, and away from zero otherwise.
+func roundToMultiple(amount, unit *big.Rat, halfEven bool) *big.Rat {
+	scaled := new(big.Rat).Quo(amount, unit)
+	num := new(big.Int).Abs(scaled.Num())
+	quo, rem := new(big.Int).QuoRem(num, scaled.Denom(), new(big.Int))
+
+	// Compare twice the remainder with the denominator to find which half it falls in.
+	switch new(big.Int).Lsh(rem, 1).Cmp(scaled.Denom()) {
+	case 1:
+		quo.Add(quo, big.NewInt(1))
+	case 0:
+		if !halfEven || quo.Bit(0) == 1 {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	if scaled.Sign() < 0 {
+		quo.Neg(quo)
+	}
+	return new(big.Rat).Mul(new(big.Rat).SetInt(quo), unit)
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRoundingPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    RoundingPolicy
+		wantErr error
+	}{
+		{"half_up", RoundHalfUp, nil},
+		{"Bankers", RoundBankers, nil},
+		{"half_even", RoundBankers, nil},
+		{" charm ", RoundCharm, nil},
+		{"", "", ErrInvalidRoundingPolicy},
+		{"ceiling", "", ErrInvalidRoundingPolicy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRoundingPolicy(tt.name)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRoundingPolicy_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RoundingPolicy
+		amount *big.Rat
+		want   string
+	}{
+		{"half up exact", RoundHalfUp, big.NewRat(1999, 100), "19.99"},
+		{"half up tie", RoundHalfUp, big.NewRat(10125, 1000), "10.13"},
+		{"half up below tie", RoundHalfUp, big.NewRat(10124, 1000), "10.12"},
+		{"half up third", RoundHalfUp, big.NewRat(20, 3), "6.67"},
+		{"half up negative tie", RoundHalfUp, big.NewRat(-10125, 1000), "-10.13"},
+		{"bankers tie to even", RoundBankers, big.NewRat(10125, 1000), "10.12"},
+		{"bankers tie odd up", RoundBankers, big.NewRat(10135, 1000), "10.14"},
+		{"bankers above tie", RoundBankers, big.NewRat(101251, 10000), "10.13"},
+		{"bankers negative tie", RoundBankers, big.NewRat(-10125, 1000), "-10.12"},
+		{"charm up", RoundCharm, big.NewRat(1960, 100), "19.99"},
+		{"charm down", RoundCharm, big.NewRat(1920, 100), "18.99"},
+		{"charm tie", RoundCharm, big.NewRat(1950, 100), "19.99"},
+		{"charm whole", RoundCharm, big.NewRat(20, 1), "19.99"},
+		{"charm already", RoundCharm, big.NewRat(1999, 100), "19.99"},
+		{"charm small falls back", RoundCharm, big.NewRat(4125, 10000), "0.41"},
+		{"unknown policy", RoundingPolicy("other"), big.NewRat(10125, 1000), "10.13"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.Format(tt.amount))
+		})
+	}
+}
+
+func TestMoney_Round(t *testing.T) {
+	price, err := NewMoneyInCurrency(20, 3, "EUR")
+	require.NoError(t, err)
+
+	rounded := price.Round(RoundBankers)
+	assert.Equal(t, "EUR", rounded.Currency())
+	assert.Equal(t, "6.67", rounded.String())
+	assert.Equal(t, big.NewRat(20, 3), price.Amount(), "the original amount is unchanged")
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+)
+
+// NewSalePriceDiscount creates a discount selling the product at salePrice, in the
+// currency of basePrice and below it. Its percentage is the implied percentage off
+// basePrice, rounded to MaxPercentageScale decimal places, and is only used for reporting:
+// ApplyTo sells at the sale price itself. Product.ApplyDiscount derives the percentage
+// again from the product's base price, and keeps it up to date when the base price
+// changes.
+func NewSalePriceDiscount(salePrice, basePrice *Money, startDate, endDate time.Time) (*Discount, error) {
+	percentage, err := impliedPercentage(salePrice, basePrice)
+	if err != nil {
+		return nil, err
+	}
+	discount, err := NewDiscount(percentage, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	discount.salePrice = salePrice
+	return discount, nil
+}
+
+// SalePrice returns the sale price of a discount expressed as one, or nil for a discount
+// expressed as a percentage.
+func (d *Discount) SalePrice() *Money {
+	if d == nil {
+		return nil
+	}
+	return d.salePrice
+}
+
+// WithSalePrice returns a copy of the discount selling at the given sale price. The
+// percentage is kept; it is used to restore a stored sale price discount.
+func (d *Discount) WithSalePrice(price *Money) *Discount {
+	changed := *d
+	changed.salePrice = price
+	return &changed
+}
+
+// impliedPercentage returns how many percent salePrice is off price, rounded to
+// MaxPercentageScale decimal places. The sale price must be below the price, in its
+// currency, and not negative.
+func impliedPercentage(salePrice, price *Money) (*big.Rat, error) {
+	if salePrice == nil || price == nil || salePrice.IsNegative() {
+		return nil, ErrInvalidSalePrice
+	}
+	if !salePrice.SameCurrency(price) {
+		return nil, ErrCurrencyMismatch
+	}
+	if !salePrice.LessThan(price) {
+		return nil, ErrInvalidSalePrice
+	}
+
+	off := new(big.Rat).Sub(price.Amount(), salePrice.Amount())
+	off.Quo(off, price.Amount())
+	off.Mul(off, big.NewRat(100, 1))
+	percentage, _ := new(big.Rat).SetString(off.FloatString(MaxPercentageScale))
+	if percentage.Sign() <= 0 {
+		// The sale price is too close to the price for the percentage to be stored.
+		return nil, ErrInvalidSalePrice
+	}
+	return percentage, nil
+}
+
+// priceSaleDiscount returns discount with its percentage derived from the base price of
+// the product if it is expressed as a sale price. The sale price must be below the base
+// price and the pending base price. Sale prices replace the base price, so they cannot be
+// scoped to a market.
+func (p *Product) priceSaleDiscount(discount *Discount) (*Discount, error) {
+	sale := discount.SalePrice()
+	if sale == nil {
+		return discount, nil
+	}
+	if discount.Market() != "" {
+		return nil, ErrSalePriceMarket
+	}
+	percentage, err := impliedPercentage(sale, p.basePrice)
+	if err != nil {
+		return nil, err
+	}
+	if change := p.pendingPriceChange; change != nil && !sale.LessThan(change.Price()) {
+		return nil, ErrInvalidSalePrice
+	}
+	priced := *discount
+	priced.percentage = percentage
+	return &priced, nil
+}
+
+// checkBasePriceAboveSalePrices returns ErrBasePriceBelowSalePrice if price is not above
+// the sale price of every discount that has not expired by now.
+func (p *Product) checkBasePriceAboveSalePrices(price *Money, now time.Time) error {
+	for _, d := range p.discounts {
+		if d.SalePrice() == nil || d.IsExpired(now) {
+			continue
+		}
+		if _, err := impliedPercentage(d.SalePrice(), price); err != nil {
+			return ErrBasePriceBelowSalePrice
+		}
+	}
+	return nil
+}
+
+// repriceSaleDiscounts derives the percentage of the sale price discounts that have not
+// expired by now again from the base price, after it changed. Expired discounts keep the
+// percentage they were sold at.
+func (p *Product) repriceSaleDiscounts(now time.Time) {
+	for i, d := range p.discounts {
+		if d.SalePrice() == nil || d.IsExpired(now) {
+			continue
+		}
+		percentage, err := impliedPercentage(d.SalePrice(), p.basePrice)
+		if err != nil || percentage.Cmp(d.percentage) == 0 {
+			// The base price was checked to stay above the sale price.
+			continue
+		}
+		repriced := *d
+		repriced.percentage = percentage
+		p.discounts[i] = &repriced
+		p.changes.MarkDirty(FieldDiscount)
+	}
+}
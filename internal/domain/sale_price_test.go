@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSalePriceDiscount(t *testing.T) {
+	now := time.Now()
+	base := NewMoney(2999, 100)
+
+	discount, err := NewSalePriceDiscount(NewMoney(1999, 100), base, now, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, discount.SalePrice().Equals(NewMoney(1999, 100)))
+	assert.Equal(t, "33.3444", discount.Percentage().FloatString(4))
+	assert.True(t, discount.ApplyTo(base).Equals(NewMoney(1999, 100)), "sells at the sale price, not the rounded percentage")
+	assert.True(t, discount.ApplyTo(NewMoney(1500, 100)).Equals(NewMoney(15, 1)), "never raises a lower price")
+
+	percentage, err := NewDiscount(big.NewRat(10, 1), now, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Nil(t, percentage.SalePrice())
+
+	eur, err := NewMoneyInCurrency(1999, 100, "EUR")
+	require.NoError(t, err)
+	tests := []struct {
+		name    string
+		sale    *Money
+		wantErr error
+	}{
+		{name: "at the base price", sale: NewMoney(2999, 100), wantErr: ErrInvalidSalePrice},
+		{name: "above the base price", sale: NewMoney(3999, 100), wantErr: ErrInvalidSalePrice},
+		{name: "negative", sale: NewMoney(-1, 1), wantErr: ErrInvalidSalePrice},
+		{name: "other currency", sale: eur, wantErr: ErrCurrencyMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSalePriceDiscount(tt.sale, base, now, now.Add(time.Hour))
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestProduct_ApplySalePriceDiscount(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2999, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+	product.ClearEvents()
+
+	// Priced against another base price, the percentage is derived again from the product's.
+	discount, err := NewSalePriceDiscount(NewMoney(1999, 100), NewMoney(3999, 100), now, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(discount.WithID("sale"), now))
+
+	applied := product.Discounts()[0]
+	assert.Equal(t, "33.3444", applied.Percentage().FloatString(4))
+	assert.True(t, product.EffectivePrice(now).Equals(NewMoney(1999, 100)))
+	event, ok := product.DomainEvents()[0].(DiscountAppliedEvent)
+	require.True(t, ok)
+	assert.True(t, event.SalePrice.Equals(NewMoney(1999, 100)))
+
+	market, err := NewSalePriceDiscount(NewMoney(1999, 100), NewMoney(2999, 100), now.Add(2*time.Hour), now.Add(3*time.Hour))
+	require.NoError(t, err)
+	assert.ErrorIs(t, product.ApplyDiscount(market.WithID("eu").WithMarket(MarketEU), now), ErrSalePriceMarket)
+
+	require.NoError(t, product.ChangeBasePrice(NewMoney(3998, 100), now))
+	assert.Equal(t, "50.0000", product.Discounts()[0].Percentage().FloatString(4))
+	assert.True(t, product.EffectivePrice(now).Equals(NewMoney(1999, 100)))
+
+	later := now.Add(2 * time.Hour)
+	require.NoError(t, product.ChangeBasePrice(NewMoney(15, 1), later),
+		"the sale price of an expired discount does not hold the base price")
+	above, err := NewSalePriceDiscount(NewMoney(1999, 100), NewMoney(3999, 100), later, later.Add(time.Hour))
+	require.NoError(t, err)
+	assert.ErrorIs(t, product.ApplyDiscount(above.WithID("above"), later), ErrInvalidSalePrice)
+}
+
+func TestProduct_ChangeBasePriceBelowSalePrice(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2999, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.Activate(now))
+	discount, err := NewSalePriceDiscount(NewMoney(1999, 100), product.BasePrice(), now, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(discount.WithID("sale"), now))
+
+	assert.ErrorIs(t, product.ChangeBasePrice(NewMoney(1999, 100), now), ErrBasePriceBelowSalePrice)
+	assert.ErrorIs(t, product.ChangeBasePrice(NewMoney(999, 100), now), ErrBasePriceBelowSalePrice)
+	assert.ErrorIs(t, product.SchedulePriceChange(NewMoney(1999, 100), now.Add(time.Minute), now), ErrBasePriceBelowSalePrice)
+	assert.True(t, product.BasePrice().Equals(NewMoney(2999, 100)))
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// SalesChannel is a storefront the catalog serves, each with its own assortment.
+type SalesChannel string
+
+const (
+	SalesChannelWeb         SalesChannel = "web"
+	SalesChannelRetail      SalesChannel = "retail"
+	SalesChannelMarketplace SalesChannel = "marketplace"
+)
+
+// salesChannels are all sales channels, in name order.
+var salesChannels = []SalesChannel{SalesChannelMarketplace, SalesChannelRetail, SalesChannelWeb}
+
+// SalesChannels returns all sales channels in name order.
+func SalesChannels() []SalesChannel {
+	return append([]SalesChannel(nil), salesChannels...)
+}
+
+// IsValid reports whether the sales channel is known.
+func (c SalesChannel) IsValid() bool {
+	switch c {
+	case SalesChannelWeb, SalesChannelRetail, SalesChannelMarketplace:
+		return true
+	}
+	return false
+}
+
+// ParseSalesChannel validates a sales channel name.
+func ParseSalesChannel(s string) (SalesChannel, error) {
+	channel := SalesChannel(s)
+	if !channel.IsValid() {
+		return "", ErrInvalidSalesChannel
+	}
+	return channel, nil
+}
+
+// Channels returns the sales channels the product is available on, in name order. New
+// products are available on every channel.
+func (p *Product) Channels() []SalesChannel {
+	channels := make([]SalesChannel, 0, len(salesChannels))
+	for _, channel := range salesChannels {
+		if p.AvailableOn(channel) {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
+// UnavailableChannels returns the sales channels the product is withheld from, in name
+// order; empty if it is available on every channel.
+func (p *Product) UnavailableChannels() []SalesChannel {
+	return append([]SalesChannel(nil), p.unavailableChannels...)
+}
+
+// AvailableOn reports whether the product is available on a sales channel.
+func (p *Product) AvailableOn(channel SalesChannel) bool {
+	for _, c := range p.unavailableChannels {
+		if c == channel {
+			return false
+		}
+	}
+	return true
+}
+
+// SetChannelAvailability makes the product available on, or withholds it from, each
+// sales channel in availability; the other channels are left as they are. Nothing is
+// changed if any channel is unknown, and setting the current availability is a no-op
+// that raises no event.
+func (p *Product) SetChannelAvailability(availability map[SalesChannel]bool, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	for channel := range availability {
+		if !channel.IsValid() {
+			return ErrInvalidSalesChannel
+		}
+	}
+
+	var unavailable []SalesChannel
+	changed := false
+	for _, channel := range salesChannels {
+		available, ok := availability[channel]
+		if !ok {
+			available = p.AvailableOn(channel)
+		}
+		if available != p.AvailableOn(channel) {
+			changed = true
+		}
+		if !available {
+			unavailable = append(unavailable, channel)
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	p.unavailableChannels = unavailable
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldChannels)
+	p.events = append(p.events, NewProductChannelAvailabilityChangedEvent(p.id, p.Channels(), now))
+	return nil
+}
+
+// sortSalesChannels sorts sales channels in name order.
+func sortSalesChannels(channels []SalesChannel) {
+	sort.Slice(channels, func(i, j int) bool { return channels[i] < channels[j] })
+}
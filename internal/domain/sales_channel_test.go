@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSalesChannel(t *testing.T) {
+	channel, err := ParseSalesChannel("marketplace")
+	require.NoError(t, err)
+	assert.Equal(t, SalesChannelMarketplace, channel)
+
+	for _, name := range []string{"", "Web", "kiosk"} {
+		_, err := ParseSalesChannel(name)
+		assert.ErrorIs(t, err, ErrInvalidSalesChannel, name)
+	}
+}
+
+func TestProduct_SetChannelAvailability(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1999, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+	product.Changes().Reset()
+	assert.Equal(t, SalesChannels(), product.Channels(), "new products are available on every channel")
+
+	require.NoError(t, product.SetChannelAvailability(map[SalesChannel]bool{SalesChannelWeb: false}, now))
+	assert.False(t, product.AvailableOn(SalesChannelWeb))
+	assert.Equal(t, []SalesChannel{SalesChannelMarketplace, SalesChannelRetail}, product.Channels())
+	assert.Equal(t, []SalesChannel{SalesChannelWeb}, product.UnavailableChannels())
+	assert.True(t, product.Changes().Dirty(FieldChannels))
+	require.Len(t, product.DomainEvents(), 1)
+	event := product.DomainEvents()[0].(ProductChannelAvailabilityChangedEvent)
+	assert.Equal(t, []SalesChannel{SalesChannelMarketplace, SalesChannelRetail}, event.Channels)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	require.NoError(t, product.SetChannelAvailability(map[SalesChannel]bool{SalesChannelWeb: false, SalesChannelRetail: true}, now))
+	assert.Empty(t, product.DomainEvents(), "setting the current availability is a no-op")
+
+	assert.ErrorIs(t, product.SetChannelAvailability(map[SalesChannel]bool{SalesChannelWeb: true, "kiosk": true}, now), ErrInvalidSalesChannel)
+	assert.False(t, product.AvailableOn(SalesChannelWeb), "nothing changes if a channel is unknown")
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.SetChannelAvailability(map[SalesChannel]bool{SalesChannelWeb: true}, now), ErrProductArchived)
+}
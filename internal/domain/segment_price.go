@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxSegmentPrices is the maximum number of customer segments a product is priced for.
+const MaxSegmentPrices = 20
+
+// MaxSegmentLength is the longest customer segment name, the size of the segment column.
+const MaxSegmentLength = 50
+
+var segmentPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// ParseSegment validates a customer segment name, e.g. "wholesale" or "employee":
+// lowercase letters, digits and underscores, at most MaxSegmentLength characters.
+func ParseSegment(segment string) (string, error) {
+	segment = strings.TrimSpace(segment)
+	if segment == "" || len(segment) > MaxSegmentLength || !segmentPattern.MatchString(segment) {
+		return "", ErrInvalidSegment
+	}
+	return segment, nil
+}
+
+// SegmentPrice is the price of a product for customers of one segment, either a fixed
+// price replacing the base price or a percentage off the base price. Discounts apply to
+// it as they do to the base price.
+type SegmentPrice struct {
+	segment    string
+	price      *Money
+	percentOff *big.Rat
+}
+
+// NewSegmentPriceOverride creates a segment price that replaces the base price with price.
+func NewSegmentPriceOverride(segment string, price *Money) (*SegmentPrice, error) {
+	segment, err := ParseSegment(segment)
+	if err != nil {
+		return nil, err
+	}
+	if price == nil || !price.IsPositive() {
+		return nil, ErrInvalidSegmentPrice
+	}
+	return &SegmentPrice{segment: segment, price: price}, nil
+}
+
+// NewSegmentPercentOff creates a segment price that takes percentOff percent off the base
+// price.
+func NewSegmentPercentOff(segment string, percentOff *big.Rat) (*SegmentPrice, error) {
+	segment, err := ParseSegment(segment)
+	if err != nil {
+		return nil, err
+	}
+	if percentOff == nil || percentOff.Sign() <= 0 || percentOff.Cmp(big.NewRat(100, 1)) >= 0 {
+		return nil, ErrInvalidSegmentPrice
+	}
+	if !hasMaxScale(percentOff, MaxPercentageScale) {
+		return nil, ErrInvalidDiscountPrecision
+	}
+	return &SegmentPrice{segment: segment, percentOff: new(big.Rat).Set(percentOff)}, nil
+}
+
+// Segment returns the customer segment the price is for.
+func (s *SegmentPrice) Segment() string { return s.segment }
+
+// Price returns the fixed price of the segment, or nil for a percent-off segment price.
+func (s *SegmentPrice) Price() *Money { return s.price }
+
+// PercentOff returns a copy of the percentage taken off the base price, or nil for a
+// fixed segment price.
+func (s *SegmentPrice) PercentOff() *big.Rat {
+	if s.percentOff == nil {
+		return nil
+	}
+	return new(big.Rat).Set(s.percentOff)
+}
+
+// ApplyTo returns the base price of the segment for the given base price.
+func (s *SegmentPrice) ApplyTo(basePrice *Money) *Money {
+	if s == nil || basePrice == nil {
+		return basePrice
+	}
+	if s.percentOff != nil {
+		return basePrice.ApplyDiscount(s.percentOff)
+	}
+	return s.price
+}
+
+// Equals checks if two segment prices are equal.
+func (s *SegmentPrice) Equals(other *SegmentPrice) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if s.segment != other.segment || (s.price == nil) != (other.price == nil) {
+		return false
+	}
+	if s.price != nil {
+		return s.price.Equals(other.price)
+	}
+	return s.percentOff.Cmp(other.percentOff) == 0
+}
+
+// SortSegmentPrices orders segment prices by segment.
+func SortSegmentPrices(prices []*SegmentPrice) {
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].segment < prices[j].segment
+	})
+}
+
+// SegmentPrices returns the customer segment prices of the product, ordered by segment.
+func (p *Product) SegmentPrices() []*SegmentPrice {
+	return append([]*SegmentPrice(nil), p.segmentPrices...)
+}
+
+// SegmentPrice returns the price of the product for the given customer segment, or nil if
+// the segment pays the base price.
+func (p *Product) SegmentPrice(segment string) *SegmentPrice {
+	for _, s := range p.segmentPrices {
+		if s.segment == segment {
+			return s
+		}
+	}
+	return nil
+}
+
+// SetSegmentPrices replaces the customer segment prices of the product; an empty list
+// removes them all. Segments must be distinct, fixed prices must be in the currency of the
+// product, and a product holds up to MaxSegmentPrices of them. Setting the current prices
+// again is a no-op and raises no event.
+func (p *Product) SetSegmentPrices(prices []*SegmentPrice, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if len(prices) > MaxSegmentPrices {
+		return ErrTooManySegmentPrices
+	}
+
+	sorted := make([]*SegmentPrice, 0, len(prices))
+	for _, s := range prices {
+		if s == nil {
+			return ErrInvalidSegmentPrice
+		}
+		if price := s.Price(); price != nil && !price.SameCurrency(p.basePrice) {
+			return ErrCurrencyMismatch
+		}
+		sorted = append(sorted, s)
+	}
+	SortSegmentPrices(sorted)
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].segment == sorted[i-1].segment {
+			return ErrDuplicateSegment
+		}
+	}
+
+	if segmentPricesEqual(p.segmentPrices, sorted) {
+		return nil
+	}
+
+	p.segmentPrices = sorted
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldSegmentPrices)
+
+	p.events = append(p.events, NewSegmentPricesChangedEvent(p.id, p.Currency(), p.SegmentPrices(), now))
+	return nil
+}
+
+func segmentPricesEqual(a, b []*SegmentPrice) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equals(b[i]) {
+			return false
+		}
+	}
+	return true
+}
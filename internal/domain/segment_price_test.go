@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSegment(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"wholesale", "wholesale", false},
+		{" employee_2024 ", "employee_2024", false},
+		{"", "", true},
+		{"Wholesale", "", true},
+		{"vip-members", "", true},
+		{string(make([]byte, MaxSegmentLength+1)), "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseSegment(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSegment)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSegmentPrice_ApplyTo(t *testing.T) {
+	base := NewMoney(2000, 100)
+
+	fixed, err := NewSegmentPriceOverride("employee", NewMoney(1200, 100))
+	require.NoError(t, err)
+	assert.True(t, fixed.ApplyTo(base).Equals(NewMoney(12, 1)))
+
+	pct, err := NewSegmentPercentOff("wholesale", big.NewRat(15, 1))
+	require.NoError(t, err)
+	assert.True(t, pct.ApplyTo(base).Equals(NewMoney(17, 1)))
+
+	var none *SegmentPrice
+	assert.True(t, none.ApplyTo(base).Equals(base))
+}
+
+func TestProduct_SetSegmentPrices(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	wholesale, err := NewSegmentPercentOff("wholesale", big.NewRat(15, 1))
+	require.NoError(t, err)
+	employee, err := NewSegmentPriceOverride("employee", NewMoney(1200, 100))
+	require.NoError(t, err)
+	require.NoError(t, product.SetSegmentPrices([]*SegmentPrice{wholesale, employee}, now))
+
+	got := product.SegmentPrices()
+	require.Len(t, got, 2)
+	assert.Equal(t, "employee", got[0].Segment())
+	assert.Equal(t, "wholesale", got[1].Segment())
+	assert.True(t, product.Changes().Dirty(FieldSegmentPrices))
+	require.Len(t, product.DomainEvents(), 1)
+	event, ok := product.DomainEvents()[0].(SegmentPricesChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, DefaultCurrency, event.Currency)
+	assert.Len(t, event.Prices, 2)
+
+	assert.Same(t, wholesale, product.SegmentPrice("wholesale"))
+	assert.Nil(t, product.SegmentPrice("retail"))
+
+	// Setting the same prices again is a no-op
+	product.ClearEvents()
+	product.Changes().Reset()
+	require.NoError(t, product.SetSegmentPrices([]*SegmentPrice{employee, wholesale}, now))
+	assert.Empty(t, product.DomainEvents())
+	assert.False(t, product.Changes().HasChanges())
+
+	// An empty list removes every segment price
+	require.NoError(t, product.SetSegmentPrices(nil, now))
+	assert.Empty(t, product.SegmentPrices())
+	assert.Len(t, product.DomainEvents(), 1)
+}
+
+func TestProduct_SetSegmentPrices_Invalid(t *testing.T) {
+	now := time.Now()
+
+	tooMany := make([]*SegmentPrice, MaxSegmentPrices+1)
+	for i := range tooMany {
+		price, err := NewSegmentPercentOff(fmt.Sprintf("segment_%d", i), big.NewRat(5, 1))
+		require.NoError(t, err)
+		tooMany[i] = price
+	}
+	eur, err := NewSegmentPriceOverride("export", mustMoneyInCurrency(t, 1800, 100, "EUR"))
+	require.NoError(t, err)
+	first, err := NewSegmentPercentOff("wholesale", big.NewRat(10, 1))
+	require.NoError(t, err)
+	second, err := NewSegmentPercentOff("wholesale", big.NewRat(20, 1))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		archive bool
+		prices  []*SegmentPrice
+		wantErr error
+	}{
+		{"other currency", false, []*SegmentPrice{eur}, ErrCurrencyMismatch},
+		{"duplicate segment", false, []*SegmentPrice{first, second}, ErrDuplicateSegment},
+		{"nil price", false, []*SegmentPrice{nil}, ErrInvalidSegmentPrice},
+		{"too many prices", false, tooMany, ErrTooManySegmentPrices},
+		{"archived product", true, []*SegmentPrice{first}, ErrProductArchived},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+			require.NoError(t, err)
+			if tt.archive {
+				require.NoError(t, product.Archive(now))
+			}
+
+			assert.ErrorIs(t, product.SetSegmentPrices(tt.prices, now), tt.wantErr)
+			assert.Empty(t, product.SegmentPrices())
+		})
+	}
+}
+
+func TestNewSegmentPrice_Invalid(t *testing.T) {
+	_, err := NewSegmentPriceOverride("employee", NewMoney(0, 1))
+	assert.ErrorIs(t, err, ErrInvalidSegmentPrice)
+	_, err = NewSegmentPercentOff("wholesale", big.NewRat(100, 1))
+	assert.ErrorIs(t, err, ErrInvalidSegmentPrice)
+	_, err = NewSegmentPercentOff("wholesale", big.NewRat(0, 1))
+	assert.ErrorIs(t, err, ErrInvalidSegmentPrice)
+	_, err = NewSegmentPercentOff("Wholesale", big.NewRat(10, 1))
+	assert.ErrorIs(t, err, ErrInvalidSegment)
+}
@@ -0,0 +1,97 @@
+package domain
+
+import "time"
+
+// ActivateAt returns when the product is scheduled to be activated, or nil if it is not.
+func (p *Product) ActivateAt() *time.Time { return p.activateAt }
+
+// DeactivateAt returns when the product is scheduled to be deactivated, or nil if it is
+// not.
+func (p *Product) DeactivateAt() *time.Time { return p.deactivateAt }
+
+// ScheduleActivation replaces the scheduled activation and deactivation of the product,
+// e.g. to launch it at midnight and take it off sale after a weekend. A nil time clears
+// that schedule. Scheduled times must be after now, and a deactivation scheduled with an
+// activation must follow it. Setting the current schedule is a no-op and raises no event.
+// The changes are made by ApplyStatusSchedule once they are due. A product pending review
+// is activated by approving it, so its activation cannot be scheduled.
+func (p *Product) ScheduleActivation(activateAt, deactivateAt *time.Time, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if activateAt != nil && p.status == ProductStatusPendingReview {
+		return ErrProductReviewRequired
+	}
+	if activateAt != nil && !activateAt.After(now) {
+		return ErrInvalidStatusSchedule
+	}
+	if deactivateAt != nil && !deactivateAt.After(now) {
+		return ErrInvalidStatusSchedule
+	}
+	if activateAt != nil && deactivateAt != nil && !deactivateAt.After(*activateAt) {
+		return ErrInvalidStatusSchedule
+	}
+	if sameTime(activateAt, p.activateAt) && sameTime(deactivateAt, p.deactivateAt) {
+		return nil
+	}
+
+	p.activateAt = copyTime(activateAt)
+	p.deactivateAt = copyTime(deactivateAt)
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldStatusSchedule)
+	p.events = append(p.events, NewProductStatusScheduledEvent(p.id, p.activateAt, p.deactivateAt, now))
+	return nil
+}
+
+// ApplyStatusSchedule makes the scheduled status changes that are due, activation first,
+// through Activate and Deactivate, which raise their usual events. A due change the
+// product already has the status of, e.g. an activation of a product that was activated
+// by hand since, is cleared and the product left as it is. If the product cannot be
+// activated, e.g. because it is pending review, it fails with the error of Activate and
+// nothing changes. It reports whether the product changed.
+func (p *Product) ApplyStatusSchedule(now time.Time) (bool, error) {
+	activate := p.activateAt != nil && !now.Before(*p.activateAt)
+	if activate && p.status != ProductStatusActive {
+		if err := p.Activate(now); err != nil {
+			return false, err
+		}
+	}
+
+	changed := false
+	if activate {
+		p.activateAt = nil
+		p.changes.MarkDirty(FieldStatusSchedule)
+		changed = true
+	}
+	if p.deactivateAt != nil && !now.Before(*p.deactivateAt) {
+		p.deactivateAt = nil
+		p.changes.MarkDirty(FieldStatusSchedule)
+		if p.status.CanDeactivate() {
+			if err := p.Deactivate(now); err != nil {
+				return false, err
+			}
+		}
+		changed = true
+	}
+	if changed {
+		p.updatedAt = now
+	}
+	return changed, nil
+}
+
+// sameTime reports whether two optional times are both unset or the same instant.
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// copyTime returns a copy of an optional time.
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct_ScheduleActivation(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	midnight := now.Add(12 * time.Hour)
+	monday := midnight.Add(72 * time.Hour)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1000, 100), now)
+	require.NoError(t, err)
+	product.ClearEvents()
+
+	assert.ErrorIs(t, product.ScheduleActivation(&now, nil, now), ErrInvalidStatusSchedule)
+	assert.ErrorIs(t, product.ScheduleActivation(nil, &now, now), ErrInvalidStatusSchedule)
+	assert.ErrorIs(t, product.ScheduleActivation(&monday, &midnight, now), ErrInvalidStatusSchedule)
+	assert.Empty(t, product.DomainEvents())
+
+	require.NoError(t, product.ScheduleActivation(&midnight, &monday, now))
+	assert.Equal(t, midnight, *product.ActivateAt())
+	assert.Equal(t, monday, *product.DeactivateAt())
+	assert.True(t, product.Changes().Dirty(FieldStatusSchedule))
+	require.Len(t, product.DomainEvents(), 1)
+	event := product.DomainEvents()[0].(ProductStatusScheduledEvent)
+	assert.Equal(t, "product.status_scheduled", event.EventType())
+	assert.Equal(t, midnight, *event.ActivateAt)
+	assert.Equal(t, monday, *event.DeactivateAt)
+	product.ClearEvents()
+
+	// Setting the same schedule is a no-op
+	same := midnight.In(time.FixedZone("CET", 3600))
+	require.NoError(t, product.ScheduleActivation(&same, &monday, now))
+	assert.Empty(t, product.DomainEvents())
+
+	// A nil time clears that schedule
+	require.NoError(t, product.ScheduleActivation(&midnight, nil, now))
+	assert.Nil(t, product.DeactivateAt())
+	require.Len(t, product.DomainEvents(), 1)
+	assert.Nil(t, product.DomainEvents()[0].(ProductStatusScheduledEvent).DeactivateAt)
+	product.ClearEvents()
+
+	// Archiving drops the schedule
+	require.NoError(t, product.Archive(now))
+	assert.Nil(t, product.ActivateAt())
+	assert.ErrorIs(t, product.ScheduleActivation(&midnight, nil, now), ErrProductArchived)
+}
+
+func TestProduct_ApplyStatusSchedule(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	midnight := now.Add(12 * time.Hour)
+	monday := midnight.Add(72 * time.Hour)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.ScheduleActivation(&midnight, &monday, now))
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	// Nothing is due before midnight
+	changed, err := product.ApplyStatusSchedule(midnight.Add(-time.Second))
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Empty(t, product.DomainEvents())
+
+	// The activation is made at midnight, raising the usual event
+	changed, err = product.ApplyStatusSchedule(midnight)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, ProductStatusActive, product.Status())
+	assert.Nil(t, product.ActivateAt())
+	assert.NotNil(t, product.DeactivateAt())
+	assert.True(t, product.Changes().Dirty(FieldStatus))
+	assert.True(t, product.Changes().Dirty(FieldStatusSchedule))
+	require.Len(t, product.DomainEvents(), 1)
+	assert.Equal(t, "product.activated", product.DomainEvents()[0].EventType())
+	product.ClearEvents()
+
+	// The deactivation of a product deactivated by hand since is cleared without an event
+	require.NoError(t, product.Deactivate(midnight))
+	product.ClearEvents()
+	changed, err = product.ApplyStatusSchedule(monday)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Nil(t, product.DeactivateAt())
+	assert.Empty(t, product.DomainEvents())
+	changed, err = product.ApplyStatusSchedule(monday)
+	require.NoError(t, err)
+	assert.False(t, changed)
+}
+
+func TestProduct_ApplyStatusScheduleLate(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	midnight := now.Add(12 * time.Hour)
+	monday := midnight.Add(72 * time.Hour)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.ScheduleActivation(&midnight, &monday, now))
+	product.ClearEvents()
+
+	// Both changes are due: the product is activated, then deactivated
+	changed, err := product.ApplyStatusSchedule(monday.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, ProductStatusInactive, product.Status())
+	require.Len(t, product.DomainEvents(), 2)
+	assert.Equal(t, "product.activated", product.DomainEvents()[0].EventType())
+	assert.Equal(t, "product.deactivated", product.DomainEvents()[1].EventType())
+}
+
+func TestProduct_StatusScheduleUnderReview(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	midnight := now.Add(12 * time.Hour)
+	monday := midnight.Add(72 * time.Hour)
+	product, err := NewProduct("product-1", "Widget", "", "Tools", NewMoney(1000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.ScheduleActivation(&midnight, &monday, now))
+	require.NoError(t, product.SubmitForReview("alice", now))
+	product.ClearEvents()
+
+	// A product pending review is activated by approving it, not on schedule
+	assert.ErrorIs(t, product.ScheduleActivation(&midnight, nil, now), ErrProductReviewRequired)
+	changed, err := product.ApplyStatusSchedule(midnight)
+	assert.ErrorIs(t, err, ErrProductNotActive)
+	assert.False(t, changed)
+	assert.Equal(t, ProductStatusPendingReview, product.Status())
+	assert.Equal(t, midnight, *product.ActivateAt(), "the activation stays scheduled")
+	assert.Empty(t, product.DomainEvents())
+
+	// Rejecting the product drops its schedule
+	require.NoError(t, product.Reject("bob", "Missing images", now))
+	assert.Nil(t, product.ActivateAt())
+	assert.Nil(t, product.DeactivateAt())
+	assert.True(t, product.Changes().Dirty(FieldStatusSchedule))
+}
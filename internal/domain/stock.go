@@ -0,0 +1,117 @@
+package domain
+
+import "time"
+
+// MaxStockLevel is the maximum number of units of a product in stock.
+const MaxStockLevel = 1_000_000_000
+
+// Stock is the aggregate root of the stock of a product: the units on hand and the units
+// of them reserved for orders not yet shipped. The other units are available to sell.
+// Stock is only tracked for products it was set for; the catalog does not know the
+// availability of the others and treats them as in stock. Every change increments the
+// version, which lets writers check that the stock did not change since they read it.
+type Stock struct {
+	productID string
+	level     int64
+	reserved  int64
+	version   int64
+	updatedAt time.Time
+	events    []DomainEvent
+}
+
+// NewStock returns the stock of a product that is not tracked yet, at version 0.
+func NewStock(productID string) *Stock {
+	return &Stock{productID: productID}
+}
+
+// ReconstructStock reconstructs a Stock from persistence.
+func ReconstructStock(productID string, level, reserved, version int64, updatedAt time.Time) *Stock {
+	return &Stock{
+		productID: productID,
+		level:     level,
+		reserved:  reserved,
+		version:   version,
+		updatedAt: updatedAt,
+	}
+}
+
+// ProductID returns the product the stock is of.
+func (s *Stock) ProductID() string { return s.productID }
+
+// Level returns the number of units on hand.
+func (s *Stock) Level() int64 { return s.level }
+
+// Reserved returns the number of units on hand reserved for orders.
+func (s *Stock) Reserved() int64 { return s.reserved }
+
+// Available returns the number of units that can be sold.
+func (s *Stock) Available() int64 { return s.level - s.reserved }
+
+// Tracked reports whether the stock of the product has been set.
+func (s *Stock) Tracked() bool { return s.version > 0 }
+
+// InStock reports whether units are available, or the stock is not tracked.
+func (s *Stock) InStock() bool { return !s.Tracked() || s.Available() > 0 }
+
+// Version returns the number of changes made to the stock; 0 if it is not tracked.
+func (s *Stock) Version() int64 { return s.version }
+
+// UpdatedAt returns the time of the last change.
+func (s *Stock) UpdatedAt() time.Time { return s.updatedAt }
+
+// DomainEvents returns the events raised since the stock was loaded.
+func (s *Stock) DomainEvents() []DomainEvent { return s.events }
+
+// Set replaces the units on hand and reserved, e.g. after a stock take. Setting the
+// current values again is a no-op and raises no event.
+func (s *Stock) Set(level, reserved int64, now time.Time) error {
+	if level < 0 || level > MaxStockLevel || reserved < 0 || reserved > level {
+		return ErrInvalidStock
+	}
+	if s.Tracked() && level == s.level && reserved == s.reserved {
+		return nil
+	}
+	s.change(level, reserved, now)
+	return nil
+}
+
+// Adjust adds levelDelta to the units on hand and reservedDelta to the units reserved,
+// either of which may be negative, e.g. -2 and -2 when a reserved order of 2 ships. It
+// fails with ErrInsufficientStock if fewer units would be on hand than reserved, or if
+// either would drop below zero.
+func (s *Stock) Adjust(levelDelta, reservedDelta int64, now time.Time) error {
+	if levelDelta < -MaxStockLevel || levelDelta > MaxStockLevel || reservedDelta < -MaxStockLevel || reservedDelta > MaxStockLevel {
+		return ErrInvalidStock
+	}
+	if levelDelta == 0 && reservedDelta == 0 {
+		return nil
+	}
+	level, reserved := s.level+levelDelta, s.reserved+reservedDelta
+	if level > MaxStockLevel {
+		return ErrInvalidStock
+	}
+	if level < 0 || reserved < 0 || reserved > level {
+		return ErrInsufficientStock
+	}
+	s.change(level, reserved, now)
+	return nil
+}
+
+// change applies new stock values and raises StockChangedEvent, and OutOfStockEvent or
+// BackInStockEvent when the product runs out or becomes available again. Untracked stock
+// counts as in stock.
+func (s *Stock) change(level, reserved int64, now time.Time) {
+	wasInStock := s.InStock()
+	s.level = level
+	s.reserved = reserved
+	s.version++
+	s.updatedAt = now
+
+	s.events = append(s.events, NewStockChangedEvent(s, now))
+	switch {
+	case wasInStock && !s.InStock():
+		s.events = append(s.events, NewOutOfStockEvent(s.productID, now))
+	case !wasInStock && s.InStock():
+		s.events = append(s.events, NewBackInStockEvent(s.productID, s.Available(), now))
+	}
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func eventTypes(events []DomainEvent) []string {
+	types := make([]string, len(events))
+	for i, e := range events {
+		types[i] = e.EventType()
+	}
+	return types
+}
+
+func TestStock_Set(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		level          int64
+		reserved       int64
+		wantErr        error
+		wantEventTypes []string
+	}{
+		{"in stock", 10, 2, nil, []string{"product.stock_changed"}},
+		{"all reserved", 3, 3, nil, []string{"product.stock_changed", "product.out_of_stock"}},
+		{"none", 0, 0, nil, []string{"product.stock_changed", "product.out_of_stock"}},
+		{"negative level", -1, 0, ErrInvalidStock, nil},
+		{"negative reserved", 5, -1, ErrInvalidStock, nil},
+		{"more reserved than on hand", 5, 6, ErrInvalidStock, nil},
+		{"too many", MaxStockLevel + 1, 0, ErrInvalidStock, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Untracked stock counts as in stock
+			stock := NewStock("p1")
+			assert.True(t, stock.InStock())
+
+			err := stock.Set(tt.level, tt.reserved, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.False(t, stock.Tracked())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.level, stock.Level())
+			assert.Equal(t, tt.reserved, stock.Reserved())
+			assert.Equal(t, tt.level-tt.reserved, stock.Available())
+			assert.Equal(t, int64(1), stock.Version())
+			assert.Equal(t, tt.wantEventTypes, eventTypes(stock.DomainEvents()))
+		})
+	}
+
+	t.Run("same values", func(t *testing.T) {
+		stock := ReconstructStock("p1", 10, 2, 4, now)
+		require.NoError(t, stock.Set(10, 2, now.Add(time.Hour)))
+		assert.Equal(t, int64(4), stock.Version())
+		assert.Equal(t, now, stock.UpdatedAt())
+		assert.Empty(t, stock.DomainEvents())
+	})
+}
+
+func TestStock_Adjust(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	stock := ReconstructStock("p1", 5, 1, 3, now)
+
+	// Reserve the last available units
+	require.NoError(t, stock.Adjust(0, 4, now))
+	assert.Equal(t, int64(0), stock.Available())
+	assert.False(t, stock.InStock())
+	assert.Equal(t, int64(4), stock.Version())
+
+	// Ship a reserved order
+	require.NoError(t, stock.Adjust(-2, -2, now))
+	assert.Equal(t, int64(3), stock.Level())
+	assert.Equal(t, int64(3), stock.Reserved())
+
+	// Receive new units
+	require.NoError(t, stock.Adjust(10, 0, now))
+	assert.Equal(t, int64(10), stock.Available())
+	assert.Equal(t, int64(6), stock.Version())
+
+	assert.Equal(t, []string{
+		"product.stock_changed", "product.out_of_stock",
+		"product.stock_changed",
+		"product.stock_changed", "product.back_in_stock",
+	}, eventTypes(stock.DomainEvents()))
+	backInStock, ok := stock.DomainEvents()[4].(BackInStockEvent)
+	require.True(t, ok)
+	assert.Equal(t, int64(10), backInStock.Available)
+
+	// Nothing changes on failure
+	assert.ErrorIs(t, stock.Adjust(0, 11, now), ErrInsufficientStock)
+	assert.ErrorIs(t, stock.Adjust(-14, 0, now), ErrInsufficientStock)
+	assert.ErrorIs(t, stock.Adjust(0, -4, now), ErrInsufficientStock)
+	assert.ErrorIs(t, stock.Adjust(MaxStockLevel, 0, now), ErrInvalidStock)
+	assert.ErrorIs(t, stock.Adjust(0, -MaxStockLevel-1, now), ErrInvalidStock)
+	assert.Equal(t, int64(13), stock.Level())
+	assert.Equal(t, int64(6), stock.Version())
+
+	// No change is a no-op
+	require.NoError(t, stock.Adjust(0, 0, now))
+	assert.Len(t, stock.DomainEvents(), 5)
+}
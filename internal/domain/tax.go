@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// TaxClass names the tax treatment of a product, e.g. "standard" or "reduced". The rate
+// of a class is looked up from a TaxRateProvider when a price is calculated.
+type TaxClass string
+
+// DefaultTaxClass is the tax class of new products and of products stored before tax
+// classes existed.
+const DefaultTaxClass TaxClass = "standard"
+
+// MaxTaxClassLength is the size of the tax_class column.
+const MaxTaxClassLength = 50
+
+var taxClassPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_]*$`)
+
+// ParseTaxClass validates a tax class: lowercase letters, digits and underscores, at most
+// MaxTaxClassLength characters. An empty class is DefaultTaxClass.
+func ParseTaxClass(class string) (TaxClass, error) {
+	class = strings.TrimSpace(class)
+	if class == "" {
+		return DefaultTaxClass, nil
+	}
+	if len(class) > MaxTaxClassLength || !taxClassPattern.MatchString(class) {
+		return "", ErrInvalidTaxClass
+	}
+	return TaxClass(class), nil
+}
+
+// String returns the tax class name.
+func (c TaxClass) String() string { return string(c) }
+
+// TaxClass returns the tax class of the product.
+func (p *Product) TaxClass() TaxClass { return p.taxClass }
+
+// SetTaxClass changes the tax class of the product. Setting the current class again is a
+// no-op and raises no event.
+func (p *Product) SetTaxClass(class TaxClass, now time.Time) error {
+	if p.status == ProductStatusArchived {
+		return ErrProductArchived
+	}
+	if parsed, err := ParseTaxClass(string(class)); err != nil || parsed != class {
+		return ErrInvalidTaxClass
+	}
+	if p.taxClass == class {
+		return nil
+	}
+
+	oldClass := p.taxClass
+	p.taxClass = class
+	p.updatedAt = now
+	p.changes.MarkDirty(FieldTaxClass)
+
+	p.events = append(p.events, NewTaxClassChangedEvent(p.id, oldClass, class, now))
+	return nil
+}
+
+// TaxRateProvider looks up tax rates. A rate is a percentage of the net price, e.g. 20
+// for 20%.
+type TaxRateProvider interface {
+	// TaxRate returns the rate of a tax class in a region; an empty region means the
+	// default one. It returns ErrNoTaxRate if the class has no rate there.
+	TaxRate(class TaxClass, region string) (*big.Rat, error)
+}
+
+// TaxRates is a fixed TaxRateProvider: default rates per tax class, overridden by rates
+// per region and tax class.
+type TaxRates struct {
+	defaults map[TaxClass]*big.Rat
+	regional map[string]map[TaxClass]*big.Rat
+}
+
+// ParseTaxRates creates TaxRates from a comma-separated list of decimal percentages per
+// tax class, optionally prefixed with a region, e.g. "standard=20,reduced=5,DE:standard=19".
+func ParseTaxRates(spec string) (*TaxRates, error) {
+	r := &TaxRates{
+		defaults: make(map[TaxClass]*big.Rat),
+		regional: make(map[string]map[TaxClass]*big.Rat),
+	}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, ErrInvalidTaxRate
+		}
+		rate, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+		if !ok || rate.Sign() < 0 || rate.Cmp(big.NewRat(100, 1)) > 0 {
+			return nil, ErrInvalidTaxRate
+		}
+
+		rates := r.defaults
+		if region, name, ok := strings.Cut(key, ":"); ok {
+			region = strings.ToUpper(strings.TrimSpace(region))
+			if region == "" {
+				return nil, ErrInvalidTaxRate
+			}
+			if r.regional[region] == nil {
+				r.regional[region] = make(map[TaxClass]*big.Rat)
+			}
+			rates, key = r.regional[region], name
+		}
+		class, err := ParseTaxClass(key)
+		if err != nil || strings.TrimSpace(key) == "" {
+			return nil, ErrInvalidTaxRate
+		}
+		rates[class] = rate
+	}
+	return r, nil
+}
+
+// TaxRate implements TaxRateProvider. A region without a rate for the class falls back to
+// the default rate of the class. Regions are case-insensitive.
+func (r *TaxRates) TaxRate(class TaxClass, region string) (*big.Rat, error) {
+	if rate, ok := r.regional[strings.ToUpper(region)][class]; ok {
+		return new(big.Rat).Set(rate), nil
+	}
+	if rate, ok := r.defaults[class]; ok {
+		return new(big.Rat).Set(rate), nil
+	}
+	return nil, ErrNoTaxRate
+}
+
+// TaxedPrice is a net price with the tax on it.
+type TaxedPrice struct {
+	Net   *Money
+	Tax   *Money
+	Gross *Money
+	// RatePercent is the tax rate applied, e.g. 20 for 20%.
+	RatePercent *big.Rat
+}
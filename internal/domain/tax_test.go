@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaxClass(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected TaxClass
+		wantErr  bool
+	}{
+		{input: "", expected: DefaultTaxClass},
+		{input: "reduced", expected: "reduced"},
+		{input: " zero_rated ", expected: "zero_rated"},
+		{input: "Reduced", wantErr: true},
+		{input: "reduced rate", wantErr: true},
+		{input: "_reduced", wantErr: true},
+		{input: strings.Repeat("a", MaxTaxClassLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTaxClass(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidTaxClass)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestProduct_SetTaxClass(t *testing.T) {
+	now := time.Now()
+	product, err := NewProduct("123", "Widget", "", "Tools", NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTaxClass, product.TaxClass())
+	product.ClearEvents()
+	product.Changes().Reset()
+
+	require.NoError(t, product.SetTaxClass("reduced", now))
+	assert.Equal(t, TaxClass("reduced"), product.TaxClass())
+	assert.True(t, product.Changes().Dirty(FieldTaxClass))
+	require.Len(t, product.DomainEvents(), 1)
+	event, ok := product.DomainEvents()[0].(TaxClassChangedEvent)
+	require.True(t, ok)
+	assert.Equal(t, DefaultTaxClass, event.OldTaxClass)
+	assert.Equal(t, TaxClass("reduced"), event.NewTaxClass)
+
+	// Setting the same class again is a no-op
+	product.ClearEvents()
+	product.Changes().Reset()
+	require.NoError(t, product.SetTaxClass("reduced", now))
+	assert.Empty(t, product.DomainEvents())
+	assert.False(t, product.Changes().HasChanges())
+
+	assert.ErrorIs(t, product.SetTaxClass("", now), ErrInvalidTaxClass)
+	assert.ErrorIs(t, product.SetTaxClass(" reduced", now), ErrInvalidTaxClass)
+
+	require.NoError(t, product.Archive(now))
+	assert.ErrorIs(t, product.SetTaxClass("standard", now), ErrProductArchived)
+}
+
+func TestParseTaxRates(t *testing.T) {
+	rates, err := ParseTaxRates("standard=20, reduced=5.5, zero=0, de:standard=19")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		class    TaxClass
+		region   string
+		expected *big.Rat
+	}{
+		{"default", "standard", "", big.NewRat(20, 1)},
+		{"decimal", "reduced", "", big.NewRat(11, 2)},
+		{"zero", "zero", "", big.NewRat(0, 1)},
+		{"regional", "standard", "DE", big.NewRat(19, 1)},
+		{"regional is case-insensitive", "standard", "de", big.NewRat(19, 1)},
+		{"region falls back to default", "reduced", "DE", big.NewRat(11, 2)},
+		{"unknown region", "standard", "FR", big.NewRat(20, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := rates.TaxRate(tt.class, tt.region)
+			require.NoError(t, err)
+			assert.Zero(t, tt.expected.Cmp(rate), "rate %s", rate.RatString())
+		})
+	}
+
+	_, err = rates.TaxRate("luxury", "")
+	assert.ErrorIs(t, err, ErrNoTaxRate)
+
+	for _, spec := range []string{"standard", "standard=abc", "standard=-1", "standard=101", "Standard=20", ":standard=20", "DE:=20"} {
+		_, err := ParseTaxRates(spec)
+		assert.ErrorIs(t, err, ErrInvalidTaxRate, spec)
+	}
+}
+
+func TestPricingCalculator_CalculateTaxedPrice(t *testing.T) {
+	rates, err := ParseTaxRates("standard=20,reduced=7")
+	require.NoError(t, err)
+	pc := NewPricingCalculator()
+	net := mustMoneyInCurrency(t, 1999, 100, "EUR")
+
+	taxed, err := pc.CalculateTaxedPrice(net, "reduced", "", rates)
+	require.NoError(t, err)
+	assert.True(t, taxed.Net.Equals(net))
+	assert.True(t, taxed.Tax.Equals(mustMoneyInCurrency(t, 13993, 10000, "EUR")))
+	assert.True(t, taxed.Gross.Equals(mustMoneyInCurrency(t, 213893, 10000, "EUR")))
+	assert.Zero(t, taxed.RatePercent.Cmp(big.NewRat(7, 1)))
+
+	_, err = pc.CalculateTaxedPrice(net, "luxury", "", rates)
+	assert.ErrorIs(t, err, ErrNoTaxRate)
+	_, err = pc.CalculateTaxedPrice(net, "standard", "", nil)
+	assert.ErrorIs(t, err, ErrNoTaxRate)
+}
@@ -0,0 +1,77 @@
+// Package eventbus provides an in-process publish/subscribe bus for domain events.
+//
+// The bus complements the transactional outbox: it lets components in the same process
+// (cache invalidation, metrics) react to events synchronously after a command has been
+// committed. Delivery is best effort; events published to the bus are not persisted and
+// are lost if the process stops, so anything that must not miss an event reads the outbox.
+package eventbus
+
+import (
+	"context"
+	"expvar"
+	"sync"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// AllEvents subscribes a handler to every event type.
+const AllEvents = "*"
+
+// Handler reacts to a committed domain event.
+// Handlers run synchronously on the publishing goroutine and must be quick and safe for
+// concurrent use. They cannot fail the command, which has already been committed.
+type Handler func(ctx context.Context, event domain.DomainEvent)
+
+// Bus dispatches domain events to subscribed handlers.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// NewBus creates a new Bus without subscribers.
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[string][]Handler)}
+}
+
+// Subscribe registers h for events of the given type, or for all events with AllEvents.
+func (b *Bus) Subscribe(eventType string, h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], h)
+}
+
+// Publish delivers events in order. For each event, handlers subscribed to its type run
+// first, in subscription order, followed by handlers subscribed to AllEvents.
+// A panicking handler is logged and does not prevent delivery to the others.
+func (b *Bus) Publish(ctx context.Context, events ...domain.DomainEvent) {
+	for _, event := range events {
+		b.mu.RLock()
+		handlers := make([]Handler, 0, len(b.handlers[event.EventType()])+len(b.handlers[AllEvents]))
+		handlers = append(handlers, b.handlers[event.EventType()]...)
+		handlers = append(handlers, b.handlers[AllEvents]...)
+		b.mu.RUnlock()
+
+		for _, h := range handlers {
+			deliver(ctx, h, event)
+		}
+	}
+}
+
+func deliver(ctx context.Context, h Handler, event domain.DomainEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			logging.Errorf("eventbus: handler panicked on %s for %s: %v", event.EventType(), event.AggregateID(), r)
+		}
+	}()
+	h(ctx, event)
+}
+
+// eventsPublished counts events delivered to the bus, keyed by event type.
+var eventsPublished = expvar.NewMap("eventbus_events_published")
+
+// CountEvents is a Handler that counts events by type in the eventbus_events_published
+// expvar map. Subscribe it with AllEvents.
+func CountEvents(_ context.Context, event domain.DomainEvent) {
+	eventsPublished.Add(event.EventType(), 1)
+}
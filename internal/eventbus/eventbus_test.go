@@ -0,0 +1,94 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBus_Publish(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	activated := domain.NewProductActivatedEvent("product-1", now)
+	applied := domain.NewDiscountAppliedEvent("product-1", "discount-1", nil, 0, now, now.Add(time.Hour), now)
+
+	tests := []struct {
+		name      string
+		subscribe []string
+		expected  []string
+	}{
+		{
+			name:      "no subscribers",
+			subscribe: nil,
+			expected:  nil,
+		},
+		{
+			name:      "by type",
+			subscribe: []string{"product.activated"},
+			expected:  []string{"product.activated:product.activated"},
+		},
+		{
+			name:      "all events",
+			subscribe: []string{AllEvents},
+			expected:  []string{"*:product.activated", "*:product.discount_applied"},
+		},
+		{
+			name:      "typed handlers before all events",
+			subscribe: []string{AllEvents, "product.discount_applied"},
+			expected: []string{
+				"*:product.activated",
+				"product.discount_applied:product.discount_applied",
+				"*:product.discount_applied",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := NewBus()
+			var received []string
+			for _, eventType := range tt.subscribe {
+				bus.Subscribe(eventType, func(_ context.Context, event domain.DomainEvent) {
+					received = append(received, eventType+":"+event.EventType())
+				})
+			}
+
+			bus.Publish(context.Background(), activated, applied)
+
+			assert.Equal(t, tt.expected, received)
+		})
+	}
+}
+
+func TestBus_PublishRecoversFromPanic(t *testing.T) {
+	bus := NewBus()
+	var delivered int
+	bus.Subscribe(AllEvents, func(context.Context, domain.DomainEvent) {
+		panic("boom")
+	})
+	bus.Subscribe(AllEvents, func(context.Context, domain.DomainEvent) {
+		delivered++
+	})
+
+	assert.NotPanics(t, func() {
+		bus.Publish(context.Background(), domain.NewProductArchivedEvent("product-1", time.Now()))
+	})
+	assert.Equal(t, 1, delivered)
+}
+
+func TestCountEvents(t *testing.T) {
+	before := counter("product.archived")
+
+	CountEvents(context.Background(), domain.NewProductArchivedEvent("product-1", time.Now()))
+
+	assert.Equal(t, before+1, counter("product.archived"))
+}
+
+func counter(eventType string) int64 {
+	if v, ok := eventsPublished.Get(eventType).(interface{ Value() int64 }); ok {
+		return v.Value()
+	}
+	return 0
+}
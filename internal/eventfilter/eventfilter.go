@@ -0,0 +1,391 @@
+// Package eventfilter parses and evaluates filter expressions over the fields of an event.
+//
+// An expression compares fields with literals and combines the comparisons with &&, ||,
+// ! and parentheses, e.g.
+//
+//	category == "Electronics" && event_type == "product.price_changed"
+//
+// Fields are identifiers such as event_type; literals are double-quoted strings, numbers,
+// true, false and null. == and != compare any values, while <, <=, > and >= only hold
+// between two numbers or two strings. A field the event does not have is null, so it is
+// == null and != any other literal. && binds tighter than ||.
+package eventfilter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFilter is returned for expressions that cannot be parsed.
+var ErrInvalidFilter = errors.New("invalid filter expression")
+
+// MaxExpressionLength is the longest expression Parse accepts, in bytes.
+const MaxExpressionLength = 4096
+
+// Fields looks up the value of a field of an event. Values are strings, float64 numbers,
+// bools or nil, as decoded from JSON.
+type Fields func(name string) (interface{}, bool)
+
+// Filter is a parsed filter expression.
+type Filter struct {
+	expr string
+	root node
+}
+
+// Parse parses a filter expression. It returns an error wrapping ErrInvalidFilter, with
+// the offset of the problem, if the expression is blank or malformed.
+func Parse(expr string) (*Filter, error) {
+	if len(expr) > MaxExpressionLength {
+		return nil, fmt.Errorf("%w: longer than %d bytes", ErrInvalidFilter, MaxExpressionLength)
+	}
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, fmt.Errorf("%w: empty expression", ErrInvalidFilter)
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.unexpected(t)
+	}
+	return &Filter{expr: strings.TrimSpace(expr), root: root}, nil
+}
+
+// Match reports whether an event with the given fields satisfies the filter.
+// A nil filter matches every event.
+func (f *Filter) Match(fields Fields) bool {
+	if f == nil {
+		return true
+	}
+	return f.root.eval(fields)
+}
+
+// String returns the expression the filter was parsed from.
+func (f *Filter) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.expr
+}
+
+type node interface {
+	eval(fields Fields) bool
+}
+
+type orNode struct{ left, right node }
+
+func (n orNode) eval(fields Fields) bool { return n.left.eval(fields) || n.right.eval(fields) }
+
+type andNode struct{ left, right node }
+
+func (n andNode) eval(fields Fields) bool { return n.left.eval(fields) && n.right.eval(fields) }
+
+type notNode struct{ operand node }
+
+func (n notNode) eval(fields Fields) bool { return !n.operand.eval(fields) }
+
+type comparisonNode struct {
+	field string
+	op    string
+	value interface{}
+}
+
+func (n comparisonNode) eval(fields Fields) bool {
+	actual, ok := fields(n.field)
+	if !ok {
+		actual = nil
+	}
+	switch n.op {
+	case "==":
+		return equal(actual, n.value)
+	case "!=":
+		return !equal(actual, n.value)
+	}
+
+	var cmp int
+	switch a := actual.(type) {
+	case float64:
+		b, ok := n.value.(float64)
+		if !ok {
+			return false
+		}
+		switch {
+		case a < b:
+			cmp = -1
+		case a > b:
+			cmp = 1
+		}
+	case string:
+		b, ok := n.value.(string)
+		if !ok {
+			return false
+		}
+		cmp = strings.Compare(a, b)
+	default:
+		return false
+	}
+	switch n.op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+// equal compares two values. Numbers of different Go types, as decoded by different JSON
+// decoders, are compared by value.
+func equal(a, b interface{}) bool {
+	if x, ok := number(a); ok {
+		y, ok := number(b)
+		return ok && x == y
+	}
+	return a == b
+}
+
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int64:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	// value is the decoded literal of string and number tokens.
+	value interface{}
+	pos   int
+}
+
+// operators lists the operators, two-character ones first so they win over their prefixes.
+var operators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!"}
+
+func tokenize(expr string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case c == '"':
+			end := i + 1
+			for end < len(expr) && expr[end] != '"' {
+				if expr[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(expr) {
+				return nil, fmt.Errorf("%w: unterminated string at offset %d", ErrInvalidFilter, i)
+			}
+			value, err := strconv.Unquote(expr[i : end+1])
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid string at offset %d", ErrInvalidFilter, i)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: expr[i : end+1], value: value, pos: i})
+			i = end + 1
+		case c == '-' || c == '.' || (c >= '0' && c <= '9'):
+			end := i + 1
+			for end < len(expr) && (expr[end] == '.' || (expr[end] >= '0' && expr[end] <= '9')) {
+				end++
+			}
+			value, err := strconv.ParseFloat(expr[i:end], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid number %q at offset %d", ErrInvalidFilter, expr[i:end], i)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: expr[i:end], value: value, pos: i})
+			i = end
+		case c == '_' || isLetter(c):
+			end := i + 1
+			for end < len(expr) && isIdentChar(expr[end]) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: expr[i:end], pos: i})
+			i = end
+		default:
+			op := ""
+			for _, candidate := range operators {
+				if strings.HasPrefix(expr[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("%w: unexpected character %q at offset %d", ErrInvalidFilter, c, i)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: op, pos: i})
+			i += len(op)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(expr)}), nil
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// isIdentChar reports whether c may continue a field name. Dots are allowed so nested
+// fields such as discount.kind can be named.
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '.' || (c >= '0' && c <= '9') || isLetter(c)
+}
+
+// parser is a recursive descent parser over the grammar
+//
+//	or         = and { "||" and }
+//	and        = unary { "&&" unary }
+//	unary      = "!" unary | "(" or ")" | comparison
+//	comparison = field ( "==" | "!=" | "<" | "<=" | ">" | ">=" ) literal
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isOperator(op string) bool {
+	t := p.peek()
+	return t.kind == tokenOperator && t.text == op
+}
+
+func (p *parser) unexpected(t token) error {
+	if t.kind == tokenEOF {
+		return fmt.Errorf("%w: unexpected end of expression", ErrInvalidFilter)
+	}
+	return fmt.Errorf("%w: unexpected %q at offset %d", ErrInvalidFilter, t.text, t.pos)
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("||") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("&&") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isOperator("!") {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+	if p.peek().kind == tokenLParen {
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokenRParen {
+			return nil, p.unexpected(t)
+		}
+		return inner, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	field := p.next()
+	if field.kind != tokenIdent || isKeyword(field.text) {
+		return nil, p.unexpected(field)
+	}
+	op := p.next()
+	if op.kind != tokenOperator || op.text == "&&" || op.text == "||" || op.text == "!" {
+		return nil, p.unexpected(op)
+	}
+
+	literal := p.next()
+	var value interface{}
+	switch {
+	case literal.kind == tokenString || literal.kind == tokenNumber:
+		value = literal.value
+	case literal.kind == tokenIdent && literal.text == "true":
+		value = true
+	case literal.kind == tokenIdent && literal.text == "false":
+		value = false
+	case literal.kind == tokenIdent && literal.text == "null":
+		value = nil
+	default:
+		return nil, p.unexpected(literal)
+	}
+	if op.text != "==" && op.text != "!=" {
+		if _, ok := value.(bool); ok || value == nil {
+			return nil, fmt.Errorf("%w: %s needs a number or a string at offset %d", ErrInvalidFilter, op.text, literal.pos)
+		}
+	}
+	return comparisonNode{field: field.text, op: op.text, value: value}, nil
+}
+
+func isKeyword(ident string) bool {
+	return ident == "true" || ident == "false" || ident == "null"
+}
@@ -0,0 +1,109 @@
+package eventfilter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fields(values map[string]interface{}) Fields {
+	return func(name string) (interface{}, bool) {
+		value, ok := values[name]
+		return value, ok
+	}
+}
+
+func TestFilter_Match(t *testing.T) {
+	event := fields(map[string]interface{}{
+		"event_type":       "product.price_changed",
+		"category":         "Electronics",
+		"discount.percent": 25.0,
+		"replayed":         false,
+		"description":      nil,
+	})
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`category == "Electronics" && event_type == "product.price_changed"`, true},
+		{`category == "Toys" && event_type == "product.price_changed"`, false},
+		{`category == "Toys" || event_type == "product.price_changed"`, true},
+		{`category == "Toys" || category == "Books" && event_type == "product.price_changed"`, false},
+		{`(category == "Toys" || category == "Electronics") && event_type == "product.price_changed"`, true},
+		{`!(category == "Electronics")`, false},
+		{`!!(category == "Electronics")`, true},
+		{`category != "Toys"`, true},
+		{`discount.percent >= 25`, true},
+		{`discount.percent > 25`, false},
+		{`discount.percent < 30.5`, true},
+		{`discount.percent == 25`, true},
+		{`discount.percent == "25"`, false},
+		{`category < "F"`, true},
+		{`category > 5`, false},
+		{`replayed == false`, true},
+		{`description == null`, true},
+		{`missing == null`, true},
+		{`missing != "x"`, true},
+		{`missing == "x"`, false},
+		{`missing < 10`, false},
+		{`event_type == "product.price_changed"`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			filter, err := Parse(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, filter.Match(event))
+		})
+	}
+}
+
+func TestFilter_NilMatchesEverything(t *testing.T) {
+	var filter *Filter
+	assert.True(t, filter.Match(fields(nil)))
+	assert.Empty(t, filter.String())
+}
+
+func TestFilter_String(t *testing.T) {
+	filter, err := Parse(`  category == "Toys" `)
+	require.NoError(t, err)
+	assert.Equal(t, `category == "Toys"`, filter.String())
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		expr    string
+		message string
+	}{
+		{"", "empty expression"},
+		{"   ", "empty expression"},
+		{`category`, "unexpected end of expression"},
+		{`category ==`, "unexpected end of expression"},
+		{`category = "Toys"`, `unexpected character '='`},
+		{`category == Toys`, `unexpected "Toys" at offset 12`},
+		{`"Toys" == category`, `unexpected "\"Toys\"" at offset 0`},
+		{`category == "Toys`, "unterminated string at offset 12"},
+		{`category == "\q"`, "invalid string at offset 12"},
+		{`price == 1.2.3`, `invalid number "1.2.3"`},
+		{`category == "Toys" &&`, "unexpected end of expression"},
+		{`category == "Toys" "Books"`, `unexpected "\"Books\"" at offset 19`},
+		{`(category == "Toys"`, "unexpected end of expression"},
+		{`category == "Toys")`, `unexpected ")" at offset 18`},
+		{`category && "Toys"`, `unexpected "&&" at offset 9`},
+		{`true == category`, `unexpected "true" at offset 0`},
+		{`replayed < true`, "< needs a number or a string at offset 11"},
+		{`category >= null`, ">= needs a number or a string at offset 12"},
+		{strings.Repeat(" ", MaxExpressionLength+1), "longer than 4096 bytes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := Parse(tt.expr)
+			require.ErrorIs(t, err, ErrInvalidFilter)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
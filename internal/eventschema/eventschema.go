@@ -0,0 +1,264 @@
+// Package eventschema validates outbox event payloads against per-event-type JSON Schemas.
+//
+// The schemas live in schemas/<event type>.json and are embedded into the binary. Only the
+// subset of JSON Schema used by these files is supported: type, required, properties,
+// additionalProperties (boolean), items, minItems, maxItems, enum, const, minimum,
+// exclusiveMinimum, maximum, minLength, pattern, format "date-time" and $ref to another file
+// in schemas/.
+package eventschema
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Validation errors.
+var (
+	// ErrUnknownEventType is returned for event types without a schema.
+	ErrUnknownEventType = errors.New("no schema for event type")
+	// ErrInvalidPayload is returned when a payload does not match its schema.
+	ErrInvalidPayload = errors.New("event payload does not match schema")
+)
+
+//go:embed schemas/*.json
+var schemaFS embed.FS
+
+// schemas holds the parsed schema files keyed by file name.
+var schemas = mustLoadSchemas()
+
+func mustLoadSchemas() map[string]map[string]interface{} {
+	entries, err := schemaFS.ReadDir("schemas")
+	if err != nil {
+		panic(err)
+	}
+
+	loaded := make(map[string]map[string]interface{}, len(entries))
+	for _, entry := range entries {
+		raw, err := schemaFS.ReadFile(path.Join("schemas", entry.Name()))
+		if err != nil {
+			panic(err)
+		}
+		var schema map[string]interface{}
+		if err := json.Unmarshal(raw, &schema); err != nil {
+			panic(fmt.Sprintf("eventschema: parse %s: %v", entry.Name(), err))
+		}
+		loaded[entry.Name()] = schema
+	}
+	return loaded
+}
+
+// EventTypes returns the event types that have a schema, sorted.
+func EventTypes() []string {
+	types := make([]string, 0, len(schemas))
+	for name := range schemas {
+		if name != "snapshot.json" {
+			types = append(types, strings.TrimSuffix(name, ".json"))
+		}
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Validate checks the serialized payload of an event of the given type against the
+// schema of that type. Errors wrap ErrUnknownEventType or ErrInvalidPayload.
+func Validate(eventType string, payload []byte) error {
+	schema, ok := schemas[eventType+".json"]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownEventType, eventType)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidPayload, eventType, err)
+	}
+
+	if err := validate(schema, value, "$"); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidPayload, eventType, err)
+	}
+	return nil
+}
+
+// validate checks value against schema; at is the JSON path used in error messages.
+func validate(schema map[string]interface{}, value interface{}, at string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		target, ok := schemas[ref]
+		if !ok {
+			return fmt.Errorf("%s: unknown $ref %q", at, ref)
+		}
+		return validate(target, value, at)
+	}
+
+	if t, ok := schema["type"]; ok && !matchesType(t, value) {
+		return fmt.Errorf("%s: expected type %v, got %s", at, t, typeName(value))
+	}
+	if c, ok := schema["const"]; ok && !equalJSON(c, value) {
+		return fmt.Errorf("%s: expected %v", at, c)
+	}
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, e := range enum {
+			if equalJSON(e, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: must be one of %v", at, enum)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return validateString(schema, v, at)
+	case json.Number:
+		return validateNumber(schema, v, at)
+	case map[string]interface{}:
+		return validateObject(schema, v, at)
+	case []interface{}:
+		return validateArray(schema, v, at)
+	}
+	return nil
+}
+
+func validateString(schema map[string]interface{}, s string, at string) error {
+	if min, ok := schema["minLength"].(float64); ok && float64(len([]rune(s))) < min {
+		return fmt.Errorf("%s: shorter than %v characters", at, min)
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("%s: invalid pattern %q: %w", at, pattern, err)
+		}
+		if !re.MatchString(s) {
+			return fmt.Errorf("%s: does not match %s", at, pattern)
+		}
+	}
+	if schema["format"] == "date-time" {
+		if _, err := time.Parse(time.RFC3339Nano, s); err != nil {
+			return fmt.Errorf("%s: not an RFC 3339 date-time", at)
+		}
+	}
+	return nil
+}
+
+func validateNumber(schema map[string]interface{}, n json.Number, at string) error {
+	f, err := n.Float64()
+	if err != nil {
+		return fmt.Errorf("%s: %v", at, err)
+	}
+	if min, ok := schema["minimum"].(float64); ok && f < min {
+		return fmt.Errorf("%s: less than %v", at, min)
+	}
+	if min, ok := schema["exclusiveMinimum"].(float64); ok && f <= min {
+		return fmt.Errorf("%s: must be greater than %v", at, min)
+	}
+	if max, ok := schema["maximum"].(float64); ok && f > max {
+		return fmt.Errorf("%s: greater than %v", at, max)
+	}
+	return nil
+}
+
+func validateObject(schema map[string]interface{}, obj map[string]interface{}, at string) error {
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, r := range required {
+			if _, ok := obj[r.(string)]; !ok {
+				return fmt.Errorf("%s.%s: required", at, r)
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		prop, ok := properties[key].(map[string]interface{})
+		if !ok {
+			if schema["additionalProperties"] == false {
+				return fmt.Errorf("%s.%s: unexpected property", at, key)
+			}
+			continue
+		}
+		if err := validate(prop, obj[key], at+"."+key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateArray(schema map[string]interface{}, items []interface{}, at string) error {
+	if min, ok := schema["minItems"].(float64); ok && float64(len(items)) < min {
+		return fmt.Errorf("%s: fewer than %v items", at, min)
+	}
+	if max, ok := schema["maxItems"].(float64); ok && float64(len(items)) > max {
+		return fmt.Errorf("%s: more than %v items", at, max)
+	}
+	if itemSchema, ok := schema["items"].(map[string]interface{}); ok {
+		for i, item := range items {
+			if err := validate(itemSchema, item, fmt.Sprintf("%s[%d]", at, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// matchesType reports whether value has the JSON type t, a type name or a list of names.
+func matchesType(t interface{}, value interface{}) bool {
+	if names, ok := t.([]interface{}); ok {
+		for _, name := range names {
+			if matchesType(name, value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	name := typeName(value)
+	return name == t || (t == "number" && name == "integer")
+}
+
+// typeName returns the JSON Schema type of a value decoded with UseNumber.
+func typeName(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// equalJSON compares a schema value (numbers decoded as float64) with a payload value
+// (numbers decoded as json.Number).
+func equalJSON(schemaValue, value interface{}) bool {
+	if n, ok := value.(json.Number); ok {
+		f, err := n.Float64()
+		return err == nil && schemaValue == f
+	}
+	return reflect.DeepEqual(schemaValue, value)
+}
@@ -0,0 +1,293 @@
+package eventschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventTypes(t *testing.T) {
+	assert.Equal(t, []string{
+		"campaign.activated",
+		"campaign.created",
+		"campaign.ended",
+		"catalog.digest",
+		"experiment.exposure",
+		"notification.back_in_stock",
+		"notification.discounted",
+		"notification.subscription_cancelled",
+		"price_list.created",
+		"price_list.entries_changed",
+		"product.activated",
+		"product.approved",
+		"product.archived",
+		"product.attachments_changed",
+		"product.attribute_changed",
+		"product.back_in_stock",
+		"product.channel_availability_changed",
+		"product.compliance_changed",
+		"product.cost_price_changed",
+		"product.created",
+		"product.deactivated",
+		"product.dimensions_changed",
+		"product.discount_applied",
+		"product.discount_approved",
+		"product.discount_ended",
+		"product.discount_expired",
+		"product.discount_removed",
+		"product.discount_resumed",
+		"product.discount_started",
+		"product.discount_suspended",
+		"product.identifiers_changed",
+		"product.images_changed",
+		"product.kind_changed",
+		"product.linked",
+		"product.market_prices_changed",
+		"product.minimum_price_changed",
+		"product.out_of_stock",
+		"product.price_book_changed",
+		"product.price_change_cancelled",
+		"product.price_change_scheduled",
+		"product.price_changed",
+		"product.price_tiers_changed",
+		"product.purged",
+		"product.rejected",
+		"product.segment_prices_changed",
+		"product.slug_changed",
+		"product.status_scheduled",
+		"product.stock_changed",
+		"product.submitted_for_review",
+		"product.tags_changed",
+		"product.tax_class_changed",
+		"product.translation_changed",
+		"product.unlinked",
+		"product.updated",
+		"product_variant.added",
+		"product_variant.discontinued",
+		"product_variant.updated",
+	}, EventTypes())
+}
+
+func TestValidate(t *testing.T) {
+	const (
+		created = `"event_type": "product.created", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z",
+			"name": "Widget", "description": "", "category": "Tools"`
+		snapshot = `"product_id": "product-123", "name": "Widget", "slug": "widget", "sku": null, "gtin": null, "description": "", "category": "Tools",
+			"base_price_numerator": 1999, "base_price_denominator": 100, "currency": "USD",
+			"effective_price_numerator": 1999, "effective_price_denominator": 100,
+			"has_active_discount": false, "discounts": [], "price_tiers": [], "price_book": [], "segment_prices": [], "market_prices": [], "tax_class": "standard",
+			"minimum_price_numerator": null, "minimum_price_denominator": null,
+			"cost_price_numerator": null, "cost_price_denominator": null, "pending_price_change": null,
+			"activate_at": null, "deactivate_at": null, "review": null, "weight": null, "dimensions": null, "kind": null, "compliance": null, "channels": ["marketplace", "retail", "web"], "tags": [], "attributes": {}, "status": "active",
+			"created_at": "2024-06-01T12:00:00Z", "updated_at": "2024-06-01T12:00:00Z", "archived_at": null`
+		notification = `"event_type": "notification.back_in_stock", "aggregate_id": "product-123",
+			"occurred_at": "2024-06-01T12:00:00Z", "trigger_event_type": "product.activated", "name": "Widget",
+			"effective_price_numerator": 1999, "effective_price_denominator": 100, "currency": "USD"`
+	)
+
+	tests := []struct {
+		name      string
+		eventType string
+		payload   string
+		wantErr   error
+		contains  string
+	}{
+		{
+			name:      "valid created event",
+			eventType: "product.created",
+			payload:   `{` + created + `, "base_price_numerator": 1999, "base_price_denominator": 100, "currency": "USD"}`,
+		},
+		{
+			name:      "missing base price",
+			eventType: "product.created",
+			payload:   `{` + created + `}`,
+			wantErr:   ErrInvalidPayload,
+			contains:  "$.base_price_numerator: required",
+		},
+		{
+			name:      "zero denominator",
+			eventType: "product.created",
+			payload:   `{` + created + `, "base_price_numerator": 1999, "base_price_denominator": 0, "currency": "USD"}`,
+			wantErr:   ErrInvalidPayload,
+			contains:  "$.base_price_denominator: must be greater than 0",
+		},
+		{
+			name:      "fractional price",
+			eventType: "product.created",
+			payload:   `{` + created + `, "base_price_numerator": 19.99, "base_price_denominator": 1, "currency": "USD"}`,
+			wantErr:   ErrInvalidPayload,
+			contains:  "$.base_price_numerator: expected type integer",
+		},
+		{
+			name:      "unexpected property",
+			eventType: "product.created",
+			payload:   `{` + created + `, "base_price_numerator": 1999, "base_price_denominator": 100, "currency": "USD", "price": 19.99}`,
+			wantErr:   ErrInvalidPayload,
+			contains:  "$.price: unexpected property",
+		},
+		{
+			name:      "lower case currency",
+			eventType: "product.created",
+			payload:   `{` + created + `, "base_price_numerator": 1999, "base_price_denominator": 100, "currency": "usd"}`,
+			wantErr:   ErrInvalidPayload,
+			contains:  "$.currency: does not match",
+		},
+		{
+			name:      "event type mismatch",
+			eventType: "product.activated",
+			payload:   `{"event_type": "product.archived", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z"}`,
+			wantErr:   ErrInvalidPayload,
+			contains:  "$.event_type: expected product.activated",
+		},
+		{
+			name:      "invalid timestamp",
+			eventType: "product.activated",
+			payload:   `{"event_type": "product.activated", "aggregate_id": "product-123", "occurred_at": "yesterday"}`,
+			wantErr:   ErrInvalidPayload,
+			contains:  "$.occurred_at: not an RFC 3339 date-time",
+		},
+		{
+			name:      "valid snapshot",
+			eventType: "product.activated",
+			payload: `{"event_type": "product.activated", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z",
+				"snapshot": {` + snapshot + `, "discount": null}}`,
+		},
+		{
+			name:      "snapshot with unknown status",
+			eventType: "product.activated",
+			payload: `{"event_type": "product.activated", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z",
+				"snapshot": {` + snapshot + `, "discount": null, "status": "deleted"}}`,
+			wantErr:  ErrInvalidPayload,
+			contains: "$.snapshot.status: must be one of",
+		},
+		{
+			name:      "snapshot discount out of range",
+			eventType: "product.activated",
+			payload: `{"event_type": "product.activated", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z",
+				"snapshot": {` + snapshot + `, "discount": {"id": "discount-1", "percentage": 120, "priority": 0,
+				"start_date": "2024-06-01T12:00:00Z", "end_date": "2024-06-02T12:00:00Z", "suspended": false}}}`,
+			wantErr:  ErrInvalidPayload,
+			contains: "$.snapshot.discount.percentage: greater than 100",
+		},
+		{
+			name:      "valid price tiers",
+			eventType: "product.price_tiers_changed",
+			payload: `{"event_type": "product.price_tiers_changed", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z",
+				"currency": "USD", "price_tiers": [
+					{"min_quantity": 10, "unit_price_numerator": 1799, "unit_price_denominator": 100, "percent_off": null},
+					{"min_quantity": 100, "unit_price_numerator": null, "unit_price_denominator": null, "percent_off": 15}]}`,
+		},
+		{
+			name:      "price tier for a single unit",
+			eventType: "product.price_tiers_changed",
+			payload: `{"event_type": "product.price_tiers_changed", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z",
+				"currency": "USD", "price_tiers": [{"min_quantity": 1, "unit_price_numerator": null, "unit_price_denominator": null, "percent_off": 5}]}`,
+			wantErr:  ErrInvalidPayload,
+			contains: "$.price_tiers[0].min_quantity: less than 2",
+		},
+		{
+			name:      "valid price book",
+			eventType: "product.price_book_changed",
+			payload: `{"event_type": "product.price_book_changed", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z",
+				"prices": [{"currency": "EUR", "price_numerator": 1849, "price_denominator": 100}]}`,
+		},
+		{
+			name:      "price book currency not a code",
+			eventType: "product.price_book_changed",
+			payload: `{"event_type": "product.price_book_changed", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z",
+				"prices": [{"currency": "euro", "price_numerator": 1849, "price_denominator": 100}]}`,
+			wantErr:  ErrInvalidPayload,
+			contains: "$.prices[0].currency",
+		},
+		{
+			name:      "valid segment prices",
+			eventType: "product.segment_prices_changed",
+			payload: `{"event_type": "product.segment_prices_changed", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z",
+				"currency": "USD", "segment_prices": [
+					{"segment": "employee", "price_numerator": 1499, "price_denominator": 100, "percent_off": null},
+					{"segment": "wholesale", "price_numerator": null, "price_denominator": null, "percent_off": 15}]}`,
+		},
+		{
+			name:      "segment percent off over 100",
+			eventType: "product.segment_prices_changed",
+			payload: `{"event_type": "product.segment_prices_changed", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z",
+				"currency": "USD", "segment_prices": [{"segment": "staff", "price_numerator": null, "price_denominator": null, "percent_off": 120}]}`,
+			wantErr:  ErrInvalidPayload,
+			contains: "$.segment_prices[0].percent_off: greater than 100",
+		},
+		{
+			name:      "valid market prices",
+			eventType: "product.market_prices_changed",
+			payload: `{"event_type": "product.market_prices_changed", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z",
+				"market_prices": [{"market": "EU", "currency": "EUR", "price_numerator": 1849, "price_denominator": 100}]}`,
+		},
+		{
+			name:      "unknown market",
+			eventType: "product.market_prices_changed",
+			payload: `{"event_type": "product.market_prices_changed", "aggregate_id": "product-123", "occurred_at": "2024-06-01T12:00:00Z",
+				"market_prices": [{"market": "JP", "currency": "JPY", "price_numerator": 2000, "price_denominator": 1}]}`,
+			wantErr:  ErrInvalidPayload,
+			contains: "$.market_prices[0].market: must be one of",
+		},
+		{
+			name:      "valid notification",
+			eventType: "notification.back_in_stock",
+			payload:   `{` + notification + `, "subscriber_ids": ["customer-1", "customer-2"]}`,
+		},
+		{
+			name:      "empty subscriber list",
+			eventType: "notification.back_in_stock",
+			payload:   `{` + notification + `, "subscriber_ids": []}`,
+			wantErr:   ErrInvalidPayload,
+			contains:  "$.subscriber_ids: fewer than 1 items",
+		},
+		{
+			name:      "empty subscriber ID",
+			eventType: "notification.back_in_stock",
+			payload:   `{` + notification + `, "subscriber_ids": ["customer-1", ""]}`,
+			wantErr:   ErrInvalidPayload,
+			contains:  "$.subscriber_ids[1]: shorter than 1 characters",
+		},
+		{
+			name:      "valid subscription cancellation",
+			eventType: "notification.subscription_cancelled",
+			payload: `{"event_type": "notification.subscription_cancelled", "aggregate_id": "product-123",
+				"occurred_at": "2024-06-01T12:00:00Z", "trigger_event_type": "product.archived", "kind": "discounted",
+				"subscriber_ids": ["customer-1"], "name": "Widget"}`,
+		},
+		{
+			name:      "subscription cancellation of unknown kind",
+			eventType: "notification.subscription_cancelled",
+			payload: `{"event_type": "notification.subscription_cancelled", "aggregate_id": "product-123",
+				"occurred_at": "2024-06-01T12:00:00Z", "trigger_event_type": "product.archived", "kind": "price_drop",
+				"subscriber_ids": ["customer-1"], "name": "Widget"}`,
+			wantErr:  ErrInvalidPayload,
+			contains: "$.kind",
+		},
+		{
+			name:      "not JSON",
+			eventType: "product.activated",
+			payload:   `{`,
+			wantErr:   ErrInvalidPayload,
+		},
+		{
+			name:      "unknown event type",
+			eventType: "product.renamed",
+			payload:   `{}`,
+			wantErr:   ErrUnknownEventType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.eventType, []byte(tt.payload))
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Contains(t, err.Error(), tt.contains)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Package experiment assigns the customers being priced to the variants of pricing
+// experiments.
+//
+// An experiment targets products by ID or category and splits subjects (customers or
+// sessions) between weighted variants. The assignment hashes the experiment ID with the
+// subject ID, so a subject always sees the same variant of an experiment without any state
+// being stored. A variant may scale the prices of the product or replace its discount.
+package experiment
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/big"
+	"os"
+)
+
+// ErrInvalidExperiment is returned for experiment definitions that cannot be used.
+var ErrInvalidExperiment = errors.New("invalid pricing experiment")
+
+// Variant is one arm of an experiment.
+type Variant struct {
+	Name string
+	// Weight is the share of subjects assigned to the variant, relative to the weights of
+	// the other variants.
+	Weight int
+	// PriceFactor multiplies every price of the product; nil keeps them.
+	PriceFactor *big.Rat
+	// DiscountPercent replaces the discount that applies to the product, 0 removing it;
+	// nil keeps it.
+	DiscountPercent *big.Rat
+}
+
+// Experiment is a pricing experiment on a set of products.
+type Experiment struct {
+	ID         string
+	ProductIDs []string
+	Categories []string
+	Variants   []Variant
+}
+
+// Assignment is the variant of an experiment a subject is assigned to.
+type Assignment struct {
+	ExperimentID string
+	Variant      Variant
+}
+
+// Set holds the running experiments.
+type Set struct {
+	experiments []Experiment
+}
+
+// NewSet validates the experiments and returns a set of them. Experiments need a unique
+// ID, at least one product or category and at least one variant; variants need a unique
+// name, a positive weight, a positive price factor and a discount in [0, 100).
+func NewSet(experiments []Experiment) (*Set, error) {
+	ids := make(map[string]bool, len(experiments))
+	for _, e := range experiments {
+		if e.ID == "" || ids[e.ID] {
+			return nil, fmt.Errorf("%w: experiment IDs must be non-empty and unique", ErrInvalidExperiment)
+		}
+		ids[e.ID] = true
+		if len(e.ProductIDs) == 0 && len(e.Categories) == 0 {
+			return nil, fmt.Errorf("%w: %s targets no product or category", ErrInvalidExperiment, e.ID)
+		}
+		if len(e.Variants) == 0 {
+			return nil, fmt.Errorf("%w: %s has no variant", ErrInvalidExperiment, e.ID)
+		}
+		names := make(map[string]bool, len(e.Variants))
+		for _, v := range e.Variants {
+			switch {
+			case v.Name == "" || names[v.Name]:
+				return nil, fmt.Errorf("%w: %s: variant names must be non-empty and unique", ErrInvalidExperiment, e.ID)
+			case v.Weight <= 0:
+				return nil, fmt.Errorf("%w: %s/%s: weight must be positive", ErrInvalidExperiment, e.ID, v.Name)
+			case v.PriceFactor != nil && v.PriceFactor.Sign() <= 0:
+				return nil, fmt.Errorf("%w: %s/%s: price factor must be positive", ErrInvalidExperiment, e.ID, v.Name)
+			case v.DiscountPercent != nil && (v.DiscountPercent.Sign() < 0 || v.DiscountPercent.Cmp(big.NewRat(100, 1)) >= 0):
+				return nil, fmt.Errorf("%w: %s/%s: discount percent must be in [0, 100)", ErrInvalidExperiment, e.ID, v.Name)
+			}
+			names[v.Name] = true
+		}
+	}
+	return &Set{experiments: experiments}, nil
+}
+
+// Assign returns the variant the subject is assigned to for the first experiment that
+// targets the product, by ID or by category. It returns false if the subject is empty or
+// no experiment targets the product.
+func (s *Set) Assign(subjectID, productID, category string) (Assignment, bool) {
+	if s == nil || subjectID == "" {
+		return Assignment{}, false
+	}
+	for _, e := range s.experiments {
+		if e.targets(productID, category) {
+			return Assignment{ExperimentID: e.ID, Variant: e.variant(subjectID)}, true
+		}
+	}
+	return Assignment{}, false
+}
+
+func (e Experiment) targets(productID, category string) bool {
+	for _, id := range e.ProductIDs {
+		if id == productID {
+			return true
+		}
+	}
+	for _, c := range e.Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// variant picks a variant by hashing the subject into the total weight of the variants.
+func (e Experiment) variant(subjectID string) Variant {
+	total := 0
+	for _, v := range e.Variants {
+		total += v.Weight
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(e.ID))
+	h.Write([]byte{0})
+	h.Write([]byte(subjectID))
+	bucket := int(h.Sum64() % uint64(total))
+
+	for _, v := range e.Variants {
+		if bucket < v.Weight {
+			return v
+		}
+		bucket -= v.Weight
+	}
+	return e.Variants[len(e.Variants)-1]
+}
+
+// fileExperiment is the JSON form of an Experiment; decimals are strings such as "0.95".
+type fileExperiment struct {
+	ID         string        `json:"id"`
+	ProductIDs []string      `json:"product_ids"`
+	Categories []string      `json:"categories"`
+	Variants   []fileVariant `json:"variants"`
+}
+
+type fileVariant struct {
+	Name            string `json:"name"`
+	Weight          int    `json:"weight"`
+	PriceFactor     string `json:"price_factor"`
+	DiscountPercent string `json:"discount_percent"`
+}
+
+// Parse decodes a JSON array of experiments and returns a set of them.
+func Parse(data []byte) (*Set, error) {
+	var file []fileExperiment
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExperiment, err)
+	}
+
+	experiments := make([]Experiment, len(file))
+	for i, fe := range file {
+		experiments[i] = Experiment{ID: fe.ID, ProductIDs: fe.ProductIDs, Categories: fe.Categories}
+		for _, fv := range fe.Variants {
+			v := Variant{Name: fv.Name, Weight: fv.Weight}
+			var err error
+			if v.PriceFactor, err = parseDecimal(fv.PriceFactor); err != nil {
+				return nil, fmt.Errorf("%w: %s/%s: price factor: %v", ErrInvalidExperiment, fe.ID, fv.Name, err)
+			}
+			if v.DiscountPercent, err = parseDecimal(fv.DiscountPercent); err != nil {
+				return nil, fmt.Errorf("%w: %s/%s: discount percent: %v", ErrInvalidExperiment, fe.ID, fv.Name, err)
+			}
+			experiments[i].Variants = append(experiments[i].Variants, v)
+		}
+	}
+	return NewSet(experiments)
+}
+
+// Load reads the experiments from a JSON file; see Parse.
+func Load(path string) (*Set, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// parseDecimal parses an optional decimal; empty means nil.
+func parseDecimal(s string) (*big.Rat, error) {
+	if s == "" {
+		return nil, nil
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a decimal", s)
+	}
+	return r, nil
+}
@@ -0,0 +1,120 @@
+package experiment
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const experimentsJSON = `[
+	{"id": "cheaper-widgets", "product_ids": ["product-1"], "variants": [
+		{"name": "control", "weight": 1},
+		{"name": "cheaper", "weight": 1, "price_factor": "0.9"}
+	]},
+	{"id": "tools-discount", "categories": ["Tools"], "variants": [
+		{"name": "control", "weight": 3},
+		{"name": "discount", "weight": 1, "discount_percent": "15"}
+	]}
+]`
+
+func TestParse(t *testing.T) {
+	set, err := Parse([]byte(experimentsJSON))
+	require.NoError(t, err)
+	require.Len(t, set.experiments, 2)
+	assert.Equal(t, 0, set.experiments[0].Variants[1].PriceFactor.Cmp(big.NewRat(9, 10)))
+	assert.Nil(t, set.experiments[0].Variants[1].DiscountPercent)
+	assert.Equal(t, 0, set.experiments[1].Variants[1].DiscountPercent.Cmp(big.NewRat(15, 1)))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"malformed", `[{"id": }]`},
+		{"missing ID", `[{"product_ids": ["p"], "variants": [{"name": "a", "weight": 1}]}]`},
+		{"duplicate ID", `[{"id": "e", "product_ids": ["p"], "variants": [{"name": "a", "weight": 1}]},
+			{"id": "e", "product_ids": ["q"], "variants": [{"name": "a", "weight": 1}]}]`},
+		{"no target", `[{"id": "e", "variants": [{"name": "a", "weight": 1}]}]`},
+		{"no variant", `[{"id": "e", "product_ids": ["p"]}]`},
+		{"duplicate variant", `[{"id": "e", "product_ids": ["p"], "variants": [{"name": "a", "weight": 1}, {"name": "a", "weight": 1}]}]`},
+		{"zero weight", `[{"id": "e", "product_ids": ["p"], "variants": [{"name": "a", "weight": 0}]}]`},
+		{"bad price factor", `[{"id": "e", "product_ids": ["p"], "variants": [{"name": "a", "weight": 1, "price_factor": "cheap"}]}]`},
+		{"negative price factor", `[{"id": "e", "product_ids": ["p"], "variants": [{"name": "a", "weight": 1, "price_factor": "-1"}]}]`},
+		{"full discount", `[{"id": "e", "product_ids": ["p"], "variants": [{"name": "a", "weight": 1, "discount_percent": "100"}]}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.json))
+			assert.ErrorIs(t, err, ErrInvalidExperiment)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "experiments.json")
+	require.NoError(t, os.WriteFile(path, []byte(experimentsJSON), 0o600))
+
+	set, err := Load(path)
+	require.NoError(t, err)
+	assert.Len(t, set.experiments, 2)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestSet_Assign(t *testing.T) {
+	set, err := Parse([]byte(experimentsJSON))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		subjectID      string
+		productID      string
+		category       string
+		wantExperiment string
+		wantOK         bool
+	}{
+		{"by product ID", "customer-1", "product-1", "Tools", "cheaper-widgets", true},
+		{"by category", "customer-1", "product-2", "Tools", "tools-discount", true},
+		{"not targeted", "customer-1", "product-2", "Garden", "", false},
+		{"no subject", "", "product-1", "Tools", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, ok := set.Assign(tt.subjectID, tt.productID, tt.category)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantExperiment, a.ExperimentID)
+		})
+	}
+
+	var none *Set
+	_, ok := none.Assign("customer-1", "product-1", "Tools")
+	assert.False(t, ok)
+}
+
+func TestSet_AssignIsStableAndWeighted(t *testing.T) {
+	set, err := Parse([]byte(experimentsJSON))
+	require.NoError(t, err)
+
+	counts := make(map[string]int)
+	for i := 0; i < 4000; i++ {
+		subject := fmt.Sprintf("customer-%d", i)
+		a, ok := set.Assign(subject, "product-9", "Tools")
+		require.True(t, ok)
+		again, _ := set.Assign(subject, "product-9", "Tools")
+		require.Equal(t, a.Variant.Name, again.Variant.Name, "a subject always gets the same variant")
+		counts[a.Variant.Name]++
+	}
+
+	// The 3:1 split holds within a few percent.
+	assert.InDelta(t, 3000, counts["control"], 150)
+	assert.InDelta(t, 1000, counts["discount"], 150)
+}
@@ -0,0 +1,151 @@
+// Package flashsale notifies storefront hooks when flash sales open and close.
+//
+// Storefronts cache product pages and banners. The outbox announces flash sales to event
+// consumers, but a CDN or page cache usually cannot consume events, so Hooks POSTs a small
+// JSON notification to configured URLs, e.g. cache purge endpoints, as soon as a flash
+// sale opens or closes. Hooks subscribes to the in-process event bus: notifications are
+// sent after the command committed, on a best-effort basis and without retries.
+package flashsale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// DefaultTimeout bounds a single hook request.
+const DefaultTimeout = 5 * time.Second
+
+// ErrInvalidHookURL is returned by ParseHookURLs for URLs that are not absolute HTTP(S)
+// URLs.
+var ErrInvalidHookURL = errors.New("invalid flash sale hook URL")
+
+// Notification states.
+const (
+	StateOpened = "opened"
+	StateClosed = "closed"
+)
+
+// Notification is the JSON body POSTed to the hooks.
+type Notification struct {
+	// State is StateOpened or StateClosed.
+	State      string    `json:"state"`
+	ProductID  string    `json:"product_id"`
+	DiscountID string    `json:"discount_id"`
+	EndDate    time.Time `json:"end_date"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// ParseHookURLs parses a comma-separated list of hook URLs. Blank entries are skipped.
+func ParseHookURLs(value string) ([]string, error) {
+	var urls []string
+	for _, raw := range strings.Split(value, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidHookURL, raw)
+		}
+		urls = append(urls, raw)
+	}
+	return urls, nil
+}
+
+// notificationsSent and notificationsFailed count hook requests by state.
+var (
+	notificationsSent   = expvar.NewMap("flash_sale_hooks_sent")
+	notificationsFailed = expvar.NewMap("flash_sale_hooks_failed")
+)
+
+// Hooks POSTs a Notification to every URL when a flash sale opens or closes.
+type Hooks struct {
+	urls   []string
+	client *http.Client
+}
+
+// NewHooks creates Hooks notifying urls. A nil client uses one with DefaultTimeout.
+func NewHooks(urls []string, client *http.Client) *Hooks {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultTimeout}
+	}
+	return &Hooks{urls: urls, client: client}
+}
+
+// Handle is an eventbus.Handler; subscribe it with eventbus.AllEvents. A flash sale opens
+// with its DiscountStartedEvent, or with its DiscountAppliedEvent if it applies right
+// away, and closes with its DiscountEndedEvent. The hooks are called in the background, so
+// Handle returns at once.
+func (h *Hooks) Handle(_ context.Context, event domain.DomainEvent) {
+	notification, ok := NotificationFor(event)
+	if !ok || len(h.urls) == 0 {
+		return
+	}
+	go h.notify(notification)
+}
+
+// NotificationFor returns the notification of an event that opens or closes a flash
+// sale, or false for any other event.
+func NotificationFor(event domain.DomainEvent) (Notification, bool) {
+	n := Notification{ProductID: event.AggregateID(), OccurredAt: event.OccurredAt()}
+	switch e := event.(type) {
+	case domain.DiscountAppliedEvent:
+		if !e.FlashSale || e.PendingApproval || e.StartDate.After(e.OccurredAt()) {
+			return Notification{}, false
+		}
+		n.State, n.DiscountID, n.EndDate = StateOpened, e.DiscountID, e.EndDate
+	case domain.DiscountStartedEvent:
+		if !e.FlashSale {
+			return Notification{}, false
+		}
+		n.State, n.DiscountID, n.EndDate = StateOpened, e.DiscountID, e.EndDate
+	case domain.DiscountEndedEvent:
+		if !e.FlashSale {
+			return Notification{}, false
+		}
+		n.State, n.DiscountID, n.EndDate = StateClosed, e.DiscountID, e.EndDate
+	default:
+		return Notification{}, false
+	}
+	return n, true
+}
+
+// notify POSTs the notification to every hook, logging failures.
+func (h *Hooks) notify(n Notification) {
+	body, err := json.Marshal(n)
+	if err != nil {
+		logging.Errorf("flash sale hooks: encode notification: %v", err)
+		return
+	}
+	for _, u := range h.urls {
+		if err := h.post(u, body); err != nil {
+			notificationsFailed.Add(n.State, 1)
+			logging.Errorf("flash sale hooks: %s for %s: %v", n.State, n.ProductID, err)
+			continue
+		}
+		notificationsSent.Add(n.State, 1)
+	}
+}
+
+func (h *Hooks) post(u string, body []byte) error {
+	resp, err := h.client.Post(u, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s: %s", u, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package flashsale
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHookURLs(t *testing.T) {
+	urls, err := ParseHookURLs(" https://cdn.example.com/purge , ,http://localhost:8081/hook")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://cdn.example.com/purge", "http://localhost:8081/hook"}, urls)
+
+	urls, err = ParseHookURLs("")
+	require.NoError(t, err)
+	assert.Empty(t, urls)
+
+	for _, value := range []string{"cdn.example.com/purge", "ftp://cdn.example.com", "https://", "http://%zz"} {
+		_, err := ParseHookURLs(value)
+		assert.ErrorIs(t, err, ErrInvalidHookURL, value)
+	}
+}
+
+func TestNotificationFor(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	end := now.Add(time.Hour)
+	applied := func(start time.Time, flash, pending bool) domain.DiscountAppliedEvent {
+		e := domain.NewDiscountAppliedEvent("product-1", "flash", big.NewRat(50, 1), 0, start, end, now)
+		e.FlashSale, e.PendingApproval = flash, pending
+		return e
+	}
+	started := domain.NewDiscountStartedEvent("product-1", "flash", big.NewRat(50, 1), now, end, now)
+	started.FlashSale = true
+	ended := domain.NewDiscountEndedEvent("product-1", "flash", end, end)
+	ended.FlashSale = true
+
+	tests := []struct {
+		name      string
+		event     domain.DomainEvent
+		wantState string
+	}{
+		{"applied and open", applied(now, true, false), StateOpened},
+		{"applied for later", applied(now.Add(time.Minute), true, false), ""},
+		{"applied pending approval", applied(now, true, true), ""},
+		{"applied discount", applied(now, false, false), ""},
+		{"started", started, StateOpened},
+		{"started discount", domain.NewDiscountStartedEvent("product-1", "d1", big.NewRat(10, 1), now, end, now), ""},
+		{"ended", ended, StateClosed},
+		{"ended discount", domain.NewDiscountEndedEvent("product-1", "d1", end, end), ""},
+		{"other event", domain.NewDiscountRemovedEvent("product-1", "flash", now), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, ok := NotificationFor(tt.event)
+			assert.Equal(t, tt.wantState != "", ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, tt.wantState, n.State)
+			assert.Equal(t, "product-1", n.ProductID)
+			assert.Equal(t, "flash", n.DiscountID)
+			assert.Equal(t, end, n.EndDate)
+			assert.Equal(t, tt.event.OccurredAt(), n.OccurredAt)
+		})
+	}
+}
+
+func TestHooks_Handle(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	received := make(chan Notification, 2)
+	var contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		var n Notification
+		if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- n
+	}))
+	defer server.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	hooks := NewHooks([]string{failing.URL, server.URL}, nil)
+	hooks.Handle(context.Background(), domain.NewDiscountRemovedEvent("product-1", "flash", now))
+	ended := domain.NewDiscountEndedEvent("product-1", "flash", now, now)
+	ended.FlashSale = true
+	hooks.Handle(context.Background(), ended)
+
+	select {
+	case n := <-received:
+		assert.Equal(t, Notification{State: StateClosed, ProductID: "product-1", DiscountID: "flash", EndDate: now, OccurredAt: now}, n)
+		assert.Equal(t, "application/json", contentType)
+	case <-time.After(5 * time.Second):
+		t.Fatal("hook not called")
+	}
+	assert.Empty(t, received, "only the flash sale is notified")
+}
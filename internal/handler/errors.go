@@ -4,9 +4,16 @@ package handler
 import (
 	"errors"
 
+	"github.com/product-catalog-service/internal/apikey"
+	"github.com/product-catalog-service/internal/availability"
 	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/pricelock"
+	"github.com/product-catalog-service/internal/query"
+	"github.com/product-catalog-service/internal/usecase"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // MapDomainErrorToGRPC converts domain errors to gRPC status errors.
@@ -19,6 +26,18 @@ func MapDomainErrorToGRPC(err error) error {
 	// Not found errors
 	case errors.Is(err, domain.ErrProductNotFound):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrDiscountNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrCampaignNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrPriceListNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrVariantNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrAPIKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrRevisionNotFound):
+		return status.Error(codes.NotFound, err.Error())
 
 	// Invalid argument errors
 	case errors.Is(err, domain.ErrInvalidID):
@@ -29,10 +48,186 @@ func MapDomainErrorToGRPC(err error) error {
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, domain.ErrInvalidBasePrice):
 		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidPriceChangeTime):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidStatusSchedule):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrSubmitterRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrReviewerRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrRejectionReasonRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, domain.ErrInvalidDiscountPercentage):
 		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidDiscountPrecision):
+		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, domain.ErrInvalidDiscountPeriod):
 		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidDiscountPriority):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidPromotionQuantity):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidNotificationKind):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidPriceTierQuantity):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidPriceTierPrice):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDuplicatePriceTier):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrTooManyPriceTiers):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidQuantity):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidCurrency):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidPriceBookPrice):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrPriceBookBaseCurrency):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDuplicatePriceBookCurrency):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrTooManyPriceBookEntries):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidSegment):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidSegmentPrice):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDuplicateSegment):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrTooManySegmentPrices):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidMarket):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidMarketPrice):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDuplicateMarket):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrMarketCurrencyMismatch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidOrderBy):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidPageNumber):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidPriceAt):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidReadStaleness):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidSearchQuery):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidPriceRange):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrSubjectIDTooLong):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidTaxClass):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidMinimumPrice):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidCostPrice):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidMarginGuard):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidTargetMargin):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrApproverRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidHistoryRange):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidRevisionRange):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidFreezeWindow):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidCampaignName):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidCampaignTarget):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidPriceListName):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidPriceListValidity):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidPriceListEntry):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDuplicatePriceListEntry):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrTooManyPriceListEntries):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidClientID):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrSalePriceMarket):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidSKU):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidVariantAttributes):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidStock):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidStockVersion):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidTag):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidProductAttribute):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrAttributeSchemaViolation):
+		return attributeSchemaStatus(err)
+	case errors.Is(err, domain.ErrInvalidImageURL):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidImageAltText):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrTooManyImages):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDuplicateImage):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrMultiplePrimaryImages):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidAttachmentType):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidAttachmentURL):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrTooManyAttachments):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDuplicateAttachment):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidRelationType):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrSelfRelation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidLocale):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDefaultLocaleTranslation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidTranslatedName):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidSlug):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidGTIN):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidWeight):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidDimensions):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidProductKind):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidCompliance):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidSalesChannel):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidTenantID):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidRating):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInvalidReviewID):
+		return status.Error(codes.InvalidArgument, err.Error())
+
+	// Already exists errors
+	case errors.Is(err, domain.ErrDuplicateSKU):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrSlugTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrDuplicateGTIN):
+		return status.Error(codes.AlreadyExists, err.Error())
 
 	// Precondition failed errors
 	case errors.Is(err, domain.ErrProductNotActive):
@@ -41,6 +236,14 @@ func MapDomainErrorToGRPC(err error) error {
 		return status.Error(codes.FailedPrecondition, err.Error())
 	case errors.Is(err, domain.ErrProductAlreadyActive):
 		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrProductNotDraft):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrProductNotPendingReview):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrProductReviewRequired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrReviewerIsSubmitter):
+		return status.Error(codes.FailedPrecondition, err.Error())
 	case errors.Is(err, domain.ErrProductAlreadyInactive):
 		return status.Error(codes.FailedPrecondition, err.Error())
 	case errors.Is(err, domain.ErrDiscountNotActive):
@@ -49,9 +252,176 @@ func MapDomainErrorToGRPC(err error) error {
 		return status.Error(codes.FailedPrecondition, err.Error())
 	case errors.Is(err, domain.ErrNoDiscountToRemove):
 		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrDiscountOverlap):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrTooManyDiscounts):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrTooManyTags):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrTooManyProductAttributes):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrInvalidImageOrder):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrRelationCycle):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrTooManyRelations):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrCurrencyMismatch):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrNoMarketPrice):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrDiscountBelowMinimumPrice):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrInvalidSalePrice):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrBasePriceBelowSalePrice):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrNegativeMargin):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrNoCostPrice):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrNoPendingPriceChange):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrDiscountNotPendingApproval):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrMarketPriceInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrNoExchangeRate):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrNoTaxRate):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrCatalogFrozen):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrDiscountBlackedOut):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrCampaignNotDraft):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrCampaignNotActive):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrAPIKeyRevoked):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrInvalidVariantPrice):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrVariantDiscontinued):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrInsufficientStock):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrMeasuresRequired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrMeasuresNotApplicable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrStockNotApplicable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrAgeRestrictedCategory):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrHazardousMeasuresRequired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrRestrictedMarketPrice):
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	// Aborted errors
+	case errors.Is(err, domain.ErrStockConflict):
+		return status.Error(codes.Aborted, err.Error())
+
+	// Price lock errors
+	case errors.Is(err, pricelock.ErrInvalidToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, pricelock.ErrTokenExpired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, pricelock.ErrDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	// Notification errors
+	case errors.Is(err, usecase.ErrNotificationsDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	// Campaign errors
+	case errors.Is(err, usecase.ErrCampaignsDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	// Price list errors
+	case errors.Is(err, usecase.ErrPriceListsDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, query.ErrPriceListsDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	// Variant errors
+	case errors.Is(err, usecase.ErrVariantsDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	// Stock errors
+	case errors.Is(err, usecase.ErrStockDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	// Image errors
+	case errors.Is(err, usecase.ErrImagesDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	// Attachment errors
+	case errors.Is(err, usecase.ErrAttachmentsDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	// Relation errors
+	case errors.Is(err, usecase.ErrRelationsDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	// Translation errors
+	case errors.Is(err, usecase.ErrTranslationsDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	// Rating errors
+	case errors.Is(err, usecase.ErrRatingsDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	// API key errors
+	case errors.Is(err, apikey.ErrInvalidKey):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, apikey.ErrDisabled):
+		return status.Error(codes.Unimplemented, err.Error())
+
+	// Availability errors
+	case errors.Is(err, availability.ErrUnavailable):
+		return unavailableStatus(err)
 
 	// Default to internal error
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
 }
+
+// attributeSchemaStatus converts an attribute schema violation to an InvalidArgument
+// status with a field violation per attribute, named "attributes.<name>".
+func attributeSchemaStatus(err error) error {
+	st := status.New(codes.InvalidArgument, err.Error())
+	var schemaErr *domain.AttributeSchemaError
+	if !errors.As(err, &schemaErr) {
+		return st.Err()
+	}
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range schemaErr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "attributes." + v.Attribute,
+			Description: v.Attribute + " " + v.Reason,
+		})
+	}
+	withViolations, detailErr := st.WithDetails(badRequest)
+	if detailErr != nil {
+		return st.Err()
+	}
+	return withViolations.Err()
+}
+
+// unavailableStatus converts an availability error to an Unavailable status that tells
+// the client when to retry.
+func unavailableStatus(err error) error {
+	st := status.New(codes.Unavailable, err.Error())
+	var unavailable *availability.UnavailableError
+	if !errors.As(err, &unavailable) {
+		return st.Err()
+	}
+	withRetry, detailErr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(unavailable.RetryAfter)})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return withRetry.Err()
+}
@@ -3,11 +3,15 @@ package handler
 import (
 	"context"
 
+	"github.com/product-catalog-service/internal/apikey"
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/domain"
 	"github.com/product-catalog-service/internal/query"
 	"github.com/product-catalog-service/internal/usecase"
 	pb "github.com/product-catalog-service/proto/product/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Handler implements the ProductServiceServer interface.
@@ -15,14 +19,30 @@ type Handler struct {
 	pb.UnimplementedProductServiceServer
 	useCases *usecase.ProductUseCases
 	queries  *query.ProductQueries
+	apiKeys  *apikey.Manager
+}
+
+// Option configures optional Handler capabilities.
+type Option func(*Handler)
+
+// WithAPIKeys serves the RPCs that let clients create, rotate and revoke their API keys.
+// Without it they fail with Unimplemented.
+func WithAPIKeys(manager *apikey.Manager) Option {
+	return func(h *Handler) {
+		h.apiKeys = manager
+	}
 }
 
 // NewHandler creates a new ProductService gRPC handler.
-func NewHandler(useCases *usecase.ProductUseCases, queries *query.ProductQueries) *Handler {
-	return &Handler{
+func NewHandler(useCases *usecase.ProductUseCases, queries *query.ProductQueries, opts ...Option) *Handler {
+	h := &Handler{
 		useCases: useCases,
 		queries:  queries,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // CreateProduct creates a new product.
@@ -37,6 +57,13 @@ func (h *Handler) CreateProduct(ctx context.Context, req *pb.CreateProductReques
 		Category:             req.GetCategory(),
 		BasePriceNumerator:   req.GetBasePrice().GetNumerator(),
 		BasePriceDenominator: req.GetBasePrice().GetDenominator(),
+		Currency:             req.GetBasePrice().GetCurrency(),
+		SKU:                  req.GetSku(),
+		GTIN:                 req.GetGtin(),
+		Attributes:           req.GetAttributes(),
+		Weight:               MapWeightFromProto(req.GetWeight()),
+		Dimensions:           MapDimensionsFromProto(req.GetDimensions()),
+		Kind:                 req.GetKind(),
 	}
 
 	resp, err := h.useCases.CreateProduct(ctx, appReq)
@@ -46,6 +73,24 @@ func (h *Handler) CreateProduct(ctx context.Context, req *pb.CreateProductReques
 
 	return &pb.CreateProductReply{
 		ProductId: resp.ProductID,
+		Slug:      resp.Slug,
+	}, nil
+}
+
+// CloneProduct creates a draft copy of a product.
+func (h *Handler) CloneProduct(ctx context.Context, req *pb.CloneProductRequest) (*pb.CloneProductReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrProductIDRequired.Error())
+	}
+
+	resp, err := h.useCases.CloneProduct(ctx, usecase.CloneProductRequest{ProductID: req.GetProductId()})
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.CloneProductReply{
+		ProductId: resp.ProductID,
+		Slug:      resp.Slug,
 	}, nil
 }
 
@@ -60,6 +105,7 @@ func (h *Handler) UpdateProduct(ctx context.Context, req *pb.UpdateProductReques
 		Name:        req.GetName(),
 		Description: req.GetDescription(),
 		Category:    req.GetCategory(),
+		Attributes:  req.GetAttributes(),
 	}
 
 	if err := h.useCases.UpdateProduct(ctx, appReq); err != nil {
@@ -69,6 +115,64 @@ func (h *Handler) UpdateProduct(ctx context.Context, req *pb.UpdateProductReques
 	return &pb.UpdateProductReply{}, nil
 }
 
+// ChangeBasePrice changes the base price of a product.
+func (h *Handler) ChangeBasePrice(ctx context.Context, req *pb.ChangeBasePriceRequest) (*pb.ChangeBasePriceReply, error) {
+	if err := validateChangeBasePriceRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.ChangeBasePriceRequest{
+		ProductID:            req.GetProductId(),
+		BasePriceNumerator:   req.GetBasePrice().GetNumerator(),
+		BasePriceDenominator: req.GetBasePrice().GetDenominator(),
+		Currency:             req.GetBasePrice().GetCurrency(),
+	}
+
+	if err := h.useCases.ChangeBasePrice(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.ChangeBasePriceReply{}, nil
+}
+
+// SchedulePriceChange schedules a change of the base price of a product.
+func (h *Handler) SchedulePriceChange(ctx context.Context, req *pb.SchedulePriceChangeRequest) (*pb.SchedulePriceChangeReply, error) {
+	if err := validateSchedulePriceChangeRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SchedulePriceChangeRequest{
+		ProductID:            req.GetProductId(),
+		BasePriceNumerator:   req.GetBasePrice().GetNumerator(),
+		BasePriceDenominator: req.GetBasePrice().GetDenominator(),
+		Currency:             req.GetBasePrice().GetCurrency(),
+		EffectiveAt:          req.GetEffectiveAt().AsTime(),
+	}
+
+	if err := h.useCases.SchedulePriceChange(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SchedulePriceChangeReply{}, nil
+}
+
+// CancelPriceChange cancels the pending price change of a product.
+func (h *Handler) CancelPriceChange(ctx context.Context, req *pb.CancelPriceChangeRequest) (*pb.CancelPriceChangeReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := usecase.CancelPriceChangeRequest{
+		ProductID: req.GetProductId(),
+	}
+
+	if err := h.useCases.CancelPriceChange(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.CancelPriceChangeReply{}, nil
+}
+
 // ActivateProduct activates a product.
 func (h *Handler) ActivateProduct(ctx context.Context, req *pb.ActivateProductRequest) (*pb.ActivateProductReply, error) {
 	if req.GetProductId() == "" {
@@ -103,6 +207,84 @@ func (h *Handler) DeactivateProduct(ctx context.Context, req *pb.DeactivateProdu
 	return &pb.DeactivateProductReply{}, nil
 }
 
+// ScheduleActivation schedules the activation and deactivation of a product.
+func (h *Handler) ScheduleActivation(ctx context.Context, req *pb.ScheduleActivationRequest) (*pb.ScheduleActivationReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrProductIDRequired.Error())
+	}
+
+	appReq := usecase.ScheduleActivationRequest{
+		ProductID: req.GetProductId(),
+	}
+	if req.GetActivateAt() != nil {
+		appReq.ActivateAt = req.GetActivateAt().AsTime()
+	}
+	if req.GetDeactivateAt() != nil {
+		appReq.DeactivateAt = req.GetDeactivateAt().AsTime()
+	}
+
+	if err := h.useCases.ScheduleActivation(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.ScheduleActivationReply{}, nil
+}
+
+// SubmitForReview submits a draft product for review.
+func (h *Handler) SubmitForReview(ctx context.Context, req *pb.SubmitForReviewRequest) (*pb.SubmitForReviewReply, error) {
+	if err := validateSubmitForReviewRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SubmitForReviewRequest{
+		ProductID:   req.GetProductId(),
+		SubmittedBy: req.GetSubmittedBy(),
+	}
+
+	if err := h.useCases.SubmitForReview(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SubmitForReviewReply{}, nil
+}
+
+// ApproveProduct approves a product pending review, activating it.
+func (h *Handler) ApproveProduct(ctx context.Context, req *pb.ApproveProductRequest) (*pb.ApproveProductReply, error) {
+	if err := validateReviewer(req.GetProductId(), req.GetReviewer()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.ApproveProductRequest{
+		ProductID: req.GetProductId(),
+		Reviewer:  req.GetReviewer(),
+	}
+
+	if err := h.useCases.ApproveProduct(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.ApproveProductReply{}, nil
+}
+
+// RejectProduct rejects a product pending review, returning it to draft.
+func (h *Handler) RejectProduct(ctx context.Context, req *pb.RejectProductRequest) (*pb.RejectProductReply, error) {
+	if err := validateRejectProductRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.RejectProductRequest{
+		ProductID: req.GetProductId(),
+		Reviewer:  req.GetReviewer(),
+		Reason:    req.GetReason(),
+	}
+
+	if err := h.useCases.RejectProduct(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.RejectProductReply{}, nil
+}
+
 // ArchiveProduct archives a product (soft delete).
 func (h *Handler) ArchiveProduct(ctx context.Context, req *pb.ArchiveProductRequest) (*pb.ArchiveProductReply, error) {
 	if req.GetProductId() == "" {
@@ -129,15 +311,48 @@ func (h *Handler) ApplyDiscount(ctx context.Context, req *pb.ApplyDiscountReques
 	appReq := usecase.ApplyDiscountRequest{
 		ProductID:          req.GetProductId(),
 		DiscountPercentage: req.GetDiscountPercentage(),
+		Priority:           int(req.GetPriority()),
+		StartDate:          req.GetStartDate().AsTime(),
+		EndDate:            req.GetEndDate().AsTime(),
+		BuyQuantity:        int(req.GetBuyXGetY().GetBuyQuantity()),
+		GetQuantity:        int(req.GetBuyXGetY().GetGetQuantity()),
+		Market:             req.GetMarket(),
+		FlashSale:          req.GetFlashSale(),
+	}
+	if sale := req.GetSalePrice(); sale != nil {
+		appReq.SalePriceNumerator = sale.GetNumerator()
+		appReq.SalePriceDenominator = sale.GetDenominator()
+		appReq.SalePriceCurrency = sale.GetCurrency()
+	}
+
+	resp, err := h.useCases.ApplyDiscount(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.ApplyDiscountReply{DiscountId: resp.DiscountID, PendingApproval: resp.PendingApproval}, nil
+}
+
+// ApplyDiscountToCategory applies a discount to every active product of a category.
+func (h *Handler) ApplyDiscountToCategory(ctx context.Context, req *pb.ApplyDiscountToCategoryRequest) (*pb.ApplyDiscountToCategoryReply, error) {
+	if err := validateApplyDiscountToCategoryRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.ApplyDiscountToCategoryRequest{
+		Category:           req.GetCategory(),
+		DiscountPercentage: req.GetDiscountPercentage(),
+		Priority:           int(req.GetPriority()),
 		StartDate:          req.GetStartDate().AsTime(),
 		EndDate:            req.GetEndDate().AsTime(),
 	}
 
-	if err := h.useCases.ApplyDiscount(ctx, appReq); err != nil {
+	resp, err := h.useCases.ApplyDiscountToCategory(ctx, appReq)
+	if err != nil {
 		return nil, MapDomainErrorToGRPC(err)
 	}
 
-	return &pb.ApplyDiscountReply{}, nil
+	return MapApplyDiscountToCategoryResponseToProto(resp), nil
 }
 
 // RemoveDiscount removes a discount from a product.
@@ -147,7 +362,8 @@ func (h *Handler) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequ
 	}
 
 	appReq := usecase.RemoveDiscountRequest{
-		ProductID: req.GetProductId(),
+		ProductID:  req.GetProductId(),
+		DiscountID: req.GetDiscountId(),
 	}
 
 	if err := h.useCases.RemoveDiscount(ctx, appReq); err != nil {
@@ -157,40 +373,1233 @@ func (h *Handler) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequ
 	return &pb.RemoveDiscountReply{}, nil
 }
 
-// GetProduct retrieves a product by ID.
-func (h *Handler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductReply, error) {
-	if req.GetProductId() == "" {
-		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+// ApproveDiscount approves a discount held pending approval.
+func (h *Handler) ApproveDiscount(ctx context.Context, req *pb.ApproveDiscountRequest) (*pb.ApproveDiscountReply, error) {
+	if err := validateApproveDiscountRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	appReq := query.GetProductRequest{
+	appReq := usecase.ApproveDiscountRequest{
+		ProductID:  req.GetProductId(),
+		DiscountID: req.GetDiscountId(),
+		Approver:   req.GetApprover(),
+	}
+
+	if err := h.useCases.ApproveDiscount(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.ApproveDiscountReply{}, nil
+}
+
+// SetPriceTiers replaces the volume price tiers of a product.
+func (h *Handler) SetPriceTiers(ctx context.Context, req *pb.SetPriceTiersRequest) (*pb.SetPriceTiersReply, error) {
+	if err := validateSetPriceTiersRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetPriceTiersRequest{
 		ProductID: req.GetProductId(),
+		Tiers:     MapPriceTiersFromProto(req.GetTiers()),
 	}
 
-	resp, err := h.queries.GetProduct(ctx, appReq)
+	if err := h.useCases.SetPriceTiers(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetPriceTiersReply{}, nil
+}
+
+// SetPriceBook replaces the prices of a product in currencies other than its own.
+func (h *Handler) SetPriceBook(ctx context.Context, req *pb.SetPriceBookRequest) (*pb.SetPriceBookReply, error) {
+	if err := validateSetPriceBookRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetPriceBookRequest{
+		ProductID: req.GetProductId(),
+		Prices:    MapPricesFromProto(req.GetPrices()),
+	}
+
+	if err := h.useCases.SetPriceBook(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetPriceBookReply{}, nil
+}
+
+// SetSegmentPrices replaces the customer segment prices of a product.
+func (h *Handler) SetSegmentPrices(ctx context.Context, req *pb.SetSegmentPricesRequest) (*pb.SetSegmentPricesReply, error) {
+	if err := validateSetSegmentPricesRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetSegmentPricesRequest{
+		ProductID: req.GetProductId(),
+		Prices:    MapSegmentPricesFromProto(req.GetPrices()),
+	}
+
+	if err := h.useCases.SetSegmentPrices(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetSegmentPricesReply{}, nil
+}
+
+// SetMarketPrices replaces the regional prices of a product.
+func (h *Handler) SetMarketPrices(ctx context.Context, req *pb.SetMarketPricesRequest) (*pb.SetMarketPricesReply, error) {
+	if err := validateSetMarketPricesRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetMarketPricesRequest{
+		ProductID: req.GetProductId(),
+		Prices:    MapMarketPricesFromProto(req.GetPrices()),
+	}
+
+	if err := h.useCases.SetMarketPrices(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetMarketPricesReply{}, nil
+}
+
+// SetTaxClass changes the tax class of a product.
+func (h *Handler) SetTaxClass(ctx context.Context, req *pb.SetTaxClassRequest) (*pb.SetTaxClassReply, error) {
+	if err := validateSetTaxClassRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetTaxClassRequest{
+		ProductID: req.GetProductId(),
+		TaxClass:  req.GetTaxClass(),
+	}
+
+	if err := h.useCases.SetTaxClass(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetTaxClassReply{}, nil
+}
+
+// SetMinimumPrice sets or removes the minimum price of a product.
+func (h *Handler) SetMinimumPrice(ctx context.Context, req *pb.SetMinimumPriceRequest) (*pb.SetMinimumPriceReply, error) {
+	if err := validateSetMinimumPriceRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetMinimumPriceRequest{
+		ProductID:   req.GetProductId(),
+		Numerator:   req.GetMinimumPrice().GetNumerator(),
+		Denominator: req.GetMinimumPrice().GetDenominator(),
+		Currency:    req.GetMinimumPrice().GetCurrency(),
+	}
+
+	if err := h.useCases.SetMinimumPrice(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetMinimumPriceReply{}, nil
+}
+
+// SetCostPrice sets or removes the cost price of a product.
+func (h *Handler) SetCostPrice(ctx context.Context, req *pb.SetCostPriceRequest) (*pb.SetCostPriceReply, error) {
+	if err := validateSetCostPriceRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetCostPriceRequest{
+		ProductID:   req.GetProductId(),
+		Numerator:   req.GetCostPrice().GetNumerator(),
+		Denominator: req.GetCostPrice().GetDenominator(),
+		Currency:    req.GetCostPrice().GetCurrency(),
+	}
+
+	if err := h.useCases.SetCostPrice(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetCostPriceReply{}, nil
+}
+
+// AddVariant adds a variant to a product.
+func (h *Handler) AddVariant(ctx context.Context, req *pb.AddVariantRequest) (*pb.AddVariantReply, error) {
+	if err := validateAddVariantRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.AddVariantRequest{
+		ProductID:             req.GetProductId(),
+		SKU:                   req.GetSku(),
+		Attributes:            req.GetAttributes(),
+		PriceDeltaNumerator:   req.GetPriceDelta().GetNumerator(),
+		PriceDeltaDenominator: req.GetPriceDelta().GetDenominator(),
+		PriceDeltaCurrency:    req.GetPriceDelta().GetCurrency(),
+	}
+
+	resp, err := h.useCases.AddVariant(ctx, appReq)
 	if err != nil {
 		return nil, MapDomainErrorToGRPC(err)
 	}
 
-	return &pb.GetProductReply{
-		Product: MapProductResponseToProto(resp),
-	}, nil
+	return &pb.AddVariantReply{VariantId: resp.VariantID}, nil
 }
 
-// ListProducts lists products with optional filters and pagination.
-func (h *Handler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsReply, error) {
-	appReq := query.ListProductsRequest{
-		Category:   req.GetCategory(),
-		Status:     req.GetStatus(),
-		ActiveOnly: req.GetActiveOnly(),
-		PageSize:   req.GetPageSize(),
-		PageToken:  req.GetPageToken(),
+// UpdateVariant replaces the SKU, attributes and price delta of a variant.
+func (h *Handler) UpdateVariant(ctx context.Context, req *pb.UpdateVariantRequest) (*pb.UpdateVariantReply, error) {
+	if err := validateUpdateVariantRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	resp, err := h.queries.ListProducts(ctx, appReq)
+	appReq := usecase.UpdateVariantRequest{
+		ProductID:             req.GetProductId(),
+		VariantID:             req.GetVariantId(),
+		SKU:                   req.GetSku(),
+		Attributes:            req.GetAttributes(),
+		PriceDeltaNumerator:   req.GetPriceDelta().GetNumerator(),
+		PriceDeltaDenominator: req.GetPriceDelta().GetDenominator(),
+		PriceDeltaCurrency:    req.GetPriceDelta().GetCurrency(),
+	}
+
+	if err := h.useCases.UpdateVariant(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.UpdateVariantReply{}, nil
+}
+
+// DiscontinueVariant discontinues a variant.
+func (h *Handler) DiscontinueVariant(ctx context.Context, req *pb.DiscontinueVariantRequest) (*pb.DiscontinueVariantReply, error) {
+	if err := validateDiscontinueVariantRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.DiscontinueVariantRequest{
+		ProductID: req.GetProductId(),
+		VariantID: req.GetVariantId(),
+	}
+
+	if err := h.useCases.DiscontinueVariant(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.DiscontinueVariantReply{}, nil
+}
+
+// SetStock replaces the stock of a product.
+func (h *Handler) SetStock(ctx context.Context, req *pb.SetStockRequest) (*pb.SetStockReply, error) {
+	if err := validateSetStockRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetStockRequest{
+		ProductID:       req.GetProductId(),
+		Level:           req.GetLevel(),
+		Reserved:        req.GetReserved(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	}
+
+	resp, err := h.useCases.SetStock(ctx, appReq)
 	if err != nil {
 		return nil, MapDomainErrorToGRPC(err)
 	}
 
-	return MapListProductsResponseToProto(resp), nil
+	return &pb.SetStockReply{Stock: MapStockToProto(resp)}, nil
+}
+
+// AdjustStock adds to or takes from the stock of a product.
+func (h *Handler) AdjustStock(ctx context.Context, req *pb.AdjustStockRequest) (*pb.AdjustStockReply, error) {
+	if err := validateAdjustStockRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.AdjustStockRequest{
+		ProductID:       req.GetProductId(),
+		LevelDelta:      req.GetLevelDelta(),
+		ReservedDelta:   req.GetReservedDelta(),
+		ExpectedVersion: req.GetExpectedVersion(),
+	}
+
+	resp, err := h.useCases.AdjustStock(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.AdjustStockReply{Stock: MapStockToProto(resp)}, nil
+}
+
+// AddTag tags a product.
+func (h *Handler) AddTag(ctx context.Context, req *pb.AddTagRequest) (*pb.AddTagReply, error) {
+	if err := validateTagRequest(req.GetProductId(), req.GetTag()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.TagRequest{
+		ProductID: req.GetProductId(),
+		Tag:       req.GetTag(),
+	}
+
+	if err := h.useCases.AddTag(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.AddTagReply{}, nil
+}
+
+// RemoveTag removes a tag from a product.
+func (h *Handler) RemoveTag(ctx context.Context, req *pb.RemoveTagRequest) (*pb.RemoveTagReply, error) {
+	if err := validateTagRequest(req.GetProductId(), req.GetTag()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.TagRequest{
+		ProductID: req.GetProductId(),
+		Tag:       req.GetTag(),
+	}
+
+	if err := h.useCases.RemoveTag(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.RemoveTagReply{}, nil
+}
+
+// SetAttribute sets or removes an attribute of a product.
+func (h *Handler) SetAttribute(ctx context.Context, req *pb.SetAttributeRequest) (*pb.SetAttributeReply, error) {
+	if err := validateSetAttributeRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetAttributeRequest{
+		ProductID: req.GetProductId(),
+		Name:      req.GetName(),
+		Value:     req.GetValue(),
+	}
+
+	if err := h.useCases.SetAttribute(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetAttributeReply{}, nil
+}
+
+// SetSlug changes the URL slug of a product.
+func (h *Handler) SetSlug(ctx context.Context, req *pb.SetSlugRequest) (*pb.SetSlugReply, error) {
+	if err := validateSetSlugRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetSlugRequest{
+		ProductID: req.GetProductId(),
+		Slug:      req.GetSlug(),
+	}
+
+	if err := h.useCases.SetSlug(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetSlugReply{}, nil
+}
+
+// SetIdentifiers replaces the SKU and GTIN of a product.
+func (h *Handler) SetIdentifiers(ctx context.Context, req *pb.SetIdentifiersRequest) (*pb.SetIdentifiersReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrProductIDRequired.Error())
+	}
+
+	appReq := usecase.SetIdentifiersRequest{
+		ProductID: req.GetProductId(),
+		SKU:       req.GetSku(),
+		GTIN:      req.GetGtin(),
+	}
+
+	if err := h.useCases.SetIdentifiers(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetIdentifiersReply{}, nil
+}
+
+// SetDimensions replaces the weight and dimensions of a product.
+func (h *Handler) SetDimensions(ctx context.Context, req *pb.SetDimensionsRequest) (*pb.SetDimensionsReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrProductIDRequired.Error())
+	}
+
+	appReq := usecase.SetDimensionsRequest{
+		ProductID:  req.GetProductId(),
+		Weight:     MapWeightFromProto(req.GetWeight()),
+		Dimensions: MapDimensionsFromProto(req.GetDimensions()),
+	}
+
+	if err := h.useCases.SetDimensions(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetDimensionsReply{}, nil
+}
+
+// SetKind makes a product physical or digital.
+func (h *Handler) SetKind(ctx context.Context, req *pb.SetKindRequest) (*pb.SetKindReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrProductIDRequired.Error())
+	}
+
+	appReq := usecase.SetKindRequest{
+		ProductID:  req.GetProductId(),
+		Kind:       req.GetKind(),
+		Weight:     MapWeightFromProto(req.GetWeight()),
+		Dimensions: MapDimensionsFromProto(req.GetDimensions()),
+	}
+
+	if err := h.useCases.SetKind(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetKindReply{}, nil
+}
+
+// SetCompliance replaces the compliance flags of a product.
+func (h *Handler) SetCompliance(ctx context.Context, req *pb.SetComplianceRequest) (*pb.SetComplianceReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrProductIDRequired.Error())
+	}
+
+	appReq := usecase.SetComplianceRequest{
+		ProductID:  req.GetProductId(),
+		Compliance: MapComplianceFromProto(req.GetCompliance()),
+	}
+
+	if err := h.useCases.SetCompliance(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetComplianceReply{}, nil
+}
+
+// SetChannelAvailability makes a product available on, or withholds it from, sales
+// channels.
+func (h *Handler) SetChannelAvailability(ctx context.Context, req *pb.SetChannelAvailabilityRequest) (*pb.SetChannelAvailabilityReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrProductIDRequired.Error())
+	}
+
+	appReq := usecase.SetChannelAvailabilityRequest{
+		ProductID: req.GetProductId(),
+		Channels:  req.GetChannels(),
+	}
+
+	if err := h.useCases.SetChannelAvailability(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetChannelAvailabilityReply{}, nil
+}
+
+// IngestReview records the rating of a customer review of a product.
+func (h *Handler) IngestReview(ctx context.Context, req *pb.IngestReviewRequest) (*pb.IngestReviewReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrProductIDRequired.Error())
+	}
+
+	appReq := usecase.IngestReviewRequest{
+		ProductID: req.GetProductId(),
+		ReviewID:  req.GetReviewId(),
+		Rating:    req.GetRating(),
+	}
+
+	resp, err := h.useCases.IngestReview(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.IngestReviewReply{
+		Rating: &pb.RatingSummary{Average: resp.RatingAverage, Count: resp.RatingCount},
+	}, nil
+}
+
+// SetTranslation sets or removes the name and description of a product in a locale.
+func (h *Handler) SetTranslation(ctx context.Context, req *pb.SetTranslationRequest) (*pb.SetTranslationReply, error) {
+	if err := validateSetTranslationRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetTranslationRequest{
+		ProductID:   req.GetProductId(),
+		Locale:      req.GetLocale(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+	}
+
+	if err := h.useCases.SetTranslation(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetTranslationReply{}, nil
+}
+
+// SetImages replaces the images of a product.
+func (h *Handler) SetImages(ctx context.Context, req *pb.SetImagesRequest) (*pb.SetImagesReply, error) {
+	if err := validateSetImagesRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetImagesRequest{
+		ProductID: req.GetProductId(),
+		Images:    make([]usecase.ImageInput, len(req.GetImages())),
+	}
+	for i, image := range req.GetImages() {
+		appReq.Images[i] = usecase.ImageInput{
+			URL:     image.GetUrl(),
+			AltText: image.GetAltText(),
+			Primary: image.GetPrimary(),
+		}
+	}
+
+	if err := h.useCases.SetImages(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetImagesReply{}, nil
+}
+
+// ManageAttachments replaces the attachments of a product.
+func (h *Handler) ManageAttachments(ctx context.Context, req *pb.ManageAttachmentsRequest) (*pb.ManageAttachmentsReply, error) {
+	if err := validateManageAttachmentsRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.ManageAttachmentsRequest{
+		ProductID:   req.GetProductId(),
+		Attachments: make([]usecase.AttachmentInput, len(req.GetAttachments())),
+	}
+	for i, attachment := range req.GetAttachments() {
+		appReq.Attachments[i] = usecase.AttachmentInput{
+			Type:   attachment.GetType(),
+			URL:    attachment.GetUrl(),
+			Locale: attachment.GetLocale(),
+		}
+	}
+
+	if err := h.useCases.ManageAttachments(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.ManageAttachmentsReply{}, nil
+}
+
+// ReorderImages changes the display order of the images of a product.
+func (h *Handler) ReorderImages(ctx context.Context, req *pb.ReorderImagesRequest) (*pb.ReorderImagesReply, error) {
+	if err := validateReorderImagesRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.ReorderImagesRequest{
+		ProductID: req.GetProductId(),
+		URLs:      req.GetUrls(),
+	}
+
+	if err := h.useCases.ReorderImages(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.ReorderImagesReply{}, nil
+}
+
+// LinkProducts links a product to a related product.
+func (h *Handler) LinkProducts(ctx context.Context, req *pb.LinkProductsRequest) (*pb.LinkProductsReply, error) {
+	if err := validateProductLinkRequest(req.GetProductId(), req.GetRelatedProductId(), req.GetType()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.ProductLinkRequest{
+		ProductID:        req.GetProductId(),
+		RelatedProductID: req.GetRelatedProductId(),
+		RelationType:     req.GetType(),
+	}
+
+	if err := h.useCases.LinkProducts(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.LinkProductsReply{}, nil
+}
+
+// UnlinkProducts removes the link from a product to a related product.
+func (h *Handler) UnlinkProducts(ctx context.Context, req *pb.UnlinkProductsRequest) (*pb.UnlinkProductsReply, error) {
+	if err := validateProductLinkRequest(req.GetProductId(), req.GetRelatedProductId(), req.GetType()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.ProductLinkRequest{
+		ProductID:        req.GetProductId(),
+		RelatedProductID: req.GetRelatedProductId(),
+		RelationType:     req.GetType(),
+	}
+
+	if err := h.useCases.UnlinkProducts(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.UnlinkProductsReply{}, nil
+}
+
+// SubscribeToNotifications subscribes a customer to notifications about a product.
+func (h *Handler) SubscribeToNotifications(ctx context.Context, req *pb.SubscribeToNotificationsRequest) (*pb.SubscribeToNotificationsReply, error) {
+	if err := validateSubscriptionRequest(req.GetProductId(), req.GetSubscriberId(), req.GetKind()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SubscribeToNotificationsRequest{
+		ProductID:    req.GetProductId(),
+		SubscriberID: req.GetSubscriberId(),
+		Kind:         domain.NotificationKind(req.GetKind()),
+	}
+
+	if err := h.useCases.SubscribeToNotifications(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SubscribeToNotificationsReply{}, nil
+}
+
+// UnsubscribeFromNotifications removes a customer's subscription to a product.
+func (h *Handler) UnsubscribeFromNotifications(ctx context.Context, req *pb.UnsubscribeFromNotificationsRequest) (*pb.UnsubscribeFromNotificationsReply, error) {
+	if err := validateSubscriptionRequest(req.GetProductId(), req.GetSubscriberId(), req.GetKind()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.UnsubscribeFromNotificationsRequest{
+		ProductID:    req.GetProductId(),
+		SubscriberID: req.GetSubscriberId(),
+		Kind:         domain.NotificationKind(req.GetKind()),
+	}
+
+	if err := h.useCases.UnsubscribeFromNotifications(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.UnsubscribeFromNotificationsReply{}, nil
+}
+
+// CreateCampaign creates a draft campaign.
+func (h *Handler) CreateCampaign(ctx context.Context, req *pb.CreateCampaignRequest) (*pb.CreateCampaignReply, error) {
+	if err := validateCreateCampaignRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.CreateCampaignRequest{
+		Name:               req.GetName(),
+		Category:           req.GetCategory(),
+		ProductIDs:         req.GetProductIds(),
+		DiscountPercentage: req.GetDiscountPercentage(),
+		Priority:           int(req.GetPriority()),
+		StartDate:          req.GetStartDate().AsTime(),
+		EndDate:            req.GetEndDate().AsTime(),
+	}
+
+	resp, err := h.useCases.CreateCampaign(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.CreateCampaignReply{CampaignId: resp.CampaignID}, nil
+}
+
+// ActivateCampaign applies the discount of a campaign to its products.
+func (h *Handler) ActivateCampaign(ctx context.Context, req *pb.ActivateCampaignRequest) (*pb.ActivateCampaignReply, error) {
+	if req.GetCampaignId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrCampaignIDRequired.Error())
+	}
+
+	resp, err := h.useCases.ActivateCampaign(ctx, usecase.ActivateCampaignRequest{CampaignID: req.GetCampaignId()})
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapActivateCampaignResponseToProto(resp), nil
+}
+
+// EndCampaign removes the discount of a campaign from its products.
+func (h *Handler) EndCampaign(ctx context.Context, req *pb.EndCampaignRequest) (*pb.EndCampaignReply, error) {
+	if req.GetCampaignId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrCampaignIDRequired.Error())
+	}
+
+	resp, err := h.useCases.EndCampaign(ctx, usecase.EndCampaignRequest{CampaignID: req.GetCampaignId()})
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.EndCampaignReply{RemovedCount: int32(resp.RemovedCount)}, nil
+}
+
+// CreatePriceList creates a price list without entries.
+func (h *Handler) CreatePriceList(ctx context.Context, req *pb.CreatePriceListRequest) (*pb.CreatePriceListReply, error) {
+	if err := validateCreatePriceListRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.CreatePriceListRequest{
+		Name:      req.GetName(),
+		Currency:  req.GetCurrency(),
+		ValidFrom: req.GetValidFrom().AsTime(),
+	}
+	if req.GetValidUntil() != nil {
+		appReq.ValidUntil = req.GetValidUntil().AsTime()
+	}
+
+	resp, err := h.useCases.CreatePriceList(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.CreatePriceListReply{PriceListId: resp.PriceListID}, nil
+}
+
+// SetPriceListEntries replaces the entries of a price list.
+func (h *Handler) SetPriceListEntries(ctx context.Context, req *pb.SetPriceListEntriesRequest) (*pb.SetPriceListEntriesReply, error) {
+	if err := validateSetPriceListEntriesRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := usecase.SetPriceListEntriesRequest{
+		PriceListID: req.GetPriceListId(),
+		Entries:     MapPriceListEntriesFromProto(req.GetEntries()),
+	}
+
+	if err := h.useCases.SetPriceListEntries(ctx, appReq); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.SetPriceListEntriesReply{}, nil
+}
+
+// CreateAPIKey issues another API key to the calling client.
+func (h *Handler) CreateAPIKey(ctx context.Context, _ *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyReply, error) {
+	clientID, err := h.apiKeyClient(ctx)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	issued, err := h.apiKeys.Create(ctx, clientID)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.CreateAPIKeyReply{KeyId: issued.Key.ID(), Secret: issued.Secret}, nil
+}
+
+// RotateAPIKey replaces an API key of the calling client. The old key keeps working for
+// the configured grace period.
+func (h *Handler) RotateAPIKey(ctx context.Context, req *pb.RotateAPIKeyRequest) (*pb.RotateAPIKeyReply, error) {
+	if req.GetKeyId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrKeyIDRequired.Error())
+	}
+	clientID, err := h.apiKeyClient(ctx)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	issued, err := h.apiKeys.Rotate(ctx, clientID, req.GetKeyId())
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.RotateAPIKeyReply{
+		KeyId:                issued.Key.ID(),
+		Secret:               issued.Secret,
+		PreviousKeyExpiresAt: timestamppb.New(*issued.Replaces.ExpiresAt()),
+	}, nil
+}
+
+// RevokeAPIKey revokes an API key of the calling client at once.
+func (h *Handler) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyReply, error) {
+	if req.GetKeyId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrKeyIDRequired.Error())
+	}
+	clientID, err := h.apiKeyClient(ctx)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	if err := h.apiKeys.Revoke(ctx, clientID, req.GetKeyId()); err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return &pb.RevokeAPIKeyReply{}, nil
+}
+
+// apiKeyClient returns the client that authenticated the request with its API key.
+func (h *Handler) apiKeyClient(ctx context.Context) (string, error) {
+	if h.apiKeys == nil {
+		return "", apikey.ErrDisabled
+	}
+	clientID := caller.FromContext(ctx).Client
+	if clientID == "" {
+		return "", apikey.ErrInvalidKey
+	}
+	return clientID, nil
+}
+
+// GetProduct retrieves a product by ID.
+func (h *Handler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := query.GetProductRequest{
+		ProductID:     req.GetProductId(),
+		Currency:      req.GetCurrency(),
+		Experiment:    query.ExperimentContext{SubjectID: req.GetExperiment().GetSubjectId()},
+		Segment:       req.GetSegment(),
+		Market:        req.GetMarket(),
+		Locale:        req.GetLocale(),
+		ReadStaleness: req.GetReadStaleness(),
+	}
+	if req.GetPriceAt() != nil {
+		appReq.PriceAt = req.GetPriceAt().AsTime()
+	}
+
+	resp, err := h.queries.GetProduct(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	reply := &pb.GetProductReply{
+		Product: MapProductResponseToProto(resp),
+	}
+	if resp.CachedAt != nil {
+		reply.Stale = true
+		reply.CachedAt = timestamppb.New(*resp.CachedAt)
+	}
+	return reply, nil
+}
+
+// BatchGetProducts retrieves several products in a single read.
+func (h *Handler) BatchGetProducts(ctx context.Context, req *pb.BatchGetProductsRequest) (*pb.BatchGetProductsReply, error) {
+	if err := validateBatchGetProductsRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := query.BatchGetProductsRequest{
+		ProductIDs: req.GetProductIds(),
+		Currency:   req.GetCurrency(),
+		Experiment: query.ExperimentContext{SubjectID: req.GetExperiment().GetSubjectId()},
+		Segment:    req.GetSegment(),
+		Market:     req.GetMarket(),
+		Locale:     req.GetLocale(),
+	}
+	if req.GetPriceAt() != nil {
+		appReq.PriceAt = req.GetPriceAt().AsTime()
+	}
+
+	resp, err := h.queries.BatchGetProducts(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	reply := &pb.BatchGetProductsReply{
+		Products:          make([]*pb.Product, len(resp.Products)),
+		MissingProductIds: resp.MissingProductIDs,
+	}
+	for i, product := range resp.Products {
+		reply.Products[i] = MapProductResponseToProto(product)
+	}
+	return reply, nil
+}
+
+// GetProductBySlug retrieves a product by its URL slug.
+func (h *Handler) GetProductBySlug(ctx context.Context, req *pb.GetProductBySlugRequest) (*pb.GetProductBySlugReply, error) {
+	if req.GetSlug() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrSlugRequired.Error())
+	}
+
+	appReq := query.GetProductBySlugRequest{
+		Slug:       req.GetSlug(),
+		Currency:   req.GetCurrency(),
+		Experiment: query.ExperimentContext{SubjectID: req.GetExperiment().GetSubjectId()},
+		Segment:    req.GetSegment(),
+		Market:     req.GetMarket(),
+		Locale:     req.GetLocale(),
+	}
+	if req.GetPriceAt() != nil {
+		appReq.PriceAt = req.GetPriceAt().AsTime()
+	}
+
+	resp, err := h.queries.GetProductBySlug(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	reply := &pb.GetProductBySlugReply{
+		Product: MapProductResponseToProto(resp),
+	}
+	if resp.CachedAt != nil {
+		reply.Stale = true
+		reply.CachedAt = timestamppb.New(*resp.CachedAt)
+	}
+	return reply, nil
+}
+
+// GetProductBySKU retrieves a product by its SKU.
+func (h *Handler) GetProductBySKU(ctx context.Context, req *pb.GetProductBySKURequest) (*pb.GetProductBySKUReply, error) {
+	if req.GetSku() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrSKURequired.Error())
+	}
+
+	appReq := query.GetProductBySKURequest{
+		SKU:        req.GetSku(),
+		Currency:   req.GetCurrency(),
+		Experiment: query.ExperimentContext{SubjectID: req.GetExperiment().GetSubjectId()},
+		Segment:    req.GetSegment(),
+		Market:     req.GetMarket(),
+		Locale:     req.GetLocale(),
+	}
+	if req.GetPriceAt() != nil {
+		appReq.PriceAt = req.GetPriceAt().AsTime()
+	}
+
+	resp, err := h.queries.GetProductBySKU(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	reply := &pb.GetProductBySKUReply{
+		Product: MapProductResponseToProto(resp),
+	}
+	if resp.CachedAt != nil {
+		reply.Stale = true
+		reply.CachedAt = timestamppb.New(*resp.CachedAt)
+	}
+	return reply, nil
+}
+
+// ListProducts lists products with optional filters and pagination.
+func (h *Handler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsReply, error) {
+	appReq := query.ListProductsRequest{
+		Category:          req.GetCategory(),
+		Status:            req.GetStatus(),
+		ActiveOnly:        req.GetActiveOnly(),
+		PageSize:          req.GetPageSize(),
+		PageToken:         req.GetPageToken(),
+		Currency:          req.GetCurrency(),
+		OrderBy:           req.GetOrderBy(),
+		Market:            req.GetMarket(),
+		InStockOnly:       req.GetInStock(),
+		HasActiveDiscount: req.GetHasActiveDiscount(),
+		IncludeArchived:   req.GetIncludeArchived(),
+		ReadStaleness:     req.GetReadStaleness(),
+		Tag:               req.GetTag(),
+		Channel:           req.GetChannel(),
+		Locale:            req.GetLocale(),
+		MinPrice:          MapPriceBoundFromProto(req.GetMinPrice()),
+		MaxPrice:          MapPriceBoundFromProto(req.GetMaxPrice()),
+		IncludeTotalCount: req.GetIncludeTotalCount(),
+		PageNumber:        req.GetPageNumber(),
+	}
+	if req.GetPriceAt() != nil {
+		appReq.PriceAt = req.GetPriceAt().AsTime()
+	}
+
+	resp, err := h.queries.ListProducts(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapListProductsResponseToProto(resp), nil
+}
+
+// ListAllProducts streams all the products ListProducts lists with the same filters.
+func (h *Handler) ListAllProducts(req *pb.ListAllProductsRequest, stream pb.ProductService_ListAllProductsServer) error {
+	appReq := query.ListProductsRequest{
+		Category:          req.GetCategory(),
+		Status:            req.GetStatus(),
+		ActiveOnly:        req.GetActiveOnly(),
+		Currency:          req.GetCurrency(),
+		Market:            req.GetMarket(),
+		InStockOnly:       req.GetInStock(),
+		HasActiveDiscount: req.GetHasActiveDiscount(),
+		IncludeArchived:   req.GetIncludeArchived(),
+		ReadStaleness:     req.GetReadStaleness(),
+		Tag:               req.GetTag(),
+		Channel:           req.GetChannel(),
+		Locale:            req.GetLocale(),
+		MinPrice:          MapPriceBoundFromProto(req.GetMinPrice()),
+		MaxPrice:          MapPriceBoundFromProto(req.GetMaxPrice()),
+	}
+	if req.GetPriceAt() != nil {
+		appReq.PriceAt = req.GetPriceAt().AsTime()
+	}
+
+	var sendErr error
+	err := h.queries.ListAllProducts(stream.Context(), appReq, func(p *query.ProductSummary) error {
+		sendErr = stream.Send(mapProductSummaryToProto(p))
+		return sendErr
+	})
+	// Errors sending on the stream already carry a gRPC status.
+	if err != nil && err != sendErr {
+		return MapDomainErrorToGRPC(err)
+	}
+	return err
+}
+
+// SearchProducts searches products by their names and descriptions.
+func (h *Handler) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsReply, error) {
+	appReq := query.SearchProductsRequest{
+		Query:      req.GetQuery(),
+		Category:   req.GetCategory(),
+		ActiveOnly: req.GetActiveOnly(),
+		Tag:        req.GetTag(),
+		Channel:    req.GetChannel(),
+		PageSize:   req.GetPageSize(),
+		PageToken:  req.GetPageToken(),
+		Locale:     req.GetLocale(),
+	}
+
+	resp, err := h.queries.SearchProducts(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapSearchProductsResponseToProto(resp), nil
+}
+
+// GetEffectivePrices prices several products at once, e.g. the items of a cart.
+func (h *Handler) GetEffectivePrices(ctx context.Context, req *pb.GetEffectivePricesRequest) (*pb.GetEffectivePricesReply, error) {
+	if err := validateGetEffectivePricesRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := query.GetEffectivePricesRequest{
+		ProductIDs: req.GetProductIds(),
+		Segment:    req.GetSegment(),
+		Experiment: query.ExperimentContext{SubjectID: req.GetExperiment().GetSubjectId()},
+	}
+	if req.GetAt() != nil {
+		appReq.At = req.GetAt().AsTime()
+	}
+
+	resp, err := h.queries.GetEffectivePrices(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapEffectivePricesResponseToProto(resp), nil
+}
+
+// GetPriceForQuantity prices a quantity of a product, applying its volume price tiers.
+func (h *Handler) GetPriceForQuantity(ctx context.Context, req *pb.GetPriceForQuantityRequest) (*pb.GetPriceForQuantityReply, error) {
+	if err := validateGetPriceForQuantityRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := query.GetPriceForQuantityRequest{
+		ProductID: req.GetProductId(),
+		Quantity:  req.GetQuantity(),
+	}
+	if req.GetAt() != nil {
+		appReq.At = req.GetAt().AsTime()
+	}
+
+	resp, err := h.queries.GetPriceForQuantity(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapQuantityPriceResponseToProto(resp), nil
+}
+
+// GetTaxInclusivePrice prices a product with the tax of its tax class.
+func (h *Handler) GetTaxInclusivePrice(ctx context.Context, req *pb.GetTaxInclusivePriceRequest) (*pb.GetTaxInclusivePriceReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := query.GetTaxInclusivePriceRequest{
+		ProductID: req.GetProductId(),
+		Region:    req.GetRegion(),
+	}
+	if req.GetAt() != nil {
+		appReq.At = req.GetAt().AsTime()
+	}
+
+	resp, err := h.queries.GetTaxInclusivePrice(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapTaxInclusivePriceResponseToProto(resp), nil
+}
+
+// GetMargin returns the margin of a product over its cost price.
+func (h *Handler) GetMargin(ctx context.Context, req *pb.GetMarginRequest) (*pb.GetMarginReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := query.GetMarginRequest{ProductID: req.GetProductId()}
+	if req.GetAt() != nil {
+		appReq.At = req.GetAt().AsTime()
+	}
+
+	resp, err := h.queries.GetMargin(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapMarginResponseToProto(resp), nil
+}
+
+// CalculatePrice previews the price of a quantity of a product or of an inline base price.
+func (h *Handler) CalculatePrice(ctx context.Context, req *pb.CalculatePriceRequest) (*pb.CalculatePriceReply, error) {
+	if err := validateCalculatePriceRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	appReq := query.CalculatePriceRequest{
+		ProductID:           req.GetProductId(),
+		Quantity:            req.GetQuantity(),
+		CouponPercent:       req.GetCouponPercent(),
+		Segment:             req.GetSegment(),
+		SuggestCharmPrice:   req.GetSuggestCharmPrice(),
+		TargetMarginPercent: req.GetTargetMarginPercent(),
+	}
+	if price := req.GetBasePrice(); price != nil {
+		appReq.BasePriceNumerator = price.GetNumerator()
+		appReq.BasePriceDenominator = price.GetDenominator()
+		appReq.Currency = price.GetCurrency()
+	}
+	if req.GetAt() != nil {
+		appReq.At = req.GetAt().AsTime()
+	}
+
+	resp, err := h.queries.CalculatePrice(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapCalculatePriceResponseToProto(resp), nil
+}
+
+// GetPriceListPrice prices a product for a price list.
+func (h *Handler) GetPriceListPrice(ctx context.Context, req *pb.GetPriceListPriceRequest) (*pb.GetPriceListPriceReply, error) {
+	if req.GetPriceListId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrPriceListIDRequired.Error())
+	}
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ErrProductIDRequired.Error())
+	}
+
+	appReq := query.GetPriceListPriceRequest{
+		PriceListID: req.GetPriceListId(),
+		ProductID:   req.GetProductId(),
+	}
+	if req.GetAt() != nil {
+		appReq.At = req.GetAt().AsTime()
+	}
+
+	resp, err := h.queries.GetPriceListPrice(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapPriceListPriceResponseToProto(resp), nil
+}
+
+// GetPriceHistory returns the price changes of a product in the requested range.
+func (h *Handler) GetPriceHistory(ctx context.Context, req *pb.GetPriceHistoryRequest) (*pb.GetPriceHistoryReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := query.GetPriceHistoryRequest{ProductID: req.GetProductId()}
+	if req.GetFrom() != nil {
+		appReq.From = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		appReq.To = req.GetTo().AsTime()
+	}
+
+	resp, err := h.queries.GetPriceHistory(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapPriceHistoryResponseToProto(resp), nil
+}
+
+// GetProductRevisions returns the revisions of a product in the requested range.
+func (h *Handler) GetProductRevisions(ctx context.Context, req *pb.GetProductRevisionsRequest) (*pb.GetProductRevisionsReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := query.GetProductRevisionsRequest{ProductID: req.GetProductId()}
+	if req.GetFrom() != nil {
+		appReq.From = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		appReq.To = req.GetTo().AsTime()
+	}
+
+	resp, err := h.queries.GetProductRevisions(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapProductRevisionsResponseToProto(resp), nil
+}
+
+// GetProductAtRevision returns the full state of a product right after a revision.
+func (h *Handler) GetProductAtRevision(ctx context.Context, req *pb.GetProductAtRevisionRequest) (*pb.GetProductAtRevisionReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	if req.GetRevisionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "revision_id is required")
+	}
+
+	resp, err := h.queries.GetProductAtRevision(ctx, query.GetProductAtRevisionRequest{
+		ProductID:  req.GetProductId(),
+		RevisionID: req.GetRevisionId(),
+	})
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapProductAtRevisionResponseToProto(resp), nil
+}
+
+// GetRelatedProducts returns the products a product links to.
+func (h *Handler) GetRelatedProducts(ctx context.Context, req *pb.GetRelatedProductsRequest) (*pb.GetRelatedProductsReply, error) {
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+
+	appReq := query.GetRelatedProductsRequest{
+		ProductID: req.GetProductId(),
+		Type:      req.GetType(),
+	}
+
+	resp, err := h.queries.GetRelatedProducts(ctx, appReq)
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapRelatedProductsResponseToProto(resp), nil
+}
+
+// VerifyPriceLock verifies a price lock token and returns the prices it locks.
+func (h *Handler) VerifyPriceLock(ctx context.Context, req *pb.VerifyPriceLockRequest) (*pb.VerifyPriceLockReply, error) {
+	if req.GetPriceLockToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "price_lock_token is required")
+	}
+
+	resp, err := h.queries.VerifyPriceLock(ctx, query.VerifyPriceLockRequest{Token: req.GetPriceLockToken()})
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+
+	return MapVerifyPriceLockResponseToProto(resp), nil
 }
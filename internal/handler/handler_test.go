@@ -3,11 +3,22 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/product-catalog-service/internal/apikey"
+	"github.com/product-catalog-service/internal/availability"
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/clock"
 	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/pricelock"
+	"github.com/product-catalog-service/internal/query"
+	"github.com/product-catalog-service/internal/usecase"
 	pb "github.com/product-catalog-service/proto/product/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -55,6 +66,21 @@ func TestMapDomainErrorToGRPC(t *testing.T) {
 			inputError:   domain.ErrInvalidBasePrice,
 			expectedCode: codes.InvalidArgument,
 		},
+		{
+			name:         "invalid price change time",
+			inputError:   domain.ErrInvalidPriceChangeTime,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid status schedule",
+			inputError:   domain.ErrInvalidStatusSchedule,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "approver required",
+			inputError:   domain.ErrApproverRequired,
+			expectedCode: codes.InvalidArgument,
+		},
 		{
 			name:         "invalid discount percentage",
 			inputError:   domain.ErrInvalidDiscountPercentage,
@@ -70,6 +96,526 @@ func TestMapDomainErrorToGRPC(t *testing.T) {
 			inputError:   domain.ErrNoDiscountToRemove,
 			expectedCode: codes.FailedPrecondition,
 		},
+		{
+			name:         "discount not found",
+			inputError:   domain.ErrDiscountNotFound,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "overlapping discount",
+			inputError:   domain.ErrDiscountOverlap,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "too many discounts",
+			inputError:   domain.ErrTooManyDiscounts,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "invalid price lock token",
+			inputError:   pricelock.ErrInvalidToken,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "expired price lock token",
+			inputError:   pricelock.ErrTokenExpired,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "price locks disabled",
+			inputError:   pricelock.ErrDisabled,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "invalid notification kind",
+			inputError:   domain.ErrInvalidNotificationKind,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid price tier quantity",
+			inputError:   domain.ErrInvalidPriceTierQuantity,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid price tier price",
+			inputError:   domain.ErrInvalidPriceTierPrice,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "duplicate price tier",
+			inputError:   domain.ErrDuplicatePriceTier,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "too many price tiers",
+			inputError:   domain.ErrTooManyPriceTiers,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid quantity",
+			inputError:   domain.ErrInvalidQuantity,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid currency",
+			inputError:   domain.ErrInvalidCurrency,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid order by",
+			inputError:   domain.ErrInvalidOrderBy,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "experiment subject ID too long",
+			inputError:   domain.ErrSubjectIDTooLong,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid page token",
+			inputError:   domain.ErrInvalidPageToken,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid read staleness",
+			inputError:   domain.ErrInvalidReadStaleness,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid page number",
+			inputError:   domain.ErrInvalidPageNumber,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid price at",
+			inputError:   domain.ErrInvalidPriceAt,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid search query",
+			inputError:   domain.ErrInvalidSearchQuery,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid price range",
+			inputError:   domain.ErrInvalidPriceRange,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "currency mismatch",
+			inputError:   domain.ErrCurrencyMismatch,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "price book in the product's currency",
+			inputError:   domain.ErrPriceBookBaseCurrency,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "duplicate segment",
+			inputError:   domain.ErrDuplicateSegment,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "market price in another currency than the market's",
+			inputError:   domain.ErrInvalidMarketPrice,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "market and currency mismatch",
+			inputError:   domain.ErrMarketCurrencyMismatch,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "market price in use",
+			inputError:   domain.ErrMarketPriceInUse,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "no exchange rate",
+			inputError:   domain.ErrNoExchangeRate,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "invalid tax class",
+			inputError:   domain.ErrInvalidTaxClass,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid minimum price",
+			inputError:   domain.ErrInvalidMinimumPrice,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "discount below minimum price",
+			inputError:   domain.ErrDiscountBelowMinimumPrice,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "invalid cost price",
+			inputError:   domain.ErrInvalidCostPrice,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "negative margin",
+			inputError:   domain.ErrNegativeMargin,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "no cost price",
+			inputError:   domain.ErrNoCostPrice,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "no pending price change",
+			inputError:   domain.ErrNoPendingPriceChange,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "discount not pending approval",
+			inputError:   domain.ErrDiscountNotPendingApproval,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "product not pending review",
+			inputError:   domain.ErrProductNotPendingReview,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "reviewer is submitter",
+			inputError:   domain.ErrReviewerIsSubmitter,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "no tax rate",
+			inputError:   domain.ErrNoTaxRate,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "invalid price history range",
+			inputError:   domain.ErrInvalidHistoryRange,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid revision range",
+			inputError:   domain.ErrInvalidRevisionRange,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "catalog frozen",
+			inputError:   fmt.Errorf("%w until 2024-12-02T00:00:00Z (freeze f-1)", domain.ErrCatalogFrozen),
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "discount blacked out",
+			inputError:   fmt.Errorf("%w from 2024-11-25T00:00:00Z until 2024-12-02T00:00:00Z (blackout b-1)", domain.ErrDiscountBlackedOut),
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "notifications disabled",
+			inputError:   usecase.ErrNotificationsDisabled,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "campaign not found",
+			inputError:   domain.ErrCampaignNotFound,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "invalid campaign target",
+			inputError:   domain.ErrInvalidCampaignTarget,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "campaign not draft",
+			inputError:   domain.ErrCampaignNotDraft,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "campaigns disabled",
+			inputError:   usecase.ErrCampaignsDisabled,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "price list not found",
+			inputError:   domain.ErrPriceListNotFound,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "variant not found",
+			inputError:   domain.ErrVariantNotFound,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "revision not found",
+			inputError:   domain.ErrRevisionNotFound,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "duplicate SKU",
+			inputError:   domain.ErrDuplicateSKU,
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name:         "variant discontinued",
+			inputError:   domain.ErrVariantDiscontinued,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "variants disabled",
+			inputError:   usecase.ErrVariantsDisabled,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "invalid stock",
+			inputError:   domain.ErrInvalidStock,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "insufficient stock",
+			inputError:   domain.ErrInsufficientStock,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "measures required",
+			inputError:   domain.ErrMeasuresRequired,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "measures not applicable",
+			inputError:   domain.ErrMeasuresNotApplicable,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "stock not applicable",
+			inputError:   domain.ErrStockNotApplicable,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "age-restricted category",
+			inputError:   domain.ErrAgeRestrictedCategory,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "hazardous measures required",
+			inputError:   domain.ErrHazardousMeasuresRequired,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "restricted market price",
+			inputError:   domain.ErrRestrictedMarketPrice,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "stock conflict",
+			inputError:   domain.ErrStockConflict,
+			expectedCode: codes.Aborted,
+		},
+		{
+			name:         "invalid tag",
+			inputError:   domain.ErrInvalidTag,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "too many tags",
+			inputError:   domain.ErrTooManyTags,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "invalid product attribute",
+			inputError:   domain.ErrInvalidProductAttribute,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "attribute schema violation",
+			inputError:   domain.ErrAttributeSchemaViolation,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "too many product attributes",
+			inputError:   domain.ErrTooManyProductAttributes,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "invalid image URL",
+			inputError:   domain.ErrInvalidImageURL,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "multiple primary images",
+			inputError:   domain.ErrMultiplePrimaryImages,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid image order",
+			inputError:   domain.ErrInvalidImageOrder,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "images disabled",
+			inputError:   usecase.ErrImagesDisabled,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "invalid attachment type",
+			inputError:   domain.ErrInvalidAttachmentType,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "duplicate attachment",
+			inputError:   domain.ErrDuplicateAttachment,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "attachments disabled",
+			inputError:   usecase.ErrAttachmentsDisabled,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "self relation",
+			inputError:   domain.ErrSelfRelation,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "relation cycle",
+			inputError:   domain.ErrRelationCycle,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "too many relations",
+			inputError:   domain.ErrTooManyRelations,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "relations disabled",
+			inputError:   usecase.ErrRelationsDisabled,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "invalid locale",
+			inputError:   domain.ErrInvalidLocale,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "default locale translation",
+			inputError:   domain.ErrDefaultLocaleTranslation,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "translations disabled",
+			inputError:   usecase.ErrTranslationsDisabled,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "invalid slug",
+			inputError:   domain.ErrInvalidSlug,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "slug taken",
+			inputError:   domain.ErrSlugTaken,
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name:         "invalid GTIN",
+			inputError:   domain.ErrInvalidGTIN,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid weight",
+			inputError:   domain.ErrInvalidWeight,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid dimensions",
+			inputError:   domain.ErrInvalidDimensions,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid product kind",
+			inputError:   domain.ErrInvalidProductKind,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid compliance",
+			inputError:   domain.ErrInvalidCompliance,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid sales channel",
+			inputError:   domain.ErrInvalidSalesChannel,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid tenant ID",
+			inputError:   domain.ErrInvalidTenantID,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid rating",
+			inputError:   domain.ErrInvalidRating,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid review ID",
+			inputError:   domain.ErrInvalidReviewID,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "ratings disabled",
+			inputError:   usecase.ErrRatingsDisabled,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "duplicate GTIN",
+			inputError:   domain.ErrDuplicateGTIN,
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name:         "stock disabled",
+			inputError:   usecase.ErrStockDisabled,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "duplicate price list entry",
+			inputError:   domain.ErrDuplicatePriceListEntry,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "price lists disabled",
+			inputError:   query.ErrPriceListsDisabled,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "api key not found",
+			inputError:   domain.ErrAPIKeyNotFound,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "invalid client id",
+			inputError:   domain.ErrInvalidClientID,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "api key revoked",
+			inputError:   domain.ErrAPIKeyRevoked,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "invalid api key",
+			inputError:   apikey.ErrInvalidKey,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "api keys disabled",
+			inputError:   apikey.ErrDisabled,
+			expectedCode: codes.Unimplemented,
+		},
+		{
+			name:         "database unavailable",
+			inputError:   &availability.UnavailableError{RetryAfter: 5 * time.Second},
+			expectedCode: codes.Unavailable,
+		},
 		{
 			name:         "generic error",
 			inputError:   errors.New("some internal error"),
@@ -89,6 +635,35 @@ func TestMapDomainErrorToGRPC(t *testing.T) {
 	}
 }
 
+func TestMapDomainErrorToGRPC_RetryInfo(t *testing.T) {
+	t.Parallel()
+
+	st, ok := status.FromError(MapDomainErrorToGRPC(&availability.UnavailableError{RetryAfter: 5 * time.Second}))
+	require.True(t, ok)
+	require.Len(t, st.Details(), 1)
+	retry, ok := st.Details()[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.Equal(t, 5*time.Second, retry.GetRetryDelay().AsDuration())
+}
+
+func TestMapDomainErrorToGRPC_AttributeSchemaViolations(t *testing.T) {
+	t.Parallel()
+
+	err := &domain.AttributeSchemaError{Category: "Furniture", Violations: []domain.AttributeViolation{
+		{Attribute: "legs", Reason: "must be an integer"},
+		{Attribute: "material", Reason: "is required"},
+	}}
+	st, ok := status.FromError(MapDomainErrorToGRPC(err))
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.GetFieldViolations(), 2)
+	assert.Equal(t, "attributes.legs", badRequest.GetFieldViolations()[0].GetField())
+	assert.Equal(t, "material is required", badRequest.GetFieldViolations()[1].GetDescription())
+}
+
 func TestHandler_CreateProduct_Validation(t *testing.T) {
 	t.Parallel()
 
@@ -187,6 +762,68 @@ func TestHandler_CreateProduct_Validation(t *testing.T) {
 	}
 }
 
+func TestHandler_CloneProduct_Validation(t *testing.T) {
+	t.Parallel()
+
+	handler := NewHandler(nil, nil)
+
+	_, err := handler.CloneProduct(context.Background(), &pb.CloneProductRequest{
+		ProductId: "",
+	})
+
+	assert.Error(t, err)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestHandler_ScheduleActivation_Validation(t *testing.T) {
+	t.Parallel()
+
+	handler := NewHandler(nil, nil)
+
+	_, err := handler.ScheduleActivation(context.Background(), &pb.ScheduleActivationRequest{
+		ProductId: "",
+	})
+
+	assert.Error(t, err)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestHandler_SubmitForReview_Validation(t *testing.T) {
+	t.Parallel()
+
+	handler := NewHandler(nil, nil)
+
+	_, err := handler.SubmitForReview(context.Background(), &pb.SubmitForReviewRequest{
+		ProductId:   "product-123",
+		SubmittedBy: " ",
+	})
+
+	assert.Error(t, err)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestHandler_ApproveProduct_Validation(t *testing.T) {
+	t.Parallel()
+
+	handler := NewHandler(nil, nil)
+
+	_, err := handler.ApproveProduct(context.Background(), &pb.ApproveProductRequest{
+		ProductId: "",
+		Reviewer:  "merch-lead",
+	})
+
+	assert.Error(t, err)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
 func TestHandler_ActivateProduct_Validation(t *testing.T) {
 	t.Parallel()
 
@@ -262,6 +899,19 @@ func TestHandler_GetProduct_Validation(t *testing.T) {
 	assert.Equal(t, codes.InvalidArgument, st.Code())
 }
 
+func TestHandler_VerifyPriceLock_Validation(t *testing.T) {
+	t.Parallel()
+
+	handler := NewHandler(nil, nil)
+
+	_, err := handler.VerifyPriceLock(context.Background(), &pb.VerifyPriceLockRequest{})
+
+	assert.Error(t, err)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
 func TestHandler_UpdateProduct_Validation(t *testing.T) {
 	t.Parallel()
 
@@ -347,6 +997,16 @@ func TestHandler_ApplyDiscount_Validation(t *testing.T) {
 			expectError: true,
 			errorCode:   codes.InvalidArgument,
 		},
+		{
+			name: "invalid priority",
+			request: &pb.ApplyDiscountRequest{
+				ProductId:          "test-id",
+				DiscountPercentage: 10,
+				Priority:           101,
+			},
+			expectError: true,
+			errorCode:   codes.InvalidArgument,
+		},
 	}
 
 	handler := NewHandler(nil, nil)
@@ -366,3 +1026,35 @@ func TestHandler_ApplyDiscount_Validation(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_APIKeys(t *testing.T) {
+	t.Parallel()
+
+	clk := clock.NewFixedClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	handler := NewHandler(nil, nil, WithAPIKeys(apikey.NewManager(apiKeyRepo{}, clk, apikey.WithRotationGrace(time.Hour))))
+	ctx := caller.NewContext(context.Background(), caller.Identity{Client: "storefront"})
+
+	_, err := NewHandler(nil, nil).CreateAPIKey(ctx, &pb.CreateAPIKeyRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = handler.CreateAPIKey(context.Background(), &pb.CreateAPIKeyRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = handler.RotateAPIKey(ctx, &pb.RotateAPIKeyRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	created, err := handler.CreateAPIKey(ctx, &pb.CreateAPIKeyRequest{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.GetSecret())
+
+	rotated, err := handler.RotateAPIKey(ctx, &pb.RotateAPIKeyRequest{KeyId: created.GetKeyId()})
+	require.NoError(t, err)
+	assert.NotEqual(t, created.GetKeyId(), rotated.GetKeyId())
+	assert.Equal(t, clk.Now().Add(time.Hour), rotated.GetPreviousKeyExpiresAt().AsTime())
+
+	other := caller.NewContext(context.Background(), caller.Identity{Client: "backoffice"})
+	_, err = handler.RevokeAPIKey(other, &pb.RevokeAPIKeyRequest{KeyId: rotated.GetKeyId()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = handler.RevokeAPIKey(ctx, &pb.RevokeAPIKeyRequest{KeyId: rotated.GetKeyId()})
+	assert.NoError(t, err)
+	_, err = handler.RevokeAPIKey(ctx, &pb.RevokeAPIKeyRequest{KeyId: rotated.GetKeyId()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/product-catalog-service/internal/apikey"
+	"github.com/product-catalog-service/internal/availability"
+	"github.com/product-catalog-service/internal/caller"
+	pb "github.com/product-catalog-service/proto/product/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys the API gateway asserts the identity of the caller in.
+const (
+	TenantMetadataKey = "x-catalog-tenant"
+	RoleMetadataKey   = "x-catalog-role"
+)
+
+// APIKeyMetadataKey is the metadata key clients send their API key secret in.
+const APIKeyMetadataKey = "x-api-key"
+
+// readMethods are the ProductService RPCs that do not write to the catalog. While the
+// database is unavailable, availability.ReadModel serves or fails them itself.
+var readMethods = map[string]bool{
+	pb.ProductService_GetProduct_FullMethodName:           true,
+	pb.ProductService_BatchGetProducts_FullMethodName:     true,
+	pb.ProductService_ListProducts_FullMethodName:         true,
+	pb.ProductService_GetEffectivePrices_FullMethodName:   true,
+	pb.ProductService_GetPriceForQuantity_FullMethodName:  true,
+	pb.ProductService_GetTaxInclusivePrice_FullMethodName: true,
+	pb.ProductService_GetMargin_FullMethodName:            true,
+	pb.ProductService_CalculatePrice_FullMethodName:       true,
+	pb.ProductService_GetPriceHistory_FullMethodName:      true,
+	pb.ProductService_GetProductRevisions_FullMethodName:  true,
+	pb.ProductService_GetProductAtRevision_FullMethodName: true,
+	pb.ProductService_VerifyPriceLock_FullMethodName:      true,
+}
+
+// productServicePrefix is the method prefix of every ProductService RPC.
+var productServicePrefix = "/" + pb.ProductService_ServiceDesc.ServiceName + "/"
+
+// FastFailWritesInterceptor rejects the ProductService RPCs that write to the catalog with
+// Unavailable while monitor reports the database unavailable, instead of letting them wait
+// for their deadline.
+func FastFailWritesInterceptor(monitor *availability.Monitor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, productServicePrefix) && !readMethods[info.FullMethod] {
+			if err := monitor.Err(); err != nil {
+				return nil, MapDomainErrorToGRPC(err)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// CallerInterceptor stores the caller identity asserted by the API gateway in the request
+// metadata in the request context; see package caller. The gateway must drop these keys
+// from client requests.
+func CallerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(callerContext(ctx), req)
+	}
+}
+
+// CallerStreamInterceptor is CallerInterceptor for streaming RPCs.
+func CallerStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &contextStream{ServerStream: ss, ctx: callerContext(ss.Context())})
+	}
+}
+
+// callerContext returns ctx with the caller identity asserted in its metadata.
+func callerContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return caller.NewContext(ctx, caller.Identity{Tenant: firstValue(md, TenantMetadataKey), Role: firstValue(md, RoleMetadataKey)})
+}
+
+// APIKeyInterceptor rejects ProductService RPCs without a valid API key with
+// Unauthenticated, and adds the client the key was issued to to the caller identity. It
+// must run after CallerInterceptor.
+func APIKeyInterceptor(auth *apikey.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, auth, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// APIKeyStreamInterceptor is APIKeyInterceptor for streaming RPCs. It must run after
+// CallerStreamInterceptor.
+func APIKeyStreamInterceptor(auth *apikey.Authenticator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), auth, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate checks the API key of a call of method, returning ctx with the client the
+// key was issued to added to the caller identity. Calls of methods outside ProductService
+// pass unchanged.
+func authenticate(ctx context.Context, auth *apikey.Authenticator, method string) (context.Context, error) {
+	if !strings.HasPrefix(method, productServicePrefix) {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	key, err := auth.Authenticate(ctx, firstValue(md, APIKeyMetadataKey))
+	if err != nil {
+		return nil, MapDomainErrorToGRPC(err)
+	}
+	id := caller.FromContext(ctx)
+	id.Client = key.ClientID()
+	return caller.NewContext(ctx, id), nil
+}
+
+// contextStream is a server stream with the context of an interceptor.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream.
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// firstValue returns the first value of a metadata key, or "" if it has none.
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
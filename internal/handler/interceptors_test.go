@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/apikey"
+	"github.com/product-catalog-service/internal/availability"
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/domain"
+	pb "github.com/product-catalog-service/proto/product/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestFastFailWritesInterceptor(t *testing.T) {
+	monitor := availability.NewMonitor(func(context.Context) error { return errors.New("unavailable") },
+		availability.MonitorOptions{FailureThreshold: 1})
+	interceptor := FastFailWritesInterceptor(monitor)
+
+	call := func(method string) (bool, error) {
+		called := false
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(context.Context, any) (any, error) {
+				called = true
+				return nil, nil
+			})
+		return called, err
+	}
+
+	// Everything passes while the database is available
+	called, err := call(pb.ProductService_CreateProduct_FullMethodName)
+	assert.NoError(t, err)
+	assert.True(t, called)
+
+	monitor.Check(context.Background())
+
+	tests := []struct {
+		method   string
+		fastFail bool
+	}{
+		{pb.ProductService_CreateProduct_FullMethodName, true},
+		{pb.ProductService_ApplyDiscount_FullMethodName, true},
+		{pb.ProductService_SubscribeToNotifications_FullMethodName, true},
+		{pb.ProductService_GetProduct_FullMethodName, false},
+		{pb.ProductService_ListProducts_FullMethodName, false},
+		{pb.ProductService_VerifyPriceLock_FullMethodName, false},
+		{pb.ProductService_CalculatePrice_FullMethodName, false},
+		{"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			called, err := call(tt.method)
+			assert.Equal(t, !tt.fastFail, called)
+			if tt.fastFail {
+				assert.Equal(t, codes.Unavailable, status.Code(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCallerInterceptor(t *testing.T) {
+	interceptor := CallerInterceptor()
+	identity := func(ctx context.Context) caller.Identity {
+		var id caller.Identity
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: pb.ProductService_ApplyDiscount_FullMethodName},
+			func(ctx context.Context, _ any) (any, error) {
+				id = caller.FromContext(ctx)
+				return nil, nil
+			})
+		assert.NoError(t, err)
+		return id
+	}
+
+	assert.Equal(t, caller.Identity{}, identity(context.Background()))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		TenantMetadataKey, "acme",
+		RoleMetadataKey, "pricing-oncall",
+	))
+	assert.Equal(t, caller.Identity{Tenant: "acme", Role: "pricing-oncall"}, identity(ctx))
+}
+
+// apiKeyRepo is an in-memory contract.APIKeyRepository.
+type apiKeyRepo map[string]*domain.APIKey
+
+func (r apiKeyRepo) FindByID(_ context.Context, id string) (*domain.APIKey, error) {
+	if key, ok := r[id]; ok {
+		return key, nil
+	}
+	return nil, domain.ErrAPIKeyNotFound
+}
+
+func (r apiKeyRepo) Save(_ context.Context, keys ...*domain.APIKey) error {
+	for _, key := range keys {
+		r[key.ID()] = key
+	}
+	return nil
+}
+
+func TestAPIKeyInterceptor(t *testing.T) {
+	clk := clock.NewFixedClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	repo := apiKeyRepo{}
+	issued, err := apikey.NewManager(repo, clk).Create(context.Background(), "storefront")
+	require.NoError(t, err)
+	interceptor := APIKeyInterceptor(apikey.NewAuthenticator(repo, clk, apikey.DefaultCacheTTL))
+
+	call := func(method, secret string) (caller.Identity, bool, error) {
+		ctx := caller.NewContext(context.Background(), caller.Identity{Tenant: "acme"})
+		if secret != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(APIKeyMetadataKey, secret))
+		}
+		var id caller.Identity
+		called := false
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, _ any) (any, error) {
+				id = caller.FromContext(ctx)
+				called = true
+				return nil, nil
+			})
+		return id, called, err
+	}
+
+	id, called, err := call(pb.ProductService_GetProduct_FullMethodName, issued.Secret)
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Equal(t, caller.Identity{Tenant: "acme", Client: "storefront"}, id)
+
+	tests := []struct {
+		name   string
+		method string
+		secret string
+	}{
+		{"missing key", pb.ProductService_GetProduct_FullMethodName, ""},
+		{"wrong secret", pb.ProductService_CreateProduct_FullMethodName, issued.Secret + "x"},
+		{"malformed key", pb.ProductService_CreateProduct_FullMethodName, "secret"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, called, err := call(tt.method, tt.secret)
+			assert.False(t, called)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		})
+	}
+
+	// Other services, such as health checks, need no key
+	_, called, err = call("/grpc.health.v1.Health/Check", "")
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestStreamInterceptors(t *testing.T) {
+	clk := clock.NewFixedClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	repo := apiKeyRepo{}
+	issued, err := apikey.NewManager(repo, clk).Create(context.Background(), "storefront")
+	require.NoError(t, err)
+	callerInterceptor := CallerStreamInterceptor()
+	apiKeyInterceptor := APIKeyStreamInterceptor(apikey.NewAuthenticator(repo, clk, apikey.DefaultCacheTTL))
+	info := &grpc.StreamServerInfo{FullMethod: pb.ProductService_ListAllProducts_FullMethodName, IsServerStream: true}
+
+	call := func(secret string) (caller.Identity, bool, error) {
+		md := metadata.Pairs(TenantMetadataKey, "acme")
+		if secret != "" {
+			md.Set(APIKeyMetadataKey, secret)
+		}
+		stream := &contextStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+		var id caller.Identity
+		called := false
+		err := callerInterceptor(nil, stream, info, func(_ any, ss grpc.ServerStream) error {
+			return apiKeyInterceptor(nil, ss, info, func(_ any, ss grpc.ServerStream) error {
+				id = caller.FromContext(ss.Context())
+				called = true
+				return nil
+			})
+		})
+		return id, called, err
+	}
+
+	id, called, err := call(issued.Secret)
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Equal(t, caller.Identity{Tenant: "acme", Client: "storefront"}, id)
+
+	_, called, err = call("")
+	assert.False(t, called)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
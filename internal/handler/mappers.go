@@ -1,7 +1,10 @@
 package handler
 
 import (
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
 	"github.com/product-catalog-service/internal/query"
+	"github.com/product-catalog-service/internal/usecase"
 	pb "github.com/product-catalog-service/proto/product/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -20,20 +23,126 @@ func MapProductResponseToProto(resp *query.ProductResponse) *pb.Product {
 		BasePrice: &pb.Money{
 			Numerator:   resp.BasePriceNumerator,
 			Denominator: resp.BasePriceDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.BasePriceDisplay,
 		},
 		EffectivePrice: &pb.Money{
 			Numerator:   resp.EffectivePriceNumerator,
 			Denominator: resp.EffectivePriceDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.EffectivePriceDisplay,
 		},
 		HasActiveDiscount: resp.HasActiveDiscount,
 		Status:            resp.Status,
 		CreatedAt:         timestamppb.New(resp.CreatedAt),
 		UpdatedAt:         timestamppb.New(resp.UpdatedAt),
+		PriceSource:       resp.PriceSource,
+		Experiment:        mapExperimentTagToProto(resp.Experiment),
+		TaxClass:          resp.TaxClass,
+		Segment:           resp.Segment,
+		Market:            resp.Market,
+		InStock:           resp.InStock,
+		Tags:              resp.Tags,
+		Attributes:        resp.Attributes,
+		Images:            make([]*pb.ProductImage, len(resp.Images)),
+		Attachments:       make([]*pb.ProductAttachment, len(resp.Attachments)),
+		Locale:            resp.Locale,
+		Slug:              resp.Slug,
+		Sku:               resp.SKU,
+		Gtin:              resp.GTIN,
+	}
+	for i := range resp.Images {
+		product.Images[i] = mapImageToProto(&resp.Images[i])
+	}
+	for i, attachment := range resp.Attachments {
+		product.Attachments[i] = &pb.ProductAttachment{
+			Type:   attachment.Type,
+			Url:    attachment.URL,
+			Locale: attachment.Locale,
+		}
+	}
+
+	if resp.Stock != nil {
+		product.Stock = &pb.Stock{
+			Level:     resp.Stock.Level,
+			Reserved:  resp.Stock.Reserved,
+			Available: resp.Stock.Available,
+			Version:   resp.Stock.Version,
+		}
+	}
+
+	if resp.MinimumPriceDenominator != 0 {
+		product.MinimumPrice = &pb.Money{
+			Numerator:   resp.MinimumPriceNumerator,
+			Denominator: resp.MinimumPriceDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.MinimumPriceDisplay,
+		}
+	}
+
+	if resp.CostPriceDenominator != 0 {
+		product.CostPrice = &pb.Money{
+			Numerator:   resp.CostPriceNumerator,
+			Denominator: resp.CostPriceDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.CostPriceDisplay,
+		}
+	}
+
+	if c := resp.PendingPriceChange; c != nil {
+		product.PendingPriceChange = &pb.PendingPriceChange{
+			BasePrice: &pb.Money{
+				Numerator:   c.PriceNumerator,
+				Denominator: c.PriceDenominator,
+				Currency:    resp.Currency,
+				Display:     c.PriceDisplay,
+			},
+			EffectiveAt: timestamppb.New(c.EffectiveAt),
+		}
+	}
+
+	if resp.ActivateAt != nil {
+		product.ActivateAt = timestamppb.New(*resp.ActivateAt)
+	}
+	if resp.DeactivateAt != nil {
+		product.DeactivateAt = timestamppb.New(*resp.DeactivateAt)
+	}
+	if r := resp.Review; r != nil {
+		product.Review = &pb.ProductReview{
+			SubmittedBy:     r.SubmittedBy,
+			ReviewedBy:      r.ReviewedBy,
+			RejectionReason: r.RejectionReason,
+		}
+	}
+	if w := resp.Weight; w != nil {
+		product.Weight = &pb.Weight{Value: w.Value, Unit: w.Unit, Grams: w.Grams}
+	}
+	if d := resp.Dimensions; d != nil {
+		product.Dimensions = &pb.Dimensions{
+			Height:   d.Height,
+			Width:    d.Width,
+			Depth:    d.Depth,
+			Unit:     d.Unit,
+			HeightMm: d.HeightMM,
+			WidthMm:  d.WidthMM,
+			DepthMm:  d.DepthMM,
+		}
+	}
+	product.Rating = mapRatingToProto(resp.Rating)
+	product.Channels = resp.Channels
+	product.Kind = resp.Kind
+	if c := resp.Compliance; c != nil {
+		product.Compliance = &pb.Compliance{
+			MinimumAge:        int32(c.MinimumAge),
+			Hazardous:         c.Hazardous,
+			RestrictedMarkets: c.RestrictedMarkets,
+		}
 	}
 
 	if resp.DiscountPercent != nil {
 		product.Discount = &pb.Discount{
 			Percentage: *resp.DiscountPercent,
+			Suspended:  resp.DiscountSuspended,
 		}
 		if resp.DiscountStartDate != nil {
 			product.Discount.StartDate = timestamppb.New(*resp.DiscountStartDate)
@@ -43,10 +152,264 @@ func MapProductResponseToProto(resp *query.ProductResponse) *pb.Product {
 		}
 	}
 
+	for _, d := range resp.Discounts {
+		discount := &pb.Discount{
+			Id:              d.ID,
+			Percentage:      d.Percent,
+			Priority:        int32(d.Priority),
+			StartDate:       timestamppb.New(d.StartDate),
+			EndDate:         timestamppb.New(d.EndDate),
+			Suspended:       d.Suspended,
+			Kind:            d.Kind,
+			Market:          d.Market,
+			PendingApproval: d.PendingApproval,
+			ApprovedBy:      d.ApprovedBy,
+			FlashSale:       d.FlashSale,
+		}
+		if d.Kind == string(domain.DiscountKindBuyXGetY) {
+			discount.BuyXGetY = &pb.BuyXGetY{BuyQuantity: int32(d.BuyQuantity), GetQuantity: int32(d.GetQuantity)}
+		}
+		if d.SalePriceDenominator != 0 {
+			discount.SalePrice = &pb.Money{
+				Numerator:   d.SalePriceNumerator,
+				Denominator: d.SalePriceDenominator,
+				Currency:    resp.Currency,
+				Display:     d.SalePriceDisplay,
+			}
+		}
+		product.Discounts = append(product.Discounts, discount)
+	}
+
+	for _, t := range resp.PriceTiers {
+		tier := &pb.PriceTier{MinQuantity: t.MinQuantity}
+		if t.UnitPriceDenominator != 0 {
+			tier.Price = &pb.PriceTier_UnitPrice{UnitPrice: &pb.Money{
+				Numerator:   t.UnitPriceNumerator,
+				Denominator: t.UnitPriceDenominator,
+				Currency:    resp.Currency,
+				Display:     t.UnitPriceDisplay,
+			}}
+		} else {
+			tier.Price = &pb.PriceTier_PercentOff{PercentOff: t.PercentOff}
+		}
+		product.PriceTiers = append(product.PriceTiers, tier)
+	}
+
+	for _, p := range resp.PriceBook {
+		product.PriceBook = append(product.PriceBook, &pb.Money{
+			Numerator:   p.PriceNumerator,
+			Denominator: p.PriceDenominator,
+			Currency:    p.Currency,
+			Display:     p.PriceDisplay,
+		})
+	}
+
+	for _, sp := range resp.SegmentPrices {
+		price := &pb.SegmentPrice{Segment: sp.Segment}
+		if sp.PriceDenominator != 0 {
+			price.Price = &pb.SegmentPrice_FixedPrice{FixedPrice: &pb.Money{
+				Numerator:   sp.PriceNumerator,
+				Denominator: sp.PriceDenominator,
+				Currency:    resp.Currency,
+				Display:     sp.PriceDisplay,
+			}}
+		} else {
+			price.Price = &pb.SegmentPrice_PercentOff{PercentOff: sp.PercentOff}
+		}
+		product.SegmentPrices = append(product.SegmentPrices, price)
+	}
+
+	for _, mp := range resp.MarketPrices {
+		product.MarketPrices = append(product.MarketPrices, &pb.MarketPrice{
+			Market: mp.Market,
+			Price: &pb.Money{
+				Numerator:   mp.PriceNumerator,
+				Denominator: mp.PriceDenominator,
+				Currency:    mp.Currency,
+				Display:     mp.PriceDisplay,
+			},
+			EffectivePrice: &pb.Money{
+				Numerator:   mp.EffectivePriceNumerator,
+				Denominator: mp.EffectivePriceDenominator,
+				Currency:    mp.Currency,
+				Display:     mp.EffectivePriceDisplay,
+			},
+			HasActiveDiscount: mp.HasActiveDiscount,
+		})
+	}
+
+	for _, v := range resp.Variants {
+		product.Variants = append(product.Variants, &pb.Variant{
+			Id:         v.ID,
+			Sku:        v.SKU,
+			Attributes: v.Attributes,
+			PriceDelta: &pb.Money{
+				Numerator:   v.PriceDeltaNumerator,
+				Denominator: v.PriceDeltaDenominator,
+				Currency:    resp.Currency,
+				Display:     v.PriceDeltaDisplay,
+			},
+			Price: &pb.Money{
+				Numerator:   v.PriceNumerator,
+				Denominator: v.PriceDenominator,
+				Currency:    resp.Currency,
+				Display:     v.PriceDisplay,
+			},
+			EffectivePrice: &pb.Money{
+				Numerator:   v.EffectivePriceNumerator,
+				Denominator: v.EffectivePriceDenominator,
+				Currency:    resp.Currency,
+				Display:     v.EffectivePriceDisplay,
+			},
+			Status: v.Status,
+		})
+	}
+
 	return product
 }
 
+// MapPriceTiersFromProto maps proto price tiers to use case requests.
+func MapPriceTiersFromProto(tiers []*pb.PriceTier) []usecase.PriceTierRequest {
+	requests := make([]usecase.PriceTierRequest, len(tiers))
+	for i, t := range tiers {
+		requests[i] = usecase.PriceTierRequest{
+			MinQuantity:          t.GetMinQuantity(),
+			UnitPriceNumerator:   t.GetUnitPrice().GetNumerator(),
+			UnitPriceDenominator: t.GetUnitPrice().GetDenominator(),
+			UnitPriceCurrency:    t.GetUnitPrice().GetCurrency(),
+			PercentOff:           t.GetPercentOff(),
+		}
+	}
+	return requests
+}
+
+// MapPricesFromProto maps proto prices to use case requests.
+func MapPricesFromProto(prices []*pb.Money) []usecase.PriceRequest {
+	requests := make([]usecase.PriceRequest, len(prices))
+	for i, p := range prices {
+		requests[i] = usecase.PriceRequest{
+			Numerator:   p.GetNumerator(),
+			Denominator: p.GetDenominator(),
+			Currency:    p.GetCurrency(),
+		}
+	}
+	return requests
+}
+
+// MapSegmentPricesFromProto maps proto segment prices to use case requests.
+func MapSegmentPricesFromProto(prices []*pb.SegmentPrice) []usecase.SegmentPriceRequest {
+	requests := make([]usecase.SegmentPriceRequest, len(prices))
+	for i, p := range prices {
+		requests[i] = usecase.SegmentPriceRequest{
+			Segment:          p.GetSegment(),
+			PriceNumerator:   p.GetFixedPrice().GetNumerator(),
+			PriceDenominator: p.GetFixedPrice().GetDenominator(),
+			PriceCurrency:    p.GetFixedPrice().GetCurrency(),
+			PercentOff:       p.GetPercentOff(),
+		}
+	}
+	return requests
+}
+
+// MapMarketPricesFromProto maps proto market prices to use case requests.
+func MapMarketPricesFromProto(prices []*pb.MarketPrice) []usecase.MarketPriceRequest {
+	requests := make([]usecase.MarketPriceRequest, len(prices))
+	for i, p := range prices {
+		requests[i] = usecase.MarketPriceRequest{
+			Market:      p.GetMarket(),
+			Numerator:   p.GetPrice().GetNumerator(),
+			Denominator: p.GetPrice().GetDenominator(),
+			Currency:    p.GetPrice().GetCurrency(),
+		}
+	}
+	return requests
+}
+
+// MapComplianceFromProto maps proto compliance flags to a use case request, nil if they
+// are unset.
+func MapComplianceFromProto(compliance *pb.Compliance) *usecase.ComplianceInput {
+	if compliance == nil {
+		return nil
+	}
+	return &usecase.ComplianceInput{
+		MinimumAge:        int(compliance.GetMinimumAge()),
+		Hazardous:         compliance.GetHazardous(),
+		RestrictedMarkets: compliance.GetRestrictedMarkets(),
+	}
+}
+
+// MapWeightFromProto maps a proto weight to a use case request, nil if it is unset.
+func MapWeightFromProto(weight *pb.Weight) *usecase.WeightInput {
+	if weight == nil {
+		return nil
+	}
+	return &usecase.WeightInput{Value: weight.GetValue(), Unit: weight.GetUnit()}
+}
+
+// MapDimensionsFromProto maps proto dimensions to a use case request, nil if they are
+// unset.
+func MapDimensionsFromProto(dimensions *pb.Dimensions) *usecase.DimensionsInput {
+	if dimensions == nil {
+		return nil
+	}
+	return &usecase.DimensionsInput{
+		Height: dimensions.GetHeight(),
+		Width:  dimensions.GetWidth(),
+		Depth:  dimensions.GetDepth(),
+		Unit:   dimensions.GetUnit(),
+	}
+}
+
+// MapPriceBoundFromProto maps a proto price to a bound of a price range, nil if it is
+// unset.
+func MapPriceBoundFromProto(price *pb.Money) *contract.PriceBound {
+	if price == nil {
+		return nil
+	}
+	return &contract.PriceBound{
+		Numerator:   price.GetNumerator(),
+		Denominator: price.GetDenominator(),
+		Currency:    price.GetCurrency(),
+	}
+}
+
+// MapPriceListEntriesFromProto maps proto price list entries to use case requests.
+func MapPriceListEntriesFromProto(entries []*pb.PriceListEntry) []usecase.PriceListEntryRequest {
+	requests := make([]usecase.PriceListEntryRequest, len(entries))
+	for i, e := range entries {
+		requests[i] = usecase.PriceListEntryRequest{
+			ProductID:   e.GetProductId(),
+			Numerator:   e.GetPrice().GetNumerator(),
+			Denominator: e.GetPrice().GetDenominator(),
+			Currency:    e.GetPrice().GetCurrency(),
+		}
+	}
+	return requests
+}
+
 // MapListProductsResponseToProto maps an application response to a proto response.
+// mapImageToProto converts an image of a product to its proto message.
+func mapImageToProto(image *query.ImageResponse) *pb.ProductImage {
+	return &pb.ProductImage{
+		Url:     image.URL,
+		AltText: image.AltText,
+		Primary: image.Primary,
+	}
+}
+
+// MapStockToProto converts the stock returned by a stock use case to its proto message.
+func MapStockToProto(stock *usecase.StockResponse) *pb.Stock {
+	if stock == nil {
+		return nil
+	}
+	return &pb.Stock{
+		Level:     stock.Level,
+		Reserved:  stock.Reserved,
+		Available: stock.Available,
+		Version:   stock.Version,
+	}
+}
+
 func MapListProductsResponseToProto(resp *query.ListProductsResponse) *pb.ListProductsReply {
 	if resp == nil {
 		return &pb.ListProductsReply{}
@@ -54,31 +417,453 @@ func MapListProductsResponseToProto(resp *query.ListProductsResponse) *pb.ListPr
 
 	products := make([]*pb.ProductSummary, len(resp.Products))
 	for i, p := range resp.Products {
-		summary := &pb.ProductSummary{
-			Id:       p.ID,
-			Name:     p.Name,
-			Category: p.Category,
+		products[i] = mapProductSummaryToProto(p)
+	}
+
+	reply := &pb.ListProductsReply{
+		Products:      products,
+		NextPageToken: resp.NextPageToken,
+		TotalCount:    resp.TotalCount,
+	}
+	if resp.CachedAt != nil {
+		reply.Stale = true
+		reply.CachedAt = timestamppb.New(*resp.CachedAt)
+	}
+	return reply
+}
+
+// MapSearchProductsResponseToProto converts the products found by SearchProducts to the
+// proto reply.
+func MapSearchProductsResponseToProto(resp *query.SearchProductsResponse) *pb.SearchProductsReply {
+	products := make([]*pb.ProductSummary, len(resp.Products))
+	for i, p := range resp.Products {
+		products[i] = mapProductSummaryToProto(p)
+	}
+	facets := make([]*pb.SearchFacet, len(resp.Facets))
+	for i, f := range resp.Facets {
+		values := make([]*pb.FacetValue, len(f.Values))
+		for j, v := range f.Values {
+			values[j] = &pb.FacetValue{Value: v.Value, Count: v.Count}
+		}
+		facets[i] = &pb.SearchFacet{Field: f.Field, Values: values}
+	}
+	return &pb.SearchProductsReply{
+		Products:      products,
+		NextPageToken: resp.NextPageToken,
+		TotalCount:    resp.TotalCount,
+		Facets:        facets,
+	}
+}
+
+// mapProductSummaryToProto converts a listed product to its proto message.
+func mapProductSummaryToProto(p *query.ProductSummary) *pb.ProductSummary {
+	summary := &pb.ProductSummary{
+		Id:       p.ID,
+		Name:     p.Name,
+		Category: p.Category,
+		BasePrice: &pb.Money{
+			Numerator:   p.BasePriceNumerator,
+			Denominator: p.BasePriceDenominator,
+			Currency:    p.Currency,
+			Display:     p.BasePriceDisplay,
+		},
+		EffectivePrice: &pb.Money{
+			Numerator:   p.EffectivePriceNumerator,
+			Denominator: p.EffectivePriceDenominator,
+			Currency:    p.Currency,
+			Display:     p.EffectivePriceDisplay,
+		},
+		HasActiveDiscount: p.HasActiveDiscount,
+		Status:            p.Status,
+		CreatedAt:         timestamppb.New(p.CreatedAt),
+		PriceSource:       p.PriceSource,
+		Market:            p.Market,
+		InStock:           p.InStock,
+		Tags:              p.Tags,
+		Locale:            p.Locale,
+		Slug:              p.Slug,
+		Sku:               p.SKU,
+		Gtin:              p.GTIN,
+		Rating:            mapRatingToProto(p.Rating),
+		Channels:          p.Channels,
+	}
+	if p.PrimaryImage != nil {
+		summary.PrimaryImage = mapImageToProto(p.PrimaryImage)
+	}
+	if p.DiscountPercent != nil {
+		summary.DiscountPercent = *p.DiscountPercent
+	}
+	return summary
+}
+
+// mapRatingToProto maps a rating summary to proto.
+func mapRatingToProto(rating query.RatingResponse) *pb.RatingSummary {
+	return &pb.RatingSummary{Average: rating.Average, Count: rating.Count}
+}
+
+// MapEffectivePricesResponseToProto maps an application response to a proto response.
+func MapEffectivePricesResponseToProto(resp *query.GetEffectivePricesResponse) *pb.GetEffectivePricesReply {
+	if resp == nil {
+		return &pb.GetEffectivePricesReply{}
+	}
+
+	prices := make([]*pb.EffectivePrice, len(resp.Prices))
+	for i, p := range resp.Prices {
+		price := &pb.EffectivePrice{
+			ProductId: p.ProductID,
 			BasePrice: &pb.Money{
 				Numerator:   p.BasePriceNumerator,
 				Denominator: p.BasePriceDenominator,
+				Currency:    p.Currency,
+				Display:     p.BasePriceDisplay,
 			},
 			EffectivePrice: &pb.Money{
 				Numerator:   p.EffectivePriceNumerator,
 				Denominator: p.EffectivePriceDenominator,
+				Currency:    p.Currency,
+				Display:     p.EffectivePriceDisplay,
 			},
 			HasActiveDiscount: p.HasActiveDiscount,
+			Currency:          p.Currency,
 			Status:            p.Status,
-			CreatedAt:         timestamppb.New(p.CreatedAt),
+			Experiment:        mapExperimentTagToProto(p.Experiment),
 		}
 		if p.DiscountPercent != nil {
-			summary.DiscountPercent = *p.DiscountPercent
+			price.DiscountPercent = *p.DiscountPercent
 		}
-		products[i] = summary
+		prices[i] = price
 	}
 
-	return &pb.ListProductsReply{
-		Products:      products,
-		NextPageToken: resp.NextPageToken,
-		TotalCount:    resp.TotalCount,
+	reply := &pb.GetEffectivePricesReply{
+		Prices:            prices,
+		MissingProductIds: resp.MissingProductIDs,
+		PriceLockToken:    resp.PriceLockToken,
+	}
+	if resp.PriceLockExpiresAt != nil {
+		reply.PriceLockExpiresAt = timestamppb.New(*resp.PriceLockExpiresAt)
+	}
+	return reply
+}
+
+func mapExperimentTagToProto(tag *query.ExperimentTag) *pb.ExperimentAssignment {
+	if tag == nil {
+		return nil
+	}
+	return &pb.ExperimentAssignment{ExperimentId: tag.ExperimentID, Variant: tag.Variant}
+}
+
+// MapQuantityPriceResponseToProto maps an application response to a proto response.
+func MapQuantityPriceResponseToProto(resp *query.QuantityPriceResponse) *pb.GetPriceForQuantityReply {
+	if resp == nil {
+		return &pb.GetPriceForQuantityReply{}
+	}
+
+	reply := &pb.GetPriceForQuantityReply{
+		ProductId: resp.ProductID,
+		Quantity:  resp.Quantity,
+		BasePrice: &pb.Money{
+			Numerator:   resp.BasePriceNumerator,
+			Denominator: resp.BasePriceDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.BasePriceDisplay,
+		},
+		UnitPrice: &pb.Money{
+			Numerator:   resp.UnitPriceNumerator,
+			Denominator: resp.UnitPriceDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.UnitPriceDisplay,
+		},
+		TotalPrice: &pb.Money{
+			Numerator:   resp.TotalPriceNumerator,
+			Denominator: resp.TotalPriceDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.TotalPriceDisplay,
+		},
+		TierMinQuantity: resp.TierMinQuantity,
+		Currency:        resp.Currency,
+		Status:          resp.Status,
+	}
+	if resp.DiscountPercent != nil {
+		reply.DiscountPercent = *resp.DiscountPercent
+	}
+	return reply
+}
+
+// MapCalculatePriceResponseToProto maps an application response to a proto response.
+func MapCalculatePriceResponseToProto(resp *query.CalculatePriceResponse) *pb.CalculatePriceReply {
+	if resp == nil {
+		return &pb.CalculatePriceReply{}
+	}
+
+	money := func(numerator, denominator int64, display string) *pb.Money {
+		return &pb.Money{
+			Numerator:   numerator,
+			Denominator: denominator,
+			Currency:    resp.Currency,
+			Display:     display,
+		}
+	}
+	reply := &pb.CalculatePriceReply{
+		ProductId:       resp.ProductID,
+		Quantity:        resp.Quantity,
+		BasePrice:       money(resp.BasePriceNumerator, resp.BasePriceDenominator, resp.BasePriceDisplay),
+		UnitPrice:       money(resp.UnitPriceNumerator, resp.UnitPriceDenominator, resp.UnitPriceDisplay),
+		TotalPrice:      money(resp.TotalPriceNumerator, resp.TotalPriceDenominator, resp.TotalPriceDisplay),
+		DiscountAmount:  money(resp.DiscountAmountNumerator, resp.DiscountAmountDenominator, resp.DiscountAmountDisplay),
+		Savings:         money(resp.SavingsNumerator, resp.SavingsDenominator, resp.SavingsDisplay),
+		TierMinQuantity: resp.TierMinQuantity,
+		DiscountPercent: resp.DiscountPercent,
+		CouponPercent:   resp.CouponPercent,
+		Segment:         resp.Segment,
+		Currency:        resp.Currency,
+		Status:          resp.Status,
+	}
+	if resp.CharmPriceDenominator != 0 {
+		reply.CharmPrice = money(resp.CharmPriceNumerator, resp.CharmPriceDenominator, resp.CharmPriceDisplay)
+	}
+	return reply
+}
+
+// MapVerifyPriceLockResponseToProto maps an application response to a proto response.
+func MapVerifyPriceLockResponseToProto(resp *query.VerifyPriceLockResponse) *pb.VerifyPriceLockReply {
+	if resp == nil {
+		return &pb.VerifyPriceLockReply{}
+	}
+
+	prices := make([]*pb.LockedPrice, len(resp.Prices))
+	for i, p := range resp.Prices {
+		prices[i] = &pb.LockedPrice{
+			ProductId: p.ProductID,
+			EffectivePrice: &pb.Money{
+				Numerator:   p.Numerator,
+				Denominator: p.Denominator,
+				Currency:    p.Currency,
+			},
+			Currency: p.Currency,
+		}
+	}
+
+	return &pb.VerifyPriceLockReply{
+		Prices:    prices,
+		IssuedAt:  timestamppb.New(resp.IssuedAt),
+		ExpiresAt: timestamppb.New(resp.ExpiresAt),
+	}
+}
+
+// MapPriceHistoryResponseToProto maps an application response to a proto response.
+func MapPriceHistoryResponseToProto(resp *query.PriceHistoryResponse) *pb.GetPriceHistoryReply {
+	if resp == nil {
+		return &pb.GetPriceHistoryReply{}
+	}
+
+	entries := make([]*pb.PriceHistoryEntry, len(resp.Entries))
+	for i, e := range resp.Entries {
+		entry := &pb.PriceHistoryEntry{
+			ChangedAt:  timestamppb.New(e.ChangedAt),
+			ChangeId:   e.ChangeID,
+			ChangeType: e.ChangeType,
+			BasePrice: &pb.Money{
+				Numerator:   e.BasePriceNumerator,
+				Denominator: e.BasePriceDenominator,
+				Currency:    e.Currency,
+				Display:     e.BasePriceDisplay,
+			},
+			EffectivePrice: &pb.Money{
+				Numerator:   e.EffectivePriceNumerator,
+				Denominator: e.EffectivePriceDenominator,
+				Currency:    e.Currency,
+				Display:     e.EffectivePriceDisplay,
+			},
+			DiscountId: e.DiscountID,
+		}
+		if e.DiscountPercent != nil {
+			entry.DiscountPercent = *e.DiscountPercent
+		}
+		entries[i] = entry
+	}
+
+	return &pb.GetPriceHistoryReply{
+		ProductId: resp.ProductID,
+		Entries:   entries,
+	}
+}
+
+// MapProductRevisionsResponseToProto maps an application response to a proto response.
+func MapProductRevisionsResponseToProto(resp *query.ProductRevisionsResponse) *pb.GetProductRevisionsReply {
+	if resp == nil {
+		return &pb.GetProductRevisionsReply{}
+	}
+
+	revisions := make([]*pb.ProductRevision, len(resp.Revisions))
+	for i, r := range resp.Revisions {
+		changes := make([]*pb.FieldChange, len(r.Changes))
+		for j, c := range r.Changes {
+			changes[j] = &pb.FieldChange{
+				Field:  c.Field,
+				Before: string(c.Before),
+				After:  string(c.After),
+			}
+		}
+		revisions[i] = &pb.ProductRevision{
+			RevisionId:    r.RevisionID,
+			RevisedAt:     timestamppb.New(r.RevisedAt),
+			ChangedFields: r.ChangedFields,
+			Changes:       changes,
+		}
+	}
+
+	return &pb.GetProductRevisionsReply{
+		ProductId: resp.ProductID,
+		Revisions: revisions,
+	}
+}
+
+// MapProductAtRevisionResponseToProto maps an application response to a proto response.
+func MapProductAtRevisionResponseToProto(resp *query.ProductAtRevisionResponse) *pb.GetProductAtRevisionReply {
+	if resp == nil {
+		return &pb.GetProductAtRevisionReply{}
+	}
+
+	return &pb.GetProductAtRevisionReply{
+		ProductId:  resp.ProductID,
+		RevisionId: resp.RevisionID,
+		RevisedAt:  timestamppb.New(resp.RevisedAt),
+		Snapshot:   string(resp.Snapshot),
+	}
+}
+
+// MapRelatedProductsResponseToProto maps an application response to a proto response.
+func MapRelatedProductsResponseToProto(resp *query.GetRelatedProductsResponse) *pb.GetRelatedProductsReply {
+	if resp == nil {
+		return &pb.GetRelatedProductsReply{}
+	}
+
+	products := make([]*pb.RelatedProduct, len(resp.Products))
+	for i, p := range resp.Products {
+		products[i] = &pb.RelatedProduct{
+			Type:    p.Type,
+			Product: mapProductSummaryToProto(p.Product),
+		}
+	}
+
+	return &pb.GetRelatedProductsReply{
+		ProductId: resp.ProductID,
+		Products:  products,
+	}
+}
+
+// MapTaxInclusivePriceResponseToProto maps an application response to a proto response.
+func MapTaxInclusivePriceResponseToProto(resp *query.TaxInclusivePriceResponse) *pb.GetTaxInclusivePriceReply {
+	if resp == nil {
+		return &pb.GetTaxInclusivePriceReply{}
+	}
+
+	return &pb.GetTaxInclusivePriceReply{
+		ProductId:      resp.ProductID,
+		TaxClass:       resp.TaxClass,
+		TaxRatePercent: resp.TaxRatePercent,
+		NetPrice: &pb.Money{
+			Numerator:   resp.NetPriceNumerator,
+			Denominator: resp.NetPriceDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.NetPriceDisplay,
+		},
+		TaxAmount: &pb.Money{
+			Numerator:   resp.TaxAmountNumerator,
+			Denominator: resp.TaxAmountDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.TaxAmountDisplay,
+		},
+		GrossPrice: &pb.Money{
+			Numerator:   resp.GrossPriceNumerator,
+			Denominator: resp.GrossPriceDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.GrossPriceDisplay,
+		},
+		Currency: resp.Currency,
+		Status:   resp.Status,
+	}
+}
+
+// MapMarginResponseToProto maps an application response to a proto response.
+func MapMarginResponseToProto(resp *query.MarginResponse) *pb.GetMarginReply {
+	if resp == nil {
+		return &pb.GetMarginReply{}
+	}
+
+	return &pb.GetMarginReply{
+		ProductId: resp.ProductID,
+		Price: &pb.Money{
+			Numerator:   resp.PriceNumerator,
+			Denominator: resp.PriceDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.PriceDisplay,
+		},
+		CostPrice: &pb.Money{
+			Numerator:   resp.CostPriceNumerator,
+			Denominator: resp.CostPriceDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.CostPriceDisplay,
+		},
+		Margin: &pb.Money{
+			Numerator:   resp.MarginNumerator,
+			Denominator: resp.MarginDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.MarginDisplay,
+		},
+		MarginPercent:     resp.MarginPercent,
+		HasActiveDiscount: resp.HasActiveDiscount,
+		Currency:          resp.Currency,
+		Status:            resp.Status,
+	}
+}
+
+// MapPriceListPriceResponseToProto maps an application response to a proto response.
+func MapPriceListPriceResponseToProto(resp *query.PriceListPriceResponse) *pb.GetPriceListPriceReply {
+	if resp == nil {
+		return &pb.GetPriceListPriceReply{}
+	}
+
+	return &pb.GetPriceListPriceReply{
+		PriceListId: resp.PriceListID,
+		ProductId:   resp.ProductID,
+		Price: &pb.Money{
+			Numerator:   resp.PriceNumerator,
+			Denominator: resp.PriceDenominator,
+			Currency:    resp.Currency,
+			Display:     resp.PriceDisplay,
+		},
+		Source: resp.Source,
+		Status: resp.Status,
+	}
+}
+
+// MapActivateCampaignResponseToProto converts the result of activating a campaign to
+// protobuf.
+func MapActivateCampaignResponseToProto(resp *usecase.ActivateCampaignResponse) *pb.ActivateCampaignReply {
+	return &pb.ActivateCampaignReply{
+		AppliedCount: int32(resp.AppliedCount),
+		Skipped:      mapSkippedProductsToProto(resp.Skipped),
+	}
+}
+
+// MapApplyDiscountToCategoryResponseToProto converts the result of applying a discount to
+// a category to protobuf.
+func MapApplyDiscountToCategoryResponseToProto(resp *usecase.ApplyDiscountToCategoryResponse) *pb.ApplyDiscountToCategoryReply {
+	return &pb.ApplyDiscountToCategoryReply{
+		DiscountId:      resp.DiscountID,
+		PendingApproval: resp.PendingApproval,
+		AppliedCount:    int32(resp.AppliedCount),
+		Skipped:         mapSkippedProductsToProto(resp.Skipped),
+	}
+}
+
+// mapSkippedProductsToProto converts the products a discount was not applied to to
+// protobuf.
+func mapSkippedProductsToProto(products []usecase.SkippedProduct) []*pb.SkippedProduct {
+	skipped := make([]*pb.SkippedProduct, len(products))
+	for i, s := range products {
+		skipped[i] = &pb.SkippedProduct{ProductId: s.ProductID, Reason: s.Reason}
 	}
+	return skipped
 }
@@ -2,7 +2,11 @@ package handler
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/query"
 	pb "github.com/product-catalog-service/proto/product/v1"
 )
 
@@ -15,11 +19,91 @@ var (
 	ErrInvalidBasePrice       = errors.New("base_price must be positive")
 	ErrDiscountRequired       = errors.New("discount_percentage is required")
 	ErrInvalidDiscount        = errors.New("discount_percentage must be between 0 and 100")
+	ErrInvalidPriority        = fmt.Errorf("priority must be between 0 and %d", domain.MaxDiscountPriority)
 	ErrStartDateRequired      = errors.New("start_date is required")
 	ErrEndDateRequired        = errors.New("end_date is required")
 	ErrEndDateBeforeStartDate = errors.New("end_date must be after start_date")
+	ErrProductIDsRequired     = errors.New("product_ids is required")
+	ErrTooManyProductIDs      = fmt.Errorf("product_ids must not contain more than %d IDs", query.MaxEffectivePriceIDs)
+	ErrSubscriberIDRequired   = errors.New("subscriber_id is required")
+	ErrSubscriberIDTooLong    = fmt.Errorf("subscriber_id must not be longer than %d characters", maxSubscriberIDLength)
+	ErrKindRequired           = errors.New("kind is required")
+	ErrTooManyPriceTiers      = fmt.Errorf("tiers must not contain more than %d tiers", domain.MaxPriceTiersPerProduct)
+	ErrInvalidMinQuantity     = errors.New("min_quantity must be at least 2")
+	ErrTierPriceRequired      = errors.New("unit_price or percent_off is required")
+	ErrInvalidUnitPrice       = errors.New("unit_price must be positive")
+	ErrInvalidPercentOff      = errors.New("percent_off must be between 0 and 100")
+	ErrInvalidQuantity        = fmt.Errorf("quantity must be between 1 and %d", domain.MaxPricedQuantity)
+	ErrTooManyPrices          = fmt.Errorf("prices must not contain more than %d prices", domain.MaxPriceBookEntries)
+	ErrPriceCurrencyRequired  = errors.New("currency is required for every price")
+	ErrInvalidPrice           = errors.New("prices must be positive")
+	ErrTaxClassRequired       = errors.New("tax_class is required")
+	ErrCampaignIDRequired     = errors.New("campaign_id is required")
+	ErrCampaignTargetRequired = errors.New("exactly one of category and product_ids is required")
+	ErrTooManyCampaignIDs     = fmt.Errorf("product_ids must not contain more than %d IDs", domain.MaxCampaignProducts)
+	ErrKeyIDRequired          = errors.New("key_id is required")
+	ErrPromotionPercentage    = errors.New("discount_percentage must be 0 for a buy_x_get_y promotion")
+	ErrInvalidPromotion       = fmt.Errorf("buy_quantity and get_quantity must be between 1 and %d", domain.MaxPromotionQuantity)
+	ErrTooManySegmentPrices   = fmt.Errorf("prices must not contain more than %d segment prices", domain.MaxSegmentPrices)
+	ErrSegmentRequired        = errors.New("segment is required for every segment price")
+	ErrSegmentPriceRequired   = errors.New("fixed_price or percent_off is required")
+	ErrInvalidFixedPrice      = errors.New("fixed_price must be positive")
+	ErrMarketRequired         = errors.New("market is required for every market price")
+	ErrMarketPriceRequired    = errors.New("price is required for every market price")
+	ErrCurrencyRequired       = errors.New("currency is required")
+	ErrValidFromRequired      = errors.New("valid_from is required")
+	ErrValidUntilBeforeFrom   = errors.New("valid_until must be after valid_from")
+	ErrPriceListIDRequired    = errors.New("price_list_id is required")
+	ErrTooManyPriceListItems  = fmt.Errorf("entries must not contain more than %d entries", domain.MaxPriceListEntries)
+	ErrEntryProductIDRequired = errors.New("product_id is required for every entry")
+	ErrEntryPriceRequired     = errors.New("price is required for every entry")
+	ErrEffectiveAtRequired    = errors.New("effective_at is required")
+	ErrDiscountIDRequired     = errors.New("discount_id is required")
+	ErrApproverRequired       = errors.New("approver is required")
+	ErrApproverTooLong        = fmt.Errorf("approver must not be longer than %d characters", maxApproverLength)
+	ErrPriceSubjectRequired   = errors.New("exactly one of product_id and base_price is required")
+	ErrInvalidCouponPercent   = errors.New("coupon_percent must be between 0 and 100")
+	ErrSalePricePercentage    = errors.New("discount_percentage must be 0 for a sale_price discount")
+	ErrSalePriceCombined      = errors.New("sale_price cannot be combined with buy_x_get_y or market")
+	ErrInvalidSalePrice       = errors.New("sale_price must not be negative")
+	ErrInvalidTargetMargin    = errors.New("target_margin_percent must be at least 0 and below 100")
+	ErrVariantIDRequired      = errors.New("variant_id is required")
+	ErrSKURequired            = errors.New("sku is required")
+	ErrTooManyAttributes      = fmt.Errorf("attributes must not contain more than %d attributes", domain.MaxVariantAttributes)
+	ErrInvalidPriceDelta      = errors.New("price_delta must have a positive denominator")
+	ErrInvalidStockLevel      = fmt.Errorf("level must be between 0 and %d", domain.MaxStockLevel)
+	ErrInvalidReserved        = errors.New("reserved must be between 0 and level")
+	ErrStockDeltaRequired     = errors.New("level_delta or reserved_delta is required")
+	ErrInvalidStockDelta      = fmt.Errorf("level_delta and reserved_delta must be between -%d and %d", domain.MaxStockLevel, domain.MaxStockLevel)
+	ErrInvalidExpectedVersion = errors.New("expected_version must not be negative")
+	ErrTagRequired            = errors.New("tag is required")
+	ErrAttributeNameRequired  = errors.New("name is required")
+	ErrImageURLRequired       = errors.New("every image needs a url")
+	ErrAttachmentRequired     = errors.New("every attachment needs a type and a url")
+	ErrRelatedProductRequired = errors.New("related_product_id is required")
+	ErrRelationTypeRequired   = errors.New("type is required")
+	ErrLocaleRequired         = errors.New("locale is required")
+	ErrSlugRequired           = errors.New("slug is required")
+	ErrSubmitterRequired      = errors.New("submitted_by is required")
+	ErrSubmitterTooLong       = fmt.Errorf("submitted_by must not be longer than %d characters", maxReviewerLength)
+	ErrReviewerRequired       = errors.New("reviewer is required")
+	ErrReviewerTooLong        = fmt.Errorf("reviewer must not be longer than %d characters", maxReviewerLength)
+	ErrReasonRequired         = errors.New("reason is required")
+	ErrReasonTooLong          = fmt.Errorf("reason must not be longer than %d characters", maxRejectionReasonLength)
 )
 
+// maxSubscriberIDLength is the size of the subscriber_id column.
+const maxSubscriberIDLength = 100
+
+// maxApproverLength is the size of the approved_by column of product_discounts.
+const maxApproverLength = 256
+
+// maxReviewerLength is the size of the submitted_by and reviewed_by columns of products.
+const maxReviewerLength = 256
+
+// maxRejectionReasonLength is the longest rejection reason accepted.
+const maxRejectionReasonLength = 1000
+
 // validateCreateRequest validates a CreateProductRequest.
 func validateCreateRequest(req *pb.CreateProductRequest) error {
 	if req.GetName() == "" {
@@ -51,14 +135,148 @@ func validateUpdateRequest(req *pb.UpdateProductRequest) error {
 	return nil
 }
 
+// validateChangeBasePriceRequest validates a ChangeBasePriceRequest.
+func validateChangeBasePriceRequest(req *pb.ChangeBasePriceRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if req.GetBasePrice() == nil {
+		return ErrBasePriceRequired
+	}
+	if req.GetBasePrice().GetNumerator() <= 0 || req.GetBasePrice().GetDenominator() <= 0 {
+		return ErrInvalidBasePrice
+	}
+	return nil
+}
+
+// validateSchedulePriceChangeRequest validates a SchedulePriceChangeRequest.
+func validateSchedulePriceChangeRequest(req *pb.SchedulePriceChangeRequest) error {
+	if err := validateChangeBasePriceRequest(&pb.ChangeBasePriceRequest{
+		ProductId: req.GetProductId(),
+		BasePrice: req.GetBasePrice(),
+	}); err != nil {
+		return err
+	}
+	if req.GetEffectiveAt() == nil {
+		return ErrEffectiveAtRequired
+	}
+	return nil
+}
+
+// validateSubmitForReviewRequest validates a SubmitForReviewRequest.
+func validateSubmitForReviewRequest(req *pb.SubmitForReviewRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	submittedBy := strings.TrimSpace(req.GetSubmittedBy())
+	if submittedBy == "" {
+		return ErrSubmitterRequired
+	}
+	if len(submittedBy) > maxReviewerLength {
+		return ErrSubmitterTooLong
+	}
+	return nil
+}
+
+// validateReviewer validates the product ID and reviewer of a product review.
+func validateReviewer(productID, reviewer string) error {
+	if productID == "" {
+		return ErrProductIDRequired
+	}
+	reviewer = strings.TrimSpace(reviewer)
+	if reviewer == "" {
+		return ErrReviewerRequired
+	}
+	if len(reviewer) > maxReviewerLength {
+		return ErrReviewerTooLong
+	}
+	return nil
+}
+
+// validateRejectProductRequest validates a RejectProductRequest.
+func validateRejectProductRequest(req *pb.RejectProductRequest) error {
+	if err := validateReviewer(req.GetProductId(), req.GetReviewer()); err != nil {
+		return err
+	}
+	reason := strings.TrimSpace(req.GetReason())
+	if reason == "" {
+		return ErrReasonRequired
+	}
+	if len(reason) > maxRejectionReasonLength {
+		return ErrReasonTooLong
+	}
+	return nil
+}
+
+// validateApproveDiscountRequest validates an ApproveDiscountRequest.
+func validateApproveDiscountRequest(req *pb.ApproveDiscountRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if req.GetDiscountId() == "" {
+		return ErrDiscountIDRequired
+	}
+	approver := strings.TrimSpace(req.GetApprover())
+	if approver == "" {
+		return ErrApproverRequired
+	}
+	if len(approver) > maxApproverLength {
+		return ErrApproverTooLong
+	}
+	return nil
+}
+
 // validateApplyDiscountRequest validates an ApplyDiscountRequest.
 func validateApplyDiscountRequest(req *pb.ApplyDiscountRequest) error {
 	if req.GetProductId() == "" {
 		return ErrProductIDRequired
 	}
+	if sale := req.GetSalePrice(); sale != nil {
+		if req.GetDiscountPercentage() != 0 {
+			return ErrSalePricePercentage
+		}
+		if req.GetBuyXGetY() != nil || req.GetMarket() != "" {
+			return ErrSalePriceCombined
+		}
+		if sale.GetNumerator() < 0 || sale.GetDenominator() <= 0 {
+			return ErrInvalidSalePrice
+		}
+	} else if promotion := req.GetBuyXGetY(); promotion != nil {
+		if req.GetDiscountPercentage() != 0 {
+			return ErrPromotionPercentage
+		}
+		if !validPromotionQuantity(promotion.GetBuyQuantity()) || !validPromotionQuantity(promotion.GetGetQuantity()) {
+			return ErrInvalidPromotion
+		}
+	} else if req.GetDiscountPercentage() <= 0 || req.GetDiscountPercentage() > 100 {
+		return ErrInvalidDiscount
+	}
+	if req.GetPriority() < 0 || req.GetPriority() > domain.MaxDiscountPriority {
+		return ErrInvalidPriority
+	}
+	if req.GetStartDate() == nil {
+		return ErrStartDateRequired
+	}
+	if req.GetEndDate() == nil {
+		return ErrEndDateRequired
+	}
+	if !req.GetEndDate().AsTime().After(req.GetStartDate().AsTime()) {
+		return ErrEndDateBeforeStartDate
+	}
+	return nil
+}
+
+// validateApplyDiscountToCategoryRequest validates an ApplyDiscountToCategoryRequest.
+func validateApplyDiscountToCategoryRequest(req *pb.ApplyDiscountToCategoryRequest) error {
+	if req.GetCategory() == "" {
+		return ErrCategoryRequired
+	}
 	if req.GetDiscountPercentage() <= 0 || req.GetDiscountPercentage() > 100 {
 		return ErrInvalidDiscount
 	}
+	if req.GetPriority() < 0 || req.GetPriority() > domain.MaxDiscountPriority {
+		return ErrInvalidPriority
+	}
 	if req.GetStartDate() == nil {
 		return ErrStartDateRequired
 	}
@@ -70,3 +288,460 @@ func validateApplyDiscountRequest(req *pb.ApplyDiscountRequest) error {
 	}
 	return nil
 }
+
+// validPromotionQuantity reports whether q is a valid buy or get quantity.
+func validPromotionQuantity(q int32) bool {
+	return q >= 1 && q <= domain.MaxPromotionQuantity
+}
+
+// validateCreateCampaignRequest validates a CreateCampaignRequest.
+func validateCreateCampaignRequest(req *pb.CreateCampaignRequest) error {
+	if req.GetName() == "" {
+		return ErrNameRequired
+	}
+	if (req.GetCategory() == "") == (len(req.GetProductIds()) == 0) {
+		return ErrCampaignTargetRequired
+	}
+	if len(req.GetProductIds()) > domain.MaxCampaignProducts {
+		return ErrTooManyCampaignIDs
+	}
+	if req.GetDiscountPercentage() <= 0 || req.GetDiscountPercentage() > 100 {
+		return ErrInvalidDiscount
+	}
+	if req.GetPriority() < 0 || req.GetPriority() > domain.MaxDiscountPriority {
+		return ErrInvalidPriority
+	}
+	if req.GetStartDate() == nil {
+		return ErrStartDateRequired
+	}
+	if req.GetEndDate() == nil {
+		return ErrEndDateRequired
+	}
+	if !req.GetEndDate().AsTime().After(req.GetStartDate().AsTime()) {
+		return ErrEndDateBeforeStartDate
+	}
+	return nil
+}
+
+// validateCreatePriceListRequest validates a CreatePriceListRequest.
+func validateCreatePriceListRequest(req *pb.CreatePriceListRequest) error {
+	if req.GetName() == "" {
+		return ErrNameRequired
+	}
+	if req.GetCurrency() == "" {
+		return ErrCurrencyRequired
+	}
+	if req.GetValidFrom() == nil {
+		return ErrValidFromRequired
+	}
+	if req.GetValidUntil() != nil && !req.GetValidUntil().AsTime().After(req.GetValidFrom().AsTime()) {
+		return ErrValidUntilBeforeFrom
+	}
+	return nil
+}
+
+// validateSetPriceListEntriesRequest validates a SetPriceListEntriesRequest.
+func validateSetPriceListEntriesRequest(req *pb.SetPriceListEntriesRequest) error {
+	if req.GetPriceListId() == "" {
+		return ErrPriceListIDRequired
+	}
+	if len(req.GetEntries()) > domain.MaxPriceListEntries {
+		return ErrTooManyPriceListItems
+	}
+	for _, e := range req.GetEntries() {
+		if e.GetProductId() == "" {
+			return ErrEntryProductIDRequired
+		}
+		if e.GetPrice() == nil {
+			return ErrEntryPriceRequired
+		}
+		if e.GetPrice().GetNumerator() <= 0 || e.GetPrice().GetDenominator() <= 0 {
+			return ErrInvalidPrice
+		}
+	}
+	return nil
+}
+
+// validateGetEffectivePricesRequest validates a GetEffectivePricesRequest.
+func validateGetEffectivePricesRequest(req *pb.GetEffectivePricesRequest) error {
+	if len(req.GetProductIds()) == 0 {
+		return ErrProductIDsRequired
+	}
+	if len(req.GetProductIds()) > query.MaxEffectivePriceIDs {
+		return ErrTooManyProductIDs
+	}
+	for _, id := range req.GetProductIds() {
+		if id == "" {
+			return ErrProductIDRequired
+		}
+	}
+	return nil
+}
+
+// validateBatchGetProductsRequest validates a BatchGetProductsRequest.
+func validateBatchGetProductsRequest(req *pb.BatchGetProductsRequest) error {
+	if len(req.GetProductIds()) == 0 {
+		return ErrProductIDsRequired
+	}
+	if len(req.GetProductIds()) > query.MaxBatchGetProductIDs {
+		return ErrTooManyProductIDs
+	}
+	for _, id := range req.GetProductIds() {
+		if id == "" {
+			return ErrProductIDRequired
+		}
+	}
+	return nil
+}
+
+// validateSetPriceTiersRequest validates a SetPriceTiersRequest.
+func validateSetPriceTiersRequest(req *pb.SetPriceTiersRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if len(req.GetTiers()) > domain.MaxPriceTiersPerProduct {
+		return ErrTooManyPriceTiers
+	}
+	for _, tier := range req.GetTiers() {
+		if tier.GetMinQuantity() < 2 {
+			return ErrInvalidMinQuantity
+		}
+		switch price := tier.GetPrice().(type) {
+		case *pb.PriceTier_UnitPrice:
+			if price.UnitPrice.GetNumerator() <= 0 || price.UnitPrice.GetDenominator() <= 0 {
+				return ErrInvalidUnitPrice
+			}
+		case *pb.PriceTier_PercentOff:
+			if price.PercentOff <= 0 || price.PercentOff > 100 {
+				return ErrInvalidPercentOff
+			}
+		default:
+			return ErrTierPriceRequired
+		}
+	}
+	return nil
+}
+
+// validateSetPriceBookRequest validates a SetPriceBookRequest.
+func validateSetPriceBookRequest(req *pb.SetPriceBookRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if len(req.GetPrices()) > domain.MaxPriceBookEntries {
+		return ErrTooManyPrices
+	}
+	for _, price := range req.GetPrices() {
+		if price.GetNumerator() <= 0 || price.GetDenominator() <= 0 {
+			return ErrInvalidPrice
+		}
+		if price.GetCurrency() == "" {
+			return ErrPriceCurrencyRequired
+		}
+	}
+	return nil
+}
+
+// validateSetSegmentPricesRequest validates a SetSegmentPricesRequest.
+func validateSetSegmentPricesRequest(req *pb.SetSegmentPricesRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if len(req.GetPrices()) > domain.MaxSegmentPrices {
+		return ErrTooManySegmentPrices
+	}
+	for _, sp := range req.GetPrices() {
+		if sp.GetSegment() == "" {
+			return ErrSegmentRequired
+		}
+		switch price := sp.GetPrice().(type) {
+		case *pb.SegmentPrice_FixedPrice:
+			if price.FixedPrice.GetNumerator() <= 0 || price.FixedPrice.GetDenominator() <= 0 {
+				return ErrInvalidFixedPrice
+			}
+		case *pb.SegmentPrice_PercentOff:
+			if price.PercentOff <= 0 || price.PercentOff >= 100 {
+				return ErrInvalidPercentOff
+			}
+		default:
+			return ErrSegmentPriceRequired
+		}
+	}
+	return nil
+}
+
+// validateSetMarketPricesRequest validates a SetMarketPricesRequest.
+func validateSetMarketPricesRequest(req *pb.SetMarketPricesRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	for _, mp := range req.GetPrices() {
+		if mp.GetMarket() == "" {
+			return ErrMarketRequired
+		}
+		if mp.GetPrice() == nil {
+			return ErrMarketPriceRequired
+		}
+		if mp.GetPrice().GetNumerator() <= 0 || mp.GetPrice().GetDenominator() <= 0 {
+			return ErrInvalidPrice
+		}
+	}
+	return nil
+}
+
+// validateSetTaxClassRequest validates a SetTaxClassRequest.
+func validateSetTaxClassRequest(req *pb.SetTaxClassRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if req.GetTaxClass() == "" {
+		return ErrTaxClassRequired
+	}
+	return nil
+}
+
+// validateSetMinimumPriceRequest validates a SetMinimumPriceRequest.
+func validateSetMinimumPriceRequest(req *pb.SetMinimumPriceRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if price := req.GetMinimumPrice(); price != nil && (price.GetNumerator() <= 0 || price.GetDenominator() <= 0) {
+		return ErrInvalidPrice
+	}
+	return nil
+}
+
+// validateSetCostPriceRequest validates a SetCostPriceRequest.
+func validateSetCostPriceRequest(req *pb.SetCostPriceRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if price := req.GetCostPrice(); price != nil && (price.GetNumerator() <= 0 || price.GetDenominator() <= 0) {
+		return ErrInvalidPrice
+	}
+	return nil
+}
+
+// validateAddVariantRequest validates an AddVariantRequest.
+func validateAddVariantRequest(req *pb.AddVariantRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	return validateVariant(req.GetSku(), req.GetAttributes(), req.GetPriceDelta())
+}
+
+// validateUpdateVariantRequest validates an UpdateVariantRequest.
+func validateUpdateVariantRequest(req *pb.UpdateVariantRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if req.GetVariantId() == "" {
+		return ErrVariantIDRequired
+	}
+	return validateVariant(req.GetSku(), req.GetAttributes(), req.GetPriceDelta())
+}
+
+// validateDiscontinueVariantRequest validates a DiscontinueVariantRequest.
+func validateDiscontinueVariantRequest(req *pb.DiscontinueVariantRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if req.GetVariantId() == "" {
+		return ErrVariantIDRequired
+	}
+	return nil
+}
+
+func validateVariant(sku string, attributes map[string]string, priceDelta *pb.Money) error {
+	if strings.TrimSpace(sku) == "" {
+		return ErrSKURequired
+	}
+	if len(attributes) > domain.MaxVariantAttributes {
+		return ErrTooManyAttributes
+	}
+	if priceDelta != nil && priceDelta.GetDenominator() <= 0 {
+		return ErrInvalidPriceDelta
+	}
+	return nil
+}
+
+// validateSetStockRequest validates a SetStockRequest.
+func validateSetStockRequest(req *pb.SetStockRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if req.GetLevel() < 0 || req.GetLevel() > domain.MaxStockLevel {
+		return ErrInvalidStockLevel
+	}
+	if req.GetReserved() < 0 || req.GetReserved() > req.GetLevel() {
+		return ErrInvalidReserved
+	}
+	if req.GetExpectedVersion() < 0 {
+		return ErrInvalidExpectedVersion
+	}
+	return nil
+}
+
+// validateAdjustStockRequest validates an AdjustStockRequest.
+func validateAdjustStockRequest(req *pb.AdjustStockRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if req.GetLevelDelta() == 0 && req.GetReservedDelta() == 0 {
+		return ErrStockDeltaRequired
+	}
+	for _, delta := range []int64{req.GetLevelDelta(), req.GetReservedDelta()} {
+		if delta < -domain.MaxStockLevel || delta > domain.MaxStockLevel {
+			return ErrInvalidStockDelta
+		}
+	}
+	if req.GetExpectedVersion() < 0 {
+		return ErrInvalidExpectedVersion
+	}
+	return nil
+}
+
+// validateTagRequest validates an AddTagRequest or a RemoveTagRequest.
+func validateTagRequest(productID, tag string) error {
+	if productID == "" {
+		return ErrProductIDRequired
+	}
+	if strings.TrimSpace(tag) == "" {
+		return ErrTagRequired
+	}
+	return nil
+}
+
+// validateSetAttributeRequest validates a SetAttributeRequest.
+func validateSetAttributeRequest(req *pb.SetAttributeRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if strings.TrimSpace(req.GetName()) == "" {
+		return ErrAttributeNameRequired
+	}
+	return nil
+}
+
+// validateSetSlugRequest validates a SetSlugRequest.
+func validateSetSlugRequest(req *pb.SetSlugRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if strings.TrimSpace(req.GetSlug()) == "" {
+		return ErrSlugRequired
+	}
+	return nil
+}
+
+// validateSetTranslationRequest validates a SetTranslationRequest.
+func validateSetTranslationRequest(req *pb.SetTranslationRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if strings.TrimSpace(req.GetLocale()) == "" {
+		return ErrLocaleRequired
+	}
+	return nil
+}
+
+// validateSetImagesRequest validates a SetImagesRequest.
+func validateSetImagesRequest(req *pb.SetImagesRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	for _, image := range req.GetImages() {
+		if strings.TrimSpace(image.GetUrl()) == "" {
+			return ErrImageURLRequired
+		}
+	}
+	return nil
+}
+
+// validateManageAttachmentsRequest validates a ManageAttachmentsRequest.
+func validateManageAttachmentsRequest(req *pb.ManageAttachmentsRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	for _, attachment := range req.GetAttachments() {
+		if attachment.GetType() == "" || strings.TrimSpace(attachment.GetUrl()) == "" {
+			return ErrAttachmentRequired
+		}
+	}
+	return nil
+}
+
+// validateReorderImagesRequest validates a ReorderImagesRequest.
+func validateReorderImagesRequest(req *pb.ReorderImagesRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	for _, url := range req.GetUrls() {
+		if strings.TrimSpace(url) == "" {
+			return ErrImageURLRequired
+		}
+	}
+	return nil
+}
+
+// validateProductLinkRequest validates a LinkProductsRequest or an UnlinkProductsRequest.
+func validateProductLinkRequest(productID, relatedProductID, relationType string) error {
+	if productID == "" {
+		return ErrProductIDRequired
+	}
+	if relatedProductID == "" {
+		return ErrRelatedProductRequired
+	}
+	if relationType == "" {
+		return ErrRelationTypeRequired
+	}
+	return nil
+}
+
+// validateGetPriceForQuantityRequest validates a GetPriceForQuantityRequest.
+func validateGetPriceForQuantityRequest(req *pb.GetPriceForQuantityRequest) error {
+	if req.GetProductId() == "" {
+		return ErrProductIDRequired
+	}
+	if req.GetQuantity() < 1 || req.GetQuantity() > domain.MaxPricedQuantity {
+		return ErrInvalidQuantity
+	}
+	return nil
+}
+
+// validateCalculatePriceRequest validates a CalculatePriceRequest.
+func validateCalculatePriceRequest(req *pb.CalculatePriceRequest) error {
+	if (req.GetProductId() == "") == (req.GetBasePrice() == nil) {
+		return ErrPriceSubjectRequired
+	}
+	if price := req.GetBasePrice(); price != nil && (price.GetNumerator() <= 0 || price.GetDenominator() <= 0) {
+		return ErrInvalidBasePrice
+	}
+	if req.GetQuantity() < 0 || req.GetQuantity() > domain.MaxPricedQuantity {
+		return ErrInvalidQuantity
+	}
+	if req.GetCouponPercent() < 0 || req.GetCouponPercent() > 100 {
+		return ErrInvalidCouponPercent
+	}
+	if req.GetTargetMarginPercent() < 0 || req.GetTargetMarginPercent() >= 100 {
+		return ErrInvalidTargetMargin
+	}
+	return nil
+}
+
+// validateSubscriptionRequest validates the fields shared by the subscribe and
+// unsubscribe requests.
+func validateSubscriptionRequest(productID, subscriberID, kind string) error {
+	if productID == "" {
+		return ErrProductIDRequired
+	}
+	if subscriberID == "" {
+		return ErrSubscriberIDRequired
+	}
+	if len([]rune(subscriberID)) > maxSubscriberIDLength {
+		return ErrSubscriberIDTooLong
+	}
+	if kind == "" {
+		return ErrKindRequired
+	}
+	return nil
+}
@@ -1,9 +1,13 @@
 package handler
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -175,6 +179,316 @@ func TestValidateUpdateRequest(t *testing.T) {
 	}
 }
 
+func TestValidateChangeBasePriceRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *pb.ChangeBasePriceRequest
+		wantErr error
+	}{
+		{
+			name: "valid request",
+			req: &pb.ChangeBasePriceRequest{
+				ProductId: "product-123",
+				BasePrice: &pb.Money{Numerator: 2499, Denominator: 100},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "empty product ID",
+			req: &pb.ChangeBasePriceRequest{
+				BasePrice: &pb.Money{Numerator: 2499, Denominator: 100},
+			},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name: "nil base price",
+			req: &pb.ChangeBasePriceRequest{
+				ProductId: "product-123",
+			},
+			wantErr: ErrBasePriceRequired,
+		},
+		{
+			name: "zero numerator",
+			req: &pb.ChangeBasePriceRequest{
+				ProductId: "product-123",
+				BasePrice: &pb.Money{Numerator: 0, Denominator: 100},
+			},
+			wantErr: ErrInvalidBasePrice,
+		},
+		{
+			name: "negative denominator",
+			req: &pb.ChangeBasePriceRequest{
+				ProductId: "product-123",
+				BasePrice: &pb.Money{Numerator: 2499, Denominator: -100},
+			},
+			wantErr: ErrInvalidBasePrice,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateChangeBasePriceRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSchedulePriceChangeRequest(t *testing.T) {
+	midnight := timestamppb.New(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name    string
+		req     *pb.SchedulePriceChangeRequest
+		wantErr error
+	}{
+		{
+			name: "valid request",
+			req: &pb.SchedulePriceChangeRequest{
+				ProductId:   "product-123",
+				BasePrice:   &pb.Money{Numerator: 2499, Denominator: 100},
+				EffectiveAt: midnight,
+			},
+		},
+		{
+			name: "empty product ID",
+			req: &pb.SchedulePriceChangeRequest{
+				BasePrice:   &pb.Money{Numerator: 2499, Denominator: 100},
+				EffectiveAt: midnight,
+			},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name: "nil base price",
+			req: &pb.SchedulePriceChangeRequest{
+				ProductId:   "product-123",
+				EffectiveAt: midnight,
+			},
+			wantErr: ErrBasePriceRequired,
+		},
+		{
+			name: "zero numerator",
+			req: &pb.SchedulePriceChangeRequest{
+				ProductId:   "product-123",
+				BasePrice:   &pb.Money{Numerator: 0, Denominator: 100},
+				EffectiveAt: midnight,
+			},
+			wantErr: ErrInvalidBasePrice,
+		},
+		{
+			name: "nil effective time",
+			req: &pb.SchedulePriceChangeRequest{
+				ProductId: "product-123",
+				BasePrice: &pb.Money{Numerator: 2499, Denominator: 100},
+			},
+			wantErr: ErrEffectiveAtRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSchedulePriceChangeRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateApproveDiscountRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *pb.ApproveDiscountRequest
+		wantErr error
+	}{
+		{
+			name: "valid request",
+			req:  &pb.ApproveDiscountRequest{ProductId: "product-123", DiscountId: "discount-1", Approver: "pricing-lead"},
+		},
+		{
+			name:    "empty product ID",
+			req:     &pb.ApproveDiscountRequest{DiscountId: "discount-1", Approver: "pricing-lead"},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "empty discount ID",
+			req:     &pb.ApproveDiscountRequest{ProductId: "product-123", Approver: "pricing-lead"},
+			wantErr: ErrDiscountIDRequired,
+		},
+		{
+			name:    "blank approver",
+			req:     &pb.ApproveDiscountRequest{ProductId: "product-123", DiscountId: "discount-1", Approver: "  "},
+			wantErr: ErrApproverRequired,
+		},
+		{
+			name:    "approver too long",
+			req:     &pb.ApproveDiscountRequest{ProductId: "product-123", DiscountId: "discount-1", Approver: strings.Repeat("a", maxApproverLength+1)},
+			wantErr: ErrApproverTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateApproveDiscountRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRejectProductRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *pb.RejectProductRequest
+		wantErr error
+	}{
+		{
+			name: "valid request",
+			req:  &pb.RejectProductRequest{ProductId: "product-123", Reviewer: "merch-lead", Reason: "Missing images"},
+		},
+		{
+			name:    "empty product ID",
+			req:     &pb.RejectProductRequest{Reviewer: "merch-lead", Reason: "Missing images"},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "blank reviewer",
+			req:     &pb.RejectProductRequest{ProductId: "product-123", Reviewer: "  ", Reason: "Missing images"},
+			wantErr: ErrReviewerRequired,
+		},
+		{
+			name:    "reviewer too long",
+			req:     &pb.RejectProductRequest{ProductId: "product-123", Reviewer: strings.Repeat("a", maxReviewerLength+1), Reason: "Missing images"},
+			wantErr: ErrReviewerTooLong,
+		},
+		{
+			name:    "blank reason",
+			req:     &pb.RejectProductRequest{ProductId: "product-123", Reviewer: "merch-lead"},
+			wantErr: ErrReasonRequired,
+		},
+		{
+			name:    "reason too long",
+			req:     &pb.RejectProductRequest{ProductId: "product-123", Reviewer: "merch-lead", Reason: strings.Repeat("a", maxRejectionReasonLength+1)},
+			wantErr: ErrReasonTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRejectProductRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateGetEffectivePricesRequest(t *testing.T) {
+	tooMany := make([]string, query.MaxEffectivePriceIDs+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("product-%d", i)
+	}
+
+	tests := []struct {
+		name    string
+		req     *pb.GetEffectivePricesRequest
+		wantErr error
+	}{
+		{
+			name:    "valid request",
+			req:     &pb.GetEffectivePricesRequest{ProductIds: []string{"product-1", "product-2"}},
+			wantErr: nil,
+		},
+		{
+			name:    "no product IDs",
+			req:     &pb.GetEffectivePricesRequest{},
+			wantErr: ErrProductIDsRequired,
+		},
+		{
+			name:    "empty product ID",
+			req:     &pb.GetEffectivePricesRequest{ProductIds: []string{"product-1", ""}},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "too many product IDs",
+			req:     &pb.GetEffectivePricesRequest{ProductIds: tooMany},
+			wantErr: ErrTooManyProductIDs,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGetEffectivePricesRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBatchGetProductsRequest(t *testing.T) {
+	tooMany := make([]string, query.MaxBatchGetProductIDs+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("product-%d", i)
+	}
+
+	tests := []struct {
+		name    string
+		req     *pb.BatchGetProductsRequest
+		wantErr error
+	}{
+		{
+			name:    "valid request",
+			req:     &pb.BatchGetProductsRequest{ProductIds: []string{"product-1", "product-2"}},
+			wantErr: nil,
+		},
+		{
+			name:    "no product IDs",
+			req:     &pb.BatchGetProductsRequest{},
+			wantErr: ErrProductIDsRequired,
+		},
+		{
+			name:    "empty product ID",
+			req:     &pb.BatchGetProductsRequest{ProductIds: []string{"product-1", ""}},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "too many product IDs",
+			req:     &pb.BatchGetProductsRequest{ProductIds: tooMany},
+			wantErr: ErrTooManyProductIDs,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBatchGetProductsRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateApplyDiscountRequest(t *testing.T) {
 	now := time.Now()
 	future := now.Add(24 * time.Hour)
@@ -295,6 +609,48 @@ func TestValidateApplyDiscountRequest(t *testing.T) {
 			},
 			wantErr: ErrEndDateBeforeStartDate,
 		},
+		{
+			name: "valid sale price",
+			req: &pb.ApplyDiscountRequest{
+				ProductId: "product-123",
+				SalePrice: &pb.Money{Numerator: 1999, Denominator: 100},
+				StartDate: timestamppb.New(now),
+				EndDate:   timestamppb.New(future),
+			},
+			wantErr: nil,
+		},
+		{
+			name: "sale price with a percentage",
+			req: &pb.ApplyDiscountRequest{
+				ProductId:          "product-123",
+				DiscountPercentage: 10,
+				SalePrice:          &pb.Money{Numerator: 1999, Denominator: 100},
+				StartDate:          timestamppb.New(now),
+				EndDate:            timestamppb.New(future),
+			},
+			wantErr: ErrSalePricePercentage,
+		},
+		{
+			name: "sale price in a market",
+			req: &pb.ApplyDiscountRequest{
+				ProductId: "product-123",
+				SalePrice: &pb.Money{Numerator: 1999, Denominator: 100},
+				Market:    "EU",
+				StartDate: timestamppb.New(now),
+				EndDate:   timestamppb.New(future),
+			},
+			wantErr: ErrSalePriceCombined,
+		},
+		{
+			name: "negative sale price",
+			req: &pb.ApplyDiscountRequest{
+				ProductId: "product-123",
+				SalePrice: &pb.Money{Numerator: -1, Denominator: 100},
+				StartDate: timestamppb.New(now),
+				EndDate:   timestamppb.New(future),
+			},
+			wantErr: ErrInvalidSalePrice,
+		},
 	}
 
 	for _, tt := range tests {
@@ -309,3 +665,838 @@ func TestValidateApplyDiscountRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSetPriceTiersRequest(t *testing.T) {
+	unitPrice := func(minQuantity, num, denom int64) *pb.PriceTier {
+		return &pb.PriceTier{
+			MinQuantity: minQuantity,
+			Price:       &pb.PriceTier_UnitPrice{UnitPrice: &pb.Money{Numerator: num, Denominator: denom}},
+		}
+	}
+	percentOff := func(minQuantity int64, pct float64) *pb.PriceTier {
+		return &pb.PriceTier{MinQuantity: minQuantity, Price: &pb.PriceTier_PercentOff{PercentOff: pct}}
+	}
+
+	tooMany := make([]*pb.PriceTier, domain.MaxPriceTiersPerProduct+1)
+	for i := range tooMany {
+		tooMany[i] = percentOff(int64(i+2), 5)
+	}
+
+	tests := []struct {
+		name    string
+		req     *pb.SetPriceTiersRequest
+		wantErr error
+	}{
+		{
+			name: "valid request",
+			req: &pb.SetPriceTiersRequest{
+				ProductId: "product-123",
+				Tiers:     []*pb.PriceTier{unitPrice(10, 1800, 100), percentOff(100, 25)},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "no tiers",
+			req:     &pb.SetPriceTiersRequest{ProductId: "product-123"},
+			wantErr: nil,
+		},
+		{
+			name:    "missing product ID",
+			req:     &pb.SetPriceTiersRequest{Tiers: []*pb.PriceTier{percentOff(10, 5)}},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "too many tiers",
+			req:     &pb.SetPriceTiersRequest{ProductId: "product-123", Tiers: tooMany},
+			wantErr: ErrTooManyPriceTiers,
+		},
+		{
+			name:    "min quantity of one",
+			req:     &pb.SetPriceTiersRequest{ProductId: "product-123", Tiers: []*pb.PriceTier{percentOff(1, 5)}},
+			wantErr: ErrInvalidMinQuantity,
+		},
+		{
+			name:    "no price",
+			req:     &pb.SetPriceTiersRequest{ProductId: "product-123", Tiers: []*pb.PriceTier{{MinQuantity: 10}}},
+			wantErr: ErrTierPriceRequired,
+		},
+		{
+			name:    "zero unit price",
+			req:     &pb.SetPriceTiersRequest{ProductId: "product-123", Tiers: []*pb.PriceTier{unitPrice(10, 0, 100)}},
+			wantErr: ErrInvalidUnitPrice,
+		},
+		{
+			name:    "percent off over 100",
+			req:     &pb.SetPriceTiersRequest{ProductId: "product-123", Tiers: []*pb.PriceTier{percentOff(10, 101)}},
+			wantErr: ErrInvalidPercentOff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSetPriceTiersRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateGetPriceForQuantityRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *pb.GetPriceForQuantityRequest
+		wantErr error
+	}{
+		{
+			name:    "valid request",
+			req:     &pb.GetPriceForQuantityRequest{ProductId: "product-123", Quantity: 25},
+			wantErr: nil,
+		},
+		{
+			name:    "missing product ID",
+			req:     &pb.GetPriceForQuantityRequest{Quantity: 25},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "zero quantity",
+			req:     &pb.GetPriceForQuantityRequest{ProductId: "product-123"},
+			wantErr: ErrInvalidQuantity,
+		},
+		{
+			name:    "quantity too large",
+			req:     &pb.GetPriceForQuantityRequest{ProductId: "product-123", Quantity: domain.MaxPricedQuantity + 1},
+			wantErr: ErrInvalidQuantity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGetPriceForQuantityRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCalculatePriceRequest(t *testing.T) {
+	price := &pb.Money{Numerator: 1999, Denominator: 100}
+	tests := []struct {
+		name    string
+		req     *pb.CalculatePriceRequest
+		wantErr error
+	}{
+		{
+			name:    "product",
+			req:     &pb.CalculatePriceRequest{ProductId: "product-123", Quantity: 25, CouponPercent: 10},
+			wantErr: nil,
+		},
+		{
+			name:    "inline base price",
+			req:     &pb.CalculatePriceRequest{BasePrice: price},
+			wantErr: nil,
+		},
+		{
+			name:    "neither product nor base price",
+			req:     &pb.CalculatePriceRequest{Quantity: 2},
+			wantErr: ErrPriceSubjectRequired,
+		},
+		{
+			name:    "both product and base price",
+			req:     &pb.CalculatePriceRequest{ProductId: "product-123", BasePrice: price},
+			wantErr: ErrPriceSubjectRequired,
+		},
+		{
+			name:    "zero base price",
+			req:     &pb.CalculatePriceRequest{BasePrice: &pb.Money{Denominator: 100}},
+			wantErr: ErrInvalidBasePrice,
+		},
+		{
+			name:    "quantity too large",
+			req:     &pb.CalculatePriceRequest{ProductId: "product-123", Quantity: domain.MaxPricedQuantity + 1},
+			wantErr: ErrInvalidQuantity,
+		},
+		{
+			name:    "negative coupon",
+			req:     &pb.CalculatePriceRequest{ProductId: "product-123", CouponPercent: -5},
+			wantErr: ErrInvalidCouponPercent,
+		},
+		{
+			name:    "coupon above 100",
+			req:     &pb.CalculatePriceRequest{ProductId: "product-123", CouponPercent: 101},
+			wantErr: ErrInvalidCouponPercent,
+		},
+		{
+			name:    "charm price for a target margin",
+			req:     &pb.CalculatePriceRequest{ProductId: "product-123", TargetMarginPercent: 40},
+			wantErr: nil,
+		},
+		{
+			name:    "target margin of 100",
+			req:     &pb.CalculatePriceRequest{ProductId: "product-123", TargetMarginPercent: 100},
+			wantErr: ErrInvalidTargetMargin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCalculatePriceRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetTaxClassRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *pb.SetTaxClassRequest
+		wantErr error
+	}{
+		{
+			name:    "valid request",
+			req:     &pb.SetTaxClassRequest{ProductId: "product-123", TaxClass: "reduced"},
+			wantErr: nil,
+		},
+		{
+			name:    "missing product ID",
+			req:     &pb.SetTaxClassRequest{TaxClass: "reduced"},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "missing tax class",
+			req:     &pb.SetTaxClassRequest{ProductId: "product-123"},
+			wantErr: ErrTaxClassRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSetTaxClassRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetMinimumPriceRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *pb.SetMinimumPriceRequest
+		wantErr error
+	}{
+		{
+			name:    "valid request",
+			req:     &pb.SetMinimumPriceRequest{ProductId: "product-123", MinimumPrice: &pb.Money{Numerator: 999, Denominator: 100}},
+			wantErr: nil,
+		},
+		{
+			name:    "remove minimum price",
+			req:     &pb.SetMinimumPriceRequest{ProductId: "product-123"},
+			wantErr: nil,
+		},
+		{
+			name:    "missing product ID",
+			req:     &pb.SetMinimumPriceRequest{MinimumPrice: &pb.Money{Numerator: 999, Denominator: 100}},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "zero minimum price",
+			req:     &pb.SetMinimumPriceRequest{ProductId: "product-123", MinimumPrice: &pb.Money{Denominator: 100}},
+			wantErr: ErrInvalidPrice,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSetMinimumPriceRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetCostPriceRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *pb.SetCostPriceRequest
+		wantErr error
+	}{
+		{
+			name:    "valid request",
+			req:     &pb.SetCostPriceRequest{ProductId: "product-123", CostPrice: &pb.Money{Numerator: 1200, Denominator: 100}},
+			wantErr: nil,
+		},
+		{
+			name:    "remove cost price",
+			req:     &pb.SetCostPriceRequest{ProductId: "product-123"},
+			wantErr: nil,
+		},
+		{
+			name:    "missing product ID",
+			req:     &pb.SetCostPriceRequest{CostPrice: &pb.Money{Numerator: 1200, Denominator: 100}},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "negative cost price",
+			req:     &pb.SetCostPriceRequest{ProductId: "product-123", CostPrice: &pb.Money{Numerator: -1200, Denominator: 100}},
+			wantErr: ErrInvalidPrice,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSetCostPriceRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAddVariantRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *pb.AddVariantRequest
+		wantErr error
+	}{
+		{
+			name: "valid request",
+			req: &pb.AddVariantRequest{ProductId: "product-123", Sku: "SHIRT-M", Attributes: map[string]string{"size": "M"},
+				PriceDelta: &pb.Money{Numerator: -250, Denominator: 100}},
+			wantErr: nil,
+		},
+		{
+			name:    "no price delta",
+			req:     &pb.AddVariantRequest{ProductId: "product-123", Sku: "SHIRT-M"},
+			wantErr: nil,
+		},
+		{
+			name:    "missing product ID",
+			req:     &pb.AddVariantRequest{Sku: "SHIRT-M"},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "missing SKU",
+			req:     &pb.AddVariantRequest{ProductId: "product-123", Sku: " "},
+			wantErr: ErrSKURequired,
+		},
+		{
+			name: "too many attributes",
+			req: &pb.AddVariantRequest{ProductId: "product-123", Sku: "SHIRT-M", Attributes: map[string]string{
+				"a": "1", "b": "2", "c": "3", "d": "4", "e": "5", "f": "6", "g": "7", "h": "8", "i": "9", "j": "10", "k": "11",
+			}},
+			wantErr: ErrTooManyAttributes,
+		},
+		{
+			name:    "price delta without denominator",
+			req:     &pb.AddVariantRequest{ProductId: "product-123", Sku: "SHIRT-M", PriceDelta: &pb.Money{Numerator: 250}},
+			wantErr: ErrInvalidPriceDelta,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAddVariantRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateVariantRequest(t *testing.T) {
+	assert.NoError(t, validateUpdateVariantRequest(&pb.UpdateVariantRequest{ProductId: "product-123", VariantId: "variant-1", Sku: "SHIRT-L"}))
+	assert.Equal(t, ErrVariantIDRequired, validateUpdateVariantRequest(&pb.UpdateVariantRequest{ProductId: "product-123", Sku: "SHIRT-L"}))
+	assert.Equal(t, ErrSKURequired, validateUpdateVariantRequest(&pb.UpdateVariantRequest{ProductId: "product-123", VariantId: "variant-1"}))
+	assert.NoError(t, validateDiscontinueVariantRequest(&pb.DiscontinueVariantRequest{ProductId: "product-123", VariantId: "variant-1"}))
+	assert.Equal(t, ErrVariantIDRequired, validateDiscontinueVariantRequest(&pb.DiscontinueVariantRequest{ProductId: "product-123"}))
+}
+
+func TestValidateSetStockRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *pb.SetStockRequest
+		wantErr error
+	}{
+		{"valid request", &pb.SetStockRequest{ProductId: "product-123", Level: 10, Reserved: 2, ExpectedVersion: 4}, nil},
+		{"out of stock", &pb.SetStockRequest{ProductId: "product-123"}, nil},
+		{"missing product ID", &pb.SetStockRequest{Level: 10}, ErrProductIDRequired},
+		{"negative level", &pb.SetStockRequest{ProductId: "product-123", Level: -1}, ErrInvalidStockLevel},
+		{"too many", &pb.SetStockRequest{ProductId: "product-123", Level: domain.MaxStockLevel + 1}, ErrInvalidStockLevel},
+		{"more reserved than on hand", &pb.SetStockRequest{ProductId: "product-123", Level: 1, Reserved: 2}, ErrInvalidReserved},
+		{"negative expected version", &pb.SetStockRequest{ProductId: "product-123", ExpectedVersion: -1}, ErrInvalidExpectedVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, validateSetStockRequest(tt.req))
+		})
+	}
+}
+
+func TestValidateAdjustStockRequest(t *testing.T) {
+	assert.NoError(t, validateAdjustStockRequest(&pb.AdjustStockRequest{ProductId: "product-123", LevelDelta: -2, ReservedDelta: -2}))
+	assert.NoError(t, validateAdjustStockRequest(&pb.AdjustStockRequest{ProductId: "product-123", ReservedDelta: 1}))
+	assert.Equal(t, ErrProductIDRequired, validateAdjustStockRequest(&pb.AdjustStockRequest{LevelDelta: 1}))
+	assert.Equal(t, ErrStockDeltaRequired, validateAdjustStockRequest(&pb.AdjustStockRequest{ProductId: "product-123"}))
+	assert.Equal(t, ErrInvalidStockDelta, validateAdjustStockRequest(&pb.AdjustStockRequest{ProductId: "product-123", LevelDelta: -domain.MaxStockLevel - 1}))
+	assert.Equal(t, ErrInvalidExpectedVersion, validateAdjustStockRequest(&pb.AdjustStockRequest{ProductId: "product-123", LevelDelta: 1, ExpectedVersion: -1}))
+}
+
+func TestValidateTagRequest(t *testing.T) {
+	assert.NoError(t, validateTagRequest("product-123", "sale"))
+	assert.Equal(t, ErrProductIDRequired, validateTagRequest("", "sale"))
+	assert.Equal(t, ErrTagRequired, validateTagRequest("product-123", " "))
+}
+
+func TestValidateSetAttributeRequest(t *testing.T) {
+	assert.NoError(t, validateSetAttributeRequest(&pb.SetAttributeRequest{ProductId: "product-123", Name: "material", Value: "oak"}))
+	assert.NoError(t, validateSetAttributeRequest(&pb.SetAttributeRequest{ProductId: "product-123", Name: "material"}))
+	assert.Equal(t, ErrProductIDRequired, validateSetAttributeRequest(&pb.SetAttributeRequest{Name: "material"}))
+	assert.Equal(t, ErrAttributeNameRequired, validateSetAttributeRequest(&pb.SetAttributeRequest{ProductId: "product-123"}))
+}
+
+func TestValidateImageRequests(t *testing.T) {
+	front := &pb.ProductImage{Url: "https://cdn.example.com/front.jpg", AltText: "Front view"}
+	assert.NoError(t, validateSetImagesRequest(&pb.SetImagesRequest{ProductId: "product-123", Images: []*pb.ProductImage{front}}))
+	assert.NoError(t, validateSetImagesRequest(&pb.SetImagesRequest{ProductId: "product-123"}))
+	assert.Equal(t, ErrProductIDRequired, validateSetImagesRequest(&pb.SetImagesRequest{Images: []*pb.ProductImage{front}}))
+	assert.Equal(t, ErrImageURLRequired, validateSetImagesRequest(&pb.SetImagesRequest{ProductId: "product-123",
+		Images: []*pb.ProductImage{front, {AltText: "Back view"}}}))
+
+	assert.NoError(t, validateReorderImagesRequest(&pb.ReorderImagesRequest{ProductId: "product-123", Urls: []string{front.Url}}))
+	assert.Equal(t, ErrProductIDRequired, validateReorderImagesRequest(&pb.ReorderImagesRequest{Urls: []string{front.Url}}))
+	assert.Equal(t, ErrImageURLRequired, validateReorderImagesRequest(&pb.ReorderImagesRequest{ProductId: "product-123", Urls: []string{" "}}))
+}
+
+func TestValidateManageAttachmentsRequest(t *testing.T) {
+	manual := &pb.ProductAttachment{Type: "manual", Url: "https://docs.example.com/manual.pdf", Locale: "de"}
+	assert.NoError(t, validateManageAttachmentsRequest(&pb.ManageAttachmentsRequest{ProductId: "product-123", Attachments: []*pb.ProductAttachment{manual}}))
+	assert.NoError(t, validateManageAttachmentsRequest(&pb.ManageAttachmentsRequest{ProductId: "product-123"}))
+	assert.Equal(t, ErrProductIDRequired, validateManageAttachmentsRequest(&pb.ManageAttachmentsRequest{Attachments: []*pb.ProductAttachment{manual}}))
+	assert.Equal(t, ErrAttachmentRequired, validateManageAttachmentsRequest(&pb.ManageAttachmentsRequest{ProductId: "product-123",
+		Attachments: []*pb.ProductAttachment{manual, {Url: "https://docs.example.com/sheet.pdf"}}}))
+	assert.Equal(t, ErrAttachmentRequired, validateManageAttachmentsRequest(&pb.ManageAttachmentsRequest{ProductId: "product-123",
+		Attachments: []*pb.ProductAttachment{{Type: "manual", Url: " "}}}))
+}
+
+func TestValidateSetTranslationRequest(t *testing.T) {
+	assert.NoError(t, validateSetTranslationRequest(&pb.SetTranslationRequest{ProductId: "product-123", Locale: "de", Name: "Stuhl"}))
+	assert.NoError(t, validateSetTranslationRequest(&pb.SetTranslationRequest{ProductId: "product-123", Locale: "de"}))
+	assert.Equal(t, ErrProductIDRequired, validateSetTranslationRequest(&pb.SetTranslationRequest{Locale: "de", Name: "Stuhl"}))
+	assert.Equal(t, ErrLocaleRequired, validateSetTranslationRequest(&pb.SetTranslationRequest{ProductId: "product-123", Locale: " ", Name: "Stuhl"}))
+}
+
+func TestValidateSetSlugRequest(t *testing.T) {
+	assert.NoError(t, validateSetSlugRequest(&pb.SetSlugRequest{ProductId: "product-123", Slug: "blue-widget"}))
+	assert.Equal(t, ErrProductIDRequired, validateSetSlugRequest(&pb.SetSlugRequest{Slug: "blue-widget"}))
+	assert.Equal(t, ErrSlugRequired, validateSetSlugRequest(&pb.SetSlugRequest{ProductId: "product-123", Slug: " "}))
+}
+
+func TestValidateProductLinkRequest(t *testing.T) {
+	assert.NoError(t, validateProductLinkRequest("product-123", "product-456", "accessory"))
+	assert.Equal(t, ErrProductIDRequired, validateProductLinkRequest("", "product-456", "accessory"))
+	assert.Equal(t, ErrRelatedProductRequired, validateProductLinkRequest("product-123", "", "accessory"))
+	assert.Equal(t, ErrRelationTypeRequired, validateProductLinkRequest("product-123", "product-456", ""))
+}
+
+func TestValidateSubscriptionRequest(t *testing.T) {
+	tests := []struct {
+		name         string
+		productID    string
+		subscriberID string
+		kind         string
+		wantErr      error
+	}{
+		{
+			name:         "valid request",
+			productID:    "product-1",
+			subscriberID: "customer-1",
+			kind:         "discounted",
+			wantErr:      nil,
+		},
+		{
+			name:         "missing product ID",
+			subscriberID: "customer-1",
+			kind:         "discounted",
+			wantErr:      ErrProductIDRequired,
+		},
+		{
+			name:      "missing subscriber ID",
+			productID: "product-1",
+			kind:      "discounted",
+			wantErr:   ErrSubscriberIDRequired,
+		},
+		{
+			name:         "subscriber ID too long",
+			productID:    "product-1",
+			subscriberID: strings.Repeat("c", maxSubscriberIDLength+1),
+			kind:         "discounted",
+			wantErr:      ErrSubscriberIDTooLong,
+		},
+		{
+			name:         "missing kind",
+			productID:    "product-1",
+			subscriberID: "customer-1",
+			wantErr:      ErrKindRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSubscriptionRequest(tt.productID, tt.subscriberID, tt.kind)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetPriceBookRequest(t *testing.T) {
+	eur := &pb.Money{Numerator: 1849, Denominator: 100, Currency: "EUR"}
+	tooMany := make([]*pb.Money, domain.MaxPriceBookEntries+1)
+	for i := range tooMany {
+		tooMany[i] = eur
+	}
+
+	tests := []struct {
+		name    string
+		req     *pb.SetPriceBookRequest
+		wantErr error
+	}{
+		{
+			name: "valid request",
+			req:  &pb.SetPriceBookRequest{ProductId: "product-123", Prices: []*pb.Money{eur}},
+		},
+		{
+			name: "empty price book",
+			req:  &pb.SetPriceBookRequest{ProductId: "product-123"},
+		},
+		{
+			name:    "missing product ID",
+			req:     &pb.SetPriceBookRequest{Prices: []*pb.Money{eur}},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "too many prices",
+			req:     &pb.SetPriceBookRequest{ProductId: "product-123", Prices: tooMany},
+			wantErr: ErrTooManyPrices,
+		},
+		{
+			name:    "zero price",
+			req:     &pb.SetPriceBookRequest{ProductId: "product-123", Prices: []*pb.Money{{Numerator: 0, Denominator: 100, Currency: "EUR"}}},
+			wantErr: ErrInvalidPrice,
+		},
+		{
+			name:    "missing currency",
+			req:     &pb.SetPriceBookRequest{ProductId: "product-123", Prices: []*pb.Money{{Numerator: 1849, Denominator: 100}}},
+			wantErr: ErrPriceCurrencyRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSetPriceBookRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetSegmentPricesRequest(t *testing.T) {
+	wholesale := &pb.SegmentPrice{Segment: "wholesale", Price: &pb.SegmentPrice_PercentOff{PercentOff: 15}}
+	employee := &pb.SegmentPrice{Segment: "employee", Price: &pb.SegmentPrice_FixedPrice{FixedPrice: &pb.Money{Numerator: 1499, Denominator: 100}}}
+	tooMany := make([]*pb.SegmentPrice, domain.MaxSegmentPrices+1)
+	for i := range tooMany {
+		tooMany[i] = wholesale
+	}
+
+	tests := []struct {
+		name    string
+		req     *pb.SetSegmentPricesRequest
+		wantErr error
+	}{
+		{
+			name: "valid request",
+			req:  &pb.SetSegmentPricesRequest{ProductId: "product-123", Prices: []*pb.SegmentPrice{wholesale, employee}},
+		},
+		{
+			name: "empty segment prices",
+			req:  &pb.SetSegmentPricesRequest{ProductId: "product-123"},
+		},
+		{
+			name:    "missing product ID",
+			req:     &pb.SetSegmentPricesRequest{Prices: []*pb.SegmentPrice{wholesale}},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "too many prices",
+			req:     &pb.SetSegmentPricesRequest{ProductId: "product-123", Prices: tooMany},
+			wantErr: ErrTooManySegmentPrices,
+		},
+		{
+			name:    "missing segment",
+			req:     &pb.SetSegmentPricesRequest{ProductId: "product-123", Prices: []*pb.SegmentPrice{{Price: wholesale.Price}}},
+			wantErr: ErrSegmentRequired,
+		},
+		{
+			name:    "missing price",
+			req:     &pb.SetSegmentPricesRequest{ProductId: "product-123", Prices: []*pb.SegmentPrice{{Segment: "wholesale"}}},
+			wantErr: ErrSegmentPriceRequired,
+		},
+		{
+			name: "zero fixed price",
+			req: &pb.SetSegmentPricesRequest{ProductId: "product-123", Prices: []*pb.SegmentPrice{
+				{Segment: "employee", Price: &pb.SegmentPrice_FixedPrice{FixedPrice: &pb.Money{Numerator: 0, Denominator: 100}}},
+			}},
+			wantErr: ErrInvalidFixedPrice,
+		},
+		{
+			name: "percent off of 100",
+			req: &pb.SetSegmentPricesRequest{ProductId: "product-123", Prices: []*pb.SegmentPrice{
+				{Segment: "staff", Price: &pb.SegmentPrice_PercentOff{PercentOff: 100}},
+			}},
+			wantErr: ErrInvalidPercentOff,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSetSegmentPricesRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetMarketPricesRequest(t *testing.T) {
+	uk := &pb.MarketPrice{Market: "UK", Price: &pb.Money{Numerator: 1599, Denominator: 100, Currency: "GBP"}}
+
+	tests := []struct {
+		name    string
+		req     *pb.SetMarketPricesRequest
+		wantErr error
+	}{
+		{
+			name: "valid request",
+			req:  &pb.SetMarketPricesRequest{ProductId: "product-123", Prices: []*pb.MarketPrice{uk}},
+		},
+		{
+			name: "empty market prices",
+			req:  &pb.SetMarketPricesRequest{ProductId: "product-123"},
+		},
+		{
+			name:    "missing product ID",
+			req:     &pb.SetMarketPricesRequest{Prices: []*pb.MarketPrice{uk}},
+			wantErr: ErrProductIDRequired,
+		},
+		{
+			name:    "missing market",
+			req:     &pb.SetMarketPricesRequest{ProductId: "product-123", Prices: []*pb.MarketPrice{{Price: uk.Price}}},
+			wantErr: ErrMarketRequired,
+		},
+		{
+			name:    "missing price",
+			req:     &pb.SetMarketPricesRequest{ProductId: "product-123", Prices: []*pb.MarketPrice{{Market: "UK"}}},
+			wantErr: ErrMarketPriceRequired,
+		},
+		{
+			name: "zero price",
+			req: &pb.SetMarketPricesRequest{ProductId: "product-123", Prices: []*pb.MarketPrice{
+				{Market: "EU", Price: &pb.Money{Numerator: 0, Denominator: 100}},
+			}},
+			wantErr: ErrInvalidPrice,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSetMarketPricesRequest(tt.req)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCreateCampaignRequest(t *testing.T) {
+	now := time.Now()
+	valid := func(modify func(*pb.CreateCampaignRequest)) *pb.CreateCampaignRequest {
+		req := &pb.CreateCampaignRequest{
+			Name:               "Black Friday",
+			Category:           "electronics",
+			DiscountPercentage: 20,
+			StartDate:          timestamppb.New(now),
+			EndDate:            timestamppb.New(now.Add(72 * time.Hour)),
+		}
+		modify(req)
+		return req
+	}
+
+	tests := []struct {
+		name    string
+		req     *pb.CreateCampaignRequest
+		wantErr error
+	}{
+		{"category", valid(func(*pb.CreateCampaignRequest) {}), nil},
+		{"products", valid(func(r *pb.CreateCampaignRequest) { r.Category, r.ProductIds = "", []string{"p1"} }), nil},
+		{"missing name", valid(func(r *pb.CreateCampaignRequest) { r.Name = "" }), ErrNameRequired},
+		{"no target", valid(func(r *pb.CreateCampaignRequest) { r.Category = "" }), ErrCampaignTargetRequired},
+		{"both targets", valid(func(r *pb.CreateCampaignRequest) { r.ProductIds = []string{"p1"} }), ErrCampaignTargetRequired},
+		{"too many products", valid(func(r *pb.CreateCampaignRequest) {
+			r.Category, r.ProductIds = "", make([]string, domain.MaxCampaignProducts+1)
+		}), ErrTooManyCampaignIDs},
+		{"invalid discount", valid(func(r *pb.CreateCampaignRequest) { r.DiscountPercentage = 120 }), ErrInvalidDiscount},
+		{"invalid priority", valid(func(r *pb.CreateCampaignRequest) { r.Priority = -1 }), ErrInvalidPriority},
+		{"missing start date", valid(func(r *pb.CreateCampaignRequest) { r.StartDate = nil }), ErrStartDateRequired},
+		{"end before start", valid(func(r *pb.CreateCampaignRequest) { r.EndDate = timestamppb.New(now) }), ErrEndDateBeforeStartDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, validateCreateCampaignRequest(tt.req))
+		})
+	}
+}
+
+func TestValidateApplyDiscountToCategoryRequest(t *testing.T) {
+	now := time.Now()
+	valid := func(modify func(*pb.ApplyDiscountToCategoryRequest)) *pb.ApplyDiscountToCategoryRequest {
+		req := &pb.ApplyDiscountToCategoryRequest{
+			Category:           "electronics",
+			DiscountPercentage: 15,
+			StartDate:          timestamppb.New(now),
+			EndDate:            timestamppb.New(now.Add(72 * time.Hour)),
+		}
+		modify(req)
+		return req
+	}
+
+	tests := []struct {
+		name    string
+		req     *pb.ApplyDiscountToCategoryRequest
+		wantErr error
+	}{
+		{"valid", valid(func(*pb.ApplyDiscountToCategoryRequest) {}), nil},
+		{"missing category", valid(func(r *pb.ApplyDiscountToCategoryRequest) { r.Category = "" }), ErrCategoryRequired},
+		{"invalid discount", valid(func(r *pb.ApplyDiscountToCategoryRequest) { r.DiscountPercentage = 0 }), ErrInvalidDiscount},
+		{"invalid priority", valid(func(r *pb.ApplyDiscountToCategoryRequest) { r.Priority = 101 }), ErrInvalidPriority},
+		{"missing end date", valid(func(r *pb.ApplyDiscountToCategoryRequest) { r.EndDate = nil }), ErrEndDateRequired},
+		{"end before start", valid(func(r *pb.ApplyDiscountToCategoryRequest) { r.EndDate = timestamppb.New(now) }), ErrEndDateBeforeStartDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, validateApplyDiscountToCategoryRequest(tt.req))
+		})
+	}
+}
+
+func TestValidateCreatePriceListRequest(t *testing.T) {
+	now := time.Now()
+	valid := func(modify func(*pb.CreatePriceListRequest)) *pb.CreatePriceListRequest {
+		req := &pb.CreatePriceListRequest{
+			Name:       "Acme 2025",
+			Currency:   "EUR",
+			ValidFrom:  timestamppb.New(now),
+			ValidUntil: timestamppb.New(now.AddDate(1, 0, 0)),
+		}
+		modify(req)
+		return req
+	}
+
+	tests := []struct {
+		name    string
+		req     *pb.CreatePriceListRequest
+		wantErr error
+	}{
+		{"bounded", valid(func(*pb.CreatePriceListRequest) {}), nil},
+		{"open-ended", valid(func(r *pb.CreatePriceListRequest) { r.ValidUntil = nil }), nil},
+		{"missing name", valid(func(r *pb.CreatePriceListRequest) { r.Name = "" }), ErrNameRequired},
+		{"missing currency", valid(func(r *pb.CreatePriceListRequest) { r.Currency = "" }), ErrCurrencyRequired},
+		{"missing valid from", valid(func(r *pb.CreatePriceListRequest) { r.ValidFrom = nil }), ErrValidFromRequired},
+		{"ends when it starts", valid(func(r *pb.CreatePriceListRequest) { r.ValidUntil = r.ValidFrom }), ErrValidUntilBeforeFrom},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, validateCreatePriceListRequest(tt.req))
+		})
+	}
+}
+
+func TestValidateSetPriceListEntriesRequest(t *testing.T) {
+	entry := &pb.PriceListEntry{ProductId: "product-123", Price: &pb.Money{Numerator: 1499, Denominator: 100}}
+
+	tests := []struct {
+		name    string
+		req     *pb.SetPriceListEntriesRequest
+		wantErr error
+	}{
+		{"valid request", &pb.SetPriceListEntriesRequest{PriceListId: "pl-1", Entries: []*pb.PriceListEntry{entry}}, nil},
+		{"empty entries", &pb.SetPriceListEntriesRequest{PriceListId: "pl-1"}, nil},
+		{"missing price list ID", &pb.SetPriceListEntriesRequest{Entries: []*pb.PriceListEntry{entry}}, ErrPriceListIDRequired},
+		{"too many entries", &pb.SetPriceListEntriesRequest{
+			PriceListId: "pl-1",
+			Entries:     make([]*pb.PriceListEntry, domain.MaxPriceListEntries+1),
+		}, ErrTooManyPriceListItems},
+		{"missing product ID", &pb.SetPriceListEntriesRequest{
+			PriceListId: "pl-1",
+			Entries:     []*pb.PriceListEntry{{Price: entry.Price}},
+		}, ErrEntryProductIDRequired},
+		{"missing price", &pb.SetPriceListEntriesRequest{
+			PriceListId: "pl-1",
+			Entries:     []*pb.PriceListEntry{{ProductId: "product-123"}},
+		}, ErrEntryPriceRequired},
+		{"zero price", &pb.SetPriceListEntriesRequest{
+			PriceListId: "pl-1",
+			Entries:     []*pb.PriceListEntry{{ProductId: "product-123", Price: &pb.Money{Denominator: 100}}},
+		}, ErrInvalidPrice},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, validateSetPriceListEntriesRequest(tt.req))
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Package idgen provides an ID generation abstraction for deterministic testing.
+package idgen
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Generator is an interface for generating new entity IDs.
+// This abstraction allows tests to predict the IDs of the entities they create.
+type Generator interface {
+	NewID() string
+}
+
+// UUIDGenerator implements Generator with random (version 4) UUIDs.
+type UUIDGenerator struct{}
+
+// NewUUIDGenerator creates a new UUIDGenerator instance.
+func NewUUIDGenerator() *UUIDGenerator {
+	return &UUIDGenerator{}
+}
+
+// NewID returns a random UUID.
+func (g *UUIDGenerator) NewID() string {
+	return uuid.New().String()
+}
+
+// SequenceGenerator implements Generator with sequential IDs for testing. The IDs are
+// UUIDs whose first group is the namespace and whose last group counts from 1, e.g.
+// "601de000-0000-4000-8000-000000000001", so generators with different namespaces never
+// produce the same ID. It is safe for concurrent use.
+type SequenceGenerator struct {
+	mu        sync.Mutex
+	namespace uint32
+	next      uint64
+}
+
+// NewSequenceGenerator creates a new SequenceGenerator for the given namespace.
+func NewSequenceGenerator(namespace uint32) *SequenceGenerator {
+	return &SequenceGenerator{namespace: namespace, next: 1}
+}
+
+// NewID returns the next ID of the sequence.
+func (g *SequenceGenerator) NewID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	id := SequenceID(g.namespace, g.next)
+	g.next++
+	return id
+}
+
+// Reset restarts the sequence at 1.
+func (g *SequenceGenerator) Reset() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.next = 1
+}
+
+// SequenceID returns the n-th ID a SequenceGenerator for namespace produces.
+func SequenceID(namespace uint32, n uint64) string {
+	return fmt.Sprintf("%08x-0000-4000-8000-%012x", namespace, n)
+}
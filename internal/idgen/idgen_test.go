@@ -0,0 +1,76 @@
+package idgen
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUUIDGenerator_NewID(t *testing.T) {
+	t.Parallel()
+
+	g := NewUUIDGenerator()
+	first := g.NewID()
+	second := g.NewID()
+
+	_, err := uuid.Parse(first)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+}
+
+func TestSequenceGenerator_NewID(t *testing.T) {
+	t.Parallel()
+
+	g := NewSequenceGenerator(0x601de000)
+	assert.Equal(t, "601de000-0000-4000-8000-000000000001", g.NewID())
+	assert.Equal(t, "601de000-0000-4000-8000-000000000002", g.NewID())
+	assert.Equal(t, SequenceID(0x601de000, 3), g.NewID())
+
+	g.Reset()
+	assert.Equal(t, "601de000-0000-4000-8000-000000000001", g.NewID())
+
+	other := NewSequenceGenerator(1)
+	assert.Equal(t, "00000001-0000-4000-8000-000000000001", other.NewID())
+
+	id, err := uuid.Parse(other.NewID())
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Version(4), id.Version())
+	assert.Equal(t, uuid.RFC4122, id.Variant())
+}
+
+func TestSequenceGenerator_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	g := NewSequenceGenerator(1)
+	const n = 100
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		seen = make(map[string]bool)
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id := g.NewID()
+			mu.Lock()
+			seen[id] = true
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, seen, n)
+	assert.Equal(t, SequenceID(1, n+1), g.NewID())
+}
+
+func TestGenerator_Interface(t *testing.T) {
+	t.Parallel()
+
+	var _ Generator = NewUUIDGenerator()
+	var _ Generator = NewSequenceGenerator(0)
+}
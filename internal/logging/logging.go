@@ -0,0 +1,217 @@
+// Package logging provides a leveled logger and debug features that can be changed while
+// the service is running, through the admin endpoint or SIGHUP.
+//
+// Messages are written through the standard log package, prefixed with their level.
+// Debug features dump detail that is too verbose or sensitive to log by default; a dump is
+// written whenever its feature is enabled, regardless of the level.
+package logging
+
+import (
+	"errors"
+	"expvar"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Errors returned when parsing settings.
+var (
+	ErrUnknownLevel   = errors.New("unknown log level")
+	ErrUnknownFeature = errors.New("unknown debug feature")
+)
+
+// Level is the minimum severity of messages that are written.
+type Level int32
+
+// Levels, from most to least verbose.
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// String returns the name used in configuration and log lines.
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	default:
+		return "error"
+	}
+}
+
+// ParseLevel parses a level name such as "debug" or "WARN".
+func ParseLevel(s string) (Level, error) {
+	for _, l := range []Level{LevelDebug, LevelInfo, LevelWarn, LevelError} {
+		if strings.EqualFold(s, l.String()) {
+			return l, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", ErrUnknownLevel, s)
+}
+
+// Feature is a debug feature that can be toggled at runtime.
+type Feature string
+
+// Debug features.
+const (
+	// FeatureSQL logs the SQL statements and parameters of read queries.
+	FeatureSQL Feature = "sql"
+	// FeaturePayloads logs the serialized payload of every outbox event written.
+	FeaturePayloads Feature = "payloads"
+)
+
+// Features returns all debug features, sorted.
+func Features() []Feature {
+	return []Feature{FeaturePayloads, FeatureSQL}
+}
+
+// ParseFeatures parses a comma-separated list of feature names such as "sql,payloads".
+func ParseFeatures(s string) ([]Feature, error) {
+	var features []Feature
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		f := Feature(strings.ToLower(name))
+		if !f.valid() {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownFeature, name)
+		}
+		features = append(features, f)
+	}
+	return features, nil
+}
+
+func (f Feature) valid() bool {
+	for _, known := range Features() {
+		if f == known {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	level    atomic.Int32
+	mu       sync.RWMutex
+	features = make(map[Feature]bool)
+)
+
+func init() {
+	level.Store(int32(LevelInfo))
+	expvar.Publish("logging", expvar.Func(func() interface{} { return Snapshot() }))
+}
+
+// SetLevel changes the minimum level of messages that are written.
+func SetLevel(l Level) {
+	level.Store(int32(l))
+}
+
+// CurrentLevel returns the minimum level of messages that are written.
+func CurrentLevel() Level {
+	return Level(level.Load())
+}
+
+// Enabled reports whether messages of the given level are written.
+func Enabled(l Level) bool {
+	return l >= CurrentLevel()
+}
+
+// SetFeature enables or disables a debug feature.
+func SetFeature(f Feature, on bool) error {
+	if !f.valid() {
+		return fmt.Errorf("%w: %q", ErrUnknownFeature, f)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	features[f] = on
+	return nil
+}
+
+// FeatureEnabled reports whether a debug feature is enabled.
+func FeatureEnabled(f Feature) bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	return features[f]
+}
+
+// Configure sets the level and enables exactly the given features.
+func Configure(l Level, enabled []Feature) {
+	mu.Lock()
+	defer mu.Unlock()
+	level.Store(int32(l))
+	features = make(map[Feature]bool, len(enabled))
+	for _, f := range enabled {
+		features[f] = true
+	}
+}
+
+// State is the current logging configuration.
+type State struct {
+	Level    string          `json:"level"`
+	Features map[string]bool `json:"features"`
+}
+
+// Snapshot returns the current logging configuration.
+func Snapshot() State {
+	mu.RLock()
+	defer mu.RUnlock()
+	state := State{Level: CurrentLevel().String(), Features: make(map[string]bool)}
+	for _, f := range Features() {
+		state.Features[string(f)] = features[f]
+	}
+	return state
+}
+
+// Debugf writes a debug message.
+func Debugf(format string, args ...interface{}) { logf(LevelDebug, format, args...) }
+
+// Infof writes an informational message.
+func Infof(format string, args ...interface{}) { logf(LevelInfo, format, args...) }
+
+// Warnf writes a warning.
+func Warnf(format string, args ...interface{}) { logf(LevelWarn, format, args...) }
+
+// Errorf writes an error.
+func Errorf(format string, args ...interface{}) { logf(LevelError, format, args...) }
+
+func logf(l Level, format string, args ...interface{}) {
+	if !Enabled(l) {
+		return
+	}
+	_ = log.Output(3, strings.ToUpper(l.String())+" "+fmt.Sprintf(format, args...))
+}
+
+// SQL dumps a statement run by component if FeatureSQL is enabled.
+func SQL(component, sql string, params map[string]interface{}) {
+	if !FeatureEnabled(FeatureSQL) {
+		return
+	}
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	bound := make([]string, len(names))
+	for i, name := range names {
+		bound[i] = fmt.Sprintf("@%s=%v", name, params[name])
+	}
+	_ = log.Output(2, fmt.Sprintf("DEBUG %s: sql: %s [%s]", component, strings.Join(strings.Fields(sql), " "), strings.Join(bound, " ")))
+}
+
+// Payload dumps an event payload handled by component if FeaturePayloads is enabled.
+func Payload(component, eventType string, payload []byte) {
+	if !FeatureEnabled(FeaturePayloads) {
+		return
+	}
+	_ = log.Output(2, fmt.Sprintf("DEBUG %s: payload of %s: %s", component, eventType, payload))
+}
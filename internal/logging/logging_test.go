@@ -0,0 +1,98 @@
+package logging
+
+import (
+	"bytes"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// captureLog redirects the standard logger for the duration of the test and restores
+// the default configuration afterwards.
+func captureLog(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	out, flags := log.Writer(), log.Flags()
+	log.SetOutput(&buf)
+	log.SetFlags(0)
+	t.Cleanup(func() {
+		log.SetOutput(out)
+		log.SetFlags(flags)
+		Configure(LevelInfo, nil)
+	})
+	return &buf
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Level
+		wantErr bool
+	}{
+		{input: "debug", want: LevelDebug},
+		{input: "INFO", want: LevelInfo},
+		{input: "Warn", want: LevelWarn},
+		{input: "error", want: LevelError},
+		{input: "trace", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseLevel(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnknownLevel)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseFeatures(t *testing.T) {
+	features, err := ParseFeatures(" SQL, payloads ,")
+	require.NoError(t, err)
+	assert.Equal(t, []Feature{FeatureSQL, FeaturePayloads}, features)
+
+	features, err = ParseFeatures("")
+	require.NoError(t, err)
+	assert.Empty(t, features)
+
+	_, err = ParseFeatures("sql,queries")
+	assert.ErrorIs(t, err, ErrUnknownFeature)
+}
+
+func TestLevels(t *testing.T) {
+	buf := captureLog(t)
+
+	SetLevel(LevelWarn)
+	Debugf("debug %d", 1)
+	Infof("info %d", 2)
+	Warnf("warn %d", 3)
+	Errorf("error %d", 4)
+	assert.Equal(t, "WARN warn 3\nERROR error 4\n", buf.String())
+
+	buf.Reset()
+	SetLevel(LevelDebug)
+	Debugf("debug %d", 1)
+	assert.Equal(t, "DEBUG debug 1\n", buf.String())
+}
+
+func TestFeatures(t *testing.T) {
+	buf := captureLog(t)
+
+	SQL("read_model", "SELECT 1", nil)
+	Payload("outbox", "product.created", []byte(`{}`))
+	assert.Empty(t, buf.String())
+
+	require.NoError(t, SetFeature(FeatureSQL, true))
+	SQL("read_model", "SELECT *\n\t  FROM products WHERE id = @id", map[string]interface{}{"id": "product-1"})
+	Payload("outbox", "product.created", []byte(`{}`))
+	assert.Equal(t, "DEBUG read_model: sql: SELECT * FROM products WHERE id = @id [@id=product-1]\n", buf.String())
+
+	assert.ErrorIs(t, SetFeature("profiling", true), ErrUnknownFeature)
+	assert.Equal(t, State{Level: "info", Features: map[string]bool{"sql": true, "payloads": false}}, Snapshot())
+}
@@ -0,0 +1,94 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/product-catalog-service/internal/selftest"
+	"github.com/product-catalog-service/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApply(t *testing.T) {
+	widgets := selftest.Column{Table: "widgets", Name: "widget_id", Type: "STRING(36)", NotNull: true}
+	color := selftest.Column{Table: "widgets", Name: "color", Type: "STRING(20)"}
+	migrationFiles := []selftest.Migration{
+		{Name: "001_create.sql", Columns: []selftest.Column{widgets}, Statements: []string{
+			"CREATE TABLE widgets (widget_id STRING(36) NOT NULL) PRIMARY KEY (widget_id)",
+			"CREATE INDEX idx_widgets_live ON widgets(widget_id) WHERE widget_id != ''",
+		}},
+		{Name: "002_add_column.sql", Columns: []selftest.Column{color}, Statements: []string{
+			"ALTER TABLE widgets ADD COLUMN color STRING(20)",
+		}},
+	}
+	schemaOf := func(columns ...selftest.Column) selftest.SchemaReader {
+		return func(context.Context) (selftest.Schema, error) {
+			schema := make(selftest.Schema)
+			for _, c := range columns {
+				if schema[c.Table] == nil {
+					schema[c.Table] = make(map[string]selftest.Column)
+				}
+				schema[c.Table][c.Name] = c
+			}
+			return schema, nil
+		}
+	}
+
+	t.Run("empty database", func(t *testing.T) {
+		var updates [][]string
+		applied, err := Apply(context.Background(), migrationFiles, schemaOf(), func(_ context.Context, statements []string) error {
+			updates = append(updates, statements)
+			return nil
+		}, PartialIndex)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"001_create.sql", "002_add_column.sql"}, applied)
+		assert.Equal(t, [][]string{
+			{"CREATE TABLE widgets (widget_id STRING(36) NOT NULL) PRIMARY KEY (widget_id)"},
+			{"ALTER TABLE widgets ADD COLUMN color STRING(20)"},
+		}, updates)
+	})
+
+	t.Run("up to date", func(t *testing.T) {
+		applied, err := Apply(context.Background(), migrationFiles, schemaOf(widgets, color), func(context.Context, []string) error {
+			t.Fatal("no update expected")
+			return nil
+		}, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, applied)
+	})
+
+	t.Run("stops at the failing migration", func(t *testing.T) {
+		applied, err := Apply(context.Background(), migrationFiles, schemaOf(), func(_ context.Context, statements []string) error {
+			if statements[0] == "ALTER TABLE widgets ADD COLUMN color STRING(20)" {
+				return errors.New("duplicate column")
+			}
+			return nil
+		}, nil)
+
+		assert.EqualError(t, err, "apply 002_add_column.sql: duplicate column")
+		assert.Equal(t, []string{"001_create.sql"}, applied)
+	})
+}
+
+func TestPartialIndex(t *testing.T) {
+	assert.True(t, PartialIndex("CREATE INDEX idx_products_status ON products(status) WHERE status != 'archived'"))
+	assert.False(t, PartialIndex("CREATE INDEX idx_products_category ON products(category, status)"))
+	assert.False(t, PartialIndex("CREATE NULL_FILTERED INDEX idx_products_pending_price ON products(pending_price_effective_at)"))
+	assert.False(t, PartialIndex("CREATE TABLE products (product_id STRING(36) NOT NULL) PRIMARY KEY (product_id)"))
+
+	migrationFiles, err := selftest.ParseMigrations(migrations.Files)
+	require.NoError(t, err)
+	var skipped []string
+	for _, m := range migrationFiles {
+		for _, stmt := range m.Statements {
+			if PartialIndex(stmt) {
+				skipped = append(skipped, m.Name)
+			}
+		}
+	}
+	assert.Equal(t, []string{"001_initial_schema.sql"}, skipped, "only idx_products_status is left out")
+}
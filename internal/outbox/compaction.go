@@ -0,0 +1,49 @@
+package outbox
+
+// compactableEventTypes are event types whose payload carries the complete state of the
+// fields they describe, so the latest event of a run supersedes the earlier ones.
+// Lifecycle events (created, activated, archived, discount changes) are never compacted
+// and break runs.
+var compactableEventTypes = map[string]bool{
+	"product.updated": true,
+}
+
+// compact coalesces consecutive compactable events of the same type and aggregate into
+// the latest one. "Consecutive" is judged per aggregate: events of other aggregates in
+// between do not break a run, but any other event of the same aggregate does.
+//
+// The surviving message lists the superseded events in CompactedEventIDs so they are
+// marked processed together with it. The relative order of the remaining messages is
+// unchanged.
+func compact(msgs []*Message) []*Message {
+	// lastRun maps an aggregate to the index in out of its latest message, if compactable.
+	lastRun := make(map[string]int)
+	out := make([]*Message, 0, len(msgs))
+
+	for _, msg := range msgs {
+		if !compactableEventTypes[msg.EventType] {
+			delete(lastRun, msg.AggregateID)
+			out = append(out, msg)
+			continue
+		}
+
+		i, ok := lastRun[msg.AggregateID]
+		if !ok || out[i].EventType != msg.EventType {
+			lastRun[msg.AggregateID] = len(out)
+			out = append(out, msg)
+			continue
+		}
+
+		// Replace the earlier message in place with the newer one, carrying over the
+		// superseded IDs. No event of this aggregate lies in between, so per-aggregate
+		// order is preserved.
+		prev := out[i]
+		merged := *msg
+		merged.CompactedEventIDs = make([]string, 0, len(prev.CompactedEventIDs)+1)
+		merged.CompactedEventIDs = append(merged.CompactedEventIDs, prev.CompactedEventIDs...)
+		merged.CompactedEventIDs = append(merged.CompactedEventIDs, prev.EventID)
+		out[i] = &merged
+	}
+
+	return out
+}
@@ -0,0 +1,90 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func event(id, eventType, aggregateID string) *Message {
+	return &Message{EventID: id, EventType: eventType, AggregateID: aggregateID, CreatedAt: time.Now()}
+}
+
+func TestCompact(t *testing.T) {
+	type result struct {
+		ID        string
+		Compacted []string
+	}
+
+	tests := []struct {
+		name     string
+		msgs     []*Message
+		expected []result
+	}{
+		{
+			name: "coalesces consecutive updates into the latest",
+			msgs: []*Message{
+				event("u1", "product.updated", "A"),
+				event("u2", "product.updated", "A"),
+				event("u3", "product.updated", "A"),
+			},
+			expected: []result{{"u3", []string{"u1", "u2"}}},
+		},
+		{
+			name: "lifecycle event breaks the run",
+			msgs: []*Message{
+				event("u1", "product.updated", "A"),
+				event("a1", "product.activated", "A"),
+				event("u2", "product.updated", "A"),
+				event("u3", "product.updated", "A"),
+			},
+			expected: []result{{"u1", nil}, {"a1", nil}, {"u3", []string{"u2"}}},
+		},
+		{
+			name: "other aggregates do not break the run",
+			msgs: []*Message{
+				event("a-u1", "product.updated", "A"),
+				event("b-u1", "product.updated", "B"),
+				event("a-u2", "product.updated", "A"),
+				event("b-d1", "product.discount_applied", "B"),
+			},
+			expected: []result{{"a-u2", []string{"a-u1"}}, {"b-u1", nil}, {"b-d1", nil}},
+		},
+		{
+			name: "created event is kept",
+			msgs: []*Message{
+				event("c1", "product.created", "A"),
+				event("u1", "product.updated", "A"),
+			},
+			expected: []result{{"c1", nil}, {"u1", nil}},
+		},
+		{
+			name:     "empty",
+			msgs:     nil,
+			expected: []result{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]result, 0)
+			for _, msg := range compact(tt.msgs) {
+				got = append(got, result{msg.EventID, msg.CompactedEventIDs})
+			}
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestCompact_DoesNotModifyInput(t *testing.T) {
+	msgs := []*Message{
+		event("u1", "product.updated", "A"),
+		event("u2", "product.updated", "A"),
+	}
+
+	compact(msgs)
+
+	assert.Empty(t, msgs[0].CompactedEventIDs)
+	assert.Empty(t, msgs[1].CompactedEventIDs)
+}
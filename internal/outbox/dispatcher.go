@@ -0,0 +1,266 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/logging"
+	"github.com/product-catalog-service/internal/repository"
+)
+
+// Default dispatcher settings.
+const (
+	DefaultPollInterval  = time.Second
+	DefaultFetchSize     = 100
+	DefaultMaxBatchSize  = 20
+	DefaultFlushInterval = 100 * time.Millisecond
+)
+
+// DispatcherOptions controls the polling and batching behavior of a Dispatcher.
+type DispatcherOptions struct {
+	// PollInterval is the delay between polls when the outbox is drained.
+	PollInterval time.Duration
+	// FetchSize is the maximum number of pending events read per poll.
+	FetchSize int
+	// MaxBatchSize is the maximum number of events published in one batch.
+	// A batch only holds events of a single aggregate.
+	MaxBatchSize int
+	// FlushInterval is how long a partial batch may wait to fill up, measured from the
+	// creation of its oldest event. Full batches are published immediately.
+	FlushInterval time.Duration
+	// Compact coalesces consecutive product.updated events of an aggregate into the
+	// latest one before publishing.
+	Compact bool
+}
+
+// DispatchStats summarizes a single dispatch pass.
+type DispatchStats struct {
+	// Fetched is the number of pending events read.
+	Fetched int
+	// Published is the number of events delivered and marked processed.
+	Published int
+	// Held is the number of events left in partial batches waiting for the flush interval.
+	Held int
+	// Compacted is the number of events superseded by a later event and marked
+	// processed without being published.
+	Compacted int
+}
+
+// Dispatcher polls the outbox for pending events, publishes them, and marks them processed.
+//
+// Events are grouped into batches by aggregate ID, which is used as the ordering key:
+// events of one product are always published in creation order, while batches of
+// different products are published concurrently. Delivery is at-least-once.
+type Dispatcher struct {
+	client    *spanner.Client
+	repo      contract.OutboxRepository
+	publisher Publisher
+	clock     clock.Clock
+	opts      DispatcherOptions
+}
+
+// NewDispatcher creates a new Dispatcher.
+func NewDispatcher(client *spanner.Client, publisher Publisher, clock clock.Clock, opts DispatcherOptions) *Dispatcher {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	if opts.FetchSize <= 0 {
+		opts.FetchSize = DefaultFetchSize
+	}
+	if opts.MaxBatchSize <= 0 {
+		opts.MaxBatchSize = DefaultMaxBatchSize
+	}
+	if opts.FlushInterval < 0 {
+		opts.FlushInterval = 0
+	}
+	return &Dispatcher{
+		client:    client,
+		repo:      repository.NewOutboxRepo(client),
+		publisher: publisher,
+		clock:     clock,
+		opts:      opts,
+	}
+}
+
+// Run dispatches events until the context is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	for {
+		stats, err := d.DispatchOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			logging.Errorf("outbox dispatcher: %v", err)
+		}
+
+		// Keep draining while full fetches make progress.
+		if err == nil && stats.Fetched == d.opts.FetchSize && stats.Published > 0 {
+			continue
+		}
+
+		wait := d.opts.PollInterval
+		if stats.Held > 0 && d.opts.FlushInterval < wait {
+			wait = d.opts.FlushInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// DispatchOnce publishes the ready batches among the oldest pending events.
+func (d *Dispatcher) DispatchOnce(ctx context.Context) (DispatchStats, error) {
+	msgs, err := d.fetchPending(ctx)
+	if err != nil {
+		return DispatchStats{}, err
+	}
+
+	stats := DispatchStats{Fetched: len(msgs)}
+	if d.opts.Compact {
+		msgs = compact(msgs)
+	}
+
+	batches, held := planBatches(msgs, d.clock.Now(), d.opts.MaxBatchSize, d.opts.FlushInterval)
+	published := publishBatches(ctx, d.publisher, batches)
+
+	stats.Published = len(published)
+	stats.Held = held
+	if len(published) == 0 {
+		return stats, nil
+	}
+
+	now := d.clock.Now()
+	muts := make([]*spanner.Mutation, 0, len(published))
+	for _, msg := range published {
+		muts = append(muts, d.repo.UpdateStatusMut(msg.EventID, repository.StatusProcessed, now))
+		for _, id := range msg.CompactedEventIDs {
+			muts = append(muts, d.repo.UpdateStatusMut(id, repository.StatusProcessed, now))
+		}
+		stats.Compacted += len(msg.CompactedEventIDs)
+	}
+	if _, err := d.client.Apply(ctx, muts); err != nil {
+		return stats, err
+	}
+	return stats, nil
+}
+
+// batch is a run of events sharing an ordering key.
+type batch struct {
+	key  string
+	msgs []*Message
+}
+
+// planBatches groups msgs by aggregate ID, preserving creation order within each group,
+// and splits each group into batches of at most maxSize. A trailing partial batch is held
+// back (and counted in held) until its oldest event is flushInterval old.
+//
+// Batches of the same key are returned in order; a held batch is always the last of its key.
+func planBatches(msgs []*Message, now time.Time, maxSize int, flushInterval time.Duration) ([]batch, int) {
+	var keys []string
+	groups := make(map[string][]*Message)
+	for _, msg := range msgs {
+		if _, ok := groups[msg.AggregateID]; !ok {
+			keys = append(keys, msg.AggregateID)
+		}
+		groups[msg.AggregateID] = append(groups[msg.AggregateID], msg)
+	}
+
+	var (
+		batches []batch
+		held    int
+	)
+	for _, key := range keys {
+		group := groups[key]
+		for len(group) > 0 {
+			n := min(maxSize, len(group))
+			if n < maxSize && now.Sub(group[0].CreatedAt) < flushInterval {
+				held += n
+				break
+			}
+			batches = append(batches, batch{key: key, msgs: group[:n]})
+			group = group[n:]
+		}
+	}
+	return batches, held
+}
+
+// publishBatches publishes the batches and returns the delivered messages.
+// Keys are published concurrently; the batches of one key are published sequentially
+// and stop at the first failure so later events of that aggregate are not delivered
+// ahead of it.
+func publishBatches(ctx context.Context, publisher Publisher, batches []batch) []*Message {
+	var keys []string
+	byKey := make(map[string][]batch)
+	for _, b := range batches {
+		if _, ok := byKey[b.key]; !ok {
+			keys = append(keys, b.key)
+		}
+		byKey[b.key] = append(byKey[b.key], b)
+	}
+
+	var (
+		mu        sync.Mutex
+		published []*Message
+		wg        sync.WaitGroup
+	)
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key string, batches []batch) {
+			defer wg.Done()
+			for _, b := range batches {
+				n, err := publishBatch(ctx, publisher, b)
+
+				mu.Lock()
+				published = append(published, b.msgs[:n]...)
+				mu.Unlock()
+
+				if err != nil {
+					logging.Errorf("outbox dispatcher: publish batch for %s: %v", key, err)
+					return
+				}
+			}
+		}(key, byKey[key])
+	}
+	wg.Wait()
+
+	return published
+}
+
+// publishBatch delivers a batch, falling back to one message at a time when the
+// publisher does not support batches.
+func publishBatch(ctx context.Context, publisher Publisher, b batch) (int, error) {
+	if bp, ok := publisher.(BatchPublisher); ok {
+		return bp.PublishBatch(ctx, b.key, b.msgs)
+	}
+	for i, msg := range b.msgs {
+		if err := publisher.Publish(ctx, msg); err != nil {
+			return i, err
+		}
+	}
+	return len(b.msgs), nil
+}
+
+// fetchPending reads the oldest pending events.
+func (d *Dispatcher) fetchPending(ctx context.Context) ([]*Message, error) {
+	events, err := d.repo.FindPending(ctx, d.opts.FetchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]*Message, len(events))
+	for i, event := range events {
+		msgs[i] = &Message{
+			EventID:     event.EventID,
+			EventType:   event.EventType,
+			AggregateID: event.AggregateID,
+			TenantID:    event.TenantID,
+			Payload:     event.Payload,
+			CreatedAt:   event.CreatedAt,
+		}
+	}
+	return msgs, nil
+}
@@ -0,0 +1,227 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingPublisher records published messages and fails on the configured event IDs.
+type recordingPublisher struct {
+	mu        sync.Mutex
+	published []*Message
+	batches   [][]string
+	failOn    map[string]bool
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, msg *Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.failOn[msg.EventID] {
+		return errors.New("publish failed")
+	}
+	p.published = append(p.published, msg)
+	return nil
+}
+
+func (p *recordingPublisher) Close() error { return nil }
+
+// recordingBatchPublisher additionally records the batches it receives.
+type recordingBatchPublisher struct {
+	recordingPublisher
+}
+
+func (p *recordingBatchPublisher) PublishBatch(ctx context.Context, _ string, msgs []*Message) (int, error) {
+	ids := make([]string, len(msgs))
+	for i, msg := range msgs {
+		ids[i] = msg.EventID
+	}
+	p.mu.Lock()
+	p.batches = append(p.batches, ids)
+	p.mu.Unlock()
+
+	for i, msg := range msgs {
+		if err := p.Publish(ctx, msg); err != nil {
+			return i, err
+		}
+	}
+	return len(msgs), nil
+}
+
+func msgAt(id, aggregateID string, createdAt time.Time) *Message {
+	return &Message{EventID: id, EventType: "product.updated", AggregateID: aggregateID, CreatedAt: createdAt}
+}
+
+func batchIDs(batches []batch) [][]string {
+	var out [][]string
+	for _, b := range batches {
+		var ids []string
+		for _, msg := range b.msgs {
+			ids = append(ids, msg.EventID)
+		}
+		out = append(out, ids)
+	}
+	return out
+}
+
+func TestPlanBatches(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-time.Second)
+	fresh := now.Add(-10 * time.Millisecond)
+
+	tests := []struct {
+		name          string
+		msgs          []*Message
+		maxSize       int
+		flushInterval time.Duration
+		expected      [][]string
+		expectedHeld  int
+	}{
+		{
+			name: "groups by aggregate preserving order",
+			msgs: []*Message{
+				msgAt("a1", "A", old), msgAt("b1", "B", old), msgAt("a2", "A", old), msgAt("b2", "B", old),
+			},
+			maxSize:  10,
+			expected: [][]string{{"a1", "a2"}, {"b1", "b2"}},
+		},
+		{
+			name: "splits groups at max batch size",
+			msgs: []*Message{
+				msgAt("a1", "A", old), msgAt("a2", "A", old), msgAt("a3", "A", old),
+			},
+			maxSize:  2,
+			expected: [][]string{{"a1", "a2"}, {"a3"}},
+		},
+		{
+			name: "holds fresh partial batch",
+			msgs: []*Message{
+				msgAt("a1", "A", fresh), msgAt("b1", "B", old),
+			},
+			maxSize:       10,
+			flushInterval: 100 * time.Millisecond,
+			expected:      [][]string{{"b1"}},
+			expectedHeld:  1,
+		},
+		{
+			name: "publishes full batch and holds fresh remainder",
+			msgs: []*Message{
+				msgAt("a1", "A", fresh), msgAt("a2", "A", fresh), msgAt("a3", "A", fresh),
+			},
+			maxSize:       2,
+			flushInterval: 100 * time.Millisecond,
+			expected:      [][]string{{"a1", "a2"}},
+			expectedHeld:  1,
+		},
+		{
+			name:          "empty",
+			msgs:          nil,
+			maxSize:       10,
+			flushInterval: 100 * time.Millisecond,
+			expected:      nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batches, held := planBatches(tt.msgs, now, tt.maxSize, tt.flushInterval)
+			assert.Equal(t, tt.expected, batchIDs(batches))
+			assert.Equal(t, tt.expectedHeld, held)
+		})
+	}
+}
+
+func TestPublishBatches_UsesBatchPublisher(t *testing.T) {
+	now := time.Now()
+	batches := []batch{
+		{key: "A", msgs: []*Message{msgAt("a1", "A", now), msgAt("a2", "A", now)}},
+		{key: "A", msgs: []*Message{msgAt("a3", "A", now)}},
+		{key: "B", msgs: []*Message{msgAt("b1", "B", now)}},
+	}
+
+	publisher := &recordingBatchPublisher{}
+	published := publishBatches(context.Background(), publisher, batches)
+
+	assert.Len(t, published, 4)
+	assert.ElementsMatch(t, [][]string{{"a1", "a2"}, {"a3"}, {"b1"}}, publisher.batches)
+}
+
+func TestPublishBatches_StopsKeyAtFirstFailure(t *testing.T) {
+	now := time.Now()
+	batches := []batch{
+		{key: "A", msgs: []*Message{msgAt("a1", "A", now), msgAt("a2", "A", now)}},
+		{key: "A", msgs: []*Message{msgAt("a3", "A", now)}},
+		{key: "B", msgs: []*Message{msgAt("b1", "B", now)}},
+	}
+
+	tests := []struct {
+		name      string
+		publisher Publisher
+	}{
+		{"batch publisher", &recordingBatchPublisher{recordingPublisher{failOn: map[string]bool{"a2": true}}}},
+		{"single publisher", &recordingPublisher{failOn: map[string]bool{"a2": true}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			published := publishBatches(context.Background(), tt.publisher, batches)
+
+			var ids []string
+			for _, msg := range published {
+				ids = append(ids, msg.EventID)
+			}
+			// a1 is delivered; a2 fails, so a3 must not overtake it. B is unaffected.
+			assert.ElementsMatch(t, []string{"a1", "b1"}, ids)
+		})
+	}
+}
+
+func TestJetStreamPublisher_PublishBatch(t *testing.T) {
+	server := newFakeNATS(t)
+	ctx := context.Background()
+
+	p, err := NewJetStreamPublisher(ctx, JetStreamOptions{URL: server.url(), Timeout: time.Second})
+	require.NoError(t, err)
+	defer p.Close()
+
+	now := time.Now()
+	msgs := []*Message{msgAt("a1", "A", now), msgAt("a2", "A", now), msgAt("a3", "A", now)}
+
+	n, err := p.PublishBatch(ctx, "A", msgs)
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	require.Len(t, server.published, 3)
+	for i, pub := range server.published {
+		assert.Equal(t, "catalog.product.updated", pub.subject)
+		assert.Contains(t, pub.headers, "Nats-Msg-Id: "+msgs[i].EventID+"\r\n")
+	}
+}
+
+func TestJetStreamPublisher_PublishBatchPartialFailure(t *testing.T) {
+	server := newFakeNATS(t)
+	calls := 0
+	server.respond = func(string) string {
+		calls++
+		if calls == 2 {
+			return `{"error":{"code":503,"err_code":10077,"description":"maximum messages exceeded"}}`
+		}
+		return `{"stream":"CATALOG","seq":1}`
+	}
+
+	p, err := NewJetStreamPublisher(context.Background(), JetStreamOptions{URL: server.url(), Timeout: time.Second})
+	require.NoError(t, err)
+	defer p.Close()
+
+	now := time.Now()
+	n, err := p.PublishBatch(context.Background(), "A", []*Message{msgAt("a1", "A", now), msgAt("a2", "A", now), msgAt("a3", "A", now)})
+	assert.ErrorIs(t, err, ErrJetStream)
+	assert.Equal(t, 1, n)
+}
@@ -0,0 +1,60 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/product-catalog-service/internal/config"
+)
+
+// Publisher names accepted by NewPublisher.
+const (
+	PublisherNone   = "none"
+	PublisherStdout = "stdout"
+	PublisherNATS   = "nats"
+	PublisherPubSub = "pubsub"
+)
+
+// ErrUnknownPublisher is returned by NewPublisher for an unsupported publisher name.
+var ErrUnknownPublisher = errors.New("unknown publisher")
+
+// NewPublisher builds the named publisher from the configuration.
+// For nats, the stream is created or updated with DefaultStreamConfig;
+// for pubsub, the topic is created if missing.
+func NewPublisher(ctx context.Context, name string, cfg config.Config) (Publisher, error) {
+	switch name {
+	case PublisherStdout:
+		return NewWriterPublisher(os.Stdout), nil
+	case PublisherNATS:
+		p, err := NewJetStreamPublisher(ctx, JetStreamOptions{
+			URL:           cfg.NATSURL,
+			SubjectPrefix: cfg.NATSSubjectPrefix,
+			Stream:        cfg.NATSStream,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := p.EnsureStream(ctx, DefaultStreamConfig(p.opts.Stream, p.opts.SubjectPrefix)); err != nil {
+			p.Close()
+			return nil, err
+		}
+		return p, nil
+	case PublisherPubSub:
+		p, err := NewPubSubPublisher(ctx, PubSubOptions{
+			Project:      cfg.PubSubProject,
+			Topic:        cfg.PubSubTopic,
+			EmulatorHost: cfg.PubSubEmulatorHost,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := p.EnsureTopic(ctx); err != nil {
+			return nil, err
+		}
+		return p, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPublisher, name)
+	}
+}
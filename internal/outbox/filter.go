@@ -0,0 +1,105 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/product-catalog-service/internal/eventfilter"
+)
+
+// FilteredPublisher delivers only the messages matching a filter expression to the
+// publisher it wraps. Other messages are dropped but reported as delivered, so the
+// dispatcher marks them processed instead of retrying them.
+//
+// The expression sees the envelope fields event_id, event_type, aggregate_id and replayed
+// and the fields of the payload, nested ones by dotted path (e.g. discount.kind). A field
+// missing from the payload is looked up in its product snapshot, so with
+// OUTBOX_EVENT_SNAPSHOTS every product event can be filtered by category.
+type FilteredPublisher struct {
+	publisher Publisher
+	filter    *eventfilter.Filter
+}
+
+// NewFilteredPublisher wraps publisher so that it only receives messages matching filter.
+func NewFilteredPublisher(publisher Publisher, filter *eventfilter.Filter) *FilteredPublisher {
+	return &FilteredPublisher{publisher: publisher, filter: filter}
+}
+
+// Publish delivers the message if it matches the filter.
+func (p *FilteredPublisher) Publish(ctx context.Context, msg *Message) error {
+	if !p.Matches(msg) {
+		return nil
+	}
+	return p.publisher.Publish(ctx, msg)
+}
+
+// PublishBatch delivers the messages matching the filter in order. The count returned
+// covers the dropped messages ahead of the first message that failed.
+func (p *FilteredPublisher) PublishBatch(ctx context.Context, orderingKey string, msgs []*Message) (int, error) {
+	matching := make([]*Message, 0, len(msgs))
+	positions := make([]int, 0, len(msgs))
+	for i, msg := range msgs {
+		if p.Matches(msg) {
+			matching = append(matching, msg)
+			positions = append(positions, i)
+		}
+	}
+	if len(matching) == 0 {
+		return len(msgs), nil
+	}
+
+	n, err := publishBatch(ctx, p.publisher, batch{key: orderingKey, msgs: matching})
+	if err != nil {
+		return positions[n], err
+	}
+	return len(msgs), nil
+}
+
+// Close closes the wrapped publisher.
+func (p *FilteredPublisher) Close() error {
+	return p.publisher.Close()
+}
+
+// Matches reports whether the message matches the filter. Messages whose payload is not a
+// JSON object only expose their envelope fields.
+func (p *FilteredPublisher) Matches(msg *Message) bool {
+	var payload map[string]interface{}
+	if len(msg.Payload) > 0 {
+		_ = json.Unmarshal(msg.Payload, &payload)
+	}
+	return p.filter.Match(func(name string) (interface{}, bool) {
+		switch name {
+		case "event_id":
+			return msg.EventID, true
+		case "event_type":
+			return msg.EventType, true
+		case "aggregate_id":
+			return msg.AggregateID, true
+		case "replayed":
+			return msg.Replayed, true
+		}
+		if value, ok := lookupPath(payload, name); ok {
+			return value, true
+		}
+		if snapshot, ok := payload["snapshot"].(map[string]interface{}); ok {
+			return lookupPath(snapshot, name)
+		}
+		return nil, false
+	})
+}
+
+// lookupPath returns the value at a dotted path of nested JSON objects.
+func lookupPath(object map[string]interface{}, path string) (interface{}, bool) {
+	var value interface{} = object
+	for _, key := range strings.Split(path, ".") {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = fields[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,108 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/eventfilter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func filteredPublisher(t *testing.T, expr string, inner Publisher) *FilteredPublisher {
+	filter, err := eventfilter.Parse(expr)
+	require.NoError(t, err)
+	return NewFilteredPublisher(inner, filter)
+}
+
+func msgWithPayload(id, eventType string, payload map[string]interface{}) *Message {
+	data, _ := json.Marshal(payload)
+	return &Message{EventID: id, EventType: eventType, AggregateID: "p1", Payload: data, CreatedAt: time.Now()}
+}
+
+func TestFilteredPublisher_Matches(t *testing.T) {
+	created := msgWithPayload("e1", "product.created", map[string]interface{}{"category": "Electronics"})
+	priceChanged := msgWithPayload("e2", "product.price_changed", map[string]interface{}{
+		"new_price_numerator": 1500,
+		"snapshot":            map[string]interface{}{"category": "Electronics", "status": "active"},
+	})
+	discount := msgWithPayload("e3", "product.discount_applied", map[string]interface{}{
+		"discount": map[string]interface{}{"kind": "percentage"},
+	})
+	notJSON := &Message{EventID: "e4", EventType: "product.updated", AggregateID: "p1", Payload: json.RawMessage(`"x"`)}
+
+	tests := []struct {
+		expr string
+		msg  *Message
+		want bool
+	}{
+		{`category == "Electronics"`, created, true},
+		{`category == "Electronics" && event_type == "product.price_changed"`, priceChanged, true},
+		{`category == "Electronics" && event_type == "product.price_changed"`, created, false},
+		{`new_price_numerator > 1000`, priceChanged, true},
+		{`status == "active"`, priceChanged, true},
+		{`discount.kind == "percentage"`, discount, true},
+		{`category == "Electronics"`, discount, false},
+		{`aggregate_id == "p1" && event_id == "e4" && replayed == false`, notJSON, true},
+		{`category == null`, notJSON, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			p := filteredPublisher(t, tt.expr, &recordingPublisher{})
+			assert.Equal(t, tt.want, p.Matches(tt.msg))
+		})
+	}
+}
+
+func TestFilteredPublisher_Publish(t *testing.T) {
+	inner := &recordingPublisher{}
+	p := filteredPublisher(t, `event_type == "product.price_changed"`, inner)
+
+	require.NoError(t, p.Publish(context.Background(), msgAt("e1", "p1", time.Now())))
+	require.NoError(t, p.Publish(context.Background(), &Message{EventID: "e2", EventType: "product.price_changed"}))
+
+	require.Len(t, inner.published, 1)
+	assert.Equal(t, "e2", inner.published[0].EventID)
+}
+
+func TestFilteredPublisher_PublishBatch(t *testing.T) {
+	now := time.Now()
+	changed := func(id string) *Message {
+		return &Message{EventID: id, EventType: "product.price_changed", AggregateID: "p1", CreatedAt: now}
+	}
+	msgs := []*Message{msgAt("e1", "p1", now), changed("e2"), msgAt("e3", "p1", now), changed("e4"), msgAt("e5", "p1", now)}
+
+	t.Run("delivers matching messages as one batch", func(t *testing.T) {
+		inner := &recordingBatchPublisher{}
+		p := filteredPublisher(t, `event_type == "product.price_changed"`, inner)
+
+		n, err := p.PublishBatch(context.Background(), "p1", msgs)
+		require.NoError(t, err)
+		assert.Equal(t, len(msgs), n)
+		assert.Equal(t, [][]string{{"e2", "e4"}}, inner.batches)
+	})
+
+	t.Run("nothing matches", func(t *testing.T) {
+		inner := &recordingBatchPublisher{}
+		p := filteredPublisher(t, `event_type == "product.archived"`, inner)
+
+		n, err := p.PublishBatch(context.Background(), "p1", msgs)
+		require.NoError(t, err)
+		assert.Equal(t, len(msgs), n)
+		assert.Empty(t, inner.batches)
+	})
+
+	t.Run("failure counts the dropped messages ahead of it", func(t *testing.T) {
+		inner := &recordingPublisher{failOn: map[string]bool{"e4": true}}
+		p := filteredPublisher(t, `event_type == "product.price_changed"`, inner)
+
+		n, err := p.PublishBatch(context.Background(), "p1", msgs)
+		require.Error(t, err)
+		assert.Equal(t, 3, n)
+		require.Len(t, inner.published, 1)
+		assert.Equal(t, "e2", inner.published[0].EventID)
+	})
+}
@@ -0,0 +1,241 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Default JetStream settings.
+const (
+	DefaultSubjectPrefix = "catalog"
+	DefaultStream        = "CATALOG"
+	DefaultNATSTimeout   = 5 * time.Second
+)
+
+// JetStream error code returned when a stream with the same name already exists.
+const jsErrCodeStreamNameInUse = 10058
+
+// ErrJetStream wraps errors reported by the JetStream API.
+var ErrJetStream = errors.New("jetstream error")
+
+// JetStreamOptions configures a JetStreamPublisher.
+type JetStreamOptions struct {
+	// URL is the NATS server URL, e.g. nats://localhost:4222.
+	URL string
+	// SubjectPrefix is prepended to the event type to build the subject.
+	SubjectPrefix string
+	// Stream is the name of the stream events are published into.
+	Stream string
+	// Timeout bounds each request to the server.
+	Timeout time.Duration
+}
+
+// SubjectFor returns the subject an event type is published on,
+// e.g. product.created becomes catalog.product.created.
+func SubjectFor(prefix, eventType string) string {
+	if prefix == "" {
+		return eventType
+	}
+	return prefix + "." + eventType
+}
+
+// StreamConfig is the JetStream stream configuration used by EnsureStream.
+type StreamConfig struct {
+	Name            string        `json:"name"`
+	Subjects        []string      `json:"subjects"`
+	Retention       string        `json:"retention"`
+	Storage         string        `json:"storage"`
+	MaxAge          time.Duration `json:"max_age"`
+	DuplicateWindow time.Duration `json:"duplicate_window"`
+	NumReplicas     int           `json:"num_replicas"`
+}
+
+// DefaultStreamConfig returns a file-backed stream capturing every subject under prefix.
+// The duplicate window matches the Nats-Msg-Id deduplication used by Publish.
+func DefaultStreamConfig(stream, prefix string) StreamConfig {
+	return StreamConfig{
+		Name:            stream,
+		Subjects:        []string{SubjectFor(prefix, ">")},
+		Retention:       "limits",
+		Storage:         "file",
+		MaxAge:          7 * 24 * time.Hour,
+		DuplicateWindow: 2 * time.Minute,
+		NumReplicas:     1,
+	}
+}
+
+// jsAPIError is the error object embedded in JetStream API responses.
+type jsAPIError struct {
+	Code        int    `json:"code"`
+	ErrCode     int    `json:"err_code"`
+	Description string `json:"description"`
+}
+
+// jsPubAck is the response to a JetStream publish.
+type jsPubAck struct {
+	Stream    string      `json:"stream"`
+	Sequence  uint64      `json:"seq"`
+	Duplicate bool        `json:"duplicate,omitempty"`
+	Error     *jsAPIError `json:"error,omitempty"`
+}
+
+// jsStreamResponse is the response to a stream create or update.
+type jsStreamResponse struct {
+	Error *jsAPIError `json:"error,omitempty"`
+}
+
+// JetStreamPublisher publishes outbox messages to a NATS JetStream stream.
+// The event ID is sent as Nats-Msg-Id so redeliveries within the duplicate window are dropped.
+type JetStreamPublisher struct {
+	conn *natsConn
+	opts JetStreamOptions
+}
+
+// NewJetStreamPublisher connects to the NATS server.
+func NewJetStreamPublisher(ctx context.Context, opts JetStreamOptions) (*JetStreamPublisher, error) {
+	if opts.SubjectPrefix == "" {
+		opts.SubjectPrefix = DefaultSubjectPrefix
+	}
+	if opts.Stream == "" {
+		opts.Stream = DefaultStream
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultNATSTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	conn, err := dialNATS(ctx, opts.URL, "product-catalog-outbox")
+	if err != nil {
+		return nil, err
+	}
+	return &JetStreamPublisher{conn: conn, opts: opts}, nil
+}
+
+// EnsureStream creates the stream, or updates it if it already exists.
+func (p *JetStreamPublisher) EnsureStream(ctx context.Context, cfg StreamConfig) error {
+	body, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+
+	apiErr, err := p.streamRequest(ctx, "$JS.API.STREAM.CREATE."+cfg.Name, body)
+	if err != nil {
+		return err
+	}
+	if apiErr != nil && apiErr.ErrCode == jsErrCodeStreamNameInUse {
+		apiErr, err = p.streamRequest(ctx, "$JS.API.STREAM.UPDATE."+cfg.Name, body)
+		if err != nil {
+			return err
+		}
+	}
+	if apiErr != nil {
+		return fmt.Errorf("%w: stream %s: %s", ErrJetStream, cfg.Name, apiErr.Description)
+	}
+	return nil
+}
+
+func (p *JetStreamPublisher) streamRequest(ctx context.Context, subject string, body []byte) (*jsAPIError, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancel()
+
+	data, err := p.conn.request(ctx, subject, nil, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp jsStreamResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("%w: invalid response: %v", ErrJetStream, err)
+	}
+	return resp.Error, nil
+}
+
+// Publish sends the message payload to the subject derived from its event type and waits for the ack.
+func (p *JetStreamPublisher) Publish(ctx context.Context, msg *Message) error {
+	_, err := p.PublishBatch(ctx, msg.AggregateID, []*Message{msg})
+	return err
+}
+
+// PublishBatch pipelines the messages and then waits for their acks in order, so a batch
+// costs one round trip instead of one per message.
+//
+// If a message in the middle of a batch is rejected, later messages may already be stored;
+// when the batch is retried the stream drops them as duplicates, so consumers can observe
+// them before the rejected message.
+func (p *JetStreamPublisher) PublishBatch(ctx context.Context, _ string, msgs []*Message) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.opts.Timeout)
+	defer cancel()
+
+	pending := make([]*pendingReply, 0, len(msgs))
+
+	var sendErr error
+	for _, msg := range msgs {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			sendErr = err
+			break
+		}
+		pr, err := p.conn.send(SubjectFor(p.opts.SubjectPrefix, msg.EventType), p.headers(msg), data)
+		if err != nil {
+			sendErr = fmt.Errorf("publish %s: %w", msg.EventID, err)
+			break
+		}
+		pending = append(pending, pr)
+	}
+
+	for i, pr := range pending {
+		if err := p.awaitAck(ctx, pr); err != nil {
+			for _, rest := range pending[i+1:] {
+				rest.release()
+			}
+			return i, fmt.Errorf("publish %s: %w", msgs[i].EventID, err)
+		}
+	}
+	if sendErr != nil {
+		return len(pending), sendErr
+	}
+	return len(msgs), nil
+}
+
+func (p *JetStreamPublisher) headers(msg *Message) map[string]string {
+	headers := map[string]string{
+		"Nats-Msg-Id":          msg.EventID,
+		"Nats-Expected-Stream": p.opts.Stream,
+		"Catalog-Event-Type":   msg.EventType,
+		"Catalog-Aggregate-Id": msg.AggregateID,
+	}
+	if msg.TenantID != "" {
+		headers["Catalog-Tenant-Id"] = msg.TenantID
+	}
+	if msg.Replayed {
+		// Replays must reach consumers even if the original is still in the duplicate window.
+		headers["Nats-Msg-Id"] = msg.EventID + ".replay." + fmt.Sprint(time.Now().UnixNano())
+	}
+	return headers
+}
+
+func (p *JetStreamPublisher) awaitAck(ctx context.Context, pr *pendingReply) error {
+	resp, err := pr.wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	var ack jsPubAck
+	if err := json.Unmarshal(resp, &ack); err != nil {
+		return fmt.Errorf("%w: invalid ack: %v", ErrJetStream, err)
+	}
+	if ack.Error != nil {
+		return fmt.Errorf("%w: %s", ErrJetStream, ack.Error.Description)
+	}
+	return nil
+}
+
+// Close closes the connection.
+func (p *JetStreamPublisher) Close() error {
+	return p.conn.close()
+}
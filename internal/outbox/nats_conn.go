@@ -0,0 +1,340 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// natsConn is a minimal NATS client speaking the core text protocol.
+// It supports exactly what the JetStream publisher needs: request/reply with headers.
+// TLS and cluster reconnects are not supported; the dispatcher recreates the connection on failure.
+type natsConn struct {
+	conn  net.Conn
+	bw    *bufio.Writer
+	wmu   sync.Mutex
+	inbox string
+
+	mu      sync.Mutex
+	nextID  uint64
+	pending map[string]chan natsReply
+	err     error
+	closed  chan struct{}
+}
+
+// natsReply is a message delivered to the connection's inbox.
+type natsReply struct {
+	status string
+	data   []byte
+}
+
+// ErrNATSNoResponders is returned when no JetStream stream is bound to the subject.
+var ErrNATSNoResponders = errors.New("nats: no responders available for request")
+
+// dialNATS connects to the server at rawURL (nats://[user:pass@]host:port) and performs the handshake.
+func dialNATS(ctx context.Context, rawURL, name string) (*natsConn, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("nats: invalid url: %w", err)
+	}
+	if u.Scheme != "nats" {
+		return nil, fmt.Errorf("nats: unsupported scheme %q", u.Scheme)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "4222")
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, fmt.Errorf("nats: dial %s: %w", host, err)
+	}
+
+	nc := &natsConn{
+		conn:    conn,
+		bw:      bufio.NewWriter(conn),
+		inbox:   "_INBOX." + randomToken(),
+		pending: make(map[string]chan natsReply),
+		closed:  make(chan struct{}),
+	}
+
+	br := bufio.NewReader(conn)
+	if err := nc.handshake(ctx, br, u, name); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	go nc.readLoop(br)
+	return nc, nil
+}
+
+// handshake reads INFO, sends CONNECT, subscribes to the inbox, and waits for the server PONG.
+func (nc *natsConn) handshake(ctx context.Context, br *bufio.Reader, u *url.URL, name string) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = nc.conn.SetDeadline(deadline)
+		defer func() { _ = nc.conn.SetDeadline(time.Time{}) }()
+	}
+
+	line, err := readLine(br)
+	if err != nil {
+		return fmt.Errorf("nats: read INFO: %w", err)
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		return fmt.Errorf("nats: expected INFO, got %q", line)
+	}
+
+	connect := map[string]interface{}{
+		"verbose":       false,
+		"pedantic":      false,
+		"headers":       true,
+		"no_responders": true,
+		"lang":          "go",
+		"name":          name,
+		"protocol":      1,
+	}
+	if u.User != nil {
+		if pass, ok := u.User.Password(); ok {
+			connect["user"] = u.User.Username()
+			connect["pass"] = pass
+		} else {
+			connect["auth_token"] = u.User.Username()
+		}
+	}
+	payload, err := json.Marshal(connect)
+	if err != nil {
+		return err
+	}
+
+	if err := nc.write(fmt.Sprintf("CONNECT %s\r\nSUB %s.* 1\r\nPING\r\n", payload, nc.inbox)); err != nil {
+		return err
+	}
+
+	for {
+		line, err := readLine(br)
+		if err != nil {
+			return fmt.Errorf("nats: handshake: %w", err)
+		}
+		switch {
+		case line == "PONG":
+			return nil
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+// request publishes data with headers to subject and waits for a single reply.
+func (nc *natsConn) request(ctx context.Context, subject string, headers map[string]string, data []byte) ([]byte, error) {
+	pr, err := nc.send(subject, headers, data)
+	if err != nil {
+		return nil, err
+	}
+	return pr.wait(ctx)
+}
+
+// pendingReply is a request that has been written and is awaiting its reply.
+type pendingReply struct {
+	nc    *natsConn
+	reply string
+	ch    chan natsReply
+}
+
+// send writes a request without waiting for the reply, so several requests can be pipelined.
+// The caller must call wait on the returned reply.
+func (nc *natsConn) send(subject string, headers map[string]string, data []byte) (*pendingReply, error) {
+	nc.mu.Lock()
+	if nc.err != nil {
+		err := nc.err
+		nc.mu.Unlock()
+		return nil, err
+	}
+	nc.nextID++
+	pr := &pendingReply{
+		nc:    nc,
+		reply: nc.inbox + "." + strconv.FormatUint(nc.nextID, 10),
+		ch:    make(chan natsReply, 1),
+	}
+	nc.pending[pr.reply] = pr.ch
+	nc.mu.Unlock()
+
+	if err := nc.write(encodeHPUB(subject, pr.reply, headers, data)); err != nil {
+		pr.release()
+		return nil, err
+	}
+	return pr, nil
+}
+
+// wait blocks until the reply arrives, the connection fails, or the context is done.
+func (pr *pendingReply) wait(ctx context.Context) ([]byte, error) {
+	defer pr.release()
+
+	select {
+	case msg := <-pr.ch:
+		if strings.HasPrefix(msg.status, "503") {
+			return nil, ErrNATSNoResponders
+		}
+		return msg.data, nil
+	case <-pr.nc.closed:
+		return nil, pr.nc.closeErr()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (pr *pendingReply) release() {
+	pr.nc.mu.Lock()
+	delete(pr.nc.pending, pr.reply)
+	pr.nc.mu.Unlock()
+}
+
+// close terminates the connection.
+func (nc *natsConn) close() error {
+	return nc.conn.Close()
+}
+
+func (nc *natsConn) closeErr() error {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+	return nc.err
+}
+
+func (nc *natsConn) write(s string) error {
+	nc.wmu.Lock()
+	defer nc.wmu.Unlock()
+	if _, err := nc.bw.WriteString(s); err != nil {
+		return err
+	}
+	return nc.bw.Flush()
+}
+
+// readLoop dispatches server messages until the connection fails.
+func (nc *natsConn) readLoop(br *bufio.Reader) {
+	err := nc.readMessages(br)
+
+	nc.mu.Lock()
+	if err == nil || errors.Is(err, net.ErrClosed) {
+		err = errors.New("nats: connection closed")
+	}
+	nc.err = err
+	nc.mu.Unlock()
+	close(nc.closed)
+}
+
+func (nc *natsConn) readMessages(br *bufio.Reader) error {
+	for {
+		line, err := readLine(br)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case line == "PING":
+			if err := nc.write("PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "MSG "), strings.HasPrefix(line, "HMSG "):
+			subject, msg, err := readMsg(br, line)
+			if err != nil {
+				return err
+			}
+			nc.deliver(subject, msg)
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+func (nc *natsConn) deliver(subject string, msg natsReply) {
+	nc.mu.Lock()
+	ch, ok := nc.pending[subject]
+	nc.mu.Unlock()
+	if ok {
+		ch <- msg
+	}
+}
+
+// readMsg reads the payload of a MSG or HMSG control line.
+func readMsg(br *bufio.Reader, line string) (string, natsReply, error) {
+	fields := strings.Fields(line)
+	headered := fields[0] == "HMSG"
+
+	// MSG <subject> <sid> [reply] <size>
+	// HMSG <subject> <sid> [reply] <hdr size> <total size>
+	minFields := 4
+	if headered {
+		minFields = 5
+	}
+	if len(fields) < minFields {
+		return "", natsReply{}, fmt.Errorf("nats: malformed %q", line)
+	}
+
+	total, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil {
+		return "", natsReply{}, fmt.Errorf("nats: malformed %q", line)
+	}
+	hdrSize := 0
+	if headered {
+		if hdrSize, err = strconv.Atoi(fields[len(fields)-2]); err != nil || hdrSize > total {
+			return "", natsReply{}, fmt.Errorf("nats: malformed %q", line)
+		}
+	}
+
+	buf := make([]byte, total+2)
+	if _, err := io.ReadFull(br, buf); err != nil {
+		return "", natsReply{}, err
+	}
+
+	msg := natsReply{data: buf[hdrSize:total]}
+	if headered {
+		msg.status = parseStatus(string(buf[:hdrSize]))
+	}
+	return fields[1], msg, nil
+}
+
+// parseStatus extracts the inline status code from a NATS/1.0 header block, if any.
+func parseStatus(hdr string) string {
+	first, _, _ := strings.Cut(hdr, "\r\n")
+	return strings.TrimSpace(strings.TrimPrefix(first, "NATS/1.0"))
+}
+
+// encodeHPUB encodes a publish with headers.
+func encodeHPUB(subject, reply string, headers map[string]string, data []byte) string {
+	var hdr strings.Builder
+	hdr.WriteString("NATS/1.0\r\n")
+	for k, v := range headers {
+		hdr.WriteString(k)
+		hdr.WriteString(": ")
+		hdr.WriteString(v)
+		hdr.WriteString("\r\n")
+	}
+	hdr.WriteString("\r\n")
+
+	h := hdr.String()
+	return fmt.Sprintf("HPUB %s %s %d %d\r\n%s%s\r\n", subject, reply, len(h), len(h)+len(data), h, data)
+}
+
+func readLine(br *bufio.Reader) (string, error) {
+	line, err := br.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func randomToken() string {
+	b := make([]byte, 11)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
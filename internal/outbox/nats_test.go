@@ -0,0 +1,243 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNATS is a single-connection NATS server that answers JetStream requests.
+type fakeNATS struct {
+	listener net.Listener
+
+	mu        sync.Mutex
+	published []fakePub
+	streams   map[string]bool
+	// respond returns the reply payload for a request, or "" to reply with a 503 status.
+	respond func(subject string) string
+}
+
+type fakePub struct {
+	subject string
+	headers string
+	data    []byte
+}
+
+func newFakeNATS(t *testing.T) *fakeNATS {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeNATS{listener: l, streams: make(map[string]bool)}
+	s.respond = s.defaultRespond
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeNATS) url() string {
+	return "nats://" + s.listener.Addr().String()
+}
+
+func (s *fakeNATS) defaultRespond(subject string) string {
+	switch {
+	case strings.HasPrefix(subject, "$JS.API.STREAM.CREATE."):
+		name := strings.TrimPrefix(subject, "$JS.API.STREAM.CREATE.")
+		if s.streams[name] {
+			return `{"error":{"code":400,"err_code":10058,"description":"stream name already in use"}}`
+		}
+		s.streams[name] = true
+		return `{}`
+	case strings.HasPrefix(subject, "$JS.API.STREAM.UPDATE."):
+		return `{}`
+	case strings.HasPrefix(subject, "catalog."):
+		return fmt.Sprintf(`{"stream":"CATALOG","seq":%d}`, len(s.published))
+	default:
+		return ""
+	}
+}
+
+func (s *fakeNATS) serve(conn net.Conn) {
+	defer conn.Close()
+	br := bufio.NewReader(conn)
+	fmt.Fprint(conn, "INFO {\"headers\":true}\r\n")
+
+	for {
+		line, err := readLine(br)
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "PING":
+			fmt.Fprint(conn, "PONG\r\n")
+		case "HPUB":
+			// HPUB <subject> <reply> <hdr size> <total size>
+			hdrSize, _ := strconv.Atoi(fields[3])
+			total, _ := strconv.Atoi(fields[4])
+			buf := make([]byte, total+2)
+			if _, err := io.ReadFull(br, buf); err != nil {
+				return
+			}
+
+			s.mu.Lock()
+			s.published = append(s.published, fakePub{
+				subject: fields[1],
+				headers: string(buf[:hdrSize]),
+				data:    buf[hdrSize:total],
+			})
+			resp := s.respond(fields[1])
+			s.mu.Unlock()
+
+			if resp == "" {
+				hdr := "NATS/1.0 503\r\n\r\n"
+				fmt.Fprintf(conn, "HMSG %s 1 %d %d\r\n%s\r\n", fields[2], len(hdr), len(hdr), hdr)
+				continue
+			}
+			fmt.Fprintf(conn, "MSG %s 1 %d\r\n%s\r\n", fields[2], len(resp), resp)
+		}
+	}
+}
+
+func (s *fakeNATS) lastPublished() fakePub {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.published[len(s.published)-1]
+}
+
+func TestSubjectFor(t *testing.T) {
+	tests := []struct {
+		prefix    string
+		eventType string
+		expected  string
+	}{
+		{"catalog", "product.created", "catalog.product.created"},
+		{"catalog", "product.discount_applied", "catalog.product.discount_applied"},
+		{"", "product.updated", "product.updated"},
+		{"catalog", ">", "catalog.>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, SubjectFor(tt.prefix, tt.eventType))
+		})
+	}
+}
+
+func TestDefaultStreamConfig(t *testing.T) {
+	cfg := DefaultStreamConfig("CATALOG", "catalog")
+
+	assert.Equal(t, "CATALOG", cfg.Name)
+	assert.Equal(t, []string{"catalog.>"}, cfg.Subjects)
+
+	// JetStream expects durations in nanoseconds.
+	data, err := json.Marshal(cfg)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"duplicate_window":120000000000`)
+}
+
+func TestJetStreamPublisher_Publish(t *testing.T) {
+	server := newFakeNATS(t)
+	ctx := context.Background()
+
+	p, err := NewJetStreamPublisher(ctx, JetStreamOptions{URL: server.url(), Timeout: time.Second})
+	require.NoError(t, err)
+	defer p.Close()
+
+	msg := &Message{
+		EventID:     "event-1",
+		EventType:   "product.created",
+		AggregateID: "product-123",
+		Payload:     json.RawMessage(`{"name":"Widget"}`),
+	}
+	require.NoError(t, p.Publish(ctx, msg))
+
+	pub := server.lastPublished()
+	assert.Equal(t, "catalog.product.created", pub.subject)
+	assert.Contains(t, pub.headers, "Nats-Msg-Id: event-1\r\n")
+	assert.Contains(t, pub.headers, "Nats-Expected-Stream: CATALOG\r\n")
+
+	var got Message
+	require.NoError(t, json.Unmarshal(pub.data, &got))
+	assert.Equal(t, msg.EventID, got.EventID)
+	assert.JSONEq(t, `{"name":"Widget"}`, string(got.Payload))
+}
+
+func TestJetStreamPublisher_PublishErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected error
+	}{
+		{
+			name:     "no stream bound to subject",
+			response: "",
+			expected: ErrNATSNoResponders,
+		},
+		{
+			name:     "jetstream api error",
+			response: `{"error":{"code":503,"err_code":10077,"description":"maximum messages exceeded"}}`,
+			expected: ErrJetStream,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeNATS(t)
+			server.respond = func(string) string { return tt.response }
+
+			p, err := NewJetStreamPublisher(context.Background(), JetStreamOptions{URL: server.url(), Timeout: time.Second})
+			require.NoError(t, err)
+			defer p.Close()
+
+			err = p.Publish(context.Background(), &Message{EventID: "event-1", EventType: "product.updated"})
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestJetStreamPublisher_EnsureStream(t *testing.T) {
+	server := newFakeNATS(t)
+	ctx := context.Background()
+
+	p, err := NewJetStreamPublisher(ctx, JetStreamOptions{URL: server.url(), Timeout: time.Second})
+	require.NoError(t, err)
+	defer p.Close()
+
+	cfg := DefaultStreamConfig("CATALOG", "catalog")
+	require.NoError(t, p.EnsureStream(ctx, cfg))
+	assert.Equal(t, "$JS.API.STREAM.CREATE.CATALOG", server.lastPublished().subject)
+
+	// An existing stream is updated instead.
+	require.NoError(t, p.EnsureStream(ctx, cfg))
+	assert.Equal(t, "$JS.API.STREAM.UPDATE.CATALOG", server.lastPublished().subject)
+}
+
+func TestNewJetStreamPublisher_InvalidURL(t *testing.T) {
+	_, err := NewJetStreamPublisher(context.Background(), JetStreamOptions{URL: "http://localhost:4222"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+// Package outbox delivers events stored in the transactional outbox to downstream consumers.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Message is the envelope delivered to downstream consumers.
+type Message struct {
+	EventID     string `json:"event_id"`
+	EventType   string `json:"event_type"`
+	AggregateID string `json:"aggregate_id"`
+	// TenantID is the tenant that owns the product the event is about; empty for the
+	// default tenant and for events not about a product.
+	TenantID  string          `json:"tenant_id,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+	Replayed  bool            `json:"replayed,omitempty"`
+	// CompactedEventIDs lists earlier events superseded by this one when the
+	// dispatcher compacts consecutive updates.
+	CompactedEventIDs []string `json:"compacted_event_ids,omitempty"`
+}
+
+// Publisher delivers outbox messages to a downstream transport.
+type Publisher interface {
+	// Publish delivers a single message. Implementations must be safe for concurrent use.
+	Publish(ctx context.Context, msg *Message) error
+
+	// Close releases any resources held by the publisher.
+	Close() error
+}
+
+// BatchPublisher is implemented by publishers that can deliver several messages in one call.
+// All messages in a batch share the same ordering key (the aggregate ID) and must be
+// delivered in order.
+type BatchPublisher interface {
+	Publisher
+
+	// PublishBatch delivers msgs in order and returns how many messages at the head of
+	// the batch were delivered. On error, the remaining messages are retried later.
+	PublishBatch(ctx context.Context, orderingKey string, msgs []*Message) (int, error)
+}
+
+// WriterPublisher writes each message as a line of JSON to an io.Writer.
+// It is used for dry runs and for piping events into other tools.
+type WriterPublisher struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewWriterPublisher creates a new WriterPublisher.
+func NewWriterPublisher(w io.Writer) *WriterPublisher {
+	return &WriterPublisher{enc: json.NewEncoder(w)}
+}
+
+// Publish writes the message as a JSON line.
+func (p *WriterPublisher) Publish(_ context.Context, msg *Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.enc.Encode(msg)
+}
+
+// PublishBatch writes each message as a JSON line.
+func (p *WriterPublisher) PublishBatch(_ context.Context, _ string, msgs []*Message) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, msg := range msgs {
+		if err := p.enc.Encode(msg); err != nil {
+			return i, err
+		}
+	}
+	return len(msgs), nil
+}
+
+// Close is a no-op.
+func (p *WriterPublisher) Close() error {
+	return nil
+}
@@ -0,0 +1,142 @@
+package outbox
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	pubsub "google.golang.org/api/pubsub/v1"
+)
+
+// DefaultPubSubTopic is the topic events are published to when none is configured.
+const DefaultPubSubTopic = "catalog-events"
+
+// Message attributes set on every Pub/Sub message, allowing subscriptions to filter
+// without decoding the payload. AttrTenantID is only set on events of a tenant other
+// than the default one.
+const (
+	AttrEventID     = "event_id"
+	AttrEventType   = "event_type"
+	AttrAggregateID = "aggregate_id"
+	AttrTenantID    = "tenant_id"
+)
+
+// PubSubOptions configures a PubSubPublisher.
+type PubSubOptions struct {
+	// Project is the Google Cloud project owning the topic.
+	Project string
+	// Topic is the topic ID (not the full resource name).
+	Topic string
+	// EmulatorHost, when set, points the publisher at a Pub/Sub emulator (host:port)
+	// without authentication.
+	EmulatorHost string
+}
+
+// TopicName returns the full resource name of the topic.
+func (o PubSubOptions) TopicName() string {
+	return fmt.Sprintf("projects/%s/topics/%s", o.Project, o.Topic)
+}
+
+// ClientOptions returns the client options for the configured endpoint.
+func (o PubSubOptions) ClientOptions() []option.ClientOption {
+	if o.EmulatorHost == "" {
+		return nil
+	}
+	return []option.ClientOption{
+		option.WithEndpoint("http://" + o.EmulatorHost + "/"),
+		option.WithoutAuthentication(),
+	}
+}
+
+// PubSubPublisher publishes outbox messages to a Google Cloud Pub/Sub topic.
+// The aggregate ID is used as the ordering key, so subscriptions with message
+// ordering enabled receive the events of a product in order.
+type PubSubPublisher struct {
+	service *pubsub.Service
+	opts    PubSubOptions
+}
+
+// NewPubSubPublisher creates a new PubSubPublisher.
+func NewPubSubPublisher(ctx context.Context, opts PubSubOptions) (*PubSubPublisher, error) {
+	if opts.Topic == "" {
+		opts.Topic = DefaultPubSubTopic
+	}
+	if opts.Project == "" {
+		return nil, errors.New("pubsub: project is required")
+	}
+
+	service, err := pubsub.NewService(ctx, opts.ClientOptions()...)
+	if err != nil {
+		return nil, fmt.Errorf("pubsub: %w", err)
+	}
+	return &PubSubPublisher{service: service, opts: opts}, nil
+}
+
+// EnsureTopic creates the topic if it does not exist.
+func (p *PubSubPublisher) EnsureTopic(ctx context.Context) error {
+	_, err := p.service.Projects.Topics.Create(p.opts.TopicName(), &pubsub.Topic{}).Context(ctx).Do()
+	if err != nil && !isHTTPStatus(err, http.StatusConflict) {
+		return fmt.Errorf("pubsub: create topic %s: %w", p.opts.Topic, err)
+	}
+	return nil
+}
+
+// Publish publishes a single message.
+func (p *PubSubPublisher) Publish(ctx context.Context, msg *Message) error {
+	_, err := p.PublishBatch(ctx, msg.AggregateID, []*Message{msg})
+	return err
+}
+
+// PublishBatch publishes the messages in a single request. The request is atomic:
+// either every message is accepted or none is.
+func (p *PubSubPublisher) PublishBatch(ctx context.Context, orderingKey string, msgs []*Message) (int, error) {
+	req := &pubsub.PublishRequest{Messages: make([]*pubsub.PubsubMessage, 0, len(msgs))}
+	for _, msg := range msgs {
+		pm, err := toPubSubMessage(msg, orderingKey)
+		if err != nil {
+			return 0, err
+		}
+		req.Messages = append(req.Messages, pm)
+	}
+
+	if _, err := p.service.Projects.Topics.Publish(p.opts.TopicName(), req).Context(ctx).Do(); err != nil {
+		return 0, fmt.Errorf("pubsub: publish to %s: %w", p.opts.Topic, err)
+	}
+	return len(msgs), nil
+}
+
+// Close is a no-op; the underlying HTTP client needs no cleanup.
+func (p *PubSubPublisher) Close() error {
+	return nil
+}
+
+// toPubSubMessage encodes the message envelope as the Pub/Sub message data.
+func toPubSubMessage(msg *Message, orderingKey string) (*pubsub.PubsubMessage, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	attributes := map[string]string{
+		AttrEventID:     msg.EventID,
+		AttrEventType:   msg.EventType,
+		AttrAggregateID: msg.AggregateID,
+	}
+	if msg.TenantID != "" {
+		attributes[AttrTenantID] = msg.TenantID
+	}
+	return &pubsub.PubsubMessage{
+		Data:        base64.StdEncoding.EncodeToString(data),
+		OrderingKey: orderingKey,
+		Attributes:  attributes,
+	}, nil
+}
+
+func isHTTPStatus(err error, code int) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
@@ -0,0 +1,138 @@
+package outbox
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pubsub "google.golang.org/api/pubsub/v1"
+)
+
+// fakePubSub serves the subset of the Pub/Sub REST API used by PubSubPublisher.
+type fakePubSub struct {
+	mu       sync.Mutex
+	topics   map[string]bool
+	requests []*pubsub.PublishRequest
+	failWith int
+}
+
+func newFakePubSub(t *testing.T) (*fakePubSub, string) {
+	t.Helper()
+	f := &fakePubSub{topics: make(map[string]bool)}
+	srv := httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(srv.Close)
+	return f, strings.TrimPrefix(srv.URL, "http://")
+}
+
+func (f *fakePubSub) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	switch {
+	case r.Method == http.MethodPut:
+		if f.topics[path] {
+			http.Error(w, `{"error":{"code":409,"message":"Topic already exists"}}`, http.StatusConflict)
+			return
+		}
+		f.topics[path] = true
+		w.Write([]byte(`{"name":"` + path + `"}`))
+	case r.Method == http.MethodPost && strings.HasSuffix(path, ":publish"):
+		if f.failWith != 0 {
+			http.Error(w, `{"error":{"code":500,"message":"internal"}}`, f.failWith)
+			return
+		}
+		var req pubsub.PublishRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.requests = append(f.requests, &req)
+
+		resp := pubsub.PublishResponse{}
+		for range req.Messages {
+			resp.MessageIds = append(resp.MessageIds, "1")
+		}
+		json.NewEncoder(w).Encode(resp)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestPubSubOptions_TopicName(t *testing.T) {
+	opts := PubSubOptions{Project: "test-project", Topic: "catalog-events"}
+	assert.Equal(t, "projects/test-project/topics/catalog-events", opts.TopicName())
+}
+
+func TestNewPubSubPublisher_RequiresProject(t *testing.T) {
+	_, err := NewPubSubPublisher(context.Background(), PubSubOptions{EmulatorHost: "localhost:8085"})
+	assert.Error(t, err)
+}
+
+func TestPubSubPublisher_EnsureTopicIsIdempotent(t *testing.T) {
+	fake, host := newFakePubSub(t)
+	ctx := context.Background()
+
+	p, err := NewPubSubPublisher(ctx, PubSubOptions{Project: "test-project", EmulatorHost: host})
+	require.NoError(t, err)
+
+	require.NoError(t, p.EnsureTopic(ctx))
+	require.NoError(t, p.EnsureTopic(ctx))
+	assert.True(t, fake.topics["projects/test-project/topics/"+DefaultPubSubTopic])
+}
+
+func TestPubSubPublisher_PublishBatch(t *testing.T) {
+	fake, host := newFakePubSub(t)
+	ctx := context.Background()
+
+	p, err := NewPubSubPublisher(ctx, PubSubOptions{Project: "test-project", Topic: "events", EmulatorHost: host})
+	require.NoError(t, err)
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	msgs := []*Message{msgAt("a1", "A", now), msgAt("a2", "A", now)}
+	msgs[0].Payload = json.RawMessage(`{"name":"Widget"}`)
+	msgs[1].TenantID = "acme"
+
+	n, err := p.PublishBatch(ctx, "A", msgs)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	require.Len(t, fake.requests, 1)
+	sent := fake.requests[0].Messages
+	require.Len(t, sent, 2)
+	assert.Equal(t, "A", sent[0].OrderingKey)
+	assert.Equal(t, map[string]string{
+		AttrEventID:     "a1",
+		AttrEventType:   "product.updated",
+		AttrAggregateID: "A",
+	}, sent[0].Attributes)
+	assert.Equal(t, "acme", sent[1].Attributes[AttrTenantID])
+
+	data, err := base64.StdEncoding.DecodeString(sent[0].Data)
+	require.NoError(t, err)
+	var envelope Message
+	require.NoError(t, json.Unmarshal(data, &envelope))
+	assert.Equal(t, "a1", envelope.EventID)
+	assert.JSONEq(t, `{"name":"Widget"}`, string(envelope.Payload))
+}
+
+func TestPubSubPublisher_PublishBatchFailureDeliversNothing(t *testing.T) {
+	fake, host := newFakePubSub(t)
+	fake.failWith = http.StatusInternalServerError
+
+	p, err := NewPubSubPublisher(context.Background(), PubSubOptions{Project: "test-project", EmulatorHost: host})
+	require.NoError(t, err)
+
+	now := time.Now()
+	n, err := p.PublishBatch(context.Background(), "A", []*Message{msgAt("a1", "A", now)})
+	assert.Error(t, err)
+	assert.Zero(t, n)
+}
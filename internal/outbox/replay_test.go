@@ -0,0 +1,79 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayFilter_IsEmpty(t *testing.T) {
+	assert.True(t, ReplayFilter{}.IsEmpty())
+	assert.False(t, ReplayFilter{AggregateID: "product-123"}.IsEmpty())
+	assert.False(t, ReplayFilter{EventTypes: []string{"product.created"}}.IsEmpty())
+	assert.False(t, ReplayFilter{From: time.Now()}.IsEmpty())
+	assert.False(t, ReplayFilter{To: time.Now()}.IsEmpty())
+}
+
+func TestReplayer_RejectsEmptyFilter(t *testing.T) {
+	r := NewReplayer(nil, NewWriterPublisher(&bytes.Buffer{}))
+
+	n, err := r.Replay(context.Background(), ReplayFilter{})
+	assert.ErrorIs(t, err, ErrEmptyReplayFilter)
+	assert.Equal(t, 0, n)
+}
+
+func TestBuildReplayQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	stmt := buildReplayQuery(ReplayFilter{
+		AggregateID: "product-123",
+		EventTypes:  []string{"product.created", "product.updated"},
+		From:        from,
+		To:          to,
+	})
+
+	assert.Contains(t, stmt.SQL, `aggregate_id = @aggregate_id`)
+	assert.Contains(t, stmt.SQL, `event_type IN UNNEST(@event_types)`)
+	assert.Contains(t, stmt.SQL, `created_at >= @from`)
+	assert.Contains(t, stmt.SQL, `created_at < @to`)
+	assert.Contains(t, stmt.SQL, `ORDER BY created_at, event_id`)
+	assert.Equal(t, "product-123", stmt.Params["aggregate_id"])
+	assert.Equal(t, []string{"product.created", "product.updated"}, stmt.Params["event_types"])
+	assert.Equal(t, from, stmt.Params["from"])
+	assert.Equal(t, to, stmt.Params["to"])
+
+	stmt = buildReplayQuery(ReplayFilter{AggregateID: "product-123"})
+	assert.NotContains(t, stmt.SQL, `@event_types`)
+	assert.NotContains(t, stmt.SQL, `@from`)
+	assert.Len(t, stmt.Params, 1)
+}
+
+func TestWriterPublisher(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewWriterPublisher(&buf)
+
+	msg := &Message{
+		EventID:     "event-1",
+		EventType:   "product.created",
+		AggregateID: "product-123",
+		Payload:     json.RawMessage(`{"name":"Widget"}`),
+		CreatedAt:   time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		Replayed:    true,
+	}
+	require.NoError(t, p.Publish(context.Background(), msg))
+	require.NoError(t, p.Close())
+
+	var decoded Message
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, msg.EventID, decoded.EventID)
+	assert.Equal(t, msg.EventType, decoded.EventType)
+	assert.Equal(t, msg.AggregateID, decoded.AggregateID)
+	assert.JSONEq(t, `{"name":"Widget"}`, string(decoded.Payload))
+	assert.True(t, decoded.Replayed)
+}
@@ -0,0 +1,40 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+)
+
+// TeePublisher delivers every message to several publishers in turn, e.g. to the
+// downstream transport and to the search indexer. A message counts as delivered once all
+// of them have it; if one fails, the message is retried and so delivered again to those
+// ahead of it, which the at-least-once delivery of the outbox already requires them to
+// tolerate.
+type TeePublisher struct {
+	publishers []Publisher
+}
+
+// NewTeePublisher creates a TeePublisher delivering to publishers in order.
+func NewTeePublisher(publishers ...Publisher) *TeePublisher {
+	return &TeePublisher{publishers: publishers}
+}
+
+// Publish delivers the message to each publisher in order, stopping at the first that
+// fails.
+func (p *TeePublisher) Publish(ctx context.Context, msg *Message) error {
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes every publisher.
+func (p *TeePublisher) Close() error {
+	var errs []error
+	for _, publisher := range p.publishers {
+		errs = append(errs, publisher.Close())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,23 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeePublisher_Publish(t *testing.T) {
+	first := &recordingPublisher{}
+	second := &recordingPublisher{failOn: map[string]bool{"e2": true}}
+	tee := NewTeePublisher(first, second)
+
+	require.NoError(t, tee.Publish(context.Background(), &Message{EventID: "e1"}))
+	assert.Error(t, tee.Publish(context.Background(), &Message{EventID: "e2"}))
+
+	assert.Len(t, first.published, 2, "the first publisher got both messages")
+	require.Len(t, second.published, 1)
+	assert.Equal(t, "e1", second.published[0].EventID)
+	assert.NoError(t, tee.Close())
+}
@@ -0,0 +1,104 @@
+package pagetoken
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+type testFilter struct {
+	Category string
+	OrderBy  string
+}
+
+func TestNewSigner(t *testing.T) {
+	_, err := NewSigner(testKey)
+	assert.NoError(t, err)
+	_, err = NewSigner(testKey[:MinKeyLength-1])
+	assert.ErrorIs(t, err, ErrKeyTooShort)
+}
+
+func TestSigner_SealOpen(t *testing.T) {
+	signer, err := NewSigner(testKey)
+	require.NoError(t, err)
+	tools := testFilter{Category: "Tools", OrderBy: "name"}
+
+	token, err := signer.Seal("bmFtZTpXaWRnZXQ6cHJvZHVjdC0x", tools)
+	require.NoError(t, err)
+	assert.NotContains(t, token, "product-1")
+
+	cursor, err := signer.Open(token, tools)
+	require.NoError(t, err)
+	assert.Equal(t, "bmFtZTpXaWRnZXQ6cHJvZHVjdC0x", cursor)
+
+	// A token only continues the listing with the same filters
+	_, err = signer.Open(token, testFilter{Category: "Toys", OrderBy: "name"})
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	_, err = signer.Open(token, testFilter{Category: "Tools"})
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	// Tokens of another key are rejected
+	other, err := NewSigner([]byte(strings.Repeat("k", MinKeyLength)))
+	require.NoError(t, err)
+	_, err = other.Open(token, tools)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestUnsigned_SealOpen(t *testing.T) {
+	unsigned := NewUnsigned()
+	tools := testFilter{Category: "Tools", OrderBy: "name"}
+
+	token, err := unsigned.Seal("product-1", tools)
+	require.NoError(t, err)
+	assert.NotContains(t, token, ".")
+
+	cursor, err := unsigned.Open(token, tools)
+	require.NoError(t, err)
+	assert.Equal(t, "product-1", cursor)
+
+	// Unsigned tokens are still bound to their filters
+	_, err = unsigned.Open(token, testFilter{Category: "Toys", OrderBy: "name"})
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	_, err = unsigned.Open("product-1", tools)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	// Signed and unsigned tokens are not interchangeable
+	signer, err := NewSigner(testKey)
+	require.NoError(t, err)
+	_, err = signer.Open(token, tools)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	signed, err := signer.Seal("product-1", tools)
+	require.NoError(t, err)
+	_, err = unsigned.Open(signed, tools)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestSigner_OpenTampered(t *testing.T) {
+	signer, err := NewSigner(testKey)
+	require.NoError(t, err)
+	token, err := signer.Seal("product-1", testFilter{})
+	require.NoError(t, err)
+	encoded, sig, _ := strings.Cut(token, ".")
+
+	forged, err := signer.Seal("product-9", testFilter{})
+	require.NoError(t, err)
+	forgedEncoded, _, _ := strings.Cut(forged, ".")
+
+	for name, token := range map[string]string{
+		"raw product ID":    "product-1",
+		"empty":             "",
+		"swapped body":      forgedEncoded + "." + sig,
+		"bad signature":     encoded + ".AAAA",
+		"unencoded body":    "{}." + sig,
+		"missing signature": encoded + ".",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := signer.Open(token, testFilter{})
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
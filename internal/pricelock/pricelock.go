@@ -0,0 +1,127 @@
+// Package pricelock issues and verifies signed price-lock tokens.
+//
+// A token records the effective prices a client was shown, so an order placed before the
+// token expires can be charged those prices even if the catalog changed in the meantime.
+// Tokens are self-contained: base64url(JSON claims) "." base64url(HMAC-SHA256 of the claims),
+// so verifying one needs the signing key but no storage.
+package pricelock
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MinKeyLength is the minimum length of the signing key in bytes.
+const MinKeyLength = 32
+
+// Price lock errors.
+var (
+	ErrKeyTooShort  = fmt.Errorf("price lock key must be at least %d bytes", MinKeyLength)
+	ErrInvalidToken = errors.New("invalid price lock token")
+	ErrTokenExpired = errors.New("price lock token has expired")
+	ErrDisabled     = errors.New("price locks are not enabled")
+)
+
+// Price is a locked effective price of one product.
+type Price struct {
+	ProductID   string `json:"product_id"`
+	Numerator   int64  `json:"numerator"`
+	Denominator int64  `json:"denominator"`
+	Currency    string `json:"currency"`
+}
+
+// Lock is the content of a verified token.
+type Lock struct {
+	Prices    []Price
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// claims is the signed token body. Times are Unix seconds.
+type claims struct {
+	IssuedAt  int64   `json:"iat"`
+	ExpiresAt int64   `json:"exp"`
+	Prices    []Price `json:"prices"`
+}
+
+// Signer issues and verifies tokens with a shared key.
+type Signer struct {
+	key []byte
+	ttl time.Duration
+}
+
+// NewSigner creates a Signer whose tokens are valid for ttl.
+func NewSigner(key []byte, ttl time.Duration) (*Signer, error) {
+	if len(key) < MinKeyLength {
+		return nil, ErrKeyTooShort
+	}
+	if ttl <= 0 {
+		return nil, fmt.Errorf("price lock TTL must be positive, got %s", ttl)
+	}
+	return &Signer{key: append([]byte(nil), key...), ttl: ttl}, nil
+}
+
+// Issue returns a token locking prices from now until now plus the TTL.
+func (s *Signer) Issue(prices []Price, now time.Time) (string, *Lock, error) {
+	c := claims{
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(s.ttl).Unix(),
+		Prices:    prices,
+	}
+	body, err := json.Marshal(c)
+	if err != nil {
+		return "", nil, err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(body)
+	token := encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(encoded))
+	return token, c.lock(), nil
+}
+
+// Verify checks the signature and expiry of token and returns the locked prices.
+// Errors are ErrInvalidToken or ErrTokenExpired.
+func (s *Signer) Verify(token string, now time.Time) (*Lock, error) {
+	encoded, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	got, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(got, s.sign(encoded)) {
+		return nil, ErrInvalidToken
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var c claims
+	if err := json.Unmarshal(body, &c); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	lock := c.lock()
+	if !now.Before(lock.ExpiresAt) {
+		return nil, ErrTokenExpired
+	}
+	return lock, nil
+}
+
+func (s *Signer) sign(encoded string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
+
+func (c claims) lock() *Lock {
+	return &Lock{
+		Prices:    c.Prices,
+		IssuedAt:  time.Unix(c.IssuedAt, 0).UTC(),
+		ExpiresAt: time.Unix(c.ExpiresAt, 0).UTC(),
+	}
+}
@@ -0,0 +1,89 @@
+package pricelock
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestNewSigner(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     []byte
+		ttl     time.Duration
+		wantErr bool
+	}{
+		{"valid", testKey, time.Minute, false},
+		{"short key", testKey[:MinKeyLength-1], time.Minute, true},
+		{"zero ttl", testKey, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSigner(tt.key, tt.ttl)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestSigner_IssueVerify(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	signer, err := NewSigner(testKey, 15*time.Minute)
+	require.NoError(t, err)
+	prices := []Price{
+		{ProductID: "product-1", Numerator: 4000, Denominator: 100, Currency: "USD"},
+		{ProductID: "product-2", Numerator: 1999, Denominator: 100, Currency: "USD"},
+	}
+
+	token, issued, err := signer.Issue(prices, now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(15*time.Minute), issued.ExpiresAt)
+
+	lock, err := signer.Verify(token, now.Add(10*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, prices, lock.Prices)
+	assert.Equal(t, now, lock.IssuedAt)
+	assert.Equal(t, now.Add(15*time.Minute), lock.ExpiresAt)
+}
+
+func TestSigner_VerifyRejects(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	signer, err := NewSigner(testKey, 15*time.Minute)
+	require.NoError(t, err)
+	other, err := NewSigner([]byte(strings.Repeat("x", MinKeyLength)), 15*time.Minute)
+	require.NoError(t, err)
+
+	token, _, err := signer.Issue([]Price{{ProductID: "product-1", Numerator: 4000, Denominator: 100, Currency: "USD"}}, now)
+	require.NoError(t, err)
+	forged, _, err := other.Issue([]Price{{ProductID: "product-1", Numerator: 1, Denominator: 100, Currency: "USD"}}, now)
+	require.NoError(t, err)
+
+	body, sig, _ := strings.Cut(token, ".")
+	forgedBody, _, _ := strings.Cut(forged, ".")
+
+	tests := []struct {
+		name    string
+		token   string
+		at      time.Time
+		wantErr error
+	}{
+		{"expired", token, now.Add(15 * time.Minute), ErrTokenExpired},
+		{"signed with another key", forged, now, ErrInvalidToken},
+		{"tampered body", forgedBody + "." + sig, now, ErrInvalidToken},
+		{"missing signature", body, now, ErrInvalidToken},
+		{"garbage", "not-a-token", now, ErrInvalidToken},
+		{"empty", "", now, ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := signer.Verify(tt.token, tt.at)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
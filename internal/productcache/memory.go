@@ -0,0 +1,99 @@
+package productcache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+)
+
+// MemoryStore is a Store in process memory that keeps the most recently used values up to
+// a fixed number. It suits single-instance deployments, whose event bus sees every
+// change: the cache of an instance is not invalidated by the commits of another.
+type MemoryStore struct {
+	clock clock.Clock
+
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// memoryEntry is a stored value.
+type memoryEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryStore creates a MemoryStore of size values whose expiry is measured on clk.
+func NewMemoryStore(size int, clk clock.Clock) *MemoryStore {
+	return &MemoryStore{
+		clock:   clk,
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	elem, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	e := elem.Value.(*memoryEntry)
+	if !s.clock.Now().Before(e.expiresAt) {
+		s.remove(elem)
+		return nil, false, nil
+	}
+	s.order.MoveToFront(elem)
+	return e.value, true, nil
+}
+
+// Set implements Store. It evicts the least recently used value when the store is full.
+func (s *MemoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := &memoryEntry{key: key, value: value, expiresAt: s.clock.Now().Add(ttl)}
+	if elem, ok := s.entries[key]; ok {
+		elem.Value = e
+		s.order.MoveToFront(elem)
+		return nil
+	}
+	s.entries[key] = s.order.PushFront(e)
+	if s.order.Len() > s.size {
+		s.remove(s.order.Back())
+	}
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(_ context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		if elem, ok := s.entries[key]; ok {
+			s.remove(elem)
+		}
+	}
+	return nil
+}
+
+// Len returns the number of values stored, including expired ones not yet evicted.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.order.Len()
+}
+
+func (s *MemoryStore) remove(elem *list.Element) {
+	s.order.Remove(elem)
+	delete(s.entries, elem.Value.(*memoryEntry).key)
+}
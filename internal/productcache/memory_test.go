@@ -0,0 +1,50 @@
+package productcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	clk := clock.NewFixedClock(now)
+	s := NewMemoryStore(2, clk)
+	ctx := context.Background()
+
+	require.NoError(t, s.Set(ctx, "a", []byte("1"), time.Minute))
+	require.NoError(t, s.Set(ctx, "b", []byte("2"), time.Minute))
+	_, ok, _ := s.Get(ctx, "a")
+	require.True(t, ok)
+
+	require.NoError(t, s.Set(ctx, "c", []byte("3"), time.Minute))
+	_, ok, _ = s.Get(ctx, "b")
+	assert.False(t, ok, "least recently used value is evicted")
+	v, ok, _ := s.Get(ctx, "a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), v)
+
+	require.NoError(t, s.Delete(ctx, "a", "missing"))
+	_, ok, _ = s.Get(ctx, "a")
+	assert.False(t, ok)
+	assert.Equal(t, 1, s.Len())
+}
+
+func TestMemoryStore_Expiry(t *testing.T) {
+	clk := clock.NewFixedClock(now)
+	s := NewMemoryStore(10, clk)
+	ctx := context.Background()
+
+	require.NoError(t, s.Set(ctx, "a", []byte("1"), time.Minute))
+	clk.Advance(time.Minute - time.Second)
+	_, ok, _ := s.Get(ctx, "a")
+	assert.True(t, ok)
+
+	clk.Advance(time.Second)
+	_, ok, _ = s.Get(ctx, "a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, s.Len())
+}
@@ -0,0 +1,215 @@
+// Package productcache serves the hot products from a cache in front of the product read
+// model: Redis, shared by every instance, or the memory of a single-instance deployment.
+//
+// ReadModel caches the products read by GetProduct at the current time, and Invalidate
+// removes a product from the cache when an event of it is committed; subscribe it to
+// the event bus. A cached product also expires after a TTL, and before the next
+// scheduled change of its prices or status, so the cache never serves a product priced
+// at a time it no longer applies. The TTL bounds how stale a product may be served
+// after a change that raises no event, such as a new rating, or whose invalidation was
+// lost, e.g. because Redis was unreachable or because a concurrent read cached the
+// product as it was before the change. Only strong reads fill the cache, as a product
+// read within a staleness bound may predate the last invalidation. Hits and misses are
+// counted in the product_cache_hits and product_cache_misses expvars.
+package productcache
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"strings"
+	"time"
+
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+	"github.com/product-catalog-service/internal/staleness"
+)
+
+// DefaultTTL is how long a product is cached by default.
+const DefaultTTL = 5 * time.Minute
+
+// DefaultTimeout is the default timeout of a cache command. A read that times out is
+// served by the read model.
+const DefaultTimeout = 100 * time.Millisecond
+
+// keyPrefix prefixes the cache keys of products, which end with the product ID.
+const keyPrefix = "catalog:product:"
+
+// currentTolerance is how far from now a read may be priced and still be served from or
+// stored in the cache. Reads without a price time are priced at the time the query
+// layer received them.
+const currentTolerance = time.Second
+
+var (
+	cacheHits   = expvar.NewInt("product_cache_hits")
+	cacheMisses = expvar.NewInt("product_cache_misses")
+)
+
+// Store is a cache of values that expire; Client implements it with Redis and
+// MemoryStore in process memory.
+type Store interface {
+	// Get returns the value of key and whether it exists.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores value under key for ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes keys; missing keys are ignored.
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// entry is a cached product. Products are only cached for reads scoped to a tenant,
+// which see the products of that tenant only; see package caller.
+type entry struct {
+	Tenant    string               `json:"tenant"`
+	ExpiresAt time.Time            `json:"expires_at"`
+	Product   *contract.ProductDTO `json:"product"`
+}
+
+// ReadModel decorates a product read model to serve GetProduct from a Store. Reads
+// priced at another time than now, reads not scoped to a tenant and strong reads (see
+// package staleness) bypass the cache, although strong reads refresh it. Other reads
+// are served from the cache, but a miss only fills it if next read strongly. Failed
+// cache commands are logged and the read served by the decorated read model. The other
+// reads go to the decorated read model.
+type ReadModel struct {
+	contract.ProductReadModel
+	store     Store
+	clock     clock.Clock
+	ttl       time.Duration
+	staleness staleness.Bound
+}
+
+var _ contract.ProductReadModel = (*ReadModel)(nil)
+
+// Option configures a ReadModel.
+type Option func(*ReadModel)
+
+// WithReadStaleness tells the ReadModel that next reads within bound unless the context
+// carries its own, as configured by repository.WithReadStaleness. Without it, next is
+// taken to read strongly.
+func WithReadStaleness(bound staleness.Bound) Option {
+	return func(rm *ReadModel) {
+		rm.staleness = bound
+	}
+}
+
+// NewReadModel creates a ReadModel that caches the products read from next in store for
+// at most ttl.
+func NewReadModel(next contract.ProductReadModel, store Store, clk clock.Clock, ttl time.Duration, opts ...Option) *ReadModel {
+	rm := &ReadModel{ProductReadModel: next, store: store, clock: clk, ttl: ttl}
+	for _, opt := range opts {
+		opt(rm)
+	}
+	return rm
+}
+
+// GetProduct implements contract.ProductReadModel.
+func (rm *ReadModel) GetProduct(ctx context.Context, id string, at time.Time) (*contract.ProductDTO, error) {
+	tenant, scoped := caller.Tenant(ctx)
+	now := rm.clock.Now()
+	if d := at.Sub(now); !scoped || d < -currentTolerance || d > currentTolerance {
+		return rm.ProductReadModel.GetProduct(ctx, id, at)
+	}
+
+	key := keyPrefix + id
+	bound, ok := staleness.FromContext(ctx)
+	if !ok || bound != staleness.Strong {
+		if e, ok := rm.get(ctx, key); ok && now.Before(e.ExpiresAt) {
+			cacheHits.Add(1)
+			if e.Tenant != tenant {
+				return nil, domain.ErrProductNotFound
+			}
+			return e.Product, nil
+		}
+		cacheMisses.Add(1)
+	}
+	if !ok {
+		bound = rm.staleness
+	}
+
+	dto, err := rm.ProductReadModel.GetProduct(ctx, id, at)
+	if err != nil || dto.CachedAt != nil || bound != staleness.Strong {
+		return dto, err
+	}
+	if expiresAt := rm.expiry(dto, at, now); expiresAt.After(now) {
+		rm.set(ctx, key, entry{Tenant: tenant, ExpiresAt: expiresAt, Product: dto}, expiresAt.Sub(now))
+	}
+	return dto, nil
+}
+
+// Invalidate removes the product of event from the cache. Its signature is that of an
+// eventbus.Handler, which should be subscribed to all events; events of other
+// aggregates are ignored.
+func (rm *ReadModel) Invalidate(ctx context.Context, event domain.DomainEvent) {
+	var id string
+	switch e := event.(type) {
+	case domain.VariantAddedEvent:
+		id = e.ProductID
+	case domain.VariantUpdatedEvent:
+		id = e.ProductID
+	case domain.VariantDiscontinuedEvent:
+		id = e.ProductID
+	default:
+		if !strings.HasPrefix(event.EventType(), "product.") {
+			return
+		}
+		id = event.AggregateID()
+	}
+	// The change is committed, so the invalidation must not fail because the request
+	// that made it is cancelled.
+	if err := rm.store.Delete(context.WithoutCancel(ctx), keyPrefix+id); err != nil {
+		logging.Errorf("Product cache: invalidating product %s after %s: %v", id, event.EventType(), err)
+	}
+}
+
+// expiry returns when the product read at at stops being valid: after the TTL, or when
+// its next discount, scheduled price change or scheduled status change starts or ends.
+func (rm *ReadModel) expiry(dto *contract.ProductDTO, at, now time.Time) time.Time {
+	expiresAt := now.Add(rm.ttl)
+	until := func(t time.Time) {
+		if t.After(at) && t.Before(expiresAt) {
+			expiresAt = t
+		}
+	}
+	for _, t := range []*time.Time{dto.DiscountStartDate, dto.DiscountEndDate, dto.PendingPriceEffectiveAt, dto.ActivateAt, dto.DeactivateAt} {
+		if t != nil {
+			until(*t)
+		}
+	}
+	for _, d := range dto.Discounts {
+		until(d.StartDate)
+		until(d.EndDate)
+	}
+	return expiresAt
+}
+
+// get returns the entry cached under key, if any.
+func (rm *ReadModel) get(ctx context.Context, key string) (entry, bool) {
+	data, ok, err := rm.store.Get(ctx, key)
+	if err != nil {
+		logging.Warnf("Product cache: reading %s: %v", key, err)
+		return entry{}, false
+	}
+	if !ok {
+		return entry{}, false
+	}
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil || e.Product == nil {
+		logging.Warnf("Product cache: decoding %s: %v", key, err)
+		return entry{}, false
+	}
+	return e, true
+}
+
+// set caches e under key for ttl.
+func (rm *ReadModel) set(ctx context.Context, key string, e entry, ttl time.Duration) {
+	data, err := json.Marshal(e)
+	if err == nil {
+		err = rm.store.Set(ctx, key, data, ttl)
+	}
+	if err != nil {
+		logging.Warnf("Product cache: writing %s: %v", key, err)
+	}
+}
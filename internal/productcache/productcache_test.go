@@ -0,0 +1,325 @@
+package productcache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/staleness"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// productReader is a contract.ProductReadModel that serves fixed products and counts
+// the reads it receives.
+type productReader struct {
+	contract.ProductReadModel
+	products map[string]*contract.ProductDTO
+	reads    int
+}
+
+func (r *productReader) GetProduct(_ context.Context, id string, _ time.Time) (*contract.ProductDTO, error) {
+	r.reads++
+	dto, ok := r.products[id]
+	if !ok {
+		return nil, domain.ErrProductNotFound
+	}
+	return dto, nil
+}
+
+// memStore is an in-memory Store on a clock.
+type memStore struct {
+	clock *clock.FixedClock
+	err   error
+
+	mu      sync.Mutex
+	values  map[string][]byte
+	expires map[string]time.Time
+	ttls    map[string]time.Duration
+}
+
+func newMemStore(clk *clock.FixedClock) *memStore {
+	return &memStore{clock: clk, values: map[string][]byte{}, expires: map[string]time.Time{}, ttls: map[string]time.Duration{}}
+}
+
+func (s *memStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, false, s.err
+	}
+	v, ok := s.values[key]
+	if !ok || !s.clock.Now().Before(s.expires[key]) {
+		return nil, false, nil
+	}
+	return v, true, nil
+}
+
+func (s *memStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.values[key] = value
+	s.expires[key] = s.clock.Now().Add(ttl)
+	s.ttls[key] = ttl
+	return nil
+}
+
+func (s *memStore) Delete(_ context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	for _, key := range keys {
+		delete(s.values, key)
+	}
+	return nil
+}
+
+var now = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+func newCache(t *testing.T, products ...*contract.ProductDTO) (*ReadModel, *productReader, *memStore, *clock.FixedClock) {
+	t.Helper()
+	clk := clock.NewFixedClock(now)
+	next := &productReader{products: map[string]*contract.ProductDTO{}}
+	for _, p := range products {
+		next.products[p.ID] = p
+	}
+	store := newMemStore(clk)
+	return NewReadModel(next, store, clk, DefaultTTL), next, store, clk
+}
+
+func tenantCtx(tenant string) context.Context {
+	return caller.NewContext(context.Background(), caller.Identity{Tenant: tenant})
+}
+
+func product(id string) *contract.ProductDTO {
+	return &contract.ProductDTO{
+		ID:                  id,
+		Name:                "Widget",
+		BasePriceNum:        2000,
+		BasePriceDenom:      100,
+		EffectivePriceNum:   2000,
+		EffectivePriceDenom: 100,
+		Currency:            "USD",
+		Status:              "active",
+		CreatedAt:           now.Add(-time.Hour),
+		UpdatedAt:           now.Add(-time.Hour),
+		Attributes:          map[string]string{"color": "red"},
+	}
+}
+
+func TestReadModel_GetProduct(t *testing.T) {
+	rm, next, store, _ := newCache(t, product("p1"))
+	ctx := tenantCtx("acme")
+
+	first, err := rm.GetProduct(ctx, "p1", now)
+	require.NoError(t, err)
+	second, err := rm.GetProduct(ctx, "p1", now)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, next.reads)
+	assert.Equal(t, first, second)
+	assert.Equal(t, DefaultTTL, store.ttls["catalog:product:p1"])
+}
+
+func TestReadModel_GetProduct_Metrics(t *testing.T) {
+	rm, _, _, _ := newCache(t, product("p1"))
+	ctx := tenantCtx("acme")
+	hits, misses := cacheHits.Value(), cacheMisses.Value()
+
+	for i := 0; i < 3; i++ {
+		_, err := rm.GetProduct(ctx, "p1", now)
+		require.NoError(t, err)
+	}
+	_, err := rm.GetProduct(context.Background(), "p1", now)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2), cacheHits.Value()-hits)
+	assert.Equal(t, int64(1), cacheMisses.Value()-misses, "unscoped reads bypass the cache")
+}
+
+func TestReadModel_GetProduct_MemoryStore(t *testing.T) {
+	clk := clock.NewFixedClock(now)
+	next := &productReader{products: map[string]*contract.ProductDTO{"p1": product("p1")}}
+	rm := NewReadModel(next, NewMemoryStore(10, clk), clk, DefaultTTL)
+	ctx := tenantCtx("acme")
+
+	_, err := rm.GetProduct(ctx, "p1", now)
+	require.NoError(t, err)
+	rm.Invalidate(ctx, domain.NewProductArchivedEvent("p1", now))
+	_, err = rm.GetProduct(ctx, "p1", now)
+	require.NoError(t, err)
+	_, err = rm.GetProduct(ctx, "p1", now)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, next.reads)
+}
+
+func TestReadModel_GetProduct_OtherTenant(t *testing.T) {
+	rm, _, _, _ := newCache(t, product("p1"))
+
+	_, err := rm.GetProduct(tenantCtx("acme"), "p1", now)
+	require.NoError(t, err)
+
+	_, err = rm.GetProduct(tenantCtx("globex"), "p1", now)
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+}
+
+func TestReadModel_GetProduct_Bypass(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		at   time.Time
+	}{
+		{name: "unscoped", ctx: context.Background(), at: now},
+		{name: "priced in the future", ctx: tenantCtx("acme"), at: now.Add(24 * time.Hour)},
+		{name: "priced in the past", ctx: tenantCtx("acme"), at: now.Add(-time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm, next, store, _ := newCache(t, product("p1"))
+
+			for i := 0; i < 2; i++ {
+				_, err := rm.GetProduct(tt.ctx, "p1", tt.at)
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, 2, next.reads)
+			assert.Empty(t, store.values)
+		})
+	}
+}
+
+func TestReadModel_GetProduct_StrongReadRefreshes(t *testing.T) {
+	rm, next, _, _ := newCache(t, product("p1"))
+	ctx := tenantCtx("acme")
+
+	_, err := rm.GetProduct(ctx, "p1", now)
+	require.NoError(t, err)
+	next.products["p1"].Name = "Gadget"
+
+	dto, err := rm.GetProduct(staleness.NewContext(ctx, staleness.Strong), "p1", now)
+	require.NoError(t, err)
+	assert.Equal(t, "Gadget", dto.Name)
+
+	dto, err = rm.GetProduct(ctx, "p1", now)
+	require.NoError(t, err)
+	assert.Equal(t, "Gadget", dto.Name)
+	assert.Equal(t, 2, next.reads)
+}
+
+func TestReadModel_GetProduct_StaleReadsDoNotFill(t *testing.T) {
+	stale := staleness.Bound{Staleness: time.Hour, Exact: true}
+	ctx := tenantCtx("acme")
+
+	t.Run("bound of the read", func(t *testing.T) {
+		rm, next, store, _ := newCache(t, product("p1"))
+		for i := 0; i < 2; i++ {
+			_, err := rm.GetProduct(staleness.NewContext(ctx, stale), "p1", now)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, next.reads)
+		assert.Empty(t, store.values)
+
+		_, err := rm.GetProduct(ctx, "p1", now)
+		require.NoError(t, err)
+		_, err = rm.GetProduct(staleness.NewContext(ctx, stale), "p1", now)
+		require.NoError(t, err)
+		assert.Equal(t, 3, next.reads, "stale reads are served cached products")
+	})
+
+	t.Run("default bound", func(t *testing.T) {
+		clk := clock.NewFixedClock(now)
+		next := &productReader{products: map[string]*contract.ProductDTO{"p1": product("p1")}}
+		store := newMemStore(clk)
+		rm := NewReadModel(next, store, clk, DefaultTTL, WithReadStaleness(stale))
+
+		_, err := rm.GetProduct(ctx, "p1", now)
+		require.NoError(t, err)
+		assert.Empty(t, store.values)
+
+		_, err = rm.GetProduct(staleness.NewContext(ctx, staleness.Strong), "p1", now)
+		require.NoError(t, err)
+		assert.Contains(t, store.values, "catalog:product:p1")
+	})
+}
+
+func TestReadModel_GetProduct_ExpiresAtNextPriceChange(t *testing.T) {
+	p := product("p1")
+	start, end := now.Add(-time.Hour), now.Add(90*time.Second)
+	p.Discounts = []contract.DiscountDTO{{ID: "d1", Percent: 10, StartDate: start, EndDate: end}}
+	rm, next, store, clk := newCache(t, p)
+	ctx := tenantCtx("acme")
+
+	_, err := rm.GetProduct(ctx, "p1", now)
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, store.ttls["catalog:product:p1"])
+
+	clk.Advance(90 * time.Second)
+	_, err = rm.GetProduct(ctx, "p1", clk.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 2, next.reads)
+}
+
+func TestReadModel_GetProduct_StoreErrors(t *testing.T) {
+	rm, next, store, _ := newCache(t, product("p1"))
+	store.err = errors.New("connection refused")
+
+	for i := 0; i < 2; i++ {
+		dto, err := rm.GetProduct(tenantCtx("acme"), "p1", now)
+		require.NoError(t, err)
+		assert.Equal(t, "p1", dto.ID)
+	}
+	assert.Equal(t, 2, next.reads)
+}
+
+func TestReadModel_GetProduct_DegradedReadsAreNotCached(t *testing.T) {
+	p := product("p1")
+	cachedAt := now.Add(-time.Minute)
+	p.CachedAt = &cachedAt
+	rm, _, store, _ := newCache(t, p)
+
+	_, err := rm.GetProduct(tenantCtx("acme"), "p1", now)
+	require.NoError(t, err)
+	assert.Empty(t, store.values)
+}
+
+func TestReadModel_Invalidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		event domain.DomainEvent
+		want  bool
+	}{
+		{name: "product event", event: domain.NewProductArchivedEvent("p1", now), want: true},
+		{name: "variant event", event: domain.NewVariantDiscontinuedEvent("v1", "p1", "SKU-1", now), want: true},
+		{name: "event of another product", event: domain.NewProductArchivedEvent("p2", now)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm, next, _, _ := newCache(t, product("p1"))
+			ctx := tenantCtx("acme")
+			_, err := rm.GetProduct(ctx, "p1", now)
+			require.NoError(t, err)
+
+			rm.Invalidate(ctx, tt.event)
+
+			_, err = rm.GetProduct(ctx, "p1", now)
+			require.NoError(t, err)
+			if tt.want {
+				assert.Equal(t, 2, next.reads)
+			} else {
+				assert.Equal(t, 1, next.reads)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package productcache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxIdleConns is the number of idle connections a Client keeps to the server.
+const MaxIdleConns = 16
+
+// ErrRedis wraps the error replies of the server.
+var ErrRedis = errors.New("redis: error reply")
+
+// Client is a minimal Redis client speaking RESP2 over TCP. It supports exactly what the
+// cache needs: GET, SET with an expiry and DEL. Connections are pooled and a connection
+// that fails is dropped; TLS and Redis Cluster are not supported.
+type Client struct {
+	addr     string
+	username string
+	password string
+	db       int
+	timeout  time.Duration
+	idle     chan *redisConn
+}
+
+var _ Store = (*Client)(nil)
+
+type redisConn struct {
+	conn net.Conn
+	br   *bufio.Reader
+	bw   *bufio.Writer
+}
+
+// reply is a RESP2 reply other than an array or an error.
+type reply struct {
+	str  []byte
+	n    int64
+	null bool
+}
+
+// NewClient creates a Client for the server at rawURL
+// (redis://[[user]:password@]host[:port][/db]). It does not connect until the first
+// command. Each command, including dialing when no idle connection is left, times out
+// after timeout or at the deadline of its context, whichever comes first.
+func NewClient(rawURL string, timeout time.Duration) (*Client, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("redis: invalid url: %w", err)
+	}
+	if u.Scheme != "redis" {
+		return nil, fmt.Errorf("redis: unsupported scheme %q", u.Scheme)
+	}
+	c := &Client{
+		addr:    u.Host,
+		timeout: timeout,
+		idle:    make(chan *redisConn, MaxIdleConns),
+	}
+	if u.Port() == "" {
+		c.addr = net.JoinHostPort(u.Hostname(), "6379")
+	}
+	if u.User != nil {
+		c.username = u.User.Username()
+		c.password, _ = u.User.Password()
+	}
+	if path := strings.TrimPrefix(u.Path, "/"); path != "" {
+		if c.db, err = strconv.Atoi(path); err != nil || c.db < 0 {
+			return nil, fmt.Errorf("redis: invalid database %q", path)
+		}
+	}
+	return c, nil
+}
+
+// Get returns the value of key and whether it exists.
+func (c *Client) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	r, err := c.do(ctx, "GET", key)
+	if err != nil {
+		return nil, false, err
+	}
+	if r.null {
+		return nil, false, nil
+	}
+	return r.str, true, nil
+}
+
+// Set stores value under key for ttl, which is rounded down to the millisecond.
+func (c *Client) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl < time.Millisecond {
+		return fmt.Errorf("redis: expiry %s is too short", ttl)
+	}
+	_, err := c.do(ctx, "SET", key, string(value), "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	return err
+}
+
+// Delete removes keys; missing keys are ignored.
+func (c *Client) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err := c.do(ctx, append([]string{"DEL"}, keys...)...)
+	return err
+}
+
+// Close closes the idle connections. Commands in flight finish and close theirs.
+func (c *Client) Close() error {
+	for {
+		select {
+		case rc := <-c.idle:
+			rc.conn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// do runs a command on a pooled connection. The connection is returned to the pool
+// unless the command failed other than with an error reply, which leaves it in an
+// unknown state.
+func (c *Client) do(ctx context.Context, args ...string) (reply, error) {
+	deadline := time.Now().Add(c.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	rc, err := c.conn(ctx, deadline)
+	if err != nil {
+		return reply{}, err
+	}
+	r, err := rc.do(deadline, args...)
+	if err != nil && !errors.Is(err, ErrRedis) {
+		rc.conn.Close()
+		return reply{}, err
+	}
+	select {
+	case c.idle <- rc:
+	default:
+		rc.conn.Close()
+	}
+	return r, err
+}
+
+// conn returns an idle connection, or dials a new one.
+func (c *Client) conn(ctx context.Context, deadline time.Time) (*redisConn, error) {
+	select {
+	case rc := <-c.idle:
+		return rc, nil
+	default:
+	}
+
+	d := net.Dialer{Deadline: deadline}
+	conn, err := d.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return nil, fmt.Errorf("redis: dial %s: %w", c.addr, err)
+	}
+	rc := &redisConn{conn: conn, br: bufio.NewReader(conn), bw: bufio.NewWriter(conn)}
+	if err := c.handshake(rc, deadline); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return rc, nil
+}
+
+// handshake authenticates the connection and selects the database.
+func (c *Client) handshake(rc *redisConn, deadline time.Time) error {
+	switch {
+	case c.username != "":
+		if _, err := rc.do(deadline, "AUTH", c.username, c.password); err != nil {
+			return fmt.Errorf("redis: auth: %w", err)
+		}
+	case c.password != "":
+		if _, err := rc.do(deadline, "AUTH", c.password); err != nil {
+			return fmt.Errorf("redis: auth: %w", err)
+		}
+	}
+	if c.db != 0 {
+		if _, err := rc.do(deadline, "SELECT", strconv.Itoa(c.db)); err != nil {
+			return fmt.Errorf("redis: select: %w", err)
+		}
+	}
+	return nil
+}
+
+// do writes a command as an array of bulk strings and reads its reply.
+func (rc *redisConn) do(deadline time.Time, args ...string) (reply, error) {
+	if err := rc.conn.SetDeadline(deadline); err != nil {
+		return reply{}, err
+	}
+	fmt.Fprintf(rc.bw, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(rc.bw, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if err := rc.bw.Flush(); err != nil {
+		return reply{}, fmt.Errorf("redis: write: %w", err)
+	}
+	return rc.read()
+}
+
+// read reads a reply. Array replies are not supported, as no command the client sends
+// returns one.
+func (rc *redisConn) read() (reply, error) {
+	line, err := rc.br.ReadString('\n')
+	if err != nil {
+		return reply{}, fmt.Errorf("redis: read: %w", err)
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return reply{}, errors.New("redis: empty reply")
+	}
+	switch kind, rest := line[0], line[1:]; kind {
+	case '+':
+		return reply{str: []byte(rest)}, nil
+	case '-':
+		return reply{}, fmt.Errorf("%w: %s", ErrRedis, rest)
+	case ':':
+		n, err := strconv.ParseInt(rest, 10, 64)
+		if err != nil {
+			return reply{}, fmt.Errorf("redis: invalid integer reply %q", rest)
+		}
+		return reply{n: n}, nil
+	case '$':
+		n, err := strconv.Atoi(rest)
+		if err != nil || n < -1 {
+			return reply{}, fmt.Errorf("redis: invalid bulk length %q", rest)
+		}
+		if n == -1 {
+			return reply{null: true}, nil
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(rc.br, buf); err != nil {
+			return reply{}, fmt.Errorf("redis: read: %w", err)
+		}
+		return reply{str: buf[:n]}, nil
+	default:
+		return reply{}, fmt.Errorf("redis: unsupported reply %q", line)
+	}
+}
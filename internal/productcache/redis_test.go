@@ -0,0 +1,214 @@
+package productcache
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis is a Redis server that answers the commands the client sends.
+type fakeRedis struct {
+	listener net.Listener
+	password string
+
+	mu       sync.Mutex
+	values   map[string]string
+	commands [][]string
+	dials    int
+}
+
+func newFakeRedis(t *testing.T, password string) *fakeRedis {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeRedis{listener: l, password: password, values: make(map[string]string)}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.dials++
+			s.mu.Unlock()
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeRedis) url() string {
+	return "redis://" + s.listener.Addr().String()
+}
+
+func (s *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	br := bufio.NewReader(conn)
+	authenticated := s.password == ""
+	for {
+		args, err := readCommand(br)
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.commands = append(s.commands, args)
+		var out string
+		switch cmd := strings.ToUpper(args[0]); {
+		case cmd == "AUTH":
+			authenticated = args[len(args)-1] == s.password
+			out = "+OK\r\n"
+			if !authenticated {
+				out = "-WRONGPASS invalid password\r\n"
+			}
+		case !authenticated:
+			out = "-NOAUTH Authentication required.\r\n"
+		case cmd == "SELECT":
+			out = "+OK\r\n"
+		case cmd == "GET":
+			if v, ok := s.values[args[1]]; ok {
+				out = fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+			} else {
+				out = "$-1\r\n"
+			}
+		case cmd == "SET":
+			s.values[args[1]] = args[2]
+			out = "+OK\r\n"
+		case cmd == "DEL":
+			n := 0
+			for _, key := range args[1:] {
+				if _, ok := s.values[key]; ok {
+					delete(s.values, key)
+					n++
+				}
+			}
+			out = fmt.Sprintf(":%d\r\n", n)
+		default:
+			out = "-ERR unknown command\r\n"
+		}
+		s.mu.Unlock()
+		if _, err := io.WriteString(conn, out); err != nil {
+			return
+		}
+	}
+}
+
+// readCommand reads a command sent as an array of bulk strings.
+func readCommand(br *bufio.Reader) ([]string, error) {
+	line, err := br.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "$")))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(br, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+	return args, nil
+}
+
+// command returns the i-th command received, counting from the end if i is negative.
+func (s *fakeRedis) command(i int) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i < 0 {
+		i += len(s.commands)
+	}
+	return s.commands[i]
+}
+
+func (s *fakeRedis) dialCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dials
+}
+
+func TestClient(t *testing.T) {
+	server := newFakeRedis(t, "")
+	c, err := NewClient(server.url(), time.Second)
+	require.NoError(t, err)
+	defer c.Close()
+	ctx := context.Background()
+
+	_, ok, err := c.Get(ctx, "catalog:product:p1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	value := []byte("{\"name\":\"Widget\r\n\"}")
+	require.NoError(t, c.Set(ctx, "catalog:product:p1", value, 90*time.Second))
+	assert.Equal(t, []string{"SET", "catalog:product:p1", string(value), "PX", "90000"}, server.command(-1))
+
+	got, ok, err := c.Get(ctx, "catalog:product:p1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, value, got)
+
+	require.NoError(t, c.Delete(ctx, "catalog:product:p1"))
+	_, ok, err = c.Get(ctx, "catalog:product:p1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.Equal(t, 1, server.dialCount(), "connection is reused")
+}
+
+func TestClient_AuthAndSelect(t *testing.T) {
+	server := newFakeRedis(t, "s3cret")
+	addr := server.listener.Addr().String()
+
+	c, err := NewClient("redis://:s3cret@"+addr+"/2", time.Second)
+	require.NoError(t, err)
+	require.NoError(t, c.Set(context.Background(), "k", []byte("v"), time.Minute))
+	assert.Equal(t, []string{"AUTH", "s3cret"}, server.command(0))
+	assert.Equal(t, []string{"SELECT", "2"}, server.command(1))
+
+	c, err = NewClient("redis://:wrong@"+addr, time.Second)
+	require.NoError(t, err)
+	_, _, err = c.Get(context.Background(), "k")
+	assert.ErrorIs(t, err, ErrRedis)
+}
+
+func TestClient_ErrorReplyKeepsConnection(t *testing.T) {
+	server := newFakeRedis(t, "")
+	c, err := NewClient(server.url(), time.Second)
+	require.NoError(t, err)
+
+	_, err = c.do(context.Background(), "FLUSHALL")
+	assert.ErrorIs(t, err, ErrRedis)
+	_, _, err = c.Get(context.Background(), "k")
+	require.NoError(t, err)
+	assert.Equal(t, 1, server.dialCount())
+}
+
+func TestNewClient_InvalidURL(t *testing.T) {
+	for _, rawURL := range []string{"http://localhost:6379", "redis://localhost/db", "redis://localhost/-1"} {
+		_, err := NewClient(rawURL, time.Second)
+		assert.Error(t, err, rawURL)
+	}
+}
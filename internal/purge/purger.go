@@ -0,0 +1,138 @@
+// Package purge permanently deletes products that have been archived for longer than the
+// retention period, so archived rows do not pile up against the data-retention policy.
+//
+// A Purger polls for such products in the background and deletes each through
+// usecase.ProductUseCases.PurgeProduct, which also removes its outbox history and records
+// a product.purged event. In dry-run mode it only logs what it would delete.
+package purge
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/logging"
+	"github.com/product-catalog-service/internal/usecase"
+)
+
+// Default purger settings.
+const (
+	DefaultRetention    = 365 * 24 * time.Hour
+	DefaultPollInterval = time.Hour
+	DefaultBatchSize    = 100
+)
+
+// Finder finds products archived before a cutoff. It is implemented by
+// repository.ProductRepo.
+type Finder interface {
+	FindPurgeable(ctx context.Context, archivedBefore time.Time, limit int) ([]string, error)
+}
+
+// ProductPurger deletes one archived product permanently. It is implemented by
+// usecase.ProductUseCases.
+type ProductPurger interface {
+	PurgeProduct(ctx context.Context, req usecase.PurgeProductRequest) error
+}
+
+// Options controls what a Purger deletes and how often it polls.
+type Options struct {
+	// Retention is how long a product stays archived before it is purged.
+	Retention time.Duration
+	// PollInterval is the delay between polls once no purgeable products are left.
+	PollInterval time.Duration
+	// BatchSize is the maximum number of products purged per poll.
+	BatchSize int
+	// DryRun logs the products that would be purged instead of purging them.
+	DryRun bool
+}
+
+// Stats summarizes a single purger pass.
+type Stats struct {
+	// Eligible is the number of products found archived for longer than the retention
+	// period.
+	Eligible int
+	// Purged is the number of products deleted; always 0 in dry-run mode.
+	Purged int
+	// Failed is the number of products that could not be deleted; they are retried on
+	// the next poll.
+	Failed int
+}
+
+// Purger polls for products archived for longer than the retention period and purges
+// them. Running several purgers is safe: a product purged by one is no longer found by
+// the others, and purging it again fails with domain.ErrProductNotFound.
+type Purger struct {
+	finder Finder
+	purger ProductPurger
+	clock  clock.Clock
+	opts   Options
+}
+
+// NewPurger creates a new Purger.
+func NewPurger(finder Finder, purger ProductPurger, clock clock.Clock, opts Options) *Purger {
+	if opts.Retention <= 0 {
+		opts.Retention = DefaultRetention
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	return &Purger{
+		finder: finder,
+		purger: purger,
+		clock:  clock,
+		opts:   opts,
+	}
+}
+
+// Run purges products until the context is cancelled.
+func (p *Purger) Run(ctx context.Context) error {
+	for {
+		stats, err := p.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			logging.Errorf("purger: %v", err)
+		}
+
+		// Keep draining while full batches make progress. A dry run never does, so it
+		// reports each product once per poll.
+		if err == nil && stats.Eligible == p.opts.BatchSize && stats.Purged > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(p.opts.PollInterval):
+		}
+	}
+}
+
+// RunOnce purges one batch of products archived before the retention cutoff. A product
+// that fails is logged and skipped; it is found again on the next pass.
+func (p *Purger) RunOnce(ctx context.Context) (Stats, error) {
+	cutoff := p.clock.Now().Add(-p.opts.Retention)
+	ids, err := p.finder.FindPurgeable(ctx, cutoff, p.opts.BatchSize)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	stats := Stats{Eligible: len(ids)}
+	for _, id := range ids {
+		if p.opts.DryRun {
+			logging.Infof("purger: dry run: would purge product %s", id)
+			continue
+		}
+		if err := p.purger.PurgeProduct(ctx, usecase.PurgeProductRequest{ProductID: id, ArchivedBefore: cutoff}); err != nil {
+			if ctx.Err() != nil {
+				return stats, ctx.Err()
+			}
+			logging.Errorf("purger: product %s: %v", id, err)
+			stats.Failed++
+			continue
+		}
+		stats.Purged++
+	}
+	return stats, nil
+}
@@ -0,0 +1,82 @@
+package purge
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFinder struct {
+	ids            []string
+	archivedBefore time.Time
+	limit          int
+	err            error
+}
+
+func (f *fakeFinder) FindPurgeable(_ context.Context, archivedBefore time.Time, limit int) ([]string, error) {
+	f.archivedBefore, f.limit = archivedBefore, limit
+	return f.ids, f.err
+}
+
+type fakeProductPurger struct {
+	failing map[string]bool
+	purged  []usecase.PurgeProductRequest
+}
+
+func (p *fakeProductPurger) PurgeProduct(_ context.Context, req usecase.PurgeProductRequest) error {
+	if p.failing[req.ProductID] {
+		return errors.New("transaction aborted")
+	}
+	p.purged = append(p.purged, req)
+	return nil
+}
+
+func TestPurger_RunOnce(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	cutoff := now.Add(-30 * 24 * time.Hour)
+	finder := &fakeFinder{ids: []string{"product-1", "product-2", "product-3"}}
+	purger := &fakeProductPurger{failing: map[string]bool{"product-2": true}}
+	p := NewPurger(finder, purger, clock.NewFixedClock(now), Options{Retention: 30 * 24 * time.Hour, BatchSize: 10})
+
+	stats, err := p.RunOnce(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, Stats{Eligible: 3, Purged: 2, Failed: 1}, stats)
+	assert.Equal(t, []usecase.PurgeProductRequest{
+		{ProductID: "product-1", ArchivedBefore: cutoff},
+		{ProductID: "product-3", ArchivedBefore: cutoff},
+	}, purger.purged)
+	assert.Equal(t, cutoff, finder.archivedBefore)
+	assert.Equal(t, 10, finder.limit)
+}
+
+func TestPurger_RunOnceDryRun(t *testing.T) {
+	finder := &fakeFinder{ids: []string{"product-1", "product-2"}}
+	purger := &fakeProductPurger{}
+	p := NewPurger(finder, purger, clock.NewFixedClock(time.Now()), Options{DryRun: true})
+
+	stats, err := p.RunOnce(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, Stats{Eligible: 2}, stats)
+	assert.Empty(t, purger.purged)
+}
+
+func TestPurger_RunOnceFinderError(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	finder := &fakeFinder{err: errors.New("spanner unavailable")}
+	purger := &fakeProductPurger{}
+	p := NewPurger(finder, purger, clock.NewFixedClock(now), Options{})
+
+	_, err := p.RunOnce(context.Background())
+	assert.Error(t, err)
+	assert.Empty(t, purger.purged)
+	assert.Equal(t, now.Add(-DefaultRetention), finder.archivedBefore)
+	assert.Equal(t, DefaultBatchSize, finder.limit)
+}
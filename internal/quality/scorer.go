@@ -0,0 +1,109 @@
+// Package quality keeps the quality scores of products, which rate how complete their
+// content is (see domain.QualityPolicy), up to date for the admin listings.
+//
+// Scorer consumes the outbox: for every event that changes the content of a product it
+// reads the product back and rescores it, or deletes its score once it is archived or
+// purged. A product is scored against the attribute schema its category has when it is
+// rescored, so changing a schema, or the locales products should be translated to, takes
+// effect as products change; `catalogctl score-quality` rescores them all at once.
+package quality
+
+import (
+	"context"
+	"errors"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/outbox"
+)
+
+// contentEvents are the types of the events that change what a product is scored on, or
+// whether it is scored.
+var contentEvents = map[string]bool{
+	"product.created":             true,
+	"product.updated":             true,
+	"product.attribute_changed":   true,
+	"product.images_changed":      true,
+	"product.translation_changed": true,
+	"product.archived":            true,
+	"product.purged":              true,
+}
+
+// Scorer scores products and stores their scores. It is an outbox.Publisher, so the
+// outbox dispatcher delivers it every event, in order per product and retried until it
+// succeeds. Like searchindex.Indexer, it scores the product as read back from the
+// catalog, which makes scoring idempotent.
+type Scorer struct {
+	readModel contract.ProductReadModel
+	schemas   contract.AttributeSchemaRepository
+	scores    contract.QualityScoreRepository
+	policy    *domain.QualityPolicy
+	clock     clock.Clock
+}
+
+var _ outbox.Publisher = (*Scorer)(nil)
+
+// NewScorer creates a Scorer that reads products from readModel and the attribute schemas
+// of their categories from schemas, scores them under policy and stores their scores in
+// scores. A nil schemas scores every product as of a category without a schema.
+func NewScorer(readModel contract.ProductReadModel, schemas contract.AttributeSchemaRepository,
+	scores contract.QualityScoreRepository, policy *domain.QualityPolicy, clk clock.Clock) *Scorer {
+	return &Scorer{readModel: readModel, schemas: schemas, scores: scores, policy: policy, clock: clk}
+}
+
+// Publish rescores the product of an event that changes its content. Other events are
+// ignored.
+func (s *Scorer) Publish(ctx context.Context, msg *outbox.Message) error {
+	if !contentEvents[msg.EventType] {
+		return nil
+	}
+	return s.Score(ctx, msg.AggregateID, msg.TenantID)
+}
+
+// Score scores the product with the given ID, of the given tenant, and stores its score,
+// or deletes the score of a product that is archived or no longer exists.
+func (s *Scorer) Score(ctx context.Context, productID, tenantID string) error {
+	// ctx carries no caller, so the read sees the products of every tenant.
+	product, err := s.readModel.GetProduct(ctx, productID, s.clock.Now())
+	if errors.Is(err, domain.ErrProductNotFound) {
+		return s.scores.Delete(ctx, productID)
+	}
+	if err != nil {
+		return err
+	}
+	if product.Status == string(domain.ProductStatusArchived) {
+		return s.scores.Delete(ctx, productID)
+	}
+
+	var schema *domain.CategoryAttributeSchema
+	if s.schemas != nil {
+		if schema, err = s.schemas.Find(ctx, product.Category); err != nil {
+			return err
+		}
+	}
+	locales := make([]string, len(product.Translations))
+	for i, t := range product.Translations {
+		locales[i] = t.Locale
+	}
+	score := s.policy.Score(domain.ProductContent{
+		Description: product.Description,
+		ImageCount:  len(product.Images),
+		Attributes:  product.Attributes,
+		Schema:      schema,
+		Locales:     locales,
+	})
+
+	return s.scores.Save(ctx, &contract.ProductQualityDTO{
+		ProductID: product.ID,
+		TenantID:  tenantID,
+		Name:      product.Name,
+		Category:  product.Category,
+		Score:     score,
+	})
+}
+
+// Close is a no-op.
+func (s *Scorer) Close() error {
+	return nil
+}
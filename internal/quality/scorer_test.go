@@ -0,0 +1,103 @@
+package quality
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/outbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// productsReadModel serves products from a map.
+type productsReadModel struct {
+	contract.ProductReadModel
+	products map[string]*contract.ProductDTO
+}
+
+func (rm *productsReadModel) GetProduct(_ context.Context, id string, _ time.Time) (*contract.ProductDTO, error) {
+	product, ok := rm.products[id]
+	if !ok {
+		return nil, domain.ErrProductNotFound
+	}
+	return product, nil
+}
+
+// schemaRepo serves attribute schemas from a map.
+type schemaRepo struct {
+	contract.AttributeSchemaRepository
+	schemas map[string]*domain.CategoryAttributeSchema
+}
+
+func (r *schemaRepo) Find(_ context.Context, category string) (*domain.CategoryAttributeSchema, error) {
+	return r.schemas[category], nil
+}
+
+// scoreRepo keeps quality scores in a map.
+type scoreRepo struct {
+	contract.QualityScoreRepository
+	scores map[string]*contract.ProductQualityDTO
+}
+
+func (r *scoreRepo) Save(_ context.Context, quality *contract.ProductQualityDTO) error {
+	r.scores[quality.ProductID] = quality
+	return nil
+}
+
+func (r *scoreRepo) Delete(_ context.Context, productID string) error {
+	delete(r.scores, productID)
+	return nil
+}
+
+func TestScorer_Publish(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	color, err := domain.NewAttributeDefinition("color", domain.AttributeTypeString, true, nil)
+	require.NoError(t, err)
+	size, err := domain.NewAttributeDefinition("size", domain.AttributeTypeString, false, nil)
+	require.NoError(t, err)
+	schema, err := domain.NewCategoryAttributeSchema("Furniture", []*domain.AttributeDefinition{color, size})
+	require.NoError(t, err)
+	policy, err := domain.ParseQualityPolicy("de,fr")
+	require.NoError(t, err)
+
+	readModel := &productsReadModel{products: map[string]*contract.ProductDTO{
+		"product-1": {
+			ID: "product-1", Name: "Oak Chair", Category: "Furniture", Status: "active",
+			Description:  strings.Repeat("x", domain.QualityDescriptionLength),
+			Images:       []contract.ImageDTO{{URL: "https://cdn.example.com/chair.jpg"}},
+			Attributes:   map[string]string{"color": "oak"},
+			Translations: []contract.TranslationDTO{{Locale: "de", Name: "Eichenstuhl"}},
+		},
+	}}
+	scores := &scoreRepo{scores: map[string]*contract.ProductQualityDTO{}}
+	scorer := NewScorer(readModel, &schemaRepo{schemas: map[string]*domain.CategoryAttributeSchema{"Furniture": schema}},
+		scores, policy, clock.NewFixedClock(now))
+	ctx := context.Background()
+
+	require.NoError(t, scorer.Publish(ctx, &outbox.Message{EventType: "product.created", AggregateID: "product-1", TenantID: "acme"}))
+	translations := 50
+	assert.Equal(t, &contract.ProductQualityDTO{
+		ProductID: "product-1", TenantID: "acme", Name: "Oak Chair", Category: "Furniture",
+		Score: domain.QualityScore{Overall: 58, Description: 100, Images: 33, Attributes: 50, Translations: &translations},
+	}, scores.scores["product-1"])
+
+	readModel.products["product-1"].Description = ""
+	require.NoError(t, scorer.Publish(ctx, &outbox.Message{EventType: "product.price_changed", AggregateID: "product-1"}))
+	assert.Equal(t, 100, scores.scores["product-1"].Score.Description, "events that change no content are ignored")
+
+	require.NoError(t, scorer.Publish(ctx, &outbox.Message{EventType: "product.updated", AggregateID: "product-1", TenantID: "acme"}))
+	assert.Equal(t, 0, scores.scores["product-1"].Score.Description)
+
+	readModel.products["product-1"].Status = "archived"
+	require.NoError(t, scorer.Publish(ctx, &outbox.Message{EventType: "product.archived", AggregateID: "product-1"}))
+	assert.Empty(t, scores.scores, "archived products are not scored")
+
+	delete(readModel.products, "product-1")
+	require.NoError(t, scorer.Publish(ctx, &outbox.Message{EventType: "product.purged", AggregateID: "product-1"}))
+	assert.Empty(t, scores.scores)
+}
@@ -0,0 +1,85 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// MaxBatchGetProductIDs is the maximum number of products read by one BatchGetProducts
+// call: as many as one GetEffectivePrices call prices.
+const MaxBatchGetProductIDs = MaxEffectivePriceIDs
+
+// BatchGetProductsRequest represents the input for getting several products at once, e.g.
+// to render a cart. The other fields apply to every product as in GetProductRequest.
+type BatchGetProductsRequest struct {
+	ProductIDs []string
+	Currency   string
+	Experiment ExperimentContext
+	Segment    string
+	Market     string
+	PriceAt    time.Time
+	Locale     string
+}
+
+// BatchGetProductsResponse partitions the requested products into those found and the
+// IDs of those missing.
+type BatchGetProductsResponse struct {
+	// Products are in request order, one per distinct existing product.
+	Products          []*ProductResponse
+	MissingProductIDs []string
+}
+
+// BatchGetProducts retrieves several products, each as GetProduct retrieves it, in a
+// single read. Duplicate IDs are read once; IDs without a product are reported as
+// missing. It fails if any product cannot be presented as requested, e.g. priced in the
+// currency.
+func (q *ProductQueries) BatchGetProducts(ctx context.Context, req BatchGetProductsRequest) (*BatchGetProductsResponse, error) {
+	ids := uniqueIDs(req.ProductIDs)
+	if len(ids) == 0 {
+		return nil, domain.ErrInvalidID
+	}
+	for _, id := range ids {
+		if id == "" {
+			return nil, domain.ErrInvalidID
+		}
+	}
+	view, err := newProductView(req.Currency, req.Segment, req.Market, req.Locale, req.Experiment)
+	if err != nil {
+		return nil, err
+	}
+
+	now := q.clock.Now()
+	at, err := pricingTime(req.PriceAt, now)
+	if err != nil {
+		return nil, err
+	}
+	dtos, err := q.readModel.BatchGetProducts(ctx, ids, at)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*contract.ProductDTO, len(dtos))
+	for _, dto := range dtos {
+		byID[dto.ID] = dto
+	}
+	resp := &BatchGetProductsResponse{Products: make([]*ProductResponse, 0, len(dtos))}
+	var exposures []contract.Exposure
+	for _, id := range ids {
+		dto, ok := byID[id]
+		if !ok {
+			resp.MissingProductIDs = append(resp.MissingProductIDs, id)
+			continue
+		}
+		product, exposed, err := q.presentProduct(dto, view, SurfaceBatchGetProducts, now)
+		if err != nil {
+			return nil, err
+		}
+		resp.Products = append(resp.Products, product)
+		exposures = append(exposures, exposed...)
+	}
+	q.recordExposures(ctx, exposures)
+	return resp, nil
+}
@@ -0,0 +1,80 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// batchReadModel serves BatchGetProducts from a fixed set of products, in reverse order,
+// and records the IDs of the last read.
+type batchReadModel struct {
+	contract.ProductReadModel
+	products []*contract.ProductDTO
+	ids      []string
+}
+
+func (rm *batchReadModel) BatchGetProducts(_ context.Context, ids []string, _ time.Time) ([]*contract.ProductDTO, error) {
+	rm.ids = ids
+	var found []*contract.ProductDTO
+	for i := len(rm.products) - 1; i >= 0; i-- {
+		for _, id := range ids {
+			if rm.products[i].ID == id {
+				found = append(found, rm.products[i])
+			}
+		}
+	}
+	return found, nil
+}
+
+func TestProductQueries_BatchGetProducts(t *testing.T) {
+	gadget := widgetDTO()
+	gadget.ID = "product-2"
+	gadget.Translations = []contract.TranslationDTO{{Locale: "de", Name: "Gerät"}}
+	readModel := &batchReadModel{products: []*contract.ProductDTO{widgetDTO(), gadget}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(time.Now()))
+
+	resp, err := q.BatchGetProducts(context.Background(), BatchGetProductsRequest{
+		ProductIDs: []string{"product-2", "missing-1", "product-1", "product-2"},
+		Currency:   "EUR",
+		Locale:     "de",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"product-2", "missing-1", "product-1"}, readModel.ids, "duplicates are read once")
+	require.Len(t, resp.Products, 2)
+	assert.Equal(t, "product-2", resp.Products[0].ID, "products are in request order")
+	assert.Equal(t, "Gerät", resp.Products[0].Name)
+	assert.Equal(t, "product-1", resp.Products[1].ID)
+	for _, p := range resp.Products {
+		assert.Equal(t, "EUR", p.Currency)
+		assert.Equal(t, []int64{19, 1}, []int64{p.BasePriceNumerator, p.BasePriceDenominator}, "priced from the price book")
+	}
+	assert.Equal(t, []string{"missing-1"}, resp.MissingProductIDs)
+}
+
+func TestProductQueries_BatchGetProducts_Invalid(t *testing.T) {
+	q := NewProductQueries(&batchReadModel{}, clock.NewFixedClock(time.Now()))
+
+	tests := []struct {
+		name    string
+		req     BatchGetProductsRequest
+		wantErr error
+	}{
+		{"no ids", BatchGetProductsRequest{}, domain.ErrInvalidID},
+		{"empty id", BatchGetProductsRequest{ProductIDs: []string{"product-1", ""}}, domain.ErrInvalidID},
+		{"invalid currency", BatchGetProductsRequest{ProductIDs: []string{"product-1"}, Currency: "dollars"}, domain.ErrInvalidCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := q.BatchGetProducts(context.Background(), tt.req)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
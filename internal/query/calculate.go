@@ -0,0 +1,292 @@
+package query
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// CalculatePriceRequest represents the input for a price preview. It prices either a
+// product, with its price tiers, discounts and segment prices, or an inline base price.
+type CalculatePriceRequest struct {
+	// ProductID is the product to price; empty prices the inline base price instead.
+	ProductID string
+	// BasePriceNumerator, BasePriceDenominator and Currency are the inline base price,
+	// used only without a product ID. Currency defaults to USD.
+	BasePriceNumerator   int64
+	BasePriceDenominator int64
+	Currency             string
+	// Quantity is the number of units; 0 means 1.
+	Quantity int64
+	// CouponPercent is taken off the price left by the product's discount; 0 means no
+	// coupon.
+	CouponPercent float64
+	// Segment prices the product for a customer segment; empty or a segment the product
+	// has no price for means its regular prices.
+	Segment string
+	// At is the pricing time; the zero value means now.
+	At time.Time
+	// SuggestCharmPrice asks for the charm price nearest to the unit price, i.e. to the
+	// price left by the coupon as a target discount.
+	SuggestCharmPrice bool
+	// TargetMarginPercent, if above 0, asks for the charm price nearest to the price
+	// earning that margin over the product's cost price instead; it must be below 100.
+	TargetMarginPercent float64
+}
+
+// CalculatePriceResponse represents a price preview. The base price is the unit price
+// before tiers, discounts and the coupon; the discount amount is what the discount and
+// the coupon take off the total, and the savings what the customer saves over paying the
+// base price for every unit. The prices are exact; the display fields are rounded.
+type CalculatePriceResponse struct {
+	ProductID                 string
+	Quantity                  int64
+	BasePriceNumerator        int64
+	BasePriceDenominator      int64
+	UnitPriceNumerator        int64
+	UnitPriceDenominator      int64
+	TotalPriceNumerator       int64
+	TotalPriceDenominator     int64
+	DiscountAmountNumerator   int64
+	DiscountAmountDenominator int64
+	SavingsNumerator          int64
+	SavingsDenominator        int64
+	BasePriceDisplay          string
+	UnitPriceDisplay          string
+	TotalPriceDisplay         string
+	DiscountAmountDisplay     string
+	SavingsDisplay            string
+	// TierMinQuantity is the minimum quantity of the tier applied, or 0 if none applied.
+	TierMinQuantity int64
+	// DiscountPercent is the percentage of the product's discount applied, or 0 if none.
+	DiscountPercent float64
+	CouponPercent   float64
+	// Segment is set to the requested segment when the product has a price for it.
+	Segment  string
+	Currency string
+	// Status is the status of the product; empty for an inline base price.
+	Status string
+	// CharmPriceNumerator and CharmPriceDenominator are the suggested charm price (see
+	// domain.NearestCharmPrice); both are zero unless one was asked for.
+	CharmPriceNumerator   int64
+	CharmPriceDenominator int64
+	CharmPriceDisplay     string
+}
+
+// CalculatePrice previews the price of a quantity of a product, or of an inline base
+// price, at the requested time without changing anything. The tier for the quantity sets
+// the unit price, the product's discount is taken off it and the coupon then off what is
+// left. Under a discount composition policy (see WithDiscountComposition), the discount is
+// that of every discount of the product valid at the time combined, and the total with
+// the coupon is capped by the policy.
+func (q *ProductQueries) CalculatePrice(ctx context.Context, req CalculatePriceRequest) (*CalculatePriceResponse, error) {
+	quantity := req.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity < 1 || quantity > domain.MaxPricedQuantity {
+		return nil, domain.ErrInvalidQuantity
+	}
+	coupon := domain.PercentageFromFloat(req.CouponPercent)
+	if coupon == nil || coupon.Sign() < 0 || coupon.Cmp(big.NewRat(100, 1)) > 0 {
+		return nil, domain.ErrInvalidDiscountPercentage
+	}
+	segment, err := requestSegment(req.Segment)
+	if err != nil {
+		return nil, err
+	}
+
+	at := req.At
+	if at.IsZero() {
+		at = q.clock.Now()
+	}
+
+	resp := &CalculatePriceResponse{
+		ProductID:     req.ProductID,
+		Quantity:      quantity,
+		CouponPercent: req.CouponPercent,
+	}
+	var (
+		base      *domain.Money
+		cost      *domain.Money
+		tier      *domain.PriceTier
+		discount  *big.Rat
+		discounts []*big.Rat
+		salePrice *domain.Money
+		category  string
+	)
+	if req.ProductID == "" {
+		if req.BasePriceNumerator <= 0 || req.BasePriceDenominator <= 0 {
+			return nil, domain.ErrInvalidBasePrice
+		}
+		currency := req.Currency
+		if currency == "" {
+			currency = domain.DefaultCurrency
+		}
+		base, err = domain.NewMoneyInCurrency(req.BasePriceNumerator, req.BasePriceDenominator, currency)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		dto, err := q.readModel.GetProduct(ctx, req.ProductID, at)
+		if err != nil {
+			return nil, err
+		}
+		dto, inSegmentPrice := inSegment(dto, segment)
+		if inSegmentPrice {
+			resp.Segment = segment
+		}
+		resp.Status = dto.Status
+
+		base, err = domain.NewMoneyInCurrency(dto.BasePriceNum, dto.BasePriceDenom, dto.Currency)
+		if err != nil {
+			return nil, err
+		}
+		if dto.CostPriceDenom != 0 {
+			if cost, err = domain.NewMoneyInCurrency(dto.CostPriceNum, dto.CostPriceDenom, dto.Currency); err != nil {
+				return nil, err
+			}
+		}
+		tiers, err := priceTiers(dto.PriceTiers, dto.Currency)
+		if err != nil {
+			return nil, err
+		}
+		tier = domain.PriceTierFor(tiers, quantity)
+		category = dto.Category
+		switch {
+		case !q.composition.IsDefault():
+			if discounts = applicableDiscountPercents(dto.Discounts, at); len(discounts) > 0 {
+				discount = q.composition.Combine(category, discounts)
+				resp.DiscountPercent, _ = discount.Float64()
+			}
+		case dto.HasActiveDiscount && dto.DiscountPercent != nil:
+			discount = domain.PercentageFromFloat(*dto.DiscountPercent)
+			resp.DiscountPercent = *dto.DiscountPercent
+			if applicable := applicableDiscounts(dto.Discounts, at); len(applicable) > 0 && applicable[0].SalePriceDenom != 0 {
+				sale := applicable[0]
+				if salePrice, err = domain.NewMoneyInCurrency(sale.SalePriceNum, sale.SalePriceDenom, dto.Currency); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	calculator := domain.NewPricingCalculator().WithComposition(q.composition)
+	listPrice := tier.ApplyTo(base)
+	discounted := calculator.CalculateDiscountedPrice(listPrice, discount)
+	if salePrice != nil {
+		// A sale price replaces the prices above it exactly, as in Discount.ApplyTo,
+		// rather than by its rounded implied percentage.
+		discounted = listPrice
+		if salePrice.LessThan(listPrice) {
+			discounted = salePrice
+		}
+	}
+	unitPrice := calculator.CalculateDiscountedPrice(discounted, coupon)
+	if !q.composition.IsDefault() {
+		unitPrice = calculator.CalculateDiscountedPrice(listPrice, calculator.CalculateCouponDiscount(category, discounts, coupon))
+	}
+
+	units := big.NewRat(quantity, 1)
+	total := unitPrice.Multiply(units)
+	discountAmount, err := listPrice.Sub(unitPrice)
+	if err != nil {
+		return nil, err
+	}
+	discountAmount = discountAmount.Multiply(units)
+	savings, err := base.Multiply(units).Sub(total)
+	if err != nil {
+		return nil, err
+	}
+
+	var charm *domain.Money
+	switch {
+	case req.TargetMarginPercent != 0:
+		charm, err = calculator.SuggestCharmPriceForMargin(cost, domain.PercentageFromFloat(req.TargetMarginPercent))
+	case req.SuggestCharmPrice:
+		charm, err = calculator.SuggestCharmPriceForDiscount(discounted, coupon)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if tier != nil {
+		resp.TierMinQuantity = tier.MinQuantity()
+	}
+	if charm != nil {
+		resp.CharmPriceNumerator, resp.CharmPriceDenominator = charm.Numerator(), charm.Denominator()
+	}
+	resp.BasePriceNumerator, resp.BasePriceDenominator = base.Numerator(), base.Denominator()
+	resp.UnitPriceNumerator, resp.UnitPriceDenominator = unitPrice.Numerator(), unitPrice.Denominator()
+	resp.TotalPriceNumerator, resp.TotalPriceDenominator = total.Numerator(), total.Denominator()
+	resp.DiscountAmountNumerator, resp.DiscountAmountDenominator = discountAmount.Numerator(), discountAmount.Denominator()
+	resp.SavingsNumerator, resp.SavingsDenominator = savings.Numerator(), savings.Denominator()
+	resp.Currency = base.Currency()
+	q.roundCalculatedPrice(resp)
+	return resp, nil
+}
+
+// applicableDiscountPercents returns the percentages of the discounts valid at t that
+// apply to the base price, the one that takes precedence first, as
+// domain.ApplicableDiscounts orders them.
+func applicableDiscountPercents(dtos []contract.DiscountDTO, t time.Time) []*big.Rat {
+	applicable := applicableDiscounts(dtos, t)
+	percents := make([]*big.Rat, len(applicable))
+	for i, d := range applicable {
+		percents[i] = domain.PercentageFromFloat(d.Percent)
+	}
+	return percents
+}
+
+// applicableDiscounts returns the discounts valid at t that apply to the base price, the
+// one that takes precedence first.
+func applicableDiscounts(dtos []contract.DiscountDTO, t time.Time) []contract.DiscountDTO {
+	var applicable []contract.DiscountDTO
+	for _, d := range dtos {
+		if d.Kind != "" && d.Kind != string(domain.DiscountKindPercentage) || d.Market != "" ||
+			d.Suspended || d.PendingApproval || t.Before(d.StartDate) || !t.Before(d.EndDate) {
+			continue
+		}
+		applicable = append(applicable, d)
+	}
+	sort.SliceStable(applicable, func(i, j int) bool {
+		a, b := applicable[i], applicable[j]
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		if !a.StartDate.Equal(b.StartDate) {
+			return a.StartDate.After(b.StartDate)
+		}
+		return a.ID > b.ID
+	})
+	return applicable
+}
+
+// priceTiers converts the price tiers of a product for read operations, whose unit prices
+// are in currency, to domain tiers.
+func priceTiers(dtos []contract.PriceTierDTO, currency string) ([]*domain.PriceTier, error) {
+	tiers := make([]*domain.PriceTier, 0, len(dtos))
+	for _, t := range dtos {
+		var (
+			tier *domain.PriceTier
+			err  error
+		)
+		if t.UnitPriceDenom != 0 {
+			var price *domain.Money
+			if price, err = domain.NewMoneyInCurrency(t.UnitPriceNum, t.UnitPriceDenom, currency); err == nil {
+				tier, err = domain.NewUnitPriceTier(t.MinQuantity, price)
+			}
+		} else {
+			tier, err = domain.NewPercentOffTier(t.MinQuantity, domain.PercentageFromFloat(t.PercentOff))
+		}
+		if err != nil {
+			return nil, err
+		}
+		tiers = append(tiers, tier)
+	}
+	return tiers, nil
+}
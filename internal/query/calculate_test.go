@@ -0,0 +1,255 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductQueries_CalculatePrice(t *testing.T) {
+	percent := 20.0
+	product := &contract.ProductDTO{
+		ID:                  "product-1",
+		BasePriceNum:        2000,
+		BasePriceDenom:      100,
+		EffectivePriceNum:   1600,
+		EffectivePriceDenom: 100,
+		Currency:            "EUR",
+		Status:              "active",
+		DiscountPercent:     &percent,
+		HasActiveDiscount:   true,
+		PriceTiers: []contract.PriceTierDTO{
+			{MinQuantity: 10, PercentOff: 10},
+			{MinQuantity: 100, UnitPriceNum: 15, UnitPriceDenom: 1},
+		},
+		SegmentPrices: []contract.SegmentPriceDTO{{Segment: "wholesale", PercentOff: 50}},
+	}
+
+	tests := []struct {
+		name                          string
+		req                           CalculatePriceRequest
+		wantBase, wantUnit, wantTotal string
+		wantOff, wantSave             string
+		wantTier                      int64
+		wantSegment                   string
+	}{
+		{
+			name:     "one unit",
+			req:      CalculatePriceRequest{ProductID: "product-1"},
+			wantBase: "20.00", wantUnit: "16.00", wantTotal: "16.00", wantOff: "4.00", wantSave: "4.00",
+		},
+		{
+			name:     "percent off tier",
+			req:      CalculatePriceRequest{ProductID: "product-1", Quantity: 10},
+			wantBase: "20.00", wantUnit: "14.40", wantTotal: "144.00", wantOff: "36.00", wantSave: "56.00", wantTier: 10,
+		},
+		{
+			name:     "unit price tier with coupon",
+			req:      CalculatePriceRequest{ProductID: "product-1", Quantity: 100, CouponPercent: 50},
+			wantBase: "20.00", wantUnit: "6.00", wantTotal: "600.00", wantOff: "900.00", wantSave: "1400.00", wantTier: 100,
+		},
+		{
+			name:     "segment",
+			req:      CalculatePriceRequest{ProductID: "product-1", Segment: "wholesale"},
+			wantBase: "10.00", wantUnit: "8.00", wantTotal: "8.00", wantOff: "2.00", wantSave: "2.00", wantSegment: "wholesale",
+		},
+		{
+			name:     "segment without a price",
+			req:      CalculatePriceRequest{ProductID: "product-1", Segment: "retail"},
+			wantBase: "20.00", wantUnit: "16.00", wantTotal: "16.00", wantOff: "4.00", wantSave: "4.00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewProductQueries(&productReadModel{product: product}, clock.NewFixedClock(time.Now()))
+
+			resp, err := q.CalculatePrice(context.Background(), tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBase, resp.BasePriceDisplay)
+			assert.Equal(t, tt.wantUnit, resp.UnitPriceDisplay)
+			assert.Equal(t, tt.wantTotal, resp.TotalPriceDisplay)
+			assert.Equal(t, tt.wantOff, resp.DiscountAmountDisplay)
+			assert.Equal(t, tt.wantSave, resp.SavingsDisplay)
+			assert.Equal(t, tt.wantTier, resp.TierMinQuantity)
+			assert.Equal(t, tt.wantSegment, resp.Segment)
+			assert.InDelta(t, 20, resp.DiscountPercent, 1e-9)
+			assert.Equal(t, "EUR", resp.Currency)
+			assert.Equal(t, "active", resp.Status)
+		})
+	}
+}
+
+func TestProductQueries_CalculatePrice_InlineBasePrice(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	q := NewProductQueries(&productReadModel{}, clock.NewFixedClock(now))
+
+	resp, err := q.CalculatePrice(context.Background(), CalculatePriceRequest{
+		BasePriceNumerator:   1999,
+		BasePriceDenominator: 100,
+		Quantity:             3,
+		CouponPercent:        10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.ProductID)
+	assert.Equal(t, int64(3), resp.Quantity)
+	assert.True(t, domain.NewMoney(resp.UnitPriceNumerator, resp.UnitPriceDenominator).Equals(domain.NewMoney(17991, 1000)))
+	assert.True(t, domain.NewMoney(resp.TotalPriceNumerator, resp.TotalPriceDenominator).Equals(domain.NewMoney(53973, 1000)))
+	assert.True(t, domain.NewMoney(resp.DiscountAmountNumerator, resp.DiscountAmountDenominator).Equals(domain.NewMoney(5997, 1000)))
+	assert.Equal(t, resp.DiscountAmountDisplay, resp.SavingsDisplay)
+	assert.Zero(t, resp.DiscountPercent)
+	assert.Equal(t, domain.DefaultCurrency, resp.Currency)
+	assert.Empty(t, resp.Status)
+}
+
+func TestProductQueries_CalculatePrice_Composition(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	percent := 20.0
+	product := &contract.ProductDTO{
+		ID:                  "product-1",
+		Category:            "Electronics",
+		BasePriceNum:        2000,
+		BasePriceDenom:      100,
+		EffectivePriceNum:   1600,
+		EffectivePriceDenom: 100,
+		Currency:            "EUR",
+		DiscountPercent:     &percent,
+		HasActiveDiscount:   true,
+		Discounts: []contract.DiscountDTO{
+			{ID: "campaign", Percent: 10, StartDate: now.Add(-time.Hour), EndDate: now.Add(time.Hour)},
+			{ID: "sale", Percent: 20, Priority: 10, StartDate: now.Add(-time.Hour), EndDate: now.Add(time.Hour)},
+			{ID: "expired", Percent: 50, StartDate: now.Add(-2 * time.Hour), EndDate: now.Add(-time.Hour)},
+			{ID: "pending", Percent: 50, PendingApproval: true, StartDate: now.Add(-time.Hour), EndDate: now.Add(time.Hour)},
+		},
+	}
+	capped, err := domain.ParseDiscountComposition("additive", "Electronics=40")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		composition *domain.DiscountComposition
+		coupon      float64
+		wantUnit    string
+		wantPercent float64
+	}{
+		{name: "default", coupon: 50, wantUnit: "8.00", wantPercent: 20},
+		{name: "additive", composition: domain.NewDiscountComposition(domain.CompositionAdditive), wantUnit: "14.00", wantPercent: 30},
+		{name: "multiplicative", composition: domain.NewDiscountComposition(domain.CompositionMultiplicative), wantUnit: "14.40", wantPercent: 28},
+		{name: "capped with the coupon", composition: capped, coupon: 50, wantUnit: "12.00", wantPercent: 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewProductQueries(&productReadModel{product: product}, clock.NewFixedClock(now),
+				WithDiscountComposition(tt.composition))
+
+			resp, err := q.CalculatePrice(context.Background(), CalculatePriceRequest{ProductID: "product-1", CouponPercent: tt.coupon})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUnit, resp.UnitPriceDisplay)
+			assert.InDelta(t, tt.wantPercent, resp.DiscountPercent, 1e-9)
+		})
+	}
+}
+
+func TestProductQueries_CalculatePrice_SalePrice(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	percent := 33.34
+	product := &contract.ProductDTO{
+		ID:                  "product-1",
+		BasePriceNum:        2999,
+		BasePriceDenom:      100,
+		EffectivePriceNum:   1999,
+		EffectivePriceDenom: 100,
+		Currency:            "USD",
+		DiscountPercent:     &percent,
+		HasActiveDiscount:   true,
+		Discounts: []contract.DiscountDTO{{
+			ID: "sale", Percent: percent, SalePriceNum: 1999, SalePriceDenom: 100,
+			StartDate: now.Add(-time.Hour), EndDate: now.Add(time.Hour),
+		}},
+	}
+	q := NewProductQueries(&productReadModel{product: product}, clock.NewFixedClock(now))
+
+	resp, err := q.CalculatePrice(context.Background(), CalculatePriceRequest{ProductID: "product-1", Quantity: 3})
+	require.NoError(t, err)
+	assert.True(t, domain.NewMoney(resp.UnitPriceNumerator, resp.UnitPriceDenominator).Equals(domain.NewMoney(1999, 100)))
+	assert.True(t, domain.NewMoney(resp.TotalPriceNumerator, resp.TotalPriceDenominator).Equals(domain.NewMoney(5997, 100)))
+	assert.True(t, domain.NewMoney(resp.DiscountAmountNumerator, resp.DiscountAmountDenominator).Equals(domain.NewMoney(3000, 100)))
+	assert.InDelta(t, percent, resp.DiscountPercent, 1e-9)
+
+	resp, err = q.CalculatePrice(context.Background(), CalculatePriceRequest{ProductID: "product-1", CouponPercent: 50})
+	require.NoError(t, err)
+	assert.True(t, domain.NewMoney(resp.UnitPriceNumerator, resp.UnitPriceDenominator).Equals(domain.NewMoney(1999, 200)))
+}
+
+func TestProductQueries_CalculatePrice_CharmPrice(t *testing.T) {
+	percent := 20.0
+	product := &contract.ProductDTO{
+		ID:                "product-1",
+		BasePriceNum:      2000,
+		BasePriceDenom:    100,
+		CostPriceNum:      1200,
+		CostPriceDenom:    100,
+		Currency:          "EUR",
+		DiscountPercent:   &percent,
+		HasActiveDiscount: true,
+	}
+	q := NewProductQueries(&productReadModel{product: product}, clock.NewFixedClock(time.Now()))
+
+	tests := []struct {
+		name string
+		req  CalculatePriceRequest
+		want string
+	}{
+		{"not asked for", CalculatePriceRequest{ProductID: "product-1"}, ""},
+		{"unit price", CalculatePriceRequest{ProductID: "product-1", SuggestCharmPrice: true}, "15.99"},
+		{"coupon", CalculatePriceRequest{ProductID: "product-1", CouponPercent: 10, SuggestCharmPrice: true}, "13.99"},
+		{"target margin", CalculatePriceRequest{ProductID: "product-1", TargetMarginPercent: 40}, "19.99"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := q.CalculatePrice(context.Background(), tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp.CharmPriceDisplay)
+			if tt.want == "" {
+				assert.Zero(t, resp.CharmPriceDenominator)
+			}
+		})
+	}
+
+	_, err := q.CalculatePrice(context.Background(), CalculatePriceRequest{ProductID: "product-1", TargetMarginPercent: 100})
+	assert.ErrorIs(t, err, domain.ErrInvalidTargetMargin)
+	_, err = q.CalculatePrice(context.Background(), CalculatePriceRequest{
+		BasePriceNumerator: 1999, BasePriceDenominator: 100, TargetMarginPercent: 40,
+	})
+	assert.ErrorIs(t, err, domain.ErrNoCostPrice)
+}
+
+func TestProductQueries_CalculatePrice_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     CalculatePriceRequest
+		wantErr error
+	}{
+		{"no base price", CalculatePriceRequest{}, domain.ErrInvalidBasePrice},
+		{"negative quantity", CalculatePriceRequest{ProductID: "product-1", Quantity: -1}, domain.ErrInvalidQuantity},
+		{"quantity too large", CalculatePriceRequest{ProductID: "product-1", Quantity: domain.MaxPricedQuantity + 1}, domain.ErrInvalidQuantity},
+		{"coupon above 100", CalculatePriceRequest{ProductID: "product-1", CouponPercent: 101}, domain.ErrInvalidDiscountPercentage},
+		{"invalid segment", CalculatePriceRequest{ProductID: "product-1", Segment: "Whole Sale"}, domain.ErrInvalidSegment},
+		{"invalid currency", CalculatePriceRequest{BasePriceNumerator: 1, BasePriceDenominator: 1, Currency: "euro"}, domain.ErrInvalidCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewProductQueries(&productReadModel{}, clock.NewFixedClock(time.Now()))
+			_, err := q.CalculatePrice(context.Background(), tt.req)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
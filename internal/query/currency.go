@@ -0,0 +1,99 @@
+package query
+
+import (
+	"math/big"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// Price sources tell where the prices of a product in the requested currency come from.
+const (
+	// PriceSourceProduct means the product is priced in its own currency.
+	PriceSourceProduct = "product"
+	// PriceSourcePriceBook means the base price comes from the product's price book.
+	PriceSourcePriceBook = "price_book"
+	// PriceSourceConverted means the base price was converted with the configured
+	// exchange rates.
+	PriceSourceConverted = "converted"
+)
+
+// inCurrency returns dto priced in currency, and the source of its prices. The base price
+// is taken from the price book of the product, or else converted with rates; discounts,
+// tiers and fixed segment prices keep their proportions to it. An empty currency leaves
+// the product in its own currency. dto itself is never modified, as read models may cache it.
+func inCurrency(dto *contract.ProductDTO, currency string, rates *domain.CurrencyRates) (*contract.ProductDTO, string, error) {
+	if currency == "" || currency == dto.Currency {
+		return dto, PriceSourceProduct, nil
+	}
+
+	base := new(big.Rat).SetFrac64(dto.BasePriceNum, dto.BasePriceDenom)
+	var target *big.Rat
+	source := PriceSourcePriceBook
+	for _, entry := range dto.PriceBook {
+		if entry.Currency == currency {
+			target = new(big.Rat).SetFrac64(entry.PriceNum, entry.PriceDenom)
+			break
+		}
+	}
+	if target == nil {
+		if rates == nil {
+			return nil, "", domain.ErrNoExchangeRate
+		}
+		basePrice, err := domain.NewMoneyInCurrency(dto.BasePriceNum, dto.BasePriceDenom, dto.Currency)
+		if err != nil {
+			return nil, "", err
+		}
+		converted, err := rates.Convert(basePrice, currency)
+		if err != nil {
+			return nil, "", err
+		}
+		target = converted.Amount()
+		source = PriceSourceConverted
+	}
+
+	// Every price of the product scales by the same factor as its base price.
+	factor := new(big.Rat).Quo(target, base)
+	scale := func(num, denom int64) (int64, int64) {
+		amount := new(big.Rat).SetFrac64(num, denom)
+		amount.Mul(amount, factor)
+		return amount.Num().Int64(), amount.Denom().Int64()
+	}
+
+	priced := *dto
+	priced.Currency = currency
+	priced.MinimumPriceNum, priced.MinimumPriceDenom = 0, 0
+	priced.CostPriceNum, priced.CostPriceDenom = 0, 0
+	priced.PendingPriceNum, priced.PendingPriceDenom, priced.PendingPriceEffectiveAt = 0, 0, nil
+	priced.BasePriceNum, priced.BasePriceDenom = target.Num().Int64(), target.Denom().Int64()
+	priced.EffectivePriceNum, priced.EffectivePriceDenom = scale(dto.EffectivePriceNum, dto.EffectivePriceDenom)
+	if len(dto.PriceTiers) > 0 {
+		priced.PriceTiers = make([]contract.PriceTierDTO, len(dto.PriceTiers))
+		for i, t := range dto.PriceTiers {
+			priced.PriceTiers[i] = t
+			if t.UnitPriceDenom != 0 {
+				priced.PriceTiers[i].UnitPriceNum, priced.PriceTiers[i].UnitPriceDenom = scale(t.UnitPriceNum, t.UnitPriceDenom)
+			}
+		}
+	}
+	if len(dto.SegmentPrices) > 0 {
+		priced.SegmentPrices = make([]contract.SegmentPriceDTO, len(dto.SegmentPrices))
+		for i, s := range dto.SegmentPrices {
+			priced.SegmentPrices[i] = s
+			if s.PriceDenom != 0 {
+				priced.SegmentPrices[i].PriceNum, priced.SegmentPrices[i].PriceDenom = scale(s.PriceNum, s.PriceDenom)
+			}
+		}
+	}
+	if len(dto.Discounts) > 0 {
+		priced.Discounts = make([]contract.DiscountDTO, len(dto.Discounts))
+		for i, d := range dto.Discounts {
+			priced.Discounts[i] = d
+			if d.SalePriceDenom != 0 {
+				priced.Discounts[i].SalePriceNum, priced.Discounts[i].SalePriceDenom = scale(d.SalePriceNum, d.SalePriceDenom)
+			}
+		}
+	}
+	priced.Variants = scaleVariants(dto, &priced, scale)
+	return &priced, source, nil
+}
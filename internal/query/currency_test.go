@@ -0,0 +1,132 @@
+package query
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// widgetDTO is a USD product at 20.00 with a 25% discount, a unit price tier and a
+// percent-off tier, and a EUR price in its price book.
+func widgetDTO() *contract.ProductDTO {
+	return &contract.ProductDTO{
+		ID:                  "product-1",
+		BasePriceNum:        2000,
+		BasePriceDenom:      100,
+		EffectivePriceNum:   1500,
+		EffectivePriceDenom: 100,
+		Currency:            "USD",
+		PriceTiers: []contract.PriceTierDTO{
+			{MinQuantity: 10, UnitPriceNum: 1800, UnitPriceDenom: 100},
+			{MinQuantity: 100, PercentOff: 15},
+		},
+		PriceBook: []contract.PriceBookEntryDTO{
+			{Currency: "EUR", PriceNum: 1900, PriceDenom: 100},
+		},
+	}
+}
+
+func TestInCurrency(t *testing.T) {
+	rates, err := domain.NewCurrencyRates("USD", map[string]*big.Rat{
+		"EUR": big.NewRat(92, 100),
+		"GBP": big.NewRat(80, 100),
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		currency   string
+		rates      *domain.CurrencyRates
+		wantSource string
+		wantBase   *big.Rat
+		wantErr    error
+	}{
+		{"own currency", "", rates, PriceSourceProduct, big.NewRat(20, 1), nil},
+		{"own currency named", "USD", nil, PriceSourceProduct, big.NewRat(20, 1), nil},
+		{"price book", "EUR", rates, PriceSourcePriceBook, big.NewRat(19, 1), nil},
+		{"price book without rates", "EUR", nil, PriceSourcePriceBook, big.NewRat(19, 1), nil},
+		{"converted", "GBP", rates, PriceSourceConverted, big.NewRat(16, 1), nil},
+		{"no rate", "JPY", rates, "", nil, domain.ErrNoExchangeRate},
+		{"no rates configured", "GBP", nil, "", nil, domain.ErrNoExchangeRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dto := widgetDTO()
+			priced, source, err := inCurrency(dto, tt.currency, tt.rates)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSource, source)
+			assert.Equal(t, 0, new(big.Rat).SetFrac64(priced.BasePriceNum, priced.BasePriceDenom).Cmp(tt.wantBase))
+
+			// The discount and the unit price tier keep their proportions to the base price.
+			effective := new(big.Rat).Mul(tt.wantBase, big.NewRat(3, 4))
+			assert.Equal(t, 0, new(big.Rat).SetFrac64(priced.EffectivePriceNum, priced.EffectivePriceDenom).Cmp(effective))
+			unit := new(big.Rat).Mul(tt.wantBase, big.NewRat(9, 10))
+			assert.Equal(t, 0, new(big.Rat).SetFrac64(priced.PriceTiers[0].UnitPriceNum, priced.PriceTiers[0].UnitPriceDenom).Cmp(unit))
+			assert.Equal(t, 15.0, priced.PriceTiers[1].PercentOff)
+
+			// The read model's DTO is left as it was.
+			assert.Equal(t, widgetDTO(), dto)
+		})
+	}
+}
+
+// productReadModel serves GetProduct and ListProducts from a fixed product and records
+// the pricing time of the last read.
+type productReadModel struct {
+	contract.ProductReadModel
+	product *contract.ProductDTO
+	at      time.Time
+}
+
+func (rm *productReadModel) GetProduct(_ context.Context, _ string, at time.Time) (*contract.ProductDTO, error) {
+	rm.at = at
+	return rm.product, nil
+}
+
+func (rm *productReadModel) ListProducts(_ context.Context, _ contract.ListProductsFilter, _ contract.Pagination, at time.Time) (*contract.ListProductsResult, error) {
+	rm.at = at
+	return &contract.ListProductsResult{Products: []*contract.ProductDTO{rm.product}}, nil
+}
+
+func TestProductQueries_Currency(t *testing.T) {
+	rates, err := domain.ParseCurrencyRates("USD", "GBP=0.8")
+	require.NoError(t, err)
+	q := NewProductQueries(&productReadModel{product: widgetDTO()}, clock.NewFixedClock(time.Now()), WithCurrencyRates(rates))
+	ctx := context.Background()
+
+	product, err := q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Currency: "eur"})
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", product.Currency)
+	assert.Equal(t, PriceSourcePriceBook, product.PriceSource)
+	assert.Equal(t, []*PriceBookEntryResponse{{Currency: "EUR", PriceNumerator: 1900, PriceDenominator: 100, PriceDisplay: "19.00"}}, product.PriceBook)
+
+	list, err := q.ListProducts(ctx, ListProductsRequest{Currency: "GBP"})
+	require.NoError(t, err)
+	require.Len(t, list.Products, 1)
+	assert.Equal(t, "GBP", list.Products[0].Currency)
+	assert.Equal(t, PriceSourceConverted, list.Products[0].PriceSource)
+	assert.Equal(t, int64(16), list.Products[0].BasePriceNumerator)
+	assert.Equal(t, int64(12), list.Products[0].EffectivePriceNumerator)
+
+	list, err = q.ListProducts(ctx, ListProductsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, PriceSourceProduct, list.Products[0].PriceSource)
+
+	_, err = q.ListProducts(ctx, ListProductsRequest{Currency: "JPY"})
+	assert.ErrorIs(t, err, domain.ErrNoExchangeRate)
+
+	_, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Currency: "euro"})
+	assert.ErrorIs(t, err, domain.ErrInvalidCurrency)
+}
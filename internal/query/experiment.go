@@ -0,0 +1,176 @@
+package query
+
+import (
+	"context"
+	"expvar"
+	"math/big"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/experiment"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// Surfaces report which query exposed a subject to an experiment.
+const (
+	SurfaceGetProduct         = "get_product"
+	SurfaceGetEffectivePrices = "get_effective_prices"
+	SurfaceBatchGetProducts   = "batch_get_products"
+)
+
+// failedExposures counts the experiment exposures that could not be recorded.
+var failedExposures = expvar.NewInt("experiment_exposures_failed")
+
+// MaxSubjectIDLength is the longest experiment subject ID, in characters.
+const MaxSubjectIDLength = 128
+
+// ExperimentContext identifies who a request prices for, so that pricing experiments can
+// assign them a variant.
+type ExperimentContext struct {
+	// SubjectID is a stable ID of the customer or session; empty opts out of experiments.
+	SubjectID string
+}
+
+// ExperimentTag tells which experiment variant a price was computed in.
+type ExperimentTag struct {
+	ExperimentID string
+	Variant      string
+}
+
+func (c ExperimentContext) validate() error {
+	if len([]rune(c.SubjectID)) > MaxSubjectIDLength {
+		return domain.ErrSubjectIDTooLong
+	}
+	return nil
+}
+
+// WithExperiments prices the products targeted by the experiments of set in the variant
+// assigned to the subject of the request, and records each exposure with exposures.
+func WithExperiments(set *experiment.Set, exposures contract.ExposureRecorder) Option {
+	return func(q *ProductQueries) {
+		q.experiments = set
+		q.exposures = exposures
+	}
+}
+
+// assign returns the experiment variant of the subject for a product, if any.
+func (q *ProductQueries) assign(ctx ExperimentContext, productID, category string) (experiment.Assignment, bool) {
+	return q.experiments.Assign(ctx.SubjectID, productID, category)
+}
+
+// recordExposures records exposures on a best-effort basis: a failure is logged and
+// counted but does not fail the read that priced them.
+func (q *ProductQueries) recordExposures(ctx context.Context, exposures []contract.Exposure) {
+	if q.exposures == nil || len(exposures) == 0 {
+		return
+	}
+	if err := q.exposures.RecordExposures(ctx, exposures); err != nil {
+		failedExposures.Add(int64(len(exposures)))
+		logging.Warnf("query: failed to record %d experiment exposures: %v", len(exposures), err)
+	}
+}
+
+func exposure(a experiment.Assignment, subjectID, productID, surface string, num, denom int64, currency string, at time.Time) contract.Exposure {
+	return contract.Exposure{
+		ExperimentID:        a.ExperimentID,
+		Variant:             a.Variant.Name,
+		SubjectID:           subjectID,
+		ProductID:           productID,
+		Surface:             surface,
+		EffectivePriceNum:   num,
+		EffectivePriceDenom: denom,
+		Currency:            currency,
+		At:                  at,
+	}
+}
+
+// variantPricing computes the prices of a variant from the prices of the product.
+type variantPricing struct {
+	factor   *big.Rat
+	discount *big.Rat
+}
+
+func newVariantPricing(v experiment.Variant) variantPricing {
+	factor := big.NewRat(1, 1)
+	if v.PriceFactor != nil {
+		factor = v.PriceFactor
+	}
+	return variantPricing{factor: factor, discount: v.DiscountPercent}
+}
+
+// scale returns a price multiplied by the price factor of the variant.
+func (p variantPricing) scale(num, denom int64) (int64, int64) {
+	if denom == 0 {
+		return num, denom
+	}
+	amount := new(big.Rat).SetFrac64(num, denom)
+	amount.Mul(amount, p.factor)
+	return amount.Num().Int64(), amount.Denom().Int64()
+}
+
+// effective returns the effective price of the variant given the scaled base price and the
+// effective price of the product.
+func (p variantPricing) effective(baseNum, baseDenom, effectiveNum, effectiveDenom int64) (int64, int64) {
+	if p.discount == nil {
+		return p.scale(effectiveNum, effectiveDenom)
+	}
+	amount := new(big.Rat).SetFrac64(baseNum, baseDenom)
+	amount.Mul(amount, new(big.Rat).Sub(big.NewRat(1, 1), new(big.Rat).Quo(p.discount, big.NewRat(100, 1))))
+	return amount.Num().Int64(), amount.Denom().Int64()
+}
+
+// discountPercent returns the discount of the variant, if it replaces the product's, and
+// whether it applies.
+func (p variantPricing) discountPercent() (*float64, bool) {
+	if p.discount.Sign() == 0 {
+		return nil, false
+	}
+	f, _ := p.discount.Float64()
+	return &f, true
+}
+
+// inVariant returns dto priced in the variant: every price scaled by its price factor and,
+// if it sets a discount, the effective price and the single discount fields replaced by
+// it. dto itself is never modified, as read models may cache it.
+func inVariant(dto *contract.ProductDTO, v experiment.Variant) *contract.ProductDTO {
+	pricing := newVariantPricing(v)
+
+	priced := *dto
+	priced.BasePriceNum, priced.BasePriceDenom = pricing.scale(dto.BasePriceNum, dto.BasePriceDenom)
+	priced.EffectivePriceNum, priced.EffectivePriceDenom = pricing.effective(
+		priced.BasePriceNum, priced.BasePriceDenom, dto.EffectivePriceNum, dto.EffectivePriceDenom)
+	if pricing.discount != nil {
+		priced.DiscountPercent, priced.HasActiveDiscount = pricing.discountPercent()
+		priced.DiscountStartDate, priced.DiscountEndDate, priced.DiscountSuspended = nil, nil, false
+	}
+	if len(dto.PriceTiers) > 0 {
+		priced.PriceTiers = make([]contract.PriceTierDTO, len(dto.PriceTiers))
+		for i, t := range dto.PriceTiers {
+			priced.PriceTiers[i] = t
+			priced.PriceTiers[i].UnitPriceNum, priced.PriceTiers[i].UnitPriceDenom = pricing.scale(t.UnitPriceNum, t.UnitPriceDenom)
+		}
+	}
+	if len(dto.PriceBook) > 0 {
+		priced.PriceBook = make([]contract.PriceBookEntryDTO, len(dto.PriceBook))
+		for i, e := range dto.PriceBook {
+			priced.PriceBook[i] = e
+			priced.PriceBook[i].PriceNum, priced.PriceBook[i].PriceDenom = pricing.scale(e.PriceNum, e.PriceDenom)
+		}
+	}
+	return &priced
+}
+
+// priceInVariant is inVariant for the price of a product.
+func priceInVariant(dto *contract.PriceDTO, v experiment.Variant) *contract.PriceDTO {
+	pricing := newVariantPricing(v)
+
+	priced := *dto
+	priced.BasePriceNum, priced.BasePriceDenom = pricing.scale(dto.BasePriceNum, dto.BasePriceDenom)
+	priced.EffectivePriceNum, priced.EffectivePriceDenom = pricing.effective(
+		priced.BasePriceNum, priced.BasePriceDenom, dto.EffectivePriceNum, dto.EffectivePriceDenom)
+	if pricing.discount != nil {
+		priced.DiscountPercent, priced.HasActiveDiscount = pricing.discountPercent()
+	}
+	return &priced
+}
@@ -0,0 +1,152 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/experiment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeExposureRecorder records exposures in memory, or fails with err.
+type fakeExposureRecorder struct {
+	exposures []contract.Exposure
+	err       error
+}
+
+func (r *fakeExposureRecorder) RecordExposures(_ context.Context, exposures []contract.Exposure) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.exposures = append(r.exposures, exposures...)
+	return nil
+}
+
+// Every experiment has a single variant, so every subject is assigned to it.
+const queryExperimentsJSON = `[
+	{"id": "cheaper-widgets", "product_ids": ["product-1"], "variants": [
+		{"name": "cheaper", "weight": 1, "price_factor": "0.9"}
+	]},
+	{"id": "tools-discount", "categories": ["Tools"], "variants": [
+		{"name": "discount", "weight": 1, "discount_percent": "25"}
+	]}
+]`
+
+func newExperimentQueries(t *testing.T, readModel contract.ProductReadModel, recorder contract.ExposureRecorder, now time.Time) *ProductQueries {
+	t.Helper()
+	set, err := experiment.Parse([]byte(queryExperimentsJSON))
+	require.NoError(t, err)
+	return NewProductQueries(readModel, clock.NewFixedClock(now), WithExperiments(set, recorder))
+}
+
+func TestProductQueries_GetProduct_Experiment(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	dto := &contract.ProductDTO{
+		ID:                  "product-1",
+		Category:            "Widgets",
+		BasePriceNum:        2000,
+		BasePriceDenom:      100,
+		EffectivePriceNum:   1800,
+		EffectivePriceDenom: 100,
+		Currency:            "USD",
+		DiscountPercent:     ptrFloat64(10),
+		HasActiveDiscount:   true,
+		PriceTiers: []contract.PriceTierDTO{
+			{MinQuantity: 10, UnitPriceNum: 15, UnitPriceDenom: 1},
+		},
+		PriceBook: []contract.PriceBookEntryDTO{
+			{Currency: "EUR", PriceNum: 19, PriceDenom: 1},
+		},
+	}
+	recorder := &fakeExposureRecorder{}
+	q := newExperimentQueries(t, &productReadModel{product: dto}, recorder, now)
+
+	product, err := q.GetProduct(context.Background(), GetProductRequest{
+		ProductID:  "product-1",
+		Experiment: ExperimentContext{SubjectID: "customer-1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &ExperimentTag{ExperimentID: "cheaper-widgets", Variant: "cheaper"}, product.Experiment)
+	assert.Equal(t, "18.00", product.BasePriceDisplay)
+	assert.Equal(t, "16.20", product.EffectivePriceDisplay)
+	assert.Equal(t, "13.50", product.PriceTiers[0].UnitPriceDisplay)
+	assert.Equal(t, "17.10", product.PriceBook[0].PriceDisplay)
+	assert.Equal(t, []contract.Exposure{{
+		ExperimentID:        "cheaper-widgets",
+		Variant:             "cheaper",
+		SubjectID:           "customer-1",
+		ProductID:           "product-1",
+		Surface:             SurfaceGetProduct,
+		EffectivePriceNum:   81,
+		EffectivePriceDenom: 5,
+		Currency:            "USD",
+		At:                  now,
+	}}, recorder.exposures)
+
+	// The read model's product is left as it is.
+	assert.Equal(t, int64(2000), dto.BasePriceNum)
+	assert.Equal(t, int64(15), dto.PriceTiers[0].UnitPriceNum)
+
+	// Without a subject the product is priced as usual.
+	product, err = q.GetProduct(context.Background(), GetProductRequest{ProductID: "product-1"})
+	require.NoError(t, err)
+	assert.Nil(t, product.Experiment)
+	assert.Equal(t, "18.00", product.EffectivePriceDisplay)
+	assert.Len(t, recorder.exposures, 1)
+}
+
+func TestProductQueries_GetEffectivePrices_Experiment(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	readModel := &priceReadModel{prices: []*contract.PriceDTO{
+		{ProductID: "product-2", Category: "Tools", BasePriceNum: 40, BasePriceDenom: 1, EffectivePriceNum: 40, EffectivePriceDenom: 1, Currency: "USD"},
+		{ProductID: "product-3", Category: "Garden", BasePriceNum: 10, BasePriceDenom: 1, EffectivePriceNum: 10, EffectivePriceDenom: 1, Currency: "USD"},
+	}}
+	recorder := &fakeExposureRecorder{}
+	q := newExperimentQueries(t, readModel, recorder, now)
+
+	resp, err := q.GetEffectivePrices(context.Background(), GetEffectivePricesRequest{
+		ProductIDs: []string{"product-2", "product-3"},
+		Experiment: ExperimentContext{SubjectID: "customer-1"},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Prices, 2)
+
+	discounted := resp.Prices[0]
+	assert.Equal(t, &ExperimentTag{ExperimentID: "tools-discount", Variant: "discount"}, discounted.Experiment)
+	assert.Equal(t, "30.00", discounted.EffectivePriceDisplay)
+	assert.Equal(t, ptrFloat64(25), discounted.DiscountPercent)
+	assert.True(t, discounted.HasActiveDiscount)
+
+	untargeted := resp.Prices[1]
+	assert.Nil(t, untargeted.Experiment)
+	assert.Equal(t, "10.00", untargeted.EffectivePriceDisplay)
+
+	require.Len(t, recorder.exposures, 1)
+	assert.Equal(t, SurfaceGetEffectivePrices, recorder.exposures[0].Surface)
+	assert.Equal(t, "product-2", recorder.exposures[0].ProductID)
+	assert.Equal(t, int64(30), recorder.exposures[0].EffectivePriceNum)
+
+	// The read model's prices are left as they are.
+	assert.Equal(t, int64(40), readModel.prices[0].EffectivePriceNum)
+}
+
+func TestProductQueries_Experiment_RecordFailure(t *testing.T) {
+	dto := &contract.ProductDTO{
+		ID: "product-1", BasePriceNum: 10, BasePriceDenom: 1, EffectivePriceNum: 10, EffectivePriceDenom: 1, Currency: "USD",
+	}
+	recorder := &fakeExposureRecorder{err: errors.New("outbox unavailable")}
+	q := newExperimentQueries(t, &productReadModel{product: dto}, recorder, time.Now())
+
+	// A failure to record the exposure does not fail the read.
+	product, err := q.GetProduct(context.Background(), GetProductRequest{
+		ProductID:  "product-1",
+		Experiment: ExperimentContext{SubjectID: "customer-1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "9.00", product.EffectivePriceDisplay)
+}
@@ -0,0 +1,43 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// GetProductBySKURequest represents the input for getting a product by its SKU, e.g.
+// "WDG-1". The other fields are as in GetProductRequest.
+type GetProductBySKURequest struct {
+	SKU        string
+	Currency   string
+	Experiment ExperimentContext
+	Segment    string
+	Market     string
+	PriceAt    time.Time
+	Locale     string
+}
+
+// GetProductBySKU retrieves the product with a SKU as GetProduct retrieves it by ID. It
+// fails with domain.ErrProductNotFound if no product has the SKU; the SKUs of variants
+// are not looked up.
+func (q *ProductQueries) GetProductBySKU(ctx context.Context, req GetProductBySKURequest) (*ProductResponse, error) {
+	sku, err := domain.ParseSKU(req.SKU)
+	if err != nil {
+		return nil, err
+	}
+	id, err := q.readModel.FindProductIDBySKU(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+	return q.GetProduct(ctx, GetProductRequest{
+		ProductID:  id,
+		Currency:   req.Currency,
+		Experiment: req.Experiment,
+		Segment:    req.Segment,
+		Market:     req.Market,
+		PriceAt:    req.PriceAt,
+		Locale:     req.Locale,
+	})
+}
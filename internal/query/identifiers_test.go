@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// skuReadModel serves a single product by ID or by SKU.
+type skuReadModel struct {
+	contract.ProductReadModel
+	product *contract.ProductDTO
+}
+
+func (rm *skuReadModel) FindProductIDBySKU(_ context.Context, sku string) (string, error) {
+	if sku != rm.product.SKU {
+		return "", domain.ErrProductNotFound
+	}
+	return rm.product.ID, nil
+}
+
+func (rm *skuReadModel) GetProduct(_ context.Context, id string, _ time.Time) (*contract.ProductDTO, error) {
+	if id != rm.product.ID {
+		return nil, domain.ErrProductNotFound
+	}
+	return rm.product, nil
+}
+
+func TestProductQueries_GetProductBySKU(t *testing.T) {
+	dto := widgetDTO()
+	dto.SKU = "WDG-1"
+	dto.GTIN = "4006381333931"
+	q := NewProductQueries(&skuReadModel{product: dto}, clock.NewFixedClock(time.Now()))
+
+	resp, err := q.GetProductBySKU(context.Background(), GetProductBySKURequest{SKU: "wdg-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "product-1", resp.ID)
+	assert.Equal(t, "WDG-1", resp.SKU)
+	assert.Equal(t, "4006381333931", resp.GTIN)
+
+	_, err = q.GetProductBySKU(context.Background(), GetProductBySKURequest{SKU: "GDG-1"})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	_, err = q.GetProductBySKU(context.Background(), GetProductBySKURequest{SKU: "WDG 1"})
+	assert.ErrorIs(t, err, domain.ErrInvalidSKU)
+}
@@ -0,0 +1,46 @@
+package query
+
+import (
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// requestLocale validates the locale of a request; empty means domain.DefaultLocale.
+func requestLocale(locale string) (string, error) {
+	if locale == "" {
+		return domain.DefaultLocale, nil
+	}
+	return domain.ParseLocale(locale)
+}
+
+// inLocale returns dto with its name and description in a locale, and the locale they
+// are in. It uses the translation in the locale, else the translation in the language of
+// the locale, e.g. "de" for "de-AT", else the name and description of the product
+// itself, in domain.DefaultLocale. A translation without a description keeps the
+// description of the product. dto itself is never modified, as read models may cache it.
+func inLocale(dto *contract.ProductDTO, locale string) (*contract.ProductDTO, string) {
+	translation := findTranslation(dto.Translations, locale)
+	if translation == nil {
+		translation = findTranslation(dto.Translations, domain.LocaleLanguage(locale))
+	}
+	if translation == nil {
+		return dto, domain.DefaultLocale
+	}
+
+	localized := *dto
+	localized.Name = translation.Name
+	if translation.Description != "" {
+		localized.Description = translation.Description
+	}
+	return &localized, translation.Locale
+}
+
+// findTranslation returns the translation in a locale, or nil if there is none.
+func findTranslation(translations []contract.TranslationDTO, locale string) *contract.TranslationDTO {
+	for i := range translations {
+		if translations[i].Locale == locale {
+			return &translations[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func translatedDTO() *contract.ProductDTO {
+	dto := widgetDTO()
+	dto.Name = "Widget"
+	dto.Description = "A widget"
+	dto.Translations = []contract.TranslationDTO{
+		{Locale: "de", Name: "Widget (de)", Description: "Ein Widget"},
+		{Locale: "pt-BR", Name: "Widget (pt-BR)"},
+	}
+	return dto
+}
+
+func TestInLocale(t *testing.T) {
+	tests := []struct {
+		name            string
+		locale          string
+		wantLocale      string
+		wantName        string
+		wantDescription string
+	}{
+		{"default locale", "en", "en", "Widget", "A widget"},
+		{"translated", "de", "de", "Widget (de)", "Ein Widget"},
+		{"language fallback", "de-AT", "de", "Widget (de)", "Ein Widget"},
+		{"without description", "pt-BR", "pt-BR", "Widget (pt-BR)", "A widget"},
+		{"no translation in the language", "pt", "en", "Widget", "A widget"},
+		{"untranslated", "fr-FR", "en", "Widget", "A widget"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dto := translatedDTO()
+			localized, locale := inLocale(dto, tt.locale)
+			assert.Equal(t, tt.wantLocale, locale)
+			assert.Equal(t, tt.wantName, localized.Name)
+			assert.Equal(t, tt.wantDescription, localized.Description)
+			assert.Equal(t, translatedDTO(), dto)
+		})
+	}
+}
+
+func TestProductQueries_Locale(t *testing.T) {
+	q := NewProductQueries(&productReadModel{product: translatedDTO()}, clock.NewFixedClock(time.Now()))
+	ctx := context.Background()
+
+	product, err := q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Locale: "de_at"})
+	require.NoError(t, err)
+	assert.Equal(t, "de", product.Locale)
+	assert.Equal(t, "Widget (de)", product.Name)
+	assert.Equal(t, "Ein Widget", product.Description)
+
+	product, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-1"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultLocale, product.Locale)
+	assert.Equal(t, "Widget", product.Name)
+
+	list, err := q.ListProducts(ctx, ListProductsRequest{Locale: "pt-BR"})
+	require.NoError(t, err)
+	require.Len(t, list.Products, 1)
+	assert.Equal(t, "pt-BR", list.Products[0].Locale)
+	assert.Equal(t, "Widget (pt-BR)", list.Products[0].Name)
+
+	_, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Locale: "deutsch"})
+	assert.ErrorIs(t, err, domain.ErrInvalidLocale)
+	_, err = q.ListProducts(ctx, ListProductsRequest{Locale: "deutsch"})
+	assert.ErrorIs(t, err, domain.ErrInvalidLocale)
+}
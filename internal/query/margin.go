@@ -0,0 +1,91 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// GetMarginRequest represents the input for the margin of a product.
+type GetMarginRequest struct {
+	ProductID string
+	// At is the pricing time; the zero value means now.
+	At time.Time
+}
+
+// MarginResponse represents the margin of selling a product at its effective price. The
+// prices and margin are exact; the display fields are rounded. The margin is negative
+// when the product sells below its cost price.
+type MarginResponse struct {
+	ProductID            string
+	PriceNumerator       int64
+	PriceDenominator     int64
+	CostPriceNumerator   int64
+	CostPriceDenominator int64
+	MarginNumerator      int64
+	MarginDenominator    int64
+	MarginPercent        float64
+	PriceDisplay         string
+	CostPriceDisplay     string
+	MarginDisplay        string
+	HasActiveDiscount    bool
+	Currency             string
+	Status               string
+}
+
+// GetMargin prices a product at the requested time and returns the margin of its
+// effective price over its cost price. It fails with domain.ErrNoCostPrice if the product
+// has no cost price.
+func (q *ProductQueries) GetMargin(ctx context.Context, req GetMarginRequest) (*MarginResponse, error) {
+	if req.ProductID == "" {
+		return nil, domain.ErrInvalidID
+	}
+
+	at := req.At
+	if at.IsZero() {
+		at = q.clock.Now()
+	}
+
+	dtos, err := q.readModel.GetEffectivePrices(ctx, []string{req.ProductID}, at)
+	if err != nil {
+		return nil, err
+	}
+	if len(dtos) == 0 {
+		return nil, domain.ErrProductNotFound
+	}
+	dto := dtos[0]
+	if dto.CostPriceDenom == 0 {
+		return nil, domain.ErrNoCostPrice
+	}
+
+	price, err := domain.NewMoneyInCurrency(dto.EffectivePriceNum, dto.EffectivePriceDenom, dto.Currency)
+	if err != nil {
+		return nil, err
+	}
+	cost, err := domain.NewMoneyInCurrency(dto.CostPriceNum, dto.CostPriceDenom, dto.Currency)
+	if err != nil {
+		return nil, err
+	}
+	margin, err := domain.NewPricingCalculator().CalculateMargin(price, cost)
+	if err != nil {
+		return nil, err
+	}
+
+	percent, _ := margin.Percent.Float64()
+	resp := &MarginResponse{
+		ProductID:            dto.ProductID,
+		PriceNumerator:       margin.Price.Numerator(),
+		PriceDenominator:     margin.Price.Denominator(),
+		CostPriceNumerator:   margin.Cost.Numerator(),
+		CostPriceDenominator: margin.Cost.Denominator(),
+		MarginNumerator:      margin.Amount.Numerator(),
+		MarginDenominator:    margin.Amount.Denominator(),
+		MarginPercent:        percent,
+		HasActiveDiscount:    dto.HasActiveDiscount,
+		Currency:             dto.Currency,
+		Status:               dto.Status,
+	}
+	q.roundMargin(resp)
+	return resp, nil
+}
@@ -0,0 +1,76 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductQueries_GetMargin(t *testing.T) {
+	tests := []struct {
+		name                    string
+		effectiveNum            int64
+		wantMargin, wantDisplay string
+		wantPercent             float64
+	}{
+		{name: "full price", effectiveNum: 2000, wantMargin: "8", wantDisplay: "8.00", wantPercent: 40},
+		{name: "discounted", effectiveNum: 1500, wantMargin: "3", wantDisplay: "3.00", wantPercent: 20},
+		{name: "below cost", effectiveNum: 1000, wantMargin: "-2", wantDisplay: "-2.00", wantPercent: -20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readModel := &priceReadModel{prices: []*contract.PriceDTO{{
+				ProductID:           "product-1",
+				BasePriceNum:        2000,
+				BasePriceDenom:      100,
+				EffectivePriceNum:   tt.effectiveNum,
+				EffectivePriceDenom: 100,
+				Currency:            "EUR",
+				Status:              "active",
+				CostPriceNum:        1200,
+				CostPriceDenom:      100,
+			}}}
+			q := NewProductQueries(readModel, clock.NewFixedClock(time.Now()))
+
+			resp, err := q.GetMargin(context.Background(), GetMarginRequest{ProductID: "product-1"})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMargin, domain.NewMoney(resp.MarginNumerator, resp.MarginDenominator).Amount().RatString())
+			assert.Equal(t, tt.wantDisplay, resp.MarginDisplay)
+			assert.InDelta(t, tt.wantPercent, resp.MarginPercent, 1e-9)
+			assert.Equal(t, "12.00", resp.CostPriceDisplay)
+			assert.Equal(t, "EUR", resp.Currency)
+		})
+	}
+}
+
+func TestProductQueries_GetMargin_Errors(t *testing.T) {
+	price := &contract.PriceDTO{
+		ProductID: "product-1", EffectivePriceNum: 10, EffectivePriceDenom: 1, Currency: "USD",
+	}
+
+	tests := []struct {
+		name    string
+		prices  []*contract.PriceDTO
+		id      string
+		wantErr error
+	}{
+		{"empty ID", nil, "", domain.ErrInvalidID},
+		{"missing product", nil, "product-1", domain.ErrProductNotFound},
+		{"no cost price", []*contract.PriceDTO{price}, "product-1", domain.ErrNoCostPrice},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewProductQueries(&priceReadModel{prices: tt.prices}, clock.NewFixedClock(time.Now()))
+			_, err := q.GetMargin(context.Background(), GetMarginRequest{ProductID: tt.id})
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package query
+
+import (
+	"math/big"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// requestMarket validates the market of a request; empty means the default market.
+func requestMarket(market string) (domain.Market, error) {
+	if market == "" {
+		return "", nil
+	}
+	return domain.ParseMarket(market)
+}
+
+// inMarket returns dto priced in a market, and whether the product has a price there. The
+// base price, currency and discount are those of the market; tiers and fixed segment
+// prices keep their proportions to the base price. A product without a price in the
+// market falls back to its default prices. The price book holds default-market prices, so
+// it is dropped. dto itself is never modified, as read models may cache it.
+func inMarket(dto *contract.ProductDTO, market domain.Market) (*contract.ProductDTO, bool) {
+	if market == "" || dto.BasePriceNum == 0 || dto.BasePriceDenom == 0 {
+		return dto, false
+	}
+	var entry *contract.MarketPriceDTO
+	for i := range dto.MarketPrices {
+		if dto.MarketPrices[i].Market == market.String() {
+			entry = &dto.MarketPrices[i]
+			break
+		}
+	}
+	if entry == nil || entry.PriceDenom == 0 {
+		return dto, false
+	}
+
+	factor := new(big.Rat).SetFrac64(entry.PriceNum, entry.PriceDenom)
+	factor.Quo(factor, new(big.Rat).SetFrac64(dto.BasePriceNum, dto.BasePriceDenom))
+	scale := func(num, denom int64) (int64, int64) {
+		amount := new(big.Rat).SetFrac64(num, denom)
+		amount.Mul(amount, factor)
+		return amount.Num().Int64(), amount.Denom().Int64()
+	}
+
+	priced := *dto
+	priced.Currency = entry.Currency
+	priced.MinimumPriceNum, priced.MinimumPriceDenom = 0, 0
+	priced.CostPriceNum, priced.CostPriceDenom = 0, 0
+	priced.PendingPriceNum, priced.PendingPriceDenom, priced.PendingPriceEffectiveAt = 0, 0, nil
+	priced.BasePriceNum, priced.BasePriceDenom = entry.PriceNum, entry.PriceDenom
+	priced.EffectivePriceNum, priced.EffectivePriceDenom = entry.EffectivePriceNum, entry.EffectivePriceDenom
+	priced.DiscountPercent = entry.DiscountPercent
+	priced.DiscountStartDate = entry.DiscountStartDate
+	priced.DiscountEndDate = entry.DiscountEndDate
+	priced.DiscountSuspended = entry.DiscountSuspended
+	priced.HasActiveDiscount = entry.HasActiveDiscount
+	priced.PriceBook = nil
+	if len(dto.PriceTiers) > 0 {
+		priced.PriceTiers = make([]contract.PriceTierDTO, len(dto.PriceTiers))
+		for i, t := range dto.PriceTiers {
+			priced.PriceTiers[i] = t
+			if t.UnitPriceDenom != 0 {
+				priced.PriceTiers[i].UnitPriceNum, priced.PriceTiers[i].UnitPriceDenom = scale(t.UnitPriceNum, t.UnitPriceDenom)
+			}
+		}
+	}
+	if len(dto.SegmentPrices) > 0 {
+		priced.SegmentPrices = make([]contract.SegmentPriceDTO, len(dto.SegmentPrices))
+		for i, s := range dto.SegmentPrices {
+			priced.SegmentPrices[i] = s
+			if s.PriceDenom != 0 {
+				priced.SegmentPrices[i].PriceNum, priced.SegmentPrices[i].PriceDenom = scale(s.PriceNum, s.PriceDenom)
+			}
+		}
+	}
+	if len(dto.Discounts) > 0 {
+		priced.Discounts = make([]contract.DiscountDTO, len(dto.Discounts))
+		for i, d := range dto.Discounts {
+			priced.Discounts[i] = d
+			if d.SalePriceDenom != 0 {
+				priced.Discounts[i].SalePriceNum, priced.Discounts[i].SalePriceDenom = scale(d.SalePriceNum, d.SalePriceDenom)
+			}
+		}
+	}
+	priced.Variants = scaleVariants(dto, &priced, scale)
+	return &priced, true
+}
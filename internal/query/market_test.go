@@ -0,0 +1,92 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// marketWidgetDTO is widgetDTO with a UK price of 16.00 under a 10% UK-only discount and
+// a fixed employee price of 12.00.
+func marketWidgetDTO() *contract.ProductDTO {
+	dto := widgetDTO()
+	pct := 10.0
+	dto.MarketPrices = []contract.MarketPriceDTO{{
+		Market:              "UK",
+		Currency:            "GBP",
+		PriceNum:            1600,
+		PriceDenom:          100,
+		EffectivePriceNum:   1440,
+		EffectivePriceDenom: 100,
+		DiscountPercent:     &pct,
+		HasActiveDiscount:   true,
+	}}
+	dto.SegmentPrices = []contract.SegmentPriceDTO{{Segment: "employee", PriceNum: 1200, PriceDenom: 100}}
+	return dto
+}
+
+func TestInMarket(t *testing.T) {
+	dto := marketWidgetDTO()
+
+	priced, in := inMarket(dto, domain.MarketUK)
+	require.True(t, in)
+	assert.Equal(t, "GBP", priced.Currency)
+	assert.Equal(t, int64(1600), priced.BasePriceNum)
+	assert.Equal(t, int64(1440), priced.EffectivePriceNum)
+	assert.Equal(t, 10.0, *priced.DiscountPercent)
+	assert.Nil(t, priced.PriceBook)
+
+	// Tiers and fixed segment prices keep their proportions to the base price.
+	assert.Equal(t, []int64{72, 5}, []int64{priced.PriceTiers[0].UnitPriceNum, priced.PriceTiers[0].UnitPriceDenom})
+	assert.Equal(t, []int64{48, 5}, []int64{priced.SegmentPrices[0].PriceNum, priced.SegmentPrices[0].PriceDenom})
+
+	// Markets without a price fall back to the default prices.
+	fallback, in := inMarket(dto, domain.MarketEU)
+	assert.False(t, in)
+	assert.Same(t, dto, fallback)
+
+	// The read model's DTO is left as it was.
+	assert.Equal(t, marketWidgetDTO(), dto)
+}
+
+func TestProductQueries_GetProduct_Market(t *testing.T) {
+	q := NewProductQueries(&productReadModel{product: marketWidgetDTO()}, clock.NewFixedClock(time.Now()))
+	ctx := context.Background()
+
+	product, err := q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Market: "uk"})
+	require.NoError(t, err)
+	assert.Equal(t, "UK", product.Market)
+	assert.Equal(t, "GBP", product.Currency)
+	assert.Equal(t, "16.00", product.BasePriceDisplay)
+	assert.Equal(t, "14.40", product.EffectivePriceDisplay)
+	require.Len(t, product.MarketPrices, 1)
+	assert.Equal(t, "14.40", product.MarketPrices[0].EffectivePriceDisplay)
+
+	// Segment prices apply within the market.
+	product, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Market: "UK", Segment: "employee"})
+	require.NoError(t, err)
+	assert.Equal(t, "9.60", product.BasePriceDisplay)
+
+	product, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Market: "EU"})
+	require.NoError(t, err)
+	assert.Empty(t, product.Market)
+	assert.Equal(t, "USD", product.Currency)
+	assert.Equal(t, "20.00", product.BasePriceDisplay)
+
+	_, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Market: "UK", Currency: "EUR"})
+	assert.ErrorIs(t, err, domain.ErrMarketCurrencyMismatch)
+	_, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Market: "JP"})
+	assert.ErrorIs(t, err, domain.ErrInvalidMarket)
+
+	list, err := q.ListProducts(ctx, ListProductsRequest{Market: "UK"})
+	require.NoError(t, err)
+	require.Len(t, list.Products, 1)
+	assert.Equal(t, "UK", list.Products[0].Market)
+	assert.Equal(t, "14.40", list.Products[0].EffectivePriceDisplay)
+}
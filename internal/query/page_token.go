@@ -0,0 +1,77 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/pagetoken"
+)
+
+// Listings issuing page tokens; a token is only valid for the listing that issued it.
+const (
+	listingProducts   = "list_products"
+	listingByCategory = "list_by_category"
+	listingSearch     = "search_products"
+)
+
+// pageTokenScope is what a page token is sealed for: a listing with its filters, for a
+// tenant. Page sizes and pricing are left out, so they can change from page to page.
+type pageTokenScope struct {
+	Listing string `json:"listing"`
+	Tenant  string `json:"tenant"`
+	Scoped  bool   `json:"scoped"`
+	Filter  any    `json:"filter"`
+}
+
+// listPage is the filter of a ListProducts page token.
+type listPage struct {
+	Filter  contract.ListProductsFilter `json:"filter"`
+	OrderBy string                      `json:"order_by"`
+}
+
+// searchPage is the filter of a SearchProducts page token.
+type searchPage struct {
+	Filter contract.SearchProductsFilter `json:"filter"`
+	Engine bool                          `json:"engine"`
+}
+
+// WithPageTokens makes ListProducts, ListProductsByCategory and SearchProducts issue page
+// tokens signed by signer, and reject tokens not issued by them for the same filters.
+// Without it, page tokens are unsigned (see pagetoken.NewUnsigned): they hide the cursors
+// of the read model and are bound to the filters, but can be forged.
+func WithPageTokens(signer *pagetoken.Signer) Option {
+	return func(q *ProductQueries) {
+		q.pageTokens = signer
+	}
+}
+
+// openPageToken returns the read model cursor of a page token of listing with filter; an
+// empty token is the first page. It fails with domain.ErrInvalidPageToken if the token
+// was not issued for them.
+func (q *ProductQueries) openPageToken(ctx context.Context, token, listing string, filter any) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	cursor, err := q.pageTokens.Open(token, newPageTokenScope(ctx, listing, filter))
+	if errors.Is(err, pagetoken.ErrInvalidToken) {
+		return "", domain.ErrInvalidPageToken
+	}
+	return cursor, err
+}
+
+// sealPageToken returns the page token of a read model cursor of listing with filter; an
+// empty cursor is the last page.
+func (q *ProductQueries) sealPageToken(ctx context.Context, cursor, listing string, filter any) (string, error) {
+	if cursor == "" {
+		return "", nil
+	}
+	return q.pageTokens.Seal(cursor, newPageTokenScope(ctx, listing, filter))
+}
+
+func newPageTokenScope(ctx context.Context, listing string, filter any) pageTokenScope {
+	tenant, scoped := caller.Tenant(ctx)
+	return pageTokenScope{Listing: listing, Tenant: tenant, Scoped: scoped, Filter: filter}
+}
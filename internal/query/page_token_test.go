@@ -0,0 +1,117 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/pagetoken"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cursorReadModel lists pages ending with a fixed cursor and records the cursor and page
+// number of the page requested.
+type cursorReadModel struct {
+	contract.ProductReadModel
+	next       string
+	cursor     string
+	pageNumber int32
+}
+
+func (rm *cursorReadModel) ListProducts(_ context.Context, _ contract.ListProductsFilter, pagination contract.Pagination, _ time.Time) (*contract.ListProductsResult, error) {
+	rm.cursor, rm.pageNumber = pagination.PageToken, pagination.PageNumber
+	return &contract.ListProductsResult{NextPageToken: rm.next}, nil
+}
+
+func (rm *cursorReadModel) ListByCategory(_ context.Context, _ string, pagination contract.Pagination, _ time.Time) (*contract.ListProductsResult, error) {
+	rm.cursor = pagination.PageToken
+	return &contract.ListProductsResult{NextPageToken: rm.next}, nil
+}
+
+func TestProductQueries_PageTokens(t *testing.T) {
+	signer, err := pagetoken.NewSigner([]byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+
+	for name, opts := range map[string][]Option{
+		"signed":   {WithPageTokens(signer)},
+		"unsigned": nil,
+	} {
+		t.Run(name, func(t *testing.T) {
+			readModel := &cursorReadModel{next: "product-2"}
+			q := NewProductQueries(readModel, clock.NewFixedClock(time.Now()), opts...)
+			ctx := context.Background()
+
+			tools := ListProductsRequest{Category: "Tools", OrderBy: contract.OrderByName}
+			first, err := q.ListProducts(ctx, tools)
+			require.NoError(t, err)
+			assert.Empty(t, readModel.cursor)
+			require.NotEmpty(t, first.NextPageToken)
+			assert.NotEqual(t, "product-2", first.NextPageToken)
+
+			// The token continues the same listing, whatever the page size
+			tools.PageToken, tools.PageSize = first.NextPageToken, 50
+			_, err = q.ListProducts(ctx, tools)
+			require.NoError(t, err)
+			assert.Equal(t, "product-2", readModel.cursor)
+
+			// It is rejected with other filters, another ordering, for another tenant or listing
+			for name, req := range map[string]ListProductsRequest{
+				"category": {Category: "Toys", OrderBy: contract.OrderByName, PageToken: first.NextPageToken},
+				"order by": {Category: "Tools", OrderBy: contract.OrderByPrice, PageToken: first.NextPageToken},
+				"raw":      {Category: "Tools", OrderBy: contract.OrderByName, PageToken: "product-2"},
+			} {
+				t.Run(name, func(t *testing.T) {
+					_, err := q.ListProducts(ctx, req)
+					assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+				})
+			}
+			_, err = q.ListProducts(caller.NewContext(ctx, caller.Identity{Tenant: "acme"}), tools)
+			assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+			_, err = q.ListProductsByCategory(ctx, "Tools", 20, first.NextPageToken)
+			assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+
+			byCategory, err := q.ListProductsByCategory(ctx, "Tools", 20, "")
+			require.NoError(t, err)
+			_, err = q.ListProductsByCategory(ctx, "Tools", 20, byCategory.NextPageToken)
+			require.NoError(t, err)
+			assert.Equal(t, "product-2", readModel.cursor)
+
+			// The last page has no token
+			readModel.next = ""
+			last, err := q.ListProducts(ctx, tools)
+			require.NoError(t, err)
+			assert.Empty(t, last.NextPageToken)
+		})
+	}
+}
+
+func TestProductQueries_PageNumber(t *testing.T) {
+	readModel := &cursorReadModel{}
+	q := NewProductQueries(readModel, clock.NewFixedClock(time.Now()))
+	ctx := context.Background()
+
+	_, err := q.ListProducts(ctx, ListProductsRequest{PageNumber: 3, PageSize: 50})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), readModel.pageNumber)
+
+	// The last page within the cap, with the default page size of 20
+	_, err = q.ListProducts(ctx, ListProductsRequest{PageNumber: MaxOffsetProducts / 20})
+	require.NoError(t, err)
+
+	for name, req := range map[string]ListProductsRequest{
+		"negative":        {PageNumber: -1},
+		"with page token": {PageNumber: 2, PageToken: "product-2"},
+		"past the cap":    {PageNumber: MaxOffsetProducts/100 + 1, PageSize: 100},
+		"capped size":     {PageNumber: MaxOffsetProducts/100 + 1, PageSize: 500},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := q.ListProducts(ctx, req)
+			assert.ErrorIs(t, err, domain.ErrInvalidPageNumber)
+		})
+	}
+}
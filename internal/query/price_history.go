@@ -0,0 +1,83 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// GetPriceHistoryRequest represents the input for reading the price history of a product.
+type GetPriceHistoryRequest struct {
+	ProductID string
+	// From and To bound the changes returned to [From, To); a zero value leaves that end
+	// of the range open.
+	From time.Time
+	To   time.Time
+}
+
+// PriceHistoryEntry represents the prices of a product right after a change of its base
+// price or discounts.
+type PriceHistoryEntry struct {
+	ChangedAt                 time.Time
+	ChangeID                  string
+	ChangeType                string
+	BasePriceNumerator        int64
+	BasePriceDenominator      int64
+	EffectivePriceNumerator   int64
+	EffectivePriceDenominator int64
+	BasePriceDisplay          string
+	EffectivePriceDisplay     string
+	Currency                  string
+	// DiscountID and DiscountPercent are set only if a discount applied after the change.
+	DiscountID      string
+	DiscountPercent *float64
+}
+
+// PriceHistoryResponse represents the price history of a product, oldest change first.
+type PriceHistoryResponse struct {
+	ProductID string
+	Entries   []*PriceHistoryEntry
+}
+
+// GetPriceHistory returns the changes of the base price and discounts of a product in the
+// requested range, each with the prices in effect right after it.
+func (q *ProductQueries) GetPriceHistory(ctx context.Context, req GetPriceHistoryRequest) (*PriceHistoryResponse, error) {
+	if req.ProductID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	if !req.From.IsZero() && !req.To.IsZero() && !req.To.After(req.From) {
+		return nil, domain.ErrInvalidHistoryRange
+	}
+
+	dtos, err := q.readModel.GetPriceHistory(ctx, req.ProductID, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &PriceHistoryResponse{
+		ProductID: req.ProductID,
+		Entries:   make([]*PriceHistoryEntry, len(dtos)),
+	}
+	for i, dto := range dtos {
+		resp.Entries[i] = priceHistoryEntryFromDTO(dto)
+	}
+	q.roundPriceHistory(resp.Entries)
+	return resp, nil
+}
+
+func priceHistoryEntryFromDTO(dto *contract.PriceHistoryEntryDTO) *PriceHistoryEntry {
+	return &PriceHistoryEntry{
+		ChangedAt:                 dto.ChangedAt,
+		ChangeID:                  dto.ChangeID,
+		ChangeType:                dto.ChangeType,
+		BasePriceNumerator:        dto.BasePriceNum,
+		BasePriceDenominator:      dto.BasePriceDenom,
+		EffectivePriceNumerator:   dto.EffectivePriceNum,
+		EffectivePriceDenominator: dto.EffectivePriceDenom,
+		Currency:                  dto.Currency,
+		DiscountID:                dto.DiscountID,
+		DiscountPercent:           dto.DiscountPercent,
+	}
+}
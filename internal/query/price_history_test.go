@@ -0,0 +1,77 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// historyReadModel serves GetPriceHistory from a fixed list of entries and records the
+// requested range.
+type historyReadModel struct {
+	contract.ProductReadModel
+	entries  []*contract.PriceHistoryEntryDTO
+	from, to time.Time
+}
+
+func (rm *historyReadModel) GetPriceHistory(_ context.Context, _ string, from, to time.Time) ([]*contract.PriceHistoryEntryDTO, error) {
+	rm.from, rm.to = from, to
+	return rm.entries, nil
+}
+
+func TestProductQueries_GetPriceHistory(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	pct := 12.5
+	readModel := &historyReadModel{entries: []*contract.PriceHistoryEntryDTO{
+		{ChangedAt: now.Add(-2 * time.Hour), ChangeID: "change-1", ChangeType: "product.created",
+			BasePriceNum: 2000, BasePriceDenom: 100, EffectivePriceNum: 2000, EffectivePriceDenom: 100, Currency: "USD"},
+		{ChangedAt: now.Add(-time.Hour), ChangeID: "change-2", ChangeType: "product.discount_applied",
+			BasePriceNum: 2000, BasePriceDenom: 100, EffectivePriceNum: 35, EffectivePriceDenom: 2, Currency: "USD",
+			DiscountID: "discount-1", DiscountPercent: &pct},
+	}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(now))
+
+	resp, err := q.GetPriceHistory(context.Background(), GetPriceHistoryRequest{ProductID: "product-1", From: now.Add(-24 * time.Hour)})
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-24*time.Hour), readModel.from)
+	assert.True(t, readModel.to.IsZero())
+
+	assert.Equal(t, "product-1", resp.ProductID)
+	require.Len(t, resp.Entries, 2)
+	assert.Equal(t, "product.created", resp.Entries[0].ChangeType)
+	assert.Nil(t, resp.Entries[0].DiscountPercent)
+	assert.Equal(t, "20.00", resp.Entries[0].EffectivePriceDisplay)
+	assert.Equal(t, "discount-1", resp.Entries[1].DiscountID)
+	assert.Equal(t, &pct, resp.Entries[1].DiscountPercent)
+	assert.Equal(t, int64(35), resp.Entries[1].EffectivePriceNumerator)
+	assert.Equal(t, "17.50", resp.Entries[1].EffectivePriceDisplay)
+	assert.Equal(t, "20.00", resp.Entries[1].BasePriceDisplay)
+}
+
+func TestProductQueries_GetPriceHistory_InvalidRequest(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	q := NewProductQueries(&historyReadModel{}, clock.NewFixedClock(now))
+
+	tests := []struct {
+		name    string
+		req     GetPriceHistoryRequest
+		wantErr error
+	}{
+		{"missing product ID", GetPriceHistoryRequest{}, domain.ErrInvalidID},
+		{"empty range", GetPriceHistoryRequest{ProductID: "product-1", From: now, To: now}, domain.ErrInvalidHistoryRange},
+		{"reversed range", GetPriceHistoryRequest{ProductID: "product-1", From: now, To: now.Add(-time.Hour)}, domain.ErrInvalidHistoryRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := q.GetPriceHistory(context.Background(), tt.req)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ErrPriceListsDisabled is returned by GetPriceListPrice when no price list reader was
+// configured with WithPriceLists.
+var ErrPriceListsDisabled = errors.New("price lists are not enabled")
+
+// Where the price of a product for a price list comes from.
+const (
+	// PriceListSourceEntry means the price list has an entry for the product.
+	PriceListSourceEntry = "price_list"
+	// PriceListSourceBasePrice means the price list has no entry for the product or is
+	// not valid at the pricing time, so the product's base price applies.
+	PriceListSourceBasePrice = "base_price"
+)
+
+// GetPriceListPriceRequest represents the input for pricing a product for a price list.
+type GetPriceListPriceRequest struct {
+	PriceListID string
+	ProductID   string
+	// At is the pricing time; the zero value means now.
+	At time.Time
+}
+
+// PriceListPriceResponse represents the price of a product for a price list. The price
+// is exact; the display field is rounded.
+type PriceListPriceResponse struct {
+	PriceListID      string
+	ProductID        string
+	Source           string
+	PriceNumerator   int64
+	PriceDenominator int64
+	PriceDisplay     string
+	// Currency is the currency of the price list, or of the product if its base price
+	// applies.
+	Currency string
+	Status   string
+}
+
+// WithPriceLists enables GetPriceListPrice with the price lists of priceLists.
+func WithPriceLists(priceLists contract.PriceListReader) Option {
+	return func(q *ProductQueries) {
+		q.priceLists = priceLists
+	}
+}
+
+// GetPriceListPrice prices a product for a price list at the requested time: the price of
+// its entry if the list has one and is valid at that time, or else the product's base
+// price in the product's currency. Discounts never apply to either.
+func (q *ProductQueries) GetPriceListPrice(ctx context.Context, req GetPriceListPriceRequest) (*PriceListPriceResponse, error) {
+	if q.priceLists == nil {
+		return nil, ErrPriceListsDisabled
+	}
+	if req.PriceListID == "" || req.ProductID == "" {
+		return nil, domain.ErrInvalidID
+	}
+
+	at := req.At
+	if at.IsZero() {
+		at = q.clock.Now()
+	}
+
+	dtos, err := q.readModel.GetEffectivePrices(ctx, []string{req.ProductID}, at)
+	if err != nil {
+		return nil, err
+	}
+	if len(dtos) == 0 {
+		return nil, domain.ErrProductNotFound
+	}
+	product := dtos[0]
+
+	entry, err := q.priceLists.GetPriceListEntry(ctx, req.PriceListID, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &PriceListPriceResponse{
+		PriceListID:      entry.PriceListID,
+		ProductID:        product.ProductID,
+		Source:           PriceListSourceBasePrice,
+		PriceNumerator:   product.BasePriceNum,
+		PriceDenominator: product.BasePriceDenom,
+		Currency:         product.Currency,
+		Status:           product.Status,
+	}
+	if entry.HasEntry && priceListValidAt(entry, at) {
+		resp.Source = PriceListSourceEntry
+		resp.PriceNumerator = entry.PriceNum
+		resp.PriceDenominator = entry.PriceDenom
+		resp.Currency = entry.Currency
+	}
+	resp.PriceDisplay = q.display(resp.PriceNumerator, resp.PriceDenominator)
+	return resp, nil
+}
+
+// priceListValidAt reports whether the price list of entry prices products at t.
+func priceListValidAt(entry *contract.PriceListEntryDTO, t time.Time) bool {
+	return !t.Before(entry.ValidFrom) && (entry.ValidUntil == nil || t.Before(*entry.ValidUntil))
+}
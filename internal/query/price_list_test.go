@@ -0,0 +1,90 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePriceListReader struct {
+	entry *contract.PriceListEntryDTO
+}
+
+func (r *fakePriceListReader) GetPriceListEntry(_ context.Context, priceListID, _ string) (*contract.PriceListEntryDTO, error) {
+	if r.entry == nil || r.entry.PriceListID != priceListID {
+		return nil, domain.ErrPriceListNotFound
+	}
+	return r.entry, nil
+}
+
+func TestProductQueries_GetPriceListPrice(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	until := now.AddDate(0, 1, 0)
+	readModel := &priceReadModel{prices: []*contract.PriceDTO{{
+		ProductID:           "product-1",
+		BasePriceNum:        2500,
+		BasePriceDenom:      100,
+		EffectivePriceNum:   1999,
+		EffectivePriceDenom: 100,
+		Currency:            "USD",
+		Status:              "active",
+	}}}
+	reader := &fakePriceListReader{entry: &contract.PriceListEntryDTO{
+		PriceListID: "price-list-1",
+		Currency:    "EUR",
+		ValidFrom:   now.AddDate(0, -1, 0),
+		ValidUntil:  &until,
+		HasEntry:    true,
+		PriceNum:    2000,
+		PriceDenom:  3,
+	}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(now), WithPriceLists(reader))
+
+	tests := []struct {
+		name         string
+		at           time.Time
+		hasEntry     bool
+		wantSource   string
+		wantCurrency string
+		wantDisplay  string
+	}{
+		{"entry", time.Time{}, true, PriceListSourceEntry, "EUR", "666.67"},
+		{"no entry", time.Time{}, false, PriceListSourceBasePrice, "USD", "25.00"},
+		{"list expired", until, true, PriceListSourceBasePrice, "USD", "25.00"},
+		{"list not yet valid", now.AddDate(0, -2, 0), true, PriceListSourceBasePrice, "USD", "25.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader.entry.HasEntry = tt.hasEntry
+			resp, err := q.GetPriceListPrice(context.Background(), GetPriceListPriceRequest{
+				PriceListID: "price-list-1",
+				ProductID:   "product-1",
+				At:          tt.at,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSource, resp.Source)
+			assert.Equal(t, tt.wantCurrency, resp.Currency)
+			assert.Equal(t, tt.wantDisplay, resp.PriceDisplay)
+			assert.Equal(t, "active", resp.Status)
+		})
+	}
+
+	_, err := q.GetPriceListPrice(context.Background(), GetPriceListPriceRequest{PriceListID: "price-list-2", ProductID: "product-1"})
+	assert.ErrorIs(t, err, domain.ErrPriceListNotFound)
+
+	_, err = q.GetPriceListPrice(context.Background(), GetPriceListPriceRequest{ProductID: "product-1"})
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+
+	_, err = NewProductQueries(readModel, clock.NewFixedClock(now)).GetPriceListPrice(context.Background(), GetPriceListPriceRequest{
+		PriceListID: "price-list-1",
+		ProductID:   "product-1",
+	})
+	assert.ErrorIs(t, err, ErrPriceListsDisabled)
+}
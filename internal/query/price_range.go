@@ -0,0 +1,48 @@
+package query
+
+import (
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// priceRange validates the bounds of a price range filter and returns them with their
+// currencies normalized; a bound without a currency is in domain.DefaultCurrency. Either
+// bound may be nil.
+func priceRange(min, max *contract.PriceBound) (*contract.PriceBound, *contract.PriceBound, error) {
+	var err error
+	if min, err = priceBound(min); err != nil {
+		return nil, nil, err
+	}
+	if max, err = priceBound(max); err != nil {
+		return nil, nil, err
+	}
+	if min == nil || max == nil {
+		return min, max, nil
+	}
+
+	low, _ := domain.NewMoneyInCurrency(min.Numerator, min.Denominator, min.Currency)
+	high, _ := domain.NewMoneyInCurrency(max.Numerator, max.Denominator, max.Currency)
+	if !low.SameCurrency(high) || low.GreaterThan(high) {
+		return nil, nil, domain.ErrInvalidPriceRange
+	}
+	return min, max, nil
+}
+
+// priceBound validates a bound of a price range: a non-negative amount with a positive
+// denominator in a known currency.
+func priceBound(bound *contract.PriceBound) (*contract.PriceBound, error) {
+	if bound == nil {
+		return nil, nil
+	}
+	if bound.Numerator < 0 || bound.Denominator <= 0 {
+		return nil, domain.ErrInvalidPriceRange
+	}
+	currency := domain.DefaultCurrency
+	if bound.Currency != "" {
+		var err error
+		if currency, err = domain.ParseCurrency(bound.Currency); err != nil {
+			return nil, err
+		}
+	}
+	return &contract.PriceBound{Numerator: bound.Numerator, Denominator: bound.Denominator, Currency: currency}, nil
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductQueries_ListProducts_PriceRange(t *testing.T) {
+	readModel := &filterReadModel{productReadModel: productReadModel{product: widgetDTO()}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(time.Now()))
+	ctx := context.Background()
+
+	_, err := q.ListProducts(ctx, ListProductsRequest{
+		MinPrice: &contract.PriceBound{Numerator: 10, Denominator: 1},
+		MaxPrice: &contract.PriceBound{Numerator: 4999, Denominator: 100, Currency: "usd"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &contract.PriceBound{Numerator: 10, Denominator: 1, Currency: "USD"}, readModel.filter.MinPrice,
+		"a bound without a currency is in the default currency")
+	assert.Equal(t, &contract.PriceBound{Numerator: 4999, Denominator: 100, Currency: "USD"}, readModel.filter.MaxPrice)
+
+	_, err = q.ListProducts(ctx, ListProductsRequest{MaxPrice: &contract.PriceBound{Numerator: 20, Denominator: 1, Currency: "EUR"}})
+	require.NoError(t, err)
+	assert.Nil(t, readModel.filter.MinPrice)
+	assert.Equal(t, "EUR", readModel.filter.MaxPrice.Currency)
+
+	tests := []struct {
+		name     string
+		min, max *contract.PriceBound
+		wantErr  error
+	}{
+		{"negative", &contract.PriceBound{Numerator: -1, Denominator: 1}, nil, domain.ErrInvalidPriceRange},
+		{"zero denominator", nil, &contract.PriceBound{Numerator: 1}, domain.ErrInvalidPriceRange},
+		{"min above max", &contract.PriceBound{Numerator: 21, Denominator: 1}, &contract.PriceBound{Numerator: 20, Denominator: 1}, domain.ErrInvalidPriceRange},
+		{"currencies differ", &contract.PriceBound{Numerator: 1, Denominator: 1, Currency: "EUR"}, &contract.PriceBound{Numerator: 20, Denominator: 1}, domain.ErrInvalidPriceRange},
+		{"unknown currency", &contract.PriceBound{Numerator: 1, Denominator: 1, Currency: "dollars"}, nil, domain.ErrInvalidCurrency},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := q.ListProducts(ctx, ListProductsRequest{MinPrice: tt.min, MaxPrice: tt.max})
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -3,16 +3,40 @@ package query
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/product-catalog-service/internal/contract"
 	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/experiment"
 	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/pagetoken"
+	"github.com/product-catalog-service/internal/pricelock"
 )
 
 // GetProductRequest represents the input for getting a product.
 type GetProductRequest struct {
 	ProductID string
+	// Currency prices the product in another currency; empty means its own currency.
+	Currency string
+	// Experiment prices the product in the pricing experiment variant of its subject.
+	Experiment ExperimentContext
+	// Segment prices the product for a customer segment, e.g. "wholesale"; empty or a
+	// segment the product has no price for means its regular prices.
+	Segment string
+	// Market prices the product in a market, e.g. "EU"; empty or a market the product
+	// has no price in means its default prices.
+	Market string
+	// PriceAt is the pricing time, within MaxPriceAtPast and MaxPriceAtFuture of now; the
+	// zero value means now.
+	PriceAt time.Time
+	// Locale is the locale of the name and description, e.g. "de"; empty means
+	// domain.DefaultLocale. A product without a translation in the locale falls back to
+	// the translation in its language, then to the default locale.
+	Locale string
+	// ReadStaleness is how stale the product may be, e.g. "15s" or "exact:15s" (see
+	// package staleness); empty means the default of the read model.
+	ReadStaleness string
 }
 
 // ListProductsRequest represents the input for listing products.
@@ -22,89 +46,653 @@ type ListProductsRequest struct {
 	ActiveOnly bool
 	PageSize   int32
 	PageToken  string
+	// Currency prices the products in another currency; empty means their own currency.
+	Currency string
+	// OrderBy is contract.OrderByProductID (the default), contract.OrderByMerchandised,
+	// contract.OrderByRating or one of the column orderings, e.g. contract.OrderByPriceDesc.
+	OrderBy string
+	// Market prices the products in a market; those without a price there keep their
+	// default prices.
+	Market string
+	// PriceAt is the pricing time, as in GetProductRequest.
+	PriceAt time.Time
+	// InStockOnly leaves out products whose stock is tracked and has no available units.
+	InStockOnly bool
+	// HasActiveDiscount lists only the products with a discount that applies at PriceAt.
+	HasActiveDiscount bool
+	// IncludeArchived lists archived products too, for back-office tools.
+	IncludeArchived bool
+	// ReadStaleness is how stale the products may be, as in GetProductRequest.
+	ReadStaleness string
+	// Tag lists only the products with the tag.
+	Tag string
+	// Channel lists only the products available on the sales channel.
+	Channel string
+	// MinPrice and MaxPrice list only the products whose effective price at PriceAt, in
+	// their own currency, is at least or at most the bound; nil does not bound it. A bound
+	// without a currency is in domain.DefaultCurrency.
+	MinPrice *contract.PriceBound
+	MaxPrice *contract.PriceBound
+	// Locale is the locale of the names, as in GetProductRequest.
+	Locale string
+	// IncludeTotalCount also counts the products listed on all pages, in TotalCount.
+	IncludeTotalCount bool
+	// PageNumber, if positive, lists the page with that 1-based number by offset rather
+	// than the page after PageToken, for admin tools that jump to a page; the response has
+	// no NextPageToken. Offset pages only reach the first MaxOffsetProducts products.
+	PageNumber int32
 }
 
+// MaxOffsetProducts is how deep ListProducts pages by page number. The database skips
+// every product before an offset page, so deeper pages need page tokens.
+const MaxOffsetProducts = 10000
+
+// Bounds of the pricing time of GetProduct and ListProducts. Products are priced with
+// their current discounts, which says little about their prices long ago;
+// GetPriceHistory reports those.
+const (
+	MaxPriceAtPast   = 30 * 24 * time.Hour
+	MaxPriceAtFuture = 366 * 24 * time.Hour
+)
+
 // ProductResponse represents the response for getting a product.
 type ProductResponse struct {
 	ID                        string
 	Name                      string
+	Slug                      string
+	SKU                       string
+	GTIN                      string
 	Description               string
 	Category                  string
 	BasePriceNumerator        int64
 	BasePriceDenominator      int64
 	EffectivePriceNumerator   int64
 	EffectivePriceDenominator int64
-	DiscountPercent           *float64
-	DiscountStartDate         *time.Time
-	DiscountEndDate           *time.Time
+	// BasePriceDisplay and EffectivePriceDisplay are the prices rounded for display by
+	// the configured rounding policy, e.g. "19.99".
+	BasePriceDisplay      string
+	EffectivePriceDisplay string
+	// Currency is the ISO 4217 code of every price of the product.
+	Currency string
+	// TaxClass names the tax treatment of the product, e.g. "standard".
+	TaxClass          string
+	DiscountPercent   *float64
+	DiscountStartDate *time.Time
+	DiscountEndDate   *time.Time
+	DiscountSuspended bool
+	HasActiveDiscount bool
+	Status            string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	// Discounts lists every discount of the product, ordered by start date.
+	Discounts []*DiscountResponse
+	// PriceTiers lists the volume price tiers of the product, ordered by minimum quantity.
+	PriceTiers []*PriceTierResponse
+	// PriceBook lists the base prices of the product in other currencies, ordered by
+	// currency.
+	PriceBook []*PriceBookEntryResponse
+	// SegmentPrices lists the customer segment prices of the product, ordered by segment.
+	SegmentPrices []*SegmentPriceResponse
+	// Segment is set to the requested customer segment when the prices are for it.
+	Segment string
+	// MarketPrices lists the prices of the product in its markets, ordered by market.
+	MarketPrices []*MarketPriceResponse
+	// Market is set to the requested market when the prices are for it.
+	Market string
+	// Variants lists the variants of the product, ordered by ID.
+	Variants []*VariantResponse
+	// PriceSource tells where the prices come from: one of the PriceSource constants.
+	PriceSource string
+	// MinimumPriceNumerator and MinimumPriceDenominator are the price floor of the
+	// product in Currency; both are zero if it has none or the prices are converted or
+	// for a market.
+	MinimumPriceNumerator   int64
+	MinimumPriceDenominator int64
+	MinimumPriceDisplay     string
+	// CostPriceNumerator and CostPriceDenominator are the cost price of the product in
+	// Currency; both are zero if it is not recorded or the prices are converted or for a
+	// market.
+	CostPriceNumerator   int64
+	CostPriceDenominator int64
+	CostPriceDisplay     string
+	// PendingPriceChange is the scheduled base price change of the product; nil if none
+	// is scheduled or the prices are converted or for a market.
+	PendingPriceChange *PendingPriceChangeResponse
+	// ActivateAt and DeactivateAt are when the product is scheduled to be activated and
+	// deactivated; nil if no such change is scheduled.
+	ActivateAt   *time.Time
+	DeactivateAt *time.Time
+	// Review is the latest review of the product; nil if it was never submitted for
+	// review.
+	Review *ReviewResponse
+	// Kind is "physical" or "digital"; empty if unset.
+	Kind string
+	// Compliance holds the regulatory flags of the product; nil if it has none.
+	Compliance *ComplianceResponse
+	// Weight and Dimensions are what the product weighs and measures packed for
+	// shipping; nil if unset.
+	Weight     *WeightResponse
+	Dimensions *DimensionsResponse
+	// Rating summarizes the customer ratings of the product.
+	Rating RatingResponse
+	// Channels are the sales channels the product is available on, in name order.
+	Channels []string
+	// InStock reports whether units of the product are available, or its stock is not
+	// tracked.
+	InStock bool
+	// Stock is the stock of the product; nil if it is not tracked.
+	Stock *StockResponse
+	// Tags are the tags of the product, sorted; Attributes are its attributes.
+	Tags       []string
+	Attributes map[string]string
+	// Images lists the images of the product in display order.
+	Images []ImageResponse
+	// Attachments lists the support documents of the product in display order.
+	Attachments []AttachmentResponse
+	// Locale is the locale Name and Description are in.
+	Locale string
+	// CachedAt is set when the product was served from the cache while the database is
+	// unavailable; it may be stale.
+	CachedAt *time.Time
+	// Experiment is set when the product is priced in a pricing experiment variant.
+	Experiment *ExperimentTag
+}
+
+// PendingPriceChangeResponse represents a scheduled change of the base price.
+type PendingPriceChangeResponse struct {
+	PriceNumerator   int64
+	PriceDenominator int64
+	PriceDisplay     string
+	EffectiveAt      time.Time
+}
+
+// ReviewResponse represents the latest review of a product. ReviewedBy is empty while
+// the review is pending; RejectionReason is empty unless the product was rejected.
+type ReviewResponse struct {
+	SubmittedBy     string
+	ReviewedBy      string
+	RejectionReason string
+}
+
+// ComplianceResponse represents the compliance flags of a product. MinimumAge is zero if
+// the product is not age-restricted; RestrictedMarkets are sorted market codes.
+type ComplianceResponse struct {
+	MinimumAge        int
+	Hazardous         bool
+	RestrictedMarkets []string
+}
+
+// WeightResponse represents the weight of a product in the unit it was given in, and in
+// grams.
+type WeightResponse struct {
+	Value float64
+	Unit  string
+	Grams float64
+}
+
+// DimensionsResponse represents the dimensions of a product in the unit they were given
+// in, and in millimetres.
+type DimensionsResponse struct {
+	Height   float64
+	Width    float64
+	Depth    float64
+	Unit     string
+	HeightMM float64
+	WidthMM  float64
+	DepthMM  float64
+}
+
+// RatingResponse summarizes the customer ratings of a product: how many there are and
+// their average, both zero if it has none.
+type RatingResponse struct {
+	Count   int64
+	Average float64
+}
+
+// StockResponse represents the stock of a product. Version is incremented by every
+// change; writers pass it back to check that the stock did not change since they read it.
+type StockResponse struct {
+	Level     int64
+	Reserved  int64
+	Available int64
+	Version   int64
+}
+
+// ImageResponse represents one image of a product. The primary image is the one shown in
+// listings.
+type ImageResponse struct {
+	URL     string
+	AltText string
+	Primary bool
+}
+
+// AttachmentResponse represents one support document of a product, e.g. its manual.
+// Locale is empty for a document that is not written in any one language.
+type AttachmentResponse struct {
+	Type   string
+	URL    string
+	Locale string
+}
+
+// DiscountResponse represents one discount of a product.
+type DiscountResponse struct {
+	ID        string
+	Percent   float64
+	Priority  int
+	StartDate time.Time
+	EndDate   time.Time
+	Suspended bool
+	// Kind is "percentage" or "buy_x_get_y"; BuyQuantity and GetQuantity are set for
+	// buy-X-get-Y promotions only.
+	Kind        string
+	BuyQuantity int
+	GetQuantity int
+	// Market is the market the discount is scoped to; empty if it applies in every
+	// market.
+	Market string
+	// PendingApproval is set while the discount awaits approval and does not apply.
+	// ApprovedBy is who approved a discount that needed approval.
+	PendingApproval bool
+	ApprovedBy      string
+	// FlashSale is set if the discount is a flash sale.
+	FlashSale bool
+	// SalePriceNumerator and SalePriceDenominator are set for a discount expressed as a
+	// sale price, in the currency of the prices; Percent is then the implied percentage.
+	SalePriceNumerator   int64
+	SalePriceDenominator int64
+	SalePriceDisplay     string
+}
+
+// PriceTierResponse represents one price tier of a product. A tier has either a unit
+// price or a percent off; the other is zero.
+type PriceTierResponse struct {
+	MinQuantity          int64
+	UnitPriceNumerator   int64
+	UnitPriceDenominator int64
+	UnitPriceDisplay     string
+	PercentOff           float64
+}
+
+// SegmentPriceResponse represents the price of a product for one customer segment. It has
+// either a fixed price or a percent off; the other is zero.
+type SegmentPriceResponse struct {
+	Segment          string
+	PriceNumerator   int64
+	PriceDenominator int64
+	PriceDisplay     string
+	PercentOff       float64
+}
+
+// MarketPriceResponse represents the base and effective prices of a product in one
+// market, in the market's currency.
+type MarketPriceResponse struct {
+	Market                    string
+	Currency                  string
+	PriceNumerator            int64
+	PriceDenominator          int64
+	PriceDisplay              string
+	EffectivePriceNumerator   int64
+	EffectivePriceDenominator int64
+	EffectivePriceDisplay     string
 	HasActiveDiscount         bool
+}
+
+// VariantResponse represents one variant of a product. Its prices are the prices of the
+// product plus the price delta; discounts apply to them in proportion.
+type VariantResponse struct {
+	ID                        string
+	SKU                       string
+	Attributes                map[string]string
+	PriceDeltaNumerator       int64
+	PriceDeltaDenominator     int64
+	PriceDeltaDisplay         string
+	PriceNumerator            int64
+	PriceDenominator          int64
+	PriceDisplay              string
+	EffectivePriceNumerator   int64
+	EffectivePriceDenominator int64
+	EffectivePriceDisplay     string
 	Status                    string
-	CreatedAt                 time.Time
-	UpdatedAt                 time.Time
+}
+
+// PriceBookEntryResponse represents the base price of a product in another currency.
+type PriceBookEntryResponse struct {
+	Currency         string
+	PriceNumerator   int64
+	PriceDenominator int64
+	PriceDisplay     string
 }
 
 // ProductSummary represents a summary of a product in a list.
 type ProductSummary struct {
 	ID                        string
 	Name                      string
+	Slug                      string
+	SKU                       string
+	GTIN                      string
 	Category                  string
 	BasePriceNumerator        int64
 	BasePriceDenominator      int64
 	EffectivePriceNumerator   int64
 	EffectivePriceDenominator int64
-	HasActiveDiscount         bool
-	DiscountPercent           *float64
-	Status                    string
-	CreatedAt                 time.Time
+	BasePriceDisplay          string
+	EffectivePriceDisplay     string
+	Currency                  string
+	PriceSource               string
+	// Market is set to the requested market when the prices are for it.
+	Market            string
+	HasActiveDiscount bool
+	DiscountPercent   *float64
+	// InStock reports whether units of the product are available, or its stock is not
+	// tracked.
+	InStock bool
+	Tags    []string
+	// PrimaryImage is the image shown for the product in listings; nil if it has none.
+	PrimaryImage *ImageResponse
+	// Rating summarizes the customer ratings of the product.
+	Rating RatingResponse
+	// Channels are the sales channels the product is available on, as in ProductResponse.
+	Channels []string
+	// Locale is the locale Name is in; ListProducts sets it.
+	Locale    string
+	Status    string
+	CreatedAt time.Time
 }
 
 // ListProductsResponse represents the response for listing products.
 type ListProductsResponse struct {
 	Products      []*ProductSummary
 	NextPageToken string
-	TotalCount    int64
+	// TotalCount is the number of products listed on all pages; 0 unless
+	// IncludeTotalCount was requested.
+	TotalCount int64
+	// CachedAt is set when the list was served from the cache while the database is
+	// unavailable; it may be stale.
+	CachedAt *time.Time
+}
+
+// MaxEffectivePriceIDs is the maximum number of products priced by one GetEffectivePrices call.
+const MaxEffectivePriceIDs = 100
+
+// GetEffectivePricesRequest represents the input for pricing several products at once.
+type GetEffectivePricesRequest struct {
+	ProductIDs []string
+	// At is the pricing time; the zero value means now.
+	At time.Time
+	// Segment is the customer segment. It is accepted for forward compatibility;
+	// every segment currently gets the same price.
+	Segment string
+	// Experiment prices the products in the pricing experiment variants of its subject.
+	Experiment ExperimentContext
+}
+
+// EffectivePrice represents the price of a single product.
+type EffectivePrice struct {
+	ProductID                 string
+	BasePriceNumerator        int64
+	BasePriceDenominator      int64
+	EffectivePriceNumerator   int64
+	EffectivePriceDenominator int64
+	BasePriceDisplay          string
+	EffectivePriceDisplay     string
+	// DiscountPercent is set only if a discount applies at the pricing time.
+	DiscountPercent   *float64
+	HasActiveDiscount bool
+	Currency          string
+	Status            string
+	// Experiment is set when the price is in a pricing experiment variant.
+	Experiment *ExperimentTag
+}
+
+// GetEffectivePricesResponse represents the response for pricing several products.
+type GetEffectivePricesResponse struct {
+	// Prices are in request order, one per distinct existing product.
+	Prices            []*EffectivePrice
+	MissingProductIDs []string
+	// PriceLockToken locks the returned effective prices until PriceLockExpiresAt. It is
+	// only issued when pricing at the current time and price locks are enabled.
+	PriceLockToken     string
+	PriceLockExpiresAt *time.Time
+}
+
+// GetPriceForQuantityRequest represents the input for pricing a quantity of a product.
+type GetPriceForQuantityRequest struct {
+	ProductID string
+	Quantity  int64
+	// At is the pricing time; the zero value means now.
+	At time.Time
+}
+
+// QuantityPriceResponse represents the price of buying a quantity of a product.
+type QuantityPriceResponse struct {
+	ProductID             string
+	Quantity              int64
+	BasePriceNumerator    int64
+	BasePriceDenominator  int64
+	UnitPriceNumerator    int64
+	UnitPriceDenominator  int64
+	TotalPriceNumerator   int64
+	TotalPriceDenominator int64
+	BasePriceDisplay      string
+	UnitPriceDisplay      string
+	TotalPriceDisplay     string
+	// TierMinQuantity is the minimum quantity of the price tier applied; 0 if none applied.
+	TierMinQuantity int64
+	// DiscountPercent is set only if a discount applies at the pricing time.
+	DiscountPercent *float64
+	Currency        string
+	Status          string
+}
+
+// VerifyPriceLockRequest represents the input for verifying a price lock token.
+type VerifyPriceLockRequest struct {
+	Token string
+}
+
+// VerifyPriceLockResponse represents the prices locked by a valid token.
+type VerifyPriceLockResponse struct {
+	Prices    []pricelock.Price
+	IssuedAt  time.Time
+	ExpiresAt time.Time
 }
 
 // ProductQueries provides all product-related query operations.
 type ProductQueries struct {
 	readModel contract.ProductReadModel
 	clock     clock.Clock
+	priceLock *pricelock.Signer
+	rates     *domain.CurrencyRates
+	rounding  domain.RoundingPolicy
+
+	composition *domain.DiscountComposition
+
+	experiments  *experiment.Set
+	exposures    contract.ExposureRecorder
+	taxRates     domain.TaxRateProvider
+	priceLists   contract.PriceListReader
+	searchEngine contract.ProductSearchEngine
+	pageTokens   *pagetoken.Signer
+}
+
+// Option configures optional ProductQueries behavior.
+type Option func(*ProductQueries)
+
+// WithPriceLocks makes GetEffectivePrices issue price lock tokens signed by signer and
+// enables VerifyPriceLock.
+func WithPriceLocks(signer *pricelock.Signer) Option {
+	return func(q *ProductQueries) {
+		q.priceLock = signer
+	}
+}
+
+// WithCurrencyRates prices products in currencies missing from their price book by
+// converting their base price with rates.
+func WithCurrencyRates(rates *domain.CurrencyRates) Option {
+	return func(q *ProductQueries) {
+		q.rates = rates
+	}
+}
+
+// WithRoundingPolicy rounds the display prices of responses by policy instead of
+// domain.DefaultRoundingPolicy. The exact prices are never rounded.
+func WithRoundingPolicy(policy domain.RoundingPolicy) Option {
+	return func(q *ProductQueries) {
+		q.rounding = policy
+	}
+}
+
+// WithDiscountComposition makes CalculatePrice combine the discounts of a product valid at
+// the same time, and the coupon, under composition rather than apply only the discount
+// that takes precedence.
+func WithDiscountComposition(composition *domain.DiscountComposition) Option {
+	return func(q *ProductQueries) {
+		q.composition = composition
+	}
 }
 
 // NewProductQueries creates a new ProductQueries instance.
-func NewProductQueries(readModel contract.ProductReadModel, clock clock.Clock) *ProductQueries {
-	return &ProductQueries{
+func NewProductQueries(readModel contract.ProductReadModel, clock clock.Clock, opts ...Option) *ProductQueries {
+	q := &ProductQueries{
 		readModel: readModel,
 		clock:     clock,
+		rounding:  domain.DefaultRoundingPolicy,
+
+		pageTokens: pagetoken.NewUnsigned(),
+	}
+	for _, opt := range opts {
+		opt(q)
 	}
+	return q
 }
 
-// GetProduct retrieves a product by ID with its current effective price.
+// GetProduct retrieves a product by ID with its effective price at the requested time,
+// by default now, in the requested market, for the requested customer segment and in the
+// requested currency if any. A product priced in a market can only be priced in the
+// market's currency.
 func (q *ProductQueries) GetProduct(ctx context.Context, req GetProductRequest) (*ProductResponse, error) {
 	if req.ProductID == "" {
 		return nil, domain.ErrInvalidID
 	}
+	view, err := newProductView(req.Currency, req.Segment, req.Market, req.Locale, req.Experiment)
+	if err != nil {
+		return nil, err
+	}
+	if ctx, err = readWithin(ctx, req.ReadStaleness); err != nil {
+		return nil, err
+	}
 
 	now := q.clock.Now()
-	dto, err := q.readModel.GetProduct(ctx, req.ProductID, now)
+	at, err := pricingTime(req.PriceAt, now)
+	if err != nil {
+		return nil, err
+	}
+	dto, err := q.readModel.GetProduct(ctx, req.ProductID, at)
 	if err != nil {
 		return nil, err
 	}
 
-	return productResponseFromDTO(dto), nil
+	resp, exposures, err := q.presentProduct(dto, view, SurfaceGetProduct, now)
+	if err != nil {
+		return nil, err
+	}
+	q.recordExposures(ctx, exposures)
+	return resp, nil
+}
+
+// productView holds the validated options of a product read that say how to present the
+// product: in which currency, segment, market and locale and for which experiment subject.
+type productView struct {
+	currency   string
+	segment    string
+	market     domain.Market
+	locale     string
+	experiment ExperimentContext
+}
+
+// newProductView validates the presentation options of a product read.
+func newProductView(currency, segment, market, locale string, experiment ExperimentContext) (productView, error) {
+	var view productView
+	var err error
+	if view.currency, err = requestCurrency(currency); err != nil {
+		return productView{}, err
+	}
+	if view.segment, err = requestSegment(segment); err != nil {
+		return productView{}, err
+	}
+	if view.market, err = requestMarket(market); err != nil {
+		return productView{}, err
+	}
+	if view.locale, err = requestLocale(locale); err != nil {
+		return productView{}, err
+	}
+	if err := experiment.validate(); err != nil {
+		return productView{}, err
+	}
+	view.experiment = experiment
+	return view, nil
+}
+
+// presentProduct names and prices a product as view asks. It returns the exposure to
+// record, reported on surface at now, if the product is priced in an experiment variant.
+func (q *ProductQueries) presentProduct(dto *contract.ProductDTO, view productView, surface string, now time.Time) (*ProductResponse, []contract.Exposure, error) {
+	dto, locale := inLocale(dto, view.locale)
+	dto, inMarketPrice := inMarket(dto, view.market)
+	if inMarketPrice && view.currency != "" && view.currency != dto.Currency {
+		return nil, nil, domain.ErrMarketCurrencyMismatch
+	}
+	dto, inSegmentPrice := inSegment(dto, view.segment)
+
+	assignment, inExperiment := q.assign(view.experiment, dto.ID, dto.Category)
+	if inExperiment {
+		dto = inVariant(dto, assignment.Variant)
+	}
+
+	dto, source, err := inCurrency(dto, view.currency, q.rates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := productResponseFromDTO(dto)
+	resp.PriceSource = source
+	resp.Locale = locale
+	if inSegmentPrice {
+		resp.Segment = view.segment
+	}
+	if inMarketPrice {
+		resp.Market = view.market.String()
+	}
+	q.roundProduct(resp)
+
+	if !inExperiment {
+		return resp, nil, nil
+	}
+	resp.Experiment = &ExperimentTag{ExperimentID: assignment.ExperimentID, Variant: assignment.Variant.Name}
+	return resp, []contract.Exposure{exposure(assignment, view.experiment.SubjectID, resp.ID, surface,
+		resp.EffectivePriceNumerator, resp.EffectivePriceDenominator, resp.Currency, now)}, nil
 }
 
-// ListProducts lists products with optional filters and pagination.
+// ListProducts lists products with optional filters and pagination, priced at the
+// requested time and in the requested market and currency if any. It fails if any listed
+// product cannot be priced in the currency.
 func (q *ProductQueries) ListProducts(ctx context.Context, req ListProductsRequest) (*ListProductsResponse, error) {
-	filter := contract.ListProductsFilter{
-		Category:   req.Category,
-		Status:     req.Status,
-		ActiveOnly: req.ActiveOnly,
+	view, err := newProductView(req.Currency, "", req.Market, req.Locale, ExperimentContext{})
+	if err != nil {
+		return nil, err
+	}
+	if ctx, err = readWithin(ctx, req.ReadStaleness); err != nil {
+		return nil, err
+	}
+	filter, err := listFilter(req)
+	if err != nil {
+		return nil, err
 	}
 
 	pagination := contract.Pagination{
-		PageSize:  req.PageSize,
-		PageToken: req.PageToken,
+		PageSize:          req.PageSize,
+		PageToken:         req.PageToken,
+		OrderBy:           req.OrderBy,
+		IncludeTotalCount: req.IncludeTotalCount,
+		PageNumber:        req.PageNumber,
 	}
 
 	if pagination.PageSize <= 0 {
@@ -113,14 +701,197 @@ func (q *ProductQueries) ListProducts(ctx context.Context, req ListProductsReque
 	if pagination.PageSize > 100 {
 		pagination.PageSize = 100
 	}
+	switch pagination.OrderBy {
+	case "", contract.OrderByProductID, contract.OrderByMerchandised, contract.OrderByRating,
+		contract.OrderByName, contract.OrderByNameDesc, contract.OrderByCreatedAt, contract.OrderByCreatedAtDesc,
+		contract.OrderByUpdatedAt, contract.OrderByUpdatedAtDesc, contract.OrderByPrice, contract.OrderByPriceDesc:
+	default:
+		return nil, domain.ErrInvalidOrderBy
+	}
+	if pagination.PageNumber < 0 || pagination.PageNumber > 0 && req.PageToken != "" ||
+		int64(pagination.PageNumber)*int64(pagination.PageSize) > MaxOffsetProducts {
+		return nil, domain.ErrInvalidPageNumber
+	}
 
-	now := q.clock.Now()
-	result, err := q.readModel.ListProducts(ctx, filter, pagination, now)
+	page := listPage{Filter: filter, OrderBy: pagination.OrderBy}
+	if pagination.PageToken, err = q.openPageToken(ctx, req.PageToken, listingProducts, page); err != nil {
+		return nil, err
+	}
+
+	at, err := pricingTime(req.PriceAt, q.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	// A listing ordered by price keeps the pricing time of its first page.
+	byPrice := pagination.OrderBy == contract.OrderByPrice || pagination.OrderBy == contract.OrderByPriceDesc
+	if byPrice && pagination.PageToken != "" {
+		if at, pagination.PageToken, err = openPricedCursor(pagination.PageToken, req.PriceAt, q.clock.Now()); err != nil {
+			return nil, err
+		}
+	}
+	result, err := q.readModel.ListProducts(ctx, filter, pagination, at)
 	if err != nil {
 		return nil, err
 	}
 
-	return listProductsResponseFromDTOs(result), nil
+	if result == nil {
+		return listProductsResponseFromDTOs(result), nil
+	}
+
+	products, err := q.presentSummaries(result.Products, view)
+	if err != nil {
+		return nil, err
+	}
+	cursor := result.NextPageToken
+	if byPrice {
+		cursor = pricedCursor(at, cursor)
+	}
+	nextPageToken, err := q.sealPageToken(ctx, cursor, listingProducts, page)
+	if err != nil {
+		return nil, err
+	}
+	return &ListProductsResponse{
+		Products:      products,
+		NextPageToken: nextPageToken,
+		TotalCount:    result.TotalCount,
+		CachedAt:      result.CachedAt,
+	}, nil
+}
+
+// ListAllProducts streams all the products ListProducts lists for req to send, in product
+// ID order, paging through them internally; req.PageSize, req.PageToken and req.OrderBy
+// are ignored. It stops at the first error, including one returned by send.
+func (q *ProductQueries) ListAllProducts(ctx context.Context, req ListProductsRequest, send func(*ProductSummary) error) error {
+	view, err := newProductView(req.Currency, "", req.Market, req.Locale, ExperimentContext{})
+	if err != nil {
+		return err
+	}
+	if ctx, err = readWithin(ctx, req.ReadStaleness); err != nil {
+		return err
+	}
+	filter, err := listFilter(req)
+	if err != nil {
+		return err
+	}
+	at, err := pricingTime(req.PriceAt, q.clock.Now())
+	if err != nil {
+		return err
+	}
+
+	for dto, err := range q.readModel.AllProducts(ctx, filter, at) {
+		if err != nil {
+			return err
+		}
+		summaries, err := q.presentSummaries([]*contract.ProductDTO{dto}, view)
+		if err != nil {
+			return err
+		}
+		if err := send(summaries[0]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listFilter validates the filters of a ListProductsRequest.
+func listFilter(req ListProductsRequest) (contract.ListProductsFilter, error) {
+	filter := contract.ListProductsFilter{
+		Category:          req.Category,
+		Status:            req.Status,
+		ActiveOnly:        req.ActiveOnly,
+		InStockOnly:       req.InStockOnly,
+		HasActiveDiscount: req.HasActiveDiscount,
+		IncludeArchived:   req.IncludeArchived,
+	}
+	var err error
+	if req.Tag != "" {
+		if filter.Tag, err = domain.ParseTag(req.Tag); err != nil {
+			return contract.ListProductsFilter{}, err
+		}
+	}
+	if req.Channel != "" {
+		channel, err := domain.ParseSalesChannel(req.Channel)
+		if err != nil {
+			return contract.ListProductsFilter{}, err
+		}
+		filter.Channel = string(channel)
+	}
+	if filter.MinPrice, filter.MaxPrice, err = priceRange(req.MinPrice, req.MaxPrice); err != nil {
+		return contract.ListProductsFilter{}, err
+	}
+	return filter, nil
+}
+
+// presentSummaries names and prices listed products in the locale, market and currency of
+// view. It fails if any product cannot be priced in the currency.
+func (q *ProductQueries) presentSummaries(dtos []*contract.ProductDTO, view productView) ([]*ProductSummary, error) {
+	priced := make([]*contract.ProductDTO, len(dtos))
+	sources := make([]string, len(dtos))
+	inMarketPrice := make([]bool, len(dtos))
+	locales := make([]string, len(dtos))
+	for i, dto := range dtos {
+		dto, locales[i] = inLocale(dto, view.locale)
+		dto, inMarketPrice[i] = inMarket(dto, view.market)
+		if inMarketPrice[i] && view.currency != "" && view.currency != dto.Currency {
+			return nil, domain.ErrMarketCurrencyMismatch
+		}
+		var err error
+		if priced[i], sources[i], err = inCurrency(dto, view.currency, q.rates); err != nil {
+			return nil, err
+		}
+	}
+
+	summaries := listProductsResponseFromDTOs(&contract.ListProductsResult{Products: priced}).Products
+	for i, p := range summaries {
+		p.PriceSource = sources[i]
+		p.Locale = locales[i]
+		if inMarketPrice[i] {
+			p.Market = view.market.String()
+		}
+	}
+	q.roundSummaries(summaries)
+	return summaries, nil
+}
+
+// pricingTime returns the pricing time of a request for priceAt, or now if it is zero. It
+// fails with domain.ErrInvalidPriceAt if priceAt is out of bounds.
+func pricingTime(priceAt, now time.Time) (time.Time, error) {
+	if priceAt.IsZero() {
+		return now, nil
+	}
+	if priceAt.Before(now.Add(-MaxPriceAtPast)) || priceAt.After(now.Add(MaxPriceAtFuture)) {
+		return time.Time{}, domain.ErrInvalidPriceAt
+	}
+	return priceAt, nil
+}
+
+// pricedCursor returns the read model cursor of a listing ordered by price at the pricing
+// time at, with at, so the next page is ordered by the same prices: a cursor positions
+// the page by the effective price of the last product at that time only.
+func pricedCursor(at time.Time, cursor string) string {
+	if cursor == "" {
+		return ""
+	}
+	return at.UTC().Format(time.RFC3339Nano) + " " + cursor
+}
+
+// openPricedCursor returns the pricing time and the read model cursor of a cursor from
+// pricedCursor. The pricing time is that of the listing's first page; it fails with
+// domain.ErrInvalidPageToken if priceAt is set to another time, and with
+// domain.ErrInvalidPriceAt if the time is no longer in bounds.
+func openPricedCursor(cursor string, priceAt, now time.Time) (time.Time, string, error) {
+	value, cursor, ok := strings.Cut(cursor, " ")
+	at, err := time.Parse(time.RFC3339Nano, value)
+	if !ok || err != nil || cursor == "" {
+		return time.Time{}, "", domain.ErrInvalidPageToken
+	}
+	if !priceAt.IsZero() && !priceAt.Equal(at) {
+		return time.Time{}, "", domain.ErrInvalidPageToken
+	}
+	if at, err = pricingTime(at, now); err != nil {
+		return time.Time{}, "", err
+	}
+	return at, cursor, nil
 }
 
 // ListProductsByCategory lists products in a specific category.
@@ -136,6 +907,10 @@ func (q *ProductQueries) ListProductsByCategory(ctx context.Context, category st
 	if pagination.PageSize > 100 {
 		pagination.PageSize = 100
 	}
+	var err error
+	if pagination.PageToken, err = q.openPageToken(ctx, pageToken, listingByCategory, category); err != nil {
+		return nil, err
+	}
 
 	now := q.clock.Now()
 	result, err := q.readModel.ListByCategory(ctx, category, pagination, now)
@@ -143,29 +918,411 @@ func (q *ProductQueries) ListProductsByCategory(ctx context.Context, category st
 		return nil, err
 	}
 
-	return listProductsResponseFromDTOs(result), nil
+	resp := listProductsResponseFromDTOs(result)
+	q.roundSummaries(resp.Products)
+	if resp.NextPageToken, err = q.sealPageToken(ctx, resp.NextPageToken, listingByCategory, category); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetEffectivePrices prices several products at the requested time in a single read.
+// Duplicate IDs are priced once; IDs without a product are reported as missing.
+func (q *ProductQueries) GetEffectivePrices(ctx context.Context, req GetEffectivePricesRequest) (*GetEffectivePricesResponse, error) {
+	ids := uniqueIDs(req.ProductIDs)
+	if len(ids) == 0 {
+		return nil, domain.ErrInvalidID
+	}
+	for _, id := range ids {
+		if id == "" {
+			return nil, domain.ErrInvalidID
+		}
+	}
+	if err := req.Experiment.validate(); err != nil {
+		return nil, err
+	}
+
+	now := q.clock.Now()
+	at := req.At
+	if at.IsZero() {
+		at = now
+	}
+
+	dtos, err := q.readModel.GetEffectivePrices(ctx, ids, at)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments := make(map[string]experiment.Assignment)
+	priced := make([]*contract.PriceDTO, len(dtos))
+	for i, dto := range dtos {
+		priced[i] = dto
+		if assignment, ok := q.assign(req.Experiment, dto.ProductID, dto.Category); ok {
+			assignments[dto.ProductID] = assignment
+			priced[i] = priceInVariant(dto, assignment.Variant)
+		}
+	}
+
+	resp := effectivePricesResponseFromDTOs(ids, priced)
+	q.roundEffectivePrices(resp.Prices)
+
+	var exposures []contract.Exposure
+	for _, price := range resp.Prices {
+		if assignment, ok := assignments[price.ProductID]; ok {
+			price.Experiment = &ExperimentTag{ExperimentID: assignment.ExperimentID, Variant: assignment.Variant.Name}
+			exposures = append(exposures, exposure(assignment, req.Experiment.SubjectID, price.ProductID, SurfaceGetEffectivePrices,
+				price.EffectivePriceNumerator, price.EffectivePriceDenominator, price.Currency, now))
+		}
+	}
+	q.recordExposures(ctx, exposures)
+
+	// Only current prices are locked; a price quoted for another time is informational.
+	if q.priceLock != nil && req.At.IsZero() && len(resp.Prices) > 0 {
+		token, lock, err := q.priceLock.Issue(lockedPrices(resp.Prices), now)
+		if err != nil {
+			return nil, err
+		}
+		resp.PriceLockToken = token
+		resp.PriceLockExpiresAt = &lock.ExpiresAt
+	}
+
+	return resp, nil
+}
+
+// GetPriceForQuantity prices a quantity of a product at the requested time, applying the
+// product's price tier for that quantity and the discount that applies at that time.
+func (q *ProductQueries) GetPriceForQuantity(ctx context.Context, req GetPriceForQuantityRequest) (*QuantityPriceResponse, error) {
+	if req.ProductID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	if req.Quantity < 1 || req.Quantity > domain.MaxPricedQuantity {
+		return nil, domain.ErrInvalidQuantity
+	}
+
+	at := req.At
+	if at.IsZero() {
+		at = q.clock.Now()
+	}
+
+	dto, err := q.readModel.GetPriceForQuantity(ctx, req.ProductID, req.Quantity, at)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := quantityPriceResponseFromDTO(dto)
+	q.roundQuantityPrice(resp)
+	return resp, nil
+}
+
+// VerifyPriceLock checks a price lock token and returns the prices it locks.
+func (q *ProductQueries) VerifyPriceLock(_ context.Context, req VerifyPriceLockRequest) (*VerifyPriceLockResponse, error) {
+	if q.priceLock == nil {
+		return nil, pricelock.ErrDisabled
+	}
+
+	lock, err := q.priceLock.Verify(req.Token, q.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifyPriceLockResponse{
+		Prices:    lock.Prices,
+		IssuedAt:  lock.IssuedAt,
+		ExpiresAt: lock.ExpiresAt,
+	}, nil
+}
+
+// requestCurrency validates the currency of a request; empty means the product's own.
+func requestCurrency(code string) (string, error) {
+	if code == "" {
+		return "", nil
+	}
+	return domain.ParseCurrency(code)
+}
+
+func lockedPrices(prices []*EffectivePrice) []pricelock.Price {
+	locked := make([]pricelock.Price, len(prices))
+	for i, p := range prices {
+		locked[i] = pricelock.Price{
+			ProductID:   p.ProductID,
+			Numerator:   p.EffectivePriceNumerator,
+			Denominator: p.EffectivePriceDenominator,
+			Currency:    p.Currency,
+		}
+	}
+	return locked
+}
+
+// uniqueIDs returns ids without duplicates, keeping the first occurrence of each.
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+func effectivePricesResponseFromDTOs(ids []string, dtos []*contract.PriceDTO) *GetEffectivePricesResponse {
+	byID := make(map[string]*contract.PriceDTO, len(dtos))
+	for _, dto := range dtos {
+		byID[dto.ProductID] = dto
+	}
+
+	resp := &GetEffectivePricesResponse{
+		Prices: make([]*EffectivePrice, 0, len(dtos)),
+	}
+	for _, id := range ids {
+		dto, ok := byID[id]
+		if !ok {
+			resp.MissingProductIDs = append(resp.MissingProductIDs, id)
+			continue
+		}
+		resp.Prices = append(resp.Prices, &EffectivePrice{
+			ProductID:                 dto.ProductID,
+			BasePriceNumerator:        dto.BasePriceNum,
+			BasePriceDenominator:      dto.BasePriceDenom,
+			EffectivePriceNumerator:   dto.EffectivePriceNum,
+			EffectivePriceDenominator: dto.EffectivePriceDenom,
+			DiscountPercent:           dto.DiscountPercent,
+			HasActiveDiscount:         dto.HasActiveDiscount,
+			Currency:                  dto.Currency,
+			Status:                    dto.Status,
+		})
+	}
+	return resp
+}
+
+func quantityPriceResponseFromDTO(dto *contract.QuantityPriceDTO) *QuantityPriceResponse {
+	return &QuantityPriceResponse{
+		ProductID:             dto.ProductID,
+		Quantity:              dto.Quantity,
+		BasePriceNumerator:    dto.BasePriceNum,
+		BasePriceDenominator:  dto.BasePriceDenom,
+		UnitPriceNumerator:    dto.UnitPriceNum,
+		UnitPriceDenominator:  dto.UnitPriceDenom,
+		TotalPriceNumerator:   dto.TotalPriceNum,
+		TotalPriceDenominator: dto.TotalPriceDenom,
+		TierMinQuantity:       dto.TierMinQuantity,
+		DiscountPercent:       dto.DiscountPercent,
+		Currency:              dto.Currency,
+		Status:                dto.Status,
+	}
 }
 
 func productResponseFromDTO(dto *contract.ProductDTO) *ProductResponse {
 	if dto == nil {
 		return nil
 	}
+	discounts := make([]*DiscountResponse, len(dto.Discounts))
+	for i, d := range dto.Discounts {
+		discounts[i] = &DiscountResponse{
+			ID:                   d.ID,
+			Percent:              d.Percent,
+			Priority:             d.Priority,
+			StartDate:            d.StartDate,
+			EndDate:              d.EndDate,
+			Suspended:            d.Suspended,
+			Kind:                 d.Kind,
+			BuyQuantity:          d.BuyQuantity,
+			GetQuantity:          d.GetQuantity,
+			Market:               d.Market,
+			PendingApproval:      d.PendingApproval,
+			ApprovedBy:           d.ApprovedBy,
+			FlashSale:            d.FlashSale,
+			SalePriceNumerator:   d.SalePriceNum,
+			SalePriceDenominator: d.SalePriceDenom,
+		}
+	}
+	book := make([]*PriceBookEntryResponse, len(dto.PriceBook))
+	for i, p := range dto.PriceBook {
+		book[i] = &PriceBookEntryResponse{
+			Currency:         p.Currency,
+			PriceNumerator:   p.PriceNum,
+			PriceDenominator: p.PriceDenom,
+		}
+	}
+	tiers := make([]*PriceTierResponse, len(dto.PriceTiers))
+	for i, t := range dto.PriceTiers {
+		tiers[i] = &PriceTierResponse{
+			MinQuantity:          t.MinQuantity,
+			UnitPriceNumerator:   t.UnitPriceNum,
+			UnitPriceDenominator: t.UnitPriceDenom,
+			PercentOff:           t.PercentOff,
+		}
+	}
+	segments := make([]*SegmentPriceResponse, len(dto.SegmentPrices))
+	for i, sp := range dto.SegmentPrices {
+		segments[i] = &SegmentPriceResponse{
+			Segment:          sp.Segment,
+			PriceNumerator:   sp.PriceNum,
+			PriceDenominator: sp.PriceDenom,
+			PercentOff:       sp.PercentOff,
+		}
+	}
+	markets := make([]*MarketPriceResponse, len(dto.MarketPrices))
+	for i, m := range dto.MarketPrices {
+		markets[i] = &MarketPriceResponse{
+			Market:                    m.Market,
+			Currency:                  m.Currency,
+			PriceNumerator:            m.PriceNum,
+			PriceDenominator:          m.PriceDenom,
+			EffectivePriceNumerator:   m.EffectivePriceNum,
+			EffectivePriceDenominator: m.EffectivePriceDenom,
+			HasActiveDiscount:         m.HasActiveDiscount,
+		}
+	}
+	variants := make([]*VariantResponse, len(dto.Variants))
+	for i, v := range dto.Variants {
+		variants[i] = &VariantResponse{
+			ID:                        v.ID,
+			SKU:                       v.SKU,
+			Attributes:                v.Attributes,
+			PriceDeltaNumerator:       v.PriceDeltaNum,
+			PriceDeltaDenominator:     v.PriceDeltaDenom,
+			PriceNumerator:            v.PriceNum,
+			PriceDenominator:          v.PriceDenom,
+			EffectivePriceNumerator:   v.EffectivePriceNum,
+			EffectivePriceDenominator: v.EffectivePriceDenom,
+			Status:                    v.Status,
+		}
+	}
 	return &ProductResponse{
 		ID:                        dto.ID,
 		Name:                      dto.Name,
+		Slug:                      dto.Slug,
+		SKU:                       dto.SKU,
+		GTIN:                      dto.GTIN,
 		Description:               dto.Description,
 		Category:                  dto.Category,
 		BasePriceNumerator:        dto.BasePriceNum,
 		BasePriceDenominator:      dto.BasePriceDenom,
 		EffectivePriceNumerator:   dto.EffectivePriceNum,
 		EffectivePriceDenominator: dto.EffectivePriceDenom,
+		Currency:                  dto.Currency,
+		TaxClass:                  dto.TaxClass,
 		DiscountPercent:           dto.DiscountPercent,
 		DiscountStartDate:         dto.DiscountStartDate,
 		DiscountEndDate:           dto.DiscountEndDate,
+		DiscountSuspended:         dto.DiscountSuspended,
 		HasActiveDiscount:         dto.HasActiveDiscount,
 		Status:                    dto.Status,
 		CreatedAt:                 dto.CreatedAt,
 		UpdatedAt:                 dto.UpdatedAt,
+		Discounts:                 discounts,
+		PriceTiers:                tiers,
+		PriceBook:                 book,
+		SegmentPrices:             segments,
+		MarketPrices:              markets,
+		Variants:                  variants,
+		MinimumPriceNumerator:     dto.MinimumPriceNum,
+		MinimumPriceDenominator:   dto.MinimumPriceDenom,
+		CostPriceNumerator:        dto.CostPriceNum,
+		CostPriceDenominator:      dto.CostPriceDenom,
+		PendingPriceChange:        pendingPriceChangeFromDTO(dto),
+		ActivateAt:                dto.ActivateAt,
+		DeactivateAt:              dto.DeactivateAt,
+		Review:                    reviewFromDTO(dto.Review),
+		Kind:                      dto.Kind,
+		Compliance:                complianceFromDTO(dto.Compliance),
+		Weight:                    weightFromDTO(dto.Weight),
+		Dimensions:                dimensionsFromDTO(dto.Dimensions),
+		Rating:                    RatingResponse{Count: dto.RatingCount, Average: dto.RatingAverage},
+		Channels:                  dto.Channels,
+		InStock:                   dto.InStock,
+		Stock:                     stockFromDTO(dto),
+		Tags:                      dto.Tags,
+		Attributes:                dto.Attributes,
+		Images:                    imagesFromDTO(dto.Images),
+		Attachments:               attachmentsFromDTO(dto.Attachments),
+		CachedAt:                  dto.CachedAt,
+	}
+}
+
+func reviewFromDTO(dto *contract.ReviewDTO) *ReviewResponse {
+	if dto == nil {
+		return nil
+	}
+	return &ReviewResponse{SubmittedBy: dto.SubmittedBy, ReviewedBy: dto.ReviewedBy, RejectionReason: dto.RejectionReason}
+}
+
+func complianceFromDTO(dto *contract.ComplianceDTO) *ComplianceResponse {
+	if dto == nil {
+		return nil
+	}
+	return &ComplianceResponse{MinimumAge: dto.MinimumAge, Hazardous: dto.Hazardous, RestrictedMarkets: dto.RestrictedMarkets}
+}
+
+func weightFromDTO(dto *contract.WeightDTO) *WeightResponse {
+	if dto == nil {
+		return nil
+	}
+	return &WeightResponse{Value: dto.Value, Unit: dto.Unit, Grams: dto.Grams}
+}
+
+func dimensionsFromDTO(dto *contract.DimensionsDTO) *DimensionsResponse {
+	if dto == nil {
+		return nil
+	}
+	return &DimensionsResponse{
+		Height:   dto.Height,
+		Width:    dto.Width,
+		Depth:    dto.Depth,
+		Unit:     dto.Unit,
+		HeightMM: dto.HeightMM,
+		WidthMM:  dto.WidthMM,
+		DepthMM:  dto.DepthMM,
+	}
+}
+
+func imagesFromDTO(dtos []contract.ImageDTO) []ImageResponse {
+	images := make([]ImageResponse, len(dtos))
+	for i, image := range dtos {
+		images[i] = ImageResponse{URL: image.URL, AltText: image.AltText, Primary: image.Primary}
+	}
+	return images
+}
+
+func attachmentsFromDTO(dtos []contract.AttachmentDTO) []AttachmentResponse {
+	attachments := make([]AttachmentResponse, len(dtos))
+	for i, attachment := range dtos {
+		attachments[i] = AttachmentResponse{Type: attachment.Type, URL: attachment.URL, Locale: attachment.Locale}
+	}
+	return attachments
+}
+
+func primaryImageFromDTO(dtos []contract.ImageDTO) *ImageResponse {
+	for _, image := range dtos {
+		if image.Primary {
+			return &ImageResponse{URL: image.URL, AltText: image.AltText, Primary: true}
+		}
+	}
+	return nil
+}
+
+func stockFromDTO(dto *contract.ProductDTO) *StockResponse {
+	if !dto.StockTracked {
+		return nil
+	}
+	return &StockResponse{
+		Level:     dto.StockLevel,
+		Reserved:  dto.StockReserved,
+		Available: dto.StockLevel - dto.StockReserved,
+		Version:   dto.StockVersion,
+	}
+}
+
+func pendingPriceChangeFromDTO(dto *contract.ProductDTO) *PendingPriceChangeResponse {
+	if dto.PendingPriceEffectiveAt == nil {
+		return nil
+	}
+	return &PendingPriceChangeResponse{
+		PriceNumerator:   dto.PendingPriceNum,
+		PriceDenominator: dto.PendingPriceDenom,
+		EffectiveAt:      *dto.PendingPriceEffectiveAt,
 	}
 }
 
@@ -181,12 +1338,21 @@ func listProductsResponseFromDTOs(result *contract.ListProductsResult) *ListProd
 		products[i] = &ProductSummary{
 			ID:                        dto.ID,
 			Name:                      dto.Name,
+			Slug:                      dto.Slug,
+			SKU:                       dto.SKU,
+			GTIN:                      dto.GTIN,
 			Category:                  dto.Category,
 			BasePriceNumerator:        dto.BasePriceNum,
 			BasePriceDenominator:      dto.BasePriceDenom,
 			EffectivePriceNumerator:   dto.EffectivePriceNum,
 			EffectivePriceDenominator: dto.EffectivePriceDenom,
+			Currency:                  dto.Currency,
 			HasActiveDiscount:         dto.HasActiveDiscount,
+			InStock:                   dto.InStock,
+			Tags:                      dto.Tags,
+			PrimaryImage:              primaryImageFromDTO(dto.Images),
+			Rating:                    RatingResponse{Count: dto.RatingCount, Average: dto.RatingAverage},
+			Channels:                  dto.Channels,
 			DiscountPercent:           dto.DiscountPercent,
 			Status:                    dto.Status,
 			CreatedAt:                 dto.CreatedAt,
@@ -197,5 +1363,6 @@ func listProductsResponseFromDTOs(result *contract.ListProductsResult) *ListProd
 		Products:      products,
 		NextPageToken: result.NextPageToken,
 		TotalCount:    result.TotalCount,
+		CachedAt:      result.CachedAt,
 	}
 }
@@ -1,10 +1,16 @@
 package query
 
 import (
+	"context"
+	"errors"
+	"iter"
 	"testing"
 	"time"
 
+	"github.com/product-catalog-service/internal/clock"
 	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/pricelock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -30,6 +36,7 @@ func TestProductResponseFromDTO(t *testing.T) {
 				Category:            "Electronics",
 				BasePriceNum:        1999,
 				BasePriceDenom:      100,
+				Currency:            "EUR",
 				EffectivePriceNum:   1999,
 				EffectivePriceDenom: 100,
 				HasActiveDiscount:   false,
@@ -47,6 +54,7 @@ func TestProductResponseFromDTO(t *testing.T) {
 				BasePriceDenominator:      100,
 				EffectivePriceNumerator:   1999,
 				EffectivePriceDenominator: 100,
+				Currency:                  "EUR",
 				HasActiveDiscount:         false,
 				Status:                    "active",
 				CreatedAt:                 time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
@@ -192,6 +200,106 @@ func TestListProductsResponseFromDTOs(t *testing.T) {
 	}
 }
 
+func TestEffectivePricesResponseFromDTOs(t *testing.T) {
+	dtos := []*contract.PriceDTO{
+		{ProductID: "product-2", BasePriceNum: 5000, BasePriceDenom: 100, EffectivePriceNum: 4000, EffectivePriceDenom: 100, DiscountPercent: ptrFloat64(20.0), HasActiveDiscount: true, Currency: "USD", Status: "active"},
+		{ProductID: "product-1", BasePriceNum: 1000, BasePriceDenom: 100, EffectivePriceNum: 1000, EffectivePriceDenom: 100, Currency: "USD", Status: "inactive"},
+	}
+
+	ids := uniqueIDs([]string{"product-1", "missing-1", "product-2", "product-1"})
+	result := effectivePricesResponseFromDTOs(ids, dtos)
+
+	require.Len(t, result.Prices, 2)
+	assert.Equal(t, "product-1", result.Prices[0].ProductID)
+	assert.Equal(t, "inactive", result.Prices[0].Status)
+	assert.Nil(t, result.Prices[0].DiscountPercent)
+	assert.Equal(t, "product-2", result.Prices[1].ProductID)
+	assert.Equal(t, int64(4000), result.Prices[1].EffectivePriceNumerator)
+	assert.True(t, result.Prices[1].HasActiveDiscount)
+	assert.Equal(t, ptrFloat64(20.0), result.Prices[1].DiscountPercent)
+	for _, p := range result.Prices {
+		assert.Equal(t, "USD", p.Currency)
+	}
+	assert.Equal(t, []string{"missing-1"}, result.MissingProductIDs)
+}
+
+func TestProductQueries_GetEffectivePrices_InvalidIDs(t *testing.T) {
+	q := NewProductQueries(nil, nil)
+
+	tests := []struct {
+		name string
+		ids  []string
+	}{
+		{"no ids", nil},
+		{"empty id", []string{"product-1", ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := q.GetEffectivePrices(context.Background(), GetEffectivePricesRequest{ProductIDs: tt.ids})
+			assert.ErrorIs(t, err, domain.ErrInvalidID)
+		})
+	}
+}
+
+// priceReadModel serves GetEffectivePrices from a fixed list of prices.
+type priceReadModel struct {
+	contract.ProductReadModel
+	prices []*contract.PriceDTO
+}
+
+func (rm *priceReadModel) GetEffectivePrices(_ context.Context, _ []string, _ time.Time) ([]*contract.PriceDTO, error) {
+	return rm.prices, nil
+}
+
+func TestProductQueries_PriceLock(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewFixedClock(now)
+	signer, err := pricelock.NewSigner([]byte("0123456789abcdef0123456789abcdef"), 15*time.Minute)
+	require.NoError(t, err)
+	readModel := &priceReadModel{prices: []*contract.PriceDTO{
+		{ProductID: "product-1", BasePriceNum: 5000, BasePriceDenom: 100, EffectivePriceNum: 4000, EffectivePriceDenom: 100, Currency: "USD", Status: "active"},
+	}}
+	q := NewProductQueries(readModel, clk, WithPriceLocks(signer))
+
+	// Pricing at another time does not lock the price.
+	resp, err := q.GetEffectivePrices(context.Background(), GetEffectivePricesRequest{
+		ProductIDs: []string{"product-1"},
+		At:         now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.PriceLockToken)
+	assert.Nil(t, resp.PriceLockExpiresAt)
+
+	resp, err = q.GetEffectivePrices(context.Background(), GetEffectivePricesRequest{ProductIDs: []string{"product-1"}})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.PriceLockToken)
+	require.NotNil(t, resp.PriceLockExpiresAt)
+	assert.Equal(t, now.Add(15*time.Minute), *resp.PriceLockExpiresAt)
+
+	// The locked price survives a price change until the token expires.
+	readModel.prices[0].EffectivePriceNum = 4500
+	clk.Advance(10 * time.Minute)
+	verified, err := q.VerifyPriceLock(context.Background(), VerifyPriceLockRequest{Token: resp.PriceLockToken})
+	require.NoError(t, err)
+	assert.Equal(t, []pricelock.Price{{ProductID: "product-1", Numerator: 4000, Denominator: 100, Currency: "USD"}}, verified.Prices)
+
+	clk.Advance(5 * time.Minute)
+	_, err = q.VerifyPriceLock(context.Background(), VerifyPriceLockRequest{Token: resp.PriceLockToken})
+	assert.ErrorIs(t, err, pricelock.ErrTokenExpired)
+}
+
+func TestProductQueries_PriceLockDisabled(t *testing.T) {
+	q := NewProductQueries(&priceReadModel{prices: []*contract.PriceDTO{{ProductID: "product-1"}}}, clock.NewFixedClock(time.Now()))
+
+	resp, err := q.GetEffectivePrices(context.Background(), GetEffectivePricesRequest{ProductIDs: []string{"product-1"}})
+	require.NoError(t, err)
+	assert.Empty(t, resp.PriceLockToken)
+
+	_, err = q.VerifyPriceLock(context.Background(), VerifyPriceLockRequest{Token: "token"})
+	assert.ErrorIs(t, err, pricelock.ErrDisabled)
+}
+
 func ptrFloat64(v float64) *float64 {
 	return &v
 }
@@ -199,3 +307,282 @@ func ptrFloat64(v float64) *float64 {
 func ptrTime(v time.Time) *time.Time {
 	return &v
 }
+
+func TestProductQueries_GetPriceForQuantity_InvalidRequest(t *testing.T) {
+	q := NewProductQueries(nil, nil)
+
+	tests := []struct {
+		name    string
+		req     GetPriceForQuantityRequest
+		wantErr error
+	}{
+		{"empty id", GetPriceForQuantityRequest{Quantity: 10}, domain.ErrInvalidID},
+		{"zero quantity", GetPriceForQuantityRequest{ProductID: "product-1"}, domain.ErrInvalidQuantity},
+		{"quantity too large", GetPriceForQuantityRequest{ProductID: "product-1", Quantity: domain.MaxPricedQuantity + 1}, domain.ErrInvalidQuantity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := q.GetPriceForQuantity(context.Background(), tt.req)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestProductQueries_PriceAt(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	readModel := &productReadModel{product: &contract.ProductDTO{
+		ID: "product-1", BasePriceNum: 10, BasePriceDenom: 1, EffectivePriceNum: 10, EffectivePriceDenom: 1, Currency: "USD",
+	}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(now))
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		priceAt time.Time
+		wantAt  time.Time
+		wantErr error
+	}{
+		{name: "now by default", wantAt: now},
+		{name: "next week", priceAt: now.Add(7 * 24 * time.Hour), wantAt: now.Add(7 * 24 * time.Hour)},
+		{name: "furthest future", priceAt: now.Add(MaxPriceAtFuture), wantAt: now.Add(MaxPriceAtFuture)},
+		{name: "furthest past", priceAt: now.Add(-MaxPriceAtPast), wantAt: now.Add(-MaxPriceAtPast)},
+		{name: "too far ahead", priceAt: now.Add(MaxPriceAtFuture + time.Second), wantErr: domain.ErrInvalidPriceAt},
+		{name: "too long ago", priceAt: now.Add(-MaxPriceAtPast - time.Second), wantErr: domain.ErrInvalidPriceAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", PriceAt: tt.priceAt})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantAt, readModel.at)
+			}
+
+			_, err = q.ListProducts(ctx, ListProductsRequest{PriceAt: tt.priceAt})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantAt, readModel.at)
+			}
+		})
+	}
+}
+
+// pagedReadModel lists pages ending with a fixed cursor and records the cursor and
+// pricing time of the page requested.
+type pagedReadModel struct {
+	contract.ProductReadModel
+	next   string
+	cursor string
+	at     time.Time
+}
+
+func (rm *pagedReadModel) ListProducts(_ context.Context, _ contract.ListProductsFilter, pagination contract.Pagination, at time.Time) (*contract.ListProductsResult, error) {
+	rm.cursor, rm.at = pagination.PageToken, at
+	return &contract.ListProductsResult{NextPageToken: rm.next}, nil
+}
+
+func TestProductQueries_ListProducts_OrderByPrice(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewFixedClock(now)
+	readModel := &pagedReadModel{next: "price-cursor"}
+	q := NewProductQueries(readModel, clk)
+	ctx := context.Background()
+
+	req := ListProductsRequest{OrderBy: contract.OrderByPrice}
+	first, err := q.ListProducts(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, first.NextPageToken)
+
+	// The next page is ordered by the prices at the pricing time of the first one
+	clk.Advance(time.Minute)
+	req.PageToken = first.NextPageToken
+	_, err = q.ListProducts(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "price-cursor", readModel.cursor)
+	assert.Equal(t, now, readModel.at)
+
+	req.PriceAt = now
+	_, err = q.ListProducts(ctx, req)
+	require.NoError(t, err)
+
+	req.PriceAt = now.Add(time.Hour)
+	_, err = q.ListProducts(ctx, req)
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken, "another pricing time")
+
+	// Pages ordered otherwise are priced at the time requested
+	tools := ListProductsRequest{OrderBy: contract.OrderByName}
+	first, err = q.ListProducts(ctx, tools)
+	require.NoError(t, err)
+	clk.Advance(time.Minute)
+	tools.PageToken = first.NextPageToken
+	_, err = q.ListProducts(ctx, tools)
+	require.NoError(t, err)
+	assert.Equal(t, clk.Now(), readModel.at)
+}
+
+// filterReadModel records the filter of the products it lists.
+type filterReadModel struct {
+	productReadModel
+	filter contract.ListProductsFilter
+}
+
+func (rm *filterReadModel) ListProducts(ctx context.Context, filter contract.ListProductsFilter, pagination contract.Pagination, at time.Time) (*contract.ListProductsResult, error) {
+	rm.filter = filter
+	return rm.productReadModel.ListProducts(ctx, filter, pagination, at)
+}
+
+func TestProductQueries_Stock(t *testing.T) {
+	rates, err := domain.ParseCurrencyRates("USD", "GBP=0.8")
+	require.NoError(t, err)
+	dto := widgetDTO()
+	dto.StockTracked, dto.StockLevel, dto.StockReserved, dto.StockVersion = true, 12, 12, 3
+	readModel := &filterReadModel{productReadModel: productReadModel{product: dto}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(time.Now()), WithCurrencyRates(rates))
+	ctx := context.Background()
+
+	// Converted prices keep the stock
+	product, err := q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Currency: "GBP"})
+	require.NoError(t, err)
+	assert.False(t, product.InStock)
+	assert.Equal(t, &StockResponse{Level: 12, Reserved: 12, Available: 0, Version: 3}, product.Stock)
+
+	list, err := q.ListProducts(ctx, ListProductsRequest{InStockOnly: true})
+	require.NoError(t, err)
+	assert.True(t, readModel.filter.InStockOnly)
+	require.Len(t, list.Products, 1)
+	assert.False(t, list.Products[0].InStock)
+
+	// Untracked stock counts as in stock
+	readModel.product = &contract.ProductDTO{ID: "product-2", BasePriceNum: 10, BasePriceDenom: 1,
+		EffectivePriceNum: 10, EffectivePriceDenom: 1, Currency: "USD", InStock: true}
+	product, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-2"})
+	require.NoError(t, err)
+	assert.True(t, product.InStock)
+	assert.Nil(t, product.Stock)
+}
+
+func TestProductQueries_Tags(t *testing.T) {
+	dto := widgetDTO()
+	dto.Tags = []string{"eco", "sale"}
+	dto.Attributes = map[string]string{"material": "oak"}
+	readModel := &filterReadModel{productReadModel: productReadModel{product: dto}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(time.Now()))
+	ctx := context.Background()
+
+	product, err := q.GetProduct(ctx, GetProductRequest{ProductID: "product-1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eco", "sale"}, product.Tags)
+	assert.Equal(t, map[string]string{"material": "oak"}, product.Attributes)
+
+	// Tags are matched case-insensitively
+	list, err := q.ListProducts(ctx, ListProductsRequest{Tag: " Sale "})
+	require.NoError(t, err)
+	assert.Equal(t, "sale", readModel.filter.Tag)
+	require.Len(t, list.Products, 1)
+	assert.Equal(t, []string{"eco", "sale"}, list.Products[0].Tags)
+
+	_, err = q.ListProducts(ctx, ListProductsRequest{Tag: "big sale"})
+	assert.ErrorIs(t, err, domain.ErrInvalidTag)
+}
+
+func TestProductQueries_Images(t *testing.T) {
+	rates, err := domain.ParseCurrencyRates("USD", "GBP=0.8")
+	require.NoError(t, err)
+	dto := widgetDTO()
+	dto.Images = []contract.ImageDTO{
+		{URL: "https://cdn.example.com/back.jpg"},
+		{URL: "https://cdn.example.com/front.jpg", AltText: "Front view", Primary: true},
+	}
+	readModel := &filterReadModel{productReadModel: productReadModel{product: dto}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(time.Now()), WithCurrencyRates(rates))
+	ctx := context.Background()
+
+	product, err := q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Currency: "GBP"})
+	require.NoError(t, err)
+	assert.Equal(t, []ImageResponse{
+		{URL: "https://cdn.example.com/back.jpg"},
+		{URL: "https://cdn.example.com/front.jpg", AltText: "Front view", Primary: true},
+	}, product.Images)
+
+	// Listings only carry the primary image
+	list, err := q.ListProducts(ctx, ListProductsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Products, 1)
+	assert.Equal(t, &ImageResponse{URL: "https://cdn.example.com/front.jpg", AltText: "Front view", Primary: true}, list.Products[0].PrimaryImage)
+
+	readModel.product = widgetDTO()
+	list, err = q.ListProducts(ctx, ListProductsRequest{})
+	require.NoError(t, err)
+	assert.Nil(t, list.Products[0].PrimaryImage)
+}
+
+// allReadModel iterates over a fixed list of products, then fails with err if set, and
+// records the filter of the iteration.
+type allReadModel struct {
+	contract.ProductReadModel
+	products []*contract.ProductDTO
+	err      error
+	filter   contract.ListProductsFilter
+}
+
+func (rm *allReadModel) AllProducts(_ context.Context, filter contract.ListProductsFilter, _ time.Time) iter.Seq2[*contract.ProductDTO, error] {
+	rm.filter = filter
+	return func(yield func(*contract.ProductDTO, error) bool) {
+		for _, p := range rm.products {
+			if !yield(p, nil) {
+				return
+			}
+		}
+		if rm.err != nil {
+			yield(nil, rm.err)
+		}
+	}
+}
+
+func TestProductQueries_ListAllProducts(t *testing.T) {
+	gadget := widgetDTO()
+	gadget.ID = "product-2"
+	readModel := &allReadModel{products: []*contract.ProductDTO{widgetDTO(), gadget}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(time.Now()))
+	ctx := context.Background()
+
+	var streamed []*ProductSummary
+	send := func(p *ProductSummary) error {
+		streamed = append(streamed, p)
+		return nil
+	}
+	err := q.ListAllProducts(ctx, ListProductsRequest{Category: "Tools", Tag: "Eco", Currency: "EUR", PageSize: 1}, send)
+	require.NoError(t, err)
+	assert.Equal(t, contract.ListProductsFilter{Category: "Tools", Tag: "eco"}, readModel.filter)
+	require.Len(t, streamed, 2)
+	for i, id := range []string{"product-1", "product-2"} {
+		assert.Equal(t, id, streamed[i].ID)
+		assert.Equal(t, "EUR", streamed[i].Currency)
+		assert.Equal(t, "en", streamed[i].Locale)
+	}
+
+	// A failed send stops the stream
+	errClosed := errors.New("stream closed")
+	calls := 0
+	err = q.ListAllProducts(ctx, ListProductsRequest{}, func(*ProductSummary) error {
+		calls++
+		return errClosed
+	})
+	assert.ErrorIs(t, err, errClosed)
+	assert.Equal(t, 1, calls)
+
+	// So does a failed read, after the products read before it
+	readModel.err = errors.New("spanner unavailable")
+	streamed = nil
+	err = q.ListAllProducts(ctx, ListProductsRequest{}, send)
+	assert.ErrorIs(t, err, readModel.err)
+	assert.Len(t, streamed, 2)
+
+	err = q.ListAllProducts(ctx, ListProductsRequest{Channel: "kiosk"}, send)
+	assert.ErrorIs(t, err, domain.ErrInvalidSalesChannel)
+}
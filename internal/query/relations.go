@@ -0,0 +1,64 @@
+package query
+
+import (
+	"context"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// GetRelatedProductsRequest represents the input for reading the products a product links
+// to. Type is "related", "accessory" or "replacement"; the empty type returns the links
+// of every type.
+type GetRelatedProductsRequest struct {
+	ProductID string
+	Type      string
+}
+
+// RelatedProductResponse represents a product linked from another product.
+type RelatedProductResponse struct {
+	Type    string
+	Product *ProductSummary
+}
+
+// GetRelatedProductsResponse represents the products a product links to, ordered by
+// relation type and then product ID.
+type GetRelatedProductsResponse struct {
+	ProductID string
+	Products  []*RelatedProductResponse
+}
+
+// GetRelatedProducts returns the products a product links to, priced now in their own
+// currency. Archived products are left out.
+func (q *ProductQueries) GetRelatedProducts(ctx context.Context, req GetRelatedProductsRequest) (*GetRelatedProductsResponse, error) {
+	if req.ProductID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	if req.Type != "" {
+		if _, err := domain.ParseRelationType(req.Type); err != nil {
+			return nil, err
+		}
+	}
+
+	dtos, err := q.readModel.GetRelatedProducts(ctx, req.ProductID, req.Type, q.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &contract.ListProductsResult{Products: make([]*contract.ProductDTO, len(dtos))}
+	for i, dto := range dtos {
+		result.Products[i] = dto.Product
+	}
+	summaries := listProductsResponseFromDTOs(result).Products
+	q.roundSummaries(summaries)
+
+	resp := &GetRelatedProductsResponse{
+		ProductID: req.ProductID,
+		Products:  make([]*RelatedProductResponse, len(dtos)),
+	}
+	for i, dto := range dtos {
+		summaries[i].PriceSource = PriceSourceProduct
+		resp.Products[i] = &RelatedProductResponse{Type: dto.RelationType, Product: summaries[i]}
+	}
+	return resp, nil
+}
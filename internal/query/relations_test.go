@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// relatedReadModel serves GetRelatedProducts from a fixed list of links and records the
+// requested relation type.
+type relatedReadModel struct {
+	contract.ProductReadModel
+	related      []*contract.RelatedProductDTO
+	relationType string
+}
+
+func (rm *relatedReadModel) GetRelatedProducts(_ context.Context, _ string, relationType string, _ time.Time) ([]*contract.RelatedProductDTO, error) {
+	rm.relationType = relationType
+	return rm.related, nil
+}
+
+func TestProductQueries_GetRelatedProducts(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	readModel := &relatedReadModel{related: []*contract.RelatedProductDTO{
+		{RelationType: "accessory", Product: &contract.ProductDTO{ID: "case", Name: "Case", Currency: "USD",
+			BasePriceNum: 1999, BasePriceDenom: 100, EffectivePriceNum: 1999, EffectivePriceDenom: 100, Status: "active"}},
+		{RelationType: "related", Product: &contract.ProductDTO{ID: "tablet", Name: "Tablet", Currency: "USD",
+			BasePriceNum: 30000, BasePriceDenom: 100, EffectivePriceNum: 27000, EffectivePriceDenom: 100, HasActiveDiscount: true, Status: "active"}},
+	}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(now))
+
+	resp, err := q.GetRelatedProducts(context.Background(), GetRelatedProductsRequest{ProductID: "phone"})
+	require.NoError(t, err)
+	assert.Equal(t, "", readModel.relationType)
+	assert.Equal(t, "phone", resp.ProductID)
+	require.Len(t, resp.Products, 2)
+	assert.Equal(t, "accessory", resp.Products[0].Type)
+	assert.Equal(t, "case", resp.Products[0].Product.ID)
+	assert.Equal(t, "19.99", resp.Products[0].Product.EffectivePriceDisplay)
+	assert.Equal(t, PriceSourceProduct, resp.Products[0].Product.PriceSource)
+	assert.Equal(t, "related", resp.Products[1].Type)
+	assert.Equal(t, "270.00", resp.Products[1].Product.EffectivePriceDisplay)
+
+	_, err = q.GetRelatedProducts(context.Background(), GetRelatedProductsRequest{ProductID: "phone", Type: "replacement"})
+	require.NoError(t, err)
+	assert.Equal(t, "replacement", readModel.relationType)
+}
+
+func TestProductQueries_GetRelatedProducts_InvalidRequest(t *testing.T) {
+	q := NewProductQueries(&relatedReadModel{}, clock.NewFixedClock(time.Now()))
+
+	_, err := q.GetRelatedProducts(context.Background(), GetRelatedProductsRequest{Type: "related"})
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+	_, err = q.GetRelatedProducts(context.Background(), GetRelatedProductsRequest{ProductID: "phone", Type: "upsell"})
+	assert.ErrorIs(t, err, domain.ErrInvalidRelationType)
+}
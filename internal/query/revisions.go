@@ -0,0 +1,153 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// GetProductRevisionsRequest represents the input for reading the revisions of a product.
+type GetProductRevisionsRequest struct {
+	ProductID string
+	// From and To bound the revisions returned to [From, To); a zero value leaves that
+	// end of the range open.
+	From time.Time
+	To   time.Time
+}
+
+// FieldChange represents a field of the product snapshot that differs between a revision
+// and the one before it. Before and After are the JSON values of the field; Before is
+// nil for the first revision of a product.
+type FieldChange struct {
+	Field  string
+	Before json.RawMessage
+	After  json.RawMessage
+}
+
+// ProductRevision represents a change of a product with its diff against the revision
+// before it.
+type ProductRevision struct {
+	RevisionID string
+	RevisedAt  time.Time
+	// ChangedFields are the fields the command that made the revision changed.
+	ChangedFields []string
+	// Changes are the snapshot fields that differ from the revision before, sorted by
+	// field.
+	Changes []*FieldChange
+}
+
+// ProductRevisionsResponse represents the revisions of a product, oldest first.
+type ProductRevisionsResponse struct {
+	ProductID string
+	Revisions []*ProductRevision
+}
+
+// GetProductAtRevisionRequest represents the input for reading a product as it was at a
+// revision.
+type GetProductAtRevisionRequest struct {
+	ProductID  string
+	RevisionID string
+}
+
+// ProductAtRevisionResponse represents the full state of a product right after a
+// revision.
+type ProductAtRevisionResponse struct {
+	ProductID  string
+	RevisionID string
+	RevisedAt  time.Time
+	Snapshot   json.RawMessage
+}
+
+// GetProductRevisions returns the revisions of a product in the requested range, each
+// with the fields that differ from the revision before it.
+func (q *ProductQueries) GetProductRevisions(ctx context.Context, req GetProductRevisionsRequest) (*ProductRevisionsResponse, error) {
+	if req.ProductID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	if !req.From.IsZero() && !req.To.IsZero() && !req.To.After(req.From) {
+		return nil, domain.ErrInvalidRevisionRange
+	}
+
+	dtos, err := q.readModel.GetProductRevisions(ctx, req.ProductID, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ProductRevisionsResponse{
+		ProductID: req.ProductID,
+		Revisions: make([]*ProductRevision, len(dtos)),
+	}
+	for i, dto := range dtos {
+		changes, err := snapshotChanges(dto.PreviousSnapshot, dto.Snapshot)
+		if err != nil {
+			return nil, fmt.Errorf("diff revision %s: %w", dto.RevisionID, err)
+		}
+		resp.Revisions[i] = &ProductRevision{
+			RevisionID:    dto.RevisionID,
+			RevisedAt:     dto.RevisedAt,
+			ChangedFields: dto.ChangedFields,
+			Changes:       changes,
+		}
+	}
+	return resp, nil
+}
+
+// GetProductAtRevision returns the full state of a product right after a revision.
+func (q *ProductQueries) GetProductAtRevision(ctx context.Context, req GetProductAtRevisionRequest) (*ProductAtRevisionResponse, error) {
+	if req.ProductID == "" || req.RevisionID == "" {
+		return nil, domain.ErrInvalidID
+	}
+
+	dto, err := q.readModel.GetProductRevision(ctx, req.ProductID, req.RevisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProductAtRevisionResponse{
+		ProductID:  req.ProductID,
+		RevisionID: dto.RevisionID,
+		RevisedAt:  dto.RevisedAt,
+		Snapshot:   dto.Snapshot,
+	}, nil
+}
+
+// snapshotChanges compares the top-level fields of two product snapshots and returns
+// those that differ, sorted by field. A nil previous snapshot reports every field.
+func snapshotChanges(previous, current json.RawMessage) ([]*FieldChange, error) {
+	before := map[string]json.RawMessage{}
+	if previous != nil {
+		if err := json.Unmarshal(previous, &before); err != nil {
+			return nil, err
+		}
+	}
+	after := map[string]json.RawMessage{}
+	if err := json.Unmarshal(current, &after); err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, 0, len(after))
+	for field := range after {
+		fields = append(fields, field)
+	}
+	for field := range before {
+		if _, ok := after[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	changes := []*FieldChange{}
+	for _, field := range fields {
+		was, now := before[field], after[field]
+		if bytes.Equal(was, now) {
+			continue
+		}
+		changes = append(changes, &FieldChange{Field: field, Before: was, After: now})
+	}
+	return changes, nil
+}
@@ -0,0 +1,113 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// revisionReadModel serves the revisions of a product from a fixed list and records the
+// requested range.
+type revisionReadModel struct {
+	contract.ProductReadModel
+	revisions []*contract.ProductRevisionDTO
+	from, to  time.Time
+}
+
+func (rm *revisionReadModel) GetProductRevisions(_ context.Context, _ string, from, to time.Time) ([]*contract.ProductRevisionDTO, error) {
+	rm.from, rm.to = from, to
+	return rm.revisions, nil
+}
+
+func (rm *revisionReadModel) GetProductRevision(_ context.Context, _, revisionID string) (*contract.ProductRevisionDTO, error) {
+	for _, revision := range rm.revisions {
+		if revision.RevisionID == revisionID {
+			return revision, nil
+		}
+	}
+	return nil, domain.ErrRevisionNotFound
+}
+
+func TestProductQueries_GetProductRevisions(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	created := json.RawMessage(`{"name":"Chair","tags":[],"status":"draft"}`)
+	renamed := json.RawMessage(`{"name":"Oak Chair","tags":["oak"],"status":"draft"}`)
+	readModel := &revisionReadModel{revisions: []*contract.ProductRevisionDTO{
+		{RevisionID: "revision-1", RevisedAt: now.Add(-2 * time.Hour), ChangedFields: []string{}, Snapshot: created},
+		{RevisionID: "revision-2", RevisedAt: now.Add(-time.Hour), ChangedFields: []string{"name", "tags"},
+			Snapshot: renamed, PreviousSnapshot: created},
+	}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(now))
+
+	resp, err := q.GetProductRevisions(context.Background(), GetProductRevisionsRequest{ProductID: "product-1", To: now})
+	require.NoError(t, err)
+	assert.True(t, readModel.from.IsZero())
+	assert.Equal(t, now, readModel.to)
+
+	assert.Equal(t, "product-1", resp.ProductID)
+	require.Len(t, resp.Revisions, 2)
+
+	first := resp.Revisions[0]
+	assert.Equal(t, "revision-1", first.RevisionID)
+	require.Len(t, first.Changes, 3)
+	assert.Equal(t, "name", first.Changes[0].Field)
+	assert.Nil(t, first.Changes[0].Before)
+	assert.JSONEq(t, `"Chair"`, string(first.Changes[0].After))
+
+	second := resp.Revisions[1]
+	assert.Equal(t, []string{"name", "tags"}, second.ChangedFields)
+	require.Len(t, second.Changes, 2)
+	assert.Equal(t, "name", second.Changes[0].Field)
+	assert.JSONEq(t, `"Chair"`, string(second.Changes[0].Before))
+	assert.JSONEq(t, `"Oak Chair"`, string(second.Changes[0].After))
+	assert.Equal(t, "tags", second.Changes[1].Field)
+	assert.JSONEq(t, `["oak"]`, string(second.Changes[1].After))
+}
+
+func TestProductQueries_GetProductRevisions_InvalidRequest(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	q := NewProductQueries(&revisionReadModel{}, clock.NewFixedClock(now))
+
+	tests := []struct {
+		name    string
+		req     GetProductRevisionsRequest
+		wantErr error
+	}{
+		{"missing product ID", GetProductRevisionsRequest{}, domain.ErrInvalidID},
+		{"empty range", GetProductRevisionsRequest{ProductID: "product-1", From: now, To: now}, domain.ErrInvalidRevisionRange},
+		{"reversed range", GetProductRevisionsRequest{ProductID: "product-1", From: now, To: now.Add(-time.Hour)}, domain.ErrInvalidRevisionRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := q.GetProductRevisions(context.Background(), tt.req)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestProductQueries_GetProductAtRevision(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	snapshot := json.RawMessage(`{"name":"Chair"}`)
+	q := NewProductQueries(&revisionReadModel{revisions: []*contract.ProductRevisionDTO{
+		{RevisionID: "revision-1", RevisedAt: now, Snapshot: snapshot},
+	}}, clock.NewFixedClock(now))
+
+	resp, err := q.GetProductAtRevision(context.Background(), GetProductAtRevisionRequest{ProductID: "product-1", RevisionID: "revision-1"})
+	require.NoError(t, err)
+	assert.Equal(t, now, resp.RevisedAt)
+	assert.JSONEq(t, string(snapshot), string(resp.Snapshot))
+
+	_, err = q.GetProductAtRevision(context.Background(), GetProductAtRevisionRequest{ProductID: "product-1", RevisionID: "revision-2"})
+	assert.ErrorIs(t, err, domain.ErrRevisionNotFound)
+
+	_, err = q.GetProductAtRevision(context.Background(), GetProductAtRevisionRequest{ProductID: "product-1"})
+	assert.ErrorIs(t, err, domain.ErrInvalidID)
+}
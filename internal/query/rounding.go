@@ -0,0 +1,90 @@
+package query
+
+import "math/big"
+
+// display returns an exact price rounded for display by the rounding policy.
+func (q *ProductQueries) display(numerator, denominator int64) string {
+	if denominator == 0 {
+		return ""
+	}
+	return q.rounding.Format(big.NewRat(numerator, denominator))
+}
+
+func (q *ProductQueries) roundProduct(p *ProductResponse) {
+	p.BasePriceDisplay = q.display(p.BasePriceNumerator, p.BasePriceDenominator)
+	p.EffectivePriceDisplay = q.display(p.EffectivePriceNumerator, p.EffectivePriceDenominator)
+	p.MinimumPriceDisplay = q.display(p.MinimumPriceNumerator, p.MinimumPriceDenominator)
+	p.CostPriceDisplay = q.display(p.CostPriceNumerator, p.CostPriceDenominator)
+	if c := p.PendingPriceChange; c != nil {
+		c.PriceDisplay = q.display(c.PriceNumerator, c.PriceDenominator)
+	}
+	for _, d := range p.Discounts {
+		d.SalePriceDisplay = q.display(d.SalePriceNumerator, d.SalePriceDenominator)
+	}
+	for _, t := range p.PriceTiers {
+		t.UnitPriceDisplay = q.display(t.UnitPriceNumerator, t.UnitPriceDenominator)
+	}
+	for _, e := range p.PriceBook {
+		e.PriceDisplay = q.display(e.PriceNumerator, e.PriceDenominator)
+	}
+	for _, s := range p.SegmentPrices {
+		s.PriceDisplay = q.display(s.PriceNumerator, s.PriceDenominator)
+	}
+	for _, m := range p.MarketPrices {
+		m.PriceDisplay = q.display(m.PriceNumerator, m.PriceDenominator)
+		m.EffectivePriceDisplay = q.display(m.EffectivePriceNumerator, m.EffectivePriceDenominator)
+	}
+	for _, v := range p.Variants {
+		v.PriceDeltaDisplay = q.display(v.PriceDeltaNumerator, v.PriceDeltaDenominator)
+		v.PriceDisplay = q.display(v.PriceNumerator, v.PriceDenominator)
+		v.EffectivePriceDisplay = q.display(v.EffectivePriceNumerator, v.EffectivePriceDenominator)
+	}
+}
+
+func (q *ProductQueries) roundSummaries(products []*ProductSummary) {
+	for _, p := range products {
+		p.BasePriceDisplay = q.display(p.BasePriceNumerator, p.BasePriceDenominator)
+		p.EffectivePriceDisplay = q.display(p.EffectivePriceNumerator, p.EffectivePriceDenominator)
+	}
+}
+
+func (q *ProductQueries) roundEffectivePrices(prices []*EffectivePrice) {
+	for _, p := range prices {
+		p.BasePriceDisplay = q.display(p.BasePriceNumerator, p.BasePriceDenominator)
+		p.EffectivePriceDisplay = q.display(p.EffectivePriceNumerator, p.EffectivePriceDenominator)
+	}
+}
+
+func (q *ProductQueries) roundQuantityPrice(p *QuantityPriceResponse) {
+	p.BasePriceDisplay = q.display(p.BasePriceNumerator, p.BasePriceDenominator)
+	p.UnitPriceDisplay = q.display(p.UnitPriceNumerator, p.UnitPriceDenominator)
+	p.TotalPriceDisplay = q.display(p.TotalPriceNumerator, p.TotalPriceDenominator)
+}
+
+func (q *ProductQueries) roundTaxInclusivePrice(p *TaxInclusivePriceResponse) {
+	p.NetPriceDisplay = q.display(p.NetPriceNumerator, p.NetPriceDenominator)
+	p.TaxAmountDisplay = q.display(p.TaxAmountNumerator, p.TaxAmountDenominator)
+	p.GrossPriceDisplay = q.display(p.GrossPriceNumerator, p.GrossPriceDenominator)
+}
+
+func (q *ProductQueries) roundPriceHistory(entries []*PriceHistoryEntry) {
+	for _, e := range entries {
+		e.BasePriceDisplay = q.display(e.BasePriceNumerator, e.BasePriceDenominator)
+		e.EffectivePriceDisplay = q.display(e.EffectivePriceNumerator, e.EffectivePriceDenominator)
+	}
+}
+
+func (q *ProductQueries) roundMargin(p *MarginResponse) {
+	p.PriceDisplay = q.display(p.PriceNumerator, p.PriceDenominator)
+	p.CostPriceDisplay = q.display(p.CostPriceNumerator, p.CostPriceDenominator)
+	p.MarginDisplay = q.display(p.MarginNumerator, p.MarginDenominator)
+}
+
+func (q *ProductQueries) roundCalculatedPrice(p *CalculatePriceResponse) {
+	p.BasePriceDisplay = q.display(p.BasePriceNumerator, p.BasePriceDenominator)
+	p.UnitPriceDisplay = q.display(p.UnitPriceNumerator, p.UnitPriceDenominator)
+	p.TotalPriceDisplay = q.display(p.TotalPriceNumerator, p.TotalPriceDenominator)
+	p.DiscountAmountDisplay = q.display(p.DiscountAmountNumerator, p.DiscountAmountDenominator)
+	p.SavingsDisplay = q.display(p.SavingsNumerator, p.SavingsDenominator)
+	p.CharmPriceDisplay = q.display(p.CharmPriceNumerator, p.CharmPriceDenominator)
+}
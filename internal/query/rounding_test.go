@@ -0,0 +1,66 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductQueries_RoundingPolicy(t *testing.T) {
+	// 20.00 with a 1/3 discount off is 13.333...
+	dto := &contract.ProductDTO{
+		ID:                  "product-1",
+		BasePriceNum:        2000,
+		BasePriceDenom:      100,
+		EffectivePriceNum:   40,
+		EffectivePriceDenom: 3,
+		Currency:            "USD",
+		PriceTiers: []contract.PriceTierDTO{
+			{MinQuantity: 10, UnitPriceNum: 10125, UnitPriceDenom: 1000},
+			{MinQuantity: 100, PercentOff: 15},
+		},
+		PriceBook: []contract.PriceBookEntryDTO{
+			{Currency: "EUR", PriceNum: 1850, PriceDenom: 100},
+		},
+	}
+
+	tests := []struct {
+		name                     string
+		opts                     []Option
+		wantBase, wantEffective  string
+		wantTierUnit, wantInBook string
+	}{
+		{"default half up", nil, "20.00", "13.33", "10.13", "18.50"},
+		{"bankers", []Option{WithRoundingPolicy(domain.RoundBankers)}, "20.00", "13.33", "10.12", "18.50"},
+		{"charm", []Option{WithRoundingPolicy(domain.RoundCharm)}, "19.99", "12.99", "9.99", "18.99"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewProductQueries(&productReadModel{product: dto}, clock.NewFixedClock(time.Now()), tt.opts...)
+
+			product, err := q.GetProduct(context.Background(), GetProductRequest{ProductID: "product-1"})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBase, product.BasePriceDisplay)
+			assert.Equal(t, tt.wantEffective, product.EffectivePriceDisplay)
+			assert.Equal(t, tt.wantTierUnit, product.PriceTiers[0].UnitPriceDisplay)
+			assert.Empty(t, product.PriceTiers[1].UnitPriceDisplay)
+			assert.Equal(t, tt.wantInBook, product.PriceBook[0].PriceDisplay)
+
+			// The exact prices are left as they are.
+			assert.Equal(t, int64(40), product.EffectivePriceNumerator)
+			assert.Equal(t, int64(3), product.EffectivePriceDenominator)
+
+			list, err := q.ListProducts(context.Background(), ListProductsRequest{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBase, list.Products[0].BasePriceDisplay)
+			assert.Equal(t, tt.wantEffective, list.Products[0].EffectivePriceDisplay)
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package query
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// MaxSearchQueryLength is the maximum length of a search query, in characters.
+const MaxSearchQueryLength = 200
+
+// SearchProductsRequest represents the input for searching products by text.
+type SearchProductsRequest struct {
+	// Query is matched against the names and descriptions of products in the default
+	// locale, e.g. "oak chair" for products that mention both words. Without a search
+	// engine, quoted phrases, OR and a leading - to exclude a word are supported; with
+	// one, words match fuzzily, so "oak chiar" finds oak chairs too.
+	Query      string
+	Category   string
+	ActiveOnly bool
+	// Tag and Channel search only the products with the tag or available on the sales
+	// channel; empty searches all.
+	Tag       string
+	Channel   string
+	PageSize  int32
+	PageToken string
+	// Locale is the locale of the names, as in GetProductRequest.
+	Locale string
+}
+
+// SearchProductsResponse represents a page of products found by a search.
+type SearchProductsResponse struct {
+	Products      []*ProductSummary
+	NextPageToken string
+	// TotalCount is the number of products matching the search; 0 if unknown, as it is
+	// without a search engine.
+	TotalCount int64
+	// Facets count the products matching the search by category, tag and channel; empty
+	// without a search engine.
+	Facets []FacetResponse
+}
+
+// FacetResponse counts the products matching a search by the values of a field.
+type FacetResponse struct {
+	// Field is "category", "tag" or "channel".
+	Field  string
+	Values []FacetValueResponse
+}
+
+// FacetValueResponse is the number of products matching a search with a value of a field.
+type FacetValueResponse struct {
+	Value string
+	Count int64
+}
+
+// WithSearchEngine makes SearchProducts find products with engine rather than the
+// full-text search of the read model, for fuzzy matching and facets. The products found
+// are still read from the read model.
+func WithSearchEngine(engine contract.ProductSearchEngine) Option {
+	return func(q *ProductQueries) {
+		q.searchEngine = engine
+	}
+}
+
+// SearchProducts searches products that are not archived by their names and
+// descriptions, most relevant first, priced now in their own currency. The page token of
+// the response is only valid for the same query and filters.
+func (q *ProductQueries) SearchProducts(ctx context.Context, req SearchProductsRequest) (*SearchProductsResponse, error) {
+	text := strings.TrimSpace(req.Query)
+	if text == "" || utf8.RuneCountInString(text) > MaxSearchQueryLength {
+		return nil, domain.ErrInvalidSearchQuery
+	}
+	locale, err := requestLocale(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := contract.SearchProductsFilter{
+		Query:      text,
+		Category:   req.Category,
+		ActiveOnly: req.ActiveOnly,
+	}
+	if req.Tag != "" {
+		if filter.Tag, err = domain.ParseTag(req.Tag); err != nil {
+			return nil, err
+		}
+	}
+	if req.Channel != "" {
+		channel, err := domain.ParseSalesChannel(req.Channel)
+		if err != nil {
+			return nil, err
+		}
+		filter.Channel = string(channel)
+	}
+	pagination := contract.Pagination{
+		PageSize:  req.PageSize,
+		PageToken: req.PageToken,
+	}
+	if pagination.PageSize <= 0 {
+		pagination.PageSize = 20
+	}
+	if pagination.PageSize > 100 {
+		pagination.PageSize = 100
+	}
+	// Tokens of the search engine and of the read model are not interchangeable.
+	page := searchPage{Filter: filter, Engine: q.searchEngine != nil}
+	if pagination.PageToken, err = q.openPageToken(ctx, req.PageToken, listingSearch, page); err != nil {
+		return nil, err
+	}
+
+	var (
+		result = &contract.ListProductsResult{}
+		facets []contract.FacetDTO
+	)
+	if q.searchEngine != nil {
+		found, err := q.searchEngine.Search(ctx, filter, pagination)
+		if err != nil {
+			return nil, err
+		}
+		// The index lags behind the catalog, so a product found may have been archived
+		// or purged since; ListProductsByID leaves those out.
+		products, err := q.readModel.ListProductsByID(ctx, found.ProductIDs, q.clock.Now())
+		if err != nil {
+			return nil, err
+		}
+		result.Products = products
+		result.NextPageToken = found.NextPageToken
+		result.TotalCount = found.TotalCount
+		facets = found.Facets
+	} else if result, err = q.readModel.SearchProducts(ctx, filter, pagination, q.clock.Now()); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		result = &contract.ListProductsResult{}
+	}
+
+	localized := *result
+	localized.Products = make([]*contract.ProductDTO, len(result.Products))
+	locales := make([]string, len(result.Products))
+	for i, dto := range result.Products {
+		localized.Products[i], locales[i] = inLocale(dto, locale)
+	}
+
+	list := listProductsResponseFromDTOs(&localized)
+	for i, p := range list.Products {
+		p.PriceSource = PriceSourceProduct
+		p.Locale = locales[i]
+	}
+	q.roundSummaries(list.Products)
+	nextPageToken, err := q.sealPageToken(ctx, list.NextPageToken, listingSearch, page)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SearchProductsResponse{
+		Products:      list.Products,
+		NextPageToken: nextPageToken,
+		TotalCount:    list.TotalCount,
+	}
+	for _, facet := range facets {
+		fr := FacetResponse{Field: facet.Field}
+		for _, v := range facet.Values {
+			fr.Values = append(fr.Values, FacetValueResponse{Value: v.Value, Count: v.Count})
+		}
+		resp.Facets = append(resp.Facets, fr)
+	}
+	return resp, nil
+}
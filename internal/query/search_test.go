@@ -0,0 +1,124 @@
+package query
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// searchReadModel serves a fixed page of search results and records the search.
+type searchReadModel struct {
+	contract.ProductReadModel
+	result     *contract.ListProductsResult
+	filter     contract.SearchProductsFilter
+	pagination contract.Pagination
+}
+
+func (rm *searchReadModel) SearchProducts(_ context.Context, filter contract.SearchProductsFilter, pagination contract.Pagination, _ time.Time) (*contract.ListProductsResult, error) {
+	rm.filter, rm.pagination = filter, pagination
+	return rm.result, nil
+}
+
+func TestProductQueries_SearchProducts(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	readModel := &searchReadModel{result: &contract.ListProductsResult{
+		Products: []*contract.ProductDTO{{
+			ID: "product-1", Name: "Oak Chair", Currency: "USD", BasePriceNum: 12000, BasePriceDenom: 100,
+			EffectivePriceNum: 12000, EffectivePriceDenom: 100,
+			Translations: []contract.TranslationDTO{{Locale: "de", Name: "Eichenstuhl"}},
+		}},
+		NextPageToken: "1.5:product-1",
+	}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(now))
+
+	resp, err := q.SearchProducts(context.Background(), SearchProductsRequest{
+		Query:    "  oak chair ",
+		Category: "Furniture",
+		PageSize: 500,
+		Locale:   "de-AT",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, contract.SearchProductsFilter{Query: "oak chair", Category: "Furniture"}, readModel.filter)
+	assert.Equal(t, int32(100), readModel.pagination.PageSize)
+	require.Len(t, resp.Products, 1)
+	assert.Equal(t, "Eichenstuhl", resp.Products[0].Name)
+	assert.Equal(t, "de", resp.Products[0].Locale)
+	assert.Equal(t, PriceSourceProduct, resp.Products[0].PriceSource)
+	assert.NotEmpty(t, resp.NextPageToken)
+	assert.NotContains(t, resp.NextPageToken, "product-1", "page tokens hide the cursors")
+
+	for _, query := range []string{"", "   ", strings.Repeat("a", MaxSearchQueryLength+1)} {
+		_, err := q.SearchProducts(context.Background(), SearchProductsRequest{Query: query})
+		assert.ErrorIs(t, err, domain.ErrInvalidSearchQuery)
+	}
+	_, err = q.SearchProducts(context.Background(), SearchProductsRequest{Query: "oak", Locale: "english"})
+	assert.ErrorIs(t, err, domain.ErrInvalidLocale)
+}
+
+// fakeSearchEngine serves a fixed search result and records the search.
+type fakeSearchEngine struct {
+	result *contract.SearchResult
+	filter contract.SearchProductsFilter
+}
+
+func (e *fakeSearchEngine) Search(_ context.Context, filter contract.SearchProductsFilter, _ contract.Pagination) (*contract.SearchResult, error) {
+	e.filter = filter
+	return e.result, nil
+}
+
+// productsByIDReadModel serves products by ID and records the IDs asked for.
+type productsByIDReadModel struct {
+	contract.ProductReadModel
+	products map[string]*contract.ProductDTO
+	ids      []string
+}
+
+func (rm *productsByIDReadModel) ListProductsByID(_ context.Context, ids []string, _ time.Time) ([]*contract.ProductDTO, error) {
+	rm.ids = ids
+	var products []*contract.ProductDTO
+	for _, id := range ids {
+		if p, ok := rm.products[id]; ok {
+			products = append(products, p)
+		}
+	}
+	return products, nil
+}
+
+func TestProductQueries_SearchProducts_WithSearchEngine(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	readModel := &productsByIDReadModel{products: map[string]*contract.ProductDTO{
+		"product-1": {ID: "product-1", Name: "Oak Chair", Currency: "USD", BasePriceNum: 12000, BasePriceDenom: 100,
+			EffectivePriceNum: 12000, EffectivePriceDenom: 100},
+	}}
+	engine := &fakeSearchEngine{result: &contract.SearchResult{
+		ProductIDs:    []string{"product-1", "product-archived"},
+		NextPageToken: "1.5:product-archived",
+		TotalCount:    7,
+		Facets: []contract.FacetDTO{
+			{Field: contract.FacetTag, Values: []contract.FacetValueDTO{{Value: "oak", Count: 7}}},
+		},
+	}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(now), WithSearchEngine(engine))
+
+	resp, err := q.SearchProducts(context.Background(), SearchProductsRequest{Query: "oak chiar", Tag: " Oak ", Channel: "web"})
+	require.NoError(t, err)
+	assert.Equal(t, contract.SearchProductsFilter{Query: "oak chiar", Tag: "oak", Channel: "web"}, engine.filter)
+	assert.Equal(t, []string{"product-1", "product-archived"}, readModel.ids)
+	require.Len(t, resp.Products, 1, "products archived since they were indexed are left out")
+	assert.Equal(t, "Oak Chair", resp.Products[0].Name)
+	assert.NotEmpty(t, resp.NextPageToken)
+	assert.Equal(t, int64(7), resp.TotalCount)
+	assert.Equal(t, []FacetResponse{{Field: "tag", Values: []FacetValueResponse{{Value: "oak", Count: 7}}}}, resp.Facets)
+
+	_, err = q.SearchProducts(context.Background(), SearchProductsRequest{Query: "oak", Tag: "no tags!"})
+	assert.ErrorIs(t, err, domain.ErrInvalidTag)
+	_, err = q.SearchProducts(context.Background(), SearchProductsRequest{Query: "oak", Channel: "fax"})
+	assert.ErrorIs(t, err, domain.ErrInvalidSalesChannel)
+}
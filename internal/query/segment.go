@@ -0,0 +1,78 @@
+package query
+
+import (
+	"math/big"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// requestSegment validates the customer segment of a request; empty means none.
+func requestSegment(segment string) (string, error) {
+	if segment == "" {
+		return "", nil
+	}
+	return domain.ParseSegment(segment)
+}
+
+// segmentFactor returns the factor that turns the base price of dto into its price for
+// the segment, or nil if the product has no price for it.
+func segmentFactor(dto *contract.ProductDTO, segment string) *big.Rat {
+	if segment == "" || dto.BasePriceNum == 0 || dto.BasePriceDenom == 0 {
+		return nil
+	}
+	for _, s := range dto.SegmentPrices {
+		if s.Segment != segment {
+			continue
+		}
+		if s.PercentOff != 0 {
+			off := new(big.Rat).Quo(domain.PercentageFromFloat(s.PercentOff), big.NewRat(100, 1))
+			return off.Sub(big.NewRat(1, 1), off)
+		}
+		if s.PriceDenom == 0 {
+			return nil
+		}
+		price := new(big.Rat).SetFrac64(s.PriceNum, s.PriceDenom)
+		return price.Quo(price, new(big.Rat).SetFrac64(dto.BasePriceNum, dto.BasePriceDenom))
+	}
+	return nil
+}
+
+// inSegment returns dto priced for a customer segment, and whether the product has a price
+// for it. The base price is replaced by the segment price; discounts, tiers and the price
+// book keep their proportions to it, so discounts apply on top of the segment price. A
+// product without a price for the segment keeps its regular prices. dto itself is never
+// modified, as read models may cache it.
+func inSegment(dto *contract.ProductDTO, segment string) (*contract.ProductDTO, bool) {
+	factor := segmentFactor(dto, segment)
+	if factor == nil {
+		return dto, false
+	}
+	scale := func(num, denom int64) (int64, int64) {
+		amount := new(big.Rat).SetFrac64(num, denom)
+		amount.Mul(amount, factor)
+		return amount.Num().Int64(), amount.Denom().Int64()
+	}
+
+	priced := *dto
+	priced.BasePriceNum, priced.BasePriceDenom = scale(dto.BasePriceNum, dto.BasePriceDenom)
+	priced.EffectivePriceNum, priced.EffectivePriceDenom = scale(dto.EffectivePriceNum, dto.EffectivePriceDenom)
+	if len(dto.PriceTiers) > 0 {
+		priced.PriceTiers = make([]contract.PriceTierDTO, len(dto.PriceTiers))
+		for i, t := range dto.PriceTiers {
+			priced.PriceTiers[i] = t
+			if t.UnitPriceDenom != 0 {
+				priced.PriceTiers[i].UnitPriceNum, priced.PriceTiers[i].UnitPriceDenom = scale(t.UnitPriceNum, t.UnitPriceDenom)
+			}
+		}
+	}
+	if len(dto.PriceBook) > 0 {
+		priced.PriceBook = make([]contract.PriceBookEntryDTO, len(dto.PriceBook))
+		for i, e := range dto.PriceBook {
+			priced.PriceBook[i] = e
+			priced.PriceBook[i].PriceNum, priced.PriceBook[i].PriceDenom = scale(e.PriceNum, e.PriceDenom)
+		}
+	}
+	priced.Variants = scaleVariants(dto, &priced, scale)
+	return &priced, true
+}
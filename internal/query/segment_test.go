@@ -0,0 +1,89 @@
+package query
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// segmentWidgetDTO is widgetDTO with a fixed employee price of 12.00 and 10% off for
+// wholesale customers.
+func segmentWidgetDTO() *contract.ProductDTO {
+	dto := widgetDTO()
+	dto.SegmentPrices = []contract.SegmentPriceDTO{
+		{Segment: "employee", PriceNum: 1200, PriceDenom: 100},
+		{Segment: "wholesale", PercentOff: 10},
+	}
+	return dto
+}
+
+func TestInSegment(t *testing.T) {
+	tests := []struct {
+		name     string
+		segment  string
+		wantBase *big.Rat
+		wantIn   bool
+	}{
+		{"no segment", "", big.NewRat(20, 1), false},
+		{"fixed price", "employee", big.NewRat(12, 1), true},
+		{"percent off", "wholesale", big.NewRat(18, 1), true},
+		{"segment without a price", "retail", big.NewRat(20, 1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dto := segmentWidgetDTO()
+			priced, in := inSegment(dto, tt.segment)
+			assert.Equal(t, tt.wantIn, in)
+			assert.Equal(t, 0, new(big.Rat).SetFrac64(priced.BasePriceNum, priced.BasePriceDenom).Cmp(tt.wantBase))
+
+			// The discount, the unit price tier and the price book keep their proportions
+			// to the base price.
+			factor := new(big.Rat).Quo(tt.wantBase, big.NewRat(20, 1))
+			effective := new(big.Rat).Mul(tt.wantBase, big.NewRat(3, 4))
+			assert.Equal(t, 0, new(big.Rat).SetFrac64(priced.EffectivePriceNum, priced.EffectivePriceDenom).Cmp(effective))
+			unit := new(big.Rat).Mul(tt.wantBase, big.NewRat(9, 10))
+			assert.Equal(t, 0, new(big.Rat).SetFrac64(priced.PriceTiers[0].UnitPriceNum, priced.PriceTiers[0].UnitPriceDenom).Cmp(unit))
+			eur := new(big.Rat).Mul(big.NewRat(19, 1), factor)
+			assert.Equal(t, 0, new(big.Rat).SetFrac64(priced.PriceBook[0].PriceNum, priced.PriceBook[0].PriceDenom).Cmp(eur))
+
+			// The read model's DTO is left as it was.
+			assert.Equal(t, segmentWidgetDTO(), dto)
+		})
+	}
+}
+
+func TestProductQueries_GetProduct_Segment(t *testing.T) {
+	q := NewProductQueries(&productReadModel{product: segmentWidgetDTO()}, clock.NewFixedClock(time.Now()))
+	ctx := context.Background()
+
+	product, err := q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Segment: "employee"})
+	require.NoError(t, err)
+	assert.Equal(t, "employee", product.Segment)
+	assert.Equal(t, "12.00", product.BasePriceDisplay)
+	assert.Equal(t, "9.00", product.EffectivePriceDisplay)
+	require.Len(t, product.SegmentPrices, 2)
+	assert.Equal(t, "12.00", product.SegmentPrices[0].PriceDisplay)
+	assert.Equal(t, 10.0, product.SegmentPrices[1].PercentOff)
+
+	// Segment prices apply before the conversion to another currency.
+	product, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Segment: "wholesale", Currency: "EUR"})
+	require.NoError(t, err)
+	assert.Equal(t, "wholesale", product.Segment)
+	assert.Equal(t, "17.10", product.BasePriceDisplay)
+
+	product, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Segment: "retail"})
+	require.NoError(t, err)
+	assert.Empty(t, product.Segment)
+	assert.Equal(t, "20.00", product.BasePriceDisplay)
+
+	_, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Segment: "Wholesale Partners"})
+	assert.ErrorIs(t, err, domain.ErrInvalidSegment)
+}
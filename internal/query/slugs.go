@@ -0,0 +1,42 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// GetProductBySlugRequest represents the input for getting a product by its URL slug,
+// e.g. "blue-widget". The other fields are as in GetProductRequest.
+type GetProductBySlugRequest struct {
+	Slug       string
+	Currency   string
+	Experiment ExperimentContext
+	Segment    string
+	Market     string
+	PriceAt    time.Time
+	Locale     string
+}
+
+// GetProductBySlug retrieves the product with a slug as GetProduct retrieves it by ID.
+// It fails with domain.ErrProductNotFound if no product has the slug.
+func (q *ProductQueries) GetProductBySlug(ctx context.Context, req GetProductBySlugRequest) (*ProductResponse, error) {
+	slug, err := domain.ParseSlug(req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	id, err := q.readModel.FindProductIDBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	return q.GetProduct(ctx, GetProductRequest{
+		ProductID:  id,
+		Currency:   req.Currency,
+		Experiment: req.Experiment,
+		Segment:    req.Segment,
+		Market:     req.Market,
+		PriceAt:    req.PriceAt,
+		Locale:     req.Locale,
+	})
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slugReadModel serves a single product by ID or by slug.
+type slugReadModel struct {
+	contract.ProductReadModel
+	product *contract.ProductDTO
+}
+
+func (rm *slugReadModel) FindProductIDBySlug(_ context.Context, slug string) (string, error) {
+	if slug != rm.product.Slug {
+		return "", domain.ErrProductNotFound
+	}
+	return rm.product.ID, nil
+}
+
+func (rm *slugReadModel) GetProduct(_ context.Context, id string, _ time.Time) (*contract.ProductDTO, error) {
+	if id != rm.product.ID {
+		return nil, domain.ErrProductNotFound
+	}
+	return rm.product, nil
+}
+
+func TestProductQueries_GetProductBySlug(t *testing.T) {
+	dto := widgetDTO()
+	dto.Slug = "widget"
+	q := NewProductQueries(&slugReadModel{product: dto}, clock.NewFixedClock(time.Now()))
+
+	resp, err := q.GetProductBySlug(context.Background(), GetProductBySlugRequest{Slug: "Widget"})
+	require.NoError(t, err)
+	assert.Equal(t, "product-1", resp.ID)
+	assert.Equal(t, "widget", resp.Slug)
+
+	_, err = q.GetProductBySlug(context.Background(), GetProductBySlugRequest{Slug: "gadget"})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	_, err = q.GetProductBySlug(context.Background(), GetProductBySlugRequest{Slug: "blue widget"})
+	assert.ErrorIs(t, err, domain.ErrInvalidSlug)
+	_, err = q.GetProductBySlug(context.Background(), GetProductBySlugRequest{Slug: "widget", Currency: "euro"})
+	assert.ErrorIs(t, err, domain.ErrInvalidCurrency)
+}
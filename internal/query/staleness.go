@@ -0,0 +1,21 @@
+package query
+
+import (
+	"context"
+
+	"github.com/product-catalog-service/internal/staleness"
+)
+
+// readWithin returns ctx with its reads within the staleness bound written as value (see
+// package staleness), or ctx itself, reading within the default bound of the read model,
+// if value is empty.
+func readWithin(ctx context.Context, value string) (context.Context, error) {
+	if value == "" {
+		return ctx, nil
+	}
+	bound, err := staleness.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	return staleness.NewContext(ctx, bound), nil
+}
@@ -0,0 +1,55 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/staleness"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stalenessReadModel records the staleness bound the products are read within.
+type stalenessReadModel struct {
+	productReadModel
+	bound    staleness.Bound
+	hasBound bool
+}
+
+func (rm *stalenessReadModel) GetProduct(ctx context.Context, id string, at time.Time) (*contract.ProductDTO, error) {
+	rm.bound, rm.hasBound = staleness.FromContext(ctx)
+	return rm.productReadModel.GetProduct(ctx, id, at)
+}
+
+func (rm *stalenessReadModel) ListProducts(ctx context.Context, filter contract.ListProductsFilter, pagination contract.Pagination, at time.Time) (*contract.ListProductsResult, error) {
+	rm.bound, rm.hasBound = staleness.FromContext(ctx)
+	return rm.productReadModel.ListProducts(ctx, filter, pagination, at)
+}
+
+func TestProductQueries_ReadStaleness(t *testing.T) {
+	readModel := &stalenessReadModel{productReadModel: productReadModel{product: widgetDTO()}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(time.Now()))
+	ctx := context.Background()
+
+	// Without a staleness, the read model reads within its default
+	_, err := q.GetProduct(ctx, GetProductRequest{ProductID: "product-1"})
+	require.NoError(t, err)
+	assert.False(t, readModel.hasBound)
+
+	_, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", ReadStaleness: "exact:10s"})
+	require.NoError(t, err)
+	assert.True(t, readModel.hasBound)
+	assert.Equal(t, staleness.Bound{Staleness: 10 * time.Second, Exact: true}, readModel.bound)
+
+	_, err = q.ListProducts(ctx, ListProductsRequest{ReadStaleness: "strong"})
+	require.NoError(t, err)
+	assert.True(t, readModel.hasBound)
+	assert.Equal(t, staleness.Strong, readModel.bound)
+
+	_, err = q.ListProducts(ctx, ListProductsRequest{ReadStaleness: "soon"})
+	assert.ErrorIs(t, err, domain.ErrInvalidReadStaleness)
+}
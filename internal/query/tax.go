@@ -0,0 +1,96 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// GetTaxInclusivePriceRequest represents the input for pricing a product with tax.
+type GetTaxInclusivePriceRequest struct {
+	ProductID string
+	// Region selects regional tax rates, e.g. "DE"; empty means the default rates.
+	Region string
+	// At is the pricing time; the zero value means now.
+	At time.Time
+}
+
+// TaxInclusivePriceResponse represents the effective price of a product with the tax on
+// it. Net, tax and gross prices are exact; the display fields are rounded.
+type TaxInclusivePriceResponse struct {
+	ProductID             string
+	TaxClass              string
+	TaxRatePercent        float64
+	NetPriceNumerator     int64
+	NetPriceDenominator   int64
+	TaxAmountNumerator    int64
+	TaxAmountDenominator  int64
+	GrossPriceNumerator   int64
+	GrossPriceDenominator int64
+	NetPriceDisplay       string
+	TaxAmountDisplay      string
+	GrossPriceDisplay     string
+	Currency              string
+	Status                string
+}
+
+// WithTaxRates enables GetTaxInclusivePrice with the tax rates of rates.
+func WithTaxRates(rates domain.TaxRateProvider) Option {
+	return func(q *ProductQueries) {
+		q.taxRates = rates
+	}
+}
+
+// GetTaxInclusivePrice prices a product at the requested time and adds the tax of its
+// tax class in the requested region. The effective price is the net price. It fails with
+// domain.ErrNoTaxRate if the tax class has no rate.
+func (q *ProductQueries) GetTaxInclusivePrice(ctx context.Context, req GetTaxInclusivePriceRequest) (*TaxInclusivePriceResponse, error) {
+	if req.ProductID == "" {
+		return nil, domain.ErrInvalidID
+	}
+
+	at := req.At
+	if at.IsZero() {
+		at = q.clock.Now()
+	}
+
+	dtos, err := q.readModel.GetEffectivePrices(ctx, []string{req.ProductID}, at)
+	if err != nil {
+		return nil, err
+	}
+	if len(dtos) == 0 {
+		return nil, domain.ErrProductNotFound
+	}
+	dto := dtos[0]
+
+	class, err := domain.ParseTaxClass(dto.TaxClass)
+	if err != nil {
+		return nil, err
+	}
+	net, err := domain.NewMoneyInCurrency(dto.EffectivePriceNum, dto.EffectivePriceDenom, dto.Currency)
+	if err != nil {
+		return nil, err
+	}
+	taxed, err := domain.NewPricingCalculator().CalculateTaxedPrice(net, class, req.Region, q.taxRates)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, _ := taxed.RatePercent.Float64()
+	resp := &TaxInclusivePriceResponse{
+		ProductID:             dto.ProductID,
+		TaxClass:              class.String(),
+		TaxRatePercent:        rate,
+		NetPriceNumerator:     taxed.Net.Numerator(),
+		NetPriceDenominator:   taxed.Net.Denominator(),
+		TaxAmountNumerator:    taxed.Tax.Numerator(),
+		TaxAmountDenominator:  taxed.Tax.Denominator(),
+		GrossPriceNumerator:   taxed.Gross.Numerator(),
+		GrossPriceDenominator: taxed.Gross.Denominator(),
+		Currency:              taxed.Gross.Currency(),
+		Status:                dto.Status,
+	}
+	q.roundTaxInclusivePrice(resp)
+	return resp, nil
+}
@@ -0,0 +1,89 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductQueries_GetTaxInclusivePrice(t *testing.T) {
+	rates, err := domain.ParseTaxRates("standard=20,reduced=7,DE:standard=19")
+	require.NoError(t, err)
+	readModel := &priceReadModel{prices: []*contract.PriceDTO{{
+		ProductID:           "product-1",
+		BasePriceNum:        2500,
+		BasePriceDenom:      100,
+		EffectivePriceNum:   1999,
+		EffectivePriceDenom: 100,
+		Currency:            "EUR",
+		Status:              "active",
+		TaxClass:            "standard",
+	}}}
+	q := NewProductQueries(readModel, clock.NewFixedClock(time.Now()), WithTaxRates(rates))
+
+	tests := []struct {
+		name                                     string
+		taxClass                                 string
+		region                                   string
+		wantRate                                 float64
+		wantNet, wantTax, wantGross              string
+		wantGrossNumerator, wantGrossDenominator int64
+	}{
+		{"standard", "standard", "", 20, "19.99", "4.00", "23.99", 5997, 250},
+		{"regional", "standard", "DE", 19, "19.99", "3.80", "23.79", 237881, 10000},
+		{"stored before tax classes", "", "", 20, "19.99", "4.00", "23.99", 5997, 250},
+		{"reduced", "reduced", "", 7, "19.99", "1.40", "21.39", 213893, 10000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readModel.prices[0].TaxClass = tt.taxClass
+
+			resp, err := q.GetTaxInclusivePrice(context.Background(), GetTaxInclusivePriceRequest{ProductID: "product-1", Region: tt.region})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRate, resp.TaxRatePercent)
+			assert.Equal(t, int64(1999), resp.NetPriceNumerator)
+			assert.Equal(t, tt.wantGrossNumerator, resp.GrossPriceNumerator)
+			assert.Equal(t, tt.wantGrossDenominator, resp.GrossPriceDenominator)
+			assert.Equal(t, tt.wantNet, resp.NetPriceDisplay)
+			assert.Equal(t, tt.wantTax, resp.TaxAmountDisplay)
+			assert.Equal(t, tt.wantGross, resp.GrossPriceDisplay)
+			assert.Equal(t, "EUR", resp.Currency)
+		})
+	}
+}
+
+func TestProductQueries_GetTaxInclusivePrice_Errors(t *testing.T) {
+	rates, err := domain.ParseTaxRates("standard=20")
+	require.NoError(t, err)
+	price := &contract.PriceDTO{
+		ProductID: "product-1", EffectivePriceNum: 10, EffectivePriceDenom: 1, Currency: "USD", TaxClass: "luxury",
+	}
+
+	tests := []struct {
+		name    string
+		prices  []*contract.PriceDTO
+		opts    []Option
+		id      string
+		wantErr error
+	}{
+		{"empty ID", nil, nil, "", domain.ErrInvalidID},
+		{"missing product", nil, nil, "product-1", domain.ErrProductNotFound},
+		{"no tax rates configured", []*contract.PriceDTO{price}, nil, "product-1", domain.ErrNoTaxRate},
+		{"no rate for the tax class", []*contract.PriceDTO{price}, []Option{WithTaxRates(rates)}, "product-1", domain.ErrNoTaxRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewProductQueries(&priceReadModel{prices: tt.prices}, clock.NewFixedClock(time.Now()), tt.opts...)
+			_, err := q.GetTaxInclusivePrice(context.Background(), GetTaxInclusivePriceRequest{ProductID: tt.id})
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
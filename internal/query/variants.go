@@ -0,0 +1,33 @@
+package query
+
+import (
+	"math/big"
+
+	"github.com/product-catalog-service/internal/contract"
+)
+
+// scaleVariants returns a copy of the variants of dto with their prices and price deltas
+// scaled like the base price of the product, as priced turns out. The effective prices
+// keep the proportion of the effective to the base price of priced, since a market may
+// have discounts of its own. It returns nil if there are no variants.
+func scaleVariants(dto, priced *contract.ProductDTO, scale func(num, denom int64) (int64, int64)) []contract.VariantDTO {
+	if len(dto.Variants) == 0 {
+		return nil
+	}
+	discount := big.NewRat(1, 1)
+	if priced.BasePriceNum != 0 && priced.BasePriceDenom != 0 && priced.EffectivePriceDenom != 0 {
+		discount.SetFrac64(priced.EffectivePriceNum, priced.EffectivePriceDenom)
+		discount.Quo(discount, new(big.Rat).SetFrac64(priced.BasePriceNum, priced.BasePriceDenom))
+	}
+
+	scaled := make([]contract.VariantDTO, len(dto.Variants))
+	for i, v := range dto.Variants {
+		scaled[i] = v
+		scaled[i].PriceDeltaNum, scaled[i].PriceDeltaDenom = scale(v.PriceDeltaNum, v.PriceDeltaDenom)
+		scaled[i].PriceNum, scaled[i].PriceDenom = scale(v.PriceNum, v.PriceDenom)
+		effective := new(big.Rat).SetFrac64(scaled[i].PriceNum, scaled[i].PriceDenom)
+		effective.Mul(effective, discount)
+		scaled[i].EffectivePriceNum, scaled[i].EffectivePriceDenom = effective.Num().Int64(), effective.Denom().Int64()
+	}
+	return scaled
+}
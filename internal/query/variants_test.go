@@ -0,0 +1,52 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductQueries_GetProduct_Variants(t *testing.T) {
+	dto := marketWidgetDTO()
+	dto.Variants = []contract.VariantDTO{{
+		ID:                  "variant-1",
+		SKU:                 "WIDGET-XL",
+		Attributes:          map[string]string{"size": "XL"},
+		PriceDeltaNum:       500,
+		PriceDeltaDenom:     100,
+		PriceNum:            2500,
+		PriceDenom:          100,
+		EffectivePriceNum:   1875,
+		EffectivePriceDenom: 100,
+		Status:              "active",
+	}}
+	q := NewProductQueries(&productReadModel{product: dto}, clock.NewFixedClock(time.Now()))
+	ctx := context.Background()
+
+	product, err := q.GetProduct(ctx, GetProductRequest{ProductID: "product-1"})
+	require.NoError(t, err)
+	require.Len(t, product.Variants, 1)
+	variant := product.Variants[0]
+	assert.Equal(t, "WIDGET-XL", variant.SKU)
+	assert.Equal(t, map[string]string{"size": "XL"}, variant.Attributes)
+	assert.Equal(t, "5.00", variant.PriceDeltaDisplay)
+	assert.Equal(t, "25.00", variant.PriceDisplay)
+	assert.Equal(t, "18.75", variant.EffectivePriceDisplay)
+
+	// In the UK the prices scale like the base price, and the UK discount applies.
+	product, err = q.GetProduct(ctx, GetProductRequest{ProductID: "product-1", Market: "UK"})
+	require.NoError(t, err)
+	require.Len(t, product.Variants, 1)
+	variant = product.Variants[0]
+	assert.Equal(t, "4.00", variant.PriceDeltaDisplay)
+	assert.Equal(t, "20.00", variant.PriceDisplay)
+	assert.Equal(t, "18.00", variant.EffectivePriceDisplay)
+
+	// The read model's DTO is left as it was.
+	assert.Equal(t, int64(2500), dto.Variants[0].PriceNum)
+}
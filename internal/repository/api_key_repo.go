@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/grpc/codes"
+)
+
+// APIKeyRepo implements the APIKeyRepository interface using Spanner.
+type APIKeyRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.APIKeyRepository = (*APIKeyRepo)(nil)
+
+// NewAPIKeyRepo creates a new APIKeyRepo.
+func NewAPIKeyRepo(client *spanner.Client) *APIKeyRepo {
+	return &APIKeyRepo{client: client}
+}
+
+// FindByID retrieves an API key by its ID.
+func (r *APIKeyRepo) FindByID(ctx context.Context, id string) (*domain.APIKey, error) {
+	row, err := r.client.Single().ReadRow(ctx, APIKeysTable, spanner.Key{id}, []string{
+		APIKeyID, APIKeyClientID, APIKeyHash, APIKeyCreatedAt, APIKeyExpiresAt, APIKeyRevokedAt,
+	})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+
+	var (
+		keyID, clientID      string
+		hash                 []byte
+		createdAt            time.Time
+		expiresAt, revokedAt spanner.NullTime
+	)
+	if err := row.Columns(&keyID, &clientID, &hash, &createdAt, &expiresAt, &revokedAt); err != nil {
+		return nil, err
+	}
+	return domain.ReconstructAPIKey(keyID, clientID, hash, createdAt,
+		nullTimePtr(expiresAt), nullTimePtr(revokedAt)), nil
+}
+
+// Save inserts or updates the given keys in one transaction.
+func (r *APIKeyRepo) Save(ctx context.Context, keys ...*domain.APIKey) error {
+	muts := make([]*spanner.Mutation, 0, len(keys))
+	for _, key := range keys {
+		muts = append(muts, spanner.InsertOrUpdateMap(APIKeysTable, map[string]interface{}{
+			APIKeyID:        key.ID(),
+			APIKeyClientID:  key.ClientID(),
+			APIKeyHash:      key.Hash(),
+			APIKeyCreatedAt: key.CreatedAt(),
+			APIKeyExpiresAt: timePtrNull(key.ExpiresAt()),
+			APIKeyRevokedAt: timePtrNull(key.RevokedAt()),
+		}))
+	}
+	_, err := r.client.Apply(ctx, muts)
+	return err
+}
+
+// nullTimePtr converts a nullable timestamp column to a time pointer.
+func nullTimePtr(t spanner.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// timePtrNull converts a time pointer to a nullable timestamp column.
+func timePtrNull(t *time.Time) spanner.NullTime {
+	if t == nil {
+		return spanner.NullTime{}
+	}
+	return spanner.NullTime{Time: *t, Valid: true}
+}
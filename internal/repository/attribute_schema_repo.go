@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// AttributeSchemaRepo implements the AttributeSchemaRepository interface using Spanner.
+type AttributeSchemaRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.AttributeSchemaRepository = (*AttributeSchemaRepo)(nil)
+
+// NewAttributeSchemaRepo creates a new AttributeSchemaRepo.
+func NewAttributeSchemaRepo(client *spanner.Client) *AttributeSchemaRepo {
+	return &AttributeSchemaRepo{client: client}
+}
+
+// attributeDefinitionJSON is an attribute definition as stored in the attributes column.
+type attributeDefinitionJSON struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Required      bool     `json:"required,omitempty"`
+	AllowedValues []string `json:"allowed_values,omitempty"`
+}
+
+// Save stores the attribute schema of a category, replacing any it had.
+func (r *AttributeSchemaRepo) Save(ctx context.Context, schema *domain.CategoryAttributeSchema) error {
+	definitions := make([]attributeDefinitionJSON, 0, len(schema.Attributes()))
+	for _, d := range schema.Attributes() {
+		definitions = append(definitions, attributeDefinitionJSON{
+			Name:          d.Name(),
+			Type:          string(d.Type()),
+			Required:      d.Required(),
+			AllowedValues: d.AllowedValues(),
+		})
+	}
+	mut := spanner.InsertOrUpdateMap(AttributeSchemasTable, map[string]interface{}{
+		AttributeSchemaCategory:   schema.Category(),
+		AttributeSchemaAttributes: spanner.NullJSON{Value: definitions, Valid: true},
+		AttributeSchemaUpdatedAt:  spanner.CommitTimestamp,
+	})
+	_, err := r.client.Apply(ctx, []*spanner.Mutation{mut})
+	return err
+}
+
+// Delete deletes the attribute schema of a category. It returns
+// domain.ErrAttributeSchemaNotFound if the category has none.
+func (r *AttributeSchemaRepo) Delete(ctx context.Context, category string) error {
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		if _, err := txn.ReadRow(ctx, AttributeSchemasTable, spanner.Key{category}, []string{AttributeSchemaCategory}); err != nil {
+			if spanner.ErrCode(err) == codes.NotFound {
+				return domain.ErrAttributeSchemaNotFound
+			}
+			return err
+		}
+		return txn.BufferWrite([]*spanner.Mutation{spanner.Delete(AttributeSchemasTable, spanner.Key{category})})
+	})
+	return err
+}
+
+// Find returns the attribute schema of a category, or nil if it has none.
+func (r *AttributeSchemaRepo) Find(ctx context.Context, category string) (*domain.CategoryAttributeSchema, error) {
+	row, err := r.client.Single().ReadRow(ctx, AttributeSchemasTable, spanner.Key{category},
+		[]string{AttributeSchemaCategory, AttributeSchemaAttributes})
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return attributeSchemaFromRow(row)
+}
+
+// List returns the attribute schemas of every category, ordered by category.
+func (r *AttributeSchemaRepo) List(ctx context.Context) ([]*domain.CategoryAttributeSchema, error) {
+	iter := r.client.Single().Query(ctx, spanner.Statement{
+		SQL: `SELECT category, attributes FROM category_attribute_schemas ORDER BY category`,
+	})
+	defer iter.Stop()
+
+	var schemas []*domain.CategoryAttributeSchema
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return schemas, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		schema, err := attributeSchemaFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+}
+
+// attributeSchemaFromRow reads a row of the category and attributes columns.
+func attributeSchemaFromRow(row *spanner.Row) (*domain.CategoryAttributeSchema, error) {
+	var (
+		category   string
+		attributes spanner.NullJSON
+	)
+	if err := row.Columns(&category, &attributes); err != nil {
+		return nil, err
+	}
+	return attributeSchemaFromJSON(category, attributes)
+}
+
+// attributeSchemaFromJSON builds the attribute schema of a category from its attributes
+// column.
+func attributeSchemaFromJSON(category string, attributes spanner.NullJSON) (*domain.CategoryAttributeSchema, error) {
+	var definitions []attributeDefinitionJSON
+	if attributes.Valid {
+		raw, err := json.Marshal(attributes.Value)
+		if err == nil {
+			err = json.Unmarshal(raw, &definitions)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("attribute schema of category %q: %w", category, err)
+		}
+	}
+
+	defs := make([]*domain.AttributeDefinition, 0, len(definitions))
+	for _, d := range definitions {
+		def, err := domain.NewAttributeDefinition(d.Name, domain.AttributeType(d.Type), d.Required, d.AllowedValues)
+		if err != nil {
+			return nil, fmt.Errorf("attribute schema of category %q: attribute %q: %w", category, d.Name, err)
+		}
+		defs = append(defs, def)
+	}
+	return domain.NewCategoryAttributeSchema(category, defs)
+}
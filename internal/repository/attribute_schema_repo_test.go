@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributeSchemaFromJSON(t *testing.T) {
+	var stored interface{}
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"name": "material", "type": "string", "required": true, "allowed_values": ["oak", "pine"]},
+		{"name": "legs", "type": "integer"}
+	]`), &stored))
+
+	schema, err := attributeSchemaFromJSON("Furniture", spanner.NullJSON{Value: stored, Valid: true})
+	require.NoError(t, err)
+	assert.Equal(t, "Furniture", schema.Category())
+	require.Len(t, schema.Attributes(), 2)
+	material := schema.Attribute("material")
+	require.NotNil(t, material)
+	assert.Equal(t, domain.AttributeTypeString, material.Type())
+	assert.True(t, material.Required())
+	assert.Equal(t, []string{"oak", "pine"}, material.AllowedValues())
+	assert.False(t, schema.Attribute("legs").Required())
+
+	_, err = attributeSchemaFromJSON("Furniture", spanner.NullJSON{Value: []interface{}{
+		map[string]interface{}{"name": "legs", "type": "date"},
+	}, Valid: true})
+	assert.ErrorIs(t, err, domain.ErrInvalidAttributeSchema)
+}
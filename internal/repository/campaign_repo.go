@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/grpc/codes"
+)
+
+// CampaignRepo implements the CampaignRepository interface using Spanner.
+type CampaignRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.CampaignRepository = (*CampaignRepo)(nil)
+
+// NewCampaignRepo creates a new CampaignRepo.
+func NewCampaignRepo(client *spanner.Client) *CampaignRepo {
+	return &CampaignRepo{client: client}
+}
+
+// FindByID retrieves a campaign by its ID. Campaigns of another tenant than that of the
+// caller are not found; see tenantScope.
+func (r *CampaignRepo) FindByID(ctx context.Context, id string) (*domain.Campaign, error) {
+	row, err := r.client.Single().ReadRow(ctx, CampaignsTable, spanner.Key{id}, campaignColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrCampaignNotFound
+		}
+		return nil, err
+	}
+	campaign, err := campaignFromRow(row)
+	if err != nil {
+		return nil, err
+	}
+	if !tenantScopeOf(ctx).visible(optionalStringColumn(campaign.TenantID())) {
+		return nil, domain.ErrCampaignNotFound
+	}
+	return campaign, nil
+}
+
+// InsertMut returns a mutation for inserting a new campaign.
+func (r *CampaignRepo) InsertMut(campaign *domain.Campaign) *spanner.Mutation {
+	discount := campaign.Discount()
+	category := spanner.NullString{StringVal: campaign.Category(), Valid: campaign.Category() != ""}
+	var productIDs []string
+	if ids := campaign.ProductIDs(); len(ids) > 0 {
+		productIDs = ids
+	}
+	return spanner.InsertMap(CampaignsTable, map[string]interface{}{
+		CampaignID:         campaign.ID(),
+		CampaignTenantID:   optionalStringColumn(campaign.TenantID()),
+		CampaignName:       campaign.Name(),
+		CampaignCategory:   category,
+		CampaignProductIDs: productIDs,
+		CampaignPercentage: *discount.Percentage(),
+		CampaignPriority:   int64(discount.Priority()),
+		CampaignStartDate:  discount.StartDate(),
+		CampaignEndDate:    discount.EndDate(),
+		CampaignStatus:     campaign.Status().String(),
+		CampaignCreatedAt:  campaign.CreatedAt(),
+		CampaignUpdatedAt:  campaign.UpdatedAt(),
+	})
+}
+
+// UpdateStatusMut returns a mutation that persists the status of a campaign.
+func (r *CampaignRepo) UpdateStatusMut(campaign *domain.Campaign) *spanner.Mutation {
+	return spanner.UpdateMap(CampaignsTable, map[string]interface{}{
+		CampaignID:        campaign.ID(),
+		CampaignStatus:    campaign.Status().String(),
+		CampaignUpdatedAt: campaign.UpdatedAt(),
+	})
+}
+
+// campaignColumns returns the columns read by FindByID, in the order campaignFromRow
+// expects them.
+func campaignColumns() []string {
+	return []string{
+		CampaignID,
+		CampaignTenantID,
+		CampaignName,
+		CampaignCategory,
+		CampaignProductIDs,
+		CampaignPercentage,
+		CampaignPriority,
+		CampaignStartDate,
+		CampaignEndDate,
+		CampaignStatus,
+		CampaignCreatedAt,
+		CampaignUpdatedAt,
+	}
+}
+
+// campaignFromRow reads a row of the columns returned by campaignColumns.
+func campaignFromRow(row *spanner.Row) (*domain.Campaign, error) {
+	var (
+		id, name             string
+		tenantID, category   spanner.NullString
+		productIDs           []string
+		percentage           big.Rat
+		priority             int64
+		startDate, endDate   time.Time
+		status               string
+		createdAt, updatedAt time.Time
+	)
+	if err := row.Columns(&id, &tenantID, &name, &category, &productIDs, &percentage, &priority,
+		&startDate, &endDate, &status, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	discount, err := domain.NewDiscount(&percentage, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ReconstructCampaign(id, tenantID.StringVal, name, category.StringVal, productIDs,
+		discount.WithPriority(int(priority)), domain.CampaignStatus(status), createdAt, updatedAt), nil
+}
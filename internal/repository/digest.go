@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+	"google.golang.org/api/iterator"
+)
+
+// DigestRepo implements the DigestReader interface using Spanner. The queries scan their
+// tables, which is acceptable once a day.
+type DigestRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.DigestReader = (*DigestRepo)(nil)
+
+// NewDigestRepo creates a new DigestRepo.
+func NewDigestRepo(client *spanner.Client) *DigestRepo {
+	return &DigestRepo{client: client}
+}
+
+// FindNewProducts returns the products created in [from, to) that are not archived,
+// oldest first.
+func (r *DigestRepo) FindNewProducts(ctx context.Context, from, to time.Time) ([]*contract.DigestProductDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT product_id, name, category, status, base_price_numerator, base_price_denominator,
+		             IFNULL(currency, @default_currency), created_at
+		      FROM products
+		      WHERE created_at >= @from AND created_at < @to AND status != @archived
+		      ORDER BY created_at, product_id`,
+		Params: map[string]interface{}{
+			"from":             from,
+			"to":               to,
+			"archived":         string(domain.ProductStatusArchived),
+			"default_currency": domain.DefaultCurrency,
+		},
+	}
+
+	products := make([]*contract.DigestProductDTO, 0)
+	err := r.query(ctx, stmt, func(row *spanner.Row) error {
+		p := &contract.DigestProductDTO{}
+		if err := row.Columns(&p.ProductID, &p.Name, &p.Category, &p.Status,
+			&p.BasePriceNum, &p.BasePriceDenom, &p.Currency, &p.CreatedAt); err != nil {
+			return err
+		}
+		products = append(products, p)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// FindPriceMoves returns the active products whose price history records a change in
+// [from, to) and a price before from, with their effective prices before from and after
+// the last change in the range.
+func (r *DigestRepo) FindPriceMoves(ctx context.Context, from, to time.Time) ([]*contract.PriceMoveDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT h.product_id, p.name, p.category, h.currency, h.changed_at,
+		             h.effective_price_numerator, h.effective_price_denominator
+		      FROM price_history h JOIN products p ON p.product_id = h.product_id
+		      WHERE p.status = @active AND h.changed_at < @to
+		        AND h.product_id IN (
+		          SELECT product_id FROM price_history WHERE changed_at >= @from AND changed_at < @to)
+		      ORDER BY h.product_id, h.changed_at, h.change_id`,
+		Params: map[string]interface{}{
+			"from":   from,
+			"to":     to,
+			"active": string(domain.ProductStatusActive),
+		},
+	}
+
+	moves := make([]*contract.PriceMoveDTO, 0)
+	var current *contract.PriceMoveDTO
+	err := r.query(ctx, stmt, func(row *spanner.Row) error {
+		var (
+			productID, name, category, currency string
+			changedAt                           time.Time
+			num, denom                          int64
+		)
+		if err := row.Columns(&productID, &name, &category, &currency, &changedAt, &num, &denom); err != nil {
+			return err
+		}
+
+		if current == nil || current.ProductID != productID {
+			current = &contract.PriceMoveDTO{ProductID: productID, Name: name, Category: category, Currency: currency}
+		}
+		if changedAt.Before(from) {
+			// The rows are in time order, so the last one before from is the old price.
+			current.OldPriceNum, current.OldPriceDenom = num, denom
+			return nil
+		}
+		if current.OldPriceDenom == 0 {
+			// Created in the range: there is no price to compare with.
+			return nil
+		}
+		if current.NewPriceDenom == 0 {
+			moves = append(moves, current)
+		}
+		current.NewPriceNum, current.NewPriceDenom = num, denom
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return moves, nil
+}
+
+// FindExpiringDiscounts returns the discounts of active products that are neither suspended
+// nor pending approval and end in [from, to), soonest first. A discount still held in the legacy discount
+// columns has the product ID as its discount ID.
+func (r *DigestRepo) FindExpiringDiscounts(ctx context.Context, from, to time.Time) ([]*contract.ExpiringDiscountDTO, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT product_id, name, discount_id, percentage, end_date, market FROM (
+		        SELECT d.product_id, p.name, d.discount_id, CAST(d.percentage AS FLOAT64) AS percentage,
+		               d.end_date, d.market
+		        FROM product_discounts d JOIN products p ON p.product_id = d.product_id
+		        WHERE p.status = @active AND d.suspended_at IS NULL AND d.pending_approval IS NOT TRUE
+		          AND d.end_date >= @from AND d.end_date < @to
+		        UNION ALL
+		        SELECT product_id, name, product_id, CAST(discount_percent AS FLOAT64),
+		               discount_end_date, CAST(NULL AS STRING)
+		        FROM products
+		        WHERE status = @active AND discount_percent IS NOT NULL AND discount_suspended_at IS NULL
+		          AND discount_end_date >= @from AND discount_end_date < @to
+		      )
+		      ORDER BY end_date, product_id, discount_id`,
+		Params: map[string]interface{}{
+			"from":   from,
+			"to":     to,
+			"active": string(domain.ProductStatusActive),
+		},
+	}
+
+	discounts := make([]*contract.ExpiringDiscountDTO, 0)
+	err := r.query(ctx, stmt, func(row *spanner.Row) error {
+		var (
+			d      contract.ExpiringDiscountDTO
+			market spanner.NullString
+		)
+		if err := row.Columns(&d.ProductID, &d.Name, &d.DiscountID, &d.Percentage, &d.EndDate, &market); err != nil {
+			return err
+		}
+		d.Market = market.StringVal
+		discounts = append(discounts, &d)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return discounts, nil
+}
+
+// query runs stmt in a single-use read and calls fn for every row.
+func (r *DigestRepo) query(ctx context.Context, stmt spanner.Statement, fn func(row *spanner.Row) error) error {
+	logging.SQL("digest_repo", stmt.SQL, stmt.Params)
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+}
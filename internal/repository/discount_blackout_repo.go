@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// DiscountBlackoutRepo implements the DiscountBlackoutRepository interface using Spanner.
+type DiscountBlackoutRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.DiscountBlackoutRepository = (*DiscountBlackoutRepo)(nil)
+
+// NewDiscountBlackoutRepo creates a new DiscountBlackoutRepo.
+func NewDiscountBlackoutRepo(client *spanner.Client) *DiscountBlackoutRepo {
+	return &DiscountBlackoutRepo{client: client}
+}
+
+// Schedule stores a discount blackout.
+func (r *DiscountBlackoutRepo) Schedule(ctx context.Context, blackout *domain.DiscountBlackout) error {
+	_, err := r.client.Apply(ctx, []*spanner.Mutation{discountBlackoutMut(blackout)})
+	return err
+}
+
+// Cancel deletes a discount blackout. It returns domain.ErrDiscountBlackoutNotFound if
+// there is none with the ID.
+func (r *DiscountBlackoutRepo) Cancel(ctx context.Context, id string) error {
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		if _, err := txn.ReadRow(ctx, DiscountBlackoutsTable, spanner.Key{id}, []string{DiscountBlackoutID}); err != nil {
+			if spanner.ErrCode(err) == codes.NotFound {
+				return domain.ErrDiscountBlackoutNotFound
+			}
+			return err
+		}
+		return txn.BufferWrite([]*spanner.Mutation{spanner.Delete(DiscountBlackoutsTable, spanner.Key{id})})
+	})
+	return err
+}
+
+// List returns the discount blackouts that have not ended by the given time, soonest first.
+func (r *DiscountBlackoutRepo) List(ctx context.Context, at time.Time) ([]*domain.DiscountBlackout, error) {
+	return r.query(ctx, spanner.Statement{
+		SQL: `SELECT blackout_id, category, start_time, end_time, reason
+			FROM discount_blackouts WHERE end_time > @at ORDER BY start_time, blackout_id`,
+		Params: map[string]interface{}{"at": at},
+	})
+}
+
+// FindOverlapping returns the discount blackouts of every category that overlap [start, end).
+func (r *DiscountBlackoutRepo) FindOverlapping(ctx context.Context, start, end time.Time) ([]*domain.DiscountBlackout, error) {
+	return r.query(ctx, spanner.Statement{
+		SQL: `SELECT blackout_id, category, start_time, end_time, reason
+			FROM discount_blackouts WHERE start_time < @end AND end_time > @start
+			ORDER BY start_time, blackout_id`,
+		Params: map[string]interface{}{"start": start, "end": end},
+	})
+}
+
+func (r *DiscountBlackoutRepo) query(ctx context.Context, stmt spanner.Statement) ([]*domain.DiscountBlackout, error) {
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var blackouts []*domain.DiscountBlackout
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return blackouts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		blackout, err := discountBlackoutFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		blackouts = append(blackouts, blackout)
+	}
+}
+
+// discountBlackoutMut returns the mutation that inserts a discount blackout.
+func discountBlackoutMut(blackout *domain.DiscountBlackout) *spanner.Mutation {
+	reason := spanner.NullString{StringVal: blackout.Reason(), Valid: blackout.Reason() != ""}
+	return spanner.InsertMap(DiscountBlackoutsTable, map[string]interface{}{
+		DiscountBlackoutID:        blackout.ID(),
+		DiscountBlackoutCategory:  blackout.Category(),
+		DiscountBlackoutStart:     blackout.Start(),
+		DiscountBlackoutEnd:       blackout.End(),
+		DiscountBlackoutReason:    reason,
+		DiscountBlackoutCreatedAt: spanner.CommitTimestamp,
+	})
+}
+
+// discountBlackoutFromRow reads a row selected by List or FindOverlapping.
+func discountBlackoutFromRow(row *spanner.Row) (*domain.DiscountBlackout, error) {
+	var (
+		id, category string
+		start, end   time.Time
+		reason       spanner.NullString
+	)
+	if err := row.Columns(&id, &category, &start, &end, &reason); err != nil {
+		return nil, err
+	}
+	return domain.NewDiscountBlackout(id, category, start, end, reason.StringVal)
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"expvar"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// DiscountConsistency classifies how the three discount columns of a row relate.
+type DiscountConsistency int
+
+// DiscountConsistency values.
+const (
+	// DiscountAbsent means all discount columns are NULL.
+	DiscountAbsent DiscountConsistency = iota
+	// DiscountComplete means all discount columns are set.
+	DiscountComplete
+	// DiscountPartial means only some discount columns are set, e.g. after a
+	// partial write or a manual edit.
+	DiscountPartial
+)
+
+// String returns the name used in logs and metrics.
+func (c DiscountConsistency) String() string {
+	switch c {
+	case DiscountAbsent:
+		return "absent"
+	case DiscountComplete:
+		return "complete"
+	default:
+		return "partial"
+	}
+}
+
+// inconsistentDiscountRows counts rows read with partial discount columns, keyed by reader.
+var inconsistentDiscountRows = expvar.NewMap("repository_inconsistent_discount_rows")
+
+// DiscountConsistency classifies the discount columns of the row.
+func (p *ProductData) DiscountConsistency() DiscountConsistency {
+	set := 0
+	for _, valid := range []bool{p.DiscountPercent.Valid, p.DiscountStartDate.Valid, p.DiscountEndDate.Valid} {
+		if valid {
+			set++
+		}
+	}
+
+	switch set {
+	case 0:
+		return DiscountAbsent
+	case 3:
+		return DiscountComplete
+	default:
+		return DiscountPartial
+	}
+}
+
+// reportInconsistentDiscount logs and counts a row with partial discount columns.
+//
+// Fallback policy: a partial discount is treated as no discount at all. Readers expose
+// none of the discount columns and price the product at its base price, and the domain
+// model reconstructs the product without a discount. The row stays as-is until it is
+// repaired with ClearDiscountMut (see catalogctl repair-discounts).
+func reportInconsistentDiscount(reader string, data *ProductData) {
+	inconsistentDiscountRows.Add(reader, 1)
+	logging.Warnf("repository: product %s has partial discount columns (percent=%t start=%t end=%t); ignoring discount",
+		data.ProductID, data.DiscountPercent.Valid, data.DiscountStartDate.Valid, data.DiscountEndDate.Valid)
+}
+
+// ClearDiscountMut returns a mutation that sets all discount columns of a product to NULL.
+// It is used to repair rows with partial discount columns or archived rows that still
+// carry a discount; the domain already sees such products as having no discount, so
+// callers decide whether an event is recorded.
+func ClearDiscountMut(productID string, now time.Time) *spanner.Mutation {
+	return spanner.Update(ProductsTable,
+		[]string{ProductID, ProductDiscountPercent, ProductDiscountStartDate, ProductDiscountEndDate, ProductDiscountSuspendedAt, ProductDiscountPhase, ProductUpdatedAt},
+		[]interface{}{productID, spanner.NullNumeric{}, spanner.NullTime{}, spanner.NullTime{}, spanner.NullTime{}, spanner.NullString{}, now},
+	)
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"expvar"
+	"math/big"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func discountRow(now time.Time, percent, start, end bool) *ProductData {
+	data := &ProductData{
+		ProductID:            "product-123",
+		Name:                 "Widget",
+		Category:             "Tools",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+		Status:               "active",
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+	if percent {
+		data.DiscountPercent = spanner.NullNumeric{Numeric: *big.NewRat(25, 1), Valid: true}
+	}
+	if start {
+		data.DiscountStartDate = spanner.NullTime{Time: now.Add(-time.Hour), Valid: true}
+	}
+	if end {
+		data.DiscountEndDate = spanner.NullTime{Time: now.Add(time.Hour), Valid: true}
+	}
+	return data
+}
+
+func TestProductData_DiscountConsistency(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                string
+		percent, start, end bool
+		expected            DiscountConsistency
+	}{
+		{"no discount", false, false, false, DiscountAbsent},
+		{"complete discount", true, true, true, DiscountComplete},
+		{"percent only", true, false, false, DiscountPartial},
+		{"period only", false, true, true, DiscountPartial},
+		{"missing end date", true, true, false, DiscountPartial},
+		{"end date only", false, false, true, DiscountPartial},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := discountRow(now, tt.percent, tt.start, tt.end)
+			assert.Equal(t, tt.expected, data.DiscountConsistency())
+		})
+	}
+}
+
+func TestDataToDTO_DiscountFallback(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("complete discount is applied", func(t *testing.T) {
+		dto := dataToDTO(discountRow(now, true, true, true), nil, nil, now)
+
+		require.NotNil(t, dto.DiscountPercent)
+		assert.Equal(t, 25.0, *dto.DiscountPercent)
+		assert.True(t, dto.HasActiveDiscount)
+		assert.Equal(t, int64(15), dto.EffectivePriceNum)
+		assert.Equal(t, int64(1), dto.EffectivePriceDenom)
+	})
+
+	t.Run("partial discount falls back to base price", func(t *testing.T) {
+		before := readModelInconsistentRows()
+		dto := dataToDTO(discountRow(now, true, true, false), nil, nil, now)
+
+		assert.Nil(t, dto.DiscountPercent)
+		assert.Nil(t, dto.DiscountStartDate)
+		assert.Nil(t, dto.DiscountEndDate)
+		assert.False(t, dto.HasActiveDiscount)
+		assert.Equal(t, int64(2000), dto.EffectivePriceNum)
+		assert.Equal(t, int64(100), dto.EffectivePriceDenom)
+
+		assert.Equal(t, before+1, readModelInconsistentRows())
+	})
+}
+
+func readModelInconsistentRows() int64 {
+	if v, ok := inconsistentDiscountRows.Get("read_model").(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
+
+func TestClearDiscountMut(t *testing.T) {
+	assert.NotNil(t, ClearDiscountMut("product-123", time.Now()))
+}
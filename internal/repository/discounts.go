@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"math/big"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// rowReader is implemented by the Spanner transactions discounts are read in.
+type rowReader interface {
+	Read(ctx context.Context, table string, keys spanner.KeySet, columns []string) *spanner.RowIterator
+}
+
+// readDiscounts reads the product_discounts rows of the given products, keyed by product ID.
+func readDiscounts(ctx context.Context, reader rowReader, productIDs []string) (map[string][]*DiscountData, error) {
+	discounts := make(map[string][]*DiscountData)
+	if len(productIDs) == 0 {
+		return discounts, nil
+	}
+
+	keys := make([]spanner.KeySet, len(productIDs))
+	for i, id := range productIDs {
+		keys[i] = spanner.Key{id}.AsPrefix()
+	}
+
+	iter := reader.Read(ctx, DiscountsTable, spanner.KeySets(keys...), DiscountAllColumns())
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return discounts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := DiscountDataFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		discounts[data.ProductID] = append(discounts[data.ProductID], data)
+	}
+}
+
+// productDiscounts assembles the discounts of a product from its product_discounts rows
+// and, for rows written before migration 005, its legacy discount columns.
+// Archived products cannot retain a discount; see catalogctl repair-discounts.
+func productDiscounts(reader string, data *ProductData, rows []*DiscountData) []*domain.Discount {
+	legacy := legacyDiscount(reader, data)
+	if data.Status == string(domain.ProductStatusArchived) {
+		return nil
+	}
+
+	discounts := make([]*domain.Discount, 0, len(rows)+1)
+	if legacy != nil {
+		discounts = append(discounts, legacy)
+	}
+	currency := productCurrency(data)
+	for _, row := range rows {
+		if discount := dataToDiscount(row, currency); discount != nil {
+			discounts = append(discounts, discount)
+		}
+	}
+	return discounts
+}
+
+// legacyDiscount reads the discount stored in the discount columns of a product row.
+// Its ID is the product ID and its priority is zero. Partial or invalid discounts are
+// treated as no discount.
+func legacyDiscount(reader string, data *ProductData) *domain.Discount {
+	switch data.DiscountConsistency() {
+	case DiscountAbsent:
+		return nil
+	case DiscountPartial:
+		// Partial discounts are treated as no discount; see reportInconsistentDiscount.
+		reportInconsistentDiscount(reader, data)
+		return nil
+	}
+
+	discount, err := domain.NewDiscount(
+		numericToPercent(data.DiscountPercent),
+		data.DiscountStartDate.Time,
+		data.DiscountEndDate.Time,
+	)
+	if err != nil {
+		// If discount is invalid, ignore it
+		return nil
+	}
+
+	discount = discount.WithID(data.ProductID)
+	if data.DiscountSuspendedAt.Valid {
+		discount = discount.Suspend(data.DiscountSuspendedAt.Time)
+	}
+	if phase := domain.DiscountPhase(data.DiscountPhase.StringVal); data.DiscountPhase.Valid && phase.IsValid() {
+		discount = discount.WithPhase(phase)
+	}
+	return discount
+}
+
+// discountToData converts a discount of a product to a database model.
+func discountToData(productID string, discount *domain.Discount) *DiscountData {
+	data := &DiscountData{
+		ProductID:       productID,
+		DiscountID:      discount.ID(),
+		Percentage:      percentToNumeric(discount.Percentage()).Numeric,
+		Priority:        int64(discount.Priority()),
+		StartDate:       discount.StartDate(),
+		EndDate:         discount.EndDate(),
+		SuspendedAt:     suspendedAtToNullTime(discount),
+		Phase:           string(discount.Phase()),
+		Kind:            spanner.NullString{StringVal: string(discount.Kind()), Valid: true},
+		PendingApproval: spanner.NullBool{Bool: discount.IsPendingApproval(), Valid: true},
+		FlashSale:       spanner.NullBool{Bool: discount.IsFlashSale(), Valid: true},
+	}
+	if market := discount.Market(); market != "" {
+		data.Market = spanner.NullString{StringVal: market.String(), Valid: true}
+	}
+	if at := discount.ApprovedAt(); at != nil {
+		data.ApprovedBy = spanner.NullString{StringVal: discount.ApprovedBy(), Valid: true}
+		data.ApprovedAt = spanner.NullTime{Time: *at, Valid: true}
+	}
+	if promotion := discount.Promotion(); promotion != nil {
+		data.BuyQuantity = spanner.NullInt64{Int64: int64(promotion.BuyQuantity()), Valid: true}
+		data.GetQuantity = spanner.NullInt64{Int64: int64(promotion.GetQuantity()), Valid: true}
+	}
+	if sale := discount.SalePrice(); sale != nil {
+		data.SalePriceNum = spanner.NullInt64{Int64: sale.Numerator(), Valid: true}
+		data.SalePriceDenom = spanner.NullInt64{Int64: sale.Denominator(), Valid: true}
+	}
+	return data
+}
+
+// dataToDiscount converts a database model to a domain Discount, or nil if the row
+// does not hold a valid discount. A sale price is in the currency of the product.
+func dataToDiscount(data *DiscountData, currency string) *domain.Discount {
+	var (
+		discount *domain.Discount
+		err      error
+	)
+	switch domain.DiscountKind(data.Kind.StringVal) {
+	case "", domain.DiscountKindPercentage:
+		discount, err = domain.NewDiscount(new(big.Rat).Set(&data.Percentage), data.StartDate, data.EndDate)
+	case domain.DiscountKindBuyXGetY:
+		var promotion *domain.BuyXGetY
+		promotion, err = domain.NewBuyXGetY(int(data.BuyQuantity.Int64), int(data.GetQuantity.Int64))
+		if err == nil {
+			discount, err = domain.NewBuyXGetYDiscount(promotion, data.StartDate, data.EndDate)
+		}
+	default:
+		return nil
+	}
+	if err != nil {
+		return nil
+	}
+
+	discount = discount.WithID(data.DiscountID).WithPriority(int(data.Priority))
+	if data.Market.Valid {
+		market, err := domain.ParseMarket(data.Market.StringVal)
+		if err != nil {
+			return nil
+		}
+		discount = discount.WithMarket(market)
+	}
+	if data.SuspendedAt.Valid {
+		discount = discount.Suspend(data.SuspendedAt.Time)
+	}
+	if data.PendingApproval.Valid && data.PendingApproval.Bool {
+		discount = discount.RequireApproval()
+	} else if data.ApprovedAt.Valid {
+		discount = discount.Approve(data.ApprovedBy.StringVal, data.ApprovedAt.Time)
+	}
+	if data.FlashSale.Valid && data.FlashSale.Bool {
+		discount = discount.AsFlashSale()
+	}
+	if data.SalePriceNum.Valid && data.SalePriceDenom.Valid {
+		sale, err := domain.NewMoneyInCurrency(data.SalePriceNum.Int64, data.SalePriceDenom.Int64, currency)
+		if err != nil || data.SalePriceDenom.Int64 <= 0 {
+			return nil
+		}
+		discount = discount.WithSalePrice(sale)
+	}
+	if phase := domain.DiscountPhase(data.Phase); phase.IsValid() {
+		discount = discount.WithPhase(phase)
+	}
+	return discount
+}
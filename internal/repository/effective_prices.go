@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// ProjectEffectivePricesMuts returns the mutations that replace the effective price rows
+// of a product with the periods of its current prices.
+func ProjectEffectivePricesMuts(product *domain.Product) []*spanner.Mutation {
+	periods := product.PricePeriods()
+	muts := make([]*spanner.Mutation, 0, len(periods)+1)
+	muts = append(muts, spanner.Delete(EffectivePricesTable, spanner.Key{product.ID()}.AsPrefix()))
+	for _, period := range periods {
+		muts = append(muts, pricePeriodToData(product.ID(), period).InsertMutation())
+	}
+	return muts
+}
+
+// pricePeriodToData converts a price period of a product to a database model.
+func pricePeriodToData(productID string, period domain.PricePeriod) *EffectivePriceData {
+	data := &EffectivePriceData{
+		ProductID:        productID,
+		ValidFrom:        period.From,
+		PriceNumerator:   period.Price.Numerator(),
+		PriceDenominator: period.Price.Denominator(),
+	}
+	if !period.Until.IsZero() {
+		data.ValidUntil = spanner.NullTime{Time: period.Until, Valid: true}
+	}
+	if period.Discount != nil {
+		data.DiscountID = spanner.NullString{StringVal: period.Discount.ID(), Valid: true}
+		data.DiscountPercent = percentToNumeric(period.Discount.Percentage())
+	}
+	return data
+}
+
+// ProjectedPriceReader prices products from the effective_prices projection instead of
+// computing their prices from their discounts.
+type ProjectedPriceReader struct {
+	client *spanner.Client
+}
+
+var _ contract.EffectivePriceReader = (*ProjectedPriceReader)(nil)
+
+// NewProjectedPriceReader creates a new ProjectedPriceReader.
+func NewProjectedPriceReader(client *spanner.Client) *ProjectedPriceReader {
+	return &ProjectedPriceReader{client: client}
+}
+
+// GetEffectivePrices prices the products with the given IDs at the given time from their
+// projected periods. Products that do not exist, belong to a tenant other than that of
+// ctx or have not been projected are omitted.
+func (r *ProjectedPriceReader) GetEffectivePrices(ctx context.Context, ids []string, at time.Time) ([]*contract.PriceDTO, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	projected, err := readEffectivePrices(ctx, txn, ids, at)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]spanner.KeySet, len(ids))
+	for i, id := range ids {
+		keys[i] = spanner.Key{id}
+	}
+	iter := txn.Read(ctx, ProductsTable, spanner.KeySets(keys...), ProductPriceColumns())
+	defer iter.Stop()
+
+	scope := tenantScopeOf(ctx)
+	prices := make([]*contract.PriceDTO, 0, len(ids))
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return prices, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := ProductPriceDataFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		if !scope.visible(data.TenantID) {
+			continue
+		}
+		if period, ok := projected[data.ProductID]; ok {
+			prices = append(prices, projectedPriceDTO(data, period))
+		}
+	}
+}
+
+// readEffectivePrices reads the effective price rows of the given products whose period
+// contains at, keyed by product ID.
+func readEffectivePrices(ctx context.Context, txn *spanner.ReadOnlyTransaction, ids []string, at time.Time) (map[string]*EffectivePriceData, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT product_id, valid_from, valid_until, effective_price_numerator, effective_price_denominator,
+				discount_id, discount_percent
+			FROM effective_prices
+			WHERE product_id IN UNNEST(@ids) AND valid_from <= @at AND (valid_until IS NULL OR valid_until > @at)`,
+		Params: map[string]interface{}{"ids": ids, "at": at},
+	}
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	periods := make(map[string]*EffectivePriceData)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return periods, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var data EffectivePriceData
+		if err := row.Columns(
+			&data.ProductID,
+			&data.ValidFrom,
+			&data.ValidUntil,
+			&data.PriceNumerator,
+			&data.PriceDenominator,
+			&data.DiscountID,
+			&data.DiscountPercent,
+		); err != nil {
+			return nil, err
+		}
+		periods[data.ProductID] = &data
+	}
+}
+
+// projectedPriceDTO prices a row read with ProductPriceColumns with its projected period.
+func projectedPriceDTO(data *ProductData, period *EffectivePriceData) *contract.PriceDTO {
+	price := &contract.PriceDTO{
+		ProductID:           data.ProductID,
+		BasePriceNum:        data.BasePriceNumerator,
+		BasePriceDenom:      data.BasePriceDenominator,
+		EffectivePriceNum:   period.PriceNumerator,
+		EffectivePriceDenom: period.PriceDenominator,
+		HasActiveDiscount:   period.DiscountID.Valid,
+		Currency:            productCurrency(data),
+		Status:              data.Status,
+		Category:            data.Category,
+		TaxClass:            productTaxClass(data).String(),
+		CostPriceNum:        data.CostPriceNum.Int64,
+		CostPriceDenom:      data.CostPriceDenom.Int64,
+	}
+	if period.DiscountPercent.Valid {
+		pct, _ := period.DiscountPercent.Numeric.Float64()
+		price.DiscountPercent = &pct
+	}
+	return price
+}
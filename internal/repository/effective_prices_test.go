@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectEffectivePricesMuts(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	basePrice := domain.NewMoney(2000, 100)
+	discount, err := domain.NewDiscount(big.NewRat(25, 1), now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	product := domain.ReconstructProduct(
+		"product-123", "Widget", "", "", "Tools", "", "",
+		basePrice, []*domain.Discount{discount.WithID("discount-1")}, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", domain.DefaultTaxClass, domain.ProductStatusActive, nil, nil, nil, now, now, nil,
+	)
+
+	muts := ProjectEffectivePricesMuts(product)
+
+	// One delete of the previous rows, then one insert per period: before, during and
+	// after the discount.
+	assert.Len(t, muts, 1+len(product.PricePeriods()))
+	assert.Len(t, product.PricePeriods(), 3)
+}
+
+func TestPricePeriodToData(t *testing.T) {
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	until := from.AddDate(0, 0, 7)
+	price := domain.NewMoney(1500, 100)
+	discount, err := domain.NewDiscount(big.NewRat(25, 1), from, until)
+	require.NoError(t, err)
+
+	data := pricePeriodToData("product-123", domain.PricePeriod{
+		From: from, Until: until, Price: price, Discount: discount.WithID("discount-1"),
+	})
+	assert.Equal(t, "product-123", data.ProductID)
+	assert.Equal(t, from, data.ValidFrom)
+	assert.Equal(t, spanner.NullTime{Time: until, Valid: true}, data.ValidUntil)
+	assert.Equal(t, int64(15), data.PriceNumerator)
+	assert.Equal(t, int64(1), data.PriceDenominator)
+	assert.Equal(t, spanner.NullString{StringVal: "discount-1", Valid: true}, data.DiscountID)
+	assert.True(t, data.DiscountPercent.Valid)
+
+	open := pricePeriodToData("product-123", domain.PricePeriod{From: until, Price: price})
+	assert.False(t, open.ValidUntil.Valid)
+	assert.False(t, open.DiscountID.Valid)
+	assert.False(t, open.DiscountPercent.Valid)
+}
+
+func TestProjectedPriceDTO(t *testing.T) {
+	data := &ProductData{
+		ProductID:            "product-123",
+		Category:             "Tools",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+		Currency:             spanner.NullString{StringVal: "EUR", Valid: true},
+		Status:               "active",
+	}
+
+	dto := projectedPriceDTO(data, &EffectivePriceData{
+		ProductID:        "product-123",
+		PriceNumerator:   15,
+		PriceDenominator: 1,
+		DiscountID:       spanner.NullString{StringVal: "discount-1", Valid: true},
+		DiscountPercent:  spanner.NullNumeric{Numeric: *big.NewRat(25, 1), Valid: true},
+	})
+	assert.Equal(t, int64(2000), dto.BasePriceNum)
+	assert.Equal(t, int64(15), dto.EffectivePriceNum)
+	assert.Equal(t, int64(1), dto.EffectivePriceDenom)
+	assert.True(t, dto.HasActiveDiscount)
+	require.NotNil(t, dto.DiscountPercent)
+	assert.Equal(t, 25.0, *dto.DiscountPercent)
+	assert.Equal(t, "EUR", dto.Currency)
+	assert.Equal(t, domain.DefaultTaxClass.String(), dto.TaxClass)
+
+	dto = projectedPriceDTO(data, &EffectivePriceData{ProductID: "product-123", PriceNumerator: 20, PriceDenominator: 1})
+	assert.False(t, dto.HasActiveDiscount)
+	assert.Nil(t, dto.DiscountPercent)
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// FreezeWindowRepo implements the FreezeWindowRepository interface using Spanner.
+type FreezeWindowRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.FreezeWindowRepository = (*FreezeWindowRepo)(nil)
+
+// NewFreezeWindowRepo creates a new FreezeWindowRepo.
+func NewFreezeWindowRepo(client *spanner.Client) *FreezeWindowRepo {
+	return &FreezeWindowRepo{client: client}
+}
+
+// Schedule stores a freeze window.
+func (r *FreezeWindowRepo) Schedule(ctx context.Context, window *domain.FreezeWindow) error {
+	_, err := r.client.Apply(ctx, []*spanner.Mutation{freezeWindowMut(window)})
+	return err
+}
+
+// Cancel deletes a freeze window. It returns domain.ErrFreezeWindowNotFound if there is
+// none with the ID.
+func (r *FreezeWindowRepo) Cancel(ctx context.Context, id string) error {
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		if _, err := txn.ReadRow(ctx, FreezeWindowsTable, spanner.Key{id}, []string{FreezeWindowID}); err != nil {
+			if spanner.ErrCode(err) == codes.NotFound {
+				return domain.ErrFreezeWindowNotFound
+			}
+			return err
+		}
+		return txn.BufferWrite([]*spanner.Mutation{spanner.Delete(FreezeWindowsTable, spanner.Key{id})})
+	})
+	return err
+}
+
+// List returns the freeze windows that have not ended by the given time, soonest first.
+func (r *FreezeWindowRepo) List(ctx context.Context, at time.Time) ([]*domain.FreezeWindow, error) {
+	return r.query(ctx, spanner.Statement{
+		SQL: `SELECT freeze_id, tenant_id, start_time, end_time, exempt_roles, reason
+			FROM freeze_windows WHERE end_time > @at ORDER BY start_time, freeze_id`,
+		Params: map[string]interface{}{"at": at},
+	})
+}
+
+// FindActive returns the freeze windows that contain the given time.
+func (r *FreezeWindowRepo) FindActive(ctx context.Context, at time.Time) ([]*domain.FreezeWindow, error) {
+	return r.query(ctx, spanner.Statement{
+		SQL: `SELECT freeze_id, tenant_id, start_time, end_time, exempt_roles, reason
+			FROM freeze_windows WHERE start_time <= @at AND end_time > @at ORDER BY freeze_id`,
+		Params: map[string]interface{}{"at": at},
+	})
+}
+
+func (r *FreezeWindowRepo) query(ctx context.Context, stmt spanner.Statement) ([]*domain.FreezeWindow, error) {
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	var windows []*domain.FreezeWindow
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return windows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		window, err := freezeWindowFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, window)
+	}
+}
+
+// freezeWindowMut returns the mutation that inserts a freeze window.
+func freezeWindowMut(window *domain.FreezeWindow) *spanner.Mutation {
+	tenant := spanner.NullString{StringVal: window.Tenant(), Valid: window.Tenant() != ""}
+	reason := spanner.NullString{StringVal: window.Reason(), Valid: window.Reason() != ""}
+	return spanner.InsertMap(FreezeWindowsTable, map[string]interface{}{
+		FreezeWindowID:          window.ID(),
+		FreezeWindowTenantID:    tenant,
+		FreezeWindowStart:       window.Start(),
+		FreezeWindowEnd:         window.End(),
+		FreezeWindowExemptRoles: window.ExemptRoles(),
+		FreezeWindowReason:      reason,
+		FreezeWindowCreatedAt:   spanner.CommitTimestamp,
+	})
+}
+
+// freezeWindowFromRow reads a row selected by List or FindActive.
+func freezeWindowFromRow(row *spanner.Row) (*domain.FreezeWindow, error) {
+	var (
+		id          string
+		tenant      spanner.NullString
+		start, end  time.Time
+		exemptRoles []string
+		reason      spanner.NullString
+	)
+	if err := row.Columns(&id, &tenant, &start, &end, &exemptRoles, &reason); err != nil {
+		return nil, err
+	}
+	return domain.NewFreezeWindow(id, tenant.StringVal, start, end, exemptRoles, reason.StringVal)
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// priceSortKeySQL is the effective price of a product at @price_at as a NUMERIC, rounded
+// to its 9 decimal digits.
+const priceSortKeySQL = `(SELECT p.price_num / p.price_den FROM (` + effectivePriceSQL + `) p)`
+
+// columnOrder is a ListProducts ordering by a product column, or the effective price,
+// ties ordered by product ID.
+type columnOrder struct {
+	// sortKey is the SQL expression sorted by.
+	sortKey string
+	desc    bool
+	// value formats the sort key of a listed product for a page token.
+	value func(data *ProductData, dto *contract.ProductDTO) string
+	// pageKey adds the parameters of a value from a page token to params and returns the
+	// SQL expression compared with the sort key.
+	pageKey func(value string, params map[string]interface{}) (string, error)
+}
+
+// columnOrders are the column orderings by their OrderBy name.
+var columnOrders = map[string]columnOrder{
+	contract.OrderByName:          {sortKey: ProductName, value: nameValue, pageKey: namePageKey},
+	contract.OrderByNameDesc:      {sortKey: ProductName, desc: true, value: nameValue, pageKey: namePageKey},
+	contract.OrderByCreatedAt:     {sortKey: ProductCreatedAt, value: createdAtValue, pageKey: timePageKey},
+	contract.OrderByCreatedAtDesc: {sortKey: ProductCreatedAt, desc: true, value: createdAtValue, pageKey: timePageKey},
+	contract.OrderByUpdatedAt:     {sortKey: ProductUpdatedAt, value: updatedAtValue, pageKey: timePageKey},
+	contract.OrderByUpdatedAtDesc: {sortKey: ProductUpdatedAt, desc: true, value: updatedAtValue, pageKey: timePageKey},
+	contract.OrderByPrice:         {sortKey: priceSortKeySQL, value: priceValue, pageKey: pricePageKey},
+	contract.OrderByPriceDesc:     {sortKey: priceSortKeySQL, desc: true, value: priceValue, pageKey: pricePageKey},
+}
+
+// condition returns the SQL condition listing only the products after the position of a
+// page token issued for the ordering named orderBy, adding its parameters to params.
+func (o columnOrder) condition(orderBy, token string, params map[string]interface{}) (string, error) {
+	value, productID, err := parseColumnPageToken(orderBy, token)
+	if err != nil {
+		return "", err
+	}
+	pageKey, err := o.pageKey(value, params)
+	if err != nil {
+		return "", err
+	}
+	params["page_token"] = productID
+
+	after := ` > `
+	if o.desc {
+		after = ` < `
+	}
+	return ` AND (` + o.sortKey + after + pageKey + ` OR (` +
+		o.sortKey + ` = ` + pageKey + ` AND product_id > @page_token))`, nil
+}
+
+// orderBy returns the ORDER BY clause of the ordering.
+func (o columnOrder) orderBy() string {
+	if o.desc {
+		return ` ORDER BY ` + o.sortKey + ` DESC, product_id`
+	}
+	return ` ORDER BY ` + o.sortKey + `, product_id`
+}
+
+// columnPageToken encodes the position of the last product of a page in the ordering
+// named orderBy. The token names the ordering, so it cannot be used with another one,
+// and is base64 encoded, as names may hold any character.
+func columnPageToken(orderBy, value, productID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(orderBy + ":" + value + ":" + productID))
+}
+
+// parseColumnPageToken decodes a token from columnPageToken issued for the ordering named
+// orderBy, returning the sort key value and the product ID.
+func parseColumnPageToken(orderBy, token string) (string, string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", "", domain.ErrInvalidPageToken
+	}
+	rest, ok := strings.CutPrefix(string(decoded), orderBy+":")
+	if !ok {
+		return "", "", domain.ErrInvalidPageToken
+	}
+	// Product IDs never hold a colon; sort key values may.
+	i := strings.LastIndex(rest, ":")
+	if i < 0 || i == len(rest)-1 {
+		return "", "", domain.ErrInvalidPageToken
+	}
+	return rest[:i], rest[i+1:], nil
+}
+
+func nameValue(data *ProductData, _ *contract.ProductDTO) string {
+	return data.Name
+}
+
+func namePageKey(value string, params map[string]interface{}) (string, error) {
+	params["page_name"] = value
+	return `@page_name`, nil
+}
+
+func createdAtValue(data *ProductData, _ *contract.ProductDTO) string {
+	return data.CreatedAt.UTC().Format(time.RFC3339Nano)
+}
+
+func updatedAtValue(data *ProductData, _ *contract.ProductDTO) string {
+	return data.UpdatedAt.UTC().Format(time.RFC3339Nano)
+}
+
+func timePageKey(value string, params map[string]interface{}) (string, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return "", domain.ErrInvalidPageToken
+	}
+	params["page_time"] = t
+	return `@page_time`, nil
+}
+
+// priceValue formats the effective price of a listed product as an exact fraction. The
+// page key divides it in SQL, so it rounds as the sort key does.
+func priceValue(_ *ProductData, dto *contract.ProductDTO) string {
+	return big.NewRat(dto.EffectivePriceNum, dto.EffectivePriceDenom).String()
+}
+
+func pricePageKey(value string, params map[string]interface{}) (string, error) {
+	price, ok := new(big.Rat).SetString(value)
+	if !ok || !price.Num().IsInt64() || !price.Denom().IsInt64() {
+		return "", domain.ErrInvalidPageToken
+	}
+	params["page_price_num"] = price.Num().Int64()
+	params["page_price_den"] = price.Denom().Int64()
+	return `(CAST(@page_price_num AS NUMERIC) / @page_price_den)`, nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnPageToken(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		wantValue     string
+		wantProductID string
+		wantErr       error
+	}{
+		{"name", columnPageToken(contract.OrderByName, "Desk: oak", "product-1"), "Desk: oak", "product-1", nil},
+		{"empty name", columnPageToken(contract.OrderByName, "", "product-2"), "", "product-2", nil},
+		{"other ordering", columnPageToken(contract.OrderByNameDesc, "Desk", "product-1"), "", "", domain.ErrInvalidPageToken},
+		{"product ID token", "product-1", "", "", domain.ErrInvalidPageToken},
+		{"no product ID", columnPageToken(contract.OrderByName, "Desk", ""), "", "", domain.ErrInvalidPageToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, productID, err := parseColumnPageToken(contract.OrderByName, tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantValue, value)
+			assert.Equal(t, tt.wantProductID, productID)
+		})
+	}
+}
+
+func TestBuildListQuery_OrderByColumn(t *testing.T) {
+	rm := &ProductReadModel{}
+	filter := contract.ListProductsFilter{Category: "Tools"}
+	at := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+
+	stmt, err := rm.buildListQuery(tenantScope{}, filter, contract.Pagination{PageSize: 10, OrderBy: contract.OrderByName}, at)
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "ORDER BY name, product_id LIMIT 10")
+	assert.NotContains(t, stmt.SQL, "@page_name")
+
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 600, time.UTC)
+	stmt, err = rm.buildListQuery(tenantScope{}, filter, contract.Pagination{
+		PageSize:  10,
+		PageToken: createdAtValueToken(createdAt, "product-1"),
+		OrderBy:   contract.OrderByCreatedAtDesc,
+	}, at)
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "AND (created_at < @page_time OR (created_at = @page_time AND product_id > @page_token))")
+	assert.Contains(t, stmt.SQL, "ORDER BY created_at DESC, product_id LIMIT 10")
+	assert.Equal(t, createdAt, stmt.Params["page_time"])
+	assert.Equal(t, "product-1", stmt.Params["page_token"])
+
+	stmt, err = rm.buildListQuery(tenantScope{}, filter, contract.Pagination{
+		PageSize:  10,
+		PageToken: columnPageToken(contract.OrderByPrice, priceValue(nil, &contract.ProductDTO{EffectivePriceNum: 1999, EffectivePriceDenom: 100}), "product-1"),
+		OrderBy:   contract.OrderByPrice,
+	}, at)
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "ORDER BY "+priceSortKeySQL+", product_id LIMIT 10")
+	assert.Equal(t, at, stmt.Params["price_at"])
+	assert.Equal(t, int64(1999), stmt.Params["page_price_num"])
+	assert.Equal(t, int64(100), stmt.Params["page_price_den"])
+
+	_, err = rm.buildListQuery(tenantScope{}, filter, contract.Pagination{
+		PageToken: columnPageToken(contract.OrderByPrice, "cheap", "product-1"),
+		OrderBy:   contract.OrderByPrice,
+	}, at)
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+
+	_, err = rm.buildListQuery(tenantScope{}, filter, contract.Pagination{PageToken: "product-1", OrderBy: contract.OrderByUpdatedAt}, at)
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+}
+
+// createdAtValueToken returns the page token of a product created at createdAt in the
+// OrderByCreatedAtDesc ordering.
+func createdAtValueToken(createdAt time.Time, productID string) string {
+	return columnPageToken(contract.OrderByCreatedAtDesc, createdAtValue(&ProductData{CreatedAt: createdAt}, nil), productID)
+}
+
+func TestBuildListQuery_PageNumber(t *testing.T) {
+	rm := &ProductReadModel{}
+	at := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+
+	stmt, err := rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{}, contract.Pagination{PageSize: 25, PageNumber: 1}, at)
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(stmt.SQL, "ORDER BY product_id LIMIT 25"), stmt.SQL)
+
+	// Offset pages keep the ordering and ignore page tokens
+	stmt, err = rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{}, contract.Pagination{
+		PageSize:   25,
+		PageNumber: 3,
+		PageToken:  columnPageToken(contract.OrderByName, "Desk", "product-1"),
+		OrderBy:    contract.OrderByName,
+	}, at)
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(stmt.SQL, "ORDER BY name, product_id LIMIT 25 OFFSET 50"), stmt.SQL)
+	assert.NotContains(t, stmt.Params, "page_token")
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+	"google.golang.org/api/iterator"
+)
+
+// readMarketPrices reads the product_market_prices rows of the given products, keyed by
+// product ID.
+func readMarketPrices(ctx context.Context, reader rowReader, productIDs []string) (map[string][]*MarketPriceData, error) {
+	prices := make(map[string][]*MarketPriceData)
+	if len(productIDs) == 0 {
+		return prices, nil
+	}
+
+	keys := make([]spanner.KeySet, len(productIDs))
+	for i, id := range productIDs {
+		keys[i] = spanner.Key{id}.AsPrefix()
+	}
+
+	iter := reader.Read(ctx, MarketPricesTable, spanner.KeySets(keys...), MarketPriceAllColumns())
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return prices, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := MarketPriceDataFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		prices[data.ProductID] = append(prices[data.ProductID], data)
+	}
+}
+
+// productMarketPrices converts the product_market_prices rows of a product to domain
+// market prices, skipping rows that do not hold a valid one.
+func productMarketPrices(rows []*MarketPriceData) []*domain.MarketPrice {
+	prices := make([]*domain.MarketPrice, 0, len(rows))
+	for _, row := range rows {
+		price, err := dataToMarketPrice(row)
+		if err != nil {
+			logging.Warnf("repository: product %s: skipping invalid price for market %s: %v", row.ProductID, row.Market, err)
+			continue
+		}
+		prices = append(prices, price)
+	}
+	domain.SortMarketPrices(prices)
+	return prices
+}
+
+// marketPriceToData converts a market price of a product to a database model.
+func marketPriceToData(productID string, price *domain.MarketPrice) *MarketPriceData {
+	return &MarketPriceData{
+		ProductID:        productID,
+		Market:           price.Market().String(),
+		PriceNumerator:   price.Price().Numerator(),
+		PriceDenominator: price.Price().Denominator(),
+	}
+}
+
+// dataToMarketPrice converts a database model to a domain MarketPrice in the currency of
+// its market.
+func dataToMarketPrice(data *MarketPriceData) (*domain.MarketPrice, error) {
+	market, err := domain.ParseMarket(data.Market)
+	if err != nil {
+		return nil, err
+	}
+	price, err := domain.NewMoneyInCurrency(data.PriceNumerator, data.PriceDenominator, market.Currency())
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewMarketPrice(market, price)
+}
+
+// marketPriceDTOs converts the market prices of a product to their read representation,
+// each with the discount that applies or is presented in its market at the given time.
+func marketPriceDTOs(prices []*domain.MarketPrice, discounts []*domain.Discount, at time.Time) []contract.MarketPriceDTO {
+	if len(prices) == 0 {
+		return nil
+	}
+	dtos := make([]contract.MarketPriceDTO, len(prices))
+	for i, m := range prices {
+		price := m.Price()
+		dtos[i] = contract.MarketPriceDTO{
+			Market:              m.Market().String(),
+			Currency:            price.Currency(),
+			PriceNum:            price.Numerator(),
+			PriceDenom:          price.Denominator(),
+			EffectivePriceNum:   price.Numerator(),
+			EffectivePriceDenom: price.Denominator(),
+		}
+
+		applicable := domain.ApplicableDiscountIn(discounts, m.Market(), at)
+		shown := applicable
+		if shown == nil {
+			shown = domain.CurrentDiscountIn(discounts, m.Market(), at)
+		}
+		if shown != nil {
+			pct := shown.PercentageFloat()
+			start, end := shown.StartDate(), shown.EndDate()
+			dtos[i].DiscountPercent = &pct
+			dtos[i].DiscountStartDate = &start
+			dtos[i].DiscountEndDate = &end
+			dtos[i].DiscountSuspended = shown.IsSuspended()
+		}
+		if applicable != nil {
+			effective := applicable.ApplyTo(price)
+			dtos[i].HasActiveDiscount = true
+			dtos[i].EffectivePriceNum = effective.Numerator()
+			dtos[i].EffectivePriceDenom = effective.Denominator()
+		}
+	}
+	return dtos
+}
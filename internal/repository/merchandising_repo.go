@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// unrankedSortKey sorts products without a merchandising rank after every ranked one.
+const unrankedSortKey = math.MaxInt64
+
+// merchandisedSortKeySQL is the merchandising rank of a product within its category, or
+// unrankedSortKey if it has none.
+var merchandisedSortKeySQL = fmt.Sprintf(`COALESCE((SELECT r.rank FROM merchandising_ranks r
+		WHERE r.category = products.category AND r.product_id = products.product_id), %d)`, int64(unrankedSortKey))
+
+// MerchandisingRepo implements the MerchandisingRepository interface using Spanner.
+type MerchandisingRepo struct {
+	client *spanner.Client
+}
+
+// NewMerchandisingRepo creates a new MerchandisingRepo.
+func NewMerchandisingRepo(client *spanner.Client) *MerchandisingRepo {
+	return &MerchandisingRepo{client: client}
+}
+
+// ReplaceRanks deletes the ranks of the category and inserts the given ones in one
+// commit, so readers see either the old or the new ordering.
+func (r *MerchandisingRepo) ReplaceRanks(ctx context.Context, category string, ranks []contract.MerchandisingRank) error {
+	mutations := make([]*spanner.Mutation, 0, len(ranks)+1)
+	mutations = append(mutations, spanner.Delete(RanksTable, spanner.Key{category}.AsPrefix()))
+	for _, rank := range ranks {
+		mutations = append(mutations, spanner.InsertMap(RanksTable, map[string]interface{}{
+			RankCategory:  category,
+			RankProductID: rank.ProductID,
+			RankRank:      rank.Rank,
+			RankUpdatedAt: spanner.CommitTimestamp,
+		}))
+	}
+	_, err := r.client.Apply(ctx, mutations)
+	return err
+}
+
+// readMerchandisingRank returns the rank of a product within a category, or
+// unrankedSortKey if it has none.
+func readMerchandisingRank(ctx context.Context, reader rowReader, category, productID string) (int64, error) {
+	iter := reader.Read(ctx, RanksTable, spanner.Key{category, productID}, []string{RankRank})
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err == iterator.Done {
+		return unrankedSortKey, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	var rank int64
+	if err := row.Columns(&rank); err != nil {
+		return 0, err
+	}
+	return rank, nil
+}
+
+// merchandisedPageToken encodes the position of the last product of a page ordered by
+// merchandising rank.
+func merchandisedPageToken(rank int64, productID string) string {
+	return strconv.FormatInt(rank, 10) + ":" + productID
+}
+
+// parseMerchandisedPageToken decodes a token from merchandisedPageToken.
+func parseMerchandisedPageToken(token string) (int64, string, error) {
+	rank, productID, ok := strings.Cut(token, ":")
+	if !ok || productID == "" {
+		return 0, "", domain.ErrInvalidPageToken
+	}
+	n, err := strconv.ParseInt(rank, 10, 64)
+	if err != nil {
+		return 0, "", domain.ErrInvalidPageToken
+	}
+	return n, productID, nil
+}
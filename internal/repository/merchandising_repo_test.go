@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerchandisedPageToken(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		wantRank      int64
+		wantProductID string
+		wantErr       error
+	}{
+		{"ranked", merchandisedPageToken(3, "product-1"), 3, "product-1", nil},
+		{"unranked", merchandisedPageToken(unrankedSortKey, "product-2"), math.MaxInt64, "product-2", nil},
+		{"product ID token", "product-1", 0, "", domain.ErrInvalidPageToken},
+		{"bad rank", "x:product-1", 0, "", domain.ErrInvalidPageToken},
+		{"no product ID", "3:", 0, "", domain.ErrInvalidPageToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rank, productID, err := parseMerchandisedPageToken(tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRank, rank)
+			assert.Equal(t, tt.wantProductID, productID)
+		})
+	}
+}
+
+func TestBuildListQuery_OrderBy(t *testing.T) {
+	rm := &ProductReadModel{}
+	filter := contract.ListProductsFilter{Category: "Tools"}
+
+	stmt, err := rm.buildListQuery(tenantScope{}, filter, contract.Pagination{PageSize: 10, PageToken: "product-1"}, time.Time{})
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "AND product_id > @page_token ORDER BY product_id LIMIT 10")
+	assert.NotContains(t, stmt.SQL, RanksTable)
+
+	stmt, err = rm.buildListQuery(tenantScope{}, filter, contract.Pagination{
+		PageSize:  10,
+		PageToken: merchandisedPageToken(5, "product-1"),
+		OrderBy:   contract.OrderByMerchandised,
+	}, time.Time{})
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "ORDER BY "+merchandisedSortKeySQL+", product_id LIMIT 10")
+	assert.Equal(t, int64(5), stmt.Params["page_rank"])
+	assert.Equal(t, "product-1", stmt.Params["page_token"])
+
+	_, err = rm.buildListQuery(tenantScope{}, filter, contract.Pagination{PageToken: "product-1", OrderBy: contract.OrderByMerchandised}, time.Time{})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+
+	_, err = rm.buildListQuery(tenantScope{}, filter, contract.Pagination{OrderBy: "popularity"}, time.Time{})
+	assert.ErrorIs(t, err, domain.ErrInvalidOrderBy)
+}
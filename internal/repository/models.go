@@ -2,6 +2,7 @@
 package repository
 
 import (
+	"math/big"
 	"time"
 
 	"cloud.google.com/go/spanner"
@@ -23,6 +24,246 @@ const (
 	ProductCreatedAt         = "created_at"
 	ProductUpdatedAt         = "updated_at"
 	ProductArchivedAt        = "archived_at"
+	// ProductDiscountSuspendedAt is set while the discount is suspended by deactivation.
+	ProductDiscountSuspendedAt = "discount_suspended_at"
+	// ProductDiscountPhase is the last announced phase of the discount period; NULL is
+	// read as scheduled.
+	ProductDiscountPhase = "discount_phase"
+	// ProductCurrency is the ISO 4217 code of the product's prices; NULL is read as
+	// domain.DefaultCurrency.
+	ProductCurrency = "currency"
+	// ProductTaxClass is the tax class of the product; NULL is read as
+	// domain.DefaultTaxClass.
+	ProductTaxClass = "tax_class"
+	// ProductMinPriceNum and ProductMinPriceDenom are the price floor of the product in
+	// its currency; NULL if it has none.
+	ProductMinPriceNum   = "minimum_price_numerator"
+	ProductMinPriceDenom = "minimum_price_denominator"
+	// ProductCostPriceNum and ProductCostPriceDenom are what the product costs the
+	// merchant, in its currency; NULL if it is not recorded.
+	ProductCostPriceNum   = "cost_price_numerator"
+	ProductCostPriceDenom = "cost_price_denominator"
+	// ProductPendingPriceNum, ProductPendingPriceDenom and ProductPendingPriceEffectiveAt
+	// are the scheduled base price change of the product, in its currency; NULL if none is
+	// pending.
+	ProductPendingPriceNum         = "pending_price_numerator"
+	ProductPendingPriceDenom       = "pending_price_denominator"
+	ProductPendingPriceEffectiveAt = "pending_price_effective_at"
+	// ProductTags are the tags of the product, sorted; NULL if it has none.
+	ProductTags = "tags"
+	// ProductAttributes is a JSON object of the attributes of the product; NULL if it has
+	// none.
+	ProductAttributes = "attributes"
+	// ProductSlug is the URL slug of the product, unique among products through
+	// ProductSlugIndex; NULL for products created before slugs that have not been given one.
+	ProductSlug = "slug"
+	// ProductSlugIndex is the unique index of products by slug.
+	ProductSlugIndex = "idx_products_slug"
+	// ProductSKU is the stock keeping unit of the product, unique among products through
+	// ProductSKUIndex; NULL if the product has none.
+	ProductSKU = "sku"
+	// ProductSKUIndex is the unique index of products by SKU.
+	ProductSKUIndex = "idx_products_sku"
+	// ProductGTIN is the barcode number of the product, unique among products through
+	// ProductGTINIndex; NULL if the product has none.
+	ProductGTIN = "gtin"
+	// ProductGTINIndex is the unique index of products by GTIN.
+	ProductGTINIndex = "idx_products_gtin"
+	// ProductNameTokens and ProductDescriptionTokens are the full-text tokens of the name
+	// and description of the product, generated by Spanner and searched through
+	// ProductSearchIndex. They are hidden and never read.
+	ProductNameTokens        = "name_tokens"
+	ProductDescriptionTokens = "description_tokens"
+	// ProductSearchIndex is the search index of products by name and description tokens.
+	ProductSearchIndex = "idx_products_search"
+	// ProductActivateAt and ProductDeactivateAt are when the product is scheduled to be
+	// activated and deactivated; NULL if no such change is scheduled.
+	ProductActivateAt   = "activate_at"
+	ProductDeactivateAt = "deactivate_at"
+	// ProductSubmittedBy, ProductReviewedBy and ProductRejectionReason record the latest
+	// review of the product: who submitted it, who approved or rejected it and why it was
+	// rejected. ProductSubmittedBy is NULL if the product was never submitted for review.
+	ProductSubmittedBy     = "submitted_by"
+	ProductReviewedBy      = "reviewed_by"
+	ProductRejectionReason = "rejection_reason"
+	// ProductWeight and ProductWeightUnit are the shipping weight of the product in the
+	// unit it was given in ('g', 'kg', 'lb' or 'oz'); NULL if it has none.
+	ProductWeight     = "weight"
+	ProductWeightUnit = "weight_unit"
+	// ProductHeight, ProductWidth, ProductDepth and ProductDimensionUnit are the packed
+	// dimensions of the product in the unit they were given in ('mm', 'cm', 'm' or 'in');
+	// NULL if it has none.
+	ProductHeight        = "height"
+	ProductWidth         = "width"
+	ProductDepth         = "depth"
+	ProductDimensionUnit = "dimension_unit"
+	// ProductRatingCount and ProductRatingSum summarize the customer ratings of the
+	// product in product_ratings; NULL if it has none.
+	ProductRatingCount = "rating_count"
+	ProductRatingSum   = "rating_sum"
+	// ProductUnavailableChannels are the sales channels the product is withheld from,
+	// sorted; NULL if it is available on every channel.
+	ProductUnavailableChannels = "unavailable_channels"
+	// ProductTenantID is the tenant that owns the product; NULL for the default tenant.
+	ProductTenantID = "tenant_id"
+	// ProductKind is 'physical' or 'digital'; NULL if the kind of the product is unset.
+	ProductKind = "kind"
+	// ProductMinimumAge, ProductHazardous and ProductRestrictedMarkets are the compliance
+	// flags of the product: the minimum age of its buyers, whether it is hazardous to
+	// ship, and the sorted market codes it must not be sold in. All are NULL if the
+	// product has no compliance flags.
+	ProductMinimumAge        = "minimum_age"
+	ProductHazardous         = "hazardous"
+	ProductRestrictedMarkets = "restricted_markets"
+)
+
+// Product discount table constants. Discounts are stored in product_discounts; the
+// discount columns of products hold discounts written before the table existed and are
+// cleared when the product's discounts are next written.
+const (
+	DiscountsTable      = "product_discounts"
+	DiscountProductID   = "product_id"
+	DiscountID          = "discount_id"
+	DiscountPercentage  = "percentage"
+	DiscountPriority    = "priority"
+	DiscountStartDate   = "start_date"
+	DiscountEndDate     = "end_date"
+	DiscountSuspendedAt = "suspended_at"
+	DiscountPhase       = "phase"
+	// DiscountKind is NULL for rows written before promotions, read as a percentage
+	// discount. DiscountBuyQuantity and DiscountGetQuantity are set for promotions only.
+	DiscountKind        = "kind"
+	DiscountBuyQuantity = "buy_quantity"
+	DiscountGetQuantity = "get_quantity"
+	// DiscountMarket is the market the discount is scoped to; NULL applies it in every
+	// market.
+	DiscountMarket = "market"
+	// DiscountPendingApproval is set while the discount awaits approval; NULL is read as
+	// false. DiscountApprovedBy and DiscountApprovedAt are set once it is approved.
+	DiscountPendingApproval = "pending_approval"
+	DiscountApprovedBy      = "approved_by"
+	DiscountApprovedAt      = "approved_at"
+	// DiscountFlashSale marks a flash sale; NULL is read as false.
+	DiscountFlashSale = "flash_sale"
+	// DiscountSalePriceNum and DiscountSalePriceDenom are set for discounts expressed as a
+	// sale price, in the currency of the product.
+	DiscountSalePriceNum   = "sale_price_numerator"
+	DiscountSalePriceDenom = "sale_price_denominator"
+)
+
+// Product price tier table constants. A tier row holds either a unit price or a
+// percent off.
+const (
+	PriceTiersTable         = "product_price_tiers"
+	PriceTierProductID      = "product_id"
+	PriceTierMinQuantity    = "min_quantity"
+	PriceTierUnitPriceNum   = "unit_price_numerator"
+	PriceTierUnitPriceDenom = "unit_price_denominator"
+	PriceTierPercentOff     = "percent_off"
+)
+
+// Product price book table constants. A price book row holds the base price of a product
+// in a currency other than its own.
+const (
+	PricesTable      = "product_prices"
+	PriceProductID   = "product_id"
+	PriceCurrency    = "currency"
+	PriceNumerator   = "price_numerator"
+	PriceDenominator = "price_denominator"
+)
+
+// Product segment price table constants. A segment price row holds either a fixed price
+// or a percent off.
+const (
+	SegmentPricesTable      = "product_segment_prices"
+	SegmentPriceProductID   = "product_id"
+	SegmentPriceSegment     = "segment"
+	SegmentPriceNumerator   = "price_numerator"
+	SegmentPriceDenominator = "price_denominator"
+	SegmentPricePercentOff  = "percent_off"
+)
+
+// Product market price table constants. A market price row holds the base price of a
+// product in a market, in the market's currency.
+const (
+	MarketPricesTable      = "product_market_prices"
+	MarketPriceProductID   = "product_id"
+	MarketPriceMarket      = "market"
+	MarketPriceNumerator   = "price_numerator"
+	MarketPriceDenominator = "price_denominator"
+)
+
+// Price history table constants. A history row records the prices of a product right
+// after a change of its base price or discounts.
+const (
+	PriceHistoryTable          = "price_history"
+	HistoryProductID           = "product_id"
+	HistoryChangedAt           = "changed_at"
+	HistoryChangeID            = "change_id"
+	HistoryChangeType          = "change_type"
+	HistoryBasePriceNum        = "base_price_numerator"
+	HistoryBasePriceDenom      = "base_price_denominator"
+	HistoryEffectivePriceNum   = "effective_price_numerator"
+	HistoryEffectivePriceDenom = "effective_price_denominator"
+	HistoryCurrency            = "currency"
+	HistoryDiscountID          = "discount_id"
+	HistoryDiscountPercent     = "discount_percent"
+)
+
+// Product revision table constants. A revision row records the state of a product right
+// after a command changed it, with the fields the command changed.
+const (
+	RevisionsTable        = "product_revisions"
+	RevisionProductID     = "product_id"
+	RevisionRevisedAt     = "revised_at"
+	RevisionID            = "revision_id"
+	RevisionChangedFields = "changed_fields"
+	RevisionSnapshot      = "snapshot"
+)
+
+// Effective price table constants. An effective price row projects the effective price
+// of a product over a period in which it does not change.
+const (
+	EffectivePricesTable      = "effective_prices"
+	EffectivePriceProductID   = "product_id"
+	EffectivePriceValidFrom   = "valid_from"
+	EffectivePriceValidUntil  = "valid_until"
+	EffectivePriceNumerator   = "effective_price_numerator"
+	EffectivePriceDenominator = "effective_price_denominator"
+	EffectivePriceDiscountID  = "discount_id"
+	EffectivePriceDiscountPct = "discount_percent"
+)
+
+// Product summary table constants. A summary row holds the columns listings filter and
+// order by, so they do not scan the wide product rows.
+const (
+	ProductSummariesTable      = "product_summaries"
+	SummaryProductID           = "product_id"
+	SummaryTenantID            = "tenant_id"
+	SummaryName                = "name"
+	SummaryCategory            = "category"
+	SummaryStatus              = "status"
+	SummaryMinPriceNumerator   = "min_price_numerator"
+	SummaryMinPriceDenominator = "min_price_denominator"
+	SummaryMaxPriceNumerator   = "max_price_numerator"
+	SummaryMaxPriceDenominator = "max_price_denominator"
+	SummarySummarizedAt        = "summarized_at"
+)
+
+// Product quality score table constants. A quality score row rates how complete the
+// content of a product is; see domain.QualityPolicy.
+const (
+	QualityScoresTable      = "product_quality_scores"
+	QualityProductID        = "product_id"
+	QualityTenantID         = "tenant_id"
+	QualityName             = "name"
+	QualityCategory         = "category"
+	QualityScore            = "score"
+	QualityDescriptionScore = "description_score"
+	QualityImageScore       = "image_score"
+	QualityAttributeScore   = "attribute_score"
+	QualityTranslationScore = "translation_score"
+	QualityScoredAt         = "scored_at"
 )
 
 // Outbox table constants
@@ -35,6 +276,191 @@ const (
 	OutboxStatus      = "status"
 	OutboxCreatedAt   = "created_at"
 	OutboxProcessedAt = "processed_at"
+	// OutboxTenantID is the tenant that owns the product the event is about; NULL for
+	// the default tenant and for events not about a product.
+	OutboxTenantID = "tenant_id"
+)
+
+// Notification subscription table constants
+const (
+	SubscriptionsTable       = "notification_subscriptions"
+	SubscriptionProductID    = "product_id"
+	SubscriptionKind         = "kind"
+	SubscriptionSubscriberID = "subscriber_id"
+	SubscriptionCreatedAt    = "created_at"
+)
+
+// Merchandising rank table constants. A rank orders a product within its category; lower
+// ranks come first.
+const (
+	RanksTable    = "merchandising_ranks"
+	RankCategory  = "category"
+	RankProductID = "product_id"
+	RankRank      = "rank"
+	RankUpdatedAt = "updated_at"
+)
+
+// Freeze window table constants.
+const (
+	FreezeWindowsTable      = "freeze_windows"
+	FreezeWindowID          = "freeze_id"
+	FreezeWindowTenantID    = "tenant_id"
+	FreezeWindowStart       = "start_time"
+	FreezeWindowEnd         = "end_time"
+	FreezeWindowExemptRoles = "exempt_roles"
+	FreezeWindowReason      = "reason"
+	FreezeWindowCreatedAt   = "created_at"
+)
+
+// Discount blackout table constants.
+const (
+	DiscountBlackoutsTable    = "discount_blackouts"
+	DiscountBlackoutID        = "blackout_id"
+	DiscountBlackoutCategory  = "category"
+	DiscountBlackoutStart     = "start_time"
+	DiscountBlackoutEnd       = "end_time"
+	DiscountBlackoutReason    = "reason"
+	DiscountBlackoutCreatedAt = "created_at"
+)
+
+// Attribute schema table constants. The attributes column holds a JSON array of the
+// attribute definitions of the category; see attributeDefinitionJSON.
+const (
+	AttributeSchemasTable     = "category_attribute_schemas"
+	AttributeSchemaCategory   = "category"
+	AttributeSchemaAttributes = "attributes"
+	AttributeSchemaUpdatedAt  = "updated_at"
+)
+
+// Campaign table constants. A campaign row targets either a category or the listed
+// product IDs.
+const (
+	CampaignsTable     = "campaigns"
+	CampaignID         = "campaign_id"
+	CampaignTenantID   = "tenant_id"
+	CampaignName       = "name"
+	CampaignCategory   = "category"
+	CampaignProductIDs = "product_ids"
+	CampaignPercentage = "percentage"
+	CampaignPriority   = "priority"
+	CampaignStartDate  = "start_date"
+	CampaignEndDate    = "end_date"
+	CampaignStatus     = "status"
+	CampaignCreatedAt  = "created_at"
+	CampaignUpdatedAt  = "updated_at"
+)
+
+// Price list table constants. price_list_entries rows are interleaved in their
+// price_lists row.
+const (
+	PriceListsTable     = "price_lists"
+	PriceListID         = "price_list_id"
+	PriceListTenantID   = "tenant_id"
+	PriceListName       = "name"
+	PriceListCurrency   = "currency"
+	PriceListValidFrom  = "valid_from"
+	PriceListValidUntil = "valid_until"
+	PriceListCreatedAt  = "created_at"
+	PriceListUpdatedAt  = "updated_at"
+
+	PriceListEntriesTable          = "price_list_entries"
+	PriceListEntryPriceListID      = "price_list_id"
+	PriceListEntryProductID        = "product_id"
+	PriceListEntryPriceNumerator   = "price_numerator"
+	PriceListEntryPriceDenominator = "price_denominator"
+)
+
+// Product variant table constants. product_variants rows are interleaved in their
+// products row; idx_product_variants_sku keeps SKUs unique.
+const (
+	VariantsTable              = "product_variants"
+	VariantProductID           = "product_id"
+	VariantID                  = "variant_id"
+	VariantSKU                 = "sku"
+	VariantAttributes          = "attributes"
+	VariantPriceDeltaNumerator = "price_delta_numerator"
+	VariantPriceDeltaDenom     = "price_delta_denominator"
+	VariantStatus              = "status"
+	VariantCreatedAt           = "created_at"
+	VariantUpdatedAt           = "updated_at"
+
+	VariantSKUIndex = "idx_product_variants_sku"
+)
+
+// Product stock table constants. product_stock rows are interleaved in their products
+// row; products without one do not have their stock tracked.
+const (
+	StockTable     = "product_stock"
+	StockProductID = "product_id"
+	StockLevel     = "stock_level"
+	StockReserved  = "reserved"
+	StockVersion   = "version"
+	StockUpdatedAt = "updated_at"
+)
+
+// Product image table constants. product_images rows are interleaved in their products
+// row and ordered by position, from 0.
+const (
+	ImagesTable    = "product_images"
+	ImageProductID = "product_id"
+	ImagePosition  = "position"
+	ImageURL       = "url"
+	ImageAltText   = "alt_text"
+	ImageIsPrimary = "is_primary"
+)
+
+// Product attachment table constants. product_attachments rows are interleaved in their
+// products row and ordered by position, from 0.
+const (
+	AttachmentsTable    = "product_attachments"
+	AttachmentProductID = "product_id"
+	AttachmentPosition  = "position"
+	AttachmentType      = "attachment_type"
+	AttachmentURL       = "url"
+	AttachmentLocale    = "locale"
+)
+
+// Product rating table constants. product_ratings rows are interleaved in their products
+// row, one per customer review of the product, keyed by the ID the reviews service gave
+// the review.
+const (
+	RatingsTable     = "product_ratings"
+	RatingProductID  = "product_id"
+	RatingReviewID   = "review_id"
+	RatingRating     = "rating"
+	RatingIngestedAt = "ingested_at"
+)
+
+// Product relation table constants. product_relations rows are interleaved in the
+// products row of the product they link from.
+const (
+	RelationsTable           = "product_relations"
+	RelationProductID        = "product_id"
+	RelationType             = "relation_type"
+	RelationRelatedProductID = "related_product_id"
+	RelationCreatedAt        = "created_at"
+)
+
+// Product translation table constants. product_translations rows are interleaved in
+// their products row, one per locale other than the default locale.
+const (
+	TranslationsTable      = "product_translations"
+	TranslationProductID   = "product_id"
+	TranslationLocale      = "locale"
+	TranslationName        = "name"
+	TranslationDescription = "description"
+	TranslationUpdatedAt   = "updated_at"
+)
+
+// API key table constants
+const (
+	APIKeysTable    = "api_keys"
+	APIKeyID        = "key_id"
+	APIKeyClientID  = "client_id"
+	APIKeyHash      = "key_hash"
+	APIKeyCreatedAt = "created_at"
+	APIKeyExpiresAt = "expires_at"
+	APIKeyRevokedAt = "revoked_at"
 )
 
 // Outbox event status constants
@@ -59,24 +485,94 @@ type ProductData struct {
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 	ArchivedAt           spanner.NullTime
+	DiscountSuspendedAt  spanner.NullTime
+	DiscountPhase        spanner.NullString
+	Currency             spanner.NullString
+	TaxClass             spanner.NullString
+	MinimumPriceNum      spanner.NullInt64
+	MinimumPriceDenom    spanner.NullInt64
+	CostPriceNum         spanner.NullInt64
+	CostPriceDenom       spanner.NullInt64
+	PendingPriceNum      spanner.NullInt64
+	PendingPriceDenom    spanner.NullInt64
+	PendingPriceAt       spanner.NullTime
+	Tags                 []string
+	Attributes           spanner.NullJSON
+	Slug                 spanner.NullString
+	SKU                  spanner.NullString
+	GTIN                 spanner.NullString
+	ActivateAt           spanner.NullTime
+	DeactivateAt         spanner.NullTime
+	SubmittedBy          spanner.NullString
+	ReviewedBy           spanner.NullString
+	RejectionReason      spanner.NullString
+	Weight               spanner.NullFloat64
+	WeightUnit           spanner.NullString
+	Height               spanner.NullFloat64
+	Width                spanner.NullFloat64
+	Depth                spanner.NullFloat64
+	DimensionUnit        spanner.NullString
+	RatingCount          spanner.NullInt64
+	RatingSum            spanner.NullInt64
+	UnavailableChannels  []string
+	TenantID             spanner.NullString
+	Kind                 spanner.NullString
+	MinimumAge           spanner.NullInt64
+	Hazardous            spanner.NullBool
+	RestrictedMarkets    []string
 }
 
 // InsertMap returns a map of column names to values for INSERT operations.
 func (p *ProductData) InsertMap() map[string]interface{} {
 	return map[string]interface{}{
-		ProductID:                p.ProductID,
-		ProductName:              p.Name,
-		ProductDescription:       p.Description,
-		ProductCategory:          p.Category,
-		ProductBasePriceNum:      p.BasePriceNumerator,
-		ProductBasePriceDenom:    p.BasePriceDenominator,
-		ProductDiscountPercent:   p.DiscountPercent,
-		ProductDiscountStartDate: p.DiscountStartDate,
-		ProductDiscountEndDate:   p.DiscountEndDate,
-		ProductStatus:            p.Status,
-		ProductCreatedAt:         p.CreatedAt,
-		ProductUpdatedAt:         p.UpdatedAt,
-		ProductArchivedAt:        p.ArchivedAt,
+		ProductID:                      p.ProductID,
+		ProductName:                    p.Name,
+		ProductDescription:             p.Description,
+		ProductCategory:                p.Category,
+		ProductBasePriceNum:            p.BasePriceNumerator,
+		ProductBasePriceDenom:          p.BasePriceDenominator,
+		ProductDiscountPercent:         p.DiscountPercent,
+		ProductDiscountStartDate:       p.DiscountStartDate,
+		ProductDiscountEndDate:         p.DiscountEndDate,
+		ProductStatus:                  p.Status,
+		ProductCreatedAt:               p.CreatedAt,
+		ProductUpdatedAt:               p.UpdatedAt,
+		ProductArchivedAt:              p.ArchivedAt,
+		ProductDiscountSuspendedAt:     p.DiscountSuspendedAt,
+		ProductDiscountPhase:           p.DiscountPhase,
+		ProductCurrency:                p.Currency,
+		ProductTaxClass:                p.TaxClass,
+		ProductMinPriceNum:             p.MinimumPriceNum,
+		ProductMinPriceDenom:           p.MinimumPriceDenom,
+		ProductCostPriceNum:            p.CostPriceNum,
+		ProductCostPriceDenom:          p.CostPriceDenom,
+		ProductPendingPriceNum:         p.PendingPriceNum,
+		ProductPendingPriceDenom:       p.PendingPriceDenom,
+		ProductPendingPriceEffectiveAt: p.PendingPriceAt,
+		ProductTags:                    p.Tags,
+		ProductAttributes:              p.Attributes,
+		ProductSlug:                    p.Slug,
+		ProductSKU:                     p.SKU,
+		ProductGTIN:                    p.GTIN,
+		ProductActivateAt:              p.ActivateAt,
+		ProductDeactivateAt:            p.DeactivateAt,
+		ProductSubmittedBy:             p.SubmittedBy,
+		ProductReviewedBy:              p.ReviewedBy,
+		ProductRejectionReason:         p.RejectionReason,
+		ProductWeight:                  p.Weight,
+		ProductWeightUnit:              p.WeightUnit,
+		ProductHeight:                  p.Height,
+		ProductWidth:                   p.Width,
+		ProductDepth:                   p.Depth,
+		ProductDimensionUnit:           p.DimensionUnit,
+		ProductRatingCount:             p.RatingCount,
+		ProductRatingSum:               p.RatingSum,
+		ProductUnavailableChannels:     p.UnavailableChannels,
+		ProductTenantID:                p.TenantID,
+		ProductKind:                    p.Kind,
+		ProductMinimumAge:              p.MinimumAge,
+		ProductHazardous:               p.Hazardous,
+		ProductRestrictedMarkets:       p.RestrictedMarkets,
 	}
 }
 
@@ -101,6 +597,676 @@ func ProductAllColumns() []string {
 		ProductCreatedAt,
 		ProductUpdatedAt,
 		ProductArchivedAt,
+		ProductDiscountSuspendedAt,
+		ProductDiscountPhase,
+		ProductCurrency,
+		ProductTaxClass,
+		ProductMinPriceNum,
+		ProductMinPriceDenom,
+		ProductCostPriceNum,
+		ProductCostPriceDenom,
+		ProductPendingPriceNum,
+		ProductPendingPriceDenom,
+		ProductPendingPriceEffectiveAt,
+		ProductTags,
+		ProductAttributes,
+		ProductSlug,
+		ProductSKU,
+		ProductGTIN,
+		ProductActivateAt,
+		ProductDeactivateAt,
+		ProductSubmittedBy,
+		ProductReviewedBy,
+		ProductRejectionReason,
+		ProductWeight,
+		ProductWeightUnit,
+		ProductHeight,
+		ProductWidth,
+		ProductDepth,
+		ProductDimensionUnit,
+		ProductRatingCount,
+		ProductRatingSum,
+		ProductUnavailableChannels,
+		ProductTenantID,
+		ProductKind,
+		ProductMinimumAge,
+		ProductHazardous,
+		ProductRestrictedMarkets,
+	}
+}
+
+// ProductDataFromRow decodes a row read with ProductAllColumns into ProductData.
+func ProductDataFromRow(row *spanner.Row) (*ProductData, error) {
+	var data ProductData
+
+	if err := row.Columns(
+		&data.ProductID,
+		&data.Name,
+		&data.Description,
+		&data.Category,
+		&data.BasePriceNumerator,
+		&data.BasePriceDenominator,
+		&data.DiscountPercent,
+		&data.DiscountStartDate,
+		&data.DiscountEndDate,
+		&data.Status,
+		&data.CreatedAt,
+		&data.UpdatedAt,
+		&data.ArchivedAt,
+		&data.DiscountSuspendedAt,
+		&data.DiscountPhase,
+		&data.Currency,
+		&data.TaxClass,
+		&data.MinimumPriceNum,
+		&data.MinimumPriceDenom,
+		&data.CostPriceNum,
+		&data.CostPriceDenom,
+		&data.PendingPriceNum,
+		&data.PendingPriceDenom,
+		&data.PendingPriceAt,
+		&data.Tags,
+		&data.Attributes,
+		&data.Slug,
+		&data.SKU,
+		&data.GTIN,
+		&data.ActivateAt,
+		&data.DeactivateAt,
+		&data.SubmittedBy,
+		&data.ReviewedBy,
+		&data.RejectionReason,
+		&data.Weight,
+		&data.WeightUnit,
+		&data.Height,
+		&data.Width,
+		&data.Depth,
+		&data.DimensionUnit,
+		&data.RatingCount,
+		&data.RatingSum,
+		&data.UnavailableChannels,
+		&data.TenantID,
+		&data.Kind,
+		&data.MinimumAge,
+		&data.Hazardous,
+		&data.RestrictedMarkets,
+	); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// ProductPriceColumns returns the columns needed to price a product.
+func ProductPriceColumns() []string {
+	return []string{
+		ProductID,
+		ProductBasePriceNum,
+		ProductBasePriceDenom,
+		ProductDiscountPercent,
+		ProductDiscountStartDate,
+		ProductDiscountEndDate,
+		ProductDiscountSuspendedAt,
+		ProductStatus,
+		ProductCurrency,
+		ProductCategory,
+		ProductTaxClass,
+		ProductCostPriceNum,
+		ProductCostPriceDenom,
+		ProductTenantID,
+	}
+}
+
+// ProductPriceDataFromRow decodes a row read with ProductPriceColumns into ProductData.
+// Columns outside the projection are left at their zero values.
+func ProductPriceDataFromRow(row *spanner.Row) (*ProductData, error) {
+	var data ProductData
+
+	if err := row.Columns(
+		&data.ProductID,
+		&data.BasePriceNumerator,
+		&data.BasePriceDenominator,
+		&data.DiscountPercent,
+		&data.DiscountStartDate,
+		&data.DiscountEndDate,
+		&data.DiscountSuspendedAt,
+		&data.Status,
+		&data.Currency,
+		&data.Category,
+		&data.TaxClass,
+		&data.CostPriceNum,
+		&data.CostPriceDenom,
+		&data.TenantID,
+	); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// DiscountData represents the database model for a product discount.
+type DiscountData struct {
+	ProductID   string
+	DiscountID  string
+	Percentage  big.Rat
+	Priority    int64
+	StartDate   time.Time
+	EndDate     time.Time
+	SuspendedAt spanner.NullTime
+	Phase       string
+	Kind        spanner.NullString
+	BuyQuantity spanner.NullInt64
+	GetQuantity spanner.NullInt64
+	Market      spanner.NullString
+	// PendingApproval is NULL for rows written before approvals, read as false.
+	PendingApproval spanner.NullBool
+	ApprovedBy      spanner.NullString
+	ApprovedAt      spanner.NullTime
+	// FlashSale is NULL for rows written before flash sales, read as false.
+	FlashSale spanner.NullBool
+	// SalePriceNum and SalePriceDenom are NULL for percentage discounts.
+	SalePriceNum   spanner.NullInt64
+	SalePriceDenom spanner.NullInt64
+}
+
+// InsertMap returns a map of column names to values for INSERT operations.
+func (d *DiscountData) InsertMap() map[string]interface{} {
+	return map[string]interface{}{
+		DiscountProductID:       d.ProductID,
+		DiscountID:              d.DiscountID,
+		DiscountPercentage:      d.Percentage,
+		DiscountPriority:        d.Priority,
+		DiscountStartDate:       d.StartDate,
+		DiscountEndDate:         d.EndDate,
+		DiscountSuspendedAt:     d.SuspendedAt,
+		DiscountPhase:           d.Phase,
+		DiscountKind:            d.Kind,
+		DiscountBuyQuantity:     d.BuyQuantity,
+		DiscountGetQuantity:     d.GetQuantity,
+		DiscountMarket:          d.Market,
+		DiscountPendingApproval: d.PendingApproval,
+		DiscountApprovedBy:      d.ApprovedBy,
+		DiscountApprovedAt:      d.ApprovedAt,
+		DiscountFlashSale:       d.FlashSale,
+		DiscountSalePriceNum:    d.SalePriceNum,
+		DiscountSalePriceDenom:  d.SalePriceDenom,
+	}
+}
+
+// InsertMutation creates a Spanner mutation for inserting a discount.
+func (d *DiscountData) InsertMutation() *spanner.Mutation {
+	return spanner.InsertMap(DiscountsTable, d.InsertMap())
+}
+
+// DiscountAllColumns returns all column names for the product_discounts table.
+func DiscountAllColumns() []string {
+	return []string{
+		DiscountProductID,
+		DiscountID,
+		DiscountPercentage,
+		DiscountPriority,
+		DiscountStartDate,
+		DiscountEndDate,
+		DiscountSuspendedAt,
+		DiscountPhase,
+		DiscountKind,
+		DiscountBuyQuantity,
+		DiscountGetQuantity,
+		DiscountMarket,
+		DiscountPendingApproval,
+		DiscountApprovedBy,
+		DiscountApprovedAt,
+		DiscountFlashSale,
+		DiscountSalePriceNum,
+		DiscountSalePriceDenom,
+	}
+}
+
+// DiscountDataFromRow decodes a row read with DiscountAllColumns into DiscountData.
+func DiscountDataFromRow(row *spanner.Row) (*DiscountData, error) {
+	var data DiscountData
+
+	if err := row.Columns(
+		&data.ProductID,
+		&data.DiscountID,
+		&data.Percentage,
+		&data.Priority,
+		&data.StartDate,
+		&data.EndDate,
+		&data.SuspendedAt,
+		&data.Phase,
+		&data.Kind,
+		&data.BuyQuantity,
+		&data.GetQuantity,
+		&data.Market,
+		&data.PendingApproval,
+		&data.ApprovedBy,
+		&data.ApprovedAt,
+		&data.FlashSale,
+		&data.SalePriceNum,
+		&data.SalePriceDenom,
+	); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// PriceTierData represents the database model for a product price tier.
+type PriceTierData struct {
+	ProductID            string
+	MinQuantity          int64
+	UnitPriceNumerator   spanner.NullInt64
+	UnitPriceDenominator spanner.NullInt64
+	PercentOff           spanner.NullNumeric
+}
+
+// InsertMap returns a map of column names to values for INSERT operations.
+func (t *PriceTierData) InsertMap() map[string]interface{} {
+	return map[string]interface{}{
+		PriceTierProductID:      t.ProductID,
+		PriceTierMinQuantity:    t.MinQuantity,
+		PriceTierUnitPriceNum:   t.UnitPriceNumerator,
+		PriceTierUnitPriceDenom: t.UnitPriceDenominator,
+		PriceTierPercentOff:     t.PercentOff,
+	}
+}
+
+// InsertMutation creates a Spanner mutation for inserting a price tier.
+func (t *PriceTierData) InsertMutation() *spanner.Mutation {
+	return spanner.InsertMap(PriceTiersTable, t.InsertMap())
+}
+
+// PriceTierAllColumns returns all column names for the product_price_tiers table.
+func PriceTierAllColumns() []string {
+	return []string{
+		PriceTierProductID,
+		PriceTierMinQuantity,
+		PriceTierUnitPriceNum,
+		PriceTierUnitPriceDenom,
+		PriceTierPercentOff,
+	}
+}
+
+// PriceTierDataFromRow decodes a row read with PriceTierAllColumns into PriceTierData.
+func PriceTierDataFromRow(row *spanner.Row) (*PriceTierData, error) {
+	var data PriceTierData
+
+	if err := row.Columns(
+		&data.ProductID,
+		&data.MinQuantity,
+		&data.UnitPriceNumerator,
+		&data.UnitPriceDenominator,
+		&data.PercentOff,
+	); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// PriceData represents the database model for a price book entry of a product.
+type PriceData struct {
+	ProductID        string
+	Currency         string
+	PriceNumerator   int64
+	PriceDenominator int64
+}
+
+// InsertMap returns a map of column names to values for INSERT operations.
+func (p *PriceData) InsertMap() map[string]interface{} {
+	return map[string]interface{}{
+		PriceProductID:   p.ProductID,
+		PriceCurrency:    p.Currency,
+		PriceNumerator:   p.PriceNumerator,
+		PriceDenominator: p.PriceDenominator,
+	}
+}
+
+// InsertMutation creates a Spanner mutation for inserting a price book entry.
+func (p *PriceData) InsertMutation() *spanner.Mutation {
+	return spanner.InsertMap(PricesTable, p.InsertMap())
+}
+
+// PriceAllColumns returns all column names for the product_prices table.
+func PriceAllColumns() []string {
+	return []string{
+		PriceProductID,
+		PriceCurrency,
+		PriceNumerator,
+		PriceDenominator,
+	}
+}
+
+// PriceDataFromRow decodes a row read with PriceAllColumns into PriceData.
+func PriceDataFromRow(row *spanner.Row) (*PriceData, error) {
+	var data PriceData
+
+	if err := row.Columns(
+		&data.ProductID,
+		&data.Currency,
+		&data.PriceNumerator,
+		&data.PriceDenominator,
+	); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// SegmentPriceData represents the database model for a customer segment price of a
+// product.
+type SegmentPriceData struct {
+	ProductID        string
+	Segment          string
+	PriceNumerator   spanner.NullInt64
+	PriceDenominator spanner.NullInt64
+	PercentOff       spanner.NullNumeric
+}
+
+// InsertMap returns a map of column names to values for INSERT operations.
+func (s *SegmentPriceData) InsertMap() map[string]interface{} {
+	return map[string]interface{}{
+		SegmentPriceProductID:   s.ProductID,
+		SegmentPriceSegment:     s.Segment,
+		SegmentPriceNumerator:   s.PriceNumerator,
+		SegmentPriceDenominator: s.PriceDenominator,
+		SegmentPricePercentOff:  s.PercentOff,
+	}
+}
+
+// InsertMutation creates a Spanner mutation for inserting a segment price.
+func (s *SegmentPriceData) InsertMutation() *spanner.Mutation {
+	return spanner.InsertMap(SegmentPricesTable, s.InsertMap())
+}
+
+// SegmentPriceAllColumns returns all column names for the product_segment_prices table.
+func SegmentPriceAllColumns() []string {
+	return []string{
+		SegmentPriceProductID,
+		SegmentPriceSegment,
+		SegmentPriceNumerator,
+		SegmentPriceDenominator,
+		SegmentPricePercentOff,
+	}
+}
+
+// SegmentPriceDataFromRow decodes a row read with SegmentPriceAllColumns into
+// SegmentPriceData.
+func SegmentPriceDataFromRow(row *spanner.Row) (*SegmentPriceData, error) {
+	var data SegmentPriceData
+
+	if err := row.Columns(
+		&data.ProductID,
+		&data.Segment,
+		&data.PriceNumerator,
+		&data.PriceDenominator,
+		&data.PercentOff,
+	); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// MarketPriceData represents the database model for the price of a product in a market.
+type MarketPriceData struct {
+	ProductID        string
+	Market           string
+	PriceNumerator   int64
+	PriceDenominator int64
+}
+
+// InsertMap returns a map of column names to values for INSERT operations.
+func (m *MarketPriceData) InsertMap() map[string]interface{} {
+	return map[string]interface{}{
+		MarketPriceProductID:   m.ProductID,
+		MarketPriceMarket:      m.Market,
+		MarketPriceNumerator:   m.PriceNumerator,
+		MarketPriceDenominator: m.PriceDenominator,
+	}
+}
+
+// InsertMutation creates a Spanner mutation for inserting a market price.
+func (m *MarketPriceData) InsertMutation() *spanner.Mutation {
+	return spanner.InsertMap(MarketPricesTable, m.InsertMap())
+}
+
+// MarketPriceAllColumns returns all column names for the product_market_prices table.
+func MarketPriceAllColumns() []string {
+	return []string{
+		MarketPriceProductID,
+		MarketPriceMarket,
+		MarketPriceNumerator,
+		MarketPriceDenominator,
+	}
+}
+
+// MarketPriceDataFromRow decodes a row read with MarketPriceAllColumns into
+// MarketPriceData.
+func MarketPriceDataFromRow(row *spanner.Row) (*MarketPriceData, error) {
+	var data MarketPriceData
+
+	if err := row.Columns(
+		&data.ProductID,
+		&data.Market,
+		&data.PriceNumerator,
+		&data.PriceDenominator,
+	); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// PriceHistoryData represents the database model for a price history entry of a product.
+type PriceHistoryData struct {
+	ProductID           string
+	ChangedAt           time.Time
+	ChangeID            string
+	ChangeType          string
+	BasePriceNum        int64
+	BasePriceDenom      int64
+	EffectivePriceNum   int64
+	EffectivePriceDenom int64
+	Currency            string
+	DiscountID          spanner.NullString
+	DiscountPercent     spanner.NullNumeric
+}
+
+// InsertMap returns a map of column names to values for INSERT operations.
+func (h *PriceHistoryData) InsertMap() map[string]interface{} {
+	return map[string]interface{}{
+		HistoryProductID:           h.ProductID,
+		HistoryChangedAt:           h.ChangedAt,
+		HistoryChangeID:            h.ChangeID,
+		HistoryChangeType:          h.ChangeType,
+		HistoryBasePriceNum:        h.BasePriceNum,
+		HistoryBasePriceDenom:      h.BasePriceDenom,
+		HistoryEffectivePriceNum:   h.EffectivePriceNum,
+		HistoryEffectivePriceDenom: h.EffectivePriceDenom,
+		HistoryCurrency:            h.Currency,
+		HistoryDiscountID:          h.DiscountID,
+		HistoryDiscountPercent:     h.DiscountPercent,
+	}
+}
+
+// InsertMutation creates a Spanner mutation for inserting a price history entry.
+func (h *PriceHistoryData) InsertMutation() *spanner.Mutation {
+	return spanner.InsertMap(PriceHistoryTable, h.InsertMap())
+}
+
+// PriceHistoryAllColumns returns all column names for the price_history table.
+func PriceHistoryAllColumns() []string {
+	return []string{
+		HistoryProductID,
+		HistoryChangedAt,
+		HistoryChangeID,
+		HistoryChangeType,
+		HistoryBasePriceNum,
+		HistoryBasePriceDenom,
+		HistoryEffectivePriceNum,
+		HistoryEffectivePriceDenom,
+		HistoryCurrency,
+		HistoryDiscountID,
+		HistoryDiscountPercent,
+	}
+}
+
+// PriceHistoryDataFromRow decodes a row read with PriceHistoryAllColumns into
+// PriceHistoryData.
+func PriceHistoryDataFromRow(row *spanner.Row) (*PriceHistoryData, error) {
+	var data PriceHistoryData
+
+	if err := row.Columns(
+		&data.ProductID,
+		&data.ChangedAt,
+		&data.ChangeID,
+		&data.ChangeType,
+		&data.BasePriceNum,
+		&data.BasePriceDenom,
+		&data.EffectivePriceNum,
+		&data.EffectivePriceDenom,
+		&data.Currency,
+		&data.DiscountID,
+		&data.DiscountPercent,
+	); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// RevisionData represents the database model for a revision of a product.
+type RevisionData struct {
+	ProductID     string
+	RevisedAt     time.Time
+	RevisionID    string
+	ChangedFields []string
+	Snapshot      spanner.NullJSON
+}
+
+// InsertMap returns a map of column names to values for INSERT operations.
+func (r *RevisionData) InsertMap() map[string]interface{} {
+	return map[string]interface{}{
+		RevisionProductID:     r.ProductID,
+		RevisionRevisedAt:     r.RevisedAt,
+		RevisionID:            r.RevisionID,
+		RevisionChangedFields: r.ChangedFields,
+		RevisionSnapshot:      r.Snapshot,
+	}
+}
+
+// InsertMutation creates a Spanner mutation for inserting a revision.
+func (r *RevisionData) InsertMutation() *spanner.Mutation {
+	return spanner.InsertMap(RevisionsTable, r.InsertMap())
+}
+
+// RevisionAllColumns returns all column names for the product_revisions table.
+func RevisionAllColumns() []string {
+	return []string{
+		RevisionProductID,
+		RevisionRevisedAt,
+		RevisionID,
+		RevisionChangedFields,
+		RevisionSnapshot,
+	}
+}
+
+// RevisionDataFromRow decodes a row read with RevisionAllColumns into RevisionData.
+func RevisionDataFromRow(row *spanner.Row) (*RevisionData, error) {
+	var data RevisionData
+
+	if err := row.Columns(
+		&data.ProductID,
+		&data.RevisedAt,
+		&data.RevisionID,
+		&data.ChangedFields,
+		&data.Snapshot,
+	); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// EffectivePriceData represents the database model for a projected effective price period
+// of a product.
+type EffectivePriceData struct {
+	ProductID        string
+	ValidFrom        time.Time
+	ValidUntil       spanner.NullTime
+	PriceNumerator   int64
+	PriceDenominator int64
+	DiscountID       spanner.NullString
+	DiscountPercent  spanner.NullNumeric
+}
+
+// InsertMap returns a map of column names to values for INSERT operations.
+func (e *EffectivePriceData) InsertMap() map[string]interface{} {
+	return map[string]interface{}{
+		EffectivePriceProductID:   e.ProductID,
+		EffectivePriceValidFrom:   e.ValidFrom,
+		EffectivePriceValidUntil:  e.ValidUntil,
+		EffectivePriceNumerator:   e.PriceNumerator,
+		EffectivePriceDenominator: e.PriceDenominator,
+		EffectivePriceDiscountID:  e.DiscountID,
+		EffectivePriceDiscountPct: e.DiscountPercent,
+	}
+}
+
+// InsertMutation creates a Spanner mutation for inserting an effective price period.
+func (e *EffectivePriceData) InsertMutation() *spanner.Mutation {
+	return spanner.InsertMap(EffectivePricesTable, e.InsertMap())
+}
+
+// ProductSummaryData represents the database model for the summary of a product.
+type ProductSummaryData struct {
+	ProductID           string
+	TenantID            spanner.NullString
+	Name                string
+	Category            string
+	Status              string
+	MinPriceNumerator   int64
+	MinPriceDenominator int64
+	MaxPriceNumerator   int64
+	MaxPriceDenominator int64
+}
+
+// InsertMap returns a map of column names to values for INSERT operations. The summary
+// is stamped with the commit timestamp.
+func (s *ProductSummaryData) InsertMap() map[string]interface{} {
+	return map[string]interface{}{
+		SummaryProductID:           s.ProductID,
+		SummaryTenantID:            s.TenantID,
+		SummaryName:                s.Name,
+		SummaryCategory:            s.Category,
+		SummaryStatus:              s.Status,
+		SummaryMinPriceNumerator:   s.MinPriceNumerator,
+		SummaryMinPriceDenominator: s.MinPriceDenominator,
+		SummaryMaxPriceNumerator:   s.MaxPriceNumerator,
+		SummaryMaxPriceDenominator: s.MaxPriceDenominator,
+		SummarySummarizedAt:        spanner.CommitTimestamp,
+	}
+}
+
+// InsertOrUpdateMutation creates a Spanner mutation writing the summary of a product,
+// whether or not it has one.
+func (s *ProductSummaryData) InsertOrUpdateMutation() *spanner.Mutation {
+	return spanner.InsertOrUpdateMap(ProductSummariesTable, s.InsertMap())
+}
+
+// ProductSummaryAllColumns returns all column names for the product_summaries table.
+func ProductSummaryAllColumns() []string {
+	return []string{
+		SummaryProductID,
+		SummaryTenantID,
+		SummaryName,
+		SummaryCategory,
+		SummaryStatus,
+		SummaryMinPriceNumerator,
+		SummaryMinPriceDenominator,
+		SummaryMaxPriceNumerator,
+		SummaryMaxPriceDenominator,
+		SummarySummarizedAt,
 	}
 }
 
@@ -113,6 +1279,7 @@ type OutboxEventData struct {
 	Status      string
 	CreatedAt   time.Time
 	ProcessedAt spanner.NullTime
+	TenantID    spanner.NullString
 }
 
 // InsertMap returns a map of column names to values for INSERT operations.
@@ -125,6 +1292,7 @@ func (e *OutboxEventData) InsertMap() map[string]interface{} {
 		OutboxStatus:      e.Status,
 		OutboxCreatedAt:   e.CreatedAt,
 		OutboxProcessedAt: e.ProcessedAt,
+		OutboxTenantID:    e.TenantID,
 	}
 }
 
@@ -143,9 +1311,30 @@ func OutboxAllColumns() []string {
 		OutboxStatus,
 		OutboxCreatedAt,
 		OutboxProcessedAt,
+		OutboxTenantID,
 	}
 }
 
+// OutboxEventDataFromRow decodes a row read with OutboxAllColumns into OutboxEventData.
+func OutboxEventDataFromRow(row *spanner.Row) (*OutboxEventData, error) {
+	var data OutboxEventData
+
+	if err := row.Columns(
+		&data.EventID,
+		&data.EventType,
+		&data.AggregateID,
+		&data.Payload,
+		&data.Status,
+		&data.CreatedAt,
+		&data.ProcessedAt,
+		&data.TenantID,
+	); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
 // ProductModel provides helper methods for building product Spanner mutations.
 type ProductModel struct{}
 
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
@@ -41,6 +42,7 @@ func TestProductData_InsertMap(t *testing.T) {
 				DiscountPercent:      spanner.NullNumeric{Valid: true},
 				DiscountStartDate:    spanner.NullTime{Time: now, Valid: true},
 				DiscountEndDate:      spanner.NullTime{Time: now.AddDate(0, 1, 0), Valid: true},
+				Currency:             spanner.NullString{StringVal: "EUR", Valid: true},
 				Status:               "active",
 				CreatedAt:            now,
 				UpdatedAt:            now,
@@ -74,6 +76,7 @@ func TestProductData_InsertMap(t *testing.T) {
 			assert.Equal(t, tt.data.Category, m[ProductCategory])
 			assert.Equal(t, tt.data.BasePriceNumerator, m[ProductBasePriceNum])
 			assert.Equal(t, tt.data.BasePriceDenominator, m[ProductBasePriceDenom])
+			assert.Equal(t, tt.data.Currency, m[ProductCurrency])
 			assert.Equal(t, tt.data.Status, m[ProductStatus])
 			assert.Equal(t, tt.data.CreatedAt, m[ProductCreatedAt])
 			assert.Equal(t, tt.data.UpdatedAt, m[ProductUpdatedAt])
@@ -151,6 +154,41 @@ func TestProductAllColumns(t *testing.T) {
 		ProductCreatedAt,
 		ProductUpdatedAt,
 		ProductArchivedAt,
+		ProductDiscountSuspendedAt,
+		ProductDiscountPhase,
+		ProductCurrency,
+		ProductTaxClass,
+		ProductMinPriceNum,
+		ProductMinPriceDenom,
+		ProductCostPriceNum,
+		ProductCostPriceDenom,
+		ProductPendingPriceNum,
+		ProductPendingPriceDenom,
+		ProductPendingPriceEffectiveAt,
+		ProductTags,
+		ProductAttributes,
+		ProductSlug,
+		ProductSKU,
+		ProductGTIN,
+		ProductActivateAt,
+		ProductDeactivateAt,
+		ProductSubmittedBy,
+		ProductReviewedBy,
+		ProductRejectionReason,
+		ProductWeight,
+		ProductWeightUnit,
+		ProductHeight,
+		ProductWidth,
+		ProductDepth,
+		ProductDimensionUnit,
+		ProductRatingCount,
+		ProductRatingSum,
+		ProductUnavailableChannels,
+		ProductTenantID,
+		ProductKind,
+		ProductMinimumAge,
+		ProductHazardous,
+		ProductRestrictedMarkets,
 	}
 
 	assert.Equal(t, len(expectedColumns), len(columns))
@@ -159,6 +197,118 @@ func TestProductAllColumns(t *testing.T) {
 	}
 }
 
+func TestDiscountData_InsertMap(t *testing.T) {
+	now := time.Now()
+	data := &DiscountData{
+		ProductID:   "product-123",
+		DiscountID:  "discount-1",
+		Percentage:  *big.NewRat(25, 2),
+		Priority:    10,
+		StartDate:   now,
+		EndDate:     now.Add(time.Hour),
+		SuspendedAt: spanner.NullTime{Time: now, Valid: true},
+		Phase:       "started",
+	}
+
+	m := data.InsertMap()
+
+	assert.Len(t, m, len(DiscountAllColumns()))
+	for _, col := range DiscountAllColumns() {
+		assert.Contains(t, m, col)
+	}
+	assert.Equal(t, data.DiscountID, m[DiscountID])
+	assert.Equal(t, data.Percentage, m[DiscountPercentage])
+	assert.Equal(t, data.Priority, m[DiscountPriority])
+	assert.Equal(t, data.Phase, m[DiscountPhase])
+}
+
+func TestPriceHistoryData_InsertMap(t *testing.T) {
+	data := &PriceHistoryData{
+		ProductID:           "product-123",
+		ChangedAt:           time.Now(),
+		ChangeID:            "change-1",
+		ChangeType:          "product.discount_applied",
+		BasePriceNum:        2000,
+		BasePriceDenom:      100,
+		EffectivePriceNum:   1500,
+		EffectivePriceDenom: 100,
+		Currency:            "USD",
+		DiscountID:          spanner.NullString{StringVal: "discount-1", Valid: true},
+		DiscountPercent:     spanner.NullNumeric{Numeric: *big.NewRat(25, 1), Valid: true},
+	}
+
+	m := data.InsertMap()
+
+	assert.Len(t, m, len(PriceHistoryAllColumns()))
+	for _, col := range PriceHistoryAllColumns() {
+		assert.Contains(t, m, col)
+	}
+	assert.Equal(t, data.ChangeType, m[HistoryChangeType])
+	assert.Equal(t, data.EffectivePriceNum, m[HistoryEffectivePriceNum])
+	assert.Equal(t, data.DiscountID, m[HistoryDiscountID])
+}
+
+func TestRevisionData_InsertMap(t *testing.T) {
+	data := &RevisionData{
+		ProductID:     "product-123",
+		RevisedAt:     time.Now(),
+		RevisionID:    "revision-1",
+		ChangedFields: []string{"name"},
+		Snapshot:      spanner.NullJSON{Value: map[string]interface{}{"name": "Widget"}, Valid: true},
+	}
+
+	m := data.InsertMap()
+
+	assert.Len(t, m, len(RevisionAllColumns()))
+	for _, col := range RevisionAllColumns() {
+		assert.Contains(t, m, col)
+	}
+	assert.Equal(t, data.ChangedFields, m[RevisionChangedFields])
+	assert.Equal(t, data.Snapshot, m[RevisionSnapshot])
+}
+
+func TestProductSummaryData_InsertMap(t *testing.T) {
+	data := &ProductSummaryData{
+		ProductID:           "product-123",
+		TenantID:            spanner.NullString{StringVal: "acme", Valid: true},
+		Name:                "Widget",
+		Category:            "Tools",
+		Status:              "active",
+		MinPriceNumerator:   15,
+		MinPriceDenominator: 1,
+		MaxPriceNumerator:   20,
+		MaxPriceDenominator: 1,
+	}
+
+	m := data.InsertMap()
+
+	assert.Len(t, m, len(ProductSummaryAllColumns()))
+	for _, col := range ProductSummaryAllColumns() {
+		assert.Contains(t, m, col)
+	}
+	assert.Equal(t, data.TenantID, m[SummaryTenantID])
+	assert.Equal(t, spanner.CommitTimestamp, m[SummarySummarizedAt])
+}
+
+func TestEffectivePriceData_InsertMap(t *testing.T) {
+	data := &EffectivePriceData{
+		ProductID:        "product-123",
+		ValidFrom:        time.Now(),
+		PriceNumerator:   15,
+		PriceDenominator: 1,
+		DiscountID:       spanner.NullString{StringVal: "discount-1", Valid: true},
+		DiscountPercent:  spanner.NullNumeric{Numeric: *big.NewRat(25, 1), Valid: true},
+	}
+
+	m := data.InsertMap()
+
+	assert.Len(t, m, 7)
+	assert.Equal(t, data.ValidFrom, m[EffectivePriceValidFrom])
+	assert.Equal(t, data.ValidUntil, m[EffectivePriceValidUntil])
+	assert.Equal(t, data.PriceNumerator, m[EffectivePriceNumerator])
+	assert.Equal(t, data.DiscountID, m[EffectivePriceDiscountID])
+}
+
 func TestOutboxAllColumns(t *testing.T) {
 	columns := OutboxAllColumns()
 
@@ -170,6 +320,7 @@ func TestOutboxAllColumns(t *testing.T) {
 		OutboxStatus,
 		OutboxCreatedAt,
 		OutboxProcessedAt,
+		OutboxTenantID,
 	}
 
 	assert.Equal(t, len(expectedColumns), len(columns))
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// NotificationSubscriptionRepo implements the NotificationSubscriptionRepository
+// interface using Spanner.
+type NotificationSubscriptionRepo struct {
+	client *spanner.Client
+}
+
+// NewNotificationSubscriptionRepo creates a new NotificationSubscriptionRepo.
+// The client is only used by FindSubscribers; mutation builders work with a nil client.
+func NewNotificationSubscriptionRepo(client *spanner.Client) *NotificationSubscriptionRepo {
+	return &NotificationSubscriptionRepo{client: client}
+}
+
+// SubscribeMut returns a mutation that subscribes a customer to notifications of the
+// given kind for a product. Re-subscribing refreshes created_at.
+func (r *NotificationSubscriptionRepo) SubscribeMut(productID string, kind domain.NotificationKind, subscriberID string, at time.Time) *spanner.Mutation {
+	return spanner.InsertOrUpdateMap(SubscriptionsTable, map[string]interface{}{
+		SubscriptionProductID:    productID,
+		SubscriptionKind:         kind.String(),
+		SubscriptionSubscriberID: subscriberID,
+		SubscriptionCreatedAt:    at,
+	})
+}
+
+// UnsubscribeMut returns a mutation that removes a subscription, if it exists.
+func (r *NotificationSubscriptionRepo) UnsubscribeMut(productID string, kind domain.NotificationKind, subscriberID string) *spanner.Mutation {
+	return spanner.Delete(SubscriptionsTable, spanner.Key{productID, kind.String(), subscriberID})
+}
+
+// DeleteAllMut returns a mutation that removes every subscription of a product.
+func (r *NotificationSubscriptionRepo) DeleteAllMut(productID string) *spanner.Mutation {
+	return spanner.Delete(SubscriptionsTable, spanner.Key{productID}.AsPrefix())
+}
+
+// FindSubscribers returns the IDs of the customers subscribed to notifications of the
+// given kind for a product. The read is a primary key prefix scan, so subscribers are
+// returned sorted.
+func (r *NotificationSubscriptionRepo) FindSubscribers(ctx context.Context, productID string, kind domain.NotificationKind) ([]string, error) {
+	iter := r.client.Single().Read(ctx, SubscriptionsTable,
+		spanner.Key{productID, kind.String()}.AsPrefix(),
+		[]string{SubscriptionSubscriberID},
+	)
+	defer iter.Stop()
+
+	subscribers := make([]string, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return subscribers, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var subscriberID string
+		if err := row.Columns(&subscriberID); err != nil {
+			return nil, err
+		}
+		subscribers = append(subscribers, subscriberID)
+	}
+}
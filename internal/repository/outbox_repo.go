@@ -1,57 +1,606 @@
 package repository
 
 import (
+	"context"
 	"encoding/json"
+	"expvar"
+	"fmt"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/spanner"
 	"github.com/google/uuid"
 	"github.com/product-catalog-service/internal/contract"
 	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/eventschema"
+	"github.com/product-catalog-service/internal/logging"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
 )
 
+// invalidPayloads counts outbox events rejected by schema validation, keyed by event type.
+var invalidPayloads = expvar.NewMap("outbox_invalid_payloads")
+
 // OutboxRepo implements the OutboxRepository interface using Spanner.
 type OutboxRepo struct {
-	model *OutboxModel
+	client *spanner.Client
+	model  *OutboxModel
 }
 
 // NewOutboxRepo creates a new OutboxRepo.
-func NewOutboxRepo() *OutboxRepo {
+// The client is only used by the Find methods; mutation builders work with a nil client.
+func NewOutboxRepo(client *spanner.Client) *OutboxRepo {
 	return &OutboxRepo{
-		model: NewOutboxModel(),
+		client: client,
+		model:  NewOutboxModel(),
 	}
 }
 
 // InsertMut returns a mutation for inserting an outbox event.
-func (r *OutboxRepo) InsertMut(event *contract.OutboxEvent) *spanner.Mutation {
+// The serialized payload is validated against the JSON Schema of the event type, so a
+// malformed event fails the command instead of reaching consumers.
+func (r *OutboxRepo) InsertMut(event *contract.OutboxEvent) (*spanner.Mutation, error) {
 	payload, err := json.Marshal(event.Payload)
 	if err != nil {
-		payload = []byte("{}")
+		return nil, fmt.Errorf("encode payload of %s event: %w", event.EventType, err)
+	}
+	logging.Payload("outbox_repo", event.EventType, payload)
+	if err := eventschema.Validate(event.EventType, payload); err != nil {
+		invalidPayloads.Add(event.EventType, 1)
+		return nil, err
 	}
 
 	data := &OutboxEventData{
 		EventID:     event.EventID,
 		EventType:   event.EventType,
 		AggregateID: event.AggregateID,
+		TenantID:    optionalStringColumn(event.TenantID),
 		Payload:     spanner.NullJSON{Value: json.RawMessage(payload), Valid: true},
 		Status:      StatusPending,
 		CreatedAt:   time.Now(),
 	}
 
-	return r.model.InsertMut(data)
+	return r.model.InsertMut(data), nil
 }
 
 // InsertDomainEventMut converts a domain event to an outbox event and returns a mutation.
-func (r *OutboxRepo) InsertDomainEventMut(event domain.DomainEvent) *spanner.Mutation {
+func (r *OutboxRepo) InsertDomainEventMut(event domain.DomainEvent) (*spanner.Mutation, error) {
+	return r.InsertTenantEventMut("", event)
+}
+
+// InsertTenantEventMut converts a domain event about a product of the given tenant to an
+// outbox event and returns a mutation.
+func (r *OutboxRepo) InsertTenantEventMut(tenantID string, event domain.DomainEvent) (*spanner.Mutation, error) {
 	outboxEvent := &contract.OutboxEvent{
 		EventID:     uuid.New().String(),
 		EventType:   event.EventType(),
 		AggregateID: event.AggregateID(),
+		TenantID:    tenantID,
 		Payload:     r.domainEventToPayload(event),
 	}
 	return r.InsertMut(outboxEvent)
 }
 
+// InsertDomainEventWithSnapshotMut converts a domain event to an outbox event whose payload
+// embeds a snapshot of the product, and returns a mutation.
+func (r *OutboxRepo) InsertDomainEventWithSnapshotMut(event domain.DomainEvent, product *domain.Product, at time.Time) (*spanner.Mutation, error) {
+	payload := r.domainEventToPayload(event)
+	payload["snapshot"] = productSnapshot(product, at)
+
+	outboxEvent := &contract.OutboxEvent{
+		EventID:     uuid.New().String(),
+		EventType:   event.EventType(),
+		AggregateID: event.AggregateID(),
+		TenantID:    product.TenantID(),
+		Payload:     payload,
+	}
+	return r.InsertMut(outboxEvent)
+}
+
+// InsertNotificationMut returns a mutation for inserting a notification event that
+// targets the given subscribers of a product. The payload carries what the notification
+// service needs to render the message, priced as of the triggering event.
+func (r *OutboxRepo) InsertNotificationMut(kind domain.NotificationKind, event domain.DomainEvent, product *domain.Product, subscriberIDs []string) (*spanner.Mutation, error) {
+	effective := product.EffectivePrice(event.OccurredAt())
+	payload := map[string]interface{}{
+		"event_type":                  kind.EventType(),
+		"aggregate_id":                event.AggregateID(),
+		"occurred_at":                 event.OccurredAt(),
+		"trigger_event_type":          event.EventType(),
+		"subscriber_ids":              subscriberIDs,
+		"name":                        product.Name(),
+		"effective_price_numerator":   effective.Numerator(),
+		"effective_price_denominator": effective.Denominator(),
+		"currency":                    effective.Currency(),
+	}
+	if d := notificationDiscount(product, event.OccurredAt()); kind == domain.NotificationKindDiscounted && d != nil {
+		payload["discount_percentage"] = d.PercentageFloat()
+		payload["start_date"] = d.StartDate()
+		payload["end_date"] = d.EndDate()
+	}
+
+	outboxEvent := &contract.OutboxEvent{
+		EventID:     uuid.New().String(),
+		EventType:   kind.EventType(),
+		AggregateID: event.AggregateID(),
+		TenantID:    product.TenantID(),
+		Payload:     payload,
+	}
+	return r.InsertMut(outboxEvent)
+}
+
+// InsertSubscriptionCancelledMut returns a mutation for inserting an event that tells the
+// given subscribers of a product that their subscriptions of kind were cancelled by event.
+func (r *OutboxRepo) InsertSubscriptionCancelledMut(kind domain.NotificationKind, event domain.DomainEvent, product *domain.Product, subscriberIDs []string) (*spanner.Mutation, error) {
+	outboxEvent := &contract.OutboxEvent{
+		EventID:     uuid.New().String(),
+		EventType:   domain.SubscriptionCancelledEventType,
+		AggregateID: event.AggregateID(),
+		TenantID:    product.TenantID(),
+		Payload: map[string]interface{}{
+			"event_type":         domain.SubscriptionCancelledEventType,
+			"aggregate_id":       event.AggregateID(),
+			"occurred_at":        event.OccurredAt(),
+			"trigger_event_type": event.EventType(),
+			"kind":               kind.String(),
+			"subscriber_ids":     subscriberIDs,
+			"name":               product.Name(),
+		},
+	}
+	return r.InsertMut(outboxEvent)
+}
+
+// InsertExposureMut returns a mutation for inserting an experiment exposure event.
+func (r *OutboxRepo) InsertExposureMut(exposure contract.Exposure) (*spanner.Mutation, error) {
+	outboxEvent := &contract.OutboxEvent{
+		EventID:     uuid.New().String(),
+		EventType:   contract.ExposureEventType,
+		AggregateID: exposure.ProductID,
+		Payload: map[string]interface{}{
+			"event_type":                  contract.ExposureEventType,
+			"aggregate_id":                exposure.ProductID,
+			"occurred_at":                 exposure.At,
+			"experiment_id":               exposure.ExperimentID,
+			"variant":                     exposure.Variant,
+			"subject_id":                  exposure.SubjectID,
+			"surface":                     exposure.Surface,
+			"effective_price_numerator":   exposure.EffectivePriceNum,
+			"effective_price_denominator": exposure.EffectivePriceDenom,
+			"currency":                    exposure.Currency,
+		},
+	}
+	return r.InsertMut(outboxEvent)
+}
+
+// RecordExposures writes an exposure event per exposure to the outbox in one commit. It
+// implements contract.ExposureRecorder.
+func (r *OutboxRepo) RecordExposures(ctx context.Context, exposures []contract.Exposure) error {
+	if len(exposures) == 0 {
+		return nil
+	}
+	mutations := make([]*spanner.Mutation, len(exposures))
+	for i, exposure := range exposures {
+		mut, err := r.InsertExposureMut(exposure)
+		if err != nil {
+			return err
+		}
+		mutations[i] = mut
+	}
+	_, err := r.client.Apply(ctx, mutations)
+	return err
+}
+
+// digestDayLayout formats the day of a digest, which is the aggregate ID of its event.
+const digestDayLayout = "2006-01-02"
+
+// InsertDigestMut returns a mutation for inserting the event of a catalog digest. Its
+// event ID is derived from the day, so the digest of a day is stored at most once.
+func (r *OutboxRepo) InsertDigestMut(digest *contract.Digest) (*spanner.Mutation, error) {
+	day := digest.Day.UTC().Format(digestDayLayout)
+
+	newProducts := make([]interface{}, len(digest.NewProducts))
+	for i, p := range digest.NewProducts {
+		newProducts[i] = map[string]interface{}{
+			"product_id":             p.ProductID,
+			"name":                   p.Name,
+			"category":               p.Category,
+			"status":                 p.Status,
+			"base_price_numerator":   p.BasePriceNum,
+			"base_price_denominator": p.BasePriceDenom,
+			"currency":               p.Currency,
+			"created_at":             p.CreatedAt,
+		}
+	}
+	priceDrops := make([]interface{}, len(digest.PriceDrops))
+	for i, d := range digest.PriceDrops {
+		priceDrops[i] = map[string]interface{}{
+			"product_id":            d.ProductID,
+			"name":                  d.Name,
+			"category":              d.Category,
+			"currency":              d.Currency,
+			"old_price_numerator":   d.OldPriceNum,
+			"old_price_denominator": d.OldPriceDenom,
+			"new_price_numerator":   d.NewPriceNum,
+			"new_price_denominator": d.NewPriceDenom,
+			"drop_percent":          d.DropPercent,
+		}
+	}
+	expiring := make([]interface{}, len(digest.ExpiringDiscounts))
+	for i, d := range digest.ExpiringDiscounts {
+		discount := map[string]interface{}{
+			"product_id":  d.ProductID,
+			"name":        d.Name,
+			"discount_id": d.DiscountID,
+			"percentage":  d.Percentage,
+			"end_date":    d.EndDate,
+		}
+		if d.Market != "" {
+			discount["market"] = d.Market
+		}
+		expiring[i] = discount
+	}
+
+	outboxEvent := &contract.OutboxEvent{
+		EventID:     digestEventID(day),
+		EventType:   contract.DigestEventType,
+		AggregateID: day,
+		Payload: map[string]interface{}{
+			"event_type":              contract.DigestEventType,
+			"aggregate_id":            day,
+			"occurred_at":             digest.GeneratedAt,
+			"day":                     day,
+			"new_products":            newProducts,
+			"new_product_count":       digest.NewProductCount,
+			"price_drops":             priceDrops,
+			"price_drop_count":        digest.PriceDropCount,
+			"expiring_discounts":      expiring,
+			"expiring_discount_count": digest.ExpiringDiscountCount,
+		},
+	}
+	return r.InsertMut(outboxEvent)
+}
+
+// digestEventID returns the event ID of the digest of day.
+func digestEventID(day string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(contract.DigestEventType+"/"+day)).String()
+}
+
+// PublishDigest writes the event of a catalog digest to the outbox. It implements
+// contract.DigestPublisher: if the digest of the day is already stored, it returns nil.
+func (r *OutboxRepo) PublishDigest(ctx context.Context, digest *contract.Digest) error {
+	mut, err := r.InsertDigestMut(digest)
+	if err != nil {
+		return err
+	}
+	_, err = r.client.Apply(ctx, []*spanner.Mutation{mut})
+	if spanner.ErrCode(err) == codes.AlreadyExists {
+		return nil
+	}
+	return err
+}
+
+// notificationDiscount returns the discount a notification describes: the one that
+// applies at the given time, else the one that is running or starts next.
+func notificationDiscount(product *domain.Product, at time.Time) *domain.Discount {
+	if d := product.ApplicableDiscount(at); d != nil {
+		return d
+	}
+	return domain.CurrentDiscount(product.Discounts(), at)
+}
+
+// UpdateStatusMut returns a mutation that records the delivery outcome of an event.
+func (r *OutboxRepo) UpdateStatusMut(eventID, status string, at time.Time) *spanner.Mutation {
+	return r.model.UpdateMut(eventID, map[string]interface{}{
+		OutboxStatus:      status,
+		OutboxProcessedAt: at,
+	})
+}
+
+// DeleteMut returns a mutation that deletes the events with the given IDs, or nil if
+// there are none.
+func (r *OutboxRepo) DeleteMut(eventIDs []string) *spanner.Mutation {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+	keys := make([]spanner.KeySet, 0, len(eventIDs))
+	for _, id := range eventIDs {
+		keys = append(keys, spanner.Key{id})
+	}
+	return spanner.Delete(OutboxTable, spanner.KeySets(keys...))
+}
+
+// FindPending returns up to limit pending events, oldest first.
+func (r *OutboxRepo) FindPending(ctx context.Context, limit int) ([]*contract.StoredOutboxEvent, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + outboxColumnsSQL() + ` FROM outbox_events@{FORCE_INDEX=idx_outbox_status}
+		      WHERE status = @status ORDER BY created_at, event_id LIMIT @limit`,
+		Params: map[string]interface{}{
+			"status": StatusPending,
+			"limit":  int64(limit),
+		},
+	}
+	return r.query(ctx, stmt)
+}
+
+// FindByAggregateID returns all events of an aggregate in creation order.
+func (r *OutboxRepo) FindByAggregateID(ctx context.Context, aggregateID string) ([]*contract.StoredOutboxEvent, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + outboxColumnsSQL() + ` FROM outbox_events
+		      WHERE aggregate_id = @aggregate_id ORDER BY created_at, event_id`,
+		Params: map[string]interface{}{
+			"aggregate_id": aggregateID,
+		},
+	}
+	return r.query(ctx, stmt)
+}
+
+// query runs a statement selecting outboxColumnsSQL and decodes the events.
+func (r *OutboxRepo) query(ctx context.Context, stmt spanner.Statement) ([]*contract.StoredOutboxEvent, error) {
+	logging.SQL("outbox_repo", stmt.SQL, stmt.Params)
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	events := make([]*contract.StoredOutboxEvent, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return events, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := OutboxEventDataFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		event, err := dataToStoredEvent(data)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+}
+
+// dataToStoredEvent converts a database model to a StoredOutboxEvent.
+func dataToStoredEvent(data *OutboxEventData) (*contract.StoredOutboxEvent, error) {
+	event := &contract.StoredOutboxEvent{
+		EventID:     data.EventID,
+		EventType:   data.EventType,
+		AggregateID: data.AggregateID,
+		TenantID:    data.TenantID.StringVal,
+		Payload:     json.RawMessage("{}"),
+		Status:      data.Status,
+		CreatedAt:   data.CreatedAt,
+	}
+	if data.Payload.Valid {
+		raw, err := json.Marshal(data.Payload.Value)
+		if err != nil {
+			return nil, fmt.Errorf("decode payload of event %s: %w", data.EventID, err)
+		}
+		event.Payload = raw
+	}
+	if data.ProcessedAt.Valid {
+		processedAt := data.ProcessedAt.Time
+		event.ProcessedAt = &processedAt
+	}
+	return event, nil
+}
+
+// outboxColumnsSQL returns OutboxAllColumns as a comma-separated SQL string.
+func outboxColumnsSQL() string {
+	return strings.Join(OutboxAllColumns(), ", ")
+}
+
+// productSnapshot returns the complete state of a product as a JSON-serializable map,
+// so consumers can update their copy without reading the product back.
+func productSnapshot(product *domain.Product, at time.Time) map[string]interface{} {
+	effective := product.EffectivePrice(at)
+	snapshot := map[string]interface{}{
+		"product_id":                  product.ID(),
+		"name":                        product.Name(),
+		"slug":                        nil,
+		"sku":                         nil,
+		"gtin":                        nil,
+		"description":                 product.Description(),
+		"category":                    product.Category(),
+		"base_price_numerator":        product.BasePrice().Numerator(),
+		"base_price_denominator":      product.BasePrice().Denominator(),
+		"currency":                    product.Currency(),
+		"effective_price_numerator":   effective.Numerator(),
+		"effective_price_denominator": effective.Denominator(),
+		"has_active_discount":         product.HasActiveDiscount(at),
+		"discount":                    nil,
+		"discounts":                   []interface{}{},
+		"price_tiers":                 priceTierSnapshots(product.PriceTiers()),
+		"price_book":                  priceSnapshots(product.PriceBook()),
+		"segment_prices":              segmentPriceSnapshots(product.SegmentPrices()),
+		"market_prices":               marketPriceSnapshots(product.MarketPrices()),
+		"tax_class":                   product.TaxClass().String(),
+		"minimum_price_numerator":     nil,
+		"minimum_price_denominator":   nil,
+		"cost_price_numerator":        nil,
+		"cost_price_denominator":      nil,
+		"pending_price_change":        nil,
+		"activate_at":                 product.ActivateAt(),
+		"deactivate_at":               product.DeactivateAt(),
+		"review":                      nil,
+		"weight":                      weightPayload(product.Weight()),
+		"dimensions":                  dimensionsPayload(product.Dimensions()),
+		"kind":                        nil,
+		"compliance":                  compliancePayload(product.Compliance()),
+		"channels":                    channelsPayload(product.Channels()),
+		"tags":                        append([]string{}, product.Tags()...),
+		"attributes":                  product.Attributes(),
+		"status":                      string(product.Status()),
+		"created_at":                  product.CreatedAt(),
+		"updated_at":                  product.UpdatedAt(),
+		"archived_at":                 product.ArchivedAt(),
+	}
+
+	if slug := product.Slug(); slug != "" {
+		snapshot["slug"] = slug
+	}
+	if kind := product.Kind(); kind != "" {
+		snapshot["kind"] = string(kind)
+	}
+	if sku := product.SKU(); sku != "" {
+		snapshot["sku"] = sku
+	}
+	if gtin := product.GTIN(); gtin != "" {
+		snapshot["gtin"] = gtin
+	}
+	if minimum := product.MinimumPrice(); minimum != nil {
+		snapshot["minimum_price_numerator"] = minimum.Numerator()
+		snapshot["minimum_price_denominator"] = minimum.Denominator()
+	}
+	if cost := product.CostPrice(); cost != nil {
+		snapshot["cost_price_numerator"] = cost.Numerator()
+		snapshot["cost_price_denominator"] = cost.Denominator()
+	}
+	if review := product.Review(); review != nil {
+		snapshotReview := map[string]interface{}{
+			"submitted_by":     review.SubmittedBy(),
+			"reviewed_by":      nil,
+			"rejection_reason": nil,
+		}
+		if reviewedBy := review.ReviewedBy(); reviewedBy != "" {
+			snapshotReview["reviewed_by"] = reviewedBy
+		}
+		if reason := review.RejectionReason(); reason != "" {
+			snapshotReview["rejection_reason"] = reason
+		}
+		snapshot["review"] = snapshotReview
+	}
+	if change := product.PendingPriceChange(); change != nil {
+		snapshot["pending_price_change"] = map[string]interface{}{
+			"price_numerator":   change.Price().Numerator(),
+			"price_denominator": change.Price().Denominator(),
+			"effective_at":      change.EffectiveAt(),
+		}
+	}
+
+	discounts := product.Discounts()
+	if d := domain.CurrentDiscount(discounts, at); d != nil {
+		snapshot["discount"] = discountSnapshot(d)
+	}
+	all := make([]interface{}, len(discounts))
+	for i, d := range discounts {
+		all[i] = discountSnapshot(d)
+	}
+	snapshot["discounts"] = all
+
+	return snapshot
+}
+
+// discountSnapshot returns the state of a discount as a JSON-serializable map.
+func discountSnapshot(d *domain.Discount) map[string]interface{} {
+	snapshot := map[string]interface{}{
+		"id":         d.ID(),
+		"percentage": d.PercentageFloat(),
+		"priority":   d.Priority(),
+		"start_date": d.StartDate(),
+		"end_date":   d.EndDate(),
+		"suspended":  d.IsSuspended(),
+		"kind":       string(d.Kind()),
+	}
+	if promotion := d.Promotion(); promotion != nil {
+		snapshot["buy_quantity"] = promotion.BuyQuantity()
+		snapshot["get_quantity"] = promotion.GetQuantity()
+	}
+	if market := d.Market(); market != "" {
+		snapshot["market"] = market.String()
+	}
+	if d.IsPendingApproval() {
+		snapshot["pending_approval"] = true
+	}
+	if approver := d.ApprovedBy(); approver != "" {
+		snapshot["approved_by"] = approver
+	}
+	if d.IsFlashSale() {
+		snapshot["flash_sale"] = true
+	}
+	if sale := d.SalePrice(); sale != nil {
+		snapshot["sale_price_numerator"] = sale.Numerator()
+		snapshot["sale_price_denominator"] = sale.Denominator()
+	}
+	return snapshot
+}
+
+// priceTierSnapshots returns price tiers as a JSON-serializable list. Each tier carries
+// either its unit price or its percent off; the other fields are null.
+func priceTierSnapshots(tiers []*domain.PriceTier) []interface{} {
+	snapshots := make([]interface{}, len(tiers))
+	for i, t := range tiers {
+		snapshot := map[string]interface{}{
+			"min_quantity":           t.MinQuantity(),
+			"unit_price_numerator":   nil,
+			"unit_price_denominator": nil,
+			"percent_off":            nil,
+		}
+		if price := t.UnitPrice(); price != nil {
+			snapshot["unit_price_numerator"] = price.Numerator()
+			snapshot["unit_price_denominator"] = price.Denominator()
+		}
+		if pct := t.PercentOff(); pct != nil {
+			f, _ := pct.Float64()
+			snapshot["percent_off"] = f
+		}
+		snapshots[i] = snapshot
+	}
+	return snapshots
+}
+
+func priceSnapshots(prices []*domain.Money) []interface{} {
+	snapshots := make([]interface{}, len(prices))
+	for i, p := range prices {
+		snapshots[i] = map[string]interface{}{
+			"currency":          p.Currency(),
+			"price_numerator":   p.Numerator(),
+			"price_denominator": p.Denominator(),
+		}
+	}
+	return snapshots
+}
+
+// segmentPriceSnapshots returns segment prices as a JSON-serializable list. Each price
+// carries either its fixed price or its percent off; the other fields are null.
+func segmentPriceSnapshots(prices []*domain.SegmentPrice) []interface{} {
+	snapshots := make([]interface{}, len(prices))
+	for i, s := range prices {
+		snapshot := map[string]interface{}{
+			"segment":           s.Segment(),
+			"price_numerator":   nil,
+			"price_denominator": nil,
+			"percent_off":       nil,
+		}
+		if price := s.Price(); price != nil {
+			snapshot["price_numerator"] = price.Numerator()
+			snapshot["price_denominator"] = price.Denominator()
+		}
+		if pct := s.PercentOff(); pct != nil {
+			f, _ := pct.Float64()
+			snapshot["percent_off"] = f
+		}
+		snapshots[i] = snapshot
+	}
+	return snapshots
+}
+
+// marketPriceSnapshots returns market prices as a JSON-serializable list.
+func marketPriceSnapshots(prices []*domain.MarketPrice) []interface{} {
+	snapshots := make([]interface{}, len(prices))
+	for i, m := range prices {
+		snapshots[i] = map[string]interface{}{
+			"market":            m.Market().String(),
+			"currency":          m.Price().Currency(),
+			"price_numerator":   m.Price().Numerator(),
+			"price_denominator": m.Price().Denominator(),
+		}
+	}
+	return snapshots
+}
+
 // domainEventToPayload converts a domain event to a JSON-serializable payload.
 func (r *OutboxRepo) domainEventToPayload(event domain.DomainEvent) map[string]interface{} {
 	payload := map[string]interface{}{
@@ -68,6 +617,7 @@ func (r *OutboxRepo) domainEventToPayload(event domain.DomainEvent) map[string]i
 		if e.BasePrice != nil {
 			payload["base_price_numerator"] = e.BasePrice.Numerator()
 			payload["base_price_denominator"] = e.BasePrice.Denominator()
+			payload["currency"] = e.BasePrice.Currency()
 		}
 
 	case domain.ProductUpdatedEvent:
@@ -75,13 +625,176 @@ func (r *OutboxRepo) domainEventToPayload(event domain.DomainEvent) map[string]i
 		payload["description"] = e.Description
 		payload["category"] = e.Category
 
+	case domain.ProductPriceChangedEvent:
+		if e.OldPrice != nil {
+			payload["old_price_numerator"] = e.OldPrice.Numerator()
+			payload["old_price_denominator"] = e.OldPrice.Denominator()
+		}
+		if e.NewPrice != nil {
+			payload["new_price_numerator"] = e.NewPrice.Numerator()
+			payload["new_price_denominator"] = e.NewPrice.Denominator()
+			payload["currency"] = e.NewPrice.Currency()
+		}
+		payload["effective_at"] = nil
+		if !e.EffectiveAt.IsZero() {
+			payload["effective_at"] = e.EffectiveAt
+		}
+
+	case domain.PriceChangeScheduledEvent:
+		if e.NewPrice != nil {
+			payload["new_price_numerator"] = e.NewPrice.Numerator()
+			payload["new_price_denominator"] = e.NewPrice.Denominator()
+			payload["currency"] = e.NewPrice.Currency()
+		}
+		payload["effective_at"] = e.EffectiveAt
+
+	case domain.PriceChangeCancelledEvent:
+		// No additional fields
+
 	case domain.DiscountAppliedEvent:
+		payload["discount_id"] = e.DiscountID
+		if e.DiscountPercentage != nil {
+			f, _ := e.DiscountPercentage.Float64()
+			payload["discount_percentage"] = f
+		}
+		payload["priority"] = e.Priority
+		payload["start_date"] = e.StartDate
+		payload["end_date"] = e.EndDate
+		if e.Promotion != nil {
+			payload["buy_quantity"] = e.Promotion.BuyQuantity()
+			payload["get_quantity"] = e.Promotion.GetQuantity()
+		}
+		if e.Market != "" {
+			payload["market"] = e.Market.String()
+		}
+		payload["pending_approval"] = e.PendingApproval
+		if e.FlashSale {
+			payload["flash_sale"] = true
+		}
+		if e.SalePrice != nil {
+			payload["sale_price_numerator"] = e.SalePrice.Numerator()
+			payload["sale_price_denominator"] = e.SalePrice.Denominator()
+		}
+
+	case domain.DiscountApprovedEvent:
+		payload["discount_id"] = e.DiscountID
+		payload["approved_by"] = e.ApprovedBy
+
+	case domain.DiscountStartedEvent:
+		payload["discount_id"] = e.DiscountID
 		if e.DiscountPercentage != nil {
 			f, _ := e.DiscountPercentage.Float64()
 			payload["discount_percentage"] = f
 		}
 		payload["start_date"] = e.StartDate
 		payload["end_date"] = e.EndDate
+		if e.Promotion != nil {
+			payload["buy_quantity"] = e.Promotion.BuyQuantity()
+			payload["get_quantity"] = e.Promotion.GetQuantity()
+		}
+		if e.FlashSale {
+			payload["flash_sale"] = true
+		}
+
+	case domain.DiscountEndedEvent:
+		payload["discount_id"] = e.DiscountID
+		payload["end_date"] = e.EndDate
+		if e.FlashSale {
+			payload["flash_sale"] = true
+		}
+
+	case domain.PriceTiersChangedEvent:
+		payload["currency"] = e.Currency
+		payload["price_tiers"] = priceTierSnapshots(e.Tiers)
+
+	case domain.SegmentPricesChangedEvent:
+		payload["currency"] = e.Currency
+		payload["segment_prices"] = segmentPriceSnapshots(e.Prices)
+
+	case domain.MarketPricesChangedEvent:
+		payload["market_prices"] = marketPriceSnapshots(e.Prices)
+
+	case domain.PriceBookChangedEvent:
+		payload["prices"] = priceSnapshots(e.Prices)
+
+	case domain.TaxClassChangedEvent:
+		payload["old_tax_class"] = e.OldTaxClass.String()
+		payload["new_tax_class"] = e.NewTaxClass.String()
+
+	case domain.MinimumPriceChangedEvent:
+		payload["minimum_price_numerator"] = nil
+		payload["minimum_price_denominator"] = nil
+		if e.MinimumPrice != nil {
+			payload["minimum_price_numerator"] = e.MinimumPrice.Numerator()
+			payload["minimum_price_denominator"] = e.MinimumPrice.Denominator()
+			payload["currency"] = e.MinimumPrice.Currency()
+		}
+
+	case domain.CostPriceChangedEvent:
+		payload["cost_price_numerator"] = nil
+		payload["cost_price_denominator"] = nil
+		if e.CostPrice != nil {
+			payload["cost_price_numerator"] = e.CostPrice.Numerator()
+			payload["cost_price_denominator"] = e.CostPrice.Denominator()
+			payload["currency"] = e.CostPrice.Currency()
+		}
+
+	case domain.ProductTagsChangedEvent:
+		payload["tags"] = append([]string{}, e.Tags...)
+
+	case domain.ProductChannelAvailabilityChangedEvent:
+		payload["channels"] = channelsPayload(e.Channels)
+
+	case domain.ProductAttributeChangedEvent:
+		payload["name"] = e.Name
+		payload["value"] = nil
+		if e.Value != "" {
+			payload["value"] = e.Value
+		}
+
+	case domain.ProductSlugChangedEvent:
+		payload["slug"] = e.Slug
+		payload["previous_slug"] = nil
+		if e.PreviousSlug != "" {
+			payload["previous_slug"] = e.PreviousSlug
+		}
+
+	case domain.ProductIdentifiersChangedEvent:
+		payload["sku"] = nil
+		if e.SKU != "" {
+			payload["sku"] = e.SKU
+		}
+		payload["gtin"] = nil
+		if e.GTIN != "" {
+			payload["gtin"] = e.GTIN
+		}
+
+	case domain.ProductDimensionsChangedEvent:
+		payload["weight"] = weightPayload(e.Weight)
+		payload["dimensions"] = dimensionsPayload(e.Dimensions)
+
+	case domain.ProductKindChangedEvent:
+		payload["kind"] = string(e.Kind)
+
+	case domain.ProductComplianceChangedEvent:
+		payload["compliance"] = compliancePayload(e.Compliance)
+
+	case domain.ProductStatusScheduledEvent:
+		payload["activate_at"] = e.ActivateAt
+		payload["deactivate_at"] = e.DeactivateAt
+
+	case domain.ProductSubmittedForReviewEvent:
+		payload["submitted_by"] = e.SubmittedBy
+
+	case domain.ProductApprovedEvent:
+		payload["reviewer"] = e.Reviewer
+
+	case domain.ProductRejectedEvent:
+		payload["reviewer"] = e.Reviewer
+		payload["reason"] = e.Reason
+
+	case domain.ProductPurgedEvent:
+		payload["archived_at"] = e.ArchivedAt
 
 	case domain.ProductActivatedEvent:
 		// No additional fields
@@ -93,8 +806,184 @@ func (r *OutboxRepo) domainEventToPayload(event domain.DomainEvent) map[string]i
 		// No additional fields
 
 	case domain.DiscountRemovedEvent:
+		payload["discount_id"] = e.DiscountID
+
+	case domain.DiscountExpiredEvent:
+		payload["discount_id"] = e.DiscountID
+
+	case domain.DiscountSuspendedEvent, domain.DiscountResumedEvent:
+		// No additional fields
+
+	case domain.CampaignCreatedEvent:
+		payload["name"] = e.Name
+		payload["category"] = nil
+		if e.Category != "" {
+			payload["category"] = e.Category
+		}
+		payload["product_count"] = e.ProductCount
+		if e.DiscountPercentage != nil {
+			f, _ := e.DiscountPercentage.Float64()
+			payload["discount_percentage"] = f
+		}
+		payload["priority"] = e.Priority
+		payload["start_date"] = e.StartDate
+		payload["end_date"] = e.EndDate
+
+	case domain.CampaignActivatedEvent:
+		payload["applied_count"] = e.AppliedCount
+		payload["skipped_count"] = e.SkippedCount
+
+	case domain.CampaignEndedEvent:
+		payload["removed_count"] = e.RemovedCount
+
+	case domain.PriceListCreatedEvent:
+		payload["name"] = e.Name
+		payload["currency"] = e.Currency
+		payload["valid_from"] = e.ValidFrom
+		payload["valid_until"] = nil
+		if !e.ValidUntil.IsZero() {
+			payload["valid_until"] = e.ValidUntil
+		}
+
+	case domain.PriceListEntriesChangedEvent:
+		entries := make([]interface{}, len(e.Entries))
+		for i, entry := range e.Entries {
+			entries[i] = map[string]interface{}{
+				"product_id":        entry.ProductID(),
+				"price_numerator":   entry.Price().Numerator(),
+				"price_denominator": entry.Price().Denominator(),
+			}
+		}
+		payload["entries"] = entries
+
+	case domain.ProductImagesChangedEvent:
+		images := make([]interface{}, len(e.Images))
+		for i, image := range e.Images {
+			images[i] = map[string]interface{}{
+				"url":      image.URL(),
+				"alt_text": image.AltText(),
+				"primary":  image.Primary(),
+			}
+		}
+		payload["images"] = images
+
+	case domain.ProductAttachmentsChangedEvent:
+		attachments := make([]interface{}, len(e.Attachments))
+		for i, attachment := range e.Attachments {
+			attachments[i] = map[string]interface{}{
+				"type":   string(attachment.Type()),
+				"url":    attachment.URL(),
+				"locale": attachment.Locale(),
+			}
+		}
+		payload["attachments"] = attachments
+
+	case domain.ProductTranslationChangedEvent:
+		payload["locale"] = e.Locale
+		payload["name"] = nil
+		payload["description"] = nil
+		if e.Translation != nil {
+			payload["name"] = e.Translation.Name()
+			payload["description"] = e.Translation.Description()
+		}
+
+	case domain.ProductLinkedEvent:
+		payload["related_product_id"] = e.RelatedProductID
+		payload["relation_type"] = e.RelationType.String()
+
+	case domain.ProductUnlinkedEvent:
+		payload["related_product_id"] = e.RelatedProductID
+		payload["relation_type"] = e.RelationType.String()
+
+	case domain.StockChangedEvent:
+		payload["level"] = e.Level
+		payload["reserved"] = e.Reserved
+		payload["available"] = e.Available
+		payload["version"] = e.Version
+
+	case domain.OutOfStockEvent:
 		// No additional fields
+
+	case domain.BackInStockEvent:
+		payload["available"] = e.Available
+
+	case domain.VariantAddedEvent:
+		addVariantPayload(payload, e.ProductID, e.SKU, e.Attributes, e.PriceDelta)
+
+	case domain.VariantUpdatedEvent:
+		addVariantPayload(payload, e.ProductID, e.SKU, e.Attributes, e.PriceDelta)
+
+	case domain.VariantDiscontinuedEvent:
+		payload["product_id"] = e.ProductID
+		payload["sku"] = e.SKU
 	}
 
 	return payload
 }
+
+// addVariantPayload adds the fields of a variant shared by its added and updated events
+// to payload.
+func addVariantPayload(payload map[string]interface{}, productID, sku string, attributes map[string]string, priceDelta *domain.Money) {
+	payload["product_id"] = productID
+	payload["sku"] = sku
+	payload["attributes"] = attributes
+	payload["price_delta_numerator"] = priceDelta.Numerator()
+	payload["price_delta_denominator"] = priceDelta.Denominator()
+	payload["currency"] = priceDelta.Currency()
+}
+
+// compliancePayload returns the payload of the compliance flags of a product, nil if it
+// has none.
+func compliancePayload(compliance *domain.Compliance) interface{} {
+	if compliance == nil {
+		return nil
+	}
+	markets := make([]string, 0, len(compliance.RestrictedMarkets()))
+	for _, market := range compliance.RestrictedMarkets() {
+		markets = append(markets, market.String())
+	}
+	return map[string]interface{}{
+		"minimum_age":        compliance.MinimumAge(),
+		"hazardous":          compliance.Hazardous(),
+		"restricted_markets": markets,
+	}
+}
+
+// weightPayload returns the payload of the weight of a product, nil if it has none.
+func weightPayload(weight *domain.Weight) interface{} {
+	if weight == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"value": weight.Value(),
+		"unit":  string(weight.Unit()),
+		"grams": weight.Grams(),
+	}
+}
+
+// dimensionsPayload returns the payload of the dimensions of a product, nil if it has
+// none.
+func dimensionsPayload(dimensions *domain.Dimensions) interface{} {
+	if dimensions == nil {
+		return nil
+	}
+	heightMM, widthMM, depthMM := dimensions.In(domain.LengthUnitMillimetre)
+	return map[string]interface{}{
+		"height":    dimensions.Height(),
+		"width":     dimensions.Width(),
+		"depth":     dimensions.Depth(),
+		"unit":      string(dimensions.Unit()),
+		"height_mm": heightMM,
+		"width_mm":  widthMM,
+		"depth_mm":  depthMM,
+	}
+}
+
+// channelsPayload returns the payload of the sales channels a product is available on.
+func channelsPayload(channels []domain.SalesChannel) []string {
+	payload := make([]string, len(channels))
+	for i, channel := range channels {
+		payload[i] = string(channel)
+	}
+	return payload
+}
@@ -0,0 +1,633 @@
+package repository
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/eventschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductSnapshot(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		product  func(t *testing.T) *domain.Product
+		expected map[string]interface{}
+	}{
+		{
+			name: "product without discount",
+			product: func(t *testing.T) *domain.Product {
+				p, err := domain.NewProduct("product-123", "Widget", "A widget", "Tools", domain.NewMoney(1999, 100), now)
+				require.NoError(t, err)
+				return p
+			},
+			expected: map[string]interface{}{
+				"name":                        "Widget",
+				"status":                      "draft",
+				"base_price_numerator":        int64(1999),
+				"currency":                    "USD",
+				"effective_price_numerator":   int64(1999),
+				"effective_price_denominator": int64(100),
+				"has_active_discount":         false,
+				"discount":                    nil,
+				"discounts":                   []interface{}{},
+			},
+		},
+		{
+			name: "product with active discount",
+			product: func(t *testing.T) *domain.Product {
+				p, err := domain.NewProduct("product-123", "Widget", "A widget", "Tools", domain.NewMoney(2000, 100), now)
+				require.NoError(t, err)
+				require.NoError(t, p.Activate(now))
+				d, err := domain.NewDiscount(big.NewRat(25, 1), now.Add(-time.Hour), now.Add(time.Hour))
+				require.NoError(t, err)
+				require.NoError(t, p.ApplyDiscount(d.WithID("discount-1").WithPriority(5), now))
+				return p
+			},
+			expected: map[string]interface{}{
+				"status":                      "active",
+				"effective_price_numerator":   int64(15),
+				"effective_price_denominator": int64(1),
+				"has_active_discount":         true,
+				"discount": map[string]interface{}{
+					"id":         "discount-1",
+					"percentage": 25.0,
+					"priority":   5,
+					"start_date": now.Add(-time.Hour),
+					"end_date":   now.Add(time.Hour),
+					"suspended":  false,
+					"kind":       "percentage",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := productSnapshot(tt.product(t), now)
+
+			assert.Equal(t, "product-123", snapshot["product_id"])
+			for key, want := range tt.expected {
+				assert.Equal(t, want, snapshot[key], key)
+			}
+		})
+	}
+}
+
+func TestOutboxRepo_InsertDomainEventWithSnapshotMut(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := domain.NewProduct("product-123", "Widget", "A widget", "Tools", domain.NewMoney(1999, 100), now)
+	require.NoError(t, err)
+	require.Len(t, product.DomainEvents(), 1)
+
+	repo := NewOutboxRepo(nil)
+	mut, err := repo.InsertDomainEventWithSnapshotMut(product.DomainEvents()[0], product, now)
+	require.NoError(t, err)
+	assert.NotNil(t, mut)
+}
+
+func TestOutboxRepo_PayloadsMatchSchemas(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	discount, err := domain.NewDiscount(big.NewRat(25, 2), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	unitTier, err := domain.NewUnitPriceTier(10, domain.NewMoney(1799, 100))
+	require.NoError(t, err)
+	percentTier, err := domain.NewPercentOffTier(100, big.NewRat(15, 1))
+	require.NoError(t, err)
+	tiers := []*domain.PriceTier{unitTier, percentTier}
+	eur, err := domain.NewMoneyInCurrency(1849, 100, "EUR")
+	require.NoError(t, err)
+	employee, err := domain.NewSegmentPriceOverride("employee", domain.NewMoney(1499, 100))
+	require.NoError(t, err)
+	wholesale, err := domain.NewSegmentPercentOff("wholesale", big.NewRat(15, 1))
+	require.NoError(t, err)
+	segmentPrices := []*domain.SegmentPrice{employee, wholesale}
+	euPrice, err := domain.NewMarketPrice(domain.MarketEU, eur)
+	require.NoError(t, err)
+	marketPrices := []*domain.MarketPrice{euPrice}
+	sale, err := domain.NewSalePriceDiscount(domain.NewMoney(1499, 100), domain.NewMoney(1999, 100), now, now.Add(time.Hour))
+	require.NoError(t, err)
+	discounts := []*domain.Discount{
+		discount.WithID("discount-1"),
+		discount.WithID("discount-eu").WithMarket(domain.MarketEU),
+		discount.WithID("discount-deep").WithPriority(20).RequireApproval(),
+		discount.WithID("discount-approved").WithPriority(30).Approve("pricing-lead", now),
+		discount.WithID("discount-flash").WithPriority(40).AsFlashSale(),
+		sale.WithID("discount-sale").WithPriority(50),
+	}
+	weight, err := domain.NewWeight(1.5, domain.WeightUnitKilogram)
+	require.NoError(t, err)
+	dimensions, err := domain.NewDimensions(10, 20, 5, domain.LengthUnitInch)
+	require.NoError(t, err)
+	compliance, err := domain.NewCompliance(18, true, []domain.Market{domain.MarketUK})
+	require.NoError(t, err)
+	product := domain.ReconstructProduct("product-123", "Widget", "widget", "", "Tools", "WDG-1", "4006381333931",
+		domain.NewMoney(1999, 100), discounts, tiers, []*domain.Money{eur}, segmentPrices, marketPrices, domain.NewMoney(999, 100), domain.NewMoney(1200, 100), domain.NewPendingPriceChange(domain.NewMoney(1799, 100), now.Add(24*time.Hour)), []string{"oak", "sale"}, map[string]string{"material": "oak"}, weight, dimensions, domain.ProductKindPhysical, compliance, []domain.SalesChannel{domain.SalesChannelMarketplace}, "", "reduced", domain.ProductStatusActive, domain.NewProductReview("alice", "bob", ""), nil, nil, now, now, nil)
+	archivedAt := now.Add(time.Hour)
+	archived := domain.ReconstructProduct("product-123", "Widget", "", "", "Tools", "", "",
+		domain.NewMoney(1999, 100), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", domain.DefaultTaxClass, domain.ProductStatusArchived, nil, nil, nil, now, now, &archivedAt)
+
+	promotion, err := domain.NewBuyXGetY(2, 1)
+	require.NoError(t, err)
+	promotionApplied := domain.NewDiscountAppliedEvent("product-123", "b2g1", big.NewRat(100, 1), 0, now, now.Add(time.Hour), now)
+	promotionApplied.Promotion = promotion
+	marketApplied := domain.NewDiscountAppliedEvent("product-123", "discount-eu", big.NewRat(25, 2), 0, now, now.Add(time.Hour), now)
+	marketApplied.Market = domain.MarketEU
+	pendingApplied := domain.NewDiscountAppliedEvent("product-123", "discount-deep", big.NewRat(60, 1), 0, now, now.Add(time.Hour), now)
+	pendingApplied.PendingApproval = true
+	flashApplied := domain.NewDiscountAppliedEvent("product-123", "discount-flash", big.NewRat(50, 1), 0, now, now.Add(time.Hour), now)
+	flashApplied.FlashSale = true
+	saleApplied := domain.NewDiscountAppliedEvent("product-123", "discount-sale", sale.Percentage(), 50, now, now.Add(time.Hour), now)
+	saleApplied.SalePrice = sale.SalePrice()
+	flashStarted := domain.NewDiscountStartedEvent("product-123", "discount-flash", big.NewRat(50, 1), now, now.Add(time.Hour), now)
+	flashStarted.FlashSale = true
+	flashEnded := domain.NewDiscountEndedEvent("product-123", "discount-flash", now, now)
+	flashEnded.FlashSale = true
+	scheduledPriceChange := domain.NewProductPriceChangedEvent("product-123", domain.NewMoney(1999, 100), domain.NewMoney(1799, 100), now)
+	scheduledPriceChange.EffectiveAt = now.Add(-time.Second)
+
+	activateAt, deactivateAt := now.Add(time.Hour), now.Add(48*time.Hour)
+	events := []domain.DomainEvent{
+		domain.NewProductCreatedEvent("product-123", "Widget", "", "Tools", domain.NewMoney(1999, 100), now),
+		domain.NewProductUpdatedEvent("product-123", "Widget", "A widget", "Tools", now),
+		domain.NewProductPriceChangedEvent("product-123", domain.NewMoney(1999, 100), domain.NewMoney(2499, 100), now),
+		scheduledPriceChange,
+		domain.NewPriceChangeScheduledEvent("product-123", domain.NewMoney(1799, 100), now.Add(24*time.Hour), now),
+		domain.NewPriceChangeCancelledEvent("product-123", now),
+		domain.NewProductActivatedEvent("product-123", now),
+		domain.NewProductDeactivatedEvent("product-123", now),
+		domain.NewProductArchivedEvent("product-123", now),
+		domain.NewDiscountAppliedEvent("product-123", "discount-1", big.NewRat(25, 2), 10, now, now.Add(time.Hour), now),
+		promotionApplied,
+		marketApplied,
+		pendingApplied,
+		flashApplied,
+		saleApplied,
+		domain.NewDiscountApprovedEvent("product-123", "discount-deep", "pricing-lead", now),
+		domain.NewDiscountRemovedEvent("product-123", "discount-1", now),
+		domain.NewDiscountSuspendedEvent("product-123", now),
+		domain.NewDiscountResumedEvent("product-123", now),
+		domain.NewDiscountExpiredEvent("product-123", "discount-1", now),
+		domain.NewDiscountStartedEvent("product-123", "discount-1", big.NewRat(25, 2), now, now.Add(time.Hour), now),
+		domain.NewDiscountEndedEvent("product-123", "discount-1", now, now),
+		flashStarted,
+		flashEnded,
+		domain.NewPriceTiersChangedEvent("product-123", domain.DefaultCurrency, tiers, now),
+		domain.NewPriceBookChangedEvent("product-123", []*domain.Money{eur}, now),
+		domain.NewSegmentPricesChangedEvent("product-123", domain.DefaultCurrency, segmentPrices, now),
+		domain.NewMarketPricesChangedEvent("product-123", marketPrices, now),
+		domain.NewTaxClassChangedEvent("product-123", domain.DefaultTaxClass, "reduced", now),
+		domain.NewMinimumPriceChangedEvent("product-123", domain.NewMoney(999, 100), now),
+		domain.NewMinimumPriceChangedEvent("product-123", nil, now),
+		domain.NewCostPriceChangedEvent("product-123", domain.NewMoney(1200, 100), now),
+		domain.NewCostPriceChangedEvent("product-123", nil, now),
+		domain.NewProductTagsChangedEvent("product-123", []string{"oak", "sale"}, now),
+		domain.NewProductTagsChangedEvent("product-123", nil, now),
+		domain.NewProductAttributeChangedEvent("product-123", "material", "oak", now),
+		domain.NewProductAttributeChangedEvent("product-123", "material", "", now),
+		domain.NewProductSlugChangedEvent("product-123", "widget-2", "widget", now),
+		domain.NewProductSlugChangedEvent("product-123", "widget", "", now),
+		domain.NewProductIdentifiersChangedEvent("product-123", "WDG-1", "4006381333931", now),
+		domain.NewProductIdentifiersChangedEvent("product-123", "", "", now),
+		domain.NewProductDimensionsChangedEvent("product-123", weight, dimensions, now),
+		domain.NewProductDimensionsChangedEvent("product-123", nil, nil, now),
+		domain.NewProductChannelAvailabilityChangedEvent("product-123", []domain.SalesChannel{domain.SalesChannelRetail, domain.SalesChannelWeb}, now),
+		domain.NewProductChannelAvailabilityChangedEvent("product-123", nil, now),
+		domain.NewProductKindChangedEvent("product-123", domain.ProductKindDigital, now),
+		domain.NewProductComplianceChangedEvent("product-123", compliance, now),
+		domain.NewProductComplianceChangedEvent("product-123", nil, now),
+		domain.NewProductStatusScheduledEvent("product-123", &activateAt, &deactivateAt, now),
+		domain.NewProductStatusScheduledEvent("product-123", nil, nil, now),
+		domain.NewProductSubmittedForReviewEvent("product-123", "alice", now),
+		domain.NewProductApprovedEvent("product-123", "bob", now),
+		domain.NewProductRejectedEvent("product-123", "bob", "Missing images", now),
+		domain.NewProductPurgedEvent("product-123", now.Add(-400*24*time.Hour), now),
+	}
+
+	repo := NewOutboxRepo(nil)
+	for _, event := range events {
+		t.Run(event.EventType(), func(t *testing.T) {
+			_, err := repo.InsertDomainEventMut(event)
+			assert.NoError(t, err)
+
+			for _, p := range []*domain.Product{product, archived} {
+				_, err = repo.InsertDomainEventWithSnapshotMut(event, p, now)
+				assert.NoError(t, err, "snapshot of %s product", p.Status())
+			}
+		})
+	}
+}
+
+func TestOutboxRepo_CampaignPayloadsMatchSchemas(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	byCategory, err := domain.NewCampaign("campaign-1", "Summer Sale", "Tools", nil, big.NewRat(20, 1), 10, now, now.Add(time.Hour), now)
+	require.NoError(t, err)
+	byProducts, err := domain.NewCampaign("campaign-2", "Clearance", "", []string{"product-123"}, big.NewRat(20, 1), 10, now, now.Add(time.Hour), now)
+	require.NoError(t, err)
+
+	events := []domain.DomainEvent{
+		domain.NewCampaignCreatedEvent(byCategory, now),
+		domain.NewCampaignCreatedEvent(byProducts, now),
+		domain.NewCampaignActivatedEvent("campaign-1", 120, 3, now),
+		domain.NewCampaignEndedEvent("campaign-1", 120, now),
+	}
+
+	repo := NewOutboxRepo(nil)
+	for _, event := range events {
+		t.Run(event.EventType(), func(t *testing.T) {
+			_, err := repo.InsertDomainEventMut(event)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOutboxRepo_PriceListPayloadsMatchSchemas(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	bounded, err := domain.NewPriceList("price-list-1", "Acme 2024", "EUR", now, now.AddDate(1, 0, 0), now)
+	require.NoError(t, err)
+	open, err := domain.NewPriceList("price-list-2", "Acme", "USD", now, time.Time{}, now)
+	require.NoError(t, err)
+	entry, err := domain.NewPriceListEntry("product-123", domain.NewMoney(1499, 100))
+	require.NoError(t, err)
+
+	events := []domain.DomainEvent{
+		domain.NewPriceListCreatedEvent(bounded, now),
+		domain.NewPriceListCreatedEvent(open, now),
+		domain.NewPriceListEntriesChangedEvent("price-list-2", []*domain.PriceListEntry{entry}, now),
+		domain.NewPriceListEntriesChangedEvent("price-list-2", nil, now),
+	}
+
+	repo := NewOutboxRepo(nil)
+	for _, event := range events {
+		t.Run(event.EventType(), func(t *testing.T) {
+			_, err := repo.InsertDomainEventMut(event)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOutboxRepo_VariantPayloadsMatchSchemas(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := domain.NewProduct("product-123", "Shirt", "", "Apparel", domain.NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	plain, err := domain.NewProductVariant("variant-1", product, "SHIRT-M", nil, domain.NewMoney(0, 1), now)
+	require.NoError(t, err)
+	sized, err := domain.NewProductVariant("variant-2", product, "SHIRT-S-BLUE", map[string]string{"size": "S", "color": "blue"},
+		domain.NewMoney(-250, 100), now)
+	require.NoError(t, err)
+
+	events := []domain.DomainEvent{
+		domain.NewVariantAddedEvent(plain, now),
+		domain.NewVariantAddedEvent(sized, now),
+		domain.NewVariantUpdatedEvent(sized, now),
+		domain.NewVariantDiscontinuedEvent("variant-1", "product-123", "SHIRT-M", now),
+	}
+
+	repo := NewOutboxRepo(nil)
+	for _, event := range events {
+		t.Run(event.EventType(), func(t *testing.T) {
+			_, err := repo.InsertDomainEventMut(event)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOutboxRepo_StockPayloadsMatchSchemas(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	stock := domain.NewStock("product-123")
+	require.NoError(t, stock.Set(0, 0, now))
+	require.NoError(t, stock.Adjust(12, 2, now))
+	require.Len(t, stock.DomainEvents(), 4)
+
+	repo := NewOutboxRepo(nil)
+	for _, event := range stock.DomainEvents() {
+		t.Run(event.EventType(), func(t *testing.T) {
+			_, err := repo.InsertDomainEventMut(event)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOutboxRepo_ImagePayloadsMatchSchemas(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	front, err := domain.NewProductImage("https://cdn.example.com/front.jpg", "Front view", false)
+	require.NoError(t, err)
+	back, err := domain.NewProductImage("https://cdn.example.com/back.jpg", "", false)
+	require.NoError(t, err)
+	images := domain.NewProductImages("product-123")
+	require.NoError(t, images.Set([]*domain.ProductImage{front, back}, now))
+	require.NoError(t, images.Reorder([]string{"https://cdn.example.com/back.jpg", "https://cdn.example.com/front.jpg"}, now))
+	require.NoError(t, images.Set(nil, now))
+	require.Len(t, images.DomainEvents(), 3)
+
+	repo := NewOutboxRepo(nil)
+	for _, event := range images.DomainEvents() {
+		t.Run(event.EventType(), func(t *testing.T) {
+			_, err := repo.InsertDomainEventMut(event)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOutboxRepo_AttachmentPayloadsMatchSchema(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	manual, err := domain.NewProductAttachment(domain.AttachmentTypeManual, "https://docs.example.com/manual.pdf", "de")
+	require.NoError(t, err)
+	sheet, err := domain.NewProductAttachment(domain.AttachmentTypeSpecSheet, "https://docs.example.com/sheet.pdf", "")
+	require.NoError(t, err)
+	attachments := domain.NewProductAttachments("product-123")
+	require.NoError(t, attachments.Set([]*domain.ProductAttachment{manual, sheet}, now))
+	require.NoError(t, attachments.Set(nil, now))
+	require.Len(t, attachments.DomainEvents(), 2)
+
+	repo := NewOutboxRepo(nil)
+	for _, event := range attachments.DomainEvents() {
+		t.Run(event.EventType(), func(t *testing.T) {
+			_, err := repo.InsertDomainEventMut(event)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOutboxRepo_RelationPayloadsMatchSchemas(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	relation, err := domain.NewProductRelation("product-123", "product-456", domain.RelationAccessory, now)
+	require.NoError(t, err)
+
+	repo := NewOutboxRepo(nil)
+	for _, event := range []domain.DomainEvent{
+		domain.NewProductLinkedEvent(relation, now),
+		domain.NewProductUnlinkedEvent("product-123", "product-456", domain.RelationAccessory, now),
+	} {
+		t.Run(event.EventType(), func(t *testing.T) {
+			_, err := repo.InsertDomainEventMut(event)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOutboxRepo_TranslationPayloadsMatchSchema(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	translation, err := domain.NewProductTranslation("product-123", "de", "Stuhl", "", now)
+	require.NoError(t, err)
+
+	repo := NewOutboxRepo(nil)
+	for name, event := range map[string]domain.DomainEvent{
+		"set":     domain.NewProductTranslationChangedEvent("product-123", "de", translation, now),
+		"removed": domain.NewProductTranslationChangedEvent("product-123", "de", nil, now),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := repo.InsertDomainEventMut(event)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOutboxRepo_InsertNotificationMut(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	discount, err := domain.NewDiscount(big.NewRat(25, 2), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	discounted := domain.ReconstructProduct("product-123", "Widget", "", "", "Tools", "", "",
+		domain.NewMoney(1999, 100), []*domain.Discount{discount.WithID("discount-1")}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", domain.DefaultTaxClass, domain.ProductStatusActive, nil, nil, nil, now, now, nil)
+	plain := domain.ReconstructProduct("product-123", "Widget", "", "", "Tools", "", "",
+		domain.NewMoney(1999, 100), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", domain.DefaultTaxClass, domain.ProductStatusActive, nil, nil, nil, now, now, nil)
+
+	tests := []struct {
+		name    string
+		kind    domain.NotificationKind
+		event   domain.DomainEvent
+		product *domain.Product
+	}{
+		{"discount applied", domain.NotificationKindDiscounted, domain.NewDiscountAppliedEvent("product-123", "discount-1", big.NewRat(25, 2), 0, now.Add(-time.Hour), now.Add(time.Hour), now), discounted},
+		{"discount started", domain.NotificationKindDiscounted, domain.NewDiscountStartedEvent("product-123", "discount-1", big.NewRat(25, 2), now.Add(-time.Hour), now.Add(time.Hour), now), discounted},
+		{"discount resumed", domain.NotificationKindDiscounted, domain.NewDiscountResumedEvent("product-123", now), discounted},
+		{"activated", domain.NotificationKindBackInStock, domain.NewProductActivatedEvent("product-123", now), plain},
+		{"activated with discount", domain.NotificationKindBackInStock, domain.NewProductActivatedEvent("product-123", now), discounted},
+		{"back in stock", domain.NotificationKindBackInStock, domain.NewBackInStockEvent("product-123", 5, now), plain},
+	}
+
+	repo := NewOutboxRepo(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mut, err := repo.InsertNotificationMut(tt.kind, tt.event, tt.product, []string{"customer-1", "customer-2"})
+			require.NoError(t, err)
+			assert.NotNil(t, mut)
+		})
+	}
+
+	t.Run("no subscribers", func(t *testing.T) {
+		_, err := repo.InsertNotificationMut(domain.NotificationKindBackInStock, domain.NewProductActivatedEvent("product-123", now), plain, nil)
+		assert.ErrorIs(t, err, eventschema.ErrInvalidPayload)
+	})
+}
+
+func TestOutboxRepo_InsertExposureMut(t *testing.T) {
+	exposure := contract.Exposure{
+		ExperimentID:        "cheaper-widgets",
+		Variant:             "cheaper",
+		SubjectID:           "customer-1",
+		ProductID:           "product-123",
+		Surface:             "get_product",
+		EffectivePriceNum:   1799,
+		EffectivePriceDenom: 100,
+		Currency:            "USD",
+		At:                  time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	repo := NewOutboxRepo(nil)
+	mut, err := repo.InsertExposureMut(exposure)
+	require.NoError(t, err)
+	assert.NotNil(t, mut)
+
+	exposure.Surface = "list_products"
+	_, err = repo.InsertExposureMut(exposure)
+	assert.ErrorIs(t, err, eventschema.ErrInvalidPayload)
+}
+
+func TestOutboxRepo_InsertDigestMut(t *testing.T) {
+	day := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	digest := &contract.Digest{
+		Day:         day,
+		GeneratedAt: day.Add(24*time.Hour + 5*time.Minute),
+		NewProducts: []*contract.DigestProductDTO{{
+			ProductID: "product-1", Name: "Widget", Category: "Tools", Status: "draft",
+			BasePriceNum: 1999, BasePriceDenom: 100, Currency: "USD", CreatedAt: day.Add(time.Hour),
+		}},
+		NewProductCount: 1,
+		PriceDrops: []*contract.PriceDrop{{
+			PriceMoveDTO: contract.PriceMoveDTO{
+				ProductID: "product-2", Name: "Gadget", Category: "Tools", Currency: "EUR",
+				OldPriceNum: 50, OldPriceDenom: 1, NewPriceNum: 35, NewPriceDenom: 1,
+			},
+			DropPercent: 30,
+		}},
+		PriceDropCount: 1,
+		ExpiringDiscounts: []*contract.ExpiringDiscountDTO{
+			{ProductID: "product-3", Name: "Gizmo", DiscountID: "d-1", Percentage: 10, EndDate: day.Add(30 * time.Hour)},
+			{ProductID: "product-3", Name: "Gizmo", DiscountID: "d-2", Percentage: 15, EndDate: day.Add(40 * time.Hour), Market: "EU"},
+		},
+		ExpiringDiscountCount: 2,
+	}
+
+	repo := NewOutboxRepo(nil)
+	mut, err := repo.InsertDigestMut(digest)
+	require.NoError(t, err)
+	assert.NotNil(t, mut)
+
+	// The event ID depends on the day only
+	assert.Equal(t, digestEventID("2024-06-01"), digestEventID("2024-06-01"))
+	assert.NotEqual(t, digestEventID("2024-06-01"), digestEventID("2024-06-02"))
+
+	digest.ExpiringDiscounts[1].Market = "FR"
+	_, err = repo.InsertDigestMut(digest)
+	assert.ErrorIs(t, err, eventschema.ErrInvalidPayload)
+}
+
+func TestOutboxRepo_InsertMutRejectsInvalidPayload(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := NewOutboxRepo(nil)
+
+	tests := []struct {
+		name    string
+		event   *contract.OutboxEvent
+		wantErr error
+	}{
+		{
+			name:    "created event without base price",
+			event:   createdEvent(domain.NewProductCreatedEvent("product-123", "Widget", "", "Tools", nil, now)),
+			wantErr: eventschema.ErrInvalidPayload,
+		},
+		{
+			name: "unknown event type",
+			event: &contract.OutboxEvent{
+				EventID:     "event-1",
+				EventType:   "product.renamed",
+				AggregateID: "product-123",
+				Payload:     map[string]interface{}{},
+			},
+			wantErr: eventschema.ErrUnknownEventType,
+		},
+		{
+			name: "unserializable payload",
+			event: &contract.OutboxEvent{
+				EventID:     "event-1",
+				EventType:   "product.activated",
+				AggregateID: "product-123",
+				Payload:     map[string]interface{}{"occurred_at": make(chan int)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mut, err := repo.InsertMut(tt.event)
+
+			require.Error(t, err)
+			assert.Nil(t, mut)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func createdEvent(event domain.ProductCreatedEvent) *contract.OutboxEvent {
+	return &contract.OutboxEvent{
+		EventID:     "event-1",
+		EventType:   event.EventType(),
+		AggregateID: event.AggregateID(),
+		Payload:     NewOutboxRepo(nil).domainEventToPayload(event),
+	}
+}
+
+func TestOutboxRepo_PriceChangedPayload(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	event := domain.NewProductPriceChangedEvent("product-123", domain.NewMoney(1999, 100), domain.NewMoney(2499, 100), now)
+
+	payload := NewOutboxRepo(nil).domainEventToPayload(event)
+
+	assert.Equal(t, "product.price_changed", payload["event_type"])
+	assert.Equal(t, int64(1999), payload["old_price_numerator"])
+	assert.Equal(t, int64(100), payload["old_price_denominator"])
+	assert.Equal(t, int64(2499), payload["new_price_numerator"])
+	assert.Equal(t, int64(100), payload["new_price_denominator"])
+	assert.Equal(t, "USD", payload["currency"])
+	assert.Nil(t, payload["effective_at"])
+
+	midnight := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+	event.EffectiveAt = midnight
+	payload = NewOutboxRepo(nil).domainEventToPayload(event)
+	assert.Equal(t, midnight, payload["effective_at"])
+}
+
+func TestOutboxRepo_UpdateStatusMut(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.NotNil(t, NewOutboxRepo(nil).UpdateStatusMut("event-123", StatusProcessed, now))
+}
+
+func TestDataToStoredEvent(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		data            *OutboxEventData
+		expectedPayload string
+		expectProcessed bool
+	}{
+		{
+			name: "pending event with payload",
+			data: &OutboxEventData{
+				EventID:     "event-1",
+				EventType:   "product.created",
+				AggregateID: "product-123",
+				Payload:     spanner.NullJSON{Value: map[string]interface{}{"name": "Widget"}, Valid: true},
+				Status:      StatusPending,
+				CreatedAt:   now,
+				TenantID:    spanner.NullString{StringVal: "acme", Valid: true},
+			},
+			expectedPayload: `{"name":"Widget"}`,
+		},
+		{
+			name: "processed event without payload",
+			data: &OutboxEventData{
+				EventID:     "event-2",
+				EventType:   "product.archived",
+				AggregateID: "product-123",
+				Status:      StatusProcessed,
+				CreatedAt:   now,
+				ProcessedAt: spanner.NullTime{Time: now.Add(time.Second), Valid: true},
+			},
+			expectedPayload: `{}`,
+			expectProcessed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := dataToStoredEvent(tt.data)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.data.EventID, event.EventID)
+			assert.Equal(t, tt.data.EventType, event.EventType)
+			assert.Equal(t, tt.data.AggregateID, event.AggregateID)
+			assert.Equal(t, tt.data.TenantID.StringVal, event.TenantID)
+			assert.Equal(t, tt.data.Status, event.Status)
+			assert.Equal(t, now, event.CreatedAt)
+			assert.JSONEq(t, tt.expectedPayload, string(event.Payload))
+			if tt.expectProcessed {
+				require.NotNil(t, event.ProcessedAt)
+				assert.Equal(t, tt.data.ProcessedAt.Time, *event.ProcessedAt)
+			} else {
+				assert.Nil(t, event.ProcessedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+	"google.golang.org/api/iterator"
+)
+
+// readPriceBooks reads the product_prices rows of the given products, keyed by product ID.
+func readPriceBooks(ctx context.Context, reader rowReader, productIDs []string) (map[string][]*PriceData, error) {
+	prices := make(map[string][]*PriceData)
+	if len(productIDs) == 0 {
+		return prices, nil
+	}
+
+	keys := make([]spanner.KeySet, len(productIDs))
+	for i, id := range productIDs {
+		keys[i] = spanner.Key{id}.AsPrefix()
+	}
+
+	iter := reader.Read(ctx, PricesTable, spanner.KeySets(keys...), PriceAllColumns())
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return prices, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := PriceDataFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		prices[data.ProductID] = append(prices[data.ProductID], data)
+	}
+}
+
+// productPriceBook converts the product_prices rows of a product to domain money, skipping
+// rows that do not hold a valid price.
+func productPriceBook(rows []*PriceData) []*domain.Money {
+	prices := make([]*domain.Money, 0, len(rows))
+	for _, row := range rows {
+		price, err := domain.NewMoneyInCurrency(row.PriceNumerator, row.PriceDenominator, row.Currency)
+		if err != nil || !price.IsPositive() {
+			logging.Warnf("repository: product %s: skipping invalid %s price: %v", row.ProductID, row.Currency, err)
+			continue
+		}
+		prices = append(prices, price)
+	}
+	domain.SortPrices(prices)
+	return prices
+}
+
+// priceToData converts a price book entry of a product to a database model.
+func priceToData(productID string, price *domain.Money) *PriceData {
+	return &PriceData{
+		ProductID:        productID,
+		Currency:         price.Currency(),
+		PriceNumerator:   price.Numerator(),
+		PriceDenominator: price.Denominator(),
+	}
+}
+
+// priceBookDTOs converts price book entries to their read representation.
+func priceBookDTOs(prices []*domain.Money) []contract.PriceBookEntryDTO {
+	if len(prices) == 0 {
+		return nil
+	}
+	dtos := make([]contract.PriceBookEntryDTO, len(prices))
+	for i, p := range prices {
+		dtos[i] = contract.PriceBookEntryDTO{
+			Currency:   p.Currency(),
+			PriceNum:   p.Numerator(),
+			PriceDenom: p.Denominator(),
+		}
+	}
+	return dtos
+}
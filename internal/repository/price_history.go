@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// priceHistoryToData records the prices of a product at the given time, after change.
+func priceHistoryToData(product *domain.Product, change domain.DomainEvent, at time.Time) *PriceHistoryData {
+	basePrice := product.BasePrice()
+	effectivePrice := product.EffectivePrice(at)
+	data := &PriceHistoryData{
+		ProductID:           product.ID(),
+		ChangedAt:           at,
+		ChangeID:            uuid.New().String(),
+		ChangeType:          change.EventType(),
+		BasePriceNum:        basePrice.Numerator(),
+		BasePriceDenom:      basePrice.Denominator(),
+		EffectivePriceNum:   effectivePrice.Numerator(),
+		EffectivePriceDenom: effectivePrice.Denominator(),
+		Currency:            product.Currency(),
+	}
+	if discount := product.ApplicableDiscount(at); discount != nil {
+		data.DiscountID = spanner.NullString{StringVal: discount.ID(), Valid: true}
+		data.DiscountPercent = percentToNumeric(discount.Percentage())
+	}
+	return data
+}
+
+// priceHistoryKeys returns the price history rows of a product changed in [from, to).
+// A zero from or to leaves that end of the range open.
+func priceHistoryKeys(productID string, from, to time.Time) spanner.KeyRange {
+	keys := spanner.KeyRange{Start: spanner.Key{productID}, End: spanner.Key{productID}, Kind: spanner.ClosedClosed}
+	if !from.IsZero() {
+		keys.Start = spanner.Key{productID, from}
+	}
+	if !to.IsZero() {
+		keys.End = spanner.Key{productID, to}
+		keys.Kind = spanner.ClosedOpen
+	}
+	return keys
+}
+
+// readPriceHistory reads the price history rows of a product in key order, i.e. by the
+// time of the change.
+func readPriceHistory(ctx context.Context, reader rowReader, keys spanner.KeyRange) ([]*PriceHistoryData, error) {
+	iter := reader.Read(ctx, PriceHistoryTable, keys, PriceHistoryAllColumns())
+	defer iter.Stop()
+
+	var rows []*PriceHistoryData
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := PriceHistoryDataFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, data)
+	}
+}
+
+// priceHistoryDTO converts a price history row to its read representation.
+func priceHistoryDTO(data *PriceHistoryData) *contract.PriceHistoryEntryDTO {
+	dto := &contract.PriceHistoryEntryDTO{
+		ChangedAt:           data.ChangedAt,
+		ChangeID:            data.ChangeID,
+		ChangeType:          data.ChangeType,
+		BasePriceNum:        data.BasePriceNum,
+		BasePriceDenom:      data.BasePriceDenom,
+		EffectivePriceNum:   data.EffectivePriceNum,
+		EffectivePriceDenom: data.EffectivePriceDenom,
+		Currency:            data.Currency,
+	}
+	if data.DiscountID.Valid {
+		dto.DiscountID = data.DiscountID.StringVal
+	}
+	if data.DiscountPercent.Valid {
+		pct, _ := data.DiscountPercent.Numeric.Float64()
+		dto.DiscountPercent = &pct
+	}
+	return dto
+}
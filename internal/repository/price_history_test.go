@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductRepo_PriceHistoryMut(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+	basePrice, err := domain.NewMoneyInCurrency(2000, 100, "EUR")
+	require.NoError(t, err)
+	newProduct := func() *domain.Product {
+		return domain.ReconstructProduct(
+			"product-123", "Widget", "", "", "Tools", "", "",
+			basePrice, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", domain.DefaultTaxClass, domain.ProductStatusActive, nil, nil, nil, now, now, nil,
+		)
+	}
+
+	product := newProduct()
+	require.NoError(t, product.SetTaxClass("reduced", now))
+	assert.Nil(t, repo.PriceHistoryMut(product, now), "tax class changes are not price changes")
+
+	product = newProduct()
+	discount, err := domain.NewDiscount(big.NewRat(25, 1), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, product.ApplyDiscount(discount.WithID("discount-1"), now))
+	assert.NotNil(t, repo.PriceHistoryMut(product, now))
+
+	data := priceHistoryToData(product, domain.PriceChange(product.DomainEvents()), now)
+	assert.Equal(t, "product-123", data.ProductID)
+	assert.Equal(t, now, data.ChangedAt)
+	assert.NotEmpty(t, data.ChangeID)
+	assert.Equal(t, "product.discount_applied", data.ChangeType)
+	assert.Equal(t, int64(20), data.BasePriceNum)
+	assert.Equal(t, int64(1), data.BasePriceDenom)
+	assert.Equal(t, int64(15), data.EffectivePriceNum)
+	assert.Equal(t, int64(1), data.EffectivePriceDenom)
+	assert.Equal(t, "EUR", data.Currency)
+	assert.Equal(t, spanner.NullString{StringVal: "discount-1", Valid: true}, data.DiscountID)
+
+	dto := priceHistoryDTO(data)
+	assert.Equal(t, "discount-1", dto.DiscountID)
+	require.NotNil(t, dto.DiscountPercent)
+	assert.Equal(t, 25.0, *dto.DiscountPercent)
+	assert.Equal(t, "product.discount_applied", dto.ChangeType)
+}
+
+func TestPriceHistoryDTO_NoDiscount(t *testing.T) {
+	dto := priceHistoryDTO(&PriceHistoryData{
+		ProductID:           "product-123",
+		ChangeType:          "product.price_changed",
+		BasePriceNum:        1999,
+		BasePriceDenom:      100,
+		EffectivePriceNum:   1999,
+		EffectivePriceDenom: 100,
+		Currency:            "USD",
+	})
+	assert.Empty(t, dto.DiscountID)
+	assert.Nil(t, dto.DiscountPercent)
+	assert.Equal(t, int64(1999), dto.EffectivePriceNum)
+}
+
+func TestPriceHistoryKeys(t *testing.T) {
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		want     spanner.KeyRange
+	}{
+		{"whole history", time.Time{}, time.Time{},
+			spanner.KeyRange{Start: spanner.Key{"p"}, End: spanner.Key{"p"}, Kind: spanner.ClosedClosed}},
+		{"from", from, time.Time{},
+			spanner.KeyRange{Start: spanner.Key{"p", from}, End: spanner.Key{"p"}, Kind: spanner.ClosedClosed}},
+		{"to", time.Time{}, to,
+			spanner.KeyRange{Start: spanner.Key{"p"}, End: spanner.Key{"p", to}, Kind: spanner.ClosedOpen}},
+		{"from and to", from, to,
+			spanner.KeyRange{Start: spanner.Key{"p", from}, End: spanner.Key{"p", to}, Kind: spanner.ClosedOpen}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, priceHistoryKeys("p", tt.from, tt.to))
+		})
+	}
+}
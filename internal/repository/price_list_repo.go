@@ -0,0 +1,255 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// PriceListRepo implements the PriceListRepository and PriceListReader interfaces using
+// Spanner.
+type PriceListRepo struct {
+	client *spanner.Client
+}
+
+var (
+	_ contract.PriceListRepository = (*PriceListRepo)(nil)
+	_ contract.PriceListReader     = (*PriceListRepo)(nil)
+)
+
+// NewPriceListRepo creates a new PriceListRepo.
+func NewPriceListRepo(client *spanner.Client) *PriceListRepo {
+	return &PriceListRepo{client: client}
+}
+
+// FindByID retrieves a price list with its entries by its ID. Price lists of another
+// tenant than that of the caller are not found; see tenantScope.
+func (r *PriceListRepo) FindByID(ctx context.Context, id string) (*domain.PriceList, error) {
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	list, err := readPriceList(ctx, txn, id)
+	if err != nil {
+		return nil, err
+	}
+
+	iter := txn.Read(ctx, PriceListEntriesTable, spanner.Key{id}.AsPrefix(), priceListEntryColumns())
+	defer iter.Stop()
+
+	var entries []*domain.PriceListEntry
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		productID, price, err := priceListEntryFromRow(row, list.Currency())
+		if err != nil {
+			return nil, err
+		}
+		entry, err := domain.NewPriceListEntry(productID, price)
+		if err != nil {
+			logging.Warnf("repository: price list %s: skipping invalid entry of product %s: %v", id, productID, err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return domain.ReconstructPriceList(list.ID(), list.TenantID(), list.Name(), list.Currency(), list.ValidFrom(),
+		list.ValidUntil(), entries, list.CreatedAt(), list.UpdatedAt()), nil
+}
+
+// InsertMut returns a mutation for inserting a new price list without entries.
+func (r *PriceListRepo) InsertMut(list *domain.PriceList) *spanner.Mutation {
+	return spanner.InsertMap(PriceListsTable, map[string]interface{}{
+		PriceListID:         list.ID(),
+		PriceListTenantID:   optionalStringColumn(list.TenantID()),
+		PriceListName:       list.Name(),
+		PriceListCurrency:   list.Currency(),
+		PriceListValidFrom:  list.ValidFrom(),
+		PriceListValidUntil: spanner.NullTime{Time: list.ValidUntil(), Valid: !list.ValidUntil().IsZero()},
+		PriceListCreatedAt:  list.CreatedAt(),
+		PriceListUpdatedAt:  list.UpdatedAt(),
+	})
+}
+
+// EntryMuts returns the mutations that replace the stored entries of a price list with
+// its current ones, and persist its update time.
+func (r *PriceListRepo) EntryMuts(list *domain.PriceList) []*spanner.Mutation {
+	entries := list.Entries()
+	muts := make([]*spanner.Mutation, 0, len(entries)+2)
+	muts = append(muts,
+		spanner.UpdateMap(PriceListsTable, map[string]interface{}{
+			PriceListID:        list.ID(),
+			PriceListUpdatedAt: list.UpdatedAt(),
+		}),
+		spanner.Delete(PriceListEntriesTable, spanner.Key{list.ID()}.AsPrefix()),
+	)
+	for _, e := range entries {
+		muts = append(muts, spanner.InsertMap(PriceListEntriesTable, map[string]interface{}{
+			PriceListEntryPriceListID:      list.ID(),
+			PriceListEntryProductID:        e.ProductID(),
+			PriceListEntryPriceNumerator:   e.Price().Numerator(),
+			PriceListEntryPriceDenominator: e.Price().Denominator(),
+		}))
+	}
+	return muts
+}
+
+// ProductEntryDeleteCheck returns a check that reads the entries of a product in the
+// commit transaction and deletes them from every price list. Entries are keyed by price
+// list, so all of them are scanned; an entry added concurrently is either read and
+// deleted or conflicts with the scan, so none outlives the product.
+func (r *PriceListRepo) ProductEntryDeleteCheck(productID string) committer.Check {
+	return func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		iter := txn.Query(ctx, spanner.Statement{
+			SQL: `SELECT ` + PriceListEntryPriceListID + ` FROM ` + PriceListEntriesTable +
+				` WHERE ` + PriceListEntryProductID + ` = @product_id`,
+			Params: map[string]interface{}{"product_id": productID},
+		})
+		defer iter.Stop()
+
+		var muts []*spanner.Mutation
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				return txn.BufferWrite(muts)
+			}
+			if err != nil {
+				return err
+			}
+			var priceListID string
+			if err := row.Columns(&priceListID); err != nil {
+				return err
+			}
+			muts = append(muts, spanner.Delete(PriceListEntriesTable, spanner.Key{priceListID, productID}))
+		}
+	}
+}
+
+// GetPriceListEntry returns the price list with the given ID and its entry for the
+// product, if any. Like FindByID, it does not find price lists of other tenants.
+func (r *PriceListRepo) GetPriceListEntry(ctx context.Context, priceListID, productID string) (*contract.PriceListEntryDTO, error) {
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	list, err := readPriceList(ctx, txn, priceListID)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &contract.PriceListEntryDTO{
+		PriceListID: list.ID(),
+		Currency:    list.Currency(),
+		ValidFrom:   list.ValidFrom(),
+	}
+	if until := list.ValidUntil(); !until.IsZero() {
+		dto.ValidUntil = &until
+	}
+
+	row, err := txn.ReadRow(ctx, PriceListEntriesTable, spanner.Key{priceListID, productID}, priceListEntryColumns())
+	if spanner.ErrCode(err) == codes.NotFound {
+		return dto, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	_, price, err := priceListEntryFromRow(row, list.Currency())
+	if err != nil {
+		return nil, err
+	}
+	dto.HasEntry = true
+	dto.PriceNum = price.Numerator()
+	dto.PriceDenom = price.Denominator()
+	return dto, nil
+}
+
+// readPriceList reads a price list of the tenant of ctx without its entries.
+func readPriceList(ctx context.Context, txn *spanner.ReadOnlyTransaction, id string) (*domain.PriceList, error) {
+	row, err := txn.ReadRow(ctx, PriceListsTable, spanner.Key{id}, priceListColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrPriceListNotFound
+		}
+		return nil, err
+	}
+	list, err := priceListFromRow(row)
+	if err != nil {
+		return nil, err
+	}
+	if !tenantScopeOf(ctx).visible(optionalStringColumn(list.TenantID())) {
+		return nil, domain.ErrPriceListNotFound
+	}
+	return list, nil
+}
+
+// priceListColumns returns the columns of a price_lists row, in the order
+// priceListFromRow expects them.
+func priceListColumns() []string {
+	return []string{
+		PriceListID,
+		PriceListTenantID,
+		PriceListName,
+		PriceListCurrency,
+		PriceListValidFrom,
+		PriceListValidUntil,
+		PriceListCreatedAt,
+		PriceListUpdatedAt,
+	}
+}
+
+// priceListFromRow reads a row of the columns returned by priceListColumns into a price
+// list without entries.
+func priceListFromRow(row *spanner.Row) (*domain.PriceList, error) {
+	var (
+		id, name, currency   string
+		tenantID             spanner.NullString
+		validFrom            time.Time
+		validUntil           spanner.NullTime
+		createdAt, updatedAt time.Time
+	)
+	if err := row.Columns(&id, &tenantID, &name, &currency, &validFrom, &validUntil, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	var until time.Time
+	if validUntil.Valid {
+		until = validUntil.Time
+	}
+	return domain.ReconstructPriceList(id, tenantID.StringVal, name, currency, validFrom, until, nil, createdAt, updatedAt), nil
+}
+
+// priceListEntryColumns returns the columns of a price_list_entries row, in the order
+// priceListEntryFromRow expects them.
+func priceListEntryColumns() []string {
+	return []string{
+		PriceListEntryProductID,
+		PriceListEntryPriceNumerator,
+		PriceListEntryPriceDenominator,
+	}
+}
+
+// priceListEntryFromRow reads a row of the columns returned by priceListEntryColumns; the
+// price is in the currency of the list.
+func priceListEntryFromRow(row *spanner.Row, currency string) (string, *domain.Money, error) {
+	var (
+		productID              string
+		numerator, denominator int64
+	)
+	if err := row.Columns(&productID, &numerator, &denominator); err != nil {
+		return "", nil, err
+	}
+	price, err := domain.NewMoneyInCurrency(numerator, denominator, currency)
+	if err != nil {
+		return "", nil, err
+	}
+	return productID, price, nil
+}
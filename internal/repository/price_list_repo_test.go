@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceListRepo_EntryMuts(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &PriceListRepo{}
+
+	list, err := domain.NewPriceList("price-list-1", "Acme", "USD", now, time.Time{}, now)
+	require.NoError(t, err)
+	assert.NotNil(t, repo.InsertMut(list))
+
+	// Without entries the stored ones are only deleted
+	assert.Len(t, repo.EntryMuts(list), 2)
+
+	widget, err := domain.NewPriceListEntry("product-1", domain.NewMoney(1499, 100))
+	require.NoError(t, err)
+	gadget, err := domain.NewPriceListEntry("product-2", domain.NewMoney(999, 100))
+	require.NoError(t, err)
+	require.NoError(t, list.SetEntries([]*domain.PriceListEntry{widget, gadget}, now))
+
+	assert.Len(t, repo.EntryMuts(list), 4)
+}
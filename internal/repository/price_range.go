@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"strings"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// applicableDiscountSQL selects the discount that applies to the base price of a row of
+// products at @price_at, as domain.ApplicableDiscount picks it: of the percentage
+// discounts valid then that are not scoped to a market, suspended or pending approval,
+// the one with the highest priority, then the latest start, then the highest ID. The
+// discount columns of the row itself count as a discount of priority 0 with the product
+// ID as its ID. It selects no row if none applies.
+const applicableDiscountSQL = `SELECT percentage, sale_num, sale_den FROM (
+		SELECT d.` + DiscountPercentage + ` AS percentage, d.` + DiscountSalePriceNum + ` AS sale_num,
+			d.` + DiscountSalePriceDenom + ` AS sale_den, d.` + DiscountPriority + ` AS priority,
+			d.` + DiscountStartDate + ` AS start_date, d.` + DiscountID + ` AS discount_id
+		FROM ` + DiscountsTable + ` d
+		WHERE d.` + DiscountProductID + ` = products.product_id
+			AND IFNULL(d.` + DiscountKind + `, 'percentage') = 'percentage'
+			AND d.` + DiscountMarket + ` IS NULL AND d.` + DiscountSuspendedAt + ` IS NULL
+			AND NOT IFNULL(d.` + DiscountPendingApproval + `, FALSE)
+			AND d.` + DiscountStartDate + ` <= @price_at AND d.` + DiscountEndDate + ` > @price_at
+		UNION ALL
+		SELECT products.` + ProductDiscountPercent + `, CAST(NULL AS INT64), CAST(NULL AS INT64), 0,
+			products.` + ProductDiscountStartDate + `, products.product_id
+		FROM UNNEST([1])
+		WHERE products.` + ProductDiscountPercent + ` IS NOT NULL
+			AND products.` + ProductDiscountSuspendedAt + ` IS NULL
+			AND products.` + ProductDiscountStartDate + ` <= @price_at
+			AND products.` + ProductDiscountEndDate + ` > @price_at
+	)
+	ORDER BY priority DESC, start_date DESC, discount_id DESC
+	LIMIT 1`
+
+// effectivePriceSQL selects the effective price of a row of products at @price_at as the
+// NUMERIC fraction price_num / price_den, priced as domain.Discount.ApplyTo prices it: the
+// sale price of the applicable discount if it is below the base price, else the base
+// price less its percentage. The fraction is not reduced; bounds are compared with it by
+// cross-multiplication, so the comparison is exact.
+const effectivePriceSQL = `SELECT
+		CASE
+			WHEN d.sale_num IS NOT NULL AND d.sale_num * products.` + ProductBasePriceDenom + ` < products.` + ProductBasePriceNum + ` * d.sale_den
+				THEN CAST(d.sale_num AS NUMERIC)
+			WHEN d.sale_num IS NULL AND d.percentage IS NOT NULL
+				THEN products.` + ProductBasePriceNum + ` * (100 - d.percentage)
+			ELSE CAST(products.` + ProductBasePriceNum + ` AS NUMERIC)
+		END AS price_num,
+		CASE
+			WHEN d.sale_num IS NOT NULL AND d.sale_num * products.` + ProductBasePriceDenom + ` < products.` + ProductBasePriceNum + ` * d.sale_den
+				THEN CAST(d.sale_den AS NUMERIC)
+			WHEN d.sale_num IS NULL AND d.percentage IS NOT NULL
+				THEN CAST(products.` + ProductBasePriceDenom + ` * 100 AS NUMERIC)
+			ELSE CAST(products.` + ProductBasePriceDenom + ` AS NUMERIC)
+		END AS price_den
+	FROM UNNEST([1]) LEFT JOIN (` + applicableDiscountSQL + `) d ON TRUE`
+
+// priceRangeCondition returns the SQL condition listing only the products whose effective
+// price at is within the bounds of filter, adding its parameters to params; "" if filter
+// bounds no price.
+func priceRangeCondition(filter contract.ListProductsFilter, at time.Time, params map[string]interface{}) string {
+	bound := filter.MinPrice
+	if bound == nil {
+		bound = filter.MaxPrice
+	}
+	if bound == nil {
+		return ""
+	}
+
+	var conditions []string
+	if b := filter.MinPrice; b != nil {
+		conditions = append(conditions, `p.price_num * @min_price_den >= @min_price_num * p.price_den`)
+		params["min_price_num"], params["min_price_den"] = b.Numerator, b.Denominator
+	}
+	if b := filter.MaxPrice; b != nil {
+		conditions = append(conditions, `p.price_num * @max_price_den <= @max_price_num * p.price_den`)
+		params["max_price_num"], params["max_price_den"] = b.Numerator, b.Denominator
+	}
+	params["price_at"] = at
+	params["price_currency"] = bound.Currency
+
+	return ` AND IFNULL(` + ProductCurrency + `, '` + domain.DefaultCurrency + `') = @price_currency` +
+		` AND EXISTS (SELECT 1 FROM (` + effectivePriceSQL + `) p WHERE ` + strings.Join(conditions, ` AND `) + `)`
+}
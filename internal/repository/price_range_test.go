@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildListQuery_PriceRange(t *testing.T) {
+	rm := &ProductReadModel{}
+	at := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	stmt, err := rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{}, contract.Pagination{PageSize: 10}, at)
+	require.NoError(t, err)
+	assert.NotContains(t, stmt.SQL, "@price_at", "without bounds, prices are not computed")
+
+	stmt, err = rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{
+		MinPrice: &contract.PriceBound{Numerator: 10, Denominator: 1, Currency: "EUR"},
+		MaxPrice: &contract.PriceBound{Numerator: 4999, Denominator: 100, Currency: "EUR"},
+	}, contract.Pagination{PageSize: 10}, at)
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "AND IFNULL(currency, 'USD') = @price_currency")
+	assert.Contains(t, stmt.SQL, "p.price_num * @min_price_den >= @min_price_num * p.price_den AND "+
+		"p.price_num * @max_price_den <= @max_price_num * p.price_den)")
+	assert.Contains(t, stmt.SQL, "ORDER BY priority DESC, start_date DESC, discount_id DESC")
+	assert.Equal(t, "EUR", stmt.Params["price_currency"])
+	assert.Equal(t, at, stmt.Params["price_at"])
+	assert.Equal(t, int64(10), stmt.Params["min_price_num"])
+	assert.Equal(t, int64(4999), stmt.Params["max_price_num"])
+	assert.Equal(t, int64(100), stmt.Params["max_price_den"])
+
+	stmt, err = rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{
+		MaxPrice: &contract.PriceBound{Numerator: 20, Denominator: 1, Currency: "USD"},
+	}, contract.Pagination{PageSize: 10}, at)
+	require.NoError(t, err)
+	assert.NotContains(t, stmt.SQL, "@min_price_num")
+	assert.NotContains(t, stmt.Params, "min_price_num")
+	assert.Equal(t, "USD", stmt.Params["price_currency"])
+}
+
+func TestBuildListQuery_HasActiveDiscount(t *testing.T) {
+	rm := &ProductReadModel{}
+	at := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	stmt, err := rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{HasActiveDiscount: true}, contract.Pagination{PageSize: 10}, at)
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "AND EXISTS (SELECT percentage, sale_num, sale_den FROM")
+	assert.Contains(t, stmt.SQL, "d.start_date <= @price_at AND d.end_date > @price_at")
+	assert.Contains(t, stmt.SQL, "products.discount_end_date > @price_at")
+	assert.NotContains(t, stmt.SQL, "@price_currency", "without bounds, prices are not compared")
+	assert.Equal(t, at, stmt.Params["price_at"])
+}
+
+func TestBuildCountQuery(t *testing.T) {
+	rm := &ProductReadModel{}
+	at := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	filter := contract.ListProductsFilter{
+		Category: "Tools",
+		MinPrice: &contract.PriceBound{Numerator: 10, Denominator: 1, Currency: "EUR"},
+	}
+
+	list, err := rm.buildListQuery(tenantScope{}, filter, contract.Pagination{PageSize: 10, PageToken: "product-9"}, at)
+	require.NoError(t, err)
+	count := buildCountQuery(ProductsTable, tenantScope{}, filter, at)
+
+	// The count has the filters of the list, without its page
+	where := strings.TrimPrefix(count.SQL, "SELECT COUNT(*) FROM products WHERE 1=1")
+	assert.NotEqual(t, count.SQL, where)
+	assert.Contains(t, list.SQL, where)
+	assert.NotContains(t, count.SQL, "@page_token")
+	assert.False(t, strings.HasSuffix(count.SQL, "LIMIT 10"))
+	assert.NotContains(t, count.Params, "page_token")
+	assert.Equal(t, "Tools", count.Params["category"])
+	assert.Equal(t, int64(10), count.Params["min_price_num"])
+	assert.Equal(t, at, count.Params["price_at"])
+}
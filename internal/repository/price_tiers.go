@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"math/big"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// readPriceTiers reads the product_price_tiers rows of the given products, keyed by
+// product ID.
+func readPriceTiers(ctx context.Context, reader rowReader, productIDs []string) (map[string][]*PriceTierData, error) {
+	tiers := make(map[string][]*PriceTierData)
+	if len(productIDs) == 0 {
+		return tiers, nil
+	}
+
+	keys := make([]spanner.KeySet, len(productIDs))
+	for i, id := range productIDs {
+		keys[i] = spanner.Key{id}.AsPrefix()
+	}
+
+	iter := reader.Read(ctx, PriceTiersTable, spanner.KeySets(keys...), PriceTierAllColumns())
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return tiers, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := PriceTierDataFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		tiers[data.ProductID] = append(tiers[data.ProductID], data)
+	}
+}
+
+// productPriceTiers converts the product_price_tiers rows of a product to domain tiers,
+// skipping rows that do not hold a valid tier. Unit prices are in the product's currency.
+func productPriceTiers(rows []*PriceTierData, currency string) []*domain.PriceTier {
+	tiers := make([]*domain.PriceTier, 0, len(rows))
+	for _, row := range rows {
+		if tier := dataToPriceTier(row, currency); tier != nil {
+			tiers = append(tiers, tier)
+		}
+	}
+	domain.SortPriceTiers(tiers)
+	return tiers
+}
+
+// priceTierToData converts a price tier of a product to a database model.
+func priceTierToData(productID string, tier *domain.PriceTier) *PriceTierData {
+	data := &PriceTierData{
+		ProductID:   productID,
+		MinQuantity: tier.MinQuantity(),
+	}
+	if price := tier.UnitPrice(); price != nil {
+		data.UnitPriceNumerator = spanner.NullInt64{Int64: price.Numerator(), Valid: true}
+		data.UnitPriceDenominator = spanner.NullInt64{Int64: price.Denominator(), Valid: true}
+	}
+	if pct := tier.PercentOff(); pct != nil {
+		data.PercentOff = percentToNumeric(pct)
+	}
+	return data
+}
+
+// dataToPriceTier converts a database model to a domain PriceTier with a unit price in the
+// given currency, or nil if the row does not hold a valid tier.
+func dataToPriceTier(data *PriceTierData, currency string) *domain.PriceTier {
+	var (
+		tier *domain.PriceTier
+		err  error
+	)
+	switch {
+	case data.UnitPriceNumerator.Valid && data.UnitPriceDenominator.Valid && !data.PercentOff.Valid:
+		var price *domain.Money
+		if price, err = domain.NewMoneyInCurrency(data.UnitPriceNumerator.Int64, data.UnitPriceDenominator.Int64, currency); err != nil {
+			return nil
+		}
+		tier, err = domain.NewUnitPriceTier(data.MinQuantity, price)
+	case data.PercentOff.Valid && !data.UnitPriceNumerator.Valid:
+		tier, err = domain.NewPercentOffTier(data.MinQuantity, new(big.Rat).Set(&data.PercentOff.Numeric))
+	default:
+		return nil
+	}
+	if err != nil {
+		return nil
+	}
+	return tier
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// ProductAttachmentRepo implements the ProductAttachmentRepository interface using Spanner.
+type ProductAttachmentRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.ProductAttachmentRepository = (*ProductAttachmentRepo)(nil)
+
+// NewProductAttachmentRepo creates a new ProductAttachmentRepo.
+func NewProductAttachmentRepo(client *spanner.Client) *ProductAttachmentRepo {
+	return &ProductAttachmentRepo{client: client}
+}
+
+// FindByProductID retrieves the attachments of a product in display order.
+func (r *ProductAttachmentRepo) FindByProductID(ctx context.Context, productID string) (*domain.ProductAttachments, error) {
+	attachments, err := readAttachments(ctx, r.client.Single(), []string{productID})
+	if err != nil {
+		return nil, err
+	}
+	return domain.ReconstructProductAttachments(productID, attachments[productID]), nil
+}
+
+// SaveMuts returns the mutations that replace the product_attachments rows of a product,
+// numbering their positions from 0.
+func (r *ProductAttachmentRepo) SaveMuts(attachments *domain.ProductAttachments) []*spanner.Mutation {
+	all := attachments.Attachments()
+	muts := make([]*spanner.Mutation, 0, len(all)+1)
+	muts = append(muts, spanner.Delete(AttachmentsTable, spanner.Key{attachments.ProductID()}.AsPrefix()))
+	for i, attachment := range all {
+		locale := spanner.NullString{StringVal: attachment.Locale(), Valid: attachment.Locale() != ""}
+		muts = append(muts, spanner.InsertMap(AttachmentsTable, map[string]interface{}{
+			AttachmentProductID: attachments.ProductID(),
+			AttachmentPosition:  int64(i),
+			AttachmentType:      string(attachment.Type()),
+			AttachmentURL:       attachment.URL(),
+			AttachmentLocale:    locale,
+		}))
+	}
+	return muts
+}
+
+// readAttachments reads the attachments of the given products in display order, keyed by
+// product ID. Rows that do not hold a valid attachment are skipped.
+func readAttachments(ctx context.Context, reader rowReader, productIDs []string) (map[string][]*domain.ProductAttachment, error) {
+	attachments := make(map[string][]*domain.ProductAttachment)
+	if len(productIDs) == 0 {
+		return attachments, nil
+	}
+
+	keys := make([]spanner.KeySet, len(productIDs))
+	for i, id := range productIDs {
+		keys[i] = spanner.Key{id}.AsPrefix()
+	}
+
+	iter := reader.Read(ctx, AttachmentsTable, spanner.KeySets(keys...), attachmentColumns())
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return attachments, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			productID      string
+			position       int64
+			attachmentType string
+			url            string
+			locale         spanner.NullString
+		)
+		if err := row.Columns(&productID, &position, &attachmentType, &url, &locale); err != nil {
+			return nil, err
+		}
+		attachment, err := domain.NewProductAttachment(domain.AttachmentType(attachmentType), url, locale.StringVal)
+		if err != nil {
+			continue
+		}
+		attachments[productID] = append(attachments[productID], attachment)
+	}
+}
+
+// attachmentDTOs converts the attachments of a product to DTOs, in display order.
+func attachmentDTOs(attachments []*domain.ProductAttachment) []contract.AttachmentDTO {
+	dtos := make([]contract.AttachmentDTO, len(attachments))
+	for i, attachment := range attachments {
+		dtos[i] = contract.AttachmentDTO{
+			Type:   string(attachment.Type()),
+			URL:    attachment.URL(),
+			Locale: attachment.Locale(),
+		}
+	}
+	return dtos
+}
+
+// attachmentColumns returns the columns of a product_attachments row, in the order
+// readAttachments decodes them.
+func attachmentColumns() []string {
+	return []string{AttachmentProductID, AttachmentPosition, AttachmentType, AttachmentURL, AttachmentLocale}
+}
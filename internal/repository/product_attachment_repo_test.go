@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductAttachmentRepo_SaveMuts(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	manual, err := domain.NewProductAttachment(domain.AttachmentTypeManual, "https://docs.example.com/manual.pdf", "de")
+	require.NoError(t, err)
+	sheet, err := domain.NewProductAttachment(domain.AttachmentTypeSpecSheet, "https://docs.example.com/sheet.pdf", "")
+	require.NoError(t, err)
+
+	attachments := domain.NewProductAttachments("p1")
+	require.NoError(t, attachments.Set([]*domain.ProductAttachment{manual, sheet}, now))
+
+	repo := NewProductAttachmentRepo(nil)
+	assert.Len(t, repo.SaveMuts(attachments), 3, "a delete of the old rows and an insert per attachment")
+	assert.Len(t, repo.SaveMuts(domain.NewProductAttachments("p1")), 1)
+
+	assert.Equal(t, []contract.AttachmentDTO{
+		{Type: "manual", URL: "https://docs.example.com/manual.pdf", Locale: "de"},
+		{Type: "spec_sheet", URL: "https://docs.example.com/sheet.pdf"},
+	}, attachmentDTOs(attachments.Attachments()))
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// ProductImageRepo implements the ProductImageRepository interface using Spanner.
+type ProductImageRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.ProductImageRepository = (*ProductImageRepo)(nil)
+
+// NewProductImageRepo creates a new ProductImageRepo.
+func NewProductImageRepo(client *spanner.Client) *ProductImageRepo {
+	return &ProductImageRepo{client: client}
+}
+
+// FindByProductID retrieves the images of a product in display order.
+func (r *ProductImageRepo) FindByProductID(ctx context.Context, productID string) (*domain.ProductImages, error) {
+	images, err := readImages(ctx, r.client.Single(), []string{productID})
+	if err != nil {
+		return nil, err
+	}
+	return domain.ReconstructProductImages(productID, images[productID]), nil
+}
+
+// SaveMuts returns the mutations that replace the product_images rows of a product,
+// numbering their positions from 0.
+func (r *ProductImageRepo) SaveMuts(images *domain.ProductImages) []*spanner.Mutation {
+	all := images.Images()
+	muts := make([]*spanner.Mutation, 0, len(all)+1)
+	muts = append(muts, spanner.Delete(ImagesTable, spanner.Key{images.ProductID()}.AsPrefix()))
+	for i, image := range all {
+		altText := spanner.NullString{StringVal: image.AltText(), Valid: image.AltText() != ""}
+		muts = append(muts, spanner.InsertMap(ImagesTable, map[string]interface{}{
+			ImageProductID: images.ProductID(),
+			ImagePosition:  int64(i),
+			ImageURL:       image.URL(),
+			ImageAltText:   altText,
+			ImageIsPrimary: image.Primary(),
+		}))
+	}
+	return muts
+}
+
+// readImages reads the images of the given products in display order, keyed by product
+// ID. Rows that do not hold a valid image are skipped.
+func readImages(ctx context.Context, reader rowReader, productIDs []string) (map[string][]*domain.ProductImage, error) {
+	images := make(map[string][]*domain.ProductImage)
+	if len(productIDs) == 0 {
+		return images, nil
+	}
+
+	keys := make([]spanner.KeySet, len(productIDs))
+	for i, id := range productIDs {
+		keys[i] = spanner.Key{id}.AsPrefix()
+	}
+
+	iter := reader.Read(ctx, ImagesTable, spanner.KeySets(keys...), imageColumns())
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return images, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			productID string
+			position  int64
+			url       string
+			altText   spanner.NullString
+			primary   bool
+		)
+		if err := row.Columns(&productID, &position, &url, &altText, &primary); err != nil {
+			return nil, err
+		}
+		image, err := domain.NewProductImage(url, altText.StringVal, primary)
+		if err != nil {
+			continue
+		}
+		images[productID] = append(images[productID], image)
+	}
+}
+
+// imageDTOs converts the images of a product to DTOs, in display order.
+func imageDTOs(images []*domain.ProductImage) []contract.ImageDTO {
+	dtos := make([]contract.ImageDTO, len(images))
+	for i, image := range images {
+		dtos[i] = contract.ImageDTO{
+			URL:     image.URL(),
+			AltText: image.AltText(),
+			Primary: image.Primary(),
+		}
+	}
+	return dtos
+}
+
+// imageColumns returns the columns of a product_images row, in the order readImages
+// decodes them.
+func imageColumns() []string {
+	return []string{ImageProductID, ImagePosition, ImageURL, ImageAltText, ImageIsPrimary}
+}
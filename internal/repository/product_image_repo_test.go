@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductImageRepo_SaveMuts(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	front, err := domain.NewProductImage("https://cdn.example.com/front.jpg", "Front view", false)
+	require.NoError(t, err)
+	back, err := domain.NewProductImage("https://cdn.example.com/back.jpg", "", false)
+	require.NoError(t, err)
+
+	images := domain.NewProductImages("p1")
+	require.NoError(t, images.Set([]*domain.ProductImage{front, back}, now))
+
+	repo := NewProductImageRepo(nil)
+	assert.Len(t, repo.SaveMuts(images), 3, "a delete of the old rows and an insert per image")
+	assert.Len(t, repo.SaveMuts(domain.NewProductImages("p1")), 1)
+
+	assert.Equal(t, []contract.ImageDTO{
+		{URL: "https://cdn.example.com/front.jpg", AltText: "Front view", Primary: true},
+		{URL: "https://cdn.example.com/back.jpg"},
+	}, imageDTOs(images.Images()))
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// ProductRelationRepo implements the ProductRelationRepository interface using Spanner.
+type ProductRelationRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.ProductRelationRepository = (*ProductRelationRepo)(nil)
+
+// NewProductRelationRepo creates a new ProductRelationRepo.
+func NewProductRelationRepo(client *spanner.Client) *ProductRelationRepo {
+	return &ProductRelationRepo{client: client}
+}
+
+// FindRelatedIDs returns the IDs of the products each of the given products links to
+// with relationType, sorted and keyed by product ID.
+func (r *ProductRelationRepo) FindRelatedIDs(ctx context.Context, productIDs []string, relationType domain.RelationType) (map[string][]string, error) {
+	related := make(map[string][]string)
+	if len(productIDs) == 0 {
+		return related, nil
+	}
+
+	keys := make([]spanner.KeySet, len(productIDs))
+	for i, id := range productIDs {
+		keys[i] = spanner.Key{id, relationType.String()}.AsPrefix()
+	}
+
+	rows, err := readRelations(ctx, r.client.Single(), spanner.KeySets(keys...))
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		related[row.productID] = append(related[row.productID], row.relatedProductID)
+	}
+	return related, nil
+}
+
+// InsertMut returns a mutation for inserting a new link.
+func (r *ProductRelationRepo) InsertMut(relation *domain.ProductRelation) *spanner.Mutation {
+	return spanner.InsertMap(RelationsTable, map[string]interface{}{
+		RelationProductID:        relation.ProductID(),
+		RelationType:             relation.Type().String(),
+		RelationRelatedProductID: relation.RelatedProductID(),
+		RelationCreatedAt:        relation.CreatedAt(),
+	})
+}
+
+// DeleteMut returns a mutation deleting a link, if there is one.
+func (r *ProductRelationRepo) DeleteMut(productID, relatedProductID string, relationType domain.RelationType) *spanner.Mutation {
+	return spanner.Delete(RelationsTable, spanner.Key{productID, relationType.String(), relatedProductID})
+}
+
+// InboundDeleteCheck returns a check that reads the links from other products to a
+// product in the commit transaction and deletes them. Links are keyed by the product they
+// link from, so all of them are scanned; a link added concurrently is either read and
+// deleted or conflicts with the scan, so none outlives the product.
+func (r *ProductRelationRepo) InboundDeleteCheck(productID string) committer.Check {
+	return func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		iter := txn.Query(ctx, spanner.Statement{
+			SQL: `SELECT ` + RelationProductID + `, ` + RelationType + ` FROM ` + RelationsTable +
+				` WHERE ` + RelationRelatedProductID + ` = @product_id`,
+			Params: map[string]interface{}{"product_id": productID},
+		})
+		defer iter.Stop()
+
+		var muts []*spanner.Mutation
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				return txn.BufferWrite(muts)
+			}
+			if err != nil {
+				return err
+			}
+			var fromID, relationType string
+			if err := row.Columns(&fromID, &relationType); err != nil {
+				return err
+			}
+			muts = append(muts, spanner.Delete(RelationsTable, spanner.Key{fromID, relationType, productID}))
+		}
+	}
+}
+
+// relationRow is a product_relations row.
+type relationRow struct {
+	productID        string
+	relationType     string
+	relatedProductID string
+}
+
+// readRelations reads the product_relations rows with the given keys, in key order.
+func readRelations(ctx context.Context, reader rowReader, keys spanner.KeySet) ([]relationRow, error) {
+	iter := reader.Read(ctx, RelationsTable, keys, []string{RelationProductID, RelationType, RelationRelatedProductID})
+	defer iter.Stop()
+
+	var rows []relationRow
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var r relationRow
+		if err := row.Columns(&r.productID, &r.relationType, &r.relatedProductID); err != nil {
+			return nil, err
+		}
+		rows = append(rows, r)
+	}
+}
@@ -2,11 +2,14 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
 	"time"
 
 	"cloud.google.com/go/spanner"
 	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+	"google.golang.org/api/iterator"
 )
 
 // ProductRepo implements the ProductRepository interface using Spanner.
@@ -23,13 +26,20 @@ func NewProductRepo(client *spanner.Client) *ProductRepo {
 	}
 }
 
-// FindByID retrieves a product by its ID.
+// FindByID retrieves a product by its ID, failing with domain.ErrProductNotFound if it
+// belongs to a tenant other than that of ctx.
+// The product row, its discounts, its price tiers and its price book are read from the
+// same snapshot. A discount still held in the legacy discount columns is marked changed, so the next
+// write of the product moves it to product_discounts.
 func (r *ProductRepo) FindByID(ctx context.Context, id string) (*domain.Product, error) {
-	row, err := r.client.Single().ReadRow(
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	row, err := txn.ReadRow(
 		ctx,
-ProductsTable,
+		ProductsTable,
 		spanner.Key{id},
-ProductAllColumns(),
+		ProductAllColumns(),
 	)
 	if err != nil {
 		if spanner.ErrCode(err) == 5 { // NOT_FOUND
@@ -38,7 +48,47 @@ ProductAllColumns(),
 		return nil, err
 	}
 
-	return r.rowToProduct(row)
+	data, err := ProductDataFromRow(row)
+	if err != nil {
+		return nil, err
+	}
+	if !tenantScopeOf(ctx).visible(data.TenantID) {
+		return nil, domain.ErrProductNotFound
+	}
+
+	discounts, err := readDiscounts(ctx, txn, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, err := readPriceTiers(ctx, txn, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := readPriceBooks(ctx, txn, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	segmentPrices, err := readSegmentPrices(ctx, txn, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	marketPrices, err := readMarketPrices(ctx, txn, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := r.dataToDomain(data, discounts[id], tiers[id], prices[id], segmentPrices[id], marketPrices[id])
+	if err != nil {
+		return nil, err
+	}
+	if data.DiscountPercent.Valid && product.FindDiscount(id) != nil {
+		product.Changes().MarkDirty(domain.FieldDiscount)
+	}
+	return product, nil
 }
 
 // InsertMut returns a mutation for inserting a new product.
@@ -72,23 +122,88 @@ func (r *ProductRepo) UpdateMut(product *domain.Product) *spanner.Mutation {
 	if changes.Dirty(domain.FieldBasePrice) {
 		updates[ProductBasePriceNum] = product.BasePrice().Numerator()
 		updates[ProductBasePriceDenom] = product.BasePrice().Denominator()
+		// Rows written before the currency column existed get it on their next price change.
+		updates[ProductCurrency] = spanner.NullString{StringVal: product.Currency(), Valid: true}
 	}
 
 	if changes.Dirty(domain.FieldDiscount) {
-		discount := product.Discount()
-		if discount != nil {
-			pct, _ := discount.Percentage().Float64()
-			updates[ProductDiscountPercent] = spanner.NullNumeric{
-				Numeric: *big.NewRat(int64(pct*100), 100),
-				Valid:   true,
-			}
-			updates[ProductDiscountStartDate] = spanner.NullTime{Time: discount.StartDate(), Valid: true}
-			updates[ProductDiscountEndDate] = spanner.NullTime{Time: discount.EndDate(), Valid: true}
-		} else {
-			updates[ProductDiscountPercent] = spanner.NullNumeric{Valid: false}
-			updates[ProductDiscountStartDate] = spanner.NullTime{Valid: false}
-			updates[ProductDiscountEndDate] = spanner.NullTime{Valid: false}
-		}
+		// Discounts are written by DiscountMuts; clear any legacy discount columns.
+		clearLegacyDiscount(updates)
+	}
+
+	if changes.Dirty(domain.FieldPriceTiers) || changes.Dirty(domain.FieldPriceBook) ||
+		changes.Dirty(domain.FieldSegmentPrices) || changes.Dirty(domain.FieldMarketPrices) {
+		// Tiers and prices are written by PriceTierMuts, PriceBookMuts, SegmentPriceMuts
+		// and MarketPriceMuts; only the update time changes here.
+		updates[ProductUpdatedAt] = product.UpdatedAt()
+	}
+
+	if changes.Dirty(domain.FieldTaxClass) {
+		updates[ProductTaxClass] = spanner.NullString{StringVal: product.TaxClass().String(), Valid: true}
+	}
+
+	if changes.Dirty(domain.FieldMinimumPrice) {
+		num, denom := optionalPriceColumns(product.MinimumPrice())
+		updates[ProductMinPriceNum] = num
+		updates[ProductMinPriceDenom] = denom
+	}
+
+	if changes.Dirty(domain.FieldCostPrice) {
+		num, denom := optionalPriceColumns(product.CostPrice())
+		updates[ProductCostPriceNum] = num
+		updates[ProductCostPriceDenom] = denom
+	}
+
+	if changes.Dirty(domain.FieldTags) {
+		updates[ProductTags] = tagsColumn(product.Tags())
+	}
+
+	if changes.Dirty(domain.FieldAttributes) {
+		updates[ProductAttributes] = attributesColumn(product.Attributes())
+	}
+
+	if changes.Dirty(domain.FieldSlug) {
+		updates[ProductSlug] = optionalStringColumn(product.Slug())
+	}
+
+	if changes.Dirty(domain.FieldSKU) {
+		updates[ProductSKU] = optionalStringColumn(product.SKU())
+	}
+
+	if changes.Dirty(domain.FieldGTIN) {
+		updates[ProductGTIN] = optionalStringColumn(product.GTIN())
+	}
+
+	if changes.Dirty(domain.FieldWeight) {
+		weightColumns(updates, product.Weight())
+	}
+
+	if changes.Dirty(domain.FieldDimensions) {
+		dimensionsColumns(updates, product.Dimensions())
+	}
+
+	if changes.Dirty(domain.FieldKind) {
+		updates[ProductKind] = optionalStringColumn(string(product.Kind()))
+	}
+
+	if changes.Dirty(domain.FieldCompliance) {
+		complianceColumns(updates, product.Compliance())
+	}
+
+	if changes.Dirty(domain.FieldChannels) {
+		updates[ProductUnavailableChannels] = channelsColumn(product.UnavailableChannels())
+	}
+
+	if changes.Dirty(domain.FieldPendingPriceChange) {
+		pendingPriceChangeColumns(updates, product.PendingPriceChange())
+	}
+
+	if changes.Dirty(domain.FieldStatusSchedule) {
+		statusScheduleColumns(updates, product)
+	}
+
+	if changes.Dirty(domain.FieldReview) {
+		reviewColumns(updates, product.Review())
 	}
 
 	if changes.Dirty(domain.FieldStatus) {
@@ -109,15 +224,431 @@ func (r *ProductRepo) UpdateMut(product *domain.Product) *spanner.Mutation {
 // ArchiveMut returns a mutation for archiving a product.
 func (r *ProductRepo) ArchiveMut(product *domain.Product) *spanner.Mutation {
 	updates := map[string]interface{}{
-ProductStatus:    product.Status().String(),
-ProductUpdatedAt: product.UpdatedAt(),
+		ProductStatus:    product.Status().String(),
+		ProductUpdatedAt: product.UpdatedAt(),
 	}
 	if product.ArchivedAt() != nil {
 		updates[ProductArchivedAt] = spanner.NullTime{Time: *product.ArchivedAt(), Valid: true}
 	}
+	if product.Changes().Dirty(domain.FieldDiscount) {
+		clearLegacyDiscount(updates)
+	}
+	if product.Changes().Dirty(domain.FieldPendingPriceChange) {
+		pendingPriceChangeColumns(updates, product.PendingPriceChange())
+	}
+	if product.Changes().Dirty(domain.FieldStatusSchedule) {
+		statusScheduleColumns(updates, product)
+	}
 	return r.model.UpdateMut(product.ID(), updates)
 }
 
+// DeleteMut returns a mutation that deletes a product permanently. The rows interleaved
+// in it, e.g. its discounts, prices and price history, are deleted with it.
+func (r *ProductRepo) DeleteMut(product *domain.Product) *spanner.Mutation {
+	return spanner.Delete(ProductsTable, spanner.Key{product.ID()})
+}
+
+// ArchiveDependentsMuts returns the mutations that remove what depends on a product
+// being archived. Archived products are only listed when filtered for, so their
+// merchandising rank is deleted rather than left to the next ranking of the category.
+func (r *ProductRepo) ArchiveDependentsMuts(product *domain.Product) []*spanner.Mutation {
+	if !product.Changes().Dirty(domain.FieldStatus) || product.Status() != domain.ProductStatusArchived {
+		return nil
+	}
+	return []*spanner.Mutation{
+		spanner.Delete(RanksTable, spanner.Key{product.Category(), product.ID()}),
+	}
+}
+
+// DiscountMuts returns the mutations that persist the discounts of a product.
+// When the discount list changed, the product's discount rows are replaced; when only
+// discount phases advanced, just the phase column of each discount is updated.
+func (r *ProductRepo) DiscountMuts(product *domain.Product) []*spanner.Mutation {
+	changes := product.Changes()
+	discounts := product.Discounts()
+
+	switch {
+	case changes.Dirty(domain.FieldDiscount):
+		muts := make([]*spanner.Mutation, 0, len(discounts)+1)
+		muts = append(muts, spanner.Delete(DiscountsTable, spanner.Key{product.ID()}.AsPrefix()))
+		for _, discount := range discounts {
+			muts = append(muts, discountToData(product.ID(), discount).InsertMutation())
+		}
+		return muts
+	case changes.Dirty(domain.FieldDiscountPhase):
+		muts := make([]*spanner.Mutation, 0, len(discounts))
+		for _, discount := range discounts {
+			muts = append(muts, spanner.Update(DiscountsTable,
+				[]string{DiscountProductID, DiscountID, DiscountPhase},
+				[]interface{}{product.ID(), discount.ID(), string(discount.Phase())},
+			))
+		}
+		return muts
+	default:
+		return nil
+	}
+}
+
+// PriceTierMuts returns the mutations that replace the price tier rows of a product,
+// or nil if its tiers did not change.
+func (r *ProductRepo) PriceTierMuts(product *domain.Product) []*spanner.Mutation {
+	if !product.Changes().Dirty(domain.FieldPriceTiers) {
+		return nil
+	}
+
+	tiers := product.PriceTiers()
+	muts := make([]*spanner.Mutation, 0, len(tiers)+1)
+	muts = append(muts, spanner.Delete(PriceTiersTable, spanner.Key{product.ID()}.AsPrefix()))
+	for _, tier := range tiers {
+		muts = append(muts, priceTierToData(product.ID(), tier).InsertMutation())
+	}
+	return muts
+}
+
+// PriceBookMuts returns the mutations that replace the price book rows of a product,
+// or nil if its price book did not change.
+func (r *ProductRepo) PriceBookMuts(product *domain.Product) []*spanner.Mutation {
+	if !product.Changes().Dirty(domain.FieldPriceBook) {
+		return nil
+	}
+
+	prices := product.PriceBook()
+	muts := make([]*spanner.Mutation, 0, len(prices)+1)
+	muts = append(muts, spanner.Delete(PricesTable, spanner.Key{product.ID()}.AsPrefix()))
+	for _, price := range prices {
+		muts = append(muts, priceToData(product.ID(), price).InsertMutation())
+	}
+	return muts
+}
+
+// SegmentPriceMuts returns the mutations that replace the segment price rows of a product,
+// or nil if its segment prices did not change.
+func (r *ProductRepo) SegmentPriceMuts(product *domain.Product) []*spanner.Mutation {
+	if !product.Changes().Dirty(domain.FieldSegmentPrices) {
+		return nil
+	}
+
+	prices := product.SegmentPrices()
+	muts := make([]*spanner.Mutation, 0, len(prices)+1)
+	muts = append(muts, spanner.Delete(SegmentPricesTable, spanner.Key{product.ID()}.AsPrefix()))
+	for _, price := range prices {
+		muts = append(muts, segmentPriceToData(product.ID(), price).InsertMutation())
+	}
+	return muts
+}
+
+// MarketPriceMuts returns the mutations that replace the market price rows of a product,
+// or nil if its market prices did not change.
+func (r *ProductRepo) MarketPriceMuts(product *domain.Product) []*spanner.Mutation {
+	if !product.Changes().Dirty(domain.FieldMarketPrices) {
+		return nil
+	}
+
+	prices := product.MarketPrices()
+	muts := make([]*spanner.Mutation, 0, len(prices)+1)
+	muts = append(muts, spanner.Delete(MarketPricesTable, spanner.Key{product.ID()}.AsPrefix()))
+	for _, price := range prices {
+		muts = append(muts, marketPriceToData(product.ID(), price).InsertMutation())
+	}
+	return muts
+}
+
+// EffectivePriceMuts returns the mutations that reproject the effective prices of a
+// product, or nil if neither its base price nor its discounts changed.
+func (r *ProductRepo) EffectivePriceMuts(product *domain.Product) []*spanner.Mutation {
+	changes := product.Changes()
+	if !changes.Dirty(domain.FieldBasePrice) && !changes.Dirty(domain.FieldDiscount) {
+		return nil
+	}
+	return ProjectEffectivePricesMuts(product)
+}
+
+// PriceHistoryMut returns a mutation recording the prices of a product at the given time
+// in its price history, or nil if none of its pending events changed its base price or
+// discounts.
+func (r *ProductRepo) PriceHistoryMut(product *domain.Product, at time.Time) *spanner.Mutation {
+	change := domain.PriceChange(product.DomainEvents())
+	if change == nil {
+		return nil
+	}
+	return priceHistoryToData(product, change, at).InsertMutation()
+}
+
+// FindIDsByDiscountID returns the IDs of the products of the tenant of ctx holding a
+// discount with the given ID, ordered by ID.
+func (r *ProductRepo) FindIDsByDiscountID(ctx context.Context, discountID string) ([]string, error) {
+	params := map[string]interface{}{"discount_id": discountID}
+	return r.queryIDs(ctx, spanner.Statement{
+		SQL: `SELECT d.product_id FROM product_discounts d
+		      JOIN products p ON p.product_id = d.product_id
+		      WHERE d.discount_id = @discount_id` + tenantScopeOf(ctx).condition("p."+ProductTenantID, params) + `
+		      ORDER BY d.product_id`,
+		Params: params,
+	})
+}
+
+// FindIDs returns those of the given IDs that belong to products of the tenant of ctx, of
+// any status, ordered by ID.
+func (r *ProductRepo) FindIDs(ctx context.Context, ids []string) ([]string, error) {
+	params := map[string]interface{}{"ids": ids}
+	return r.queryIDs(ctx, spanner.Statement{
+		SQL: `SELECT product_id FROM products
+		      WHERE product_id IN UNNEST(@ids)` + tenantScopeOf(ctx).condition(ProductTenantID, params) + `
+		      ORDER BY product_id`,
+		Params: params,
+	})
+}
+
+// FindIDsByCategory returns the IDs of the products of the tenant of ctx in a category
+// that are not archived, ordered by ID.
+func (r *ProductRepo) FindIDsByCategory(ctx context.Context, category string) ([]string, error) {
+	params := map[string]interface{}{
+		"category": category,
+		"archived": string(domain.ProductStatusArchived),
+	}
+	return r.queryIDs(ctx, spanner.Statement{
+		SQL: `SELECT product_id FROM products
+		      WHERE category = @category AND status != @archived` + tenantScopeOf(ctx).condition(ProductTenantID, params) + `
+		      ORDER BY product_id`,
+		Params: params,
+	})
+}
+
+// FindActiveIDsByCategory returns the IDs of up to limit active products of the tenant of
+// ctx in a category with an ID after afterID, ordered by ID. An empty afterID starts from
+// the first product.
+func (r *ProductRepo) FindActiveIDsByCategory(ctx context.Context, category, afterID string, limit int) ([]string, error) {
+	params := map[string]interface{}{
+		"category": category,
+		"active":   string(domain.ProductStatusActive),
+		"after_id": afterID,
+		"limit":    int64(limit),
+	}
+	return r.queryIDs(ctx, spanner.Statement{
+		SQL: `SELECT product_id FROM products
+		      WHERE category = @category AND status = @active AND product_id > @after_id` + tenantScopeOf(ctx).condition(ProductTenantID, params) + `
+		      ORDER BY product_id LIMIT @limit`,
+		Params: params,
+	})
+}
+
+// FindIDBySlug returns the ID of the product with the slug, of any status.
+func (r *ProductRepo) FindIDBySlug(ctx context.Context, slug string) (string, error) {
+	return readProductIDUsingIndex(ctx, r.client.Single(), ProductSlugIndex, slug)
+}
+
+// FindIDBySKU returns the ID of the product with the SKU, of any status.
+func (r *ProductRepo) FindIDBySKU(ctx context.Context, sku string) (string, error) {
+	return readProductIDUsingIndex(ctx, r.client.Single(), ProductSKUIndex, sku)
+}
+
+// FindIDByGTIN returns the ID of the product with the GTIN, of any status.
+func (r *ProductRepo) FindIDByGTIN(ctx context.Context, gtin string) (string, error) {
+	return readProductIDUsingIndex(ctx, r.client.Single(), ProductGTINIndex, gtin)
+}
+
+// FindSlugs returns the slugs of the products whose slug is base or starts with base
+// followed by a hyphen, such as the suffixed slugs built from it, in any order.
+func (r *ProductRepo) FindSlugs(ctx context.Context, base string) ([]string, error) {
+	return r.queryIDs(ctx, spanner.Statement{
+		SQL: `SELECT slug FROM products@{FORCE_INDEX=` + ProductSlugIndex + `}
+		      WHERE slug = @base OR STARTS_WITH(slug, @prefix)`,
+		Params: map[string]interface{}{
+			"base":   base,
+			"prefix": base + "-",
+		},
+	})
+}
+
+// readProductIDUsingIndex reads the ID of the product with the key value in a unique
+// index of products, failing with domain.ErrProductNotFound if there is none.
+func readProductIDUsingIndex(ctx context.Context, txn *spanner.ReadOnlyTransaction, index, value string) (string, error) {
+	row, err := txn.ReadRowUsingIndex(ctx, ProductsTable, index, spanner.Key{value}, []string{ProductID})
+	if err != nil {
+		if spanner.ErrCode(err) == 5 { // NOT_FOUND
+			return "", domain.ErrProductNotFound
+		}
+		return "", err
+	}
+	var id string
+	if err := row.Columns(&id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// queryIDs runs a statement selecting product IDs, or another single string column.
+func (r *ProductRepo) queryIDs(ctx context.Context, stmt spanner.Statement) ([]string, error) {
+	logging.SQL("product_repo", stmt.SQL, stmt.Params)
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	ids := make([]string, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return ids, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+}
+
+// FindPriceChangeDue returns the IDs of up to limit products that are not archived with a
+// pending price change that took effect by the given time.
+func (r *ProductRepo) FindPriceChangeDue(ctx context.Context, at time.Time, limit int) ([]string, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT product_id FROM products
+		      WHERE pending_price_effective_at <= @at AND status != @archived
+		      ORDER BY pending_price_effective_at, product_id LIMIT @limit`,
+		Params: map[string]interface{}{
+			"archived": string(domain.ProductStatusArchived),
+			"at":       at,
+			"limit":    int64(limit),
+		},
+	}
+
+	logging.SQL("product_repo", stmt.SQL, stmt.Params)
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	ids := make([]string, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return ids, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+}
+
+// FindStatusChangeDue returns the IDs of up to limit products that are not archived with a
+// scheduled activation or deactivation due by the given time.
+func (r *ProductRepo) FindStatusChangeDue(ctx context.Context, at time.Time, limit int) ([]string, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT product_id FROM products
+		      WHERE (activate_at <= @at OR deactivate_at <= @at) AND status != @archived
+		      ORDER BY product_id LIMIT @limit`,
+		Params: map[string]interface{}{
+			"archived": string(domain.ProductStatusArchived),
+			"at":       at,
+			"limit":    int64(limit),
+		},
+	}
+	return r.queryIDs(ctx, stmt)
+}
+
+// FindPurgeable returns the IDs of up to limit products archived before the given time,
+// longest archived first.
+func (r *ProductRepo) FindPurgeable(ctx context.Context, archivedBefore time.Time, limit int) ([]string, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT product_id FROM products
+		      WHERE status = @archived AND archived_at < @before
+		      ORDER BY archived_at, product_id LIMIT @limit`,
+		Params: map[string]interface{}{
+			"archived": string(domain.ProductStatusArchived),
+			"before":   archivedBefore,
+			"limit":    int64(limit),
+		},
+	}
+	return r.queryIDs(ctx, stmt)
+}
+
+// FindDiscountPhaseDue returns the IDs of up to limit active products with a discount
+// whose period started or ended by the given time without having been announced.
+// Discounts pending approval are left out. Legacy discount columns are included; a NULL discount_phase is treated as scheduled.
+func (r *ProductRepo) FindDiscountPhaseDue(ctx context.Context, at time.Time, limit int) ([]string, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT product_id FROM (
+		        SELECT d.product_id FROM product_discounts d
+		        JOIN products p ON p.product_id = d.product_id
+		        WHERE p.status = @status AND d.suspended_at IS NULL AND d.pending_approval IS NOT TRUE
+		          AND ((d.phase = @scheduled AND d.start_date <= @at)
+		            OR (d.phase != @ended AND d.end_date <= @at))
+		        UNION DISTINCT
+		        SELECT product_id FROM products
+		        WHERE status = @status AND discount_percent IS NOT NULL AND discount_suspended_at IS NULL
+		          AND ((IFNULL(discount_phase, @scheduled) = @scheduled AND discount_start_date <= @at)
+		            OR (IFNULL(discount_phase, @scheduled) != @ended AND discount_end_date <= @at))
+		      )
+		      ORDER BY product_id LIMIT @limit`,
+		Params: map[string]interface{}{
+			"status":    string(domain.ProductStatusActive),
+			"scheduled": string(domain.DiscountPhaseScheduled),
+			"ended":     string(domain.DiscountPhaseEnded),
+			"at":        at,
+			"limit":     int64(limit),
+		},
+	}
+
+	logging.SQL("product_repo", stmt.SQL, stmt.Params)
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	ids := make([]string, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return ids, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+}
+
+// NextFlashSaleBoundary returns the earliest unannounced start or end of a flash sale of
+// an active product, which may already have passed. It returns false if there is none.
+// Suspended flash sales and flash sales pending approval are left out.
+func (r *ProductRepo) NextFlashSaleBoundary(ctx context.Context) (time.Time, bool, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT MIN(IF(d.phase = @scheduled, d.start_date, d.end_date))
+		      FROM product_discounts d JOIN products p ON p.product_id = d.product_id
+		      WHERE p.status = @status AND d.flash_sale = TRUE AND d.phase != @ended
+		        AND d.suspended_at IS NULL AND d.pending_approval IS NOT TRUE`,
+		Params: map[string]interface{}{
+			"status":    string(domain.ProductStatusActive),
+			"scheduled": string(domain.DiscountPhaseScheduled),
+			"ended":     string(domain.DiscountPhaseEnded),
+		},
+	}
+
+	logging.SQL("product_repo", stmt.SQL, stmt.Params)
+	iter := r.client.Single().Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	var boundary spanner.NullTime
+	if err := row.Columns(&boundary); err != nil {
+		return time.Time{}, false, err
+	}
+	return boundary.Time, boundary.Valid, nil
+}
+
 // productToData converts a domain Product to a database model.
 func (r *ProductRepo) productToData(product *domain.Product) *ProductData {
 	data := &ProductData{
@@ -127,19 +658,44 @@ func (r *ProductRepo) productToData(product *domain.Product) *ProductData {
 		Category:             product.Category(),
 		BasePriceNumerator:   product.BasePrice().Numerator(),
 		BasePriceDenominator: product.BasePrice().Denominator(),
+		Currency:             spanner.NullString{StringVal: product.Currency(), Valid: true},
+		TaxClass:             spanner.NullString{StringVal: product.TaxClass().String(), Valid: true},
 		Status:               product.Status().String(),
 		CreatedAt:            product.CreatedAt(),
 		UpdatedAt:            product.UpdatedAt(),
 	}
 
-	if discount := product.Discount(); discount != nil {
-		pct, _ := discount.Percentage().Float64()
-		data.DiscountPercent = spanner.NullNumeric{
-			Numeric: *big.NewRat(int64(pct*100), 100),
-			Valid:   true,
-		}
-		data.DiscountStartDate = spanner.NullTime{Time: discount.StartDate(), Valid: true}
-		data.DiscountEndDate = spanner.NullTime{Time: discount.EndDate(), Valid: true}
+	data.MinimumPriceNum, data.MinimumPriceDenom = optionalPriceColumns(product.MinimumPrice())
+	data.CostPriceNum, data.CostPriceDenom = optionalPriceColumns(product.CostPrice())
+	data.Tags = tagsColumn(product.Tags())
+	data.UnavailableChannels = channelsColumn(product.UnavailableChannels())
+	data.Attributes = attributesColumn(product.Attributes())
+	data.TenantID = optionalStringColumn(product.TenantID())
+	data.Kind = optionalStringColumn(string(product.Kind()))
+	complianceData(data, product.Compliance())
+	data.Slug = optionalStringColumn(product.Slug())
+	data.SKU = optionalStringColumn(product.SKU())
+	data.GTIN = optionalStringColumn(product.GTIN())
+	if weight := product.Weight(); weight != nil {
+		data.Weight = spanner.NullFloat64{Float64: weight.Value(), Valid: true}
+		data.WeightUnit = optionalStringColumn(string(weight.Unit()))
+	}
+	if d := product.Dimensions(); d != nil {
+		data.Height = spanner.NullFloat64{Float64: d.Height(), Valid: true}
+		data.Width = spanner.NullFloat64{Float64: d.Width(), Valid: true}
+		data.Depth = spanner.NullFloat64{Float64: d.Depth(), Valid: true}
+		data.DimensionUnit = optionalStringColumn(string(d.Unit()))
+	}
+	if change := product.PendingPriceChange(); change != nil {
+		data.PendingPriceNum, data.PendingPriceDenom = optionalPriceColumns(change.Price())
+		data.PendingPriceAt = spanner.NullTime{Time: change.EffectiveAt(), Valid: true}
+	}
+	data.ActivateAt = optionalTimeColumn(product.ActivateAt())
+	data.DeactivateAt = optionalTimeColumn(product.DeactivateAt())
+	if review := product.Review(); review != nil {
+		data.SubmittedBy = optionalStringColumn(review.SubmittedBy())
+		data.ReviewedBy = optionalStringColumn(review.ReviewedBy())
+		data.RejectionReason = optionalStringColumn(review.RejectionReason())
 	}
 
 	if archivedAt := product.ArchivedAt(); archivedAt != nil {
@@ -149,49 +705,159 @@ func (r *ProductRepo) productToData(product *domain.Product) *ProductData {
 	return data
 }
 
-// rowToProduct converts a Spanner row to a domain Product.
-func (r *ProductRepo) rowToProduct(row *spanner.Row) (*domain.Product, error) {
-	var data ProductData
+// optionalPriceColumns returns the numerator and denominator columns of an optional price
+// of a product, NULL if it is not set.
+func optionalPriceColumns(price *domain.Money) (spanner.NullInt64, spanner.NullInt64) {
+	if price == nil {
+		return spanner.NullInt64{}, spanner.NullInt64{}
+	}
+	return spanner.NullInt64{Int64: price.Numerator(), Valid: true},
+		spanner.NullInt64{Int64: price.Denominator(), Valid: true}
+}
 
-	if err := row.Columns(
-		&data.ProductID,
-		&data.Name,
-		&data.Description,
-		&data.Category,
-		&data.BasePriceNumerator,
-		&data.BasePriceDenominator,
-		&data.DiscountPercent,
-		&data.DiscountStartDate,
-		&data.DiscountEndDate,
-		&data.Status,
-		&data.CreatedAt,
-		&data.UpdatedAt,
-		&data.ArchivedAt,
-	); err != nil {
-		return nil, err
+// tagsColumn returns the tags column of a product, NULL if it has no tags.
+func tagsColumn(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
 	}
+	return tags
+}
 
-	return r.dataToDomain(&data)
+// channelsColumn returns the unavailable channels column of a product, NULL if it is
+// available on every channel.
+func channelsColumn(channels []domain.SalesChannel) []string {
+	if len(channels) == 0 {
+		return nil
+	}
+	column := make([]string, len(channels))
+	for i, channel := range channels {
+		column[i] = string(channel)
+	}
+	return column
 }
 
-// dataToDomain converts a database model to a domain Product.
-func (r *ProductRepo) dataToDomain(data *ProductData) (*domain.Product, error) {
-	basePrice := domain.NewMoney(data.BasePriceNumerator, data.BasePriceDenominator)
+// attributesColumn returns the attributes column of a product, NULL if it has no
+// attributes.
+func attributesColumn(attributes map[string]string) spanner.NullJSON {
+	if len(attributes) == 0 {
+		return spanner.NullJSON{}
+	}
+	return spanner.NullJSON{Value: attributes, Valid: true}
+}
 
-	var discount *domain.Discount
-	if data.DiscountPercent.Valid && data.DiscountStartDate.Valid && data.DiscountEndDate.Valid {
-		pct, _ := data.DiscountPercent.Numeric.Float64()
-		var err error
-		discount, err = domain.NewDiscount(
-			big.NewRat(int64(pct), 1),
-			data.DiscountStartDate.Time,
-			data.DiscountEndDate.Time,
-		)
-		if err != nil {
-			// If discount is invalid, ignore it
-			discount = nil
-		}
+// optionalStringColumn returns an optional string column of a product, such as its slug
+// or SKU, NULL if it is empty.
+func optionalStringColumn(value string) spanner.NullString {
+	return spanner.NullString{StringVal: value, Valid: value != ""}
+}
+
+// pendingPriceChangeColumns sets the pending price change columns in a products update,
+// to NULL if change is nil.
+func pendingPriceChangeColumns(updates map[string]interface{}, change *domain.PendingPriceChange) {
+	if change == nil {
+		updates[ProductPendingPriceNum] = spanner.NullInt64{}
+		updates[ProductPendingPriceDenom] = spanner.NullInt64{}
+		updates[ProductPendingPriceEffectiveAt] = spanner.NullTime{}
+		return
+	}
+	updates[ProductPendingPriceNum], updates[ProductPendingPriceDenom] = optionalPriceColumns(change.Price())
+	updates[ProductPendingPriceEffectiveAt] = spanner.NullTime{Time: change.EffectiveAt(), Valid: true}
+}
+
+// statusScheduleColumns sets the scheduled activation and deactivation columns in a
+// products update.
+func statusScheduleColumns(updates map[string]interface{}, product *domain.Product) {
+	updates[ProductActivateAt] = optionalTimeColumn(product.ActivateAt())
+	updates[ProductDeactivateAt] = optionalTimeColumn(product.DeactivateAt())
+}
+
+// reviewColumns sets the review columns in a products update.
+func reviewColumns(updates map[string]interface{}, review *domain.ProductReview) {
+	if review == nil {
+		updates[ProductSubmittedBy] = spanner.NullString{}
+		updates[ProductReviewedBy] = spanner.NullString{}
+		updates[ProductRejectionReason] = spanner.NullString{}
+		return
+	}
+	updates[ProductSubmittedBy] = optionalStringColumn(review.SubmittedBy())
+	updates[ProductReviewedBy] = optionalStringColumn(review.ReviewedBy())
+	updates[ProductRejectionReason] = optionalStringColumn(review.RejectionReason())
+}
+
+// weightColumns sets the weight columns in a products update, to NULL if weight is nil.
+func weightColumns(updates map[string]interface{}, weight *domain.Weight) {
+	if weight == nil {
+		updates[ProductWeight] = spanner.NullFloat64{}
+		updates[ProductWeightUnit] = spanner.NullString{}
+		return
 	}
+	updates[ProductWeight] = spanner.NullFloat64{Float64: weight.Value(), Valid: true}
+	updates[ProductWeightUnit] = optionalStringColumn(string(weight.Unit()))
+}
+
+// dimensionsColumns sets the dimension columns in a products update, to NULL if
+// dimensions is nil.
+func dimensionsColumns(updates map[string]interface{}, dimensions *domain.Dimensions) {
+	if dimensions == nil {
+		updates[ProductHeight] = spanner.NullFloat64{}
+		updates[ProductWidth] = spanner.NullFloat64{}
+		updates[ProductDepth] = spanner.NullFloat64{}
+		updates[ProductDimensionUnit] = spanner.NullString{}
+		return
+	}
+	updates[ProductHeight] = spanner.NullFloat64{Float64: dimensions.Height(), Valid: true}
+	updates[ProductWidth] = spanner.NullFloat64{Float64: dimensions.Width(), Valid: true}
+	updates[ProductDepth] = spanner.NullFloat64{Float64: dimensions.Depth(), Valid: true}
+	updates[ProductDimensionUnit] = optionalStringColumn(string(dimensions.Unit()))
+}
+
+// complianceData sets the compliance columns of a product row, to NULL if compliance is
+// nil.
+func complianceData(data *ProductData, compliance *domain.Compliance) {
+	if compliance == nil {
+		data.MinimumAge, data.Hazardous, data.RestrictedMarkets = spanner.NullInt64{}, spanner.NullBool{}, nil
+		return
+	}
+	data.MinimumAge = spanner.NullInt64{Int64: int64(compliance.MinimumAge()), Valid: true}
+	data.Hazardous = spanner.NullBool{Bool: compliance.Hazardous(), Valid: true}
+	data.RestrictedMarkets = marketsColumn(compliance.RestrictedMarkets())
+}
+
+// complianceColumns sets the compliance columns in a products update, to NULL if
+// compliance is nil.
+func complianceColumns(updates map[string]interface{}, compliance *domain.Compliance) {
+	var data ProductData
+	complianceData(&data, compliance)
+	updates[ProductMinimumAge] = data.MinimumAge
+	updates[ProductHazardous] = data.Hazardous
+	updates[ProductRestrictedMarkets] = data.RestrictedMarkets
+}
+
+// marketsColumn returns a column of market codes, NULL if there are none.
+func marketsColumn(markets []domain.Market) []string {
+	if len(markets) == 0 {
+		return nil
+	}
+	column := make([]string, len(markets))
+	for i, market := range markets {
+		column[i] = market.String()
+	}
+	return column
+}
+
+// optionalTimeColumn returns an optional time column of a product, NULL if t is nil.
+func optionalTimeColumn(t *time.Time) spanner.NullTime {
+	if t == nil {
+		return spanner.NullTime{}
+	}
+	return spanner.NullTime{Time: *t, Valid: true}
+}
+
+// dataToDomain converts a database model and its discount and price tier rows to a
+// domain Product.
+func (r *ProductRepo) dataToDomain(data *ProductData, discountRows []*DiscountData, tierRows []*PriceTierData, priceRows []*PriceData, segmentRows []*SegmentPriceData, marketRows []*MarketPriceData) (*domain.Product, error) {
+	basePrice := productBasePrice(data)
+	discounts := productDiscounts("product_repo", data, discountRows)
 
 	var archivedAt *time.Time
 	if data.ArchivedAt.Valid {
@@ -201,13 +867,262 @@ func (r *ProductRepo) dataToDomain(data *ProductData) (*domain.Product, error) {
 	return domain.ReconstructProduct(
 		data.ProductID,
 		data.Name,
+		data.Slug.StringVal,
 		data.Description,
 		data.Category,
+		data.SKU.StringVal,
+		data.GTIN.StringVal,
 		basePrice,
-		discount,
+		discounts,
+		productPriceTiers(tierRows, basePrice.Currency()),
+		productPriceBook(priceRows),
+		productSegmentPrices(segmentRows, basePrice.Currency()),
+		productMarketPrices(marketRows),
+		productOptionalPrice(data.MinimumPriceNum, data.MinimumPriceDenom, basePrice.Currency()),
+		productOptionalPrice(data.CostPriceNum, data.CostPriceDenom, basePrice.Currency()),
+		productPendingPriceChange(data, basePrice.Currency()),
+		data.Tags,
+		productAttributes(data),
+		productWeight(data),
+		productDimensions(data),
+		productKind(data),
+		productCompliance(data),
+		productUnavailableChannels(data),
+		data.TenantID.StringVal,
+		productTaxClass(data),
 		domain.ProductStatus(data.Status),
+		productReview(data),
+		productOptionalTime(data.ActivateAt),
+		productOptionalTime(data.DeactivateAt),
 		data.CreatedAt,
 		data.UpdatedAt,
 		archivedAt,
 	), nil
 }
+
+// productBasePrice returns the base price of a product row in the row's currency.
+func productBasePrice(data *ProductData) *domain.Money {
+	price, err := domain.NewMoneyInCurrency(data.BasePriceNumerator, data.BasePriceDenominator, productCurrency(data))
+	if err != nil {
+		// productCurrency only returns valid codes.
+		return domain.NewMoney(data.BasePriceNumerator, data.BasePriceDenominator)
+	}
+	return price
+}
+
+// productCurrency returns the currency of a product row. Rows written before the currency
+// column existed are in domain.DefaultCurrency; a malformed code is logged and read as
+// domain.DefaultCurrency too.
+func productCurrency(data *ProductData) string {
+	if !data.Currency.Valid {
+		return domain.DefaultCurrency
+	}
+	code, err := domain.ParseCurrency(data.Currency.StringVal)
+	if err != nil {
+		logging.Warnf("product %s has invalid currency %q; reading it as %s", data.ProductID, data.Currency.StringVal, domain.DefaultCurrency)
+		return domain.DefaultCurrency
+	}
+	return code
+}
+
+// productTaxClass returns the tax class of a product row. Rows written before the
+// tax_class column existed have domain.DefaultTaxClass; a malformed class is logged and
+// read as domain.DefaultTaxClass too.
+func productTaxClass(data *ProductData) domain.TaxClass {
+	if !data.TaxClass.Valid {
+		return domain.DefaultTaxClass
+	}
+	class, err := domain.ParseTaxClass(data.TaxClass.StringVal)
+	if err != nil {
+		logging.Warnf("product %s has invalid tax class %q; reading it as %s", data.ProductID, data.TaxClass.StringVal, domain.DefaultTaxClass)
+		return domain.DefaultTaxClass
+	}
+	return class
+}
+
+// productAttributes returns the attributes of a product row. A malformed attributes
+// column is logged and read as no attributes.
+func productAttributes(data *ProductData) map[string]string {
+	attributes := make(map[string]string)
+	if !data.Attributes.Valid {
+		return attributes
+	}
+	raw, err := json.Marshal(data.Attributes.Value)
+	if err == nil {
+		err = json.Unmarshal(raw, &attributes)
+	}
+	if err != nil {
+		logging.Warnf("product %s has invalid attributes: %v; reading it as having none", data.ProductID, err)
+		return make(map[string]string)
+	}
+	return attributes
+}
+
+// productOptionalPrice returns an optional price of a product row, such as its minimum
+// price, in currency, or nil if it is not set.
+func productOptionalPrice(num, denom spanner.NullInt64, currency string) *domain.Money {
+	if !num.Valid || !denom.Valid {
+		return nil
+	}
+	price, err := domain.NewMoneyInCurrency(num.Int64, denom.Int64, currency)
+	if err != nil {
+		// currency is the product's, which is always valid.
+		return nil
+	}
+	return price
+}
+
+// productReview returns the latest review of a product row, nil if it was never
+// submitted for review.
+func productReview(data *ProductData) *domain.ProductReview {
+	if !data.SubmittedBy.Valid {
+		return nil
+	}
+	return domain.NewProductReview(data.SubmittedBy.StringVal, data.ReviewedBy.StringVal, data.RejectionReason.StringVal)
+}
+
+// productWeight returns the weight of a product row, nil if it has none. An invalid
+// weight is logged and read as none.
+func productWeight(data *ProductData) *domain.Weight {
+	if !data.Weight.Valid {
+		return nil
+	}
+	weight, err := domain.NewWeight(data.Weight.Float64, domain.WeightUnit(data.WeightUnit.StringVal))
+	if err != nil {
+		logging.Warnf("product %s has an invalid weight: %v; reading it as having none", data.ProductID, err)
+		return nil
+	}
+	return weight
+}
+
+// productDimensions returns the dimensions of a product row, nil if it has none. Invalid
+// dimensions are logged and read as none.
+func productDimensions(data *ProductData) *domain.Dimensions {
+	if !data.Height.Valid || !data.Width.Valid || !data.Depth.Valid {
+		return nil
+	}
+	dimensions, err := domain.NewDimensions(data.Height.Float64, data.Width.Float64, data.Depth.Float64,
+		domain.LengthUnit(data.DimensionUnit.StringVal))
+	if err != nil {
+		logging.Warnf("product %s has invalid dimensions: %v; reading it as having none", data.ProductID, err)
+		return nil
+	}
+	return dimensions
+}
+
+// productCompliance returns the compliance flags of a product row, nil if it has none.
+// Unknown restricted markets are logged and ignored, and an invalid minimum age is
+// logged and read as none.
+func productCompliance(data *ProductData) *domain.Compliance {
+	if !data.MinimumAge.Valid && !data.Hazardous.Valid && len(data.RestrictedMarkets) == 0 {
+		return nil
+	}
+	minimumAge := int(data.MinimumAge.Int64)
+	if minimumAge < 0 || minimumAge > domain.MaxMinimumAge {
+		logging.Warnf("product %s has an invalid minimum age %d; reading it as none", data.ProductID, minimumAge)
+		minimumAge = 0
+	}
+	var markets []domain.Market
+	for _, code := range data.RestrictedMarkets {
+		market, err := domain.ParseMarket(code)
+		if err != nil {
+			logging.Warnf("product %s is restricted in an unknown market %q; ignoring it", data.ProductID, code)
+			continue
+		}
+		markets = append(markets, market)
+	}
+	compliance, _ := domain.NewCompliance(minimumAge, data.Hazardous.Bool, markets)
+	return compliance
+}
+
+// productUnavailableChannels returns the sales channels a product row is withheld from.
+// Unknown channels are logged and ignored.
+func productUnavailableChannels(data *ProductData) []domain.SalesChannel {
+	var channels []domain.SalesChannel
+	for _, name := range data.UnavailableChannels {
+		channel, err := domain.ParseSalesChannel(name)
+		if err != nil {
+			logging.Warnf("product %s is withheld from an unknown sales channel %q; ignoring it", data.ProductID, name)
+			continue
+		}
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// productChannels returns the names of the sales channels a product row is available on,
+// in name order.
+func productChannels(data *ProductData) []string {
+	unavailable := make(map[string]bool, len(data.UnavailableChannels))
+	for _, name := range data.UnavailableChannels {
+		unavailable[name] = true
+	}
+	channels := make([]string, 0, len(domain.SalesChannels()))
+	for _, channel := range domain.SalesChannels() {
+		if !unavailable[string(channel)] {
+			channels = append(channels, string(channel))
+		}
+	}
+	return channels
+}
+
+// productKind returns the kind of a product row, empty if it is unset. An unknown kind
+// is logged and read as unset.
+func productKind(data *ProductData) domain.ProductKind {
+	if !data.Kind.Valid {
+		return ""
+	}
+	kind := domain.ProductKind(data.Kind.StringVal)
+	if !kind.IsValid() {
+		logging.Warnf("product %s has an unknown kind %q; reading it as unset", data.ProductID, data.Kind.StringVal)
+		return ""
+	}
+	return kind
+}
+
+// productOptionalTime returns an optional time column of a product row, nil if NULL.
+func productOptionalTime(t spanner.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// productPendingPriceChange returns the pending price change of a product row in
+// currency, or nil if none is pending.
+func productPendingPriceChange(data *ProductData, currency string) *domain.PendingPriceChange {
+	price := productOptionalPrice(data.PendingPriceNum, data.PendingPriceDenom, currency)
+	if price == nil || !data.PendingPriceAt.Valid {
+		return nil
+	}
+	return domain.NewPendingPriceChange(price, data.PendingPriceAt.Time)
+}
+
+// percentToNumeric converts a discount percentage to its persisted form. The NUMERIC
+// column holds the exact value, so fractional percentages such as 12.5 or 33.33 round-trip.
+func percentToNumeric(pct *big.Rat) spanner.NullNumeric {
+	return spanner.NullNumeric{Numeric: *new(big.Rat).Set(pct), Valid: true}
+}
+
+// numericToPercent converts a persisted discount percentage back to a rational.
+// It returns a copy so callers never alias the row data.
+func numericToPercent(n spanner.NullNumeric) *big.Rat {
+	return new(big.Rat).Set(&n.Numeric)
+}
+
+// clearLegacyDiscount sets the legacy discount columns to NULL in a products update.
+func clearLegacyDiscount(updates map[string]interface{}) {
+	updates[ProductDiscountPercent] = spanner.NullNumeric{Valid: false}
+	updates[ProductDiscountStartDate] = spanner.NullTime{Valid: false}
+	updates[ProductDiscountEndDate] = spanner.NullTime{Valid: false}
+	updates[ProductDiscountSuspendedAt] = spanner.NullTime{Valid: false}
+	updates[ProductDiscountPhase] = spanner.NullString{Valid: false}
+}
+
+// suspendedAtToNullTime converts the suspension time of a discount to its persisted form.
+func suspendedAtToNullTime(discount *domain.Discount) spanner.NullTime {
+	if at := discount.SuspendedAt(); at != nil {
+		return spanner.NullTime{Time: *at, Valid: true}
+	}
+	return spanner.NullTime{Valid: false}
+}
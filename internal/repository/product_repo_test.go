@@ -0,0 +1,836 @@
+package repository
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductRepo_DiscountPercentRoundTrip(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	tests := []struct {
+		name     string
+		input    float64
+		expected *big.Rat
+	}{
+		{"whole percent", 20, big.NewRat(20, 1)},
+		{"half percent", 12.5, big.NewRat(25, 2)},
+		{"hundredths", 33.33, big.NewRat(3333, 100)},
+		{"hundredths below one", 0.29, big.NewRat(29, 100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discount, err := domain.NewDiscount(domain.PercentageFromFloat(tt.input), now.Add(-time.Hour), now.Add(time.Hour))
+			require.NoError(t, err)
+			discount = discount.WithID("discount-1")
+			product := domain.ReconstructProduct(
+				"product-123", "Widget", "", "", "Tools", "", "",
+				domain.NewMoney(2000, 100), []*domain.Discount{discount}, nil,
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", domain.DefaultTaxClass, domain.ProductStatusActive, nil, nil, nil, now, now, nil,
+			)
+
+			row := discountToData(product.ID(), discount)
+			assert.Zero(t, tt.expected.Cmp(&row.Percentage), "stored %s", row.Percentage.RatString())
+
+			loaded, err := repo.dataToDomain(repo.productToData(product), []*DiscountData{row}, nil, nil, nil, nil)
+			require.NoError(t, err)
+			require.NotNil(t, loaded.FindDiscount("discount-1"))
+			assert.Zero(t, tt.expected.Cmp(loaded.FindDiscount("discount-1").Percentage()), "loaded %s", loaded.FindDiscount("discount-1").Percentage().RatString())
+			assert.True(t, product.EffectivePrice(now).Equals(loaded.EffectivePrice(now)))
+		})
+	}
+}
+
+func TestDataToDTO_FractionalDiscount(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		percent       *big.Rat
+		expectedFloat float64
+		expectedPrice *big.Rat
+	}{
+		// 20.00 * (1 - 12.5/100) = 17.50
+		{"half percent", big.NewRat(25, 2), 12.5, big.NewRat(35, 2)},
+		// 20.00 * (1 - 33.33/100) = 13.334
+		{"hundredths", big.NewRat(3333, 100), 33.33, big.NewRat(6667, 500)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := discountRow(now, true, true, true)
+			data.DiscountPercent.Numeric = *tt.percent
+
+			dto := dataToDTO(data, nil, nil, now)
+
+			require.NotNil(t, dto.DiscountPercent)
+			assert.Equal(t, tt.expectedFloat, *dto.DiscountPercent)
+			assert.True(t, dto.HasActiveDiscount)
+			price := big.NewRat(dto.EffectivePriceNum, dto.EffectivePriceDenom)
+			assert.Zero(t, tt.expectedPrice.Cmp(price), "effective price %s", price.RatString())
+		})
+	}
+}
+
+func TestProductRepo_ArchivedRowIgnoresDiscount(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	data := discountRow(now, true, true, true)
+	data.Status = "archived"
+	data.ArchivedAt = spanner.NullTime{Time: now, Valid: true}
+
+	rows := []*DiscountData{discountToData(data.ProductID, mustDiscount(t, now).WithID("discount-1"))}
+	product, err := (&ProductRepo{}).dataToDomain(data, rows, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, product.Discounts())
+
+	dto := dataToDTO(data, rows, nil, now)
+	assert.Nil(t, dto.DiscountPercent)
+	assert.False(t, dto.HasActiveDiscount)
+	assert.Equal(t, int64(2000), dto.EffectivePriceNum)
+	assert.Equal(t, int64(100), dto.EffectivePriceDenom)
+}
+
+func TestProductRepo_SuspendedDiscount(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	discount := mustDiscount(t, now).WithID("discount-1").Suspend(now)
+	product := domain.ReconstructProduct(
+		"product-123", "Widget", "", "", "Tools", "", "",
+		domain.NewMoney(2000, 100), []*domain.Discount{discount}, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", domain.DefaultTaxClass, domain.ProductStatusInactive, nil, nil, nil, now, now, nil,
+	)
+
+	row := discountToData(product.ID(), discount)
+	require.True(t, row.SuspendedAt.Valid)
+	assert.Equal(t, now, row.SuspendedAt.Time)
+
+	data := repo.productToData(product)
+	loaded, err := repo.dataToDomain(data, []*DiscountData{row}, nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, loaded.FindDiscount("discount-1"))
+	assert.True(t, loaded.FindDiscount("discount-1").Equals(discount))
+
+	dto := dataToDTO(data, []*DiscountData{row}, nil, now)
+	require.NotNil(t, dto.DiscountPercent)
+	assert.True(t, dto.DiscountSuspended)
+	assert.False(t, dto.HasActiveDiscount)
+	assert.Zero(t, big.NewRat(20, 1).Cmp(big.NewRat(dto.EffectivePriceNum, dto.EffectivePriceDenom)))
+}
+
+func TestProductRepo_DiscountApproval(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	data := discountRow(now, false, false, false)
+
+	pending := discountToData(data.ProductID, mustDiscount(t, now).WithID("pending").RequireApproval())
+	assert.Equal(t, spanner.NullBool{Bool: true, Valid: true}, pending.PendingApproval)
+	assert.False(t, pending.ApprovedAt.Valid)
+
+	approved := discountToData(data.ProductID, mustDiscount(t, now).WithID("approved").WithPriority(10).Approve("pricing-lead", now))
+	assert.Equal(t, spanner.NullBool{Bool: false, Valid: true}, approved.PendingApproval)
+	assert.Equal(t, spanner.NullString{StringVal: "pricing-lead", Valid: true}, approved.ApprovedBy)
+	assert.Equal(t, spanner.NullTime{Time: now, Valid: true}, approved.ApprovedAt)
+
+	// Rows written before approvals have a NULL pending_approval
+	legacy := discountToData(data.ProductID, mustDiscount(t, now).WithID("legacy").WithPriority(20))
+	legacy.PendingApproval = spanner.NullBool{}
+
+	loaded := productDiscounts("test", data, []*DiscountData{pending, approved, legacy})
+	require.Len(t, loaded, 3)
+	assert.True(t, loaded[0].IsPendingApproval())
+	assert.False(t, loaded[1].IsPendingApproval())
+	assert.Equal(t, "pricing-lead", loaded[1].ApprovedBy())
+	assert.Equal(t, now, *loaded[1].ApprovedAt())
+	assert.False(t, loaded[2].IsPendingApproval())
+	assert.Empty(t, loaded[2].ApprovedBy())
+
+	dto := dataToDTO(data, []*DiscountData{pending}, nil, now)
+	require.Len(t, dto.Discounts, 1)
+	assert.True(t, dto.Discounts[0].PendingApproval)
+	assert.Nil(t, dto.DiscountPercent, "a discount pending approval is not the product's discount")
+	assert.False(t, dto.HasActiveDiscount)
+}
+
+func TestProductRepo_FlashSale(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	data := discountRow(now, false, false, false)
+
+	flash := discountToData(data.ProductID, mustDiscount(t, now).WithID("flash").AsFlashSale())
+	assert.Equal(t, spanner.NullBool{Bool: true, Valid: true}, flash.FlashSale)
+
+	// Rows written before flash sales have a NULL flash_sale
+	legacy := discountToData(data.ProductID, mustDiscount(t, now).WithID("legacy").WithPriority(20))
+	legacy.FlashSale = spanner.NullBool{}
+
+	loaded := productDiscounts("test", data, []*DiscountData{flash, legacy})
+	require.Len(t, loaded, 2)
+	assert.True(t, loaded[0].IsFlashSale())
+	assert.False(t, loaded[1].IsFlashSale())
+
+	dto := dataToDTO(data, []*DiscountData{flash}, nil, now)
+	require.Len(t, dto.Discounts, 1)
+	assert.True(t, dto.Discounts[0].FlashSale)
+}
+
+func TestProductRepo_DiscountPhase(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	row := discountToData("product-123", mustDiscount(t, now).WithID("discount-1"))
+	assert.Equal(t, "scheduled", row.Phase)
+
+	// Legacy rows written before the discount_phase column existed are read as scheduled
+	legacy := discountRow(now, true, true, true)
+	loaded, err := repo.dataToDomain(legacy, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DiscountPhaseScheduled, loaded.FindDiscount("product-123").Phase())
+
+	row.Phase = "started"
+	loaded, err = repo.dataToDomain(discountRow(now, false, false, false), []*DiscountData{row}, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DiscountPhaseStarted, loaded.FindDiscount("discount-1").Phase())
+
+	// Advancing the phase only updates the phase of the discount rows
+	require.True(t, loaded.AdvanceDiscountPhase(now.Add(2*time.Hour)))
+	assert.True(t, loaded.Changes().Dirty(domain.FieldDiscountPhase))
+	assert.False(t, loaded.Changes().Dirty(domain.FieldDiscount))
+	assert.Nil(t, repo.UpdateMut(loaded))
+	assert.Len(t, repo.DiscountMuts(loaded), 1)
+}
+
+func TestProductRepo_LegacyDiscount(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	data := discountRow(now, true, true, true)
+	data.DiscountSuspendedAt = spanner.NullTime{Time: now, Valid: true}
+	row := discountToData(data.ProductID, mustDiscount(t, now).WithID("discount-1").WithPriority(10))
+
+	product, err := repo.dataToDomain(data, []*DiscountData{row}, nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, product.Discounts(), 2)
+
+	legacy := product.FindDiscount(data.ProductID)
+	require.NotNil(t, legacy)
+	assert.Zero(t, big.NewRat(25, 1).Cmp(legacy.Percentage()))
+	assert.Equal(t, 0, legacy.Priority())
+	assert.True(t, legacy.IsSuspended())
+	assert.Equal(t, 10, product.FindDiscount("discount-1").Priority())
+}
+
+func TestProductRepo_DiscountMuts(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, true, true, true), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, repo.DiscountMuts(product))
+
+	// A changed discount list replaces the discount rows and clears the legacy columns
+	second := mustDiscount(t, now).WithID("discount-2").WithPriority(10)
+	require.NoError(t, product.ApplyDiscount(second, now))
+	assert.Len(t, repo.DiscountMuts(product), 3)
+	assert.NotNil(t, repo.UpdateMut(product))
+
+	product.ClearEvents()
+	product.Changes().Reset()
+	require.NoError(t, product.RemoveDiscount("", now))
+	assert.Len(t, repo.DiscountMuts(product), 1)
+}
+
+func TestDataToDTO_MultipleDiscounts(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	data := discountRow(now, false, false, false)
+
+	low, err := domain.NewDiscount(big.NewRat(10, 1), now.Add(-2*time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	high, err := domain.NewDiscount(big.NewRat(50, 1), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	rows := []*DiscountData{
+		discountToData(data.ProductID, low.WithID("low")),
+		discountToData(data.ProductID, high.WithID("high").WithPriority(10)),
+	}
+
+	dto := dataToDTO(data, rows, nil, now)
+	require.Len(t, dto.Discounts, 2)
+	assert.Equal(t, "low", dto.Discounts[0].ID)
+	assert.Equal(t, "high", dto.Discounts[1].ID)
+	assert.Equal(t, 10, dto.Discounts[1].Priority)
+	require.NotNil(t, dto.DiscountPercent)
+	assert.Equal(t, 50.0, *dto.DiscountPercent)
+	assert.True(t, dto.HasActiveDiscount)
+	assert.Equal(t, int64(10), dto.EffectivePriceNum)
+	assert.Equal(t, int64(1), dto.EffectivePriceDenom)
+
+	// Once the higher priority discount ends, the lower one applies
+	later := now.Add(90 * time.Minute)
+	price := dataToPriceDTO(data, rows, later)
+	require.NotNil(t, price.DiscountPercent)
+	assert.Equal(t, 10.0, *price.DiscountPercent)
+	assert.Equal(t, int64(18), price.EffectivePriceNum)
+}
+
+func TestDataToPriceDTO(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		at             time.Time
+		suspended      bool
+		expectDiscount bool
+		expectedPrice  *big.Rat
+	}{
+		{"active discount", now, false, true, big.NewRat(16, 1)},
+		{"before discount starts", now.Add(-2 * time.Hour), false, false, big.NewRat(20, 1)},
+		{"suspended discount", now, true, false, big.NewRat(20, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := discountRow(now, true, true, true)
+			data.DiscountPercent.Numeric = *big.NewRat(20, 1)
+			data.DiscountSuspendedAt = spanner.NullTime{Time: now, Valid: tt.suspended}
+
+			price := dataToPriceDTO(data, nil, tt.at)
+
+			assert.Equal(t, data.ProductID, price.ProductID)
+			assert.Equal(t, data.Status, price.Status)
+			assert.Equal(t, tt.expectDiscount, price.HasActiveDiscount)
+			assert.Equal(t, tt.expectDiscount, price.DiscountPercent != nil)
+			effective := big.NewRat(price.EffectivePriceNum, price.EffectivePriceDenom)
+			assert.Zero(t, tt.expectedPrice.Cmp(effective), "effective price %s", effective.RatString())
+		})
+	}
+}
+
+func mustDiscount(t *testing.T, now time.Time) *domain.Discount {
+	t.Helper()
+	discount, err := domain.NewDiscount(big.NewRat(20, 1), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	return discount
+}
+
+func TestProductRepo_PriceTierMuts(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, repo.PriceTierMuts(product))
+
+	unit, err := domain.NewUnitPriceTier(10, domain.NewMoney(1800, 100))
+	require.NoError(t, err)
+	pct, err := domain.NewPercentOffTier(100, big.NewRat(25, 1))
+	require.NoError(t, err)
+	require.NoError(t, product.SetPriceTiers([]*domain.PriceTier{unit, pct}, now))
+
+	assert.Len(t, repo.PriceTierMuts(product), 3)
+	assert.NotNil(t, repo.UpdateMut(product))
+	assert.Nil(t, repo.DiscountMuts(product))
+}
+
+func TestProductRepo_PriceBookMuts(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, repo.PriceBookMuts(product))
+
+	eur, err := domain.NewMoneyInCurrency(1849, 100, "EUR")
+	require.NoError(t, err)
+	require.NoError(t, product.SetPriceBook([]*domain.Money{eur}, now))
+
+	assert.Len(t, repo.PriceBookMuts(product), 2)
+	assert.NotNil(t, repo.UpdateMut(product))
+	assert.Nil(t, repo.PriceTierMuts(product))
+}
+
+func TestProductRepo_SegmentPriceMuts(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, repo.SegmentPriceMuts(product))
+
+	wholesale, err := domain.NewSegmentPercentOff("wholesale", big.NewRat(15, 1))
+	require.NoError(t, err)
+	require.NoError(t, product.SetSegmentPrices([]*domain.SegmentPrice{wholesale}, now))
+
+	assert.Len(t, repo.SegmentPriceMuts(product), 2)
+	assert.NotNil(t, repo.UpdateMut(product))
+	assert.Nil(t, repo.PriceBookMuts(product))
+}
+
+func TestSegmentPriceData_RoundTrip(t *testing.T) {
+	fixed, err := domain.NewSegmentPriceOverride("employee", domain.NewMoney(1200, 100))
+	require.NoError(t, err)
+	pct, err := domain.NewSegmentPercentOff("wholesale", big.NewRat(25, 2))
+	require.NoError(t, err)
+
+	for _, price := range []*domain.SegmentPrice{fixed, pct} {
+		got, err := dataToSegmentPrice(segmentPriceToData("product-123", price), domain.DefaultCurrency)
+		require.NoError(t, err)
+		assert.True(t, price.Equals(got))
+	}
+
+	// A row holding both a fixed price and a percent off is not a valid segment price
+	mixed := segmentPriceToData("product-123", fixed)
+	mixed.PercentOff = percentToNumeric(big.NewRat(10, 1))
+	_, err = dataToSegmentPrice(mixed, domain.DefaultCurrency)
+	assert.ErrorIs(t, err, domain.ErrInvalidSegmentPrice)
+
+	// Rows are returned sorted by segment and invalid rows are skipped
+	rows := []*SegmentPriceData{segmentPriceToData("product-123", pct), mixed, segmentPriceToData("product-123", fixed)}
+	prices := productSegmentPrices(rows, domain.DefaultCurrency)
+	require.Len(t, prices, 2)
+	assert.Equal(t, []contract.SegmentPriceDTO{
+		{Segment: "employee", PriceNum: 12, PriceDenom: 1},
+		{Segment: "wholesale", PercentOff: 12.5},
+	}, segmentPriceDTOs(prices))
+}
+
+func TestProductRepo_MarketPriceMuts(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, repo.MarketPriceMuts(product))
+
+	eur, err := domain.NewMoneyInCurrency(1849, 100, "EUR")
+	require.NoError(t, err)
+	eu, err := domain.NewMarketPrice(domain.MarketEU, eur)
+	require.NoError(t, err)
+	require.NoError(t, product.SetMarketPrices([]*domain.MarketPrice{eu}, now))
+
+	assert.Len(t, repo.MarketPriceMuts(product), 2)
+	assert.NotNil(t, repo.UpdateMut(product))
+	assert.Nil(t, repo.SegmentPriceMuts(product))
+}
+
+func TestMarketPriceData_RoundTrip(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	gbp, err := domain.NewMoneyInCurrency(1599, 100, "GBP")
+	require.NoError(t, err)
+	uk, err := domain.NewMarketPrice(domain.MarketUK, gbp)
+	require.NoError(t, err)
+
+	got, err := dataToMarketPrice(marketPriceToData("product-123", uk))
+	require.NoError(t, err)
+	assert.True(t, uk.Equals(got))
+
+	// Rows of unknown markets are skipped
+	unknown := &MarketPriceData{ProductID: "product-123", Market: "JP", PriceNumerator: 2000, PriceDenominator: 1}
+	prices := productMarketPrices([]*MarketPriceData{unknown, marketPriceToData("product-123", uk)})
+	require.Len(t, prices, 1)
+
+	// A discount scoped to the market applies to its price only
+	discount, err := domain.NewDiscount(big.NewRat(10, 1), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+	scoped := discountToData("product-123", discount.WithID("uk-sale").WithMarket(domain.MarketUK))
+	assert.Equal(t, domain.MarketUK, dataToDiscount(scoped, domain.DefaultCurrency).Market())
+
+	data := discountRow(now, false, false, false)
+	dto := dataToDTO(data, []*DiscountData{scoped}, []*MarketPriceData{marketPriceToData("product-123", uk)}, now)
+	assert.False(t, dto.HasActiveDiscount)
+	assert.Nil(t, dto.DiscountPercent)
+	require.Len(t, dto.Discounts, 1)
+	assert.Equal(t, "UK", dto.Discounts[0].Market)
+	require.Len(t, dto.MarketPrices, 1)
+	assert.Equal(t, "GBP", dto.MarketPrices[0].Currency)
+	assert.True(t, dto.MarketPrices[0].HasActiveDiscount)
+	assert.Equal(t, int64(14391), dto.MarketPrices[0].EffectivePriceNum)
+	assert.Equal(t, int64(1000), dto.MarketPrices[0].EffectivePriceDenom)
+}
+
+func TestProductTaxClass(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	tests := []struct {
+		name     string
+		column   spanner.NullString
+		expected domain.TaxClass
+	}{
+		{"written before tax classes", spanner.NullString{}, domain.DefaultTaxClass},
+		{"reduced", spanner.NullString{StringVal: "reduced", Valid: true}, "reduced"},
+		{"malformed", spanner.NullString{StringVal: "Reduced Rate", Valid: true}, domain.DefaultTaxClass},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := discountRow(now, false, false, false)
+			data.TaxClass = tt.column
+
+			product, err := repo.dataToDomain(data, nil, nil, nil, nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, product.TaxClass())
+			assert.Equal(t, tt.expected.String(), dataToDTO(data, nil, nil, now).TaxClass)
+		})
+	}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, product.SetTaxClass("reduced", now))
+	assert.NotNil(t, repo.UpdateMut(product))
+	assert.Equal(t, spanner.NullString{StringVal: "reduced", Valid: true}, repo.productToData(product).TaxClass)
+}
+
+func TestProductPriceBook(t *testing.T) {
+	rows := []*PriceData{
+		{ProductID: "product-123", Currency: "GBP", PriceNumerator: 1599, PriceDenominator: 100},
+		{ProductID: "product-123", Currency: "EUR", PriceNumerator: 1849, PriceDenominator: 100},
+		{ProductID: "product-123", Currency: "EU", PriceNumerator: 1849, PriceDenominator: 100},
+		{ProductID: "product-123", Currency: "JPY", PriceNumerator: 0, PriceDenominator: 1},
+	}
+
+	prices := productPriceBook(rows)
+
+	require.Len(t, prices, 2)
+	assert.Equal(t, "EUR", prices[0].Currency())
+	assert.Equal(t, "GBP", prices[1].Currency())
+	assert.Equal(t, []contract.PriceBookEntryDTO{
+		{Currency: "EUR", PriceNum: 1849, PriceDenom: 100},
+		{Currency: "GBP", PriceNum: 1599, PriceDenom: 100},
+	}, priceBookDTOs(prices))
+}
+
+func TestPriceTierData_RoundTrip(t *testing.T) {
+	unit, err := domain.NewUnitPriceTier(10, domain.NewMoney(1800, 100))
+	require.NoError(t, err)
+	pct, err := domain.NewPercentOffTier(100, big.NewRat(25, 2))
+	require.NoError(t, err)
+
+	for _, tier := range []*domain.PriceTier{unit, pct} {
+		data := priceTierToData("product-123", tier)
+		got := dataToPriceTier(data, domain.DefaultCurrency)
+		require.NotNil(t, got)
+		assert.True(t, tier.Equals(got))
+	}
+
+	// A row holding both a unit price and a percent off is not a valid tier
+	mixed := priceTierToData("product-123", unit)
+	mixed.PercentOff = percentToNumeric(big.NewRat(10, 1))
+	assert.Nil(t, dataToPriceTier(mixed, domain.DefaultCurrency))
+
+	// Rows are returned sorted by minimum quantity and invalid rows are skipped
+	rows := []*PriceTierData{priceTierToData("product-123", pct), mixed, priceTierToData("product-123", unit)}
+	tiers := productPriceTiers(rows, domain.DefaultCurrency)
+	require.Len(t, tiers, 2)
+	assert.Equal(t, int64(10), tiers[0].MinQuantity())
+	assert.Equal(t, int64(100), tiers[1].MinQuantity())
+}
+
+func TestDataToQuantityPriceDTO(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	data := discountRow(now, true, true, true)
+
+	tier, err := domain.NewUnitPriceTier(10, domain.NewMoney(1600, 100))
+	require.NoError(t, err)
+	rows := []*PriceTierData{priceTierToData(data.ProductID, tier)}
+
+	price, err := dataToQuantityPriceDTO(data, nil, rows, 12, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), price.Quantity)
+	assert.Equal(t, int64(10), price.TierMinQuantity)
+	require.NotNil(t, price.DiscountPercent)
+	assert.Equal(t, 25.0, *price.DiscountPercent)
+	assert.Zero(t, big.NewRat(12, 1).Cmp(big.NewRat(price.UnitPriceNum, price.UnitPriceDenom)))
+	assert.Zero(t, big.NewRat(144, 1).Cmp(big.NewRat(price.TotalPriceNum, price.TotalPriceDenom)))
+
+	_, err = dataToQuantityPriceDTO(data, nil, rows, 0, now)
+	assert.ErrorIs(t, err, domain.ErrInvalidQuantity)
+}
+
+func TestProductRepo_PendingPriceChange(t *testing.T) {
+	now := time.Date(2024, 6, 1, 18, 0, 0, 0, time.UTC)
+	midnight := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product := domain.ReconstructProduct(
+		"product-123", "Widget", "", "", "Tools", "", "",
+		domain.NewMoney(2000, 100), nil, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", domain.DefaultTaxClass, domain.ProductStatusActive, nil, nil, nil, now, now, nil,
+	)
+	require.NoError(t, product.SchedulePriceChange(domain.NewMoney(1800, 100), midnight, now))
+	assert.NotNil(t, repo.UpdateMut(product))
+
+	data := repo.productToData(product)
+	assert.Equal(t, spanner.NullInt64{Int64: 18, Valid: true}, data.PendingPriceNum)
+	assert.Equal(t, spanner.NullInt64{Int64: 1, Valid: true}, data.PendingPriceDenom)
+	assert.Equal(t, spanner.NullTime{Time: midnight, Valid: true}, data.PendingPriceAt)
+
+	loaded, err := repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.NotNil(t, loaded.PendingPriceChange())
+	assert.True(t, loaded.PendingPriceChange().Price().Equals(domain.NewMoney(18, 1)))
+	assert.Equal(t, midnight, loaded.PendingPriceChange().EffectiveAt())
+
+	dto := dataToDTO(data, nil, nil, now)
+	assert.Equal(t, int64(18), dto.PendingPriceNum)
+	assert.Equal(t, int64(1), dto.PendingPriceDenom)
+	require.NotNil(t, dto.PendingPriceEffectiveAt)
+	assert.Equal(t, midnight, *dto.PendingPriceEffectiveAt)
+	assert.Equal(t, int64(20), dto.BasePriceNum)
+
+	// A row with only some of the columns set has no pending change.
+	data.PendingPriceAt = spanner.NullTime{}
+	loaded, err = repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, loaded.PendingPriceChange())
+	assert.Nil(t, dataToDTO(data, nil, nil, now).PendingPriceEffectiveAt)
+}
+
+func TestProductRepo_TagsAndAttributes(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, product.Tags())
+	assert.Empty(t, product.Attributes())
+	data := repo.productToData(product)
+	assert.Nil(t, data.Tags)
+	assert.False(t, data.Attributes.Valid)
+
+	require.NoError(t, product.AddTag("sale", now))
+	require.NoError(t, product.AddTag("eco", now))
+	require.NoError(t, product.SetAttribute("material", "oak", now))
+	assert.NotNil(t, repo.UpdateMut(product))
+
+	data = repo.productToData(product)
+	assert.Equal(t, []string{"eco", "sale"}, data.Tags)
+	require.True(t, data.Attributes.Valid)
+
+	loaded, err := repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eco", "sale"}, loaded.Tags())
+	assert.Equal(t, map[string]string{"material": "oak"}, loaded.Attributes())
+
+	dto := dataToDTO(data, nil, nil, now)
+	assert.Equal(t, []string{"eco", "sale"}, dto.Tags)
+	assert.Equal(t, map[string]string{"material": "oak"}, dto.Attributes)
+
+	// Attributes are read back from JSON as decoded by the Spanner client.
+	data.Attributes = spanner.NullJSON{Value: map[string]interface{}{"material": "oak"}, Valid: true}
+	assert.Equal(t, map[string]string{"material": "oak"}, productAttributes(data))
+	data.Attributes = spanner.NullJSON{Value: []interface{}{"oak"}, Valid: true}
+	assert.Empty(t, productAttributes(data), "malformed attributes are read as none")
+}
+
+func TestProductRepo_Slug(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, product.Slug())
+	data := repo.productToData(product)
+	assert.False(t, data.Slug.Valid, "products without a slug are NULL, which the unique index allows many of")
+
+	require.NoError(t, product.ChangeSlug("blue-widget", now))
+	assert.NotNil(t, repo.UpdateMut(product))
+
+	data = repo.productToData(product)
+	assert.Equal(t, spanner.NullString{StringVal: "blue-widget", Valid: true}, data.Slug)
+
+	loaded, err := repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "blue-widget", loaded.Slug())
+	assert.Equal(t, "blue-widget", dataToDTO(data, nil, nil, now).Slug)
+}
+
+func TestProductRepo_Identifiers(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	data := repo.productToData(product)
+	assert.False(t, data.SKU.Valid, "products without a SKU are NULL, which the unique index allows many of")
+	assert.False(t, data.GTIN.Valid)
+
+	require.NoError(t, product.ChangeIdentifiers("WDG-1", "4006381333931", now))
+	assert.NotNil(t, repo.UpdateMut(product))
+
+	data = repo.productToData(product)
+	assert.Equal(t, spanner.NullString{StringVal: "WDG-1", Valid: true}, data.SKU)
+	assert.Equal(t, spanner.NullString{StringVal: "4006381333931", Valid: true}, data.GTIN)
+
+	loaded, err := repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "WDG-1", loaded.SKU())
+	assert.Equal(t, "4006381333931", loaded.GTIN())
+	dto := dataToDTO(data, nil, nil, now)
+	assert.Equal(t, "WDG-1", dto.SKU)
+	assert.Equal(t, "4006381333931", dto.GTIN)
+}
+
+func TestProductRepo_Dimensions(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	data := repo.productToData(product)
+	assert.False(t, data.Weight.Valid)
+	assert.False(t, data.Height.Valid)
+
+	weight, err := domain.NewWeight(2, domain.WeightUnitPound)
+	require.NoError(t, err)
+	dimensions, err := domain.NewDimensions(10, 20, 5, domain.LengthUnitCentimetre)
+	require.NoError(t, err)
+	require.NoError(t, product.ChangeDimensions(weight, dimensions, now))
+	assert.NotNil(t, repo.UpdateMut(product))
+
+	data = repo.productToData(product)
+	assert.Equal(t, spanner.NullFloat64{Float64: 2, Valid: true}, data.Weight)
+	assert.Equal(t, spanner.NullString{StringVal: "lb", Valid: true}, data.WeightUnit)
+	assert.Equal(t, spanner.NullString{StringVal: "cm", Valid: true}, data.DimensionUnit)
+
+	loaded, err := repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, weight.Equal(loaded.Weight()))
+	assert.True(t, dimensions.Equal(loaded.Dimensions()))
+	dto := dataToDTO(data, nil, nil, now)
+	require.NotNil(t, dto.Weight)
+	assert.InDelta(t, 907.18474, dto.Weight.Grams, 1e-9)
+	require.NotNil(t, dto.Dimensions)
+	assert.Equal(t, "cm", dto.Dimensions.Unit)
+	assert.InDelta(t, 100, dto.Dimensions.HeightMM, 1e-9)
+
+	data.WeightUnit = spanner.NullString{StringVal: "stone", Valid: true}
+	loaded, err = repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, loaded.Weight(), "an invalid weight is read as none")
+}
+
+func TestProductRepo_Kind(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	data := repo.productToData(product)
+	assert.False(t, data.Kind.Valid, "an unset kind is stored as NULL")
+	assert.Empty(t, dataToDTO(data, nil, nil, now).Kind)
+
+	require.NoError(t, product.ChangeKind(domain.ProductKindDigital, nil, nil, now))
+	assert.NotNil(t, repo.UpdateMut(product))
+
+	data = repo.productToData(product)
+	assert.Equal(t, spanner.NullString{StringVal: "digital", Valid: true}, data.Kind)
+	assert.Equal(t, "digital", dataToDTO(data, nil, nil, now).Kind)
+	loaded, err := repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProductKindDigital, loaded.Kind())
+
+	data.Kind = spanner.NullString{StringVal: "service", Valid: true}
+	loaded, err = repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, loaded.Kind(), "an unknown kind is read as unset")
+}
+
+func TestProductRepo_Compliance(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	data := repo.productToData(product)
+	assert.False(t, data.MinimumAge.Valid, "a product without flags stores NULL")
+	assert.Nil(t, data.RestrictedMarkets)
+	assert.Nil(t, dataToDTO(data, nil, nil, now).Compliance)
+
+	compliance, err := domain.NewCompliance(18, true, []domain.Market{domain.MarketUS, domain.MarketUK})
+	require.NoError(t, err)
+	require.NoError(t, product.ChangeCompliance(compliance, now))
+	assert.NotNil(t, repo.UpdateMut(product))
+
+	data = repo.productToData(product)
+	assert.Equal(t, spanner.NullInt64{Int64: 18, Valid: true}, data.MinimumAge)
+	assert.Equal(t, spanner.NullBool{Bool: true, Valid: true}, data.Hazardous)
+	assert.Equal(t, []string{"UK", "US"}, data.RestrictedMarkets)
+	assert.Equal(t, &contract.ComplianceDTO{MinimumAge: 18, Hazardous: true, RestrictedMarkets: []string{"UK", "US"}},
+		dataToDTO(data, nil, nil, now).Compliance)
+	loaded, err := repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, compliance.Equal(loaded.Compliance()))
+
+	data.RestrictedMarkets = []string{"UK", "MARS"}
+	loaded, err = repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Market{domain.MarketUK}, loaded.Compliance().RestrictedMarkets(), "unknown markets are ignored")
+}
+
+func TestBuildListQuery_Tag(t *testing.T) {
+	rm := &ProductReadModel{}
+
+	stmt, err := rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{Tag: "sale"}, contract.Pagination{PageSize: 10}, time.Time{})
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "AND @tag IN UNNEST(tags)")
+	assert.Equal(t, "sale", stmt.Params["tag"])
+}
+
+func TestProductRepo_Channels(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	data := repo.productToData(product)
+	assert.Nil(t, data.UnavailableChannels, "a product available everywhere is stored as NULL")
+	assert.Equal(t, []string{"marketplace", "retail", "web"}, dataToDTO(data, nil, nil, now).Channels)
+
+	err = product.SetChannelAvailability(map[domain.SalesChannel]bool{domain.SalesChannelWeb: false, domain.SalesChannelRetail: false}, now)
+	require.NoError(t, err)
+	assert.NotNil(t, repo.UpdateMut(product))
+
+	data = repo.productToData(product)
+	assert.Equal(t, []string{"retail", "web"}, data.UnavailableChannels)
+	assert.Equal(t, []string{"marketplace"}, dataToDTO(data, nil, nil, now).Channels)
+
+	data.UnavailableChannels = append(data.UnavailableChannels, "kiosk")
+	loaded, err := repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SalesChannel{domain.SalesChannelMarketplace}, loaded.Channels(), "unknown channels are ignored")
+}
+
+func TestBuildListQuery_Channel(t *testing.T) {
+	rm := &ProductReadModel{}
+
+	stmt, err := rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{Channel: "web"}, contract.Pagination{PageSize: 10}, time.Time{})
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "AND (unavailable_channels IS NULL OR @channel NOT IN UNNEST(unavailable_channels))")
+	assert.Equal(t, "web", stmt.Params["channel"])
+}
+
+func TestBuildListQuery_Archived(t *testing.T) {
+	rm := &ProductReadModel{}
+	page := contract.Pagination{PageSize: 10}
+
+	stmt, err := rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{}, page, time.Time{})
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "AND status != 'archived'")
+
+	stmt, err = rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{Status: "archived"}, page, time.Time{})
+	require.NoError(t, err)
+	assert.NotContains(t, stmt.SQL, "AND status != 'archived'")
+
+	stmt, err = rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{IncludeArchived: true}, page, time.Time{})
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(stmt.SQL, "FROM products WHERE 1=1 ORDER BY product_id LIMIT 10"))
+	assert.NotContains(t, buildCountQuery(ProductsTable, tenantScope{}, contract.ListProductsFilter{IncludeArchived: true}, time.Time{}).SQL, "archived")
+}
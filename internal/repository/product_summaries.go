@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// SummaryMut returns a mutation that rewrites the summary of a product as of the given
+// time, deletes it if the product is being purged, or nil if the product has neither
+// pending changes nor pending events.
+func (r *ProductRepo) SummaryMut(product *domain.Product, at time.Time) *spanner.Mutation {
+	for _, event := range product.DomainEvents() {
+		if _, ok := event.(domain.ProductPurgedEvent); ok {
+			return spanner.Delete(ProductSummariesTable, spanner.Key{product.ID()})
+		}
+	}
+	if !product.Changes().HasChanges() && len(product.DomainEvents()) == 0 {
+		return nil
+	}
+	return ProjectSummaryMut(product, at)
+}
+
+// ProjectSummaryMut returns the mutation that writes the summary of a product as of the
+// given time.
+func ProjectSummaryMut(product *domain.Product, at time.Time) *spanner.Mutation {
+	return summaryToData(product, at).InsertOrUpdateMutation()
+}
+
+// summaryToData converts a product to its summary as of the given time. Its price bounds
+// are those of the price periods not over at that time.
+func summaryToData(product *domain.Product, at time.Time) *ProductSummaryData {
+	var minPrice, maxPrice *domain.Money
+	for _, period := range product.PricePeriods() {
+		if !period.Until.IsZero() && !period.Until.After(at) {
+			continue
+		}
+		if minPrice == nil || period.Price.LessThan(minPrice) {
+			minPrice = period.Price
+		}
+		if maxPrice == nil || period.Price.GreaterThan(maxPrice) {
+			maxPrice = period.Price
+		}
+	}
+	return &ProductSummaryData{
+		ProductID:           product.ID(),
+		TenantID:            optionalStringColumn(product.TenantID()),
+		Name:                product.Name(),
+		Category:            product.Category(),
+		Status:              string(product.Status()),
+		MinPriceNumerator:   minPrice.Numerator(),
+		MinPriceDenominator: minPrice.Denominator(),
+		MaxPriceNumerator:   maxPrice.Numerator(),
+		MaxPriceDenominator: maxPrice.Denominator(),
+	}
+}
+
+// WithSummaryListing makes ListProducts scan product_summaries rather than products for
+// the listings it can answer from them; see summaryListable. Enable it once every product
+// is summarized.
+func WithSummaryListing() ReadModelOption {
+	return func(rm *ProductReadModel) {
+		rm.summaryListing = true
+	}
+}
+
+// listsSummaries reports whether ListProducts scans product_summaries for a listing.
+func (rm *ProductReadModel) listsSummaries(filter contract.ListProductsFilter, pagination contract.Pagination) bool {
+	return rm.summaryListing && summaryListable(filter, pagination)
+}
+
+// summaryListable reports whether a listing filters and orders by summary columns only:
+// the category, status and price range, in product ID or name order.
+func summaryListable(filter contract.ListProductsFilter, pagination contract.Pagination) bool {
+	if filter.Tag != "" || filter.Channel != "" || filter.InStockOnly || filter.HasActiveDiscount {
+		return false
+	}
+	switch pagination.OrderBy {
+	case "", contract.OrderByProductID, contract.OrderByName, contract.OrderByNameDesc:
+		return true
+	default:
+		return false
+	}
+}
+
+// summaryPriceRangeCondition returns the SQL condition listing only the summarized
+// products whose effective price at is within the bounds of filter, adding its parameters
+// to params; "" if filter bounds no price. The price bounds of the summaries rule out the
+// products whose price cannot be in range without reading them. The others are read by
+// key and checked as priceRangeCondition checks products. Bounds written after at do not
+// cover it, so they rule out no product.
+func summaryPriceRangeCondition(filter contract.ListProductsFilter, at time.Time, params map[string]interface{}) string {
+	exact := priceRangeCondition(filter, at, params)
+	if exact == "" {
+		return ""
+	}
+
+	var bounds []string
+	if filter.MinPrice != nil {
+		bounds = append(bounds, `CAST(`+SummaryMaxPriceNumerator+` AS NUMERIC) * @min_price_den >= @min_price_num * `+SummaryMaxPriceDenominator)
+	}
+	if filter.MaxPrice != nil {
+		bounds = append(bounds, `CAST(`+SummaryMinPriceNumerator+` AS NUMERIC) * @max_price_den <= @max_price_num * `+SummaryMinPriceDenominator)
+	}
+	return ` AND (` + SummarySummarizedAt + ` > @price_at OR (` + strings.Join(bounds, ` AND `) + `))` +
+		` AND EXISTS (SELECT 1 FROM ` + ProductsTable + ` WHERE ` + ProductsTable + `.product_id = ` +
+		ProductSummariesTable + `.product_id` + exact + `)`
+}
+
+// queryProductIDs runs a query selecting product_id and returns the IDs in order.
+func (rm *ProductReadModel) queryProductIDs(ctx context.Context, txn *spanner.ReadOnlyTransaction, stmt spanner.Statement) ([]string, error) {
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	ids := make([]string, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return ids, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var id string
+		if err := row.Columns(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+}
+
+// readProductRows reads the product rows with the given IDs, in the order of ids. IDs
+// without a row are skipped.
+func (rm *ProductReadModel) readProductRows(ctx context.Context, txn *spanner.ReadOnlyTransaction, ids []string) ([]*ProductData, error) {
+	if len(ids) == 0 {
+		return []*ProductData{}, nil
+	}
+	rows, err := rm.queryProducts(ctx, txn, spanner.Statement{
+		SQL:    `SELECT ` + allColumnsSQL() + ` FROM products WHERE product_id IN UNNEST(@ids)`,
+		Params: map[string]interface{}{"ids": ids},
+	})
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*ProductData, len(rows))
+	for _, data := range rows {
+		byID[data.ProductID] = data
+	}
+	ordered := make([]*ProductData, 0, len(rows))
+	for _, id := range ids {
+		if data, ok := byID[id]; ok {
+			ordered = append(ordered, data)
+		}
+	}
+	return ordered, nil
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummaryToData(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	ended, err := domain.NewDiscount(big.NewRat(50, 1), now.Add(-2*time.Hour), now.Add(-time.Hour))
+	require.NoError(t, err)
+	upcoming, err := domain.NewDiscount(big.NewRat(25, 1), now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	product := domain.ReconstructProduct(
+		"product-123", "Widget", "", "", "Tools", "", "",
+		domain.NewMoney(2000, 100), []*domain.Discount{ended.WithID("discount-1"), upcoming.WithID("discount-2")}, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", domain.DefaultTaxClass, domain.ProductStatusActive, nil, nil, nil, now, now, nil,
+	)
+
+	data := summaryToData(product, now)
+
+	assert.Equal(t, "product-123", data.ProductID)
+	assert.Equal(t, spanner.NullString{}, data.TenantID)
+	assert.Equal(t, "Widget", data.Name)
+	assert.Equal(t, "Tools", data.Category)
+	assert.Equal(t, "active", data.Status)
+	// The ended discount no longer bounds the price; the upcoming one does.
+	assert.Equal(t, 0, big.NewRat(data.MinPriceNumerator, data.MinPriceDenominator).Cmp(big.NewRat(15, 1)))
+	assert.Equal(t, 0, big.NewRat(data.MaxPriceNumerator, data.MaxPriceDenominator).Cmp(big.NewRat(20, 1)))
+}
+
+func TestProductRepo_SummaryMut(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+	product, err := domain.NewProduct("product-123", "Widget", "", "Tools", domain.NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	assert.NotNil(t, repo.SummaryMut(product, now))
+
+	require.NoError(t, product.Archive(now))
+	product.ClearEvents()
+	require.NoError(t, product.Purge(now.Add(time.Hour), now.Add(2*time.Hour)))
+	assert.Equal(t, spanner.Delete(ProductSummariesTable, spanner.Key{"product-123"}), repo.SummaryMut(product, now))
+}
+
+func TestSummaryListable(t *testing.T) {
+	tests := []struct {
+		name       string
+		filter     contract.ListProductsFilter
+		pagination contract.Pagination
+		want       bool
+	}{
+		{name: "no filter", want: true},
+		{name: "category and status", filter: contract.ListProductsFilter{Category: "Tools", Status: "active"}, want: true},
+		{name: "archived included", filter: contract.ListProductsFilter{IncludeArchived: true}, want: true},
+		{name: "by name", pagination: contract.Pagination{OrderBy: contract.OrderByNameDesc}, want: true},
+		{name: "tag", filter: contract.ListProductsFilter{Tag: "sale"}},
+		{name: "in stock", filter: contract.ListProductsFilter{InStockOnly: true}},
+		{name: "price range", filter: contract.ListProductsFilter{MinPrice: &contract.PriceBound{Numerator: 10, Denominator: 1, Currency: "USD"}}, want: true},
+		{name: "active discount", filter: contract.ListProductsFilter{HasActiveDiscount: true}},
+		{name: "by price", pagination: contract.Pagination{OrderBy: contract.OrderByPrice}},
+		{name: "by rating", pagination: contract.Pagination{OrderBy: contract.OrderByRating}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, summaryListable(tt.filter, tt.pagination))
+		})
+	}
+}
+
+func TestBuildListQuery_Summaries(t *testing.T) {
+	rm := &ProductReadModel{summaryListing: true}
+	filter := contract.ListProductsFilter{Category: "Tools"}
+	page := contract.Pagination{PageSize: 10, OrderBy: contract.OrderByName}
+
+	stmt, err := rm.buildListQuery(tenantScope{scoped: true, tenant: "acme"}, filter, page, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT product_id FROM product_summaries WHERE 1=1 AND tenant_id = @tenant_id AND category = @category"+
+		" AND status != 'archived' ORDER BY name, product_id LIMIT 10", stmt.SQL)
+
+	filter.Tag = "sale"
+	stmt, err = rm.buildListQuery(tenantScope{}, filter, page, time.Time{})
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, " FROM products WHERE ")
+}
+
+func TestBuildListQuery_SummaryPriceRange(t *testing.T) {
+	rm := &ProductReadModel{summaryListing: true}
+	at := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	filter := contract.ListProductsFilter{
+		MinPrice: &contract.PriceBound{Numerator: 10, Denominator: 1, Currency: "USD"},
+		MaxPrice: &contract.PriceBound{Numerator: 20, Denominator: 1, Currency: "USD"},
+	}
+
+	stmt, err := rm.buildListQuery(tenantScope{}, filter, contract.Pagination{PageSize: 10}, at)
+	require.NoError(t, err)
+	// The bounds of the summaries rule out products, the effective price of the others is
+	// checked exactly.
+	assert.True(t, strings.HasPrefix(stmt.SQL, "SELECT product_id FROM product_summaries WHERE 1=1"))
+	assert.Contains(t, stmt.SQL, "AND (summarized_at > @price_at OR ("+
+		"CAST(max_price_numerator AS NUMERIC) * @min_price_den >= @min_price_num * max_price_denominator AND "+
+		"CAST(min_price_numerator AS NUMERIC) * @max_price_den <= @max_price_num * min_price_denominator))")
+	assert.Contains(t, stmt.SQL, "AND EXISTS (SELECT 1 FROM products WHERE products.product_id = product_summaries.product_id"+
+		" AND IFNULL(currency, 'USD') = @price_currency AND EXISTS (SELECT 1 FROM (")
+	assert.Equal(t, at, stmt.Params["price_at"])
+
+	count := buildCountQuery(ProductSummariesTable, tenantScope{}, filter, at)
+	where := strings.TrimPrefix(count.SQL, "SELECT COUNT(*) FROM product_summaries WHERE 1=1")
+	assert.NotEqual(t, count.SQL, where)
+	assert.Contains(t, stmt.SQL, where)
+}
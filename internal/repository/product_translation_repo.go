@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// ProductTranslationRepo implements the ProductTranslationRepository interface using
+// Spanner.
+type ProductTranslationRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.ProductTranslationRepository = (*ProductTranslationRepo)(nil)
+
+// NewProductTranslationRepo creates a new ProductTranslationRepo.
+func NewProductTranslationRepo(client *spanner.Client) *ProductTranslationRepo {
+	return &ProductTranslationRepo{client: client}
+}
+
+// FindByLocale retrieves the translation of a product in a locale, or nil if it has none.
+func (r *ProductTranslationRepo) FindByLocale(ctx context.Context, productID, locale string) (*domain.ProductTranslation, error) {
+	row, err := r.client.Single().ReadRow(ctx, TranslationsTable, spanner.Key{productID, locale}, translationColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == 5 { // NOT_FOUND
+			return nil, nil
+		}
+		return nil, err
+	}
+	return translationFromRow(row)
+}
+
+// SaveMut returns the mutation that inserts or replaces the product_translations row of
+// a translation.
+func (r *ProductTranslationRepo) SaveMut(translation *domain.ProductTranslation) *spanner.Mutation {
+	description := spanner.NullString{StringVal: translation.Description(), Valid: translation.Description() != ""}
+	return spanner.InsertOrUpdateMap(TranslationsTable, map[string]interface{}{
+		TranslationProductID:   translation.ProductID(),
+		TranslationLocale:      translation.Locale(),
+		TranslationName:        translation.Name(),
+		TranslationDescription: description,
+		TranslationUpdatedAt:   translation.UpdatedAt(),
+	})
+}
+
+// DeleteMut returns the mutation that deletes the product_translations row of a product
+// in a locale.
+func (r *ProductTranslationRepo) DeleteMut(productID, locale string) *spanner.Mutation {
+	return spanner.Delete(TranslationsTable, spanner.Key{productID, locale})
+}
+
+// readTranslations reads the translations of the given products, ordered by locale and
+// keyed by product ID. Rows that do not hold a valid translation are skipped.
+func readTranslations(ctx context.Context, reader rowReader, productIDs []string) (map[string][]*domain.ProductTranslation, error) {
+	translations := make(map[string][]*domain.ProductTranslation)
+	if len(productIDs) == 0 {
+		return translations, nil
+	}
+
+	keys := make([]spanner.KeySet, len(productIDs))
+	for i, id := range productIDs {
+		keys[i] = spanner.Key{id}.AsPrefix()
+	}
+
+	iter := reader.Read(ctx, TranslationsTable, spanner.KeySets(keys...), translationColumns())
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return translations, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		translation, err := translationFromRow(row)
+		if err != nil {
+			continue
+		}
+		translations[translation.ProductID()] = append(translations[translation.ProductID()], translation)
+	}
+}
+
+// translationFromRow decodes a product_translations row read with translationColumns.
+// It fails if the row does not hold a valid translation.
+func translationFromRow(row *spanner.Row) (*domain.ProductTranslation, error) {
+	var (
+		productID   string
+		locale      string
+		name        string
+		description spanner.NullString
+		updatedAt   time.Time
+	)
+	if err := row.Columns(&productID, &locale, &name, &description, &updatedAt); err != nil {
+		return nil, err
+	}
+	return domain.NewProductTranslation(productID, locale, name, description.StringVal, updatedAt)
+}
+
+// translationDTOs converts the translations of a product to DTOs, ordered by locale.
+func translationDTOs(translations []*domain.ProductTranslation) []contract.TranslationDTO {
+	if len(translations) == 0 {
+		return nil
+	}
+	dtos := make([]contract.TranslationDTO, len(translations))
+	for i, t := range translations {
+		dtos[i] = contract.TranslationDTO{
+			Locale:      t.Locale(),
+			Name:        t.Name(),
+			Description: t.Description(),
+		}
+	}
+	return dtos
+}
+
+// translationColumns returns the columns of a product_translations row, in the order
+// readTranslations decodes them.
+func translationColumns() []string {
+	return []string{TranslationProductID, TranslationLocale, TranslationName, TranslationDescription, TranslationUpdatedAt}
+}
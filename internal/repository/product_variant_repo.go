@@ -0,0 +1,253 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// ProductVariantRepo implements the ProductVariantRepository interface using Spanner.
+type ProductVariantRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.ProductVariantRepository = (*ProductVariantRepo)(nil)
+
+// NewProductVariantRepo creates a new ProductVariantRepo.
+func NewProductVariantRepo(client *spanner.Client) *ProductVariantRepo {
+	return &ProductVariantRepo{client: client}
+}
+
+// FindByID retrieves a variant of a product by its ID, with its price delta in the
+// currency of the product.
+func (r *ProductVariantRepo) FindByID(ctx context.Context, productID, variantID string) (*domain.ProductVariant, error) {
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	row, err := txn.ReadRow(ctx, ProductsTable, spanner.Key{productID}, []string{ProductCurrency})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrVariantNotFound
+		}
+		return nil, err
+	}
+	var currency spanner.NullString
+	if err := row.Columns(&currency); err != nil {
+		return nil, err
+	}
+
+	row, err = txn.ReadRow(ctx, VariantsTable, spanner.Key{productID, variantID}, variantColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, domain.ErrVariantNotFound
+		}
+		return nil, err
+	}
+	return variantFromRow(row, productCurrency(&ProductData{ProductID: productID, Currency: currency}))
+}
+
+// FindByProductID retrieves the variants of a product, ordered by variant ID, with their
+// price deltas in the currency of the product.
+func (r *ProductVariantRepo) FindByProductID(ctx context.Context, productID string) ([]*domain.ProductVariant, error) {
+	txn := r.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	row, err := txn.ReadRow(ctx, ProductsTable, spanner.Key{productID}, []string{ProductCurrency})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var currency spanner.NullString
+	if err := row.Columns(&currency); err != nil {
+		return nil, err
+	}
+
+	variants, err := readVariants(ctx, txn, map[string]string{
+		productID: productCurrency(&ProductData{ProductID: productID, Currency: currency}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return variants[productID], nil
+}
+
+// FindIDBySKU returns the ID of the variant with the given SKU, of any product.
+func (r *ProductVariantRepo) FindIDBySKU(ctx context.Context, sku string) (string, error) {
+	row, err := r.client.Single().ReadRowUsingIndex(ctx, VariantsTable, VariantSKUIndex, spanner.Key{sku}, []string{VariantID})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return "", domain.ErrVariantNotFound
+		}
+		return "", err
+	}
+	var id string
+	if err := row.Columns(&id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// InsertMut returns a mutation for inserting a new variant.
+func (r *ProductVariantRepo) InsertMut(v *domain.ProductVariant) *spanner.Mutation {
+	return spanner.InsertMap(VariantsTable, map[string]interface{}{
+		VariantProductID:           v.ProductID(),
+		VariantID:                  v.ID(),
+		VariantSKU:                 v.SKU(),
+		VariantAttributes:          spanner.NullJSON{Value: v.Attributes(), Valid: true},
+		VariantPriceDeltaNumerator: v.PriceDelta().Numerator(),
+		VariantPriceDeltaDenom:     v.PriceDelta().Denominator(),
+		VariantStatus:              v.Status().String(),
+		VariantCreatedAt:           v.CreatedAt(),
+		VariantUpdatedAt:           v.UpdatedAt(),
+	})
+}
+
+// UpdateMut returns a mutation persisting the SKU, attributes, price delta, status and
+// update time of a variant.
+func (r *ProductVariantRepo) UpdateMut(v *domain.ProductVariant) *spanner.Mutation {
+	return spanner.UpdateMap(VariantsTable, map[string]interface{}{
+		VariantProductID:           v.ProductID(),
+		VariantID:                  v.ID(),
+		VariantSKU:                 v.SKU(),
+		VariantAttributes:          spanner.NullJSON{Value: v.Attributes(), Valid: true},
+		VariantPriceDeltaNumerator: v.PriceDelta().Numerator(),
+		VariantPriceDeltaDenom:     v.PriceDelta().Denominator(),
+		VariantStatus:              v.Status().String(),
+		VariantUpdatedAt:           v.UpdatedAt(),
+	})
+}
+
+// readVariants reads the variants of several products in one read, keyed by product ID
+// and ordered by variant ID. currencies maps the ID of each product to its currency, in
+// which its price deltas are. Rows that do not hold a valid variant are skipped.
+func readVariants(ctx context.Context, reader rowReader, currencies map[string]string) (map[string][]*domain.ProductVariant, error) {
+	variants := make(map[string][]*domain.ProductVariant)
+	if len(currencies) == 0 {
+		return variants, nil
+	}
+
+	keys := make([]spanner.KeySet, 0, len(currencies))
+	for id := range currencies {
+		keys = append(keys, spanner.Key{id}.AsPrefix())
+	}
+
+	iter := reader.Read(ctx, VariantsTable, spanner.KeySets(keys...), variantColumns())
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return variants, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var productID string
+		if err := row.ColumnByName(VariantProductID, &productID); err != nil {
+			return nil, err
+		}
+		v, err := variantFromRow(row, currencies[productID])
+		if err != nil {
+			logging.Warnf("repository: product %s: skipping invalid variant: %v", productID, err)
+			continue
+		}
+		variants[productID] = append(variants[productID], v)
+	}
+}
+
+// variantDTOs converts the variants of a product to their read representation, priced
+// from the base and effective prices of dto. Discounts apply to a variant in proportion
+// to its price.
+func variantDTOs(variants []*domain.ProductVariant, dto *contract.ProductDTO) []contract.VariantDTO {
+	if len(variants) == 0 {
+		return nil
+	}
+	basePrice, err := domain.NewMoneyInCurrency(dto.BasePriceNum, dto.BasePriceDenom, dto.Currency)
+	if err != nil || !basePrice.IsPositive() {
+		return nil
+	}
+	discount := big.NewRat(1, 1)
+	if dto.EffectivePriceDenom != 0 {
+		discount.Quo(big.NewRat(dto.EffectivePriceNum, dto.EffectivePriceDenom), basePrice.Amount())
+	}
+
+	dtos := make([]contract.VariantDTO, 0, len(variants))
+	for _, v := range variants {
+		price, err := v.Price(basePrice)
+		if err != nil {
+			continue
+		}
+		effective := new(big.Rat).Mul(price.Amount(), discount)
+		dtos = append(dtos, contract.VariantDTO{
+			ID:                  v.ID(),
+			SKU:                 v.SKU(),
+			Attributes:          v.Attributes(),
+			PriceDeltaNum:       v.PriceDelta().Numerator(),
+			PriceDeltaDenom:     v.PriceDelta().Denominator(),
+			PriceNum:            price.Numerator(),
+			PriceDenom:          price.Denominator(),
+			EffectivePriceNum:   effective.Num().Int64(),
+			EffectivePriceDenom: effective.Denom().Int64(),
+			Status:              v.Status().String(),
+		})
+	}
+	return dtos
+}
+
+// variantColumns returns the columns of a product_variants row, in the order
+// variantFromRow expects them.
+func variantColumns() []string {
+	return []string{
+		VariantProductID,
+		VariantID,
+		VariantSKU,
+		VariantAttributes,
+		VariantPriceDeltaNumerator,
+		VariantPriceDeltaDenom,
+		VariantStatus,
+		VariantCreatedAt,
+		VariantUpdatedAt,
+	}
+}
+
+// variantFromRow reads a row of the columns returned by variantColumns into a variant; the
+// price delta is in the currency of the product.
+func variantFromRow(row *spanner.Row, currency string) (*domain.ProductVariant, error) {
+	var (
+		productID, id, sku, status string
+		attributesJSON             spanner.NullJSON
+		numerator, denominator     int64
+		createdAt, updatedAt       time.Time
+	)
+	if err := row.Columns(&productID, &id, &sku, &attributesJSON, &numerator, &denominator, &status, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	attributes := make(map[string]string)
+	if attributesJSON.Valid {
+		raw, err := json.Marshal(attributesJSON.Value)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, &attributes); err != nil {
+			return nil, fmt.Errorf("variant %s: decode attributes: %w", id, err)
+		}
+	}
+	delta, err := domain.NewMoneyInCurrency(numerator, denominator, currency)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ReconstructProductVariant(id, productID, sku, attributes, delta,
+		domain.VariantStatus(status), createdAt, updatedAt), nil
+}
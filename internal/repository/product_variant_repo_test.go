@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductVariantRepo_Muts(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductVariantRepo{}
+
+	product, err := domain.NewProduct("product-1", "Shirt", "", "Apparel", domain.NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	v, err := domain.NewProductVariant("variant-1", product, "SHIRT-M", map[string]string{"size": "M"}, domain.NewMoney(0, 1), now)
+	require.NoError(t, err)
+
+	assert.NotNil(t, repo.InsertMut(v))
+	require.NoError(t, v.Discontinue(now))
+	assert.NotNil(t, repo.UpdateMut(v))
+}
+
+func TestVariantDTOs(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	product, err := domain.NewProduct("product-1", "Shirt", "", "Apparel", domain.NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	small, err := domain.NewProductVariant("variant-1", product, "SHIRT-S", map[string]string{"size": "S"}, domain.NewMoney(-500, 100), now)
+	require.NoError(t, err)
+	large, err := domain.NewProductVariant("variant-2", product, "SHIRT-XL", map[string]string{"size": "XL"}, domain.NewMoney(500, 100), now)
+	require.NoError(t, err)
+
+	// The product is 25% off
+	dto := &contract.ProductDTO{
+		BasePriceNum: 2000, BasePriceDenom: 100,
+		EffectivePriceNum: 1500, EffectivePriceDenom: 100,
+		Currency: "USD",
+	}
+	dtos := variantDTOs([]*domain.ProductVariant{small, large}, dto)
+
+	require.Len(t, dtos, 2)
+	assert.Equal(t, "SHIRT-S", dtos[0].SKU)
+	assert.Equal(t, map[string]string{"size": "S"}, dtos[0].Attributes)
+	assert.Equal(t, int64(-5), dtos[0].PriceDeltaNum)
+	assert.Equal(t, [2]int64{15, 1}, [2]int64{dtos[0].PriceNum, dtos[0].PriceDenom})
+	assert.Equal(t, [2]int64{45, 4}, [2]int64{dtos[0].EffectivePriceNum, dtos[0].EffectivePriceDenom})
+	assert.Equal(t, [2]int64{25, 1}, [2]int64{dtos[1].PriceNum, dtos[1].PriceDenom})
+	assert.Equal(t, [2]int64{75, 4}, [2]int64{dtos[1].EffectivePriceNum, dtos[1].EffectivePriceDenom})
+	assert.Equal(t, "active", dtos[1].Status)
+
+	assert.Nil(t, variantDTOs(nil, dto))
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// QualityScoreRepo implements the QualityScoreRepository interface using Spanner.
+type QualityScoreRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.QualityScoreRepository = (*QualityScoreRepo)(nil)
+
+// NewQualityScoreRepo creates a new QualityScoreRepo.
+func NewQualityScoreRepo(client *spanner.Client) *QualityScoreRepo {
+	return &QualityScoreRepo{client: client}
+}
+
+// Save stores the quality score of a product, replacing any it had. The score is stamped
+// with the commit timestamp.
+func (r *QualityScoreRepo) Save(ctx context.Context, quality *contract.ProductQualityDTO) error {
+	_, err := r.client.Apply(ctx, []*spanner.Mutation{qualityScoreMut(quality)})
+	return err
+}
+
+// Delete deletes the quality score of a product, if it has one.
+func (r *QualityScoreRepo) Delete(ctx context.Context, productID string) error {
+	_, err := r.client.Apply(ctx, []*spanner.Mutation{spanner.Delete(QualityScoresTable, spanner.Key{productID})})
+	return err
+}
+
+// List returns up to filter.Limit of the quality scores filter selects, worst first
+// unless filter.Descending.
+func (r *QualityScoreRepo) List(ctx context.Context, filter contract.QualityListFilter) ([]*contract.ProductQualityDTO, error) {
+	iter := r.client.Single().Query(ctx, qualityListStatement(filter))
+	defer iter.Stop()
+
+	scores := make([]*contract.ProductQualityDTO, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return scores, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		quality, err := qualityScoreFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		scores = append(scores, quality)
+	}
+}
+
+// qualityListStatement returns the query listing the quality scores filter selects.
+func qualityListStatement(filter contract.QualityListFilter) spanner.Statement {
+	params := map[string]interface{}{"limit": int64(filter.Limit)}
+	sql := `SELECT ` + strings.Join(qualityScoreColumns(), `, `) + ` FROM ` + QualityScoresTable + ` WHERE TRUE`
+	if filter.TenantID != nil {
+		if *filter.TenantID == "" {
+			sql += ` AND ` + QualityTenantID + ` IS NULL`
+		} else {
+			sql += ` AND ` + QualityTenantID + ` = @tenant_id`
+			params["tenant_id"] = *filter.TenantID
+		}
+	}
+	if filter.Category != "" {
+		sql += ` AND ` + QualityCategory + ` = @category`
+		params["category"] = filter.Category
+	}
+	order, after := ` ASC`, `>`
+	if filter.Descending {
+		order, after = ` DESC`, `<`
+	}
+	if filter.After != nil {
+		sql += ` AND (` + QualityScore + ` ` + after + ` @after_score OR (` + QualityScore + ` = @after_score AND ` +
+			QualityProductID + ` ` + after + ` @after_id))`
+		params["after_score"] = int64(filter.After.Overall)
+		params["after_id"] = filter.After.ProductID
+	}
+	sql += ` ORDER BY ` + QualityScore + order + `, ` + QualityProductID + order + ` LIMIT @limit`
+	return spanner.Statement{SQL: sql, Params: params}
+}
+
+// qualityScoreMut returns the mutation that writes the quality score of a product.
+func qualityScoreMut(quality *contract.ProductQualityDTO) *spanner.Mutation {
+	var translations spanner.NullInt64
+	if quality.Score.Translations != nil {
+		translations = spanner.NullInt64{Int64: int64(*quality.Score.Translations), Valid: true}
+	}
+	return spanner.InsertOrUpdateMap(QualityScoresTable, map[string]interface{}{
+		QualityProductID:        quality.ProductID,
+		QualityTenantID:         optionalStringColumn(quality.TenantID),
+		QualityName:             quality.Name,
+		QualityCategory:         quality.Category,
+		QualityScore:            int64(quality.Score.Overall),
+		QualityDescriptionScore: int64(quality.Score.Description),
+		QualityImageScore:       int64(quality.Score.Images),
+		QualityAttributeScore:   int64(quality.Score.Attributes),
+		QualityTranslationScore: translations,
+		QualityScoredAt:         spanner.CommitTimestamp,
+	})
+}
+
+// qualityScoreColumns returns the columns of a product_quality_scores row, in the order
+// qualityScoreFromRow expects them.
+func qualityScoreColumns() []string {
+	return []string{
+		QualityProductID,
+		QualityTenantID,
+		QualityName,
+		QualityCategory,
+		QualityScore,
+		QualityDescriptionScore,
+		QualityImageScore,
+		QualityAttributeScore,
+		QualityTranslationScore,
+		QualityScoredAt,
+	}
+}
+
+// qualityScoreFromRow reads a row of the columns returned by qualityScoreColumns.
+func qualityScoreFromRow(row *spanner.Row) (*contract.ProductQualityDTO, error) {
+	var (
+		productID, name, category                string
+		tenantID                                 spanner.NullString
+		overall, description, images, attributes int64
+		translations                             spanner.NullInt64
+		scoredAt                                 time.Time
+	)
+	if err := row.Columns(&productID, &tenantID, &name, &category, &overall, &description, &images, &attributes,
+		&translations, &scoredAt); err != nil {
+		return nil, err
+	}
+	score := domain.QualityScore{
+		Overall:     int(overall),
+		Description: int(description),
+		Images:      int(images),
+		Attributes:  int(attributes),
+	}
+	if translations.Valid {
+		n := int(translations.Int64)
+		score.Translations = &n
+	}
+	return &contract.ProductQualityDTO{
+		ProductID: productID,
+		TenantID:  tenantID.StringVal,
+		Name:      name,
+		Category:  category,
+		Score:     score,
+		ScoredAt:  scoredAt,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualityListStatement(t *testing.T) {
+	stmt := qualityListStatement(contract.QualityListFilter{Limit: 11})
+	assert.Contains(t, stmt.SQL, "WHERE TRUE ORDER BY score ASC, product_id ASC LIMIT @limit")
+	assert.Equal(t, map[string]interface{}{"limit": int64(11)}, stmt.Params)
+
+	defaultTenant := ""
+	stmt = qualityListStatement(contract.QualityListFilter{TenantID: &defaultTenant, Category: "Furniture", Limit: 11})
+	assert.Contains(t, stmt.SQL, "AND tenant_id IS NULL AND category = @category")
+	assert.NotContains(t, stmt.Params, "tenant_id")
+
+	tenant := "acme"
+	stmt = qualityListStatement(contract.QualityListFilter{
+		TenantID:   &tenant,
+		Descending: true,
+		After:      &contract.QualityCursor{Overall: 40, ProductID: "product-1"},
+		Limit:      11,
+	})
+	assert.Contains(t, stmt.SQL, "AND tenant_id = @tenant_id")
+	assert.Contains(t, stmt.SQL, "AND (score < @after_score OR (score = @after_score AND product_id < @after_id))"+
+		" ORDER BY score DESC, product_id DESC")
+	assert.Equal(t, int64(40), stmt.Params["after_score"])
+	assert.Equal(t, "product-1", stmt.Params["after_id"])
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/grpc/codes"
+)
+
+// ratingSortKeySQL is the average rating of a product, or 0 if it has none, which sorts
+// unrated products after every rated one.
+var ratingSortKeySQL = fmt.Sprintf(`IFNULL(SAFE_DIVIDE(%s, %s), 0)`, ProductRatingSum, ProductRatingCount)
+
+// RatingRepo implements the RatingRepository interface using Spanner.
+type RatingRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.RatingRepository = (*RatingRepo)(nil)
+
+// NewRatingRepo creates a new RatingRepo.
+func NewRatingRepo(client *spanner.Client) *RatingRepo {
+	return &RatingRepo{client: client}
+}
+
+// Ingest records the rating of a review and updates the rating summary of the product in
+// one read-write transaction, so concurrent reviews of the same product are all counted.
+// Ingesting a review again with the rating it has is a no-op. A product of a tenant other
+// than that of ctx is not found.
+func (r *RatingRepo) Ingest(ctx context.Context, productID, reviewID string, rating int64) (domain.RatingSummary, error) {
+	var summary domain.RatingSummary
+	_, err := r.client.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		row, err := txn.ReadRow(ctx, ProductsTable, spanner.Key{productID}, []string{ProductRatingCount, ProductRatingSum, ProductTenantID})
+		if err != nil {
+			if spanner.ErrCode(err) == codes.NotFound {
+				return domain.ErrProductNotFound
+			}
+			return err
+		}
+		var count, sum spanner.NullInt64
+		var tenantID spanner.NullString
+		if err := row.Columns(&count, &sum, &tenantID); err != nil {
+			return err
+		}
+		if !tenantScopeOf(ctx).visible(tenantID) {
+			return domain.ErrProductNotFound
+		}
+		summary = domain.NewRatingSummary(count.Int64, sum.Int64)
+
+		var previous int64
+		row, err = txn.ReadRow(ctx, RatingsTable, spanner.Key{productID, reviewID}, []string{RatingRating})
+		switch {
+		case spanner.ErrCode(err) == codes.NotFound:
+		case err != nil:
+			return err
+		default:
+			if err := row.Columns(&previous); err != nil {
+				return err
+			}
+		}
+		if previous == rating {
+			return nil
+		}
+
+		summary = summary.Rate(previous, rating)
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.InsertOrUpdateMap(RatingsTable, map[string]interface{}{
+				RatingProductID:  productID,
+				RatingReviewID:   reviewID,
+				RatingRating:     rating,
+				RatingIngestedAt: spanner.CommitTimestamp,
+			}),
+			spanner.UpdateMap(ProductsTable, map[string]interface{}{
+				ProductID:          productID,
+				ProductRatingCount: summary.Count(),
+				ProductRatingSum:   summary.Sum(),
+			}),
+		})
+	})
+	return summary, err
+}
+
+// productRatingSummary returns the rating summary of a product row.
+func productRatingSummary(data *ProductData) domain.RatingSummary {
+	return domain.NewRatingSummary(data.RatingCount.Int64, data.RatingSum.Int64)
+}
+
+// ratingPageToken encodes the position of the last product of a page ordered by rating.
+// The average is formatted exactly, so it compares equal to the sort key it came from.
+func ratingPageToken(average float64, productID string) string {
+	return strconv.FormatFloat(average, 'g', -1, 64) + ":" + productID
+}
+
+// parseRatingPageToken decodes a token from ratingPageToken.
+func parseRatingPageToken(token string) (float64, string, error) {
+	average, productID, ok := strings.Cut(token, ":")
+	if !ok || productID == "" {
+		return 0, "", domain.ErrInvalidPageToken
+	}
+	f, err := strconv.ParseFloat(average, 64)
+	if err != nil {
+		return 0, "", domain.ErrInvalidPageToken
+	}
+	return f, productID, nil
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRatingPageToken(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		wantAverage   float64
+		wantProductID string
+		wantErr       error
+	}{
+		{"rated", ratingPageToken(4.5, "product-1"), 4.5, "product-1", nil},
+		{"repeating average", ratingPageToken(11.0/3, "product-2"), 11.0 / 3, "product-2", nil},
+		{"unrated", ratingPageToken(0, "product-3"), 0, "product-3", nil},
+		{"product ID token", "product-1", 0, "", domain.ErrInvalidPageToken},
+		{"bad average", "x:product-1", 0, "", domain.ErrInvalidPageToken},
+		{"no product ID", "4.5:", 0, "", domain.ErrInvalidPageToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			average, productID, err := parseRatingPageToken(tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAverage, average)
+			assert.Equal(t, tt.wantProductID, productID)
+		})
+	}
+}
+
+func TestBuildListQuery_OrderByRating(t *testing.T) {
+	rm := &ProductReadModel{}
+	filter := contract.ListProductsFilter{Category: "Tools"}
+
+	stmt, err := rm.buildListQuery(tenantScope{}, filter, contract.Pagination{PageSize: 10, OrderBy: contract.OrderByRating}, time.Time{})
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "ORDER BY "+ratingSortKeySQL+" DESC, product_id LIMIT 10")
+	assert.NotContains(t, stmt.SQL, "@page_rating")
+
+	stmt, err = rm.buildListQuery(tenantScope{}, filter, contract.Pagination{
+		PageSize:  10,
+		PageToken: ratingPageToken(4.25, "product-1"),
+		OrderBy:   contract.OrderByRating,
+	}, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, 4.25, stmt.Params["page_rating"])
+	assert.Equal(t, "product-1", stmt.Params["page_token"])
+
+	_, err = rm.buildListQuery(tenantScope{}, filter, contract.Pagination{PageToken: "product-1", OrderBy: contract.OrderByRating}, time.Time{})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+}
@@ -3,52 +3,95 @@ package repository
 import (
 	"context"
 	"fmt"
-	"math/big"
+	"iter"
+	"sort"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/spanner"
 	"github.com/product-catalog-service/internal/contract"
 	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+	"github.com/product-catalog-service/internal/staleness"
 	"google.golang.org/api/iterator"
 )
 
 // ProductReadModel implements the contract.ProductReadModel interface using Spanner.
 type ProductReadModel struct {
-	client *spanner.Client
+	client         *spanner.Client
+	staleness      staleness.Bound
+	summaryListing bool
 }
 
 // NewProductReadModel creates a new ProductReadModel.
-func NewProductReadModel(client *spanner.Client) *ProductReadModel {
-	return &ProductReadModel{client: client}
+func NewProductReadModel(client *spanner.Client, opts ...ReadModelOption) *ProductReadModel {
+	rm := &ProductReadModel{client: client}
+	for _, opt := range opts {
+		opt(rm)
+	}
+	return rm
 }
 
-// GetProduct retrieves a product by ID with its current effective price.
+// GetProduct retrieves a product by ID with its current effective price, its price tiers,
+// its price book, its market prices, its variants, its stock, its images and its
+// translations. A product of a tenant other than that of ctx is not found. It reads within
+// the staleness bound of ctx.
 func (rm *ProductReadModel) GetProduct(ctx context.Context, id string, at time.Time) (*contract.ProductDTO, error) {
-	row, err := rm.client.Single().ReadRow(
-		ctx,
-ProductsTable,
-		spanner.Key{id},
-ProductAllColumns(),
-	)
+	var row *spanner.Row
+	txn, err := rm.snapshot(ctx, func(txn *spanner.ReadOnlyTransaction) (err error) {
+		row, err = txn.ReadRow(
+			ctx,
+			ProductsTable,
+			spanner.Key{id},
+			ProductAllColumns(),
+		)
+		return err
+	})
 	if err != nil {
 		if spanner.ErrCode(err) == 5 { // NOT_FOUND
 			return nil, domain.ErrProductNotFound
 		}
 		return nil, err
 	}
+	defer txn.Close()
 
-	return rm.rowToDTO(row, at)
+	data, err := ProductDataFromRow(row)
+	if err != nil {
+		return nil, err
+	}
+	if !tenantScopeOf(ctx).visible(data.TenantID) {
+		return nil, domain.ErrProductNotFound
+	}
+
+	dtos, err := detailDTOs(ctx, txn, []*ProductData{data}, at)
+	if err != nil {
+		return nil, err
+	}
+	return dtos[0], nil
 }
 
-// ListProducts lists products with optional filters and pagination.
-func (rm *ProductReadModel) ListProducts(ctx context.Context, filter contract.ListProductsFilter, pagination contract.Pagination, at time.Time) (*contract.ListProductsResult, error) {
-	stmt := rm.buildListQuery(filter, pagination)
-	iter := rm.client.Single().Query(ctx, stmt)
-	defer iter.Stop()
+// BatchGetProducts returns the products in the tenant scope of ctx with the given IDs,
+// each as GetProduct returns it. The product rows are fetched in one batched key read and
+// the rows of each child table in one more, all from the same snapshot. Products that do
+// not exist are omitted; the order is unspecified.
+func (rm *ProductReadModel) BatchGetProducts(ctx context.Context, ids []string, at time.Time) ([]*contract.ProductDTO, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
 
-	products := make([]*contract.ProductDTO, 0)
-	var lastProductID string
+	keys := make([]spanner.KeySet, len(ids))
+	for i, id := range ids {
+		keys[i] = spanner.Key{id}
+	}
+
+	txn := rm.client.ReadOnlyTransaction()
+	defer txn.Close()
 
+	iter := txn.Read(ctx, ProductsTable, spanner.KeySets(keys...), ProductAllColumns())
+	defer iter.Stop()
+
+	scope := tenantScopeOf(ctx)
+	rows := make([]*ProductData, 0, len(ids))
 	for {
 		row, err := iter.Next()
 		if err == iterator.Done {
@@ -58,27 +101,335 @@ func (rm *ProductReadModel) ListProducts(ctx context.Context, filter contract.Li
 			return nil, err
 		}
 
-		dto, err := rm.rowToDTO(row, at)
+		data, err := ProductDataFromRow(row)
 		if err != nil {
 			return nil, err
 		}
+		if scope.visible(data.TenantID) {
+			rows = append(rows, data)
+		}
+	}
 
-		products = append(products, dto)
-		lastProductID = dto.ID
+	return detailDTOs(ctx, txn, rows, at)
+}
+
+// detailDTOs converts product rows to ProductDTOs with everything GetProduct returns,
+// reading the rows of each child table for all of them at once.
+func detailDTOs(ctx context.Context, txn *spanner.ReadOnlyTransaction, rows []*ProductData, at time.Time) ([]*contract.ProductDTO, error) {
+	if len(rows) == 0 {
+		return []*contract.ProductDTO{}, nil
+	}
+	ids := make([]string, len(rows))
+	for i, data := range rows {
+		ids[i] = data.ProductID
+	}
+
+	discounts, err := readDiscounts(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, err := readPriceTiers(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := readPriceBooks(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	segmentPrices, err := readSegmentPrices(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	marketPrices, err := readMarketPrices(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	currencies := make(map[string]string, len(rows))
+	for _, data := range rows {
+		currencies[data.ProductID] = productCurrency(data)
+	}
+	variants, err := readVariants(ctx, txn, currencies)
+	if err != nil {
+		return nil, err
+	}
+
+	stock, err := readStock(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := readImages(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := readAttachments(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := readTranslations(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*contract.ProductDTO, 0, len(rows))
+	for _, data := range rows {
+		id := data.ProductID
+		dto := dataToDTO(data, discounts[id], marketPrices[id], at)
+		dto.PriceTiers = priceTierDTOs(productPriceTiers(tiers[id], dto.Currency))
+		dto.PriceBook = priceBookDTOs(productPriceBook(prices[id]))
+		dto.SegmentPrices = segmentPriceDTOs(productSegmentPrices(segmentPrices[id], dto.Currency))
+		dto.Variants = variantDTOs(variants[id], dto)
+		stockDTO(dto, stock[id])
+		dto.Images = imageDTOs(images[id])
+		dto.Attachments = attachmentDTOs(attachments[id])
+		dto.Translations = translationDTOs(translations[id])
+		dtos = append(dtos, dto)
+	}
+	return dtos, nil
+}
+
+// FindProductIDBySlug returns the ID of the product with the slug.
+func (rm *ProductReadModel) FindProductIDBySlug(ctx context.Context, slug string) (string, error) {
+	return readProductIDUsingIndex(ctx, rm.client.Single(), ProductSlugIndex, slug)
+}
+
+// FindProductIDBySKU returns the ID of the product with the SKU.
+func (rm *ProductReadModel) FindProductIDBySKU(ctx context.Context, sku string) (string, error) {
+	return readProductIDUsingIndex(ctx, rm.client.Single(), ProductSKUIndex, sku)
+}
+
+// ListProducts lists products with optional filters and pagination, reading within the
+// staleness bound of ctx. With WithSummaryListing, the listings it can answer from the
+// product summaries scan them for the IDs of the page and then read its products.
+func (rm *ProductReadModel) ListProducts(ctx context.Context, filter contract.ListProductsFilter, pagination contract.Pagination, at time.Time) (*contract.ListProductsResult, error) {
+	stmt, err := rm.buildListQuery(tenantScopeOf(ctx), filter, pagination, at)
+	if err != nil {
+		return nil, err
+	}
+	fromSummaries := rm.listsSummaries(filter, pagination)
+	var rows []*ProductData
+	var ids []string
+	txn, err := rm.snapshot(ctx, func(txn *spanner.ReadOnlyTransaction) (err error) {
+		if fromSummaries {
+			ids, err = rm.queryProductIDs(ctx, txn, stmt)
+		} else {
+			rows, err = rm.queryProducts(ctx, txn, stmt)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Close()
+	if fromSummaries {
+		if rows, err = rm.readProductRows(ctx, txn, ids); err != nil {
+			return nil, err
+		}
+	}
+	products, err := summaryDTOs(ctx, txn, rows, at)
+	if err != nil {
+		return nil, err
+	}
+
+	// The count reads the same snapshot as the page, so the two agree.
+	var totalCount int64
+	if pagination.IncludeTotalCount {
+		table := ProductsTable
+		if fromSummaries {
+			table = ProductSummariesTable
+		}
+		totalCount, err = rm.countProducts(ctx, txn, buildCountQuery(table, tenantScopeOf(ctx), filter, at))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var lastProductID string
+	if len(products) > 0 {
+		lastProductID = products[len(products)-1].ID
 	}
 
 	// Determine next page token
 	var nextPageToken string
-	if len(products) == int(pagination.PageSize) && lastProductID != "" {
+	if len(products) == int(pagination.PageSize) && lastProductID != "" && pagination.PageNumber == 0 {
 		nextPageToken = lastProductID
+		if pagination.OrderBy == contract.OrderByMerchandised {
+			last := rows[len(rows)-1]
+			rank, err := readMerchandisingRank(ctx, txn, last.Category, last.ProductID)
+			if err != nil {
+				return nil, err
+			}
+			nextPageToken = merchandisedPageToken(rank, last.ProductID)
+		}
+		if pagination.OrderBy == contract.OrderByRating {
+			last := rows[len(rows)-1]
+			nextPageToken = ratingPageToken(productRatingSummary(last).Average(), last.ProductID)
+		}
+		if order, ok := columnOrders[pagination.OrderBy]; ok {
+			last := rows[len(rows)-1]
+			nextPageToken = columnPageToken(pagination.OrderBy, order.value(last, products[len(products)-1]), last.ProductID)
+		}
 	}
 
 	return &contract.ListProductsResult{
 		Products:      products,
 		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
 	}, nil
 }
 
+// countProducts runs a query selecting COUNT(*) and returns the count.
+func (rm *ProductReadModel) countProducts(ctx context.Context, txn *spanner.ReadOnlyTransaction, stmt spanner.Statement) (int64, error) {
+	logging.SQL("read_model", stmt.SQL, stmt.Params)
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count products: %w", err)
+	}
+	var count int64
+	if err := row.Columns(&count); err != nil {
+		return 0, fmt.Errorf("failed to parse product count: %w", err)
+	}
+	return count, nil
+}
+
+// allProductsPageSize is the size of the pages AllProducts reads.
+const allProductsPageSize = 100
+
+// AllProducts returns an iterator over the products ListProducts lists with filter, in
+// product ID order. It reads them a page at a time, each page in its own snapshot, so a
+// product changed while iterating is listed as it was when its page was read.
+func (rm *ProductReadModel) AllProducts(ctx context.Context, filter contract.ListProductsFilter, at time.Time) iter.Seq2[*contract.ProductDTO, error] {
+	return func(yield func(*contract.ProductDTO, error) bool) {
+		pagination := contract.Pagination{PageSize: allProductsPageSize}
+		for {
+			page, err := rm.ListProducts(ctx, filter, pagination, at)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, product := range page.Products {
+				if !yield(product, nil) {
+					return
+				}
+			}
+			if page.NextPageToken == "" {
+				return
+			}
+			pagination.PageToken = page.NextPageToken
+		}
+	}
+}
+
+// GetRelatedProducts returns the products a product links to that are not archived,
+// ordered by relation type and product ID, optionally of one relation type only.
+func (rm *ProductReadModel) GetRelatedProducts(ctx context.Context, id string, relationType string, at time.Time) ([]*contract.RelatedProductDTO, error) {
+	txn := rm.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	if err := checkProductInScope(ctx, txn, id); err != nil {
+		return nil, err
+	}
+
+	key := spanner.Key{id}
+	if relationType != "" {
+		key = spanner.Key{id, relationType}
+	}
+	relations, err := readRelations(ctx, txn, key.AsPrefix())
+	if err != nil || len(relations) == 0 {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(relations))
+	seen := make(map[string]bool, len(relations))
+	for _, r := range relations {
+		if !seen[r.relatedProductID] {
+			seen[r.relatedProductID] = true
+			ids = append(ids, r.relatedProductID)
+		}
+	}
+	params := map[string]interface{}{"ids": ids}
+	stmt := spanner.Statement{
+		SQL: `SELECT ` + allColumnsSQL() + ` FROM products WHERE product_id IN UNNEST(@ids) AND status != 'archived'` +
+			tenantScopeOf(ctx).condition(ProductTenantID, params),
+		Params: params,
+	}
+	rows, err := rm.queryProducts(ctx, txn, stmt)
+	if err != nil {
+		return nil, err
+	}
+	products, err := summaryDTOs(ctx, txn, rows, at)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*contract.ProductDTO, len(products))
+	for _, dto := range products {
+		byID[dto.ID] = dto
+	}
+	related := make([]*contract.RelatedProductDTO, 0, len(relations))
+	for _, r := range relations {
+		if dto, ok := byID[r.relatedProductID]; ok {
+			related = append(related, &contract.RelatedProductDTO{RelationType: r.relationType, Product: dto})
+		}
+	}
+	return related, nil
+}
+
+// summaryDTOs converts product rows to the DTOs of product listings, in the same order:
+// with their discounts, price books, market prices, stock, images and translations, but
+// without their price tiers, segment prices and variants.
+func summaryDTOs(ctx context.Context, txn *spanner.ReadOnlyTransaction, rows []*ProductData, at time.Time) ([]*contract.ProductDTO, error) {
+	ids := make([]string, len(rows))
+	for i, data := range rows {
+		ids[i] = data.ProductID
+	}
+	discounts, err := readDiscounts(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+	prices, err := readPriceBooks(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+	marketPrices, err := readMarketPrices(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+	stock, err := readStock(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+	images, err := readImages(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+	translations, err := readTranslations(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*contract.ProductDTO, 0, len(rows))
+	for _, data := range rows {
+		dto := dataToDTO(data, discounts[data.ProductID], marketPrices[data.ProductID], at)
+		dto.PriceBook = priceBookDTOs(productPriceBook(prices[data.ProductID]))
+		stockDTO(dto, stock[data.ProductID])
+		dto.Images = imageDTOs(images[data.ProductID])
+		dto.Translations = translationDTOs(translations[data.ProductID])
+		products = append(products, dto)
+	}
+	return products, nil
+}
+
 // ListByCategory lists products in a specific category.
 func (rm *ProductReadModel) ListByCategory(ctx context.Context, category string, pagination contract.Pagination, at time.Time) (*contract.ListProductsResult, error) {
 	filter := contract.ListProductsFilter{
@@ -88,16 +439,19 @@ func (rm *ProductReadModel) ListByCategory(ctx context.Context, category string,
 	return rm.ListProducts(ctx, filter, pagination, at)
 }
 
-// CountByCategory returns the count of active products in a category.
+// CountByCategory returns the count of active products of the tenant of ctx in a category.
 func (rm *ProductReadModel) CountByCategory(ctx context.Context, category string) (int64, error) {
+	params := map[string]interface{}{
+		"category": category,
+		"status":   string(domain.ProductStatusActive),
+	}
 	stmt := spanner.Statement{
-		SQL: `SELECT COUNT(*) as count FROM products WHERE category = @category AND status = @status`,
-		Params: map[string]interface{}{
-			"category": category,
-			"status":   string(domain.ProductStatusActive),
-		},
+		SQL: `SELECT COUNT(*) as count FROM products WHERE category = @category AND status = @status` +
+			tenantScopeOf(ctx).condition(ProductTenantID, params),
+		Params: params,
 	}
 
+	logging.SQL("read_model", stmt.SQL, stmt.Params)
 	iter := rm.client.Single().Query(ctx, stmt)
 	defer iter.Stop()
 
@@ -114,10 +468,344 @@ func (rm *ProductReadModel) CountByCategory(ctx context.Context, category string
 	return count, nil
 }
 
-// buildListQuery builds the SQL query for listing products.
-func (rm *ProductReadModel) buildListQuery(filter contract.ListProductsFilter, pagination contract.Pagination) spanner.Statement {
+// GetEffectivePrices prices the products with the given IDs at the given time, omitting
+// those of tenants other than that of ctx.
+// All rows are fetched in one batched key read that only projects the pricing columns.
+func (rm *ProductReadModel) GetEffectivePrices(ctx context.Context, ids []string, at time.Time) ([]*contract.PriceDTO, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]spanner.KeySet, len(ids))
+	for i, id := range ids {
+		keys[i] = spanner.Key{id}
+	}
+
+	txn := rm.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	iter := txn.Read(ctx, ProductsTable, spanner.KeySets(keys...), ProductPriceColumns())
+	defer iter.Stop()
+
+	scope := tenantScopeOf(ctx)
+	rows := make([]*ProductData, 0, len(ids))
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := ProductPriceDataFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		if scope.visible(data.TenantID) {
+			rows = append(rows, data)
+		}
+	}
+
+	discounts, err := readDiscounts(ctx, txn, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make([]*contract.PriceDTO, 0, len(rows))
+	for _, data := range rows {
+		prices = append(prices, dataToPriceDTO(data, discounts[data.ProductID], at))
+	}
+
+	return prices, nil
+}
+
+// GetPriceForQuantity prices quantity units of a product at the given time, applying the
+// price tier for the quantity and the discount that applies at that time.
+// The product row, its discounts and its price tiers are read from the same snapshot.
+func (rm *ProductReadModel) GetPriceForQuantity(ctx context.Context, id string, quantity int64, at time.Time) (*contract.QuantityPriceDTO, error) {
+	txn := rm.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	row, err := txn.ReadRow(ctx, ProductsTable, spanner.Key{id}, ProductPriceColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == 5 { // NOT_FOUND
+			return nil, domain.ErrProductNotFound
+		}
+		return nil, err
+	}
+
+	data, err := ProductPriceDataFromRow(row)
+	if err != nil {
+		return nil, err
+	}
+	if !tenantScopeOf(ctx).visible(data.TenantID) {
+		return nil, domain.ErrProductNotFound
+	}
+
+	discounts, err := readDiscounts(ctx, txn, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, err := readPriceTiers(ctx, txn, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return dataToQuantityPriceDTO(data, discounts[id], tiers[id], quantity, at)
+}
+
+// GetPriceHistory returns the price history entries of a product changed in [from, to),
+// oldest first. It fails with domain.ErrProductNotFound if the product does not exist
+// or belongs to a tenant other than that of ctx.
+func (rm *ProductReadModel) GetPriceHistory(ctx context.Context, id string, from, to time.Time) ([]*contract.PriceHistoryEntryDTO, error) {
+	txn := rm.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	if err := checkProductInScope(ctx, txn, id); err != nil {
+		return nil, err
+	}
+
+	rows, err := readPriceHistory(ctx, txn, priceHistoryKeys(id, from, to))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*contract.PriceHistoryEntryDTO, len(rows))
+	for i, data := range rows {
+		entries[i] = priceHistoryDTO(data)
+	}
+	return entries, nil
+}
+
+// GetProductRevisions returns the revisions of a product made in [from, to), oldest
+// first. It fails with domain.ErrProductNotFound if the product does not exist or
+// belongs to a tenant other than that of ctx.
+func (rm *ProductReadModel) GetProductRevisions(ctx context.Context, id string, from, to time.Time) ([]*contract.ProductRevisionDTO, error) {
+	txn := rm.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	if err := checkProductInScope(ctx, txn, id); err != nil {
+		return nil, err
+	}
+
+	// Revisions before from are read for the previous snapshot of the first one in range.
+	revisions, err := readRevisions(ctx, txn, revisionKeys(id, to))
+	if err != nil {
+		return nil, err
+	}
+
+	start := sort.Search(len(revisions), func(i int) bool {
+		return !revisions[i].RevisedAt.Before(from)
+	})
+	return revisions[start:], nil
+}
+
+// GetProductRevision returns a revision of a product. It fails with
+// domain.ErrProductNotFound if the product does not exist or belongs to a tenant other
+// than that of ctx, and with domain.ErrRevisionNotFound if it has no such revision.
+func (rm *ProductReadModel) GetProductRevision(ctx context.Context, id, revisionID string) (*contract.ProductRevisionDTO, error) {
+	txn := rm.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	if err := checkProductInScope(ctx, txn, id); err != nil {
+		return nil, err
+	}
+
+	revisions, err := readRevisions(ctx, txn, revisionKeys(id, time.Time{}))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, revision := range revisions {
+		if revision.RevisionID == revisionID {
+			return revision, nil
+		}
+	}
+	return nil, domain.ErrRevisionNotFound
+}
+
+// dataToQuantityPriceDTO prices quantity units of a row read with ProductPriceColumns,
+// given its discount and price tier rows.
+func dataToQuantityPriceDTO(data *ProductData, discountRows []*DiscountData, tierRows []*PriceTierData, quantity int64, at time.Time) (*contract.QuantityPriceDTO, error) {
+	basePrice := productBasePrice(data)
+	price, err := domain.PriceForQuantity(
+		basePrice,
+		productDiscounts("read_model", data, discountRows),
+		productPriceTiers(tierRows, basePrice.Currency()),
+		quantity,
+		at,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := &contract.QuantityPriceDTO{
+		ProductID:       data.ProductID,
+		Quantity:        price.Quantity,
+		BasePriceNum:    data.BasePriceNumerator,
+		BasePriceDenom:  data.BasePriceDenominator,
+		UnitPriceNum:    price.UnitPrice.Numerator(),
+		UnitPriceDenom:  price.UnitPrice.Denominator(),
+		TotalPriceNum:   price.Total.Numerator(),
+		TotalPriceDenom: price.Total.Denominator(),
+		Currency:        basePrice.Currency(),
+		Status:          data.Status,
+	}
+	if price.Tier != nil {
+		dto.TierMinQuantity = price.Tier.MinQuantity()
+	}
+	if price.Discount != nil {
+		pct := price.Discount.PercentageFloat()
+		dto.DiscountPercent = &pct
+	}
+	return dto, nil
+}
+
+// priceTierDTOs converts price tiers to their read representation.
+func priceTierDTOs(tiers []*domain.PriceTier) []contract.PriceTierDTO {
+	if len(tiers) == 0 {
+		return nil
+	}
+	dtos := make([]contract.PriceTierDTO, len(tiers))
+	for i, t := range tiers {
+		dtos[i] = contract.PriceTierDTO{MinQuantity: t.MinQuantity()}
+		if price := t.UnitPrice(); price != nil {
+			dtos[i].UnitPriceNum = price.Numerator()
+			dtos[i].UnitPriceDenom = price.Denominator()
+		}
+		if pct := t.PercentOff(); pct != nil {
+			dtos[i].PercentOff, _ = pct.Float64()
+		}
+	}
+	return dtos
+}
+
+// dataToPriceDTO prices a row read with ProductPriceColumns and its discount rows at the
+// given time.
+func dataToPriceDTO(data *ProductData, discountRows []*DiscountData, at time.Time) *contract.PriceDTO {
+	dto := dataToDTO(data, discountRows, nil, at)
+	price := &contract.PriceDTO{
+		ProductID:           dto.ID,
+		BasePriceNum:        dto.BasePriceNum,
+		BasePriceDenom:      dto.BasePriceDenom,
+		EffectivePriceNum:   dto.EffectivePriceNum,
+		EffectivePriceDenom: dto.EffectivePriceDenom,
+		HasActiveDiscount:   dto.HasActiveDiscount,
+		Currency:            dto.Currency,
+		Status:              dto.Status,
+		Category:            dto.Category,
+		TaxClass:            dto.TaxClass,
+		CostPriceNum:        dto.CostPriceNum,
+		CostPriceDenom:      dto.CostPriceDenom,
+	}
+	if dto.HasActiveDiscount {
+		price.DiscountPercent = dto.DiscountPercent
+	}
+	return price
+}
+
+// buildListQuery builds the SQL query for listing products. Pages are ordered by product
+// ID, by merchandising rank and then product ID, by average rating, highest first, and
+// then product ID, or by one of columnOrders and then product ID; either way the page
+// token is the position of the last product of the previous page. Only the products in
+// scope are listed, and a price range and active discounts are checked at the given time.
+// A listing that scans the product summaries selects the product IDs only.
+func (rm *ProductReadModel) buildListQuery(scope tenantScope, filter contract.ListProductsFilter, pagination contract.Pagination, at time.Time) (spanner.Statement, error) {
+	params := make(map[string]interface{})
 	sql := `SELECT ` + allColumnsSQL() + ` FROM products WHERE 1=1`
+	table := ProductsTable
+	if rm.listsSummaries(filter, pagination) {
+		sql = `SELECT product_id FROM ` + ProductSummariesTable + ` WHERE 1=1`
+		table = ProductSummariesTable
+	}
+	sql += listConditions(table, scope, filter, at, params)
+
+	// Pagination using keyset pagination, unless a page number is requested
+	if pagination.PageNumber > 0 {
+		pagination.PageToken = ""
+	}
+	switch pagination.OrderBy {
+	case "", contract.OrderByProductID:
+		if pagination.PageToken != "" {
+			sql += ` AND product_id > @page_token`
+			params["page_token"] = pagination.PageToken
+		}
+		sql += ` ORDER BY product_id`
+	case contract.OrderByMerchandised:
+		if pagination.PageToken != "" {
+			rank, productID, err := parseMerchandisedPageToken(pagination.PageToken)
+			if err != nil {
+				return spanner.Statement{}, err
+			}
+			sql += ` AND (` + merchandisedSortKeySQL + ` > @page_rank OR (` +
+				merchandisedSortKeySQL + ` = @page_rank AND product_id > @page_token))`
+			params["page_rank"] = rank
+			params["page_token"] = productID
+		}
+		sql += ` ORDER BY ` + merchandisedSortKeySQL + `, product_id`
+	case contract.OrderByRating:
+		if pagination.PageToken != "" {
+			average, productID, err := parseRatingPageToken(pagination.PageToken)
+			if err != nil {
+				return spanner.Statement{}, err
+			}
+			sql += ` AND (` + ratingSortKeySQL + ` < @page_rating OR (` +
+				ratingSortKeySQL + ` = @page_rating AND product_id > @page_token))`
+			params["page_rating"] = average
+			params["page_token"] = productID
+		}
+		sql += ` ORDER BY ` + ratingSortKeySQL + ` DESC, product_id`
+	default:
+		order, ok := columnOrders[pagination.OrderBy]
+		if !ok {
+			return spanner.Statement{}, domain.ErrInvalidOrderBy
+		}
+		if order.sortKey == priceSortKeySQL {
+			params["price_at"] = at
+		}
+		if pagination.PageToken != "" {
+			condition, err := order.condition(pagination.OrderBy, pagination.PageToken, params)
+			if err != nil {
+				return spanner.Statement{}, err
+			}
+			sql += condition
+		}
+		sql += order.orderBy()
+	}
+
+	pageSize := pagination.PageSize
+	if pageSize <= 0 {
+		pageSize = 20 // default page size
+	}
+	if pageSize > 100 {
+		pageSize = 100 // max page size
+	}
+	sql += fmt.Sprintf(` LIMIT %d`, pageSize)
+	if pagination.PageNumber > 1 {
+		sql += fmt.Sprintf(` OFFSET %d`, int64(pagination.PageNumber-1)*int64(pageSize))
+	}
+
+	return spanner.Statement{SQL: sql, Params: params}, nil
+}
+
+// buildCountQuery builds the SQL query counting the products buildListQuery lists with
+// filter, on all pages together, in table: products, or product_summaries if the listing
+// scans them.
+func buildCountQuery(table string, scope tenantScope, filter contract.ListProductsFilter, at time.Time) spanner.Statement {
 	params := make(map[string]interface{})
+	return spanner.Statement{
+		SQL:    `SELECT COUNT(*) FROM ` + table + ` WHERE 1=1` + listConditions(table, scope, filter, at, params),
+		Params: params,
+	}
+}
+
+// listConditions returns the SQL conditions listing only the products in scope that pass
+// filter at the given time, adding their parameters to params. The conditions apply to the
+// rows of table: products, or product_summaries for the filters summaryListable accepts.
+func listConditions(table string, scope tenantScope, filter contract.ListProductsFilter, at time.Time, params map[string]interface{}) string {
+	sql := scope.condition(ProductTenantID, params)
 
 	if filter.Category != "" {
 		sql += ` AND category = @category`
@@ -133,109 +821,188 @@ func (rm *ProductReadModel) buildListQuery(filter contract.ListProductsFilter, p
 	}
 
 	// Exclude archived products by default unless specifically filtering for them
-	if filter.Status != string(domain.ProductStatusArchived) {
+	if filter.Status != string(domain.ProductStatusArchived) && !filter.IncludeArchived {
 		sql += ` AND status != 'archived'`
 	}
 
-	// Pagination using keyset pagination
-	if pagination.PageToken != "" {
-		sql += ` AND product_id > @page_token`
-		params["page_token"] = pagination.PageToken
+	if filter.Tag != "" {
+		sql += ` AND @tag IN UNNEST(` + ProductTags + `)`
+		params["tag"] = filter.Tag
 	}
 
-	sql += ` ORDER BY product_id`
+	if filter.Channel != "" {
+		sql += ` AND (` + ProductUnavailableChannels + ` IS NULL OR @channel NOT IN UNNEST(` + ProductUnavailableChannels + `))`
+		params["channel"] = filter.Channel
+	}
 
-	pageSize := pagination.PageSize
-	if pageSize <= 0 {
-		pageSize = 20 // default page size
+	if filter.InStockOnly {
+		sql += ` AND NOT EXISTS (SELECT 1 FROM ` + StockTable + ` s WHERE s.` + StockProductID +
+			` = products.product_id AND s.` + StockLevel + ` <= s.` + StockReserved + `)`
 	}
-	if pageSize > 100 {
-		pageSize = 100 // max page size
+
+	if table == ProductSummariesTable {
+		sql += summaryPriceRangeCondition(filter, at, params)
+	} else {
+		sql += priceRangeCondition(filter, at, params)
 	}
-	sql += fmt.Sprintf(` LIMIT %d`, pageSize)
 
-	return spanner.Statement{SQL: sql, Params: params}
-}
-
-// rowToDTO converts a Spanner row to a ProductDTO.
-func (rm *ProductReadModel) rowToDTO(row *spanner.Row, at time.Time) (*contract.ProductDTO, error) {
-	var (
-		productID            string
-		name                 string
-		description          string
-		category             string
-		basePriceNumerator   int64
-		basePriceDenominator int64
-		discountPercent      spanner.NullNumeric
-		discountStartDate    spanner.NullTime
-		discountEndDate      spanner.NullTime
-		status               string
-		createdAt            time.Time
-		updatedAt            time.Time
-		archivedAt           spanner.NullTime
-	)
+	if filter.HasActiveDiscount {
+		sql += ` AND EXISTS (` + applicableDiscountSQL + `)`
+		params["price_at"] = at
+	}
 
-	if err := row.Columns(
-		&productID,
-		&name,
-		&description,
-		&category,
-		&basePriceNumerator,
-		&basePriceDenominator,
-		&discountPercent,
-		&discountStartDate,
-		&discountEndDate,
-		&status,
-		&createdAt,
-		&updatedAt,
-		&archivedAt,
-	); err != nil {
-		return nil, err
+	return sql
+}
+
+// queryProducts runs a query selecting allColumnsSQL and decodes the rows.
+func (rm *ProductReadModel) queryProducts(ctx context.Context, txn *spanner.ReadOnlyTransaction, stmt spanner.Statement) ([]*ProductData, error) {
+	logging.SQL("read_model", stmt.SQL, stmt.Params)
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	rows := make([]*ProductData, 0)
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := ProductDataFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, data)
 	}
+}
 
+// dataToDTO converts a database model and its discount and market price rows to a
+// ProductDTO priced at the given time. The single discount fields describe the discount
+// that applies at that time, else the one that is running or starts next; Discounts lists
+// all of them. Discounts scoped to a market only show in its MarketPrices entry.
+func dataToDTO(data *ProductData, discountRows []*DiscountData, marketRows []*MarketPriceData, at time.Time) *contract.ProductDTO {
 	dto := &contract.ProductDTO{
-		ID:                 productID,
-		Name:               name,
-		Description:        description,
-		Category:           category,
-		BasePriceNum:       basePriceNumerator,
-		BasePriceDenom:     basePriceDenominator,
-		Status:             status,
-		CreatedAt:          createdAt,
-		UpdatedAt:          updatedAt,
-		EffectivePriceNum:  basePriceNumerator,
-		EffectivePriceDenom: basePriceDenominator,
-	}
-
-	// Handle discount fields
-	if discountPercent.Valid {
-		pct, _ := discountPercent.Numeric.Float64()
-		dto.DiscountPercent = &pct
+		ID:                  data.ProductID,
+		Name:                data.Name,
+		Slug:                data.Slug.StringVal,
+		SKU:                 data.SKU.StringVal,
+		GTIN:                data.GTIN.StringVal,
+		Description:         data.Description,
+		Category:            data.Category,
+		BasePriceNum:        data.BasePriceNumerator,
+		BasePriceDenom:      data.BasePriceDenominator,
+		Currency:            productCurrency(data),
+		TaxClass:            productTaxClass(data).String(),
+		MinimumPriceNum:     data.MinimumPriceNum.Int64,
+		MinimumPriceDenom:   data.MinimumPriceDenom.Int64,
+		CostPriceNum:        data.CostPriceNum.Int64,
+		CostPriceDenom:      data.CostPriceDenom.Int64,
+		Tags:                data.Tags,
+		Attributes:          productAttributes(data),
+		Status:              data.Status,
+		CreatedAt:           data.CreatedAt,
+		UpdatedAt:           data.UpdatedAt,
+		EffectivePriceNum:   data.BasePriceNumerator,
+		EffectivePriceDenom: data.BasePriceDenominator,
+		ActivateAt:          productOptionalTime(data.ActivateAt),
+		DeactivateAt:        productOptionalTime(data.DeactivateAt),
+		RatingCount:         data.RatingCount.Int64,
+		RatingAverage:       productRatingSummary(data).Average(),
+		Channels:            productChannels(data),
 	}
-	if discountStartDate.Valid {
-		dto.DiscountStartDate = &discountStartDate.Time
+
+	if review := productReview(data); review != nil {
+		dto.Review = &contract.ReviewDTO{
+			SubmittedBy:     review.SubmittedBy(),
+			ReviewedBy:      review.ReviewedBy(),
+			RejectionReason: review.RejectionReason(),
+		}
 	}
-	if discountEndDate.Valid {
-		dto.DiscountEndDate = &discountEndDate.Time
+
+	dto.Kind = string(productKind(data))
+	if compliance := productCompliance(data); compliance != nil {
+		dto.Compliance = &contract.ComplianceDTO{
+			MinimumAge:        compliance.MinimumAge(),
+			Hazardous:         compliance.Hazardous(),
+			RestrictedMarkets: marketsColumn(compliance.RestrictedMarkets()),
+		}
+	}
+	if weight := productWeight(data); weight != nil {
+		dto.Weight = &contract.WeightDTO{Value: weight.Value(), Unit: string(weight.Unit()), Grams: weight.Grams()}
+	}
+	if d := productDimensions(data); d != nil {
+		dto.Dimensions = &contract.DimensionsDTO{Height: d.Height(), Width: d.Width(), Depth: d.Depth(), Unit: string(d.Unit())}
+		dto.Dimensions.HeightMM, dto.Dimensions.WidthMM, dto.Dimensions.DepthMM = d.In(domain.LengthUnitMillimetre)
+	}
+
+	if change := productPendingPriceChange(data, dto.Currency); change != nil {
+		effectiveAt := change.EffectiveAt()
+		dto.PendingPriceNum = change.Price().Numerator()
+		dto.PendingPriceDenom = change.Price().Denominator()
+		dto.PendingPriceEffectiveAt = &effectiveAt
 	}
 
-	// Calculate effective price if there's an active discount
-	if dto.DiscountPercent != nil && dto.DiscountStartDate != nil && dto.DiscountEndDate != nil {
-		if !at.Before(*dto.DiscountStartDate) && at.Before(*dto.DiscountEndDate) {
-			dto.HasActiveDiscount = true
-			basePrice := domain.NewMoney(basePriceNumerator, basePriceDenominator)
-			discountPct := big.NewRat(int64(*dto.DiscountPercent), 1)
-			effectivePrice := basePrice.ApplyDiscount(discountPct)
-			dto.EffectivePriceNum = effectivePrice.Numerator()
-			dto.EffectivePriceDenom = effectivePrice.Denominator()
+	discounts := productDiscounts("read_model", data, discountRows)
+	dto.MarketPrices = marketPriceDTOs(productMarketPrices(marketRows), discounts, at)
+	if len(discounts) == 0 {
+		return dto
+	}
+	domain.SortDiscounts(discounts)
+
+	dto.Discounts = make([]contract.DiscountDTO, len(discounts))
+	for i, d := range discounts {
+		dto.Discounts[i] = contract.DiscountDTO{
+			ID:              d.ID(),
+			Percent:         d.PercentageFloat(),
+			Priority:        d.Priority(),
+			StartDate:       d.StartDate(),
+			EndDate:         d.EndDate(),
+			Suspended:       d.IsSuspended(),
+			Kind:            string(d.Kind()),
+			Market:          d.Market().String(),
+			PendingApproval: d.IsPendingApproval(),
+			ApprovedBy:      d.ApprovedBy(),
+			FlashSale:       d.IsFlashSale(),
+		}
+		if promotion := d.Promotion(); promotion != nil {
+			dto.Discounts[i].BuyQuantity = promotion.BuyQuantity()
+			dto.Discounts[i].GetQuantity = promotion.GetQuantity()
+		}
+		if sale := d.SalePrice(); sale != nil {
+			dto.Discounts[i].SalePriceNum = sale.Numerator()
+			dto.Discounts[i].SalePriceDenom = sale.Denominator()
 		}
 	}
 
-	return dto, nil
+	applicable := domain.ApplicableDiscount(discounts, at)
+	shown := applicable
+	if shown == nil {
+		shown = domain.CurrentDiscount(discounts, at)
+	}
+	if shown != nil {
+		pct := shown.PercentageFloat()
+		start, end := shown.StartDate(), shown.EndDate()
+		dto.DiscountPercent = &pct
+		dto.DiscountStartDate = &start
+		dto.DiscountEndDate = &end
+		dto.DiscountSuspended = shown.IsSuspended()
+	}
+
+	// Calculate effective price if a discount is active
+	if applicable != nil {
+		dto.HasActiveDiscount = true
+		effectivePrice := applicable.ApplyTo(productBasePrice(data))
+		dto.EffectivePriceNum = effectivePrice.Numerator()
+		dto.EffectivePriceDenom = effectivePrice.Denominator()
+	}
+
+	return dto
 }
 
-// allColumnsSQL returns all column names as a comma-separated SQL string.
+// allColumnsSQL returns ProductAllColumns as a comma-separated SQL string, so rows can be
+// decoded with ProductDataFromRow.
 func allColumnsSQL() string {
-	return `product_id, name, description, category, base_price_numerator, base_price_denominator, 
-		discount_percent, discount_start_date, discount_end_date, status, created_at, updated_at, archived_at`
+	return strings.Join(ProductAllColumns(), ", ")
 }
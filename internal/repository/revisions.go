@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+)
+
+// RevisionMut returns a mutation recording the state of a product at the given time as a
+// revision, with the fields its pending changes touched, or nil if it has neither
+// pending changes nor pending events.
+func (r *ProductRepo) RevisionMut(product *domain.Product, at time.Time) *spanner.Mutation {
+	changes := product.Changes()
+	if !changes.HasChanges() && len(product.DomainEvents()) == 0 {
+		return nil
+	}
+	return revisionToData(product, at).InsertMutation()
+}
+
+// revisionToData records the state of a product at the given time as a revision.
+func revisionToData(product *domain.Product, at time.Time) *RevisionData {
+	changed := product.Changes().DirtyFields()
+	sort.Strings(changed)
+	if changed == nil {
+		changed = []string{}
+	}
+	return &RevisionData{
+		ProductID:     product.ID(),
+		RevisedAt:     at,
+		RevisionID:    uuid.New().String(),
+		ChangedFields: changed,
+		Snapshot:      spanner.NullJSON{Value: productSnapshot(product, at), Valid: true},
+	}
+}
+
+// revisionKeys returns the revision rows of a product made before to, or all of them if
+// to is zero.
+func revisionKeys(productID string, to time.Time) spanner.KeyRange {
+	keys := spanner.KeyRange{Start: spanner.Key{productID}, End: spanner.Key{productID}, Kind: spanner.ClosedClosed}
+	if !to.IsZero() {
+		keys.End = spanner.Key{productID, to}
+		keys.Kind = spanner.ClosedOpen
+	}
+	return keys
+}
+
+// readRevisions reads the revision rows of a product in key order, i.e. by the time of
+// the revision, and converts them to their read representation, each with the snapshot
+// of the revision before it.
+func readRevisions(ctx context.Context, reader rowReader, keys spanner.KeyRange) ([]*contract.ProductRevisionDTO, error) {
+	iter := reader.Read(ctx, RevisionsTable, keys, RevisionAllColumns())
+	defer iter.Stop()
+
+	var revisions []*contract.ProductRevisionDTO
+	var previous json.RawMessage
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return revisions, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := RevisionDataFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		revision, err := revisionDTO(data, previous)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+		previous = revision.Snapshot
+	}
+}
+
+// revisionDTO converts a revision row to its read representation.
+func revisionDTO(data *RevisionData, previous json.RawMessage) (*contract.ProductRevisionDTO, error) {
+	snapshot := json.RawMessage("{}")
+	if data.Snapshot.Valid {
+		raw, err := json.Marshal(data.Snapshot.Value)
+		if err != nil {
+			return nil, fmt.Errorf("decode snapshot of revision %s: %w", data.RevisionID, err)
+		}
+		snapshot = raw
+	}
+	changed := data.ChangedFields
+	if changed == nil {
+		changed = []string{}
+	}
+	return &contract.ProductRevisionDTO{
+		RevisionID:       data.RevisionID,
+		RevisedAt:        data.RevisedAt,
+		ChangedFields:    changed,
+		Snapshot:         snapshot,
+		PreviousSnapshot: previous,
+	}, nil
+}
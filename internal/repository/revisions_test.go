@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductRepo_RevisionMut(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+	basePrice, err := domain.NewMoneyInCurrency(2000, 100, "EUR")
+	require.NoError(t, err)
+	product := domain.ReconstructProduct(
+		"product-123", "Widget", "", "", "Tools", "", "",
+		basePrice, nil, nil,
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil, nil, "", domain.DefaultTaxClass, domain.ProductStatusActive, nil, nil, nil, now, now, nil,
+	)
+	assert.Nil(t, repo.RevisionMut(product, now), "an unchanged product has no revision")
+
+	require.NoError(t, product.SetTaxClass("reduced", now))
+	assert.NotNil(t, repo.RevisionMut(product, now))
+
+	data := revisionToData(product, now)
+	assert.Equal(t, "product-123", data.ProductID)
+	assert.Equal(t, now, data.RevisedAt)
+	assert.NotEmpty(t, data.RevisionID)
+	assert.Contains(t, data.ChangedFields, domain.FieldTaxClass)
+	require.True(t, data.Snapshot.Valid)
+
+	dto, err := revisionDTO(data, nil)
+	require.NoError(t, err)
+	assert.Equal(t, data.RevisionID, dto.RevisionID)
+	assert.Equal(t, data.ChangedFields, dto.ChangedFields)
+	assert.Nil(t, dto.PreviousSnapshot)
+	assert.Contains(t, string(dto.Snapshot), `"tax_class":"reduced"`)
+}
+
+func TestRevisionDTO_Decode(t *testing.T) {
+	previous := []byte(`{"name":"Widget"}`)
+	dto, err := revisionDTO(&RevisionData{
+		RevisionID: "revision-2",
+		Snapshot:   spanner.NullJSON{Value: map[string]interface{}{"name": "Gadget"}, Valid: true},
+	}, previous)
+	require.NoError(t, err)
+	assert.Equal(t, []string{}, dto.ChangedFields)
+	assert.JSONEq(t, `{"name":"Gadget"}`, string(dto.Snapshot))
+	assert.JSONEq(t, string(previous), string(dto.PreviousSnapshot))
+}
+
+func TestRevisionKeys(t *testing.T) {
+	to := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t,
+		spanner.KeyRange{Start: spanner.Key{"product-1"}, End: spanner.Key{"product-1"}, Kind: spanner.ClosedClosed},
+		revisionKeys("product-1", time.Time{}))
+	assert.Equal(t,
+		spanner.KeyRange{Start: spanner.Key{"product-1"}, End: spanner.Key{"product-1", to}, Kind: spanner.ClosedOpen},
+		revisionKeys("product-1", to))
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+	"google.golang.org/api/iterator"
+)
+
+// searchScoreSQL is the relevance of a product to the search query @query. A match in
+// the name counts twice as much as one in the description.
+var searchScoreSQL = fmt.Sprintf(`(2 * SCORE(%s, @query) + SCORE(%s, @query))`, ProductNameTokens, ProductDescriptionTokens)
+
+// searchHit is a product found by a search and its relevance.
+type searchHit struct {
+	productID string
+	score     float64
+}
+
+// SearchProducts searches the products in the tenant scope of ctx that are not archived
+// through ProductSearchIndex. The page is read in two steps: the IDs and relevance of
+// the matching products from the index, then the products themselves.
+func (rm *ProductReadModel) SearchProducts(ctx context.Context, filter contract.SearchProductsFilter, pagination contract.Pagination, at time.Time) (*contract.ListProductsResult, error) {
+	txn := rm.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	stmt, pageSize, err := buildSearchQuery(tenantScopeOf(ctx), filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+	hits, err := querySearchHits(ctx, txn, stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.productID
+	}
+	products, err := rm.listProductsByID(ctx, txn, ids, at)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if len(hits) == pageSize {
+		last := hits[len(hits)-1]
+		nextPageToken = searchPageToken(last.score, last.productID)
+	}
+	return &contract.ListProductsResult{
+		Products:      products,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// ListProductsByID returns the products in the tenant scope of ctx with the given IDs
+// that are not archived, in the order of ids.
+func (rm *ProductReadModel) ListProductsByID(ctx context.Context, ids []string, at time.Time) ([]*contract.ProductDTO, error) {
+	txn := rm.client.ReadOnlyTransaction()
+	defer txn.Close()
+
+	return rm.listProductsByID(ctx, txn, ids, at)
+}
+
+// listProductsByID reads the products in the tenant scope of ctx with the given IDs that
+// are not archived as listing DTOs, in the order of ids.
+func (rm *ProductReadModel) listProductsByID(ctx context.Context, txn *spanner.ReadOnlyTransaction, ids []string, at time.Time) ([]*contract.ProductDTO, error) {
+	if len(ids) == 0 {
+		return []*contract.ProductDTO{}, nil
+	}
+	params := map[string]interface{}{"ids": ids}
+	rows, err := rm.queryProducts(ctx, txn, spanner.Statement{
+		SQL: `SELECT ` + allColumnsSQL() + ` FROM products WHERE product_id IN UNNEST(@ids) AND status != 'archived'` +
+			tenantScopeOf(ctx).condition(ProductTenantID, params),
+		Params: params,
+	})
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*ProductData, len(rows))
+	for _, data := range rows {
+		byID[data.ProductID] = data
+	}
+	ordered := make([]*ProductData, 0, len(rows))
+	for _, id := range ids {
+		if data, ok := byID[id]; ok {
+			ordered = append(ordered, data)
+		}
+	}
+	return summaryDTOs(ctx, txn, ordered, at)
+}
+
+// buildSearchQuery builds the SQL query for the IDs and relevance of a page of products
+// matching a search, most relevant first, ties ordered by product ID. The page token is
+// the position of the last product of the previous page; as the relevance is computed in
+// the SELECT list, the page is cut from the results of an inner query. It also returns
+// the page size.
+func buildSearchQuery(scope tenantScope, filter contract.SearchProductsFilter, pagination contract.Pagination) (spanner.Statement, int, error) {
+	sql := `SELECT product_id, ` + searchScoreSQL + ` AS score FROM products@{FORCE_INDEX=` + ProductSearchIndex + `}` +
+		` WHERE (SEARCH(` + ProductNameTokens + `, @query) OR SEARCH(` + ProductDescriptionTokens + `, @query))` +
+		` AND status != 'archived'`
+	params := map[string]interface{}{"query": filter.Query}
+	sql += scope.condition(ProductTenantID, params)
+
+	if filter.Category != "" {
+		sql += ` AND category = @category`
+		params["category"] = filter.Category
+	}
+	if filter.ActiveOnly {
+		sql += ` AND status = @status`
+		params["status"] = string(domain.ProductStatusActive)
+	}
+	if filter.Tag != "" {
+		sql += ` AND @tag IN UNNEST(` + ProductTags + `)`
+		params["tag"] = filter.Tag
+	}
+	if filter.Channel != "" {
+		sql += ` AND (` + ProductUnavailableChannels + ` IS NULL OR @channel NOT IN UNNEST(` + ProductUnavailableChannels + `))`
+		params["channel"] = filter.Channel
+	}
+
+	sql = `SELECT product_id, score FROM (` + sql + `)`
+	if pagination.PageToken != "" {
+		score, productID, err := parseSearchPageToken(pagination.PageToken)
+		if err != nil {
+			return spanner.Statement{}, 0, err
+		}
+		sql += ` WHERE score < @page_score OR (score = @page_score AND product_id > @page_token)`
+		params["page_score"] = score
+		params["page_token"] = productID
+	}
+	sql += ` ORDER BY score DESC, product_id`
+
+	pageSize := int(pagination.PageSize)
+	if pageSize <= 0 {
+		pageSize = 20 // default page size
+	}
+	if pageSize > 100 {
+		pageSize = 100 // max page size
+	}
+	sql += fmt.Sprintf(` LIMIT %d`, pageSize)
+
+	return spanner.Statement{SQL: sql, Params: params}, pageSize, nil
+}
+
+// querySearchHits runs a query built by buildSearchQuery and decodes the rows.
+func querySearchHits(ctx context.Context, txn *spanner.ReadOnlyTransaction, stmt spanner.Statement) ([]searchHit, error) {
+	logging.SQL("read_model", stmt.SQL, stmt.Params)
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	var hits []searchHit
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return hits, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var hit searchHit
+		if err := row.Columns(&hit.productID, &hit.score); err != nil {
+			return nil, err
+		}
+		hits = append(hits, hit)
+	}
+}
+
+// searchPageToken encodes the position of a product in search results as a page token.
+func searchPageToken(score float64, productID string) string {
+	return strconv.FormatFloat(score, 'g', -1, 64) + ":" + productID
+}
+
+// parseSearchPageToken decodes a token from searchPageToken.
+func parseSearchPageToken(token string) (float64, string, error) {
+	score, productID, ok := strings.Cut(token, ":")
+	if !ok || productID == "" {
+		return 0, "", domain.ErrInvalidPageToken
+	}
+	f, err := strconv.ParseFloat(score, 64)
+	if err != nil {
+		return 0, "", domain.ErrInvalidPageToken
+	}
+	return f, productID, nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchPageToken(t *testing.T) {
+	score, productID, err := parseSearchPageToken(searchPageToken(1.0/3, "product-1"))
+	require.NoError(t, err)
+	assert.Equal(t, 1.0/3, score)
+	assert.Equal(t, "product-1", productID)
+
+	for _, token := range []string{"product-1", "x:product-1", "0.5:"} {
+		_, _, err := parseSearchPageToken(token)
+		assert.ErrorIs(t, err, domain.ErrInvalidPageToken, "token %q", token)
+	}
+}
+
+func TestBuildSearchQuery(t *testing.T) {
+	filter := contract.SearchProductsFilter{Query: "oak chair", Category: "Furniture"}
+
+	stmt, pageSize, err := buildSearchQuery(tenantScope{}, filter, contract.Pagination{PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 10, pageSize)
+	assert.Contains(t, stmt.SQL, "FROM products@{FORCE_INDEX="+ProductSearchIndex+"}")
+	assert.Contains(t, stmt.SQL, "SEARCH(name_tokens, @query) OR SEARCH(description_tokens, @query)")
+	assert.Contains(t, stmt.SQL, "ORDER BY score DESC, product_id LIMIT 10")
+	assert.Equal(t, "oak chair", stmt.Params["query"])
+	assert.Equal(t, "Furniture", stmt.Params["category"])
+	assert.NotContains(t, stmt.SQL, "@page_score")
+	assert.NotContains(t, stmt.SQL, "@status")
+
+	stmt, pageSize, err = buildSearchQuery(tenantScope{scoped: true, tenant: "acme"}, contract.SearchProductsFilter{
+		Query: "oak", ActiveOnly: true, Tag: "sale", Channel: "web",
+	}, contract.Pagination{
+		PageSize:  500,
+		PageToken: searchPageToken(1.5, "product-1"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 100, pageSize)
+	assert.Equal(t, 1.5, stmt.Params["page_score"])
+	assert.Equal(t, "product-1", stmt.Params["page_token"])
+	assert.Equal(t, "acme", stmt.Params["tenant_id"])
+	assert.Equal(t, "active", stmt.Params["status"])
+	assert.Equal(t, "sale", stmt.Params["tag"])
+	assert.Equal(t, "web", stmt.Params["channel"])
+
+	_, _, err = buildSearchQuery(tenantScope{}, filter, contract.Pagination{PageToken: "product-1"})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"math/big"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+	"google.golang.org/api/iterator"
+)
+
+// readSegmentPrices reads the product_segment_prices rows of the given products, keyed by
+// product ID.
+func readSegmentPrices(ctx context.Context, reader rowReader, productIDs []string) (map[string][]*SegmentPriceData, error) {
+	prices := make(map[string][]*SegmentPriceData)
+	if len(productIDs) == 0 {
+		return prices, nil
+	}
+
+	keys := make([]spanner.KeySet, len(productIDs))
+	for i, id := range productIDs {
+		keys[i] = spanner.Key{id}.AsPrefix()
+	}
+
+	iter := reader.Read(ctx, SegmentPricesTable, spanner.KeySets(keys...), SegmentPriceAllColumns())
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return prices, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := SegmentPriceDataFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		prices[data.ProductID] = append(prices[data.ProductID], data)
+	}
+}
+
+// productSegmentPrices converts the product_segment_prices rows of a product to domain
+// segment prices, skipping rows that do not hold a valid one. Fixed prices are in the
+// product's currency.
+func productSegmentPrices(rows []*SegmentPriceData, currency string) []*domain.SegmentPrice {
+	prices := make([]*domain.SegmentPrice, 0, len(rows))
+	for _, row := range rows {
+		price, err := dataToSegmentPrice(row, currency)
+		if err != nil {
+			logging.Warnf("repository: product %s: skipping invalid price for segment %s: %v", row.ProductID, row.Segment, err)
+			continue
+		}
+		prices = append(prices, price)
+	}
+	domain.SortSegmentPrices(prices)
+	return prices
+}
+
+// segmentPriceToData converts a segment price of a product to a database model.
+func segmentPriceToData(productID string, price *domain.SegmentPrice) *SegmentPriceData {
+	data := &SegmentPriceData{
+		ProductID: productID,
+		Segment:   price.Segment(),
+	}
+	if p := price.Price(); p != nil {
+		data.PriceNumerator = spanner.NullInt64{Int64: p.Numerator(), Valid: true}
+		data.PriceDenominator = spanner.NullInt64{Int64: p.Denominator(), Valid: true}
+	}
+	if pct := price.PercentOff(); pct != nil {
+		data.PercentOff = percentToNumeric(pct)
+	}
+	return data
+}
+
+// dataToSegmentPrice converts a database model to a domain SegmentPrice with a fixed price
+// in the given currency.
+func dataToSegmentPrice(data *SegmentPriceData, currency string) (*domain.SegmentPrice, error) {
+	switch {
+	case data.PriceNumerator.Valid && data.PriceDenominator.Valid && !data.PercentOff.Valid:
+		price, err := domain.NewMoneyInCurrency(data.PriceNumerator.Int64, data.PriceDenominator.Int64, currency)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewSegmentPriceOverride(data.Segment, price)
+	case data.PercentOff.Valid && !data.PriceNumerator.Valid:
+		return domain.NewSegmentPercentOff(data.Segment, new(big.Rat).Set(&data.PercentOff.Numeric))
+	default:
+		return nil, domain.ErrInvalidSegmentPrice
+	}
+}
+
+// segmentPriceDTOs converts segment prices to their read representation.
+func segmentPriceDTOs(prices []*domain.SegmentPrice) []contract.SegmentPriceDTO {
+	if len(prices) == 0 {
+		return nil
+	}
+	dtos := make([]contract.SegmentPriceDTO, len(prices))
+	for i, s := range prices {
+		dtos[i] = contract.SegmentPriceDTO{Segment: s.Segment()}
+		if price := s.Price(); price != nil {
+			dtos[i].PriceNum = price.Numerator()
+			dtos[i].PriceDenom = price.Denominator()
+		}
+		if pct := s.PercentOff(); pct != nil {
+			dtos[i].PercentOff, _ = pct.Float64()
+		}
+	}
+	return dtos
+}
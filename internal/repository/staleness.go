@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/staleness"
+)
+
+// ReadModelOption configures optional ProductReadModel behavior.
+type ReadModelOption func(*ProductReadModel)
+
+// WithReadStaleness makes GetProduct and ListProducts read within bound unless the context
+// carries its own; see package staleness. Without it, they read strongly.
+func WithReadStaleness(bound staleness.Bound) ReadModelOption {
+	return func(rm *ProductReadModel) {
+		rm.staleness = bound
+	}
+}
+
+// readBound returns the staleness bound of the reads of ctx.
+func (rm *ProductReadModel) readBound(ctx context.Context) staleness.Bound {
+	if bound, ok := staleness.FromContext(ctx); ok {
+		return bound
+	}
+	return rm.staleness
+}
+
+// snapshot runs first, the first read of ctx, and returns a transaction reading the same
+// snapshot for the others, to be closed by the caller. Bounded staleness is only available
+// to single-use reads, so with a bounded staleness first runs in one, at the newest
+// timestamp within the bound the nearest replica can serve, and the others read at that
+// timestamp.
+func (rm *ProductReadModel) snapshot(ctx context.Context, first func(*spanner.ReadOnlyTransaction) error) (*spanner.ReadOnlyTransaction, error) {
+	bound := rm.readBound(ctx)
+	if bound.Staleness == 0 || bound.Exact {
+		txn := rm.client.ReadOnlyTransaction().WithTimestampBound(timestampBound(bound))
+		if err := first(txn); err != nil {
+			txn.Close()
+			return nil, err
+		}
+		return txn, nil
+	}
+
+	single := rm.client.Single().WithTimestampBound(timestampBound(bound))
+	if err := first(single); err != nil {
+		return nil, err
+	}
+	readAt, err := single.Timestamp()
+	if err != nil {
+		return nil, err
+	}
+	return rm.client.ReadOnlyTransaction().WithTimestampBound(spanner.ReadTimestamp(readAt)), nil
+}
+
+// timestampBound converts bound to a Spanner timestamp bound.
+func timestampBound(bound staleness.Bound) spanner.TimestampBound {
+	switch {
+	case bound.Staleness == 0:
+		return spanner.StrongRead()
+	case bound.Exact:
+		return spanner.ExactStaleness(bound.Staleness)
+	default:
+		return spanner.MaxStaleness(bound.Staleness)
+	}
+}
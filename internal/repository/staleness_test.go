@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/staleness"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadBound(t *testing.T) {
+	ctx := context.Background()
+	bounded := staleness.Bound{Staleness: 15 * time.Second}
+
+	assert.Equal(t, staleness.Strong, NewProductReadModel(nil).readBound(ctx))
+
+	rm := NewProductReadModel(nil, WithReadStaleness(bounded))
+	assert.Equal(t, bounded, rm.readBound(ctx))
+
+	// A request can read strongly from a read model that reads stale by default
+	assert.Equal(t, staleness.Strong, rm.readBound(staleness.NewContext(ctx, staleness.Strong)))
+}
+
+func TestTimestampBound(t *testing.T) {
+	assert.Equal(t, spanner.StrongRead(), timestampBound(staleness.Strong))
+	assert.Equal(t, spanner.MaxStaleness(15*time.Second), timestampBound(staleness.Bound{Staleness: 15 * time.Second}))
+	assert.Equal(t, spanner.ExactStaleness(15*time.Second), timestampBound(staleness.Bound{Staleness: 15 * time.Second, Exact: true}))
+}
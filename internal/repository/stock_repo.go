@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+)
+
+// StockRepo implements the StockRepository interface using Spanner.
+type StockRepo struct {
+	client *spanner.Client
+}
+
+var _ contract.StockRepository = (*StockRepo)(nil)
+
+// NewStockRepo creates a new StockRepo.
+func NewStockRepo(client *spanner.Client) *StockRepo {
+	return &StockRepo{client: client}
+}
+
+// FindByProductID retrieves the stock of a product, or untracked stock if it has none.
+func (r *StockRepo) FindByProductID(ctx context.Context, productID string) (*domain.Stock, error) {
+	row, err := r.client.Single().ReadRow(ctx, StockTable, spanner.Key{productID}, stockColumns())
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return domain.NewStock(productID), nil
+		}
+		return nil, err
+	}
+	return stockFromRow(row)
+}
+
+// SaveMut returns a mutation inserting or updating the stock of a product.
+func (r *StockRepo) SaveMut(stock *domain.Stock) *spanner.Mutation {
+	return spanner.InsertOrUpdateMap(StockTable, map[string]interface{}{
+		StockProductID: stock.ProductID(),
+		StockLevel:     stock.Level(),
+		StockReserved:  stock.Reserved(),
+		StockVersion:   stock.Version(),
+		StockUpdatedAt: stock.UpdatedAt(),
+	})
+}
+
+// VersionCheck returns a check that reads the version of the stock of a product in the
+// commit transaction and fails with domain.ErrStockConflict if it is not version.
+func (r *StockRepo) VersionCheck(productID string, version int64) committer.Check {
+	return func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		var stored int64
+		row, err := txn.ReadRow(ctx, StockTable, spanner.Key{productID}, []string{StockVersion})
+		switch {
+		case spanner.ErrCode(err) == codes.NotFound:
+		case err != nil:
+			return err
+		default:
+			if err := row.Columns(&stored); err != nil {
+				return err
+			}
+		}
+		if stored != version {
+			return domain.ErrStockConflict
+		}
+		return nil
+	}
+}
+
+// readStock reads the stock of the given products, keyed by product ID. Products whose
+// stock is not tracked have no entry.
+func readStock(ctx context.Context, reader rowReader, productIDs []string) (map[string]*domain.Stock, error) {
+	stock := make(map[string]*domain.Stock)
+	if len(productIDs) == 0 {
+		return stock, nil
+	}
+
+	keys := make([]spanner.KeySet, len(productIDs))
+	for i, id := range productIDs {
+		keys[i] = spanner.Key{id}
+	}
+
+	iter := reader.Read(ctx, StockTable, spanner.KeySets(keys...), stockColumns())
+	defer iter.Stop()
+
+	for {
+		row, err := iter.Next()
+		if err == iterator.Done {
+			return stock, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := stockFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		stock[s.ProductID()] = s
+	}
+}
+
+// stockDTO sets the stock fields of dto from the stock of its product, if tracked.
+func stockDTO(dto *contract.ProductDTO, stock *domain.Stock) {
+	if stock == nil {
+		dto.InStock = true
+		return
+	}
+	dto.StockTracked = true
+	dto.StockLevel = stock.Level()
+	dto.StockReserved = stock.Reserved()
+	dto.StockVersion = stock.Version()
+	dto.InStock = stock.InStock()
+}
+
+// stockColumns returns the columns of a product_stock row, in the order stockFromRow
+// decodes them.
+func stockColumns() []string {
+	return []string{StockProductID, StockLevel, StockReserved, StockVersion, StockUpdatedAt}
+}
+
+func stockFromRow(row *spanner.Row) (*domain.Stock, error) {
+	var (
+		productID                string
+		level, reserved, version int64
+		updatedAt                time.Time
+	)
+	if err := row.Columns(&productID, &level, &reserved, &version, &updatedAt); err != nil {
+		return nil, err
+	}
+	return domain.ReconstructStock(productID, level, reserved, version, updatedAt), nil
+}
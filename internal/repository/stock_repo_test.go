@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildListQuery_InStockOnly(t *testing.T) {
+	rm := &ProductReadModel{}
+
+	stmt, err := rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{Category: "Tools"}, contract.Pagination{PageSize: 10}, time.Time{})
+	require.NoError(t, err)
+	assert.NotContains(t, stmt.SQL, StockTable)
+
+	stmt, err = rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{Category: "Tools", InStockOnly: true}, contract.Pagination{PageSize: 10}, time.Time{})
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "AND NOT EXISTS (SELECT 1 FROM product_stock s WHERE s.product_id = products.product_id AND s.stock_level <= s.reserved) ORDER BY product_id")
+}
+
+func TestStockDTO(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	untracked := &contract.ProductDTO{}
+	stockDTO(untracked, nil)
+	assert.True(t, untracked.InStock)
+	assert.False(t, untracked.StockTracked)
+
+	soldOut := &contract.ProductDTO{}
+	stockDTO(soldOut, domain.ReconstructStock("p1", 4, 4, 7, now))
+	assert.False(t, soldOut.InStock)
+	assert.True(t, soldOut.StockTracked)
+	assert.Equal(t, int64(4), soldOut.StockLevel)
+	assert.Equal(t, int64(4), soldOut.StockReserved)
+	assert.Equal(t, int64(7), soldOut.StockVersion)
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/grpc/codes"
+)
+
+// tenantScope restricts the products a request can read to those of the tenant of its
+// caller; see caller.Tenant. Products of other tenants are not found. An unscoped
+// context, such as that of a background job, reads the products of all tenants.
+//
+// Slugs, SKUs and GTINs are unique across tenants, as their indexes are, so the lookups
+// by them are not scoped; the product found is then read in scope.
+type tenantScope struct {
+	tenant string
+	scoped bool
+}
+
+// tenantScopeOf returns the tenant scope of ctx.
+func tenantScopeOf(ctx context.Context) tenantScope {
+	tenant, scoped := caller.Tenant(ctx)
+	return tenantScope{tenant: tenant, scoped: scoped}
+}
+
+// visible reports whether a product with the tenant_id column value tenantID is in scope.
+func (s tenantScope) visible(tenantID spanner.NullString) bool {
+	return !s.scoped || tenantID.StringVal == s.tenant
+}
+
+// condition returns an SQL condition, starting with AND, that restricts the tenant_id
+// column to the scope, adding its parameter to params; empty if the scope is unscoped.
+func (s tenantScope) condition(column string, params map[string]interface{}) string {
+	if !s.scoped {
+		return ""
+	}
+	if s.tenant == "" {
+		return ` AND ` + column + ` IS NULL`
+	}
+	params["tenant_id"] = s.tenant
+	return ` AND ` + column + ` = @tenant_id`
+}
+
+// checkProductInScope fails with domain.ErrProductNotFound if the product does not exist
+// or is not in the tenant scope of ctx.
+func checkProductInScope(ctx context.Context, txn *spanner.ReadOnlyTransaction, id string) error {
+	row, err := txn.ReadRow(ctx, ProductsTable, spanner.Key{id}, []string{ProductTenantID})
+	if err != nil {
+		if spanner.ErrCode(err) == codes.NotFound {
+			return domain.ErrProductNotFound
+		}
+		return err
+	}
+	var tenantID spanner.NullString
+	if err := row.Columns(&tenantID); err != nil {
+		return err
+	}
+	if !tenantScopeOf(ctx).visible(tenantID) {
+		return domain.ErrProductNotFound
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantScope(t *testing.T) {
+	acme := spanner.NullString{StringVal: "acme", Valid: true}
+	defaultTenant := spanner.NullString{}
+
+	unscoped := tenantScopeOf(context.Background())
+	assert.True(t, unscoped.visible(acme))
+	assert.True(t, unscoped.visible(defaultTenant))
+	params := map[string]interface{}{}
+	assert.Empty(t, unscoped.condition(ProductTenantID, params))
+	assert.Empty(t, params)
+
+	scoped := tenantScopeOf(caller.NewContext(context.Background(), caller.Identity{Tenant: "acme"}))
+	assert.True(t, scoped.visible(acme))
+	assert.False(t, scoped.visible(defaultTenant))
+	assert.Equal(t, " AND tenant_id = @tenant_id", scoped.condition(ProductTenantID, params))
+	assert.Equal(t, "acme", params["tenant_id"])
+
+	byDefault := tenantScopeOf(caller.NewContext(context.Background(), caller.Identity{}))
+	assert.False(t, byDefault.visible(acme))
+	assert.True(t, byDefault.visible(defaultTenant))
+	params = map[string]interface{}{}
+	assert.Equal(t, " AND p.tenant_id IS NULL", byDefault.condition("p."+ProductTenantID, params))
+	assert.Empty(t, params)
+}
+
+func TestProductRepo_TenantID(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	repo := &ProductRepo{}
+
+	product, err := repo.dataToDomain(discountRow(now, false, false, false), nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, product.TenantID())
+	assert.False(t, repo.productToData(product).TenantID.Valid, "the default tenant is stored as NULL")
+
+	data := repo.productToData(product)
+	data.TenantID = spanner.NullString{StringVal: "acme", Valid: true}
+	loaded, err := repo.dataToDomain(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "acme", loaded.TenantID())
+	assert.Equal(t, data.TenantID, repo.productToData(loaded).TenantID)
+}
+
+func TestBuildListQuery_Tenant(t *testing.T) {
+	rm := &ProductReadModel{}
+
+	stmt, err := rm.buildListQuery(tenantScope{tenant: "acme", scoped: true}, contract.ListProductsFilter{Category: "Tools"}, contract.Pagination{PageSize: 10}, time.Time{})
+	require.NoError(t, err)
+	assert.Contains(t, stmt.SQL, "WHERE 1=1 AND tenant_id = @tenant_id AND category = @category")
+	assert.Equal(t, "acme", stmt.Params["tenant_id"])
+
+	stmt, err = rm.buildListQuery(tenantScope{}, contract.ListProductsFilter{Category: "Tools"}, contract.Pagination{PageSize: 10}, time.Time{})
+	require.NoError(t, err)
+	assert.NotContains(t, stmt.SQL, "@tenant_id")
+}
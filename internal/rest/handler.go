@@ -0,0 +1,337 @@
+// Package rest implements a read-only REST/JSON transport for lightweight storefront clients.
+//
+// Responses carry the same canonical, machine-readable fields as the gRPC API (exact
+// prices as numerator/denominator, RFC 3339 timestamps) plus a display block rendered for
+// the locale negotiated from the Accept-Language header. Names and descriptions are in the
+// negotiated locale where the product has a translation for it.
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/product-catalog-service/internal/availability"
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/logging"
+	"github.com/product-catalog-service/internal/query"
+)
+
+// TenantHeader is the request header in which the API gateway asserts the tenant of the
+// storefront; products of other tenants are not found. The gateway must drop it from
+// client requests.
+const TenantHeader = "X-Catalog-Tenant"
+
+// Validation errors.
+var (
+	ErrInvalidPageSize   = errors.New("page_size must be an integer")
+	ErrInvalidPageNumber = errors.New("page_number must be an integer")
+	ErrInvalidActiveOnly = errors.New("active_only must be a boolean")
+	ErrInvalidInStock    = errors.New("in_stock must be a boolean")
+	ErrInvalidOnSale     = errors.New("has_active_discount must be a boolean")
+	ErrInvalidArchived   = errors.New("include_archived must be a boolean")
+	ErrInvalidTotalCount = errors.New("include_total_count must be a boolean")
+	ErrInvalidPrice      = errors.New("min_price and max_price must be decimal amounts, e.g. 19.99")
+	ErrInvalidPriceTime  = errors.New("price_at must be an RFC 3339 time, e.g. 2025-06-13T00:00:00Z")
+)
+
+// Handler serves the product queries over HTTP.
+type Handler struct {
+	queries *query.ProductQueries
+	mux     *http.ServeMux
+}
+
+// NewHandler creates a new REST handler.
+func NewHandler(queries *query.ProductQueries) *Handler {
+	h := &Handler{
+		queries: queries,
+		mux:     http.NewServeMux(),
+	}
+	h.mux.HandleFunc("GET /v1/products/{id}", h.getProduct)
+	h.mux.HandleFunc("GET /v1/products/by-slug/{slug}", h.getProductBySlug)
+	h.mux.HandleFunc("GET /v1/products/by-sku/{sku}", h.getProductBySKU)
+	h.mux.HandleFunc("GET /v1/products", h.listProducts)
+	return h
+}
+
+// ServeHTTP implements http.Handler. The request is served for the tenant in
+// TenantHeader; see package caller.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := caller.NewContext(r.Context(), caller.Identity{Tenant: r.Header.Get(TenantHeader)})
+	h.mux.ServeHTTP(w, r.WithContext(ctx))
+}
+
+func (h *Handler) getProduct(w http.ResponseWriter, r *http.Request) {
+	locale := negotiate(w, r)
+	at, err := parsePriceAt(r.URL.Query().Get("price_at"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	resp, err := h.queries.GetProduct(r.Context(), query.GetProductRequest{
+		ProductID:     r.PathValue("id"),
+		Currency:      r.URL.Query().Get("currency"),
+		Experiment:    query.ExperimentContext{SubjectID: r.URL.Query().Get("experiment_subject")},
+		Segment:       r.URL.Query().Get("segment"),
+		Market:        r.URL.Query().Get("market"),
+		PriceAt:       at,
+		Locale:        locale.Tag.String(),
+		ReadStaleness: r.URL.Query().Get("read_staleness"),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, productToJSON(resp, locale))
+}
+
+func (h *Handler) getProductBySlug(w http.ResponseWriter, r *http.Request) {
+	locale := negotiate(w, r)
+	at, err := parsePriceAt(r.URL.Query().Get("price_at"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	resp, err := h.queries.GetProductBySlug(r.Context(), query.GetProductBySlugRequest{
+		Slug:       r.PathValue("slug"),
+		Currency:   r.URL.Query().Get("currency"),
+		Experiment: query.ExperimentContext{SubjectID: r.URL.Query().Get("experiment_subject")},
+		Segment:    r.URL.Query().Get("segment"),
+		Market:     r.URL.Query().Get("market"),
+		PriceAt:    at,
+		Locale:     locale.Tag.String(),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, productToJSON(resp, locale))
+}
+
+func (h *Handler) getProductBySKU(w http.ResponseWriter, r *http.Request) {
+	locale := negotiate(w, r)
+	at, err := parsePriceAt(r.URL.Query().Get("price_at"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	resp, err := h.queries.GetProductBySKU(r.Context(), query.GetProductBySKURequest{
+		SKU:        r.PathValue("sku"),
+		Currency:   r.URL.Query().Get("currency"),
+		Experiment: query.ExperimentContext{SubjectID: r.URL.Query().Get("experiment_subject")},
+		Segment:    r.URL.Query().Get("segment"),
+		Market:     r.URL.Query().Get("market"),
+		PriceAt:    at,
+		Locale:     locale.Tag.String(),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, productToJSON(resp, locale))
+}
+
+func (h *Handler) listProducts(w http.ResponseWriter, r *http.Request) {
+	locale := negotiate(w, r)
+	params := r.URL.Query()
+
+	req := query.ListProductsRequest{
+		Category:      params.Get("category"),
+		Status:        params.Get("status"),
+		PageToken:     params.Get("page_token"),
+		Currency:      params.Get("currency"),
+		OrderBy:       params.Get("order_by"),
+		Market:        params.Get("market"),
+		Locale:        locale.Tag.String(),
+		ReadStaleness: params.Get("read_staleness"),
+	}
+	if v := params.Get("page_size"); v != "" {
+		pageSize, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, ErrInvalidPageSize)
+			return
+		}
+		req.PageSize = int32(pageSize)
+	}
+	if v := params.Get("page_number"); v != "" {
+		pageNumber, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			writeError(w, ErrInvalidPageNumber)
+			return
+		}
+		req.PageNumber = int32(pageNumber)
+	}
+	if v := params.Get("active_only"); v != "" {
+		activeOnly, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, ErrInvalidActiveOnly)
+			return
+		}
+		req.ActiveOnly = activeOnly
+	}
+	if v := params.Get("in_stock"); v != "" {
+		inStock, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, ErrInvalidInStock)
+			return
+		}
+		req.InStockOnly = inStock
+	}
+	if v := params.Get("has_active_discount"); v != "" {
+		onSale, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, ErrInvalidOnSale)
+			return
+		}
+		req.HasActiveDiscount = onSale
+	}
+	if v := params.Get("include_archived"); v != "" {
+		includeArchived, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, ErrInvalidArchived)
+			return
+		}
+		req.IncludeArchived = includeArchived
+	}
+	if v := params.Get("include_total_count"); v != "" {
+		includeTotalCount, err := strconv.ParseBool(v)
+		if err != nil {
+			writeError(w, ErrInvalidTotalCount)
+			return
+		}
+		req.IncludeTotalCount = includeTotalCount
+	}
+	req.Tag = params.Get("tag")
+	req.Channel = params.Get("channel")
+	var err error
+	if req.PriceAt, err = parsePriceAt(params.Get("price_at")); err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.MinPrice, err = parsePriceBound(params.Get("min_price"), params.Get("price_currency")); err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.MaxPrice, err = parsePriceBound(params.Get("max_price"), params.Get("price_currency")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	resp, err := h.queries.ListProducts(r.Context(), req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, listProductsToJSON(resp, locale))
+}
+
+// parsePriceBound parses a decimal amount such as "19.99" as a bound of a price range in
+// currency; nil if the amount is empty.
+func parsePriceBound(amount, currency string) (*contract.PriceBound, error) {
+	if amount == "" {
+		return nil, nil
+	}
+	rat, ok := new(big.Rat).SetString(amount)
+	if !ok || strings.Contains(amount, "/") || !rat.Num().IsInt64() || !rat.Denom().IsInt64() {
+		return nil, ErrInvalidPrice
+	}
+	return &contract.PriceBound{Numerator: rat.Num().Int64(), Denominator: rat.Denom().Int64(), Currency: currency}, nil
+}
+
+// parsePriceAt parses an RFC 3339 pricing time; the zero time, pricing now, if value is
+// empty.
+func parsePriceAt(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, ErrInvalidPriceTime
+	}
+	return at, nil
+}
+
+// negotiate selects the display locale and records it in the response headers.
+func negotiate(w http.ResponseWriter, r *http.Request) Locale {
+	locale := NegotiateLocale(r.Header.Get("Accept-Language"))
+	w.Header().Set("Content-Language", locale.Tag.String())
+	w.Header().Add("Vary", "Accept-Language")
+	return locale
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logging.Warnf("rest: failed to write response: %v", err)
+	}
+}
+
+// writeError writes err as a JSON error body with the matching HTTP status.
+func writeError(w http.ResponseWriter, err error) {
+	status := HTTPStatus(err)
+	message := err.Error()
+	if status == http.StatusInternalServerError {
+		logging.Errorf("rest: %v", err)
+		message = "internal server error"
+	}
+	var unavailable *availability.UnavailableError
+	if errors.As(err, &unavailable) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(unavailable.RetryAfter.Seconds()))))
+	}
+	writeJSON(w, status, errorJSON{Error: errorBody{Status: status, Message: message}})
+}
+
+// HTTPStatus converts domain and validation errors to HTTP status codes.
+func HTTPStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrProductNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrInvalidID),
+		errors.Is(err, ErrInvalidPageSize),
+		errors.Is(err, ErrInvalidPageNumber),
+		errors.Is(err, ErrInvalidActiveOnly),
+		errors.Is(err, ErrInvalidInStock),
+		errors.Is(err, ErrInvalidOnSale),
+		errors.Is(err, ErrInvalidArchived),
+		errors.Is(err, ErrInvalidTotalCount),
+		errors.Is(err, ErrInvalidPrice),
+		errors.Is(err, ErrInvalidPriceTime),
+		errors.Is(err, domain.ErrInvalidPriceAt),
+		errors.Is(err, domain.ErrInvalidReadStaleness),
+		errors.Is(err, domain.ErrInvalidPriceRange),
+		errors.Is(err, domain.ErrInvalidCurrency),
+		errors.Is(err, domain.ErrInvalidOrderBy),
+		errors.Is(err, domain.ErrInvalidPageToken),
+		errors.Is(err, domain.ErrInvalidPageNumber),
+		errors.Is(err, domain.ErrSubjectIDTooLong),
+		errors.Is(err, domain.ErrInvalidSegment),
+		errors.Is(err, domain.ErrInvalidMarket),
+		errors.Is(err, domain.ErrInvalidTag),
+		errors.Is(err, domain.ErrInvalidSalesChannel),
+		errors.Is(err, domain.ErrInvalidSlug),
+		errors.Is(err, domain.ErrInvalidSKU),
+		errors.Is(err, domain.ErrMarketCurrencyMismatch):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrNoExchangeRate):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, availability.ErrUnavailable):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
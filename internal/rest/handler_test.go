@@ -0,0 +1,475 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/availability"
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/experiment"
+	"github.com/product-catalog-service/internal/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeReadModel serves a fixed set of products.
+type fakeReadModel struct {
+	contract.ProductReadModel
+	products   []*contract.ProductDTO
+	lastFilter contract.ListProductsFilter
+	lastPage   contract.Pagination
+	lastTenant string
+	lastScoped bool
+	lastAt     time.Time
+}
+
+func (rm *fakeReadModel) GetProduct(_ context.Context, id string, at time.Time) (*contract.ProductDTO, error) {
+	rm.lastAt = at
+	for _, p := range rm.products {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return nil, domain.ErrProductNotFound
+}
+
+func (rm *fakeReadModel) FindProductIDBySlug(_ context.Context, slug string) (string, error) {
+	for _, p := range rm.products {
+		if p.Slug == slug {
+			return p.ID, nil
+		}
+	}
+	return "", domain.ErrProductNotFound
+}
+
+func (rm *fakeReadModel) FindProductIDBySKU(_ context.Context, sku string) (string, error) {
+	for _, p := range rm.products {
+		if p.SKU == sku {
+			return p.ID, nil
+		}
+	}
+	return "", domain.ErrProductNotFound
+}
+
+func (rm *fakeReadModel) ListProducts(ctx context.Context, filter contract.ListProductsFilter, pagination contract.Pagination, at time.Time) (*contract.ListProductsResult, error) {
+	rm.lastTenant, rm.lastScoped = caller.Tenant(ctx)
+	rm.lastAt = at
+	rm.lastFilter = filter
+	rm.lastPage = pagination
+	return &contract.ListProductsResult{Products: rm.products, TotalCount: int64(len(rm.products))}, nil
+}
+
+func newTestHandler() (*Handler, *fakeReadModel) {
+	created := time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC)
+	percent := 12.5
+	start := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	readModel := &fakeReadModel{products: []*contract.ProductDTO{{
+		ID:                  "product-1",
+		Name:                "Widget",
+		Slug:                "widget",
+		SKU:                 "WDG-1",
+		GTIN:                "4006381333931",
+		Category:            "Tools",
+		BasePriceNum:        123450,
+		BasePriceDenom:      100,
+		Currency:            "USD",
+		EffectivePriceNum:   108019,
+		EffectivePriceDenom: 100,
+		DiscountPercent:     &percent,
+		DiscountStartDate:   &start,
+		DiscountEndDate:     &end,
+		HasActiveDiscount:   true,
+		Status:              "active",
+		CreatedAt:           created,
+		UpdatedAt:           created,
+		Discounts: []contract.DiscountDTO{
+			{ID: "discount-1", Percent: percent, Priority: 10, StartDate: start, EndDate: end},
+		},
+		PriceBook: []contract.PriceBookEntryDTO{
+			{Currency: "EUR", PriceNum: 1100, PriceDenom: 1},
+		},
+		SegmentPrices: []contract.SegmentPriceDTO{
+			{Segment: "wholesale", PercentOff: 20},
+		},
+		Translations: []contract.TranslationDTO{
+			{Locale: "de", Name: "Werkzeug"},
+		},
+	}}}
+	queries := query.NewProductQueries(readModel, clock.NewFixedClock(created))
+	return NewHandler(queries), readModel
+}
+
+func serve(h http.Handler, target, acceptLanguage string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if acceptLanguage != "" {
+		req.Header.Set("Accept-Language", acceptLanguage)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_GetProduct(t *testing.T) {
+	h, _ := newTestHandler()
+
+	rec := serve(h, "/v1/products/product-1", "de-CH, en;q=0.5")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "de-DE", rec.Header().Get("Content-Language"))
+	assert.Equal(t, "Accept-Language", rec.Header().Get("Vary"))
+
+	var body productJSON
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+
+	// Names are in the negotiated locale where the product has a translation.
+	assert.Equal(t, "Werkzeug", body.Name)
+	assert.Equal(t, "de", body.Locale)
+
+	// Canonical fields do not depend on the locale.
+	assert.Equal(t, "product-1", body.ID)
+	assert.Equal(t, moneyJSON{Numerator: 123450, Denominator: 100}, body.BasePrice)
+	assert.Equal(t, moneyJSON{Numerator: 108019, Denominator: 100}, body.EffectivePrice)
+	assert.Equal(t, "USD", body.Currency)
+	require.NotNil(t, body.Discount)
+	assert.Equal(t, 12.5, body.Discount.Percentage)
+	require.Len(t, body.Discounts, 1)
+	assert.Equal(t, "discount-1", body.Discounts[0].ID)
+	assert.Equal(t, 10, body.Discounts[0].Priority)
+	assert.Equal(t, time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC), body.CreatedAt)
+
+	assert.Equal(t, displayJSON{
+		Locale:            "de-DE",
+		BasePrice:         "1.234,50 USD",
+		EffectivePrice:    "1.080,19 USD",
+		DiscountPercent:   "12,5 %",
+		DiscountStartDate: "01.02.2024 00:00",
+		DiscountEndDate:   "01.03.2024 00:00",
+		CreatedAt:         "15.01.2024 09:30",
+		UpdatedAt:         "15.01.2024 09:30",
+	}, body.Display)
+}
+
+func TestHandler_GetProductBySlug(t *testing.T) {
+	h, _ := newTestHandler()
+
+	rec := serve(h, "/v1/products/by-slug/widget?currency=EUR", "de")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "de-DE", rec.Header().Get("Content-Language"))
+
+	var body productJSON
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "product-1", body.ID)
+	assert.Equal(t, "widget", body.Slug)
+	assert.Equal(t, "Werkzeug", body.Name)
+	assert.Equal(t, "EUR", body.Currency)
+}
+
+func TestHandler_GetProductBySKU(t *testing.T) {
+	h, _ := newTestHandler()
+
+	rec := serve(h, "/v1/products/by-sku/wdg-1", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var body productJSON
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "product-1", body.ID)
+	assert.Equal(t, "WDG-1", body.SKU)
+	assert.Equal(t, "4006381333931", body.GTIN)
+
+	assert.Equal(t, http.StatusNotFound, serve(h, "/v1/products/by-sku/GDG-1", "").Code)
+	assert.Equal(t, http.StatusBadRequest, serve(h, "/v1/products/by-sku/WDG.1", "").Code)
+}
+
+func TestHandler_ListProducts(t *testing.T) {
+	h, readModel := newTestHandler()
+
+	rec := serve(h, "/v1/products?category=Tools&active_only=true&page_size=5", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "en-US", rec.Header().Get("Content-Language"))
+	assert.Equal(t, contract.ListProductsFilter{Category: "Tools", ActiveOnly: true}, readModel.lastFilter)
+	assert.Equal(t, int32(5), readModel.lastPage.PageSize)
+
+	var body listProductsJSON
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Products, 1)
+	assert.Equal(t, int64(1), body.TotalCount)
+	assert.Equal(t, "Widget", body.Products[0].Name)
+	assert.Equal(t, "en", body.Products[0].Locale)
+	assert.Equal(t, 12.5, body.Products[0].DiscountPercent)
+	assert.Equal(t, "USD 1,234.50", body.Products[0].Display.BasePrice)
+	assert.Equal(t, "12.5%", body.Products[0].Display.DiscountPercent)
+	assert.Equal(t, "01/15/2024 9:30 AM", body.Products[0].Display.CreatedAt)
+
+	rec = serve(h, "/v1/products?category=Tools&order_by=merchandised", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, contract.OrderByMerchandised, readModel.lastPage.OrderBy)
+
+	rec = serve(h, "/v1/products?min_price=10&max_price=49.99&price_currency=EUR", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, &contract.PriceBound{Numerator: 10, Denominator: 1, Currency: "EUR"}, readModel.lastFilter.MinPrice)
+	assert.Equal(t, &contract.PriceBound{Numerator: 4999, Denominator: 100, Currency: "EUR"}, readModel.lastFilter.MaxPrice)
+
+	rec = serve(h, "/v1/products?has_active_discount=true", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, readModel.lastFilter.HasActiveDiscount)
+	assert.False(t, readModel.lastPage.IncludeTotalCount)
+
+	rec = serve(h, "/v1/products?include_total_count=true", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, readModel.lastPage.IncludeTotalCount)
+
+	rec = serve(h, "/v1/products?include_archived=true", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, readModel.lastFilter.IncludeArchived)
+
+	rec = serve(h, "/v1/products?page_number=4&page_size=25", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, int32(4), readModel.lastPage.PageNumber)
+}
+
+func TestHandler_PriceAt(t *testing.T) {
+	h, readModel := newTestHandler()
+	friday := time.Date(2024, 1, 19, 0, 0, 0, 0, time.UTC)
+
+	rec := serve(h, "/v1/products/product-1?price_at=2024-01-19T00:00:00Z", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, friday.Equal(readModel.lastAt))
+
+	rec = serve(h, "/v1/products?category=Tools&price_at=2024-01-19T01:00:00%2B01:00", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, friday.Equal(readModel.lastAt))
+
+	// Without price_at, products are priced now
+	rec = serve(h, "/v1/products/by-slug/widget", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC), readModel.lastAt)
+}
+
+func TestHandler_Tenant(t *testing.T) {
+	h, readModel := newTestHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/products", nil)
+	req.Header.Set(TenantHeader, "acme")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, readModel.lastScoped)
+	assert.Equal(t, "acme", readModel.lastTenant)
+
+	// Without the header the storefront is served for the default tenant, not for all.
+	rec = serve(h, "/v1/products", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, readModel.lastScoped)
+	assert.Empty(t, readModel.lastTenant)
+}
+
+func TestHandler_RoundingPolicy(t *testing.T) {
+	h, readModel := newTestHandler()
+	h = NewHandler(query.NewProductQueries(readModel, clock.NewFixedClock(time.Now()), query.WithRoundingPolicy(domain.RoundCharm)))
+
+	rec := serve(h, "/v1/products/product-1", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var body productJSON
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, moneyJSON{Numerator: 108019, Denominator: 100}, body.EffectivePrice)
+	assert.Equal(t, "USD 1,234.99", body.Display.BasePrice)
+	assert.Equal(t, "USD 1,079.99", body.Display.EffectivePrice)
+}
+
+func TestHandler_Experiment(t *testing.T) {
+	h, readModel := newTestHandler()
+	set, err := experiment.Parse([]byte(`[{"id": "cheaper-tools", "categories": ["Tools"],
+		"variants": [{"name": "cheaper", "weight": 1, "price_factor": "0.5"}]}]`))
+	require.NoError(t, err)
+	h = NewHandler(query.NewProductQueries(readModel, clock.NewFixedClock(time.Now()), query.WithExperiments(set, nil)))
+
+	rec := serve(h, "/v1/products/product-1?experiment_subject=customer-1", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var body productJSON
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, &experimentJSON{ID: "cheaper-tools", Variant: "cheaper"}, body.Experiment)
+	assert.Equal(t, moneyJSON{Numerator: 2469, Denominator: 4}, body.BasePrice)
+
+	rec = serve(h, "/v1/products/product-1", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), `"experiment"`)
+}
+
+func TestHandler_Currency(t *testing.T) {
+	h, _ := newTestHandler()
+
+	rec := serve(h, "/v1/products/product-1?currency=EUR", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var body productJSON
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "EUR", body.Currency)
+	assert.Equal(t, query.PriceSourcePriceBook, body.PriceSource)
+	assert.Equal(t, moneyJSON{Numerator: 1100, Denominator: 1}, body.BasePrice)
+	assert.Equal(t, []priceJSON{{Numerator: 1100, Denominator: 1, Currency: "EUR"}}, body.PriceBook)
+
+	rec = serve(h, "/v1/products?currency=EUR", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var list listProductsJSON
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Products, 1)
+	assert.Equal(t, "EUR", list.Products[0].Currency)
+	assert.Equal(t, query.PriceSourcePriceBook, list.Products[0].PriceSource)
+}
+
+func TestHandler_Segment(t *testing.T) {
+	h, _ := newTestHandler()
+
+	rec := serve(h, "/v1/products/product-1?segment=wholesale", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var body productJSON
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "wholesale", body.Segment)
+	assert.Equal(t, moneyJSON{Numerator: 4938, Denominator: 5}, body.BasePrice)
+	require.Len(t, body.SegmentPrices, 1)
+	require.NotNil(t, body.SegmentPrices[0].PercentOff)
+	assert.Equal(t, 20.0, *body.SegmentPrices[0].PercentOff)
+
+	rec = serve(h, "/v1/products/product-1?segment=retail", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body = productJSON{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Empty(t, body.Segment)
+	assert.Equal(t, moneyJSON{Numerator: 123450, Denominator: 100}, body.BasePrice)
+}
+
+func TestHandler_Market(t *testing.T) {
+	h, readModel := newTestHandler()
+	readModel.products[0].MarketPrices = []contract.MarketPriceDTO{{
+		Market:              "EU",
+		Currency:            "EUR",
+		PriceNum:            1100,
+		PriceDenom:          1,
+		EffectivePriceNum:   1100,
+		EffectivePriceDenom: 1,
+	}}
+
+	rec := serve(h, "/v1/products/product-1?market=eu", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var body productJSON
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "EU", body.Market)
+	assert.Equal(t, "EUR", body.Currency)
+	assert.Equal(t, moneyJSON{Numerator: 1100, Denominator: 1}, body.BasePrice)
+	assert.False(t, body.HasActiveDiscount)
+	require.Len(t, body.MarketPrices, 1)
+	assert.Equal(t, "EU", body.MarketPrices[0].Market)
+
+	rec = serve(h, "/v1/products?market=EU", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var list listProductsJSON
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Products, 1)
+	assert.Equal(t, "EU", list.Products[0].Market)
+
+	rec = serve(h, "/v1/products/product-1?market=EU&currency=USD", "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestHandler_Errors(t *testing.T) {
+	h, _ := newTestHandler()
+
+	tests := []struct {
+		name       string
+		target     string
+		wantStatus int
+	}{
+		{name: "product not found", target: "/v1/products/missing", wantStatus: http.StatusNotFound},
+		{name: "slug not found", target: "/v1/products/by-slug/gadget", wantStatus: http.StatusNotFound},
+		{name: "invalid slug", target: "/v1/products/by-slug/blue_widget", wantStatus: http.StatusBadRequest},
+		{name: "invalid page size", target: "/v1/products?page_size=ten", wantStatus: http.StatusBadRequest},
+		{name: "invalid active only", target: "/v1/products?active_only=maybe", wantStatus: http.StatusBadRequest},
+		{name: "invalid in stock", target: "/v1/products?in_stock=maybe", wantStatus: http.StatusBadRequest},
+		{name: "invalid has active discount", target: "/v1/products?has_active_discount=maybe", wantStatus: http.StatusBadRequest},
+		{name: "invalid include total count", target: "/v1/products?include_total_count=maybe", wantStatus: http.StatusBadRequest},
+		{name: "invalid read staleness", target: "/v1/products/product-1?read_staleness=2h", wantStatus: http.StatusBadRequest},
+		{name: "invalid price at", target: "/v1/products?price_at=friday", wantStatus: http.StatusBadRequest},
+		{name: "price at out of bounds", target: "/v1/products/product-1?price_at=2030-01-01T00:00:00Z", wantStatus: http.StatusBadRequest},
+		{name: "invalid include archived", target: "/v1/products?include_archived=maybe", wantStatus: http.StatusBadRequest},
+		{name: "invalid page number", target: "/v1/products?page_number=two", wantStatus: http.StatusBadRequest},
+		{name: "page number past the cap", target: "/v1/products?page_number=101&page_size=100", wantStatus: http.StatusBadRequest},
+		{name: "invalid tag", target: "/v1/products?tag=big+sale", wantStatus: http.StatusBadRequest},
+		{name: "invalid channel", target: "/v1/products?channel=kiosk", wantStatus: http.StatusBadRequest},
+		{name: "invalid min price", target: "/v1/products?min_price=cheap", wantStatus: http.StatusBadRequest},
+		{name: "invalid price range", target: "/v1/products?min_price=20&max_price=10", wantStatus: http.StatusBadRequest},
+		{name: "invalid currency", target: "/v1/products/product-1?currency=euro", wantStatus: http.StatusBadRequest},
+		{name: "no exchange rate", target: "/v1/products?currency=GBP", wantStatus: http.StatusUnprocessableEntity},
+		{name: "invalid order by", target: "/v1/products?order_by=popularity", wantStatus: http.StatusBadRequest},
+		{name: "invalid segment", target: "/v1/products/product-1?segment=Wholesale", wantStatus: http.StatusBadRequest},
+		{name: "invalid market", target: "/v1/products/product-1?market=JP", wantStatus: http.StatusBadRequest},
+		{name: "experiment subject too long", target: "/v1/products/product-1?experiment_subject=" + strings.Repeat("x", 129), wantStatus: http.StatusBadRequest},
+		{name: "unknown route", target: "/v1/orders", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(h, tt.target, "")
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestHandler_MethodNotAllowed(t *testing.T) {
+	h, _ := newTestHandler()
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/products", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestHandler_Degraded(t *testing.T) {
+	created := time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC)
+	var probeErr error
+	monitor := availability.NewMonitor(func(context.Context) error { return probeErr },
+		availability.MonitorOptions{CheckInterval: 5 * time.Second, FailureThreshold: 1})
+	readModel := &fakeReadModel{products: []*contract.ProductDTO{{
+		ID: "product-1", Name: "Widget", Category: "Tools", BasePriceNum: 100, BasePriceDenom: 1,
+		EffectivePriceNum: 100, EffectivePriceDenom: 1, Currency: "USD", Status: "active", CreatedAt: created,
+	}}}
+	degradable := availability.NewReadModel(readModel, monitor, clock.NewFixedClock(created), 10)
+	h := NewHandler(query.NewProductQueries(degradable, clock.NewFixedClock(created)))
+
+	rec := serve(h, "/v1/products/product-1", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), `"stale"`)
+
+	probeErr = errors.New("unavailable")
+	monitor.Check(context.Background())
+
+	rec = serve(h, "/v1/products/product-1", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body struct {
+		Stale    bool       `json:"stale"`
+		CachedAt *time.Time `json:"cached_at"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.True(t, body.Stale)
+	require.NotNil(t, body.CachedAt)
+	assert.Equal(t, created, *body.CachedAt)
+
+	rec = serve(h, "/v1/products", "")
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, "5", rec.Header().Get("Retry-After"))
+}
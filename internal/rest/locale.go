@@ -0,0 +1,113 @@
+package rest
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// Locale describes how numbers and dates are rendered for display.
+type Locale struct {
+	Tag language.Tag
+	// DecimalSeparator and GroupSeparator are used in prices and percentages.
+	DecimalSeparator string
+	GroupSeparator   string
+	// CurrencyFirst places the currency code before the amount.
+	CurrencyFirst bool
+	// PercentSuffix follows the percentage value, e.g. "%" or " %".
+	PercentSuffix string
+	// DateTimeLayout is a time.Format layout; times are rendered in UTC.
+	DateTimeLayout string
+}
+
+// Locales are the supported display locales. The first one is the default.
+var Locales = []Locale{
+	{Tag: language.AmericanEnglish, DecimalSeparator: ".", GroupSeparator: ",", CurrencyFirst: true, PercentSuffix: "%", DateTimeLayout: "01/02/2006 3:04 PM"},
+	{Tag: language.BritishEnglish, DecimalSeparator: ".", GroupSeparator: ",", CurrencyFirst: true, PercentSuffix: "%", DateTimeLayout: "02/01/2006 15:04"},
+	{Tag: language.MustParse("de-DE"), DecimalSeparator: ",", GroupSeparator: ".", PercentSuffix: " %", DateTimeLayout: "02.01.2006 15:04"},
+	{Tag: language.MustParse("fr-FR"), DecimalSeparator: ",", GroupSeparator: " ", PercentSuffix: " %", DateTimeLayout: "02/01/2006 15:04"},
+	{Tag: language.MustParse("es-ES"), DecimalSeparator: ",", GroupSeparator: ".", PercentSuffix: " %", DateTimeLayout: "02/01/2006 15:04"},
+	{Tag: language.MustParse("it-IT"), DecimalSeparator: ",", GroupSeparator: ".", PercentSuffix: "%", DateTimeLayout: "02/01/2006 15:04"},
+	{Tag: language.MustParse("nl-NL"), DecimalSeparator: ",", GroupSeparator: ".", CurrencyFirst: true, PercentSuffix: "%", DateTimeLayout: "02-01-2006 15:04"},
+	{Tag: language.BrazilianPortuguese, DecimalSeparator: ",", GroupSeparator: ".", CurrencyFirst: true, PercentSuffix: "%", DateTimeLayout: "02/01/2006 15:04"},
+	{Tag: language.Japanese, DecimalSeparator: ".", GroupSeparator: ",", CurrencyFirst: true, PercentSuffix: "%", DateTimeLayout: "2006/01/02 15:04"},
+}
+
+var localeMatcher = language.NewMatcher(localeTags())
+
+func localeTags() []language.Tag {
+	tags := make([]language.Tag, len(Locales))
+	for i, l := range Locales {
+		tags[i] = l.Tag
+	}
+	return tags
+}
+
+// NegotiateLocale returns the supported locale that best matches an Accept-Language
+// header value, or the default locale if none matches or the header is invalid.
+func NegotiateLocale(acceptLanguage string) Locale {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return Locales[0]
+	}
+	_, index, confidence := localeMatcher.Match(tags...)
+	if confidence == language.No {
+		return Locales[0]
+	}
+	return Locales[index]
+}
+
+// FormatNumber renders a non-negative decimal string such as "1234.5" with the
+// locale's separators.
+func (l Locale) FormatNumber(decimal string) string {
+	integer, fraction, hasFraction := strings.Cut(decimal, ".")
+	sign := ""
+	if strings.HasPrefix(integer, "-") {
+		sign, integer = "-", integer[1:]
+	}
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteString(l.GroupSeparator)
+		}
+		b.WriteRune(digit)
+	}
+	if hasFraction {
+		b.WriteString(l.DecimalSeparator)
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
+
+// FormatPrice renders an exact amount rounded half-up to cents with its currency code.
+func (l Locale) FormatPrice(numerator, denominator int64, currency string) string {
+	if denominator == 0 {
+		denominator = 1
+	}
+	return l.FormatAmount(big.NewRat(numerator, denominator).FloatString(2), currency)
+}
+
+// FormatAmount renders an amount already rounded for display, such as "19.99", with its
+// currency code.
+func (l Locale) FormatAmount(decimal, currency string) string {
+	amount := l.FormatNumber(decimal)
+	if l.CurrencyFirst {
+		return currency + " " + amount
+	}
+	return amount + " " + currency
+}
+
+// FormatPercent renders a percentage such as 12.5 as "12.5%".
+func (l Locale) FormatPercent(percent float64) string {
+	return l.FormatNumber(strconv.FormatFloat(percent, 'f', -1, 64)) + l.PercentSuffix
+}
+
+// FormatTime renders a timestamp in UTC.
+func (l Locale) FormatTime(t time.Time) string {
+	return t.UTC().Format(l.DateTimeLayout)
+}
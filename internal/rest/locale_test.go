@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateLocale(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           string
+	}{
+		{name: "empty header", acceptLanguage: "", want: "en-US"},
+		{name: "invalid header", acceptLanguage: ";;;=", want: "en-US"},
+		{name: "unsupported language", acceptLanguage: "sw", want: "en-US"},
+		{name: "exact match", acceptLanguage: "de-DE", want: "de-DE"},
+		{name: "regional variant", acceptLanguage: "de-AT", want: "de-DE"},
+		{name: "british english", acceptLanguage: "en-GB", want: "en-GB"},
+		{name: "quality order", acceptLanguage: "fr;q=0.5, ja;q=0.9", want: "ja"},
+		{name: "first supported", acceptLanguage: "sw, nl;q=0.8", want: "nl-NL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NegotiateLocale(tt.acceptLanguage).Tag.String())
+		})
+	}
+}
+
+func TestLocale_FormatPrice(t *testing.T) {
+	tests := []struct {
+		name        string
+		locale      string
+		numerator   int64
+		denominator int64
+		want        string
+	}{
+		{name: "en-US", locale: "en-US", numerator: 123456789, denominator: 100, want: "USD 1,234,567.89"},
+		{name: "de-DE", locale: "de-DE", numerator: 123456789, denominator: 100, want: "1.234.567,89 USD"},
+		{name: "fr-FR", locale: "fr-FR", numerator: 123456, denominator: 100, want: "1 234,56 USD"},
+		{name: "small amount", locale: "de-DE", numerator: 5, denominator: 100, want: "0,05 USD"},
+		{name: "rounds to cents", locale: "en-US", numerator: 100, denominator: 3, want: "USD 33.33"},
+		{name: "exact thousand", locale: "en-US", numerator: 100000, denominator: 1, want: "USD 100,000.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locale := NegotiateLocale(tt.locale)
+			assert.Equal(t, tt.want, locale.FormatPrice(tt.numerator, tt.denominator, "USD"))
+		})
+	}
+}
+
+func TestLocale_FormatPercent(t *testing.T) {
+	assert.Equal(t, "12.5%", NegotiateLocale("en-US").FormatPercent(12.5))
+	assert.Equal(t, "12,5 %", NegotiateLocale("de-DE").FormatPercent(12.5))
+	assert.Equal(t, "20%", NegotiateLocale("it-IT").FormatPercent(20))
+}
+
+func TestLocale_FormatTime(t *testing.T) {
+	at := time.Date(2024, 3, 5, 14, 7, 0, 0, time.FixedZone("CET", 3600))
+
+	assert.Equal(t, "03/05/2024 1:07 PM", NegotiateLocale("en-US").FormatTime(at))
+	assert.Equal(t, "05.03.2024 13:07", NegotiateLocale("de-DE").FormatTime(at))
+	assert.Equal(t, "2024/03/05 13:07", NegotiateLocale("ja").FormatTime(at))
+}
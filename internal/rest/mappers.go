@@ -0,0 +1,519 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/query"
+)
+
+// moneyJSON is an exact price, as in the gRPC Money message.
+type moneyJSON struct {
+	Numerator   int64 `json:"numerator"`
+	Denominator int64 `json:"denominator"`
+}
+
+// priceJSON is an exact price in a named currency, as in a price book.
+type priceJSON struct {
+	Numerator   int64  `json:"numerator"`
+	Denominator int64  `json:"denominator"`
+	Currency    string `json:"currency"`
+}
+
+type discountJSON struct {
+	ID         string        `json:"id,omitempty"`
+	Percentage float64       `json:"percentage"`
+	Priority   int           `json:"priority"`
+	StartDate  *time.Time    `json:"start_date,omitempty"`
+	EndDate    *time.Time    `json:"end_date,omitempty"`
+	Suspended  bool          `json:"suspended"`
+	Kind       string        `json:"kind,omitempty"`
+	BuyXGetY   *buyXGetYJSON `json:"buy_x_get_y,omitempty"`
+	SalePrice  *moneyJSON    `json:"sale_price,omitempty"`
+	Market     string        `json:"market,omitempty"`
+	// PendingApproval, ApprovedBy and FlashSale are only set on the discounts of a product.
+	PendingApproval bool   `json:"pending_approval,omitempty"`
+	ApprovedBy      string `json:"approved_by,omitempty"`
+	FlashSale       bool   `json:"flash_sale,omitempty"`
+}
+
+// buyXGetYJSON is the rule of a buy-X-get-Y promotion.
+type buyXGetYJSON struct {
+	BuyQuantity int `json:"buy_quantity"`
+	GetQuantity int `json:"get_quantity"`
+}
+
+// priceTierJSON is a volume price tier; it has either a unit price or a percent off.
+type priceTierJSON struct {
+	MinQuantity int64      `json:"min_quantity"`
+	UnitPrice   *moneyJSON `json:"unit_price,omitempty"`
+	PercentOff  *float64   `json:"percent_off,omitempty"`
+}
+
+type segmentPriceJSON struct {
+	Segment    string     `json:"segment"`
+	FixedPrice *moneyJSON `json:"fixed_price,omitempty"`
+	PercentOff *float64   `json:"percent_off,omitempty"`
+}
+
+// marketPriceJSON is the price of a product in a market, in the market's currency.
+type marketPriceJSON struct {
+	Market            string    `json:"market"`
+	Currency          string    `json:"currency"`
+	Price             moneyJSON `json:"price"`
+	EffectivePrice    moneyJSON `json:"effective_price"`
+	HasActiveDiscount bool      `json:"has_active_discount"`
+}
+
+// variantJSON is a variant of a product, priced in the product's currency.
+type variantJSON struct {
+	ID             string            `json:"id"`
+	SKU            string            `json:"sku"`
+	Attributes     map[string]string `json:"attributes,omitempty"`
+	PriceDelta     moneyJSON         `json:"price_delta"`
+	Price          moneyJSON         `json:"price"`
+	EffectivePrice moneyJSON         `json:"effective_price"`
+	Status         string            `json:"status"`
+}
+
+// stockJSON is the stock of a product whose stock is tracked.
+type stockJSON struct {
+	Level     int64 `json:"level"`
+	Reserved  int64 `json:"reserved"`
+	Available int64 `json:"available"`
+	Version   int64 `json:"version"`
+}
+
+type imageJSON struct {
+	URL     string `json:"url"`
+	AltText string `json:"alt_text,omitempty"`
+	Primary bool   `json:"primary"`
+}
+
+// attachmentJSON is one support document of a product.
+type attachmentJSON struct {
+	Type   string `json:"type"`
+	URL    string `json:"url"`
+	Locale string `json:"locale,omitempty"`
+}
+
+// reviewJSON is the latest review of a product.
+type reviewJSON struct {
+	SubmittedBy     string `json:"submitted_by"`
+	ReviewedBy      string `json:"reviewed_by,omitempty"`
+	RejectionReason string `json:"rejection_reason,omitempty"`
+}
+
+// complianceJSON are the compliance flags of a product.
+type complianceJSON struct {
+	MinimumAge        int      `json:"minimum_age,omitempty"`
+	Hazardous         bool     `json:"hazardous"`
+	RestrictedMarkets []string `json:"restricted_markets,omitempty"`
+}
+
+// weightJSON is the weight of a product in the unit it was given in, and in grams.
+type weightJSON struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+	Grams float64 `json:"grams"`
+}
+
+// dimensionsJSON are the dimensions of a product in the unit they were given in, and in
+// millimetres.
+type dimensionsJSON struct {
+	Height   float64 `json:"height"`
+	Width    float64 `json:"width"`
+	Depth    float64 `json:"depth"`
+	Unit     string  `json:"unit"`
+	HeightMM float64 `json:"height_mm"`
+	WidthMM  float64 `json:"width_mm"`
+	DepthMM  float64 `json:"depth_mm"`
+}
+
+// ratingJSON summarizes the customer ratings of a product.
+type ratingJSON struct {
+	Average float64 `json:"average"`
+	Count   int64   `json:"count"`
+}
+
+// ratingToJSON maps a rating summary, nil if the product has no ratings.
+func ratingToJSON(rating query.RatingResponse) *ratingJSON {
+	if rating.Count == 0 {
+		return nil
+	}
+	return &ratingJSON{Average: rating.Average, Count: rating.Count}
+}
+
+// pendingPriceChangeJSON is a base price change scheduled to take effect later.
+type pendingPriceChangeJSON struct {
+	BasePrice   moneyJSON `json:"base_price"`
+	EffectiveAt time.Time `json:"effective_at"`
+}
+
+// displayJSON holds the locale-formatted renderings of a product's numbers and dates.
+// Clients must not parse them; the canonical fields are authoritative.
+type displayJSON struct {
+	Locale            string `json:"locale"`
+	BasePrice         string `json:"base_price"`
+	EffectivePrice    string `json:"effective_price"`
+	DiscountPercent   string `json:"discount_percent,omitempty"`
+	DiscountStartDate string `json:"discount_start_date,omitempty"`
+	DiscountEndDate   string `json:"discount_end_date,omitempty"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at,omitempty"`
+}
+
+// experimentJSON tells which pricing experiment variant the prices are in.
+type experimentJSON struct {
+	ID      string `json:"id"`
+	Variant string `json:"variant"`
+}
+
+type productJSON struct {
+	ID                 string                  `json:"id"`
+	Name               string                  `json:"name"`
+	Slug               string                  `json:"slug,omitempty"`
+	SKU                string                  `json:"sku,omitempty"`
+	GTIN               string                  `json:"gtin,omitempty"`
+	Description        string                  `json:"description"`
+	Locale             string                  `json:"locale"`
+	Category           string                  `json:"category"`
+	BasePrice          moneyJSON               `json:"base_price"`
+	EffectivePrice     moneyJSON               `json:"effective_price"`
+	Currency           string                  `json:"currency"`
+	Discount           *discountJSON           `json:"discount,omitempty"`
+	Discounts          []discountJSON          `json:"discounts,omitempty"`
+	PriceTiers         []priceTierJSON         `json:"price_tiers,omitempty"`
+	PriceBook          []priceJSON             `json:"price_book,omitempty"`
+	SegmentPrices      []segmentPriceJSON      `json:"segment_prices,omitempty"`
+	Segment            string                  `json:"segment,omitempty"`
+	MarketPrices       []marketPriceJSON       `json:"market_prices,omitempty"`
+	Market             string                  `json:"market,omitempty"`
+	Variants           []variantJSON           `json:"variants,omitempty"`
+	PriceSource        string                  `json:"price_source"`
+	TaxClass           string                  `json:"tax_class"`
+	MinimumPrice       *moneyJSON              `json:"minimum_price,omitempty"`
+	CostPrice          *moneyJSON              `json:"cost_price,omitempty"`
+	PendingPriceChange *pendingPriceChangeJSON `json:"pending_price_change,omitempty"`
+	ActivateAt         *time.Time              `json:"activate_at,omitempty"`
+	DeactivateAt       *time.Time              `json:"deactivate_at,omitempty"`
+	Review             *reviewJSON             `json:"review,omitempty"`
+	Kind               string                  `json:"kind,omitempty"`
+	Compliance         *complianceJSON         `json:"compliance,omitempty"`
+	Weight             *weightJSON             `json:"weight,omitempty"`
+	Dimensions         *dimensionsJSON         `json:"dimensions,omitempty"`
+	Rating             *ratingJSON             `json:"rating,omitempty"`
+	Channels           []string                `json:"channels"`
+	HasActiveDiscount  bool                    `json:"has_active_discount"`
+	InStock            bool                    `json:"in_stock"`
+	Stock              *stockJSON              `json:"stock,omitempty"`
+	Tags               []string                `json:"tags,omitempty"`
+	Attributes         map[string]string       `json:"attributes,omitempty"`
+	Images             []imageJSON             `json:"images,omitempty"`
+	Attachments        []attachmentJSON        `json:"attachments,omitempty"`
+	Status             string                  `json:"status"`
+	CreatedAt          time.Time               `json:"created_at"`
+	UpdatedAt          time.Time               `json:"updated_at"`
+	Display            displayJSON             `json:"display"`
+	Experiment         *experimentJSON         `json:"experiment,omitempty"`
+	// Stale and CachedAt are set when the product is served from the cache while the
+	// database is unavailable.
+	Stale    bool       `json:"stale,omitempty"`
+	CachedAt *time.Time `json:"cached_at,omitempty"`
+}
+
+type productSummaryJSON struct {
+	ID                string      `json:"id"`
+	Name              string      `json:"name"`
+	Slug              string      `json:"slug,omitempty"`
+	SKU               string      `json:"sku,omitempty"`
+	GTIN              string      `json:"gtin,omitempty"`
+	Locale            string      `json:"locale"`
+	Category          string      `json:"category"`
+	BasePrice         moneyJSON   `json:"base_price"`
+	EffectivePrice    moneyJSON   `json:"effective_price"`
+	Currency          string      `json:"currency"`
+	PriceSource       string      `json:"price_source"`
+	Market            string      `json:"market,omitempty"`
+	HasActiveDiscount bool        `json:"has_active_discount"`
+	DiscountPercent   float64     `json:"discount_percent"`
+	InStock           bool        `json:"in_stock"`
+	Tags              []string    `json:"tags,omitempty"`
+	PrimaryImage      *imageJSON  `json:"primary_image,omitempty"`
+	Rating            *ratingJSON `json:"rating,omitempty"`
+	Channels          []string    `json:"channels"`
+	Status            string      `json:"status"`
+	CreatedAt         time.Time   `json:"created_at"`
+	Display           displayJSON `json:"display"`
+}
+
+type listProductsJSON struct {
+	Products      []productSummaryJSON `json:"products"`
+	NextPageToken string               `json:"next_page_token,omitempty"`
+	TotalCount    int64                `json:"total_count"`
+	Stale         bool                 `json:"stale,omitempty"`
+	CachedAt      *time.Time           `json:"cached_at,omitempty"`
+}
+
+type errorJSON struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+func productToJSON(resp *query.ProductResponse, locale Locale) productJSON {
+	currency := resp.Currency
+	product := productJSON{
+		ID:                resp.ID,
+		Name:              resp.Name,
+		Slug:              resp.Slug,
+		SKU:               resp.SKU,
+		GTIN:              resp.GTIN,
+		Description:       resp.Description,
+		Locale:            resp.Locale,
+		Category:          resp.Category,
+		BasePrice:         moneyJSON{Numerator: resp.BasePriceNumerator, Denominator: resp.BasePriceDenominator},
+		EffectivePrice:    moneyJSON{Numerator: resp.EffectivePriceNumerator, Denominator: resp.EffectivePriceDenominator},
+		Currency:          currency,
+		Segment:           resp.Segment,
+		Market:            resp.Market,
+		PriceSource:       resp.PriceSource,
+		TaxClass:          resp.TaxClass,
+		HasActiveDiscount: resp.HasActiveDiscount,
+		InStock:           resp.InStock,
+		Tags:              resp.Tags,
+		Attributes:        resp.Attributes,
+		Status:            resp.Status,
+		CreatedAt:         resp.CreatedAt.UTC(),
+		UpdatedAt:         resp.UpdatedAt.UTC(),
+		ActivateAt:        utc(resp.ActivateAt),
+		DeactivateAt:      utc(resp.DeactivateAt),
+		Stale:             resp.CachedAt != nil,
+		CachedAt:          utc(resp.CachedAt),
+		Display: displayJSON{
+			Locale:         locale.Tag.String(),
+			BasePrice:      displayPrice(locale, resp.BasePriceDisplay, resp.BasePriceNumerator, resp.BasePriceDenominator, currency),
+			EffectivePrice: displayPrice(locale, resp.EffectivePriceDisplay, resp.EffectivePriceNumerator, resp.EffectivePriceDenominator, currency),
+			CreatedAt:      locale.FormatTime(resp.CreatedAt),
+			UpdatedAt:      locale.FormatTime(resp.UpdatedAt),
+		},
+	}
+
+	if resp.MinimumPriceDenominator != 0 {
+		product.MinimumPrice = &moneyJSON{Numerator: resp.MinimumPriceNumerator, Denominator: resp.MinimumPriceDenominator}
+	}
+
+	if resp.CostPriceDenominator != 0 {
+		product.CostPrice = &moneyJSON{Numerator: resp.CostPriceNumerator, Denominator: resp.CostPriceDenominator}
+	}
+
+	if st := resp.Stock; st != nil {
+		product.Stock = &stockJSON{Level: st.Level, Reserved: st.Reserved, Available: st.Available, Version: st.Version}
+	}
+
+	for _, image := range resp.Images {
+		product.Images = append(product.Images, imageJSON{URL: image.URL, AltText: image.AltText, Primary: image.Primary})
+	}
+
+	for _, attachment := range resp.Attachments {
+		product.Attachments = append(product.Attachments, attachmentJSON{Type: attachment.Type, URL: attachment.URL, Locale: attachment.Locale})
+	}
+
+	if r := resp.Review; r != nil {
+		product.Review = &reviewJSON{SubmittedBy: r.SubmittedBy, ReviewedBy: r.ReviewedBy, RejectionReason: r.RejectionReason}
+	}
+	if w := resp.Weight; w != nil {
+		product.Weight = &weightJSON{Value: w.Value, Unit: w.Unit, Grams: w.Grams}
+	}
+	if d := resp.Dimensions; d != nil {
+		product.Dimensions = &dimensionsJSON{
+			Height:   d.Height,
+			Width:    d.Width,
+			Depth:    d.Depth,
+			Unit:     d.Unit,
+			HeightMM: d.HeightMM,
+			WidthMM:  d.WidthMM,
+			DepthMM:  d.DepthMM,
+		}
+	}
+	product.Rating = ratingToJSON(resp.Rating)
+	product.Channels = resp.Channels
+	product.Kind = resp.Kind
+	if c := resp.Compliance; c != nil {
+		product.Compliance = &complianceJSON{MinimumAge: c.MinimumAge, Hazardous: c.Hazardous, RestrictedMarkets: c.RestrictedMarkets}
+	}
+
+	if c := resp.PendingPriceChange; c != nil {
+		product.PendingPriceChange = &pendingPriceChangeJSON{
+			BasePrice:   moneyJSON{Numerator: c.PriceNumerator, Denominator: c.PriceDenominator},
+			EffectiveAt: c.EffectiveAt.UTC(),
+		}
+	}
+
+	if resp.DiscountPercent != nil {
+		product.Discount = &discountJSON{
+			Percentage: *resp.DiscountPercent,
+			StartDate:  utc(resp.DiscountStartDate),
+			EndDate:    utc(resp.DiscountEndDate),
+			Suspended:  resp.DiscountSuspended,
+		}
+		product.Display.DiscountPercent = locale.FormatPercent(*resp.DiscountPercent)
+		if resp.DiscountStartDate != nil {
+			product.Display.DiscountStartDate = locale.FormatTime(*resp.DiscountStartDate)
+		}
+		if resp.DiscountEndDate != nil {
+			product.Display.DiscountEndDate = locale.FormatTime(*resp.DiscountEndDate)
+		}
+	}
+
+	if resp.Experiment != nil {
+		product.Experiment = &experimentJSON{ID: resp.Experiment.ExperimentID, Variant: resp.Experiment.Variant}
+	}
+
+	for _, d := range resp.Discounts {
+		discount := discountJSON{
+			ID:              d.ID,
+			Percentage:      d.Percent,
+			Priority:        d.Priority,
+			StartDate:       utc(&d.StartDate),
+			EndDate:         utc(&d.EndDate),
+			Suspended:       d.Suspended,
+			Kind:            d.Kind,
+			Market:          d.Market,
+			PendingApproval: d.PendingApproval,
+			ApprovedBy:      d.ApprovedBy,
+			FlashSale:       d.FlashSale,
+		}
+		if d.Kind == string(domain.DiscountKindBuyXGetY) {
+			discount.BuyXGetY = &buyXGetYJSON{BuyQuantity: d.BuyQuantity, GetQuantity: d.GetQuantity}
+		}
+		if d.SalePriceDenominator != 0 {
+			discount.SalePrice = &moneyJSON{Numerator: d.SalePriceNumerator, Denominator: d.SalePriceDenominator}
+		}
+		product.Discounts = append(product.Discounts, discount)
+	}
+
+	for _, t := range resp.PriceTiers {
+		tier := priceTierJSON{MinQuantity: t.MinQuantity}
+		if t.UnitPriceDenominator != 0 {
+			tier.UnitPrice = &moneyJSON{Numerator: t.UnitPriceNumerator, Denominator: t.UnitPriceDenominator}
+		} else {
+			pct := t.PercentOff
+			tier.PercentOff = &pct
+		}
+		product.PriceTiers = append(product.PriceTiers, tier)
+	}
+
+	for _, p := range resp.PriceBook {
+		product.PriceBook = append(product.PriceBook, priceJSON{
+			Numerator:   p.PriceNumerator,
+			Denominator: p.PriceDenominator,
+			Currency:    p.Currency,
+		})
+	}
+
+	for _, sp := range resp.SegmentPrices {
+		price := segmentPriceJSON{Segment: sp.Segment}
+		if sp.PriceDenominator != 0 {
+			price.FixedPrice = &moneyJSON{Numerator: sp.PriceNumerator, Denominator: sp.PriceDenominator}
+		} else {
+			pct := sp.PercentOff
+			price.PercentOff = &pct
+		}
+		product.SegmentPrices = append(product.SegmentPrices, price)
+	}
+
+	for _, mp := range resp.MarketPrices {
+		product.MarketPrices = append(product.MarketPrices, marketPriceJSON{
+			Market:            mp.Market,
+			Currency:          mp.Currency,
+			Price:             moneyJSON{Numerator: mp.PriceNumerator, Denominator: mp.PriceDenominator},
+			EffectivePrice:    moneyJSON{Numerator: mp.EffectivePriceNumerator, Denominator: mp.EffectivePriceDenominator},
+			HasActiveDiscount: mp.HasActiveDiscount,
+		})
+	}
+
+	for _, v := range resp.Variants {
+		product.Variants = append(product.Variants, variantJSON{
+			ID:             v.ID,
+			SKU:            v.SKU,
+			Attributes:     v.Attributes,
+			PriceDelta:     moneyJSON{Numerator: v.PriceDeltaNumerator, Denominator: v.PriceDeltaDenominator},
+			Price:          moneyJSON{Numerator: v.PriceNumerator, Denominator: v.PriceDenominator},
+			EffectivePrice: moneyJSON{Numerator: v.EffectivePriceNumerator, Denominator: v.EffectivePriceDenominator},
+			Status:         v.Status,
+		})
+	}
+
+	return product
+}
+
+func listProductsToJSON(resp *query.ListProductsResponse, locale Locale) listProductsJSON {
+	products := make([]productSummaryJSON, len(resp.Products))
+	for i, p := range resp.Products {
+		currency := p.Currency
+		summary := productSummaryJSON{
+			ID:                p.ID,
+			Name:              p.Name,
+			Slug:              p.Slug,
+			SKU:               p.SKU,
+			GTIN:              p.GTIN,
+			Locale:            p.Locale,
+			Category:          p.Category,
+			BasePrice:         moneyJSON{Numerator: p.BasePriceNumerator, Denominator: p.BasePriceDenominator},
+			EffectivePrice:    moneyJSON{Numerator: p.EffectivePriceNumerator, Denominator: p.EffectivePriceDenominator},
+			Currency:          currency,
+			PriceSource:       p.PriceSource,
+			Market:            p.Market,
+			HasActiveDiscount: p.HasActiveDiscount,
+			InStock:           p.InStock,
+			Tags:              p.Tags,
+			Rating:            ratingToJSON(p.Rating),
+			Channels:          p.Channels,
+			Status:            p.Status,
+			CreatedAt:         p.CreatedAt.UTC(),
+			Display: displayJSON{
+				Locale:         locale.Tag.String(),
+				BasePrice:      displayPrice(locale, p.BasePriceDisplay, p.BasePriceNumerator, p.BasePriceDenominator, currency),
+				EffectivePrice: displayPrice(locale, p.EffectivePriceDisplay, p.EffectivePriceNumerator, p.EffectivePriceDenominator, currency),
+				CreatedAt:      locale.FormatTime(p.CreatedAt),
+			},
+		}
+		if image := p.PrimaryImage; image != nil {
+			summary.PrimaryImage = &imageJSON{URL: image.URL, AltText: image.AltText, Primary: image.Primary}
+		}
+		if p.DiscountPercent != nil {
+			summary.DiscountPercent = *p.DiscountPercent
+			summary.Display.DiscountPercent = locale.FormatPercent(*p.DiscountPercent)
+		}
+		products[i] = summary
+	}
+
+	return listProductsJSON{
+		Products:      products,
+		NextPageToken: resp.NextPageToken,
+		TotalCount:    resp.TotalCount,
+		Stale:         resp.CachedAt != nil,
+		CachedAt:      utc(resp.CachedAt),
+	}
+}
+
+// displayPrice renders a price rounded by the service's rounding policy, or the exact price
+// rounded half-up if the response carries no rounded price.
+func displayPrice(locale Locale, display string, numerator, denominator int64, currency string) string {
+	if display == "" {
+		return locale.FormatPrice(numerator, denominator, currency)
+	}
+	return locale.FormatAmount(display, currency)
+}
+
+func utc(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	u := t.UTC()
+	return &u
+}
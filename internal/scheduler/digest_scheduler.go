@@ -0,0 +1,177 @@
+package scheduler
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// Default digest scheduler settings.
+const (
+	DefaultDigestPollInterval     = time.Hour
+	DefaultDigestPriceDropPercent = 20
+	DefaultDigestMaxItems         = 200
+)
+
+// digestDay is the period a digest covers.
+const digestDay = 24 * time.Hour
+
+// DigestSchedulerOptions controls the contents and polling of a DigestScheduler.
+type DigestSchedulerOptions struct {
+	// PollInterval is the delay between checks for a completed day. It bounds how late
+	// after midnight (UTC) the digest of the day before is published.
+	PollInterval time.Duration
+	// PriceDropPercent is the smallest drop of the effective price, as a percentage of the
+	// price before it, that is reported as a price drop.
+	PriceDropPercent float64
+	// MaxItems caps each list of a digest.
+	MaxItems int
+}
+
+// DigestScheduler publishes a daily digest of catalog changes for the merchandising
+// newsletter: the products created during the previous UTC day, the products whose
+// effective price dropped by at least PriceDropPercent during it, and the discounts that
+// end during the current day. Running several schedulers is safe: the publisher stores
+// the digest of a day once.
+type DigestScheduler struct {
+	reader    contract.DigestReader
+	publisher contract.DigestPublisher
+	clock     clock.Clock
+	opts      DigestSchedulerOptions
+
+	// published is the last day whose digest was published by this scheduler.
+	published time.Time
+}
+
+// NewDigestScheduler creates a new DigestScheduler.
+func NewDigestScheduler(reader contract.DigestReader, publisher contract.DigestPublisher, clock clock.Clock, opts DigestSchedulerOptions) *DigestScheduler {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultDigestPollInterval
+	}
+	if opts.PriceDropPercent <= 0 {
+		opts.PriceDropPercent = DefaultDigestPriceDropPercent
+	}
+	if opts.MaxItems <= 0 {
+		opts.MaxItems = DefaultDigestMaxItems
+	}
+	return &DigestScheduler{
+		reader:    reader,
+		publisher: publisher,
+		clock:     clock,
+		opts:      opts,
+	}
+}
+
+// Run publishes the digest of every day that completes until the context is cancelled.
+// The digest of the day before startup is published at once if it is not stored yet.
+func (s *DigestScheduler) Run(ctx context.Context) error {
+	for {
+		if _, err := s.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			logging.Errorf("digest scheduler: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.opts.PollInterval):
+		}
+	}
+}
+
+// RunOnce publishes the digest of the last completed UTC day unless this scheduler
+// already did. It returns the published digest, or nil if there was nothing to do. A
+// failed digest is retried on the next pass.
+func (s *DigestScheduler) RunOnce(ctx context.Context) (*contract.Digest, error) {
+	now := s.clock.Now().UTC()
+	day := now.Truncate(digestDay).Add(-digestDay)
+	if day.Equal(s.published) {
+		return nil, nil
+	}
+
+	digest, err := s.Build(ctx, day, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.publisher.PublishDigest(ctx, digest); err != nil {
+		return nil, err
+	}
+
+	s.published = day
+	logging.Infof("digest scheduler: published digest of %s: %d new products, %d price drops, %d expiring discounts",
+		day.Format("2006-01-02"), digest.NewProductCount, digest.PriceDropCount, digest.ExpiringDiscountCount)
+	return digest, nil
+}
+
+// Build assembles the digest of the UTC day starting at day, generated at now.
+func (s *DigestScheduler) Build(ctx context.Context, day, now time.Time) (*contract.Digest, error) {
+	end := day.Add(digestDay)
+
+	newProducts, err := s.reader.FindNewProducts(ctx, day, end)
+	if err != nil {
+		return nil, err
+	}
+	moves, err := s.reader.FindPriceMoves(ctx, day, end)
+	if err != nil {
+		return nil, err
+	}
+	expiring, err := s.reader.FindExpiringDiscounts(ctx, end, end.Add(digestDay))
+	if err != nil {
+		return nil, err
+	}
+
+	drops := priceDrops(moves, s.opts.PriceDropPercent)
+
+	digest := &contract.Digest{
+		Day:                   day,
+		GeneratedAt:           now,
+		NewProducts:           newProducts,
+		NewProductCount:       len(newProducts),
+		PriceDrops:            drops,
+		PriceDropCount:        len(drops),
+		ExpiringDiscounts:     expiring,
+		ExpiringDiscountCount: len(expiring),
+	}
+	if len(digest.NewProducts) > s.opts.MaxItems {
+		digest.NewProducts = digest.NewProducts[:s.opts.MaxItems]
+	}
+	if len(digest.PriceDrops) > s.opts.MaxItems {
+		digest.PriceDrops = digest.PriceDrops[:s.opts.MaxItems]
+	}
+	if len(digest.ExpiringDiscounts) > s.opts.MaxItems {
+		digest.ExpiringDiscounts = digest.ExpiringDiscounts[:s.opts.MaxItems]
+	}
+	return digest, nil
+}
+
+// priceDrops returns the moves whose price dropped by at least minPercent, biggest drop
+// first.
+func priceDrops(moves []*contract.PriceMoveDTO, minPercent float64) []*contract.PriceDrop {
+	drops := make([]*contract.PriceDrop, 0)
+	for _, m := range moves {
+		if m.OldPriceNum <= 0 || m.OldPriceDenom <= 0 || m.NewPriceDenom <= 0 {
+			continue
+		}
+		oldPrice := big.NewRat(m.OldPriceNum, m.OldPriceDenom)
+		newPrice := big.NewRat(m.NewPriceNum, m.NewPriceDenom)
+
+		// (old - new) / old, as a percentage.
+		drop := new(big.Rat).Sub(oldPrice, newPrice)
+		drop.Quo(drop, oldPrice)
+		drop.Mul(drop, big.NewRat(100, 1))
+
+		percent, _ := drop.Float64()
+		if drop.Sign() <= 0 || percent < minPercent {
+			continue
+		}
+		drops = append(drops, &contract.PriceDrop{PriceMoveDTO: *m, DropPercent: percent})
+	}
+	sort.SliceStable(drops, func(i, j int) bool {
+		return drops[i].DropPercent > drops[j].DropPercent
+	})
+	return drops
+}
@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDigestReader struct {
+	products  []*contract.DigestProductDTO
+	moves     []*contract.PriceMoveDTO
+	discounts []*contract.ExpiringDiscountDTO
+	err       error
+
+	from, to                 time.Time
+	discountFrom, discountTo time.Time
+}
+
+func (r *fakeDigestReader) FindNewProducts(_ context.Context, from, to time.Time) ([]*contract.DigestProductDTO, error) {
+	r.from, r.to = from, to
+	return r.products, r.err
+}
+
+func (r *fakeDigestReader) FindPriceMoves(_ context.Context, _, _ time.Time) ([]*contract.PriceMoveDTO, error) {
+	return r.moves, nil
+}
+
+func (r *fakeDigestReader) FindExpiringDiscounts(_ context.Context, from, to time.Time) ([]*contract.ExpiringDiscountDTO, error) {
+	r.discountFrom, r.discountTo = from, to
+	return r.discounts, nil
+}
+
+type fakeDigestPublisher struct {
+	digests []*contract.Digest
+}
+
+func (p *fakeDigestPublisher) PublishDigest(_ context.Context, digest *contract.Digest) error {
+	p.digests = append(p.digests, digest)
+	return nil
+}
+
+func TestDigestScheduler_RunOnce(t *testing.T) {
+	now := time.Date(2024, 6, 2, 0, 5, 0, 0, time.UTC)
+	clk := clock.NewFixedClock(now)
+	reader := &fakeDigestReader{
+		products: []*contract.DigestProductDTO{{ProductID: "product-1"}, {ProductID: "product-2"}},
+		moves: []*contract.PriceMoveDTO{
+			{ProductID: "product-3", OldPriceNum: 100, OldPriceDenom: 1, NewPriceNum: 85, NewPriceDenom: 1},
+			{ProductID: "product-4", OldPriceNum: 100, OldPriceDenom: 1, NewPriceNum: 50, NewPriceDenom: 1},
+			{ProductID: "product-5", OldPriceNum: 100, OldPriceDenom: 1, NewPriceNum: 80, NewPriceDenom: 1},
+			{ProductID: "product-6", OldPriceNum: 100, OldPriceDenom: 1, NewPriceNum: 150, NewPriceDenom: 1},
+		},
+		discounts: []*contract.ExpiringDiscountDTO{{ProductID: "product-7", DiscountID: "d-1"}},
+	}
+	publisher := &fakeDigestPublisher{}
+	s := NewDigestScheduler(reader, publisher, clk, DigestSchedulerOptions{MaxItems: 1})
+
+	digest, err := s.RunOnce(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, digest)
+
+	// The digest covers the previous UTC day and the discounts ending today
+	day := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, day, digest.Day)
+	assert.Equal(t, day, reader.from)
+	assert.Equal(t, day.Add(24*time.Hour), reader.to)
+	assert.Equal(t, day.Add(24*time.Hour), reader.discountFrom)
+	assert.Equal(t, day.Add(48*time.Hour), reader.discountTo)
+
+	// Lists are capped, biggest price drop first; counts are not
+	require.Len(t, digest.NewProducts, 1)
+	assert.Equal(t, 2, digest.NewProductCount)
+	require.Len(t, digest.PriceDrops, 1)
+	assert.Equal(t, "product-4", digest.PriceDrops[0].ProductID)
+	assert.Equal(t, 50.0, digest.PriceDrops[0].DropPercent)
+	assert.Equal(t, 2, digest.PriceDropCount)
+	assert.Equal(t, 1, digest.ExpiringDiscountCount)
+	require.Len(t, publisher.digests, 1)
+
+	// The same day is published once
+	digest, err = s.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, digest)
+	assert.Len(t, publisher.digests, 1)
+
+	clk.Advance(24 * time.Hour)
+	digest, err = s.RunOnce(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, digest)
+	assert.Equal(t, day.Add(24*time.Hour), digest.Day)
+	assert.Len(t, publisher.digests, 2)
+}
+
+func TestDigestScheduler_RunOnceReaderError(t *testing.T) {
+	reader := &fakeDigestReader{err: errors.New("spanner unavailable")}
+	publisher := &fakeDigestPublisher{}
+	s := NewDigestScheduler(reader, publisher, clock.NewFixedClock(time.Now()), DigestSchedulerOptions{})
+
+	_, err := s.RunOnce(context.Background())
+	assert.Error(t, err)
+	assert.Empty(t, publisher.digests)
+
+	// The failed day is retried
+	reader.err = nil
+	digest, err := s.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.NotNil(t, digest)
+}
@@ -0,0 +1,159 @@
+// Package scheduler runs time-driven catalog transitions in the background.
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/logging"
+	"github.com/product-catalog-service/internal/usecase"
+)
+
+// Default discount scheduler settings.
+const (
+	DefaultDiscountPollInterval = 30 * time.Second
+	DefaultDiscountBatchSize    = 100
+)
+
+// DiscountFinder finds products with discount period boundaries to announce.
+// It is implemented by repository.ProductRepo.
+type DiscountFinder interface {
+	FindDiscountPhaseDue(ctx context.Context, at time.Time, limit int) ([]string, error)
+}
+
+// FlashSaleFinder finds the next boundary of a flash sale. A DiscountFinder that also
+// implements it lets the scheduler wake up when a flash sale opens or closes instead of on
+// its next poll. It is implemented by repository.ProductRepo.
+type FlashSaleFinder interface {
+	// NextFlashSaleBoundary returns the earliest unannounced start or end of a flash
+	// sale, or false if there is none.
+	NextFlashSaleBoundary(ctx context.Context) (time.Time, bool, error)
+}
+
+// DiscountAdvancer announces the passed discount period boundaries of one product.
+// It is implemented by usecase.ProductUseCases.
+type DiscountAdvancer interface {
+	AdvanceDiscountPhase(ctx context.Context, req usecase.AdvanceDiscountPhaseRequest) error
+}
+
+// DiscountSchedulerOptions controls the polling behavior of a DiscountScheduler.
+type DiscountSchedulerOptions struct {
+	// PollInterval is the delay between polls once no due discounts are left.
+	// It bounds how late a product.discount_started or product.discount_ended event is
+	// recorded after the boundary has passed; the boundaries of flash sales are
+	// announced as they pass.
+	PollInterval time.Duration
+	// BatchSize is the maximum number of products advanced per poll.
+	BatchSize int
+}
+
+// DiscountStats summarizes a single scheduler pass.
+type DiscountStats struct {
+	// Due is the number of products found with a boundary to announce.
+	Due int
+	// Advanced is the number of products whose events were recorded.
+	Advanced int
+	// Failed is the number of products that could not be advanced; they are retried on
+	// the next poll.
+	Failed int
+}
+
+// DiscountScheduler polls for discounts whose period started or ended and records the
+// corresponding events, so consumers learn about the price change without polling prices.
+// Running several schedulers is safe but wasteful: a boundary may then be announced twice.
+type DiscountScheduler struct {
+	finder   DiscountFinder
+	advancer DiscountAdvancer
+	clock    clock.Clock
+	opts     DiscountSchedulerOptions
+}
+
+// NewDiscountScheduler creates a new DiscountScheduler.
+func NewDiscountScheduler(finder DiscountFinder, advancer DiscountAdvancer, clock clock.Clock, opts DiscountSchedulerOptions) *DiscountScheduler {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultDiscountPollInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultDiscountBatchSize
+	}
+	return &DiscountScheduler{
+		finder:   finder,
+		advancer: advancer,
+		clock:    clock,
+		opts:     opts,
+	}
+}
+
+// Run advances due discounts until the context is cancelled.
+func (s *DiscountScheduler) Run(ctx context.Context) error {
+	for {
+		stats, err := s.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			logging.Errorf("discount scheduler: %v", err)
+		}
+
+		// Keep draining while full batches make progress.
+		if err == nil && stats.Due == s.opts.BatchSize && stats.Advanced > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.nextPoll(ctx)):
+		}
+	}
+}
+
+// nextPoll returns how long to wait before the next poll: the poll interval, or less if a
+// flash sale opens or closes sooner.
+func (s *DiscountScheduler) nextPoll(ctx context.Context) time.Duration {
+	finder, ok := s.finder.(FlashSaleFinder)
+	if !ok {
+		return s.opts.PollInterval
+	}
+	boundary, ok, err := finder.NextFlashSaleBoundary(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			logging.Errorf("discount scheduler: next flash sale: %v", err)
+		}
+		return s.opts.PollInterval
+	}
+	if !ok {
+		return s.opts.PollInterval
+	}
+	wait := boundary.Sub(s.clock.Now())
+	if wait < 0 {
+		// The last pass left it unannounced, e.g. because the product failed to advance;
+		// retry a second later rather than spinning.
+		wait = time.Second
+	}
+	if wait > s.opts.PollInterval {
+		return s.opts.PollInterval
+	}
+	return wait
+}
+
+// RunOnce advances one batch of due discounts. A product that fails is logged and
+// skipped; it is found again on the next pass.
+func (s *DiscountScheduler) RunOnce(ctx context.Context) (DiscountStats, error) {
+	ids, err := s.finder.FindDiscountPhaseDue(ctx, s.clock.Now(), s.opts.BatchSize)
+	if err != nil {
+		return DiscountStats{}, err
+	}
+
+	stats := DiscountStats{Due: len(ids)}
+	for _, id := range ids {
+		if err := s.advancer.AdvanceDiscountPhase(ctx, usecase.AdvanceDiscountPhaseRequest{ProductID: id}); err != nil {
+			if ctx.Err() != nil {
+				return stats, ctx.Err()
+			}
+			logging.Errorf("discount scheduler: product %s: %v", id, err)
+			stats.Failed++
+			continue
+		}
+		stats.Advanced++
+	}
+	return stats, nil
+}
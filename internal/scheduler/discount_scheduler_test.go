@@ -0,0 +1,101 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFinder struct {
+	ids   []string
+	at    time.Time
+	limit int
+	err   error
+}
+
+func (f *fakeFinder) FindDiscountPhaseDue(_ context.Context, at time.Time, limit int) ([]string, error) {
+	f.at, f.limit = at, limit
+	return f.ids, f.err
+}
+
+type fakeAdvancer struct {
+	failing  map[string]bool
+	advanced []string
+}
+
+func (a *fakeAdvancer) AdvanceDiscountPhase(_ context.Context, req usecase.AdvanceDiscountPhaseRequest) error {
+	if a.failing[req.ProductID] {
+		return errors.New("commit failed")
+	}
+	a.advanced = append(a.advanced, req.ProductID)
+	return nil
+}
+
+// flashSaleFinder additionally reports the next flash sale boundary.
+type flashSaleFinder struct {
+	fakeFinder
+	boundary time.Time
+	found    bool
+	err      error
+}
+
+func (f *flashSaleFinder) NextFlashSaleBoundary(context.Context) (time.Time, bool, error) {
+	return f.boundary, f.found, f.err
+}
+
+func TestDiscountScheduler_RunOnce(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	finder := &fakeFinder{ids: []string{"product-1", "product-2", "product-3"}}
+	advancer := &fakeAdvancer{failing: map[string]bool{"product-2": true}}
+	s := NewDiscountScheduler(finder, advancer, clock.NewFixedClock(now), DiscountSchedulerOptions{BatchSize: 10})
+
+	stats, err := s.RunOnce(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, DiscountStats{Due: 3, Advanced: 2, Failed: 1}, stats)
+	assert.Equal(t, []string{"product-1", "product-3"}, advancer.advanced)
+	assert.Equal(t, now, finder.at)
+	assert.Equal(t, 10, finder.limit)
+}
+
+func TestDiscountScheduler_RunOnceFinderError(t *testing.T) {
+	finder := &fakeFinder{err: errors.New("spanner unavailable")}
+	advancer := &fakeAdvancer{}
+	s := NewDiscountScheduler(finder, advancer, clock.NewFixedClock(time.Now()), DiscountSchedulerOptions{})
+
+	_, err := s.RunOnce(context.Background())
+	assert.Error(t, err)
+	assert.Empty(t, advancer.advanced)
+	assert.Equal(t, DefaultDiscountBatchSize, finder.limit)
+}
+
+func TestDiscountScheduler_NextPoll(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	opts := DiscountSchedulerOptions{PollInterval: 30 * time.Second}
+
+	tests := []struct {
+		name   string
+		finder DiscountFinder
+		want   time.Duration
+	}{
+		{"no flash sale support", &fakeFinder{}, 30 * time.Second},
+		{"no flash sale", &flashSaleFinder{}, 30 * time.Second},
+		{"flash sale opens sooner", &flashSaleFinder{boundary: now.Add(5 * time.Second), found: true}, 5 * time.Second},
+		{"flash sale closes later", &flashSaleFinder{boundary: now.Add(time.Minute), found: true}, 30 * time.Second},
+		{"boundary left unannounced", &flashSaleFinder{boundary: now.Add(-time.Minute), found: true}, time.Second},
+		{"finder error", &flashSaleFinder{boundary: now, found: true, err: errors.New("spanner unavailable")}, 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewDiscountScheduler(tt.finder, &fakeAdvancer{}, clock.NewFixedClock(now), opts)
+			assert.Equal(t, tt.want, s.nextPoll(context.Background()))
+		})
+	}
+}
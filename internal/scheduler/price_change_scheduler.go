@@ -0,0 +1,119 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/logging"
+	"github.com/product-catalog-service/internal/usecase"
+)
+
+// Default price change scheduler settings.
+const (
+	DefaultPriceChangePollInterval = 30 * time.Second
+	DefaultPriceChangeBatchSize    = 100
+)
+
+// PriceChangeFinder finds products with a pending price change that is due.
+// It is implemented by repository.ProductRepo.
+type PriceChangeFinder interface {
+	FindPriceChangeDue(ctx context.Context, at time.Time, limit int) ([]string, error)
+}
+
+// PriceChangeApplier applies the pending price change of one product.
+// It is implemented by usecase.ProductUseCases.
+type PriceChangeApplier interface {
+	ApplyPriceChange(ctx context.Context, req usecase.ApplyPriceChangeRequest) error
+}
+
+// PriceChangeSchedulerOptions controls the polling behavior of a PriceChangeScheduler.
+type PriceChangeSchedulerOptions struct {
+	// PollInterval is the delay between polls once no due price changes are left.
+	// It bounds how late a scheduled base price change takes effect.
+	PollInterval time.Duration
+	// BatchSize is the maximum number of products changed per poll.
+	BatchSize int
+}
+
+// PriceChangeStats summarizes a single scheduler pass.
+type PriceChangeStats struct {
+	// Due is the number of products found with a price change to apply.
+	Due int
+	// Applied is the number of products whose price change was applied.
+	Applied int
+	// Failed is the number of products that could not be changed, e.g. during a freeze
+	// window; they are retried on the next poll.
+	Failed int
+}
+
+// PriceChangeScheduler polls for pending base price changes that are due and applies
+// them, so a price change can be scheduled for midnight instead of being made by an
+// external job. Running several schedulers is safe: a change is applied once, and a
+// product whose change was already applied is left alone.
+type PriceChangeScheduler struct {
+	finder  PriceChangeFinder
+	applier PriceChangeApplier
+	clock   clock.Clock
+	opts    PriceChangeSchedulerOptions
+}
+
+// NewPriceChangeScheduler creates a new PriceChangeScheduler.
+func NewPriceChangeScheduler(finder PriceChangeFinder, applier PriceChangeApplier, clock clock.Clock, opts PriceChangeSchedulerOptions) *PriceChangeScheduler {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPriceChangePollInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultPriceChangeBatchSize
+	}
+	return &PriceChangeScheduler{
+		finder:  finder,
+		applier: applier,
+		clock:   clock,
+		opts:    opts,
+	}
+}
+
+// Run applies due price changes until the context is cancelled.
+func (s *PriceChangeScheduler) Run(ctx context.Context) error {
+	for {
+		stats, err := s.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			logging.Errorf("price change scheduler: %v", err)
+		}
+
+		// Keep draining while full batches make progress.
+		if err == nil && stats.Due == s.opts.BatchSize && stats.Applied > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.opts.PollInterval):
+		}
+	}
+}
+
+// RunOnce applies one batch of due price changes. A product that fails is logged and
+// skipped; it is found again on the next pass.
+func (s *PriceChangeScheduler) RunOnce(ctx context.Context) (PriceChangeStats, error) {
+	ids, err := s.finder.FindPriceChangeDue(ctx, s.clock.Now(), s.opts.BatchSize)
+	if err != nil {
+		return PriceChangeStats{}, err
+	}
+
+	stats := PriceChangeStats{Due: len(ids)}
+	for _, id := range ids {
+		if err := s.applier.ApplyPriceChange(ctx, usecase.ApplyPriceChangeRequest{ProductID: id}); err != nil {
+			if ctx.Err() != nil {
+				return stats, ctx.Err()
+			}
+			logging.Errorf("price change scheduler: product %s: %v", id, err)
+			stats.Failed++
+			continue
+		}
+		stats.Applied++
+	}
+	return stats, nil
+}
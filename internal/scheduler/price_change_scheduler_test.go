@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePriceChangeFinder struct {
+	ids   []string
+	at    time.Time
+	limit int
+	err   error
+}
+
+func (f *fakePriceChangeFinder) FindPriceChangeDue(_ context.Context, at time.Time, limit int) ([]string, error) {
+	f.at, f.limit = at, limit
+	return f.ids, f.err
+}
+
+type fakePriceChangeApplier struct {
+	failing map[string]bool
+	applied []string
+}
+
+func (a *fakePriceChangeApplier) ApplyPriceChange(_ context.Context, req usecase.ApplyPriceChangeRequest) error {
+	if a.failing[req.ProductID] {
+		return errors.New("catalog frozen")
+	}
+	a.applied = append(a.applied, req.ProductID)
+	return nil
+}
+
+func TestPriceChangeScheduler_RunOnce(t *testing.T) {
+	midnight := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+	finder := &fakePriceChangeFinder{ids: []string{"product-1", "product-2", "product-3"}}
+	applier := &fakePriceChangeApplier{failing: map[string]bool{"product-2": true}}
+	s := NewPriceChangeScheduler(finder, applier, clock.NewFixedClock(midnight), PriceChangeSchedulerOptions{BatchSize: 10})
+
+	stats, err := s.RunOnce(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, PriceChangeStats{Due: 3, Applied: 2, Failed: 1}, stats)
+	assert.Equal(t, []string{"product-1", "product-3"}, applier.applied)
+	assert.Equal(t, midnight, finder.at)
+	assert.Equal(t, 10, finder.limit)
+}
+
+func TestPriceChangeScheduler_RunOnceFinderError(t *testing.T) {
+	finder := &fakePriceChangeFinder{err: errors.New("spanner unavailable")}
+	applier := &fakePriceChangeApplier{}
+	s := NewPriceChangeScheduler(finder, applier, clock.NewFixedClock(time.Now()), PriceChangeSchedulerOptions{})
+
+	_, err := s.RunOnce(context.Background())
+	assert.Error(t, err)
+	assert.Empty(t, applier.applied)
+	assert.Equal(t, DefaultPriceChangeBatchSize, finder.limit)
+}
@@ -0,0 +1,120 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/logging"
+	"github.com/product-catalog-service/internal/usecase"
+)
+
+// Default status scheduler settings.
+const (
+	DefaultStatusPollInterval = 30 * time.Second
+	DefaultStatusBatchSize    = 100
+)
+
+// StatusChangeFinder finds products with a scheduled activation or deactivation that is
+// due. It is implemented by repository.ProductRepo.
+type StatusChangeFinder interface {
+	FindStatusChangeDue(ctx context.Context, at time.Time, limit int) ([]string, error)
+}
+
+// StatusChangeApplier makes the scheduled status changes of one product.
+// It is implemented by usecase.ProductUseCases.
+type StatusChangeApplier interface {
+	ApplyStatusSchedule(ctx context.Context, req usecase.ApplyStatusScheduleRequest) error
+}
+
+// StatusSchedulerOptions controls the polling behavior of a StatusScheduler.
+type StatusSchedulerOptions struct {
+	// PollInterval is the delay between polls once no due status changes are left.
+	// It bounds how late a scheduled activation or deactivation is made.
+	PollInterval time.Duration
+	// BatchSize is the maximum number of products changed per poll.
+	BatchSize int
+}
+
+// StatusStats summarizes a single scheduler pass.
+type StatusStats struct {
+	// Due is the number of products found with a status change to make.
+	Due int
+	// Applied is the number of products whose status change was made.
+	Applied int
+	// Failed is the number of products that could not be changed; they are retried on
+	// the next poll.
+	Failed int
+}
+
+// StatusScheduler polls for scheduled product activations and deactivations that are due
+// and makes them through the product's usual status changes, so a launch can be
+// scheduled for midnight instead of being made by hand. Running several schedulers is
+// safe: a change is made once, and a product whose change was already made is left
+// alone.
+type StatusScheduler struct {
+	finder  StatusChangeFinder
+	applier StatusChangeApplier
+	clock   clock.Clock
+	opts    StatusSchedulerOptions
+}
+
+// NewStatusScheduler creates a new StatusScheduler.
+func NewStatusScheduler(finder StatusChangeFinder, applier StatusChangeApplier, clock clock.Clock, opts StatusSchedulerOptions) *StatusScheduler {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultStatusPollInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultStatusBatchSize
+	}
+	return &StatusScheduler{
+		finder:  finder,
+		applier: applier,
+		clock:   clock,
+		opts:    opts,
+	}
+}
+
+// Run makes due status changes until the context is cancelled.
+func (s *StatusScheduler) Run(ctx context.Context) error {
+	for {
+		stats, err := s.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			logging.Errorf("status scheduler: %v", err)
+		}
+
+		// Keep draining while full batches make progress.
+		if err == nil && stats.Due == s.opts.BatchSize && stats.Applied > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.opts.PollInterval):
+		}
+	}
+}
+
+// RunOnce makes one batch of due status changes. A product that fails is logged and
+// skipped; it is found again on the next pass.
+func (s *StatusScheduler) RunOnce(ctx context.Context) (StatusStats, error) {
+	ids, err := s.finder.FindStatusChangeDue(ctx, s.clock.Now(), s.opts.BatchSize)
+	if err != nil {
+		return StatusStats{}, err
+	}
+
+	stats := StatusStats{Due: len(ids)}
+	for _, id := range ids {
+		if err := s.applier.ApplyStatusSchedule(ctx, usecase.ApplyStatusScheduleRequest{ProductID: id}); err != nil {
+			if ctx.Err() != nil {
+				return stats, ctx.Err()
+			}
+			logging.Errorf("status scheduler: product %s: %v", id, err)
+			stats.Failed++
+			continue
+		}
+		stats.Applied++
+	}
+	return stats, nil
+}
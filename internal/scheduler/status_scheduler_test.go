@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStatusChangeFinder struct {
+	ids   []string
+	at    time.Time
+	limit int
+	err   error
+}
+
+func (f *fakeStatusChangeFinder) FindStatusChangeDue(_ context.Context, at time.Time, limit int) ([]string, error) {
+	f.at, f.limit = at, limit
+	return f.ids, f.err
+}
+
+type fakeStatusChangeApplier struct {
+	failing map[string]bool
+	applied []string
+}
+
+func (a *fakeStatusChangeApplier) ApplyStatusSchedule(_ context.Context, req usecase.ApplyStatusScheduleRequest) error {
+	if a.failing[req.ProductID] {
+		return errors.New("transaction aborted")
+	}
+	a.applied = append(a.applied, req.ProductID)
+	return nil
+}
+
+func TestStatusScheduler_RunOnce(t *testing.T) {
+	midnight := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+	finder := &fakeStatusChangeFinder{ids: []string{"product-1", "product-2", "product-3"}}
+	applier := &fakeStatusChangeApplier{failing: map[string]bool{"product-2": true}}
+	s := NewStatusScheduler(finder, applier, clock.NewFixedClock(midnight), StatusSchedulerOptions{BatchSize: 10})
+
+	stats, err := s.RunOnce(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, StatusStats{Due: 3, Applied: 2, Failed: 1}, stats)
+	assert.Equal(t, []string{"product-1", "product-3"}, applier.applied)
+	assert.Equal(t, midnight, finder.at)
+	assert.Equal(t, 10, finder.limit)
+}
+
+func TestStatusScheduler_RunOnceFinderError(t *testing.T) {
+	finder := &fakeStatusChangeFinder{err: errors.New("spanner unavailable")}
+	applier := &fakeStatusChangeApplier{}
+	s := NewStatusScheduler(finder, applier, clock.NewFixedClock(time.Now()), StatusSchedulerOptions{})
+
+	_, err := s.RunOnce(context.Background())
+	assert.Error(t, err)
+	assert.Empty(t, applier.applied)
+	assert.Equal(t, DefaultStatusBatchSize, finder.limit)
+}
@@ -0,0 +1,166 @@
+// Package searchindex keeps an OpenSearch (or Elasticsearch) index of products in sync
+// with the catalog and searches it.
+//
+// Spanner full-text search matches whole words only. The index adds what merchandising
+// needs on top: fuzzy matching that tolerates typos and facet counts. Indexer keeps the
+// index in sync by consuming the outbox: for every product event it reads the product
+// back and indexes it, or removes it from the index once it is archived or purged. Engine
+// searches the index for the query layer, which reads the products it finds from Spanner.
+package searchindex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Defaults of Options.
+const (
+	DefaultIndex   = "products"
+	DefaultTimeout = 10 * time.Second
+)
+
+// ErrInvalidURL is returned by NewClient for a URL that is not an absolute HTTP(S) URL.
+var ErrInvalidURL = errors.New("invalid OpenSearch URL")
+
+// Options configures a Client.
+type Options struct {
+	// URL is the base URL of the cluster, e.g. "https://search.example.com:9200".
+	URL string
+	// Index is the name of the product index; empty means DefaultIndex.
+	Index string
+	// Username and Password authenticate with HTTP basic authentication if Username is
+	// set.
+	Username string
+	Password string
+}
+
+// Client calls the OpenSearch REST API for one index. It uses only the document, search
+// and index APIs, which Elasticsearch shares.
+type Client struct {
+	base   *url.URL
+	opts   Options
+	client *http.Client
+}
+
+// NewClient creates a Client. A nil client uses one with DefaultTimeout.
+func NewClient(opts Options, client *http.Client) (*Client, error) {
+	base, err := url.Parse(strings.TrimSuffix(opts.URL, "/"))
+	if err != nil || (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidURL, opts.URL)
+	}
+	if opts.Index == "" {
+		opts.Index = DefaultIndex
+	}
+	if client == nil {
+		client = &http.Client{Timeout: DefaultTimeout}
+	}
+	return &Client{base: base, opts: opts, client: client}, nil
+}
+
+// Index returns the name of the product index.
+func (c *Client) Index() string {
+	return c.opts.Index
+}
+
+// indexMapping maps the fields of a Document. Text fields are analyzed for matching;
+// keyword fields are matched exactly and counted in facets.
+var indexMapping = map[string]interface{}{
+	"mappings": map[string]interface{}{
+		"dynamic": "strict",
+		"properties": map[string]interface{}{
+			"id":          map[string]string{"type": "keyword"},
+			"tenant_id":   map[string]string{"type": "keyword"},
+			"name":        map[string]string{"type": "text"},
+			"description": map[string]string{"type": "text"},
+			"category":    map[string]string{"type": "keyword"},
+			"status":      map[string]string{"type": "keyword"},
+			"tags":        map[string]string{"type": "keyword"},
+			"channels":    map[string]string{"type": "keyword"},
+			"kind":        map[string]string{"type": "keyword"},
+			"updated_at":  map[string]string{"type": "date"},
+		},
+	},
+}
+
+// EnsureIndex creates the product index with its mapping if it does not exist.
+func (c *Client) EnsureIndex(ctx context.Context) error {
+	status, err := c.do(ctx, http.MethodHead, c.opts.Index, nil, nil)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusNotFound {
+		return nil
+	}
+	_, err = c.do(ctx, http.MethodPut, c.opts.Index, indexMapping, nil)
+	return err
+}
+
+// Put indexes a document, replacing the document of the product if there is one.
+func (c *Client) Put(ctx context.Context, doc *Document) error {
+	_, err := c.do(ctx, http.MethodPut, c.opts.Index+"/_doc/"+url.PathEscape(doc.ID), doc, nil)
+	return err
+}
+
+// Delete removes the document of a product from the index; removing a document that is
+// not indexed succeeds.
+func (c *Client) Delete(ctx context.Context, productID string) error {
+	_, err := c.do(ctx, http.MethodDelete, c.opts.Index+"/_doc/"+url.PathEscape(productID), nil, nil)
+	return err
+}
+
+// search runs a search request against the index, decoding the response into out.
+func (c *Client) search(ctx context.Context, body, out interface{}) error {
+	_, err := c.do(ctx, http.MethodPost, c.opts.Index+"/_search", body, out)
+	return err
+}
+
+// do sends a request with body encoded as JSON, if not nil, and decodes a successful
+// response into out, if not nil. It returns the status of the response; 404 is not an
+// error, as it answers HEAD and DELETE requests for what does not exist.
+func (c *Client) do(ctx context.Context, method, path string, body, out interface{}) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.base.String()+"/"+path, reader)
+	if err != nil {
+		return 0, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.opts.Username != "" {
+		req.SetBasicAuth(c.opts.Username, c.opts.Password)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("opensearch: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return resp.StatusCode, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return resp.StatusCode, fmt.Errorf("opensearch: %s %s: %s: %s", method, path, resp.Status, bytes.TrimSpace(detail))
+	}
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp.StatusCode, fmt.Errorf("opensearch: decode %s response: %w", path, err)
+		}
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,108 @@
+package searchindex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCluster serves the index and document APIs for one index from memory and records
+// the body of the last search.
+type fakeCluster struct {
+	mu         sync.Mutex
+	mapping    map[string]interface{}
+	docs       map[string]Document
+	search     map[string]interface{}
+	searchResp string
+}
+
+func newFakeCluster(t *testing.T) (*fakeCluster, *Client) {
+	cluster := &fakeCluster{docs: make(map[string]Document)}
+	server := httptest.NewServer(cluster)
+	t.Cleanup(server.Close)
+	client, err := NewClient(Options{URL: server.URL + "/", Username: "catalog", Password: "secret"}, nil)
+	require.NoError(t, err)
+	return cluster, client
+}
+
+func (c *fakeCluster) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if user, password, ok := r.BasicAuth(); !ok || user != "catalog" || password != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/"+DefaultIndex)
+	switch {
+	case path == "" && r.Method == http.MethodHead:
+		if c.mapping == nil {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case path == "" && r.Method == http.MethodPut:
+		_ = json.NewDecoder(r.Body).Decode(&c.mapping)
+	case path == "/_search" && r.Method == http.MethodPost:
+		_ = json.NewDecoder(r.Body).Decode(&c.search)
+		_, _ = w.Write([]byte(c.searchResp))
+	case strings.HasPrefix(path, "/_doc/") && r.Method == http.MethodPut:
+		var doc Document
+		if err := json.NewDecoder(r.Body).Decode(&doc); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		c.docs[strings.TrimPrefix(path, "/_doc/")] = doc
+	case strings.HasPrefix(path, "/_doc/") && r.Method == http.MethodDelete:
+		id := strings.TrimPrefix(path, "/_doc/")
+		if _, ok := c.docs[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(c.docs, id)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"unsupported"}`))
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	client, err := NewClient(Options{URL: "https://search.example.com:9200"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultIndex, client.Index())
+
+	for _, url := range []string{"", "search.example.com", "ftp://search.example.com", "https://"} {
+		_, err := NewClient(Options{URL: url}, nil)
+		assert.ErrorIs(t, err, ErrInvalidURL, url)
+	}
+}
+
+func TestClient_EnsureIndex(t *testing.T) {
+	cluster, client := newFakeCluster(t)
+
+	require.NoError(t, client.EnsureIndex(context.Background()))
+	require.NotNil(t, cluster.mapping, "a missing index is created")
+	cluster.mapping["created"] = true
+
+	require.NoError(t, client.EnsureIndex(context.Background()))
+	assert.Equal(t, true, cluster.mapping["created"], "an existing index is kept")
+}
+
+func TestClient_PutDelete(t *testing.T) {
+	cluster, client := newFakeCluster(t)
+	ctx := context.Background()
+
+	require.NoError(t, client.Put(ctx, &Document{ID: "product-1", Name: "Oak Chair"}))
+	assert.Equal(t, "Oak Chair", cluster.docs["product-1"].Name)
+
+	require.NoError(t, client.Delete(ctx, "product-1"))
+	assert.Empty(t, cluster.docs)
+	assert.NoError(t, client.Delete(ctx, "product-1"), "deleting a document that is not indexed succeeds")
+
+	client.opts.Password = "wrong"
+	assert.ErrorContains(t, client.Put(ctx, &Document{ID: "product-1"}), "401 Unauthorized")
+}
@@ -0,0 +1,181 @@
+package searchindex
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// Page sizes of Engine.Search, as those of the read model.
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// maxFacetValues is the number of most common values counted per facet.
+const maxFacetValues = 20
+
+// facetFields maps each facet to the document field it counts, in the order of
+// SearchResult.Facets.
+var facetFields = []struct{ facet, field string }{
+	{contract.FacetCategory, "category"},
+	{contract.FacetTag, "tags"},
+	{contract.FacetChannel, "channels"},
+}
+
+// Engine searches the index. It matches queries fuzzily against the names and
+// descriptions of products, names weighing twice as much, so a query with a typo such as
+// "chiar" still finds chairs.
+type Engine struct {
+	client *Client
+}
+
+var _ contract.ProductSearchEngine = (*Engine)(nil)
+
+// NewEngine creates an Engine.
+func NewEngine(client *Client) *Engine {
+	return &Engine{client: client}
+}
+
+// searchResponse is the part of a search response Search reads.
+type searchResponse struct {
+	Hits struct {
+		Total struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []struct {
+			ID    string  `json:"_id"`
+			Score float64 `json:"_score"`
+		} `json:"hits"`
+	} `json:"hits"`
+	Aggregations map[string]struct {
+		Buckets []struct {
+			Key      string `json:"key"`
+			DocCount int64  `json:"doc_count"`
+		} `json:"buckets"`
+	} `json:"aggregations"`
+}
+
+// Search finds the products in the tenant scope of ctx matching filter. Archived products
+// are not indexed, so they are never found.
+func (e *Engine) Search(ctx context.Context, filter contract.SearchProductsFilter, pagination contract.Pagination) (*contract.SearchResult, error) {
+	body, pageSize, err := buildSearchRequest(ctx, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+	var resp searchResponse
+	if err := e.client.search(ctx, body, &resp); err != nil {
+		return nil, err
+	}
+
+	result := &contract.SearchResult{TotalCount: resp.Hits.Total.Value}
+	for _, hit := range resp.Hits.Hits {
+		result.ProductIDs = append(result.ProductIDs, hit.ID)
+	}
+	if hits := resp.Hits.Hits; len(hits) == pageSize {
+		last := hits[len(hits)-1]
+		result.NextPageToken = searchPageToken(last.Score, last.ID)
+	}
+	for _, f := range facetFields {
+		facet := contract.FacetDTO{Field: f.facet}
+		for _, bucket := range resp.Aggregations[f.facet].Buckets {
+			facet.Values = append(facet.Values, contract.FacetValueDTO{Value: bucket.Key, Count: bucket.DocCount})
+		}
+		result.Facets = append(result.Facets, facet)
+	}
+	return result, nil
+}
+
+// buildSearchRequest builds the body of the search request for a page of a search and
+// returns it with the page size.
+func buildSearchRequest(ctx context.Context, filter contract.SearchProductsFilter, pagination contract.Pagination) (map[string]interface{}, int, error) {
+	query := map[string]interface{}{
+		"must": map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":     filter.Query,
+				"fields":    []string{"name^2", "description"},
+				"fuzziness": "AUTO",
+			},
+		},
+	}
+
+	var filters []interface{}
+	if tenant, scoped := caller.Tenant(ctx); scoped {
+		// The products of the default tenant are indexed with an empty tenant ID.
+		filters = append(filters, term("tenant_id", tenant))
+	}
+	if filter.Category != "" {
+		filters = append(filters, term("category", filter.Category))
+	}
+	if filter.ActiveOnly {
+		filters = append(filters, term("status", string(domain.ProductStatusActive)))
+	}
+	if filter.Tag != "" {
+		filters = append(filters, term("tags", filter.Tag))
+	}
+	if filter.Channel != "" {
+		filters = append(filters, term("channels", filter.Channel))
+	}
+	if len(filters) > 0 {
+		query["filter"] = filters
+	}
+
+	pageSize := int(pagination.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	aggs := make(map[string]interface{}, len(facetFields))
+	for _, f := range facetFields {
+		aggs[f.facet] = map[string]interface{}{
+			"terms": map[string]interface{}{"field": f.field, "size": maxFacetValues},
+		}
+	}
+
+	body := map[string]interface{}{
+		"query":            map[string]interface{}{"bool": query},
+		"size":             pageSize,
+		"sort":             []interface{}{map[string]string{"_score": "desc"}, map[string]string{"id": "asc"}},
+		"track_scores":     true,
+		"track_total_hits": true,
+		"aggs":             aggs,
+	}
+	if pagination.PageToken != "" {
+		score, productID, err := parseSearchPageToken(pagination.PageToken)
+		if err != nil {
+			return nil, 0, err
+		}
+		body["search_after"] = []interface{}{score, productID}
+	}
+	return body, pageSize, nil
+}
+
+// term matches documents whose keyword field has a value.
+func term(field, value string) map[string]interface{} {
+	return map[string]interface{}{"term": map[string]string{field: value}}
+}
+
+// searchPageToken encodes the position of a product in search results as a page token.
+func searchPageToken(score float64, productID string) string {
+	return strconv.FormatFloat(score, 'g', -1, 64) + ":" + productID
+}
+
+// parseSearchPageToken decodes a token from searchPageToken.
+func parseSearchPageToken(token string) (float64, string, error) {
+	score, productID, ok := strings.Cut(token, ":")
+	if !ok || productID == "" {
+		return 0, "", domain.ErrInvalidPageToken
+	}
+	f, err := strconv.ParseFloat(score, 64)
+	if err != nil {
+		return 0, "", domain.ErrInvalidPageToken
+	}
+	return f, productID, nil
+}
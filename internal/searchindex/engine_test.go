@@ -0,0 +1,68 @@
+package searchindex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_Search(t *testing.T) {
+	cluster, client := newFakeCluster(t)
+	cluster.searchResp = `{
+		"hits": {"total": {"value": 3}, "hits": [
+			{"_id": "product-1", "_score": 2.5},
+			{"_id": "product-2", "_score": 1.25}
+		]},
+		"aggregations": {
+			"category": {"buckets": [{"key": "Furniture", "doc_count": 3}]},
+			"tag": {"buckets": [{"key": "oak", "doc_count": 2}, {"key": "sale", "doc_count": 1}]},
+			"channel": {"buckets": []}
+		}
+	}`
+	engine := NewEngine(client)
+	ctx := caller.NewContext(context.Background(), caller.Identity{Tenant: "acme"})
+
+	result, err := engine.Search(ctx, contract.SearchProductsFilter{
+		Query: "oak chiar", Category: "Furniture", ActiveOnly: true, Tag: "oak", Channel: "web",
+	}, contract.Pagination{PageSize: 2, PageToken: "3.5:product-0"})
+	require.NoError(t, err)
+	assert.Equal(t, &contract.SearchResult{
+		ProductIDs:    []string{"product-1", "product-2"},
+		NextPageToken: "1.25:product-2",
+		TotalCount:    3,
+		Facets: []contract.FacetDTO{
+			{Field: contract.FacetCategory, Values: []contract.FacetValueDTO{{Value: "Furniture", Count: 3}}},
+			{Field: contract.FacetTag, Values: []contract.FacetValueDTO{{Value: "oak", Count: 2}, {Value: "sale", Count: 1}}},
+			{Field: contract.FacetChannel},
+		},
+	}, result)
+
+	search := cluster.search
+	assert.Equal(t, float64(2), search["size"])
+	assert.Equal(t, []interface{}{3.5, "product-0"}, search["search_after"])
+	query := search["query"].(map[string]interface{})["bool"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"multi_match": map[string]interface{}{
+		"query": "oak chiar", "fields": []interface{}{"name^2", "description"}, "fuzziness": "AUTO",
+	}}, query["must"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"term": map[string]interface{}{"tenant_id": "acme"}},
+		map[string]interface{}{"term": map[string]interface{}{"category": "Furniture"}},
+		map[string]interface{}{"term": map[string]interface{}{"status": "active"}},
+		map[string]interface{}{"term": map[string]interface{}{"tags": "oak"}},
+		map[string]interface{}{"term": map[string]interface{}{"channels": "web"}},
+	}, query["filter"])
+
+	_, err = engine.Search(context.Background(), contract.SearchProductsFilter{Query: "oak"}, contract.Pagination{})
+	require.NoError(t, err)
+	assert.Equal(t, float64(defaultPageSize), cluster.search["size"])
+	assert.NotContains(t, cluster.search["query"].(map[string]interface{})["bool"], "filter",
+		"an unscoped search without filters searches every product")
+
+	_, err = engine.Search(ctx, contract.SearchProductsFilter{Query: "oak"}, contract.Pagination{PageToken: "product-0"})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+}
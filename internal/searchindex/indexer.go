@@ -0,0 +1,88 @@
+package searchindex
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/outbox"
+)
+
+// Document is the indexed form of a product. It holds what searches match, filter and
+// count by; the rest of the product is read from the catalog.
+type Document struct {
+	ID string `json:"id"`
+	// TenantID is empty for the default tenant.
+	TenantID    string    `json:"tenant_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Category    string    `json:"category"`
+	Status      string    `json:"status"`
+	Tags        []string  `json:"tags"`
+	Channels    []string  `json:"channels"`
+	Kind        string    `json:"kind,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NewDocument returns the document of a product of a tenant.
+func NewDocument(tenantID string, product *contract.ProductDTO) *Document {
+	return &Document{
+		ID:          product.ID,
+		TenantID:    tenantID,
+		Name:        product.Name,
+		Description: product.Description,
+		Category:    product.Category,
+		Status:      product.Status,
+		Tags:        product.Tags,
+		Channels:    product.Channels,
+		Kind:        product.Kind,
+		UpdatedAt:   product.UpdatedAt,
+	}
+}
+
+// Indexer keeps the index in sync with the catalog. It is an outbox.Publisher, so the
+// outbox dispatcher delivers it every event, in order per product and retried until it
+// succeeds. It indexes the product of each product event as read back from the catalog,
+// which makes indexing idempotent and lets it skip over events it has missed: the index
+// only needs the latest state.
+type Indexer struct {
+	client    *Client
+	readModel contract.ProductReadModel
+	clock     clock.Clock
+}
+
+var _ outbox.Publisher = (*Indexer)(nil)
+
+// NewIndexer creates an Indexer that reads products from readModel.
+func NewIndexer(client *Client, readModel contract.ProductReadModel, clock clock.Clock) *Indexer {
+	return &Indexer{client: client, readModel: readModel, clock: clock}
+}
+
+// Publish indexes the product an event is about, or removes it from the index if it is
+// archived or no longer exists. Events not about a product are ignored.
+func (i *Indexer) Publish(ctx context.Context, msg *outbox.Message) error {
+	if !strings.HasPrefix(msg.EventType, "product.") {
+		return nil
+	}
+	// ctx carries no caller, so the read sees the products of every tenant.
+	product, err := i.readModel.GetProduct(ctx, msg.AggregateID, i.clock.Now())
+	if errors.Is(err, domain.ErrProductNotFound) {
+		return i.client.Delete(ctx, msg.AggregateID)
+	}
+	if err != nil {
+		return err
+	}
+	if product.Status == string(domain.ProductStatusArchived) {
+		return i.client.Delete(ctx, product.ID)
+	}
+	return i.client.Put(ctx, NewDocument(msg.TenantID, product))
+}
+
+// Close is a no-op.
+func (i *Indexer) Close() error {
+	return nil
+}
@@ -0,0 +1,58 @@
+package searchindex
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/outbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// productsReadModel serves products from a map.
+type productsReadModel struct {
+	contract.ProductReadModel
+	products map[string]*contract.ProductDTO
+}
+
+func (rm *productsReadModel) GetProduct(_ context.Context, id string, _ time.Time) (*contract.ProductDTO, error) {
+	product, ok := rm.products[id]
+	if !ok {
+		return nil, domain.ErrProductNotFound
+	}
+	return product, nil
+}
+
+func TestIndexer_Publish(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	cluster, client := newFakeCluster(t)
+	readModel := &productsReadModel{products: map[string]*contract.ProductDTO{
+		"product-1": {
+			ID: "product-1", Name: "Oak Chair", Description: "Solid oak", Category: "Furniture",
+			Status: "active", Tags: []string{"oak"}, Channels: []string{"web"}, Kind: "physical", UpdatedAt: now,
+		},
+	}}
+	indexer := NewIndexer(client, readModel, clock.NewFixedClock(now))
+	ctx := context.Background()
+
+	require.NoError(t, indexer.Publish(ctx, &outbox.Message{EventType: "product.created", AggregateID: "product-1", TenantID: "acme"}))
+	assert.Equal(t, Document{
+		ID: "product-1", TenantID: "acme", Name: "Oak Chair", Description: "Solid oak", Category: "Furniture",
+		Status: "active", Tags: []string{"oak"}, Channels: []string{"web"}, Kind: "physical", UpdatedAt: now,
+	}, cluster.docs["product-1"])
+
+	require.NoError(t, indexer.Publish(ctx, &outbox.Message{EventType: "campaign.created", AggregateID: "campaign-1"}))
+	assert.Len(t, cluster.docs, 1, "events not about a product are ignored")
+
+	readModel.products["product-1"].Status = "archived"
+	require.NoError(t, indexer.Publish(ctx, &outbox.Message{EventType: "product.archived", AggregateID: "product-1"}))
+	assert.Empty(t, cluster.docs, "archived products are removed")
+
+	delete(readModel.products, "product-1")
+	require.NoError(t, indexer.Publish(ctx, &outbox.Message{EventType: "product.purged", AggregateID: "product-1"}))
+	assert.Empty(t, cluster.docs)
+}
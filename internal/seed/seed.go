@@ -0,0 +1,229 @@
+// Package seed loads the golden dataset: a fixed set of products created through the use
+// cases at a fixed time and with sequential IDs, so that e2e and contract tests can assert
+// exact IDs and values.
+package seed
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/product-catalog-service/internal/clock"
+	"github.com/product-catalog-service/internal/idgen"
+	"github.com/product-catalog-service/internal/usecase"
+)
+
+// Namespace is the first group of every golden ID, which keeps them apart from the
+// random UUIDs of other data in the same database.
+const Namespace uint32 = 0x601de000
+
+// Epoch is the time the golden dataset is created at.
+var Epoch = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+// NewClock returns a clock fixed at Epoch, for the use cases that load the dataset.
+func NewClock() *clock.FixedClock {
+	return clock.NewFixedClock(Epoch)
+}
+
+// NewIDGenerator returns a fresh ID generator for the use cases that load the dataset.
+func NewIDGenerator() *idgen.SequenceGenerator {
+	return idgen.NewSequenceGenerator(Namespace)
+}
+
+// Discount is a discount of a golden product. Request.ProductID is set when loading.
+type Discount struct {
+	Key     string
+	Request usecase.ApplyDiscountRequest
+}
+
+// Product is a golden product. It is created, given its tax class and price tiers,
+// activated, discounted and archived, in that order, skipping the steps it leaves empty.
+type Product struct {
+	Key       string
+	Create    usecase.CreateProductRequest
+	TaxClass  string
+	Tiers     []usecase.PriceTierRequest
+	Activate  bool
+	Discounts []Discount
+	Archive   bool
+}
+
+// Golden is the golden dataset. Tests depend on its exact content and on its order,
+// which determines the IDs: append to it rather than change it.
+var Golden = []Product{
+	{
+		Key: "laptop",
+		Create: usecase.CreateProductRequest{
+			Name:                 "Golden Laptop",
+			Description:          "A 14-inch laptop",
+			Category:             "Electronics",
+			BasePriceNumerator:   99999,
+			BasePriceDenominator: 100,
+		},
+		Activate: true,
+		Discounts: []Discount{
+			{Key: "laptop-season", Request: usecase.ApplyDiscountRequest{
+				DiscountPercentage: 10,
+				StartDate:          Epoch,
+				EndDate:            Epoch.AddDate(0, 0, 30),
+			}},
+			{Key: "laptop-weekend", Request: usecase.ApplyDiscountRequest{
+				DiscountPercentage: 25,
+				Priority:           10,
+				StartDate:          Epoch.AddDate(0, 0, 5),
+				EndDate:            Epoch.AddDate(0, 0, 7),
+			}},
+		},
+	},
+	{
+		Key: "headphones",
+		Create: usecase.CreateProductRequest{
+			Name:                 "Golden Headphones",
+			Description:          "Over-ear wireless headphones",
+			Category:             "Electronics",
+			BasePriceNumerator:   14900,
+			BasePriceDenominator: 100,
+		},
+		Activate: true,
+		Discounts: []Discount{
+			{Key: "headphones-b2g1", Request: usecase.ApplyDiscountRequest{
+				BuyQuantity: 2,
+				GetQuantity: 1,
+				StartDate:   Epoch,
+				EndDate:     Epoch.AddDate(0, 0, 14),
+			}},
+		},
+	},
+	{
+		Key: "novel",
+		Create: usecase.CreateProductRequest{
+			Name:                 "Golden Novel",
+			Description:          "A paperback novel",
+			Category:             "Books",
+			BasePriceNumerator:   1999,
+			BasePriceDenominator: 100,
+		},
+		TaxClass: "reduced",
+		Activate: true,
+	},
+	{
+		Key: "mug",
+		Create: usecase.CreateProductRequest{
+			Name:                 "Golden Mug",
+			Description:          "A stoneware mug",
+			Category:             "Home",
+			BasePriceNumerator:   1250,
+			BasePriceDenominator: 100,
+			Currency:             "EUR",
+		},
+	},
+	{
+		Key: "lamp",
+		Create: usecase.CreateProductRequest{
+			Name:                 "Golden Lamp",
+			Description:          "A desk lamp",
+			Category:             "Home",
+			BasePriceNumerator:   4500,
+			BasePriceDenominator: 100,
+		},
+		Tiers: []usecase.PriceTierRequest{
+			{MinQuantity: 10, UnitPriceNumerator: 4000, UnitPriceDenominator: 100},
+			{MinQuantity: 100, PercentOff: 20},
+		},
+		Activate: true,
+	},
+	{
+		Key: "poster",
+		Create: usecase.CreateProductRequest{
+			Name:                 "Golden Poster",
+			Description:          "A discontinued poster",
+			Category:             "Home",
+			BasePriceNumerator:   900,
+			BasePriceDenominator: 100,
+		},
+		Archive: true,
+	},
+}
+
+// Dataset holds the IDs of the golden products and discounts by key.
+type Dataset struct {
+	Products  map[string]string
+	Discounts map[string]string
+}
+
+// ProductIDs returns the IDs of the golden products in dataset order.
+func (d *Dataset) ProductIDs() []string {
+	ids := make([]string, len(Golden))
+	for i, p := range Golden {
+		ids[i] = d.Products[p.Key]
+	}
+	return ids
+}
+
+// Expected returns the IDs Load assigns to the golden dataset.
+func Expected() *Dataset {
+	ids := NewIDGenerator()
+	dataset := &Dataset{Products: make(map[string]string), Discounts: make(map[string]string)}
+	for _, p := range Golden {
+		dataset.Products[p.Key] = ids.NewID()
+		for _, d := range p.Discounts {
+			dataset.Discounts[d.Key] = ids.NewID()
+		}
+	}
+	return dataset
+}
+
+// Load creates the golden dataset through uc, which must have been built with NewClock
+// and a fresh NewIDGenerator, into a database that does not hold it yet. It fails if an
+// ID differs from Expected, as the dataset would then not be the golden one.
+func Load(ctx context.Context, uc *usecase.ProductUseCases) (*Dataset, error) {
+	expected := Expected()
+	dataset := &Dataset{Products: make(map[string]string), Discounts: make(map[string]string)}
+	for _, p := range Golden {
+		created, err := uc.CreateProduct(ctx, p.Create)
+		if err != nil {
+			return nil, fmt.Errorf("create %s: %w", p.Key, err)
+		}
+		id := created.ProductID
+		if id != expected.Products[p.Key] {
+			return nil, fmt.Errorf("product %s got ID %s, want %s: use cases must use a fresh seed ID generator",
+				p.Key, id, expected.Products[p.Key])
+		}
+		dataset.Products[p.Key] = id
+
+		if p.TaxClass != "" {
+			if err := uc.SetTaxClass(ctx, usecase.SetTaxClassRequest{ProductID: id, TaxClass: p.TaxClass}); err != nil {
+				return nil, fmt.Errorf("set tax class of %s: %w", p.Key, err)
+			}
+		}
+		if len(p.Tiers) > 0 {
+			if err := uc.SetPriceTiers(ctx, usecase.SetPriceTiersRequest{ProductID: id, Tiers: p.Tiers}); err != nil {
+				return nil, fmt.Errorf("set price tiers of %s: %w", p.Key, err)
+			}
+		}
+		if p.Activate {
+			if err := uc.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: id}); err != nil {
+				return nil, fmt.Errorf("activate %s: %w", p.Key, err)
+			}
+		}
+		for _, d := range p.Discounts {
+			req := d.Request
+			req.ProductID = id
+			applied, err := uc.ApplyDiscount(ctx, req)
+			if err != nil {
+				return nil, fmt.Errorf("apply discount %s: %w", d.Key, err)
+			}
+			if applied.DiscountID != expected.Discounts[d.Key] {
+				return nil, fmt.Errorf("discount %s got ID %s, want %s: use cases must use a fresh seed ID generator",
+					d.Key, applied.DiscountID, expected.Discounts[d.Key])
+			}
+			dataset.Discounts[d.Key] = applied.DiscountID
+		}
+		if p.Archive {
+			if err := uc.ArchiveProduct(ctx, usecase.ArchiveProductRequest{ProductID: id}); err != nil {
+				return nil, fmt.Errorf("archive %s: %w", p.Key, err)
+			}
+		}
+	}
+	return dataset, nil
+}
@@ -0,0 +1,42 @@
+package seed
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpected(t *testing.T) {
+	dataset := Expected()
+
+	assert.Equal(t, "601de000-0000-4000-8000-000000000001", dataset.Products["laptop"])
+	assert.Equal(t, "601de000-0000-4000-8000-000000000002", dataset.Discounts["laptop-season"])
+	assert.Equal(t, "601de000-0000-4000-8000-000000000003", dataset.Discounts["laptop-weekend"])
+	assert.Equal(t, "601de000-0000-4000-8000-000000000004", dataset.Products["headphones"])
+	assert.Equal(t, "601de000-0000-4000-8000-000000000005", dataset.Discounts["headphones-b2g1"])
+	assert.Equal(t, "601de000-0000-4000-8000-000000000006", dataset.Products["novel"])
+	assert.Len(t, dataset.ProductIDs(), len(Golden))
+	assert.Equal(t, Expected(), dataset, "IDs are deterministic")
+}
+
+// TestGolden_Valid checks the golden requests against the use case validation, so a
+// change to the rules shows up here rather than as a failing seed.
+func TestGolden_Valid(t *testing.T) {
+	keys := make(map[string]bool)
+	for _, p := range Golden {
+		require.False(t, keys[p.Key], "duplicate key %s", p.Key)
+		keys[p.Key] = true
+		require.NoError(t, usecase.ValidateCreateProductRequest(p.Create), p.Key)
+
+		for _, d := range p.Discounts {
+			require.False(t, keys[d.Key], "duplicate key %s", d.Key)
+			keys[d.Key] = true
+			req := d.Request
+			req.ProductID = p.Key
+			require.NoError(t, usecase.ValidateApplyDiscountRequest(req), d.Key)
+			assert.True(t, p.Activate && !p.Archive, "discount %s needs an active product", d.Key)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package selftest
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/availability"
+	"github.com/product-catalog-service/internal/outbox"
+	cloudkms "google.golang.org/api/cloudkms/v1"
+	pubsub "google.golang.org/api/pubsub/v1"
+)
+
+// kmsKeyEnabled is the state of a usable key version.
+const kmsKeyEnabled = "ENABLED"
+
+// SpannerCheck runs a trivial query against the database of client.
+func SpannerCheck(client *spanner.Client) Check {
+	probe := availability.SpannerProbe(client)
+	return Check{
+		Name: "spanner",
+		Run: func(ctx context.Context) (string, error) {
+			return "", probe(ctx)
+		},
+	}
+}
+
+// PubSubTopicCheck checks that the outbox topic exists and is visible to the service. It
+// is skipped unless the outbox publishes to Pub/Sub.
+func PubSubTopicCheck(publisher string, opts outbox.PubSubOptions) Check {
+	return Check{
+		Name: "pubsub_topic",
+		Run: func(ctx context.Context) (string, error) {
+			if publisher != outbox.PublisherPubSub {
+				return "", Skip(fmt.Sprintf("outbox publisher is %s", publisher))
+			}
+			if opts.Topic == "" {
+				opts.Topic = outbox.DefaultPubSubTopic
+			}
+
+			service, err := pubsub.NewService(ctx, opts.ClientOptions()...)
+			if err != nil {
+				return "", err
+			}
+			topic, err := service.Projects.Topics.Get(opts.TopicName()).Context(ctx).Do()
+			if err != nil {
+				return "", fmt.Errorf("get topic %s: %w", opts.TopicName(), err)
+			}
+			return topic.Name, nil
+		},
+	}
+}
+
+// KMSKeyCheck checks that the Cloud KMS key with the given resource name is accessible
+// and that its primary version, if any, is enabled. It is skipped if keyName is empty.
+func KMSKeyCheck(keyName string) Check {
+	return Check{
+		Name: "kms_key",
+		Run: func(ctx context.Context) (string, error) {
+			if keyName == "" {
+				return "", Skip("no KMS key configured")
+			}
+
+			service, err := cloudkms.NewService(ctx)
+			if err != nil {
+				return "", err
+			}
+			key, err := service.Projects.Locations.KeyRings.CryptoKeys.Get(keyName).Context(ctx).Do()
+			if err != nil {
+				return "", fmt.Errorf("get key %s: %w", keyName, err)
+			}
+			if key.Primary != nil && key.Primary.State != kmsKeyEnabled {
+				return "", fmt.Errorf("primary version of key %s is %s", keyName, key.Primary.State)
+			}
+			return fmt.Sprintf("%s (%s)", key.Name, key.Purpose), nil
+		},
+	}
+}
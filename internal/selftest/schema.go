@@ -0,0 +1,271 @@
+package selftest
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// Column is a column of the database, as declared by a migration or read from Spanner.
+type Column struct {
+	Table   string
+	Name    string
+	Type    string
+	NotNull bool
+}
+
+// Migration is a migration file, its DDL statements and the columns it creates.
+type Migration struct {
+	Name       string
+	Statements []string
+	Columns    []Column
+}
+
+// Schema holds the columns of a database, keyed by table and then column name.
+type Schema map[string]map[string]Column
+
+// SchemaReader reads the current schema of the database.
+type SchemaReader func(ctx context.Context) (Schema, error)
+
+var (
+	createTableRe = regexp.MustCompile(`(?is)^CREATE\s+TABLE\s+(\w+)\s*\((.*)\)\s*PRIMARY\s+KEY`)
+	addColumnRe   = regexp.MustCompile(`(?is)^ALTER\s+TABLE\s+(\w+)\s+ADD\s+COLUMN\s+(\w+)\s+(.+)$`)
+	notNullRe     = regexp.MustCompile(`(?i)\bNOT\s+NULL\b`)
+)
+
+// ParseMigrations reads the *.sql migrations of fsys in name order and extracts the
+// statements and the columns each of them creates. Statements other than CREATE TABLE and
+// ALTER TABLE ADD COLUMN (e.g. indexes) create no columns.
+func ParseMigrations(fsys fs.FS) ([]Migration, error) {
+	names, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+
+	migrations := make([]Migration, 0, len(names))
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		statements, columns, err := parseDDL(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		migrations = append(migrations, Migration{Name: name, Statements: statements, Columns: columns})
+	}
+	return migrations, nil
+}
+
+// parseDDL splits a migration file into statements, dropping comment lines, and extracts
+// the columns they create.
+func parseDDL(ddl string) ([]string, []Column, error) {
+	var lines []string
+	for _, line := range strings.Split(ddl, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "--") {
+			lines = append(lines, line)
+		}
+	}
+
+	var statements []string
+	var columns []Column
+	for _, stmt := range strings.Split(strings.Join(lines, "\n"), ";") {
+		stmt = strings.TrimSpace(stmt)
+		if stmt == "" {
+			continue
+		}
+		statements = append(statements, stmt)
+		if m := createTableRe.FindStringSubmatch(stmt); m != nil {
+			for _, def := range splitTopLevel(m[2]) {
+				column, err := parseColumn(m[1], def)
+				if err != nil {
+					return nil, nil, err
+				}
+				columns = append(columns, column)
+			}
+		} else if m := addColumnRe.FindStringSubmatch(stmt); m != nil {
+			column, err := parseColumn(m[1], m[2]+" "+m[3])
+			if err != nil {
+				return nil, nil, err
+			}
+			columns = append(columns, column)
+		}
+	}
+	return statements, columns, nil
+}
+
+// parseColumn parses a column definition such as "name STRING(255) NOT NULL".
+func parseColumn(table, def string) (Column, error) {
+	fields := strings.Fields(def)
+	if len(fields) < 2 {
+		return Column{}, fmt.Errorf("table %s: invalid column definition %q", table, def)
+	}
+	return Column{
+		Table:   table,
+		Name:    fields[0],
+		Type:    strings.ToUpper(fields[1]),
+		NotNull: notNullRe.MatchString(def),
+	}, nil
+}
+
+// splitTopLevel splits the body of a CREATE TABLE statement on the commas that are not
+// inside parentheses, dropping empty parts left by trailing commas.
+func splitTopLevel(body string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range body {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, body[start:i])
+				start = i + 1
+			}
+		}
+	}
+	parts = append(parts, body[start:])
+
+	defs := parts[:0]
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			defs = append(defs, p)
+		}
+	}
+	return defs
+}
+
+// SpannerSchema returns a SchemaReader for the database of client.
+func SpannerSchema(client *spanner.Client) SchemaReader {
+	return func(ctx context.Context) (Schema, error) {
+		stmt := spanner.Statement{SQL: `SELECT TABLE_NAME, COLUMN_NAME, SPANNER_TYPE, IS_NULLABLE
+			FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = ''`}
+		iter := client.Single().Query(ctx, stmt)
+		defer iter.Stop()
+
+		schema := make(Schema)
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				return schema, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			var column Column
+			var nullable string
+			if err := row.Columns(&column.Table, &column.Name, &column.Type, &nullable); err != nil {
+				return nil, err
+			}
+			column.NotNull = nullable == "NO"
+			schema.add(column)
+		}
+	}
+}
+
+func (s Schema) add(c Column) {
+	if s[c.Table] == nil {
+		s[c.Table] = make(map[string]Column)
+	}
+	s[c.Table][c.Name] = c
+}
+
+func (s Schema) lookup(table, name string) (Column, bool) {
+	c, ok := s[table][name]
+	return c, ok
+}
+
+// MigrationsCheck reports the migrations of which the database lacks a column. It passes
+// when every migration is applied, with the latest one as detail.
+func MigrationsCheck(migrations []Migration, read SchemaReader) Check {
+	return Check{
+		Name: "migrations",
+		Run: func(ctx context.Context) (string, error) {
+			schema, err := read(ctx)
+			if err != nil {
+				return "", fmt.Errorf("read schema: %w", err)
+			}
+
+			var pending []string
+			for _, m := range PendingMigrations(migrations, schema) {
+				pending = append(pending, m.Name)
+			}
+			if len(pending) > 0 {
+				return "", fmt.Errorf("pending migrations: %s", strings.Join(pending, ", "))
+			}
+			if len(migrations) == 0 {
+				return "no migrations", nil
+			}
+			return fmt.Sprintf("%d migrations applied, latest %s", len(migrations), migrations[len(migrations)-1].Name), nil
+		},
+	}
+}
+
+// PendingMigrations returns the migrations of which schema lacks a column, in order.
+// Migrations that create no columns are never pending.
+func PendingMigrations(migrations []Migration, schema Schema) []Migration {
+	var pending []Migration
+	for _, m := range migrations {
+		for _, c := range m.Columns {
+			if _, ok := schema.lookup(c.Table, c.Name); !ok {
+				pending = append(pending, m)
+				break
+			}
+		}
+	}
+	return pending
+}
+
+// SchemaCheck compares the columns of the database with the columns declared by the
+// migrations, reporting missing columns and columns of another type or nullability.
+// Columns the migrations do not declare are ignored.
+func SchemaCheck(migrations []Migration, read SchemaReader) Check {
+	return Check{
+		Name: "schema",
+		Run: func(ctx context.Context) (string, error) {
+			schema, err := read(ctx)
+			if err != nil {
+				return "", fmt.Errorf("read schema: %w", err)
+			}
+
+			expected := make(Schema)
+			var order []Column
+			for _, m := range migrations {
+				for _, c := range m.Columns {
+					if _, ok := expected.lookup(c.Table, c.Name); !ok {
+						order = append(order, c)
+					}
+					expected.add(c)
+				}
+			}
+
+			var problems []string
+			for _, o := range order {
+				want, _ := expected.lookup(o.Table, o.Name)
+				got, ok := schema.lookup(want.Table, want.Name)
+				switch {
+				case !ok:
+					problems = append(problems, fmt.Sprintf("%s.%s is missing", want.Table, want.Name))
+				case !strings.EqualFold(got.Type, want.Type):
+					problems = append(problems, fmt.Sprintf("%s.%s is %s, want %s", want.Table, want.Name, got.Type, want.Type))
+				case got.NotNull != want.NotNull:
+					problems = append(problems, fmt.Sprintf("%s.%s has NOT NULL %t, want %t", want.Table, want.Name, got.NotNull, want.NotNull))
+				}
+			}
+			if len(problems) > 0 {
+				return "", fmt.Errorf("schema mismatch: %s", strings.Join(problems, "; "))
+			}
+			return fmt.Sprintf("%d columns in %d tables match", len(order), len(expected)), nil
+		},
+	}
+}
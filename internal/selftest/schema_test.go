@@ -0,0 +1,133 @@
+package selftest
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/product-catalog-service/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMigrations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"002_add_column.sql": {Data: []byte("-- Adds a column.\nALTER TABLE widgets ADD COLUMN color STRING(20);\n")},
+		"001_create.sql": {Data: []byte(`-- Widgets.
+CREATE TABLE widgets (
+    widget_id STRING(36) NOT NULL,
+    price NUMERIC,
+) PRIMARY KEY (widget_id, price);
+
+CREATE INDEX idx_widgets_price ON widgets(price);
+`)},
+	}
+
+	got, err := ParseMigrations(fsys)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Migration{
+		{Name: "001_create.sql", Statements: []string{
+			"CREATE TABLE widgets (\n    widget_id STRING(36) NOT NULL,\n    price NUMERIC,\n) PRIMARY KEY (widget_id, price)",
+			"CREATE INDEX idx_widgets_price ON widgets(price)",
+		}, Columns: []Column{
+			{Table: "widgets", Name: "widget_id", Type: "STRING(36)", NotNull: true},
+			{Table: "widgets", Name: "price", Type: "NUMERIC"},
+		}},
+		{Name: "002_add_column.sql", Statements: []string{
+			"ALTER TABLE widgets ADD COLUMN color STRING(20)",
+		}, Columns: []Column{
+			{Table: "widgets", Name: "color", Type: "STRING(20)"},
+		}},
+	}, got)
+}
+
+func TestParseMigrations_Repository(t *testing.T) {
+	got, err := ParseMigrations(migrations.Files)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, got)
+	assert.Equal(t, "001_initial_schema.sql", got[0].Name)
+	for _, m := range got {
+		assert.NotEmpty(t, m.Columns, "%s creates no column", m.Name)
+	}
+}
+
+// schemaOf returns a SchemaReader for a database with the given columns.
+func schemaOf(columns ...Column) SchemaReader {
+	schema := make(Schema)
+	for _, c := range columns {
+		schema.add(c)
+	}
+	return func(context.Context) (Schema, error) { return schema, nil }
+}
+
+func TestMigrationsAndSchemaChecks(t *testing.T) {
+	id := Column{Table: "widgets", Name: "widget_id", Type: "STRING(36)", NotNull: true}
+	price := Column{Table: "widgets", Name: "price", Type: "NUMERIC"}
+	color := Column{Table: "widgets", Name: "color", Type: "STRING(20)"}
+	migrationFiles := []Migration{
+		{Name: "001_create.sql", Statements: []string{
+			"CREATE TABLE widgets (\n    widget_id STRING(36) NOT NULL,\n    price NUMERIC,\n) PRIMARY KEY (widget_id, price)",
+			"CREATE INDEX idx_widgets_price ON widgets(price)",
+		}, Columns: []Column{id, price}},
+		{Name: "002_add_column.sql", Statements: []string{
+			"ALTER TABLE widgets ADD COLUMN color STRING(20)",
+		}, Columns: []Column{color}},
+	}
+	intPrice := price
+	intPrice.Type = "INT64"
+	requiredColor := color
+	requiredColor.NotNull = true
+
+	tests := []struct {
+		name            string
+		read            SchemaReader
+		wantMigrations  string
+		wantSchema      string
+		migrationDetail string
+	}{
+		{
+			name:            "up to date",
+			read:            schemaOf(id, price, color, Column{Table: "other", Name: "id", Type: "INT64"}),
+			migrationDetail: "2 migrations applied, latest 002_add_column.sql",
+		},
+		{
+			name:           "pending migration",
+			read:           schemaOf(id, price),
+			wantMigrations: "pending migrations: 002_add_column.sql",
+			wantSchema:     "schema mismatch: widgets.color is missing",
+		},
+		{
+			name:       "column of another type",
+			read:       schemaOf(id, intPrice, color),
+			wantSchema: "schema mismatch: widgets.price is INT64, want NUMERIC",
+		},
+		{
+			name:       "column of another nullability",
+			read:       schemaOf(id, price, requiredColor),
+			wantSchema: "schema mismatch: widgets.color has NOT NULL true, want false",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detail, err := MigrationsCheck(migrationFiles, tt.read).Run(context.Background())
+			if tt.wantMigrations != "" {
+				assert.EqualError(t, err, tt.wantMigrations)
+			} else {
+				require.NoError(t, err)
+				if tt.migrationDetail != "" {
+					assert.Equal(t, tt.migrationDetail, detail)
+				}
+			}
+
+			_, err = SchemaCheck(migrationFiles, tt.read).Run(context.Background())
+			if tt.wantSchema != "" {
+				assert.EqualError(t, err, tt.wantSchema)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
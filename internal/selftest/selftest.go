@@ -0,0 +1,158 @@
+// Package selftest verifies the dependencies of the service at startup: Spanner
+// connectivity, the database schema and migrations, the Pub/Sub topic and the KMS key.
+//
+// The server reports itself as not serving on the gRPC health service until every check
+// passes. Failed runs are retried, and each run writes a Report as one line of JSON.
+package selftest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// Default self-test settings.
+const (
+	DefaultCheckTimeout  = 10 * time.Second
+	DefaultRetryInterval = 10 * time.Second
+)
+
+// ErrSkipped is returned, wrapped by Skip, by checks that do not apply to the configuration.
+var ErrSkipped = errors.New("skipped")
+
+// Skip returns an error that marks a check as skipped for the given reason.
+func Skip(reason string) error {
+	return fmt.Errorf("%w: %s", ErrSkipped, reason)
+}
+
+// Status is the outcome of a check.
+type Status string
+
+// Check outcomes.
+const (
+	StatusPassed  Status = "passed"
+	StatusFailed  Status = "failed"
+	StatusSkipped Status = "skipped"
+)
+
+// Check is a single startup check.
+type Check struct {
+	Name string
+	// Run returns a nil error if the check passes, or an error wrapping ErrSkipped if it
+	// does not apply. The detail, if any, is reported with a passing result.
+	Run func(ctx context.Context) (detail string, err error)
+}
+
+// Result is the outcome of one check in a Report.
+type Result struct {
+	Name       string `json:"name"`
+	Status     Status `json:"status"`
+	Detail     string `json:"detail,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+// Report is the outcome of one run of the checks.
+type Report struct {
+	Attempt    int       `json:"attempt"`
+	Passed     bool      `json:"passed"`
+	StartedAt  time.Time `json:"started_at"`
+	DurationMS int64     `json:"duration_ms"`
+	Checks     []Result  `json:"checks"`
+}
+
+// Failed returns the names of the checks that failed.
+func (r *Report) Failed() []string {
+	var names []string
+	for _, c := range r.Checks {
+		if c.Status == StatusFailed {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
+
+// Run runs the checks in order, each with its own timeout.
+func Run(ctx context.Context, checks []Check, timeout time.Duration) *Report {
+	if timeout <= 0 {
+		timeout = DefaultCheckTimeout
+	}
+
+	report := &Report{Passed: true, StartedAt: time.Now().UTC()}
+	for _, check := range checks {
+		start := time.Now()
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		detail, err := check.Run(checkCtx)
+		cancel()
+
+		result := Result{Name: check.Name, Status: StatusPassed, Detail: detail, DurationMS: time.Since(start).Milliseconds()}
+		switch {
+		case errors.Is(err, ErrSkipped):
+			result.Status = StatusSkipped
+			result.Detail = err.Error()
+		case err != nil:
+			result.Status = StatusFailed
+			result.Detail = err.Error()
+			report.Passed = false
+		}
+		report.Checks = append(report.Checks, result)
+	}
+	report.DurationMS = time.Since(report.StartedAt).Milliseconds()
+	return report
+}
+
+// Options configures RunUntilPassed.
+type Options struct {
+	// CheckTimeout bounds each check of a run.
+	CheckTimeout time.Duration
+	// RetryInterval is the delay between a failed run and the next one.
+	RetryInterval time.Duration
+	// Output receives every Report as a line of JSON.
+	Output io.Writer
+}
+
+// RunUntilPassed runs the checks until they all pass, then calls ready. It writes every
+// report to opts.Output and returns the passing report, or nil if ctx is done first.
+func RunUntilPassed(ctx context.Context, checks []Check, opts Options, ready func()) *Report {
+	if opts.RetryInterval <= 0 {
+		opts.RetryInterval = DefaultRetryInterval
+	}
+
+	for attempt := 1; ; attempt++ {
+		report := Run(ctx, checks, opts.CheckTimeout)
+		report.Attempt = attempt
+		writeReport(opts.Output, report)
+
+		if report.Passed {
+			ready()
+			return report
+		}
+		logging.Warnf("selftest: attempt %d failed: %v; retrying in %s", attempt, report.Failed(), opts.RetryInterval)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(opts.RetryInterval):
+		}
+	}
+}
+
+func writeReport(w io.Writer, report *Report) {
+	if w == nil {
+		return
+	}
+	line, err := json.Marshal(struct {
+		SelfTest *Report `json:"selftest"`
+	}{report})
+	if err != nil {
+		logging.Errorf("selftest: encode report: %v", err)
+		return
+	}
+	if _, err := w.Write(append(line, '\n')); err != nil {
+		logging.Warnf("selftest: write report: %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package selftest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/outbox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func passing(name, detail string) Check {
+	return Check{Name: name, Run: func(context.Context) (string, error) { return detail, nil }}
+}
+
+func failing(name string, err error) Check {
+	return Check{Name: name, Run: func(context.Context) (string, error) { return "", err }}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		checks     []Check
+		wantPassed bool
+		wantStatus []Status
+		wantFailed []string
+	}{
+		{
+			name:       "all passed",
+			checks:     []Check{passing("spanner", ""), passing("migrations", "8 migrations applied")},
+			wantPassed: true,
+			wantStatus: []Status{StatusPassed, StatusPassed},
+		},
+		{
+			name:       "skipped checks do not fail the run",
+			checks:     []Check{passing("spanner", ""), failing("kms_key", Skip("no KMS key configured"))},
+			wantPassed: true,
+			wantStatus: []Status{StatusPassed, StatusSkipped},
+		},
+		{
+			name:       "a failed check fails the run",
+			checks:     []Check{failing("spanner", errors.New("connection refused")), passing("kms_key", "")},
+			wantPassed: false,
+			wantStatus: []Status{StatusFailed, StatusPassed},
+			wantFailed: []string{"spanner"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := Run(context.Background(), tt.checks, time.Second)
+
+			assert.Equal(t, tt.wantPassed, report.Passed)
+			require.Len(t, report.Checks, len(tt.wantStatus))
+			for i, status := range tt.wantStatus {
+				assert.Equal(t, status, report.Checks[i].Status, report.Checks[i].Name)
+			}
+			assert.Equal(t, tt.wantFailed, report.Failed())
+		})
+	}
+}
+
+func TestRun_CheckTimeout(t *testing.T) {
+	slow := Check{Name: "pubsub_topic", Run: func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	}}
+
+	report := Run(context.Background(), []Check{slow}, 10*time.Millisecond)
+
+	assert.False(t, report.Passed)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks[0].Detail)
+}
+
+func TestRunUntilPassed(t *testing.T) {
+	attempts := 0
+	flaky := Check{Name: "spanner", Run: func(context.Context) (string, error) {
+		attempts++
+		if attempts < 3 {
+			return "", errors.New("connection refused")
+		}
+		return "", nil
+	}}
+	var out bytes.Buffer
+	ready := 0
+
+	report := RunUntilPassed(context.Background(), []Check{flaky}, Options{RetryInterval: time.Millisecond, Output: &out}, func() { ready++ })
+
+	require.NotNil(t, report)
+	assert.True(t, report.Passed)
+	assert.Equal(t, 3, report.Attempt)
+	assert.Equal(t, 1, ready)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	var first struct {
+		SelfTest Report `json:"selftest"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	assert.Equal(t, 1, first.SelfTest.Attempt)
+	assert.False(t, first.SelfTest.Passed)
+	assert.Equal(t, []Result{{Name: "spanner", Status: StatusFailed, Detail: "connection refused"}}, first.SelfTest.Checks)
+}
+
+func TestRunUntilPassed_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report := RunUntilPassed(ctx, []Check{failing("spanner", errors.New("connection refused"))}, Options{}, func() {
+		t.Error("ready called for a failed self-test")
+	})
+
+	assert.Nil(t, report)
+}
+
+func TestDependencyChecks_Skipped(t *testing.T) {
+	checks := []Check{
+		PubSubTopicCheck(outbox.PublisherNATS, outbox.PubSubOptions{Project: "test-project"}),
+		KMSKeyCheck(""),
+	}
+
+	report := Run(context.Background(), checks, time.Second)
+
+	assert.True(t, report.Passed)
+	for _, c := range report.Checks {
+		assert.Equal(t, StatusSkipped, c.Status, c.Name)
+	}
+}
@@ -0,0 +1,265 @@
+// Package shadow compares the effective prices served by two price readers, so that the
+// source of the prices can be switched once both are known to agree.
+//
+// The authoritative reader serves every request. A sample of the requests is also priced
+// by the shadow reader in the background; the two results are compared, each mismatch is
+// logged and the mismatch rate is published as the shadow_prices_mismatch_rate expvar.
+package shadow
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"math/big"
+	"math/rand/v2"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/logging"
+)
+
+// Price sources. SourceComputed prices products from their discounts on every read;
+// SourceProjected reads the prices from the effective_prices projection.
+const (
+	SourceComputed  = "computed"
+	SourceProjected = "projected"
+)
+
+// ErrUnknownSource is returned for a price source other than the Source constants.
+var ErrUnknownSource = errors.New("unknown price read source")
+
+// DefaultTimeout is the default timeout of a shadow read.
+const DefaultTimeout = 5 * time.Second
+
+// MaxInFlight is the most shadow reads run at once; sampled reads are skipped beyond it,
+// so that a slow shadow reader cannot pile up goroutines.
+const MaxInFlight = 16
+
+var (
+	comparedPrices   = expvar.NewInt("shadow_prices_compared")
+	mismatchedPrices = expvar.NewInt("shadow_prices_mismatched")
+	failedReads      = expvar.NewInt("shadow_reads_failed")
+	skippedReads     = expvar.NewInt("shadow_reads_skipped")
+)
+
+func init() {
+	expvar.Publish("shadow_prices_mismatch_rate", expvar.Func(func() any {
+		return mismatchRate(comparedPrices.Value(), mismatchedPrices.Value())
+	}))
+}
+
+// mismatchRate returns the share of compared products whose prices did not match.
+func mismatchRate(compared, mismatched int64) float64 {
+	if compared == 0 {
+		return 0
+	}
+	return float64(mismatched) / float64(compared)
+}
+
+// Fields compared between the authoritative and shadow prices of a product.
+const (
+	FieldPresence       = "presence"
+	FieldBasePrice      = "base_price"
+	FieldEffectivePrice = "effective_price"
+	FieldDiscount       = "discount"
+	FieldCurrency       = "currency"
+	FieldStatus         = "status"
+)
+
+// Mismatch is a field of a product priced differently by the authoritative and shadow
+// readers.
+type Mismatch struct {
+	ProductID     string
+	Field         string
+	Authoritative string
+	Shadow        string
+}
+
+// String describes the mismatch for logs.
+func (m Mismatch) String() string {
+	return fmt.Sprintf("product %s: %s is %s, shadow has %s", m.ProductID, m.Field, m.Authoritative, m.Shadow)
+}
+
+// Compare returns the mismatches between the authoritative and shadow prices of the same
+// products, ordered by product ID. Prices are compared as exact fractions, so 2000/100
+// matches 20/1.
+func Compare(authoritative, shadow []*contract.PriceDTO) []Mismatch {
+	byID := make(map[string]*contract.PriceDTO, len(shadow))
+	for _, p := range shadow {
+		byID[p.ProductID] = p
+	}
+
+	var mismatches []Mismatch
+	for _, a := range authoritative {
+		s, ok := byID[a.ProductID]
+		if !ok {
+			mismatches = append(mismatches, Mismatch{ProductID: a.ProductID, Field: FieldPresence, Authoritative: "present", Shadow: "missing"})
+			continue
+		}
+		delete(byID, a.ProductID)
+		mismatches = append(mismatches, comparePrice(a, s)...)
+	}
+	for id := range byID {
+		mismatches = append(mismatches, Mismatch{ProductID: id, Field: FieldPresence, Authoritative: "missing", Shadow: "present"})
+	}
+
+	sort.SliceStable(mismatches, func(i, j int) bool { return mismatches[i].ProductID < mismatches[j].ProductID })
+	return mismatches
+}
+
+// comparePrice returns the mismatches between two prices of the same product.
+func comparePrice(a, s *contract.PriceDTO) []Mismatch {
+	var mismatches []Mismatch
+	add := func(field, authoritative, shadow string) {
+		if authoritative != shadow {
+			mismatches = append(mismatches, Mismatch{ProductID: a.ProductID, Field: field, Authoritative: authoritative, Shadow: shadow})
+		}
+	}
+	add(FieldBasePrice, fraction(a.BasePriceNum, a.BasePriceDenom), fraction(s.BasePriceNum, s.BasePriceDenom))
+	add(FieldEffectivePrice, fraction(a.EffectivePriceNum, a.EffectivePriceDenom), fraction(s.EffectivePriceNum, s.EffectivePriceDenom))
+	add(FieldDiscount, discount(a), discount(s))
+	add(FieldCurrency, a.Currency, s.Currency)
+	add(FieldStatus, a.Status, s.Status)
+	return mismatches
+}
+
+// fraction formats a price in lowest terms.
+func fraction(numerator, denominator int64) string {
+	if denominator == 0 {
+		return fmt.Sprintf("%d/0", numerator)
+	}
+	return big.NewRat(numerator, denominator).String()
+}
+
+// discount formats the discount that applies to a price.
+func discount(p *contract.PriceDTO) string {
+	if !p.HasActiveDiscount || p.DiscountPercent == nil {
+		return "none"
+	}
+	return fmt.Sprintf("%g%%", *p.DiscountPercent)
+}
+
+// Option configures a ReadModel.
+type Option func(*ReadModel)
+
+// WithSampleRate sets the share of GetEffectivePrices calls, between 0 and 1, that are
+// also priced by the shadow reader. The default of 0 disables shadow reads.
+func WithSampleRate(rate float64) Option {
+	return func(rm *ReadModel) {
+		rm.sampleRate = rate
+	}
+}
+
+// WithTimeout sets the timeout of a shadow read; it defaults to DefaultTimeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(rm *ReadModel) {
+		rm.timeout = timeout
+	}
+}
+
+// ReadModel decorates a product read model to serve GetEffectivePrices from the
+// configured source, and to compare a sample of the calls with the other source. The
+// other reads go to the decorated read model.
+type ReadModel struct {
+	contract.ProductReadModel
+	authoritative contract.EffectivePriceReader
+	shadow        contract.EffectivePriceReader
+	sampleRate    float64
+	timeout       time.Duration
+	// sample returns a number in [0, 1) for each call; tests replace it.
+	sample   func() float64
+	inFlight chan struct{}
+	wg       sync.WaitGroup
+}
+
+var _ contract.ProductReadModel = (*ReadModel)(nil)
+
+// NewReadModel creates a ReadModel that serves effective prices from source: the prices
+// computed by next, or the projected ones. The other source is the shadow.
+func NewReadModel(next contract.ProductReadModel, projected contract.EffectivePriceReader, source string, opts ...Option) (*ReadModel, error) {
+	rm := &ReadModel{
+		ProductReadModel: next,
+		timeout:          DefaultTimeout,
+		sample:           rand.Float64,
+		inFlight:         make(chan struct{}, MaxInFlight),
+	}
+	switch source {
+	case SourceComputed:
+		rm.authoritative, rm.shadow = next, projected
+	case SourceProjected:
+		rm.authoritative, rm.shadow = projected, next
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSource, source)
+	}
+	for _, opt := range opts {
+		opt(rm)
+	}
+	return rm, nil
+}
+
+// GetEffectivePrices implements contract.ProductReadModel. The authoritative prices are
+// returned without waiting for the shadow read.
+func (rm *ReadModel) GetEffectivePrices(ctx context.Context, ids []string, at time.Time) ([]*contract.PriceDTO, error) {
+	prices, err := rm.authoritative.GetEffectivePrices(ctx, ids, at)
+	if err != nil || rm.sampleRate <= 0 || rm.sample() >= rm.sampleRate {
+		return prices, err
+	}
+
+	select {
+	case rm.inFlight <- struct{}{}:
+	default:
+		skippedReads.Add(1)
+		return prices, nil
+	}
+
+	// Callers may modify the prices they are returned, so the comparison gets a copy.
+	authoritative := make([]*contract.PriceDTO, len(prices))
+	for i, p := range prices {
+		price := *p
+		authoritative[i] = &price
+	}
+	rm.wg.Add(1)
+	go func() {
+		defer rm.wg.Done()
+		defer func() { <-rm.inFlight }()
+		rm.compare(context.WithoutCancel(ctx), ids, at, authoritative)
+	}()
+	return prices, nil
+}
+
+// Wait blocks until the shadow reads in flight are compared.
+func (rm *ReadModel) Wait() {
+	rm.wg.Wait()
+}
+
+// compare reads the shadow prices and records how they differ from the authoritative ones.
+func (rm *ReadModel) compare(ctx context.Context, ids []string, at time.Time, authoritative []*contract.PriceDTO) {
+	ctx, cancel := context.WithTimeout(ctx, rm.timeout)
+	defer cancel()
+
+	shadow, err := rm.shadow.GetEffectivePrices(ctx, ids, at)
+	if err != nil {
+		failedReads.Add(1)
+		logging.Warnf("Shadow price read of %d products failed: %v", len(ids), err)
+		return
+	}
+
+	mismatches := Compare(authoritative, shadow)
+	products := make(map[string]bool)
+	for _, p := range authoritative {
+		products[p.ProductID] = true
+	}
+	for _, p := range shadow {
+		products[p.ProductID] = true
+	}
+	mismatched := make(map[string]bool)
+	for _, m := range mismatches {
+		mismatched[m.ProductID] = true
+		logging.Warnf("Shadow price mismatch at %s: %s", at.Format(time.RFC3339), m)
+	}
+	comparedPrices.Add(int64(len(products)))
+	mismatchedPrices.Add(int64(len(mismatched)))
+}
@@ -0,0 +1,210 @@
+package shadow
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// priceReader is a contract.ProductReadModel that serves fixed prices and records the
+// calls it receives.
+type priceReader struct {
+	contract.ProductReadModel
+	prices []*contract.PriceDTO
+	err    error
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (r *priceReader) GetEffectivePrices(_ context.Context, _ []string, _ time.Time) ([]*contract.PriceDTO, error) {
+	r.mu.Lock()
+	r.calls++
+	r.mu.Unlock()
+	return r.prices, r.err
+}
+
+func (r *priceReader) callCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls
+}
+
+func price(id string, num, denom int64) *contract.PriceDTO {
+	return &contract.PriceDTO{
+		ProductID:           id,
+		BasePriceNum:        num,
+		BasePriceDenom:      denom,
+		EffectivePriceNum:   num,
+		EffectivePriceDenom: denom,
+		Currency:            "USD",
+		Status:              "active",
+	}
+}
+
+func discounted(p *contract.PriceDTO, pct float64, num, denom int64) *contract.PriceDTO {
+	p.HasActiveDiscount = true
+	p.DiscountPercent = &pct
+	p.EffectivePriceNum = num
+	p.EffectivePriceDenom = denom
+	return p
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name          string
+		authoritative []*contract.PriceDTO
+		shadow        []*contract.PriceDTO
+		want          []Mismatch
+	}{
+		{
+			name:          "equal fractions match",
+			authoritative: []*contract.PriceDTO{discounted(price("p1", 2000, 100), 10, 1800, 100)},
+			shadow:        []*contract.PriceDTO{discounted(price("p1", 20, 1), 10, 18, 1)},
+		},
+		{
+			name:          "different effective price",
+			authoritative: []*contract.PriceDTO{discounted(price("p1", 20, 1), 10, 18, 1)},
+			shadow:        []*contract.PriceDTO{price("p1", 20, 1)},
+			want: []Mismatch{
+				{ProductID: "p1", Field: FieldEffectivePrice, Authoritative: "18/1", Shadow: "20/1"},
+				{ProductID: "p1", Field: FieldDiscount, Authoritative: "10%", Shadow: "none"},
+			},
+		},
+		{
+			name:          "missing products",
+			authoritative: []*contract.PriceDTO{price("p1", 20, 1), price("p2", 20, 1)},
+			shadow:        []*contract.PriceDTO{price("p2", 20, 1), price("p3", 20, 1)},
+			want: []Mismatch{
+				{ProductID: "p1", Field: FieldPresence, Authoritative: "present", Shadow: "missing"},
+				{ProductID: "p3", Field: FieldPresence, Authoritative: "missing", Shadow: "present"},
+			},
+		},
+		{
+			name:          "different currency and status",
+			authoritative: []*contract.PriceDTO{price("p1", 20, 1)},
+			shadow: []*contract.PriceDTO{func() *contract.PriceDTO {
+				p := price("p1", 20, 1)
+				p.Currency = "EUR"
+				p.Status = "inactive"
+				return p
+			}()},
+			want: []Mismatch{
+				{ProductID: "p1", Field: FieldCurrency, Authoritative: "USD", Shadow: "EUR"},
+				{ProductID: "p1", Field: FieldStatus, Authoritative: "active", Shadow: "inactive"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Compare(tt.authoritative, tt.shadow))
+		})
+	}
+}
+
+func TestNewReadModel_UnknownSource(t *testing.T) {
+	_, err := NewReadModel(&priceReader{}, &priceReader{}, "cached")
+
+	assert.ErrorIs(t, err, ErrUnknownSource)
+}
+
+func TestReadModel_GetEffectivePrices(t *testing.T) {
+	computed := []*contract.PriceDTO{discounted(price("p1", 20, 1), 10, 18, 1)}
+	projected := []*contract.PriceDTO{price("p1", 20, 1)}
+
+	tests := []struct {
+		name           string
+		source         string
+		sampleRate     float64
+		shadowErr      error
+		wantPrices     []*contract.PriceDTO
+		wantShadowRead bool
+		wantCompared   int64
+		wantMismatched int64
+		wantFailed     int64
+	}{
+		{
+			name:       "computed without shadow reads",
+			source:     SourceComputed,
+			wantPrices: computed,
+		},
+		{
+			name:           "computed with projected shadow",
+			source:         SourceComputed,
+			sampleRate:     1,
+			wantPrices:     computed,
+			wantShadowRead: true,
+			wantCompared:   1,
+			wantMismatched: 1,
+		},
+		{
+			name:           "projected with computed shadow",
+			source:         SourceProjected,
+			sampleRate:     1,
+			wantPrices:     projected,
+			wantShadowRead: true,
+			wantCompared:   1,
+			wantMismatched: 1,
+		},
+		{
+			name:           "failed shadow read is counted",
+			source:         SourceComputed,
+			sampleRate:     1,
+			shadowErr:      errors.New("spanner unavailable"),
+			wantPrices:     computed,
+			wantShadowRead: true,
+			wantFailed:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &priceReader{prices: computed}
+			projection := &priceReader{prices: projected}
+			shadowReader := projection
+			if tt.source == SourceProjected {
+				shadowReader = next
+			}
+			shadowReader.err = tt.shadowErr
+			rm, err := NewReadModel(next, projection, tt.source, WithSampleRate(tt.sampleRate))
+			require.NoError(t, err)
+			compared, mismatched, failed := comparedPrices.Value(), mismatchedPrices.Value(), failedReads.Value()
+
+			prices, err := rm.GetEffectivePrices(context.Background(), []string{"p1"}, time.Now())
+			rm.Wait()
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPrices, prices)
+			assert.Equal(t, tt.wantShadowRead, shadowReader.callCount() == 1)
+			assert.Equal(t, tt.wantCompared, comparedPrices.Value()-compared)
+			assert.Equal(t, tt.wantMismatched, mismatchedPrices.Value()-mismatched)
+			assert.Equal(t, tt.wantFailed, failedReads.Value()-failed)
+		})
+	}
+}
+
+func TestReadModel_GetEffectivePrices_NotSampled(t *testing.T) {
+	next := &priceReader{prices: []*contract.PriceDTO{price("p1", 20, 1)}}
+	projection := &priceReader{}
+	rm, err := NewReadModel(next, projection, SourceComputed, WithSampleRate(0.25))
+	require.NoError(t, err)
+	rm.sample = func() float64 { return 0.5 }
+
+	_, err = rm.GetEffectivePrices(context.Background(), []string{"p1"}, time.Now())
+	rm.Wait()
+
+	require.NoError(t, err)
+	assert.Zero(t, projection.callCount())
+}
+
+func TestMismatchRate(t *testing.T) {
+	assert.Zero(t, mismatchRate(0, 0))
+	assert.InDelta(t, 0.25, mismatchRate(8, 2), 1e-9)
+}
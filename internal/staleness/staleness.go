@@ -0,0 +1,71 @@
+// Package staleness carries how stale the catalog reads of a request may be.
+//
+// Strong reads see every committed change but must be served by the leader replica of the
+// data, or a replica that caught up with it. Stale reads are served by the nearest replica
+// without waiting, which lowers latency and leader load for read-heavy traffic, at the cost
+// of missing the changes of the last few seconds. A bound is written "strong", a duration
+// such as "15s" (at most that stale, at the newest timestamp the replica can serve) or
+// "exact:15s" (exactly that stale, for reads that must agree with each other).
+package staleness
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// Max is the largest staleness: Spanner keeps old versions of the data for an hour.
+const Max = time.Hour
+
+// Bound is how stale the reads of a request may be.
+type Bound struct {
+	// Staleness is how far in the past reads may be; zero reads strongly.
+	Staleness time.Duration
+	// Exact reads exactly Staleness in the past rather than at most.
+	Exact bool
+}
+
+// Strong is the bound of strong reads.
+var Strong = Bound{}
+
+// Parse parses a bound written as in the package documentation. It fails with
+// domain.ErrInvalidReadStaleness if the bound is malformed, negative or more than Max.
+func Parse(value string) (Bound, error) {
+	if value == "strong" {
+		return Strong, nil
+	}
+	rest, exact := strings.CutPrefix(value, "exact:")
+	d, err := time.ParseDuration(rest)
+	if err != nil || d < 0 || d > Max {
+		return Bound{}, domain.ErrInvalidReadStaleness
+	}
+	return Bound{Staleness: d, Exact: exact && d > 0}, nil
+}
+
+// String returns the bound as Parse reads it.
+func (b Bound) String() string {
+	switch {
+	case b.Staleness == 0:
+		return "strong"
+	case b.Exact:
+		return "exact:" + b.Staleness.String()
+	default:
+		return b.Staleness.String()
+	}
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx whose reads are within b, overriding the default of
+// the read model.
+func NewContext(ctx context.Context, b Bound) context.Context {
+	return context.WithValue(ctx, contextKey{}, b)
+}
+
+// FromContext returns the bound carried by ctx and whether it carries one.
+func FromContext(ctx context.Context) (Bound, bool) {
+	b, ok := ctx.Value(contextKey{}).(Bound)
+	return b, ok
+}
@@ -0,0 +1,54 @@
+package staleness
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Bound
+		wantErr bool
+	}{
+		{"strong", Strong, false},
+		{"0s", Strong, false},
+		{"exact:0s", Strong, false},
+		{"15s", Bound{Staleness: 15 * time.Second}, false},
+		{"exact:15s", Bound{Staleness: 15 * time.Second, Exact: true}, false},
+		{"1h", Bound{Staleness: time.Hour}, false},
+		{"", Bound{}, true},
+		{"61m", Bound{}, true},
+		{"-5s", Bound{}, true},
+		{"exact", Bound{}, true},
+		{"bounded:15s", Bound{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, domain.ErrInvalidReadStaleness)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			roundTrip, err := Parse(got.String())
+			assert.NoError(t, err)
+			assert.Equal(t, got, roundTrip)
+		})
+	}
+}
+
+func TestContext(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	b := Bound{Staleness: 10 * time.Second}
+	got, ok := FromContext(NewContext(context.Background(), b))
+	assert.True(t, ok)
+	assert.Equal(t, b, got)
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ErrAttachmentsDisabled is returned by ManageAttachments when no attachment repository
+// was configured with WithAttachments.
+var ErrAttachmentsDisabled = errors.New("product attachments are not enabled")
+
+// AttachmentInput represents one support document of a product in a
+// ManageAttachmentsRequest. Locale is empty for a document that is not written in any one
+// language.
+type AttachmentInput struct {
+	Type   string
+	URL    string
+	Locale string
+}
+
+// ManageAttachmentsRequest represents the input for replacing the attachments of a
+// product, in display order. No attachments removes them all.
+type ManageAttachmentsRequest struct {
+	ProductID   string
+	Attachments []AttachmentInput
+}
+
+// ManageAttachments replaces the attachments of a product that is not archived.
+func (uc *ProductUseCases) ManageAttachments(ctx context.Context, req ManageAttachmentsRequest) error {
+	if uc.attachments == nil {
+		return ErrAttachmentsDisabled
+	}
+	attachments, err := productAttachments(req.Attachments)
+	if err != nil {
+		return err
+	}
+
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+	if product.Status() == domain.ProductStatusArchived {
+		return domain.ErrProductArchived
+	}
+	current, err := uc.attachments.FindByProductID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := current.Set(attachments, now); err != nil {
+		return err
+	}
+	if len(current.DomainEvents()) == 0 {
+		return nil
+	}
+
+	plan := committer.NewPlanFor("ManageAttachments")
+	plan.AddAll(uc.attachments.SaveMuts(current)...)
+	for _, event := range current.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+	}
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	if uc.publisher != nil {
+		uc.publisher.Publish(ctx, current.DomainEvents()...)
+	}
+	return nil
+}
+
+// productAttachments converts the attachments of a request to domain attachments.
+func productAttachments(inputs []AttachmentInput) ([]*domain.ProductAttachment, error) {
+	attachments := make([]*domain.ProductAttachment, len(inputs))
+	for i, input := range inputs {
+		attachment, err := domain.NewProductAttachment(domain.AttachmentType(input.Type), input.URL, input.Locale)
+		if err != nil {
+			return nil, err
+		}
+		attachments[i] = attachment
+	}
+	return attachments, nil
+}
+
+// ValidateManageAttachmentsRequest validates the manage attachments request.
+func ValidateManageAttachmentsRequest(req ManageAttachmentsRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	attachments, err := productAttachments(req.Attachments)
+	if err != nil {
+		return err
+	}
+	return domain.NewProductAttachments(req.ProductID).Set(attachments, time.Time{})
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateManageAttachmentsRequest(t *testing.T) {
+	manual := AttachmentInput{Type: "manual", URL: "https://docs.example.com/manual.pdf", Locale: "de"}
+	sheet := AttachmentInput{Type: "spec_sheet", URL: "https://docs.example.com/sheet.pdf"}
+
+	tests := []struct {
+		name    string
+		req     ManageAttachmentsRequest
+		wantErr error
+	}{
+		{"valid", ManageAttachmentsRequest{ProductID: "product-1", Attachments: []AttachmentInput{manual, sheet}}, nil},
+		{"remove all", ManageAttachmentsRequest{ProductID: "product-1"}, nil},
+		{"missing product ID", ManageAttachmentsRequest{Attachments: []AttachmentInput{manual}}, domain.ErrInvalidID},
+		{"unknown type", ManageAttachmentsRequest{ProductID: "product-1", Attachments: []AttachmentInput{
+			{Type: "brochure", URL: manual.URL},
+		}}, domain.ErrInvalidAttachmentType},
+		{"relative URL", ManageAttachmentsRequest{ProductID: "product-1", Attachments: []AttachmentInput{
+			{Type: "manual", URL: "/manual.pdf"},
+		}}, domain.ErrInvalidAttachmentURL},
+		{"invalid locale", ManageAttachmentsRequest{ProductID: "product-1", Attachments: []AttachmentInput{
+			{Type: "manual", URL: manual.URL, Locale: "german"},
+		}}, domain.ErrInvalidLocale},
+		{"duplicate URL", ManageAttachmentsRequest{ProductID: "product-1", Attachments: []AttachmentInput{manual, manual}}, domain.ErrDuplicateAttachment},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateManageAttachmentsRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
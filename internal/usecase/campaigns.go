@@ -0,0 +1,352 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// CampaignBatchSize is the number of products whose discount a campaign changes in one
+// transaction.
+const CampaignBatchSize = 100
+
+// ErrCampaignsDisabled is returned by the campaign use cases when no campaign repository
+// was configured with WithCampaigns.
+var ErrCampaignsDisabled = errors.New("campaigns are not enabled")
+
+// CreateCampaignRequest represents the input for creating a campaign. Exactly one of
+// Category and ProductIDs is set.
+type CreateCampaignRequest struct {
+	Name               string
+	Category           string
+	ProductIDs         []string
+	DiscountPercentage float64
+	Priority           int
+	StartDate          time.Time
+	EndDate            time.Time
+}
+
+// CreateCampaignResponse represents the output of creating a campaign.
+type CreateCampaignResponse struct {
+	CampaignID string
+}
+
+// ActivateCampaignRequest represents the input for activating a campaign.
+type ActivateCampaignRequest struct {
+	CampaignID string
+}
+
+// SkippedProduct is a targeted product the discount of a campaign or category was not
+// applied to.
+type SkippedProduct struct {
+	ProductID string
+	Reason    string
+}
+
+// ActivateCampaignResponse represents the output of activating a campaign.
+type ActivateCampaignResponse struct {
+	AppliedCount int
+	Skipped      []SkippedProduct
+}
+
+// EndCampaignRequest represents the input for ending a campaign.
+type EndCampaignRequest struct {
+	CampaignID string
+}
+
+// EndCampaignResponse represents the output of ending a campaign.
+type EndCampaignResponse struct {
+	RemovedCount int
+}
+
+// CreateCampaign creates a draft campaign. Its discount is not applied until the
+// campaign is activated.
+func (uc *ProductUseCases) CreateCampaign(ctx context.Context, req CreateCampaignRequest) (*CreateCampaignResponse, error) {
+	if uc.campaigns == nil {
+		return nil, ErrCampaignsDisabled
+	}
+
+	now := uc.clock.Now()
+	campaign, err := domain.NewCampaign(uc.ids.NewID(), req.Name, req.Category, req.ProductIDs,
+		domain.PercentageFromFloat(req.DiscountPercentage), req.Priority, req.StartDate, req.EndDate, now)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, _ := caller.Tenant(ctx)
+	if err := campaign.AssignTenant(tenantID); err != nil {
+		return nil, err
+	}
+
+	plan := committer.NewPlanFor("CreateCampaign")
+	plan.Add(uc.campaigns.InsertMut(campaign))
+	if err := uc.addCampaignEvents(plan, campaign); err != nil {
+		return nil, err
+	}
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	uc.publishCampaignEvents(ctx, campaign)
+	return &CreateCampaignResponse{CampaignID: campaign.ID()}, nil
+}
+
+// ActivateCampaign applies the discount of a draft campaign to every targeted product, in
+// transactions of CampaignBatchSize products, and then marks the campaign active.
+// Products the discount cannot be applied to, e.g. inactive products or products with a
+// discount of the same priority or in a category with a discount blackout, are skipped
+// and reported.
+//
+// If a batch fails, the products of the earlier batches keep the discount and the
+// campaign stays a draft; activating it again skips the products that already have it.
+func (uc *ProductUseCases) ActivateCampaign(ctx context.Context, req ActivateCampaignRequest) (*ActivateCampaignResponse, error) {
+	if uc.campaigns == nil {
+		return nil, ErrCampaignsDisabled
+	}
+
+	campaign, err := uc.campaigns.FindByID(ctx, req.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	if campaign.Status() != domain.CampaignStatusDraft {
+		return nil, domain.ErrCampaignNotDraft
+	}
+
+	now := uc.clock.Now()
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return nil, err
+	}
+	if campaign.Discount().IsExpired(now) {
+		return nil, domain.ErrInvalidDiscountPeriod
+	}
+
+	blackouts, err := uc.discountBlackouts(ctx, campaign.Discount())
+	if err != nil {
+		return nil, err
+	}
+
+	productIDs := campaign.ProductIDs()
+	if campaign.Category() != "" {
+		if productIDs, err = uc.repo.FindIDsByCategory(ctx, campaign.Category()); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &ActivateCampaignResponse{}
+	err = uc.changeProductDiscounts(ctx, "ActivateCampaign", productIDs, now, func(product *domain.Product) (bool, error) {
+		if product.FindDiscount(campaign.ID()) != nil {
+			// Applied by an earlier, interrupted activation.
+			resp.AppliedCount++
+			return false, nil
+		}
+		if err := blackoutError(blackouts, product.Category(), campaign.Discount()); err != nil {
+			resp.Skipped = append(resp.Skipped, SkippedProduct{ProductID: product.ID(), Reason: err.Error()})
+			return false, nil
+		}
+		if err := uc.checkMargin(product, campaign.Discount()); err != nil {
+			resp.Skipped = append(resp.Skipped, SkippedProduct{ProductID: product.ID(), Reason: err.Error()})
+			return false, nil
+		}
+		if err := product.ApplyDiscount(campaign.Discount(), now); err != nil {
+			resp.Skipped = append(resp.Skipped, SkippedProduct{ProductID: product.ID(), Reason: err.Error()})
+			return false, nil
+		}
+		resp.AppliedCount++
+		return true, nil
+	}, func(id string) {
+		resp.Skipped = append(resp.Skipped, SkippedProduct{ProductID: id, Reason: domain.ErrProductNotFound.Error()})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := campaign.Activate(resp.AppliedCount, len(resp.Skipped), now); err != nil {
+		return nil, err
+	}
+	if err := uc.commitCampaignStatus(ctx, "ActivateCampaign", campaign); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// EndCampaign removes the discount of an active campaign from every product that holds
+// it, in transactions of CampaignBatchSize products, and then marks the campaign ended.
+// Like ActivateCampaign, it can be retried after a failed batch.
+func (uc *ProductUseCases) EndCampaign(ctx context.Context, req EndCampaignRequest) (*EndCampaignResponse, error) {
+	if uc.campaigns == nil {
+		return nil, ErrCampaignsDisabled
+	}
+
+	campaign, err := uc.campaigns.FindByID(ctx, req.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+	if campaign.Status() != domain.CampaignStatusActive {
+		return nil, domain.ErrCampaignNotActive
+	}
+
+	now := uc.clock.Now()
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return nil, err
+	}
+
+	// The products are found by their discount rather than by the campaign target, so
+	// that products moved out of the campaign category lose the discount too.
+	productIDs, err := uc.repo.FindIDsByDiscountID(ctx, campaign.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &EndCampaignResponse{}
+	err = uc.changeProductDiscounts(ctx, "EndCampaign", productIDs, now, func(product *domain.Product) (bool, error) {
+		err := product.RemoveDiscount(campaign.ID(), now)
+		switch {
+		case err == nil:
+			resp.RemovedCount++
+			return true, nil
+		case errors.Is(err, domain.ErrDiscountNotFound), errors.Is(err, domain.ErrNoDiscountToRemove),
+			errors.Is(err, domain.ErrProductArchived):
+			return false, nil
+		default:
+			return false, err
+		}
+	}, func(string) {})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := campaign.End(resp.RemovedCount, now); err != nil {
+		return nil, err
+	}
+	if err := uc.commitCampaignStatus(ctx, "EndCampaign", campaign); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// changeProductDiscounts calls change for each of the products, CampaignBatchSize at a
+// time, and commits the changes of each batch in one transaction of the use case. change
+// reports whether it changed the product; notFound is called for products that do not
+// exist.
+func (uc *ProductUseCases) changeProductDiscounts(ctx context.Context, useCase string, productIDs []string, now time.Time,
+	change func(*domain.Product) (bool, error), notFound func(id string)) error {
+	for start := 0; start < len(productIDs); start += CampaignBatchSize {
+		end := min(start+CampaignBatchSize, len(productIDs))
+
+		plan := committer.NewPlanFor(useCase)
+		var changed []*domain.Product
+		for _, id := range productIDs[start:end] {
+			product, err := uc.repo.FindByID(ctx, id)
+			if errors.Is(err, domain.ErrProductNotFound) {
+				notFound(id)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			ok, err := change(product)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			if mut := uc.repo.UpdateMut(product); mut != nil {
+				plan.Add(mut)
+			}
+			plan.Add(uc.repo.RevisionMut(product, now))
+			plan.Add(uc.repo.SummaryMut(product, now))
+			plan.AddAll(uc.repo.DiscountMuts(product)...)
+			plan.AddAll(uc.repo.EffectivePriceMuts(product)...)
+			plan.Add(uc.repo.PriceHistoryMut(product, now))
+
+			for _, event := range product.DomainEvents() {
+				mut, err := uc.eventMut(event, product, now)
+				if err != nil {
+					return err
+				}
+				plan.Add(mut)
+
+				muts, err := uc.notificationMuts(ctx, event, product)
+				if err != nil {
+					return err
+				}
+				plan.AddAll(muts...)
+			}
+			changed = append(changed, product)
+		}
+
+		if !plan.IsEmpty() {
+			if err := uc.committer.Apply(ctx, plan); err != nil {
+				return err
+			}
+		}
+		for _, product := range changed {
+			uc.publishEvents(ctx, product)
+		}
+	}
+	return nil
+}
+
+// commitCampaignStatus persists the status of a campaign along with its pending events in
+// a transaction of the use case.
+func (uc *ProductUseCases) commitCampaignStatus(ctx context.Context, useCase string, campaign *domain.Campaign) error {
+	plan := committer.NewPlanFor(useCase)
+	plan.Add(uc.campaigns.UpdateStatusMut(campaign))
+	if err := uc.addCampaignEvents(plan, campaign); err != nil {
+		return err
+	}
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	uc.publishCampaignEvents(ctx, campaign)
+	return nil
+}
+
+// addCampaignEvents adds the outbox mutations of the events raised by campaign to plan.
+func (uc *ProductUseCases) addCampaignEvents(plan *committer.Plan, campaign *domain.Campaign) error {
+	for _, event := range campaign.DomainEvents() {
+		mut, err := uc.outboxRepo.InsertTenantEventMut(campaign.TenantID(), event)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+	}
+	return nil
+}
+
+// publishCampaignEvents hands the events raised by campaign to the in-process publisher,
+// if any. It must only be called after the command has been committed.
+func (uc *ProductUseCases) publishCampaignEvents(ctx context.Context, campaign *domain.Campaign) {
+	if uc.publisher == nil {
+		return
+	}
+	if events := campaign.DomainEvents(); len(events) > 0 {
+		uc.publisher.Publish(ctx, events...)
+	}
+}
+
+// ValidateCreateCampaignRequest validates the create campaign request.
+func ValidateCreateCampaignRequest(req CreateCampaignRequest) error {
+	if req.Name == "" {
+		return domain.ErrInvalidCampaignName
+	}
+	if (req.Category == "") == (len(req.ProductIDs) == 0) || len(req.ProductIDs) > domain.MaxCampaignProducts {
+		return domain.ErrInvalidCampaignTarget
+	}
+	if req.DiscountPercentage <= 0 || req.DiscountPercentage > 100 {
+		return domain.ErrInvalidDiscountPercentage
+	}
+	if req.Priority < 0 || req.Priority > domain.MaxDiscountPriority {
+		return domain.ErrInvalidDiscountPriority
+	}
+	if !req.EndDate.After(req.StartDate) {
+		return domain.ErrInvalidDiscountPeriod
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCreateCampaignRequest(t *testing.T) {
+	now := time.Now()
+	valid := func(modify func(*CreateCampaignRequest)) CreateCampaignRequest {
+		req := CreateCampaignRequest{
+			Name:               "Black Friday",
+			Category:           "electronics",
+			DiscountPercentage: 20,
+			Priority:           10,
+			StartDate:          now,
+			EndDate:            now.AddDate(0, 0, 3),
+		}
+		modify(&req)
+		return req
+	}
+
+	tests := []struct {
+		name    string
+		req     CreateCampaignRequest
+		wantErr error
+	}{
+		{"category", valid(func(*CreateCampaignRequest) {}), nil},
+		{"products", valid(func(r *CreateCampaignRequest) { r.Category, r.ProductIDs = "", []string{"p1", "p2"} }), nil},
+		{"missing name", valid(func(r *CreateCampaignRequest) { r.Name = "" }), domain.ErrInvalidCampaignName},
+		{"no target", valid(func(r *CreateCampaignRequest) { r.Category = "" }), domain.ErrInvalidCampaignTarget},
+		{"both targets", valid(func(r *CreateCampaignRequest) { r.ProductIDs = []string{"p1"} }), domain.ErrInvalidCampaignTarget},
+		{"too many products", valid(func(r *CreateCampaignRequest) {
+			r.Category, r.ProductIDs = "", make([]string, domain.MaxCampaignProducts+1)
+		}), domain.ErrInvalidCampaignTarget},
+		{"zero percentage", valid(func(r *CreateCampaignRequest) { r.DiscountPercentage = 0 }), domain.ErrInvalidDiscountPercentage},
+		{"priority too high", valid(func(r *CreateCampaignRequest) { r.Priority = domain.MaxDiscountPriority + 1 }), domain.ErrInvalidDiscountPriority},
+		{"end before start", valid(func(r *CreateCampaignRequest) { r.EndDate = now.Add(-time.Hour) }), domain.ErrInvalidDiscountPeriod},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreateCampaignRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
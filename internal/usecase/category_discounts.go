@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// CategoryDiscountPageSize is the number of products of a category ApplyDiscountToCategory
+// reads, and changes in one transaction, at a time.
+const CategoryDiscountPageSize = CampaignBatchSize
+
+// ApplyDiscountToCategoryRequest represents the input for applying a discount to every
+// active product of a category.
+type ApplyDiscountToCategoryRequest struct {
+	Category           string
+	DiscountPercentage float64
+	Priority           int
+	StartDate          time.Time
+	EndDate            time.Time
+}
+
+// ApplyDiscountToCategoryResponse represents the output of applying a discount to a
+// category. Every product the discount was applied to holds it under DiscountID.
+// PendingApproval is set if the discount exceeds the approval threshold and does not
+// apply until approved on each product.
+type ApplyDiscountToCategoryResponse struct {
+	DiscountID      string
+	PendingApproval bool
+	AppliedCount    int
+	Skipped         []SkippedProduct
+}
+
+// ApplyDiscountToCategory applies the same discount to every active product of a
+// category, paging through the category CategoryDiscountPageSize products at a time and
+// committing each page in one transaction. Products the discount cannot be applied to,
+// e.g. products with a discount of the same priority or below their minimum price, are
+// skipped and reported. Like ApplyDiscount, a discount above the approval threshold is
+// held pending approval, and a discount blacked out for the category is refused.
+//
+// If a page fails, the products of the earlier pages keep the discount. Unlike a
+// campaign, a retry applies a new discount, so those products are reported as skipped
+// if it overlaps the first one.
+func (uc *ProductUseCases) ApplyDiscountToCategory(ctx context.Context, req ApplyDiscountToCategoryRequest) (*ApplyDiscountToCategoryResponse, error) {
+	if req.Category == "" {
+		return nil, domain.ErrInvalidProductCategory
+	}
+	if req.Priority < 0 || req.Priority > domain.MaxDiscountPriority {
+		return nil, domain.ErrInvalidDiscountPriority
+	}
+	discount, err := domain.NewDiscount(domain.PercentageFromFloat(req.DiscountPercentage), req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	discount = discount.WithID(uc.ids.NewID()).WithPriority(req.Priority)
+	if discount.ExceedsApprovalThreshold(uc.approvalThreshold) {
+		discount = discount.RequireApproval()
+	}
+
+	now := uc.clock.Now()
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return nil, err
+	}
+	if discount.IsExpired(now) {
+		return nil, domain.ErrInvalidDiscountPeriod
+	}
+	blackouts, err := uc.discountBlackouts(ctx, discount)
+	if err != nil {
+		return nil, err
+	}
+	if err := blackoutError(blackouts, req.Category, discount); err != nil {
+		return nil, err
+	}
+
+	resp := &ApplyDiscountToCategoryResponse{DiscountID: discount.ID(), PendingApproval: discount.IsPendingApproval()}
+	skip := func(id string, err error) {
+		resp.Skipped = append(resp.Skipped, SkippedProduct{ProductID: id, Reason: err.Error()})
+	}
+	afterID := ""
+	for {
+		productIDs, err := uc.repo.FindActiveIDsByCategory(ctx, req.Category, afterID, CategoryDiscountPageSize)
+		if err != nil {
+			return nil, err
+		}
+		err = uc.changeProductDiscounts(ctx, "ApplyDiscountToCategory", productIDs, now, func(product *domain.Product) (bool, error) {
+			if err := uc.checkMargin(product, discount); err != nil {
+				skip(product.ID(), err)
+				return false, nil
+			}
+			if err := product.ApplyDiscount(discount, now); err != nil {
+				skip(product.ID(), err)
+				return false, nil
+			}
+			resp.AppliedCount++
+			return true, nil
+		}, func(id string) {
+			skip(id, domain.ErrProductNotFound)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(productIDs) < CategoryDiscountPageSize {
+			return resp, nil
+		}
+		afterID = productIDs[len(productIDs)-1]
+	}
+}
+
+// ValidateApplyDiscountToCategoryRequest validates the apply discount to category request.
+func ValidateApplyDiscountToCategoryRequest(req ApplyDiscountToCategoryRequest) error {
+	if req.Category == "" {
+		return domain.ErrInvalidProductCategory
+	}
+	if req.DiscountPercentage <= 0 || req.DiscountPercentage > 100 {
+		return domain.ErrInvalidDiscountPercentage
+	}
+	if req.Priority < 0 || req.Priority > domain.MaxDiscountPriority {
+		return domain.ErrInvalidDiscountPriority
+	}
+	if !req.EndDate.After(req.StartDate) {
+		return domain.ErrInvalidDiscountPeriod
+	}
+	return nil
+}
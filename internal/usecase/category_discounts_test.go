@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateApplyDiscountToCategoryRequest(t *testing.T) {
+	now := time.Now()
+	valid := func(modify func(*ApplyDiscountToCategoryRequest)) ApplyDiscountToCategoryRequest {
+		req := ApplyDiscountToCategoryRequest{
+			Category:           "electronics",
+			DiscountPercentage: 15,
+			Priority:           10,
+			StartDate:          now,
+			EndDate:            now.AddDate(0, 0, 3),
+		}
+		modify(&req)
+		return req
+	}
+
+	tests := []struct {
+		name    string
+		req     ApplyDiscountToCategoryRequest
+		wantErr error
+	}{
+		{"valid", valid(func(*ApplyDiscountToCategoryRequest) {}), nil},
+		{"missing category", valid(func(r *ApplyDiscountToCategoryRequest) { r.Category = "" }), domain.ErrInvalidProductCategory},
+		{"zero percentage", valid(func(r *ApplyDiscountToCategoryRequest) { r.DiscountPercentage = 0 }), domain.ErrInvalidDiscountPercentage},
+		{"percentage over 100", valid(func(r *ApplyDiscountToCategoryRequest) { r.DiscountPercentage = 101 }), domain.ErrInvalidDiscountPercentage},
+		{"priority too high", valid(func(r *ApplyDiscountToCategoryRequest) { r.Priority = domain.MaxDiscountPriority + 1 }), domain.ErrInvalidDiscountPriority},
+		{"end before start", valid(func(r *ApplyDiscountToCategoryRequest) { r.EndDate = now.Add(-time.Hour) }), domain.ErrInvalidDiscountPeriod},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateApplyDiscountToCategoryRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
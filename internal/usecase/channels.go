@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// SetChannelAvailabilityRequest represents the input for making a product available on,
+// or withholding it from, sales channels: "web", "retail" or "marketplace". Channels
+// left out of Channels keep their availability.
+type SetChannelAvailabilityRequest struct {
+	ProductID string
+	Channels  map[string]bool
+}
+
+// SetChannelAvailability sets the availability of a product that is not archived on the
+// given sales channels.
+func (uc *ProductUseCases) SetChannelAvailability(ctx context.Context, req SetChannelAvailabilityRequest) error {
+	availability, err := parseChannelAvailability(req.Channels)
+	if err != nil {
+		return err
+	}
+
+	return uc.changeProduct(ctx, "SetChannelAvailability", req.ProductID, func(product *domain.Product, now time.Time) error {
+		return product.SetChannelAvailability(availability, now)
+	})
+}
+
+// parseChannelAvailability validates the sales channels of a request.
+func parseChannelAvailability(channels map[string]bool) (map[domain.SalesChannel]bool, error) {
+	availability := make(map[domain.SalesChannel]bool, len(channels))
+	for name, available := range channels {
+		channel, err := domain.ParseSalesChannel(name)
+		if err != nil {
+			return nil, err
+		}
+		availability[channel] = available
+	}
+	return availability, nil
+}
+
+// ValidateSetChannelAvailabilityRequest validates the set channel availability request.
+func ValidateSetChannelAvailabilityRequest(req SetChannelAvailabilityRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	_, err := parseChannelAvailability(req.Channels)
+	return err
+}
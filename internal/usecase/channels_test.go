@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSetChannelAvailabilityRequest(t *testing.T) {
+	assert.NoError(t, ValidateSetChannelAvailabilityRequest(SetChannelAvailabilityRequest{ProductID: "product-1", Channels: map[string]bool{"web": true, "retail": false}}))
+	assert.NoError(t, ValidateSetChannelAvailabilityRequest(SetChannelAvailabilityRequest{ProductID: "product-1"}), "no channels changes nothing")
+	assert.ErrorIs(t, ValidateSetChannelAvailabilityRequest(SetChannelAvailabilityRequest{Channels: map[string]bool{"web": true}}), domain.ErrInvalidID)
+	assert.ErrorIs(t, ValidateSetChannelAvailabilityRequest(SetChannelAvailabilityRequest{ProductID: "product-1", Channels: map[string]bool{"kiosk": true}}), domain.ErrInvalidSalesChannel)
+	assert.ErrorIs(t, ValidateSetChannelAvailabilityRequest(SetChannelAvailabilityRequest{ProductID: "product-1", Channels: map[string]bool{"Web": true}}), domain.ErrInvalidSalesChannel)
+}
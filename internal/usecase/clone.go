@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// CloneProductRequest represents the input for cloning a product.
+type CloneProductRequest struct {
+	ProductID string
+}
+
+// CloneProduct creates a new draft product from the name, description, category, base
+// price and attributes of a product, named with a " (copy)" suffix; see
+// domain.Product.Clone. The clone gets its own ID and a slug built from its name, as
+// CreateProduct gives them. The response is that of CreateProduct.
+func (uc *ProductUseCases) CloneProduct(ctx context.Context, req CloneProductRequest) (*CreateProductResponse, error) {
+	source, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	product, err := source.Clone(uc.ids.NewID(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.insertProduct(ctx, "CloneProduct", product, now); err != nil {
+		return nil, err
+	}
+	return &CreateProductResponse{ProductID: product.ID(), Slug: product.Slug()}, nil
+}
+
+// ValidateCloneProductRequest validates the clone product request.
+func ValidateCloneProductRequest(req CloneProductRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	return nil
+}
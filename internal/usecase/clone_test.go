@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCloneProductRequest(t *testing.T) {
+	assert.NoError(t, ValidateCloneProductRequest(CloneProductRequest{ProductID: "product-1"}))
+	assert.ErrorIs(t, ValidateCloneProductRequest(CloneProductRequest{}), domain.ErrInvalidID)
+}
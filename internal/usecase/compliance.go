@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ComplianceInput represents the compliance flags of a product in a request. MinimumAge
+// is 0 if the product is not age-restricted; RestrictedMarkets are market codes such as
+// "UK".
+type ComplianceInput struct {
+	MinimumAge        int
+	Hazardous         bool
+	RestrictedMarkets []string
+}
+
+// SetComplianceRequest represents the input for replacing the compliance flags of a
+// product. A nil Compliance clears them.
+type SetComplianceRequest struct {
+	ProductID  string
+	Compliance *ComplianceInput
+}
+
+// SetCompliance replaces the compliance flags of a product that is not archived. They
+// are checked when the product is activated, and right away if it is active.
+func (uc *ProductUseCases) SetCompliance(ctx context.Context, req SetComplianceRequest) error {
+	compliance, err := parseCompliance(req.Compliance)
+	if err != nil {
+		return err
+	}
+
+	return uc.changeProduct(ctx, "SetCompliance", req.ProductID, func(product *domain.Product, now time.Time) error {
+		if err := product.ChangeCompliance(compliance, now); err != nil {
+			return err
+		}
+		return uc.checkCompliance(product)
+	})
+}
+
+// parseCompliance validates optional compliance flags.
+func parseCompliance(input *ComplianceInput) (*domain.Compliance, error) {
+	if input == nil {
+		return nil, nil
+	}
+	markets := make([]domain.Market, len(input.RestrictedMarkets))
+	for i, code := range input.RestrictedMarkets {
+		market, err := domain.ParseMarket(code)
+		if err != nil {
+			return nil, domain.ErrInvalidCompliance
+		}
+		markets[i] = market
+	}
+	return domain.NewCompliance(input.MinimumAge, input.Hazardous, markets)
+}
+
+// ValidateSetComplianceRequest validates the set compliance request.
+func ValidateSetComplianceRequest(req SetComplianceRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	_, err := parseCompliance(req.Compliance)
+	return err
+}
@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSetComplianceRequest(t *testing.T) {
+	compliance := &ComplianceInput{MinimumAge: 18, Hazardous: true, RestrictedMarkets: []string{"uk", "US"}}
+
+	assert.NoError(t, ValidateSetComplianceRequest(SetComplianceRequest{ProductID: "product-1", Compliance: compliance}))
+	assert.NoError(t, ValidateSetComplianceRequest(SetComplianceRequest{ProductID: "product-1"}), "nil compliance clears it")
+	assert.ErrorIs(t, ValidateSetComplianceRequest(SetComplianceRequest{Compliance: compliance}), domain.ErrInvalidID)
+	assert.ErrorIs(t, ValidateSetComplianceRequest(SetComplianceRequest{ProductID: "product-1", Compliance: &ComplianceInput{MinimumAge: 100}}), domain.ErrInvalidCompliance)
+	assert.ErrorIs(t, ValidateSetComplianceRequest(SetComplianceRequest{ProductID: "product-1", Compliance: &ComplianceInput{RestrictedMarkets: []string{"FR"}}}), domain.ErrInvalidCompliance)
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// WeightInput is a weight in a unit: "g", "kg", "lb" or "oz".
+type WeightInput struct {
+	Value float64
+	Unit  string
+}
+
+// DimensionsInput are a height, width and depth in a unit: "mm", "cm", "m" or "in".
+type DimensionsInput struct {
+	Height float64
+	Width  float64
+	Depth  float64
+	Unit   string
+}
+
+// SetDimensionsRequest represents the input for replacing the weight and dimensions of a
+// product. A nil weight or dimensions clears it.
+type SetDimensionsRequest struct {
+	ProductID  string
+	Weight     *WeightInput
+	Dimensions *DimensionsInput
+}
+
+// SetDimensions replaces the weight and dimensions of a product that is not archived.
+func (uc *ProductUseCases) SetDimensions(ctx context.Context, req SetDimensionsRequest) error {
+	weight, dimensions, err := parseDimensions(req.Weight, req.Dimensions)
+	if err != nil {
+		return err
+	}
+
+	return uc.changeProduct(ctx, "SetDimensions", req.ProductID, func(product *domain.Product, now time.Time) error {
+		return product.ChangeDimensions(weight, dimensions, now)
+	})
+}
+
+// parseDimensions validates an optional weight and optional dimensions.
+func parseDimensions(weight *WeightInput, dimensions *DimensionsInput) (*domain.Weight, *domain.Dimensions, error) {
+	var w *domain.Weight
+	var d *domain.Dimensions
+	var err error
+	if weight != nil {
+		if w, err = domain.NewWeight(weight.Value, domain.WeightUnit(weight.Unit)); err != nil {
+			return nil, nil, err
+		}
+	}
+	if dimensions != nil {
+		d, err = domain.NewDimensions(dimensions.Height, dimensions.Width, dimensions.Depth, domain.LengthUnit(dimensions.Unit))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return w, d, nil
+}
+
+// ValidateSetDimensionsRequest validates the set dimensions request.
+func ValidateSetDimensionsRequest(req SetDimensionsRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	_, _, err := parseDimensions(req.Weight, req.Dimensions)
+	return err
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSetDimensionsRequest(t *testing.T) {
+	weight := &WeightInput{Value: 1.5, Unit: "kg"}
+	dimensions := &DimensionsInput{Height: 10, Width: 20, Depth: 5, Unit: "cm"}
+
+	assert.NoError(t, ValidateSetDimensionsRequest(SetDimensionsRequest{ProductID: "product-1", Weight: weight, Dimensions: dimensions}))
+	assert.NoError(t, ValidateSetDimensionsRequest(SetDimensionsRequest{ProductID: "product-1"}), "nil weight and dimensions clear them")
+	assert.ErrorIs(t, ValidateSetDimensionsRequest(SetDimensionsRequest{Weight: weight}), domain.ErrInvalidID)
+	assert.ErrorIs(t, ValidateSetDimensionsRequest(SetDimensionsRequest{ProductID: "product-1", Weight: &WeightInput{Value: 1.5, Unit: "stone"}}), domain.ErrInvalidWeight)
+	assert.ErrorIs(t, ValidateSetDimensionsRequest(SetDimensionsRequest{ProductID: "product-1", Weight: &WeightInput{Unit: "kg"}}), domain.ErrInvalidWeight)
+	assert.ErrorIs(t, ValidateSetDimensionsRequest(SetDimensionsRequest{ProductID: "product-1", Dimensions: &DimensionsInput{Height: 10, Width: 20, Unit: "cm"}}), domain.ErrInvalidDimensions)
+}
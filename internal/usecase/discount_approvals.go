@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ApproveDiscountRequest represents the input for approving a discount held pending
+// approval. Approver identifies who approved it and is recorded with the discount.
+type ApproveDiscountRequest struct {
+	ProductID  string
+	DiscountID string
+	Approver   string
+}
+
+// ApproveDiscount approves a discount that exceeded the approval threshold when it was
+// applied, so it starts affecting the price of the product. Like ApplyDiscount it is
+// refused during a freeze window.
+func (uc *ProductUseCases) ApproveDiscount(ctx context.Context, req ApproveDiscountRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return err
+	}
+	if err := product.ApproveDiscount(req.DiscountID, req.Approver, now); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlanFor("ApproveDiscount")
+
+	if mut := uc.repo.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.DiscountMuts(product)...)
+	plan.AddAll(uc.repo.EffectivePriceMuts(product)...)
+	plan.Add(uc.repo.PriceHistoryMut(product, now))
+
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
+		}
+		plan.AddAll(muts...)
+	}
+
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	uc.publishEvents(ctx, product)
+	return nil
+}
+
+// ValidateApproveDiscountRequest validates the approve discount request.
+func ValidateApproveDiscountRequest(req ApproveDiscountRequest) error {
+	if req.ProductID == "" || req.DiscountID == "" {
+		return domain.ErrInvalidID
+	}
+	if strings.TrimSpace(req.Approver) == "" {
+		return domain.ErrApproverRequired
+	}
+	return nil
+}
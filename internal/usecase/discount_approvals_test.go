@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateApproveDiscountRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     ApproveDiscountRequest
+		wantErr error
+	}{
+		{"valid", ApproveDiscountRequest{ProductID: "product-1", DiscountID: "discount-1", Approver: "pricing-lead"}, nil},
+		{"missing product ID", ApproveDiscountRequest{DiscountID: "discount-1", Approver: "pricing-lead"}, domain.ErrInvalidID},
+		{"missing discount ID", ApproveDiscountRequest{ProductID: "product-1", Approver: "pricing-lead"}, domain.ErrInvalidID},
+		{"blank approver", ApproveDiscountRequest{ProductID: "product-1", DiscountID: "discount-1", Approver: " "}, domain.ErrApproverRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateApproveDiscountRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
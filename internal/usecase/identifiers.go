@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/grpc/codes"
+)
+
+// SetIdentifiersRequest represents the input for replacing the SKU and GTIN of a product.
+// An empty identifier clears it.
+type SetIdentifiersRequest struct {
+	ProductID string
+	SKU       string
+	GTIN      string
+}
+
+// SetIdentifiers replaces the SKU and GTIN of a product that is not archived. A SKU used
+// by another product or by a variant fails with domain.ErrDuplicateSKU, and a GTIN used by
+// another product with domain.ErrDuplicateGTIN.
+func (uc *ProductUseCases) SetIdentifiers(ctx context.Context, req SetIdentifiersRequest) error {
+	sku, gtin, err := domain.ParseIdentifiers(req.SKU, req.GTIN)
+	if err != nil {
+		return err
+	}
+	if err := uc.checkIdentifiersAvailable(ctx, req.ProductID, sku, gtin); err != nil {
+		return err
+	}
+
+	err = uc.changeProduct(ctx, "SetIdentifiers", req.ProductID, func(product *domain.Product, now time.Time) error {
+		return product.ChangeIdentifiers(sku, gtin, now)
+	})
+	return uc.identifiersTakenError(ctx, req.ProductID, sku, gtin, err)
+}
+
+// checkIdentifiersAvailable returns domain.ErrDuplicateSKU if a product other than the one
+// with productID, or a variant, uses sku, and domain.ErrDuplicateGTIN if another product
+// uses gtin. Empty identifiers are not checked.
+func (uc *ProductUseCases) checkIdentifiersAvailable(ctx context.Context, productID, sku, gtin string) error {
+	if sku != "" {
+		if err := checkProductIdentifier(productID, domain.ErrDuplicateSKU, func() (string, error) {
+			return uc.repo.FindIDBySKU(ctx, sku)
+		}); err != nil {
+			return err
+		}
+		if uc.variants != nil {
+			_, err := uc.variants.FindIDBySKU(ctx, sku)
+			if err == nil {
+				return domain.ErrDuplicateSKU
+			}
+			if !errors.Is(err, domain.ErrVariantNotFound) {
+				return err
+			}
+		}
+	}
+	if gtin != "" {
+		return checkProductIdentifier(productID, domain.ErrDuplicateGTIN, func() (string, error) {
+			return uc.repo.FindIDByGTIN(ctx, gtin)
+		})
+	}
+	return nil
+}
+
+// checkProductIdentifier returns taken if find returns the ID of a product other than the
+// one with productID.
+func checkProductIdentifier(productID string, taken error, find func() (string, error)) error {
+	id, err := find()
+	switch {
+	case errors.Is(err, domain.ErrProductNotFound):
+		return nil
+	case err != nil:
+		return err
+	case id != productID:
+		return taken
+	}
+	return nil
+}
+
+// identifiersTakenError returns domain.ErrDuplicateSKU or domain.ErrDuplicateGTIN for a
+// commit that failed because a concurrent command took the SKU or GTIN it writes for the
+// product with productID, and err otherwise.
+func (uc *ProductUseCases) identifiersTakenError(ctx context.Context, productID, sku, gtin string, err error) error {
+	if spanner.ErrCode(err) != codes.AlreadyExists {
+		return err
+	}
+	if taken := uc.checkIdentifiersAvailable(ctx, productID, sku, gtin); taken != nil {
+		return taken
+	}
+	return err
+}
+
+// ValidateSetIdentifiersRequest validates the set identifiers request.
+func ValidateSetIdentifiersRequest(req SetIdentifiersRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	_, _, err := domain.ParseIdentifiers(req.SKU, req.GTIN)
+	return err
+}
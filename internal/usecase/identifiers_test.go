@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSetIdentifiersRequest(t *testing.T) {
+	assert.NoError(t, ValidateSetIdentifiersRequest(SetIdentifiersRequest{ProductID: "product-1", SKU: "wdg-1", GTIN: "4006381333931"}))
+	assert.NoError(t, ValidateSetIdentifiersRequest(SetIdentifiersRequest{ProductID: "product-1"}), "empty identifiers clear them")
+	assert.ErrorIs(t, ValidateSetIdentifiersRequest(SetIdentifiersRequest{SKU: "WDG-1"}), domain.ErrInvalidID)
+	assert.ErrorIs(t, ValidateSetIdentifiersRequest(SetIdentifiersRequest{ProductID: "product-1", SKU: "WDG 1"}), domain.ErrInvalidSKU)
+	assert.ErrorIs(t, ValidateSetIdentifiersRequest(SetIdentifiersRequest{ProductID: "product-1", GTIN: "40063813"}), domain.ErrInvalidGTIN)
+}
+
+func TestCheckProductIdentifier(t *testing.T) {
+	found := func(id string) func() (string, error) {
+		return func() (string, error) { return id, nil }
+	}
+	notFound := func() (string, error) { return "", domain.ErrProductNotFound }
+
+	assert.NoError(t, checkProductIdentifier("product-1", domain.ErrDuplicateGTIN, notFound))
+	assert.NoError(t, checkProductIdentifier("product-1", domain.ErrDuplicateGTIN, found("product-1")))
+	assert.ErrorIs(t, checkProductIdentifier("product-1", domain.ErrDuplicateGTIN, found("product-2")), domain.ErrDuplicateGTIN)
+}
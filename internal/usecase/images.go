@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ErrImagesDisabled is returned by the image use cases when no image repository was
+// configured with WithImages.
+var ErrImagesDisabled = errors.New("product images are not enabled")
+
+// ImageInput represents one image of a product in a SetImagesRequest.
+type ImageInput struct {
+	URL     string
+	AltText string
+	Primary bool
+}
+
+// SetImagesRequest represents the input for replacing the images of a product, in display
+// order. No images removes them all. If none is Primary, the first one becomes primary.
+type SetImagesRequest struct {
+	ProductID string
+	Images    []ImageInput
+}
+
+// ReorderImagesRequest represents the input for changing the display order of the images
+// of a product. URLs lists the URL of every image of the product in the new order.
+type ReorderImagesRequest struct {
+	ProductID string
+	URLs      []string
+}
+
+// SetImages replaces the images of a product that is not archived.
+func (uc *ProductUseCases) SetImages(ctx context.Context, req SetImagesRequest) error {
+	images, err := productImages(req.Images)
+	if err != nil {
+		return err
+	}
+	return uc.changeImages(ctx, "SetImages", req.ProductID, func(gallery *domain.ProductImages, now time.Time) error {
+		return gallery.Set(images, now)
+	})
+}
+
+// ReorderImages changes the display order of the images of a product that is not
+// archived.
+func (uc *ProductUseCases) ReorderImages(ctx context.Context, req ReorderImagesRequest) error {
+	return uc.changeImages(ctx, "ReorderImages", req.ProductID, func(gallery *domain.ProductImages, now time.Time) error {
+		return gallery.Reorder(req.URLs, now)
+	})
+}
+
+// changeImages loads the images of a product, applies change and commits them with their
+// events, if they changed.
+func (uc *ProductUseCases) changeImages(ctx context.Context, useCase, productID string, change func(*domain.ProductImages, time.Time) error) error {
+	if uc.images == nil {
+		return ErrImagesDisabled
+	}
+
+	product, err := uc.repo.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if product.Status() == domain.ProductStatusArchived {
+		return domain.ErrProductArchived
+	}
+	gallery, err := uc.images.FindByProductID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := change(gallery, now); err != nil {
+		return err
+	}
+	if len(gallery.DomainEvents()) == 0 {
+		return nil
+	}
+
+	plan := committer.NewPlanFor(useCase)
+	plan.AddAll(uc.images.SaveMuts(gallery)...)
+	for _, event := range gallery.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+	}
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	if uc.publisher != nil {
+		uc.publisher.Publish(ctx, gallery.DomainEvents()...)
+	}
+	return nil
+}
+
+// productImages converts the images of a request to domain images.
+func productImages(inputs []ImageInput) ([]*domain.ProductImage, error) {
+	images := make([]*domain.ProductImage, len(inputs))
+	for i, input := range inputs {
+		image, err := domain.NewProductImage(input.URL, input.AltText, input.Primary)
+		if err != nil {
+			return nil, err
+		}
+		images[i] = image
+	}
+	return images, nil
+}
+
+// ValidateSetImagesRequest validates the set images request.
+func ValidateSetImagesRequest(req SetImagesRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	images, err := productImages(req.Images)
+	if err != nil {
+		return err
+	}
+	return domain.NewProductImages(req.ProductID).Set(images, time.Time{})
+}
+
+// ValidateReorderImagesRequest validates the reorder images request.
+func ValidateReorderImagesRequest(req ReorderImagesRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	if len(req.URLs) > domain.MaxProductImages {
+		return domain.ErrInvalidImageOrder
+	}
+	seen := make(map[string]bool, len(req.URLs))
+	for _, raw := range req.URLs {
+		u, err := domain.ParseImageURL(raw)
+		if err != nil {
+			return err
+		}
+		if seen[u] {
+			return domain.ErrInvalidImageOrder
+		}
+		seen[u] = true
+	}
+	return nil
+}
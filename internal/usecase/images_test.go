@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSetImagesRequest(t *testing.T) {
+	front := ImageInput{URL: "https://cdn.example.com/front.jpg", AltText: "Front"}
+	back := ImageInput{URL: "https://cdn.example.com/back.jpg"}
+
+	tests := []struct {
+		name    string
+		req     SetImagesRequest
+		wantErr error
+	}{
+		{"valid", SetImagesRequest{ProductID: "product-1", Images: []ImageInput{front, back}}, nil},
+		{"remove all", SetImagesRequest{ProductID: "product-1"}, nil},
+		{"missing product ID", SetImagesRequest{Images: []ImageInput{front}}, domain.ErrInvalidID},
+		{"relative URL", SetImagesRequest{ProductID: "product-1", Images: []ImageInput{{URL: "/front.jpg"}}}, domain.ErrInvalidImageURL},
+		{"duplicate URL", SetImagesRequest{ProductID: "product-1", Images: []ImageInput{front, front}}, domain.ErrDuplicateImage},
+		{"two primary", SetImagesRequest{ProductID: "product-1", Images: []ImageInput{
+			{URL: front.URL, Primary: true}, {URL: back.URL, Primary: true},
+		}}, domain.ErrMultiplePrimaryImages},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetImagesRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateReorderImagesRequest(t *testing.T) {
+	assert.NoError(t, ValidateReorderImagesRequest(ReorderImagesRequest{ProductID: "product-1",
+		URLs: []string{"https://cdn.example.com/back.jpg", "https://cdn.example.com/front.jpg"}}))
+	assert.ErrorIs(t, ValidateReorderImagesRequest(ReorderImagesRequest{URLs: []string{"https://cdn.example.com/back.jpg"}}), domain.ErrInvalidID)
+	assert.ErrorIs(t, ValidateReorderImagesRequest(ReorderImagesRequest{ProductID: "product-1", URLs: []string{"back.jpg"}}), domain.ErrInvalidImageURL)
+	assert.ErrorIs(t, ValidateReorderImagesRequest(ReorderImagesRequest{ProductID: "product-1",
+		URLs: []string{"https://cdn.example.com/back.jpg", "https://cdn.example.com/back.jpg"}}), domain.ErrInvalidImageOrder)
+}
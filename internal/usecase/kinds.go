@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// SetKindRequest represents the input for making a product "physical" or "digital"
+// together with the weight and dimensions it has afterwards: both for a physical product
+// and neither for a digital one.
+type SetKindRequest struct {
+	ProductID  string
+	Kind       string
+	Weight     *WeightInput
+	Dimensions *DimensionsInput
+}
+
+// SetKind changes the kind of a product that is not archived and replaces its weight and
+// dimensions. A product becomes digital only if its stock is not tracked, failing with
+// domain.ErrStockNotApplicable otherwise.
+func (uc *ProductUseCases) SetKind(ctx context.Context, req SetKindRequest) error {
+	kind, weight, dimensions, err := parseKind(req)
+	if err != nil {
+		return err
+	}
+
+	return uc.changeProduct(ctx, "SetKind", req.ProductID, func(product *domain.Product, now time.Time) error {
+		if !kind.HasStock() && uc.stock != nil {
+			stock, err := uc.stock.FindByProductID(ctx, product.ID())
+			if err != nil {
+				return err
+			}
+			if stock.Tracked() {
+				return domain.ErrStockNotApplicable
+			}
+		}
+		return product.ChangeKind(kind, weight, dimensions, now)
+	})
+}
+
+// parseKind validates the kind of a set kind request and the weight and dimensions that
+// go with it.
+func parseKind(req SetKindRequest) (domain.ProductKind, *domain.Weight, *domain.Dimensions, error) {
+	kind := domain.ProductKind(req.Kind)
+	if !kind.IsValid() {
+		return "", nil, nil, domain.ErrInvalidProductKind
+	}
+	weight, dimensions, err := parseDimensions(req.Weight, req.Dimensions)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return kind, weight, dimensions, nil
+}
+
+// ValidateSetKindRequest validates the set kind request.
+func ValidateSetKindRequest(req SetKindRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	_, _, _, err := parseKind(req)
+	return err
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSetKindRequest(t *testing.T) {
+	weight := &WeightInput{Value: 1.5, Unit: "kg"}
+	dimensions := &DimensionsInput{Height: 10, Width: 20, Depth: 5, Unit: "cm"}
+
+	assert.NoError(t, ValidateSetKindRequest(SetKindRequest{ProductID: "product-1", Kind: "physical", Weight: weight, Dimensions: dimensions}))
+	assert.NoError(t, ValidateSetKindRequest(SetKindRequest{ProductID: "product-1", Kind: "digital"}))
+	assert.ErrorIs(t, ValidateSetKindRequest(SetKindRequest{Kind: "digital"}), domain.ErrInvalidID)
+	assert.ErrorIs(t, ValidateSetKindRequest(SetKindRequest{ProductID: "product-1"}), domain.ErrInvalidProductKind)
+	assert.ErrorIs(t, ValidateSetKindRequest(SetKindRequest{ProductID: "product-1", Kind: "Digital"}), domain.ErrInvalidProductKind)
+	assert.ErrorIs(t, ValidateSetKindRequest(SetKindRequest{ProductID: "product-1", Kind: "physical", Weight: &WeightInput{Value: 1.5, Unit: "stone"}}), domain.ErrInvalidWeight)
+}
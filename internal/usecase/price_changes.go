@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// SchedulePriceChangeRequest represents the input for changing the base price of a
+// product at a later time.
+type SchedulePriceChangeRequest struct {
+	ProductID            string
+	BasePriceNumerator   int64
+	BasePriceDenominator int64
+	// Currency must be the currency of the product if set; empty means the product's currency.
+	Currency    string
+	EffectiveAt time.Time
+}
+
+// CancelPriceChangeRequest represents the input for cancelling the pending price change
+// of a product.
+type CancelPriceChangeRequest struct {
+	ProductID string
+}
+
+// ApplyPriceChangeRequest represents the input for applying the pending price change of
+// a product once it is due.
+type ApplyPriceChangeRequest struct {
+	ProductID string
+}
+
+// SchedulePriceChange schedules a change of the base price of a product, replacing any
+// change already pending. The change is applied by the price change scheduler.
+func (uc *ProductUseCases) SchedulePriceChange(ctx context.Context, req SchedulePriceChangeRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	newPrice, err := newMoney(req.BasePriceNumerator, req.BasePriceDenominator, req.Currency, product.Currency())
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := product.SchedulePriceChange(newPrice, req.EffectiveAt, now); err != nil {
+		return err
+	}
+
+	return uc.commitPendingPriceChange(ctx, "SchedulePriceChange", product, now)
+}
+
+// CancelPriceChange cancels the pending price change of a product.
+func (uc *ProductUseCases) CancelPriceChange(ctx context.Context, req CancelPriceChangeRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := product.CancelPriceChange(now); err != nil {
+		return err
+	}
+
+	return uc.commitPendingPriceChange(ctx, "CancelPriceChange", product, now)
+}
+
+// commitPendingPriceChange commits a product whose pending price change was scheduled
+// or cancelled, together with its events.
+func (uc *ProductUseCases) commitPendingPriceChange(ctx context.Context, useCase string, product *domain.Product, now time.Time) error {
+	plan := committer.NewPlanFor(useCase)
+
+	if mut := uc.repo.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+	}
+
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	uc.publishEvents(ctx, product)
+	return nil
+}
+
+// ApplyPriceChange changes the base price of a product to its pending price change once
+// the change is due and raises product.price_changed. It is run by the price change
+// scheduler and does nothing if no change is due. Like ChangeBasePrice it is refused
+// during a freeze window; the scheduler retries once the window ends.
+func (uc *ProductUseCases) ApplyPriceChange(ctx context.Context, req ApplyPriceChangeRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if change := product.PendingPriceChange(); change == nil || !change.IsDue(now) {
+		return nil
+	}
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return err
+	}
+	if !product.ApplyPendingPriceChange(now) {
+		return nil
+	}
+
+	plan := committer.NewPlanFor("ApplyPriceChange")
+
+	if mut := uc.repo.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.DiscountMuts(product)...)
+	plan.AddAll(uc.repo.EffectivePriceMuts(product)...)
+	plan.Add(uc.repo.PriceHistoryMut(product, now))
+
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
+		}
+		plan.AddAll(muts...)
+	}
+
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	uc.publishEvents(ctx, product)
+	return nil
+}
+
+// ValidateSchedulePriceChangeRequest validates the schedule price change request.
+func ValidateSchedulePriceChangeRequest(req SchedulePriceChangeRequest) error {
+	if err := ValidateChangeBasePriceRequest(ChangeBasePriceRequest{
+		ProductID:            req.ProductID,
+		BasePriceNumerator:   req.BasePriceNumerator,
+		BasePriceDenominator: req.BasePriceDenominator,
+		Currency:             req.Currency,
+	}); err != nil {
+		return err
+	}
+	if req.EffectiveAt.IsZero() {
+		return domain.ErrInvalidPriceChangeTime
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSchedulePriceChangeRequest(t *testing.T) {
+	midnight := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	valid := func(modify func(*SchedulePriceChangeRequest)) SchedulePriceChangeRequest {
+		req := SchedulePriceChangeRequest{
+			ProductID:            "product-1",
+			BasePriceNumerator:   2499,
+			BasePriceDenominator: 100,
+			EffectiveAt:          midnight,
+		}
+		modify(&req)
+		return req
+	}
+
+	tests := []struct {
+		name    string
+		req     SchedulePriceChangeRequest
+		wantErr error
+	}{
+		{"valid", valid(func(*SchedulePriceChangeRequest) {}), nil},
+		{"with currency", valid(func(r *SchedulePriceChangeRequest) { r.Currency = "EUR" }), nil},
+		{"missing product ID", valid(func(r *SchedulePriceChangeRequest) { r.ProductID = "" }), domain.ErrInvalidID},
+		{"zero price", valid(func(r *SchedulePriceChangeRequest) { r.BasePriceNumerator = 0 }), domain.ErrInvalidBasePrice},
+		{"invalid currency", valid(func(r *SchedulePriceChangeRequest) { r.Currency = "euro" }), domain.ErrInvalidCurrency},
+		{"missing effective time", valid(func(r *SchedulePriceChangeRequest) { r.EffectiveAt = time.Time{} }), domain.ErrInvalidPriceChangeTime},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSchedulePriceChangeRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ErrPriceListsDisabled is returned by the price list use cases when no price list
+// repository was configured with WithPriceLists.
+var ErrPriceListsDisabled = errors.New("price lists are not enabled")
+
+// CreatePriceListRequest represents the input for creating a price list.
+type CreatePriceListRequest struct {
+	Name      string
+	Currency  string
+	ValidFrom time.Time
+	// ValidUntil is the zero time if the price list stays valid indefinitely.
+	ValidUntil time.Time
+}
+
+// CreatePriceListResponse represents the output of creating a price list.
+type CreatePriceListResponse struct {
+	PriceListID string
+}
+
+// PriceListEntryRequest describes the price of one product in a price list.
+type PriceListEntryRequest struct {
+	ProductID   string
+	Numerator   int64
+	Denominator int64
+	// Currency must be the currency of the price list if set; empty means the list's
+	// currency.
+	Currency string
+}
+
+// SetPriceListEntriesRequest represents the input for replacing the entries of a price
+// list. An empty Entries removes every entry.
+type SetPriceListEntriesRequest struct {
+	PriceListID string
+	Entries     []PriceListEntryRequest
+}
+
+// CreatePriceList creates a price list without entries.
+func (uc *ProductUseCases) CreatePriceList(ctx context.Context, req CreatePriceListRequest) (*CreatePriceListResponse, error) {
+	if uc.priceLists == nil {
+		return nil, ErrPriceListsDisabled
+	}
+
+	now := uc.clock.Now()
+	list, err := domain.NewPriceList(uc.ids.NewID(), req.Name, req.Currency, req.ValidFrom, req.ValidUntil, now)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, _ := caller.Tenant(ctx)
+	if err := list.AssignTenant(tenantID); err != nil {
+		return nil, err
+	}
+
+	plan := committer.NewPlanFor("CreatePriceList")
+	plan.Add(uc.priceLists.InsertMut(list))
+	if err := uc.addPriceListEvents(plan, list); err != nil {
+		return nil, err
+	}
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	uc.publishPriceListEvents(ctx, list)
+	return &CreatePriceListResponse{PriceListID: list.ID()}, nil
+}
+
+// SetPriceListEntries replaces the entries of a price list. Like other price changes, it
+// is refused while the catalog is frozen. It fails with domain.ErrProductNotFound if an
+// entry is for a product that does not exist or belongs to another tenant.
+func (uc *ProductUseCases) SetPriceListEntries(ctx context.Context, req SetPriceListEntriesRequest) error {
+	if uc.priceLists == nil {
+		return ErrPriceListsDisabled
+	}
+
+	list, err := uc.priceLists.FindByID(ctx, req.PriceListID)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]*domain.PriceListEntry, len(req.Entries))
+	for i, e := range req.Entries {
+		entry, err := newPriceListEntry(e, list.Currency())
+		if err != nil {
+			return err
+		}
+		entries[i] = entry
+	}
+
+	now := uc.clock.Now()
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return err
+	}
+	if err := list.SetEntries(entries, now); err != nil {
+		return err
+	}
+	if len(list.DomainEvents()) == 0 {
+		return nil
+	}
+	if err := uc.checkPriceListProducts(ctx, list); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlanFor("SetPriceListEntries")
+	plan.AddAll(uc.priceLists.EntryMuts(list)...)
+	if err := uc.addPriceListEvents(plan, list); err != nil {
+		return err
+	}
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	uc.publishPriceListEvents(ctx, list)
+	return nil
+}
+
+// checkPriceListProducts fails with domain.ErrProductNotFound unless every entry of list
+// is for a product of the caller's tenant.
+func (uc *ProductUseCases) checkPriceListProducts(ctx context.Context, list *domain.PriceList) error {
+	entries := list.Entries()
+	if len(entries) == 0 {
+		return nil
+	}
+	productIDs := make([]string, len(entries))
+	for i, e := range entries {
+		productIDs[i] = e.ProductID()
+	}
+	found, err := uc.repo.FindIDs(ctx, productIDs)
+	if err != nil {
+		return err
+	}
+	if len(found) != len(productIDs) {
+		return domain.ErrProductNotFound
+	}
+	return nil
+}
+
+// newPriceListEntry converts an entry of a request to a domain PriceListEntry in the
+// currency of its list.
+func newPriceListEntry(req PriceListEntryRequest, currency string) (*domain.PriceListEntry, error) {
+	if req.Denominator <= 0 || req.Numerator <= 0 {
+		return nil, domain.ErrInvalidPriceListEntry
+	}
+	price, err := newMoney(req.Numerator, req.Denominator, req.Currency, currency)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewPriceListEntry(req.ProductID, price)
+}
+
+// addPriceListEvents adds the outbox mutations of the events raised by list to plan.
+func (uc *ProductUseCases) addPriceListEvents(plan *committer.Plan, list *domain.PriceList) error {
+	for _, event := range list.DomainEvents() {
+		mut, err := uc.outboxRepo.InsertTenantEventMut(list.TenantID(), event)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+	}
+	return nil
+}
+
+// publishPriceListEvents hands the events raised by list to the in-process publisher, if
+// any. It must only be called after the command has been committed.
+func (uc *ProductUseCases) publishPriceListEvents(ctx context.Context, list *domain.PriceList) {
+	if uc.publisher == nil {
+		return
+	}
+	if events := list.DomainEvents(); len(events) > 0 {
+		uc.publisher.Publish(ctx, events...)
+	}
+}
+
+// ValidateCreatePriceListRequest validates the create price list request.
+func ValidateCreatePriceListRequest(req CreatePriceListRequest) error {
+	if req.Name == "" {
+		return domain.ErrInvalidPriceListName
+	}
+	if _, err := domain.ParseCurrency(req.Currency); err != nil {
+		return err
+	}
+	if req.ValidFrom.IsZero() || (!req.ValidUntil.IsZero() && !req.ValidUntil.After(req.ValidFrom)) {
+		return domain.ErrInvalidPriceListValidity
+	}
+	return nil
+}
+
+// ValidateSetPriceListEntriesRequest validates the set price list entries request. The
+// currencies of the entries are checked against the price list when it is loaded.
+func ValidateSetPriceListEntriesRequest(req SetPriceListEntriesRequest) error {
+	if req.PriceListID == "" {
+		return domain.ErrInvalidID
+	}
+	if len(req.Entries) > domain.MaxPriceListEntries {
+		return domain.ErrTooManyPriceListEntries
+	}
+	for _, e := range req.Entries {
+		if e.ProductID == "" || e.Denominator <= 0 || e.Numerator <= 0 {
+			return domain.ErrInvalidPriceListEntry
+		}
+		if e.Currency != "" {
+			if _, err := domain.ParseCurrency(e.Currency); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCreatePriceListRequest(t *testing.T) {
+	now := time.Now()
+	valid := func(modify func(*CreatePriceListRequest)) CreatePriceListRequest {
+		req := CreatePriceListRequest{
+			Name:       "Acme 2025",
+			Currency:   "EUR",
+			ValidFrom:  now,
+			ValidUntil: now.AddDate(1, 0, 0),
+		}
+		modify(&req)
+		return req
+	}
+
+	tests := []struct {
+		name    string
+		req     CreatePriceListRequest
+		wantErr error
+	}{
+		{"bounded", valid(func(*CreatePriceListRequest) {}), nil},
+		{"open-ended", valid(func(r *CreatePriceListRequest) { r.ValidUntil = time.Time{} }), nil},
+		{"missing name", valid(func(r *CreatePriceListRequest) { r.Name = "" }), domain.ErrInvalidPriceListName},
+		{"invalid currency", valid(func(r *CreatePriceListRequest) { r.Currency = "euro" }), domain.ErrInvalidCurrency},
+		{"missing valid from", valid(func(r *CreatePriceListRequest) { r.ValidFrom = time.Time{} }), domain.ErrInvalidPriceListValidity},
+		{"ends before it starts", valid(func(r *CreatePriceListRequest) { r.ValidUntil = now.Add(-time.Hour) }), domain.ErrInvalidPriceListValidity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreatePriceListRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSetPriceListEntriesRequest(t *testing.T) {
+	entry := PriceListEntryRequest{ProductID: "product-1", Numerator: 1499, Denominator: 100}
+
+	tests := []struct {
+		name    string
+		req     SetPriceListEntriesRequest
+		wantErr error
+	}{
+		{"valid", SetPriceListEntriesRequest{PriceListID: "pl1", Entries: []PriceListEntryRequest{entry}}, nil},
+		{"empty", SetPriceListEntriesRequest{PriceListID: "pl1"}, nil},
+		{"missing price list ID", SetPriceListEntriesRequest{Entries: []PriceListEntryRequest{entry}}, domain.ErrInvalidID},
+		{"too many entries", SetPriceListEntriesRequest{PriceListID: "pl1", Entries: make([]PriceListEntryRequest, domain.MaxPriceListEntries+1)}, domain.ErrTooManyPriceListEntries},
+		{"missing product ID", SetPriceListEntriesRequest{PriceListID: "pl1", Entries: []PriceListEntryRequest{{Numerator: 1, Denominator: 1}}}, domain.ErrInvalidPriceListEntry},
+		{"zero price", SetPriceListEntriesRequest{PriceListID: "pl1", Entries: []PriceListEntryRequest{{ProductID: "product-1", Denominator: 1}}}, domain.ErrInvalidPriceListEntry},
+		{"invalid currency", SetPriceListEntriesRequest{PriceListID: "pl1", Entries: []PriceListEntryRequest{{ProductID: "product-1", Numerator: 1, Denominator: 1, Currency: "x"}}}, domain.ErrInvalidCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetPriceListEntriesRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/committer"
+)
+
+// PurgeProductRequest represents the input for permanently deleting an archived product.
+type PurgeProductRequest struct {
+	ProductID string
+	// ArchivedBefore is the retention cutoff: only a product archived before it is purged.
+	ArchivedBefore time.Time
+}
+
+// PurgeProduct permanently deletes a product archived before the retention cutoff,
+// together with the rows that belong to it, its price list entries, the links from other
+// products to it and its outbox history, and records a product.purged event in the outbox
+// in their place. A product that is not archived or was archived since the cutoff fails
+// with domain.ErrProductNotPurgeable.
+func (uc *ProductUseCases) PurgeProduct(ctx context.Context, req PurgeProductRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := product.Purge(req.ArchivedBefore, now); err != nil {
+		return err
+	}
+
+	// The history is read before the commit; archived products raise no events, so none
+	// is added concurrently.
+	history, err := uc.outboxRepo.FindByAggregateID(ctx, product.ID())
+	if err != nil {
+		return err
+	}
+	eventIDs := make([]string, 0, len(history))
+	for _, event := range history {
+		eventIDs = append(eventIDs, event.EventID)
+	}
+
+	plan := committer.NewPlanFor("PurgeProduct")
+	plan.Add(uc.repo.DeleteMut(product))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	if mut := uc.outboxRepo.DeleteMut(eventIDs); mut != nil {
+		plan.Add(mut)
+	}
+	// Price list entries and links from other products are not interleaved in the product,
+	// so they are not deleted with it. They are read in the commit transaction, so none
+	// added since the product was read is left behind.
+	if uc.priceLists != nil {
+		plan.AddCheck(uc.priceLists.ProductEntryDeleteCheck(product.ID()))
+	}
+	if uc.relations != nil {
+		plan.AddCheck(uc.relations.InboundDeleteCheck(product.ID()))
+	}
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+	}
+
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	uc.publishEvents(ctx, product)
+	return nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ErrRatingsDisabled is returned by IngestReview when no rating repository was configured
+// with WithRatings.
+var ErrRatingsDisabled = errors.New("product ratings are not enabled")
+
+// IngestReviewRequest represents a customer review of a product published by the reviews
+// service. ReviewID is the ID the reviews service gave the review; ingesting it again
+// replaces its rating.
+type IngestReviewRequest struct {
+	ProductID string
+	ReviewID  string
+	Rating    int64
+}
+
+// IngestReviewResponse represents the rating summary of the product after a review was
+// ingested.
+type IngestReviewResponse struct {
+	RatingCount   int64
+	RatingAverage float64
+}
+
+// IngestReview records the rating of a customer review of a product and updates the
+// rating summary of the product. It is called for each review the reviews service
+// publishes, including edits of earlier reviews, and is idempotent. Ratings are not part
+// of the product aggregate and raise no event.
+func (uc *ProductUseCases) IngestReview(ctx context.Context, req IngestReviewRequest) (*IngestReviewResponse, error) {
+	if uc.ratings == nil {
+		return nil, ErrRatingsDisabled
+	}
+	if err := domain.ValidateRating(req.ReviewID, req.Rating); err != nil {
+		return nil, err
+	}
+
+	summary, err := uc.ratings.Ingest(ctx, req.ProductID, req.ReviewID, req.Rating)
+	if err != nil {
+		return nil, err
+	}
+	return &IngestReviewResponse{RatingCount: summary.Count(), RatingAverage: summary.Average()}, nil
+}
+
+// ValidateIngestReviewRequest validates the ingest review request.
+func ValidateIngestReviewRequest(req IngestReviewRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	return domain.ValidateRating(req.ReviewID, req.Rating)
+}
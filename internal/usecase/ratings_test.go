@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIngestReviewRequest(t *testing.T) {
+	assert.NoError(t, ValidateIngestReviewRequest(IngestReviewRequest{ProductID: "product-1", ReviewID: "review-1", Rating: 5}))
+	assert.ErrorIs(t, ValidateIngestReviewRequest(IngestReviewRequest{ReviewID: "review-1", Rating: 5}), domain.ErrInvalidID)
+	assert.ErrorIs(t, ValidateIngestReviewRequest(IngestReviewRequest{ProductID: "product-1", Rating: 5}), domain.ErrInvalidReviewID)
+	assert.ErrorIs(t, ValidateIngestReviewRequest(IngestReviewRequest{ProductID: "product-1", ReviewID: strings.Repeat("r", domain.MaxReviewIDLength+1), Rating: 5}), domain.ErrInvalidReviewID)
+	assert.ErrorIs(t, ValidateIngestReviewRequest(IngestReviewRequest{ProductID: "product-1", ReviewID: "review-1"}), domain.ErrInvalidRating)
+	assert.ErrorIs(t, ValidateIngestReviewRequest(IngestReviewRequest{ProductID: "product-1", ReviewID: "review-1", Rating: 6}), domain.ErrInvalidRating)
+}
@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ErrRelationsDisabled is returned by the relation use cases when no relation repository
+// was configured with WithRelations.
+var ErrRelationsDisabled = errors.New("product relations are not enabled")
+
+// ProductLinkRequest represents the input for linking a product to a related product or
+// removing the link. RelationType is "related", "accessory" or "replacement".
+type ProductLinkRequest struct {
+	ProductID        string
+	RelatedProductID string
+	RelationType     string
+}
+
+// LinkProducts links a product to a related product; neither may be archived. Linking
+// products that are already linked with the type changes nothing. An accessory or
+// replacement link that would lead back to the product through links of the same type
+// fails with domain.ErrRelationCycle.
+func (uc *ProductUseCases) LinkProducts(ctx context.Context, req ProductLinkRequest) error {
+	if uc.relations == nil {
+		return ErrRelationsDisabled
+	}
+	relationType, err := domain.ParseRelationType(req.RelationType)
+	if err != nil {
+		return err
+	}
+	now := uc.clock.Now()
+	relation, err := domain.NewProductRelation(req.ProductID, req.RelatedProductID, relationType, now)
+	if err != nil {
+		return err
+	}
+
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+	related, err := uc.repo.FindByID(ctx, req.RelatedProductID)
+	if err != nil {
+		return err
+	}
+	if product.Status() == domain.ProductStatusArchived || related.Status() == domain.ProductStatusArchived {
+		return domain.ErrProductArchived
+	}
+
+	linked, err := uc.relations.FindRelatedIDs(ctx, []string{req.ProductID}, relationType)
+	if err != nil {
+		return err
+	}
+	for _, id := range linked[req.ProductID] {
+		if id == req.RelatedProductID {
+			return nil
+		}
+	}
+	if len(linked[req.ProductID]) >= domain.MaxProductRelations {
+		return domain.ErrTooManyRelations
+	}
+	if relationType.Acyclic() {
+		if err := uc.checkRelationCycle(ctx, relation); err != nil {
+			return err
+		}
+	}
+
+	event := domain.NewProductLinkedEvent(relation, now)
+	mut, err := uc.eventMut(event, product, now)
+	if err != nil {
+		return err
+	}
+	plan := committer.NewPlanFor("LinkProducts")
+	plan.Add(uc.relations.InsertMut(relation))
+	plan.Add(mut)
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	if uc.publisher != nil {
+		uc.publisher.Publish(ctx, event)
+	}
+	return nil
+}
+
+// UnlinkProducts removes the link of a type from a product that is not archived to a
+// related product. Removing a link that does not exist changes nothing.
+func (uc *ProductUseCases) UnlinkProducts(ctx context.Context, req ProductLinkRequest) error {
+	if uc.relations == nil {
+		return ErrRelationsDisabled
+	}
+	relationType, err := domain.ParseRelationType(req.RelationType)
+	if err != nil {
+		return err
+	}
+
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+	if product.Status() == domain.ProductStatusArchived {
+		return domain.ErrProductArchived
+	}
+
+	linked, err := uc.relations.FindRelatedIDs(ctx, []string{req.ProductID}, relationType)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, id := range linked[req.ProductID] {
+		found = found || id == req.RelatedProductID
+	}
+	if !found {
+		return nil
+	}
+
+	now := uc.clock.Now()
+	event := domain.NewProductUnlinkedEvent(req.ProductID, req.RelatedProductID, relationType, now)
+	mut, err := uc.eventMut(event, product, now)
+	if err != nil {
+		return err
+	}
+	plan := committer.NewPlanFor("UnlinkProducts")
+	plan.Add(uc.relations.DeleteMut(req.ProductID, req.RelatedProductID, relationType))
+	plan.Add(mut)
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	if uc.publisher != nil {
+		uc.publisher.Publish(ctx, event)
+	}
+	return nil
+}
+
+// checkRelationCycle fails with domain.ErrRelationCycle if following links of the
+// relation's type from its related product leads back to the product it links from. It
+// reads the links one hop at a time, for every product reached in the previous hop.
+func (uc *ProductUseCases) checkRelationCycle(ctx context.Context, relation *domain.ProductRelation) error {
+	visited := map[string]bool{relation.RelatedProductID(): true}
+	frontier := []string{relation.RelatedProductID()}
+	for len(frontier) > 0 {
+		linked, err := uc.relations.FindRelatedIDs(ctx, frontier, relation.Type())
+		if err != nil {
+			return err
+		}
+
+		frontier = nil
+		for _, ids := range linked {
+			for _, id := range ids {
+				if id == relation.ProductID() {
+					return domain.ErrRelationCycle
+				}
+				if !visited[id] {
+					visited[id] = true
+					frontier = append(frontier, id)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateProductLinkRequest validates the link and unlink products requests.
+func ValidateProductLinkRequest(req ProductLinkRequest) error {
+	if req.ProductID == "" || req.RelatedProductID == "" {
+		return domain.ErrInvalidID
+	}
+	if req.ProductID == req.RelatedProductID {
+		return domain.ErrSelfRelation
+	}
+	_, err := domain.ParseRelationType(req.RelationType)
+	return err
+}
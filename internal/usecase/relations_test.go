@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateProductLinkRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     ProductLinkRequest
+		wantErr error
+	}{
+		{"valid", ProductLinkRequest{ProductID: "phone", RelatedProductID: "case", RelationType: "accessory"}, nil},
+		{"missing product ID", ProductLinkRequest{RelatedProductID: "case", RelationType: "accessory"}, domain.ErrInvalidID},
+		{"missing related product ID", ProductLinkRequest{ProductID: "phone", RelationType: "accessory"}, domain.ErrInvalidID},
+		{"self link", ProductLinkRequest{ProductID: "phone", RelatedProductID: "phone", RelationType: "related"}, domain.ErrSelfRelation},
+		{"unknown type", ProductLinkRequest{ProductID: "phone", RelatedProductID: "case", RelationType: "upsell"}, domain.ErrInvalidRelationType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateProductLinkRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// SubmitForReviewRequest represents the input for submitting a draft product for review.
+// SubmittedBy identifies who submitted it.
+type SubmitForReviewRequest struct {
+	ProductID   string
+	SubmittedBy string
+}
+
+// ApproveProductRequest represents the input for approving a product pending review.
+// Reviewer identifies who approved it and must not be its submitter.
+type ApproveProductRequest struct {
+	ProductID string
+	Reviewer  string
+}
+
+// RejectProductRequest represents the input for rejecting a product pending review.
+type RejectProductRequest struct {
+	ProductID string
+	Reviewer  string
+	Reason    string
+}
+
+// SubmitForReview submits a draft product for review.
+func (uc *ProductUseCases) SubmitForReview(ctx context.Context, req SubmitForReviewRequest) error {
+	return uc.changeProduct(ctx, "SubmitForReview", req.ProductID, func(product *domain.Product, now time.Time) error {
+		return product.SubmitForReview(req.SubmittedBy, now)
+	})
+}
+
+// ApproveProduct approves a product pending review and activates it like
+// ActivateProduct, checking its compliance flags.
+func (uc *ProductUseCases) ApproveProduct(ctx context.Context, req ApproveProductRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := product.Approve(req.Reviewer, now); err != nil {
+		return err
+	}
+	if err := uc.checkCompliance(product); err != nil {
+		return err
+	}
+
+	return uc.commitStatusChange(ctx, "ApproveProduct", product, now)
+}
+
+// RejectProduct rejects a product pending review, returning it to draft.
+func (uc *ProductUseCases) RejectProduct(ctx context.Context, req RejectProductRequest) error {
+	return uc.changeProduct(ctx, "RejectProduct", req.ProductID, func(product *domain.Product, now time.Time) error {
+		return product.Reject(req.Reviewer, req.Reason, now)
+	})
+}
+
+// ValidateSubmitForReviewRequest validates the submit for review request.
+func ValidateSubmitForReviewRequest(req SubmitForReviewRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	if strings.TrimSpace(req.SubmittedBy) == "" {
+		return domain.ErrSubmitterRequired
+	}
+	return nil
+}
+
+// ValidateApproveProductRequest validates the approve product request.
+func ValidateApproveProductRequest(req ApproveProductRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	if strings.TrimSpace(req.Reviewer) == "" {
+		return domain.ErrReviewerRequired
+	}
+	return nil
+}
+
+// ValidateRejectProductRequest validates the reject product request.
+func ValidateRejectProductRequest(req RejectProductRequest) error {
+	if err := ValidateApproveProductRequest(ApproveProductRequest{ProductID: req.ProductID, Reviewer: req.Reviewer}); err != nil {
+		return err
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return domain.ErrRejectionReasonRequired
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateReviewRequests(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr error
+	}{
+		{"valid submit", ValidateSubmitForReviewRequest(SubmitForReviewRequest{ProductID: "product-1", SubmittedBy: "alice"}), nil},
+		{"submit without product ID", ValidateSubmitForReviewRequest(SubmitForReviewRequest{SubmittedBy: "alice"}), domain.ErrInvalidID},
+		{"submit without submitter", ValidateSubmitForReviewRequest(SubmitForReviewRequest{ProductID: "product-1", SubmittedBy: " "}), domain.ErrSubmitterRequired},
+		{"valid approve", ValidateApproveProductRequest(ApproveProductRequest{ProductID: "product-1", Reviewer: "bob"}), nil},
+		{"approve without reviewer", ValidateApproveProductRequest(ApproveProductRequest{ProductID: "product-1"}), domain.ErrReviewerRequired},
+		{"valid reject", ValidateRejectProductRequest(RejectProductRequest{ProductID: "product-1", Reviewer: "bob", Reason: "Missing images"}), nil},
+		{"reject without product ID", ValidateRejectProductRequest(RejectProductRequest{Reviewer: "bob", Reason: "Missing images"}), domain.ErrInvalidID},
+		{"reject without reason", ValidateRejectProductRequest(RejectProductRequest{ProductID: "product-1", Reviewer: "bob"}), domain.ErrRejectionReasonRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr == nil {
+				assert.NoError(t, tt.err)
+			} else {
+				assert.ErrorIs(t, tt.err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/grpc/codes"
+)
+
+// SetSlugRequest represents the input for changing the URL slug of a product.
+type SetSlugRequest struct {
+	ProductID string
+	Slug      string
+}
+
+// SetSlug changes the slug of a product that is not archived, or gives one to a product
+// created before slugs. Links with the previous slug stop working. A slug used by another
+// product fails with domain.ErrSlugTaken.
+func (uc *ProductUseCases) SetSlug(ctx context.Context, req SetSlugRequest) error {
+	slug, err := domain.ParseSlug(req.Slug)
+	if err != nil {
+		return err
+	}
+	id, err := uc.repo.FindIDBySlug(ctx, slug)
+	switch {
+	case errors.Is(err, domain.ErrProductNotFound):
+	case err != nil:
+		return err
+	case id != req.ProductID:
+		return domain.ErrSlugTaken
+	}
+
+	err = uc.changeProduct(ctx, "SetSlug", req.ProductID, func(product *domain.Product, now time.Time) error {
+		return product.ChangeSlug(slug, now)
+	})
+	return slugTakenError(err)
+}
+
+// newSlug returns the slug of a new product named name: the slug built from the name,
+// suffixed if needed to tell it apart from the slugs of existing products.
+func (uc *ProductUseCases) newSlug(ctx context.Context, name string) (string, error) {
+	base := domain.Slugify(name)
+	taken, err := uc.repo.FindSlugs(ctx, base)
+	if err != nil {
+		return "", err
+	}
+	return domain.FreeSlug(base, taken), nil
+}
+
+// slugTakenError returns domain.ErrSlugTaken for a commit that failed because a
+// concurrent command took the slug it writes, and err otherwise.
+func slugTakenError(err error) error {
+	if spanner.ErrCode(err) == codes.AlreadyExists {
+		return domain.ErrSlugTaken
+	}
+	return err
+}
+
+// ValidateSetSlugRequest validates the set slug request.
+func ValidateSetSlugRequest(req SetSlugRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	_, err := domain.ParseSlug(req.Slug)
+	return err
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateSetSlugRequest(t *testing.T) {
+	assert.NoError(t, ValidateSetSlugRequest(SetSlugRequest{ProductID: "product-1", Slug: "Blue-Widget"}))
+	assert.ErrorIs(t, ValidateSetSlugRequest(SetSlugRequest{Slug: "blue-widget"}), domain.ErrInvalidID)
+	assert.ErrorIs(t, ValidateSetSlugRequest(SetSlugRequest{ProductID: "product-1"}), domain.ErrInvalidSlug)
+	assert.ErrorIs(t, ValidateSetSlugRequest(SetSlugRequest{ProductID: "product-1", Slug: "blue widget"}), domain.ErrInvalidSlug)
+}
+
+func TestSlugTakenError(t *testing.T) {
+	assert.NoError(t, slugTakenError(nil))
+	assert.ErrorIs(t, slugTakenError(spanner.ToSpannerError(status.Error(codes.AlreadyExists, "unique index violation"))), domain.ErrSlugTaken)
+	other := errors.New("deadline exceeded")
+	assert.Equal(t, other, slugTakenError(other))
+}
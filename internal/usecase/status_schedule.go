@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ScheduleActivationRequest represents the input for activating and deactivating a
+// product at later times. A zero time clears that part of the schedule.
+type ScheduleActivationRequest struct {
+	ProductID    string
+	ActivateAt   time.Time
+	DeactivateAt time.Time
+}
+
+// ApplyStatusScheduleRequest represents the input for making the scheduled status
+// changes of a product once they are due.
+type ApplyStatusScheduleRequest struct {
+	ProductID string
+}
+
+// ScheduleActivation replaces the scheduled activation and deactivation of a product.
+// The changes are made by the status scheduler. The activation of a product pending
+// review cannot be scheduled, and when products must be reviewed (see
+// WithProductReview), neither can that of a draft: only approved products, which are
+// active, or products deactivated since can be activated on schedule.
+func (uc *ProductUseCases) ScheduleActivation(ctx context.Context, req ScheduleActivationRequest) error {
+	return uc.changeProduct(ctx, "ScheduleActivation", req.ProductID, func(product *domain.Product, now time.Time) error {
+		if uc.reviewRequired && product.Status() == domain.ProductStatusDraft && !req.ActivateAt.IsZero() {
+			return domain.ErrProductReviewRequired
+		}
+		return product.ScheduleActivation(optionalTime(req.ActivateAt), optionalTime(req.DeactivateAt), now)
+	})
+}
+
+// ApplyStatusSchedule activates or deactivates a product once its scheduled change is
+// due, raising product.activated or product.deactivated as ActivateProduct and
+// DeactivateProduct do. It is run by the status scheduler and does nothing if no change
+// is due. A product that fails its compliance checks, or a draft when products must be
+// reviewed, is not activated and the change is kept, so the scheduler makes it once the
+// product is corrected; an approved product is active already.
+func (uc *ProductUseCases) ApplyStatusSchedule(ctx context.Context, req ApplyStatusScheduleRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	activateAt := product.ActivateAt()
+	if uc.reviewRequired && product.Status() == domain.ProductStatusDraft && activateAt != nil && !now.Before(*activateAt) {
+		return domain.ErrProductReviewRequired
+	}
+	changed, err := product.ApplyStatusSchedule(now)
+	if err != nil || !changed {
+		return err
+	}
+	if err := uc.checkCompliance(product); err != nil {
+		return err
+	}
+
+	return uc.commitStatusChange(ctx, "ApplyStatusSchedule", product, now)
+}
+
+// optionalTime returns a pointer to t, or nil if t is zero.
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// ValidateScheduleActivationRequest validates the schedule activation request. Whether
+// the times are in the future is checked against the clock by the product.
+func ValidateScheduleActivationRequest(req ScheduleActivationRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	if !req.ActivateAt.IsZero() && !req.DeactivateAt.IsZero() && !req.DeactivateAt.After(req.ActivateAt) {
+		return domain.ErrInvalidStatusSchedule
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateScheduleActivationRequest(t *testing.T) {
+	midnight := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	monday := midnight.AddDate(0, 0, 5)
+
+	tests := []struct {
+		name    string
+		req     ScheduleActivationRequest
+		wantErr error
+	}{
+		{"valid", ScheduleActivationRequest{ProductID: "product-1", ActivateAt: midnight, DeactivateAt: monday}, nil},
+		{"activation only", ScheduleActivationRequest{ProductID: "product-1", ActivateAt: midnight}, nil},
+		{"deactivation only", ScheduleActivationRequest{ProductID: "product-1", DeactivateAt: midnight}, nil},
+		{"clear", ScheduleActivationRequest{ProductID: "product-1"}, nil},
+		{"missing product ID", ScheduleActivationRequest{ActivateAt: midnight}, domain.ErrInvalidID},
+		{"deactivation before activation", ScheduleActivationRequest{ProductID: "product-1", ActivateAt: monday, DeactivateAt: midnight}, domain.ErrInvalidStatusSchedule},
+		{"deactivation at activation", ScheduleActivationRequest{ProductID: "product-1", ActivateAt: midnight, DeactivateAt: midnight}, domain.ErrInvalidStatusSchedule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateScheduleActivationRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ErrStockDisabled is returned by the stock use cases when no stock repository was
+// configured with WithStock.
+var ErrStockDisabled = errors.New("stock tracking is not enabled")
+
+// SetStockRequest represents the input for replacing the stock of a product, e.g. after a
+// stock take. A non-zero ExpectedVersion makes the change fail with
+// domain.ErrStockConflict unless the stock is still at that version.
+type SetStockRequest struct {
+	ProductID       string
+	Level           int64
+	Reserved        int64
+	ExpectedVersion int64
+}
+
+// AdjustStockRequest represents the input for adding to or taking from the stock of a
+// product; either delta may be negative. ExpectedVersion is as in SetStockRequest.
+type AdjustStockRequest struct {
+	ProductID       string
+	LevelDelta      int64
+	ReservedDelta   int64
+	ExpectedVersion int64
+}
+
+// StockResponse represents the stock of a product after a change.
+type StockResponse struct {
+	Level     int64
+	Reserved  int64
+	Available int64
+	Version   int64
+}
+
+// SetStock replaces the units on hand and reserved of a product that is not archived,
+// starting to track its stock if it was not. Digital products have no stock: it fails
+// with domain.ErrStockNotApplicable for them.
+func (uc *ProductUseCases) SetStock(ctx context.Context, req SetStockRequest) (*StockResponse, error) {
+	return uc.changeStock(ctx, "SetStock", req.ProductID, req.ExpectedVersion, func(stock *domain.Stock) error {
+		return stock.Set(req.Level, req.Reserved, uc.clock.Now())
+	})
+}
+
+// AdjustStock adds the deltas to the units on hand and reserved of a product that is not
+// archived. It fails with domain.ErrInsufficientStock if fewer units would be on hand
+// than reserved.
+func (uc *ProductUseCases) AdjustStock(ctx context.Context, req AdjustStockRequest) (*StockResponse, error) {
+	return uc.changeStock(ctx, "AdjustStock", req.ProductID, req.ExpectedVersion, func(stock *domain.Stock) error {
+		return stock.Adjust(req.LevelDelta, req.ReservedDelta, uc.clock.Now())
+	})
+}
+
+// changeStock loads the stock of a product, applies change and commits it with its
+// events, provided the stock is still at the version it was loaded at. Concurrent
+// changes therefore fail with domain.ErrStockConflict rather than being lost; callers
+// retry with the new stock. Subscribers are notified when an active product is back in
+// stock.
+func (uc *ProductUseCases) changeStock(ctx context.Context, useCase, productID string, expectedVersion int64, change func(*domain.Stock) error) (*StockResponse, error) {
+	if uc.stock == nil {
+		return nil, ErrStockDisabled
+	}
+
+	product, err := uc.repo.FindByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if product.Status() == domain.ProductStatusArchived {
+		return nil, domain.ErrProductArchived
+	}
+	if !product.Kind().HasStock() {
+		return nil, domain.ErrStockNotApplicable
+	}
+	stock, err := uc.stock.FindByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if expectedVersion != 0 && expectedVersion != stock.Version() {
+		return nil, domain.ErrStockConflict
+	}
+
+	loadedVersion := stock.Version()
+	if err := change(stock); err != nil {
+		return nil, err
+	}
+	if len(stock.DomainEvents()) == 0 {
+		return stockResponse(stock), nil
+	}
+
+	now := uc.clock.Now()
+	plan := committer.NewPlanFor(useCase)
+	plan.AddCheck(uc.stock.VersionCheck(productID, loadedVersion))
+	plan.Add(uc.stock.SaveMut(stock))
+	for _, event := range stock.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return nil, err
+		}
+		plan.Add(mut)
+
+		if product.Status() == domain.ProductStatusActive {
+			muts, err := uc.notificationMuts(ctx, event, product)
+			if err != nil {
+				return nil, err
+			}
+			plan.AddAll(muts...)
+		}
+	}
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	if uc.publisher != nil {
+		uc.publisher.Publish(ctx, stock.DomainEvents()...)
+	}
+	return stockResponse(stock), nil
+}
+
+func stockResponse(stock *domain.Stock) *StockResponse {
+	return &StockResponse{
+		Level:     stock.Level(),
+		Reserved:  stock.Reserved(),
+		Available: stock.Available(),
+		Version:   stock.Version(),
+	}
+}
+
+// ValidateSetStockRequest validates the set stock request.
+func ValidateSetStockRequest(req SetStockRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	if req.Level < 0 || req.Level > domain.MaxStockLevel || req.Reserved < 0 || req.Reserved > req.Level {
+		return domain.ErrInvalidStock
+	}
+	if req.ExpectedVersion < 0 {
+		return domain.ErrInvalidStockVersion
+	}
+	return nil
+}
+
+// ValidateAdjustStockRequest validates the adjust stock request.
+func ValidateAdjustStockRequest(req AdjustStockRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	if req.LevelDelta < -domain.MaxStockLevel || req.LevelDelta > domain.MaxStockLevel ||
+		req.ReservedDelta < -domain.MaxStockLevel || req.ReservedDelta > domain.MaxStockLevel {
+		return domain.ErrInvalidStock
+	}
+	if req.ExpectedVersion < 0 {
+		return domain.ErrInvalidStockVersion
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSetStockRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     SetStockRequest
+		wantErr error
+	}{
+		{"valid", SetStockRequest{ProductID: "product-1", Level: 10, Reserved: 2}, nil},
+		{"none", SetStockRequest{ProductID: "product-1"}, nil},
+		{"expected version", SetStockRequest{ProductID: "product-1", Level: 10, ExpectedVersion: 3}, nil},
+		{"missing product ID", SetStockRequest{Level: 10}, domain.ErrInvalidID},
+		{"negative level", SetStockRequest{ProductID: "product-1", Level: -1}, domain.ErrInvalidStock},
+		{"more reserved than on hand", SetStockRequest{ProductID: "product-1", Level: 1, Reserved: 2}, domain.ErrInvalidStock},
+		{"too many", SetStockRequest{ProductID: "product-1", Level: domain.MaxStockLevel + 1}, domain.ErrInvalidStock},
+		{"negative version", SetStockRequest{ProductID: "product-1", ExpectedVersion: -1}, domain.ErrInvalidStockVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetStockRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAdjustStockRequest(t *testing.T) {
+	assert.NoError(t, ValidateAdjustStockRequest(AdjustStockRequest{ProductID: "product-1", LevelDelta: -2, ReservedDelta: -2}))
+	assert.ErrorIs(t, ValidateAdjustStockRequest(AdjustStockRequest{LevelDelta: 1}), domain.ErrInvalidID)
+	assert.ErrorIs(t, ValidateAdjustStockRequest(AdjustStockRequest{ProductID: "product-1", LevelDelta: domain.MaxStockLevel + 1}), domain.ErrInvalidStock)
+	assert.ErrorIs(t, ValidateAdjustStockRequest(AdjustStockRequest{ProductID: "product-1", LevelDelta: 1, ExpectedVersion: -1}), domain.ErrInvalidStockVersion)
+}
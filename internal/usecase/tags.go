@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// TagRequest represents the input for adding a tag to or removing a tag from a product.
+type TagRequest struct {
+	ProductID string
+	Tag       string
+}
+
+// SetAttributeRequest represents the input for setting an attribute of a product. An
+// empty Value removes the attribute.
+type SetAttributeRequest struct {
+	ProductID string
+	Name      string
+	Value     string
+}
+
+// AddTag tags a product that is not archived. Adding a tag the product already has
+// changes nothing.
+func (uc *ProductUseCases) AddTag(ctx context.Context, req TagRequest) error {
+	return uc.changeProduct(ctx, "AddTag", req.ProductID, func(product *domain.Product, now time.Time) error {
+		return product.AddTag(req.Tag, now)
+	})
+}
+
+// RemoveTag removes a tag from a product that is not archived. Removing a tag the
+// product does not have changes nothing.
+func (uc *ProductUseCases) RemoveTag(ctx context.Context, req TagRequest) error {
+	return uc.changeProduct(ctx, "RemoveTag", req.ProductID, func(product *domain.Product, now time.Time) error {
+		return product.RemoveTag(req.Tag, now)
+	})
+}
+
+// SetAttribute sets or removes an attribute of a product that is not archived. With
+// attribute schemas (see WithAttributeSchemas), the attribute must be defined by the
+// schema of the product's category with a valid value, and a required attribute cannot be
+// removed; the other attributes are not checked.
+func (uc *ProductUseCases) SetAttribute(ctx context.Context, req SetAttributeRequest) error {
+	return uc.changeProduct(ctx, "SetAttribute", req.ProductID, func(product *domain.Product, now time.Time) error {
+		schema, err := uc.attributeSchema(ctx, product.Category())
+		if err != nil {
+			return err
+		}
+		if schema != nil {
+			if err := schema.ValidateAttribute(req.Name, req.Value); err != nil {
+				return err
+			}
+		}
+		return product.SetAttribute(req.Name, req.Value, now)
+	})
+}
+
+// attributeSchema returns the attribute schema of a category, or nil if it has none or
+// attribute schemas are not enabled.
+func (uc *ProductUseCases) attributeSchema(ctx context.Context, category string) (*domain.CategoryAttributeSchema, error) {
+	if uc.schemas == nil {
+		return nil, nil
+	}
+	return uc.schemas.Find(ctx, category)
+}
+
+// checkAttributes validates all attributes of a product against the attribute schema of
+// its category, returning a *domain.AttributeSchemaError listing every violation. Products
+// of a category without a schema may have any attributes.
+func (uc *ProductUseCases) checkAttributes(ctx context.Context, product *domain.Product) error {
+	schema, err := uc.attributeSchema(ctx, product.Category())
+	if err != nil || schema == nil {
+		return err
+	}
+	return schema.Validate(product.Attributes())
+}
+
+// changeProduct loads a product, applies change and commits the product with its events,
+// if it changed.
+func (uc *ProductUseCases) changeProduct(ctx context.Context, useCase, productID string, change func(*domain.Product, time.Time) error) error {
+	product, err := uc.repo.FindByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := change(product, now); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlanFor(useCase)
+
+	if mut := uc.repo.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+	}
+
+	if !plan.IsEmpty() {
+		if err := uc.committer.Apply(ctx, plan); err != nil {
+			return err
+		}
+	}
+
+	uc.publishEvents(ctx, product)
+	return nil
+}
+
+// ValidateTagRequest validates the add and remove tag requests.
+func ValidateTagRequest(req TagRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	_, err := domain.ParseTag(req.Tag)
+	return err
+}
+
+// ValidateSetAttributeRequest validates the set attribute request.
+func ValidateSetAttributeRequest(req SetAttributeRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	if _, err := domain.ParseProductAttributeName(req.Name); err != nil {
+		return err
+	}
+	if len(strings.TrimSpace(req.Value)) > domain.MaxProductAttributeValueLength {
+		return domain.ErrInvalidProductAttribute
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTagRequest(t *testing.T) {
+	assert.NoError(t, ValidateTagRequest(TagRequest{ProductID: "product-1", Tag: "Summer-Sale"}))
+	assert.ErrorIs(t, ValidateTagRequest(TagRequest{Tag: "sale"}), domain.ErrInvalidID)
+	assert.ErrorIs(t, ValidateTagRequest(TagRequest{ProductID: "product-1"}), domain.ErrInvalidTag)
+	assert.ErrorIs(t, ValidateTagRequest(TagRequest{ProductID: "product-1", Tag: "big sale"}), domain.ErrInvalidTag)
+}
+
+func TestValidateSetAttributeRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     SetAttributeRequest
+		wantErr error
+	}{
+		{"valid", SetAttributeRequest{ProductID: "product-1", Name: "material", Value: "oak"}, nil},
+		{"remove", SetAttributeRequest{ProductID: "product-1", Name: "material"}, nil},
+		{"missing product ID", SetAttributeRequest{Name: "material", Value: "oak"}, domain.ErrInvalidID},
+		{"missing name", SetAttributeRequest{ProductID: "product-1", Value: "oak"}, domain.ErrInvalidProductAttribute},
+		{"uppercase name", SetAttributeRequest{ProductID: "product-1", Name: "Material", Value: "oak"}, domain.ErrInvalidProductAttribute},
+		{"long value", SetAttributeRequest{ProductID: "product-1", Name: "material", Value: strings.Repeat("a", domain.MaxProductAttributeValueLength+1)}, domain.ErrInvalidProductAttribute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetAttributeRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
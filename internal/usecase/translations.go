@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+)
+
+// ErrTranslationsDisabled is returned by SetTranslation when no translation repository
+// was configured with WithTranslations.
+var ErrTranslationsDisabled = errors.New("product translations are not enabled")
+
+// SetTranslationRequest represents the input for setting the name and description of a
+// product in a locale other than domain.DefaultLocale. An empty Name and Description
+// remove the translation.
+type SetTranslationRequest struct {
+	ProductID   string
+	Locale      string
+	Name        string
+	Description string
+}
+
+// SetTranslation sets or removes the translation of a product that is not archived in a
+// locale. Setting the translation the product already has, or removing one it does not
+// have, changes nothing.
+func (uc *ProductUseCases) SetTranslation(ctx context.Context, req SetTranslationRequest) error {
+	if uc.translations == nil {
+		return ErrTranslationsDisabled
+	}
+	locale, err := domain.ParseLocale(req.Locale)
+	if err != nil {
+		return err
+	}
+	if locale == domain.DefaultLocale {
+		return domain.ErrDefaultLocaleTranslation
+	}
+	now := uc.clock.Now()
+	translation, err := requestTranslation(req, now)
+	if err != nil {
+		return err
+	}
+
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+	if product.Status() == domain.ProductStatusArchived {
+		return domain.ErrProductArchived
+	}
+	existing, err := uc.translations.FindByLocale(ctx, req.ProductID, locale)
+	if err != nil {
+		return err
+	}
+
+	plan := committer.NewPlanFor("SetTranslation")
+	switch {
+	case translation == nil && existing == nil:
+		return nil
+	case translation == nil:
+		plan.Add(uc.translations.DeleteMut(req.ProductID, locale))
+	case existing != nil && existing.Name() == translation.Name() && existing.Description() == translation.Description():
+		return nil
+	default:
+		plan.Add(uc.translations.SaveMut(translation))
+	}
+
+	event := domain.NewProductTranslationChangedEvent(req.ProductID, locale, translation, now)
+	mut, err := uc.eventMut(event, product, now)
+	if err != nil {
+		return err
+	}
+	plan.Add(mut)
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	if uc.publisher != nil {
+		uc.publisher.Publish(ctx, event)
+	}
+	return nil
+}
+
+// requestTranslation returns the translation set by a request, or nil if it removes the
+// translation.
+func requestTranslation(req SetTranslationRequest, now time.Time) (*domain.ProductTranslation, error) {
+	if req.Name == "" && req.Description == "" {
+		return nil, nil
+	}
+	return domain.NewProductTranslation(req.ProductID, req.Locale, req.Name, req.Description, now)
+}
+
+// ValidateSetTranslationRequest validates the set translation request.
+func ValidateSetTranslationRequest(req SetTranslationRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	locale, err := domain.ParseLocale(req.Locale)
+	if err != nil {
+		return err
+	}
+	if locale == domain.DefaultLocale {
+		return domain.ErrDefaultLocaleTranslation
+	}
+	_, err = requestTranslation(req, time.Time{})
+	return err
+}
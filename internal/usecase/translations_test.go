@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSetTranslationRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     SetTranslationRequest
+		wantErr error
+	}{
+		{"valid", SetTranslationRequest{ProductID: "product-1", Locale: "de", Name: "Stuhl", Description: "Aus Massivholz"}, nil},
+		{"name only", SetTranslationRequest{ProductID: "product-1", Locale: "pt-BR", Name: "Cadeira"}, nil},
+		{"remove", SetTranslationRequest{ProductID: "product-1", Locale: "de"}, nil},
+		{"missing product ID", SetTranslationRequest{Locale: "de", Name: "Stuhl"}, domain.ErrInvalidID},
+		{"invalid locale", SetTranslationRequest{ProductID: "product-1", Locale: "german", Name: "Stuhl"}, domain.ErrInvalidLocale},
+		{"default locale", SetTranslationRequest{ProductID: "product-1", Locale: "en", Name: "Chair"}, domain.ErrDefaultLocaleTranslation},
+		{"remove default locale", SetTranslationRequest{ProductID: "product-1", Locale: "en"}, domain.ErrDefaultLocaleTranslation},
+		{"description only", SetTranslationRequest{ProductID: "product-1", Locale: "de", Description: "Aus Massivholz"}, domain.ErrInvalidTranslatedName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetTranslationRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
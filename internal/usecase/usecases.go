@@ -3,14 +3,20 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
-	"github.com/google/uuid"
-	"github.com/product-catalog-service/internal/contract"
-	"github.com/product-catalog-service/internal/domain"
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/caller"
 	"github.com/product-catalog-service/internal/clock"
 	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/idgen"
+	"github.com/product-catalog-service/internal/logging"
 )
 
 // CreateProductRequest represents the input for creating a product.
@@ -20,11 +26,27 @@ type CreateProductRequest struct {
 	Category             string
 	BasePriceNumerator   int64
 	BasePriceDenominator int64
+	// Currency is the ISO 4217 code of the product's prices; domain.DefaultCurrency if empty.
+	Currency string
+	// SKU and GTIN are the optional identifiers of the product; see SetIdentifiers.
+	SKU  string
+	GTIN string
+	// Attributes are the optional attributes of the product, e.g. {"material": "oak"}.
+	Attributes map[string]string
+	// Weight and Dimensions are the optional weight and dimensions of the product; see
+	// SetDimensions.
+	Weight     *WeightInput
+	Dimensions *DimensionsInput
+	// Kind is "physical", which requires Weight and Dimensions, or "digital", which
+	// forbids them; the kind is unset if empty. See SetKind.
+	Kind string
 }
 
 // CreateProductResponse represents the output of creating a product.
 type CreateProductResponse struct {
 	ProductID string
+	// Slug is the URL slug built from the name of the product.
+	Slug string
 }
 
 // UpdateProductRequest represents the input for updating a product.
@@ -33,6 +55,18 @@ type UpdateProductRequest struct {
 	Name        string
 	Description string
 	Category    string
+	// Attributes replace the attributes of the product if not empty; the product keeps
+	// its attributes otherwise.
+	Attributes map[string]string
+}
+
+// ChangeBasePriceRequest represents the input for changing the base price of a product.
+type ChangeBasePriceRequest struct {
+	ProductID            string
+	BasePriceNumerator   int64
+	BasePriceDenominator int64
+	// Currency must be the currency of the product if set; empty means the product's currency.
+	Currency string
 }
 
 // ActivateProductRequest represents the input for activating a product.
@@ -51,100 +85,1215 @@ type ArchiveProductRequest struct {
 }
 
 // ApplyDiscountRequest represents the input for applying a discount to a product.
+// Among discounts valid at the same time, the one with the highest Priority applies.
+// Setting BuyQuantity and GetQuantity applies a buy-X-get-Y promotion instead of a
+// percentage discount; DiscountPercentage must then be zero. Setting Market scopes the
+// discount to the price of the product in that market, which the product must have.
 type ApplyDiscountRequest struct {
 	ProductID          string
 	DiscountPercentage float64
+	Priority           int
 	StartDate          time.Time
 	EndDate            time.Time
+	BuyQuantity        int
+	GetQuantity        int
+	Market             string
+	// FlashSale marks the discount as a flash sale; see domain.Discount.AsFlashSale.
+	FlashSale bool
+	// SalePriceDenominator is set for a discount selling at a sale price instead of a
+	// percentage off; see domain.NewSalePriceDiscount. DiscountPercentage must then be 0.
+	// An empty SalePriceCurrency means the product's currency.
+	SalePriceNumerator   int64
+	SalePriceDenominator int64
+	SalePriceCurrency    string
+}
+
+// IsSalePrice reports whether the request applies a sale price discount.
+func (r ApplyDiscountRequest) IsSalePrice() bool {
+	return r.SalePriceDenominator != 0
+}
+
+// IsPromotion reports whether the request applies a buy-X-get-Y promotion.
+func (r ApplyDiscountRequest) IsPromotion() bool {
+	return r.BuyQuantity != 0 || r.GetQuantity != 0
+}
+
+// ApplyDiscountResponse represents the output of applying a discount.
+// PendingApproval is set if the discount exceeds the approval threshold and does not
+// apply until approved.
+type ApplyDiscountResponse struct {
+	DiscountID      string
+	PendingApproval bool
 }
 
 // RemoveDiscountRequest represents the input for removing a discount from a product.
+// An empty DiscountID removes all discounts of the product.
 type RemoveDiscountRequest struct {
+	ProductID  string
+	DiscountID string
+}
+
+// PriceTierRequest describes one price tier: from MinQuantity units on, each unit costs
+// either the given unit price or the base price less PercentOff percent. Exactly one of
+// the two is set.
+type PriceTierRequest struct {
+	MinQuantity          int64
+	UnitPriceNumerator   int64
+	UnitPriceDenominator int64
+	// UnitPriceCurrency must be the currency of the product if set; empty means the
+	// product's currency.
+	UnitPriceCurrency string
+	PercentOff        float64
+}
+
+// SetPriceTiersRequest represents the input for replacing the price tiers of a product.
+// An empty Tiers removes every tier.
+type SetPriceTiersRequest struct {
+	ProductID string
+	Tiers     []PriceTierRequest
+}
+
+// PriceRequest represents the base price of a product in one currency of its price book.
+type PriceRequest struct {
+	Numerator   int64
+	Denominator int64
+	Currency    string
+}
+
+// SetPriceBookRequest represents the input for replacing the price book of a product.
+// An empty Prices removes every entry.
+type SetPriceBookRequest struct {
+	ProductID string
+	Prices    []PriceRequest
+}
+
+// SegmentPriceRequest describes the price of a product for one customer segment: either
+// a fixed price replacing the base price or the base price less PercentOff percent.
+// Exactly one of the two is set.
+type SegmentPriceRequest struct {
+	Segment          string
+	PriceNumerator   int64
+	PriceDenominator int64
+	// PriceCurrency must be the currency of the product if set; empty means the
+	// product's currency.
+	PriceCurrency string
+	PercentOff    float64
+}
+
+// SetSegmentPricesRequest represents the input for replacing the customer segment prices
+// of a product. An empty Prices removes every segment price.
+type SetSegmentPricesRequest struct {
+	ProductID string
+	Prices    []SegmentPriceRequest
+}
+
+// MarketPriceRequest describes the base price of a product in one market.
+type MarketPriceRequest struct {
+	Market      string
+	Numerator   int64
+	Denominator int64
+	// Currency must be the currency of the market if set; empty means the market's
+	// currency.
+	Currency string
+}
+
+// SetMarketPricesRequest represents the input for replacing the market prices of a
+// product. An empty Prices removes every market price.
+type SetMarketPricesRequest struct {
+	ProductID string
+	Prices    []MarketPriceRequest
+}
+
+// SetTaxClassRequest represents the input for changing the tax class of a product.
+type SetTaxClassRequest struct {
+	ProductID string
+	TaxClass  string
+}
+
+// SetMinimumPriceRequest represents the input for setting the minimum price of a product.
+// A zero numerator and denominator remove the minimum price.
+type SetMinimumPriceRequest struct {
+	ProductID   string
+	Numerator   int64
+	Denominator int64
+	// Currency must be the currency of the product if set; empty means the product's currency.
+	Currency string
+}
+
+// SetCostPriceRequest represents the input for setting the cost price of a product.
+// A zero numerator and denominator remove the cost price.
+type SetCostPriceRequest struct {
+	ProductID   string
+	Numerator   int64
+	Denominator int64
+	// Currency must be the currency of the product if set; empty means the product's currency.
+	Currency string
+}
+
+// AdvanceDiscountPhaseRequest represents the input for announcing the discount period
+// boundaries of a product that have passed.
+type AdvanceDiscountPhaseRequest struct {
 	ProductID string
 }
 
+// SubscribeToNotificationsRequest represents the input for subscribing a customer to
+// notifications about a product.
+type SubscribeToNotificationsRequest struct {
+	ProductID    string
+	SubscriberID string
+	Kind         domain.NotificationKind
+}
+
+// UnsubscribeFromNotificationsRequest represents the input for removing a subscription.
+type UnsubscribeFromNotificationsRequest struct {
+	ProductID    string
+	SubscriberID string
+	Kind         domain.NotificationKind
+}
+
+// MaxNotificationSubscribers is the maximum number of subscribers targeted by a single
+// notification event; larger subscriber lists are split across several events.
+// It matches maxItems of subscriber_ids in the notification event schemas.
+const MaxNotificationSubscribers = 500
+
+// ErrNotificationsDisabled is returned by the subscription use cases when no
+// subscription repository was configured with WithNotifications.
+var ErrNotificationsDisabled = errors.New("product notifications are not enabled")
+
 // ProductUseCases provides all product-related use cases.
 type ProductUseCases struct {
-	repo       contract.ProductRepository
-	outboxRepo contract.OutboxRepository
-	committer  *committer.Committer
-	clock      clock.Clock
+	repo          contract.ProductRepository
+	outboxRepo    contract.OutboxRepository
+	committer     *committer.Committer
+	clock         clock.Clock
+	ids           idgen.Generator
+	publisher     contract.EventPublisher
+	subscriptions contract.NotificationSubscriptionRepository
+	freezes       contract.FreezeWindowRepository
+	blackouts     contract.DiscountBlackoutRepository
+	campaigns     contract.CampaignRepository
+	priceLists    contract.PriceListRepository
+	variants      contract.ProductVariantRepository
+	stock         contract.StockRepository
+	images        contract.ProductImageRepository
+	attachments   contract.ProductAttachmentRepository
+	relations     contract.ProductRelationRepository
+	translations  contract.ProductTranslationRepository
+	schemas       contract.AttributeSchemaRepository
+	ratings       contract.RatingRepository
+
+	eventSnapshots    bool
+	marginGuard       domain.MarginGuard
+	approvalThreshold *big.Rat
+	reviewRequired    bool
+	compliancePolicy  *domain.CompliancePolicy
+}
+
+// Option configures optional ProductUseCases behavior.
+type Option func(*ProductUseCases)
+
+// WithEventSnapshots embeds the full product state in every outbox event,
+// so consumers do not need to read the product back.
+func WithEventSnapshots(enabled bool) Option {
+	return func(uc *ProductUseCases) {
+		uc.eventSnapshots = enabled
+	}
+}
+
+// WithEventPublisher delivers the events of every committed command to publisher, so
+// in-process subscribers can react to them. Events are published only after the commit
+// succeeds.
+func WithEventPublisher(publisher contract.EventPublisher) Option {
+	return func(uc *ProductUseCases) {
+		uc.publisher = publisher
+	}
+}
+
+// WithNotifications fans events that customers can subscribe to out to the subscribers
+// stored in subscriptions, as targeted notification events written to the outbox in the
+// same transaction as the triggering event. It also enables the subscription use cases.
+func WithNotifications(subscriptions contract.NotificationSubscriptionRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.subscriptions = subscriptions
+	}
+}
+
+// WithFreezeWindows refuses price and discount changes during the freeze windows stored in
+// freezes, unless the caller (see package caller) is exempt.
+func WithFreezeWindows(freezes contract.FreezeWindowRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.freezes = freezes
+	}
+}
+
+// WithDiscountBlackouts refuses discounts that would be valid during a discount blackout
+// stored in blackouts of the category of the product.
+func WithDiscountBlackouts(blackouts contract.DiscountBlackoutRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.blackouts = blackouts
+	}
+}
+
+// WithAttributeSchemas validates the attributes of products against the attribute schema
+// stored in schemas of their category, if it has one; see checkAttributes.
+func WithAttributeSchemas(schemas contract.AttributeSchemaRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.schemas = schemas
+	}
+}
+
+// WithRatings stores the ratings of customer reviews in ratings and enables IngestReview.
+func WithRatings(ratings contract.RatingRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.ratings = ratings
+	}
+}
+
+// WithCampaigns stores campaigns in campaigns and enables the campaign use cases.
+func WithCampaigns(campaigns contract.CampaignRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.campaigns = campaigns
+	}
+}
+
+// WithPriceLists stores price lists in priceLists and enables the price list use cases.
+func WithPriceLists(priceLists contract.PriceListRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.priceLists = priceLists
+	}
+}
+
+// WithVariants stores product variants in variants and enables the variant use cases.
+func WithVariants(variants contract.ProductVariantRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.variants = variants
+	}
+}
+
+// WithStock stores the stock of products in stock and enables the stock use cases.
+func WithStock(stock contract.StockRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.stock = stock
+	}
+}
+
+// WithImages stores the images of products in images and enables the image use cases.
+func WithImages(images contract.ProductImageRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.images = images
+	}
+}
+
+// WithAttachments stores the support documents of products in attachments and enables
+// ManageAttachments.
+func WithAttachments(attachments contract.ProductAttachmentRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.attachments = attachments
+	}
+}
+
+// WithRelations stores the links between products in relations and enables the relation
+// use cases.
+func WithRelations(relations contract.ProductRelationRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.relations = relations
+	}
+}
+
+// WithTranslations stores the names and descriptions of products in other locales in
+// translations and enables SetTranslation.
+func WithTranslations(translations contract.ProductTranslationRepository) Option {
+	return func(uc *ProductUseCases) {
+		uc.translations = translations
+	}
+}
+
+// WithMarginGuard sets what happens when a discount would bring the price of a product
+// below its cost price. The default is domain.DefaultMarginGuard.
+func WithMarginGuard(guard domain.MarginGuard) Option {
+	return func(uc *ProductUseCases) {
+		uc.marginGuard = guard
+	}
+}
+
+// WithDiscountApprovalThreshold holds discounts of more than threshold percent applied
+// with ApplyDiscount pending approval; see ApproveDiscount. A nil threshold, the
+// default, applies every discount right away.
+func WithDiscountApprovalThreshold(threshold *big.Rat) Option {
+	return func(uc *ProductUseCases) {
+		uc.approvalThreshold = threshold
+	}
+}
+
+// WithProductReview refuses to activate a draft product that was not approved, so every
+// product is submitted for review and approved by a second person before it first goes
+// on sale; see SubmitForReview and ApproveProduct. By default drafts can be activated
+// directly.
+func WithProductReview(required bool) Option {
+	return func(uc *ProductUseCases) {
+		uc.reviewRequired = required
+	}
+}
+
+// WithCompliancePolicy checks the compliance flags of products against policy when they
+// are activated; see domain.Product.CheckCompliance. Without a policy, age-restricted
+// products cannot be activated in any category.
+func WithCompliancePolicy(policy *domain.CompliancePolicy) Option {
+	return func(uc *ProductUseCases) {
+		uc.compliancePolicy = policy
+	}
+}
+
+// WithIDGenerator generates the IDs of new products, discounts and campaigns with ids
+// instead of random UUIDs, e.g. to seed a known dataset.
+func WithIDGenerator(ids idgen.Generator) Option {
+	return func(uc *ProductUseCases) {
+		uc.ids = ids
+	}
+}
+
+// NewProductUseCases creates a new ProductUseCases instance.
+func NewProductUseCases(
+	repo contract.ProductRepository,
+	outboxRepo contract.OutboxRepository,
+	committer *committer.Committer,
+	clock clock.Clock,
+	opts ...Option,
+) *ProductUseCases {
+	uc := &ProductUseCases{
+		repo:        repo,
+		outboxRepo:  outboxRepo,
+		committer:   committer,
+		clock:       clock,
+		ids:         idgen.NewUUIDGenerator(),
+		marginGuard: domain.DefaultMarginGuard,
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
+}
+
+// eventMut returns the outbox mutation for an event raised by product.
+// The snapshot reflects the product after the whole command, so every event of a
+// command carries the same state.
+func (uc *ProductUseCases) eventMut(event domain.DomainEvent, product *domain.Product, now time.Time) (*spanner.Mutation, error) {
+	if uc.eventSnapshots {
+		return uc.outboxRepo.InsertDomainEventWithSnapshotMut(event, product, now)
+	}
+	return uc.outboxRepo.InsertTenantEventMut(product.TenantID(), event)
+}
+
+// notificationMuts returns the outbox mutations that notify the subscribers of event,
+// if notifications are enabled and the event is one customers can subscribe to.
+// Subscribers are read before the commit, so a subscription made concurrently with the
+// command may miss the notification.
+func (uc *ProductUseCases) notificationMuts(ctx context.Context, event domain.DomainEvent, product *domain.Product) ([]*spanner.Mutation, error) {
+	if uc.subscriptions == nil {
+		return nil, nil
+	}
+	kind, ok := domain.NotifiedKind(event)
+	if !ok {
+		return nil, nil
+	}
+
+	subscribers, err := uc.subscriptions.FindSubscribers(ctx, event.AggregateID(), kind)
+	if err != nil {
+		return nil, err
+	}
+
+	muts := make([]*spanner.Mutation, 0, (len(subscribers)+MaxNotificationSubscribers-1)/MaxNotificationSubscribers)
+	for start := 0; start < len(subscribers); start += MaxNotificationSubscribers {
+		end := min(start+MaxNotificationSubscribers, len(subscribers))
+		mut, err := uc.outboxRepo.InsertNotificationMut(kind, event, product, subscribers[start:end])
+		if err != nil {
+			return nil, err
+		}
+		muts = append(muts, mut)
+	}
+	return muts, nil
+}
+
+// cancelSubscriptionMuts returns the mutations that cancel every notification
+// subscription of an archived product, which could never fire again, and tell the
+// subscribers of each kind, in batches of MaxNotificationSubscribers.
+func (uc *ProductUseCases) cancelSubscriptionMuts(ctx context.Context, archived domain.ProductArchivedEvent, product *domain.Product) ([]*spanner.Mutation, error) {
+	if uc.subscriptions == nil {
+		return nil, nil
+	}
+
+	var muts []*spanner.Mutation
+	for _, kind := range domain.NotificationKinds() {
+		subscribers, err := uc.subscriptions.FindSubscribers(ctx, product.ID(), kind)
+		if err != nil {
+			return nil, err
+		}
+		for start := 0; start < len(subscribers); start += MaxNotificationSubscribers {
+			end := min(start+MaxNotificationSubscribers, len(subscribers))
+			mut, err := uc.outboxRepo.InsertSubscriptionCancelledMut(kind, archived, product, subscribers[start:end])
+			if err != nil {
+				return nil, err
+			}
+			muts = append(muts, mut)
+		}
+	}
+	if len(muts) == 0 {
+		return nil, nil
+	}
+	return append(muts, uc.subscriptions.DeleteAllMut(product.ID())), nil
+}
+
+// checkFreeze returns an error wrapping domain.ErrCatalogFrozen if a freeze window refuses
+// price and discount changes by the caller of ctx at the given time.
+func (uc *ProductUseCases) checkFreeze(ctx context.Context, now time.Time) error {
+	if uc.freezes == nil {
+		return nil
+	}
+	windows, err := uc.freezes.FindActive(ctx, now)
+	if err != nil {
+		return err
+	}
+	id := caller.FromContext(ctx)
+	for _, w := range windows {
+		if w.Freezes(id.Tenant, id.Role, now) {
+			return freezeError(w)
+		}
+	}
+	return nil
+}
+
+// freezeError describes the freeze window that refused a change.
+func freezeError(w *domain.FreezeWindow) error {
+	if w.Reason() == "" {
+		return fmt.Errorf("%w until %s (freeze %s)", domain.ErrCatalogFrozen, w.End().Format(time.RFC3339), w.ID())
+	}
+	return fmt.Errorf("%w until %s (freeze %s: %s)", domain.ErrCatalogFrozen, w.End().Format(time.RFC3339), w.ID(), w.Reason())
+}
+
+// discountBlackouts returns the discount blackouts of every category that overlap the
+// period of discount.
+func (uc *ProductUseCases) discountBlackouts(ctx context.Context, discount *domain.Discount) ([]*domain.DiscountBlackout, error) {
+	if uc.blackouts == nil {
+		return nil, nil
+	}
+	return uc.blackouts.FindOverlapping(ctx, discount.StartDate(), discount.EndDate())
+}
+
+// blackoutError returns an error wrapping domain.ErrDiscountBlackedOut that describes the
+// first of blackouts refusing discount on a product of the category, or nil if none does.
+func blackoutError(blackouts []*domain.DiscountBlackout, category string, discount *domain.Discount) error {
+	for _, b := range blackouts {
+		if !b.Blocks(category, discount) {
+			continue
+		}
+		period := fmt.Sprintf("from %s until %s", b.Start().Format(time.RFC3339), b.End().Format(time.RFC3339))
+		if b.Reason() == "" {
+			return fmt.Errorf("%w %s (blackout %s)", domain.ErrDiscountBlackedOut, period, b.ID())
+		}
+		return fmt.Errorf("%w %s (blackout %s: %s)", domain.ErrDiscountBlackedOut, period, b.ID(), b.Reason())
+	}
+	return nil
+}
+
+// publishEvents hands the events raised by product to the in-process publisher, if any.
+// It must only be called after the command has been committed.
+func (uc *ProductUseCases) publishEvents(ctx context.Context, product *domain.Product) {
+	if uc.publisher == nil {
+		return
+	}
+	if events := product.DomainEvents(); len(events) > 0 {
+		uc.publisher.Publish(ctx, events...)
+	}
+}
+
+// CreateProduct creates a new product with a slug built from its name, suffixed with
+// "-2", "-3" and so on if products already have the slug. A slug taken by a product
+// created concurrently fails with domain.ErrSlugTaken; retrying picks the next free slug.
+// The product belongs to the tenant of the caller; see package caller.
+func (uc *ProductUseCases) CreateProduct(ctx context.Context, req CreateProductRequest) (*CreateProductResponse, error) {
+	basePrice, err := newMoney(req.BasePriceNumerator, req.BasePriceDenominator, req.Currency, domain.DefaultCurrency)
+	if err != nil {
+		return nil, err
+	}
+	productID := uc.ids.NewID()
+	now := uc.clock.Now()
+
+	product, err := domain.NewProduct(
+		productID,
+		req.Name,
+		req.Description,
+		req.Category,
+		basePrice,
+		now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, _ := caller.Tenant(ctx)
+	if err := product.AssignTenant(tenantID); err != nil {
+		return nil, err
+	}
+	if err := product.AssignIdentifiers(req.SKU, req.GTIN); err != nil {
+		return nil, err
+	}
+	weight, dimensions, err := parseDimensions(req.Weight, req.Dimensions)
+	if err != nil {
+		return nil, err
+	}
+	if weight != nil || dimensions != nil {
+		product.AssignDimensions(weight, dimensions)
+	}
+	if err := product.AssignKind(domain.ProductKind(req.Kind)); err != nil {
+		return nil, err
+	}
+	if err := product.ReplaceAttributes(req.Attributes, now); err != nil {
+		return nil, err
+	}
+	if err := uc.checkAttributes(ctx, product); err != nil {
+		return nil, err
+	}
+
+	if err := uc.insertProduct(ctx, "CreateProduct", product, now); err != nil {
+		return nil, err
+	}
+	return &CreateProductResponse{ProductID: productID, Slug: product.Slug()}, nil
+}
+
+// insertProduct gives a new product its slug, checks that its identifiers are free and
+// commits it with its events, as CreateProduct describes.
+func (uc *ProductUseCases) insertProduct(ctx context.Context, useCase string, product *domain.Product, now time.Time) error {
+	slug, err := uc.newSlug(ctx, product.Name())
+	if err != nil {
+		return err
+	}
+	if err := product.AssignSlug(slug); err != nil {
+		return err
+	}
+	if err := uc.checkIdentifiersAvailable(ctx, product.ID(), product.SKU(), product.GTIN()); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlanFor(useCase)
+
+	if mut := uc.repo.InsertMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.EffectivePriceMuts(product)...)
+	plan.Add(uc.repo.PriceHistoryMut(product, now))
+
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
+		}
+		plan.AddAll(muts...)
+	}
+
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return slugTakenError(uc.identifiersTakenError(ctx, product.ID(), product.SKU(), product.GTIN(), err))
+	}
+
+	uc.publishEvents(ctx, product)
+	return nil
 }
 
-// NewProductUseCases creates a new ProductUseCases instance.
-func NewProductUseCases(
-	repo contract.ProductRepository,
-	outboxRepo contract.OutboxRepository,
-	committer *committer.Committer,
-	clock clock.Clock,
-) *ProductUseCases {
-	return &ProductUseCases{
-		repo:       repo,
-		outboxRepo: outboxRepo,
-		committer:  committer,
-		clock:      clock,
+// UpdateProduct updates an existing product.
+func (uc *ProductUseCases) UpdateProduct(ctx context.Context, req UpdateProductRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := product.Update(req.Name, req.Description, req.Category, now); err != nil {
+		return err
+	}
+	if len(req.Attributes) > 0 {
+		if err := product.ReplaceAttributes(req.Attributes, now); err != nil {
+			return err
+		}
+	}
+	if product.Changes().Dirty(domain.FieldCategory) || product.Changes().Dirty(domain.FieldAttributes) {
+		if err := uc.checkAttributes(ctx, product); err != nil {
+			return err
+		}
+	}
+
+	plan := committer.NewPlanFor("UpdateProduct")
+
+	if mut := uc.repo.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.DiscountMuts(product)...)
+	plan.AddAll(uc.repo.EffectivePriceMuts(product)...)
+	plan.Add(uc.repo.PriceHistoryMut(product, now))
+
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
+		}
+		plan.AddAll(muts...)
+	}
+
+	if !plan.IsEmpty() {
+		if err := uc.committer.Apply(ctx, plan); err != nil {
+			return err
+		}
+	}
+
+	uc.publishEvents(ctx, product)
+	return nil
+}
+
+// ChangeBasePrice changes the base price of a product.
+func (uc *ProductUseCases) ChangeBasePrice(ctx context.Context, req ChangeBasePriceRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	newPrice, err := newMoney(req.BasePriceNumerator, req.BasePriceDenominator, req.Currency, product.Currency())
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return err
+	}
+	if err := product.ChangeBasePrice(newPrice, now); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlanFor("ChangeBasePrice")
+
+	if mut := uc.repo.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.DiscountMuts(product)...)
+	plan.AddAll(uc.repo.EffectivePriceMuts(product)...)
+	plan.Add(uc.repo.PriceHistoryMut(product, now))
+
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
+		}
+		plan.AddAll(muts...)
+	}
+
+	if !plan.IsEmpty() {
+		if err := uc.committer.Apply(ctx, plan); err != nil {
+			return err
+		}
+	}
+
+	uc.publishEvents(ctx, product)
+	return nil
+}
+
+// ActivateProduct activates a product. When products must be reviewed (see
+// WithProductReview), a draft is activated by approving it instead. The compliance
+// flags of the product are checked against the compliance policy (see
+// WithCompliancePolicy).
+func (uc *ProductUseCases) ActivateProduct(ctx context.Context, req ActivateProductRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	if uc.reviewRequired && product.Status() == domain.ProductStatusDraft {
+		return domain.ErrProductReviewRequired
+	}
+
+	now := uc.clock.Now()
+	if err := product.Activate(now); err != nil {
+		return err
+	}
+	if err := uc.checkCompliance(product); err != nil {
+		return err
+	}
+
+	return uc.commitStatusChange(ctx, "ActivateProduct", product, now)
+}
+
+// DeactivateProduct deactivates a product.
+func (uc *ProductUseCases) DeactivateProduct(ctx context.Context, req DeactivateProductRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := product.Deactivate(now); err != nil {
+		return err
+	}
+
+	return uc.commitStatusChange(ctx, "DeactivateProduct", product, now)
+}
+
+// checkCompliance checks the compliance flags of a product that is active after a
+// command against the compliance policy, so no product goes on sale without passing
+// them.
+func (uc *ProductUseCases) checkCompliance(product *domain.Product) error {
+	if product.Status() != domain.ProductStatusActive {
+		return nil
+	}
+	return product.CheckCompliance(uc.compliancePolicy)
+}
+
+// commitStatusChange commits a product that was activated or deactivated, together with
+// its discounts, effective prices, a price history row, its events and their
+// notifications.
+func (uc *ProductUseCases) commitStatusChange(ctx context.Context, useCase string, product *domain.Product, now time.Time) error {
+	plan := committer.NewPlanFor(useCase)
+
+	if mut := uc.repo.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.DiscountMuts(product)...)
+	plan.AddAll(uc.repo.EffectivePriceMuts(product)...)
+	plan.Add(uc.repo.PriceHistoryMut(product, now))
+
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
+		}
+		plan.AddAll(muts...)
+	}
+
+	if !plan.IsEmpty() {
+		if err := uc.committer.Apply(ctx, plan); err != nil {
+			return err
+		}
+	}
+
+	uc.publishEvents(ctx, product)
+	return nil
+}
+
+// ArchiveProduct archives a product (soft delete). Its discounts, merchandising rank and
+// notification subscriptions are removed and its active variants discontinued in the same
+// commit.
+func (uc *ProductUseCases) ArchiveProduct(ctx context.Context, req ArchiveProductRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := product.Archive(now); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlanFor("ArchiveProduct")
+
+	if mut := uc.repo.ArchiveMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.DiscountMuts(product)...)
+	plan.AddAll(uc.repo.EffectivePriceMuts(product)...)
+	plan.Add(uc.repo.PriceHistoryMut(product, now))
+	plan.AddAll(uc.repo.ArchiveDependentsMuts(product)...)
+
+	variants, muts, err := uc.discontinueVariantMuts(ctx, product, now)
+	if err != nil {
+		return err
+	}
+	plan.AddAll(muts...)
+
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
+		}
+		plan.AddAll(muts...)
+
+		if archived, ok := event.(domain.ProductArchivedEvent); ok {
+			muts, err := uc.cancelSubscriptionMuts(ctx, archived, product)
+			if err != nil {
+				return err
+			}
+			plan.AddAll(muts...)
+		}
+	}
+
+	if !plan.IsEmpty() {
+		if err := uc.committer.Apply(ctx, plan); err != nil {
+			return err
+		}
+	}
+
+	uc.publishEvents(ctx, product)
+	if uc.publisher != nil {
+		for _, variant := range variants {
+			uc.publisher.Publish(ctx, variant.DomainEvents()...)
+		}
+	}
+	return nil
+}
+
+// ApplyDiscount applies a discount to a product. A discount above the approval threshold
+// (see WithDiscountApprovalThreshold) is held pending approval; see ApproveDiscount. A
+// discount valid during a discount blackout of the product's category is refused.
+func (uc *ProductUseCases) ApplyDiscount(ctx context.Context, req ApplyDiscountRequest) (*ApplyDiscountResponse, error) {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	discount, err := newRequestedDiscount(req, product.BasePrice())
+	if err != nil {
+		return nil, err
+	}
+	discount = discount.WithID(uc.ids.NewID()).WithPriority(req.Priority)
+	if req.Market != "" {
+		market, err := domain.ParseMarket(req.Market)
+		if err != nil {
+			return nil, err
+		}
+		discount = discount.WithMarket(market)
+	}
+	if req.FlashSale {
+		discount = discount.AsFlashSale()
+	}
+	if discount.ExceedsApprovalThreshold(uc.approvalThreshold) {
+		discount = discount.RequireApproval()
+	}
+
+	now := uc.clock.Now()
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return nil, err
+	}
+	blackouts, err := uc.discountBlackouts(ctx, discount)
+	if err != nil {
+		return nil, err
+	}
+	if err := blackoutError(blackouts, product.Category(), discount); err != nil {
+		return nil, err
+	}
+	if err := uc.checkMargin(product, discount); err != nil {
+		return nil, err
+	}
+	if err := product.ApplyDiscount(discount, now); err != nil {
+		return nil, err
+	}
+
+	plan := committer.NewPlanFor("ApplyDiscount")
+
+	if mut := uc.repo.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.DiscountMuts(product)...)
+	plan.AddAll(uc.repo.EffectivePriceMuts(product)...)
+	plan.Add(uc.repo.PriceHistoryMut(product, now))
+
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return nil, err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return nil, err
+		}
+		plan.AddAll(muts...)
+	}
+
+	if !plan.IsEmpty() {
+		if err := uc.committer.Apply(ctx, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	uc.publishEvents(ctx, product)
+	return &ApplyDiscountResponse{DiscountID: discount.ID(), PendingApproval: discount.IsPendingApproval()}, nil
+}
+
+// newRequestedDiscount creates the percentage discount, sale price discount or
+// buy-X-get-Y promotion of req for a product at basePrice.
+func newRequestedDiscount(req ApplyDiscountRequest, basePrice *domain.Money) (*domain.Discount, error) {
+	if !req.IsPromotion() && !req.IsSalePrice() {
+		return domain.NewDiscount(domain.PercentageFromFloat(req.DiscountPercentage), req.StartDate, req.EndDate)
+	}
+	if req.DiscountPercentage != 0 {
+		return nil, domain.ErrInvalidDiscountPercentage
+	}
+	if req.IsSalePrice() {
+		if req.IsPromotion() || req.SalePriceDenominator < 0 {
+			return nil, domain.ErrInvalidSalePrice
+		}
+		salePrice, err := newMoney(req.SalePriceNumerator, req.SalePriceDenominator, req.SalePriceCurrency, basePrice.Currency())
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewSalePriceDiscount(salePrice, basePrice, req.StartDate, req.EndDate)
+	}
+	promotion, err := domain.NewBuyXGetY(req.BuyQuantity, req.GetQuantity)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewBuyXGetYDiscount(promotion, req.StartDate, req.EndDate)
+}
+
+// RemoveDiscount removes a discount from a product.
+func (uc *ProductUseCases) RemoveDiscount(ctx context.Context, req RemoveDiscountRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return err
+	}
+	if err := product.RemoveDiscount(req.DiscountID, now); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlanFor("RemoveDiscount")
+
+	if mut := uc.repo.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.DiscountMuts(product)...)
+	plan.AddAll(uc.repo.EffectivePriceMuts(product)...)
+	plan.Add(uc.repo.PriceHistoryMut(product, now))
+
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
+		}
+		plan.AddAll(muts...)
+	}
+
+	if !plan.IsEmpty() {
+		if err := uc.committer.Apply(ctx, plan); err != nil {
+			return err
+		}
+	}
+
+	uc.publishEvents(ctx, product)
+	return nil
+}
+
+// SetPriceTiers replaces the volume price tiers of a product.
+func (uc *ProductUseCases) SetPriceTiers(ctx context.Context, req SetPriceTiersRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	tiers := make([]*domain.PriceTier, len(req.Tiers))
+	for i, t := range req.Tiers {
+		tier, err := newPriceTier(t, product.Currency())
+		if err != nil {
+			return err
+		}
+		tiers[i] = tier
+	}
+
+	now := uc.clock.Now()
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return err
+	}
+	if err := product.SetPriceTiers(tiers, now); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlanFor("SetPriceTiers")
+
+	if mut := uc.repo.UpdateMut(product); mut != nil {
+		plan.Add(mut)
+	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.DiscountMuts(product)...)
+	plan.AddAll(uc.repo.EffectivePriceMuts(product)...)
+	plan.Add(uc.repo.PriceHistoryMut(product, now))
+	plan.AddAll(uc.repo.PriceTierMuts(product)...)
+
+	for _, event := range product.DomainEvents() {
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
+		}
+		plan.AddAll(muts...)
+	}
+
+	if !plan.IsEmpty() {
+		if err := uc.committer.Apply(ctx, plan); err != nil {
+			return err
+		}
+	}
+
+	uc.publishEvents(ctx, product)
+	return nil
+}
+
+// SetPriceBook replaces the prices of a product in currencies other than its own.
+func (uc *ProductUseCases) SetPriceBook(ctx context.Context, req SetPriceBookRequest) error {
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	prices := make([]*domain.Money, len(req.Prices))
+	for i, p := range req.Prices {
+		price, err := newPriceBookPrice(p)
+		if err != nil {
+			return err
+		}
+		prices[i] = price
 	}
-}
 
-// CreateProduct creates a new product.
-func (uc *ProductUseCases) CreateProduct(ctx context.Context, req CreateProductRequest) (*CreateProductResponse, error) {
-	productID := uuid.New().String()
-	basePrice := domain.NewMoney(req.BasePriceNumerator, req.BasePriceDenominator)
 	now := uc.clock.Now()
-
-	product, err := domain.NewProduct(
-		productID,
-		req.Name,
-		req.Description,
-		req.Category,
-		basePrice,
-		now,
-	)
-	if err != nil {
-		return nil, err
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return err
+	}
+	if err := product.SetPriceBook(prices, now); err != nil {
+		return err
 	}
 
-	plan := committer.NewPlan()
+	plan := committer.NewPlanFor("SetPriceBook")
 
-	if mut := uc.repo.InsertMut(product); mut != nil {
+	if mut := uc.repo.UpdateMut(product); mut != nil {
 		plan.Add(mut)
 	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.PriceBookMuts(product)...)
 
 	for _, event := range product.DomainEvents() {
-		if mut := uc.outboxRepo.InsertDomainEventMut(event); mut != nil {
-			plan.Add(mut)
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
 		}
+		plan.AddAll(muts...)
 	}
 
-	if err := uc.committer.Apply(ctx, plan); err != nil {
-		return nil, err
+	if !plan.IsEmpty() {
+		if err := uc.committer.Apply(ctx, plan); err != nil {
+			return err
+		}
 	}
 
-	return &CreateProductResponse{ProductID: productID}, nil
+	uc.publishEvents(ctx, product)
+	return nil
 }
 
-// UpdateProduct updates an existing product.
-func (uc *ProductUseCases) UpdateProduct(ctx context.Context, req UpdateProductRequest) error {
+// SetSegmentPrices replaces the customer segment prices of a product.
+func (uc *ProductUseCases) SetSegmentPrices(ctx context.Context, req SetSegmentPricesRequest) error {
 	product, err := uc.repo.FindByID(ctx, req.ProductID)
 	if err != nil {
 		return err
 	}
 
+	prices := make([]*domain.SegmentPrice, len(req.Prices))
+	for i, p := range req.Prices {
+		price, err := newSegmentPrice(p, product.Currency())
+		if err != nil {
+			return err
+		}
+		prices[i] = price
+	}
+
 	now := uc.clock.Now()
-	if err := product.Update(req.Name, req.Description, req.Category, now); err != nil {
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return err
+	}
+	if err := product.SetSegmentPrices(prices, now); err != nil {
 		return err
 	}
 
-	plan := committer.NewPlan()
+	plan := committer.NewPlanFor("SetSegmentPrices")
 
 	if mut := uc.repo.UpdateMut(product); mut != nil {
 		plan.Add(mut)
 	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.SegmentPriceMuts(product)...)
 
 	for _, event := range product.DomainEvents() {
-		if mut := uc.outboxRepo.InsertDomainEventMut(event); mut != nil {
-			plan.Add(mut)
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
 		}
+		plan.AddAll(muts...)
 	}
 
 	if !plan.IsEmpty() {
@@ -153,31 +1302,55 @@ func (uc *ProductUseCases) UpdateProduct(ctx context.Context, req UpdateProductR
 		}
 	}
 
+	uc.publishEvents(ctx, product)
 	return nil
 }
 
-// ActivateProduct activates a product.
-func (uc *ProductUseCases) ActivateProduct(ctx context.Context, req ActivateProductRequest) error {
+// SetMarketPrices replaces the market prices of a product.
+func (uc *ProductUseCases) SetMarketPrices(ctx context.Context, req SetMarketPricesRequest) error {
 	product, err := uc.repo.FindByID(ctx, req.ProductID)
 	if err != nil {
 		return err
 	}
 
+	prices := make([]*domain.MarketPrice, len(req.Prices))
+	for i, p := range req.Prices {
+		price, err := newMarketPrice(p)
+		if err != nil {
+			return err
+		}
+		prices[i] = price
+	}
+
 	now := uc.clock.Now()
-	if err := product.Activate(now); err != nil {
+	if err := uc.checkFreeze(ctx, now); err != nil {
+		return err
+	}
+	if err := product.SetMarketPrices(prices, now); err != nil {
 		return err
 	}
 
-	plan := committer.NewPlan()
+	plan := committer.NewPlanFor("SetMarketPrices")
 
 	if mut := uc.repo.UpdateMut(product); mut != nil {
 		plan.Add(mut)
 	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.MarketPriceMuts(product)...)
 
 	for _, event := range product.DomainEvents() {
-		if mut := uc.outboxRepo.InsertDomainEventMut(event); mut != nil {
-			plan.Add(mut)
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
 		}
+		plan.AddAll(muts...)
 	}
 
 	if !plan.IsEmpty() {
@@ -186,31 +1359,47 @@ func (uc *ProductUseCases) ActivateProduct(ctx context.Context, req ActivateProd
 		}
 	}
 
+	uc.publishEvents(ctx, product)
 	return nil
 }
 
-// DeactivateProduct deactivates a product.
-func (uc *ProductUseCases) DeactivateProduct(ctx context.Context, req DeactivateProductRequest) error {
+// SetTaxClass changes the tax class of a product.
+func (uc *ProductUseCases) SetTaxClass(ctx context.Context, req SetTaxClassRequest) error {
+	class, err := newTaxClass(req.TaxClass)
+	if err != nil {
+		return err
+	}
+
 	product, err := uc.repo.FindByID(ctx, req.ProductID)
 	if err != nil {
 		return err
 	}
 
 	now := uc.clock.Now()
-	if err := product.Deactivate(now); err != nil {
+	if err := product.SetTaxClass(class, now); err != nil {
 		return err
 	}
 
-	plan := committer.NewPlan()
+	plan := committer.NewPlanFor("SetTaxClass")
 
 	if mut := uc.repo.UpdateMut(product); mut != nil {
 		plan.Add(mut)
 	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
 
 	for _, event := range product.DomainEvents() {
-		if mut := uc.outboxRepo.InsertDomainEventMut(event); mut != nil {
-			plan.Add(mut)
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
+			return err
 		}
+		plan.AddAll(muts...)
 	}
 
 	if !plan.IsEmpty() {
@@ -219,31 +1408,41 @@ func (uc *ProductUseCases) DeactivateProduct(ctx context.Context, req Deactivate
 		}
 	}
 
+	uc.publishEvents(ctx, product)
 	return nil
 }
 
-// ArchiveProduct archives a product (soft delete).
-func (uc *ProductUseCases) ArchiveProduct(ctx context.Context, req ArchiveProductRequest) error {
+// SetMinimumPrice sets or removes the minimum price of a product.
+func (uc *ProductUseCases) SetMinimumPrice(ctx context.Context, req SetMinimumPriceRequest) error {
 	product, err := uc.repo.FindByID(ctx, req.ProductID)
 	if err != nil {
 		return err
 	}
 
+	price, err := newMinimumPrice(req, product.Currency())
+	if err != nil {
+		return err
+	}
+
 	now := uc.clock.Now()
-	if err := product.Archive(now); err != nil {
+	if err := product.SetMinimumPrice(price, now); err != nil {
 		return err
 	}
 
-	plan := committer.NewPlan()
+	plan := committer.NewPlanFor("SetMinimumPrice")
 
-	if mut := uc.repo.ArchiveMut(product); mut != nil {
+	if mut := uc.repo.UpdateMut(product); mut != nil {
 		plan.Add(mut)
 	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
 
 	for _, event := range product.DomainEvents() {
-		if mut := uc.outboxRepo.InsertDomainEventMut(event); mut != nil {
-			plan.Add(mut)
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
 		}
+		plan.Add(mut)
 	}
 
 	if !plan.IsEmpty() {
@@ -252,37 +1451,57 @@ func (uc *ProductUseCases) ArchiveProduct(ctx context.Context, req ArchiveProduc
 		}
 	}
 
+	uc.publishEvents(ctx, product)
 	return nil
 }
 
-// ApplyDiscount applies a discount to a product.
-func (uc *ProductUseCases) ApplyDiscount(ctx context.Context, req ApplyDiscountRequest) error {
+// checkMargin applies the margin guard to a discount about to be applied to product: it
+// returns domain.ErrNegativeMargin if the guard blocks discounts that sell below the cost
+// price, and logs a warning instead if it only warns.
+func (uc *ProductUseCases) checkMargin(product *domain.Product, discount *domain.Discount) error {
+	if uc.marginGuard == domain.MarginGuardOff {
+		return nil
+	}
+	err := product.CheckMargin(discount)
+	if err == nil || uc.marginGuard == domain.MarginGuardBlock {
+		return err
+	}
+	logging.Warnf("usecase: discount %s brings product %s below its cost price of %s",
+		discount.ID(), product.ID(), product.CostPrice())
+	return nil
+}
+
+// SetCostPrice sets or removes the cost price of a product.
+func (uc *ProductUseCases) SetCostPrice(ctx context.Context, req SetCostPriceRequest) error {
 	product, err := uc.repo.FindByID(ctx, req.ProductID)
 	if err != nil {
 		return err
 	}
 
-	percentage := big.NewRat(int64(req.DiscountPercentage*100), 100)
-	discount, err := domain.NewDiscount(percentage, req.StartDate, req.EndDate)
+	price, err := newCostPrice(req, product.Currency())
 	if err != nil {
 		return err
 	}
 
 	now := uc.clock.Now()
-	if err := product.ApplyDiscount(discount, now); err != nil {
+	if err := product.SetCostPrice(price, now); err != nil {
 		return err
 	}
 
-	plan := committer.NewPlan()
+	plan := committer.NewPlanFor("SetCostPrice")
 
 	if mut := uc.repo.UpdateMut(product); mut != nil {
 		plan.Add(mut)
 	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
 
 	for _, event := range product.DomainEvents() {
-		if mut := uc.outboxRepo.InsertDomainEventMut(event); mut != nil {
-			plan.Add(mut)
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
 		}
+		plan.Add(mut)
 	}
 
 	if !plan.IsEmpty() {
@@ -291,42 +1510,209 @@ func (uc *ProductUseCases) ApplyDiscount(ctx context.Context, req ApplyDiscountR
 		}
 	}
 
+	uc.publishEvents(ctx, product)
 	return nil
 }
 
-// RemoveDiscount removes a discount from a product.
-func (uc *ProductUseCases) RemoveDiscount(ctx context.Context, req RemoveDiscountRequest) error {
+// newCostPrice converts the cost price of a request to domain money, or nil if the
+// request removes it.
+func newCostPrice(req SetCostPriceRequest, defaultCurrency string) (*domain.Money, error) {
+	if req.Numerator == 0 && req.Denominator == 0 {
+		return nil, nil
+	}
+	if req.Numerator <= 0 || req.Denominator <= 0 {
+		return nil, domain.ErrInvalidCostPrice
+	}
+	return newMoney(req.Numerator, req.Denominator, req.Currency, defaultCurrency)
+}
+
+// newMinimumPrice converts the minimum price of a request to domain money, or nil if the
+// request removes it.
+func newMinimumPrice(req SetMinimumPriceRequest, defaultCurrency string) (*domain.Money, error) {
+	if req.Numerator == 0 && req.Denominator == 0 {
+		return nil, nil
+	}
+	if req.Numerator <= 0 || req.Denominator <= 0 {
+		return nil, domain.ErrInvalidMinimumPrice
+	}
+	return newMoney(req.Numerator, req.Denominator, req.Currency, defaultCurrency)
+}
+
+// newTaxClass parses the tax class of a request; unlike domain.ParseTaxClass, an empty
+// class is rejected rather than read as the default.
+func newTaxClass(class string) (domain.TaxClass, error) {
+	if class == "" {
+		return "", domain.ErrInvalidTaxClass
+	}
+	return domain.ParseTaxClass(class)
+}
+
+// newMoney creates the money of a request in the requested currency, or in
+// defaultCurrency if the request has none.
+func newMoney(numerator, denominator int64, currency, defaultCurrency string) (*domain.Money, error) {
+	if currency == "" {
+		currency = defaultCurrency
+	}
+	return domain.NewMoneyInCurrency(numerator, denominator, currency)
+}
+
+// newPriceBookPrice converts a price book entry of a request to domain money. Unlike other
+// prices, an entry must name its currency.
+func newPriceBookPrice(req PriceRequest) (*domain.Money, error) {
+	if req.Denominator <= 0 || req.Numerator <= 0 {
+		return nil, domain.ErrInvalidPriceBookPrice
+	}
+	if req.Currency == "" {
+		return nil, domain.ErrInvalidCurrency
+	}
+	return domain.NewMoneyInCurrency(req.Numerator, req.Denominator, req.Currency)
+}
+
+// newMarketPrice converts a market price of a request to a domain MarketPrice.
+func newMarketPrice(req MarketPriceRequest) (*domain.MarketPrice, error) {
+	market, err := domain.ParseMarket(req.Market)
+	if err != nil {
+		return nil, err
+	}
+	if req.Denominator <= 0 || req.Numerator <= 0 {
+		return nil, domain.ErrInvalidMarketPrice
+	}
+	price, err := newMoney(req.Numerator, req.Denominator, req.Currency, market.Currency())
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewMarketPrice(market, price)
+}
+
+// newPriceTier converts a tier of a request to a domain PriceTier. A unit price without a
+// currency is in defaultCurrency.
+func newPriceTier(req PriceTierRequest, defaultCurrency string) (*domain.PriceTier, error) {
+	hasUnitPrice := req.UnitPriceNumerator != 0 || req.UnitPriceDenominator != 0
+	if hasUnitPrice == (req.PercentOff != 0) {
+		return nil, domain.ErrInvalidPriceTierPrice
+	}
+	if hasUnitPrice {
+		if req.UnitPriceDenominator <= 0 {
+			return nil, domain.ErrInvalidPriceTierPrice
+		}
+		price, err := newMoney(req.UnitPriceNumerator, req.UnitPriceDenominator, req.UnitPriceCurrency, defaultCurrency)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewUnitPriceTier(req.MinQuantity, price)
+	}
+	return domain.NewPercentOffTier(req.MinQuantity, domain.PercentageFromFloat(req.PercentOff))
+}
+
+// newSegmentPrice converts a segment price of a request to a domain SegmentPrice. A fixed
+// price without a currency is in defaultCurrency.
+func newSegmentPrice(req SegmentPriceRequest, defaultCurrency string) (*domain.SegmentPrice, error) {
+	hasPrice := req.PriceNumerator != 0 || req.PriceDenominator != 0
+	if hasPrice == (req.PercentOff != 0) {
+		return nil, domain.ErrInvalidSegmentPrice
+	}
+	if hasPrice {
+		if req.PriceDenominator <= 0 {
+			return nil, domain.ErrInvalidSegmentPrice
+		}
+		price, err := newMoney(req.PriceNumerator, req.PriceDenominator, req.PriceCurrency, defaultCurrency)
+		if err != nil {
+			return nil, err
+		}
+		return domain.NewSegmentPriceOverride(req.Segment, price)
+	}
+	return domain.NewSegmentPercentOff(req.Segment, domain.PercentageFromFloat(req.PercentOff))
+}
+
+// AdvanceDiscountPhase raises product.discount_started or product.discount_ended for a
+// product whose discount period started or ended since it was last checked. It is run by
+// the discount scheduler and does nothing if there is no boundary to announce.
+func (uc *ProductUseCases) AdvanceDiscountPhase(ctx context.Context, req AdvanceDiscountPhaseRequest) error {
 	product, err := uc.repo.FindByID(ctx, req.ProductID)
 	if err != nil {
 		return err
 	}
 
 	now := uc.clock.Now()
-	if err := product.RemoveDiscount(now); err != nil {
-		return err
+	if !product.AdvanceDiscountPhase(now) {
+		return nil
 	}
 
-	plan := committer.NewPlan()
+	plan := committer.NewPlanFor("AdvanceDiscountPhase")
 
 	if mut := uc.repo.UpdateMut(product); mut != nil {
 		plan.Add(mut)
 	}
+	plan.Add(uc.repo.RevisionMut(product, now))
+	plan.Add(uc.repo.SummaryMut(product, now))
+	plan.AddAll(uc.repo.DiscountMuts(product)...)
+	plan.AddAll(uc.repo.EffectivePriceMuts(product)...)
+	plan.Add(uc.repo.PriceHistoryMut(product, now))
 
 	for _, event := range product.DomainEvents() {
-		if mut := uc.outboxRepo.InsertDomainEventMut(event); mut != nil {
-			plan.Add(mut)
+		mut, err := uc.eventMut(event, product, now)
+		if err != nil {
+			return err
 		}
-	}
+		plan.Add(mut)
 
-	if !plan.IsEmpty() {
-		if err := uc.committer.Apply(ctx, plan); err != nil {
+		muts, err := uc.notificationMuts(ctx, event, product)
+		if err != nil {
 			return err
 		}
+		plan.AddAll(muts...)
 	}
 
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		return err
+	}
+
+	uc.publishEvents(ctx, product)
 	return nil
 }
 
+// SubscribeToNotifications subscribes a customer to notifications of the given kind for
+// a product. Archived products cannot be subscribed to.
+func (uc *ProductUseCases) SubscribeToNotifications(ctx context.Context, req SubscribeToNotificationsRequest) error {
+	if uc.subscriptions == nil {
+		return ErrNotificationsDisabled
+	}
+	if !req.Kind.IsValid() {
+		return domain.ErrInvalidNotificationKind
+	}
+
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+	if product.Status() == domain.ProductStatusArchived {
+		return domain.ErrProductArchived
+	}
+
+	plan := committer.NewPlanFor("SubscribeToNotifications")
+	plan.Add(uc.subscriptions.SubscribeMut(req.ProductID, req.Kind, req.SubscriberID, uc.clock.Now()))
+	return uc.committer.Apply(ctx, plan)
+}
+
+// UnsubscribeFromNotifications removes a subscription. Removing a subscription that does
+// not exist is not an error, but the product must exist and belong to the tenant of the
+// caller.
+func (uc *ProductUseCases) UnsubscribeFromNotifications(ctx context.Context, req UnsubscribeFromNotificationsRequest) error {
+	if uc.subscriptions == nil {
+		return ErrNotificationsDisabled
+	}
+	if !req.Kind.IsValid() {
+		return domain.ErrInvalidNotificationKind
+	}
+	if _, err := uc.repo.FindByID(ctx, req.ProductID); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlanFor("UnsubscribeFromNotifications")
+	plan.Add(uc.subscriptions.UnsubscribeMut(req.ProductID, req.Kind, req.SubscriberID))
+	return uc.committer.Apply(ctx, plan)
+}
+
 // ValidateCreateProductRequest validates the create product request.
 func ValidateCreateProductRequest(req CreateProductRequest) error {
 	if req.Name == "" {
@@ -342,7 +1728,19 @@ func ValidateCreateProductRequest(req CreateProductRequest) error {
 	if price.Sign() <= 0 {
 		return domain.ErrInvalidBasePrice
 	}
-	return nil
+	if _, _, err := domain.ParseIdentifiers(req.SKU, req.GTIN); err != nil {
+		return err
+	}
+	if _, _, err := parseDimensions(req.Weight, req.Dimensions); err != nil {
+		return err
+	}
+	if req.Kind != "" && !domain.ProductKind(req.Kind).IsValid() {
+		return domain.ErrInvalidProductKind
+	}
+	if err := validateAttributes(req.Attributes); err != nil {
+		return err
+	}
+	return validateCurrency(req.Currency)
 }
 
 // ValidateUpdateProductRequest validates the update product request.
@@ -356,9 +1754,47 @@ func ValidateUpdateProductRequest(req UpdateProductRequest) error {
 	if req.Category == "" {
 		return domain.ErrInvalidProductCategory
 	}
+	return validateAttributes(req.Attributes)
+}
+
+// validateAttributes validates the names, values and number of the attributes of a
+// request; see domain.Product.ReplaceAttributes. Whether they match the attribute schema
+// of the category is checked by the use case.
+func validateAttributes(attributes map[string]string) error {
+	if len(attributes) > domain.MaxProductAttributes {
+		return domain.ErrTooManyProductAttributes
+	}
+	for name, value := range attributes {
+		if _, err := domain.ParseProductAttributeName(name); err != nil {
+			return err
+		}
+		if len(strings.TrimSpace(value)) > domain.MaxProductAttributeValueLength {
+			return domain.ErrInvalidProductAttribute
+		}
+	}
 	return nil
 }
 
+// ValidateChangeBasePriceRequest validates the change base price request.
+func ValidateChangeBasePriceRequest(req ChangeBasePriceRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	if req.BasePriceNumerator <= 0 || req.BasePriceDenominator <= 0 {
+		return domain.ErrInvalidBasePrice
+	}
+	return validateCurrency(req.Currency)
+}
+
+// validateCurrency validates an optional currency code of a request.
+func validateCurrency(code string) error {
+	if code == "" {
+		return nil
+	}
+	_, err := domain.ParseCurrency(code)
+	return err
+}
+
 // ValidateProductIDRequest validates requests that require only a product ID.
 func ValidateProductIDRequest(productID string) error {
 	if productID == "" {
@@ -372,11 +1808,124 @@ func ValidateApplyDiscountRequest(req ApplyDiscountRequest) error {
 	if req.ProductID == "" {
 		return domain.ErrInvalidID
 	}
-	if req.DiscountPercentage <= 0 || req.DiscountPercentage > 100 {
+	if req.IsSalePrice() {
+		if req.DiscountPercentage != 0 {
+			return domain.ErrInvalidDiscountPercentage
+		}
+		if req.Market != "" {
+			return domain.ErrSalePriceMarket
+		}
+		if req.IsPromotion() || req.SalePriceNumerator < 0 || req.SalePriceDenominator < 0 {
+			return domain.ErrInvalidSalePrice
+		}
+	} else if req.IsPromotion() {
+		if req.DiscountPercentage != 0 {
+			return domain.ErrInvalidDiscountPercentage
+		}
+		if _, err := domain.NewBuyXGetY(req.BuyQuantity, req.GetQuantity); err != nil {
+			return err
+		}
+	} else if req.DiscountPercentage <= 0 || req.DiscountPercentage > 100 {
 		return domain.ErrInvalidDiscountPercentage
 	}
+	if req.Priority < 0 || req.Priority > domain.MaxDiscountPriority {
+		return domain.ErrInvalidDiscountPriority
+	}
 	if !req.EndDate.After(req.StartDate) {
 		return domain.ErrInvalidDiscountPeriod
 	}
+	if req.Market != "" {
+		if _, err := domain.ParseMarket(req.Market); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateSetPriceTiersRequest validates the set price tiers request.
+func ValidateSetPriceTiersRequest(req SetPriceTiersRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	if len(req.Tiers) > domain.MaxPriceTiersPerProduct {
+		return domain.ErrTooManyPriceTiers
+	}
+	for _, t := range req.Tiers {
+		if _, err := newPriceTier(t, domain.DefaultCurrency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateSetPriceBookRequest validates the set price book request.
+func ValidateSetPriceBookRequest(req SetPriceBookRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	if len(req.Prices) > domain.MaxPriceBookEntries {
+		return domain.ErrTooManyPriceBookEntries
+	}
+	for _, p := range req.Prices {
+		if _, err := newPriceBookPrice(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateSetSegmentPricesRequest validates the set segment prices request.
+func ValidateSetSegmentPricesRequest(req SetSegmentPricesRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	if len(req.Prices) > domain.MaxSegmentPrices {
+		return domain.ErrTooManySegmentPrices
+	}
+	for _, p := range req.Prices {
+		if _, err := newSegmentPrice(p, domain.DefaultCurrency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateSetMarketPricesRequest validates the set market prices request.
+func ValidateSetMarketPricesRequest(req SetMarketPricesRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	for _, p := range req.Prices {
+		if _, err := newMarketPrice(p); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// ValidateSetTaxClassRequest validates the set tax class request.
+func ValidateSetTaxClassRequest(req SetTaxClassRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	_, err := newTaxClass(req.TaxClass)
+	return err
+}
+
+// ValidateSetMinimumPriceRequest validates the set minimum price request.
+func ValidateSetMinimumPriceRequest(req SetMinimumPriceRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	_, err := newMinimumPrice(req, domain.DefaultCurrency)
+	return err
+}
+
+// ValidateSetCostPriceRequest validates the set cost price request.
+func ValidateSetCostPriceRequest(req SetCostPriceRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	_, err := newCostPrice(req, domain.DefaultCurrency)
+	return err
+}
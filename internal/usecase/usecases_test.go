@@ -1,9 +1,13 @@
 package usecase
 
 import (
+	"fmt"
+	"math/big"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/product-catalog-service/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -86,6 +90,89 @@ func TestValidateCreateProductRequest(t *testing.T) {
 			wantErr: true,
 			errMsg:  "base price must be positive",
 		},
+		{
+			name: "valid request with currency",
+			req: CreateProductRequest{
+				Name:                 "Test Product",
+				Category:             "Electronics",
+				BasePriceNumerator:   1999,
+				BasePriceDenominator: 100,
+				Currency:             "eur",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid currency",
+			req: CreateProductRequest{
+				Name:                 "Test Product",
+				Category:             "Electronics",
+				BasePriceNumerator:   1999,
+				BasePriceDenominator: 100,
+				Currency:             "EURO",
+			},
+			wantErr: true,
+			errMsg:  "currency must be an ISO 4217 code",
+		},
+		{
+			name: "with identifiers",
+			req: CreateProductRequest{
+				Name:                 "Test Product",
+				Category:             "Electronics",
+				BasePriceNumerator:   1999,
+				BasePriceDenominator: 100,
+				SKU:                  "tp-1",
+				GTIN:                 "4006381333931",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid GTIN",
+			req: CreateProductRequest{
+				Name:                 "Test Product",
+				Category:             "Electronics",
+				BasePriceNumerator:   1999,
+				BasePriceDenominator: 100,
+				GTIN:                 "4006381333932",
+			},
+			wantErr: true,
+			errMsg:  "GTIN must be",
+		},
+		{
+			name: "invalid attribute name",
+			req: CreateProductRequest{
+				Name:                 "Test Product",
+				Category:             "Electronics",
+				BasePriceNumerator:   1999,
+				BasePriceDenominator: 100,
+				Attributes:           map[string]string{"Screen Size": "15"},
+			},
+			wantErr: true,
+			errMsg:  "product attributes are named",
+		},
+		{
+			name: "invalid weight unit",
+			req: CreateProductRequest{
+				Name:                 "Test Product",
+				Category:             "Electronics",
+				BasePriceNumerator:   1999,
+				BasePriceDenominator: 100,
+				Weight:               &WeightInput{Value: 2, Unit: "stone"},
+			},
+			wantErr: true,
+			errMsg:  "weight must be",
+		},
+		{
+			name: "unknown kind",
+			req: CreateProductRequest{
+				Name:                 "Test Product",
+				Category:             "Electronics",
+				BasePriceNumerator:   1999,
+				BasePriceDenominator: 100,
+				Kind:                 "service",
+			},
+			wantErr: true,
+			errMsg:  "product kind must be",
+		},
 	}
 
 	for _, tt := range tests {
@@ -151,6 +238,17 @@ func TestValidateUpdateProductRequest(t *testing.T) {
 			wantErr: true,
 			errMsg:  "invalid product category",
 		},
+		{
+			name: "attribute value too long",
+			req: UpdateProductRequest{
+				ProductID:  "123e4567-e89b-12d3-a456-426614174000",
+				Name:       "Updated Product",
+				Category:   "Electronics",
+				Attributes: map[string]string{"material": strings.Repeat("a", domain.MaxProductAttributeValueLength+1)},
+			},
+			wantErr: true,
+			errMsg:  "product attributes are named",
+		},
 	}
 
 	for _, tt := range tests {
@@ -166,6 +264,51 @@ func TestValidateUpdateProductRequest(t *testing.T) {
 	}
 }
 
+func TestValidateChangeBasePriceRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     ChangeBasePriceRequest
+		wantErr error
+	}{
+		{
+			name:    "valid request",
+			req:     ChangeBasePriceRequest{ProductID: "product-123", BasePriceNumerator: 2499, BasePriceDenominator: 100},
+			wantErr: nil,
+		},
+		{
+			name:    "empty product ID",
+			req:     ChangeBasePriceRequest{BasePriceNumerator: 2499, BasePriceDenominator: 100},
+			wantErr: domain.ErrInvalidID,
+		},
+		{
+			name:    "zero price",
+			req:     ChangeBasePriceRequest{ProductID: "product-123", BasePriceNumerator: 0, BasePriceDenominator: 100},
+			wantErr: domain.ErrInvalidBasePrice,
+		},
+		{
+			name:    "zero denominator",
+			req:     ChangeBasePriceRequest{ProductID: "product-123", BasePriceNumerator: 2499, BasePriceDenominator: 0},
+			wantErr: domain.ErrInvalidBasePrice,
+		},
+		{
+			name:    "invalid currency",
+			req:     ChangeBasePriceRequest{ProductID: "product-123", BasePriceNumerator: 2499, BasePriceDenominator: 100, Currency: "E1R"},
+			wantErr: domain.ErrInvalidCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateChangeBasePriceRequest(tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateProductIDRequest(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -305,6 +448,88 @@ func TestValidateApplyDiscountRequest(t *testing.T) {
 			wantErr: true,
 			errMsg:  "discount end date must be after start date",
 		},
+		{
+			name: "valid request - buy 2 get 1",
+			req: ApplyDiscountRequest{
+				ProductID:   "123e4567-e89b-12d3-a456-426614174000",
+				BuyQuantity: 2,
+				GetQuantity: 1,
+				StartDate:   now,
+				EndDate:     now.AddDate(0, 0, 7),
+			},
+			wantErr: false,
+		},
+		{
+			name: "promotion with percentage",
+			req: ApplyDiscountRequest{
+				ProductID:          "123e4567-e89b-12d3-a456-426614174000",
+				DiscountPercentage: 10,
+				BuyQuantity:        2,
+				GetQuantity:        1,
+				StartDate:          now,
+				EndDate:            now.AddDate(0, 0, 7),
+			},
+			wantErr: true,
+			errMsg:  "discount percentage must be between 0 and 100",
+		},
+		{
+			name: "promotion without get quantity",
+			req: ApplyDiscountRequest{
+				ProductID:   "123e4567-e89b-12d3-a456-426614174000",
+				BuyQuantity: 2,
+				StartDate:   now,
+				EndDate:     now.AddDate(0, 0, 7),
+			},
+			wantErr: true,
+			errMsg:  "promotion buy and get quantities must be between 1 and 1000",
+		},
+		{
+			name: "valid request - EU market",
+			req: ApplyDiscountRequest{
+				ProductID:          "123e4567-e89b-12d3-a456-426614174000",
+				DiscountPercentage: 10,
+				StartDate:          now,
+				EndDate:            now.AddDate(0, 0, 7),
+				Market:             "EU",
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown market",
+			req: ApplyDiscountRequest{
+				ProductID:          "123e4567-e89b-12d3-a456-426614174000",
+				DiscountPercentage: 10,
+				StartDate:          now,
+				EndDate:            now.AddDate(0, 0, 7),
+				Market:             "JP",
+			},
+			wantErr: true,
+			errMsg:  "market must be one of US, EU, UK",
+		},
+		{
+			name: "valid request - sale price",
+			req: ApplyDiscountRequest{
+				ProductID:            "123e4567-e89b-12d3-a456-426614174000",
+				SalePriceNumerator:   1999,
+				SalePriceDenominator: 100,
+				StartDate:            now,
+				EndDate:              now.AddDate(0, 0, 7),
+			},
+			wantErr: false,
+		},
+		{
+			name: "sale price in a market",
+			req: ApplyDiscountRequest{
+				ProductID:            "123e4567-e89b-12d3-a456-426614174000",
+				SalePriceNumerator:   1999,
+				SalePriceDenominator: 100,
+				StartDate:            now,
+				EndDate:              now.AddDate(0, 0, 7),
+				Market:               "EU",
+			},
+			wantErr: true,
+			errMsg:  "sale prices apply to the base price and cannot be scoped to a market",
+		},
 	}
 
 	for _, tt := range tests {
@@ -319,3 +544,496 @@ func TestValidateApplyDiscountRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSetPriceTiersRequest(t *testing.T) {
+	tooMany := make([]PriceTierRequest, domain.MaxPriceTiersPerProduct+1)
+	for i := range tooMany {
+		tooMany[i] = PriceTierRequest{MinQuantity: int64(i + 2), PercentOff: 5}
+	}
+
+	tests := []struct {
+		name    string
+		req     SetPriceTiersRequest
+		wantErr error
+	}{
+		{
+			name: "valid unit price and percent off tiers",
+			req: SetPriceTiersRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Tiers: []PriceTierRequest{
+					{MinQuantity: 10, UnitPriceNumerator: 1800, UnitPriceDenominator: 100},
+					{MinQuantity: 100, PercentOff: 12.5},
+				},
+			},
+		},
+		{
+			name: "empty tiers clear the tiers",
+			req:  SetPriceTiersRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000"},
+		},
+		{
+			name:    "empty product ID",
+			req:     SetPriceTiersRequest{},
+			wantErr: domain.ErrInvalidID,
+		},
+		{
+			name:    "too many tiers",
+			req:     SetPriceTiersRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", Tiers: tooMany},
+			wantErr: domain.ErrTooManyPriceTiers,
+		},
+		{
+			name: "minimum quantity of one",
+			req: SetPriceTiersRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Tiers:     []PriceTierRequest{{MinQuantity: 1, PercentOff: 10}},
+			},
+			wantErr: domain.ErrInvalidPriceTierQuantity,
+		},
+		{
+			name: "both unit price and percent off",
+			req: SetPriceTiersRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Tiers:     []PriceTierRequest{{MinQuantity: 10, UnitPriceNumerator: 1800, UnitPriceDenominator: 100, PercentOff: 10}},
+			},
+			wantErr: domain.ErrInvalidPriceTierPrice,
+		},
+		{
+			name: "neither unit price nor percent off",
+			req: SetPriceTiersRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Tiers:     []PriceTierRequest{{MinQuantity: 10}},
+			},
+			wantErr: domain.ErrInvalidPriceTierPrice,
+		},
+		{
+			name: "zero unit price denominator",
+			req: SetPriceTiersRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Tiers:     []PriceTierRequest{{MinQuantity: 10, UnitPriceNumerator: 1800}},
+			},
+			wantErr: domain.ErrInvalidPriceTierPrice,
+		},
+		{
+			name: "percent off over 100",
+			req: SetPriceTiersRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Tiers:     []PriceTierRequest{{MinQuantity: 10, PercentOff: 150}},
+			},
+			wantErr: domain.ErrInvalidPriceTierPrice,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetPriceTiersRequest(tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetPriceBookRequest(t *testing.T) {
+	tooMany := make([]PriceRequest, domain.MaxPriceBookEntries+1)
+	for i := range tooMany {
+		tooMany[i] = PriceRequest{Numerator: 1849, Denominator: 100, Currency: "EUR"}
+	}
+
+	tests := []struct {
+		name    string
+		req     SetPriceBookRequest
+		wantErr error
+	}{
+		{
+			name: "valid prices",
+			req: SetPriceBookRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices: []PriceRequest{
+					{Numerator: 1849, Denominator: 100, Currency: "EUR"},
+					{Numerator: 1599, Denominator: 100, Currency: "gbp"},
+				},
+			},
+		},
+		{
+			name: "empty prices clear the price book",
+			req:  SetPriceBookRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000"},
+		},
+		{
+			name:    "empty product ID",
+			req:     SetPriceBookRequest{},
+			wantErr: domain.ErrInvalidID,
+		},
+		{
+			name:    "too many prices",
+			req:     SetPriceBookRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", Prices: tooMany},
+			wantErr: domain.ErrTooManyPriceBookEntries,
+		},
+		{
+			name: "zero price",
+			req: SetPriceBookRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices:    []PriceRequest{{Numerator: 0, Denominator: 100, Currency: "EUR"}},
+			},
+			wantErr: domain.ErrInvalidPriceBookPrice,
+		},
+		{
+			name: "missing currency",
+			req: SetPriceBookRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices:    []PriceRequest{{Numerator: 1849, Denominator: 100}},
+			},
+			wantErr: domain.ErrInvalidCurrency,
+		},
+		{
+			name: "invalid currency",
+			req: SetPriceBookRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices:    []PriceRequest{{Numerator: 1849, Denominator: 100, Currency: "euro"}},
+			},
+			wantErr: domain.ErrInvalidCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetPriceBookRequest(tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetSegmentPricesRequest(t *testing.T) {
+	tooMany := make([]SegmentPriceRequest, domain.MaxSegmentPrices+1)
+	for i := range tooMany {
+		tooMany[i] = SegmentPriceRequest{Segment: fmt.Sprintf("segment_%d", i), PercentOff: 5}
+	}
+
+	tests := []struct {
+		name    string
+		req     SetSegmentPricesRequest
+		wantErr error
+	}{
+		{
+			name: "valid fixed price and percent off",
+			req: SetSegmentPricesRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices: []SegmentPriceRequest{
+					{Segment: "employee", PriceNumerator: 1499, PriceDenominator: 100},
+					{Segment: "wholesale", PercentOff: 15},
+				},
+			},
+		},
+		{
+			name: "empty prices clear the segment prices",
+			req:  SetSegmentPricesRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000"},
+		},
+		{
+			name:    "empty product ID",
+			req:     SetSegmentPricesRequest{},
+			wantErr: domain.ErrInvalidID,
+		},
+		{
+			name:    "too many prices",
+			req:     SetSegmentPricesRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", Prices: tooMany},
+			wantErr: domain.ErrTooManySegmentPrices,
+		},
+		{
+			name: "invalid segment",
+			req: SetSegmentPricesRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices:    []SegmentPriceRequest{{Segment: "Wholesale Partners", PercentOff: 15}},
+			},
+			wantErr: domain.ErrInvalidSegment,
+		},
+		{
+			name: "both fixed price and percent off",
+			req: SetSegmentPricesRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices:    []SegmentPriceRequest{{Segment: "employee", PriceNumerator: 1499, PriceDenominator: 100, PercentOff: 10}},
+			},
+			wantErr: domain.ErrInvalidSegmentPrice,
+		},
+		{
+			name: "neither fixed price nor percent off",
+			req: SetSegmentPricesRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices:    []SegmentPriceRequest{{Segment: "employee"}},
+			},
+			wantErr: domain.ErrInvalidSegmentPrice,
+		},
+		{
+			name: "percent off of 100",
+			req: SetSegmentPricesRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices:    []SegmentPriceRequest{{Segment: "staff", PercentOff: 100}},
+			},
+			wantErr: domain.ErrInvalidSegmentPrice,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetSegmentPricesRequest(tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetMarketPricesRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     SetMarketPricesRequest
+		wantErr error
+	}{
+		{
+			name: "valid prices",
+			req: SetMarketPricesRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices: []MarketPriceRequest{
+					{Market: "EU", Numerator: 1849, Denominator: 100},
+					{Market: "UK", Numerator: 1599, Denominator: 100, Currency: "GBP"},
+				},
+			},
+		},
+		{
+			name: "empty prices clear the market prices",
+			req:  SetMarketPricesRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000"},
+		},
+		{
+			name:    "empty product ID",
+			req:     SetMarketPricesRequest{},
+			wantErr: domain.ErrInvalidID,
+		},
+		{
+			name: "unknown market",
+			req: SetMarketPricesRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices:    []MarketPriceRequest{{Market: "JP", Numerator: 2000, Denominator: 1}},
+			},
+			wantErr: domain.ErrInvalidMarket,
+		},
+		{
+			name: "currency of another market",
+			req: SetMarketPricesRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices:    []MarketPriceRequest{{Market: "EU", Numerator: 1599, Denominator: 100, Currency: "GBP"}},
+			},
+			wantErr: domain.ErrInvalidMarketPrice,
+		},
+		{
+			name: "zero price",
+			req: SetMarketPricesRequest{
+				ProductID: "123e4567-e89b-12d3-a456-426614174000",
+				Prices:    []MarketPriceRequest{{Market: "US", Denominator: 100}},
+			},
+			wantErr: domain.ErrInvalidMarketPrice,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetMarketPricesRequest(tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetTaxClassRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     SetTaxClassRequest
+		wantErr error
+	}{
+		{
+			name: "valid tax class",
+			req:  SetTaxClassRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", TaxClass: "reduced"},
+		},
+		{
+			name:    "empty product ID",
+			req:     SetTaxClassRequest{TaxClass: "reduced"},
+			wantErr: domain.ErrInvalidID,
+		},
+		{
+			name:    "empty tax class",
+			req:     SetTaxClassRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000"},
+			wantErr: domain.ErrInvalidTaxClass,
+		},
+		{
+			name:    "invalid tax class",
+			req:     SetTaxClassRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", TaxClass: "Reduced Rate"},
+			wantErr: domain.ErrInvalidTaxClass,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetTaxClassRequest(tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetMinimumPriceRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     SetMinimumPriceRequest
+		wantErr error
+	}{
+		{
+			name: "valid minimum price",
+			req:  SetMinimumPriceRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", Numerator: 999, Denominator: 100},
+		},
+		{
+			name: "remove minimum price",
+			req:  SetMinimumPriceRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000"},
+		},
+		{
+			name:    "empty product ID",
+			req:     SetMinimumPriceRequest{Numerator: 999, Denominator: 100},
+			wantErr: domain.ErrInvalidID,
+		},
+		{
+			name:    "zero denominator",
+			req:     SetMinimumPriceRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", Numerator: 999},
+			wantErr: domain.ErrInvalidMinimumPrice,
+		},
+		{
+			name:    "negative price",
+			req:     SetMinimumPriceRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", Numerator: -999, Denominator: 100},
+			wantErr: domain.ErrInvalidMinimumPrice,
+		},
+		{
+			name:    "invalid currency",
+			req:     SetMinimumPriceRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", Numerator: 999, Denominator: 100, Currency: "EURO"},
+			wantErr: domain.ErrInvalidCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetMinimumPriceRequest(tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSetCostPriceRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     SetCostPriceRequest
+		wantErr error
+	}{
+		{
+			name: "valid cost price",
+			req:  SetCostPriceRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", Numerator: 1200, Denominator: 100},
+		},
+		{
+			name: "remove cost price",
+			req:  SetCostPriceRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000"},
+		},
+		{
+			name:    "empty product ID",
+			req:     SetCostPriceRequest{Numerator: 1200, Denominator: 100},
+			wantErr: domain.ErrInvalidID,
+		},
+		{
+			name:    "zero numerator",
+			req:     SetCostPriceRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", Denominator: 100},
+			wantErr: domain.ErrInvalidCostPrice,
+		},
+		{
+			name:    "negative price",
+			req:     SetCostPriceRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", Numerator: -1200, Denominator: 100},
+			wantErr: domain.ErrInvalidCostPrice,
+		},
+		{
+			name:    "invalid currency",
+			req:     SetCostPriceRequest{ProductID: "123e4567-e89b-12d3-a456-426614174000", Numerator: 1200, Denominator: 100, Currency: "EURO"},
+			wantErr: domain.ErrInvalidCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSetCostPriceRequest(tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckMargin(t *testing.T) {
+	now := time.Now()
+	product, err := domain.NewProduct("123", "Widget", "", "Tools", domain.NewMoney(2000, 100), now)
+	require.NoError(t, err)
+	require.NoError(t, product.SetCostPrice(domain.NewMoney(15, 1), now))
+	discount, err := domain.NewDiscount(big.NewRat(30, 1), now, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	tests := []struct {
+		guard   domain.MarginGuard
+		wantErr error
+	}{
+		{guard: domain.MarginGuardOff},
+		{guard: domain.MarginGuardWarn},
+		{guard: domain.MarginGuardBlock, wantErr: domain.ErrNegativeMargin},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.guard), func(t *testing.T) {
+			uc := &ProductUseCases{marginGuard: tt.guard}
+			err := uc.checkMargin(product, discount)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBlackoutError(t *testing.T) {
+	start := time.Date(2024, 11, 25, 0, 0, 0, 0, time.UTC)
+	end := start.Add(7 * 24 * time.Hour)
+	toys, err := domain.NewDiscountBlackout("b-1", "Toys", start, end, "")
+	require.NoError(t, err)
+	electronics, err := domain.NewDiscountBlackout("b-2", "Electronics", start, end, "MAP week")
+	require.NoError(t, err)
+	blackouts := []*domain.DiscountBlackout{toys, electronics}
+
+	during, err := domain.NewDiscount(big.NewRat(10, 1), start.Add(time.Hour), start.Add(2*time.Hour))
+	require.NoError(t, err)
+	after, err := domain.NewDiscount(big.NewRat(10, 1), end, end.Add(time.Hour))
+	require.NoError(t, err)
+
+	err = blackoutError(blackouts, "Electronics", during)
+	assert.ErrorIs(t, err, domain.ErrDiscountBlackedOut)
+	assert.Contains(t, err.Error(), "until 2024-12-02T00:00:00Z (blackout b-2: MAP week)")
+	assert.NoError(t, blackoutError(blackouts, "Electronics", after))
+	assert.NoError(t, blackoutError(blackouts, "Tools", during))
+	assert.NoError(t, blackoutError(nil, "Electronics", during))
+}
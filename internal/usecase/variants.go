@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/domain"
+	"google.golang.org/grpc/codes"
+)
+
+// ErrVariantsDisabled is returned by the variant use cases when no variant repository was
+// configured with WithVariants.
+var ErrVariantsDisabled = errors.New("product variants are not enabled")
+
+// AddVariantRequest represents the input for adding a variant to a product. The price
+// delta is added to the base price of the product; a zero PriceDeltaDenominator means no
+// delta. An empty PriceDeltaCurrency means the product's currency.
+type AddVariantRequest struct {
+	ProductID             string
+	SKU                   string
+	Attributes            map[string]string
+	PriceDeltaNumerator   int64
+	PriceDeltaDenominator int64
+	PriceDeltaCurrency    string
+}
+
+// AddVariantResponse represents the output of adding a variant.
+type AddVariantResponse struct {
+	VariantID string
+}
+
+// UpdateVariantRequest represents the input for replacing the SKU, attributes and price
+// delta of a variant; the price delta fields are those of AddVariantRequest.
+type UpdateVariantRequest struct {
+	ProductID             string
+	VariantID             string
+	SKU                   string
+	Attributes            map[string]string
+	PriceDeltaNumerator   int64
+	PriceDeltaDenominator int64
+	PriceDeltaCurrency    string
+}
+
+// DiscontinueVariantRequest represents the input for discontinuing a variant.
+type DiscontinueVariantRequest struct {
+	ProductID string
+	VariantID string
+}
+
+// AddVariant adds an active variant to a product that is not archived. The SKU must not
+// be used by any other variant or product of the catalog.
+func (uc *ProductUseCases) AddVariant(ctx context.Context, req AddVariantRequest) (*AddVariantResponse, error) {
+	if uc.variants == nil {
+		return nil, ErrVariantsDisabled
+	}
+
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	delta, err := newPriceDelta(req.PriceDeltaNumerator, req.PriceDeltaDenominator, req.PriceDeltaCurrency, product.Currency())
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	variant, err := domain.NewProductVariant(uc.ids.NewID(), product, req.SKU, req.Attributes, delta, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkSKUAvailable(ctx, variant); err != nil {
+		return nil, err
+	}
+
+	plan := committer.NewPlanFor("AddVariant")
+	plan.Add(uc.variants.InsertMut(variant))
+	if err := uc.commitVariant(ctx, plan, product, variant); err != nil {
+		return nil, err
+	}
+	return &AddVariantResponse{VariantID: variant.ID()}, nil
+}
+
+// UpdateVariant replaces the SKU, attributes and price delta of an active variant. Like
+// other price changes, a change of the price delta is refused while the catalog is
+// frozen.
+func (uc *ProductUseCases) UpdateVariant(ctx context.Context, req UpdateVariantRequest) error {
+	if uc.variants == nil {
+		return ErrVariantsDisabled
+	}
+
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+	variant, err := uc.variants.FindByID(ctx, req.ProductID, req.VariantID)
+	if err != nil {
+		return err
+	}
+	delta, err := newPriceDelta(req.PriceDeltaNumerator, req.PriceDeltaDenominator, req.PriceDeltaCurrency, product.Currency())
+	if err != nil {
+		return err
+	}
+
+	now := uc.clock.Now()
+	if !delta.Equals(variant.PriceDelta()) {
+		if err := uc.checkFreeze(ctx, now); err != nil {
+			return err
+		}
+	}
+	if err := variant.Update(product, req.SKU, req.Attributes, delta, now); err != nil {
+		return err
+	}
+	if len(variant.DomainEvents()) == 0 {
+		return nil
+	}
+	if err := uc.checkSKUAvailable(ctx, variant); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlanFor("UpdateVariant")
+	plan.Add(uc.variants.UpdateMut(variant))
+	return uc.commitVariant(ctx, plan, product, variant)
+}
+
+// DiscontinueVariant takes a variant out of sale for good. It stays readable with status
+// discontinued and keeps its SKU.
+func (uc *ProductUseCases) DiscontinueVariant(ctx context.Context, req DiscontinueVariantRequest) error {
+	if uc.variants == nil {
+		return ErrVariantsDisabled
+	}
+
+	product, err := uc.repo.FindByID(ctx, req.ProductID)
+	if err != nil {
+		return err
+	}
+	variant, err := uc.variants.FindByID(ctx, req.ProductID, req.VariantID)
+	if err != nil {
+		return err
+	}
+	if err := variant.Discontinue(uc.clock.Now()); err != nil {
+		return err
+	}
+
+	plan := committer.NewPlanFor("DiscontinueVariant")
+	plan.Add(uc.variants.UpdateMut(variant))
+	return uc.commitVariant(ctx, plan, product, variant)
+}
+
+// discontinueVariantMuts discontinues the active variants of a product being archived.
+// It returns the variants discontinued and the mutations that persist them and write
+// their events to the outbox. Without a variant repository there is nothing to
+// discontinue.
+func (uc *ProductUseCases) discontinueVariantMuts(ctx context.Context, product *domain.Product, now time.Time) ([]*domain.ProductVariant, []*spanner.Mutation, error) {
+	if uc.variants == nil || product.Status() != domain.ProductStatusArchived {
+		return nil, nil, nil
+	}
+	variants, err := uc.variants.FindByProductID(ctx, product.ID())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var discontinued []*domain.ProductVariant
+	var muts []*spanner.Mutation
+	for _, variant := range variants {
+		if variant.Status() == domain.VariantStatusDiscontinued {
+			continue
+		}
+		if err := variant.Discontinue(now); err != nil {
+			return nil, nil, err
+		}
+		muts = append(muts, uc.variants.UpdateMut(variant))
+		for _, event := range variant.DomainEvents() {
+			mut, err := uc.outboxRepo.InsertTenantEventMut(product.TenantID(), event)
+			if err != nil {
+				return nil, nil, err
+			}
+			muts = append(muts, mut)
+		}
+		discontinued = append(discontinued, variant)
+	}
+	return discontinued, muts, nil
+}
+
+// checkSKUAvailable returns domain.ErrDuplicateSKU if another variant or a product uses
+// the SKU of variant. Only the variant SKUs are unique by index, so a product and a
+// variant given the same SKU concurrently are not told apart.
+func (uc *ProductUseCases) checkSKUAvailable(ctx context.Context, variant *domain.ProductVariant) error {
+	_, err := uc.repo.FindIDBySKU(ctx, variant.SKU())
+	if err == nil {
+		return domain.ErrDuplicateSKU
+	}
+	if !errors.Is(err, domain.ErrProductNotFound) {
+		return err
+	}
+
+	id, err := uc.variants.FindIDBySKU(ctx, variant.SKU())
+	if errors.Is(err, domain.ErrVariantNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if id != variant.ID() {
+		return domain.ErrDuplicateSKU
+	}
+	return nil
+}
+
+// commitVariant adds the outbox mutations of the events raised by variant of product to
+// plan, applies it and hands the events to the in-process publisher, if any. A SKU taken
+// by a concurrent command fails the commit with domain.ErrDuplicateSKU.
+func (uc *ProductUseCases) commitVariant(ctx context.Context, plan *committer.Plan, product *domain.Product, variant *domain.ProductVariant) error {
+	for _, event := range variant.DomainEvents() {
+		mut, err := uc.outboxRepo.InsertTenantEventMut(product.TenantID(), event)
+		if err != nil {
+			return err
+		}
+		plan.Add(mut)
+	}
+	if err := uc.committer.Apply(ctx, plan); err != nil {
+		if spanner.ErrCode(err) == codes.AlreadyExists {
+			return domain.ErrDuplicateSKU
+		}
+		return err
+	}
+
+	if uc.publisher != nil {
+		uc.publisher.Publish(ctx, variant.DomainEvents()...)
+	}
+	return nil
+}
+
+// newPriceDelta converts the price delta of a request to domain money; a zero
+// denominator means no delta.
+func newPriceDelta(numerator, denominator int64, currency, defaultCurrency string) (*domain.Money, error) {
+	if denominator == 0 && numerator == 0 {
+		denominator = 1
+	}
+	if denominator <= 0 {
+		return nil, domain.ErrInvalidVariantPrice
+	}
+	return newMoney(numerator, denominator, currency, defaultCurrency)
+}
+
+// ValidateAddVariantRequest validates the add variant request. The price delta is checked
+// against the base price of the product when it is loaded.
+func ValidateAddVariantRequest(req AddVariantRequest) error {
+	if req.ProductID == "" {
+		return domain.ErrInvalidID
+	}
+	return validateVariant(req.SKU, req.Attributes, req.PriceDeltaNumerator, req.PriceDeltaDenominator, req.PriceDeltaCurrency)
+}
+
+// ValidateUpdateVariantRequest validates the update variant request.
+func ValidateUpdateVariantRequest(req UpdateVariantRequest) error {
+	if req.ProductID == "" || req.VariantID == "" {
+		return domain.ErrInvalidID
+	}
+	return validateVariant(req.SKU, req.Attributes, req.PriceDeltaNumerator, req.PriceDeltaDenominator, req.PriceDeltaCurrency)
+}
+
+// ValidateDiscontinueVariantRequest validates the discontinue variant request.
+func ValidateDiscontinueVariantRequest(req DiscontinueVariantRequest) error {
+	if req.ProductID == "" || req.VariantID == "" {
+		return domain.ErrInvalidID
+	}
+	return nil
+}
+
+func validateVariant(sku string, attributes map[string]string, numerator, denominator int64, currency string) error {
+	if _, err := domain.ParseSKU(sku); err != nil {
+		return err
+	}
+	if _, err := domain.ParseVariantAttributes(attributes); err != nil {
+		return err
+	}
+	if denominator < 0 || (denominator == 0 && numerator != 0) {
+		return domain.ErrInvalidVariantPrice
+	}
+	if currency != "" {
+		if _, err := domain.ParseCurrency(currency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
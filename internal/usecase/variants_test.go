@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/product-catalog-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAddVariantRequest(t *testing.T) {
+	valid := func(modify func(*AddVariantRequest)) AddVariantRequest {
+		req := AddVariantRequest{
+			ProductID:             "product-1",
+			SKU:                   "SHIRT-M-BLUE",
+			Attributes:            map[string]string{"size": "M", "color": "blue"},
+			PriceDeltaNumerator:   250,
+			PriceDeltaDenominator: 100,
+		}
+		modify(&req)
+		return req
+	}
+
+	tests := []struct {
+		name    string
+		req     AddVariantRequest
+		wantErr error
+	}{
+		{"valid", valid(func(*AddVariantRequest) {}), nil},
+		{"no delta", valid(func(r *AddVariantRequest) { r.PriceDeltaNumerator, r.PriceDeltaDenominator = 0, 0 }), nil},
+		{"negative delta", valid(func(r *AddVariantRequest) { r.PriceDeltaNumerator = -250 }), nil},
+		{"no attributes", valid(func(r *AddVariantRequest) { r.Attributes = nil }), nil},
+		{"missing product ID", valid(func(r *AddVariantRequest) { r.ProductID = "" }), domain.ErrInvalidID},
+		{"missing SKU", valid(func(r *AddVariantRequest) { r.SKU = "" }), domain.ErrInvalidSKU},
+		{"invalid SKU", valid(func(r *AddVariantRequest) { r.SKU = "SHIRT/M" }), domain.ErrInvalidSKU},
+		{"invalid attribute", valid(func(r *AddVariantRequest) { r.Attributes = map[string]string{"Size": "M"} }), domain.ErrInvalidVariantAttributes},
+		{"delta without denominator", valid(func(r *AddVariantRequest) { r.PriceDeltaDenominator = 0 }), domain.ErrInvalidVariantPrice},
+		{"negative denominator", valid(func(r *AddVariantRequest) { r.PriceDeltaDenominator = -100 }), domain.ErrInvalidVariantPrice},
+		{"invalid currency", valid(func(r *AddVariantRequest) { r.PriceDeltaCurrency = "euro" }), domain.ErrInvalidCurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAddVariantRequest(tt.req)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateVariantRequest(t *testing.T) {
+	req := UpdateVariantRequest{ProductID: "product-1", VariantID: "variant-1", SKU: "SHIRT-L"}
+	assert.NoError(t, ValidateUpdateVariantRequest(req))
+
+	req.VariantID = ""
+	assert.ErrorIs(t, ValidateUpdateVariantRequest(req), domain.ErrInvalidID)
+
+	req.VariantID, req.SKU = "variant-1", " "
+	assert.ErrorIs(t, ValidateUpdateVariantRequest(req), domain.ErrInvalidSKU)
+}
+
+func TestValidateDiscontinueVariantRequest(t *testing.T) {
+	assert.NoError(t, ValidateDiscontinueVariantRequest(DiscontinueVariantRequest{ProductID: "product-1", VariantID: "variant-1"}))
+	assert.ErrorIs(t, ValidateDiscontinueVariantRequest(DiscontinueVariantRequest{ProductID: "product-1"}), domain.ErrInvalidID)
+}
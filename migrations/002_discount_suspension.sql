@@ -0,0 +1,4 @@
+-- Discounts are suspended while a product is inactive.
+-- Existing rows have no suspended discounts, so no backfill is needed.
+
+ALTER TABLE products ADD COLUMN discount_suspended_at TIMESTAMP;
@@ -0,0 +1,10 @@
+-- Customer "notify me" subscriptions, fanned out to targeted outbox events.
+-- Interleaved in products so subscriptions are stored with, and deleted with, their product.
+
+CREATE TABLE notification_subscriptions (
+    product_id STRING(36) NOT NULL,
+    kind STRING(20) NOT NULL,
+    subscriber_id STRING(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id, kind, subscriber_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
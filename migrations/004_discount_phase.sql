@@ -0,0 +1,6 @@
+-- Last announced phase of the discount period (scheduled, started, ended), so the discount
+-- scheduler records product.discount_started / product.discount_ended exactly once.
+-- NULL is read as scheduled; run `go run ./cmd/backfill -filler discount_phase` after
+-- applying this migration so discounts already running or expired are not re-announced.
+
+ALTER TABLE products ADD COLUMN discount_phase STRING(20);
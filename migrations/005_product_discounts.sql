@@ -0,0 +1,16 @@
+-- Products may hold several discounts, so discounts move to an interleaved child table.
+-- The discount_* columns of products are kept for rows written before this migration;
+-- they are read as a single discount whose ID is the product ID and are cleared the next
+-- time the product is written.
+
+CREATE TABLE product_discounts (
+    product_id STRING(36) NOT NULL,
+    discount_id STRING(36) NOT NULL,
+    percentage NUMERIC NOT NULL,
+    priority INT64 NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    suspended_at TIMESTAMP,
+    phase STRING(20) NOT NULL,
+) PRIMARY KEY (product_id, discount_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
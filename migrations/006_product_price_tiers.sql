@@ -0,0 +1,12 @@
+-- Volume pricing: buying at least min_quantity units of a product sets its unit price,
+-- either to a fixed price (unit_price_*) or to the base price less percent_off.
+-- Exactly one of the two is set on each row.
+
+CREATE TABLE product_price_tiers (
+    product_id STRING(36) NOT NULL,
+    min_quantity INT64 NOT NULL,
+    unit_price_numerator INT64,
+    unit_price_denominator INT64,
+    percent_off NUMERIC,
+) PRIMARY KEY (product_id, min_quantity),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,5 @@
+-- ISO 4217 code of the product's prices (base price and price tier unit prices).
+-- NULL is read as USD, the only currency before this migration; run
+-- `go run ./cmd/backfill -filler currency` after applying it to record USD explicitly.
+
+ALTER TABLE products ADD COLUMN currency STRING(3);
@@ -0,0 +1,10 @@
+-- Price book: the base price of a product in currencies other than its own.
+-- Products without a row for a currency are priced in it by converting the base price.
+
+CREATE TABLE product_prices (
+    product_id STRING(36) NOT NULL,
+    currency STRING(3) NOT NULL,
+    price_numerator INT64 NOT NULL,
+    price_denominator INT64 NOT NULL,
+) PRIMARY KEY (product_id, currency),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,10 @@
+-- Merchandising ranks: the order of the products of a category, ingested from the
+-- central merchandising service. Products without a rank are listed after ranked ones.
+-- Ranks are keyed by category, so a product moved to another category loses its rank.
+
+CREATE TABLE merchandising_ranks (
+    category STRING(100) NOT NULL,
+    product_id STRING(36) NOT NULL,
+    rank INT64 NOT NULL,
+    updated_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+) PRIMARY KEY (category, product_id);
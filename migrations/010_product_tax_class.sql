@@ -0,0 +1,4 @@
+-- Product tax class: selects the tax rate applied when computing tax-inclusive prices.
+-- Existing rows keep a NULL tax class, which is read as "standard".
+
+ALTER TABLE products ADD COLUMN tax_class STRING(50);
@@ -0,0 +1,19 @@
+-- Price history: an append-only audit trail of the prices of a product. A row is written
+-- in the same transaction as every change of its base price or discounts, with the base
+-- and effective price in the product's currency right after the change.
+-- Products changed only before this migration have no history.
+
+CREATE TABLE price_history (
+    product_id STRING(36) NOT NULL,
+    changed_at TIMESTAMP NOT NULL,
+    change_id STRING(36) NOT NULL,
+    change_type STRING(100) NOT NULL,
+    base_price_numerator INT64 NOT NULL,
+    base_price_denominator INT64 NOT NULL,
+    effective_price_numerator INT64 NOT NULL,
+    effective_price_denominator INT64 NOT NULL,
+    currency STRING(3) NOT NULL,
+    discount_id STRING(36),
+    discount_percent NUMERIC,
+) PRIMARY KEY (product_id, changed_at, change_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
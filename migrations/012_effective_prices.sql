@@ -0,0 +1,16 @@
+-- Effective prices: a projection of the effective price of each product over time, one row
+-- per period over which it does not change. The first period starts at
+-- 0001-01-01T00:00:00Z and the last has no valid_until. The rows of a product are rewritten
+-- in the same transaction as every change of its base price or discounts.
+-- Run `catalogctl project-prices` after applying it to project the existing products.
+
+CREATE TABLE effective_prices (
+    product_id STRING(36) NOT NULL,
+    valid_from TIMESTAMP NOT NULL,
+    valid_until TIMESTAMP,
+    effective_price_numerator INT64 NOT NULL,
+    effective_price_denominator INT64 NOT NULL,
+    discount_id STRING(36),
+    discount_percent NUMERIC,
+) PRIMARY KEY (product_id, valid_from),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
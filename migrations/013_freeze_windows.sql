@@ -0,0 +1,13 @@
+-- Freeze windows: periods during which price and discount changes are refused, e.g. during
+-- peak sales events. A window without tenant_id freezes every tenant; callers in one of
+-- the exempt_roles may still make changes. Scheduled through the admin endpoint.
+
+CREATE TABLE freeze_windows (
+    freeze_id STRING(36) NOT NULL,
+    tenant_id STRING(100),
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    exempt_roles ARRAY<STRING(50)>,
+    reason STRING(MAX),
+    created_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+) PRIMARY KEY (freeze_id);
@@ -0,0 +1,17 @@
+-- Campaigns apply the same discount to many products: every product of a category, or
+-- the explicit product_ids. Exactly one of category and product_ids is set. The discount
+-- applied to each product has the campaign_id as its discount ID.
+
+CREATE TABLE campaigns (
+    campaign_id STRING(36) NOT NULL,
+    name STRING(255) NOT NULL,
+    category STRING(100),
+    product_ids ARRAY<STRING(36)>,
+    percentage NUMERIC NOT NULL,
+    priority INT64 NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL,
+    status STRING(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (campaign_id);
@@ -0,0 +1,12 @@
+-- API keys authenticate clients when API_KEY_AUTH is enabled. Only the SHA-256 hash of
+-- a key's secret is stored. A rotated key keeps working until its expires_at; a revoked
+-- key stops working at once.
+
+CREATE TABLE api_keys (
+    key_id STRING(36) NOT NULL,
+    client_id STRING(100) NOT NULL,
+    key_hash BYTES(32) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+) PRIMARY KEY (key_id);
@@ -0,0 +1,7 @@
+-- Discount kinds: a discount either lowers the unit price by its percentage or is a
+-- buy-X-get-Y promotion, whose buy_quantity and get_quantity are set and whose percentage
+-- is 100. Existing rows keep a NULL kind, which is read as "percentage".
+
+ALTER TABLE product_discounts ADD COLUMN kind STRING(20);
+ALTER TABLE product_discounts ADD COLUMN buy_quantity INT64;
+ALTER TABLE product_discounts ADD COLUMN get_quantity INT64;
@@ -0,0 +1,12 @@
+-- Customer segment pricing: the base price of a product for customers of a segment,
+-- either a fixed price (price_*) in the product's currency or the base price less
+-- percent_off. Exactly one of the two is set on each row. Discounts apply on top.
+
+CREATE TABLE product_segment_prices (
+    product_id STRING(36) NOT NULL,
+    segment STRING(50) NOT NULL,
+    price_numerator INT64,
+    price_denominator INT64,
+    percent_off NUMERIC,
+) PRIMARY KEY (product_id, segment),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,14 @@
+-- Regional pricing: the base price of a product in a market (US, EU, UK), in the
+-- market's currency. A market without a row sells at the product's base price.
+-- A discount with a market applies to the price in that market only; NULL applies it
+-- to the base price.
+
+CREATE TABLE product_market_prices (
+    product_id STRING(36) NOT NULL,
+    market STRING(2) NOT NULL,
+    price_numerator INT64 NOT NULL,
+    price_denominator INT64 NOT NULL,
+) PRIMARY KEY (product_id, market),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+ALTER TABLE product_discounts ADD COLUMN market STRING(2);
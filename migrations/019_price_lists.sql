@@ -0,0 +1,21 @@
+-- Price lists hold negotiated prices, e.g. for a B2B customer, apart from the base prices
+-- of the products. Every entry is in the currency of its list. A list prices products
+-- from valid_from until valid_until; a NULL valid_until keeps it valid indefinitely.
+
+CREATE TABLE price_lists (
+    price_list_id STRING(36) NOT NULL,
+    name STRING(255) NOT NULL,
+    currency STRING(3) NOT NULL,
+    valid_from TIMESTAMP NOT NULL,
+    valid_until TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (price_list_id);
+
+CREATE TABLE price_list_entries (
+    price_list_id STRING(36) NOT NULL,
+    product_id STRING(36) NOT NULL,
+    price_numerator INT64 NOT NULL,
+    price_denominator INT64 NOT NULL,
+) PRIMARY KEY (price_list_id, product_id),
+  INTERLEAVE IN PARENT price_lists ON DELETE CASCADE;
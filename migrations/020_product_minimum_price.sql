@@ -0,0 +1,5 @@
+-- Product minimum price: the price floor below which a discount must not bring the base
+-- price. Existing rows keep a NULL minimum price, i.e. no floor.
+
+ALTER TABLE products ADD COLUMN minimum_price_numerator INT64;
+ALTER TABLE products ADD COLUMN minimum_price_denominator INT64;
@@ -0,0 +1,5 @@
+-- Product cost price: what the product costs the merchant, used to report its margin and
+-- to guard discounts against selling at a loss. Existing rows keep a NULL cost price.
+
+ALTER TABLE products ADD COLUMN cost_price_numerator INT64;
+ALTER TABLE products ADD COLUMN cost_price_denominator INT64;
@@ -0,0 +1,8 @@
+-- Scheduled base price changes: the new base price of a product and when it takes effect.
+-- The price change scheduler applies changes once they are due; NULL means none is pending.
+
+ALTER TABLE products ADD COLUMN pending_price_numerator INT64;
+ALTER TABLE products ADD COLUMN pending_price_denominator INT64;
+ALTER TABLE products ADD COLUMN pending_price_effective_at TIMESTAMP;
+
+CREATE NULL_FILTERED INDEX idx_products_pending_price ON products(pending_price_effective_at);
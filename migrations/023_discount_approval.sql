@@ -0,0 +1,7 @@
+-- Deep discounts: a discount above the configured approval threshold is held pending
+-- approval and does not apply until approved. NULL pending_approval is read as false;
+-- approved_by and approved_at record who approved a discount that needed approval.
+
+ALTER TABLE product_discounts ADD COLUMN pending_approval BOOL;
+ALTER TABLE product_discounts ADD COLUMN approved_by STRING(256);
+ALTER TABLE product_discounts ADD COLUMN approved_at TIMESTAMP;
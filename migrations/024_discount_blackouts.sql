@@ -0,0 +1,13 @@
+-- Discount blackouts: periods during which the products of a category must not be
+-- discounted, e.g. minimum advertised price weeks. ApplyDiscount refuses discounts valid
+-- at any time during a blackout of the product's category. Scheduled through the admin
+-- endpoint.
+
+CREATE TABLE discount_blackouts (
+    blackout_id STRING(36) NOT NULL,
+    category STRING(100) NOT NULL,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    reason STRING(MAX),
+    created_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+) PRIMARY KEY (blackout_id);
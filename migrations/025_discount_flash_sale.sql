@@ -0,0 +1,4 @@
+-- Flash sales: a discount marked as a flash sale has its start and end announced as soon
+-- as they pass. NULL flash_sale is read as false.
+
+ALTER TABLE product_discounts ADD COLUMN flash_sale BOOL;
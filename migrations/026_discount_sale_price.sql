@@ -0,0 +1,6 @@
+-- Sale price discounts: a discount expressed as "sale price = X" in the product's currency.
+-- percentage holds the implied percentage off the base price. Percentage discounts keep a
+-- NULL sale price.
+
+ALTER TABLE product_discounts ADD COLUMN sale_price_numerator INT64;
+ALTER TABLE product_discounts ADD COLUMN sale_price_denominator INT64;
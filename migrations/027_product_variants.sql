@@ -0,0 +1,19 @@
+-- Product variants: the sellable versions of a product, e.g. the sizes and colors of a
+-- shirt. Each has a SKU unique across the catalog, its attributes as a JSON object of
+-- strings, and a price delta in the product's currency added to its base price.
+-- Discontinued variants are kept with status 'discontinued'.
+
+CREATE TABLE product_variants (
+    product_id STRING(36) NOT NULL,
+    variant_id STRING(36) NOT NULL,
+    sku STRING(64) NOT NULL,
+    attributes JSON,
+    price_delta_numerator INT64 NOT NULL,
+    price_delta_denominator INT64 NOT NULL,
+    status STRING(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id, variant_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX idx_product_variants_sku ON product_variants(sku);
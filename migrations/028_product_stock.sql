@@ -0,0 +1,12 @@
+-- Product stock: the units of a product on hand and the units of them reserved for
+-- orders, with a version incremented by every change for optimistic concurrency checks.
+-- Products without a row do not have their stock tracked.
+
+CREATE TABLE product_stock (
+    product_id STRING(36) NOT NULL,
+    stock_level INT64 NOT NULL,
+    reserved INT64 NOT NULL,
+    version INT64 NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,6 @@
+-- Product tags and attributes: free-form labels to group and filter products by, e.g.
+-- "summer-sale", and key-value pairs describing them, e.g. {"material": "oak"}, as a JSON
+-- object. Existing rows keep NULL, which is read as no tags or attributes.
+
+ALTER TABLE products ADD COLUMN tags ARRAY<STRING(50)>;
+ALTER TABLE products ADD COLUMN attributes JSON;
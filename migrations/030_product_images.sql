@@ -0,0 +1,13 @@
+-- Product images: the gallery of a product, in display order by position. Each image has
+-- the URL it is served from and an optional alternative text; a product with images has
+-- exactly one primary image, shown in listings. The images of a product are replaced as a
+-- whole, so positions are renumbered from 0 on every change.
+
+CREATE TABLE product_images (
+    product_id STRING(36) NOT NULL,
+    position INT64 NOT NULL,
+    url STRING(2048) NOT NULL,
+    alt_text STRING(250),
+    is_primary BOOL NOT NULL,
+) PRIMARY KEY (product_id, position),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
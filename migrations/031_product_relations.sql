@@ -0,0 +1,12 @@
+-- Product relations: typed links from a product to related products, e.g. to sell
+-- accessories with it or point to the product that replaces it. relation_type is
+-- 'related', 'accessory' or 'replacement'. Links are removed with the product they are
+-- from; a link to a product that no longer exists is ignored when reading.
+
+CREATE TABLE product_relations (
+    product_id STRING(36) NOT NULL,
+    relation_type STRING(20) NOT NULL,
+    related_product_id STRING(36) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id, relation_type, related_product_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
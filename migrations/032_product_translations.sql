@@ -0,0 +1,13 @@
+-- Product translations: the name and description of a product in locales other than the
+-- default locale ('en'), which the products table holds. locale is a language tag such as
+-- 'de' or 'pt-BR'. Reads in a locale without a translation fall back to the translation
+-- in its language, then to the default locale.
+
+CREATE TABLE product_translations (
+    product_id STRING(36) NOT NULL,
+    locale STRING(10) NOT NULL,
+    name STRING(255) NOT NULL,
+    description STRING(MAX),
+    updated_at TIMESTAMP NOT NULL,
+) PRIMARY KEY (product_id, locale),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
@@ -0,0 +1,7 @@
+-- Product slugs: the human-readable URL key of a product, e.g. 'blue-widget', built from
+-- its name at creation and suffixed ('blue-widget-2') if another product has it. Existing
+-- rows keep NULL until they are given a slug; the index is NULL_FILTERED so any number of
+-- them can lack one while every slug stays unique.
+
+ALTER TABLE products ADD COLUMN slug STRING(100);
+CREATE UNIQUE NULL_FILTERED INDEX idx_products_slug ON products(slug);
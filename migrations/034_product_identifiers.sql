@@ -0,0 +1,9 @@
+-- Product identifiers: the stock keeping unit ('WDG-1') and the barcode number (GTIN-8,
+-- -12, -13 or -14) of a product, both optional. The indexes are NULL_FILTERED so any
+-- number of products can lack an identifier while every SKU and GTIN stays unique among
+-- products; SKUs are also checked against the SKUs of variants before they are written.
+
+ALTER TABLE products ADD COLUMN sku STRING(64);
+ALTER TABLE products ADD COLUMN gtin STRING(14);
+CREATE UNIQUE NULL_FILTERED INDEX idx_products_sku ON products(sku);
+CREATE UNIQUE NULL_FILTERED INDEX idx_products_gtin ON products(gtin);
@@ -0,0 +1,9 @@
+-- Scheduled status changes: when a product is to be activated and deactivated, e.g. for
+-- a midnight launch or a seasonal range. The status scheduler makes the changes through
+-- the usual activation and deactivation once they are due and clears the column; the
+-- NULL_FILTERED indexes only hold the products with a change scheduled.
+
+ALTER TABLE products ADD COLUMN activate_at TIMESTAMP;
+ALTER TABLE products ADD COLUMN deactivate_at TIMESTAMP;
+CREATE NULL_FILTERED INDEX idx_products_activate_at ON products(activate_at);
+CREATE NULL_FILTERED INDEX idx_products_deactivate_at ON products(deactivate_at);
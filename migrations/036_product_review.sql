@@ -0,0 +1,8 @@
+-- Product review: the latest review of a product in the publishing workflow, where a
+-- draft is submitted for review (status 'pending_review') and approved, which activates
+-- it, or rejected back to draft. submitted_by is NULL for products never submitted;
+-- reviewed_by is NULL while a review is pending and rejection_reason unless rejected.
+
+ALTER TABLE products ADD COLUMN submitted_by STRING(256);
+ALTER TABLE products ADD COLUMN reviewed_by STRING(256);
+ALTER TABLE products ADD COLUMN rejection_reason STRING(MAX);
@@ -0,0 +1,11 @@
+-- Category attribute schemas: the attributes the products of a category may have, as a
+-- JSON array of {"name", "type", "required", "allowed_values"} objects, where type is
+-- 'string', 'integer', 'decimal' or 'boolean'. Product attributes are validated against
+-- the schema of their category when created or changed. Stored through the admin
+-- endpoint.
+
+CREATE TABLE category_attribute_schemas (
+    category STRING(100) NOT NULL,
+    attributes JSON NOT NULL,
+    updated_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+) PRIMARY KEY (category);
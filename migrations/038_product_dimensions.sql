@@ -0,0 +1,10 @@
+-- Product dimensions: the shipping weight and packed height, width and depth of a
+-- product, each kept in the unit it was given in ('g', 'kg', 'lb' or 'oz' for the
+-- weight; 'mm', 'cm', 'm' or 'in' for the dimensions). All NULL if the product has none.
+
+ALTER TABLE products ADD COLUMN weight FLOAT64;
+ALTER TABLE products ADD COLUMN weight_unit STRING(2);
+ALTER TABLE products ADD COLUMN height FLOAT64;
+ALTER TABLE products ADD COLUMN width FLOAT64;
+ALTER TABLE products ADD COLUMN depth FLOAT64;
+ALTER TABLE products ADD COLUMN dimension_unit STRING(2);
@@ -0,0 +1,16 @@
+-- Product ratings: the star rating of each customer review of a product, keyed by the ID
+-- the reviews service gave the review so redelivered and edited reviews are counted once,
+-- and the number and sum of the ratings on the product, from which the average is derived.
+-- Both rating columns are NULL until the product is first rated. Ratings are interleaved
+-- in products and deleted with their product.
+
+ALTER TABLE products ADD COLUMN rating_count INT64;
+ALTER TABLE products ADD COLUMN rating_sum INT64;
+
+CREATE TABLE product_ratings (
+    product_id STRING(36) NOT NULL,
+    review_id STRING(100) NOT NULL,
+    rating INT64 NOT NULL,
+    ingested_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+) PRIMARY KEY (product_id, review_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
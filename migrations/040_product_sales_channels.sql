@@ -0,0 +1,5 @@
+-- Product sales channels: the storefronts ('web', 'retail' or 'marketplace') a product is
+-- withheld from, sorted. NULL if the product is available on every channel, so products
+-- created before this migration stay available everywhere without a backfill.
+
+ALTER TABLE products ADD COLUMN unavailable_channels ARRAY<STRING(20)>;
@@ -0,0 +1,14 @@
+-- Multi-tenancy: the tenant that owns a product, asserted by the API gateway on the
+-- request that created it. NULL for the default tenant, so products created before this
+-- migration stay with the default tenant without a backfill. Campaigns and price lists
+-- belong to a tenant the same way. Outbox events carry the tenant for consumers to route on.
+
+ALTER TABLE products ADD COLUMN tenant_id STRING(100);
+
+ALTER TABLE campaigns ADD COLUMN tenant_id STRING(100);
+
+ALTER TABLE price_lists ADD COLUMN tenant_id STRING(100);
+
+ALTER TABLE outbox_events ADD COLUMN tenant_id STRING(100);
+
+CREATE INDEX idx_products_tenant_category ON products(tenant_id, category, status);
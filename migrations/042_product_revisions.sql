@@ -0,0 +1,14 @@
+-- Product revisions: an append-only history of the state of a product. A row is written
+-- in the same transaction as every command that changes a product, with the fields the
+-- command changed and a full snapshot of the product right after it, in the format of
+-- the snapshot of outbox events. Diffs are computed on read against the revision before.
+-- Products changed only before this migration have no revisions.
+
+CREATE TABLE product_revisions (
+    product_id STRING(36) NOT NULL,
+    revised_at TIMESTAMP NOT NULL,
+    revision_id STRING(36) NOT NULL,
+    changed_fields ARRAY<STRING(50)> NOT NULL,
+    snapshot JSON NOT NULL,
+) PRIMARY KEY (product_id, revised_at, revision_id),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
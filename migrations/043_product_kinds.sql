@@ -0,0 +1,6 @@
+-- Product kinds: whether a product is 'physical', and must have a weight and dimensions,
+-- or 'digital', and has no weight, dimensions or stock. NULL for products whose kind is
+-- unset, so products created before this migration keep their measures and stock rules
+-- without a backfill.
+
+ALTER TABLE products ADD COLUMN kind STRING(20);
@@ -0,0 +1,8 @@
+-- Product compliance: the regulatory flags of a product, checked when it is activated.
+-- minimum_age is the minimum age of its buyers (0 if it is not age-restricted), hazardous
+-- whether it is hazardous to ship, and restricted_markets the sorted market codes ('US',
+-- 'EU', 'UK') it must not be sold in. All are NULL for products without flags.
+
+ALTER TABLE products ADD COLUMN minimum_age INT64;
+ALTER TABLE products ADD COLUMN hazardous BOOL;
+ALTER TABLE products ADD COLUMN restricted_markets ARRAY<STRING(2)>;
@@ -0,0 +1,14 @@
+-- Product attachments: the support documents of a product, e.g. its spec sheet or manual,
+-- in display order by position. Each attachment has its type, the URL it is served from
+-- and the locale it is written in, NULL for a document not written in any one language.
+-- The attachments of a product are replaced as a whole, so positions are renumbered from
+-- 0 on every change.
+
+CREATE TABLE product_attachments (
+    product_id STRING(36) NOT NULL,
+    position INT64 NOT NULL,
+    attachment_type STRING(20) NOT NULL,
+    url STRING(2048) NOT NULL,
+    locale STRING(10),
+) PRIMARY KEY (product_id, position),
+  INTERLEAVE IN PARENT products ON DELETE CASCADE;
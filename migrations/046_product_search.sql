@@ -0,0 +1,10 @@
+-- Product search: full-text tokens of the name and description of every product, kept up
+-- to date by Spanner as generated columns, and the search index SearchProducts queries
+-- them through. The token columns are HIDDEN so SELECT * does not return them. The index
+-- stores the columns searches filter on, so a search does not read the base table to
+-- apply them.
+
+ALTER TABLE products ADD COLUMN name_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(name)) HIDDEN;
+ALTER TABLE products ADD COLUMN description_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(description)) HIDDEN;
+CREATE SEARCH INDEX idx_products_search ON products(name_tokens, description_tokens)
+    STORING (category, status, tenant_id);
@@ -0,0 +1,22 @@
+-- Product summaries: one slim row per product with the columns ListProducts filters and
+-- orders by, so listings scan it instead of the wide products rows and read only the
+-- products of the page. The row of a product is rewritten in the same transaction as every
+-- change of the product. The price bounds are the lowest and highest effective price of
+-- the product, in its currency, over the price periods not over when it was written.
+-- Run `catalogctl project-summaries` after applying it to summarize the existing products,
+-- then set LIST_PRODUCT_SUMMARIES=true.
+
+CREATE TABLE product_summaries (
+    product_id STRING(36) NOT NULL,
+    tenant_id STRING(100),
+    name STRING(255) NOT NULL,
+    category STRING(100) NOT NULL,
+    status STRING(20) NOT NULL,
+    min_price_numerator INT64 NOT NULL,
+    min_price_denominator INT64 NOT NULL,
+    max_price_numerator INT64 NOT NULL,
+    max_price_denominator INT64 NOT NULL,
+    summarized_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+) PRIMARY KEY (product_id);
+
+CREATE INDEX idx_product_summaries_category ON product_summaries(tenant_id, category, status);
@@ -0,0 +1,21 @@
+-- Product quality scores: how complete the content of each product is, from 0 to 100,
+-- overall and for its description, images, attributes and translations, for the admin
+-- listings. With QUALITY_SCORING_ENABLED=true the outbox dispatcher rescores a product
+-- whenever its content changes. Run `catalogctl score-quality` after applying it,
+-- and after changing an attribute schema or QUALITY_LOCALES, to score the existing
+-- products. translation_score is NULL when no locales are configured.
+
+CREATE TABLE product_quality_scores (
+    product_id STRING(36) NOT NULL,
+    tenant_id STRING(100),
+    name STRING(255) NOT NULL,
+    category STRING(100) NOT NULL,
+    score INT64 NOT NULL,
+    description_score INT64 NOT NULL,
+    image_score INT64 NOT NULL,
+    attribute_score INT64 NOT NULL,
+    translation_score INT64,
+    scored_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+) PRIMARY KEY (product_id);
+
+CREATE INDEX idx_product_quality_scores_score ON product_quality_scores(score);
@@ -0,0 +1,10 @@
+// Package migrations embeds the numbered DDL migrations of the catalog database, so the
+// service can check at startup that its database is up to date.
+package migrations
+
+import "embed"
+
+// Files holds the migration files, named NNN_description.sql and applied in name order.
+//
+//go:embed *.sql
+var Files embed.FS
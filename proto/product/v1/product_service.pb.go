@@ -24,9 +24,15 @@ const (
 
 // Money represents a monetary value with precise decimal arithmetic.
 type Money struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Numerator     int64                  `protobuf:"varint,1,opt,name=numerator,proto3" json:"numerator,omitempty"`
-	Denominator   int64                  `protobuf:"varint,2,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Numerator   int64                  `protobuf:"varint,1,opt,name=numerator,proto3" json:"numerator,omitempty"`
+	Denominator int64                  `protobuf:"varint,2,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	// ISO 4217 currency code. Optional in requests: a new product defaults to USD and a
+	// price change to the product's currency.
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// The amount rounded for display by the service's rounding policy, e.g. "19.99". Set
+	// in responses only; numerator and denominator stay exact.
+	Display       string `protobuf:"bytes,4,opt,name=display,proto3" json:"display,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -75,12 +81,50 @@ func (x *Money) GetDenominator() int64 {
 	return 0
 }
 
-// Discount represents a percentage-based discount with a validity period.
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Money) GetDisplay() string {
+	if x != nil {
+		return x.Display
+	}
+	return ""
+}
+
+// Discount represents a percentage-based discount or a buy-X-get-Y promotion with a
+// validity period. A suspended discount (product deactivated) does not apply until the
+// product is activated.
 type Discount struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Percentage    float64                `protobuf:"fixed64,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Percentage float64                `protobuf:"fixed64,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	StartDate  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Suspended  bool                   `protobuf:"varint,4,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	Id         string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// Among discounts of the same kind valid at the same time, the one with the highest
+	// priority applies.
+	Priority int32 `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	// "percentage" or "buy_x_get_y".
+	Kind string `protobuf:"bytes,7,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The rule of a buy_x_get_y promotion, whose percentage is 100; unset otherwise.
+	BuyXGetY *BuyXGetY `protobuf:"bytes,8,opt,name=buy_x_get_y,json=buyXGetY,proto3" json:"buy_x_get_y,omitempty"`
+	// The market ("US", "EU" or "UK") whose price the discount applies to; empty if it
+	// applies to the base price.
+	Market string `protobuf:"bytes,9,opt,name=market,proto3" json:"market,omitempty"`
+	// Set while the discount exceeds the approval threshold and awaits ApproveDiscount;
+	// it does not apply until approved.
+	PendingApproval bool `protobuf:"varint,10,opt,name=pending_approval,json=pendingApproval,proto3" json:"pending_approval,omitempty"`
+	// Who approved the discount; empty if it needed no approval or is still pending.
+	ApprovedBy string `protobuf:"bytes,11,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	// Set if the discount is a flash sale.
+	FlashSale bool `protobuf:"varint,12,opt,name=flash_sale,json=flashSale,proto3" json:"flash_sale,omitempty"`
+	// The price the product sells at under a discount expressed as a sale price, whose
+	// percentage is the implied percentage off the base price; unset otherwise.
+	SalePrice     *Money `protobuf:"bytes,13,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -136,162 +180,104 @@ func (x *Discount) GetEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
-// Product represents a product in the catalog.
-type Product struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Category          string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	BasePrice         *Money                 `protobuf:"bytes,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
-	EffectivePrice    *Money                 `protobuf:"bytes,6,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
-	Discount          *Discount              `protobuf:"bytes,7,opt,name=discount,proto3" json:"discount,omitempty"`
-	HasActiveDiscount bool                   `protobuf:"varint,8,opt,name=has_active_discount,json=hasActiveDiscount,proto3" json:"has_active_discount,omitempty"`
-	Status            string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *Product) Reset() {
-	*x = Product{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Product) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Product) ProtoMessage() {}
-
-func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[2]
+func (x *Discount) GetSuspended() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Suspended
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{2}
+	return false
 }
 
-func (x *Product) GetId() string {
+func (x *Discount) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Product) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Product) GetDescription() string {
+func (x *Discount) GetPriority() int32 {
 	if x != nil {
-		return x.Description
+		return x.Priority
 	}
-	return ""
+	return 0
 }
 
-func (x *Product) GetCategory() string {
+func (x *Discount) GetKind() string {
 	if x != nil {
-		return x.Category
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *Product) GetBasePrice() *Money {
-	if x != nil {
-		return x.BasePrice
-	}
-	return nil
-}
-
-func (x *Product) GetEffectivePrice() *Money {
+func (x *Discount) GetBuyXGetY() *BuyXGetY {
 	if x != nil {
-		return x.EffectivePrice
+		return x.BuyXGetY
 	}
 	return nil
 }
 
-func (x *Product) GetDiscount() *Discount {
+func (x *Discount) GetMarket() string {
 	if x != nil {
-		return x.Discount
+		return x.Market
 	}
-	return nil
+	return ""
 }
 
-func (x *Product) GetHasActiveDiscount() bool {
+func (x *Discount) GetPendingApproval() bool {
 	if x != nil {
-		return x.HasActiveDiscount
+		return x.PendingApproval
 	}
 	return false
 }
 
-func (x *Product) GetStatus() string {
+func (x *Discount) GetApprovedBy() string {
 	if x != nil {
-		return x.Status
+		return x.ApprovedBy
 	}
 	return ""
 }
 
-func (x *Product) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Discount) GetFlashSale() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.FlashSale
 	}
-	return nil
+	return false
 }
 
-func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Discount) GetSalePrice() *Money {
 	if x != nil {
-		return x.UpdatedAt
+		return x.SalePrice
 	}
 	return nil
 }
 
-// ProductSummary represents a summary of a product for list operations.
-type ProductSummary struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Category          string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	BasePrice         *Money                 `protobuf:"bytes,4,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
-	EffectivePrice    *Money                 `protobuf:"bytes,5,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
-	HasActiveDiscount bool                   `protobuf:"varint,6,opt,name=has_active_discount,json=hasActiveDiscount,proto3" json:"has_active_discount,omitempty"`
-	DiscountPercent   float64                `protobuf:"fixed64,7,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
-	Status            string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// BuyXGetY is the rule of a promotion: of every buy_quantity plus get_quantity units in a
+// cart, get_quantity units are free. Promotions do not change the unit or effective price;
+// the cart applies them.
+type BuyXGetY struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// From 1 to 1000.
+	BuyQuantity int32 `protobuf:"varint,1,opt,name=buy_quantity,json=buyQuantity,proto3" json:"buy_quantity,omitempty"`
+	// From 1 to 1000.
+	GetQuantity   int32 `protobuf:"varint,2,opt,name=get_quantity,json=getQuantity,proto3" json:"get_quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductSummary) Reset() {
-	*x = ProductSummary{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[3]
+func (x *BuyXGetY) Reset() {
+	*x = BuyXGetY{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductSummary) String() string {
+func (x *BuyXGetY) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductSummary) ProtoMessage() {}
+func (*BuyXGetY) ProtoMessage() {}
 
-func (x *ProductSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[3]
+func (x *BuyXGetY) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,99 +288,148 @@ func (x *ProductSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductSummary.ProtoReflect.Descriptor instead.
-func (*ProductSummary) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use BuyXGetY.ProtoReflect.Descriptor instead.
+func (*BuyXGetY) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ProductSummary) GetId() string {
+func (x *BuyXGetY) GetBuyQuantity() int32 {
 	if x != nil {
-		return x.Id
+		return x.BuyQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *ProductSummary) GetName() string {
+func (x *BuyXGetY) GetGetQuantity() int32 {
 	if x != nil {
-		return x.Name
+		return x.GetQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *ProductSummary) GetCategory() string {
+// PriceTier is a volume price: from min_quantity units on, each unit costs unit_price or
+// the base price less percent_off percent. A tier never raises the price above the base price.
+type PriceTier struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At least 2.
+	MinQuantity int64 `protobuf:"varint,1,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	// Types that are valid to be assigned to Price:
+	//
+	//	*PriceTier_UnitPrice
+	//	*PriceTier_PercentOff
+	Price         isPriceTier_Price `protobuf_oneof:"price"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceTier) Reset() {
+	*x = PriceTier{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTier) ProtoMessage() {}
+
+func (x *PriceTier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[3]
 	if x != nil {
-		return x.Category
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ProductSummary) GetBasePrice() *Money {
+// Deprecated: Use PriceTier.ProtoReflect.Descriptor instead.
+func (*PriceTier) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PriceTier) GetMinQuantity() int64 {
 	if x != nil {
-		return x.BasePrice
+		return x.MinQuantity
 	}
-	return nil
+	return 0
 }
 
-func (x *ProductSummary) GetEffectivePrice() *Money {
+func (x *PriceTier) GetPrice() isPriceTier_Price {
 	if x != nil {
-		return x.EffectivePrice
+		return x.Price
 	}
 	return nil
 }
 
-func (x *ProductSummary) GetHasActiveDiscount() bool {
+func (x *PriceTier) GetUnitPrice() *Money {
 	if x != nil {
-		return x.HasActiveDiscount
+		if x, ok := x.Price.(*PriceTier_UnitPrice); ok {
+			return x.UnitPrice
+		}
 	}
-	return false
+	return nil
 }
 
-func (x *ProductSummary) GetDiscountPercent() float64 {
+func (x *PriceTier) GetPercentOff() float64 {
 	if x != nil {
-		return x.DiscountPercent
+		if x, ok := x.Price.(*PriceTier_PercentOff); ok {
+			return x.PercentOff
+		}
 	}
 	return 0
 }
 
-func (x *ProductSummary) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+type isPriceTier_Price interface {
+	isPriceTier_Price()
 }
 
-func (x *ProductSummary) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+type PriceTier_UnitPrice struct {
+	UnitPrice *Money `protobuf:"bytes,2,opt,name=unit_price,json=unitPrice,proto3,oneof"`
 }
 
-// CreateProductRequest is the request to create a new product.
-type CreateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	BasePrice     *Money                 `protobuf:"bytes,4,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+type PriceTier_PercentOff struct {
+	PercentOff float64 `protobuf:"fixed64,3,opt,name=percent_off,json=percentOff,proto3,oneof"`
+}
+
+func (*PriceTier_UnitPrice) isPriceTier_Price() {}
+
+func (*PriceTier_PercentOff) isPriceTier_Price() {}
+
+// SegmentPrice is the price of a product for customers of a segment: a fixed price in the
+// product's currency replacing the base price, or the base price less percent_off percent.
+// Discounts apply on top of it.
+type SegmentPrice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lowercase letters, digits and underscores, at most 50 characters, e.g. "wholesale".
+	Segment string `protobuf:"bytes,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	// Types that are valid to be assigned to Price:
+	//
+	//	*SegmentPrice_FixedPrice
+	//	*SegmentPrice_PercentOff
+	Price         isSegmentPrice_Price `protobuf_oneof:"price"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductRequest) Reset() {
-	*x = CreateProductRequest{}
+func (x *SegmentPrice) Reset() {
+	*x = SegmentPrice{}
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductRequest) String() string {
+func (x *SegmentPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductRequest) ProtoMessage() {}
+func (*SegmentPrice) ProtoMessage() {}
 
-func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+func (x *SegmentPrice) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -406,61 +441,88 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
-func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SegmentPrice.ProtoReflect.Descriptor instead.
+func (*SegmentPrice) Descriptor() ([]byte, []int) {
 	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateProductRequest) GetName() string {
+func (x *SegmentPrice) GetSegment() string {
 	if x != nil {
-		return x.Name
+		return x.Segment
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetDescription() string {
+func (x *SegmentPrice) GetPrice() isSegmentPrice_Price {
 	if x != nil {
-		return x.Description
+		return x.Price
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateProductRequest) GetCategory() string {
+func (x *SegmentPrice) GetFixedPrice() *Money {
 	if x != nil {
-		return x.Category
+		if x, ok := x.Price.(*SegmentPrice_FixedPrice); ok {
+			return x.FixedPrice
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateProductRequest) GetBasePrice() *Money {
+func (x *SegmentPrice) GetPercentOff() float64 {
 	if x != nil {
-		return x.BasePrice
+		if x, ok := x.Price.(*SegmentPrice_PercentOff); ok {
+			return x.PercentOff
+		}
 	}
-	return nil
+	return 0
 }
 
-// CreateProductReply is the response after creating a product.
-type CreateProductReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type isSegmentPrice_Price interface {
+	isSegmentPrice_Price()
 }
 
-func (x *CreateProductReply) Reset() {
-	*x = CreateProductReply{}
+type SegmentPrice_FixedPrice struct {
+	FixedPrice *Money `protobuf:"bytes,2,opt,name=fixed_price,json=fixedPrice,proto3,oneof"`
+}
+
+type SegmentPrice_PercentOff struct {
+	PercentOff float64 `protobuf:"fixed64,3,opt,name=percent_off,json=percentOff,proto3,oneof"`
+}
+
+func (*SegmentPrice_FixedPrice) isSegmentPrice_Price() {}
+
+func (*SegmentPrice_PercentOff) isSegmentPrice_Price() {}
+
+// MarketPrice is the base price of a product in a market, in the market's currency, with
+// the discounts scoped to that market applied.
+type MarketPrice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "US", "EU" or "UK".
+	Market string `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	Price  *Money `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	// Set in responses only.
+	EffectivePrice *Money `protobuf:"bytes,3,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	// Set in responses only.
+	HasActiveDiscount bool `protobuf:"varint,4,opt,name=has_active_discount,json=hasActiveDiscount,proto3" json:"has_active_discount,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MarketPrice) Reset() {
+	*x = MarketPrice{}
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductReply) String() string {
+func (x *MarketPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductReply) ProtoMessage() {}
+func (*MarketPrice) ProtoMessage() {}
 
-func (x *CreateProductReply) ProtoReflect() protoreflect.Message {
+func (x *MarketPrice) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_product_v1_product_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,44 +534,9525 @@ func (x *CreateProductReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductReply.ProtoReflect.Descriptor instead.
-func (*CreateProductReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarketPrice.ProtoReflect.Descriptor instead.
+func (*MarketPrice) Descriptor() ([]byte, []int) {
 	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateProductReply) GetProductId() string {
+func (x *MarketPrice) GetMarket() string {
 	if x != nil {
-		return x.ProductId
+		return x.Market
 	}
 	return ""
 }
 
-// UpdateProductRequest is the request to update a product.
-type UpdateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MarketPrice) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *MarketPrice) GetEffectivePrice() *Money {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
 }
 
-func (x *UpdateProductRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MarketPrice) GetHasActiveDiscount() bool {
+	if x != nil {
+		return x.HasActiveDiscount
+	}
+	return false
+}
+
+// ExperimentContext identifies who a request prices for, so that pricing experiments can
+// assign them a variant.
+type ExperimentContext struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stable ID of the customer or session, at most 128 characters. Empty opts out of
+	// pricing experiments.
+	SubjectId     string `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExperimentContext) Reset() {
+	*x = ExperimentContext{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentContext) ProtoMessage() {}
+
+func (x *ExperimentContext) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentContext.ProtoReflect.Descriptor instead.
+func (*ExperimentContext) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExperimentContext) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+// ExperimentAssignment tells which pricing experiment variant prices are in.
+type ExperimentAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExperimentId  string                 `protobuf:"bytes,1,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	Variant       string                 `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExperimentAssignment) Reset() {
+	*x = ExperimentAssignment{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentAssignment) ProtoMessage() {}
+
+func (x *ExperimentAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentAssignment.ProtoReflect.Descriptor instead.
+func (*ExperimentAssignment) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExperimentAssignment) GetExperimentId() string {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return ""
+}
+
+func (x *ExperimentAssignment) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+// Product represents a product in the catalog.
+type Product struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category          string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	BasePrice         *Money                 `protobuf:"bytes,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	EffectivePrice    *Money                 `protobuf:"bytes,6,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	Discount          *Discount              `protobuf:"bytes,7,opt,name=discount,proto3" json:"discount,omitempty"`
+	HasActiveDiscount bool                   `protobuf:"varint,8,opt,name=has_active_discount,json=hasActiveDiscount,proto3" json:"has_active_discount,omitempty"`
+	Status            string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// All discounts of the product, ordered by start date. discount is the one that
+	// applies now, or else the one running or starting next.
+	Discounts []*Discount `protobuf:"bytes,12,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	// Volume price tiers, ordered by min_quantity.
+	PriceTiers []*PriceTier `protobuf:"bytes,13,rep,name=price_tiers,json=priceTiers,proto3" json:"price_tiers,omitempty"`
+	// Base prices in currencies other than the product's own, ordered by currency.
+	PriceBook []*Money `protobuf:"bytes,14,rep,name=price_book,json=priceBook,proto3" json:"price_book,omitempty"`
+	// Where the prices come from: "product" (the product's own currency), "price_book" or
+	// "converted" (the base price converted with the configured exchange rates).
+	PriceSource string `protobuf:"bytes,15,opt,name=price_source,json=priceSource,proto3" json:"price_source,omitempty"`
+	// The pricing experiment variant the prices are in; unset if none.
+	Experiment *ExperimentAssignment `protobuf:"bytes,16,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	// Tax treatment of the product, e.g. "standard"; see GetTaxInclusivePrice.
+	TaxClass string `protobuf:"bytes,17,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`
+	// Customer segment prices, ordered by segment.
+	SegmentPrices []*SegmentPrice `protobuf:"bytes,18,rep,name=segment_prices,json=segmentPrices,proto3" json:"segment_prices,omitempty"`
+	// The customer segment the prices are for; empty if the regular prices apply.
+	Segment string `protobuf:"bytes,19,opt,name=segment,proto3" json:"segment,omitempty"`
+	// Market prices, ordered by market.
+	MarketPrices []*MarketPrice `protobuf:"bytes,20,rep,name=market_prices,json=marketPrices,proto3" json:"market_prices,omitempty"`
+	// The market the prices are for; empty if the base prices apply.
+	Market string `protobuf:"bytes,21,opt,name=market,proto3" json:"market,omitempty"`
+	// The price floor below which a discount may not bring the base price; unset if the
+	// product has none, or the prices are converted or for a market. See SetMinimumPrice.
+	MinimumPrice *Money `protobuf:"bytes,22,opt,name=minimum_price,json=minimumPrice,proto3" json:"minimum_price,omitempty"`
+	// What the product costs the merchant; unset if it is not recorded, or the prices are
+	// converted or for a market. See SetCostPrice and GetMargin.
+	CostPrice *Money `protobuf:"bytes,23,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`
+	// The base price change scheduled for the product; unset if none is scheduled, or the
+	// prices are converted or for a market. See SchedulePriceChange.
+	PendingPriceChange *PendingPriceChange `protobuf:"bytes,24,opt,name=pending_price_change,json=pendingPriceChange,proto3" json:"pending_price_change,omitempty"`
+	// Variants of the product, ordered by ID. Only GetProduct sets them.
+	Variants []*Variant `protobuf:"bytes,25,rep,name=variants,proto3" json:"variants,omitempty"`
+	// Whether units of the product are available to sell; true if its stock is not
+	// tracked.
+	InStock bool `protobuf:"varint,26,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	// The stock of the product; unset if it is not tracked. See SetStock.
+	Stock *Stock `protobuf:"bytes,27,opt,name=stock,proto3" json:"stock,omitempty"`
+	// Tags of the product, sorted. See AddTag.
+	Tags []string `protobuf:"bytes,28,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Attributes of the product, e.g. {"material": "oak"}. See SetAttribute.
+	Attributes map[string]string `protobuf:"bytes,29,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Images of the product in display order. See SetImages.
+	Images []*ProductImage `protobuf:"bytes,30,rep,name=images,proto3" json:"images,omitempty"`
+	// Locale the name and description are in: the requested locale, its language or the
+	// default locale "en". See SetTranslation.
+	Locale string `protobuf:"bytes,31,opt,name=locale,proto3" json:"locale,omitempty"`
+	// URL slug of the product, e.g. "blue-widget"; empty for a product created before
+	// slugs that has not been given one. See GetProductBySlug and SetSlug.
+	Slug string `protobuf:"bytes,32,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Stock keeping unit of the product, e.g. "WDG-1"; empty if it has none. See
+	// GetProductBySKU and SetIdentifiers.
+	Sku string `protobuf:"bytes,33,opt,name=sku,proto3" json:"sku,omitempty"`
+	// Barcode number (GTIN-8, -12, -13 or -14) of the product; empty if it has none.
+	Gtin string `protobuf:"bytes,34,opt,name=gtin,proto3" json:"gtin,omitempty"`
+	// When the product is scheduled to be activated; unset if it is not. See
+	// ScheduleActivation.
+	ActivateAt *timestamppb.Timestamp `protobuf:"bytes,35,opt,name=activate_at,json=activateAt,proto3" json:"activate_at,omitempty"`
+	// When the product is scheduled to be deactivated; unset if it is not.
+	DeactivateAt *timestamppb.Timestamp `protobuf:"bytes,36,opt,name=deactivate_at,json=deactivateAt,proto3" json:"deactivate_at,omitempty"`
+	// The latest review of the product; unset if it was never submitted for review. See
+	// SubmitForReview.
+	Review *ProductReview `protobuf:"bytes,37,opt,name=review,proto3" json:"review,omitempty"`
+	// Shipping weight of the product; unset if it has none. See SetDimensions.
+	Weight *Weight `protobuf:"bytes,38,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Packed dimensions of the product; unset if it has none.
+	Dimensions *Dimensions `protobuf:"bytes,39,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	// Summary of the customer ratings of the product. See IngestReview.
+	Rating *RatingSummary `protobuf:"bytes,40,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Sales channels the product is available on, in name order: "marketplace", "retail"
+	// and "web". See SetChannelAvailability.
+	Channels []string `protobuf:"bytes,41,rep,name=channels,proto3" json:"channels,omitempty"`
+	// "physical" or "digital"; empty if the kind of the product is unset. See SetKind.
+	Kind string `protobuf:"bytes,42,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Regulatory flags of the product; unset if it has none. See SetCompliance.
+	Compliance *Compliance `protobuf:"bytes,43,opt,name=compliance,proto3" json:"compliance,omitempty"`
+	// Support documents of the product in display order. See ManageAttachments.
+	Attachments   []*ProductAttachment `protobuf:"bytes,44,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Product) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Product) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *Product) GetEffectivePrice() *Money {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
+}
+
+func (x *Product) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *Product) GetHasActiveDiscount() bool {
+	if x != nil {
+		return x.HasActiveDiscount
+	}
+	return false
+}
+
+func (x *Product) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Product) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetDiscounts() []*Discount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *Product) GetPriceTiers() []*PriceTier {
+	if x != nil {
+		return x.PriceTiers
+	}
+	return nil
+}
+
+func (x *Product) GetPriceBook() []*Money {
+	if x != nil {
+		return x.PriceBook
+	}
+	return nil
+}
+
+func (x *Product) GetPriceSource() string {
+	if x != nil {
+		return x.PriceSource
+	}
+	return ""
+}
+
+func (x *Product) GetExperiment() *ExperimentAssignment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+func (x *Product) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *Product) GetSegmentPrices() []*SegmentPrice {
+	if x != nil {
+		return x.SegmentPrices
+	}
+	return nil
+}
+
+func (x *Product) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *Product) GetMarketPrices() []*MarketPrice {
+	if x != nil {
+		return x.MarketPrices
+	}
+	return nil
+}
+
+func (x *Product) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *Product) GetMinimumPrice() *Money {
+	if x != nil {
+		return x.MinimumPrice
+	}
+	return nil
+}
+
+func (x *Product) GetCostPrice() *Money {
+	if x != nil {
+		return x.CostPrice
+	}
+	return nil
+}
+
+func (x *Product) GetPendingPriceChange() *PendingPriceChange {
+	if x != nil {
+		return x.PendingPriceChange
+	}
+	return nil
+}
+
+func (x *Product) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Product) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *Product) GetStock() *Stock {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+func (x *Product) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Product) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Product) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Product) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Product) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+func (x *Product) GetActivateAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivateAt
+	}
+	return nil
+}
+
+func (x *Product) GetDeactivateAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivateAt
+	}
+	return nil
+}
+
+func (x *Product) GetReview() *ProductReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *Product) GetWeight() *Weight {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *Product) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *Product) GetRating() *RatingSummary {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *Product) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Product) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Product) GetCompliance() *Compliance {
+	if x != nil {
+		return x.Compliance
+	}
+	return nil
+}
+
+func (x *Product) GetAttachments() []*ProductAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// ProductImage is an image of a product. A product with images has exactly one primary
+// image, the one shown in listings.
+type ProductImage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Absolute http or https URL, at most 2048 characters.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Alternative text, at most 250 characters.
+	AltText       string `protobuf:"bytes,2,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	Primary       bool   `protobuf:"varint,3,opt,name=primary,proto3" json:"primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductImage) Reset() {
+	*x = ProductImage{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductImage) ProtoMessage() {}
+
+func (x *ProductImage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductImage.ProtoReflect.Descriptor instead.
+func (*ProductImage) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProductImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ProductImage) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *ProductImage) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+// ProductAttachment is a support document of a product, e.g. its manual.
+type ProductAttachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "spec_sheet", "manual", "safety_data_sheet", "certificate" or "warranty".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Absolute http or https URL, at most 2048 characters.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Locale the document is written in, e.g. "de" or "pt-BR"; empty if it is not written
+	// in any one language.
+	Locale        string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductAttachment) Reset() {
+	*x = ProductAttachment{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductAttachment) ProtoMessage() {}
+
+func (x *ProductAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductAttachment.ProtoReflect.Descriptor instead.
+func (*ProductAttachment) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProductAttachment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductAttachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ProductAttachment) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// Stock is the stock of a product. version is incremented by every change; pass it as
+// expected_version to change the stock only if it did not change since it was read.
+type Stock struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Units on hand.
+	Level int64 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	// Units on hand reserved for orders.
+	Reserved int64 `protobuf:"varint,2,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	// Units available to sell: level minus reserved.
+	Available     int64 `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	Version       int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stock) Reset() {
+	*x = Stock{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stock) ProtoMessage() {}
+
+func (x *Stock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stock.ProtoReflect.Descriptor instead.
+func (*Stock) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Stock) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Stock) GetReserved() int64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *Stock) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *Stock) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Variant is a sellable version of a product, e.g. a size and color, identified by its
+// SKU. It is priced at the prices of the product plus its price delta.
+type Variant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Uppercase letters, digits, hyphens and underscores, at most 64 characters; unique
+	// across the catalog.
+	Sku string `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	// At most 10 attributes, e.g. {"size": "M", "color": "blue"}. Names are lowercase
+	// letters, digits and underscores.
+	Attributes map[string]string `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Added to the base price of the product; may be negative.
+	PriceDelta     *Money `protobuf:"bytes,4,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`
+	Price          *Money `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	EffectivePrice *Money `protobuf:"bytes,6,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	// "active" or "discontinued".
+	Status        string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Variant) Reset() {
+	*x = Variant{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variant) ProtoMessage() {}
+
+func (x *Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variant.ProtoReflect.Descriptor instead.
+func (*Variant) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Variant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Variant) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Variant) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Variant) GetPriceDelta() *Money {
+	if x != nil {
+		return x.PriceDelta
+	}
+	return nil
+}
+
+func (x *Variant) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Variant) GetEffectivePrice() *Money {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
+}
+
+func (x *Variant) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// ProductReview is the latest review of a product.
+type ProductReview struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Who submitted the product for review.
+	SubmittedBy string `protobuf:"bytes,1,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	// Who approved or rejected the product; empty while the review is pending.
+	ReviewedBy string `protobuf:"bytes,2,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	// Why the product was rejected; empty unless it was.
+	RejectionReason string `protobuf:"bytes,3,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProductReview) Reset() {
+	*x = ProductReview{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductReview) ProtoMessage() {}
+
+func (x *ProductReview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductReview.ProtoReflect.Descriptor instead.
+func (*ProductReview) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProductReview) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+func (x *ProductReview) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *ProductReview) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+// Compliance holds the regulatory flags of a product, checked when it is activated.
+type Compliance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Minimum age of the buyers, up to 99; 0 if the product is not age-restricted.
+	MinimumAge int32 `protobuf:"varint,1,opt,name=minimum_age,json=minimumAge,proto3" json:"minimum_age,omitempty"`
+	// Whether the product is hazardous to ship.
+	Hazardous bool `protobuf:"varint,2,opt,name=hazardous,proto3" json:"hazardous,omitempty"`
+	// Market codes the product must not be sold in, e.g. "UK", in code order.
+	RestrictedMarkets []string `protobuf:"bytes,3,rep,name=restricted_markets,json=restrictedMarkets,proto3" json:"restricted_markets,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Compliance) Reset() {
+	*x = Compliance{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Compliance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compliance) ProtoMessage() {}
+
+func (x *Compliance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compliance.ProtoReflect.Descriptor instead.
+func (*Compliance) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Compliance) GetMinimumAge() int32 {
+	if x != nil {
+		return x.MinimumAge
+	}
+	return 0
+}
+
+func (x *Compliance) GetHazardous() bool {
+	if x != nil {
+		return x.Hazardous
+	}
+	return false
+}
+
+func (x *Compliance) GetRestrictedMarkets() []string {
+	if x != nil {
+		return x.RestrictedMarkets
+	}
+	return nil
+}
+
+// Weight is the shipping weight of a product in the unit it was given in: "g", "kg",
+// "lb" or "oz".
+type Weight struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Positive.
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit  string  `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	// The weight in grams; ignored in requests.
+	Grams         float64 `protobuf:"fixed64,3,opt,name=grams,proto3" json:"grams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Weight) Reset() {
+	*x = Weight{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Weight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Weight) ProtoMessage() {}
+
+func (x *Weight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Weight.ProtoReflect.Descriptor instead.
+func (*Weight) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Weight) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Weight) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Weight) GetGrams() float64 {
+	if x != nil {
+		return x.Grams
+	}
+	return 0
+}
+
+// Dimensions are the height, width and depth of a packed product in the unit they were
+// given in: "mm", "cm", "m" or "in".
+type Dimensions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Positive.
+	Height float64 `protobuf:"fixed64,1,opt,name=height,proto3" json:"height,omitempty"`
+	Width  float64 `protobuf:"fixed64,2,opt,name=width,proto3" json:"width,omitempty"`
+	Depth  float64 `protobuf:"fixed64,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Unit   string  `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	// The dimensions in millimetres; ignored in requests.
+	HeightMm      float64 `protobuf:"fixed64,5,opt,name=height_mm,json=heightMm,proto3" json:"height_mm,omitempty"`
+	WidthMm       float64 `protobuf:"fixed64,6,opt,name=width_mm,json=widthMm,proto3" json:"width_mm,omitempty"`
+	DepthMm       float64 `protobuf:"fixed64,7,opt,name=depth_mm,json=depthMm,proto3" json:"depth_mm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dimensions) Reset() {
+	*x = Dimensions{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dimensions) ProtoMessage() {}
+
+func (x *Dimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
+func (*Dimensions) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Dimensions) GetHeight() float64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Dimensions) GetWidth() float64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Dimensions) GetDepth() float64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Dimensions) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Dimensions) GetHeightMm() float64 {
+	if x != nil {
+		return x.HeightMm
+	}
+	return 0
+}
+
+func (x *Dimensions) GetWidthMm() float64 {
+	if x != nil {
+		return x.WidthMm
+	}
+	return 0
+}
+
+func (x *Dimensions) GetDepthMm() float64 {
+	if x != nil {
+		return x.DepthMm
+	}
+	return 0
+}
+
+// RatingSummary summarizes the customer ratings of a product.
+type RatingSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Average star rating from 1 to 5; 0 if the product has no ratings.
+	Average float64 `protobuf:"fixed64,1,opt,name=average,proto3" json:"average,omitempty"`
+	// Number of ratings.
+	Count         int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingSummary) Reset() {
+	*x = RatingSummary{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingSummary) ProtoMessage() {}
+
+func (x *RatingSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingSummary.ProtoReflect.Descriptor instead.
+func (*RatingSummary) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RatingSummary) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *RatingSummary) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// PendingPriceChange is a base price change that takes effect at a later time.
+type PendingPriceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BasePrice     *Money                 `protobuf:"bytes,1,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingPriceChange) Reset() {
+	*x = PendingPriceChange{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingPriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingPriceChange) ProtoMessage() {}
+
+func (x *PendingPriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingPriceChange.ProtoReflect.Descriptor instead.
+func (*PendingPriceChange) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PendingPriceChange) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *PendingPriceChange) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+// ProductSummary represents a summary of a product for list operations.
+type ProductSummary struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category          string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	BasePrice         *Money                 `protobuf:"bytes,4,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	EffectivePrice    *Money                 `protobuf:"bytes,5,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	HasActiveDiscount bool                   `protobuf:"varint,6,opt,name=has_active_discount,json=hasActiveDiscount,proto3" json:"has_active_discount,omitempty"`
+	DiscountPercent   float64                `protobuf:"fixed64,7,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	Status            string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Where the prices come from, as in Product.
+	PriceSource string `protobuf:"bytes,10,opt,name=price_source,json=priceSource,proto3" json:"price_source,omitempty"`
+	// The market the prices are for, as in Product.
+	Market string `protobuf:"bytes,11,opt,name=market,proto3" json:"market,omitempty"`
+	// Whether units of the product are available, as in Product.
+	InStock bool `protobuf:"varint,12,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	// Tags of the product, as in Product.
+	Tags []string `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Primary image of the product; unset if it has no images.
+	PrimaryImage *ProductImage `protobuf:"bytes,14,opt,name=primary_image,json=primaryImage,proto3" json:"primary_image,omitempty"`
+	// Locale the name is in, as in Product.
+	Locale string `protobuf:"bytes,15,opt,name=locale,proto3" json:"locale,omitempty"`
+	// URL slug of the product, as in Product.
+	Slug string `protobuf:"bytes,16,opt,name=slug,proto3" json:"slug,omitempty"`
+	// SKU and GTIN of the product, as in Product.
+	Sku  string `protobuf:"bytes,17,opt,name=sku,proto3" json:"sku,omitempty"`
+	Gtin string `protobuf:"bytes,18,opt,name=gtin,proto3" json:"gtin,omitempty"`
+	// Summary of the customer ratings of the product, as in Product.
+	Rating *RatingSummary `protobuf:"bytes,19,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Sales channels the product is available on, as in Product.
+	Channels      []string `protobuf:"bytes,20,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSummary) Reset() {
+	*x = ProductSummary{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSummary) ProtoMessage() {}
+
+func (x *ProductSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSummary.ProtoReflect.Descriptor instead.
+func (*ProductSummary) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ProductSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *ProductSummary) GetEffectivePrice() *Money {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
+}
+
+func (x *ProductSummary) GetHasActiveDiscount() bool {
+	if x != nil {
+		return x.HasActiveDiscount
+	}
+	return false
+}
+
+func (x *ProductSummary) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *ProductSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProductSummary) GetPriceSource() string {
+	if x != nil {
+		return x.PriceSource
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *ProductSummary) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ProductSummary) GetPrimaryImage() *ProductImage {
+	if x != nil {
+		return x.PrimaryImage
+	}
+	return nil
+}
+
+func (x *ProductSummary) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+func (x *ProductSummary) GetRating() *RatingSummary {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *ProductSummary) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// CreateProductRequest is the request to create a new product.
+type CreateProductRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Category    string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	BasePrice   *Money                 `protobuf:"bytes,4,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	// Optional identifiers of the product, as in SetIdentifiersRequest.
+	Sku  string `protobuf:"bytes,5,opt,name=sku,proto3" json:"sku,omitempty"`
+	Gtin string `protobuf:"bytes,6,opt,name=gtin,proto3" json:"gtin,omitempty"`
+	// Optional attributes of the product, as in SetAttributeRequest. If the category has an
+	// attribute schema, they must match it; violations fail with INVALID_ARGUMENT and a
+	// google.rpc.BadRequest detail with a field violation per attribute.
+	Attributes map[string]string `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional weight and dimensions of the product, as in SetDimensionsRequest.
+	Weight     *Weight     `protobuf:"bytes,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	Dimensions *Dimensions `protobuf:"bytes,9,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	// Optional kind of the product, as in SetKindRequest. A physical product must be
+	// created with a weight and dimensions, a digital one without.
+	Kind          string `protobuf:"bytes,10,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetWeight() *Weight {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+// CreateProductReply is the response after creating a product.
+type CreateProductReply struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// URL slug built from the name, suffixed with "-2", "-3" and so on if another product
+	// has it. Renaming the product keeps the slug.
+	Slug          string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductReply) Reset() {
+	*x = CreateProductReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductReply) ProtoMessage() {}
+
+func (x *CreateProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductReply.ProtoReflect.Descriptor instead.
+func (*CreateProductReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateProductReply) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductReply) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// CloneProductRequest is the request to create a draft copy of a product: its name with
+// a " (copy)" suffix, description, category, base price and attributes.
+type CloneProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneProductRequest) Reset() {
+	*x = CloneProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneProductRequest) ProtoMessage() {}
+
+func (x *CloneProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneProductRequest.ProtoReflect.Descriptor instead.
+func (*CloneProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CloneProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// CloneProductReply is the response after cloning a product.
+type CloneProductReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the new product.
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// URL slug built from the name of the new product, as in CreateProductReply.
+	Slug          string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneProductReply) Reset() {
+	*x = CloneProductReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneProductReply) ProtoMessage() {}
+
+func (x *CloneProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneProductReply.ProtoReflect.Descriptor instead.
+func (*CloneProductReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CloneProductReply) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CloneProductReply) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// UpdateProductRequest is the request to update a product.
+type UpdateProductRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProductId   string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category    string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	// Replaces the attributes of the product if not empty; they are kept otherwise. The
+	// attributes are checked against the attribute schema of the category, as in
+	// CreateProductRequest, when they or the category change.
+	Attributes    map[string]string `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// UpdateProductReply is the response after updating a product.
+type UpdateProductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductReply) Reset() {
+	*x = UpdateProductReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductReply) ProtoMessage() {}
+
+func (x *UpdateProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductReply.ProtoReflect.Descriptor instead.
+func (*UpdateProductReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{25}
+}
+
+// ChangeBasePriceRequest is the request to change the base price of a product.
+type ChangeBasePriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	BasePrice     *Money                 `protobuf:"bytes,2,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeBasePriceRequest) Reset() {
+	*x = ChangeBasePriceRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeBasePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeBasePriceRequest) ProtoMessage() {}
+
+func (x *ChangeBasePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeBasePriceRequest.ProtoReflect.Descriptor instead.
+func (*ChangeBasePriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChangeBasePriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ChangeBasePriceRequest) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+// ChangeBasePriceReply is the response after changing the base price.
+type ChangeBasePriceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeBasePriceReply) Reset() {
+	*x = ChangeBasePriceReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeBasePriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeBasePriceReply) ProtoMessage() {}
+
+func (x *ChangeBasePriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeBasePriceReply.ProtoReflect.Descriptor instead.
+func (*ChangeBasePriceReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{27}
+}
+
+// SchedulePriceChangeRequest is the request to change the base price of a product at a
+// later time. A product has at most one pending change; scheduling another replaces it.
+type SchedulePriceChangeRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	BasePrice *Money                 `protobuf:"bytes,2,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	// When the change takes effect; must be in the future.
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeRequest) Reset() {
+	*x = SchedulePriceChangeRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeRequest) ProtoMessage() {}
+
+func (x *SchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SchedulePriceChangeRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SchedulePriceChangeRequest) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *SchedulePriceChangeRequest) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+// SchedulePriceChangeReply is the response after scheduling a price change.
+type SchedulePriceChangeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeReply) Reset() {
+	*x = SchedulePriceChangeReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeReply) ProtoMessage() {}
+
+func (x *SchedulePriceChangeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeReply.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{29}
+}
+
+// CancelPriceChangeRequest is the request to cancel the pending price change of a
+// product. It fails with FAILED_PRECONDITION if none is pending.
+type CancelPriceChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPriceChangeRequest) Reset() {
+	*x = CancelPriceChangeRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPriceChangeRequest) ProtoMessage() {}
+
+func (x *CancelPriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*CancelPriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CancelPriceChangeRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// CancelPriceChangeReply is the response after cancelling a price change.
+type CancelPriceChangeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPriceChangeReply) Reset() {
+	*x = CancelPriceChangeReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPriceChangeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPriceChangeReply) ProtoMessage() {}
+
+func (x *CancelPriceChangeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPriceChangeReply.ProtoReflect.Descriptor instead.
+func (*CancelPriceChangeReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{31}
+}
+
+// ActivateProductRequest is the request to activate a product.
+type ActivateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateProductRequest) Reset() {
+	*x = ActivateProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateProductRequest) ProtoMessage() {}
+
+func (x *ActivateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateProductRequest.ProtoReflect.Descriptor instead.
+func (*ActivateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ActivateProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// ActivateProductReply is the response after activating a product.
+type ActivateProductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateProductReply) Reset() {
+	*x = ActivateProductReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateProductReply) ProtoMessage() {}
+
+func (x *ActivateProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateProductReply.ProtoReflect.Descriptor instead.
+func (*ActivateProductReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{33}
+}
+
+// DeactivateProductRequest is the request to deactivate a product.
+type DeactivateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateProductRequest) Reset() {
+	*x = DeactivateProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateProductRequest) ProtoMessage() {}
+
+func (x *DeactivateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateProductRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeactivateProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// DeactivateProductReply is the response after deactivating a product.
+type DeactivateProductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateProductReply) Reset() {
+	*x = DeactivateProductReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateProductReply) ProtoMessage() {}
+
+func (x *DeactivateProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateProductReply.ProtoReflect.Descriptor instead.
+func (*DeactivateProductReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{35}
+}
+
+// ScheduleActivationRequest is the request to activate and deactivate a product at later
+// times, e.g. for a launch or a seasonal range. It replaces the schedule of the product;
+// an unset time clears that part of it. The changes are made as ActivateProduct and
+// DeactivateProduct would make them when their time comes; archiving the product drops
+// the schedule.
+type ScheduleActivationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// When to activate the product; must be in the future.
+	ActivateAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=activate_at,json=activateAt,proto3" json:"activate_at,omitempty"`
+	// When to deactivate the product; must be in the future and after activate_at.
+	DeactivateAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deactivate_at,json=deactivateAt,proto3" json:"deactivate_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleActivationRequest) Reset() {
+	*x = ScheduleActivationRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleActivationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleActivationRequest) ProtoMessage() {}
+
+func (x *ScheduleActivationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleActivationRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleActivationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ScheduleActivationRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ScheduleActivationRequest) GetActivateAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActivateAt
+	}
+	return nil
+}
+
+func (x *ScheduleActivationRequest) GetDeactivateAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivateAt
+	}
+	return nil
+}
+
+// ScheduleActivationReply is the response after scheduling the activation of a product.
+type ScheduleActivationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleActivationReply) Reset() {
+	*x = ScheduleActivationReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleActivationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleActivationReply) ProtoMessage() {}
+
+func (x *ScheduleActivationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleActivationReply.ProtoReflect.Descriptor instead.
+func (*ScheduleActivationReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{37}
+}
+
+// SubmitForReviewRequest is the request to submit a draft product for review, moving it
+// to pending_review. It fails with FAILED_PRECONDITION unless the product is a draft.
+type SubmitForReviewRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Who submits the product, at most 256 characters.
+	SubmittedBy   string `protobuf:"bytes,2,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitForReviewRequest) Reset() {
+	*x = SubmitForReviewRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitForReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitForReviewRequest) ProtoMessage() {}
+
+func (x *SubmitForReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitForReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitForReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SubmitForReviewRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SubmitForReviewRequest) GetSubmittedBy() string {
+	if x != nil {
+		return x.SubmittedBy
+	}
+	return ""
+}
+
+// SubmitForReviewReply is the response after submitting a product for review.
+type SubmitForReviewReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitForReviewReply) Reset() {
+	*x = SubmitForReviewReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitForReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitForReviewReply) ProtoMessage() {}
+
+func (x *SubmitForReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitForReviewReply.ProtoReflect.Descriptor instead.
+func (*SubmitForReviewReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{39}
+}
+
+// ApproveProductRequest is the request to approve a product pending review, which
+// activates it. It fails with FAILED_PRECONDITION unless the product is pending review,
+// or if the reviewer submitted it.
+type ApproveProductRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Who approves the product, at most 256 characters.
+	Reviewer      string `protobuf:"bytes,2,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveProductRequest) Reset() {
+	*x = ApproveProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveProductRequest) ProtoMessage() {}
+
+func (x *ApproveProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveProductRequest.ProtoReflect.Descriptor instead.
+func (*ApproveProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ApproveProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ApproveProductRequest) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+// ApproveProductReply is the response after approving a product.
+type ApproveProductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveProductReply) Reset() {
+	*x = ApproveProductReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveProductReply) ProtoMessage() {}
+
+func (x *ApproveProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveProductReply.ProtoReflect.Descriptor instead.
+func (*ApproveProductReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{41}
+}
+
+// RejectProductRequest is the request to reject a product pending review, returning it
+// to draft. It fails with FAILED_PRECONDITION unless the product is pending review.
+type RejectProductRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Who rejects the product, at most 256 characters.
+	Reviewer string `protobuf:"bytes,2,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	// Why the product is rejected, at most 1000 characters.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectProductRequest) Reset() {
+	*x = RejectProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectProductRequest) ProtoMessage() {}
+
+func (x *RejectProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectProductRequest.ProtoReflect.Descriptor instead.
+func (*RejectProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RejectProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RejectProductRequest) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *RejectProductRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// RejectProductReply is the response after rejecting a product.
+type RejectProductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectProductReply) Reset() {
+	*x = RejectProductReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectProductReply) ProtoMessage() {}
+
+func (x *RejectProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectProductReply.ProtoReflect.Descriptor instead.
+func (*RejectProductReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{43}
+}
+
+// ArchiveProductRequest is the request to archive a product.
+type ArchiveProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProductRequest) Reset() {
+	*x = ArchiveProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProductRequest) ProtoMessage() {}
+
+func (x *ArchiveProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProductRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ArchiveProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// ArchiveProductReply is the response after archiving a product.
+type ArchiveProductReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProductReply) Reset() {
+	*x = ArchiveProductReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProductReply) ProtoMessage() {}
+
+func (x *ArchiveProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProductReply.ProtoReflect.Descriptor instead.
+func (*ArchiveProductReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{45}
+}
+
+// ApplyDiscountRequest is the request to apply a discount to a product.
+type ApplyDiscountRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ProductId          string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	DiscountPercentage float64                `protobuf:"fixed64,2,opt,name=discount_percentage,json=discountPercentage,proto3" json:"discount_percentage,omitempty"`
+	StartDate          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Priority from 0 to 100; defaults to 0.
+	Priority int32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Applies a buy-X-get-Y promotion instead of a percentage discount when set;
+	// discount_percentage must then be 0.
+	BuyXGetY *BuyXGetY `protobuf:"bytes,6,opt,name=buy_x_get_y,json=buyXGetY,proto3" json:"buy_x_get_y,omitempty"`
+	// Scopes the discount to the price of a market ("US", "EU" or "UK"), which the product
+	// must have a price in; empty applies it to the base price.
+	Market string `protobuf:"bytes,7,opt,name=market,proto3" json:"market,omitempty"`
+	// Marks the discount as a flash sale: its start and end are announced as soon as they
+	// pass rather than on the scheduler's next poll, and their events carry flash_sale.
+	FlashSale bool `protobuf:"varint,8,opt,name=flash_sale,json=flashSale,proto3" json:"flash_sale,omitempty"`
+	// Sells the product at this price, in its currency, instead of taking
+	// discount_percentage off, which must then be 0. It must be below the base price; the
+	// discount's percentage is the implied one and follows base price changes. Cannot be
+	// combined with buy_x_get_y or market.
+	SalePrice     *Money `protobuf:"bytes,9,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyDiscountRequest) Reset() {
+	*x = ApplyDiscountRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDiscountRequest) ProtoMessage() {}
+
+func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDiscountRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ApplyDiscountRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRequest) GetDiscountPercentage() float64 {
+	if x != nil {
+		return x.DiscountPercentage
+	}
+	return 0
+}
+
+func (x *ApplyDiscountRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ApplyDiscountRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ApplyDiscountRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ApplyDiscountRequest) GetBuyXGetY() *BuyXGetY {
+	if x != nil {
+		return x.BuyXGetY
+	}
+	return nil
+}
+
+func (x *ApplyDiscountRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRequest) GetFlashSale() bool {
+	if x != nil {
+		return x.FlashSale
+	}
+	return false
+}
+
+func (x *ApplyDiscountRequest) GetSalePrice() *Money {
+	if x != nil {
+		return x.SalePrice
+	}
+	return nil
+}
+
+// ApplyDiscountReply is the response after applying a discount.
+type ApplyDiscountReply struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	DiscountId string                 `protobuf:"bytes,1,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	// Set if the discount exceeds the approval threshold and does not apply until approved
+	// with ApproveDiscount.
+	PendingApproval bool `protobuf:"varint,2,opt,name=pending_approval,json=pendingApproval,proto3" json:"pending_approval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApplyDiscountReply) Reset() {
+	*x = ApplyDiscountReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDiscountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDiscountReply) ProtoMessage() {}
+
+func (x *ApplyDiscountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDiscountReply.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ApplyDiscountReply) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+func (x *ApplyDiscountReply) GetPendingApproval() bool {
+	if x != nil {
+		return x.PendingApproval
+	}
+	return false
+}
+
+// ApplyDiscountToCategoryRequest is the request to apply the same percentage discount to
+// every active product of a category.
+type ApplyDiscountToCategoryRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Category           string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	DiscountPercentage float64                `protobuf:"fixed64,2,opt,name=discount_percentage,json=discountPercentage,proto3" json:"discount_percentage,omitempty"`
+	StartDate          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Priority from 0 to 100; defaults to 0.
+	Priority      int32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyDiscountToCategoryRequest) Reset() {
+	*x = ApplyDiscountToCategoryRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDiscountToCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDiscountToCategoryRequest) ProtoMessage() {}
+
+func (x *ApplyDiscountToCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDiscountToCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountToCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ApplyDiscountToCategoryRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ApplyDiscountToCategoryRequest) GetDiscountPercentage() float64 {
+	if x != nil {
+		return x.DiscountPercentage
+	}
+	return 0
+}
+
+func (x *ApplyDiscountToCategoryRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ApplyDiscountToCategoryRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ApplyDiscountToCategoryRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+// ApplyDiscountToCategoryReply is the response after applying a discount to a category.
+// Every product the discount was applied to holds it under discount_id.
+type ApplyDiscountToCategoryReply struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	DiscountId string                 `protobuf:"bytes,1,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	// Set if the discount exceeds the approval threshold and does not apply until approved
+	// on each product with ApproveDiscount.
+	PendingApproval bool              `protobuf:"varint,2,opt,name=pending_approval,json=pendingApproval,proto3" json:"pending_approval,omitempty"`
+	AppliedCount    int32             `protobuf:"varint,3,opt,name=applied_count,json=appliedCount,proto3" json:"applied_count,omitempty"`
+	Skipped         []*SkippedProduct `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApplyDiscountToCategoryReply) Reset() {
+	*x = ApplyDiscountToCategoryReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDiscountToCategoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDiscountToCategoryReply) ProtoMessage() {}
+
+func (x *ApplyDiscountToCategoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDiscountToCategoryReply.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountToCategoryReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ApplyDiscountToCategoryReply) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+func (x *ApplyDiscountToCategoryReply) GetPendingApproval() bool {
+	if x != nil {
+		return x.PendingApproval
+	}
+	return false
+}
+
+func (x *ApplyDiscountToCategoryReply) GetAppliedCount() int32 {
+	if x != nil {
+		return x.AppliedCount
+	}
+	return 0
+}
+
+func (x *ApplyDiscountToCategoryReply) GetSkipped() []*SkippedProduct {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+// RemoveDiscountRequest is the request to remove a discount from a product.
+type RemoveDiscountRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// ID of the discount to remove; empty removes all discounts of the product.
+	DiscountId    string `protobuf:"bytes,2,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDiscountRequest) Reset() {
+	*x = RemoveDiscountRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscountRequest) ProtoMessage() {}
+
+func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscountRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RemoveDiscountRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RemoveDiscountRequest) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+// RemoveDiscountReply is the response after removing a discount.
+type RemoveDiscountReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDiscountReply) Reset() {
+	*x = RemoveDiscountReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDiscountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscountReply) ProtoMessage() {}
+
+func (x *RemoveDiscountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscountReply.ProtoReflect.Descriptor instead.
+func (*RemoveDiscountReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{51}
+}
+
+// ApproveDiscountRequest is the request to approve a discount pending approval.
+type ApproveDiscountRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProductId  string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	DiscountId string                 `protobuf:"bytes,2,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	// Who approves the discount, e.g. a user name; recorded with the discount.
+	Approver      string `protobuf:"bytes,3,opt,name=approver,proto3" json:"approver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveDiscountRequest) Reset() {
+	*x = ApproveDiscountRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveDiscountRequest) ProtoMessage() {}
+
+func (x *ApproveDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveDiscountRequest.ProtoReflect.Descriptor instead.
+func (*ApproveDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ApproveDiscountRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ApproveDiscountRequest) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+func (x *ApproveDiscountRequest) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+// ApproveDiscountReply is the response after approving a discount.
+type ApproveDiscountReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveDiscountReply) Reset() {
+	*x = ApproveDiscountReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveDiscountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveDiscountReply) ProtoMessage() {}
+
+func (x *ApproveDiscountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveDiscountReply.ProtoReflect.Descriptor instead.
+func (*ApproveDiscountReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{53}
+}
+
+// SetPriceTiersRequest is the request to replace the price tiers of a product.
+type SetPriceTiersRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// The complete tier schedule, at most 10 tiers with distinct min_quantity;
+	// empty removes all tiers.
+	Tiers         []*PriceTier `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceTiersRequest) Reset() {
+	*x = SetPriceTiersRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceTiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceTiersRequest) ProtoMessage() {}
+
+func (x *SetPriceTiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceTiersRequest.ProtoReflect.Descriptor instead.
+func (*SetPriceTiersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SetPriceTiersRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetPriceTiersRequest) GetTiers() []*PriceTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+// SetPriceTiersReply is the response after setting the price tiers.
+type SetPriceTiersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceTiersReply) Reset() {
+	*x = SetPriceTiersReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceTiersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceTiersReply) ProtoMessage() {}
+
+func (x *SetPriceTiersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceTiersReply.ProtoReflect.Descriptor instead.
+func (*SetPriceTiersReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{55}
+}
+
+// SetPriceBookRequest is the request to replace the price book of a product.
+type SetPriceBookRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Base prices in other currencies, at most 20 with distinct currencies, each of which
+	// must be set. Empty removes the price book.
+	Prices        []*Money `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceBookRequest) Reset() {
+	*x = SetPriceBookRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceBookRequest) ProtoMessage() {}
+
+func (x *SetPriceBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceBookRequest.ProtoReflect.Descriptor instead.
+func (*SetPriceBookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetPriceBookRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetPriceBookRequest) GetPrices() []*Money {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// SetPriceBookReply is the response after setting the price book.
+type SetPriceBookReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceBookReply) Reset() {
+	*x = SetPriceBookReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceBookReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceBookReply) ProtoMessage() {}
+
+func (x *SetPriceBookReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceBookReply.ProtoReflect.Descriptor instead.
+func (*SetPriceBookReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{57}
+}
+
+// SetSegmentPricesRequest is the request to replace the customer segment prices of a
+// product.
+type SetSegmentPricesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// At most 20 prices with distinct segments; empty removes all segment prices.
+	Prices        []*SegmentPrice `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSegmentPricesRequest) Reset() {
+	*x = SetSegmentPricesRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSegmentPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSegmentPricesRequest) ProtoMessage() {}
+
+func (x *SetSegmentPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSegmentPricesRequest.ProtoReflect.Descriptor instead.
+func (*SetSegmentPricesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetSegmentPricesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetSegmentPricesRequest) GetPrices() []*SegmentPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// SetSegmentPricesReply is the response after setting the segment prices.
+type SetSegmentPricesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSegmentPricesReply) Reset() {
+	*x = SetSegmentPricesReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSegmentPricesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSegmentPricesReply) ProtoMessage() {}
+
+func (x *SetSegmentPricesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSegmentPricesReply.ProtoReflect.Descriptor instead.
+func (*SetSegmentPricesReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{59}
+}
+
+// SetMarketPricesRequest is the request to replace the market prices of a product.
+type SetMarketPricesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Prices with distinct markets, each in the currency of its market; empty removes all
+	// market prices.
+	Prices        []*MarketPrice `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMarketPricesRequest) Reset() {
+	*x = SetMarketPricesRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMarketPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMarketPricesRequest) ProtoMessage() {}
+
+func (x *SetMarketPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMarketPricesRequest.ProtoReflect.Descriptor instead.
+func (*SetMarketPricesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SetMarketPricesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetMarketPricesRequest) GetPrices() []*MarketPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// SetMarketPricesReply is the response after setting the market prices.
+type SetMarketPricesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMarketPricesReply) Reset() {
+	*x = SetMarketPricesReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMarketPricesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMarketPricesReply) ProtoMessage() {}
+
+func (x *SetMarketPricesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMarketPricesReply.ProtoReflect.Descriptor instead.
+func (*SetMarketPricesReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{61}
+}
+
+// SetTaxClassRequest is the request to change the tax class of a product.
+type SetTaxClassRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Lowercase letters, digits and underscores, at most 50 characters, e.g. "reduced".
+	// New products are "standard".
+	TaxClass      string `protobuf:"bytes,2,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaxClassRequest) Reset() {
+	*x = SetTaxClassRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxClassRequest) ProtoMessage() {}
+
+func (x *SetTaxClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxClassRequest.ProtoReflect.Descriptor instead.
+func (*SetTaxClassRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetTaxClassRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetTaxClassRequest) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+// SetTaxClassReply is the response after setting the tax class.
+type SetTaxClassReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaxClassReply) Reset() {
+	*x = SetTaxClassReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxClassReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxClassReply) ProtoMessage() {}
+
+func (x *SetTaxClassReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxClassReply.ProtoReflect.Descriptor instead.
+func (*SetTaxClassReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{63}
+}
+
+// SetMinimumPriceRequest is the request to set the price floor of a product. Discounts
+// that would bring the base price below it are rejected with FAILED_PRECONDITION.
+type SetMinimumPriceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// In the product's currency; unset removes the minimum price.
+	MinimumPrice  *Money `protobuf:"bytes,2,opt,name=minimum_price,json=minimumPrice,proto3" json:"minimum_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMinimumPriceRequest) Reset() {
+	*x = SetMinimumPriceRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMinimumPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMinimumPriceRequest) ProtoMessage() {}
+
+func (x *SetMinimumPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMinimumPriceRequest.ProtoReflect.Descriptor instead.
+func (*SetMinimumPriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetMinimumPriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetMinimumPriceRequest) GetMinimumPrice() *Money {
+	if x != nil {
+		return x.MinimumPrice
+	}
+	return nil
+}
+
+// SetMinimumPriceReply is the response after setting the minimum price.
+type SetMinimumPriceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMinimumPriceReply) Reset() {
+	*x = SetMinimumPriceReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMinimumPriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMinimumPriceReply) ProtoMessage() {}
+
+func (x *SetMinimumPriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMinimumPriceReply.ProtoReflect.Descriptor instead.
+func (*SetMinimumPriceReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{65}
+}
+
+// SetCostPriceRequest is the request to record what a product costs the merchant.
+// Depending on the service's margin guard, discounts that would bring the base price
+// below it are applied, logged or rejected with FAILED_PRECONDITION.
+type SetCostPriceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// In the product's currency; unset removes the cost price.
+	CostPrice     *Money `protobuf:"bytes,2,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCostPriceRequest) Reset() {
+	*x = SetCostPriceRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCostPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCostPriceRequest) ProtoMessage() {}
+
+func (x *SetCostPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCostPriceRequest.ProtoReflect.Descriptor instead.
+func (*SetCostPriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetCostPriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetCostPriceRequest) GetCostPrice() *Money {
+	if x != nil {
+		return x.CostPrice
+	}
+	return nil
+}
+
+// SetCostPriceReply is the response after setting the cost price.
+type SetCostPriceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCostPriceReply) Reset() {
+	*x = SetCostPriceReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCostPriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCostPriceReply) ProtoMessage() {}
+
+func (x *SetCostPriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCostPriceReply.ProtoReflect.Descriptor instead.
+func (*SetCostPriceReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{67}
+}
+
+// AddVariantRequest is the request to add a variant to a product.
+type AddVariantRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProductId  string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku        string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Attributes map[string]string      `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// In the product's currency, which may be omitted; unset means no delta. The variant
+	// price must stay positive.
+	PriceDelta    *Money `protobuf:"bytes,4,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVariantRequest) Reset() {
+	*x = AddVariantRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVariantRequest) ProtoMessage() {}
+
+func (x *AddVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVariantRequest.ProtoReflect.Descriptor instead.
+func (*AddVariantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AddVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddVariantRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *AddVariantRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *AddVariantRequest) GetPriceDelta() *Money {
+	if x != nil {
+		return x.PriceDelta
+	}
+	return nil
+}
+
+// AddVariantReply is the response after adding a variant.
+type AddVariantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VariantId     string                 `protobuf:"bytes,1,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVariantReply) Reset() {
+	*x = AddVariantReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVariantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVariantReply) ProtoMessage() {}
+
+func (x *AddVariantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVariantReply.ProtoReflect.Descriptor instead.
+func (*AddVariantReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AddVariantReply) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+// UpdateVariantRequest is the request to replace the SKU, attributes and price delta of
+// an active variant.
+type UpdateVariantRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProductId  string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId  string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Sku        string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	Attributes map[string]string      `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Unset means no delta.
+	PriceDelta    *Money `protobuf:"bytes,5,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVariantRequest) Reset() {
+	*x = UpdateVariantRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVariantRequest) ProtoMessage() {}
+
+func (x *UpdateVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVariantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVariantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateVariantRequest) GetPriceDelta() *Money {
+	if x != nil {
+		return x.PriceDelta
+	}
+	return nil
+}
+
+// UpdateVariantReply is the response after updating a variant.
+type UpdateVariantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVariantReply) Reset() {
+	*x = UpdateVariantReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVariantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVariantReply) ProtoMessage() {}
+
+func (x *UpdateVariantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVariantReply.ProtoReflect.Descriptor instead.
+func (*UpdateVariantReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{71}
+}
+
+// DiscontinueVariantRequest is the request to discontinue a variant for good.
+type DiscontinueVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscontinueVariantRequest) Reset() {
+	*x = DiscontinueVariantRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscontinueVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscontinueVariantRequest) ProtoMessage() {}
+
+func (x *DiscontinueVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscontinueVariantRequest.ProtoReflect.Descriptor instead.
+func (*DiscontinueVariantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DiscontinueVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DiscontinueVariantRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+// DiscontinueVariantReply is the response after discontinuing a variant.
+type DiscontinueVariantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscontinueVariantReply) Reset() {
+	*x = DiscontinueVariantReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscontinueVariantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscontinueVariantReply) ProtoMessage() {}
+
+func (x *DiscontinueVariantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscontinueVariantReply.ProtoReflect.Descriptor instead.
+func (*DiscontinueVariantReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{73}
+}
+
+// SetStockRequest is the request to replace the stock of a product, e.g. after a stock
+// take. Setting it starts tracking the stock of the product.
+type SetStockRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Units on hand, at most 1000000000.
+	Level int64 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	// Units on hand reserved for orders; at most level.
+	Reserved int64 `protobuf:"varint,3,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	// The version of the stock the change is based on; the change fails with ABORTED if
+	// the stock is at another version. 0 skips the check. Changes also fail with ABORTED
+	// if the stock changes while they are made.
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetStockRequest) Reset() {
+	*x = SetStockRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStockRequest) ProtoMessage() {}
+
+func (x *SetStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStockRequest.ProtoReflect.Descriptor instead.
+func (*SetStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SetStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetStockRequest) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *SetStockRequest) GetReserved() int64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *SetStockRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// SetStockReply is the response after setting the stock of a product.
+type SetStockReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stock         *Stock                 `protobuf:"bytes,1,opt,name=stock,proto3" json:"stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStockReply) Reset() {
+	*x = SetStockReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStockReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStockReply) ProtoMessage() {}
+
+func (x *SetStockReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStockReply.ProtoReflect.Descriptor instead.
+func (*SetStockReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetStockReply) GetStock() *Stock {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+// AdjustStockRequest is the request to add to or take from the stock of a product, e.g.
+// level_delta -2 and reserved_delta -2 when a reserved order of 2 ships.
+type AdjustStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	LevelDelta    int64                  `protobuf:"varint,2,opt,name=level_delta,json=levelDelta,proto3" json:"level_delta,omitempty"`
+	ReservedDelta int64                  `protobuf:"varint,3,opt,name=reserved_delta,json=reservedDelta,proto3" json:"reserved_delta,omitempty"`
+	// As in SetStockRequest.
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AdjustStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetLevelDelta() int64 {
+	if x != nil {
+		return x.LevelDelta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetReservedDelta() int64 {
+	if x != nil {
+		return x.ReservedDelta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// AdjustStockReply is the response after adjusting the stock of a product.
+type AdjustStockReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stock         *Stock                 `protobuf:"bytes,1,opt,name=stock,proto3" json:"stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockReply) Reset() {
+	*x = AdjustStockReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockReply) ProtoMessage() {}
+
+func (x *AdjustStockReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockReply.ProtoReflect.Descriptor instead.
+func (*AdjustStockReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AdjustStockReply) GetStock() *Stock {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+// AddTagRequest is the request to tag a product.
+type AddTagRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Lowercase letters, digits, hyphens and underscores, at most 50 characters; uppercase
+	// letters are lower-cased. A product has at most 20 tags.
+	Tag           string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTagRequest) Reset() {
+	*x = AddTagRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTagRequest) ProtoMessage() {}
+
+func (x *AddTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTagRequest.ProtoReflect.Descriptor instead.
+func (*AddTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AddTagRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// AddTagReply is the response after tagging a product.
+type AddTagReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTagReply) Reset() {
+	*x = AddTagReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTagReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTagReply) ProtoMessage() {}
+
+func (x *AddTagReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTagReply.ProtoReflect.Descriptor instead.
+func (*AddTagReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{79}
+}
+
+// RemoveTagRequest is the request to remove a tag from a product.
+type RemoveTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTagRequest) Reset() {
+	*x = RemoveTagRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTagRequest) ProtoMessage() {}
+
+func (x *RemoveTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTagRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RemoveTagRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RemoveTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// RemoveTagReply is the response after removing a tag from a product.
+type RemoveTagReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTagReply) Reset() {
+	*x = RemoveTagReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTagReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTagReply) ProtoMessage() {}
+
+func (x *RemoveTagReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTagReply.ProtoReflect.Descriptor instead.
+func (*RemoveTagReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{81}
+}
+
+// SetAttributeRequest is the request to set or remove an attribute of a product.
+type SetAttributeRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Lowercase letters, digits and underscores, at most 50 characters. A product has at
+	// most 50 attributes.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// At most 500 characters; empty removes the attribute.
+	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttributeRequest) Reset() {
+	*x = SetAttributeRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttributeRequest) ProtoMessage() {}
+
+func (x *SetAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttributeRequest.ProtoReflect.Descriptor instead.
+func (*SetAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SetAttributeRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetAttributeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetAttributeRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// SetAttributeReply is the response after setting an attribute of a product.
+type SetAttributeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttributeReply) Reset() {
+	*x = SetAttributeReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttributeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttributeReply) ProtoMessage() {}
+
+func (x *SetAttributeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttributeReply.ProtoReflect.Descriptor instead.
+func (*SetAttributeReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{83}
+}
+
+// SetSlugRequest is the request to change the URL slug of a product. Links with the
+// previous slug stop working.
+type SetSlugRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Lowercase letters and digits in words separated by hyphens, at most 100 characters;
+	// uppercase letters are lower-cased. Fails with ALREADY_EXISTS if another product has
+	// the slug.
+	Slug          string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSlugRequest) Reset() {
+	*x = SetSlugRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSlugRequest) ProtoMessage() {}
+
+func (x *SetSlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSlugRequest.ProtoReflect.Descriptor instead.
+func (*SetSlugRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SetSlugRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetSlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+// SetSlugReply is the response after changing the slug of a product.
+type SetSlugReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSlugReply) Reset() {
+	*x = SetSlugReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSlugReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSlugReply) ProtoMessage() {}
+
+func (x *SetSlugReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSlugReply.ProtoReflect.Descriptor instead.
+func (*SetSlugReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{85}
+}
+
+// SetIdentifiersRequest is the request to replace the SKU and GTIN of a product. An
+// empty identifier clears it.
+type SetIdentifiersRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Letters, digits, hyphens and underscores, at most 64 characters; lowercase letters
+	// are upper-cased. Fails with ALREADY_EXISTS if another product or a variant has it.
+	Sku string `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	// 8, 12, 13 or 14 digits ending in a valid GS1 check digit. Fails with ALREADY_EXISTS
+	// if another product has it.
+	Gtin          string `protobuf:"bytes,3,opt,name=gtin,proto3" json:"gtin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIdentifiersRequest) Reset() {
+	*x = SetIdentifiersRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIdentifiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIdentifiersRequest) ProtoMessage() {}
+
+func (x *SetIdentifiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIdentifiersRequest.ProtoReflect.Descriptor instead.
+func (*SetIdentifiersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SetIdentifiersRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetIdentifiersRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SetIdentifiersRequest) GetGtin() string {
+	if x != nil {
+		return x.Gtin
+	}
+	return ""
+}
+
+// SetIdentifiersReply is the response after replacing the identifiers of a product.
+type SetIdentifiersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIdentifiersReply) Reset() {
+	*x = SetIdentifiersReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIdentifiersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIdentifiersReply) ProtoMessage() {}
+
+func (x *SetIdentifiersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIdentifiersReply.ProtoReflect.Descriptor instead.
+func (*SetIdentifiersReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{87}
+}
+
+// SetDimensionsRequest is the request to replace the weight and dimensions of a product.
+// An unset weight or dimensions clears it.
+type SetDimensionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Weight        *Weight                `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Dimensions    *Dimensions            `protobuf:"bytes,3,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDimensionsRequest) Reset() {
+	*x = SetDimensionsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDimensionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDimensionsRequest) ProtoMessage() {}
+
+func (x *SetDimensionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDimensionsRequest.ProtoReflect.Descriptor instead.
+func (*SetDimensionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SetDimensionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetDimensionsRequest) GetWeight() *Weight {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *SetDimensionsRequest) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+// SetDimensionsReply is the response after replacing the dimensions of a product.
+type SetDimensionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDimensionsReply) Reset() {
+	*x = SetDimensionsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDimensionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDimensionsReply) ProtoMessage() {}
+
+func (x *SetDimensionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDimensionsReply.ProtoReflect.Descriptor instead.
+func (*SetDimensionsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{89}
+}
+
+// SetKindRequest is the request to make a product physical or digital.
+type SetKindRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// "physical" or "digital". A product whose stock is tracked cannot become digital;
+	// fails with FAILED_PRECONDITION otherwise.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Weight and dimensions the product has afterwards, replacing the current ones: both are
+	// required for a physical product and neither is allowed for a digital one.
+	Weight        *Weight     `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Dimensions    *Dimensions `protobuf:"bytes,4,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetKindRequest) Reset() {
+	*x = SetKindRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetKindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKindRequest) ProtoMessage() {}
+
+func (x *SetKindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKindRequest.ProtoReflect.Descriptor instead.
+func (*SetKindRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SetKindRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetKindRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SetKindRequest) GetWeight() *Weight {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *SetKindRequest) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+// SetKindReply is the response after changing the kind of a product.
+type SetKindReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetKindReply) Reset() {
+	*x = SetKindReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetKindReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKindReply) ProtoMessage() {}
+
+func (x *SetKindReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKindReply.ProtoReflect.Descriptor instead.
+func (*SetKindReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{91}
+}
+
+// SetComplianceRequest is the request to replace the compliance flags of a product.
+type SetComplianceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// New flags; unset clears them. An age-restricted product must be in a category
+	// allowed by AGE_RESTRICTED_CATEGORIES, a hazardous product must have a weight and
+	// dimensions, and a product must not have a price in a market it is restricted in.
+	// These are checked when the product is activated, and right away if it is active;
+	// fails with FAILED_PRECONDITION otherwise.
+	Compliance    *Compliance `protobuf:"bytes,2,opt,name=compliance,proto3" json:"compliance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetComplianceRequest) Reset() {
+	*x = SetComplianceRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetComplianceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetComplianceRequest) ProtoMessage() {}
+
+func (x *SetComplianceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetComplianceRequest.ProtoReflect.Descriptor instead.
+func (*SetComplianceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SetComplianceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetComplianceRequest) GetCompliance() *Compliance {
+	if x != nil {
+		return x.Compliance
+	}
+	return nil
+}
+
+// SetComplianceReply is the response after replacing the compliance flags of a product.
+type SetComplianceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetComplianceReply) Reset() {
+	*x = SetComplianceReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetComplianceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetComplianceReply) ProtoMessage() {}
+
+func (x *SetComplianceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetComplianceReply.ProtoReflect.Descriptor instead.
+func (*SetComplianceReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{93}
+}
+
+// SetChannelAvailabilityRequest is the request to make a product available on, or withhold
+// it from, sales channels.
+type SetChannelAvailabilityRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Availability by sales channel: "web", "retail" or "marketplace". Channels left out
+	// keep their availability.
+	Channels      map[string]bool `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelAvailabilityRequest) Reset() {
+	*x = SetChannelAvailabilityRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelAvailabilityRequest) ProtoMessage() {}
+
+func (x *SetChannelAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetChannelAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SetChannelAvailabilityRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetChannelAvailabilityRequest) GetChannels() map[string]bool {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// SetChannelAvailabilityReply is the response after setting the channel availability of a
+// product.
+type SetChannelAvailabilityReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelAvailabilityReply) Reset() {
+	*x = SetChannelAvailabilityReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelAvailabilityReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelAvailabilityReply) ProtoMessage() {}
+
+func (x *SetChannelAvailabilityReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelAvailabilityReply.ProtoReflect.Descriptor instead.
+func (*SetChannelAvailabilityReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{95}
+}
+
+// IngestReviewRequest is a customer review of a product published by the reviews service.
+// Ingesting a review again replaces its rating, so redelivered and edited reviews are
+// counted once.
+type IngestReviewRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// ID the reviews service gave the review; 1 to 100 characters.
+	ReviewId string `protobuf:"bytes,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	// Star rating from 1 to 5.
+	Rating        int64 `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestReviewRequest) Reset() {
+	*x = IngestReviewRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestReviewRequest) ProtoMessage() {}
+
+func (x *IngestReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestReviewRequest.ProtoReflect.Descriptor instead.
+func (*IngestReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *IngestReviewRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *IngestReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *IngestReviewRequest) GetRating() int64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+// IngestReviewReply is the response after ingesting a review.
+type IngestReviewReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Summary of the customer ratings of the product including the review.
+	Rating        *RatingSummary `protobuf:"bytes,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestReviewReply) Reset() {
+	*x = IngestReviewReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestReviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestReviewReply) ProtoMessage() {}
+
+func (x *IngestReviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestReviewReply.ProtoReflect.Descriptor instead.
+func (*IngestReviewReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *IngestReviewReply) GetRating() *RatingSummary {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+// SetTranslationRequest is the request to set or remove the name and description of a
+// product in a locale.
+type SetTranslationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Language tag with an optional region, e.g. "de" or "pt-BR", other than the default
+	// locale "en".
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// At most 255 characters. An empty name and description remove the translation.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional; without one, the description of the product is shown in the locale.
+	Description   string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTranslationRequest) Reset() {
+	*x = SetTranslationRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTranslationRequest) ProtoMessage() {}
+
+func (x *SetTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTranslationRequest.ProtoReflect.Descriptor instead.
+func (*SetTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SetTranslationRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SetTranslationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetTranslationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// SetTranslationReply is the response after setting a translation of a product.
+type SetTranslationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTranslationReply) Reset() {
+	*x = SetTranslationReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTranslationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTranslationReply) ProtoMessage() {}
+
+func (x *SetTranslationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTranslationReply.ProtoReflect.Descriptor instead.
+func (*SetTranslationReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{99}
+}
+
+// SetImagesRequest is the request to replace the images of a product.
+type SetImagesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Images in display order, at most 20 with distinct URLs; empty removes them all. At
+	// most one may be primary; if none is, the first one becomes primary.
+	Images        []*ProductImage `protobuf:"bytes,2,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetImagesRequest) Reset() {
+	*x = SetImagesRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetImagesRequest) ProtoMessage() {}
+
+func (x *SetImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetImagesRequest.ProtoReflect.Descriptor instead.
+func (*SetImagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SetImagesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetImagesRequest) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// SetImagesReply is the response after replacing the images of a product.
+type SetImagesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetImagesReply) Reset() {
+	*x = SetImagesReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetImagesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetImagesReply) ProtoMessage() {}
+
+func (x *SetImagesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetImagesReply.ProtoReflect.Descriptor instead.
+func (*SetImagesReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{101}
+}
+
+// ReorderImagesRequest is the request to change the display order of the images of a
+// product.
+type ReorderImagesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// URL of every image of the product, in the new order.
+	Urls          []string `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderImagesRequest) Reset() {
+	*x = ReorderImagesRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderImagesRequest) ProtoMessage() {}
+
+func (x *ReorderImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderImagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ReorderImagesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReorderImagesRequest) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+// ReorderImagesReply is the response after reordering the images of a product.
+type ReorderImagesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderImagesReply) Reset() {
+	*x = ReorderImagesReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderImagesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderImagesReply) ProtoMessage() {}
+
+func (x *ReorderImagesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderImagesReply.ProtoReflect.Descriptor instead.
+func (*ReorderImagesReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{103}
+}
+
+// ManageAttachmentsRequest is the request to replace the attachments of a product.
+type ManageAttachmentsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Attachments in display order, at most 50 with distinct URLs; empty removes them all.
+	Attachments   []*ProductAttachment `protobuf:"bytes,2,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManageAttachmentsRequest) Reset() {
+	*x = ManageAttachmentsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManageAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageAttachmentsRequest) ProtoMessage() {}
+
+func (x *ManageAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ManageAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ManageAttachmentsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ManageAttachmentsRequest) GetAttachments() []*ProductAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// ManageAttachmentsReply is the response after replacing the attachments of a product.
+type ManageAttachmentsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManageAttachmentsReply) Reset() {
+	*x = ManageAttachmentsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManageAttachmentsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManageAttachmentsReply) ProtoMessage() {}
+
+func (x *ManageAttachmentsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManageAttachmentsReply.ProtoReflect.Descriptor instead.
+func (*ManageAttachmentsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{105}
+}
+
+// LinkProductsRequest is the request to link a product to a related product.
+type LinkProductsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RelatedProductId string                 `protobuf:"bytes,2,opt,name=related_product_id,json=relatedProductId,proto3" json:"related_product_id,omitempty"`
+	// "related", "accessory" or "replacement". A product links to at most 50 products of
+	// each type, and accessory and replacement links must not form a cycle.
+	Type          string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkProductsRequest) Reset() {
+	*x = LinkProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkProductsRequest) ProtoMessage() {}
+
+func (x *LinkProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkProductsRequest.ProtoReflect.Descriptor instead.
+func (*LinkProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *LinkProductsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *LinkProductsRequest) GetRelatedProductId() string {
+	if x != nil {
+		return x.RelatedProductId
+	}
+	return ""
+}
+
+func (x *LinkProductsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// LinkProductsReply is the response after linking a product to a related product.
+type LinkProductsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkProductsReply) Reset() {
+	*x = LinkProductsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkProductsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkProductsReply) ProtoMessage() {}
+
+func (x *LinkProductsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkProductsReply.ProtoReflect.Descriptor instead.
+func (*LinkProductsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{107}
+}
+
+// UnlinkProductsRequest is the request to remove the link from a product to a related
+// product.
+type UnlinkProductsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RelatedProductId string                 `protobuf:"bytes,2,opt,name=related_product_id,json=relatedProductId,proto3" json:"related_product_id,omitempty"`
+	// Type of the link to remove.
+	Type          string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkProductsRequest) Reset() {
+	*x = UnlinkProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkProductsRequest) ProtoMessage() {}
+
+func (x *UnlinkProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkProductsRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *UnlinkProductsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UnlinkProductsRequest) GetRelatedProductId() string {
+	if x != nil {
+		return x.RelatedProductId
+	}
+	return ""
+}
+
+func (x *UnlinkProductsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// UnlinkProductsReply is the response after removing the link from a product to a
+// related product.
+type UnlinkProductsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkProductsReply) Reset() {
+	*x = UnlinkProductsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkProductsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkProductsReply) ProtoMessage() {}
+
+func (x *UnlinkProductsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkProductsReply.ProtoReflect.Descriptor instead.
+func (*UnlinkProductsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{109}
+}
+
+// SubscribeToNotificationsRequest is the request to notify a customer about a product.
+type SubscribeToNotificationsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ProductId    string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SubscriberId string                 `protobuf:"bytes,2,opt,name=subscriber_id,json=subscriberId,proto3" json:"subscriber_id,omitempty"`
+	// Kind of notification: "discounted" or "back_in_stock".
+	Kind          string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeToNotificationsRequest) Reset() {
+	*x = SubscribeToNotificationsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeToNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeToNotificationsRequest) ProtoMessage() {}
+
+func (x *SubscribeToNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeToNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeToNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SubscribeToNotificationsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SubscribeToNotificationsRequest) GetSubscriberId() string {
+	if x != nil {
+		return x.SubscriberId
+	}
+	return ""
+}
+
+func (x *SubscribeToNotificationsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+// SubscribeToNotificationsReply is the response after subscribing to notifications.
+type SubscribeToNotificationsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeToNotificationsReply) Reset() {
+	*x = SubscribeToNotificationsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeToNotificationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeToNotificationsReply) ProtoMessage() {}
+
+func (x *SubscribeToNotificationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeToNotificationsReply.ProtoReflect.Descriptor instead.
+func (*SubscribeToNotificationsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{111}
+}
+
+// UnsubscribeFromNotificationsRequest is the request to stop notifying a customer.
+type UnsubscribeFromNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SubscriberId  string                 `protobuf:"bytes,2,opt,name=subscriber_id,json=subscriberId,proto3" json:"subscriber_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeFromNotificationsRequest) Reset() {
+	*x = UnsubscribeFromNotificationsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeFromNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeFromNotificationsRequest) ProtoMessage() {}
+
+func (x *UnsubscribeFromNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeFromNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeFromNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *UnsubscribeFromNotificationsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UnsubscribeFromNotificationsRequest) GetSubscriberId() string {
+	if x != nil {
+		return x.SubscriberId
+	}
+	return ""
+}
+
+func (x *UnsubscribeFromNotificationsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+// UnsubscribeFromNotificationsReply is the response after unsubscribing.
+type UnsubscribeFromNotificationsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeFromNotificationsReply) Reset() {
+	*x = UnsubscribeFromNotificationsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeFromNotificationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeFromNotificationsReply) ProtoMessage() {}
+
+func (x *UnsubscribeFromNotificationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeFromNotificationsReply.ProtoReflect.Descriptor instead.
+func (*UnsubscribeFromNotificationsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{113}
+}
+
+// CreateCampaignRequest is the request to create a campaign that applies the same
+// discount to many products. Exactly one of category and product_ids is set.
+type CreateCampaignRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Targets every product of the category that is not archived when the campaign is
+	// activated.
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	// Targets the listed products, at most 10000 distinct IDs.
+	ProductIds         []string               `protobuf:"bytes,3,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	DiscountPercentage float64                `protobuf:"fixed64,4,opt,name=discount_percentage,json=discountPercentage,proto3" json:"discount_percentage,omitempty"`
+	StartDate          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Priority from 0 to 100; defaults to 0.
+	Priority      int32 `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignRequest) Reset() {
+	*x = CreateCampaignRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignRequest) ProtoMessage() {}
+
+func (x *CreateCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignRequest.ProtoReflect.Descriptor instead.
+func (*CreateCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CreateCampaignRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateCampaignRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *CreateCampaignRequest) GetDiscountPercentage() float64 {
+	if x != nil {
+		return x.DiscountPercentage
+	}
+	return 0
+}
+
+func (x *CreateCampaignRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateCampaignRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateCampaignRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+// CreateCampaignReply is the response after creating a campaign.
+type CreateCampaignReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignReply) Reset() {
+	*x = CreateCampaignReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignReply) ProtoMessage() {}
+
+func (x *CreateCampaignReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignReply.ProtoReflect.Descriptor instead.
+func (*CreateCampaignReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CreateCampaignReply) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+// ActivateCampaignRequest is the request to apply the discount of a draft campaign to
+// its products.
+type ActivateCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateCampaignRequest) Reset() {
+	*x = ActivateCampaignRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateCampaignRequest) ProtoMessage() {}
+
+func (x *ActivateCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateCampaignRequest.ProtoReflect.Descriptor instead.
+func (*ActivateCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ActivateCampaignRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+// SkippedProduct is a targeted product the discount of a campaign or category was not
+// applied to.
+type SkippedProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedProduct) Reset() {
+	*x = SkippedProduct{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedProduct) ProtoMessage() {}
+
+func (x *SkippedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedProduct.ProtoReflect.Descriptor instead.
+func (*SkippedProduct) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *SkippedProduct) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SkippedProduct) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ActivateCampaignReply is the response after activating a campaign. The discount ID of
+// the applied discounts is the campaign ID.
+type ActivateCampaignReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppliedCount  int32                  `protobuf:"varint,1,opt,name=applied_count,json=appliedCount,proto3" json:"applied_count,omitempty"`
+	Skipped       []*SkippedProduct      `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateCampaignReply) Reset() {
+	*x = ActivateCampaignReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateCampaignReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateCampaignReply) ProtoMessage() {}
+
+func (x *ActivateCampaignReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateCampaignReply.ProtoReflect.Descriptor instead.
+func (*ActivateCampaignReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ActivateCampaignReply) GetAppliedCount() int32 {
+	if x != nil {
+		return x.AppliedCount
+	}
+	return 0
+}
+
+func (x *ActivateCampaignReply) GetSkipped() []*SkippedProduct {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+// EndCampaignRequest is the request to remove the discount of an active campaign from
+// its products.
+type EndCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndCampaignRequest) Reset() {
+	*x = EndCampaignRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndCampaignRequest) ProtoMessage() {}
+
+func (x *EndCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndCampaignRequest.ProtoReflect.Descriptor instead.
+func (*EndCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *EndCampaignRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+// EndCampaignReply is the response after ending a campaign.
+type EndCampaignReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RemovedCount  int32                  `protobuf:"varint,1,opt,name=removed_count,json=removedCount,proto3" json:"removed_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndCampaignReply) Reset() {
+	*x = EndCampaignReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndCampaignReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndCampaignReply) ProtoMessage() {}
+
+func (x *EndCampaignReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndCampaignReply.ProtoReflect.Descriptor instead.
+func (*EndCampaignReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *EndCampaignReply) GetRemovedCount() int32 {
+	if x != nil {
+		return x.RemovedCount
+	}
+	return 0
+}
+
+// CreatePriceListRequest is the request to create a price list without entries.
+type CreatePriceListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// ISO 4217 currency code of the prices of the list.
+	Currency  string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// Unset means the list stays valid indefinitely.
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePriceListRequest) Reset() {
+	*x = CreatePriceListRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceListRequest) ProtoMessage() {}
+
+func (x *CreatePriceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceListRequest.ProtoReflect.Descriptor instead.
+func (*CreatePriceListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CreatePriceListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePriceListRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreatePriceListRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CreatePriceListRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+// CreatePriceListReply is the response after creating a price list.
+type CreatePriceListReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceListId   string                 `protobuf:"bytes,1,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePriceListReply) Reset() {
+	*x = CreatePriceListReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePriceListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceListReply) ProtoMessage() {}
+
+func (x *CreatePriceListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceListReply.ProtoReflect.Descriptor instead.
+func (*CreatePriceListReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CreatePriceListReply) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+// PriceListEntry is the price of a product in a price list.
+type PriceListEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// In the currency of the price list; the currency may be omitted.
+	Price         *Money `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceListEntry) Reset() {
+	*x = PriceListEntry{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceListEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceListEntry) ProtoMessage() {}
+
+func (x *PriceListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceListEntry.ProtoReflect.Descriptor instead.
+func (*PriceListEntry) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *PriceListEntry) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PriceListEntry) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// SetPriceListEntriesRequest is the request to replace the entries of a price list.
+type SetPriceListEntriesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PriceListId string                 `protobuf:"bytes,1,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	// At most 1000 entries with distinct products; empty removes all entries.
+	Entries       []*PriceListEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceListEntriesRequest) Reset() {
+	*x = SetPriceListEntriesRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceListEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceListEntriesRequest) ProtoMessage() {}
+
+func (x *SetPriceListEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceListEntriesRequest.ProtoReflect.Descriptor instead.
+func (*SetPriceListEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SetPriceListEntriesRequest) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *SetPriceListEntriesRequest) GetEntries() []*PriceListEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// SetPriceListEntriesReply is the response after setting the entries of a price list.
+type SetPriceListEntriesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceListEntriesReply) Reset() {
+	*x = SetPriceListEntriesReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceListEntriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceListEntriesReply) ProtoMessage() {}
+
+func (x *SetPriceListEntriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceListEntriesReply.ProtoReflect.Descriptor instead.
+func (*SetPriceListEntriesReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{125}
+}
+
+// CreateAPIKeyRequest is the request to issue another API key to the calling client.
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{126}
+}
+
+// CreateAPIKeyReply is the response containing a new API key. The secret is not stored
+// and cannot be retrieved again.
+type CreateAPIKeyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyReply) Reset() {
+	*x = CreateAPIKeyReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyReply) ProtoMessage() {}
+
+func (x *CreateAPIKeyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyReply.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CreateAPIKeyReply) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyReply) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// RotateAPIKeyRequest is the request to replace an API key of the calling client.
+type RotateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAPIKeyRequest) Reset() {
+	*x = RotateAPIKeyRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPIKeyRequest) ProtoMessage() {}
+
+func (x *RotateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *RotateAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+// RotateAPIKeyReply is the response containing the replacement key. The rotated key
+// keeps working until previous_key_expires_at.
+type RotateAPIKeyReply struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	KeyId                string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Secret               string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	PreviousKeyExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=previous_key_expires_at,json=previousKeyExpiresAt,proto3" json:"previous_key_expires_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RotateAPIKeyReply) Reset() {
+	*x = RotateAPIKeyReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAPIKeyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAPIKeyReply) ProtoMessage() {}
+
+func (x *RotateAPIKeyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAPIKeyReply.ProtoReflect.Descriptor instead.
+func (*RotateAPIKeyReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *RotateAPIKeyReply) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *RotateAPIKeyReply) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *RotateAPIKeyReply) GetPreviousKeyExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousKeyExpiresAt
+	}
+	return nil
+}
+
+// RevokeAPIKeyRequest is the request to revoke an API key of the calling client at once.
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *RevokeAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+// RevokeAPIKeyReply is the response after revoking an API key.
+type RevokeAPIKeyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyReply) Reset() {
+	*x = RevokeAPIKeyReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyReply) ProtoMessage() {}
+
+func (x *RevokeAPIKeyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyReply.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{131}
+}
+
+// GetProductRequest is the request to get a product by ID.
+type GetProductRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// ISO 4217 currency to price the product in; empty means the product's own currency.
+	// A currency missing from the price book is converted with the configured exchange
+	// rates, and the request fails with FAILED_PRECONDITION if there is no rate.
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Prices the product in the pricing experiment variant of the subject, if any.
+	Experiment *ExperimentContext `protobuf:"bytes,3,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	// Customer segment to price the product for, e.g. "wholesale"; empty or a segment the
+	// product has no price for means its regular prices.
+	Segment string `protobuf:"bytes,4,opt,name=segment,proto3" json:"segment,omitempty"`
+	// Market to price the product in: "US", "EU" or "UK". A product without a price in the
+	// market is priced as if no market were given; otherwise a currency other than the
+	// market's fails the request with INVALID_ARGUMENT.
+	Market string `protobuf:"bytes,5,opt,name=market,proto3" json:"market,omitempty"`
+	// Pricing time, e.g. to preview the price of next week's discounts; defaults to now.
+	// It must be at most 30 days in the past and 366 days in the future.
+	PriceAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=price_at,json=priceAt,proto3" json:"price_at,omitempty"`
+	// Locale of the name and description, e.g. "de" or "pt-BR"; empty means the default
+	// locale "en". Without a translation in the locale, the translation in its language is
+	// used, then the default locale.
+	Locale string `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	// read_staleness lets the product be read from a nearby replica, at most ("15s") or
+	// exactly ("exact:15s") that stale, for lower latency; "strong" reads the latest data.
+	// Empty uses the READ_STALENESS of the server.
+	ReadStaleness string `protobuf:"bytes,8,opt,name=read_staleness,json=readStaleness,proto3" json:"read_staleness,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetExperiment() *ExperimentContext {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+func (x *GetProductRequest) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetPriceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceAt
+	}
+	return nil
+}
+
+func (x *GetProductRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetReadStaleness() string {
+	if x != nil {
+		return x.ReadStaleness
+	}
+	return ""
+}
+
+// GetProductReply is the response containing a product.
+type GetProductReply struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Product *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	// stale is set when the catalog database is unavailable and the product is served from
+	// the cache; it may have changed since cached_at.
+	Stale         bool                   `protobuf:"varint,2,opt,name=stale,proto3" json:"stale,omitempty"`
+	CachedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=cached_at,json=cachedAt,proto3" json:"cached_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductReply) Reset() {
+	*x = GetProductReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductReply) ProtoMessage() {}
+
+func (x *GetProductReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductReply.ProtoReflect.Descriptor instead.
+func (*GetProductReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetProductReply) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *GetProductReply) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *GetProductReply) GetCachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CachedAt
+	}
+	return nil
+}
+
+// GetProductBySlugRequest is the request to get a product by its URL slug. The other
+// fields are as in GetProductRequest.
+type GetProductBySlugRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Experiment    *ExperimentContext     `protobuf:"bytes,3,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	Segment       string                 `protobuf:"bytes,4,opt,name=segment,proto3" json:"segment,omitempty"`
+	Market        string                 `protobuf:"bytes,5,opt,name=market,proto3" json:"market,omitempty"`
+	PriceAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=price_at,json=priceAt,proto3" json:"price_at,omitempty"`
+	Locale        string                 `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBySlugRequest) Reset() {
+	*x = GetProductBySlugRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBySlugRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBySlugRequest) ProtoMessage() {}
+
+func (x *GetProductBySlugRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBySlugRequest.ProtoReflect.Descriptor instead.
+func (*GetProductBySlugRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GetProductBySlugRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetProductBySlugRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetProductBySlugRequest) GetExperiment() *ExperimentContext {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+func (x *GetProductBySlugRequest) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *GetProductBySlugRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *GetProductBySlugRequest) GetPriceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceAt
+	}
+	return nil
+}
+
+func (x *GetProductBySlugRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// GetProductBySlugReply is the response containing a product, as GetProductReply.
+type GetProductBySlugReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Stale         bool                   `protobuf:"varint,2,opt,name=stale,proto3" json:"stale,omitempty"`
+	CachedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=cached_at,json=cachedAt,proto3" json:"cached_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBySlugReply) Reset() {
+	*x = GetProductBySlugReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBySlugReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBySlugReply) ProtoMessage() {}
+
+func (x *GetProductBySlugReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBySlugReply.ProtoReflect.Descriptor instead.
+func (*GetProductBySlugReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetProductBySlugReply) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *GetProductBySlugReply) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *GetProductBySlugReply) GetCachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CachedAt
+	}
+	return nil
+}
+
+// GetProductBySKURequest is the request to get a product by its SKU. The SKUs of
+// variants are not looked up. The other fields are as in GetProductRequest.
+type GetProductBySKURequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Experiment    *ExperimentContext     `protobuf:"bytes,3,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	Segment       string                 `protobuf:"bytes,4,opt,name=segment,proto3" json:"segment,omitempty"`
+	Market        string                 `protobuf:"bytes,5,opt,name=market,proto3" json:"market,omitempty"`
+	PriceAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=price_at,json=priceAt,proto3" json:"price_at,omitempty"`
+	Locale        string                 `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBySKURequest) Reset() {
+	*x = GetProductBySKURequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBySKURequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBySKURequest) ProtoMessage() {}
+
+func (x *GetProductBySKURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBySKURequest.ProtoReflect.Descriptor instead.
+func (*GetProductBySKURequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GetProductBySKURequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *GetProductBySKURequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetProductBySKURequest) GetExperiment() *ExperimentContext {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+func (x *GetProductBySKURequest) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *GetProductBySKURequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *GetProductBySKURequest) GetPriceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceAt
+	}
+	return nil
+}
+
+func (x *GetProductBySKURequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// GetProductBySKUReply is the response containing a product, as GetProductReply.
+type GetProductBySKUReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Stale         bool                   `protobuf:"varint,2,opt,name=stale,proto3" json:"stale,omitempty"`
+	CachedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=cached_at,json=cachedAt,proto3" json:"cached_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBySKUReply) Reset() {
+	*x = GetProductBySKUReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBySKUReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBySKUReply) ProtoMessage() {}
+
+func (x *GetProductBySKUReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBySKUReply.ProtoReflect.Descriptor instead.
+func (*GetProductBySKUReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetProductBySKUReply) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *GetProductBySKUReply) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *GetProductBySKUReply) GetCachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CachedAt
+	}
+	return nil
+}
+
+// BatchGetProductsRequest is the request to get several products at once, e.g. to render
+// a cart. The other fields apply to every product as in GetProductRequest.
+type BatchGetProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At most 100 product IDs; duplicates are returned once.
+	ProductIds    []string               `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Experiment    *ExperimentContext     `protobuf:"bytes,3,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	Segment       string                 `protobuf:"bytes,4,opt,name=segment,proto3" json:"segment,omitempty"`
+	Market        string                 `protobuf:"bytes,5,opt,name=market,proto3" json:"market,omitempty"`
+	PriceAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=price_at,json=priceAt,proto3" json:"price_at,omitempty"`
+	Locale        string                 `protobuf:"bytes,7,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetProductsRequest) Reset() {
+	*x = BatchGetProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProductsRequest) ProtoMessage() {}
+
+func (x *BatchGetProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *BatchGetProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *BatchGetProductsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BatchGetProductsRequest) GetExperiment() *ExperimentContext {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+func (x *BatchGetProductsRequest) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *BatchGetProductsRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *BatchGetProductsRequest) GetPriceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceAt
+	}
+	return nil
+}
+
+func (x *BatchGetProductsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// BatchGetProductsReply partitions the requested products into those found and missing.
+type BatchGetProductsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Products in request order, for products that exist.
+	Products []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	// Requested IDs for which no product exists.
+	MissingProductIds []string `protobuf:"bytes,2,rep,name=missing_product_ids,json=missingProductIds,proto3" json:"missing_product_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BatchGetProductsReply) Reset() {
+	*x = BatchGetProductsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetProductsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetProductsReply) ProtoMessage() {}
+
+func (x *BatchGetProductsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetProductsReply.ProtoReflect.Descriptor instead.
+func (*BatchGetProductsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *BatchGetProductsReply) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *BatchGetProductsReply) GetMissingProductIds() []string {
+	if x != nil {
+		return x.MissingProductIds
+	}
+	return nil
+}
+
+// ListProductsRequest is the request to list products.
+type ListProductsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Category   string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Status     string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ActiveOnly bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	PageSize   int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken  string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Currency to price the products in, as in GetProductRequest.
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Ordering of the products: "product_id" (the default), "merchandised", which lists
+	// the products of each category by their merchandising rank, then the unranked ones by
+	// product ID, "rating", which lists them by average rating, highest first, then the
+	// unrated ones, ties broken by product ID, or "name", "created_at", "updated_at" or
+	// "price", the effective price at price_at before market and currency conversion,
+	// ascending or, followed by " desc", descending, ties broken by product ID. A page
+	// token is only valid with the ordering that returned it.
+	OrderBy string `protobuf:"bytes,7,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Market to price the products in, as in GetProductRequest.
+	Market string `protobuf:"bytes,8,opt,name=market,proto3" json:"market,omitempty"`
+	// Pricing time, as in GetProductRequest.
+	PriceAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=price_at,json=priceAt,proto3" json:"price_at,omitempty"`
+	// Only list products in stock; products whose stock is not tracked count as in stock.
+	InStock bool `protobuf:"varint,10,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	// Only list products with the tag.
+	Tag string `protobuf:"bytes,11,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Locale of the names, as in GetProductRequest.
+	Locale string `protobuf:"bytes,12,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Only list products available on the sales channel: "web", "retail" or "marketplace".
+	Channel string `protobuf:"bytes,13,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Only list products whose effective price at price_at is at least min_price and at
+	// most max_price, compared in the products' own currency before any market or
+	// currency conversion; products priced in another currency are left out. The bounds
+	// share a currency, USD if unset; either may be omitted.
+	MinPrice *Money `protobuf:"bytes,14,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice *Money `protobuf:"bytes,15,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	// Only list products with a discount that applies to their base price at price_at,
+	// e.g. for an "On Sale" page.
+	HasActiveDiscount bool `protobuf:"varint,16,opt,name=has_active_discount,json=hasActiveDiscount,proto3" json:"has_active_discount,omitempty"`
+	// include_total_count also counts the products listed on all pages, in total_count,
+	// with a query of its own.
+	IncludeTotalCount bool `protobuf:"varint,17,opt,name=include_total_count,json=includeTotalCount,proto3" json:"include_total_count,omitempty"`
+	// page_number, if positive, lists the page with that 1-based number by offset instead of
+	// the page after page_token, for admin tools that jump to a page; the reply has no
+	// next_page_token. Offset pages only reach the first 10000 products.
+	PageNumber int32 `protobuf:"varint,18,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	// include_archived lists archived products too, so back-office tools can see the full
+	// catalog in one listing; without it, they are only listed with status "archived".
+	IncludeArchived bool `protobuf:"varint,19,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	// read_staleness is how stale the products may be, as in GetProductRequest.
+	ReadStaleness string `protobuf:"bytes,20,opt,name=read_staleness,json=readStaleness,proto3" json:"read_staleness,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetPriceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceAt
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetHasActiveDiscount() bool {
+	if x != nil {
+		return x.HasActiveDiscount
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetReadStaleness() string {
+	if x != nil {
+		return x.ReadStaleness
+	}
+	return ""
+}
+
+// ListAllProductsRequest is the request to stream all the products ListProducts lists
+// with the same filters, in product ID order. The fields are as in ListProductsRequest.
+type ListAllProductsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Category          string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Status            string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ActiveOnly        bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	Currency          string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Market            string                 `protobuf:"bytes,5,opt,name=market,proto3" json:"market,omitempty"`
+	PriceAt           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=price_at,json=priceAt,proto3" json:"price_at,omitempty"`
+	InStock           bool                   `protobuf:"varint,7,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	Tag               string                 `protobuf:"bytes,8,opt,name=tag,proto3" json:"tag,omitempty"`
+	Locale            string                 `protobuf:"bytes,9,opt,name=locale,proto3" json:"locale,omitempty"`
+	Channel           string                 `protobuf:"bytes,10,opt,name=channel,proto3" json:"channel,omitempty"`
+	MinPrice          *Money                 `protobuf:"bytes,11,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice          *Money                 `protobuf:"bytes,12,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	HasActiveDiscount bool                   `protobuf:"varint,13,opt,name=has_active_discount,json=hasActiveDiscount,proto3" json:"has_active_discount,omitempty"`
+	// include_archived streams archived products too, as in ListProductsRequest.
+	IncludeArchived bool   `protobuf:"varint,14,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	ReadStaleness   string `protobuf:"bytes,15,opt,name=read_staleness,json=readStaleness,proto3" json:"read_staleness,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListAllProductsRequest) Reset() {
+	*x = ListAllProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllProductsRequest) ProtoMessage() {}
+
+func (x *ListAllProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ListAllProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListAllProductsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListAllProductsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListAllProductsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListAllProductsRequest) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *ListAllProductsRequest) GetPriceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceAt
+	}
+	return nil
+}
+
+func (x *ListAllProductsRequest) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *ListAllProductsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListAllProductsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ListAllProductsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ListAllProductsRequest) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *ListAllProductsRequest) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *ListAllProductsRequest) GetHasActiveDiscount() bool {
+	if x != nil {
+		return x.HasActiveDiscount
+	}
+	return false
+}
+
+func (x *ListAllProductsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *ListAllProductsRequest) GetReadStaleness() string {
+	if x != nil {
+		return x.ReadStaleness
+	}
+	return ""
+}
+
+// ListProductsReply is the response containing a list of products.
+type ListProductsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*ProductSummary      `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// total_count is the number of products listed on all pages; 0 unless
+	// include_total_count is set.
+	TotalCount int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// stale is set when the catalog database is unavailable and the page is served from
+	// the cache; it may have changed since cached_at.
+	Stale         bool                   `protobuf:"varint,4,opt,name=stale,proto3" json:"stale,omitempty"`
+	CachedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=cached_at,json=cachedAt,proto3" json:"cached_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsReply) Reset() {
+	*x = ListProductsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsReply) ProtoMessage() {}
+
+func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsReply.ProtoReflect.Descriptor instead.
+func (*ListProductsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ListProductsReply) GetProducts() []*ProductSummary {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListProductsReply) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListProductsReply) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *ListProductsReply) GetCachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CachedAt
+	}
+	return nil
+}
+
+// SearchProductsRequest is the request to search products by their names and
+// descriptions.
+type SearchProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Full-text query, at most 200 characters, e.g. "oak chair" for products that mention
+	// both words. Quoted phrases, OR and a leading - to exclude a word are supported, unless
+	// the server searches OpenSearch, where words match fuzzily instead.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Only search products of the category.
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	// Only search active products.
+	ActiveOnly bool  `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	PageSize   int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Page token of the previous page; only valid for the same query and filters.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Locale of the names, as in GetProductRequest.
+	Locale string `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Only search products with the tag.
+	Tag string `protobuf:"bytes,7,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Only search products available on the sales channel, e.g. "web".
+	Channel       string `protobuf:"bytes,8,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *SearchProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+// SearchProductsReply is the response containing the products matching a search, most
+// relevant first.
+type SearchProductsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*ProductSummary      `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of products matching the search; 0 unless the server searches OpenSearch.
+	TotalCount int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Counts of the products matching the search by category, tag and channel; empty unless
+	// the server searches OpenSearch.
+	Facets        []*SearchFacet `protobuf:"bytes,4,rep,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsReply) Reset() {
+	*x = SearchProductsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsReply) ProtoMessage() {}
+
+func (x *SearchProductsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsReply.ProtoReflect.Descriptor instead.
+func (*SearchProductsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *SearchProductsReply) GetProducts() []*ProductSummary {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *SearchProductsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchProductsReply) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchProductsReply) GetFacets() []*SearchFacet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// SearchFacet counts the products matching a search by the values of a field.
+type SearchFacet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "category", "tag" or "channel".
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The most common values, most common first.
+	Values        []*FacetValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFacet) Reset() {
+	*x = SearchFacet{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFacet) ProtoMessage() {}
+
+func (x *SearchFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFacet.ProtoReflect.Descriptor instead.
+func (*SearchFacet) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *SearchFacet) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchFacet) GetValues() []*FacetValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// FacetValue is the number of products matching a search with a value of a field.
+type FacetValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetValue) Reset() {
+	*x = FacetValue{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetValue) ProtoMessage() {}
+
+func (x *FacetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetValue.ProtoReflect.Descriptor instead.
+func (*FacetValue) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *FacetValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetValue) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// GetEffectivePricesRequest is the request to price several products at once, e.g. a cart.
+type GetEffectivePricesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At most 100 product IDs; duplicates are priced once.
+	ProductIds []string `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	// Pricing time; defaults to now.
+	At *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	// Customer segment. Reserved for segment-specific pricing; every segment currently
+	// gets the same price.
+	Segment string `protobuf:"bytes,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	// Prices the products in the pricing experiment variants of the subject, if any.
+	Experiment    *ExperimentContext `protobuf:"bytes,4,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEffectivePricesRequest) Reset() {
+	*x = GetEffectivePricesRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectivePricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectivePricesRequest) ProtoMessage() {}
+
+func (x *GetEffectivePricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectivePricesRequest.ProtoReflect.Descriptor instead.
+func (*GetEffectivePricesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetEffectivePricesRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *GetEffectivePricesRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *GetEffectivePricesRequest) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *GetEffectivePricesRequest) GetExperiment() *ExperimentContext {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+// EffectivePrice is the price of a single product at the requested time.
+type EffectivePrice struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	BasePrice      *Money                 `protobuf:"bytes,2,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	EffectivePrice *Money                 `protobuf:"bytes,3,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	// Percentage of the discount applied to effective_price; 0 if none applied.
+	DiscountPercent   float64 `protobuf:"fixed64,4,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	HasActiveDiscount bool    `protobuf:"varint,5,opt,name=has_active_discount,json=hasActiveDiscount,proto3" json:"has_active_discount,omitempty"`
+	// ISO 4217 currency code of both prices.
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Product status, so callers can reject items that are not purchasable.
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// The pricing experiment variant the prices are in; unset if none.
+	Experiment    *ExperimentAssignment `protobuf:"bytes,8,opt,name=experiment,proto3" json:"experiment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EffectivePrice) Reset() {
+	*x = EffectivePrice{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectivePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectivePrice) ProtoMessage() {}
+
+func (x *EffectivePrice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectivePrice.ProtoReflect.Descriptor instead.
+func (*EffectivePrice) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *EffectivePrice) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *EffectivePrice) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *EffectivePrice) GetEffectivePrice() *Money {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
+}
+
+func (x *EffectivePrice) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *EffectivePrice) GetHasActiveDiscount() bool {
+	if x != nil {
+		return x.HasActiveDiscount
+	}
+	return false
+}
+
+func (x *EffectivePrice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *EffectivePrice) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EffectivePrice) GetExperiment() *ExperimentAssignment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+// GetEffectivePricesReply is the response containing the prices of the requested products.
+type GetEffectivePricesReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Prices in request order, for products that exist.
+	Prices []*EffectivePrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	// Requested IDs for which no product exists.
+	MissingProductIds []string `protobuf:"bytes,2,rep,name=missing_product_ids,json=missingProductIds,proto3" json:"missing_product_ids,omitempty"`
+	// Signed token locking the returned effective prices until price_lock_expires_at.
+	// Only issued when pricing at the current time (at unset) and price locks are enabled.
+	PriceLockToken     string                 `protobuf:"bytes,3,opt,name=price_lock_token,json=priceLockToken,proto3" json:"price_lock_token,omitempty"`
+	PriceLockExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=price_lock_expires_at,json=priceLockExpiresAt,proto3" json:"price_lock_expires_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetEffectivePricesReply) Reset() {
+	*x = GetEffectivePricesReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectivePricesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectivePricesReply) ProtoMessage() {}
+
+func (x *GetEffectivePricesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectivePricesReply.ProtoReflect.Descriptor instead.
+func (*GetEffectivePricesReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetEffectivePricesReply) GetPrices() []*EffectivePrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *GetEffectivePricesReply) GetMissingProductIds() []string {
+	if x != nil {
+		return x.MissingProductIds
+	}
+	return nil
+}
+
+func (x *GetEffectivePricesReply) GetPriceLockToken() string {
+	if x != nil {
+		return x.PriceLockToken
+	}
+	return ""
+}
+
+func (x *GetEffectivePricesReply) GetPriceLockExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceLockExpiresAt
+	}
+	return nil
+}
+
+// GetPriceForQuantityRequest is the request to price a quantity of a product.
+type GetPriceForQuantityRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Number of units, from 1 to 1000000.
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Pricing time; defaults to now.
+	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceForQuantityRequest) Reset() {
+	*x = GetPriceForQuantityRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceForQuantityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceForQuantityRequest) ProtoMessage() {}
+
+func (x *GetPriceForQuantityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceForQuantityRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceForQuantityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetPriceForQuantityRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPriceForQuantityRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GetPriceForQuantityRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+// GetPriceForQuantityReply is the price of the requested quantity. The price tier for the
+// quantity sets the unit price and the discount that applies is then taken off it.
+type GetPriceForQuantityReply struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	BasePrice *Money                 `protobuf:"bytes,3,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	UnitPrice *Money                 `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// unit_price times quantity.
+	TotalPrice *Money `protobuf:"bytes,5,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	// min_quantity of the price tier applied; 0 if the quantity is below every tier.
+	TierMinQuantity int64 `protobuf:"varint,6,opt,name=tier_min_quantity,json=tierMinQuantity,proto3" json:"tier_min_quantity,omitempty"`
+	// Percentage of the discount applied; 0 if none applied.
+	DiscountPercent float64 `protobuf:"fixed64,7,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	// ISO 4217 currency code of the prices.
+	Currency      string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status        string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceForQuantityReply) Reset() {
+	*x = GetPriceForQuantityReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceForQuantityReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceForQuantityReply) ProtoMessage() {}
+
+func (x *GetPriceForQuantityReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceForQuantityReply.ProtoReflect.Descriptor instead.
+func (*GetPriceForQuantityReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetPriceForQuantityReply) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPriceForQuantityReply) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GetPriceForQuantityReply) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *GetPriceForQuantityReply) GetUnitPrice() *Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *GetPriceForQuantityReply) GetTotalPrice() *Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *GetPriceForQuantityReply) GetTierMinQuantity() int64 {
+	if x != nil {
+		return x.TierMinQuantity
+	}
+	return 0
+}
+
+func (x *GetPriceForQuantityReply) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *GetPriceForQuantityReply) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetPriceForQuantityReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetTaxInclusivePriceRequest is the request to price a product with tax.
+type GetTaxInclusivePriceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Tax region whose rates apply, e.g. "DE"; empty means the default rates.
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	// Pricing time; defaults to now.
+	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaxInclusivePriceRequest) Reset() {
+	*x = GetTaxInclusivePriceRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaxInclusivePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaxInclusivePriceRequest) ProtoMessage() {}
+
+func (x *GetTaxInclusivePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaxInclusivePriceRequest.ProtoReflect.Descriptor instead.
+func (*GetTaxInclusivePriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetTaxInclusivePriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetTaxInclusivePriceRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetTaxInclusivePriceRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+// GetTaxInclusivePriceReply is the effective price of a product with the tax of its tax
+// class on it. The amounts are exact; their display values are rounded separately, so
+// net and tax displays need not add up to the gross display.
+type GetTaxInclusivePriceReply struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	TaxClass  string                 `protobuf:"bytes,2,opt,name=tax_class,json=taxClass,proto3" json:"tax_class,omitempty"`
+	// Tax rate applied, as a percentage of the net price.
+	TaxRatePercent float64 `protobuf:"fixed64,3,opt,name=tax_rate_percent,json=taxRatePercent,proto3" json:"tax_rate_percent,omitempty"`
+	// The effective price.
+	NetPrice  *Money `protobuf:"bytes,4,opt,name=net_price,json=netPrice,proto3" json:"net_price,omitempty"`
+	TaxAmount *Money `protobuf:"bytes,5,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	// net_price plus tax_amount.
+	GrossPrice *Money `protobuf:"bytes,6,opt,name=gross_price,json=grossPrice,proto3" json:"gross_price,omitempty"`
+	// ISO 4217 currency code of the prices.
+	Currency      string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status        string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaxInclusivePriceReply) Reset() {
+	*x = GetTaxInclusivePriceReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaxInclusivePriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaxInclusivePriceReply) ProtoMessage() {}
+
+func (x *GetTaxInclusivePriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaxInclusivePriceReply.ProtoReflect.Descriptor instead.
+func (*GetTaxInclusivePriceReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *GetTaxInclusivePriceReply) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetTaxInclusivePriceReply) GetTaxClass() string {
+	if x != nil {
+		return x.TaxClass
+	}
+	return ""
+}
+
+func (x *GetTaxInclusivePriceReply) GetTaxRatePercent() float64 {
+	if x != nil {
+		return x.TaxRatePercent
+	}
+	return 0
+}
+
+func (x *GetTaxInclusivePriceReply) GetNetPrice() *Money {
+	if x != nil {
+		return x.NetPrice
+	}
+	return nil
+}
+
+func (x *GetTaxInclusivePriceReply) GetTaxAmount() *Money {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return nil
+}
+
+func (x *GetTaxInclusivePriceReply) GetGrossPrice() *Money {
+	if x != nil {
+		return x.GrossPrice
+	}
+	return nil
+}
+
+func (x *GetTaxInclusivePriceReply) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetTaxInclusivePriceReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetMarginRequest is the request for the margin of a product.
+type GetMarginRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Pricing time; defaults to now.
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarginRequest) Reset() {
+	*x = GetMarginRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarginRequest) ProtoMessage() {}
+
+func (x *GetMarginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarginRequest.ProtoReflect.Descriptor instead.
+func (*GetMarginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *GetMarginRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetMarginRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+// GetMarginReply is the margin of selling a product at its effective price. It fails
+// with FAILED_PRECONDITION if the product has no cost price.
+type GetMarginReply struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// The effective price.
+	Price     *Money `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	CostPrice *Money `protobuf:"bytes,3,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`
+	// price minus cost_price; negative when the product sells at a loss.
+	Margin *Money `protobuf:"bytes,4,opt,name=margin,proto3" json:"margin,omitempty"`
+	// margin as a percentage of price.
+	MarginPercent     float64 `protobuf:"fixed64,5,opt,name=margin_percent,json=marginPercent,proto3" json:"margin_percent,omitempty"`
+	HasActiveDiscount bool    `protobuf:"varint,6,opt,name=has_active_discount,json=hasActiveDiscount,proto3" json:"has_active_discount,omitempty"`
+	// ISO 4217 currency code of the prices.
+	Currency      string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status        string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarginReply) Reset() {
+	*x = GetMarginReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarginReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarginReply) ProtoMessage() {}
+
+func (x *GetMarginReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarginReply.ProtoReflect.Descriptor instead.
+func (*GetMarginReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *GetMarginReply) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetMarginReply) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *GetMarginReply) GetCostPrice() *Money {
+	if x != nil {
+		return x.CostPrice
+	}
+	return nil
+}
+
+func (x *GetMarginReply) GetMargin() *Money {
+	if x != nil {
+		return x.Margin
+	}
+	return nil
+}
+
+func (x *GetMarginReply) GetMarginPercent() float64 {
+	if x != nil {
+		return x.MarginPercent
+	}
+	return 0
+}
+
+func (x *GetMarginReply) GetHasActiveDiscount() bool {
+	if x != nil {
+		return x.HasActiveDiscount
+	}
+	return false
+}
+
+func (x *GetMarginReply) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetMarginReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// CalculatePriceRequest is the request for a price preview of a product or of an inline
+// base price. Exactly one of product_id and base_price is required.
+type CalculatePriceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Priced instead of a product; currency defaults to USD. Price tiers, discounts and
+	// segment prices do not apply to it.
+	BasePrice *Money `protobuf:"bytes,2,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	// Number of units, from 1 to 1000000; defaults to 1.
+	Quantity int64 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Percentage from 0 to 100 taken off the price left by the product's discount; 0 means
+	// no coupon.
+	CouponPercent float64 `protobuf:"fixed64,4,opt,name=coupon_percent,json=couponPercent,proto3" json:"coupon_percent,omitempty"`
+	// Customer segment, e.g. "wholesale"; empty or a segment the product has no price for
+	// means its regular prices.
+	Segment string `protobuf:"bytes,5,opt,name=segment,proto3" json:"segment,omitempty"`
+	// Pricing time; defaults to now.
+	At *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=at,proto3" json:"at,omitempty"`
+	// Suggests the charm price, such as 19.99, nearest to unit_price in charm_price.
+	SuggestCharmPrice bool `protobuf:"varint,7,opt,name=suggest_charm_price,json=suggestCharmPrice,proto3" json:"suggest_charm_price,omitempty"`
+	// Suggests the charm price nearest to the price earning this gross margin over the
+	// product's cost price instead, from 0 to below 100; 0 means none. The product must have
+	// a cost price.
+	TargetMarginPercent float64 `protobuf:"fixed64,8,opt,name=target_margin_percent,json=targetMarginPercent,proto3" json:"target_margin_percent,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CalculatePriceRequest) Reset() {
+	*x = CalculatePriceRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculatePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePriceRequest) ProtoMessage() {}
+
+func (x *CalculatePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePriceRequest.ProtoReflect.Descriptor instead.
+func (*CalculatePriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *CalculatePriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CalculatePriceRequest) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *CalculatePriceRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CalculatePriceRequest) GetCouponPercent() float64 {
+	if x != nil {
+		return x.CouponPercent
+	}
+	return 0
+}
+
+func (x *CalculatePriceRequest) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (x *CalculatePriceRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[6]
+func (x *CalculatePriceRequest) GetSuggestCharmPrice() bool {
+	if x != nil {
+		return x.SuggestCharmPrice
+	}
+	return false
+}
+
+func (x *CalculatePriceRequest) GetTargetMarginPercent() float64 {
+	if x != nil {
+		return x.TargetMarginPercent
+	}
+	return 0
+}
+
+// CalculatePriceReply is a price preview. The price tier for the quantity sets the unit
+// price, the discount that applies is taken off it and the coupon then off what is left.
+type CalculatePriceReply struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Unit price before price tiers, discounts and the coupon.
+	BasePrice *Money `protobuf:"bytes,3,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	UnitPrice *Money `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// unit_price times quantity.
+	TotalPrice *Money `protobuf:"bytes,5,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	// What the discount and the coupon take off the total price.
+	DiscountAmount *Money `protobuf:"bytes,6,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	// base_price times quantity minus total_price, including the price tier savings.
+	Savings *Money `protobuf:"bytes,7,opt,name=savings,proto3" json:"savings,omitempty"`
+	// min_quantity of the price tier applied; 0 if none applied.
+	TierMinQuantity int64 `protobuf:"varint,8,opt,name=tier_min_quantity,json=tierMinQuantity,proto3" json:"tier_min_quantity,omitempty"`
+	// Percentage of the discount applied; 0 if none applied.
+	DiscountPercent float64 `protobuf:"fixed64,9,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	CouponPercent   float64 `protobuf:"fixed64,10,opt,name=coupon_percent,json=couponPercent,proto3" json:"coupon_percent,omitempty"`
+	// The requested segment if the product has a price for it.
+	Segment string `protobuf:"bytes,11,opt,name=segment,proto3" json:"segment,omitempty"`
+	// ISO 4217 currency code of the prices.
+	Currency string `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Status of the product; empty for an inline base price.
+	Status string `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	// The suggested charm price, a whole amount less one cent; only set if
+	// suggest_charm_price or target_margin_percent is.
+	CharmPrice    *Money `protobuf:"bytes,14,opt,name=charm_price,json=charmPrice,proto3" json:"charm_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculatePriceReply) Reset() {
+	*x = CalculatePriceReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculatePriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePriceReply) ProtoMessage() {}
+
+func (x *CalculatePriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,61 +10063,135 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use CalculatePriceReply.ProtoReflect.Descriptor instead.
+func (*CalculatePriceReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *UpdateProductRequest) GetProductId() string {
+func (x *CalculatePriceReply) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetName() string {
+func (x *CalculatePriceReply) GetQuantity() int64 {
 	if x != nil {
-		return x.Name
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CalculatePriceReply) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *CalculatePriceReply) GetUnitPrice() *Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *CalculatePriceReply) GetTotalPrice() *Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *CalculatePriceReply) GetDiscountAmount() *Money {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return nil
+}
+
+func (x *CalculatePriceReply) GetSavings() *Money {
+	if x != nil {
+		return x.Savings
+	}
+	return nil
+}
+
+func (x *CalculatePriceReply) GetTierMinQuantity() int64 {
+	if x != nil {
+		return x.TierMinQuantity
+	}
+	return 0
+}
+
+func (x *CalculatePriceReply) GetDiscountPercent() float64 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *CalculatePriceReply) GetCouponPercent() float64 {
+	if x != nil {
+		return x.CouponPercent
+	}
+	return 0
+}
+
+func (x *CalculatePriceReply) GetSegment() string {
+	if x != nil {
+		return x.Segment
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetDescription() string {
+func (x *CalculatePriceReply) GetCurrency() string {
 	if x != nil {
-		return x.Description
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetCategory() string {
+func (x *CalculatePriceReply) GetStatus() string {
 	if x != nil {
-		return x.Category
+		return x.Status
 	}
 	return ""
 }
 
-// UpdateProductReply is the response after updating a product.
-type UpdateProductReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *CalculatePriceReply) GetCharmPrice() *Money {
+	if x != nil {
+		return x.CharmPrice
+	}
+	return nil
+}
+
+// GetPriceListPriceRequest is the request to price a product for a price list.
+type GetPriceListPriceRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PriceListId string                 `protobuf:"bytes,1,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	ProductId   string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Pricing time; defaults to now.
+	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductReply) Reset() {
-	*x = UpdateProductReply{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[7]
+func (x *GetPriceListPriceRequest) Reset() {
+	*x = GetPriceListPriceRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductReply) String() string {
+func (x *GetPriceListPriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductReply) ProtoMessage() {}
+func (*GetPriceListPriceRequest) ProtoMessage() {}
 
-func (x *UpdateProductReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[7]
+func (x *GetPriceListPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,34 +10202,62 @@ func (x *UpdateProductReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductReply.ProtoReflect.Descriptor instead.
-func (*UpdateProductReply) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetPriceListPriceRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceListPriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{158}
 }
 
-// ActivateProductRequest is the request to activate a product.
-type ActivateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+func (x *GetPriceListPriceRequest) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *GetPriceListPriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPriceListPriceRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+// GetPriceListPriceReply is the price of a product for a price list: the price of its
+// entry if the list has one and is valid at the pricing time, or else the base price of
+// the product in its own currency. Discounts do not apply to either.
+type GetPriceListPriceReply struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PriceListId string                 `protobuf:"bytes,1,opt,name=price_list_id,json=priceListId,proto3" json:"price_list_id,omitempty"`
+	ProductId   string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price       *Money                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// "price_list" or "base_price".
+	Source        string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Status        string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateProductRequest) Reset() {
-	*x = ActivateProductRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[8]
+func (x *GetPriceListPriceReply) Reset() {
+	*x = GetPriceListPriceReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateProductRequest) String() string {
+func (x *GetPriceListPriceReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateProductRequest) ProtoMessage() {}
+func (*GetPriceListPriceReply) ProtoMessage() {}
 
-func (x *ActivateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[8]
+func (x *GetPriceListPriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,40 +10268,230 @@ func (x *ActivateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateProductRequest.ProtoReflect.Descriptor instead.
-func (*ActivateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetPriceListPriceReply.ProtoReflect.Descriptor instead.
+func (*GetPriceListPriceReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *GetPriceListPriceReply) GetPriceListId() string {
+	if x != nil {
+		return x.PriceListId
+	}
+	return ""
+}
+
+func (x *GetPriceListPriceReply) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPriceListPriceReply) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *GetPriceListPriceReply) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *GetPriceListPriceReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// GetPriceHistoryRequest is the request to read the price history of a product.
+type GetPriceHistoryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Only changes at or after from are returned; unset means from the first change.
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Only changes before to are returned; unset means up to the latest change.
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *GetPriceHistoryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetPriceHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// PriceHistoryEntry records the prices of a product right after a change of its base
+// price or discounts.
+type PriceHistoryEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	ChangeId  string                 `protobuf:"bytes,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	// Type of the event that changed the price, e.g. "product.discount_applied".
+	ChangeType     string `protobuf:"bytes,3,opt,name=change_type,json=changeType,proto3" json:"change_type,omitempty"`
+	BasePrice      *Money `protobuf:"bytes,4,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	EffectivePrice *Money `protobuf:"bytes,5,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	// ID and percentage of the discount that applied after the change; empty and 0 if none did.
+	DiscountId      string  `protobuf:"bytes,6,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	DiscountPercent float64 `protobuf:"fixed64,7,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PriceHistoryEntry) Reset() {
+	*x = PriceHistoryEntry{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryEntry) ProtoMessage() {}
+
+func (x *PriceHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistoryEntry.ProtoReflect.Descriptor instead.
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *PriceHistoryEntry) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *PriceHistoryEntry) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetChangeType() string {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *PriceHistoryEntry) GetEffectivePrice() *Money {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
+}
+
+func (x *PriceHistoryEntry) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
 }
 
-func (x *ActivateProductRequest) GetProductId() string {
+func (x *PriceHistoryEntry) GetDiscountPercent() float64 {
 	if x != nil {
-		return x.ProductId
+		return x.DiscountPercent
 	}
-	return ""
+	return 0
 }
 
-// ActivateProductReply is the response after activating a product.
-type ActivateProductReply struct {
+// GetPriceHistoryReply lists the price changes of a product in the requested range,
+// oldest first.
+type GetPriceHistoryReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Entries       []*PriceHistoryEntry   `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivateProductReply) Reset() {
-	*x = ActivateProductReply{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[9]
+func (x *GetPriceHistoryReply) Reset() {
+	*x = GetPriceHistoryReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivateProductReply) String() string {
+func (x *GetPriceHistoryReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateProductReply) ProtoMessage() {}
+func (*GetPriceHistoryReply) ProtoMessage() {}
 
-func (x *ActivateProductReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[9]
+func (x *GetPriceHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,34 +10502,52 @@ func (x *ActivateProductReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateProductReply.ProtoReflect.Descriptor instead.
-func (*ActivateProductReply) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetPriceHistoryReply.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{162}
 }
 
-// DeactivateProductRequest is the request to deactivate a product.
-type DeactivateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+func (x *GetPriceHistoryReply) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryReply) GetEntries() []*PriceHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// GetProductRevisionsRequest is the request to read the revisions of a product.
+type GetProductRevisionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Only revisions at or after from are returned; unset means from the first revision.
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// Only revisions before to are returned; unset means up to the latest revision.
+	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeactivateProductRequest) Reset() {
-	*x = DeactivateProductRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[10]
+func (x *GetProductRevisionsRequest) Reset() {
+	*x = GetProductRevisionsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeactivateProductRequest) String() string {
+func (x *GetProductRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateProductRequest) ProtoMessage() {}
+func (*GetProductRevisionsRequest) ProtoMessage() {}
 
-func (x *DeactivateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[10]
+func (x *GetProductRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -705,40 +10558,59 @@ func (x *DeactivateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateProductRequest.ProtoReflect.Descriptor instead.
-func (*DeactivateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetProductRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *DeactivateProductRequest) GetProductId() string {
+func (x *GetProductRevisionsRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-// DeactivateProductReply is the response after deactivating a product.
-type DeactivateProductReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *GetProductRevisionsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetProductRevisionsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// FieldChange is a field of the product snapshot that differs from the revision before.
+type FieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Field string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// JSON values of the field before and after the revision; before is empty for the
+	// first revision of a product.
+	Before        string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeactivateProductReply) Reset() {
-	*x = DeactivateProductReply{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[11]
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeactivateProductReply) String() string {
+func (x *FieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateProductReply) ProtoMessage() {}
+func (*FieldChange) ProtoMessage() {}
 
-func (x *DeactivateProductReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[11]
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,34 +10621,61 @@ func (x *DeactivateProductReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateProductReply.ProtoReflect.Descriptor instead.
-func (*DeactivateProductReply) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{164}
 }
 
-// ArchiveProductRequest is the request to archive a product.
-type ArchiveProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *FieldChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// ProductRevision records a change of a product with its diff against the revision
+// before it.
+type ProductRevision struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	RevisionId string                 `protobuf:"bytes,1,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	RevisedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=revised_at,json=revisedAt,proto3" json:"revised_at,omitempty"`
+	// Fields the command that made the revision changed, e.g. "name" or "tags".
+	ChangedFields []string `protobuf:"bytes,3,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	// Snapshot fields that differ from the revision before, sorted by field.
+	Changes       []*FieldChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArchiveProductRequest) Reset() {
-	*x = ArchiveProductRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[12]
+func (x *ProductRevision) Reset() {
+	*x = ProductRevision{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArchiveProductRequest) String() string {
+func (x *ProductRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveProductRequest) ProtoMessage() {}
+func (*ProductRevision) ProtoMessage() {}
 
-func (x *ArchiveProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[12]
+func (x *ProductRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,81 +10686,64 @@ func (x *ArchiveProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveProductRequest.ProtoReflect.Descriptor instead.
-func (*ArchiveProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ProductRevision.ProtoReflect.Descriptor instead.
+func (*ProductRevision) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *ArchiveProductRequest) GetProductId() string {
+func (x *ProductRevision) GetRevisionId() string {
 	if x != nil {
-		return x.ProductId
+		return x.RevisionId
 	}
 	return ""
 }
 
-// ArchiveProductReply is the response after archiving a product.
-type ArchiveProductReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ArchiveProductReply) Reset() {
-	*x = ArchiveProductReply{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ArchiveProductReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ProductRevision) GetRevisedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevisedAt
+	}
+	return nil
 }
 
-func (*ArchiveProductReply) ProtoMessage() {}
-
-func (x *ArchiveProductReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[13]
+func (x *ProductRevision) GetChangedFields() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ChangedFields
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ArchiveProductReply.ProtoReflect.Descriptor instead.
-func (*ArchiveProductReply) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{13}
+func (x *ProductRevision) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
 }
 
-// ApplyDiscountRequest is the request to apply a discount to a product.
-type ApplyDiscountRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ProductId          string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	DiscountPercentage float64                `protobuf:"fixed64,2,opt,name=discount_percentage,json=discountPercentage,proto3" json:"discount_percentage,omitempty"`
-	StartDate          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// GetProductRevisionsReply lists the revisions of a product in the requested range,
+// oldest first.
+type GetProductRevisionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Revisions     []*ProductRevision     `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyDiscountRequest) Reset() {
-	*x = ApplyDiscountRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[14]
+func (x *GetProductRevisionsReply) Reset() {
+	*x = GetProductRevisionsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyDiscountRequest) String() string {
+func (x *GetProductRevisionsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyDiscountRequest) ProtoMessage() {}
+func (*GetProductRevisionsReply) ProtoMessage() {}
 
-func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[14]
+func (x *GetProductRevisionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,61 +10754,49 @@ func (x *ApplyDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyDiscountRequest.ProtoReflect.Descriptor instead.
-func (*ApplyDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetProductRevisionsReply.ProtoReflect.Descriptor instead.
+func (*GetProductRevisionsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *ApplyDiscountRequest) GetProductId() string {
+func (x *GetProductRevisionsReply) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *ApplyDiscountRequest) GetDiscountPercentage() float64 {
-	if x != nil {
-		return x.DiscountPercentage
-	}
-	return 0
-}
-
-func (x *ApplyDiscountRequest) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
-}
-
-func (x *ApplyDiscountRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *GetProductRevisionsReply) GetRevisions() []*ProductRevision {
 	if x != nil {
-		return x.EndDate
+		return x.Revisions
 	}
 	return nil
 }
 
-// ApplyDiscountReply is the response after applying a discount.
-type ApplyDiscountReply struct {
+// GetProductAtRevisionRequest is the request to read a product as it was at a revision.
+type GetProductAtRevisionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RevisionId    string                 `protobuf:"bytes,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyDiscountReply) Reset() {
-	*x = ApplyDiscountReply{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[15]
+func (x *GetProductAtRevisionRequest) Reset() {
+	*x = GetProductAtRevisionRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyDiscountReply) String() string {
+func (x *GetProductAtRevisionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyDiscountReply) ProtoMessage() {}
+func (*GetProductAtRevisionRequest) ProtoMessage() {}
 
-func (x *ApplyDiscountReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[15]
+func (x *GetProductAtRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,34 +10807,52 @@ func (x *ApplyDiscountReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyDiscountReply.ProtoReflect.Descriptor instead.
-func (*ApplyDiscountReply) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetProductAtRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetProductAtRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{167}
 }
 
-// RemoveDiscountRequest is the request to remove a discount from a product.
-type RemoveDiscountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+func (x *GetProductAtRevisionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductAtRevisionRequest) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+// GetProductAtRevisionReply is the full state of a product right after a revision.
+type GetProductAtRevisionReply struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProductId  string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RevisionId string                 `protobuf:"bytes,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	RevisedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=revised_at,json=revisedAt,proto3" json:"revised_at,omitempty"`
+	// Snapshot of the product as JSON, in the format of the snapshot of product events.
+	Snapshot      string `protobuf:"bytes,4,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveDiscountRequest) Reset() {
-	*x = RemoveDiscountRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[16]
+func (x *GetProductAtRevisionReply) Reset() {
+	*x = GetProductAtRevisionReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveDiscountRequest) String() string {
+func (x *GetProductAtRevisionReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDiscountRequest) ProtoMessage() {}
+func (*GetProductAtRevisionReply) ProtoMessage() {}
 
-func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[16]
+func (x *GetProductAtRevisionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,40 +10863,64 @@ func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDiscountRequest.ProtoReflect.Descriptor instead.
-func (*RemoveDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetProductAtRevisionReply.ProtoReflect.Descriptor instead.
+func (*GetProductAtRevisionReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *RemoveDiscountRequest) GetProductId() string {
+func (x *GetProductAtRevisionReply) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-// RemoveDiscountReply is the response after removing a discount.
-type RemoveDiscountReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *GetProductAtRevisionReply) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+func (x *GetProductAtRevisionReply) GetRevisedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevisedAt
+	}
+	return nil
+}
+
+func (x *GetProductAtRevisionReply) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+// GetRelatedProductsRequest is the request to read the products a product links to.
+type GetRelatedProductsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// "related", "accessory" or "replacement"; empty returns the links of every type.
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveDiscountReply) Reset() {
-	*x = RemoveDiscountReply{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[17]
+func (x *GetRelatedProductsRequest) Reset() {
+	*x = GetRelatedProductsRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveDiscountReply) String() string {
+func (x *GetRelatedProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDiscountReply) ProtoMessage() {}
+func (*GetRelatedProductsRequest) ProtoMessage() {}
 
-func (x *RemoveDiscountReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[17]
+func (x *GetRelatedProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,34 +10931,49 @@ func (x *RemoveDiscountReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDiscountReply.ProtoReflect.Descriptor instead.
-func (*RemoveDiscountReply) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetRelatedProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{169}
 }
 
-// GetProductRequest is the request to get a product by ID.
-type GetProductRequest struct {
+func (x *GetRelatedProductsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetRelatedProductsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// RelatedProduct is a product linked from another product.
+type RelatedProduct struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Product       *ProductSummary        `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[18]
+func (x *RelatedProduct) Reset() {
+	*x = RelatedProduct{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *RelatedProduct) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*RelatedProduct) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[18]
+func (x *RelatedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,41 +10984,50 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use RelatedProduct.ProtoReflect.Descriptor instead.
+func (*RelatedProduct) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *GetProductRequest) GetProductId() string {
+func (x *RelatedProduct) GetType() string {
 	if x != nil {
-		return x.ProductId
+		return x.Type
 	}
 	return ""
 }
 
-// GetProductReply is the response containing a product.
-type GetProductReply struct {
+func (x *RelatedProduct) GetProduct() *ProductSummary {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// GetRelatedProductsReply lists the products a product links to that are not archived,
+// ordered by type and then product ID.
+type GetRelatedProductsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Products      []*RelatedProduct      `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductReply) Reset() {
-	*x = GetProductReply{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[19]
+func (x *GetRelatedProductsReply) Reset() {
+	*x = GetRelatedProductsReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductReply) String() string {
+func (x *GetRelatedProductsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductReply) ProtoMessage() {}
+func (*GetRelatedProductsReply) ProtoMessage() {}
 
-func (x *GetProductReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[19]
+func (x *GetRelatedProductsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1102,45 +11038,48 @@ func (x *GetProductReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductReply.ProtoReflect.Descriptor instead.
-func (*GetProductReply) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetRelatedProductsReply.ProtoReflect.Descriptor instead.
+func (*GetRelatedProductsReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *GetProductReply) GetProduct() *Product {
+func (x *GetRelatedProductsReply) GetProductId() string {
 	if x != nil {
-		return x.Product
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetRelatedProductsReply) GetProducts() []*RelatedProduct {
+	if x != nil {
+		return x.Products
 	}
 	return nil
 }
 
-// ListProductsRequest is the request to list products.
-type ListProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	ActiveOnly    bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// VerifyPriceLockRequest is the request to verify a price lock token, e.g. at order placement.
+type VerifyPriceLockRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PriceLockToken string                 `protobuf:"bytes,1,opt,name=price_lock_token,json=priceLockToken,proto3" json:"price_lock_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[20]
+func (x *VerifyPriceLockRequest) Reset() {
+	*x = VerifyPriceLockRequest{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *VerifyPriceLockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*VerifyPriceLockRequest) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[20]
+func (x *VerifyPriceLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1151,71 +11090,104 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use VerifyPriceLockRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPriceLockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *ListProductsRequest) GetCategory() string {
+func (x *VerifyPriceLockRequest) GetPriceLockToken() string {
 	if x != nil {
-		return x.Category
+		return x.PriceLockToken
 	}
 	return ""
 }
 
-func (x *ListProductsRequest) GetStatus() string {
+// LockedPrice is an effective price recorded in a price lock token.
+type LockedPrice struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	EffectivePrice *Money                 `protobuf:"bytes,2,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	Currency       string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LockedPrice) Reset() {
+	*x = LockedPrice{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockedPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockedPrice) ProtoMessage() {}
+
+func (x *LockedPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[173]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ListProductsRequest) GetActiveOnly() bool {
+// Deprecated: Use LockedPrice.ProtoReflect.Descriptor instead.
+func (*LockedPrice) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *LockedPrice) GetProductId() string {
 	if x != nil {
-		return x.ActiveOnly
+		return x.ProductId
 	}
-	return false
+	return ""
 }
 
-func (x *ListProductsRequest) GetPageSize() int32 {
+func (x *LockedPrice) GetEffectivePrice() *Money {
 	if x != nil {
-		return x.PageSize
+		return x.EffectivePrice
 	}
-	return 0
+	return nil
 }
 
-func (x *ListProductsRequest) GetPageToken() string {
+func (x *LockedPrice) GetCurrency() string {
 	if x != nil {
-		return x.PageToken
+		return x.Currency
 	}
 	return ""
 }
 
-// ListProductsReply is the response containing a list of products.
-type ListProductsReply struct {
+// VerifyPriceLockReply is the response containing the prices locked by a valid token.
+type VerifyPriceLockReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*ProductSummary      `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Prices        []*LockedPrice         `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	IssuedAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsReply) Reset() {
-	*x = ListProductsReply{}
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[21]
+func (x *VerifyPriceLockReply) Reset() {
+	*x = VerifyPriceLockReply{}
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsReply) String() string {
+func (x *VerifyPriceLockReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsReply) ProtoMessage() {}
+func (*VerifyPriceLockReply) ProtoMessage() {}
 
-func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_v1_product_service_proto_msgTypes[21]
+func (x *VerifyPriceLockReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_v1_product_service_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1226,30 +11198,30 @@ func (x *ListProductsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsReply.ProtoReflect.Descriptor instead.
-func (*ListProductsReply) Descriptor() ([]byte, []int) {
-	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use VerifyPriceLockReply.ProtoReflect.Descriptor instead.
+func (*VerifyPriceLockReply) Descriptor() ([]byte, []int) {
+	return file_proto_product_v1_product_service_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *ListProductsReply) GetProducts() []*ProductSummary {
+func (x *VerifyPriceLockReply) GetPrices() []*LockedPrice {
 	if x != nil {
-		return x.Products
+		return x.Prices
 	}
 	return nil
 }
 
-func (x *ListProductsReply) GetNextPageToken() string {
+func (x *VerifyPriceLockReply) GetIssuedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NextPageToken
+		return x.IssuedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ListProductsReply) GetTotalCount() int64 {
+func (x *VerifyPriceLockReply) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TotalCount
+		return x.ExpiresAt
 	}
-	return 0
+	return nil
 }
 
 var File_proto_product_v1_product_service_proto protoreflect.FileDescriptor
@@ -1257,17 +11229,61 @@ var File_proto_product_v1_product_service_proto protoreflect.FileDescriptor
 const file_proto_product_v1_product_service_proto_rawDesc = "" +
 	"\n" +
 	"&proto/product/v1/product_service.proto\x12\n" +
-	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"G\n" +
+	"product.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"}\n" +
 	"\x05Money\x12\x1c\n" +
 	"\tnumerator\x18\x01 \x01(\x03R\tnumerator\x12 \n" +
-	"\vdenominator\x18\x02 \x01(\x03R\vdenominator\"\x9c\x01\n" +
+	"\vdenominator\x18\x02 \x01(\x03R\vdenominator\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x18\n" +
+	"\adisplay\x18\x04 \x01(\tR\adisplay\"\xe4\x03\n" +
 	"\bDiscount\x12\x1e\n" +
 	"\n" +
 	"percentage\x18\x01 \x01(\x01R\n" +
 	"percentage\x129\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\xc9\x03\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1c\n" +
+	"\tsuspended\x18\x04 \x01(\bR\tsuspended\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\x12\x1a\n" +
+	"\bpriority\x18\x06 \x01(\x05R\bpriority\x12\x12\n" +
+	"\x04kind\x18\a \x01(\tR\x04kind\x123\n" +
+	"\vbuy_x_get_y\x18\b \x01(\v2\x14.product.v1.BuyXGetYR\bbuyXGetY\x12\x16\n" +
+	"\x06market\x18\t \x01(\tR\x06market\x12)\n" +
+	"\x10pending_approval\x18\n" +
+	" \x01(\bR\x0fpendingApproval\x12\x1f\n" +
+	"\vapproved_by\x18\v \x01(\tR\n" +
+	"approvedBy\x12\x1d\n" +
+	"\n" +
+	"flash_sale\x18\f \x01(\bR\tflashSale\x120\n" +
+	"\n" +
+	"sale_price\x18\r \x01(\v2\x11.product.v1.MoneyR\tsalePrice\"P\n" +
+	"\bBuyXGetY\x12!\n" +
+	"\fbuy_quantity\x18\x01 \x01(\x05R\vbuyQuantity\x12!\n" +
+	"\fget_quantity\x18\x02 \x01(\x05R\vgetQuantity\"\x8e\x01\n" +
+	"\tPriceTier\x12!\n" +
+	"\fmin_quantity\x18\x01 \x01(\x03R\vminQuantity\x122\n" +
+	"\n" +
+	"unit_price\x18\x02 \x01(\v2\x11.product.v1.MoneyH\x00R\tunitPrice\x12!\n" +
+	"\vpercent_off\x18\x03 \x01(\x01H\x00R\n" +
+	"percentOffB\a\n" +
+	"\x05price\"\x8a\x01\n" +
+	"\fSegmentPrice\x12\x18\n" +
+	"\asegment\x18\x01 \x01(\tR\asegment\x124\n" +
+	"\vfixed_price\x18\x02 \x01(\v2\x11.product.v1.MoneyH\x00R\n" +
+	"fixedPrice\x12!\n" +
+	"\vpercent_off\x18\x03 \x01(\x01H\x00R\n" +
+	"percentOffB\a\n" +
+	"\x05price\"\xba\x01\n" +
+	"\vMarketPrice\x12\x16\n" +
+	"\x06market\x18\x01 \x01(\tR\x06market\x12'\n" +
+	"\x05price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\x05price\x12:\n" +
+	"\x0feffective_price\x18\x03 \x01(\v2\x11.product.v1.MoneyR\x0eeffectivePrice\x12.\n" +
+	"\x13has_active_discount\x18\x04 \x01(\bR\x11hasActiveDiscount\"2\n" +
+	"\x11ExperimentContext\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x01 \x01(\tR\tsubjectId\"U\n" +
+	"\x14ExperimentAssignment\x12#\n" +
+	"\rexperiment_id\x18\x01 \x01(\tR\fexperimentId\x12\x18\n" +
+	"\avariant\x18\x02 \x01(\tR\avariant\"\xd8\x0f\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1283,7 +11299,113 @@ const file_proto_product_v1_product_service_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xec\x02\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x122\n" +
+	"\tdiscounts\x18\f \x03(\v2\x14.product.v1.DiscountR\tdiscounts\x126\n" +
+	"\vprice_tiers\x18\r \x03(\v2\x15.product.v1.PriceTierR\n" +
+	"priceTiers\x120\n" +
+	"\n" +
+	"price_book\x18\x0e \x03(\v2\x11.product.v1.MoneyR\tpriceBook\x12!\n" +
+	"\fprice_source\x18\x0f \x01(\tR\vpriceSource\x12@\n" +
+	"\n" +
+	"experiment\x18\x10 \x01(\v2 .product.v1.ExperimentAssignmentR\n" +
+	"experiment\x12\x1b\n" +
+	"\ttax_class\x18\x11 \x01(\tR\btaxClass\x12?\n" +
+	"\x0esegment_prices\x18\x12 \x03(\v2\x18.product.v1.SegmentPriceR\rsegmentPrices\x12\x18\n" +
+	"\asegment\x18\x13 \x01(\tR\asegment\x12<\n" +
+	"\rmarket_prices\x18\x14 \x03(\v2\x17.product.v1.MarketPriceR\fmarketPrices\x12\x16\n" +
+	"\x06market\x18\x15 \x01(\tR\x06market\x126\n" +
+	"\rminimum_price\x18\x16 \x01(\v2\x11.product.v1.MoneyR\fminimumPrice\x120\n" +
+	"\n" +
+	"cost_price\x18\x17 \x01(\v2\x11.product.v1.MoneyR\tcostPrice\x12P\n" +
+	"\x14pending_price_change\x18\x18 \x01(\v2\x1e.product.v1.PendingPriceChangeR\x12pendingPriceChange\x12/\n" +
+	"\bvariants\x18\x19 \x03(\v2\x13.product.v1.VariantR\bvariants\x12\x19\n" +
+	"\bin_stock\x18\x1a \x01(\bR\ainStock\x12'\n" +
+	"\x05stock\x18\x1b \x01(\v2\x11.product.v1.StockR\x05stock\x12\x12\n" +
+	"\x04tags\x18\x1c \x03(\tR\x04tags\x12C\n" +
+	"\n" +
+	"attributes\x18\x1d \x03(\v2#.product.v1.Product.AttributesEntryR\n" +
+	"attributes\x120\n" +
+	"\x06images\x18\x1e \x03(\v2\x18.product.v1.ProductImageR\x06images\x12\x16\n" +
+	"\x06locale\x18\x1f \x01(\tR\x06locale\x12\x12\n" +
+	"\x04slug\x18  \x01(\tR\x04slug\x12\x10\n" +
+	"\x03sku\x18! \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\" \x01(\tR\x04gtin\x12;\n" +
+	"\vactivate_at\x18# \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"activateAt\x12?\n" +
+	"\rdeactivate_at\x18$ \x01(\v2\x1a.google.protobuf.TimestampR\fdeactivateAt\x121\n" +
+	"\x06review\x18% \x01(\v2\x19.product.v1.ProductReviewR\x06review\x12*\n" +
+	"\x06weight\x18& \x01(\v2\x12.product.v1.WeightR\x06weight\x126\n" +
+	"\n" +
+	"dimensions\x18' \x01(\v2\x16.product.v1.DimensionsR\n" +
+	"dimensions\x121\n" +
+	"\x06rating\x18( \x01(\v2\x19.product.v1.RatingSummaryR\x06rating\x12\x1a\n" +
+	"\bchannels\x18) \x03(\tR\bchannels\x12\x12\n" +
+	"\x04kind\x18* \x01(\tR\x04kind\x126\n" +
+	"\n" +
+	"compliance\x18+ \x01(\v2\x16.product.v1.ComplianceR\n" +
+	"compliance\x12?\n" +
+	"\vattachments\x18, \x03(\v2\x1d.product.v1.ProductAttachmentR\vattachments\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"U\n" +
+	"\fProductImage\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x19\n" +
+	"\balt_text\x18\x02 \x01(\tR\aaltText\x12\x18\n" +
+	"\aprimary\x18\x03 \x01(\bR\aprimary\"Q\n" +
+	"\x11ProductAttachment\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\"q\n" +
+	"\x05Stock\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x03R\x05level\x12\x1a\n" +
+	"\breserved\x18\x02 \x01(\x03R\breserved\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x03R\tavailable\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x03R\aversion\"\xe0\x02\n" +
+	"\aVariant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12C\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2#.product.v1.Variant.AttributesEntryR\n" +
+	"attributes\x122\n" +
+	"\vprice_delta\x18\x04 \x01(\v2\x11.product.v1.MoneyR\n" +
+	"priceDelta\x12'\n" +
+	"\x05price\x18\x05 \x01(\v2\x11.product.v1.MoneyR\x05price\x12:\n" +
+	"\x0feffective_price\x18\x06 \x01(\v2\x11.product.v1.MoneyR\x0eeffectivePrice\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"~\n" +
+	"\rProductReview\x12!\n" +
+	"\fsubmitted_by\x18\x01 \x01(\tR\vsubmittedBy\x12\x1f\n" +
+	"\vreviewed_by\x18\x02 \x01(\tR\n" +
+	"reviewedBy\x12)\n" +
+	"\x10rejection_reason\x18\x03 \x01(\tR\x0frejectionReason\"z\n" +
+	"\n" +
+	"Compliance\x12\x1f\n" +
+	"\vminimum_age\x18\x01 \x01(\x05R\n" +
+	"minimumAge\x12\x1c\n" +
+	"\thazardous\x18\x02 \x01(\bR\thazardous\x12-\n" +
+	"\x12restricted_markets\x18\x03 \x03(\tR\x11restrictedMarkets\"H\n" +
+	"\x06Weight\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x12\x14\n" +
+	"\x05grams\x18\x03 \x01(\x01R\x05grams\"\xb7\x01\n" +
+	"\n" +
+	"Dimensions\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x01R\x06height\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x01R\x05width\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\x01R\x05depth\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x1b\n" +
+	"\theight_mm\x18\x05 \x01(\x01R\bheightMm\x12\x19\n" +
+	"\bwidth_mm\x18\x06 \x01(\x01R\awidthMm\x12\x19\n" +
+	"\bdepth_mm\x18\a \x01(\x01R\adepthMm\"?\n" +
+	"\rRatingSummary\x12\x18\n" +
+	"\aaverage\x18\x01 \x01(\x01R\aaverage\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x85\x01\n" +
+	"\x12PendingPriceChange\x120\n" +
+	"\n" +
+	"base_price\x18\x01 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x12=\n" +
+	"\feffective_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\"\xb6\x05\n" +
 	"\x0eProductSummary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -1295,23 +11417,80 @@ const file_proto_product_v1_product_service_proto_rawDesc = "" +
 	"\x10discount_percent\x18\a \x01(\x01R\x0fdiscountPercent\x12\x16\n" +
 	"\x06status\x18\b \x01(\tR\x06status\x129\n" +
 	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9a\x01\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fprice_source\x18\n" +
+	" \x01(\tR\vpriceSource\x12\x16\n" +
+	"\x06market\x18\v \x01(\tR\x06market\x12\x19\n" +
+	"\bin_stock\x18\f \x01(\bR\ainStock\x12\x12\n" +
+	"\x04tags\x18\r \x03(\tR\x04tags\x12=\n" +
+	"\rprimary_image\x18\x0e \x01(\v2\x18.product.v1.ProductImageR\fprimaryImage\x12\x16\n" +
+	"\x06locale\x18\x0f \x01(\tR\x06locale\x12\x12\n" +
+	"\x04slug\x18\x10 \x01(\tR\x04slug\x12\x10\n" +
+	"\x03sku\x18\x11 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\x12 \x01(\tR\x04gtin\x121\n" +
+	"\x06rating\x18\x13 \x01(\v2\x19.product.v1.RatingSummaryR\x06rating\x12\x1a\n" +
+	"\bchannels\x18\x14 \x03(\tR\bchannels\"\xc9\x03\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
 	"\bcategory\x18\x03 \x01(\tR\bcategory\x120\n" +
 	"\n" +
-	"base_price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\"3\n" +
+	"base_price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x12\x10\n" +
+	"\x03sku\x18\x05 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\x06 \x01(\tR\x04gtin\x12P\n" +
+	"\n" +
+	"attributes\x18\a \x03(\v20.product.v1.CreateProductRequest.AttributesEntryR\n" +
+	"attributes\x12*\n" +
+	"\x06weight\x18\b \x01(\v2\x12.product.v1.WeightR\x06weight\x126\n" +
+	"\n" +
+	"dimensions\x18\t \x01(\v2\x16.product.v1.DimensionsR\n" +
+	"dimensions\x12\x12\n" +
+	"\x04kind\x18\n" +
+	" \x01(\tR\x04kind\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"G\n" +
 	"\x12CreateProductReply\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"\x87\x01\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"4\n" +
+	"\x13CloneProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"F\n" +
+	"\x11CloneProductReply\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"\x98\x02\n" +
 	"\x14UpdateProductRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
-	"\bcategory\x18\x04 \x01(\tR\bcategory\"\x14\n" +
-	"\x12UpdateProductReply\"7\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12P\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v20.product.v1.UpdateProductRequest.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x14\n" +
+	"\x12UpdateProductReply\"i\n" +
+	"\x16ChangeBasePriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x120\n" +
+	"\n" +
+	"base_price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\"\x16\n" +
+	"\x14ChangeBasePriceReply\"\xac\x01\n" +
+	"\x1aSchedulePriceChangeRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x120\n" +
+	"\n" +
+	"base_price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x12=\n" +
+	"\feffective_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\"\x1a\n" +
+	"\x18SchedulePriceChangeReply\"9\n" +
+	"\x18CancelPriceChangeRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"\x18\n" +
+	"\x16CancelPriceChangeReply\"7\n" +
 	"\x16ActivateProductRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\"\x16\n" +
@@ -1319,28 +11498,395 @@ const file_proto_product_v1_product_service_proto_rawDesc = "" +
 	"\x18DeactivateProductRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\"\x18\n" +
-	"\x16DeactivateProductReply\"6\n" +
+	"\x16DeactivateProductReply\"\xb8\x01\n" +
+	"\x19ScheduleActivationRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12;\n" +
+	"\vactivate_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"activateAt\x12?\n" +
+	"\rdeactivate_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fdeactivateAt\"\x19\n" +
+	"\x17ScheduleActivationReply\"Z\n" +
+	"\x16SubmitForReviewRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12!\n" +
+	"\fsubmitted_by\x18\x02 \x01(\tR\vsubmittedBy\"\x16\n" +
+	"\x14SubmitForReviewReply\"R\n" +
+	"\x15ApproveProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\breviewer\x18\x02 \x01(\tR\breviewer\"\x15\n" +
+	"\x13ApproveProductReply\"i\n" +
+	"\x14RejectProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\breviewer\x18\x02 \x01(\tR\breviewer\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x14\n" +
+	"\x12RejectProductReply\"6\n" +
 	"\x15ArchiveProductRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\"\x15\n" +
-	"\x13ArchiveProductReply\"\xd8\x01\n" +
+	"\x13ArchiveProductReply\"\x92\x03\n" +
 	"\x14ApplyDiscountRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12/\n" +
 	"\x13discount_percentage\x18\x02 \x01(\x01R\x12discountPercentage\x129\n" +
 	"\n" +
 	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\x14\n" +
-	"\x12ApplyDiscountReply\"6\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x05R\bpriority\x123\n" +
+	"\vbuy_x_get_y\x18\x06 \x01(\v2\x14.product.v1.BuyXGetYR\bbuyXGetY\x12\x16\n" +
+	"\x06market\x18\a \x01(\tR\x06market\x12\x1d\n" +
+	"\n" +
+	"flash_sale\x18\b \x01(\bR\tflashSale\x120\n" +
+	"\n" +
+	"sale_price\x18\t \x01(\v2\x11.product.v1.MoneyR\tsalePrice\"`\n" +
+	"\x12ApplyDiscountReply\x12\x1f\n" +
+	"\vdiscount_id\x18\x01 \x01(\tR\n" +
+	"discountId\x12)\n" +
+	"\x10pending_approval\x18\x02 \x01(\bR\x0fpendingApproval\"\xfb\x01\n" +
+	"\x1eApplyDiscountToCategoryRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12/\n" +
+	"\x13discount_percentage\x18\x02 \x01(\x01R\x12discountPercentage\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x05R\bpriority\"\xc5\x01\n" +
+	"\x1cApplyDiscountToCategoryReply\x12\x1f\n" +
+	"\vdiscount_id\x18\x01 \x01(\tR\n" +
+	"discountId\x12)\n" +
+	"\x10pending_approval\x18\x02 \x01(\bR\x0fpendingApproval\x12#\n" +
+	"\rapplied_count\x18\x03 \x01(\x05R\fappliedCount\x124\n" +
+	"\askipped\x18\x04 \x03(\v2\x1a.product.v1.SkippedProductR\askipped\"W\n" +
 	"\x15RemoveDiscountRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"\x15\n" +
-	"\x13RemoveDiscountReply\"2\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vdiscount_id\x18\x02 \x01(\tR\n" +
+	"discountId\"\x15\n" +
+	"\x13RemoveDiscountReply\"t\n" +
+	"\x16ApproveDiscountRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vdiscount_id\x18\x02 \x01(\tR\n" +
+	"discountId\x12\x1a\n" +
+	"\bapprover\x18\x03 \x01(\tR\bapprover\"\x16\n" +
+	"\x14ApproveDiscountReply\"b\n" +
+	"\x14SetPriceTiersRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12+\n" +
+	"\x05tiers\x18\x02 \x03(\v2\x15.product.v1.PriceTierR\x05tiers\"\x14\n" +
+	"\x12SetPriceTiersReply\"_\n" +
+	"\x13SetPriceBookRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12)\n" +
+	"\x06prices\x18\x02 \x03(\v2\x11.product.v1.MoneyR\x06prices\"\x13\n" +
+	"\x11SetPriceBookReply\"j\n" +
+	"\x17SetSegmentPricesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x120\n" +
+	"\x06prices\x18\x02 \x03(\v2\x18.product.v1.SegmentPriceR\x06prices\"\x17\n" +
+	"\x15SetSegmentPricesReply\"h\n" +
+	"\x16SetMarketPricesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12/\n" +
+	"\x06prices\x18\x02 \x03(\v2\x17.product.v1.MarketPriceR\x06prices\"\x16\n" +
+	"\x14SetMarketPricesReply\"P\n" +
+	"\x12SetTaxClassRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\ttax_class\x18\x02 \x01(\tR\btaxClass\"\x12\n" +
+	"\x10SetTaxClassReply\"o\n" +
+	"\x16SetMinimumPriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x126\n" +
+	"\rminimum_price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\fminimumPrice\"\x16\n" +
+	"\x14SetMinimumPriceReply\"f\n" +
+	"\x13SetCostPriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x120\n" +
+	"\n" +
+	"cost_price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\tcostPrice\"\x13\n" +
+	"\x11SetCostPriceReply\"\x86\x02\n" +
+	"\x11AddVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12M\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2-.product.v1.AddVariantRequest.AttributesEntryR\n" +
+	"attributes\x122\n" +
+	"\vprice_delta\x18\x04 \x01(\v2\x11.product.v1.MoneyR\n" +
+	"priceDelta\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"0\n" +
+	"\x0fAddVariantReply\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x01 \x01(\tR\tvariantId\"\xab\x02\n" +
+	"\x14UpdateVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tR\tvariantId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12P\n" +
+	"\n" +
+	"attributes\x18\x04 \x03(\v20.product.v1.UpdateVariantRequest.AttributesEntryR\n" +
+	"attributes\x122\n" +
+	"\vprice_delta\x18\x05 \x01(\v2\x11.product.v1.MoneyR\n" +
+	"priceDelta\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x14\n" +
+	"\x12UpdateVariantReply\"Y\n" +
+	"\x19DiscontinueVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tR\tvariantId\"\x19\n" +
+	"\x17DiscontinueVariantReply\"\x8d\x01\n" +
+	"\x0fSetStockRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x03R\x05level\x12\x1a\n" +
+	"\breserved\x18\x03 \x01(\x03R\breserved\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\"8\n" +
+	"\rSetStockReply\x12'\n" +
+	"\x05stock\x18\x01 \x01(\v2\x11.product.v1.StockR\x05stock\"\xa6\x01\n" +
+	"\x12AdjustStockRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vlevel_delta\x18\x02 \x01(\x03R\n" +
+	"levelDelta\x12%\n" +
+	"\x0ereserved_delta\x18\x03 \x01(\x03R\rreservedDelta\x12)\n" +
+	"\x10expected_version\x18\x04 \x01(\x03R\x0fexpectedVersion\";\n" +
+	"\x10AdjustStockReply\x12'\n" +
+	"\x05stock\x18\x01 \x01(\v2\x11.product.v1.StockR\x05stock\"@\n" +
+	"\rAddTagRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\"\r\n" +
+	"\vAddTagReply\"C\n" +
+	"\x10RemoveTagRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\"\x10\n" +
+	"\x0eRemoveTagReply\"^\n" +
+	"\x13SetAttributeRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\x13\n" +
+	"\x11SetAttributeReply\"C\n" +
+	"\x0eSetSlugRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"\x0e\n" +
+	"\fSetSlugReply\"\\\n" +
+	"\x15SetIdentifiersRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04gtin\x18\x03 \x01(\tR\x04gtin\"\x15\n" +
+	"\x13SetIdentifiersReply\"\x99\x01\n" +
+	"\x14SetDimensionsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12*\n" +
+	"\x06weight\x18\x02 \x01(\v2\x12.product.v1.WeightR\x06weight\x126\n" +
+	"\n" +
+	"dimensions\x18\x03 \x01(\v2\x16.product.v1.DimensionsR\n" +
+	"dimensions\"\x14\n" +
+	"\x12SetDimensionsReply\"\xa7\x01\n" +
+	"\x0eSetKindRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12*\n" +
+	"\x06weight\x18\x03 \x01(\v2\x12.product.v1.WeightR\x06weight\x126\n" +
+	"\n" +
+	"dimensions\x18\x04 \x01(\v2\x16.product.v1.DimensionsR\n" +
+	"dimensions\"\x0e\n" +
+	"\fSetKindReply\"m\n" +
+	"\x14SetComplianceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x126\n" +
+	"\n" +
+	"compliance\x18\x02 \x01(\v2\x16.product.v1.ComplianceR\n" +
+	"compliance\"\x14\n" +
+	"\x12SetComplianceReply\"\xd0\x01\n" +
+	"\x1dSetChannelAvailabilityRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12S\n" +
+	"\bchannels\x18\x02 \x03(\v27.product.v1.SetChannelAvailabilityRequest.ChannelsEntryR\bchannels\x1a;\n" +
+	"\rChannelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\x1d\n" +
+	"\x1bSetChannelAvailabilityReply\"i\n" +
+	"\x13IngestReviewRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\tR\breviewId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x03R\x06rating\"F\n" +
+	"\x11IngestReviewReply\x121\n" +
+	"\x06rating\x18\x01 \x01(\v2\x19.product.v1.RatingSummaryR\x06rating\"\x84\x01\n" +
+	"\x15SetTranslationRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"\x15\n" +
+	"\x13SetTranslationReply\"c\n" +
+	"\x10SetImagesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x120\n" +
+	"\x06images\x18\x02 \x03(\v2\x18.product.v1.ProductImageR\x06images\"\x10\n" +
+	"\x0eSetImagesReply\"I\n" +
+	"\x14ReorderImagesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04urls\x18\x02 \x03(\tR\x04urls\"\x14\n" +
+	"\x12ReorderImagesReply\"z\n" +
+	"\x18ManageAttachmentsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12?\n" +
+	"\vattachments\x18\x02 \x03(\v2\x1d.product.v1.ProductAttachmentR\vattachments\"\x18\n" +
+	"\x16ManageAttachmentsReply\"v\n" +
+	"\x13LinkProductsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12,\n" +
+	"\x12related_product_id\x18\x02 \x01(\tR\x10relatedProductId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"\x13\n" +
+	"\x11LinkProductsReply\"x\n" +
+	"\x15UnlinkProductsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12,\n" +
+	"\x12related_product_id\x18\x02 \x01(\tR\x10relatedProductId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"\x15\n" +
+	"\x13UnlinkProductsReply\"y\n" +
+	"\x1fSubscribeToNotificationsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12#\n" +
+	"\rsubscriber_id\x18\x02 \x01(\tR\fsubscriberId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\"\x1f\n" +
+	"\x1dSubscribeToNotificationsReply\"}\n" +
+	"#UnsubscribeFromNotificationsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12#\n" +
+	"\rsubscriber_id\x18\x02 \x01(\tR\fsubscriberId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\"#\n" +
+	"!UnsubscribeFromNotificationsReply\"\xa7\x02\n" +
+	"\x15CreateCampaignRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\tR\n" +
+	"productIds\x12/\n" +
+	"\x13discount_percentage\x18\x04 \x01(\x01R\x12discountPercentage\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\x05R\bpriority\"6\n" +
+	"\x13CreateCampaignReply\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\":\n" +
+	"\x17ActivateCampaignRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\"G\n" +
+	"\x0eSkippedProduct\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"r\n" +
+	"\x15ActivateCampaignReply\x12#\n" +
+	"\rapplied_count\x18\x01 \x01(\x05R\fappliedCount\x124\n" +
+	"\askipped\x18\x02 \x03(\v2\x1a.product.v1.SkippedProductR\askipped\"5\n" +
+	"\x12EndCampaignRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\"7\n" +
+	"\x10EndCampaignReply\x12#\n" +
+	"\rremoved_count\x18\x01 \x01(\x05R\fremovedCount\"\xc0\x01\n" +
+	"\x16CreatePriceListRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x129\n" +
+	"\n" +
+	"valid_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x12;\n" +
+	"\vvalid_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\":\n" +
+	"\x14CreatePriceListReply\x12\"\n" +
+	"\rprice_list_id\x18\x01 \x01(\tR\vpriceListId\"X\n" +
+	"\x0ePriceListEntry\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12'\n" +
+	"\x05price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\x05price\"v\n" +
+	"\x1aSetPriceListEntriesRequest\x12\"\n" +
+	"\rprice_list_id\x18\x01 \x01(\tR\vpriceListId\x124\n" +
+	"\aentries\x18\x02 \x03(\v2\x1a.product.v1.PriceListEntryR\aentries\"\x1a\n" +
+	"\x18SetPriceListEntriesReply\"\x15\n" +
+	"\x13CreateAPIKeyRequest\"B\n" +
+	"\x11CreateAPIKeyReply\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\",\n" +
+	"\x13RotateAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"\x95\x01\n" +
+	"\x11RotateAPIKeyReply\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12Q\n" +
+	"\x17previous_key_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x14previousKeyExpiresAt\",\n" +
+	"\x13RevokeAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"\x13\n" +
+	"\x11RevokeAPIKeyReply\"\xb5\x02\n" +
 	"\x11GetProductRequest\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\tR\tproductId\"@\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12=\n" +
+	"\n" +
+	"experiment\x18\x03 \x01(\v2\x1d.product.v1.ExperimentContextR\n" +
+	"experiment\x12\x18\n" +
+	"\asegment\x18\x04 \x01(\tR\asegment\x12\x16\n" +
+	"\x06market\x18\x05 \x01(\tR\x06market\x125\n" +
+	"\bprice_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\apriceAt\x12\x16\n" +
+	"\x06locale\x18\a \x01(\tR\x06locale\x12%\n" +
+	"\x0eread_staleness\x18\b \x01(\tR\rreadStaleness\"\x8f\x01\n" +
 	"\x0fGetProductReply\x12-\n" +
-	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\"\xa6\x01\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\x12\x14\n" +
+	"\x05stale\x18\x02 \x01(\bR\x05stale\x127\n" +
+	"\tcached_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bcachedAt\"\x89\x02\n" +
+	"\x17GetProductBySlugRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12=\n" +
+	"\n" +
+	"experiment\x18\x03 \x01(\v2\x1d.product.v1.ExperimentContextR\n" +
+	"experiment\x12\x18\n" +
+	"\asegment\x18\x04 \x01(\tR\asegment\x12\x16\n" +
+	"\x06market\x18\x05 \x01(\tR\x06market\x125\n" +
+	"\bprice_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\apriceAt\x12\x16\n" +
+	"\x06locale\x18\a \x01(\tR\x06locale\"\x95\x01\n" +
+	"\x15GetProductBySlugReply\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\x12\x14\n" +
+	"\x05stale\x18\x02 \x01(\bR\x05stale\x127\n" +
+	"\tcached_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bcachedAt\"\x86\x02\n" +
+	"\x16GetProductBySKURequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12=\n" +
+	"\n" +
+	"experiment\x18\x03 \x01(\v2\x1d.product.v1.ExperimentContextR\n" +
+	"experiment\x12\x18\n" +
+	"\asegment\x18\x04 \x01(\tR\asegment\x12\x16\n" +
+	"\x06market\x18\x05 \x01(\tR\x06market\x125\n" +
+	"\bprice_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\apriceAt\x12\x16\n" +
+	"\x06locale\x18\a \x01(\tR\x06locale\"\x94\x01\n" +
+	"\x14GetProductBySKUReply\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.product.v1.ProductR\aproduct\x12\x14\n" +
+	"\x05stale\x18\x02 \x01(\bR\x05stale\x127\n" +
+	"\tcached_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bcachedAt\"\x96\x02\n" +
+	"\x17BatchGetProductsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12=\n" +
+	"\n" +
+	"experiment\x18\x03 \x01(\v2\x1d.product.v1.ExperimentContextR\n" +
+	"experiment\x12\x18\n" +
+	"\asegment\x18\x04 \x01(\tR\asegment\x12\x16\n" +
+	"\x06market\x18\x05 \x01(\tR\x06market\x125\n" +
+	"\bprice_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\apriceAt\x12\x16\n" +
+	"\x06locale\x18\a \x01(\tR\x06locale\"x\n" +
+	"\x15BatchGetProductsReply\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.product.v1.ProductR\bproducts\x12.\n" +
+	"\x13missing_product_ids\x18\x02 \x03(\tR\x11missingProductIds\"\xbe\x05\n" +
 	"\x13ListProductsRequest\x12\x1a\n" +
 	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
@@ -1348,23 +11894,347 @@ const file_proto_product_v1_product_service_proto_rawDesc = "" +
 	"activeOnly\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x05 \x01(\tR\tpageToken\"\x94\x01\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x19\n" +
+	"\border_by\x18\a \x01(\tR\aorderBy\x12\x16\n" +
+	"\x06market\x18\b \x01(\tR\x06market\x125\n" +
+	"\bprice_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\apriceAt\x12\x19\n" +
+	"\bin_stock\x18\n" +
+	" \x01(\bR\ainStock\x12\x10\n" +
+	"\x03tag\x18\v \x01(\tR\x03tag\x12\x16\n" +
+	"\x06locale\x18\f \x01(\tR\x06locale\x12\x18\n" +
+	"\achannel\x18\r \x01(\tR\achannel\x12.\n" +
+	"\tmin_price\x18\x0e \x01(\v2\x11.product.v1.MoneyR\bminPrice\x12.\n" +
+	"\tmax_price\x18\x0f \x01(\v2\x11.product.v1.MoneyR\bmaxPrice\x12.\n" +
+	"\x13has_active_discount\x18\x10 \x01(\bR\x11hasActiveDiscount\x12.\n" +
+	"\x13include_total_count\x18\x11 \x01(\bR\x11includeTotalCount\x12\x1f\n" +
+	"\vpage_number\x18\x12 \x01(\x05R\n" +
+	"pageNumber\x12)\n" +
+	"\x10include_archived\x18\x13 \x01(\bR\x0fincludeArchived\x12%\n" +
+	"\x0eread_staleness\x18\x14 \x01(\tR\rreadStaleness\"\x99\x04\n" +
+	"\x16ListAllProductsRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
+	"\vactive_only\x18\x03 \x01(\bR\n" +
+	"activeOnly\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06market\x18\x05 \x01(\tR\x06market\x125\n" +
+	"\bprice_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\apriceAt\x12\x19\n" +
+	"\bin_stock\x18\a \x01(\bR\ainStock\x12\x10\n" +
+	"\x03tag\x18\b \x01(\tR\x03tag\x12\x16\n" +
+	"\x06locale\x18\t \x01(\tR\x06locale\x12\x18\n" +
+	"\achannel\x18\n" +
+	" \x01(\tR\achannel\x12.\n" +
+	"\tmin_price\x18\v \x01(\v2\x11.product.v1.MoneyR\bminPrice\x12.\n" +
+	"\tmax_price\x18\f \x01(\v2\x11.product.v1.MoneyR\bmaxPrice\x12.\n" +
+	"\x13has_active_discount\x18\r \x01(\bR\x11hasActiveDiscount\x12)\n" +
+	"\x10include_archived\x18\x0e \x01(\bR\x0fincludeArchived\x12%\n" +
+	"\x0eread_staleness\x18\x0f \x01(\tR\rreadStaleness\"\xe3\x01\n" +
 	"\x11ListProductsReply\x126\n" +
 	"\bproducts\x18\x01 \x03(\v2\x1a.product.v1.ProductSummaryR\bproducts\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
 	"\vtotal_count\x18\x03 \x01(\x03R\n" +
-	"totalCount2\x87\x06\n" +
+	"totalCount\x12\x14\n" +
+	"\x05stale\x18\x04 \x01(\bR\x05stale\x127\n" +
+	"\tcached_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bcachedAt\"\xea\x01\n" +
+	"\x15SearchProductsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1f\n" +
+	"\vactive_only\x18\x03 \x01(\bR\n" +
+	"activeOnly\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06locale\x18\x06 \x01(\tR\x06locale\x12\x10\n" +
+	"\x03tag\x18\a \x01(\tR\x03tag\x12\x18\n" +
+	"\achannel\x18\b \x01(\tR\achannel\"\xc7\x01\n" +
+	"\x13SearchProductsReply\x126\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1a.product.v1.ProductSummaryR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\x12/\n" +
+	"\x06facets\x18\x04 \x03(\v2\x17.product.v1.SearchFacetR\x06facets\"S\n" +
+	"\vSearchFacet\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12.\n" +
+	"\x06values\x18\x02 \x03(\v2\x16.product.v1.FacetValueR\x06values\"8\n" +
+	"\n" +
+	"FacetValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xc1\x01\n" +
+	"\x19GetEffectivePricesRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x18\n" +
+	"\asegment\x18\x03 \x01(\tR\asegment\x12=\n" +
+	"\n" +
+	"experiment\x18\x04 \x01(\v2\x1d.product.v1.ExperimentContextR\n" +
+	"experiment\"\xee\x02\n" +
+	"\x0eEffectivePrice\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x120\n" +
+	"\n" +
+	"base_price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x12:\n" +
+	"\x0feffective_price\x18\x03 \x01(\v2\x11.product.v1.MoneyR\x0eeffectivePrice\x12)\n" +
+	"\x10discount_percent\x18\x04 \x01(\x01R\x0fdiscountPercent\x12.\n" +
+	"\x13has_active_discount\x18\x05 \x01(\bR\x11hasActiveDiscount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12@\n" +
+	"\n" +
+	"experiment\x18\b \x01(\v2 .product.v1.ExperimentAssignmentR\n" +
+	"experiment\"\xf6\x01\n" +
+	"\x17GetEffectivePricesReply\x122\n" +
+	"\x06prices\x18\x01 \x03(\v2\x1a.product.v1.EffectivePriceR\x06prices\x12.\n" +
+	"\x13missing_product_ids\x18\x02 \x03(\tR\x11missingProductIds\x12(\n" +
+	"\x10price_lock_token\x18\x03 \x01(\tR\x0epriceLockToken\x12M\n" +
+	"\x15price_lock_expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x12priceLockExpiresAt\"\x83\x01\n" +
+	"\x1aGetPriceForQuantityRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\xf8\x02\n" +
+	"\x18GetPriceForQuantityReply\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x120\n" +
+	"\n" +
+	"base_price\x18\x03 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x120\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\tunitPrice\x122\n" +
+	"\vtotal_price\x18\x05 \x01(\v2\x11.product.v1.MoneyR\n" +
+	"totalPrice\x12*\n" +
+	"\x11tier_min_quantity\x18\x06 \x01(\x03R\x0ftierMinQuantity\x12)\n" +
+	"\x10discount_percent\x18\a \x01(\x01R\x0fdiscountPercent\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\"\x80\x01\n" +
+	"\x1bGetTaxInclusivePriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\xcb\x02\n" +
+	"\x19GetTaxInclusivePriceReply\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\ttax_class\x18\x02 \x01(\tR\btaxClass\x12(\n" +
+	"\x10tax_rate_percent\x18\x03 \x01(\x01R\x0etaxRatePercent\x12.\n" +
+	"\tnet_price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\bnetPrice\x120\n" +
+	"\n" +
+	"tax_amount\x18\x05 \x01(\v2\x11.product.v1.MoneyR\ttaxAmount\x122\n" +
+	"\vgross_price\x18\x06 \x01(\v2\x11.product.v1.MoneyR\n" +
+	"grossPrice\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"]\n" +
+	"\x10GetMarginRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\xc0\x02\n" +
+	"\x0eGetMarginReply\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12'\n" +
+	"\x05price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\x05price\x120\n" +
+	"\n" +
+	"cost_price\x18\x03 \x01(\v2\x11.product.v1.MoneyR\tcostPrice\x12)\n" +
+	"\x06margin\x18\x04 \x01(\v2\x11.product.v1.MoneyR\x06margin\x12%\n" +
+	"\x0emargin_percent\x18\x05 \x01(\x01R\rmarginPercent\x12.\n" +
+	"\x13has_active_discount\x18\x06 \x01(\bR\x11hasActiveDiscount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"\xd5\x02\n" +
+	"\x15CalculatePriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x120\n" +
+	"\n" +
+	"base_price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12%\n" +
+	"\x0ecoupon_percent\x18\x04 \x01(\x01R\rcouponPercent\x12\x18\n" +
+	"\asegment\x18\x05 \x01(\tR\asegment\x12*\n" +
+	"\x02at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12.\n" +
+	"\x13suggest_charm_price\x18\a \x01(\bR\x11suggestCharmPrice\x122\n" +
+	"\x15target_margin_percent\x18\b \x01(\x01R\x13targetMarginPercent\"\xd1\x04\n" +
+	"\x13CalculatePriceReply\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x120\n" +
+	"\n" +
+	"base_price\x18\x03 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x120\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\tunitPrice\x122\n" +
+	"\vtotal_price\x18\x05 \x01(\v2\x11.product.v1.MoneyR\n" +
+	"totalPrice\x12:\n" +
+	"\x0fdiscount_amount\x18\x06 \x01(\v2\x11.product.v1.MoneyR\x0ediscountAmount\x12+\n" +
+	"\asavings\x18\a \x01(\v2\x11.product.v1.MoneyR\asavings\x12*\n" +
+	"\x11tier_min_quantity\x18\b \x01(\x03R\x0ftierMinQuantity\x12)\n" +
+	"\x10discount_percent\x18\t \x01(\x01R\x0fdiscountPercent\x12%\n" +
+	"\x0ecoupon_percent\x18\n" +
+	" \x01(\x01R\rcouponPercent\x12\x18\n" +
+	"\asegment\x18\v \x01(\tR\asegment\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\r \x01(\tR\x06status\x122\n" +
+	"\vcharm_price\x18\x0e \x01(\v2\x11.product.v1.MoneyR\n" +
+	"charmPrice\"\x89\x01\n" +
+	"\x18GetPriceListPriceRequest\x12\"\n" +
+	"\rprice_list_id\x18\x01 \x01(\tR\vpriceListId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\xb4\x01\n" +
+	"\x16GetPriceListPriceReply\x12\"\n" +
+	"\rprice_list_id\x18\x01 \x01(\tR\vpriceListId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12'\n" +
+	"\x05price\x18\x03 \x01(\v2\x11.product.v1.MoneyR\x05price\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"\x93\x01\n" +
+	"\x16GetPriceHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xc6\x02\n" +
+	"\x11PriceHistoryEntry\x129\n" +
+	"\n" +
+	"changed_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12\x1b\n" +
+	"\tchange_id\x18\x02 \x01(\tR\bchangeId\x12\x1f\n" +
+	"\vchange_type\x18\x03 \x01(\tR\n" +
+	"changeType\x120\n" +
+	"\n" +
+	"base_price\x18\x04 \x01(\v2\x11.product.v1.MoneyR\tbasePrice\x12:\n" +
+	"\x0feffective_price\x18\x05 \x01(\v2\x11.product.v1.MoneyR\x0eeffectivePrice\x12\x1f\n" +
+	"\vdiscount_id\x18\x06 \x01(\tR\n" +
+	"discountId\x12)\n" +
+	"\x10discount_percent\x18\a \x01(\x01R\x0fdiscountPercent\"n\n" +
+	"\x14GetPriceHistoryReply\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x127\n" +
+	"\aentries\x18\x02 \x03(\v2\x1d.product.v1.PriceHistoryEntryR\aentries\"\x97\x01\n" +
+	"\x1aGetProductRevisionsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"Q\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\xc7\x01\n" +
+	"\x0fProductRevision\x12\x1f\n" +
+	"\vrevision_id\x18\x01 \x01(\tR\n" +
+	"revisionId\x129\n" +
+	"\n" +
+	"revised_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\trevisedAt\x12%\n" +
+	"\x0echanged_fields\x18\x03 \x03(\tR\rchangedFields\x121\n" +
+	"\achanges\x18\x04 \x03(\v2\x17.product.v1.FieldChangeR\achanges\"t\n" +
+	"\x18GetProductRevisionsReply\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x129\n" +
+	"\trevisions\x18\x02 \x03(\v2\x1b.product.v1.ProductRevisionR\trevisions\"]\n" +
+	"\x1bGetProductAtRevisionRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\tR\n" +
+	"revisionId\"\xb2\x01\n" +
+	"\x19GetProductAtRevisionReply\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\tR\n" +
+	"revisionId\x129\n" +
+	"\n" +
+	"revised_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\trevisedAt\x12\x1a\n" +
+	"\bsnapshot\x18\x04 \x01(\tR\bsnapshot\"N\n" +
+	"\x19GetRelatedProductsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"Z\n" +
+	"\x0eRelatedProduct\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x124\n" +
+	"\aproduct\x18\x02 \x01(\v2\x1a.product.v1.ProductSummaryR\aproduct\"p\n" +
+	"\x17GetRelatedProductsReply\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x126\n" +
+	"\bproducts\x18\x02 \x03(\v2\x1a.product.v1.RelatedProductR\bproducts\"B\n" +
+	"\x16VerifyPriceLockRequest\x12(\n" +
+	"\x10price_lock_token\x18\x01 \x01(\tR\x0epriceLockToken\"\x84\x01\n" +
+	"\vLockedPrice\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12:\n" +
+	"\x0feffective_price\x18\x02 \x01(\v2\x11.product.v1.MoneyR\x0eeffectivePrice\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\xbb\x01\n" +
+	"\x14VerifyPriceLockReply\x12/\n" +
+	"\x06prices\x18\x01 \x03(\v2\x17.product.v1.LockedPriceR\x06prices\x127\n" +
+	"\tissued_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt2\xef1\n" +
 	"\x0eProductService\x12Q\n" +
-	"\rCreateProduct\x12 .product.v1.CreateProductRequest\x1a\x1e.product.v1.CreateProductReply\x12Q\n" +
+	"\rCreateProduct\x12 .product.v1.CreateProductRequest\x1a\x1e.product.v1.CreateProductReply\x12N\n" +
+	"\fCloneProduct\x12\x1f.product.v1.CloneProductRequest\x1a\x1d.product.v1.CloneProductReply\x12Q\n" +
 	"\rUpdateProduct\x12 .product.v1.UpdateProductRequest\x1a\x1e.product.v1.UpdateProductReply\x12W\n" +
+	"\x0fChangeBasePrice\x12\".product.v1.ChangeBasePriceRequest\x1a .product.v1.ChangeBasePriceReply\x12c\n" +
+	"\x13SchedulePriceChange\x12&.product.v1.SchedulePriceChangeRequest\x1a$.product.v1.SchedulePriceChangeReply\x12]\n" +
+	"\x11CancelPriceChange\x12$.product.v1.CancelPriceChangeRequest\x1a\".product.v1.CancelPriceChangeReply\x12W\n" +
 	"\x0fActivateProduct\x12\".product.v1.ActivateProductRequest\x1a .product.v1.ActivateProductReply\x12]\n" +
-	"\x11DeactivateProduct\x12$.product.v1.DeactivateProductRequest\x1a\".product.v1.DeactivateProductReply\x12T\n" +
+	"\x11DeactivateProduct\x12$.product.v1.DeactivateProductRequest\x1a\".product.v1.DeactivateProductReply\x12`\n" +
+	"\x12ScheduleActivation\x12%.product.v1.ScheduleActivationRequest\x1a#.product.v1.ScheduleActivationReply\x12W\n" +
+	"\x0fSubmitForReview\x12\".product.v1.SubmitForReviewRequest\x1a .product.v1.SubmitForReviewReply\x12T\n" +
+	"\x0eApproveProduct\x12!.product.v1.ApproveProductRequest\x1a\x1f.product.v1.ApproveProductReply\x12Q\n" +
+	"\rRejectProduct\x12 .product.v1.RejectProductRequest\x1a\x1e.product.v1.RejectProductReply\x12T\n" +
 	"\x0eArchiveProduct\x12!.product.v1.ArchiveProductRequest\x1a\x1f.product.v1.ArchiveProductReply\x12Q\n" +
 	"\rApplyDiscount\x12 .product.v1.ApplyDiscountRequest\x1a\x1e.product.v1.ApplyDiscountReply\x12T\n" +
-	"\x0eRemoveDiscount\x12!.product.v1.RemoveDiscountRequest\x1a\x1f.product.v1.RemoveDiscountReply\x12H\n" +
+	"\x0eRemoveDiscount\x12!.product.v1.RemoveDiscountRequest\x1a\x1f.product.v1.RemoveDiscountReply\x12W\n" +
+	"\x0fApproveDiscount\x12\".product.v1.ApproveDiscountRequest\x1a .product.v1.ApproveDiscountReply\x12o\n" +
+	"\x17ApplyDiscountToCategory\x12*.product.v1.ApplyDiscountToCategoryRequest\x1a(.product.v1.ApplyDiscountToCategoryReply\x12Q\n" +
+	"\rSetPriceTiers\x12 .product.v1.SetPriceTiersRequest\x1a\x1e.product.v1.SetPriceTiersReply\x12N\n" +
+	"\fSetPriceBook\x12\x1f.product.v1.SetPriceBookRequest\x1a\x1d.product.v1.SetPriceBookReply\x12Z\n" +
+	"\x10SetSegmentPrices\x12#.product.v1.SetSegmentPricesRequest\x1a!.product.v1.SetSegmentPricesReply\x12W\n" +
+	"\x0fSetMarketPrices\x12\".product.v1.SetMarketPricesRequest\x1a .product.v1.SetMarketPricesReply\x12K\n" +
+	"\vSetTaxClass\x12\x1e.product.v1.SetTaxClassRequest\x1a\x1c.product.v1.SetTaxClassReply\x12W\n" +
+	"\x0fSetMinimumPrice\x12\".product.v1.SetMinimumPriceRequest\x1a .product.v1.SetMinimumPriceReply\x12N\n" +
+	"\fSetCostPrice\x12\x1f.product.v1.SetCostPriceRequest\x1a\x1d.product.v1.SetCostPriceReply\x12H\n" +
+	"\n" +
+	"AddVariant\x12\x1d.product.v1.AddVariantRequest\x1a\x1b.product.v1.AddVariantReply\x12Q\n" +
+	"\rUpdateVariant\x12 .product.v1.UpdateVariantRequest\x1a\x1e.product.v1.UpdateVariantReply\x12`\n" +
+	"\x12DiscontinueVariant\x12%.product.v1.DiscontinueVariantRequest\x1a#.product.v1.DiscontinueVariantReply\x12B\n" +
+	"\bSetStock\x12\x1b.product.v1.SetStockRequest\x1a\x19.product.v1.SetStockReply\x12K\n" +
+	"\vAdjustStock\x12\x1e.product.v1.AdjustStockRequest\x1a\x1c.product.v1.AdjustStockReply\x12<\n" +
+	"\x06AddTag\x12\x19.product.v1.AddTagRequest\x1a\x17.product.v1.AddTagReply\x12E\n" +
+	"\tRemoveTag\x12\x1c.product.v1.RemoveTagRequest\x1a\x1a.product.v1.RemoveTagReply\x12N\n" +
+	"\fSetAttribute\x12\x1f.product.v1.SetAttributeRequest\x1a\x1d.product.v1.SetAttributeReply\x12?\n" +
+	"\aSetSlug\x12\x1a.product.v1.SetSlugRequest\x1a\x18.product.v1.SetSlugReply\x12T\n" +
+	"\x0eSetIdentifiers\x12!.product.v1.SetIdentifiersRequest\x1a\x1f.product.v1.SetIdentifiersReply\x12Q\n" +
+	"\rSetDimensions\x12 .product.v1.SetDimensionsRequest\x1a\x1e.product.v1.SetDimensionsReply\x12?\n" +
+	"\aSetKind\x12\x1a.product.v1.SetKindRequest\x1a\x18.product.v1.SetKindReply\x12Q\n" +
+	"\rSetCompliance\x12 .product.v1.SetComplianceRequest\x1a\x1e.product.v1.SetComplianceReply\x12l\n" +
+	"\x16SetChannelAvailability\x12).product.v1.SetChannelAvailabilityRequest\x1a'.product.v1.SetChannelAvailabilityReply\x12N\n" +
+	"\fIngestReview\x12\x1f.product.v1.IngestReviewRequest\x1a\x1d.product.v1.IngestReviewReply\x12T\n" +
+	"\x0eSetTranslation\x12!.product.v1.SetTranslationRequest\x1a\x1f.product.v1.SetTranslationReply\x12E\n" +
+	"\tSetImages\x12\x1c.product.v1.SetImagesRequest\x1a\x1a.product.v1.SetImagesReply\x12Q\n" +
+	"\rReorderImages\x12 .product.v1.ReorderImagesRequest\x1a\x1e.product.v1.ReorderImagesReply\x12]\n" +
+	"\x11ManageAttachments\x12$.product.v1.ManageAttachmentsRequest\x1a\".product.v1.ManageAttachmentsReply\x12N\n" +
+	"\fLinkProducts\x12\x1f.product.v1.LinkProductsRequest\x1a\x1d.product.v1.LinkProductsReply\x12T\n" +
+	"\x0eUnlinkProducts\x12!.product.v1.UnlinkProductsRequest\x1a\x1f.product.v1.UnlinkProductsReply\x12r\n" +
+	"\x18SubscribeToNotifications\x12+.product.v1.SubscribeToNotificationsRequest\x1a).product.v1.SubscribeToNotificationsReply\x12~\n" +
+	"\x1cUnsubscribeFromNotifications\x12/.product.v1.UnsubscribeFromNotificationsRequest\x1a-.product.v1.UnsubscribeFromNotificationsReply\x12T\n" +
+	"\x0eCreateCampaign\x12!.product.v1.CreateCampaignRequest\x1a\x1f.product.v1.CreateCampaignReply\x12Z\n" +
+	"\x10ActivateCampaign\x12#.product.v1.ActivateCampaignRequest\x1a!.product.v1.ActivateCampaignReply\x12K\n" +
+	"\vEndCampaign\x12\x1e.product.v1.EndCampaignRequest\x1a\x1c.product.v1.EndCampaignReply\x12W\n" +
+	"\x0fCreatePriceList\x12\".product.v1.CreatePriceListRequest\x1a .product.v1.CreatePriceListReply\x12c\n" +
+	"\x13SetPriceListEntries\x12&.product.v1.SetPriceListEntriesRequest\x1a$.product.v1.SetPriceListEntriesReply\x12N\n" +
+	"\fCreateAPIKey\x12\x1f.product.v1.CreateAPIKeyRequest\x1a\x1d.product.v1.CreateAPIKeyReply\x12N\n" +
+	"\fRotateAPIKey\x12\x1f.product.v1.RotateAPIKeyRequest\x1a\x1d.product.v1.RotateAPIKeyReply\x12N\n" +
+	"\fRevokeAPIKey\x12\x1f.product.v1.RevokeAPIKeyRequest\x1a\x1d.product.v1.RevokeAPIKeyReply\x12H\n" +
 	"\n" +
-	"GetProduct\x12\x1d.product.v1.GetProductRequest\x1a\x1b.product.v1.GetProductReply\x12N\n" +
-	"\fListProducts\x12\x1f.product.v1.ListProductsRequest\x1a\x1d.product.v1.ListProductsReplyB?Z=github.com/product-catalog-service/proto/product/v1;productv1b\x06proto3"
+	"GetProduct\x12\x1d.product.v1.GetProductRequest\x1a\x1b.product.v1.GetProductReply\x12Z\n" +
+	"\x10GetProductBySlug\x12#.product.v1.GetProductBySlugRequest\x1a!.product.v1.GetProductBySlugReply\x12W\n" +
+	"\x0fGetProductBySKU\x12\".product.v1.GetProductBySKURequest\x1a .product.v1.GetProductBySKUReply\x12Z\n" +
+	"\x10BatchGetProducts\x12#.product.v1.BatchGetProductsRequest\x1a!.product.v1.BatchGetProductsReply\x12N\n" +
+	"\fListProducts\x12\x1f.product.v1.ListProductsRequest\x1a\x1d.product.v1.ListProductsReply\x12S\n" +
+	"\x0fListAllProducts\x12\".product.v1.ListAllProductsRequest\x1a\x1a.product.v1.ProductSummary0\x01\x12T\n" +
+	"\x0eSearchProducts\x12!.product.v1.SearchProductsRequest\x1a\x1f.product.v1.SearchProductsReply\x12`\n" +
+	"\x12GetEffectivePrices\x12%.product.v1.GetEffectivePricesRequest\x1a#.product.v1.GetEffectivePricesReply\x12c\n" +
+	"\x13GetPriceForQuantity\x12&.product.v1.GetPriceForQuantityRequest\x1a$.product.v1.GetPriceForQuantityReply\x12f\n" +
+	"\x14GetTaxInclusivePrice\x12'.product.v1.GetTaxInclusivePriceRequest\x1a%.product.v1.GetTaxInclusivePriceReply\x12E\n" +
+	"\tGetMargin\x12\x1c.product.v1.GetMarginRequest\x1a\x1a.product.v1.GetMarginReply\x12T\n" +
+	"\x0eCalculatePrice\x12!.product.v1.CalculatePriceRequest\x1a\x1f.product.v1.CalculatePriceReply\x12]\n" +
+	"\x11GetPriceListPrice\x12$.product.v1.GetPriceListPriceRequest\x1a\".product.v1.GetPriceListPriceReply\x12W\n" +
+	"\x0fGetPriceHistory\x12\".product.v1.GetPriceHistoryRequest\x1a .product.v1.GetPriceHistoryReply\x12c\n" +
+	"\x13GetProductRevisions\x12&.product.v1.GetProductRevisionsRequest\x1a$.product.v1.GetProductRevisionsReply\x12f\n" +
+	"\x14GetProductAtRevision\x12'.product.v1.GetProductAtRevisionRequest\x1a%.product.v1.GetProductAtRevisionReply\x12`\n" +
+	"\x12GetRelatedProducts\x12%.product.v1.GetRelatedProductsRequest\x1a#.product.v1.GetRelatedProductsReply\x12W\n" +
+	"\x0fVerifyPriceLock\x12\".product.v1.VerifyPriceLockRequest\x1a .product.v1.VerifyPriceLockReplyB?Z=github.com/product-catalog-service/proto/product/v1;productv1b\x06proto3"
 
 var (
 	file_proto_product_v1_product_service_proto_rawDescOnce sync.Once
@@ -1378,71 +12248,508 @@ func file_proto_product_v1_product_service_proto_rawDescGZIP() []byte {
 	return file_proto_product_v1_product_service_proto_rawDescData
 }
 
-var file_proto_product_v1_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_proto_product_v1_product_service_proto_msgTypes = make([]protoimpl.MessageInfo, 182)
 var file_proto_product_v1_product_service_proto_goTypes = []any{
-	(*Money)(nil),                    // 0: product.v1.Money
-	(*Discount)(nil),                 // 1: product.v1.Discount
-	(*Product)(nil),                  // 2: product.v1.Product
-	(*ProductSummary)(nil),           // 3: product.v1.ProductSummary
-	(*CreateProductRequest)(nil),     // 4: product.v1.CreateProductRequest
-	(*CreateProductReply)(nil),       // 5: product.v1.CreateProductReply
-	(*UpdateProductRequest)(nil),     // 6: product.v1.UpdateProductRequest
-	(*UpdateProductReply)(nil),       // 7: product.v1.UpdateProductReply
-	(*ActivateProductRequest)(nil),   // 8: product.v1.ActivateProductRequest
-	(*ActivateProductReply)(nil),     // 9: product.v1.ActivateProductReply
-	(*DeactivateProductRequest)(nil), // 10: product.v1.DeactivateProductRequest
-	(*DeactivateProductReply)(nil),   // 11: product.v1.DeactivateProductReply
-	(*ArchiveProductRequest)(nil),    // 12: product.v1.ArchiveProductRequest
-	(*ArchiveProductReply)(nil),      // 13: product.v1.ArchiveProductReply
-	(*ApplyDiscountRequest)(nil),     // 14: product.v1.ApplyDiscountRequest
-	(*ApplyDiscountReply)(nil),       // 15: product.v1.ApplyDiscountReply
-	(*RemoveDiscountRequest)(nil),    // 16: product.v1.RemoveDiscountRequest
-	(*RemoveDiscountReply)(nil),      // 17: product.v1.RemoveDiscountReply
-	(*GetProductRequest)(nil),        // 18: product.v1.GetProductRequest
-	(*GetProductReply)(nil),          // 19: product.v1.GetProductReply
-	(*ListProductsRequest)(nil),      // 20: product.v1.ListProductsRequest
-	(*ListProductsReply)(nil),        // 21: product.v1.ListProductsReply
-	(*timestamppb.Timestamp)(nil),    // 22: google.protobuf.Timestamp
+	(*Money)(nil),                               // 0: product.v1.Money
+	(*Discount)(nil),                            // 1: product.v1.Discount
+	(*BuyXGetY)(nil),                            // 2: product.v1.BuyXGetY
+	(*PriceTier)(nil),                           // 3: product.v1.PriceTier
+	(*SegmentPrice)(nil),                        // 4: product.v1.SegmentPrice
+	(*MarketPrice)(nil),                         // 5: product.v1.MarketPrice
+	(*ExperimentContext)(nil),                   // 6: product.v1.ExperimentContext
+	(*ExperimentAssignment)(nil),                // 7: product.v1.ExperimentAssignment
+	(*Product)(nil),                             // 8: product.v1.Product
+	(*ProductImage)(nil),                        // 9: product.v1.ProductImage
+	(*ProductAttachment)(nil),                   // 10: product.v1.ProductAttachment
+	(*Stock)(nil),                               // 11: product.v1.Stock
+	(*Variant)(nil),                             // 12: product.v1.Variant
+	(*ProductReview)(nil),                       // 13: product.v1.ProductReview
+	(*Compliance)(nil),                          // 14: product.v1.Compliance
+	(*Weight)(nil),                              // 15: product.v1.Weight
+	(*Dimensions)(nil),                          // 16: product.v1.Dimensions
+	(*RatingSummary)(nil),                       // 17: product.v1.RatingSummary
+	(*PendingPriceChange)(nil),                  // 18: product.v1.PendingPriceChange
+	(*ProductSummary)(nil),                      // 19: product.v1.ProductSummary
+	(*CreateProductRequest)(nil),                // 20: product.v1.CreateProductRequest
+	(*CreateProductReply)(nil),                  // 21: product.v1.CreateProductReply
+	(*CloneProductRequest)(nil),                 // 22: product.v1.CloneProductRequest
+	(*CloneProductReply)(nil),                   // 23: product.v1.CloneProductReply
+	(*UpdateProductRequest)(nil),                // 24: product.v1.UpdateProductRequest
+	(*UpdateProductReply)(nil),                  // 25: product.v1.UpdateProductReply
+	(*ChangeBasePriceRequest)(nil),              // 26: product.v1.ChangeBasePriceRequest
+	(*ChangeBasePriceReply)(nil),                // 27: product.v1.ChangeBasePriceReply
+	(*SchedulePriceChangeRequest)(nil),          // 28: product.v1.SchedulePriceChangeRequest
+	(*SchedulePriceChangeReply)(nil),            // 29: product.v1.SchedulePriceChangeReply
+	(*CancelPriceChangeRequest)(nil),            // 30: product.v1.CancelPriceChangeRequest
+	(*CancelPriceChangeReply)(nil),              // 31: product.v1.CancelPriceChangeReply
+	(*ActivateProductRequest)(nil),              // 32: product.v1.ActivateProductRequest
+	(*ActivateProductReply)(nil),                // 33: product.v1.ActivateProductReply
+	(*DeactivateProductRequest)(nil),            // 34: product.v1.DeactivateProductRequest
+	(*DeactivateProductReply)(nil),              // 35: product.v1.DeactivateProductReply
+	(*ScheduleActivationRequest)(nil),           // 36: product.v1.ScheduleActivationRequest
+	(*ScheduleActivationReply)(nil),             // 37: product.v1.ScheduleActivationReply
+	(*SubmitForReviewRequest)(nil),              // 38: product.v1.SubmitForReviewRequest
+	(*SubmitForReviewReply)(nil),                // 39: product.v1.SubmitForReviewReply
+	(*ApproveProductRequest)(nil),               // 40: product.v1.ApproveProductRequest
+	(*ApproveProductReply)(nil),                 // 41: product.v1.ApproveProductReply
+	(*RejectProductRequest)(nil),                // 42: product.v1.RejectProductRequest
+	(*RejectProductReply)(nil),                  // 43: product.v1.RejectProductReply
+	(*ArchiveProductRequest)(nil),               // 44: product.v1.ArchiveProductRequest
+	(*ArchiveProductReply)(nil),                 // 45: product.v1.ArchiveProductReply
+	(*ApplyDiscountRequest)(nil),                // 46: product.v1.ApplyDiscountRequest
+	(*ApplyDiscountReply)(nil),                  // 47: product.v1.ApplyDiscountReply
+	(*ApplyDiscountToCategoryRequest)(nil),      // 48: product.v1.ApplyDiscountToCategoryRequest
+	(*ApplyDiscountToCategoryReply)(nil),        // 49: product.v1.ApplyDiscountToCategoryReply
+	(*RemoveDiscountRequest)(nil),               // 50: product.v1.RemoveDiscountRequest
+	(*RemoveDiscountReply)(nil),                 // 51: product.v1.RemoveDiscountReply
+	(*ApproveDiscountRequest)(nil),              // 52: product.v1.ApproveDiscountRequest
+	(*ApproveDiscountReply)(nil),                // 53: product.v1.ApproveDiscountReply
+	(*SetPriceTiersRequest)(nil),                // 54: product.v1.SetPriceTiersRequest
+	(*SetPriceTiersReply)(nil),                  // 55: product.v1.SetPriceTiersReply
+	(*SetPriceBookRequest)(nil),                 // 56: product.v1.SetPriceBookRequest
+	(*SetPriceBookReply)(nil),                   // 57: product.v1.SetPriceBookReply
+	(*SetSegmentPricesRequest)(nil),             // 58: product.v1.SetSegmentPricesRequest
+	(*SetSegmentPricesReply)(nil),               // 59: product.v1.SetSegmentPricesReply
+	(*SetMarketPricesRequest)(nil),              // 60: product.v1.SetMarketPricesRequest
+	(*SetMarketPricesReply)(nil),                // 61: product.v1.SetMarketPricesReply
+	(*SetTaxClassRequest)(nil),                  // 62: product.v1.SetTaxClassRequest
+	(*SetTaxClassReply)(nil),                    // 63: product.v1.SetTaxClassReply
+	(*SetMinimumPriceRequest)(nil),              // 64: product.v1.SetMinimumPriceRequest
+	(*SetMinimumPriceReply)(nil),                // 65: product.v1.SetMinimumPriceReply
+	(*SetCostPriceRequest)(nil),                 // 66: product.v1.SetCostPriceRequest
+	(*SetCostPriceReply)(nil),                   // 67: product.v1.SetCostPriceReply
+	(*AddVariantRequest)(nil),                   // 68: product.v1.AddVariantRequest
+	(*AddVariantReply)(nil),                     // 69: product.v1.AddVariantReply
+	(*UpdateVariantRequest)(nil),                // 70: product.v1.UpdateVariantRequest
+	(*UpdateVariantReply)(nil),                  // 71: product.v1.UpdateVariantReply
+	(*DiscontinueVariantRequest)(nil),           // 72: product.v1.DiscontinueVariantRequest
+	(*DiscontinueVariantReply)(nil),             // 73: product.v1.DiscontinueVariantReply
+	(*SetStockRequest)(nil),                     // 74: product.v1.SetStockRequest
+	(*SetStockReply)(nil),                       // 75: product.v1.SetStockReply
+	(*AdjustStockRequest)(nil),                  // 76: product.v1.AdjustStockRequest
+	(*AdjustStockReply)(nil),                    // 77: product.v1.AdjustStockReply
+	(*AddTagRequest)(nil),                       // 78: product.v1.AddTagRequest
+	(*AddTagReply)(nil),                         // 79: product.v1.AddTagReply
+	(*RemoveTagRequest)(nil),                    // 80: product.v1.RemoveTagRequest
+	(*RemoveTagReply)(nil),                      // 81: product.v1.RemoveTagReply
+	(*SetAttributeRequest)(nil),                 // 82: product.v1.SetAttributeRequest
+	(*SetAttributeReply)(nil),                   // 83: product.v1.SetAttributeReply
+	(*SetSlugRequest)(nil),                      // 84: product.v1.SetSlugRequest
+	(*SetSlugReply)(nil),                        // 85: product.v1.SetSlugReply
+	(*SetIdentifiersRequest)(nil),               // 86: product.v1.SetIdentifiersRequest
+	(*SetIdentifiersReply)(nil),                 // 87: product.v1.SetIdentifiersReply
+	(*SetDimensionsRequest)(nil),                // 88: product.v1.SetDimensionsRequest
+	(*SetDimensionsReply)(nil),                  // 89: product.v1.SetDimensionsReply
+	(*SetKindRequest)(nil),                      // 90: product.v1.SetKindRequest
+	(*SetKindReply)(nil),                        // 91: product.v1.SetKindReply
+	(*SetComplianceRequest)(nil),                // 92: product.v1.SetComplianceRequest
+	(*SetComplianceReply)(nil),                  // 93: product.v1.SetComplianceReply
+	(*SetChannelAvailabilityRequest)(nil),       // 94: product.v1.SetChannelAvailabilityRequest
+	(*SetChannelAvailabilityReply)(nil),         // 95: product.v1.SetChannelAvailabilityReply
+	(*IngestReviewRequest)(nil),                 // 96: product.v1.IngestReviewRequest
+	(*IngestReviewReply)(nil),                   // 97: product.v1.IngestReviewReply
+	(*SetTranslationRequest)(nil),               // 98: product.v1.SetTranslationRequest
+	(*SetTranslationReply)(nil),                 // 99: product.v1.SetTranslationReply
+	(*SetImagesRequest)(nil),                    // 100: product.v1.SetImagesRequest
+	(*SetImagesReply)(nil),                      // 101: product.v1.SetImagesReply
+	(*ReorderImagesRequest)(nil),                // 102: product.v1.ReorderImagesRequest
+	(*ReorderImagesReply)(nil),                  // 103: product.v1.ReorderImagesReply
+	(*ManageAttachmentsRequest)(nil),            // 104: product.v1.ManageAttachmentsRequest
+	(*ManageAttachmentsReply)(nil),              // 105: product.v1.ManageAttachmentsReply
+	(*LinkProductsRequest)(nil),                 // 106: product.v1.LinkProductsRequest
+	(*LinkProductsReply)(nil),                   // 107: product.v1.LinkProductsReply
+	(*UnlinkProductsRequest)(nil),               // 108: product.v1.UnlinkProductsRequest
+	(*UnlinkProductsReply)(nil),                 // 109: product.v1.UnlinkProductsReply
+	(*SubscribeToNotificationsRequest)(nil),     // 110: product.v1.SubscribeToNotificationsRequest
+	(*SubscribeToNotificationsReply)(nil),       // 111: product.v1.SubscribeToNotificationsReply
+	(*UnsubscribeFromNotificationsRequest)(nil), // 112: product.v1.UnsubscribeFromNotificationsRequest
+	(*UnsubscribeFromNotificationsReply)(nil),   // 113: product.v1.UnsubscribeFromNotificationsReply
+	(*CreateCampaignRequest)(nil),               // 114: product.v1.CreateCampaignRequest
+	(*CreateCampaignReply)(nil),                 // 115: product.v1.CreateCampaignReply
+	(*ActivateCampaignRequest)(nil),             // 116: product.v1.ActivateCampaignRequest
+	(*SkippedProduct)(nil),                      // 117: product.v1.SkippedProduct
+	(*ActivateCampaignReply)(nil),               // 118: product.v1.ActivateCampaignReply
+	(*EndCampaignRequest)(nil),                  // 119: product.v1.EndCampaignRequest
+	(*EndCampaignReply)(nil),                    // 120: product.v1.EndCampaignReply
+	(*CreatePriceListRequest)(nil),              // 121: product.v1.CreatePriceListRequest
+	(*CreatePriceListReply)(nil),                // 122: product.v1.CreatePriceListReply
+	(*PriceListEntry)(nil),                      // 123: product.v1.PriceListEntry
+	(*SetPriceListEntriesRequest)(nil),          // 124: product.v1.SetPriceListEntriesRequest
+	(*SetPriceListEntriesReply)(nil),            // 125: product.v1.SetPriceListEntriesReply
+	(*CreateAPIKeyRequest)(nil),                 // 126: product.v1.CreateAPIKeyRequest
+	(*CreateAPIKeyReply)(nil),                   // 127: product.v1.CreateAPIKeyReply
+	(*RotateAPIKeyRequest)(nil),                 // 128: product.v1.RotateAPIKeyRequest
+	(*RotateAPIKeyReply)(nil),                   // 129: product.v1.RotateAPIKeyReply
+	(*RevokeAPIKeyRequest)(nil),                 // 130: product.v1.RevokeAPIKeyRequest
+	(*RevokeAPIKeyReply)(nil),                   // 131: product.v1.RevokeAPIKeyReply
+	(*GetProductRequest)(nil),                   // 132: product.v1.GetProductRequest
+	(*GetProductReply)(nil),                     // 133: product.v1.GetProductReply
+	(*GetProductBySlugRequest)(nil),             // 134: product.v1.GetProductBySlugRequest
+	(*GetProductBySlugReply)(nil),               // 135: product.v1.GetProductBySlugReply
+	(*GetProductBySKURequest)(nil),              // 136: product.v1.GetProductBySKURequest
+	(*GetProductBySKUReply)(nil),                // 137: product.v1.GetProductBySKUReply
+	(*BatchGetProductsRequest)(nil),             // 138: product.v1.BatchGetProductsRequest
+	(*BatchGetProductsReply)(nil),               // 139: product.v1.BatchGetProductsReply
+	(*ListProductsRequest)(nil),                 // 140: product.v1.ListProductsRequest
+	(*ListAllProductsRequest)(nil),              // 141: product.v1.ListAllProductsRequest
+	(*ListProductsReply)(nil),                   // 142: product.v1.ListProductsReply
+	(*SearchProductsRequest)(nil),               // 143: product.v1.SearchProductsRequest
+	(*SearchProductsReply)(nil),                 // 144: product.v1.SearchProductsReply
+	(*SearchFacet)(nil),                         // 145: product.v1.SearchFacet
+	(*FacetValue)(nil),                          // 146: product.v1.FacetValue
+	(*GetEffectivePricesRequest)(nil),           // 147: product.v1.GetEffectivePricesRequest
+	(*EffectivePrice)(nil),                      // 148: product.v1.EffectivePrice
+	(*GetEffectivePricesReply)(nil),             // 149: product.v1.GetEffectivePricesReply
+	(*GetPriceForQuantityRequest)(nil),          // 150: product.v1.GetPriceForQuantityRequest
+	(*GetPriceForQuantityReply)(nil),            // 151: product.v1.GetPriceForQuantityReply
+	(*GetTaxInclusivePriceRequest)(nil),         // 152: product.v1.GetTaxInclusivePriceRequest
+	(*GetTaxInclusivePriceReply)(nil),           // 153: product.v1.GetTaxInclusivePriceReply
+	(*GetMarginRequest)(nil),                    // 154: product.v1.GetMarginRequest
+	(*GetMarginReply)(nil),                      // 155: product.v1.GetMarginReply
+	(*CalculatePriceRequest)(nil),               // 156: product.v1.CalculatePriceRequest
+	(*CalculatePriceReply)(nil),                 // 157: product.v1.CalculatePriceReply
+	(*GetPriceListPriceRequest)(nil),            // 158: product.v1.GetPriceListPriceRequest
+	(*GetPriceListPriceReply)(nil),              // 159: product.v1.GetPriceListPriceReply
+	(*GetPriceHistoryRequest)(nil),              // 160: product.v1.GetPriceHistoryRequest
+	(*PriceHistoryEntry)(nil),                   // 161: product.v1.PriceHistoryEntry
+	(*GetPriceHistoryReply)(nil),                // 162: product.v1.GetPriceHistoryReply
+	(*GetProductRevisionsRequest)(nil),          // 163: product.v1.GetProductRevisionsRequest
+	(*FieldChange)(nil),                         // 164: product.v1.FieldChange
+	(*ProductRevision)(nil),                     // 165: product.v1.ProductRevision
+	(*GetProductRevisionsReply)(nil),            // 166: product.v1.GetProductRevisionsReply
+	(*GetProductAtRevisionRequest)(nil),         // 167: product.v1.GetProductAtRevisionRequest
+	(*GetProductAtRevisionReply)(nil),           // 168: product.v1.GetProductAtRevisionReply
+	(*GetRelatedProductsRequest)(nil),           // 169: product.v1.GetRelatedProductsRequest
+	(*RelatedProduct)(nil),                      // 170: product.v1.RelatedProduct
+	(*GetRelatedProductsReply)(nil),             // 171: product.v1.GetRelatedProductsReply
+	(*VerifyPriceLockRequest)(nil),              // 172: product.v1.VerifyPriceLockRequest
+	(*LockedPrice)(nil),                         // 173: product.v1.LockedPrice
+	(*VerifyPriceLockReply)(nil),                // 174: product.v1.VerifyPriceLockReply
+	nil,                                         // 175: product.v1.Product.AttributesEntry
+	nil,                                         // 176: product.v1.Variant.AttributesEntry
+	nil,                                         // 177: product.v1.CreateProductRequest.AttributesEntry
+	nil,                                         // 178: product.v1.UpdateProductRequest.AttributesEntry
+	nil,                                         // 179: product.v1.AddVariantRequest.AttributesEntry
+	nil,                                         // 180: product.v1.UpdateVariantRequest.AttributesEntry
+	nil,                                         // 181: product.v1.SetChannelAvailabilityRequest.ChannelsEntry
+	(*timestamppb.Timestamp)(nil),               // 182: google.protobuf.Timestamp
 }
 var file_proto_product_v1_product_service_proto_depIdxs = []int32{
-	22, // 0: product.v1.Discount.start_date:type_name -> google.protobuf.Timestamp
-	22, // 1: product.v1.Discount.end_date:type_name -> google.protobuf.Timestamp
-	0,  // 2: product.v1.Product.base_price:type_name -> product.v1.Money
-	0,  // 3: product.v1.Product.effective_price:type_name -> product.v1.Money
-	1,  // 4: product.v1.Product.discount:type_name -> product.v1.Discount
-	22, // 5: product.v1.Product.created_at:type_name -> google.protobuf.Timestamp
-	22, // 6: product.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 7: product.v1.ProductSummary.base_price:type_name -> product.v1.Money
-	0,  // 8: product.v1.ProductSummary.effective_price:type_name -> product.v1.Money
-	22, // 9: product.v1.ProductSummary.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 10: product.v1.CreateProductRequest.base_price:type_name -> product.v1.Money
-	22, // 11: product.v1.ApplyDiscountRequest.start_date:type_name -> google.protobuf.Timestamp
-	22, // 12: product.v1.ApplyDiscountRequest.end_date:type_name -> google.protobuf.Timestamp
-	2,  // 13: product.v1.GetProductReply.product:type_name -> product.v1.Product
-	3,  // 14: product.v1.ListProductsReply.products:type_name -> product.v1.ProductSummary
-	4,  // 15: product.v1.ProductService.CreateProduct:input_type -> product.v1.CreateProductRequest
-	6,  // 16: product.v1.ProductService.UpdateProduct:input_type -> product.v1.UpdateProductRequest
-	8,  // 17: product.v1.ProductService.ActivateProduct:input_type -> product.v1.ActivateProductRequest
-	10, // 18: product.v1.ProductService.DeactivateProduct:input_type -> product.v1.DeactivateProductRequest
-	12, // 19: product.v1.ProductService.ArchiveProduct:input_type -> product.v1.ArchiveProductRequest
-	14, // 20: product.v1.ProductService.ApplyDiscount:input_type -> product.v1.ApplyDiscountRequest
-	16, // 21: product.v1.ProductService.RemoveDiscount:input_type -> product.v1.RemoveDiscountRequest
-	18, // 22: product.v1.ProductService.GetProduct:input_type -> product.v1.GetProductRequest
-	20, // 23: product.v1.ProductService.ListProducts:input_type -> product.v1.ListProductsRequest
-	5,  // 24: product.v1.ProductService.CreateProduct:output_type -> product.v1.CreateProductReply
-	7,  // 25: product.v1.ProductService.UpdateProduct:output_type -> product.v1.UpdateProductReply
-	9,  // 26: product.v1.ProductService.ActivateProduct:output_type -> product.v1.ActivateProductReply
-	11, // 27: product.v1.ProductService.DeactivateProduct:output_type -> product.v1.DeactivateProductReply
-	13, // 28: product.v1.ProductService.ArchiveProduct:output_type -> product.v1.ArchiveProductReply
-	15, // 29: product.v1.ProductService.ApplyDiscount:output_type -> product.v1.ApplyDiscountReply
-	17, // 30: product.v1.ProductService.RemoveDiscount:output_type -> product.v1.RemoveDiscountReply
-	19, // 31: product.v1.ProductService.GetProduct:output_type -> product.v1.GetProductReply
-	21, // 32: product.v1.ProductService.ListProducts:output_type -> product.v1.ListProductsReply
-	24, // [24:33] is the sub-list for method output_type
-	15, // [15:24] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	182, // 0: product.v1.Discount.start_date:type_name -> google.protobuf.Timestamp
+	182, // 1: product.v1.Discount.end_date:type_name -> google.protobuf.Timestamp
+	2,   // 2: product.v1.Discount.buy_x_get_y:type_name -> product.v1.BuyXGetY
+	0,   // 3: product.v1.Discount.sale_price:type_name -> product.v1.Money
+	0,   // 4: product.v1.PriceTier.unit_price:type_name -> product.v1.Money
+	0,   // 5: product.v1.SegmentPrice.fixed_price:type_name -> product.v1.Money
+	0,   // 6: product.v1.MarketPrice.price:type_name -> product.v1.Money
+	0,   // 7: product.v1.MarketPrice.effective_price:type_name -> product.v1.Money
+	0,   // 8: product.v1.Product.base_price:type_name -> product.v1.Money
+	0,   // 9: product.v1.Product.effective_price:type_name -> product.v1.Money
+	1,   // 10: product.v1.Product.discount:type_name -> product.v1.Discount
+	182, // 11: product.v1.Product.created_at:type_name -> google.protobuf.Timestamp
+	182, // 12: product.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 13: product.v1.Product.discounts:type_name -> product.v1.Discount
+	3,   // 14: product.v1.Product.price_tiers:type_name -> product.v1.PriceTier
+	0,   // 15: product.v1.Product.price_book:type_name -> product.v1.Money
+	7,   // 16: product.v1.Product.experiment:type_name -> product.v1.ExperimentAssignment
+	4,   // 17: product.v1.Product.segment_prices:type_name -> product.v1.SegmentPrice
+	5,   // 18: product.v1.Product.market_prices:type_name -> product.v1.MarketPrice
+	0,   // 19: product.v1.Product.minimum_price:type_name -> product.v1.Money
+	0,   // 20: product.v1.Product.cost_price:type_name -> product.v1.Money
+	18,  // 21: product.v1.Product.pending_price_change:type_name -> product.v1.PendingPriceChange
+	12,  // 22: product.v1.Product.variants:type_name -> product.v1.Variant
+	11,  // 23: product.v1.Product.stock:type_name -> product.v1.Stock
+	175, // 24: product.v1.Product.attributes:type_name -> product.v1.Product.AttributesEntry
+	9,   // 25: product.v1.Product.images:type_name -> product.v1.ProductImage
+	182, // 26: product.v1.Product.activate_at:type_name -> google.protobuf.Timestamp
+	182, // 27: product.v1.Product.deactivate_at:type_name -> google.protobuf.Timestamp
+	13,  // 28: product.v1.Product.review:type_name -> product.v1.ProductReview
+	15,  // 29: product.v1.Product.weight:type_name -> product.v1.Weight
+	16,  // 30: product.v1.Product.dimensions:type_name -> product.v1.Dimensions
+	17,  // 31: product.v1.Product.rating:type_name -> product.v1.RatingSummary
+	14,  // 32: product.v1.Product.compliance:type_name -> product.v1.Compliance
+	10,  // 33: product.v1.Product.attachments:type_name -> product.v1.ProductAttachment
+	176, // 34: product.v1.Variant.attributes:type_name -> product.v1.Variant.AttributesEntry
+	0,   // 35: product.v1.Variant.price_delta:type_name -> product.v1.Money
+	0,   // 36: product.v1.Variant.price:type_name -> product.v1.Money
+	0,   // 37: product.v1.Variant.effective_price:type_name -> product.v1.Money
+	0,   // 38: product.v1.PendingPriceChange.base_price:type_name -> product.v1.Money
+	182, // 39: product.v1.PendingPriceChange.effective_at:type_name -> google.protobuf.Timestamp
+	0,   // 40: product.v1.ProductSummary.base_price:type_name -> product.v1.Money
+	0,   // 41: product.v1.ProductSummary.effective_price:type_name -> product.v1.Money
+	182, // 42: product.v1.ProductSummary.created_at:type_name -> google.protobuf.Timestamp
+	9,   // 43: product.v1.ProductSummary.primary_image:type_name -> product.v1.ProductImage
+	17,  // 44: product.v1.ProductSummary.rating:type_name -> product.v1.RatingSummary
+	0,   // 45: product.v1.CreateProductRequest.base_price:type_name -> product.v1.Money
+	177, // 46: product.v1.CreateProductRequest.attributes:type_name -> product.v1.CreateProductRequest.AttributesEntry
+	15,  // 47: product.v1.CreateProductRequest.weight:type_name -> product.v1.Weight
+	16,  // 48: product.v1.CreateProductRequest.dimensions:type_name -> product.v1.Dimensions
+	178, // 49: product.v1.UpdateProductRequest.attributes:type_name -> product.v1.UpdateProductRequest.AttributesEntry
+	0,   // 50: product.v1.ChangeBasePriceRequest.base_price:type_name -> product.v1.Money
+	0,   // 51: product.v1.SchedulePriceChangeRequest.base_price:type_name -> product.v1.Money
+	182, // 52: product.v1.SchedulePriceChangeRequest.effective_at:type_name -> google.protobuf.Timestamp
+	182, // 53: product.v1.ScheduleActivationRequest.activate_at:type_name -> google.protobuf.Timestamp
+	182, // 54: product.v1.ScheduleActivationRequest.deactivate_at:type_name -> google.protobuf.Timestamp
+	182, // 55: product.v1.ApplyDiscountRequest.start_date:type_name -> google.protobuf.Timestamp
+	182, // 56: product.v1.ApplyDiscountRequest.end_date:type_name -> google.protobuf.Timestamp
+	2,   // 57: product.v1.ApplyDiscountRequest.buy_x_get_y:type_name -> product.v1.BuyXGetY
+	0,   // 58: product.v1.ApplyDiscountRequest.sale_price:type_name -> product.v1.Money
+	182, // 59: product.v1.ApplyDiscountToCategoryRequest.start_date:type_name -> google.protobuf.Timestamp
+	182, // 60: product.v1.ApplyDiscountToCategoryRequest.end_date:type_name -> google.protobuf.Timestamp
+	117, // 61: product.v1.ApplyDiscountToCategoryReply.skipped:type_name -> product.v1.SkippedProduct
+	3,   // 62: product.v1.SetPriceTiersRequest.tiers:type_name -> product.v1.PriceTier
+	0,   // 63: product.v1.SetPriceBookRequest.prices:type_name -> product.v1.Money
+	4,   // 64: product.v1.SetSegmentPricesRequest.prices:type_name -> product.v1.SegmentPrice
+	5,   // 65: product.v1.SetMarketPricesRequest.prices:type_name -> product.v1.MarketPrice
+	0,   // 66: product.v1.SetMinimumPriceRequest.minimum_price:type_name -> product.v1.Money
+	0,   // 67: product.v1.SetCostPriceRequest.cost_price:type_name -> product.v1.Money
+	179, // 68: product.v1.AddVariantRequest.attributes:type_name -> product.v1.AddVariantRequest.AttributesEntry
+	0,   // 69: product.v1.AddVariantRequest.price_delta:type_name -> product.v1.Money
+	180, // 70: product.v1.UpdateVariantRequest.attributes:type_name -> product.v1.UpdateVariantRequest.AttributesEntry
+	0,   // 71: product.v1.UpdateVariantRequest.price_delta:type_name -> product.v1.Money
+	11,  // 72: product.v1.SetStockReply.stock:type_name -> product.v1.Stock
+	11,  // 73: product.v1.AdjustStockReply.stock:type_name -> product.v1.Stock
+	15,  // 74: product.v1.SetDimensionsRequest.weight:type_name -> product.v1.Weight
+	16,  // 75: product.v1.SetDimensionsRequest.dimensions:type_name -> product.v1.Dimensions
+	15,  // 76: product.v1.SetKindRequest.weight:type_name -> product.v1.Weight
+	16,  // 77: product.v1.SetKindRequest.dimensions:type_name -> product.v1.Dimensions
+	14,  // 78: product.v1.SetComplianceRequest.compliance:type_name -> product.v1.Compliance
+	181, // 79: product.v1.SetChannelAvailabilityRequest.channels:type_name -> product.v1.SetChannelAvailabilityRequest.ChannelsEntry
+	17,  // 80: product.v1.IngestReviewReply.rating:type_name -> product.v1.RatingSummary
+	9,   // 81: product.v1.SetImagesRequest.images:type_name -> product.v1.ProductImage
+	10,  // 82: product.v1.ManageAttachmentsRequest.attachments:type_name -> product.v1.ProductAttachment
+	182, // 83: product.v1.CreateCampaignRequest.start_date:type_name -> google.protobuf.Timestamp
+	182, // 84: product.v1.CreateCampaignRequest.end_date:type_name -> google.protobuf.Timestamp
+	117, // 85: product.v1.ActivateCampaignReply.skipped:type_name -> product.v1.SkippedProduct
+	182, // 86: product.v1.CreatePriceListRequest.valid_from:type_name -> google.protobuf.Timestamp
+	182, // 87: product.v1.CreatePriceListRequest.valid_until:type_name -> google.protobuf.Timestamp
+	0,   // 88: product.v1.PriceListEntry.price:type_name -> product.v1.Money
+	123, // 89: product.v1.SetPriceListEntriesRequest.entries:type_name -> product.v1.PriceListEntry
+	182, // 90: product.v1.RotateAPIKeyReply.previous_key_expires_at:type_name -> google.protobuf.Timestamp
+	6,   // 91: product.v1.GetProductRequest.experiment:type_name -> product.v1.ExperimentContext
+	182, // 92: product.v1.GetProductRequest.price_at:type_name -> google.protobuf.Timestamp
+	8,   // 93: product.v1.GetProductReply.product:type_name -> product.v1.Product
+	182, // 94: product.v1.GetProductReply.cached_at:type_name -> google.protobuf.Timestamp
+	6,   // 95: product.v1.GetProductBySlugRequest.experiment:type_name -> product.v1.ExperimentContext
+	182, // 96: product.v1.GetProductBySlugRequest.price_at:type_name -> google.protobuf.Timestamp
+	8,   // 97: product.v1.GetProductBySlugReply.product:type_name -> product.v1.Product
+	182, // 98: product.v1.GetProductBySlugReply.cached_at:type_name -> google.protobuf.Timestamp
+	6,   // 99: product.v1.GetProductBySKURequest.experiment:type_name -> product.v1.ExperimentContext
+	182, // 100: product.v1.GetProductBySKURequest.price_at:type_name -> google.protobuf.Timestamp
+	8,   // 101: product.v1.GetProductBySKUReply.product:type_name -> product.v1.Product
+	182, // 102: product.v1.GetProductBySKUReply.cached_at:type_name -> google.protobuf.Timestamp
+	6,   // 103: product.v1.BatchGetProductsRequest.experiment:type_name -> product.v1.ExperimentContext
+	182, // 104: product.v1.BatchGetProductsRequest.price_at:type_name -> google.protobuf.Timestamp
+	8,   // 105: product.v1.BatchGetProductsReply.products:type_name -> product.v1.Product
+	182, // 106: product.v1.ListProductsRequest.price_at:type_name -> google.protobuf.Timestamp
+	0,   // 107: product.v1.ListProductsRequest.min_price:type_name -> product.v1.Money
+	0,   // 108: product.v1.ListProductsRequest.max_price:type_name -> product.v1.Money
+	182, // 109: product.v1.ListAllProductsRequest.price_at:type_name -> google.protobuf.Timestamp
+	0,   // 110: product.v1.ListAllProductsRequest.min_price:type_name -> product.v1.Money
+	0,   // 111: product.v1.ListAllProductsRequest.max_price:type_name -> product.v1.Money
+	19,  // 112: product.v1.ListProductsReply.products:type_name -> product.v1.ProductSummary
+	182, // 113: product.v1.ListProductsReply.cached_at:type_name -> google.protobuf.Timestamp
+	19,  // 114: product.v1.SearchProductsReply.products:type_name -> product.v1.ProductSummary
+	145, // 115: product.v1.SearchProductsReply.facets:type_name -> product.v1.SearchFacet
+	146, // 116: product.v1.SearchFacet.values:type_name -> product.v1.FacetValue
+	182, // 117: product.v1.GetEffectivePricesRequest.at:type_name -> google.protobuf.Timestamp
+	6,   // 118: product.v1.GetEffectivePricesRequest.experiment:type_name -> product.v1.ExperimentContext
+	0,   // 119: product.v1.EffectivePrice.base_price:type_name -> product.v1.Money
+	0,   // 120: product.v1.EffectivePrice.effective_price:type_name -> product.v1.Money
+	7,   // 121: product.v1.EffectivePrice.experiment:type_name -> product.v1.ExperimentAssignment
+	148, // 122: product.v1.GetEffectivePricesReply.prices:type_name -> product.v1.EffectivePrice
+	182, // 123: product.v1.GetEffectivePricesReply.price_lock_expires_at:type_name -> google.protobuf.Timestamp
+	182, // 124: product.v1.GetPriceForQuantityRequest.at:type_name -> google.protobuf.Timestamp
+	0,   // 125: product.v1.GetPriceForQuantityReply.base_price:type_name -> product.v1.Money
+	0,   // 126: product.v1.GetPriceForQuantityReply.unit_price:type_name -> product.v1.Money
+	0,   // 127: product.v1.GetPriceForQuantityReply.total_price:type_name -> product.v1.Money
+	182, // 128: product.v1.GetTaxInclusivePriceRequest.at:type_name -> google.protobuf.Timestamp
+	0,   // 129: product.v1.GetTaxInclusivePriceReply.net_price:type_name -> product.v1.Money
+	0,   // 130: product.v1.GetTaxInclusivePriceReply.tax_amount:type_name -> product.v1.Money
+	0,   // 131: product.v1.GetTaxInclusivePriceReply.gross_price:type_name -> product.v1.Money
+	182, // 132: product.v1.GetMarginRequest.at:type_name -> google.protobuf.Timestamp
+	0,   // 133: product.v1.GetMarginReply.price:type_name -> product.v1.Money
+	0,   // 134: product.v1.GetMarginReply.cost_price:type_name -> product.v1.Money
+	0,   // 135: product.v1.GetMarginReply.margin:type_name -> product.v1.Money
+	0,   // 136: product.v1.CalculatePriceRequest.base_price:type_name -> product.v1.Money
+	182, // 137: product.v1.CalculatePriceRequest.at:type_name -> google.protobuf.Timestamp
+	0,   // 138: product.v1.CalculatePriceReply.base_price:type_name -> product.v1.Money
+	0,   // 139: product.v1.CalculatePriceReply.unit_price:type_name -> product.v1.Money
+	0,   // 140: product.v1.CalculatePriceReply.total_price:type_name -> product.v1.Money
+	0,   // 141: product.v1.CalculatePriceReply.discount_amount:type_name -> product.v1.Money
+	0,   // 142: product.v1.CalculatePriceReply.savings:type_name -> product.v1.Money
+	0,   // 143: product.v1.CalculatePriceReply.charm_price:type_name -> product.v1.Money
+	182, // 144: product.v1.GetPriceListPriceRequest.at:type_name -> google.protobuf.Timestamp
+	0,   // 145: product.v1.GetPriceListPriceReply.price:type_name -> product.v1.Money
+	182, // 146: product.v1.GetPriceHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	182, // 147: product.v1.GetPriceHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	182, // 148: product.v1.PriceHistoryEntry.changed_at:type_name -> google.protobuf.Timestamp
+	0,   // 149: product.v1.PriceHistoryEntry.base_price:type_name -> product.v1.Money
+	0,   // 150: product.v1.PriceHistoryEntry.effective_price:type_name -> product.v1.Money
+	161, // 151: product.v1.GetPriceHistoryReply.entries:type_name -> product.v1.PriceHistoryEntry
+	182, // 152: product.v1.GetProductRevisionsRequest.from:type_name -> google.protobuf.Timestamp
+	182, // 153: product.v1.GetProductRevisionsRequest.to:type_name -> google.protobuf.Timestamp
+	182, // 154: product.v1.ProductRevision.revised_at:type_name -> google.protobuf.Timestamp
+	164, // 155: product.v1.ProductRevision.changes:type_name -> product.v1.FieldChange
+	165, // 156: product.v1.GetProductRevisionsReply.revisions:type_name -> product.v1.ProductRevision
+	182, // 157: product.v1.GetProductAtRevisionReply.revised_at:type_name -> google.protobuf.Timestamp
+	19,  // 158: product.v1.RelatedProduct.product:type_name -> product.v1.ProductSummary
+	170, // 159: product.v1.GetRelatedProductsReply.products:type_name -> product.v1.RelatedProduct
+	0,   // 160: product.v1.LockedPrice.effective_price:type_name -> product.v1.Money
+	173, // 161: product.v1.VerifyPriceLockReply.prices:type_name -> product.v1.LockedPrice
+	182, // 162: product.v1.VerifyPriceLockReply.issued_at:type_name -> google.protobuf.Timestamp
+	182, // 163: product.v1.VerifyPriceLockReply.expires_at:type_name -> google.protobuf.Timestamp
+	20,  // 164: product.v1.ProductService.CreateProduct:input_type -> product.v1.CreateProductRequest
+	22,  // 165: product.v1.ProductService.CloneProduct:input_type -> product.v1.CloneProductRequest
+	24,  // 166: product.v1.ProductService.UpdateProduct:input_type -> product.v1.UpdateProductRequest
+	26,  // 167: product.v1.ProductService.ChangeBasePrice:input_type -> product.v1.ChangeBasePriceRequest
+	28,  // 168: product.v1.ProductService.SchedulePriceChange:input_type -> product.v1.SchedulePriceChangeRequest
+	30,  // 169: product.v1.ProductService.CancelPriceChange:input_type -> product.v1.CancelPriceChangeRequest
+	32,  // 170: product.v1.ProductService.ActivateProduct:input_type -> product.v1.ActivateProductRequest
+	34,  // 171: product.v1.ProductService.DeactivateProduct:input_type -> product.v1.DeactivateProductRequest
+	36,  // 172: product.v1.ProductService.ScheduleActivation:input_type -> product.v1.ScheduleActivationRequest
+	38,  // 173: product.v1.ProductService.SubmitForReview:input_type -> product.v1.SubmitForReviewRequest
+	40,  // 174: product.v1.ProductService.ApproveProduct:input_type -> product.v1.ApproveProductRequest
+	42,  // 175: product.v1.ProductService.RejectProduct:input_type -> product.v1.RejectProductRequest
+	44,  // 176: product.v1.ProductService.ArchiveProduct:input_type -> product.v1.ArchiveProductRequest
+	46,  // 177: product.v1.ProductService.ApplyDiscount:input_type -> product.v1.ApplyDiscountRequest
+	50,  // 178: product.v1.ProductService.RemoveDiscount:input_type -> product.v1.RemoveDiscountRequest
+	52,  // 179: product.v1.ProductService.ApproveDiscount:input_type -> product.v1.ApproveDiscountRequest
+	48,  // 180: product.v1.ProductService.ApplyDiscountToCategory:input_type -> product.v1.ApplyDiscountToCategoryRequest
+	54,  // 181: product.v1.ProductService.SetPriceTiers:input_type -> product.v1.SetPriceTiersRequest
+	56,  // 182: product.v1.ProductService.SetPriceBook:input_type -> product.v1.SetPriceBookRequest
+	58,  // 183: product.v1.ProductService.SetSegmentPrices:input_type -> product.v1.SetSegmentPricesRequest
+	60,  // 184: product.v1.ProductService.SetMarketPrices:input_type -> product.v1.SetMarketPricesRequest
+	62,  // 185: product.v1.ProductService.SetTaxClass:input_type -> product.v1.SetTaxClassRequest
+	64,  // 186: product.v1.ProductService.SetMinimumPrice:input_type -> product.v1.SetMinimumPriceRequest
+	66,  // 187: product.v1.ProductService.SetCostPrice:input_type -> product.v1.SetCostPriceRequest
+	68,  // 188: product.v1.ProductService.AddVariant:input_type -> product.v1.AddVariantRequest
+	70,  // 189: product.v1.ProductService.UpdateVariant:input_type -> product.v1.UpdateVariantRequest
+	72,  // 190: product.v1.ProductService.DiscontinueVariant:input_type -> product.v1.DiscontinueVariantRequest
+	74,  // 191: product.v1.ProductService.SetStock:input_type -> product.v1.SetStockRequest
+	76,  // 192: product.v1.ProductService.AdjustStock:input_type -> product.v1.AdjustStockRequest
+	78,  // 193: product.v1.ProductService.AddTag:input_type -> product.v1.AddTagRequest
+	80,  // 194: product.v1.ProductService.RemoveTag:input_type -> product.v1.RemoveTagRequest
+	82,  // 195: product.v1.ProductService.SetAttribute:input_type -> product.v1.SetAttributeRequest
+	84,  // 196: product.v1.ProductService.SetSlug:input_type -> product.v1.SetSlugRequest
+	86,  // 197: product.v1.ProductService.SetIdentifiers:input_type -> product.v1.SetIdentifiersRequest
+	88,  // 198: product.v1.ProductService.SetDimensions:input_type -> product.v1.SetDimensionsRequest
+	90,  // 199: product.v1.ProductService.SetKind:input_type -> product.v1.SetKindRequest
+	92,  // 200: product.v1.ProductService.SetCompliance:input_type -> product.v1.SetComplianceRequest
+	94,  // 201: product.v1.ProductService.SetChannelAvailability:input_type -> product.v1.SetChannelAvailabilityRequest
+	96,  // 202: product.v1.ProductService.IngestReview:input_type -> product.v1.IngestReviewRequest
+	98,  // 203: product.v1.ProductService.SetTranslation:input_type -> product.v1.SetTranslationRequest
+	100, // 204: product.v1.ProductService.SetImages:input_type -> product.v1.SetImagesRequest
+	102, // 205: product.v1.ProductService.ReorderImages:input_type -> product.v1.ReorderImagesRequest
+	104, // 206: product.v1.ProductService.ManageAttachments:input_type -> product.v1.ManageAttachmentsRequest
+	106, // 207: product.v1.ProductService.LinkProducts:input_type -> product.v1.LinkProductsRequest
+	108, // 208: product.v1.ProductService.UnlinkProducts:input_type -> product.v1.UnlinkProductsRequest
+	110, // 209: product.v1.ProductService.SubscribeToNotifications:input_type -> product.v1.SubscribeToNotificationsRequest
+	112, // 210: product.v1.ProductService.UnsubscribeFromNotifications:input_type -> product.v1.UnsubscribeFromNotificationsRequest
+	114, // 211: product.v1.ProductService.CreateCampaign:input_type -> product.v1.CreateCampaignRequest
+	116, // 212: product.v1.ProductService.ActivateCampaign:input_type -> product.v1.ActivateCampaignRequest
+	119, // 213: product.v1.ProductService.EndCampaign:input_type -> product.v1.EndCampaignRequest
+	121, // 214: product.v1.ProductService.CreatePriceList:input_type -> product.v1.CreatePriceListRequest
+	124, // 215: product.v1.ProductService.SetPriceListEntries:input_type -> product.v1.SetPriceListEntriesRequest
+	126, // 216: product.v1.ProductService.CreateAPIKey:input_type -> product.v1.CreateAPIKeyRequest
+	128, // 217: product.v1.ProductService.RotateAPIKey:input_type -> product.v1.RotateAPIKeyRequest
+	130, // 218: product.v1.ProductService.RevokeAPIKey:input_type -> product.v1.RevokeAPIKeyRequest
+	132, // 219: product.v1.ProductService.GetProduct:input_type -> product.v1.GetProductRequest
+	134, // 220: product.v1.ProductService.GetProductBySlug:input_type -> product.v1.GetProductBySlugRequest
+	136, // 221: product.v1.ProductService.GetProductBySKU:input_type -> product.v1.GetProductBySKURequest
+	138, // 222: product.v1.ProductService.BatchGetProducts:input_type -> product.v1.BatchGetProductsRequest
+	140, // 223: product.v1.ProductService.ListProducts:input_type -> product.v1.ListProductsRequest
+	141, // 224: product.v1.ProductService.ListAllProducts:input_type -> product.v1.ListAllProductsRequest
+	143, // 225: product.v1.ProductService.SearchProducts:input_type -> product.v1.SearchProductsRequest
+	147, // 226: product.v1.ProductService.GetEffectivePrices:input_type -> product.v1.GetEffectivePricesRequest
+	150, // 227: product.v1.ProductService.GetPriceForQuantity:input_type -> product.v1.GetPriceForQuantityRequest
+	152, // 228: product.v1.ProductService.GetTaxInclusivePrice:input_type -> product.v1.GetTaxInclusivePriceRequest
+	154, // 229: product.v1.ProductService.GetMargin:input_type -> product.v1.GetMarginRequest
+	156, // 230: product.v1.ProductService.CalculatePrice:input_type -> product.v1.CalculatePriceRequest
+	158, // 231: product.v1.ProductService.GetPriceListPrice:input_type -> product.v1.GetPriceListPriceRequest
+	160, // 232: product.v1.ProductService.GetPriceHistory:input_type -> product.v1.GetPriceHistoryRequest
+	163, // 233: product.v1.ProductService.GetProductRevisions:input_type -> product.v1.GetProductRevisionsRequest
+	167, // 234: product.v1.ProductService.GetProductAtRevision:input_type -> product.v1.GetProductAtRevisionRequest
+	169, // 235: product.v1.ProductService.GetRelatedProducts:input_type -> product.v1.GetRelatedProductsRequest
+	172, // 236: product.v1.ProductService.VerifyPriceLock:input_type -> product.v1.VerifyPriceLockRequest
+	21,  // 237: product.v1.ProductService.CreateProduct:output_type -> product.v1.CreateProductReply
+	23,  // 238: product.v1.ProductService.CloneProduct:output_type -> product.v1.CloneProductReply
+	25,  // 239: product.v1.ProductService.UpdateProduct:output_type -> product.v1.UpdateProductReply
+	27,  // 240: product.v1.ProductService.ChangeBasePrice:output_type -> product.v1.ChangeBasePriceReply
+	29,  // 241: product.v1.ProductService.SchedulePriceChange:output_type -> product.v1.SchedulePriceChangeReply
+	31,  // 242: product.v1.ProductService.CancelPriceChange:output_type -> product.v1.CancelPriceChangeReply
+	33,  // 243: product.v1.ProductService.ActivateProduct:output_type -> product.v1.ActivateProductReply
+	35,  // 244: product.v1.ProductService.DeactivateProduct:output_type -> product.v1.DeactivateProductReply
+	37,  // 245: product.v1.ProductService.ScheduleActivation:output_type -> product.v1.ScheduleActivationReply
+	39,  // 246: product.v1.ProductService.SubmitForReview:output_type -> product.v1.SubmitForReviewReply
+	41,  // 247: product.v1.ProductService.ApproveProduct:output_type -> product.v1.ApproveProductReply
+	43,  // 248: product.v1.ProductService.RejectProduct:output_type -> product.v1.RejectProductReply
+	45,  // 249: product.v1.ProductService.ArchiveProduct:output_type -> product.v1.ArchiveProductReply
+	47,  // 250: product.v1.ProductService.ApplyDiscount:output_type -> product.v1.ApplyDiscountReply
+	51,  // 251: product.v1.ProductService.RemoveDiscount:output_type -> product.v1.RemoveDiscountReply
+	53,  // 252: product.v1.ProductService.ApproveDiscount:output_type -> product.v1.ApproveDiscountReply
+	49,  // 253: product.v1.ProductService.ApplyDiscountToCategory:output_type -> product.v1.ApplyDiscountToCategoryReply
+	55,  // 254: product.v1.ProductService.SetPriceTiers:output_type -> product.v1.SetPriceTiersReply
+	57,  // 255: product.v1.ProductService.SetPriceBook:output_type -> product.v1.SetPriceBookReply
+	59,  // 256: product.v1.ProductService.SetSegmentPrices:output_type -> product.v1.SetSegmentPricesReply
+	61,  // 257: product.v1.ProductService.SetMarketPrices:output_type -> product.v1.SetMarketPricesReply
+	63,  // 258: product.v1.ProductService.SetTaxClass:output_type -> product.v1.SetTaxClassReply
+	65,  // 259: product.v1.ProductService.SetMinimumPrice:output_type -> product.v1.SetMinimumPriceReply
+	67,  // 260: product.v1.ProductService.SetCostPrice:output_type -> product.v1.SetCostPriceReply
+	69,  // 261: product.v1.ProductService.AddVariant:output_type -> product.v1.AddVariantReply
+	71,  // 262: product.v1.ProductService.UpdateVariant:output_type -> product.v1.UpdateVariantReply
+	73,  // 263: product.v1.ProductService.DiscontinueVariant:output_type -> product.v1.DiscontinueVariantReply
+	75,  // 264: product.v1.ProductService.SetStock:output_type -> product.v1.SetStockReply
+	77,  // 265: product.v1.ProductService.AdjustStock:output_type -> product.v1.AdjustStockReply
+	79,  // 266: product.v1.ProductService.AddTag:output_type -> product.v1.AddTagReply
+	81,  // 267: product.v1.ProductService.RemoveTag:output_type -> product.v1.RemoveTagReply
+	83,  // 268: product.v1.ProductService.SetAttribute:output_type -> product.v1.SetAttributeReply
+	85,  // 269: product.v1.ProductService.SetSlug:output_type -> product.v1.SetSlugReply
+	87,  // 270: product.v1.ProductService.SetIdentifiers:output_type -> product.v1.SetIdentifiersReply
+	89,  // 271: product.v1.ProductService.SetDimensions:output_type -> product.v1.SetDimensionsReply
+	91,  // 272: product.v1.ProductService.SetKind:output_type -> product.v1.SetKindReply
+	93,  // 273: product.v1.ProductService.SetCompliance:output_type -> product.v1.SetComplianceReply
+	95,  // 274: product.v1.ProductService.SetChannelAvailability:output_type -> product.v1.SetChannelAvailabilityReply
+	97,  // 275: product.v1.ProductService.IngestReview:output_type -> product.v1.IngestReviewReply
+	99,  // 276: product.v1.ProductService.SetTranslation:output_type -> product.v1.SetTranslationReply
+	101, // 277: product.v1.ProductService.SetImages:output_type -> product.v1.SetImagesReply
+	103, // 278: product.v1.ProductService.ReorderImages:output_type -> product.v1.ReorderImagesReply
+	105, // 279: product.v1.ProductService.ManageAttachments:output_type -> product.v1.ManageAttachmentsReply
+	107, // 280: product.v1.ProductService.LinkProducts:output_type -> product.v1.LinkProductsReply
+	109, // 281: product.v1.ProductService.UnlinkProducts:output_type -> product.v1.UnlinkProductsReply
+	111, // 282: product.v1.ProductService.SubscribeToNotifications:output_type -> product.v1.SubscribeToNotificationsReply
+	113, // 283: product.v1.ProductService.UnsubscribeFromNotifications:output_type -> product.v1.UnsubscribeFromNotificationsReply
+	115, // 284: product.v1.ProductService.CreateCampaign:output_type -> product.v1.CreateCampaignReply
+	118, // 285: product.v1.ProductService.ActivateCampaign:output_type -> product.v1.ActivateCampaignReply
+	120, // 286: product.v1.ProductService.EndCampaign:output_type -> product.v1.EndCampaignReply
+	122, // 287: product.v1.ProductService.CreatePriceList:output_type -> product.v1.CreatePriceListReply
+	125, // 288: product.v1.ProductService.SetPriceListEntries:output_type -> product.v1.SetPriceListEntriesReply
+	127, // 289: product.v1.ProductService.CreateAPIKey:output_type -> product.v1.CreateAPIKeyReply
+	129, // 290: product.v1.ProductService.RotateAPIKey:output_type -> product.v1.RotateAPIKeyReply
+	131, // 291: product.v1.ProductService.RevokeAPIKey:output_type -> product.v1.RevokeAPIKeyReply
+	133, // 292: product.v1.ProductService.GetProduct:output_type -> product.v1.GetProductReply
+	135, // 293: product.v1.ProductService.GetProductBySlug:output_type -> product.v1.GetProductBySlugReply
+	137, // 294: product.v1.ProductService.GetProductBySKU:output_type -> product.v1.GetProductBySKUReply
+	139, // 295: product.v1.ProductService.BatchGetProducts:output_type -> product.v1.BatchGetProductsReply
+	142, // 296: product.v1.ProductService.ListProducts:output_type -> product.v1.ListProductsReply
+	19,  // 297: product.v1.ProductService.ListAllProducts:output_type -> product.v1.ProductSummary
+	144, // 298: product.v1.ProductService.SearchProducts:output_type -> product.v1.SearchProductsReply
+	149, // 299: product.v1.ProductService.GetEffectivePrices:output_type -> product.v1.GetEffectivePricesReply
+	151, // 300: product.v1.ProductService.GetPriceForQuantity:output_type -> product.v1.GetPriceForQuantityReply
+	153, // 301: product.v1.ProductService.GetTaxInclusivePrice:output_type -> product.v1.GetTaxInclusivePriceReply
+	155, // 302: product.v1.ProductService.GetMargin:output_type -> product.v1.GetMarginReply
+	157, // 303: product.v1.ProductService.CalculatePrice:output_type -> product.v1.CalculatePriceReply
+	159, // 304: product.v1.ProductService.GetPriceListPrice:output_type -> product.v1.GetPriceListPriceReply
+	162, // 305: product.v1.ProductService.GetPriceHistory:output_type -> product.v1.GetPriceHistoryReply
+	166, // 306: product.v1.ProductService.GetProductRevisions:output_type -> product.v1.GetProductRevisionsReply
+	168, // 307: product.v1.ProductService.GetProductAtRevision:output_type -> product.v1.GetProductAtRevisionReply
+	171, // 308: product.v1.ProductService.GetRelatedProducts:output_type -> product.v1.GetRelatedProductsReply
+	174, // 309: product.v1.ProductService.VerifyPriceLock:output_type -> product.v1.VerifyPriceLockReply
+	237, // [237:310] is the sub-list for method output_type
+	164, // [164:237] is the sub-list for method input_type
+	164, // [164:164] is the sub-list for extension type_name
+	164, // [164:164] is the sub-list for extension extendee
+	0,   // [0:164] is the sub-list for field type_name
 }
 
 func init() { file_proto_product_v1_product_service_proto_init() }
@@ -1450,13 +12757,21 @@ func file_proto_product_v1_product_service_proto_init() {
 	if File_proto_product_v1_product_service_proto != nil {
 		return
 	}
+	file_proto_product_v1_product_service_proto_msgTypes[3].OneofWrappers = []any{
+		(*PriceTier_UnitPrice)(nil),
+		(*PriceTier_PercentOff)(nil),
+	}
+	file_proto_product_v1_product_service_proto_msgTypes[4].OneofWrappers = []any{
+		(*SegmentPrice_FixedPrice)(nil),
+		(*SegmentPrice_PercentOff)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_product_v1_product_service_proto_rawDesc), len(file_proto_product_v1_product_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   182,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
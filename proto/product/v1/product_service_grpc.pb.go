@@ -19,15 +19,79 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_CreateProduct_FullMethodName     = "/product.v1.ProductService/CreateProduct"
-	ProductService_UpdateProduct_FullMethodName     = "/product.v1.ProductService/UpdateProduct"
-	ProductService_ActivateProduct_FullMethodName   = "/product.v1.ProductService/ActivateProduct"
-	ProductService_DeactivateProduct_FullMethodName = "/product.v1.ProductService/DeactivateProduct"
-	ProductService_ArchiveProduct_FullMethodName    = "/product.v1.ProductService/ArchiveProduct"
-	ProductService_ApplyDiscount_FullMethodName     = "/product.v1.ProductService/ApplyDiscount"
-	ProductService_RemoveDiscount_FullMethodName    = "/product.v1.ProductService/RemoveDiscount"
-	ProductService_GetProduct_FullMethodName        = "/product.v1.ProductService/GetProduct"
-	ProductService_ListProducts_FullMethodName      = "/product.v1.ProductService/ListProducts"
+	ProductService_CreateProduct_FullMethodName                = "/product.v1.ProductService/CreateProduct"
+	ProductService_CloneProduct_FullMethodName                 = "/product.v1.ProductService/CloneProduct"
+	ProductService_UpdateProduct_FullMethodName                = "/product.v1.ProductService/UpdateProduct"
+	ProductService_ChangeBasePrice_FullMethodName              = "/product.v1.ProductService/ChangeBasePrice"
+	ProductService_SchedulePriceChange_FullMethodName          = "/product.v1.ProductService/SchedulePriceChange"
+	ProductService_CancelPriceChange_FullMethodName            = "/product.v1.ProductService/CancelPriceChange"
+	ProductService_ActivateProduct_FullMethodName              = "/product.v1.ProductService/ActivateProduct"
+	ProductService_DeactivateProduct_FullMethodName            = "/product.v1.ProductService/DeactivateProduct"
+	ProductService_ScheduleActivation_FullMethodName           = "/product.v1.ProductService/ScheduleActivation"
+	ProductService_SubmitForReview_FullMethodName              = "/product.v1.ProductService/SubmitForReview"
+	ProductService_ApproveProduct_FullMethodName               = "/product.v1.ProductService/ApproveProduct"
+	ProductService_RejectProduct_FullMethodName                = "/product.v1.ProductService/RejectProduct"
+	ProductService_ArchiveProduct_FullMethodName               = "/product.v1.ProductService/ArchiveProduct"
+	ProductService_ApplyDiscount_FullMethodName                = "/product.v1.ProductService/ApplyDiscount"
+	ProductService_RemoveDiscount_FullMethodName               = "/product.v1.ProductService/RemoveDiscount"
+	ProductService_ApproveDiscount_FullMethodName              = "/product.v1.ProductService/ApproveDiscount"
+	ProductService_ApplyDiscountToCategory_FullMethodName      = "/product.v1.ProductService/ApplyDiscountToCategory"
+	ProductService_SetPriceTiers_FullMethodName                = "/product.v1.ProductService/SetPriceTiers"
+	ProductService_SetPriceBook_FullMethodName                 = "/product.v1.ProductService/SetPriceBook"
+	ProductService_SetSegmentPrices_FullMethodName             = "/product.v1.ProductService/SetSegmentPrices"
+	ProductService_SetMarketPrices_FullMethodName              = "/product.v1.ProductService/SetMarketPrices"
+	ProductService_SetTaxClass_FullMethodName                  = "/product.v1.ProductService/SetTaxClass"
+	ProductService_SetMinimumPrice_FullMethodName              = "/product.v1.ProductService/SetMinimumPrice"
+	ProductService_SetCostPrice_FullMethodName                 = "/product.v1.ProductService/SetCostPrice"
+	ProductService_AddVariant_FullMethodName                   = "/product.v1.ProductService/AddVariant"
+	ProductService_UpdateVariant_FullMethodName                = "/product.v1.ProductService/UpdateVariant"
+	ProductService_DiscontinueVariant_FullMethodName           = "/product.v1.ProductService/DiscontinueVariant"
+	ProductService_SetStock_FullMethodName                     = "/product.v1.ProductService/SetStock"
+	ProductService_AdjustStock_FullMethodName                  = "/product.v1.ProductService/AdjustStock"
+	ProductService_AddTag_FullMethodName                       = "/product.v1.ProductService/AddTag"
+	ProductService_RemoveTag_FullMethodName                    = "/product.v1.ProductService/RemoveTag"
+	ProductService_SetAttribute_FullMethodName                 = "/product.v1.ProductService/SetAttribute"
+	ProductService_SetSlug_FullMethodName                      = "/product.v1.ProductService/SetSlug"
+	ProductService_SetIdentifiers_FullMethodName               = "/product.v1.ProductService/SetIdentifiers"
+	ProductService_SetDimensions_FullMethodName                = "/product.v1.ProductService/SetDimensions"
+	ProductService_SetKind_FullMethodName                      = "/product.v1.ProductService/SetKind"
+	ProductService_SetCompliance_FullMethodName                = "/product.v1.ProductService/SetCompliance"
+	ProductService_SetChannelAvailability_FullMethodName       = "/product.v1.ProductService/SetChannelAvailability"
+	ProductService_IngestReview_FullMethodName                 = "/product.v1.ProductService/IngestReview"
+	ProductService_SetTranslation_FullMethodName               = "/product.v1.ProductService/SetTranslation"
+	ProductService_SetImages_FullMethodName                    = "/product.v1.ProductService/SetImages"
+	ProductService_ReorderImages_FullMethodName                = "/product.v1.ProductService/ReorderImages"
+	ProductService_ManageAttachments_FullMethodName            = "/product.v1.ProductService/ManageAttachments"
+	ProductService_LinkProducts_FullMethodName                 = "/product.v1.ProductService/LinkProducts"
+	ProductService_UnlinkProducts_FullMethodName               = "/product.v1.ProductService/UnlinkProducts"
+	ProductService_SubscribeToNotifications_FullMethodName     = "/product.v1.ProductService/SubscribeToNotifications"
+	ProductService_UnsubscribeFromNotifications_FullMethodName = "/product.v1.ProductService/UnsubscribeFromNotifications"
+	ProductService_CreateCampaign_FullMethodName               = "/product.v1.ProductService/CreateCampaign"
+	ProductService_ActivateCampaign_FullMethodName             = "/product.v1.ProductService/ActivateCampaign"
+	ProductService_EndCampaign_FullMethodName                  = "/product.v1.ProductService/EndCampaign"
+	ProductService_CreatePriceList_FullMethodName              = "/product.v1.ProductService/CreatePriceList"
+	ProductService_SetPriceListEntries_FullMethodName          = "/product.v1.ProductService/SetPriceListEntries"
+	ProductService_CreateAPIKey_FullMethodName                 = "/product.v1.ProductService/CreateAPIKey"
+	ProductService_RotateAPIKey_FullMethodName                 = "/product.v1.ProductService/RotateAPIKey"
+	ProductService_RevokeAPIKey_FullMethodName                 = "/product.v1.ProductService/RevokeAPIKey"
+	ProductService_GetProduct_FullMethodName                   = "/product.v1.ProductService/GetProduct"
+	ProductService_GetProductBySlug_FullMethodName             = "/product.v1.ProductService/GetProductBySlug"
+	ProductService_GetProductBySKU_FullMethodName              = "/product.v1.ProductService/GetProductBySKU"
+	ProductService_BatchGetProducts_FullMethodName             = "/product.v1.ProductService/BatchGetProducts"
+	ProductService_ListProducts_FullMethodName                 = "/product.v1.ProductService/ListProducts"
+	ProductService_ListAllProducts_FullMethodName              = "/product.v1.ProductService/ListAllProducts"
+	ProductService_SearchProducts_FullMethodName               = "/product.v1.ProductService/SearchProducts"
+	ProductService_GetEffectivePrices_FullMethodName           = "/product.v1.ProductService/GetEffectivePrices"
+	ProductService_GetPriceForQuantity_FullMethodName          = "/product.v1.ProductService/GetPriceForQuantity"
+	ProductService_GetTaxInclusivePrice_FullMethodName         = "/product.v1.ProductService/GetTaxInclusivePrice"
+	ProductService_GetMargin_FullMethodName                    = "/product.v1.ProductService/GetMargin"
+	ProductService_CalculatePrice_FullMethodName               = "/product.v1.ProductService/CalculatePrice"
+	ProductService_GetPriceListPrice_FullMethodName            = "/product.v1.ProductService/GetPriceListPrice"
+	ProductService_GetPriceHistory_FullMethodName              = "/product.v1.ProductService/GetPriceHistory"
+	ProductService_GetProductRevisions_FullMethodName          = "/product.v1.ProductService/GetProductRevisions"
+	ProductService_GetProductAtRevision_FullMethodName         = "/product.v1.ProductService/GetProductAtRevision"
+	ProductService_GetRelatedProducts_FullMethodName           = "/product.v1.ProductService/GetRelatedProducts"
+	ProductService_VerifyPriceLock_FullMethodName              = "/product.v1.ProductService/VerifyPriceLock"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -38,15 +102,79 @@ const (
 type ProductServiceClient interface {
 	// Commands
 	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductReply, error)
+	CloneProduct(ctx context.Context, in *CloneProductRequest, opts ...grpc.CallOption) (*CloneProductReply, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductReply, error)
+	ChangeBasePrice(ctx context.Context, in *ChangeBasePriceRequest, opts ...grpc.CallOption) (*ChangeBasePriceReply, error)
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeReply, error)
+	CancelPriceChange(ctx context.Context, in *CancelPriceChangeRequest, opts ...grpc.CallOption) (*CancelPriceChangeReply, error)
 	ActivateProduct(ctx context.Context, in *ActivateProductRequest, opts ...grpc.CallOption) (*ActivateProductReply, error)
 	DeactivateProduct(ctx context.Context, in *DeactivateProductRequest, opts ...grpc.CallOption) (*DeactivateProductReply, error)
+	ScheduleActivation(ctx context.Context, in *ScheduleActivationRequest, opts ...grpc.CallOption) (*ScheduleActivationReply, error)
+	SubmitForReview(ctx context.Context, in *SubmitForReviewRequest, opts ...grpc.CallOption) (*SubmitForReviewReply, error)
+	ApproveProduct(ctx context.Context, in *ApproveProductRequest, opts ...grpc.CallOption) (*ApproveProductReply, error)
+	RejectProduct(ctx context.Context, in *RejectProductRequest, opts ...grpc.CallOption) (*RejectProductReply, error)
 	ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, opts ...grpc.CallOption) (*ArchiveProductReply, error)
 	ApplyDiscount(ctx context.Context, in *ApplyDiscountRequest, opts ...grpc.CallOption) (*ApplyDiscountReply, error)
 	RemoveDiscount(ctx context.Context, in *RemoveDiscountRequest, opts ...grpc.CallOption) (*RemoveDiscountReply, error)
+	ApproveDiscount(ctx context.Context, in *ApproveDiscountRequest, opts ...grpc.CallOption) (*ApproveDiscountReply, error)
+	ApplyDiscountToCategory(ctx context.Context, in *ApplyDiscountToCategoryRequest, opts ...grpc.CallOption) (*ApplyDiscountToCategoryReply, error)
+	SetPriceTiers(ctx context.Context, in *SetPriceTiersRequest, opts ...grpc.CallOption) (*SetPriceTiersReply, error)
+	SetPriceBook(ctx context.Context, in *SetPriceBookRequest, opts ...grpc.CallOption) (*SetPriceBookReply, error)
+	SetSegmentPrices(ctx context.Context, in *SetSegmentPricesRequest, opts ...grpc.CallOption) (*SetSegmentPricesReply, error)
+	SetMarketPrices(ctx context.Context, in *SetMarketPricesRequest, opts ...grpc.CallOption) (*SetMarketPricesReply, error)
+	SetTaxClass(ctx context.Context, in *SetTaxClassRequest, opts ...grpc.CallOption) (*SetTaxClassReply, error)
+	SetMinimumPrice(ctx context.Context, in *SetMinimumPriceRequest, opts ...grpc.CallOption) (*SetMinimumPriceReply, error)
+	SetCostPrice(ctx context.Context, in *SetCostPriceRequest, opts ...grpc.CallOption) (*SetCostPriceReply, error)
+	AddVariant(ctx context.Context, in *AddVariantRequest, opts ...grpc.CallOption) (*AddVariantReply, error)
+	UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*UpdateVariantReply, error)
+	DiscontinueVariant(ctx context.Context, in *DiscontinueVariantRequest, opts ...grpc.CallOption) (*DiscontinueVariantReply, error)
+	SetStock(ctx context.Context, in *SetStockRequest, opts ...grpc.CallOption) (*SetStockReply, error)
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockReply, error)
+	AddTag(ctx context.Context, in *AddTagRequest, opts ...grpc.CallOption) (*AddTagReply, error)
+	RemoveTag(ctx context.Context, in *RemoveTagRequest, opts ...grpc.CallOption) (*RemoveTagReply, error)
+	SetAttribute(ctx context.Context, in *SetAttributeRequest, opts ...grpc.CallOption) (*SetAttributeReply, error)
+	SetSlug(ctx context.Context, in *SetSlugRequest, opts ...grpc.CallOption) (*SetSlugReply, error)
+	SetIdentifiers(ctx context.Context, in *SetIdentifiersRequest, opts ...grpc.CallOption) (*SetIdentifiersReply, error)
+	SetDimensions(ctx context.Context, in *SetDimensionsRequest, opts ...grpc.CallOption) (*SetDimensionsReply, error)
+	SetKind(ctx context.Context, in *SetKindRequest, opts ...grpc.CallOption) (*SetKindReply, error)
+	SetCompliance(ctx context.Context, in *SetComplianceRequest, opts ...grpc.CallOption) (*SetComplianceReply, error)
+	SetChannelAvailability(ctx context.Context, in *SetChannelAvailabilityRequest, opts ...grpc.CallOption) (*SetChannelAvailabilityReply, error)
+	IngestReview(ctx context.Context, in *IngestReviewRequest, opts ...grpc.CallOption) (*IngestReviewReply, error)
+	SetTranslation(ctx context.Context, in *SetTranslationRequest, opts ...grpc.CallOption) (*SetTranslationReply, error)
+	SetImages(ctx context.Context, in *SetImagesRequest, opts ...grpc.CallOption) (*SetImagesReply, error)
+	ReorderImages(ctx context.Context, in *ReorderImagesRequest, opts ...grpc.CallOption) (*ReorderImagesReply, error)
+	ManageAttachments(ctx context.Context, in *ManageAttachmentsRequest, opts ...grpc.CallOption) (*ManageAttachmentsReply, error)
+	LinkProducts(ctx context.Context, in *LinkProductsRequest, opts ...grpc.CallOption) (*LinkProductsReply, error)
+	UnlinkProducts(ctx context.Context, in *UnlinkProductsRequest, opts ...grpc.CallOption) (*UnlinkProductsReply, error)
+	SubscribeToNotifications(ctx context.Context, in *SubscribeToNotificationsRequest, opts ...grpc.CallOption) (*SubscribeToNotificationsReply, error)
+	UnsubscribeFromNotifications(ctx context.Context, in *UnsubscribeFromNotificationsRequest, opts ...grpc.CallOption) (*UnsubscribeFromNotificationsReply, error)
+	CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignReply, error)
+	ActivateCampaign(ctx context.Context, in *ActivateCampaignRequest, opts ...grpc.CallOption) (*ActivateCampaignReply, error)
+	EndCampaign(ctx context.Context, in *EndCampaignRequest, opts ...grpc.CallOption) (*EndCampaignReply, error)
+	CreatePriceList(ctx context.Context, in *CreatePriceListRequest, opts ...grpc.CallOption) (*CreatePriceListReply, error)
+	SetPriceListEntries(ctx context.Context, in *SetPriceListEntriesRequest, opts ...grpc.CallOption) (*SetPriceListEntriesReply, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyReply, error)
+	RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*RotateAPIKeyReply, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyReply, error)
 	// Queries
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductReply, error)
+	GetProductBySlug(ctx context.Context, in *GetProductBySlugRequest, opts ...grpc.CallOption) (*GetProductBySlugReply, error)
+	GetProductBySKU(ctx context.Context, in *GetProductBySKURequest, opts ...grpc.CallOption) (*GetProductBySKUReply, error)
+	BatchGetProducts(ctx context.Context, in *BatchGetProductsRequest, opts ...grpc.CallOption) (*BatchGetProductsReply, error)
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsReply, error)
+	ListAllProducts(ctx context.Context, in *ListAllProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProductSummary], error)
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsReply, error)
+	GetEffectivePrices(ctx context.Context, in *GetEffectivePricesRequest, opts ...grpc.CallOption) (*GetEffectivePricesReply, error)
+	GetPriceForQuantity(ctx context.Context, in *GetPriceForQuantityRequest, opts ...grpc.CallOption) (*GetPriceForQuantityReply, error)
+	GetTaxInclusivePrice(ctx context.Context, in *GetTaxInclusivePriceRequest, opts ...grpc.CallOption) (*GetTaxInclusivePriceReply, error)
+	GetMargin(ctx context.Context, in *GetMarginRequest, opts ...grpc.CallOption) (*GetMarginReply, error)
+	CalculatePrice(ctx context.Context, in *CalculatePriceRequest, opts ...grpc.CallOption) (*CalculatePriceReply, error)
+	GetPriceListPrice(ctx context.Context, in *GetPriceListPriceRequest, opts ...grpc.CallOption) (*GetPriceListPriceReply, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryReply, error)
+	GetProductRevisions(ctx context.Context, in *GetProductRevisionsRequest, opts ...grpc.CallOption) (*GetProductRevisionsReply, error)
+	GetProductAtRevision(ctx context.Context, in *GetProductAtRevisionRequest, opts ...grpc.CallOption) (*GetProductAtRevisionReply, error)
+	GetRelatedProducts(ctx context.Context, in *GetRelatedProductsRequest, opts ...grpc.CallOption) (*GetRelatedProductsReply, error)
+	VerifyPriceLock(ctx context.Context, in *VerifyPriceLockRequest, opts ...grpc.CallOption) (*VerifyPriceLockReply, error)
 }
 
 type productServiceClient struct {
@@ -67,6 +195,16 @@ func (c *productServiceClient) CreateProduct(ctx context.Context, in *CreateProd
 	return out, nil
 }
 
+func (c *productServiceClient) CloneProduct(ctx context.Context, in *CloneProductRequest, opts ...grpc.CallOption) (*CloneProductReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneProductReply)
+	err := c.cc.Invoke(ctx, ProductService_CloneProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateProductReply)
@@ -77,6 +215,36 @@ func (c *productServiceClient) UpdateProduct(ctx context.Context, in *UpdateProd
 	return out, nil
 }
 
+func (c *productServiceClient) ChangeBasePrice(ctx context.Context, in *ChangeBasePriceRequest, opts ...grpc.CallOption) (*ChangeBasePriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeBasePriceReply)
+	err := c.cc.Invoke(ctx, ProductService_ChangeBasePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulePriceChangeReply)
+	err := c.cc.Invoke(ctx, ProductService_SchedulePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CancelPriceChange(ctx context.Context, in *CancelPriceChangeRequest, opts ...grpc.CallOption) (*CancelPriceChangeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPriceChangeReply)
+	err := c.cc.Invoke(ctx, ProductService_CancelPriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) ActivateProduct(ctx context.Context, in *ActivateProductRequest, opts ...grpc.CallOption) (*ActivateProductReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ActivateProductReply)
@@ -97,6 +265,46 @@ func (c *productServiceClient) DeactivateProduct(ctx context.Context, in *Deacti
 	return out, nil
 }
 
+func (c *productServiceClient) ScheduleActivation(ctx context.Context, in *ScheduleActivationRequest, opts ...grpc.CallOption) (*ScheduleActivationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleActivationReply)
+	err := c.cc.Invoke(ctx, ProductService_ScheduleActivation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SubmitForReview(ctx context.Context, in *SubmitForReviewRequest, opts ...grpc.CallOption) (*SubmitForReviewReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitForReviewReply)
+	err := c.cc.Invoke(ctx, ProductService_SubmitForReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ApproveProduct(ctx context.Context, in *ApproveProductRequest, opts ...grpc.CallOption) (*ApproveProductReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveProductReply)
+	err := c.cc.Invoke(ctx, ProductService_ApproveProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RejectProduct(ctx context.Context, in *RejectProductRequest, opts ...grpc.CallOption) (*RejectProductReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectProductReply)
+	err := c.cc.Invoke(ctx, ProductService_RejectProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) ArchiveProduct(ctx context.Context, in *ArchiveProductRequest, opts ...grpc.CallOption) (*ArchiveProductReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ArchiveProductReply)
@@ -127,259 +335,2229 @@ func (c *productServiceClient) RemoveDiscount(ctx context.Context, in *RemoveDis
 	return out, nil
 }
 
-func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductReply, error) {
+func (c *productServiceClient) ApproveDiscount(ctx context.Context, in *ApproveDiscountRequest, opts ...grpc.CallOption) (*ApproveDiscountReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetProductReply)
-	err := c.cc.Invoke(ctx, ProductService_GetProduct_FullMethodName, in, out, cOpts...)
+	out := new(ApproveDiscountReply)
+	err := c.cc.Invoke(ctx, ProductService_ApproveDiscount_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsReply, error) {
+func (c *productServiceClient) ApplyDiscountToCategory(ctx context.Context, in *ApplyDiscountToCategoryRequest, opts ...grpc.CallOption) (*ApplyDiscountToCategoryReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListProductsReply)
-	err := c.cc.Invoke(ctx, ProductService_ListProducts_FullMethodName, in, out, cOpts...)
+	out := new(ApplyDiscountToCategoryReply)
+	err := c.cc.Invoke(ctx, ProductService_ApplyDiscountToCategory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ProductServiceServer is the server API for ProductService service.
-// All implementations must embed UnimplementedProductServiceServer
-// for forward compatibility.
-//
-// ProductService provides operations for managing products in the catalog.
-type ProductServiceServer interface {
-	// Commands
-	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductReply, error)
-	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductReply, error)
-	ActivateProduct(context.Context, *ActivateProductRequest) (*ActivateProductReply, error)
-	DeactivateProduct(context.Context, *DeactivateProductRequest) (*DeactivateProductReply, error)
-	ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductReply, error)
-	ApplyDiscount(context.Context, *ApplyDiscountRequest) (*ApplyDiscountReply, error)
-	RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountReply, error)
-	// Queries
-	GetProduct(context.Context, *GetProductRequest) (*GetProductReply, error)
-	ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error)
-	mustEmbedUnimplementedProductServiceServer()
+func (c *productServiceClient) SetPriceTiers(ctx context.Context, in *SetPriceTiersRequest, opts ...grpc.CallOption) (*SetPriceTiersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPriceTiersReply)
+	err := c.cc.Invoke(ctx, ProductService_SetPriceTiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedProductServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedProductServiceServer struct{}
+func (c *productServiceClient) SetPriceBook(ctx context.Context, in *SetPriceBookRequest, opts ...grpc.CallOption) (*SetPriceBookReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPriceBookReply)
+	err := c.cc.Invoke(ctx, ProductService_SetPriceBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateProduct not implemented")
+func (c *productServiceClient) SetSegmentPrices(ctx context.Context, in *SetSegmentPricesRequest, opts ...grpc.CallOption) (*SetSegmentPricesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSegmentPricesReply)
+	err := c.cc.Invoke(ctx, ProductService_SetSegmentPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateProduct not implemented")
+
+func (c *productServiceClient) SetMarketPrices(ctx context.Context, in *SetMarketPricesRequest, opts ...grpc.CallOption) (*SetMarketPricesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMarketPricesReply)
+	err := c.cc.Invoke(ctx, ProductService_SetMarketPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) ActivateProduct(context.Context, *ActivateProductRequest) (*ActivateProductReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method ActivateProduct not implemented")
+
+func (c *productServiceClient) SetTaxClass(ctx context.Context, in *SetTaxClassRequest, opts ...grpc.CallOption) (*SetTaxClassReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTaxClassReply)
+	err := c.cc.Invoke(ctx, ProductService_SetTaxClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) DeactivateProduct(context.Context, *DeactivateProductRequest) (*DeactivateProductReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeactivateProduct not implemented")
+
+func (c *productServiceClient) SetMinimumPrice(ctx context.Context, in *SetMinimumPriceRequest, opts ...grpc.CallOption) (*SetMinimumPriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMinimumPriceReply)
+	err := c.cc.Invoke(ctx, ProductService_SetMinimumPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method ArchiveProduct not implemented")
+
+func (c *productServiceClient) SetCostPrice(ctx context.Context, in *SetCostPriceRequest, opts ...grpc.CallOption) (*SetCostPriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCostPriceReply)
+	err := c.cc.Invoke(ctx, ProductService_SetCostPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) ApplyDiscount(context.Context, *ApplyDiscountRequest) (*ApplyDiscountReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method ApplyDiscount not implemented")
+
+func (c *productServiceClient) AddVariant(ctx context.Context, in *AddVariantRequest, opts ...grpc.CallOption) (*AddVariantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddVariantReply)
+	err := c.cc.Invoke(ctx, ProductService_AddVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*UpdateVariantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVariantReply)
+	err := c.cc.Invoke(ctx, ProductService_UpdateVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DiscontinueVariant(ctx context.Context, in *DiscontinueVariantRequest, opts ...grpc.CallOption) (*DiscontinueVariantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscontinueVariantReply)
+	err := c.cc.Invoke(ctx, ProductService_DiscontinueVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetStock(ctx context.Context, in *SetStockRequest, opts ...grpc.CallOption) (*SetStockReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStockReply)
+	err := c.cc.Invoke(ctx, ProductService_SetStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustStockReply)
+	err := c.cc.Invoke(ctx, ProductService_AdjustStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AddTag(ctx context.Context, in *AddTagRequest, opts ...grpc.CallOption) (*AddTagReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTagReply)
+	err := c.cc.Invoke(ctx, ProductService_AddTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RemoveTag(ctx context.Context, in *RemoveTagRequest, opts ...grpc.CallOption) (*RemoveTagReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTagReply)
+	err := c.cc.Invoke(ctx, ProductService_RemoveTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetAttribute(ctx context.Context, in *SetAttributeRequest, opts ...grpc.CallOption) (*SetAttributeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAttributeReply)
+	err := c.cc.Invoke(ctx, ProductService_SetAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetSlug(ctx context.Context, in *SetSlugRequest, opts ...grpc.CallOption) (*SetSlugReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSlugReply)
+	err := c.cc.Invoke(ctx, ProductService_SetSlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetIdentifiers(ctx context.Context, in *SetIdentifiersRequest, opts ...grpc.CallOption) (*SetIdentifiersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetIdentifiersReply)
+	err := c.cc.Invoke(ctx, ProductService_SetIdentifiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetDimensions(ctx context.Context, in *SetDimensionsRequest, opts ...grpc.CallOption) (*SetDimensionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDimensionsReply)
+	err := c.cc.Invoke(ctx, ProductService_SetDimensions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetKind(ctx context.Context, in *SetKindRequest, opts ...grpc.CallOption) (*SetKindReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetKindReply)
+	err := c.cc.Invoke(ctx, ProductService_SetKind_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetCompliance(ctx context.Context, in *SetComplianceRequest, opts ...grpc.CallOption) (*SetComplianceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetComplianceReply)
+	err := c.cc.Invoke(ctx, ProductService_SetCompliance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetChannelAvailability(ctx context.Context, in *SetChannelAvailabilityRequest, opts ...grpc.CallOption) (*SetChannelAvailabilityReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChannelAvailabilityReply)
+	err := c.cc.Invoke(ctx, ProductService_SetChannelAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) IngestReview(ctx context.Context, in *IngestReviewRequest, opts ...grpc.CallOption) (*IngestReviewReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngestReviewReply)
+	err := c.cc.Invoke(ctx, ProductService_IngestReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetTranslation(ctx context.Context, in *SetTranslationRequest, opts ...grpc.CallOption) (*SetTranslationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTranslationReply)
+	err := c.cc.Invoke(ctx, ProductService_SetTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetImages(ctx context.Context, in *SetImagesRequest, opts ...grpc.CallOption) (*SetImagesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetImagesReply)
+	err := c.cc.Invoke(ctx, ProductService_SetImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReorderImages(ctx context.Context, in *ReorderImagesRequest, opts ...grpc.CallOption) (*ReorderImagesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderImagesReply)
+	err := c.cc.Invoke(ctx, ProductService_ReorderImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ManageAttachments(ctx context.Context, in *ManageAttachmentsRequest, opts ...grpc.CallOption) (*ManageAttachmentsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ManageAttachmentsReply)
+	err := c.cc.Invoke(ctx, ProductService_ManageAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) LinkProducts(ctx context.Context, in *LinkProductsRequest, opts ...grpc.CallOption) (*LinkProductsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkProductsReply)
+	err := c.cc.Invoke(ctx, ProductService_LinkProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UnlinkProducts(ctx context.Context, in *UnlinkProductsRequest, opts ...grpc.CallOption) (*UnlinkProductsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkProductsReply)
+	err := c.cc.Invoke(ctx, ProductService_UnlinkProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SubscribeToNotifications(ctx context.Context, in *SubscribeToNotificationsRequest, opts ...grpc.CallOption) (*SubscribeToNotificationsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeToNotificationsReply)
+	err := c.cc.Invoke(ctx, ProductService_SubscribeToNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UnsubscribeFromNotifications(ctx context.Context, in *UnsubscribeFromNotificationsRequest, opts ...grpc.CallOption) (*UnsubscribeFromNotificationsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeFromNotificationsReply)
+	err := c.cc.Invoke(ctx, ProductService_UnsubscribeFromNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateCampaign(ctx context.Context, in *CreateCampaignRequest, opts ...grpc.CallOption) (*CreateCampaignReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCampaignReply)
+	err := c.cc.Invoke(ctx, ProductService_CreateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ActivateCampaign(ctx context.Context, in *ActivateCampaignRequest, opts ...grpc.CallOption) (*ActivateCampaignReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivateCampaignReply)
+	err := c.cc.Invoke(ctx, ProductService_ActivateCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) EndCampaign(ctx context.Context, in *EndCampaignRequest, opts ...grpc.CallOption) (*EndCampaignReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndCampaignReply)
+	err := c.cc.Invoke(ctx, ProductService_EndCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreatePriceList(ctx context.Context, in *CreatePriceListRequest, opts ...grpc.CallOption) (*CreatePriceListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePriceListReply)
+	err := c.cc.Invoke(ctx, ProductService_CreatePriceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetPriceListEntries(ctx context.Context, in *SetPriceListEntriesRequest, opts ...grpc.CallOption) (*SetPriceListEntriesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPriceListEntriesReply)
+	err := c.cc.Invoke(ctx, ProductService_SetPriceListEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyReply)
+	err := c.cc.Invoke(ctx, ProductService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest, opts ...grpc.CallOption) (*RotateAPIKeyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateAPIKeyReply)
+	err := c.cc.Invoke(ctx, ProductService_RotateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyReply)
+	err := c.cc.Invoke(ctx, ProductService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductReply)
+	err := c.cc.Invoke(ctx, ProductService_GetProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductBySlug(ctx context.Context, in *GetProductBySlugRequest, opts ...grpc.CallOption) (*GetProductBySlugReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductBySlugReply)
+	err := c.cc.Invoke(ctx, ProductService_GetProductBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductBySKU(ctx context.Context, in *GetProductBySKURequest, opts ...grpc.CallOption) (*GetProductBySKUReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductBySKUReply)
+	err := c.cc.Invoke(ctx, ProductService_GetProductBySKU_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) BatchGetProducts(ctx context.Context, in *BatchGetProductsRequest, opts ...grpc.CallOption) (*BatchGetProductsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetProductsReply)
+	err := c.cc.Invoke(ctx, ProductService_BatchGetProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductsReply)
+	err := c.cc.Invoke(ctx, ProductService_ListProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListAllProducts(ctx context.Context, in *ListAllProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProductSummary], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[0], ProductService_ListAllProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListAllProductsRequest, ProductSummary]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ListAllProductsClient = grpc.ServerStreamingClient[ProductSummary]
+
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchProductsReply)
+	err := c.cc.Invoke(ctx, ProductService_SearchProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetEffectivePrices(ctx context.Context, in *GetEffectivePricesRequest, opts ...grpc.CallOption) (*GetEffectivePricesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEffectivePricesReply)
+	err := c.cc.Invoke(ctx, ProductService_GetEffectivePrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceForQuantity(ctx context.Context, in *GetPriceForQuantityRequest, opts ...grpc.CallOption) (*GetPriceForQuantityReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceForQuantityReply)
+	err := c.cc.Invoke(ctx, ProductService_GetPriceForQuantity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetTaxInclusivePrice(ctx context.Context, in *GetTaxInclusivePriceRequest, opts ...grpc.CallOption) (*GetTaxInclusivePriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTaxInclusivePriceReply)
+	err := c.cc.Invoke(ctx, ProductService_GetTaxInclusivePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetMargin(ctx context.Context, in *GetMarginRequest, opts ...grpc.CallOption) (*GetMarginReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarginReply)
+	err := c.cc.Invoke(ctx, ProductService_GetMargin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CalculatePrice(ctx context.Context, in *CalculatePriceRequest, opts ...grpc.CallOption) (*CalculatePriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculatePriceReply)
+	err := c.cc.Invoke(ctx, ProductService_CalculatePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceListPrice(ctx context.Context, in *GetPriceListPriceRequest, opts ...grpc.CallOption) (*GetPriceListPriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceListPriceReply)
+	err := c.cc.Invoke(ctx, ProductService_GetPriceListPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryReply)
+	err := c.cc.Invoke(ctx, ProductService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductRevisions(ctx context.Context, in *GetProductRevisionsRequest, opts ...grpc.CallOption) (*GetProductRevisionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductRevisionsReply)
+	err := c.cc.Invoke(ctx, ProductService_GetProductRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductAtRevision(ctx context.Context, in *GetProductAtRevisionRequest, opts ...grpc.CallOption) (*GetProductAtRevisionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductAtRevisionReply)
+	err := c.cc.Invoke(ctx, ProductService_GetProductAtRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetRelatedProducts(ctx context.Context, in *GetRelatedProductsRequest, opts ...grpc.CallOption) (*GetRelatedProductsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelatedProductsReply)
+	err := c.cc.Invoke(ctx, ProductService_GetRelatedProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) VerifyPriceLock(ctx context.Context, in *VerifyPriceLockRequest, opts ...grpc.CallOption) (*VerifyPriceLockReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyPriceLockReply)
+	err := c.cc.Invoke(ctx, ProductService_VerifyPriceLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductServiceServer is the server API for ProductService service.
+// All implementations must embed UnimplementedProductServiceServer
+// for forward compatibility.
+//
+// ProductService provides operations for managing products in the catalog.
+type ProductServiceServer interface {
+	// Commands
+	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductReply, error)
+	CloneProduct(context.Context, *CloneProductRequest) (*CloneProductReply, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductReply, error)
+	ChangeBasePrice(context.Context, *ChangeBasePriceRequest) (*ChangeBasePriceReply, error)
+	SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*SchedulePriceChangeReply, error)
+	CancelPriceChange(context.Context, *CancelPriceChangeRequest) (*CancelPriceChangeReply, error)
+	ActivateProduct(context.Context, *ActivateProductRequest) (*ActivateProductReply, error)
+	DeactivateProduct(context.Context, *DeactivateProductRequest) (*DeactivateProductReply, error)
+	ScheduleActivation(context.Context, *ScheduleActivationRequest) (*ScheduleActivationReply, error)
+	SubmitForReview(context.Context, *SubmitForReviewRequest) (*SubmitForReviewReply, error)
+	ApproveProduct(context.Context, *ApproveProductRequest) (*ApproveProductReply, error)
+	RejectProduct(context.Context, *RejectProductRequest) (*RejectProductReply, error)
+	ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductReply, error)
+	ApplyDiscount(context.Context, *ApplyDiscountRequest) (*ApplyDiscountReply, error)
+	RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountReply, error)
+	ApproveDiscount(context.Context, *ApproveDiscountRequest) (*ApproveDiscountReply, error)
+	ApplyDiscountToCategory(context.Context, *ApplyDiscountToCategoryRequest) (*ApplyDiscountToCategoryReply, error)
+	SetPriceTiers(context.Context, *SetPriceTiersRequest) (*SetPriceTiersReply, error)
+	SetPriceBook(context.Context, *SetPriceBookRequest) (*SetPriceBookReply, error)
+	SetSegmentPrices(context.Context, *SetSegmentPricesRequest) (*SetSegmentPricesReply, error)
+	SetMarketPrices(context.Context, *SetMarketPricesRequest) (*SetMarketPricesReply, error)
+	SetTaxClass(context.Context, *SetTaxClassRequest) (*SetTaxClassReply, error)
+	SetMinimumPrice(context.Context, *SetMinimumPriceRequest) (*SetMinimumPriceReply, error)
+	SetCostPrice(context.Context, *SetCostPriceRequest) (*SetCostPriceReply, error)
+	AddVariant(context.Context, *AddVariantRequest) (*AddVariantReply, error)
+	UpdateVariant(context.Context, *UpdateVariantRequest) (*UpdateVariantReply, error)
+	DiscontinueVariant(context.Context, *DiscontinueVariantRequest) (*DiscontinueVariantReply, error)
+	SetStock(context.Context, *SetStockRequest) (*SetStockReply, error)
+	AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockReply, error)
+	AddTag(context.Context, *AddTagRequest) (*AddTagReply, error)
+	RemoveTag(context.Context, *RemoveTagRequest) (*RemoveTagReply, error)
+	SetAttribute(context.Context, *SetAttributeRequest) (*SetAttributeReply, error)
+	SetSlug(context.Context, *SetSlugRequest) (*SetSlugReply, error)
+	SetIdentifiers(context.Context, *SetIdentifiersRequest) (*SetIdentifiersReply, error)
+	SetDimensions(context.Context, *SetDimensionsRequest) (*SetDimensionsReply, error)
+	SetKind(context.Context, *SetKindRequest) (*SetKindReply, error)
+	SetCompliance(context.Context, *SetComplianceRequest) (*SetComplianceReply, error)
+	SetChannelAvailability(context.Context, *SetChannelAvailabilityRequest) (*SetChannelAvailabilityReply, error)
+	IngestReview(context.Context, *IngestReviewRequest) (*IngestReviewReply, error)
+	SetTranslation(context.Context, *SetTranslationRequest) (*SetTranslationReply, error)
+	SetImages(context.Context, *SetImagesRequest) (*SetImagesReply, error)
+	ReorderImages(context.Context, *ReorderImagesRequest) (*ReorderImagesReply, error)
+	ManageAttachments(context.Context, *ManageAttachmentsRequest) (*ManageAttachmentsReply, error)
+	LinkProducts(context.Context, *LinkProductsRequest) (*LinkProductsReply, error)
+	UnlinkProducts(context.Context, *UnlinkProductsRequest) (*UnlinkProductsReply, error)
+	SubscribeToNotifications(context.Context, *SubscribeToNotificationsRequest) (*SubscribeToNotificationsReply, error)
+	UnsubscribeFromNotifications(context.Context, *UnsubscribeFromNotificationsRequest) (*UnsubscribeFromNotificationsReply, error)
+	CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignReply, error)
+	ActivateCampaign(context.Context, *ActivateCampaignRequest) (*ActivateCampaignReply, error)
+	EndCampaign(context.Context, *EndCampaignRequest) (*EndCampaignReply, error)
+	CreatePriceList(context.Context, *CreatePriceListRequest) (*CreatePriceListReply, error)
+	SetPriceListEntries(context.Context, *SetPriceListEntriesRequest) (*SetPriceListEntriesReply, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyReply, error)
+	RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*RotateAPIKeyReply, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyReply, error)
+	// Queries
+	GetProduct(context.Context, *GetProductRequest) (*GetProductReply, error)
+	GetProductBySlug(context.Context, *GetProductBySlugRequest) (*GetProductBySlugReply, error)
+	GetProductBySKU(context.Context, *GetProductBySKURequest) (*GetProductBySKUReply, error)
+	BatchGetProducts(context.Context, *BatchGetProductsRequest) (*BatchGetProductsReply, error)
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error)
+	ListAllProducts(*ListAllProductsRequest, grpc.ServerStreamingServer[ProductSummary]) error
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsReply, error)
+	GetEffectivePrices(context.Context, *GetEffectivePricesRequest) (*GetEffectivePricesReply, error)
+	GetPriceForQuantity(context.Context, *GetPriceForQuantityRequest) (*GetPriceForQuantityReply, error)
+	GetTaxInclusivePrice(context.Context, *GetTaxInclusivePriceRequest) (*GetTaxInclusivePriceReply, error)
+	GetMargin(context.Context, *GetMarginRequest) (*GetMarginReply, error)
+	CalculatePrice(context.Context, *CalculatePriceRequest) (*CalculatePriceReply, error)
+	GetPriceListPrice(context.Context, *GetPriceListPriceRequest) (*GetPriceListPriceReply, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryReply, error)
+	GetProductRevisions(context.Context, *GetProductRevisionsRequest) (*GetProductRevisionsReply, error)
+	GetProductAtRevision(context.Context, *GetProductAtRevisionRequest) (*GetProductAtRevisionReply, error)
+	GetRelatedProducts(context.Context, *GetRelatedProductsRequest) (*GetRelatedProductsReply, error)
+	VerifyPriceLock(context.Context, *VerifyPriceLockRequest) (*VerifyPriceLockReply, error)
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+// UnimplementedProductServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductServiceServer struct{}
+
+func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) CloneProduct(context.Context, *CloneProductRequest) (*CloneProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneProduct not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ChangeBasePrice(context.Context, *ChangeBasePriceRequest) (*ChangeBasePriceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangeBasePrice not implemented")
+}
+func (UnimplementedProductServiceServer) SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*SchedulePriceChangeReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) CancelPriceChange(context.Context, *CancelPriceChangeRequest) (*CancelPriceChangeReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelPriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) ActivateProduct(context.Context, *ActivateProductRequest) (*ActivateProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ActivateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) DeactivateProduct(context.Context, *DeactivateProductRequest) (*DeactivateProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ScheduleActivation(context.Context, *ScheduleActivationRequest) (*ScheduleActivationReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScheduleActivation not implemented")
+}
+func (UnimplementedProductServiceServer) SubmitForReview(context.Context, *SubmitForReviewRequest) (*SubmitForReviewReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitForReview not implemented")
+}
+func (UnimplementedProductServiceServer) ApproveProduct(context.Context, *ApproveProductRequest) (*ApproveProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveProduct not implemented")
+}
+func (UnimplementedProductServiceServer) RejectProduct(context.Context, *RejectProductRequest) (*RejectProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ArchiveProduct(context.Context, *ArchiveProductRequest) (*ArchiveProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ApplyDiscount(context.Context, *ApplyDiscountRequest) (*ApplyDiscountReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyDiscount not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveDiscount not implemented")
+}
+func (UnimplementedProductServiceServer) ApproveDiscount(context.Context, *ApproveDiscountRequest) (*ApproveDiscountReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveDiscount not implemented")
+}
+func (UnimplementedProductServiceServer) ApplyDiscountToCategory(context.Context, *ApplyDiscountToCategoryRequest) (*ApplyDiscountToCategoryReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyDiscountToCategory not implemented")
+}
+func (UnimplementedProductServiceServer) SetPriceTiers(context.Context, *SetPriceTiersRequest) (*SetPriceTiersReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPriceTiers not implemented")
+}
+func (UnimplementedProductServiceServer) SetPriceBook(context.Context, *SetPriceBookRequest) (*SetPriceBookReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPriceBook not implemented")
+}
+func (UnimplementedProductServiceServer) SetSegmentPrices(context.Context, *SetSegmentPricesRequest) (*SetSegmentPricesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSegmentPrices not implemented")
+}
+func (UnimplementedProductServiceServer) SetMarketPrices(context.Context, *SetMarketPricesRequest) (*SetMarketPricesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMarketPrices not implemented")
+}
+func (UnimplementedProductServiceServer) SetTaxClass(context.Context, *SetTaxClassRequest) (*SetTaxClassReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTaxClass not implemented")
+}
+func (UnimplementedProductServiceServer) SetMinimumPrice(context.Context, *SetMinimumPriceRequest) (*SetMinimumPriceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMinimumPrice not implemented")
+}
+func (UnimplementedProductServiceServer) SetCostPrice(context.Context, *SetCostPriceRequest) (*SetCostPriceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCostPrice not implemented")
+}
+func (UnimplementedProductServiceServer) AddVariant(context.Context, *AddVariantRequest) (*AddVariantReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddVariant not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateVariant(context.Context, *UpdateVariantRequest) (*UpdateVariantReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateVariant not implemented")
+}
+func (UnimplementedProductServiceServer) DiscontinueVariant(context.Context, *DiscontinueVariantRequest) (*DiscontinueVariantReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscontinueVariant not implemented")
+}
+func (UnimplementedProductServiceServer) SetStock(context.Context, *SetStockRequest) (*SetStockReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetStock not implemented")
+}
+func (UnimplementedProductServiceServer) AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedProductServiceServer) AddTag(context.Context, *AddTagRequest) (*AddTagReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTag not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveTag(context.Context, *RemoveTagRequest) (*RemoveTagReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveTag not implemented")
+}
+func (UnimplementedProductServiceServer) SetAttribute(context.Context, *SetAttributeRequest) (*SetAttributeReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAttribute not implemented")
+}
+func (UnimplementedProductServiceServer) SetSlug(context.Context, *SetSlugRequest) (*SetSlugReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSlug not implemented")
+}
+func (UnimplementedProductServiceServer) SetIdentifiers(context.Context, *SetIdentifiersRequest) (*SetIdentifiersReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetIdentifiers not implemented")
+}
+func (UnimplementedProductServiceServer) SetDimensions(context.Context, *SetDimensionsRequest) (*SetDimensionsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDimensions not implemented")
+}
+func (UnimplementedProductServiceServer) SetKind(context.Context, *SetKindRequest) (*SetKindReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetKind not implemented")
+}
+func (UnimplementedProductServiceServer) SetCompliance(context.Context, *SetComplianceRequest) (*SetComplianceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCompliance not implemented")
+}
+func (UnimplementedProductServiceServer) SetChannelAvailability(context.Context, *SetChannelAvailabilityRequest) (*SetChannelAvailabilityReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetChannelAvailability not implemented")
+}
+func (UnimplementedProductServiceServer) IngestReview(context.Context, *IngestReviewRequest) (*IngestReviewReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method IngestReview not implemented")
+}
+func (UnimplementedProductServiceServer) SetTranslation(context.Context, *SetTranslationRequest) (*SetTranslationReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTranslation not implemented")
+}
+func (UnimplementedProductServiceServer) SetImages(context.Context, *SetImagesRequest) (*SetImagesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetImages not implemented")
+}
+func (UnimplementedProductServiceServer) ReorderImages(context.Context, *ReorderImagesRequest) (*ReorderImagesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderImages not implemented")
+}
+func (UnimplementedProductServiceServer) ManageAttachments(context.Context, *ManageAttachmentsRequest) (*ManageAttachmentsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ManageAttachments not implemented")
+}
+func (UnimplementedProductServiceServer) LinkProducts(context.Context, *LinkProductsRequest) (*LinkProductsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method LinkProducts not implemented")
+}
+func (UnimplementedProductServiceServer) UnlinkProducts(context.Context, *UnlinkProductsRequest) (*UnlinkProductsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlinkProducts not implemented")
+}
+func (UnimplementedProductServiceServer) SubscribeToNotifications(context.Context, *SubscribeToNotificationsRequest) (*SubscribeToNotificationsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubscribeToNotifications not implemented")
+}
+func (UnimplementedProductServiceServer) UnsubscribeFromNotifications(context.Context, *UnsubscribeFromNotificationsRequest) (*UnsubscribeFromNotificationsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnsubscribeFromNotifications not implemented")
+}
+func (UnimplementedProductServiceServer) CreateCampaign(context.Context, *CreateCampaignRequest) (*CreateCampaignReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (UnimplementedProductServiceServer) ActivateCampaign(context.Context, *ActivateCampaignRequest) (*ActivateCampaignReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ActivateCampaign not implemented")
+}
+func (UnimplementedProductServiceServer) EndCampaign(context.Context, *EndCampaignRequest) (*EndCampaignReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method EndCampaign not implemented")
+}
+func (UnimplementedProductServiceServer) CreatePriceList(context.Context, *CreatePriceListRequest) (*CreatePriceListReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePriceList not implemented")
+}
+func (UnimplementedProductServiceServer) SetPriceListEntries(context.Context, *SetPriceListEntriesRequest) (*SetPriceListEntriesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPriceListEntries not implemented")
+}
+func (UnimplementedProductServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedProductServiceServer) RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*RotateAPIKeyReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateAPIKey not implemented")
+}
+func (UnimplementedProductServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductBySlug(context.Context, *GetProductBySlugRequest) (*GetProductBySlugReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductBySlug not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductBySKU(context.Context, *GetProductBySKURequest) (*GetProductBySKUReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductBySKU not implemented")
+}
+func (UnimplementedProductServiceServer) BatchGetProducts(context.Context, *BatchGetProductsRequest) (*BatchGetProductsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchGetProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ListAllProducts(*ListAllProductsRequest, grpc.ServerStreamingServer[ProductSummary]) error {
+	return status.Error(codes.Unimplemented, "method ListAllProducts not implemented")
+}
+func (UnimplementedProductServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedProductServiceServer) GetEffectivePrices(context.Context, *GetEffectivePricesRequest) (*GetEffectivePricesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEffectivePrices not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceForQuantity(context.Context, *GetPriceForQuantityRequest) (*GetPriceForQuantityReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPriceForQuantity not implemented")
+}
+func (UnimplementedProductServiceServer) GetTaxInclusivePrice(context.Context, *GetTaxInclusivePriceRequest) (*GetTaxInclusivePriceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTaxInclusivePrice not implemented")
+}
+func (UnimplementedProductServiceServer) GetMargin(context.Context, *GetMarginRequest) (*GetMarginReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMargin not implemented")
+}
+func (UnimplementedProductServiceServer) CalculatePrice(context.Context, *CalculatePriceRequest) (*CalculatePriceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CalculatePrice not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceListPrice(context.Context, *GetPriceListPriceRequest) (*GetPriceListPriceReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPriceListPrice not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductRevisions(context.Context, *GetProductRevisionsRequest) (*GetProductRevisionsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductRevisions not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductAtRevision(context.Context, *GetProductAtRevisionRequest) (*GetProductAtRevisionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductAtRevision not implemented")
+}
+func (UnimplementedProductServiceServer) GetRelatedProducts(context.Context, *GetRelatedProductsRequest) (*GetRelatedProductsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRelatedProducts not implemented")
+}
+func (UnimplementedProductServiceServer) VerifyPriceLock(context.Context, *VerifyPriceLockRequest) (*VerifyPriceLockReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyPriceLock not implemented")
+}
+func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
+func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductServiceServer will
+// result in compilation errors.
+type UnsafeProductServiceServer interface {
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
+	// If the following call panics, it indicates UnimplementedProductServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProductService_ServiceDesc, srv)
+}
+
+func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CloneProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CloneProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CloneProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CloneProduct(ctx, req.(*CloneProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ChangeBasePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeBasePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ChangeBasePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ChangeBasePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ChangeBasePrice(ctx, req.(*ChangeBasePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SchedulePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SchedulePriceChange(ctx, req.(*SchedulePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CancelPriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CancelPriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CancelPriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CancelPriceChange(ctx, req.(*CancelPriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ActivateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ActivateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ActivateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ActivateProduct(ctx, req.(*ActivateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeactivateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeactivateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeactivateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeactivateProduct(ctx, req.(*DeactivateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ScheduleActivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleActivationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ScheduleActivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ScheduleActivation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ScheduleActivation(ctx, req.(*ScheduleActivationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SubmitForReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitForReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SubmitForReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SubmitForReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SubmitForReview(ctx, req.(*SubmitForReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ApproveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ApproveProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ApproveProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ApproveProduct(ctx, req.(*ApproveProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RejectProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RejectProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RejectProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RejectProduct(ctx, req.(*RejectProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ArchiveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ArchiveProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ArchiveProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ArchiveProduct(ctx, req.(*ArchiveProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ApplyDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ApplyDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ApplyDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ApplyDiscount(ctx, req.(*ApplyDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RemoveDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RemoveDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RemoveDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RemoveDiscount(ctx, req.(*RemoveDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ApproveDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ApproveDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ApproveDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ApproveDiscount(ctx, req.(*ApproveDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ApplyDiscountToCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDiscountToCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ApplyDiscountToCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ApplyDiscountToCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ApplyDiscountToCategory(ctx, req.(*ApplyDiscountToCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPriceTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPriceTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPriceTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetPriceTiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPriceTiers(ctx, req.(*SetPriceTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPriceBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPriceBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPriceBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetPriceBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPriceBook(ctx, req.(*SetPriceBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetSegmentPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSegmentPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetSegmentPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetSegmentPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetSegmentPrices(ctx, req.(*SetSegmentPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetMarketPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMarketPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetMarketPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetMarketPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetMarketPrices(ctx, req.(*SetMarketPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetTaxClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaxClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetTaxClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetTaxClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetTaxClass(ctx, req.(*SetTaxClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetMinimumPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMinimumPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetMinimumPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetMinimumPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetMinimumPrice(ctx, req.(*SetMinimumPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetCostPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCostPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetCostPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetCostPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetCostPrice(ctx, req.(*SetCostPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AddVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AddVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AddVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AddVariant(ctx, req.(*AddVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateVariant(ctx, req.(*UpdateVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DiscontinueVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscontinueVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DiscontinueVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DiscontinueVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DiscontinueVariant(ctx, req.(*DiscontinueVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetStock(ctx, req.(*SetStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AdjustStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AdjustStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AdjustStock(ctx, req.(*AdjustStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AddTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AddTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AddTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AddTag(ctx, req.(*AddTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RemoveTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RemoveTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RemoveTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RemoveTag(ctx, req.(*RemoveTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetAttribute(ctx, req.(*SetAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetSlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetSlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetSlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetSlug(ctx, req.(*SetSlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetIdentifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIdentifiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetIdentifiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetIdentifiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetIdentifiers(ctx, req.(*SetIdentifiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetDimensions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDimensionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetDimensions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetDimensions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetDimensions(ctx, req.(*SetDimensionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetKind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetKind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetKind_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetKind(ctx, req.(*SetKindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetCompliance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetComplianceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetCompliance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetCompliance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetCompliance(ctx, req.(*SetComplianceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetChannelAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChannelAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetChannelAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetChannelAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetChannelAvailability(ctx, req.(*SetChannelAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_IngestReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngestReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).IngestReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_IngestReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).IngestReview(ctx, req.(*IngestReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetTranslation(ctx, req.(*SetTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetImages(ctx, req.(*SetImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReorderImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReorderImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReorderImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReorderImages(ctx, req.(*ReorderImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ManageAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManageAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ManageAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ManageAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ManageAttachments(ctx, req.(*ManageAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_LinkProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).LinkProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_LinkProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).LinkProducts(ctx, req.(*LinkProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UnlinkProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UnlinkProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UnlinkProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UnlinkProducts(ctx, req.(*UnlinkProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SubscribeToNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeToNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SubscribeToNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SubscribeToNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SubscribeToNotifications(ctx, req.(*SubscribeToNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UnsubscribeFromNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeFromNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UnsubscribeFromNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UnsubscribeFromNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UnsubscribeFromNotifications(ctx, req.(*UnsubscribeFromNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateCampaign(ctx, req.(*CreateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ActivateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ActivateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ActivateCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ActivateCampaign(ctx, req.(*ActivateCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_EndCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).EndCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_EndCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).EndCampaign(ctx, req.(*EndCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreatePriceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePriceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreatePriceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreatePriceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreatePriceList(ctx, req.(*CreatePriceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPriceListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPriceListEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPriceListEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetPriceListEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPriceListEntries(ctx, req.(*SetPriceListEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RotateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RotateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RotateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RotateAPIKey(ctx, req.(*RotateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductBySlug(ctx, req.(*GetProductBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductBySKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductBySKURequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductBySKU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductBySKU_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductBySKU(ctx, req.(*GetProductBySKURequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_BatchGetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BatchGetProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BatchGetProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BatchGetProducts(ctx, req.(*BatchGetProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method RemoveDiscount not implemented")
+
+func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*GetProductReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetProduct not implemented")
+
+func _ProductService_ListAllProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAllProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).ListAllProducts(m, &grpc.GenericServerStream[ListAllProductsRequest, ProductSummary]{ServerStream: stream})
 }
-func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsReply, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListProducts not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ListAllProductsServer = grpc.ServerStreamingServer[ProductSummary]
+
+func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
-func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ProductServiceServer will
-// result in compilation errors.
-type UnsafeProductServiceServer interface {
-	mustEmbedUnimplementedProductServiceServer()
+func _ProductService_GetEffectivePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectivePricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetEffectivePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetEffectivePrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetEffectivePrices(ctx, req.(*GetEffectivePricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
-	// If the following call panics, it indicates UnimplementedProductServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ProductService_GetPriceForQuantity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceForQuantityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ProductService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPriceForQuantity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetPriceForQuantity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPriceForQuantity(ctx, req.(*GetPriceForQuantityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProductRequest)
+func _ProductService_GetTaxInclusivePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaxInclusivePriceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+		return srv.(ProductServiceServer).GetTaxInclusivePrice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateProduct_FullMethodName,
+		FullMethod: ProductService_GetTaxInclusivePrice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+		return srv.(ProductServiceServer).GetTaxInclusivePrice(ctx, req.(*GetTaxInclusivePriceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductRequest)
+func _ProductService_GetMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarginRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+		return srv.(ProductServiceServer).GetMargin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateProduct_FullMethodName,
+		FullMethod: ProductService_GetMargin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+		return srv.(ProductServiceServer).GetMargin(ctx, req.(*GetMarginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ActivateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateProductRequest)
+func _ProductService_CalculatePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculatePriceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ActivateProduct(ctx, in)
+		return srv.(ProductServiceServer).CalculatePrice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ActivateProduct_FullMethodName,
+		FullMethod: ProductService_CalculatePrice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ActivateProduct(ctx, req.(*ActivateProductRequest))
+		return srv.(ProductServiceServer).CalculatePrice(ctx, req.(*CalculatePriceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeactivateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeactivateProductRequest)
+func _ProductService_GetPriceListPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceListPriceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeactivateProduct(ctx, in)
+		return srv.(ProductServiceServer).GetPriceListPrice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeactivateProduct_FullMethodName,
+		FullMethod: ProductService_GetPriceListPrice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeactivateProduct(ctx, req.(*DeactivateProductRequest))
+		return srv.(ProductServiceServer).GetPriceListPrice(ctx, req.(*GetPriceListPriceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ArchiveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArchiveProductRequest)
+func _ProductService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ArchiveProduct(ctx, in)
+		return srv.(ProductServiceServer).GetPriceHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ArchiveProduct_FullMethodName,
+		FullMethod: ProductService_GetPriceHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ArchiveProduct(ctx, req.(*ArchiveProductRequest))
+		return srv.(ProductServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ApplyDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyDiscountRequest)
+func _ProductService_GetProductRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ApplyDiscount(ctx, in)
+		return srv.(ProductServiceServer).GetProductRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ApplyDiscount_FullMethodName,
+		FullMethod: ProductService_GetProductRevisions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ApplyDiscount(ctx, req.(*ApplyDiscountRequest))
+		return srv.(ProductServiceServer).GetProductRevisions(ctx, req.(*GetProductRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_RemoveDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveDiscountRequest)
+func _ProductService_GetProductAtRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductAtRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).RemoveDiscount(ctx, in)
+		return srv.(ProductServiceServer).GetProductAtRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_RemoveDiscount_FullMethodName,
+		FullMethod: ProductService_GetProductAtRevision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).RemoveDiscount(ctx, req.(*RemoveDiscountRequest))
+		return srv.(ProductServiceServer).GetProductAtRevision(ctx, req.(*GetProductAtRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductRequest)
+func _ProductService_GetRelatedProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedProductsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetProduct(ctx, in)
+		return srv.(ProductServiceServer).GetRelatedProducts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetProduct_FullMethodName,
+		FullMethod: ProductService_GetRelatedProducts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+		return srv.(ProductServiceServer).GetRelatedProducts(ctx, req.(*GetRelatedProductsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProductsRequest)
+func _ProductService_VerifyPriceLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPriceLockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListProducts(ctx, in)
+		return srv.(ProductServiceServer).VerifyPriceLock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListProducts_FullMethodName,
+		FullMethod: ProductService_VerifyPriceLock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+		return srv.(ProductServiceServer).VerifyPriceLock(ctx, req.(*VerifyPriceLockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -395,10 +2573,26 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateProduct",
 			Handler:    _ProductService_CreateProduct_Handler,
 		},
+		{
+			MethodName: "CloneProduct",
+			Handler:    _ProductService_CloneProduct_Handler,
+		},
 		{
 			MethodName: "UpdateProduct",
 			Handler:    _ProductService_UpdateProduct_Handler,
 		},
+		{
+			MethodName: "ChangeBasePrice",
+			Handler:    _ProductService_ChangeBasePrice_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _ProductService_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "CancelPriceChange",
+			Handler:    _ProductService_CancelPriceChange_Handler,
+		},
 		{
 			MethodName: "ActivateProduct",
 			Handler:    _ProductService_ActivateProduct_Handler,
@@ -407,6 +2601,22 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateProduct",
 			Handler:    _ProductService_DeactivateProduct_Handler,
 		},
+		{
+			MethodName: "ScheduleActivation",
+			Handler:    _ProductService_ScheduleActivation_Handler,
+		},
+		{
+			MethodName: "SubmitForReview",
+			Handler:    _ProductService_SubmitForReview_Handler,
+		},
+		{
+			MethodName: "ApproveProduct",
+			Handler:    _ProductService_ApproveProduct_Handler,
+		},
+		{
+			MethodName: "RejectProduct",
+			Handler:    _ProductService_RejectProduct_Handler,
+		},
 		{
 			MethodName: "ArchiveProduct",
 			Handler:    _ProductService_ArchiveProduct_Handler,
@@ -419,15 +2629,241 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveDiscount",
 			Handler:    _ProductService_RemoveDiscount_Handler,
 		},
+		{
+			MethodName: "ApproveDiscount",
+			Handler:    _ProductService_ApproveDiscount_Handler,
+		},
+		{
+			MethodName: "ApplyDiscountToCategory",
+			Handler:    _ProductService_ApplyDiscountToCategory_Handler,
+		},
+		{
+			MethodName: "SetPriceTiers",
+			Handler:    _ProductService_SetPriceTiers_Handler,
+		},
+		{
+			MethodName: "SetPriceBook",
+			Handler:    _ProductService_SetPriceBook_Handler,
+		},
+		{
+			MethodName: "SetSegmentPrices",
+			Handler:    _ProductService_SetSegmentPrices_Handler,
+		},
+		{
+			MethodName: "SetMarketPrices",
+			Handler:    _ProductService_SetMarketPrices_Handler,
+		},
+		{
+			MethodName: "SetTaxClass",
+			Handler:    _ProductService_SetTaxClass_Handler,
+		},
+		{
+			MethodName: "SetMinimumPrice",
+			Handler:    _ProductService_SetMinimumPrice_Handler,
+		},
+		{
+			MethodName: "SetCostPrice",
+			Handler:    _ProductService_SetCostPrice_Handler,
+		},
+		{
+			MethodName: "AddVariant",
+			Handler:    _ProductService_AddVariant_Handler,
+		},
+		{
+			MethodName: "UpdateVariant",
+			Handler:    _ProductService_UpdateVariant_Handler,
+		},
+		{
+			MethodName: "DiscontinueVariant",
+			Handler:    _ProductService_DiscontinueVariant_Handler,
+		},
+		{
+			MethodName: "SetStock",
+			Handler:    _ProductService_SetStock_Handler,
+		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _ProductService_AdjustStock_Handler,
+		},
+		{
+			MethodName: "AddTag",
+			Handler:    _ProductService_AddTag_Handler,
+		},
+		{
+			MethodName: "RemoveTag",
+			Handler:    _ProductService_RemoveTag_Handler,
+		},
+		{
+			MethodName: "SetAttribute",
+			Handler:    _ProductService_SetAttribute_Handler,
+		},
+		{
+			MethodName: "SetSlug",
+			Handler:    _ProductService_SetSlug_Handler,
+		},
+		{
+			MethodName: "SetIdentifiers",
+			Handler:    _ProductService_SetIdentifiers_Handler,
+		},
+		{
+			MethodName: "SetDimensions",
+			Handler:    _ProductService_SetDimensions_Handler,
+		},
+		{
+			MethodName: "SetKind",
+			Handler:    _ProductService_SetKind_Handler,
+		},
+		{
+			MethodName: "SetCompliance",
+			Handler:    _ProductService_SetCompliance_Handler,
+		},
+		{
+			MethodName: "SetChannelAvailability",
+			Handler:    _ProductService_SetChannelAvailability_Handler,
+		},
+		{
+			MethodName: "IngestReview",
+			Handler:    _ProductService_IngestReview_Handler,
+		},
+		{
+			MethodName: "SetTranslation",
+			Handler:    _ProductService_SetTranslation_Handler,
+		},
+		{
+			MethodName: "SetImages",
+			Handler:    _ProductService_SetImages_Handler,
+		},
+		{
+			MethodName: "ReorderImages",
+			Handler:    _ProductService_ReorderImages_Handler,
+		},
+		{
+			MethodName: "ManageAttachments",
+			Handler:    _ProductService_ManageAttachments_Handler,
+		},
+		{
+			MethodName: "LinkProducts",
+			Handler:    _ProductService_LinkProducts_Handler,
+		},
+		{
+			MethodName: "UnlinkProducts",
+			Handler:    _ProductService_UnlinkProducts_Handler,
+		},
+		{
+			MethodName: "SubscribeToNotifications",
+			Handler:    _ProductService_SubscribeToNotifications_Handler,
+		},
+		{
+			MethodName: "UnsubscribeFromNotifications",
+			Handler:    _ProductService_UnsubscribeFromNotifications_Handler,
+		},
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _ProductService_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "ActivateCampaign",
+			Handler:    _ProductService_ActivateCampaign_Handler,
+		},
+		{
+			MethodName: "EndCampaign",
+			Handler:    _ProductService_EndCampaign_Handler,
+		},
+		{
+			MethodName: "CreatePriceList",
+			Handler:    _ProductService_CreatePriceList_Handler,
+		},
+		{
+			MethodName: "SetPriceListEntries",
+			Handler:    _ProductService_SetPriceListEntries_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _ProductService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "RotateAPIKey",
+			Handler:    _ProductService_RotateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _ProductService_RevokeAPIKey_Handler,
+		},
 		{
 			MethodName: "GetProduct",
 			Handler:    _ProductService_GetProduct_Handler,
 		},
+		{
+			MethodName: "GetProductBySlug",
+			Handler:    _ProductService_GetProductBySlug_Handler,
+		},
+		{
+			MethodName: "GetProductBySKU",
+			Handler:    _ProductService_GetProductBySKU_Handler,
+		},
+		{
+			MethodName: "BatchGetProducts",
+			Handler:    _ProductService_BatchGetProducts_Handler,
+		},
 		{
 			MethodName: "ListProducts",
 			Handler:    _ProductService_ListProducts_Handler,
 		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductService_SearchProducts_Handler,
+		},
+		{
+			MethodName: "GetEffectivePrices",
+			Handler:    _ProductService_GetEffectivePrices_Handler,
+		},
+		{
+			MethodName: "GetPriceForQuantity",
+			Handler:    _ProductService_GetPriceForQuantity_Handler,
+		},
+		{
+			MethodName: "GetTaxInclusivePrice",
+			Handler:    _ProductService_GetTaxInclusivePrice_Handler,
+		},
+		{
+			MethodName: "GetMargin",
+			Handler:    _ProductService_GetMargin_Handler,
+		},
+		{
+			MethodName: "CalculatePrice",
+			Handler:    _ProductService_CalculatePrice_Handler,
+		},
+		{
+			MethodName: "GetPriceListPrice",
+			Handler:    _ProductService_GetPriceListPrice_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _ProductService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetProductRevisions",
+			Handler:    _ProductService_GetProductRevisions_Handler,
+		},
+		{
+			MethodName: "GetProductAtRevision",
+			Handler:    _ProductService_GetProductAtRevision_Handler,
+		},
+		{
+			MethodName: "GetRelatedProducts",
+			Handler:    _ProductService_GetRelatedProducts_Handler,
+		},
+		{
+			MethodName: "VerifyPriceLock",
+			Handler:    _ProductService_VerifyPriceLock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListAllProducts",
+			Handler:       _ProductService_ListAllProducts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/product/v1/product_service.proto",
 }
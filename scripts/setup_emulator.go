@@ -120,6 +120,342 @@ func createDatabase(ctx context.Context, opts []option.ClientOption) error {
 			) PRIMARY KEY (event_id)`,
 			`CREATE INDEX idx_outbox_status ON outbox_events(status, created_at)`,
 			`CREATE INDEX idx_products_category ON products(category, status)`,
+			// migrations/002_discount_suspension.sql
+			`ALTER TABLE products ADD COLUMN discount_suspended_at TIMESTAMP`,
+			// migrations/003_notification_subscriptions.sql
+			`CREATE TABLE notification_subscriptions (
+				product_id STRING(36) NOT NULL,
+				kind STRING(20) NOT NULL,
+				subscriber_id STRING(100) NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+			) PRIMARY KEY (product_id, kind, subscriber_id),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/004_discount_phase.sql
+			`ALTER TABLE products ADD COLUMN discount_phase STRING(20)`,
+			// migrations/005_product_discounts.sql
+			`CREATE TABLE product_discounts (
+				product_id STRING(36) NOT NULL,
+				discount_id STRING(36) NOT NULL,
+				percentage NUMERIC NOT NULL,
+				priority INT64 NOT NULL,
+				start_date TIMESTAMP NOT NULL,
+				end_date TIMESTAMP NOT NULL,
+				suspended_at TIMESTAMP,
+				phase STRING(20) NOT NULL,
+			) PRIMARY KEY (product_id, discount_id),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/006_product_price_tiers.sql
+			`CREATE TABLE product_price_tiers (
+				product_id STRING(36) NOT NULL,
+				min_quantity INT64 NOT NULL,
+				unit_price_numerator INT64,
+				unit_price_denominator INT64,
+				percent_off NUMERIC,
+			) PRIMARY KEY (product_id, min_quantity),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/007_product_currency.sql
+			`ALTER TABLE products ADD COLUMN currency STRING(3)`,
+			// migrations/008_product_prices.sql
+			`CREATE TABLE product_prices (
+				product_id STRING(36) NOT NULL,
+				currency STRING(3) NOT NULL,
+				price_numerator INT64 NOT NULL,
+				price_denominator INT64 NOT NULL,
+			) PRIMARY KEY (product_id, currency),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/009_merchandising_ranks.sql
+			`CREATE TABLE merchandising_ranks (
+				category STRING(100) NOT NULL,
+				product_id STRING(36) NOT NULL,
+				rank INT64 NOT NULL,
+				updated_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+			) PRIMARY KEY (category, product_id)`,
+			// migrations/010_product_tax_class.sql
+			`ALTER TABLE products ADD COLUMN tax_class STRING(50)`,
+			// migrations/011_price_history.sql
+			`CREATE TABLE price_history (
+				product_id STRING(36) NOT NULL,
+				changed_at TIMESTAMP NOT NULL,
+				change_id STRING(36) NOT NULL,
+				change_type STRING(100) NOT NULL,
+				base_price_numerator INT64 NOT NULL,
+				base_price_denominator INT64 NOT NULL,
+				effective_price_numerator INT64 NOT NULL,
+				effective_price_denominator INT64 NOT NULL,
+				currency STRING(3) NOT NULL,
+				discount_id STRING(36),
+				discount_percent NUMERIC,
+			) PRIMARY KEY (product_id, changed_at, change_id),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/012_effective_prices.sql
+			`CREATE TABLE effective_prices (
+				product_id STRING(36) NOT NULL,
+				valid_from TIMESTAMP NOT NULL,
+				valid_until TIMESTAMP,
+				effective_price_numerator INT64 NOT NULL,
+				effective_price_denominator INT64 NOT NULL,
+				discount_id STRING(36),
+				discount_percent NUMERIC,
+			) PRIMARY KEY (product_id, valid_from),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/013_freeze_windows.sql
+			`CREATE TABLE freeze_windows (
+				freeze_id STRING(36) NOT NULL,
+				tenant_id STRING(100),
+				start_time TIMESTAMP NOT NULL,
+				end_time TIMESTAMP NOT NULL,
+				exempt_roles ARRAY<STRING(50)>,
+				reason STRING(MAX),
+				created_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+			) PRIMARY KEY (freeze_id)`,
+			// migrations/014_campaigns.sql
+			`CREATE TABLE campaigns (
+				campaign_id STRING(36) NOT NULL,
+				name STRING(255) NOT NULL,
+				category STRING(100),
+				product_ids ARRAY<STRING(36)>,
+				percentage NUMERIC NOT NULL,
+				priority INT64 NOT NULL,
+				start_date TIMESTAMP NOT NULL,
+				end_date TIMESTAMP NOT NULL,
+				status STRING(20) NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+				updated_at TIMESTAMP NOT NULL,
+			) PRIMARY KEY (campaign_id)`,
+			// migrations/015_api_keys.sql
+			`CREATE TABLE api_keys (
+				key_id STRING(36) NOT NULL,
+				client_id STRING(100) NOT NULL,
+				key_hash BYTES(32) NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+				expires_at TIMESTAMP,
+				revoked_at TIMESTAMP,
+			) PRIMARY KEY (key_id)`,
+			// migrations/016_discount_promotions.sql
+			`ALTER TABLE product_discounts ADD COLUMN kind STRING(20)`,
+			`ALTER TABLE product_discounts ADD COLUMN buy_quantity INT64`,
+			`ALTER TABLE product_discounts ADD COLUMN get_quantity INT64`,
+			// migrations/017_product_segment_prices.sql
+			`CREATE TABLE product_segment_prices (
+				product_id STRING(36) NOT NULL,
+				segment STRING(50) NOT NULL,
+				price_numerator INT64,
+				price_denominator INT64,
+				percent_off NUMERIC,
+			) PRIMARY KEY (product_id, segment),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/018_product_market_prices.sql
+			`CREATE TABLE product_market_prices (
+				product_id STRING(36) NOT NULL,
+				market STRING(2) NOT NULL,
+				price_numerator INT64 NOT NULL,
+				price_denominator INT64 NOT NULL,
+			) PRIMARY KEY (product_id, market),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			`ALTER TABLE product_discounts ADD COLUMN market STRING(2)`,
+			// migrations/019_price_lists.sql
+			`CREATE TABLE price_lists (
+				price_list_id STRING(36) NOT NULL,
+				name STRING(255) NOT NULL,
+				currency STRING(3) NOT NULL,
+				valid_from TIMESTAMP NOT NULL,
+				valid_until TIMESTAMP,
+				created_at TIMESTAMP NOT NULL,
+				updated_at TIMESTAMP NOT NULL,
+			) PRIMARY KEY (price_list_id)`,
+			`CREATE TABLE price_list_entries (
+				price_list_id STRING(36) NOT NULL,
+				product_id STRING(36) NOT NULL,
+				price_numerator INT64 NOT NULL,
+				price_denominator INT64 NOT NULL,
+			) PRIMARY KEY (price_list_id, product_id),
+			  INTERLEAVE IN PARENT price_lists ON DELETE CASCADE`,
+			// migrations/020_product_minimum_price.sql
+			`ALTER TABLE products ADD COLUMN minimum_price_numerator INT64`,
+			`ALTER TABLE products ADD COLUMN minimum_price_denominator INT64`,
+			// migrations/021_product_cost_price.sql
+			`ALTER TABLE products ADD COLUMN cost_price_numerator INT64`,
+			`ALTER TABLE products ADD COLUMN cost_price_denominator INT64`,
+			// migrations/022_product_pending_price_change.sql
+			`ALTER TABLE products ADD COLUMN pending_price_numerator INT64`,
+			`ALTER TABLE products ADD COLUMN pending_price_denominator INT64`,
+			`ALTER TABLE products ADD COLUMN pending_price_effective_at TIMESTAMP`,
+			`CREATE NULL_FILTERED INDEX idx_products_pending_price ON products(pending_price_effective_at)`,
+			// migrations/023_discount_approval.sql
+			`ALTER TABLE product_discounts ADD COLUMN pending_approval BOOL`,
+			`ALTER TABLE product_discounts ADD COLUMN approved_by STRING(256)`,
+			`ALTER TABLE product_discounts ADD COLUMN approved_at TIMESTAMP`,
+			// migrations/024_discount_blackouts.sql
+			`CREATE TABLE discount_blackouts (
+				blackout_id STRING(36) NOT NULL,
+				category STRING(100) NOT NULL,
+				start_time TIMESTAMP NOT NULL,
+				end_time TIMESTAMP NOT NULL,
+				reason STRING(MAX),
+				created_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+			) PRIMARY KEY (blackout_id)`,
+			// migrations/025_discount_flash_sale.sql
+			`ALTER TABLE product_discounts ADD COLUMN flash_sale BOOL`,
+			// migrations/026_discount_sale_price.sql
+			`ALTER TABLE product_discounts ADD COLUMN sale_price_numerator INT64`,
+			`ALTER TABLE product_discounts ADD COLUMN sale_price_denominator INT64`,
+			// migrations/027_product_variants.sql
+			`CREATE TABLE product_variants (
+				product_id STRING(36) NOT NULL,
+				variant_id STRING(36) NOT NULL,
+				sku STRING(64) NOT NULL,
+				attributes JSON,
+				price_delta_numerator INT64 NOT NULL,
+				price_delta_denominator INT64 NOT NULL,
+				status STRING(20) NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+				updated_at TIMESTAMP NOT NULL,
+			) PRIMARY KEY (product_id, variant_id),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			`CREATE UNIQUE INDEX idx_product_variants_sku ON product_variants(sku)`,
+			// migrations/028_product_stock.sql
+			`CREATE TABLE product_stock (
+				product_id STRING(36) NOT NULL,
+				stock_level INT64 NOT NULL,
+				reserved INT64 NOT NULL,
+				version INT64 NOT NULL,
+				updated_at TIMESTAMP NOT NULL,
+			) PRIMARY KEY (product_id),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/029_product_tags_attributes.sql
+			`ALTER TABLE products ADD COLUMN tags ARRAY<STRING(50)>`,
+			`ALTER TABLE products ADD COLUMN attributes JSON`,
+			// migrations/030_product_images.sql
+			`CREATE TABLE product_images (
+				product_id STRING(36) NOT NULL,
+				position INT64 NOT NULL,
+				url STRING(2048) NOT NULL,
+				alt_text STRING(250),
+				is_primary BOOL NOT NULL,
+			) PRIMARY KEY (product_id, position),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/031_product_relations.sql
+			`CREATE TABLE product_relations (
+				product_id STRING(36) NOT NULL,
+				relation_type STRING(20) NOT NULL,
+				related_product_id STRING(36) NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+			) PRIMARY KEY (product_id, relation_type, related_product_id),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/032_product_translations.sql
+			`CREATE TABLE product_translations (
+				product_id STRING(36) NOT NULL,
+				locale STRING(10) NOT NULL,
+				name STRING(255) NOT NULL,
+				description STRING(MAX),
+				updated_at TIMESTAMP NOT NULL,
+			) PRIMARY KEY (product_id, locale),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/033_product_slugs.sql
+			`ALTER TABLE products ADD COLUMN slug STRING(100)`,
+			`CREATE UNIQUE NULL_FILTERED INDEX idx_products_slug ON products(slug)`,
+			// migrations/034_product_identifiers.sql
+			`ALTER TABLE products ADD COLUMN sku STRING(64)`,
+			`ALTER TABLE products ADD COLUMN gtin STRING(14)`,
+			`CREATE UNIQUE NULL_FILTERED INDEX idx_products_sku ON products(sku)`,
+			`CREATE UNIQUE NULL_FILTERED INDEX idx_products_gtin ON products(gtin)`,
+			// migrations/035_product_status_schedule.sql
+			`ALTER TABLE products ADD COLUMN activate_at TIMESTAMP`,
+			`ALTER TABLE products ADD COLUMN deactivate_at TIMESTAMP`,
+			`CREATE NULL_FILTERED INDEX idx_products_activate_at ON products(activate_at)`,
+			`CREATE NULL_FILTERED INDEX idx_products_deactivate_at ON products(deactivate_at)`,
+			// migrations/036_product_review.sql
+			`ALTER TABLE products ADD COLUMN submitted_by STRING(256)`,
+			`ALTER TABLE products ADD COLUMN reviewed_by STRING(256)`,
+			`ALTER TABLE products ADD COLUMN rejection_reason STRING(MAX)`,
+			// migrations/037_category_attribute_schemas.sql
+			`CREATE TABLE category_attribute_schemas (
+				category STRING(100) NOT NULL,
+				attributes JSON NOT NULL,
+				updated_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+			) PRIMARY KEY (category)`,
+			// migrations/038_product_dimensions.sql
+			`ALTER TABLE products ADD COLUMN weight FLOAT64`,
+			`ALTER TABLE products ADD COLUMN weight_unit STRING(2)`,
+			`ALTER TABLE products ADD COLUMN height FLOAT64`,
+			`ALTER TABLE products ADD COLUMN width FLOAT64`,
+			`ALTER TABLE products ADD COLUMN depth FLOAT64`,
+			`ALTER TABLE products ADD COLUMN dimension_unit STRING(2)`,
+			// migrations/039_product_ratings.sql
+			`ALTER TABLE products ADD COLUMN rating_count INT64`,
+			`ALTER TABLE products ADD COLUMN rating_sum INT64`,
+			`CREATE TABLE product_ratings (
+				product_id STRING(36) NOT NULL,
+				review_id STRING(100) NOT NULL,
+				rating INT64 NOT NULL,
+				ingested_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+			) PRIMARY KEY (product_id, review_id),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/040_product_sales_channels.sql
+			`ALTER TABLE products ADD COLUMN unavailable_channels ARRAY<STRING(20)>`,
+			// migrations/041_tenants.sql
+			`ALTER TABLE products ADD COLUMN tenant_id STRING(100)`,
+			`ALTER TABLE campaigns ADD COLUMN tenant_id STRING(100)`,
+			`ALTER TABLE price_lists ADD COLUMN tenant_id STRING(100)`,
+			`ALTER TABLE outbox_events ADD COLUMN tenant_id STRING(100)`,
+			`CREATE INDEX idx_products_tenant_category ON products(tenant_id, category, status)`,
+			// migrations/042_product_revisions.sql
+			`CREATE TABLE product_revisions (
+				product_id STRING(36) NOT NULL,
+				revised_at TIMESTAMP NOT NULL,
+				revision_id STRING(36) NOT NULL,
+				changed_fields ARRAY<STRING(50)> NOT NULL,
+				snapshot JSON NOT NULL,
+			) PRIMARY KEY (product_id, revised_at, revision_id),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/043_product_kinds.sql
+			`ALTER TABLE products ADD COLUMN kind STRING(20)`,
+			// migrations/044_product_compliance.sql
+			`ALTER TABLE products ADD COLUMN minimum_age INT64`,
+			`ALTER TABLE products ADD COLUMN hazardous BOOL`,
+			`ALTER TABLE products ADD COLUMN restricted_markets ARRAY<STRING(2)>`,
+			// migrations/045_product_attachments.sql
+			`CREATE TABLE product_attachments (
+				product_id STRING(36) NOT NULL,
+				position INT64 NOT NULL,
+				attachment_type STRING(20) NOT NULL,
+				url STRING(2048) NOT NULL,
+				locale STRING(10),
+			) PRIMARY KEY (product_id, position),
+			  INTERLEAVE IN PARENT products ON DELETE CASCADE`,
+			// migrations/046_product_search.sql
+			`ALTER TABLE products ADD COLUMN name_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(name)) HIDDEN`,
+			`ALTER TABLE products ADD COLUMN description_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(description)) HIDDEN`,
+			`CREATE SEARCH INDEX idx_products_search ON products(name_tokens, description_tokens)
+			    STORING (category, status, tenant_id)`,
+			// migrations/047_product_summaries.sql
+			`CREATE TABLE product_summaries (
+				product_id STRING(36) NOT NULL,
+				tenant_id STRING(100),
+				name STRING(255) NOT NULL,
+				category STRING(100) NOT NULL,
+				status STRING(20) NOT NULL,
+				min_price_numerator INT64 NOT NULL,
+				min_price_denominator INT64 NOT NULL,
+				max_price_numerator INT64 NOT NULL,
+				max_price_denominator INT64 NOT NULL,
+				summarized_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+			) PRIMARY KEY (product_id)`,
+			`CREATE INDEX idx_product_summaries_category ON product_summaries(tenant_id, category, status)`,
+			// migrations/048_product_quality_scores.sql
+			`CREATE TABLE product_quality_scores (
+				product_id STRING(36) NOT NULL,
+				tenant_id STRING(100),
+				name STRING(255) NOT NULL,
+				category STRING(100) NOT NULL,
+				score INT64 NOT NULL,
+				description_score INT64 NOT NULL,
+				image_score INT64 NOT NULL,
+				attribute_score INT64 NOT NULL,
+				translation_score INT64,
+				scored_at TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+			) PRIMARY KEY (product_id)`,
+			`CREATE INDEX idx_product_quality_scores_score ON product_quality_scores(score)`,
 		},
 	})
 	if err != nil {
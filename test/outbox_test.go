@@ -0,0 +1,128 @@
+package e2e
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/product-catalog-service/internal/outbox"
+	"github.com/product-catalog-service/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pubsub "google.golang.org/api/pubsub/v1"
+)
+
+func TestOutboxPublishToPubSub(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	emulatorHost := os.Getenv("PUBSUB_EMULATOR_HOST")
+	if emulatorHost == "" {
+		t.Skip("PUBSUB_EMULATOR_HOST not set, skipping Pub/Sub E2E test")
+	}
+
+	// Setup: Topic and an ordered subscription created before anything is published
+	opts := outbox.PubSubOptions{
+		Project:      testProject,
+		Topic:        "catalog-events-e2e",
+		EmulatorHost: emulatorHost,
+	}
+	publisher, err := outbox.NewPubSubPublisher(ctx, opts)
+	require.NoError(t, err)
+	require.NoError(t, publisher.EnsureTopic(ctx))
+
+	service, err := pubsub.NewService(ctx, opts.ClientOptions()...)
+	require.NoError(t, err)
+
+	subName := fmt.Sprintf("projects/%s/subscriptions/e2e-%s", testProject, uuid.NewString())
+	_, err = service.Projects.Subscriptions.Create(subName, &pubsub.Subscription{
+		Topic:                 opts.TopicName(),
+		EnableMessageOrdering: true,
+	}).Context(ctx).Do()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		service.Projects.Subscriptions.Delete(subName).Context(ctx).Do()
+	})
+
+	// Setup: Create a product, which writes a pending product.created event
+	resp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Published Product",
+		Description:          "Delivered through the outbox",
+		Category:             "Electronics",
+		BasePriceNumerator:   4999,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, resp.ProductID)
+	})
+
+	events := fixture.GetOutboxEvents(t, resp.ProductID)
+	require.Len(t, events, 1)
+
+	// Test: Run the dispatcher until the event is processed
+	dispatcher := outbox.NewDispatcher(fixture.spannerClient, publisher, fixture.clock, outbox.DispatcherOptions{})
+	for i := 0; i < 10 && fixture.GetOutboxEvents(t, resp.ProductID)[0].Status != "processed"; i++ {
+		_, err := dispatcher.DispatchOnce(ctx)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, "processed", fixture.GetOutboxEvents(t, resp.ProductID)[0].Status)
+
+	// Verify: The message arrives with the correct envelope and attributes
+	received := pullForAggregate(t, service, subName, resp.ProductID)
+	require.NotNil(t, received)
+
+	assert.Equal(t, resp.ProductID, received.OrderingKey)
+	assert.Equal(t, events[0].EventID, received.Attributes[outbox.AttrEventID])
+	assert.Equal(t, "product.created", received.Attributes[outbox.AttrEventType])
+	assert.Equal(t, resp.ProductID, received.Attributes[outbox.AttrAggregateID])
+
+	data, err := base64.StdEncoding.DecodeString(received.Data)
+	require.NoError(t, err)
+
+	var envelope outbox.Message
+	require.NoError(t, json.Unmarshal(data, &envelope))
+	assert.Equal(t, events[0].EventID, envelope.EventID)
+	assert.Equal(t, "product.created", envelope.EventType)
+	assert.Equal(t, resp.ProductID, envelope.AggregateID)
+	assert.False(t, envelope.Replayed)
+
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal(envelope.Payload, &payload))
+	assert.Equal(t, "Published Product", payload["name"])
+	assert.Equal(t, "Electronics", payload["category"])
+}
+
+// pullForAggregate pulls from the subscription until a message for the aggregate arrives.
+// Messages of other aggregates (published by concurrent tests) are acknowledged and skipped.
+func pullForAggregate(t *testing.T, service *pubsub.Service, subName, aggregateID string) *pubsub.PubsubMessage {
+	t.Helper()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		resp, err := service.Projects.Subscriptions.Pull(subName, &pubsub.PullRequest{MaxMessages: 10}).Do()
+		require.NoError(t, err)
+
+		var ackIDs []string
+		var found *pubsub.PubsubMessage
+		for _, rm := range resp.ReceivedMessages {
+			ackIDs = append(ackIDs, rm.AckId)
+			if found == nil && rm.Message.Attributes[outbox.AttrAggregateID] == aggregateID {
+				found = rm.Message
+			}
+		}
+		if len(ackIDs) > 0 {
+			_, err := service.Projects.Subscriptions.Acknowledge(subName, &pubsub.AcknowledgeRequest{AckIds: ackIDs}).Do()
+			require.NoError(t, err)
+		}
+		if found != nil {
+			return found
+		}
+	}
+	return nil
+}
@@ -1,14 +1,28 @@
 package e2e
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
 	"testing"
 	"time"
 
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"github.com/product-catalog-service/internal/apikey"
+	"github.com/product-catalog-service/internal/caller"
+	"github.com/product-catalog-service/internal/contract"
 	"github.com/product-catalog-service/internal/domain"
+	"github.com/product-catalog-service/internal/eventbus"
 	"github.com/product-catalog-service/internal/query"
+	"github.com/product-catalog-service/internal/repository"
+	"github.com/product-catalog-service/internal/seed"
 	"github.com/product-catalog-service/internal/usecase"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 )
 
 func TestProductCreationFlow(t *testing.T) {
@@ -92,6 +106,72 @@ func TestProductUpdateFlow(t *testing.T) {
 	assert.Equal(t, "product.updated", events[1].EventType)
 }
 
+func TestChangeBasePriceFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create and activate a product with a discount
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Repriced Product",
+		Description:          "Base price will change",
+		Category:             "Books",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	now := fixture.Now()
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 25.0,
+		StartDate:          now,
+		EndDate:            now.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Test: Change the base price
+	fixture.AdvanceTime(time.Hour)
+	err = fixture.UseCases.ChangeBasePrice(ctx, usecase.ChangeBasePriceRequest{
+		ProductID:            createResp.ProductID,
+		BasePriceNumerator:   4000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	// Verify: New base price, discount applies to it (25% off $40 = $30)
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4000), product.BasePriceNumerator)
+	assert.Equal(t, int64(100), product.BasePriceDenominator)
+	assert.Equal(t, int64(30), product.EffectivePriceNumerator)
+	assert.Equal(t, int64(1), product.EffectivePriceDenominator)
+
+	// Verify: Price changed event exists
+	events := fixture.GetOutboxEvents(t, createResp.ProductID)
+	eventTypes := make([]string, len(events))
+	for i, e := range events {
+		eventTypes[i] = e.EventType
+	}
+	assert.Contains(t, eventTypes, "product.price_changed")
+
+	// Test: Archived products cannot be repriced
+	err = fixture.UseCases.ArchiveProduct(ctx, usecase.ArchiveProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	err = fixture.UseCases.ChangeBasePrice(ctx, usecase.ChangeBasePriceRequest{
+		ProductID:            createResp.ProductID,
+		BasePriceNumerator:   5000,
+		BasePriceDenominator: 100,
+	})
+	assert.ErrorIs(t, err, domain.ErrProductArchived)
+}
+
 func TestDiscountApplicationFlow(t *testing.T) {
 	fixture := SetupTestFixture(t)
 	ctx := fixture.Context()
@@ -119,7 +199,7 @@ func TestDiscountApplicationFlow(t *testing.T) {
 	startDate := now
 	endDate := now.Add(7 * 24 * time.Hour)
 
-	err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
 		ProductID:          createResp.ProductID,
 		DiscountPercentage: 20.0,
 		StartDate:          startDate,
@@ -220,7 +300,7 @@ func TestBusinessRuleValidation_CannotApplyDiscountToInactiveProduct(t *testing.
 
 	// Test: Try to apply discount to draft product (should fail)
 	now := fixture.Now()
-	err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
 		ProductID:          createResp.ProductID,
 		DiscountPercentage: 10.0,
 		StartDate:          now,
@@ -286,7 +366,7 @@ func TestRemoveDiscountFlow(t *testing.T) {
 
 	// Apply discount
 	now := fixture.Now()
-	err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
 		ProductID:          createResp.ProductID,
 		DiscountPercentage: 15.0,
 		StartDate:          now,
@@ -320,69 +400,180 @@ func TestRemoveDiscountFlow(t *testing.T) {
 	assert.Contains(t, eventTypes, "product.discount_removed")
 }
 
-func TestListProductsWithPagination(t *testing.T) {
+func TestMultipleDiscountsFlow(t *testing.T) {
 	fixture := SetupTestFixture(t)
 	ctx := fixture.Context()
 
-	// Setup: Create multiple products
-	var productIDs []string
-	for i := 0; i < 5; i++ {
-		resp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
-			Name:                 "Paginated Product",
-			Description:          "For pagination test",
-			Category:             "PaginationTest",
-			BasePriceNumerator:   int64(1000 + i*100),
-			BasePriceDenominator: 100,
-		})
-		require.NoError(t, err)
-		productIDs = append(productIDs, resp.ProductID)
+	// Setup: Create and activate a product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Product With Discounts",
+		Description:          "Has a standing and a promotional discount",
+		Category:             "Test",
+		BasePriceNumerator:   10000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
 
-		// Activate to make them listable
-		err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: resp.ProductID})
-		require.NoError(t, err)
-	}
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Test: A standing 10% discount and an overlapping higher priority 30% promotion
+	now := fixture.Now()
+	standing, err := fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 10.0,
+		StartDate:          now,
+		EndDate:            now.Add(30 * 24 * time.Hour),
+	})
+	require.NoError(t, err)
+	promotion, err := fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 30.0,
+		Priority:           10,
+		StartDate:          now,
+		EndDate:            now.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Verify: An overlapping discount of the same priority is rejected
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 20.0,
+		StartDate:          now.Add(time.Hour),
+		EndDate:            now.Add(2 * time.Hour),
+	})
+	assert.ErrorIs(t, err, domain.ErrDiscountOverlap)
+
+	// Verify: The promotion takes precedence (30% off $100 = $70)
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.Len(t, product.Discounts, 2)
+	assert.True(t, product.HasActiveDiscount)
+	require.NotNil(t, product.DiscountPercent)
+	assert.Equal(t, 30.0, *product.DiscountPercent)
+	assert.Equal(t, int64(70), product.EffectivePriceNumerator)
+	assert.Equal(t, int64(1), product.EffectivePriceDenominator)
+
+	// Test: Remove the promotion only
+	fixture.AdvanceTime(time.Hour)
+	err = fixture.UseCases.RemoveDiscount(ctx, usecase.RemoveDiscountRequest{
+		ProductID:  createResp.ProductID,
+		DiscountID: promotion.DiscountID,
+	})
+	require.NoError(t, err)
+
+	// Verify: The standing discount applies again (10% off $100 = $90)
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.Len(t, product.Discounts, 1)
+	assert.Equal(t, standing.DiscountID, product.Discounts[0].ID)
+	assert.Equal(t, int64(90), product.EffectivePriceNumerator)
+	assert.Equal(t, int64(1), product.EffectivePriceDenominator)
+
+	// Verify: Removing an unknown discount fails
+	err = fixture.UseCases.RemoveDiscount(ctx, usecase.RemoveDiscountRequest{
+		ProductID:  createResp.ProductID,
+		DiscountID: promotion.DiscountID,
+	})
+	assert.ErrorIs(t, err, domain.ErrDiscountNotFound)
+}
+
+func TestPriceTiersFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create and activate a $20.00 product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Product With Tiers",
+		Description:          "Sold in bulk",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
 
 	t.Cleanup(func() {
-		for _, id := range productIDs {
-			fixture.CleanupProduct(t, id)
-		}
+		fixture.CleanupProduct(t, createResp.ProductID)
 	})
 
-	// Test: List with page size of 2
-	result, err := fixture.Queries.ListProducts(ctx, query.ListProductsRequest{
-		Category:   "PaginationTest",
-		ActiveOnly: true,
-		PageSize:   2,
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Test: $18.00 each from 10 units, 25% off from 100 units
+	err = fixture.UseCases.SetPriceTiers(ctx, usecase.SetPriceTiersRequest{
+		ProductID: createResp.ProductID,
+		Tiers: []usecase.PriceTierRequest{
+			{MinQuantity: 100, PercentOff: 25},
+			{MinQuantity: 10, UnitPriceNumerator: 1800, UnitPriceDenominator: 100},
+		},
 	})
 	require.NoError(t, err)
-	assert.Len(t, result.Products, 2)
-	assert.NotEmpty(t, result.NextPageToken)
 
-	// Test: Get next page
-	result2, err := fixture.Queries.ListProducts(ctx, query.ListProductsRequest{
-		Category:   "PaginationTest",
-		ActiveOnly: true,
-		PageSize:   2,
-		PageToken:  result.NextPageToken,
+	// Verify: The tiers are returned sorted by minimum quantity
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.Len(t, product.PriceTiers, 2)
+	assert.Equal(t, int64(10), product.PriceTiers[0].MinQuantity)
+	assert.Equal(t, int64(100), product.PriceTiers[1].MinQuantity)
+	assert.Equal(t, 25.0, product.PriceTiers[1].PercentOff)
+
+	// Verify: Quantities are priced by the highest tier reached
+	tests := []struct {
+		quantity  int64
+		tierMin   int64
+		unitPrice int64
+		total     int64
+	}{
+		{5, 0, 20, 100},
+		{10, 10, 18, 180},
+		{150, 100, 15, 2250},
+	}
+	for _, tt := range tests {
+		price, err := fixture.Queries.GetPriceForQuantity(ctx, query.GetPriceForQuantityRequest{
+			ProductID: createResp.ProductID,
+			Quantity:  tt.quantity,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tt.tierMin, price.TierMinQuantity, "quantity %d", tt.quantity)
+		assert.Equal(t, tt.unitPrice, price.UnitPriceNumerator/price.UnitPriceDenominator, "quantity %d", tt.quantity)
+		assert.Equal(t, tt.total, price.TotalPriceNumerator/price.TotalPriceDenominator, "quantity %d", tt.quantity)
+	}
+
+	// Verify: Duplicate minimum quantities are rejected
+	err = fixture.UseCases.SetPriceTiers(ctx, usecase.SetPriceTiersRequest{
+		ProductID: createResp.ProductID,
+		Tiers: []usecase.PriceTierRequest{
+			{MinQuantity: 10, PercentOff: 5},
+			{MinQuantity: 10, PercentOff: 10},
+		},
 	})
+	assert.ErrorIs(t, err, domain.ErrDuplicatePriceTier)
+
+	// Test: An empty list removes the tiers
+	err = fixture.UseCases.SetPriceTiers(ctx, usecase.SetPriceTiersRequest{ProductID: createResp.ProductID})
 	require.NoError(t, err)
-	assert.Len(t, result2.Products, 2)
 
-	// Ensure different products
-	assert.NotEqual(t, result.Products[0].ID, result2.Products[0].ID)
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Empty(t, product.PriceTiers)
 }
 
-func TestOutboxEventCreation(t *testing.T) {
+func TestProductCurrencyFlow(t *testing.T) {
 	fixture := SetupTestFixture(t)
 	ctx := fixture.Context()
 
-	// Create a product
+	// Setup: Create and activate a EUR 20.00 product
 	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
-		Name:                 "Outbox Test Product",
-		Description:          "Testing outbox events",
+		Name:                 "Product In Euros",
+		Description:          "Priced in EUR",
 		Category:             "Test",
 		BasePriceNumerator:   2000,
 		BasePriceDenominator: 100,
+		Currency:             "eur",
 	})
 	require.NoError(t, err)
 
@@ -390,14 +581,3818 @@ func TestOutboxEventCreation(t *testing.T) {
 		fixture.CleanupProduct(t, createResp.ProductID)
 	})
 
-	// Verify: Outbox event was created
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Verify: The currency is stored with the product
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", product.Currency)
+
+	// Test: A price change without a currency keeps the product's currency
+	err = fixture.UseCases.ChangeBasePrice(ctx, usecase.ChangeBasePriceRequest{
+		ProductID:            createResp.ProductID,
+		BasePriceNumerator:   2500,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	prices, err := fixture.Queries.GetEffectivePrices(ctx, query.GetEffectivePricesRequest{ProductIDs: []string{createResp.ProductID}})
+	require.NoError(t, err)
+	require.Len(t, prices.Prices, 1)
+	assert.Equal(t, "EUR", prices.Prices[0].Currency)
+	assert.Equal(t, int64(2500), prices.Prices[0].BasePriceNumerator)
+
+	// Verify: A price or tier in another currency is rejected
+	err = fixture.UseCases.ChangeBasePrice(ctx, usecase.ChangeBasePriceRequest{
+		ProductID:            createResp.ProductID,
+		BasePriceNumerator:   3000,
+		BasePriceDenominator: 100,
+		Currency:             "USD",
+	})
+	assert.ErrorIs(t, err, domain.ErrCurrencyMismatch)
+
+	err = fixture.UseCases.SetPriceTiers(ctx, usecase.SetPriceTiersRequest{
+		ProductID: createResp.ProductID,
+		Tiers: []usecase.PriceTierRequest{
+			{MinQuantity: 10, UnitPriceNumerator: 1800, UnitPriceDenominator: 100, UnitPriceCurrency: "USD"},
+		},
+	})
+	assert.ErrorIs(t, err, domain.ErrCurrencyMismatch)
+}
+
+func TestArchiveProductRemovesDiscount(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Discounted Product To Archive",
+		Description:          "Discount must not survive archiving",
+		Category:             "Test",
+		BasePriceNumerator:   5000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	now := fixture.Now()
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 12.5,
+		StartDate:          now,
+		EndDate:            now.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Test: Archive while the discount is active
+	fixture.AdvanceTime(time.Hour)
+	err = fixture.UseCases.ArchiveProduct(ctx, usecase.ArchiveProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Verify: No discount remains, effective price equals base price
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "archived", product.Status)
+	assert.False(t, product.HasActiveDiscount)
+	assert.Nil(t, product.DiscountPercent)
+	assert.Equal(t, product.BasePriceNumerator, product.EffectivePriceNumerator)
+	assert.Equal(t, product.BasePriceDenominator, product.EffectivePriceDenominator)
+
+	// Verify: discount_removed is recorded together with archived
 	events := fixture.GetOutboxEvents(t, createResp.ProductID)
-	require.Len(t, events, 1)
+	eventTypes := make([]string, len(events))
+	for i, e := range events {
+		eventTypes[i] = e.EventType
+	}
+	require.Len(t, eventTypes, 5)
+	assert.ElementsMatch(t, []string{"product.discount_removed", "product.archived"}, eventTypes[3:])
+}
 
-	event := events[0]
-	assert.NotEmpty(t, event.EventID)
-	assert.Equal(t, "product.created", event.EventType)
-	assert.Equal(t, createResp.ProductID, event.AggregateID)
-	assert.Equal(t, "pending", event.Status)
-	assert.False(t, event.CreatedAt.IsZero())
+func TestArchiveCascade(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	category := "Archive Cascade"
+
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Product With Dependents",
+		Description:          "Dependents must not survive archiving",
+		Category:             category,
+		BasePriceNumerator:   5000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+	productID := createResp.ProductID
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, productID)
+		require.NoError(t, fixture.Merchandising.ReplaceRanks(ctx, category, nil))
+	})
+
+	for _, sub := range []usecase.SubscribeToNotificationsRequest{
+		{ProductID: productID, SubscriberID: "customer-1", Kind: domain.NotificationKindBackInStock},
+		{ProductID: productID, SubscriberID: "customer-2", Kind: domain.NotificationKindBackInStock},
+		{ProductID: productID, SubscriberID: "customer-1", Kind: domain.NotificationKindDiscounted},
+	} {
+		require.NoError(t, fixture.UseCases.SubscribeToNotifications(ctx, sub))
+	}
+	err = fixture.Merchandising.ReplaceRanks(ctx, category, []contract.MerchandisingRank{{ProductID: productID, Rank: 1}})
+	require.NoError(t, err)
+	variant, err := fixture.UseCases.AddVariant(ctx, usecase.AddVariantRequest{
+		ProductID: productID,
+		SKU:       "E2E-ARCHIVE-" + productID[:8],
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupVariantEvents(t, variant.VariantID)
+	})
+
+	// Test: Archive the product
+	err = fixture.UseCases.ArchiveProduct(ctx, usecase.ArchiveProductRequest{ProductID: productID})
+	require.NoError(t, err)
+
+	// Verify: Subscribers of each kind are told their subscriptions were cancelled
+	cancelled := make(map[string][]string)
+	for _, event := range fixture.GetOutboxEvents(t, productID) {
+		if event.EventType != domain.SubscriptionCancelledEventType {
+			continue
+		}
+		var payload struct {
+			TriggerEventType string   `json:"trigger_event_type"`
+			Kind             string   `json:"kind"`
+			SubscriberIDs    []string `json:"subscriber_ids"`
+		}
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		assert.Equal(t, "product.archived", payload.TriggerEventType)
+		cancelled[payload.Kind] = payload.SubscriberIDs
+	}
+	assert.Equal(t, map[string][]string{
+		"back_in_stock": {"customer-1", "customer-2"},
+		"discounted":    {"customer-1"},
+	}, cancelled)
+
+	// Verify: The subscriptions and the merchandising rank are gone
+	for _, kind := range domain.NotificationKinds() {
+		subscribers, err := fixture.Subscriptions.FindSubscribers(ctx, productID, kind)
+		require.NoError(t, err)
+		assert.Empty(t, subscribers, kind)
+	}
+	_, err = fixture.spannerClient.Single().ReadRow(ctx, repository.RanksTable, spanner.Key{category, productID}, []string{repository.RankRank})
+	assert.Equal(t, codes.NotFound, spanner.ErrCode(err))
+
+	// Verify: The variant is discontinued, and the discontinuation written to the outbox
+	discontinued, err := repository.NewProductVariantRepo(fixture.spannerClient).FindByID(ctx, productID, variant.VariantID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.VariantStatusDiscontinued, discontinued.Status())
+	events := fixture.GetOutboxEvents(t, variant.VariantID)
+	require.Len(t, events, 2)
+	assert.Equal(t, "product_variant.discontinued", events[1].EventType)
+}
+
+func TestFreezeWindows(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Frozen Product",
+		Description:          "Prices must not change during a freeze",
+		Category:             "Test",
+		BasePriceNumerator:   5000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+	productID := createResp.ProductID
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, productID)
+	})
+
+	// Setup: Freeze one tenant, except for the pricing on-call role; the tenant is
+	// specific to this test so other tests are not frozen
+	tenant := "freeze-" + productID
+	now := fixture.Now()
+	window, err := domain.NewFreezeWindow(uuid.New().String(), tenant, now.Add(-time.Hour), now.Add(time.Hour),
+		[]string{"pricing-oncall"}, "Black Friday")
+	require.NoError(t, err)
+	require.NoError(t, fixture.Freezes.Schedule(ctx, window))
+	t.Cleanup(func() {
+		_ = fixture.Freezes.Cancel(ctx, window.ID())
+	})
+
+	changePrice := func(ctx context.Context, numerator int64) error {
+		return fixture.UseCases.ChangeBasePrice(ctx, usecase.ChangeBasePriceRequest{
+			ProductID:            productID,
+			BasePriceNumerator:   numerator,
+			BasePriceDenominator: 100,
+		})
+	}
+
+	// Test: The frozen tenant cannot change prices, even without a role
+	frozen := caller.NewContext(ctx, caller.Identity{Tenant: tenant, Role: "merchant"})
+	err = changePrice(frozen, 4000)
+	assert.ErrorIs(t, err, domain.ErrCatalogFrozen)
+	assert.ErrorContains(t, err, "Black Friday")
+	err = changePrice(caller.NewContext(ctx, caller.Identity{Tenant: tenant}), 4000)
+	assert.ErrorIs(t, err, domain.ErrCatalogFrozen)
+
+	// Verify: Exempt roles and other tenants are not frozen
+	err = changePrice(caller.NewContext(ctx, caller.Identity{Tenant: tenant, Role: "pricing-oncall"}), 4500)
+	require.NoError(t, err)
+	err = changePrice(caller.NewContext(ctx, caller.Identity{Tenant: "other-tenant", Role: "merchant"}), 4600)
+	require.NoError(t, err)
+
+	// Verify: Changes are accepted again once the window ends
+	fixture.AdvanceTime(2 * time.Hour)
+	require.NoError(t, changePrice(frozen, 4000))
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: productID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4000), product.BasePriceNumerator)
+}
+
+func TestDiscountBlackouts(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	now := fixture.Now()
+
+	// Setup: A product in a category specific to this test, so other tests are not
+	// blacked out
+	category := "blackout-" + uuid.New().String()
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "MAP Product",
+		Description:          "Must not be discounted during MAP weeks",
+		Category:             category,
+		BasePriceNumerator:   5000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+	productID := createResp.ProductID
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, productID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: productID})
+	require.NoError(t, err)
+
+	// Setup: Black out discounts of the category for a week starting tomorrow
+	start := now.Add(24 * time.Hour)
+	blackout, err := domain.NewDiscountBlackout(uuid.New().String(), category, start, start.Add(7*24*time.Hour), "MAP week")
+	require.NoError(t, err)
+	require.NoError(t, fixture.Blackouts.Schedule(ctx, blackout))
+	t.Cleanup(func() {
+		_ = fixture.Blackouts.Cancel(ctx, blackout.ID())
+	})
+
+	applyDiscount := func(from, until time.Time) error {
+		_, err := fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+			ProductID:          productID,
+			DiscountPercentage: 10,
+			StartDate:          from,
+			EndDate:            until,
+		})
+		return err
+	}
+
+	// Test: A discount running into the blackout is refused
+	err = applyDiscount(now, start.Add(time.Hour))
+	assert.ErrorIs(t, err, domain.ErrDiscountBlackedOut)
+	assert.ErrorContains(t, err, "MAP week")
+
+	// Verify: Discounts before and after the blackout are accepted
+	require.NoError(t, applyDiscount(now, start))
+	require.NoError(t, applyDiscount(blackout.End(), blackout.End().Add(24*time.Hour)))
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: productID})
+	require.NoError(t, err)
+	assert.Len(t, product.Discounts, 2)
+}
+
+func TestAttributeSchemas(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: A schema for a category specific to this test
+	category := "schema-" + uuid.New().String()
+	material, err := domain.NewAttributeDefinition("material", domain.AttributeTypeString, true, []string{"oak", "pine"})
+	require.NoError(t, err)
+	legs, err := domain.NewAttributeDefinition("legs", domain.AttributeTypeInteger, false, nil)
+	require.NoError(t, err)
+	schema, err := domain.NewCategoryAttributeSchema(category, []*domain.AttributeDefinition{material, legs})
+	require.NoError(t, err)
+	require.NoError(t, fixture.AttributeSchemas.Save(ctx, schema))
+	t.Cleanup(func() {
+		_ = fixture.AttributeSchemas.Delete(ctx, category)
+	})
+
+	stored, err := fixture.AttributeSchemas.Find(ctx, category)
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, []string{"oak", "pine"}, stored.Attribute("material").AllowedValues())
+
+	create := func(attributes map[string]string) (string, error) {
+		resp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 "Table",
+			Category:             category,
+			BasePriceNumerator:   20000,
+			BasePriceDenominator: 100,
+			Attributes:           attributes,
+		})
+		if err != nil {
+			return "", err
+		}
+		t.Cleanup(func() {
+			fixture.CleanupProduct(t, resp.ProductID)
+		})
+		return resp.ProductID, nil
+	}
+
+	// Test: Attributes violating the schema are refused with every violation
+	_, err = create(map[string]string{"legs": "four"})
+	assert.ErrorIs(t, err, domain.ErrAttributeSchemaViolation)
+	var schemaErr *domain.AttributeSchemaError
+	require.ErrorAs(t, err, &schemaErr)
+	assert.Equal(t, []domain.AttributeViolation{
+		{Attribute: "legs", Reason: "must be an integer"},
+		{Attribute: "material", Reason: "is required"},
+	}, schemaErr.Violations)
+
+	// Test: Matching attributes are accepted
+	productID, err := create(map[string]string{"material": "oak", "legs": "4"})
+	require.NoError(t, err)
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: productID})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"material": "oak", "legs": "4"}, product.Attributes)
+
+	// Test: Updates and single attributes are checked too
+	err = fixture.UseCases.UpdateProduct(ctx, usecase.UpdateProductRequest{
+		ProductID:  productID,
+		Name:       "Table",
+		Category:   category,
+		Attributes: map[string]string{"material": "steel"},
+	})
+	assert.ErrorIs(t, err, domain.ErrAttributeSchemaViolation)
+
+	err = fixture.UseCases.SetAttribute(ctx, usecase.SetAttributeRequest{ProductID: productID, Name: "material"})
+	assert.ErrorIs(t, err, domain.ErrAttributeSchemaViolation)
+
+	err = fixture.UseCases.SetAttribute(ctx, usecase.SetAttributeRequest{ProductID: productID, Name: "material", Value: "pine"})
+	require.NoError(t, err)
+
+	// Verify: Moving the product to a category without a schema accepts any attributes
+	err = fixture.UseCases.UpdateProduct(ctx, usecase.UpdateProductRequest{
+		ProductID:  productID,
+		Name:       "Table",
+		Category:   "unschematized-" + uuid.New().String(),
+		Attributes: map[string]string{"color": "red"},
+	})
+	require.NoError(t, err)
+}
+
+func TestCampaignFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Two active products and an inactive one in a category specific to this test
+	category := "campaign-" + uuid.New().String()
+	var productIDs []string
+	for i := 0; i < 3; i++ {
+		createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 fmt.Sprintf("Campaign Product %d", i),
+			Description:          "Discounted by a campaign",
+			Category:             category,
+			BasePriceNumerator:   5000,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		productID := createResp.ProductID
+		productIDs = append(productIDs, productID)
+		t.Cleanup(func() {
+			fixture.CleanupProduct(t, productID)
+		})
+		if i < 2 {
+			require.NoError(t, fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: productID}))
+		}
+	}
+
+	now := fixture.Now()
+	createResp, err := fixture.UseCases.CreateCampaign(ctx, usecase.CreateCampaignRequest{
+		Name:               "Summer Sale",
+		Category:           category,
+		DiscountPercentage: 20,
+		Priority:           10,
+		StartDate:          now,
+		EndDate:            now.Add(7 * 24 * time.Hour),
+	})
+	require.NoError(t, err)
+	campaignID := createResp.CampaignID
+	t.Cleanup(func() {
+		fixture.CleanupCampaign(t, campaignID)
+	})
+
+	// Test: Activating applies the discount to the active products and skips the other
+	activateResp, err := fixture.UseCases.ActivateCampaign(ctx, usecase.ActivateCampaignRequest{CampaignID: campaignID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, activateResp.AppliedCount)
+	require.Len(t, activateResp.Skipped, 1)
+	assert.Equal(t, productIDs[2], activateResp.Skipped[0].ProductID)
+	assert.Equal(t, domain.ErrProductNotActive.Error(), activateResp.Skipped[0].Reason)
+
+	for _, productID := range productIDs[:2] {
+		product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: productID})
+		require.NoError(t, err)
+		require.Len(t, product.Discounts, 1)
+		assert.Equal(t, campaignID, product.Discounts[0].ID)
+		assert.Equal(t, int64(4000), product.EffectivePriceNumerator*100/product.EffectivePriceDenominator)
+
+		events := fixture.GetOutboxEvents(t, productID)
+		assert.Equal(t, "product.discount_applied", events[len(events)-1].EventType)
+	}
+
+	_, err = fixture.UseCases.ActivateCampaign(ctx, usecase.ActivateCampaignRequest{CampaignID: campaignID})
+	assert.ErrorIs(t, err, domain.ErrCampaignNotDraft)
+
+	// Test: Ending removes the discount from the products again
+	endResp, err := fixture.UseCases.EndCampaign(ctx, usecase.EndCampaignRequest{CampaignID: campaignID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, endResp.RemovedCount)
+
+	for _, productID := range productIDs[:2] {
+		product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: productID})
+		require.NoError(t, err)
+		assert.Empty(t, product.Discounts)
+	}
+
+	// Verify: The campaign is ended and its lifecycle is in the outbox
+	campaign, err := fixture.Campaigns.FindByID(ctx, campaignID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.CampaignStatusEnded, campaign.Status())
+
+	var eventTypes []string
+	for _, event := range fixture.GetOutboxEvents(t, campaignID) {
+		eventTypes = append(eventTypes, event.EventType)
+	}
+	assert.Equal(t, []string{"campaign.created", "campaign.activated", "campaign.ended"}, eventTypes)
+}
+
+func TestApplyDiscountToCategory(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Three active products, one of them already discounted, and an inactive one in
+	// a category specific to this test
+	category := "category-discount-" + uuid.New().String()
+	var productIDs []string
+	for i := 0; i < 4; i++ {
+		createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 fmt.Sprintf("Category Product %d", i),
+			Description:          "Discounted with its category",
+			Category:             category,
+			BasePriceNumerator:   5000,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		productID := createResp.ProductID
+		productIDs = append(productIDs, productID)
+		t.Cleanup(func() {
+			fixture.CleanupProduct(t, productID)
+		})
+		if i < 3 {
+			require.NoError(t, fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: productID}))
+		}
+	}
+
+	now := fixture.Now()
+	_, err := fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          productIDs[2],
+		DiscountPercentage: 5,
+		Priority:           10,
+		StartDate:          now,
+		EndDate:            now.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Test: The discount is applied to the active products and skips the discounted one
+	resp, err := fixture.UseCases.ApplyDiscountToCategory(ctx, usecase.ApplyDiscountToCategoryRequest{
+		Category:           category,
+		DiscountPercentage: 20,
+		Priority:           10,
+		StartDate:          now,
+		EndDate:            now.Add(7 * 24 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.DiscountID)
+	assert.False(t, resp.PendingApproval)
+	assert.Equal(t, 2, resp.AppliedCount)
+	require.Len(t, resp.Skipped, 1)
+	assert.Equal(t, productIDs[2], resp.Skipped[0].ProductID)
+	assert.Equal(t, domain.ErrDiscountOverlap.Error(), resp.Skipped[0].Reason)
+
+	// Verify: The discounted products share the discount, the inactive one is untouched
+	for i, productID := range productIDs {
+		product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: productID})
+		require.NoError(t, err)
+		if i == 2 {
+			require.Len(t, product.Discounts, 1)
+			assert.NotEqual(t, resp.DiscountID, product.Discounts[0].ID)
+			continue
+		}
+		if i == 3 {
+			assert.Empty(t, product.Discounts)
+			continue
+		}
+		require.Len(t, product.Discounts, 1)
+		assert.Equal(t, resp.DiscountID, product.Discounts[0].ID)
+		assert.Equal(t, int64(4000), product.EffectivePriceNumerator*100/product.EffectivePriceDenominator)
+
+		events := fixture.GetOutboxEvents(t, productID)
+		assert.Equal(t, "product.discount_applied", events[len(events)-1].EventType)
+	}
+}
+
+func TestAPIKeyFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	repo := repository.NewAPIKeyRepo(fixture.spannerClient)
+	manager := apikey.NewManager(repo, fixture.clock, apikey.WithRotationGrace(time.Hour))
+	auth := apikey.NewAuthenticator(repo, fixture.clock, 0)
+	client := "client-" + uuid.New().String()
+
+	issued, err := manager.Create(ctx, client)
+	require.NoError(t, err)
+	rotated, err := manager.Rotate(ctx, client, issued.Key.ID())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		keys := spanner.KeySetFromKeys(spanner.Key{issued.Key.ID()}, spanner.Key{rotated.Key.ID()})
+		_, _ = fixture.spannerClient.Apply(ctx, []*spanner.Mutation{spanner.Delete("api_keys", keys)})
+	})
+
+	// Verify: The stored keys round-trip, and only the hash of the secret is stored
+	stored, err := repo.FindByID(ctx, issued.Key.ID())
+	require.NoError(t, err)
+	assert.Equal(t, client, stored.ClientID())
+	assert.True(t, stored.Matches(issued.Secret))
+	require.NotNil(t, stored.ExpiresAt())
+	assert.True(t, stored.ExpiresAt().Equal(fixture.Now().Add(time.Hour)))
+
+	// Verify: The rotated key works until the grace period ends
+	_, err = auth.Authenticate(ctx, issued.Secret)
+	require.NoError(t, err)
+	fixture.AdvanceTime(time.Hour)
+	_, err = auth.Authenticate(ctx, issued.Secret)
+	assert.ErrorIs(t, err, apikey.ErrInvalidKey)
+
+	// Verify: A revoked key stops working
+	key, err := auth.Authenticate(ctx, rotated.Secret)
+	require.NoError(t, err)
+	assert.Equal(t, client, key.ClientID())
+	require.NoError(t, manager.Revoke(ctx, client, rotated.Key.ID()))
+	_, err = auth.Authenticate(ctx, rotated.Secret)
+	assert.ErrorIs(t, err, apikey.ErrInvalidKey)
+
+	_, err = repo.FindByID(ctx, uuid.New().String())
+	assert.ErrorIs(t, err, domain.ErrAPIKeyNotFound)
+}
+
+func TestDeactivationSuspendsDiscount(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Discounted Product To Deactivate",
+		Description:          "Discount must not apply while inactive",
+		Category:             "Test",
+		BasePriceNumerator:   5000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	now := fixture.Now()
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 20,
+		StartDate:          now,
+		EndDate:            now.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Test: Deactivate while the discount is active
+	fixture.AdvanceTime(time.Hour)
+	err = fixture.UseCases.DeactivateProduct(ctx, usecase.DeactivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Verify: Discount is kept but suspended, effective price equals base price
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "inactive", product.Status)
+	require.NotNil(t, product.DiscountPercent)
+	assert.True(t, product.DiscountSuspended)
+	assert.False(t, product.HasActiveDiscount)
+	assert.Equal(t, product.BasePriceNumerator, product.EffectivePriceNumerator)
+	assert.Equal(t, product.BasePriceDenominator, product.EffectivePriceDenominator)
+
+	// Test: Activate again before the discount ends
+	fixture.AdvanceTime(time.Hour)
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Verify: Discount applies again ($50.00 * 0.8 = $40.00)
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.False(t, product.DiscountSuspended)
+	assert.True(t, product.HasActiveDiscount)
+	assert.Equal(t, 40*product.EffectivePriceDenominator, product.EffectivePriceNumerator)
+
+	events := fixture.GetOutboxEvents(t, createResp.ProductID)
+	eventTypes := make([]string, len(events))
+	for i, e := range events {
+		eventTypes[i] = e.EventType
+	}
+	assert.Contains(t, eventTypes, "product.discount_suspended")
+	assert.Contains(t, eventTypes, "product.discount_resumed")
+}
+
+func TestListProductsWithPagination(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create multiple products
+	var productIDs []string
+	for i := 0; i < 5; i++ {
+		resp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 "Paginated Product",
+			Description:          "For pagination test",
+			Category:             "PaginationTest",
+			BasePriceNumerator:   int64(1000 + i*100),
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		productIDs = append(productIDs, resp.ProductID)
+
+		// Activate to make them listable
+		err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: resp.ProductID})
+		require.NoError(t, err)
+	}
+
+	t.Cleanup(func() {
+		for _, id := range productIDs {
+			fixture.CleanupProduct(t, id)
+		}
+	})
+
+	// Test: List with page size of 2
+	result, err := fixture.Queries.ListProducts(ctx, query.ListProductsRequest{
+		Category:   "PaginationTest",
+		ActiveOnly: true,
+		PageSize:   2,
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.Products, 2)
+	assert.NotEmpty(t, result.NextPageToken)
+
+	// Test: Get next page
+	result2, err := fixture.Queries.ListProducts(ctx, query.ListProductsRequest{
+		Category:   "PaginationTest",
+		ActiveOnly: true,
+		PageSize:   2,
+		PageToken:  result.NextPageToken,
+	})
+	require.NoError(t, err)
+	assert.Len(t, result2.Products, 2)
+
+	// Ensure different products
+	assert.NotEqual(t, result.Products[0].ID, result2.Products[0].ID)
+}
+
+func TestListAllProductsFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	var productIDs []string
+	for i := 0; i < 3; i++ {
+		resp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 "Streamed Product",
+			Description:          "For streaming test",
+			Category:             "StreamTest",
+			BasePriceNumerator:   int64(1000 + i*100),
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		productIDs = append(productIDs, resp.ProductID)
+	}
+
+	t.Cleanup(func() {
+		for _, id := range productIDs {
+			fixture.CleanupProduct(t, id)
+		}
+	})
+
+	var streamed []string
+	err := fixture.Queries.ListAllProducts(ctx, query.ListProductsRequest{Category: "StreamTest"}, func(p *query.ProductSummary) error {
+		streamed = append(streamed, p.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, productIDs, streamed)
+	assert.IsIncreasing(t, streamed, "products are streamed in product ID order")
+}
+
+func TestSearchProductsFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create products mentioning a word no other product does, in the name of one
+	// and the descriptions of two others
+	word := "zq" + strings.ReplaceAll(uuid.New().String()[:8], "-", "")
+	create := func(name, description string) string {
+		resp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 name,
+			Description:          description,
+			Category:             "SearchTest",
+			BasePriceNumerator:   1000,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			fixture.CleanupProduct(t, resp.ProductID)
+		})
+		return resp.ProductID
+	}
+	named := create("Oak Chair "+word, "Solid oak")
+	described := create("Pine Chair", "Looks like a "+word+" chair")
+	other := create("Birch Table", "Also a "+word)
+	archived := create("Old Chair "+word, "Retired")
+	err := fixture.UseCases.ArchiveProduct(ctx, usecase.ArchiveProductRequest{ProductID: archived})
+	require.NoError(t, err)
+
+	// Test: A match in the name ranks first; archived products are left out
+	result, err := fixture.Queries.SearchProducts(ctx, query.SearchProductsRequest{Query: word, PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, result.Products, 2)
+	assert.Equal(t, named, result.Products[0].ID)
+	assert.NotEmpty(t, result.NextPageToken)
+
+	// Test: The next page holds the rest
+	next, err := fixture.Queries.SearchProducts(ctx, query.SearchProductsRequest{Query: word, PageSize: 2, PageToken: result.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, next.Products, 1)
+	assert.ElementsMatch(t, []string{described, other}, []string{result.Products[1].ID, next.Products[0].ID})
+
+	// Test: Every word must match
+	result, err = fixture.Queries.SearchProducts(ctx, query.SearchProductsRequest{Query: word + " chair"})
+	require.NoError(t, err)
+	ids := make([]string, len(result.Products))
+	for i, p := range result.Products {
+		ids[i] = p.ID
+	}
+	assert.ElementsMatch(t, []string{named, described}, ids)
+
+	// Test: An empty query is rejected
+	_, err = fixture.Queries.SearchProducts(ctx, query.SearchProductsRequest{Query: " "})
+	assert.ErrorIs(t, err, domain.ErrInvalidSearchQuery)
+}
+
+func TestOutboxEventCreation(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Create a product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Outbox Test Product",
+		Description:          "Testing outbox events",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	// Verify: Outbox event was created
+	events := fixture.GetOutboxEvents(t, createResp.ProductID)
+	require.Len(t, events, 1)
+
+	event := events[0]
+	assert.NotEmpty(t, event.EventID)
+	assert.Equal(t, "product.created", event.EventType)
+	assert.Equal(t, createResp.ProductID, event.AggregateID)
+	assert.Equal(t, "pending", event.Status)
+	assert.False(t, event.CreatedAt.IsZero())
+}
+
+func TestEventBusReceivesCommittedEvents(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	var received []string
+	fixture.Bus.Subscribe(eventbus.AllEvents, func(_ context.Context, event domain.DomainEvent) {
+		received = append(received, event.EventType())
+	})
+
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Event Bus Test Product",
+		Description:          "Testing in-process subscribers",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Test: A failing command publishes nothing
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.ErrorIs(t, err, domain.ErrProductAlreadyActive)
+
+	assert.Equal(t, []string{"product.created", "product.activated"}, received)
+}
+
+func TestNotificationFanOut(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Notify Me Product",
+		Description:          "Testing notification fan-out",
+		Category:             "Test",
+		BasePriceNumerator:   5000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+	productID := createResp.ProductID
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, productID)
+	})
+
+	for _, sub := range []usecase.SubscribeToNotificationsRequest{
+		{ProductID: productID, SubscriberID: "customer-2", Kind: domain.NotificationKindBackInStock},
+		{ProductID: productID, SubscriberID: "customer-1", Kind: domain.NotificationKindBackInStock},
+		{ProductID: productID, SubscriberID: "customer-1", Kind: domain.NotificationKindDiscounted},
+		{ProductID: productID, SubscriberID: "customer-3", Kind: domain.NotificationKindDiscounted},
+	} {
+		require.NoError(t, fixture.UseCases.SubscribeToNotifications(ctx, sub))
+	}
+	err = fixture.UseCases.UnsubscribeFromNotifications(ctx, usecase.UnsubscribeFromNotificationsRequest{
+		ProductID: productID, SubscriberID: "customer-3", Kind: domain.NotificationKindDiscounted,
+	})
+	require.NoError(t, err)
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: productID})
+	require.NoError(t, err)
+
+	now := fixture.Now()
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          productID,
+		DiscountPercentage: 20,
+		StartDate:          now,
+		EndDate:            now.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Verify: One targeted event per triggering event, with the current subscribers
+	subscribers := make(map[string][]string)
+	for _, event := range fixture.GetOutboxEvents(t, productID) {
+		if !strings.HasPrefix(event.EventType, "notification.") {
+			continue
+		}
+		var payload struct {
+			TriggerEventType string   `json:"trigger_event_type"`
+			SubscriberIDs    []string `json:"subscriber_ids"`
+		}
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		subscribers[event.EventType+" <- "+payload.TriggerEventType] = payload.SubscriberIDs
+	}
+	assert.Equal(t, map[string][]string{
+		"notification.back_in_stock <- product.activated":     {"customer-1", "customer-2"},
+		"notification.discounted <- product.discount_applied": {"customer-1"},
+	}, subscribers)
+
+	// Test: Archived products cannot be subscribed to
+	err = fixture.UseCases.ArchiveProduct(ctx, usecase.ArchiveProductRequest{ProductID: productID})
+	require.NoError(t, err)
+	err = fixture.UseCases.SubscribeToNotifications(ctx, usecase.SubscribeToNotificationsRequest{
+		ProductID: productID, SubscriberID: "customer-4", Kind: domain.NotificationKindBackInStock,
+	})
+	assert.ErrorIs(t, err, domain.ErrProductArchived)
+}
+
+func TestScheduledDiscountEvents(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Scheduled Discount Product",
+		Description:          "Testing discount start and end events",
+		Category:             "Test",
+		BasePriceNumerator:   5000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+	productID := createResp.ProductID
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, productID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: productID})
+	require.NoError(t, err)
+
+	now := fixture.Now()
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          productID,
+		DiscountPercentage: 20,
+		StartDate:          now.Add(24 * time.Hour),
+		EndDate:            now.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	advance := func() {
+		t.Helper()
+		due, err := fixture.ProductRepo.FindDiscountPhaseDue(ctx, fixture.Now(), 1000)
+		require.NoError(t, err)
+		require.Contains(t, due, productID)
+		err = fixture.UseCases.AdvanceDiscountPhase(ctx, usecase.AdvanceDiscountPhaseRequest{ProductID: productID})
+		require.NoError(t, err)
+
+		due, err = fixture.ProductRepo.FindDiscountPhaseDue(ctx, fixture.Now(), 1000)
+		require.NoError(t, err)
+		require.NotContains(t, due, productID)
+	}
+
+	// Test: Not due before the period starts
+	due, err := fixture.ProductRepo.FindDiscountPhaseDue(ctx, fixture.Now(), 1000)
+	require.NoError(t, err)
+	assert.NotContains(t, due, productID)
+
+	fixture.AdvanceTime(25 * time.Hour)
+	advance()
+	fixture.AdvanceTime(24 * time.Hour)
+	advance()
+
+	var eventTypes []string
+	for _, event := range fixture.GetOutboxEvents(t, productID) {
+		eventTypes = append(eventTypes, event.EventType)
+	}
+	assert.Equal(t, []string{
+		"product.created",
+		"product.activated",
+		"product.discount_applied",
+		"product.discount_started",
+		"product.discount_ended",
+	}, eventTypes)
+}
+
+func TestFlashSaleFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Flash Sale Product",
+		Description:          "Testing flash sale events",
+		Category:             "Test",
+		BasePriceNumerator:   5000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+	productID := createResp.ProductID
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, productID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: productID})
+	require.NoError(t, err)
+
+	start := fixture.Now().Add(time.Hour)
+	end := start.Add(30 * time.Minute)
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          productID,
+		DiscountPercentage: 50,
+		StartDate:          start,
+		EndDate:            end,
+		FlashSale:          true,
+	})
+	require.NoError(t, err)
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: productID})
+	require.NoError(t, err)
+	require.Len(t, product.Discounts, 1)
+	assert.True(t, product.Discounts[0].FlashSale)
+
+	// Test: The scheduler wakes up at the start, then at the end
+	boundary, ok, err := fixture.ProductRepo.NextFlashSaleBoundary(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.False(t, boundary.After(start))
+
+	fixture.AdvanceTime(time.Hour)
+	err = fixture.UseCases.AdvanceDiscountPhase(ctx, usecase.AdvanceDiscountPhaseRequest{ProductID: productID})
+	require.NoError(t, err)
+	boundary, ok, err = fixture.ProductRepo.NextFlashSaleBoundary(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.False(t, boundary.After(end))
+
+	fixture.AdvanceTime(30 * time.Minute)
+	err = fixture.UseCases.AdvanceDiscountPhase(ctx, usecase.AdvanceDiscountPhaseRequest{ProductID: productID})
+	require.NoError(t, err)
+
+	flagged := map[string]bool{}
+	for _, event := range fixture.GetOutboxEvents(t, productID) {
+		var payload map[string]interface{}
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		if payload["flash_sale"] == true {
+			flagged[event.EventType] = true
+		}
+	}
+	assert.Equal(t, map[string]bool{
+		"product.discount_applied": true,
+		"product.discount_started": true,
+		"product.discount_ended":   true,
+	}, flagged)
+}
+
+func TestGetEffectivePricesFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	var productIDs []string
+	for _, price := range []int64{5000, 2000} {
+		resp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 "Cart Product",
+			Category:             "Test",
+			BasePriceNumerator:   price,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		productIDs = append(productIDs, resp.ProductID)
+
+		t.Cleanup(func() {
+			fixture.CleanupProduct(t, resp.ProductID)
+		})
+	}
+
+	err := fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: productIDs[0]})
+	require.NoError(t, err)
+
+	now := fixture.Now()
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          productIDs[0],
+		DiscountPercentage: 20,
+		StartDate:          now.Add(time.Hour),
+		EndDate:            now.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Test: Price a cart with a missing product and a duplicate, at a time the discount applies
+	resp, err := fixture.Queries.GetEffectivePrices(ctx, query.GetEffectivePricesRequest{
+		ProductIDs: []string{productIDs[1], "missing-product", productIDs[0], productIDs[1]},
+		At:         now.Add(2 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Verify: Prices in request order, missing product reported separately
+	require.Len(t, resp.Prices, 2)
+	assert.Equal(t, productIDs[1], resp.Prices[0].ProductID)
+	assert.False(t, resp.Prices[0].HasActiveDiscount)
+	assert.Equal(t, "draft", resp.Prices[0].Status)
+	assert.Equal(t, productIDs[0], resp.Prices[1].ProductID)
+	assert.True(t, resp.Prices[1].HasActiveDiscount)
+	assert.Equal(t, 40*resp.Prices[1].EffectivePriceDenominator, resp.Prices[1].EffectivePriceNumerator)
+	assert.Equal(t, "USD", resp.Prices[1].Currency)
+	assert.Equal(t, []string{"missing-product"}, resp.MissingProductIDs)
+
+	// Verify: Without At, products are priced now, before the discount starts
+	resp, err = fixture.Queries.GetEffectivePrices(ctx, query.GetEffectivePricesRequest{
+		ProductIDs: []string{productIDs[0]},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Prices, 1)
+	assert.False(t, resp.Prices[0].HasActiveDiscount)
+	assert.Nil(t, resp.Prices[0].DiscountPercent)
+}
+
+func TestPriceBookFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create and activate a $20.00 product with 25% off
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Product With Price Book",
+		Description:          "Sold in the EU",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	now := fixture.Now()
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 25,
+		StartDate:          now,
+		EndDate:            now.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Test: EUR 18.00
+	err = fixture.UseCases.SetPriceBook(ctx, usecase.SetPriceBookRequest{
+		ProductID: createResp.ProductID,
+		Prices:    []usecase.PriceRequest{{Numerator: 1800, Denominator: 100, Currency: "EUR"}},
+	})
+	require.NoError(t, err)
+
+	// Verify: The product is priced from its price book, with the discount applied
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID, Currency: "EUR"})
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", product.Currency)
+	assert.Equal(t, query.PriceSourcePriceBook, product.PriceSource)
+	assert.Equal(t, int64(18), product.BasePriceNumerator/product.BasePriceDenominator)
+	assert.Equal(t, "27/2", big.NewRat(product.EffectivePriceNumerator, product.EffectivePriceDenominator).RatString())
+	require.Len(t, product.PriceBook, 1)
+	assert.Equal(t, "EUR", product.PriceBook[0].Currency)
+
+	// Verify: Without exchange rates, other currencies cannot be priced
+	_, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID, Currency: "GBP"})
+	assert.ErrorIs(t, err, domain.ErrNoExchangeRate)
+
+	// Verify: The product's own currency cannot be in its price book
+	err = fixture.UseCases.SetPriceBook(ctx, usecase.SetPriceBookRequest{
+		ProductID: createResp.ProductID,
+		Prices:    []usecase.PriceRequest{{Numerator: 2100, Denominator: 100, Currency: "USD"}},
+	})
+	assert.ErrorIs(t, err, domain.ErrPriceBookBaseCurrency)
+
+	// Test: An empty list removes the price book
+	err = fixture.UseCases.SetPriceBook(ctx, usecase.SetPriceBookRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Empty(t, product.PriceBook)
+	assert.Equal(t, query.PriceSourceProduct, product.PriceSource)
+}
+
+func TestSegmentPricingFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create and activate a $20.00 product with 25% off
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Product With Segment Prices",
+		Description:          "Sold to wholesale customers and employees",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	now := fixture.Now()
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 25,
+		StartDate:          now,
+		EndDate:            now.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Test: 12.00 for employees, 10% off for wholesale customers
+	err = fixture.UseCases.SetSegmentPrices(ctx, usecase.SetSegmentPricesRequest{
+		ProductID: createResp.ProductID,
+		Prices: []usecase.SegmentPriceRequest{
+			{Segment: "wholesale", PercentOff: 10},
+			{Segment: "employee", PriceNumerator: 1200, PriceDenominator: 100},
+		},
+	})
+	require.NoError(t, err)
+
+	// Verify: Each segment gets its own base price, with the discount applied
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID, Segment: "employee"})
+	require.NoError(t, err)
+	assert.Equal(t, "employee", product.Segment)
+	assert.Equal(t, "12", big.NewRat(product.BasePriceNumerator, product.BasePriceDenominator).RatString())
+	assert.Equal(t, "9", big.NewRat(product.EffectivePriceNumerator, product.EffectivePriceDenominator).RatString())
+	require.Len(t, product.SegmentPrices, 2)
+	assert.Equal(t, "employee", product.SegmentPrices[0].Segment)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID, Segment: "wholesale"})
+	require.NoError(t, err)
+	assert.Equal(t, "27/2", big.NewRat(product.EffectivePriceNumerator, product.EffectivePriceDenominator).RatString())
+
+	// Verify: Other segments get the regular prices
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID, Segment: "retail"})
+	require.NoError(t, err)
+	assert.Empty(t, product.Segment)
+	assert.Equal(t, "15", big.NewRat(product.EffectivePriceNumerator, product.EffectivePriceDenominator).RatString())
+
+	events := fixture.GetOutboxEvents(t, createResp.ProductID)
+	assert.Equal(t, "product.segment_prices_changed", events[len(events)-1].EventType)
+
+	// Verify: Segments must be distinct
+	err = fixture.UseCases.SetSegmentPrices(ctx, usecase.SetSegmentPricesRequest{
+		ProductID: createResp.ProductID,
+		Prices: []usecase.SegmentPriceRequest{
+			{Segment: "wholesale", PercentOff: 10},
+			{Segment: "wholesale", PercentOff: 20},
+		},
+	})
+	assert.ErrorIs(t, err, domain.ErrDuplicateSegment)
+
+	// Test: An empty list removes the segment prices
+	err = fixture.UseCases.SetSegmentPrices(ctx, usecase.SetSegmentPricesRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID, Segment: "employee"})
+	require.NoError(t, err)
+	assert.Empty(t, product.SegmentPrices)
+	assert.Empty(t, product.Segment)
+}
+
+func TestMarketPricingFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create and activate a $20.00 product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Product With Market Prices",
+		Description:          "Sold in the EU and UK at regional prices",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Test: 18.00 EUR in the EU with a 10% EU-only sale
+	err = fixture.UseCases.SetMarketPrices(ctx, usecase.SetMarketPricesRequest{
+		ProductID: createResp.ProductID,
+		Prices: []usecase.MarketPriceRequest{
+			{Market: "EU", Numerator: 1800, Denominator: 100},
+		},
+	})
+	require.NoError(t, err)
+
+	now := fixture.Now()
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 10,
+		StartDate:          now,
+		EndDate:            now.Add(24 * time.Hour),
+		Market:             "EU",
+	})
+	require.NoError(t, err)
+
+	// Verify: The EU gets its own price with the sale applied
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID, Market: "EU"})
+	require.NoError(t, err)
+	assert.Equal(t, "EU", product.Market)
+	assert.Equal(t, "EUR", product.Currency)
+	assert.Equal(t, "18", big.NewRat(product.BasePriceNumerator, product.BasePriceDenominator).RatString())
+	assert.Equal(t, "81/5", big.NewRat(product.EffectivePriceNumerator, product.EffectivePriceDenominator).RatString())
+	assert.True(t, product.HasActiveDiscount)
+
+	// Verify: The sale does not apply to the base price, nor to markets without a price
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID, Market: "UK"})
+	require.NoError(t, err)
+	assert.Empty(t, product.Market)
+	assert.Equal(t, "20", big.NewRat(product.EffectivePriceNumerator, product.EffectivePriceDenominator).RatString())
+	assert.False(t, product.HasActiveDiscount)
+	require.Len(t, product.MarketPrices, 1)
+
+	events := fixture.GetOutboxEvents(t, createResp.ProductID)
+	assert.Equal(t, "product.discount_applied", events[len(events)-1].EventType)
+	assert.Equal(t, "product.market_prices_changed", events[len(events)-2].EventType)
+
+	// Verify: The EU price cannot be removed while the sale runs
+	err = fixture.UseCases.SetMarketPrices(ctx, usecase.SetMarketPricesRequest{ProductID: createResp.ProductID})
+	assert.ErrorIs(t, err, domain.ErrMarketPriceInUse)
+
+	// Verify: A market price must be in the market's currency
+	err = fixture.UseCases.SetMarketPrices(ctx, usecase.SetMarketPricesRequest{
+		ProductID: createResp.ProductID,
+		Prices: []usecase.MarketPriceRequest{
+			{Market: "EU", Numerator: 1800, Denominator: 100},
+			{Market: "UK", Numerator: 1500, Denominator: 100, Currency: "EUR"},
+		},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidMarketPrice)
+}
+
+func TestPriceListFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create and activate a $20.00 product on sale, and a 30-day EUR price list
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Product On A Price List",
+		Description:          "Sold to a B2B customer at a negotiated price",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	now := fixture.Now()
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 50,
+		StartDate:          now,
+		EndDate:            now.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	listResp, err := fixture.UseCases.CreatePriceList(ctx, usecase.CreatePriceListRequest{
+		Name:       "Acme Corp",
+		Currency:   "EUR",
+		ValidFrom:  now,
+		ValidUntil: now.AddDate(0, 0, 30),
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupPriceList(t, listResp.PriceListID)
+	})
+
+	// Verify: Without an entry the base price applies, without the sale
+	price, err := fixture.Queries.GetPriceListPrice(ctx, query.GetPriceListPriceRequest{
+		PriceListID: listResp.PriceListID,
+		ProductID:   createResp.ProductID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, query.PriceListSourceBasePrice, price.Source)
+	assert.Equal(t, "USD", price.Currency)
+	assert.Equal(t, "20", big.NewRat(price.PriceNumerator, price.PriceDenominator).RatString())
+
+	// Test: Give the product a price on the list
+	err = fixture.UseCases.SetPriceListEntries(ctx, usecase.SetPriceListEntriesRequest{
+		PriceListID: listResp.PriceListID,
+		Entries: []usecase.PriceListEntryRequest{
+			{ProductID: createResp.ProductID, Numerator: 1650, Denominator: 100},
+		},
+	})
+	require.NoError(t, err)
+
+	// Verify: The list price applies while the list is valid
+	price, err = fixture.Queries.GetPriceListPrice(ctx, query.GetPriceListPriceRequest{
+		PriceListID: listResp.PriceListID,
+		ProductID:   createResp.ProductID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, query.PriceListSourceEntry, price.Source)
+	assert.Equal(t, "EUR", price.Currency)
+	assert.Equal(t, "33/2", big.NewRat(price.PriceNumerator, price.PriceDenominator).RatString())
+
+	price, err = fixture.Queries.GetPriceListPrice(ctx, query.GetPriceListPriceRequest{
+		PriceListID: listResp.PriceListID,
+		ProductID:   createResp.ProductID,
+		At:          now.AddDate(0, 0, 30),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, query.PriceListSourceBasePrice, price.Source)
+
+	events := fixture.GetOutboxEvents(t, listResp.PriceListID)
+	require.Len(t, events, 2)
+	assert.Equal(t, "price_list.created", events[0].EventType)
+	assert.Equal(t, "price_list.entries_changed", events[1].EventType)
+
+	// Verify: Entries must be in the currency of the list
+	err = fixture.UseCases.SetPriceListEntries(ctx, usecase.SetPriceListEntriesRequest{
+		PriceListID: listResp.PriceListID,
+		Entries: []usecase.PriceListEntryRequest{
+			{ProductID: createResp.ProductID, Numerator: 1650, Denominator: 100, Currency: "USD"},
+		},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidPriceListEntry)
+
+	_, err = fixture.Queries.GetPriceListPrice(ctx, query.GetPriceListPriceRequest{
+		PriceListID: "missing-price-list",
+		ProductID:   createResp.ProductID,
+	})
+	assert.ErrorIs(t, err, domain.ErrPriceListNotFound)
+}
+
+func TestMerchandisedOrdering(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	category := "Merchandising"
+
+	// Setup: Create three active products in a category of their own
+	ids := make([]string, 3)
+	for i := range ids {
+		createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 fmt.Sprintf("Merchandised Product %d", i),
+			Description:          "Ranked by the merchandising service",
+			Category:             category,
+			BasePriceNumerator:   1000,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		ids[i] = createResp.ProductID
+		t.Cleanup(func() {
+			fixture.CleanupProduct(t, createResp.ProductID)
+		})
+	}
+	t.Cleanup(func() {
+		require.NoError(t, fixture.Merchandising.ReplaceRanks(ctx, category, nil))
+	})
+
+	// Test: Rank the third product first and the first one second; the second is unranked
+	err := fixture.Merchandising.ReplaceRanks(ctx, category, []contract.MerchandisingRank{
+		{ProductID: ids[2], Rank: 1},
+		{ProductID: ids[0], Rank: 2},
+	})
+	require.NoError(t, err)
+
+	// Verify: Ranked products come first, in rank order, across pages
+	var listed []string
+	pageToken := ""
+	for page := 0; page < 3; page++ {
+		resp, err := fixture.Queries.ListProducts(ctx, query.ListProductsRequest{
+			Category:  category,
+			PageSize:  2,
+			PageToken: pageToken,
+			OrderBy:   contract.OrderByMerchandised,
+		})
+		require.NoError(t, err)
+		for _, p := range resp.Products {
+			listed = append(listed, p.ID)
+		}
+		if pageToken = resp.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+	assert.Equal(t, []string{ids[2], ids[0], ids[1]}, listed)
+
+	// Test: A new ranking replaces the previous one
+	err = fixture.Merchandising.ReplaceRanks(ctx, category, []contract.MerchandisingRank{{ProductID: ids[1], Rank: 1}})
+	require.NoError(t, err)
+
+	resp, err := fixture.Queries.ListProducts(ctx, query.ListProductsRequest{
+		Category: category,
+		OrderBy:  contract.OrderByMerchandised,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Products, 3)
+	assert.Equal(t, ids[1], resp.Products[0].ID)
+
+	// Verify: A product ID page token is rejected with the merchandised ordering
+	_, err = fixture.Queries.ListProducts(ctx, query.ListProductsRequest{
+		Category:  category,
+		PageToken: ids[0],
+		OrderBy:   contract.OrderByMerchandised,
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+}
+
+func TestTaxClassFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	rates, err := domain.ParseTaxRates("standard=20,reduced=5,DE:standard=19")
+	require.NoError(t, err)
+	queries := query.NewProductQueries(fixture.ReadModel, fixture.clock, query.WithTaxRates(rates))
+
+	// Setup: Create a $20.00 product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Taxed Product",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	// Verify: New products are in the standard tax class
+	product, err := queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "standard", product.TaxClass)
+
+	price, err := queries.GetTaxInclusivePrice(ctx, query.GetTaxInclusivePriceRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "20.00", price.NetPriceDisplay)
+	assert.Equal(t, "4.00", price.TaxAmountDisplay)
+	assert.Equal(t, "24.00", price.GrossPriceDisplay)
+
+	price, err = queries.GetTaxInclusivePrice(ctx, query.GetTaxInclusivePriceRequest{ProductID: createResp.ProductID, Region: "DE"})
+	require.NoError(t, err)
+	assert.Equal(t, "23.80", price.GrossPriceDisplay)
+
+	// Test: Move the product to the reduced class
+	err = fixture.UseCases.SetTaxClass(ctx, usecase.SetTaxClassRequest{ProductID: createResp.ProductID, TaxClass: "reduced"})
+	require.NoError(t, err)
+
+	price, err = queries.GetTaxInclusivePrice(ctx, query.GetTaxInclusivePriceRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "reduced", price.TaxClass)
+	assert.Equal(t, 5.0, price.TaxRatePercent)
+	assert.Equal(t, "21.00", price.GrossPriceDisplay)
+
+	// Verify: The change is recorded in the outbox
+	var types []string
+	for _, e := range fixture.GetOutboxEvents(t, createResp.ProductID) {
+		types = append(types, e.EventType)
+	}
+	assert.Contains(t, types, "product.tax_class_changed")
+
+	// Verify: A class without a rate cannot be priced
+	err = fixture.UseCases.SetTaxClass(ctx, usecase.SetTaxClassRequest{ProductID: createResp.ProductID, TaxClass: "luxury"})
+	require.NoError(t, err)
+	_, err = queries.GetTaxInclusivePrice(ctx, query.GetTaxInclusivePriceRequest{ProductID: createResp.ProductID})
+	assert.ErrorIs(t, err, domain.ErrNoTaxRate)
+}
+
+func TestMinimumPriceFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	now := fixture.Now()
+
+	// Setup: Create and activate a $20.00 product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Floored Product",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Test: Set a $15.00 minimum price
+	err = fixture.UseCases.SetMinimumPrice(ctx, usecase.SetMinimumPriceRequest{
+		ProductID:   createResp.ProductID,
+		Numerator:   1500,
+		Denominator: 100,
+	})
+	require.NoError(t, err)
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), product.MinimumPriceNumerator)
+	assert.Equal(t, int64(1), product.MinimumPriceDenominator)
+
+	// Verify: A 30% discount would bring the price to $14.00 and is rejected
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 30.0,
+		StartDate:          now,
+		EndDate:            now.Add(48 * time.Hour),
+	})
+	assert.ErrorIs(t, err, domain.ErrDiscountBelowMinimumPrice)
+
+	// Verify: A 25% discount reaches the floor exactly and is applied
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 25.0,
+		StartDate:          now,
+		EndDate:            now.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Verify: The change is recorded in the outbox
+	var types []string
+	for _, e := range fixture.GetOutboxEvents(t, createResp.ProductID) {
+		types = append(types, e.EventType)
+	}
+	assert.Contains(t, types, "product.minimum_price_changed")
+
+	// Test: Remove the minimum price
+	err = fixture.UseCases.SetMinimumPrice(ctx, usecase.SetMinimumPriceRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Zero(t, product.MinimumPriceDenominator)
+}
+
+func TestCostPriceAndMarginFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	now := fixture.Now()
+
+	// Setup: Create and activate a $20.00 product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Costed Product",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Verify: Without a cost price there is no margin
+	_, err = fixture.Queries.GetMargin(ctx, query.GetMarginRequest{ProductID: createResp.ProductID})
+	assert.ErrorIs(t, err, domain.ErrNoCostPrice)
+
+	// Test: Set a $12.00 cost price
+	err = fixture.UseCases.SetCostPrice(ctx, usecase.SetCostPriceRequest{
+		ProductID:   createResp.ProductID,
+		Numerator:   1200,
+		Denominator: 100,
+	})
+	require.NoError(t, err)
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), product.CostPriceNumerator)
+	assert.Equal(t, int64(1), product.CostPriceDenominator)
+
+	margin, err := fixture.Queries.GetMargin(ctx, query.GetMarginRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), margin.MarginNumerator)
+	assert.Equal(t, int64(1), margin.MarginDenominator)
+	assert.InDelta(t, 40.0, margin.MarginPercent, 1e-9)
+
+	// Verify: With a blocking guard, a 50% discount would sell at $10.00 and is rejected
+	blocking := usecase.NewProductUseCases(fixture.ProductRepo, fixture.OutboxRepo, fixture.committer, fixture.clock,
+		usecase.WithMarginGuard(domain.MarginGuardBlock))
+	discount := usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 50.0,
+		StartDate:          now,
+		EndDate:            now.Add(48 * time.Hour),
+	}
+	_, err = blocking.ApplyDiscount(ctx, discount)
+	assert.ErrorIs(t, err, domain.ErrNegativeMargin)
+
+	// Verify: The default guard only warns, and the margin turns negative
+	_, err = fixture.UseCases.ApplyDiscount(ctx, discount)
+	require.NoError(t, err)
+
+	margin, err = fixture.Queries.GetMargin(ctx, query.GetMarginRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(-2), margin.MarginNumerator)
+	assert.True(t, margin.HasActiveDiscount)
+
+	// Verify: The change is recorded in the outbox
+	var types []string
+	for _, e := range fixture.GetOutboxEvents(t, createResp.ProductID) {
+		types = append(types, e.EventType)
+	}
+	assert.Contains(t, types, "product.cost_price_changed")
+}
+
+func TestDiscountApprovalFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	now := fixture.Now()
+
+	// Setup: Create and activate a $20.00 product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Deeply Discounted Product",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Test: A 60% discount above a 40% threshold is held pending approval
+	guarded := usecase.NewProductUseCases(fixture.ProductRepo, fixture.OutboxRepo, fixture.committer, fixture.clock,
+		usecase.WithDiscountApprovalThreshold(big.NewRat(40, 1)))
+	applyResp, err := guarded.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 60.0,
+		StartDate:          now,
+		EndDate:            now.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.True(t, applyResp.PendingApproval)
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.Len(t, product.Discounts, 1)
+	assert.True(t, product.Discounts[0].PendingApproval)
+	assert.False(t, product.HasActiveDiscount)
+	assert.Equal(t, int64(20), product.EffectivePriceNumerator)
+
+	// Test: Approve the discount
+	err = guarded.ApproveDiscount(ctx, usecase.ApproveDiscountRequest{
+		ProductID:  createResp.ProductID,
+		DiscountID: applyResp.DiscountID,
+		Approver:   "pricing-lead",
+	})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.Len(t, product.Discounts, 1)
+	assert.False(t, product.Discounts[0].PendingApproval)
+	assert.Equal(t, "pricing-lead", product.Discounts[0].ApprovedBy)
+	assert.True(t, product.HasActiveDiscount)
+	assert.Equal(t, int64(8), product.EffectivePriceNumerator)
+
+	// Verify: Approving twice fails
+	err = guarded.ApproveDiscount(ctx, usecase.ApproveDiscountRequest{
+		ProductID:  createResp.ProductID,
+		DiscountID: applyResp.DiscountID,
+		Approver:   "pricing-lead",
+	})
+	assert.ErrorIs(t, err, domain.ErrDiscountNotPendingApproval)
+
+	// Verify: The approval and the price change are recorded in the outbox
+	var types []string
+	for _, e := range fixture.GetOutboxEvents(t, createResp.ProductID) {
+		types = append(types, e.EventType)
+	}
+	assert.Contains(t, types, "product.discount_approved")
+	assert.Contains(t, types, "product.discount_started")
+}
+
+func TestScheduledPriceChangeFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	now := fixture.Now()
+
+	// Setup: Create a $20.00 product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Repriced Product",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	// Verify: A change must take effect in the future
+	err = fixture.UseCases.SchedulePriceChange(ctx, usecase.SchedulePriceChangeRequest{
+		ProductID:            createResp.ProductID,
+		BasePriceNumerator:   1800,
+		BasePriceDenominator: 100,
+		EffectiveAt:          now.Add(-time.Minute),
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidPriceChangeTime)
+
+	// Test: Schedule $18.00 in two hours
+	effectiveAt := now.Add(2 * time.Hour)
+	err = fixture.UseCases.SchedulePriceChange(ctx, usecase.SchedulePriceChangeRequest{
+		ProductID:            createResp.ProductID,
+		BasePriceNumerator:   1800,
+		BasePriceDenominator: 100,
+		EffectiveAt:          effectiveAt,
+	})
+	require.NoError(t, err)
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), product.BasePriceNumerator)
+	require.NotNil(t, product.PendingPriceChange)
+	assert.Equal(t, int64(18), product.PendingPriceChange.PriceNumerator)
+	assert.True(t, effectiveAt.Equal(product.PendingPriceChange.EffectiveAt))
+
+	// Verify: The scheduler finds nothing before the change is due
+	ids, err := fixture.ProductRepo.FindPriceChangeDue(ctx, fixture.Now(), 100)
+	require.NoError(t, err)
+	assert.NotContains(t, ids, createResp.ProductID)
+
+	// Test: Apply the change once it is due
+	fixture.AdvanceTime(2*time.Hour + time.Second)
+	ids, err = fixture.ProductRepo.FindPriceChangeDue(ctx, fixture.Now(), 100)
+	require.NoError(t, err)
+	assert.Contains(t, ids, createResp.ProductID)
+
+	err = fixture.UseCases.ApplyPriceChange(ctx, usecase.ApplyPriceChangeRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(18), product.BasePriceNumerator)
+	assert.Nil(t, product.PendingPriceChange)
+
+	ids, err = fixture.ProductRepo.FindPriceChangeDue(ctx, fixture.Now(), 100)
+	require.NoError(t, err)
+	assert.NotContains(t, ids, createResp.ProductID)
+
+	// Verify: Cancelling without a pending change fails
+	err = fixture.UseCases.CancelPriceChange(ctx, usecase.CancelPriceChangeRequest{ProductID: createResp.ProductID})
+	assert.ErrorIs(t, err, domain.ErrNoPendingPriceChange)
+
+	// Verify: The outbox records the scheduling and the change with its effective time
+	var types []string
+	for _, e := range fixture.GetOutboxEvents(t, createResp.ProductID) {
+		types = append(types, e.EventType)
+		if e.EventType == "product.price_changed" {
+			var payload map[string]interface{}
+			require.NoError(t, json.Unmarshal(e.Payload, &payload))
+			assert.NotNil(t, payload["effective_at"])
+		}
+	}
+	assert.Contains(t, types, "product.price_change_scheduled")
+	assert.Contains(t, types, "product.price_changed")
+}
+
+func TestScheduledActivationFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	now := fixture.Now()
+
+	// Setup: Create a product, which starts as a draft
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Launch Product",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	// Verify: Scheduled times must be in the future
+	err = fixture.UseCases.ScheduleActivation(ctx, usecase.ScheduleActivationRequest{
+		ProductID:  createResp.ProductID,
+		ActivateAt: now.Add(-time.Minute),
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidStatusSchedule)
+
+	// Test: Launch in two hours and take the product off sale a day later
+	activateAt := now.Add(2 * time.Hour)
+	deactivateAt := activateAt.Add(24 * time.Hour)
+	err = fixture.UseCases.ScheduleActivation(ctx, usecase.ScheduleActivationRequest{
+		ProductID:    createResp.ProductID,
+		ActivateAt:   activateAt,
+		DeactivateAt: deactivateAt,
+	})
+	require.NoError(t, err)
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.NotEqual(t, "active", product.Status)
+	require.NotNil(t, product.ActivateAt)
+	require.NotNil(t, product.DeactivateAt)
+	assert.True(t, activateAt.Equal(*product.ActivateAt))
+	assert.True(t, deactivateAt.Equal(*product.DeactivateAt))
+
+	// Verify: The scheduler finds nothing before the activation is due
+	ids, err := fixture.ProductRepo.FindStatusChangeDue(ctx, fixture.Now(), 100)
+	require.NoError(t, err)
+	assert.NotContains(t, ids, createResp.ProductID)
+
+	// Test: Activate the product once the activation is due
+	fixture.AdvanceTime(2*time.Hour + time.Second)
+	ids, err = fixture.ProductRepo.FindStatusChangeDue(ctx, fixture.Now(), 100)
+	require.NoError(t, err)
+	assert.Contains(t, ids, createResp.ProductID)
+
+	err = fixture.UseCases.ApplyStatusSchedule(ctx, usecase.ApplyStatusScheduleRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "active", product.Status)
+	assert.Nil(t, product.ActivateAt)
+	assert.NotNil(t, product.DeactivateAt)
+
+	// Test: Deactivate it a day later
+	fixture.AdvanceTime(24 * time.Hour)
+	err = fixture.UseCases.ApplyStatusSchedule(ctx, usecase.ApplyStatusScheduleRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "inactive", product.Status)
+	assert.Nil(t, product.DeactivateAt)
+
+	ids, err = fixture.ProductRepo.FindStatusChangeDue(ctx, fixture.Now(), 100)
+	require.NoError(t, err)
+	assert.NotContains(t, ids, createResp.ProductID)
+
+	// Verify: The outbox records the schedule and the usual status events
+	var types []string
+	for _, e := range fixture.GetOutboxEvents(t, createResp.ProductID) {
+		types = append(types, e.EventType)
+	}
+	assert.Contains(t, types, "product.status_scheduled")
+	assert.Contains(t, types, "product.activated")
+	assert.Contains(t, types, "product.deactivated")
+}
+
+func TestProductReviewFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Use cases that require a review before the first activation
+	reviewed := usecase.NewProductUseCases(fixture.ProductRepo, fixture.OutboxRepo, fixture.committer, fixture.clock,
+		usecase.WithProductReview(true))
+
+	createResp, err := reviewed.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Reviewed Product",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	// Verify: A draft cannot be activated without a review
+	err = reviewed.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	assert.ErrorIs(t, err, domain.ErrProductReviewRequired)
+
+	// Verify: Nor on schedule, even if the activation was scheduled before reviews were
+	// required
+	schedule := usecase.ScheduleActivationRequest{ProductID: createResp.ProductID, ActivateAt: fixture.Now().Add(time.Hour)}
+	err = reviewed.ScheduleActivation(ctx, schedule)
+	assert.ErrorIs(t, err, domain.ErrProductReviewRequired)
+	require.NoError(t, fixture.UseCases.ScheduleActivation(ctx, schedule))
+	fixture.AdvanceTime(time.Hour + time.Second)
+	err = reviewed.ApplyStatusSchedule(ctx, usecase.ApplyStatusScheduleRequest{ProductID: createResp.ProductID})
+	assert.ErrorIs(t, err, domain.ErrProductReviewRequired)
+
+	// Test: Submit the product and reject it
+	err = reviewed.SubmitForReview(ctx, usecase.SubmitForReviewRequest{ProductID: createResp.ProductID, SubmittedBy: "alice"})
+	require.NoError(t, err)
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "pending_review", product.Status)
+	require.NotNil(t, product.Review)
+	assert.Equal(t, "alice", product.Review.SubmittedBy)
+
+	err = reviewed.ApproveProduct(ctx, usecase.ApproveProductRequest{ProductID: createResp.ProductID, Reviewer: "alice"})
+	assert.ErrorIs(t, err, domain.ErrReviewerIsSubmitter)
+
+	err = reviewed.RejectProduct(ctx, usecase.RejectProductRequest{
+		ProductID: createResp.ProductID,
+		Reviewer:  "bob",
+		Reason:    "missing description",
+	})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "draft", product.Status)
+	require.NotNil(t, product.Review)
+	assert.Equal(t, "bob", product.Review.ReviewedBy)
+	assert.Equal(t, "missing description", product.Review.RejectionReason)
+	assert.Nil(t, product.ActivateAt, "rejecting the product drops its schedule")
+
+	// Test: Submit it again and approve it, which activates it
+	err = reviewed.SubmitForReview(ctx, usecase.SubmitForReviewRequest{ProductID: createResp.ProductID, SubmittedBy: "alice"})
+	require.NoError(t, err)
+
+	err = reviewed.ApproveProduct(ctx, usecase.ApproveProductRequest{ProductID: createResp.ProductID, Reviewer: "bob"})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "active", product.Status)
+	require.NotNil(t, product.Review)
+	assert.Equal(t, "alice", product.Review.SubmittedBy)
+	assert.Equal(t, "bob", product.Review.ReviewedBy)
+	assert.Empty(t, product.Review.RejectionReason)
+
+	// Verify: The outbox records the review and the activation
+	var types []string
+	for _, e := range fixture.GetOutboxEvents(t, createResp.ProductID) {
+		types = append(types, e.EventType)
+	}
+	assert.Contains(t, types, "product.submitted_for_review")
+	assert.Contains(t, types, "product.rejected")
+	assert.Contains(t, types, "product.approved")
+	assert.Contains(t, types, "product.activated")
+}
+
+func TestPurgeFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create and archive a product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Retired Product",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	// Setup: Price it on a price list and link another product to it
+	listResp, err := fixture.UseCases.CreatePriceList(ctx, usecase.CreatePriceListRequest{
+		Name:      "Retired Prices",
+		Currency:  "USD",
+		ValidFrom: fixture.Now(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupPriceList(t, listResp.PriceListID)
+	})
+	err = fixture.UseCases.SetPriceListEntries(ctx, usecase.SetPriceListEntriesRequest{
+		PriceListID: listResp.PriceListID,
+		Entries:     []usecase.PriceListEntryRequest{{ProductID: createResp.ProductID, Numerator: 1500, Denominator: 100}},
+	})
+	require.NoError(t, err)
+
+	linkingResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Linking Product",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, linkingResp.ProductID)
+	})
+	err = fixture.UseCases.LinkProducts(ctx, usecase.ProductLinkRequest{
+		ProductID:        linkingResp.ProductID,
+		RelatedProductID: createResp.ProductID,
+		RelationType:     "related",
+	})
+	require.NoError(t, err)
+
+	err = fixture.UseCases.ArchiveProduct(ctx, usecase.ArchiveProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.NotEmpty(t, fixture.GetOutboxEvents(t, createResp.ProductID))
+
+	// Verify: A product archived since the cutoff is kept
+	cutoff := fixture.Now()
+	ids, err := fixture.ProductRepo.FindPurgeable(ctx, cutoff, 100)
+	require.NoError(t, err)
+	assert.NotContains(t, ids, createResp.ProductID)
+
+	err = fixture.UseCases.PurgeProduct(ctx, usecase.PurgeProductRequest{ProductID: createResp.ProductID, ArchivedBefore: cutoff})
+	assert.ErrorIs(t, err, domain.ErrProductNotPurgeable)
+
+	// Test: Purge it once it was archived before the cutoff
+	fixture.AdvanceTime(31 * 24 * time.Hour)
+	cutoff = fixture.Now().Add(-30 * 24 * time.Hour)
+	ids, err = fixture.ProductRepo.FindPurgeable(ctx, cutoff, 100)
+	require.NoError(t, err)
+	assert.Contains(t, ids, createResp.ProductID)
+
+	err = fixture.UseCases.PurgeProduct(ctx, usecase.PurgeProductRequest{ProductID: createResp.ProductID, ArchivedBefore: cutoff})
+	require.NoError(t, err)
+
+	_, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+
+	ids, err = fixture.ProductRepo.FindPurgeable(ctx, cutoff, 100)
+	require.NoError(t, err)
+	assert.NotContains(t, ids, createResp.ProductID)
+
+	// Verify: Only the purge event is left in the outbox
+	events := fixture.GetOutboxEvents(t, createResp.ProductID)
+	require.Len(t, events, 1)
+	assert.Equal(t, "product.purged", events[0].EventType)
+
+	// Verify: Its price list entry and the link to it are deleted with it
+	_, err = fixture.spannerClient.Single().ReadRow(ctx, repository.PriceListEntriesTable,
+		spanner.Key{listResp.PriceListID, createResp.ProductID}, []string{repository.PriceListEntryPriceNumerator})
+	assert.Equal(t, codes.NotFound, spanner.ErrCode(err))
+	_, err = fixture.spannerClient.Single().ReadRow(ctx, repository.RelationsTable,
+		spanner.Key{linkingResp.ProductID, "related", createResp.ProductID}, []string{repository.RelationCreatedAt})
+	assert.Equal(t, codes.NotFound, spanner.ErrCode(err))
+}
+
+func TestPriceHistoryFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	created := fixture.Now()
+
+	// Setup: Create a $20.00 product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Audited Product",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	// Test: Change the base price, then discount the product
+	fixture.AdvanceTime(time.Hour)
+	err = fixture.UseCases.ChangeBasePrice(ctx, usecase.ChangeBasePriceRequest{
+		ProductID:            createResp.ProductID,
+		BasePriceNumerator:   2400,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	fixture.AdvanceTime(time.Hour)
+	discounted := fixture.Now()
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 25.0,
+		StartDate:          discounted,
+		EndDate:            discounted.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Test: Renaming the product is not a price change
+	fixture.AdvanceTime(time.Hour)
+	err = fixture.UseCases.UpdateProduct(ctx, usecase.UpdateProductRequest{
+		ProductID: createResp.ProductID,
+		Name:      "Renamed Product",
+		Category:  "Test",
+	})
+	require.NoError(t, err)
+
+	// Verify: Every price change is recorded, oldest first
+	history, err := fixture.Queries.GetPriceHistory(ctx, query.GetPriceHistoryRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.Len(t, history.Entries, 3)
+
+	assert.Equal(t, "product.created", history.Entries[0].ChangeType)
+	assert.True(t, created.Equal(history.Entries[0].ChangedAt))
+	assert.Equal(t, "20.00", history.Entries[0].EffectivePriceDisplay)
+
+	assert.Equal(t, "product.price_changed", history.Entries[1].ChangeType)
+	assert.Equal(t, "24.00", history.Entries[1].EffectivePriceDisplay)
+	assert.Nil(t, history.Entries[1].DiscountPercent)
+
+	assert.Equal(t, "product.discount_applied", history.Entries[2].ChangeType)
+	assert.Equal(t, "24.00", history.Entries[2].BasePriceDisplay)
+	assert.Equal(t, "18.00", history.Entries[2].EffectivePriceDisplay)
+	require.NotNil(t, history.Entries[2].DiscountPercent)
+	assert.Equal(t, 25.0, *history.Entries[2].DiscountPercent)
+	assert.NotEmpty(t, history.Entries[2].DiscountID)
+
+	// Verify: The range selects changes in [from, to)
+	history, err = fixture.Queries.GetPriceHistory(ctx, query.GetPriceHistoryRequest{
+		ProductID: createResp.ProductID,
+		From:      created.Add(time.Hour),
+		To:        discounted,
+	})
+	require.NoError(t, err)
+	require.Len(t, history.Entries, 1)
+	assert.Equal(t, "product.price_changed", history.Entries[0].ChangeType)
+
+	// Verify: Unknown products are reported as not found
+	_, err = fixture.Queries.GetPriceHistory(ctx, query.GetPriceHistoryRequest{ProductID: "non-existent-id"})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+}
+
+func TestProductRevisionsFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	created := fixture.Now()
+
+	// Setup: Create a $20.00 product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Revised Product",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	// Test: Rename the product, then change its base price
+	fixture.AdvanceTime(time.Hour)
+	renamed := fixture.Now()
+	err = fixture.UseCases.UpdateProduct(ctx, usecase.UpdateProductRequest{
+		ProductID: createResp.ProductID,
+		Name:      "Renamed Product",
+		Category:  "Test",
+	})
+	require.NoError(t, err)
+
+	fixture.AdvanceTime(time.Hour)
+	err = fixture.UseCases.ChangeBasePrice(ctx, usecase.ChangeBasePriceRequest{
+		ProductID:            createResp.ProductID,
+		BasePriceNumerator:   2400,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	// Verify: Every change is recorded, oldest first, with a diff against the one before
+	revisions, err := fixture.Queries.GetProductRevisions(ctx, query.GetProductRevisionsRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.Len(t, revisions.Revisions, 3)
+	assert.True(t, created.Equal(revisions.Revisions[0].RevisedAt))
+
+	rename := revisions.Revisions[1]
+	assert.Contains(t, rename.ChangedFields, domain.FieldName)
+	nameChange := findFieldChange(rename.Changes, "name")
+	require.NotNil(t, nameChange)
+	assert.JSONEq(t, `"Revised Product"`, string(nameChange.Before))
+	assert.JSONEq(t, `"Renamed Product"`, string(nameChange.After))
+	assert.Nil(t, findFieldChange(rename.Changes, "base_price_numerator"))
+
+	priceChange := revisions.Revisions[2]
+	assert.Contains(t, priceChange.ChangedFields, domain.FieldBasePrice)
+	assert.Nil(t, findFieldChange(priceChange.Changes, "name"))
+	assert.NotNil(t, findFieldChange(priceChange.Changes, "base_price_numerator"))
+
+	// Verify: The range selects revisions in [from, to), still diffed against the one before
+	ranged, err := fixture.Queries.GetProductRevisions(ctx, query.GetProductRevisionsRequest{
+		ProductID: createResp.ProductID,
+		From:      renamed,
+		To:        renamed.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, ranged.Revisions, 1)
+	assert.Equal(t, rename.RevisionID, ranged.Revisions[0].RevisionID)
+	assert.NotNil(t, findFieldChange(ranged.Revisions[0].Changes, "name"))
+
+	// Verify: A revision returns the full product as it was then
+	atRename, err := fixture.Queries.GetProductAtRevision(ctx, query.GetProductAtRevisionRequest{
+		ProductID:  createResp.ProductID,
+		RevisionID: rename.RevisionID,
+	})
+	require.NoError(t, err)
+	var snapshot map[string]interface{}
+	require.NoError(t, json.Unmarshal(atRename.Snapshot, &snapshot))
+	assert.Equal(t, "Renamed Product", snapshot["name"])
+	assert.Equal(t, 20.0, snapshot["base_price_numerator"])
+
+	// Verify: Unknown products and revisions are reported as not found
+	_, err = fixture.Queries.GetProductRevisions(ctx, query.GetProductRevisionsRequest{ProductID: "non-existent-id"})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	_, err = fixture.Queries.GetProductAtRevision(ctx, query.GetProductAtRevisionRequest{
+		ProductID:  createResp.ProductID,
+		RevisionID: "non-existent-id",
+	})
+	assert.ErrorIs(t, err, domain.ErrRevisionNotFound)
+}
+
+// findFieldChange returns the change of field in changes, or nil if it did not change.
+func findFieldChange(changes []*query.FieldChange, field string) *query.FieldChange {
+	for _, change := range changes {
+		if change.Field == field {
+			return change
+		}
+	}
+	return nil
+}
+
+func TestCalculatePriceFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	now := fixture.Now()
+
+	// Setup: Create and activate a $20.00 product, 10% off from 10 units, with a 25% discount
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Previewed Product",
+		Description:          "Priced without changes",
+		Category:             "Test",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	err = fixture.UseCases.SetPriceTiers(ctx, usecase.SetPriceTiersRequest{
+		ProductID: createResp.ProductID,
+		Tiers:     []usecase.PriceTierRequest{{MinQuantity: 10, PercentOff: 10}},
+	})
+	require.NoError(t, err)
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 25.0,
+		StartDate:          now,
+		EndDate:            now.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+	eventsBefore := len(fixture.GetOutboxEvents(t, createResp.ProductID))
+
+	// Test: Preview 10 units with a 20% coupon
+	price, err := fixture.Queries.CalculatePrice(ctx, query.CalculatePriceRequest{
+		ProductID:     createResp.ProductID,
+		Quantity:      10,
+		CouponPercent: 20,
+	})
+	require.NoError(t, err)
+
+	// Verify: $18.00 tier price, 25% off then 20% off leaves $10.80 a unit
+	assert.Equal(t, int64(10), price.TierMinQuantity)
+	assert.Equal(t, 25.0, price.DiscountPercent)
+	assert.Equal(t, "10.80", price.UnitPriceDisplay)
+	assert.Equal(t, "108.00", price.TotalPriceDisplay)
+	assert.Equal(t, "72.00", price.DiscountAmountDisplay)
+	assert.Equal(t, "92.00", price.SavingsDisplay)
+
+	// Verify: After the discount ends only the tier applies
+	price, err = fixture.Queries.CalculatePrice(ctx, query.CalculatePriceRequest{
+		ProductID: createResp.ProductID,
+		Quantity:  10,
+		At:        now.Add(72 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Zero(t, price.DiscountPercent)
+	assert.Equal(t, "180.00", price.TotalPriceDisplay)
+	assert.Equal(t, "0.00", price.DiscountAmountDisplay)
+	assert.Equal(t, "20.00", price.SavingsDisplay)
+
+	// Verify: Previews change nothing
+	assert.Len(t, fixture.GetOutboxEvents(t, createResp.ProductID), eventsBefore)
+
+	// Verify: Unknown products are not found
+	_, err = fixture.Queries.CalculatePrice(ctx, query.CalculatePriceRequest{ProductID: "missing-product"})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+}
+
+func TestProductVariantFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	now := fixture.Now()
+
+	// Setup: Create and activate a $20.00 product with a 25% discount
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Variant Shirt",
+		Description:          "Sold in sizes",
+		Category:             "Apparel",
+		BasePriceNumerator:   2000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	_, err = fixture.UseCases.ApplyDiscount(ctx, usecase.ApplyDiscountRequest{
+		ProductID:          createResp.ProductID,
+		DiscountPercentage: 25.0,
+		StartDate:          now,
+		EndDate:            now.Add(48 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Test: Add a medium and a $3.00 dearer extra large variant
+	sku := "E2E-SHIRT-" + createResp.ProductID[:8]
+	medium, err := fixture.UseCases.AddVariant(ctx, usecase.AddVariantRequest{
+		ProductID:  createResp.ProductID,
+		SKU:        sku + "-M",
+		Attributes: map[string]string{"size": "M"},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupVariantEvents(t, medium.VariantID)
+	})
+	large, err := fixture.UseCases.AddVariant(ctx, usecase.AddVariantRequest{
+		ProductID:             createResp.ProductID,
+		SKU:                   sku + "-XL",
+		Attributes:            map[string]string{"size": "XL"},
+		PriceDeltaNumerator:   300,
+		PriceDeltaDenominator: 100,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupVariantEvents(t, large.VariantID)
+	})
+
+	// Verify: The product lists its variants, discounted like the product
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.Len(t, product.Variants, 2)
+	variants := map[string]*query.VariantResponse{}
+	for _, v := range product.Variants {
+		variants[v.ID] = v
+	}
+	require.Contains(t, variants, large.VariantID)
+	assert.Equal(t, sku+"-XL", variants[large.VariantID].SKU)
+	assert.Equal(t, "23.00", variants[large.VariantID].PriceDisplay)
+	assert.Equal(t, "17.25", variants[large.VariantID].EffectivePriceDisplay)
+	assert.Equal(t, "active", variants[large.VariantID].Status)
+
+	// Test: SKUs are unique across the catalog
+	_, err = fixture.UseCases.AddVariant(ctx, usecase.AddVariantRequest{
+		ProductID: createResp.ProductID,
+		SKU:       sku + "-M",
+	})
+	assert.ErrorIs(t, err, domain.ErrDuplicateSKU)
+	err = fixture.UseCases.UpdateVariant(ctx, usecase.UpdateVariantRequest{
+		ProductID: createResp.ProductID,
+		VariantID: large.VariantID,
+		SKU:       sku + "-M",
+	})
+	assert.ErrorIs(t, err, domain.ErrDuplicateSKU)
+
+	// Test: Update the extra large variant, then discontinue the medium one
+	err = fixture.UseCases.UpdateVariant(ctx, usecase.UpdateVariantRequest{
+		ProductID:             createResp.ProductID,
+		VariantID:             large.VariantID,
+		SKU:                   sku + "-XXL",
+		Attributes:            map[string]string{"size": "XXL"},
+		PriceDeltaNumerator:   500,
+		PriceDeltaDenominator: 100,
+	})
+	require.NoError(t, err)
+	err = fixture.UseCases.DiscontinueVariant(ctx, usecase.DiscontinueVariantRequest{
+		ProductID: createResp.ProductID,
+		VariantID: medium.VariantID,
+	})
+	require.NoError(t, err)
+
+	// Verify: The changes are read back
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.Len(t, product.Variants, 2)
+	for _, v := range product.Variants {
+		switch v.ID {
+		case large.VariantID:
+			assert.Equal(t, sku+"-XXL", v.SKU)
+			assert.Equal(t, map[string]string{"size": "XXL"}, v.Attributes)
+			assert.Equal(t, "25.00", v.PriceDisplay)
+		case medium.VariantID:
+			assert.Equal(t, "discontinued", v.Status)
+		}
+	}
+	err = fixture.UseCases.DiscontinueVariant(ctx, usecase.DiscontinueVariantRequest{
+		ProductID: createResp.ProductID,
+		VariantID: medium.VariantID,
+	})
+	assert.ErrorIs(t, err, domain.ErrVariantDiscontinued)
+
+	// Verify: The variant events were written to the outbox
+	events := fixture.GetOutboxEvents(t, large.VariantID)
+	require.Len(t, events, 2)
+	assert.Equal(t, "product_variant.added", events[0].EventType)
+	assert.Equal(t, "product_variant.updated", events[1].EventType)
+	events = fixture.GetOutboxEvents(t, medium.VariantID)
+	require.Len(t, events, 2)
+	assert.Equal(t, "product_variant.discontinued", events[1].EventType)
+
+	// Verify: Unknown variants are not found
+	err = fixture.UseCases.DiscontinueVariant(ctx, usecase.DiscontinueVariantRequest{
+		ProductID: createResp.ProductID,
+		VariantID: "missing-variant",
+	})
+	assert.ErrorIs(t, err, domain.ErrVariantNotFound)
+}
+
+func TestStockFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create and activate a product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Stocked Lamp",
+		Description:          "Tracked in the warehouse",
+		Category:             "StockE2E",
+		BasePriceNumerator:   4500,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	// Verify: Untracked stock counts as in stock
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.True(t, product.InStock)
+	assert.Nil(t, product.Stock)
+
+	// Test: Set the stock, then reserve all of it
+	stock, err := fixture.UseCases.SetStock(ctx, usecase.SetStockRequest{
+		ProductID: createResp.ProductID,
+		Level:     10,
+		Reserved:  2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), stock.Available)
+	assert.Equal(t, int64(1), stock.Version)
+
+	stock, err = fixture.UseCases.AdjustStock(ctx, usecase.AdjustStockRequest{
+		ProductID:       createResp.ProductID,
+		ReservedDelta:   8,
+		ExpectedVersion: stock.Version,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stock.Available)
+
+	// Verify: The product is out of stock and left out of in-stock listings
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.False(t, product.InStock)
+	require.NotNil(t, product.Stock)
+	assert.Equal(t, int64(10), product.Stock.Level)
+	assert.Equal(t, int64(10), product.Stock.Reserved)
+	assert.Equal(t, int64(2), product.Stock.Version)
+
+	list, err := fixture.Queries.ListProducts(ctx, query.ListProductsRequest{Category: "StockE2E", InStockOnly: true})
+	require.NoError(t, err)
+	for _, p := range list.Products {
+		assert.NotEqual(t, createResp.ProductID, p.ID)
+	}
+
+	// Test: Reserving more than the stock level and stale versions are rejected
+	_, err = fixture.UseCases.AdjustStock(ctx, usecase.AdjustStockRequest{
+		ProductID:     createResp.ProductID,
+		ReservedDelta: 1,
+	})
+	assert.ErrorIs(t, err, domain.ErrInsufficientStock)
+	_, err = fixture.UseCases.AdjustStock(ctx, usecase.AdjustStockRequest{
+		ProductID:       createResp.ProductID,
+		LevelDelta:      5,
+		ExpectedVersion: 1,
+	})
+	assert.ErrorIs(t, err, domain.ErrStockConflict)
+
+	// Test: Restock
+	stock, err = fixture.UseCases.AdjustStock(ctx, usecase.AdjustStockRequest{
+		ProductID:       createResp.ProductID,
+		LevelDelta:      5,
+		ExpectedVersion: 2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), stock.Available)
+
+	list, err = fixture.Queries.ListProducts(ctx, query.ListProductsRequest{Category: "StockE2E", InStockOnly: true})
+	require.NoError(t, err)
+	var listed bool
+	for _, p := range list.Products {
+		if p.ID == createResp.ProductID {
+			listed = true
+			assert.True(t, p.InStock)
+		}
+	}
+	assert.True(t, listed)
+
+	// Verify: The stock transitions were written to the outbox
+	var types []string
+	for _, e := range fixture.GetOutboxEvents(t, createResp.ProductID) {
+		types = append(types, e.EventType)
+	}
+	assert.Contains(t, types, "product.stock_changed")
+	assert.Contains(t, types, "product.out_of_stock")
+	assert.Contains(t, types, "product.back_in_stock")
+}
+
+func TestTagsAndAttributesFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create a product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Tagged Table",
+		Description:          "Solid wood",
+		Category:             "Furniture",
+		BasePriceNumerator:   25000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	// Test: Tag the product and set its attributes
+	tag := "e2e-" + createResp.ProductID[:8]
+	err = fixture.UseCases.AddTag(ctx, usecase.TagRequest{ProductID: createResp.ProductID, Tag: tag})
+	require.NoError(t, err)
+	err = fixture.UseCases.AddTag(ctx, usecase.TagRequest{ProductID: createResp.ProductID, Tag: "Eco"})
+	require.NoError(t, err)
+	err = fixture.UseCases.SetAttribute(ctx, usecase.SetAttributeRequest{ProductID: createResp.ProductID, Name: "material", Value: "oak"})
+	require.NoError(t, err)
+	err = fixture.UseCases.SetAttribute(ctx, usecase.SetAttributeRequest{ProductID: createResp.ProductID, Name: "finish", Value: "oiled"})
+	require.NoError(t, err)
+
+	// Verify: The tags and attributes are read back
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{tag, "eco"}, product.Tags)
+	assert.Equal(t, map[string]string{"material": "oak", "finish": "oiled"}, product.Attributes)
+
+	// Verify: Products can be listed by tag
+	list, err := fixture.Queries.ListProducts(ctx, query.ListProductsRequest{Tag: tag})
+	require.NoError(t, err)
+	require.Len(t, list.Products, 1)
+	assert.Equal(t, createResp.ProductID, list.Products[0].ID)
+
+	// Test: Remove the tag and an attribute
+	err = fixture.UseCases.RemoveTag(ctx, usecase.TagRequest{ProductID: createResp.ProductID, Tag: tag})
+	require.NoError(t, err)
+	err = fixture.UseCases.SetAttribute(ctx, usecase.SetAttributeRequest{ProductID: createResp.ProductID, Name: "finish"})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eco"}, product.Tags)
+	assert.Equal(t, map[string]string{"material": "oak"}, product.Attributes)
+	list, err = fixture.Queries.ListProducts(ctx, query.ListProductsRequest{Tag: tag})
+	require.NoError(t, err)
+	assert.Empty(t, list.Products)
+
+	// Verify: The changes were written to the outbox
+	var types []string
+	for _, e := range fixture.GetOutboxEvents(t, createResp.ProductID) {
+		types = append(types, e.EventType)
+	}
+	assert.Contains(t, types, "product.tags_changed")
+	assert.Contains(t, types, "product.attribute_changed")
+
+	// Test: Invalid tags are rejected
+	err = fixture.UseCases.AddTag(ctx, usecase.TagRequest{ProductID: createResp.ProductID, Tag: "big sale"})
+	assert.ErrorIs(t, err, domain.ErrInvalidTag)
+}
+
+func TestProductImagesFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create a tagged product, so it can be listed on its own
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Pictured Chair",
+		Description:          "Solid wood",
+		Category:             "Furniture",
+		BasePriceNumerator:   12000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	tag := "e2e-" + createResp.ProductID[:8]
+	err = fixture.UseCases.AddTag(ctx, usecase.TagRequest{ProductID: createResp.ProductID, Tag: tag})
+	require.NoError(t, err)
+
+	// Test: Set images without a primary one
+	front := "https://cdn.example.com/" + createResp.ProductID + "/front.jpg"
+	back := "https://cdn.example.com/" + createResp.ProductID + "/back.jpg"
+	side := "https://cdn.example.com/" + createResp.ProductID + "/side.jpg"
+	err = fixture.UseCases.SetImages(ctx, usecase.SetImagesRequest{
+		ProductID: createResp.ProductID,
+		Images: []usecase.ImageInput{
+			{URL: front, AltText: "Front view"},
+			{URL: back},
+			{URL: side},
+		},
+	})
+	require.NoError(t, err)
+
+	// Verify: The images are read back in order, the first one primary
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, []query.ImageResponse{
+		{URL: front, AltText: "Front view", Primary: true},
+		{URL: back},
+		{URL: side},
+	}, product.Images)
+
+	// Test: Reorder the images
+	err = fixture.UseCases.ReorderImages(ctx, usecase.ReorderImagesRequest{
+		ProductID: createResp.ProductID,
+		URLs:      []string{side, front, back},
+	})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	require.Len(t, product.Images, 3)
+	assert.Equal(t, side, product.Images[0].URL)
+	assert.True(t, product.Images[1].Primary)
+
+	// Verify: Listings carry the primary image
+	list, err := fixture.Queries.ListProducts(ctx, query.ListProductsRequest{Tag: tag})
+	require.NoError(t, err)
+	require.Len(t, list.Products, 1)
+	require.NotNil(t, list.Products[0].PrimaryImage)
+	assert.Equal(t, front, list.Products[0].PrimaryImage.URL)
+
+	// Test: An order missing an image is rejected
+	err = fixture.UseCases.ReorderImages(ctx, usecase.ReorderImagesRequest{
+		ProductID: createResp.ProductID,
+		URLs:      []string{side, front},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidImageOrder)
+
+	// Test: Remove all images
+	err = fixture.UseCases.SetImages(ctx, usecase.SetImagesRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Empty(t, product.Images)
+
+	// Verify: The changes were written to the outbox
+	var changes int
+	for _, e := range fixture.GetOutboxEvents(t, createResp.ProductID) {
+		if e.EventType == "product.images_changed" {
+			changes++
+		}
+	}
+	assert.Equal(t, 3, changes)
+}
+
+func TestProductAttachmentsFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create a product
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Documented Drill",
+		Category:             "Tools",
+		BasePriceNumerator:   8900,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	// Test: Attach a spec sheet and a German manual
+	sheet := "https://docs.example.com/" + createResp.ProductID + "/spec.pdf"
+	manual := "https://docs.example.com/" + createResp.ProductID + "/manual-de.pdf"
+	err = fixture.UseCases.ManageAttachments(ctx, usecase.ManageAttachmentsRequest{
+		ProductID: createResp.ProductID,
+		Attachments: []usecase.AttachmentInput{
+			{Type: "spec_sheet", URL: sheet},
+			{Type: "manual", URL: manual, Locale: "DE"},
+		},
+	})
+	require.NoError(t, err)
+
+	// Verify: The attachments are read back in order
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, []query.AttachmentResponse{
+		{Type: "spec_sheet", URL: sheet},
+		{Type: "manual", URL: manual, Locale: "de"},
+	}, product.Attachments)
+
+	// Test: An unknown type is rejected
+	err = fixture.UseCases.ManageAttachments(ctx, usecase.ManageAttachmentsRequest{
+		ProductID:   createResp.ProductID,
+		Attachments: []usecase.AttachmentInput{{Type: "brochure", URL: sheet}},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidAttachmentType)
+
+	// Test: Remove all attachments
+	err = fixture.UseCases.ManageAttachments(ctx, usecase.ManageAttachmentsRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID})
+	require.NoError(t, err)
+	assert.Empty(t, product.Attachments)
+
+	// Verify: The changes were written to the outbox
+	var changes int
+	for _, e := range fixture.GetOutboxEvents(t, createResp.ProductID) {
+		if e.EventType == "product.attachments_changed" {
+			changes++
+		}
+	}
+	assert.Equal(t, 2, changes)
+}
+
+func TestProductRelationsFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create a phone, a case for it and its successor
+	create := func(name string, price int64) string {
+		resp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 name,
+			Category:             "Electronics",
+			BasePriceNumerator:   price,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			fixture.CleanupProduct(t, resp.ProductID)
+		})
+		return resp.ProductID
+	}
+	phone := create("Linked Phone", 69900)
+	phoneCase := create("Linked Phone Case", 2900)
+	successor := create("Linked Phone 2", 79900)
+
+	link := func(from, to, relationType string) error {
+		return fixture.UseCases.LinkProducts(ctx, usecase.ProductLinkRequest{
+			ProductID:        from,
+			RelatedProductID: to,
+			RelationType:     relationType,
+		})
+	}
+
+	// Test: Link the products
+	require.NoError(t, link(phone, phoneCase, "accessory"))
+	require.NoError(t, link(phone, successor, "replacement"))
+	require.NoError(t, link(phone, successor, "related"))
+	require.NoError(t, link(successor, phone, "related"))
+
+	// Test: Linking again changes nothing
+	require.NoError(t, link(phone, phoneCase, "accessory"))
+
+	// Test: Accessory and replacement links cannot form a cycle
+	assert.ErrorIs(t, link(phoneCase, phone, "accessory"), domain.ErrRelationCycle)
+	assert.ErrorIs(t, link(successor, phone, "replacement"), domain.ErrRelationCycle)
+
+	// Verify: The links are read back by type, priced
+	related, err := fixture.Queries.GetRelatedProducts(ctx, query.GetRelatedProductsRequest{ProductID: phone})
+	require.NoError(t, err)
+	require.Len(t, related.Products, 3)
+	assert.Equal(t, "accessory", related.Products[0].Type)
+	assert.Equal(t, phoneCase, related.Products[0].Product.ID)
+	assert.Equal(t, "29.00", related.Products[0].Product.EffectivePriceDisplay)
+	assert.Equal(t, "related", related.Products[1].Type)
+	assert.Equal(t, successor, related.Products[1].Product.ID)
+	assert.Equal(t, "replacement", related.Products[2].Type)
+
+	related, err = fixture.Queries.GetRelatedProducts(ctx, query.GetRelatedProductsRequest{ProductID: phone, Type: "accessory"})
+	require.NoError(t, err)
+	require.Len(t, related.Products, 1)
+	assert.Equal(t, phoneCase, related.Products[0].Product.ID)
+
+	// Test: Unlink the accessory
+	err = fixture.UseCases.UnlinkProducts(ctx, usecase.ProductLinkRequest{ProductID: phone, RelatedProductID: phoneCase, RelationType: "accessory"})
+	require.NoError(t, err)
+
+	// Verify: Archived products are left out
+	err = fixture.UseCases.ArchiveProduct(ctx, usecase.ArchiveProductRequest{ProductID: successor})
+	require.NoError(t, err)
+
+	related, err = fixture.Queries.GetRelatedProducts(ctx, query.GetRelatedProductsRequest{ProductID: phone})
+	require.NoError(t, err)
+	assert.Empty(t, related.Products)
+	assert.ErrorIs(t, link(phone, successor, "accessory"), domain.ErrProductArchived)
+
+	// Verify: The changes were written to the outbox
+	var linked, unlinked int
+	for _, e := range fixture.GetOutboxEvents(t, phone) {
+		switch e.EventType {
+		case "product.linked":
+			linked++
+		case "product.unlinked":
+			unlinked++
+		}
+	}
+	assert.Equal(t, 3, linked)
+	assert.Equal(t, 1, unlinked)
+}
+
+func TestProductTranslationsFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Create a tagged product, so it can be listed on its own
+	createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Oak Chair",
+		Description:          "Solid oak",
+		Category:             "Furniture",
+		BasePriceNumerator:   12000,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, createResp.ProductID)
+	})
+
+	tag := "e2e-" + createResp.ProductID[:8]
+	err = fixture.UseCases.AddTag(ctx, usecase.TagRequest{ProductID: createResp.ProductID, Tag: tag})
+	require.NoError(t, err)
+
+	// Test: Translate the product into German and Brazilian Portuguese
+	err = fixture.UseCases.SetTranslation(ctx, usecase.SetTranslationRequest{
+		ProductID:   createResp.ProductID,
+		Locale:      "de",
+		Name:        "Eichenstuhl",
+		Description: "Massive Eiche",
+	})
+	require.NoError(t, err)
+	err = fixture.UseCases.SetTranslation(ctx, usecase.SetTranslationRequest{
+		ProductID: createResp.ProductID,
+		Locale:    "pt_BR",
+		Name:      "Cadeira de carvalho",
+	})
+	require.NoError(t, err)
+
+	// Verify: Reads in a locale fall back to its language, then to the default locale
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID, Locale: "de-AT"})
+	require.NoError(t, err)
+	assert.Equal(t, "de", product.Locale)
+	assert.Equal(t, "Eichenstuhl", product.Name)
+	assert.Equal(t, "Massive Eiche", product.Description)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID, Locale: "pt-BR"})
+	require.NoError(t, err)
+	assert.Equal(t, "Cadeira de carvalho", product.Name)
+	assert.Equal(t, "Solid oak", product.Description)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID, Locale: "fr"})
+	require.NoError(t, err)
+	assert.Equal(t, "en", product.Locale)
+	assert.Equal(t, "Oak Chair", product.Name)
+
+	list, err := fixture.Queries.ListProducts(ctx, query.ListProductsRequest{Tag: tag, Locale: "de"})
+	require.NoError(t, err)
+	require.Len(t, list.Products, 1)
+	assert.Equal(t, "Eichenstuhl", list.Products[0].Name)
+
+	// Test: The default locale is the product itself
+	err = fixture.UseCases.SetTranslation(ctx, usecase.SetTranslationRequest{ProductID: createResp.ProductID, Locale: "en", Name: "Chair"})
+	assert.ErrorIs(t, err, domain.ErrDefaultLocaleTranslation)
+
+	// Test: Setting the same translation again changes nothing; removing it falls back
+	err = fixture.UseCases.SetTranslation(ctx, usecase.SetTranslationRequest{
+		ProductID:   createResp.ProductID,
+		Locale:      "de",
+		Name:        "Eichenstuhl",
+		Description: "Massive Eiche",
+	})
+	require.NoError(t, err)
+	err = fixture.UseCases.SetTranslation(ctx, usecase.SetTranslationRequest{ProductID: createResp.ProductID, Locale: "de"})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: createResp.ProductID, Locale: "de"})
+	require.NoError(t, err)
+	assert.Equal(t, "Oak Chair", product.Name)
+
+	// Verify: The changes were written to the outbox
+	var changes int
+	for _, e := range fixture.GetOutboxEvents(t, createResp.ProductID) {
+		if e.EventType == "product.translation_changed" {
+			changes++
+		}
+	}
+	assert.Equal(t, 3, changes)
+}
+
+func TestProductSlugFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Two products with the same, run-unique name
+	name := "Lamp " + uuid.New().String()[:8]
+	base := domain.Slugify(name)
+	create := func() *usecase.CreateProductResponse {
+		resp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 name,
+			Category:             "Lighting",
+			BasePriceNumerator:   4500,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			fixture.CleanupProduct(t, resp.ProductID)
+		})
+		return resp
+	}
+
+	// Test: The second product gets a suffixed slug
+	first := create()
+	second := create()
+	assert.Equal(t, base, first.Slug)
+	assert.Equal(t, base+"-2", second.Slug)
+
+	// Verify: Products are read by slug, and their slug is in every read
+	product, err := fixture.Queries.GetProductBySlug(ctx, query.GetProductBySlugRequest{Slug: base + "-2"})
+	require.NoError(t, err)
+	assert.Equal(t, second.ProductID, product.ID)
+	assert.Equal(t, base+"-2", product.Slug)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: first.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, base, product.Slug)
+
+	// Test: Renaming keeps the slug
+	err = fixture.UseCases.UpdateProduct(ctx, usecase.UpdateProductRequest{ProductID: first.ProductID, Name: "Desk " + name, Category: "Lighting"})
+	require.NoError(t, err)
+	product, err = fixture.Queries.GetProductBySlug(ctx, query.GetProductBySlugRequest{Slug: base})
+	require.NoError(t, err)
+	assert.Equal(t, first.ProductID, product.ID)
+
+	// Test: A slug is changed on purpose, but not to another product's slug
+	err = fixture.UseCases.SetSlug(ctx, usecase.SetSlugRequest{ProductID: first.ProductID, Slug: base + "-2"})
+	assert.ErrorIs(t, err, domain.ErrSlugTaken)
+	err = fixture.UseCases.SetSlug(ctx, usecase.SetSlugRequest{ProductID: first.ProductID, Slug: "desk-" + base})
+	require.NoError(t, err)
+
+	_, err = fixture.Queries.GetProductBySlug(ctx, query.GetProductBySlugRequest{Slug: base})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	product, err = fixture.Queries.GetProductBySlug(ctx, query.GetProductBySlugRequest{Slug: "desk-" + base})
+	require.NoError(t, err)
+	assert.Equal(t, first.ProductID, product.ID)
+
+	// Verify: The change was written to the outbox
+	var changes int
+	for _, e := range fixture.GetOutboxEvents(t, first.ProductID) {
+		if e.EventType == "product.slug_changed" {
+			changes++
+		}
+	}
+	assert.Equal(t, 1, changes)
+}
+
+func TestProductIdentifierFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: Run-unique SKUs and a run-unique GTIN-13 with its check digit
+	sku := "E2E-LAMP-" + strings.ToUpper(uuid.New().String()[:8])
+	gtin := ""
+	prefix := fmt.Sprintf("%012d", time.Now().UnixNano()%1e12)
+	for d := '0'; gtin == ""; d++ {
+		gtin, _ = domain.ParseGTIN(prefix + string(d))
+	}
+	create := func(sku, gtin string) (*usecase.CreateProductResponse, error) {
+		resp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 "Lamp",
+			Category:             "Lighting",
+			BasePriceNumerator:   4500,
+			BasePriceDenominator: 100,
+			SKU:                  sku,
+			GTIN:                 gtin,
+		})
+		if err == nil {
+			t.Cleanup(func() {
+				fixture.CleanupProduct(t, resp.ProductID)
+			})
+		}
+		return resp, err
+	}
+
+	// Test: A product is created with a SKU and a GTIN, and read by SKU
+	first, err := create(strings.ToLower(sku), gtin)
+	require.NoError(t, err)
+	product, err := fixture.Queries.GetProductBySKU(ctx, query.GetProductBySKURequest{SKU: sku})
+	require.NoError(t, err)
+	assert.Equal(t, first.ProductID, product.ID)
+	assert.Equal(t, sku, product.SKU)
+	assert.Equal(t, gtin, product.GTIN)
+
+	// Test: Another product cannot take either identifier
+	_, err = create(sku, "")
+	assert.ErrorIs(t, err, domain.ErrDuplicateSKU)
+	_, err = create("", gtin)
+	assert.ErrorIs(t, err, domain.ErrDuplicateGTIN)
+
+	second, err := create(sku+"-2", "")
+	require.NoError(t, err)
+	err = fixture.UseCases.SetIdentifiers(ctx, usecase.SetIdentifiersRequest{ProductID: second.ProductID, SKU: sku})
+	assert.ErrorIs(t, err, domain.ErrDuplicateSKU)
+
+	// Test: SKUs are shared with variants in both directions
+	variant, err := fixture.UseCases.AddVariant(ctx, usecase.AddVariantRequest{ProductID: second.ProductID, SKU: sku})
+	assert.ErrorIs(t, err, domain.ErrDuplicateSKU)
+	variant, err = fixture.UseCases.AddVariant(ctx, usecase.AddVariantRequest{ProductID: second.ProductID, SKU: sku + "-M"})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupVariantEvents(t, variant.VariantID)
+	})
+	err = fixture.UseCases.SetIdentifiers(ctx, usecase.SetIdentifiersRequest{ProductID: first.ProductID, SKU: sku + "-M"})
+	assert.ErrorIs(t, err, domain.ErrDuplicateSKU)
+
+	// Test: Cleared identifiers are free for another product
+	err = fixture.UseCases.SetIdentifiers(ctx, usecase.SetIdentifiersRequest{ProductID: first.ProductID})
+	require.NoError(t, err)
+	err = fixture.UseCases.SetIdentifiers(ctx, usecase.SetIdentifiersRequest{ProductID: second.ProductID, SKU: sku, GTIN: gtin})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProductBySKU(ctx, query.GetProductBySKURequest{SKU: sku})
+	require.NoError(t, err)
+	assert.Equal(t, second.ProductID, product.ID)
+	assert.Equal(t, gtin, product.GTIN)
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: first.ProductID})
+	require.NoError(t, err)
+	assert.Empty(t, product.SKU)
+	assert.Empty(t, product.GTIN)
+
+	// Verify: The changes were written to the outbox
+	var changes int
+	for _, e := range fixture.GetOutboxEvents(t, second.ProductID) {
+		if e.EventType == "product.identifiers_changed" {
+			changes++
+		}
+	}
+	assert.Equal(t, 1, changes)
+}
+
+func TestProductDimensionsFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: A product created with its weight
+	resp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Crate",
+		Category:             "Storage",
+		BasePriceNumerator:   3500,
+		BasePriceDenominator: 100,
+		Weight:               &usecase.WeightInput{Value: 2, Unit: "lb"},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, resp.ProductID)
+	})
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: resp.ProductID})
+	require.NoError(t, err)
+	require.NotNil(t, product.Weight)
+	assert.Equal(t, 2.0, product.Weight.Value)
+	assert.Equal(t, "lb", product.Weight.Unit)
+	assert.InDelta(t, 907.18474, product.Weight.Grams, 1e-9)
+	assert.Nil(t, product.Dimensions)
+
+	// Test: Invalid units are refused
+	err = fixture.UseCases.SetDimensions(ctx, usecase.SetDimensionsRequest{
+		ProductID:  resp.ProductID,
+		Dimensions: &usecase.DimensionsInput{Height: 10, Width: 20, Depth: 5, Unit: "ft"},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidDimensions)
+
+	// Test: Dimensions are set and the weight replaced in another unit
+	err = fixture.UseCases.SetDimensions(ctx, usecase.SetDimensionsRequest{
+		ProductID:  resp.ProductID,
+		Weight:     &usecase.WeightInput{Value: 1.2, Unit: "kg"},
+		Dimensions: &usecase.DimensionsInput{Height: 10, Width: 20, Depth: 5, Unit: "in"},
+	})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: resp.ProductID})
+	require.NoError(t, err)
+	require.NotNil(t, product.Weight)
+	assert.InDelta(t, 1200, product.Weight.Grams, 1e-9)
+	require.NotNil(t, product.Dimensions)
+	assert.Equal(t, "in", product.Dimensions.Unit)
+	assert.InDelta(t, 254, product.Dimensions.HeightMM, 1e-9)
+	assert.InDelta(t, 508, product.Dimensions.WidthMM, 1e-9)
+	assert.InDelta(t, 127, product.Dimensions.DepthMM, 1e-9)
+
+	// Test: Setting the same values again is a no-op, and nil clears them
+	err = fixture.UseCases.SetDimensions(ctx, usecase.SetDimensionsRequest{
+		ProductID:  resp.ProductID,
+		Weight:     &usecase.WeightInput{Value: 1.2, Unit: "kg"},
+		Dimensions: &usecase.DimensionsInput{Height: 10, Width: 20, Depth: 5, Unit: "in"},
+	})
+	require.NoError(t, err)
+	err = fixture.UseCases.SetDimensions(ctx, usecase.SetDimensionsRequest{ProductID: resp.ProductID})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: resp.ProductID})
+	require.NoError(t, err)
+	assert.Nil(t, product.Weight)
+	assert.Nil(t, product.Dimensions)
+
+	// Verify: Each change was written to the outbox
+	var changes int
+	for _, e := range fixture.GetOutboxEvents(t, resp.ProductID) {
+		if e.EventType == "product.dimensions_changed" {
+			changes++
+		}
+	}
+	assert.Equal(t, 2, changes)
+}
+
+func TestProductKindsFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: A physical product cannot be created without its measures
+	_, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Desk",
+		Category:             "Furniture",
+		BasePriceNumerator:   25000,
+		BasePriceDenominator: 100,
+		Weight:               &usecase.WeightInput{Value: 30, Unit: "kg"},
+		Kind:                 "physical",
+	})
+	assert.ErrorIs(t, err, domain.ErrMeasuresRequired)
+
+	physical, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Desk",
+		Category:             "Furniture",
+		BasePriceNumerator:   25000,
+		BasePriceDenominator: 100,
+		Weight:               &usecase.WeightInput{Value: 30, Unit: "kg"},
+		Dimensions:           &usecase.DimensionsInput{Height: 75, Width: 140, Depth: 70, Unit: "cm"},
+		Kind:                 "physical",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, physical.ProductID)
+	})
+
+	digital, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Desk Assembly Video",
+		Category:             "Furniture",
+		BasePriceNumerator:   499,
+		BasePriceDenominator: 100,
+		Kind:                 "digital",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, digital.ProductID)
+	})
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: physical.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "physical", product.Kind)
+
+	// Test: A physical product keeps its measures, a digital one gets none
+	err = fixture.UseCases.SetDimensions(ctx, usecase.SetDimensionsRequest{ProductID: physical.ProductID})
+	assert.ErrorIs(t, err, domain.ErrMeasuresRequired)
+	err = fixture.UseCases.SetDimensions(ctx, usecase.SetDimensionsRequest{
+		ProductID: digital.ProductID,
+		Weight:    &usecase.WeightInput{Value: 1, Unit: "g"},
+	})
+	assert.ErrorIs(t, err, domain.ErrMeasuresNotApplicable)
+
+	// Test: Digital products have no stock
+	_, err = fixture.UseCases.SetStock(ctx, usecase.SetStockRequest{ProductID: digital.ProductID, Level: 10})
+	assert.ErrorIs(t, err, domain.ErrStockNotApplicable)
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: digital.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "digital", product.Kind)
+	assert.True(t, product.InStock)
+
+	// Test: A product with tracked stock cannot become digital
+	_, err = fixture.UseCases.SetStock(ctx, usecase.SetStockRequest{ProductID: physical.ProductID, Level: 5})
+	require.NoError(t, err)
+	err = fixture.UseCases.SetKind(ctx, usecase.SetKindRequest{ProductID: physical.ProductID, Kind: "digital"})
+	assert.ErrorIs(t, err, domain.ErrStockNotApplicable)
+
+	// Test: A digital product becomes physical together with its measures
+	err = fixture.UseCases.SetKind(ctx, usecase.SetKindRequest{ProductID: digital.ProductID, Kind: "physical"})
+	assert.ErrorIs(t, err, domain.ErrMeasuresRequired)
+	err = fixture.UseCases.SetKind(ctx, usecase.SetKindRequest{
+		ProductID:  digital.ProductID,
+		Kind:       "physical",
+		Weight:     &usecase.WeightInput{Value: 300, Unit: "g"},
+		Dimensions: &usecase.DimensionsInput{Height: 24, Width: 17, Depth: 2, Unit: "cm"},
+	})
+	require.NoError(t, err)
+
+	// Verify: The product is physical with its measures
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: digital.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "physical", product.Kind)
+	require.NotNil(t, product.Weight)
+
+	// Verify: The kind change was written to the outbox
+	events := fixture.GetOutboxEvents(t, digital.ProductID)
+	eventTypes := make([]string, len(events))
+	for i, e := range events {
+		eventTypes[i] = e.EventType
+	}
+	assert.Contains(t, eventTypes, "product.kind_changed")
+	assert.Contains(t, eventTypes, "product.dimensions_changed")
+}
+
+func TestComplianceFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: A toy and a wine, both restricted to buyers of 18 and over
+	toy, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Chemistry Set",
+		Category:             "Toys",
+		BasePriceNumerator:   3999,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, toy.ProductID)
+	})
+	wine, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 "Red Wine",
+		Category:             ageRestrictedCategory,
+		BasePriceNumerator:   1299,
+		BasePriceDenominator: 100,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, wine.ProductID)
+	})
+
+	adults := &usecase.ComplianceInput{MinimumAge: 18}
+	for _, id := range []string{toy.ProductID, wine.ProductID} {
+		err = fixture.UseCases.SetCompliance(ctx, usecase.SetComplianceRequest{ProductID: id, Compliance: adults})
+		require.NoError(t, err)
+	}
+
+	// Test: Age-restricted products are activated only in allowed categories
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: toy.ProductID})
+	assert.ErrorIs(t, err, domain.ErrAgeRestrictedCategory)
+	err = fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: wine.ProductID})
+	require.NoError(t, err)
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: toy.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, "draft", product.Status)
+
+	// Test: Flags set on an active product are checked right away
+	err = fixture.UseCases.SetCompliance(ctx, usecase.SetComplianceRequest{
+		ProductID:  wine.ProductID,
+		Compliance: &usecase.ComplianceInput{MinimumAge: 18, Hazardous: true},
+	})
+	assert.ErrorIs(t, err, domain.ErrHazardousMeasuresRequired)
+
+	err = fixture.UseCases.SetMarketPrices(ctx, usecase.SetMarketPricesRequest{
+		ProductID: wine.ProductID,
+		Prices:    []usecase.MarketPriceRequest{{Market: "UK", Numerator: 1099, Denominator: 100}},
+	})
+	require.NoError(t, err)
+	err = fixture.UseCases.SetCompliance(ctx, usecase.SetComplianceRequest{
+		ProductID:  wine.ProductID,
+		Compliance: &usecase.ComplianceInput{MinimumAge: 18, RestrictedMarkets: []string{"UK"}},
+	})
+	assert.ErrorIs(t, err, domain.ErrRestrictedMarketPrice)
+	err = fixture.UseCases.SetCompliance(ctx, usecase.SetComplianceRequest{
+		ProductID:  wine.ProductID,
+		Compliance: &usecase.ComplianceInput{MinimumAge: 18, RestrictedMarkets: []string{"US"}},
+	})
+	require.NoError(t, err)
+
+	// Verify: The flags are returned by reads
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: wine.ProductID})
+	require.NoError(t, err)
+	require.NotNil(t, product.Compliance)
+	assert.Equal(t, 18, product.Compliance.MinimumAge)
+	assert.False(t, product.Compliance.Hazardous)
+	assert.Equal(t, []string{"US"}, product.Compliance.RestrictedMarkets)
+
+	// Verify: Flag changes were written to the outbox
+	events := fixture.GetOutboxEvents(t, wine.ProductID)
+	eventTypes := make([]string, len(events))
+	for i, e := range events {
+		eventTypes[i] = e.EventType
+	}
+	assert.Contains(t, eventTypes, "product.compliance_changed")
+}
+
+func TestRatingsFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	category := "Ratings " + uuid.New().String()[:8]
+
+	// Setup: Three products in a category of their own
+	ids := make([]string, 3)
+	for i := range ids {
+		createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 fmt.Sprintf("Rated Product %d", i),
+			Category:             category,
+			BasePriceNumerator:   1000,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		ids[i] = createResp.ProductID
+		t.Cleanup(func() {
+			fixture.CleanupProduct(t, createResp.ProductID)
+		})
+	}
+
+	// Test: Reviews rate the first product 3 and 4 stars and the third one 5 stars
+	for _, review := range []usecase.IngestReviewRequest{
+		{ProductID: ids[0], ReviewID: "review-1", Rating: 3},
+		{ProductID: ids[0], ReviewID: "review-2", Rating: 4},
+		{ProductID: ids[2], ReviewID: "review-3", Rating: 5},
+	} {
+		_, err := fixture.UseCases.IngestReview(ctx, review)
+		require.NoError(t, err)
+	}
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: ids[0]})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), product.Rating.Count)
+	assert.Equal(t, 3.5, product.Rating.Average)
+
+	// Test: A redelivered review is counted once and an edited one replaces its rating
+	resp, err := fixture.UseCases.IngestReview(ctx, usecase.IngestReviewRequest{ProductID: ids[0], ReviewID: "review-2", Rating: 4})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.RatingCount)
+	resp, err = fixture.UseCases.IngestReview(ctx, usecase.IngestReviewRequest{ProductID: ids[0], ReviewID: "review-1", Rating: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.RatingCount)
+	assert.Equal(t, 2.5, resp.RatingAverage)
+
+	// Verify: Products are listed by average rating across pages, the unrated one last
+	var listed []string
+	pageToken := ""
+	for page := 0; page < 3; page++ {
+		listResp, err := fixture.Queries.ListProducts(ctx, query.ListProductsRequest{
+			Category:  category,
+			PageSize:  2,
+			PageToken: pageToken,
+			OrderBy:   contract.OrderByRating,
+		})
+		require.NoError(t, err)
+		for _, p := range listResp.Products {
+			listed = append(listed, p.ID)
+		}
+		if pageToken = listResp.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+	assert.Equal(t, []string{ids[2], ids[0], ids[1]}, listed)
+
+	// Verify: Invalid ratings and unknown products are refused, and ratings raise no events
+	_, err = fixture.UseCases.IngestReview(ctx, usecase.IngestReviewRequest{ProductID: ids[1], ReviewID: "review-4", Rating: 6})
+	assert.ErrorIs(t, err, domain.ErrInvalidRating)
+	_, err = fixture.UseCases.IngestReview(ctx, usecase.IngestReviewRequest{ProductID: uuid.New().String(), ReviewID: "review-4", Rating: 5})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	for _, e := range fixture.GetOutboxEvents(t, ids[0]) {
+		assert.Equal(t, "product.created", e.EventType)
+	}
+}
+
+func TestSalesChannelsFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	category := "Channels " + uuid.New().String()[:8]
+
+	// Setup: Two products in a category of their own
+	ids := make([]string, 2)
+	for i := range ids {
+		createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 fmt.Sprintf("Channel Product %d", i),
+			Category:             category,
+			BasePriceNumerator:   1000,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		ids[i] = createResp.ProductID
+		t.Cleanup(func() {
+			fixture.CleanupProduct(t, createResp.ProductID)
+		})
+	}
+
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: ids[0]})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"marketplace", "retail", "web"}, product.Channels)
+
+	// Test: Withhold the first product from the web store and marketplaces
+	err = fixture.UseCases.SetChannelAvailability(ctx, usecase.SetChannelAvailabilityRequest{
+		ProductID: ids[0],
+		Channels:  map[string]bool{"web": false, "marketplace": false},
+	})
+	require.NoError(t, err)
+
+	product, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: ids[0]})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"retail"}, product.Channels)
+
+	// Verify: Each storefront lists its own assortment
+	listChannel := func(channel string) []string {
+		resp, err := fixture.Queries.ListProducts(ctx, query.ListProductsRequest{Category: category, Channel: channel})
+		require.NoError(t, err)
+		var listed []string
+		for _, p := range resp.Products {
+			listed = append(listed, p.ID)
+		}
+		return listed
+	}
+	assert.ElementsMatch(t, []string{ids[0], ids[1]}, listChannel("retail"))
+	assert.Equal(t, []string{ids[1]}, listChannel("web"))
+	assert.ElementsMatch(t, []string{ids[0], ids[1]}, listChannel(""))
+
+	// Test: Make it available on the web store again; unknown channels are refused
+	err = fixture.UseCases.SetChannelAvailability(ctx, usecase.SetChannelAvailabilityRequest{
+		ProductID: ids[0],
+		Channels:  map[string]bool{"web": true},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{ids[0], ids[1]}, listChannel("web"))
+
+	err = fixture.UseCases.SetChannelAvailability(ctx, usecase.SetChannelAvailabilityRequest{
+		ProductID: ids[0],
+		Channels:  map[string]bool{"kiosk": true},
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidSalesChannel)
+	_, err = fixture.Queries.ListProducts(ctx, query.ListProductsRequest{Category: category, Channel: "kiosk"})
+	assert.ErrorIs(t, err, domain.ErrInvalidSalesChannel)
+
+	// Verify: Each change was written to the outbox
+	var changes int
+	for _, e := range fixture.GetOutboxEvents(t, ids[0]) {
+		if e.EventType == "product.channel_availability_changed" {
+			changes++
+		}
+	}
+	assert.Equal(t, 2, changes)
+}
+
+func TestMultiTenancyFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	brandA := caller.NewContext(ctx, caller.Identity{Tenant: "brand-a"})
+	brandB := caller.NewContext(ctx, caller.Identity{Tenant: "brand-b"})
+	category := "Tenants " + uuid.New().String()[:8]
+
+	// Setup: A product of each brand in the same category
+	create := func(ctx context.Context, name string) string {
+		createResp, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+			Name:                 name + " " + uuid.New().String()[:8],
+			Category:             category,
+			BasePriceNumerator:   1000,
+			BasePriceDenominator: 100,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			fixture.CleanupProduct(t, createResp.ProductID)
+		})
+		return createResp.ProductID
+	}
+	productA := create(brandA, "Brand A Lamp")
+	productB := create(brandB, "Brand B Lamp")
+
+	// Verify: Each brand reads and lists only its own products
+	product, err := fixture.Queries.GetProduct(brandA, query.GetProductRequest{ProductID: productA})
+	require.NoError(t, err)
+	assert.Equal(t, productA, product.ID)
+	_, err = fixture.Queries.GetProduct(brandA, query.GetProductRequest{ProductID: productB})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+
+	for _, tc := range []struct {
+		ctx      context.Context
+		expected []string
+	}{
+		{brandA, []string{productA}},
+		{brandB, []string{productB}},
+		{caller.NewContext(ctx, caller.Identity{}), nil},
+		{ctx, []string{productA, productB}},
+	} {
+		resp, err := fixture.Queries.ListProducts(tc.ctx, query.ListProductsRequest{Category: category})
+		require.NoError(t, err)
+		var listed []string
+		for _, p := range resp.Products {
+			listed = append(listed, p.ID)
+		}
+		assert.ElementsMatch(t, tc.expected, listed)
+	}
+	require.NoError(t, fixture.UseCases.ActivateProduct(brandA, usecase.ActivateProductRequest{ProductID: productA}))
+	count, err := fixture.ReadModel.CountByCategory(brandA, category)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	count, err = fixture.ReadModel.CountByCategory(brandB, category)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// Test: Brand B cannot change brand A's product
+	err = fixture.UseCases.UpdateProduct(brandB, usecase.UpdateProductRequest{ProductID: productA, Name: "Taken over"})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	err = fixture.UseCases.ArchiveProduct(brandB, usecase.ArchiveProductRequest{ProductID: productA})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	_, err = fixture.UseCases.CloneProduct(brandB, usecase.CloneProductRequest{ProductID: productA})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+
+	product, err = fixture.Queries.GetProduct(brandA, query.GetProductRequest{ProductID: productA})
+	require.NoError(t, err)
+	assert.NotEqual(t, "Taken over", product.Name)
+	assert.Equal(t, "active", product.Status)
+
+	// Verify: A clone belongs to the tenant of its original
+	cloneResp, err := fixture.UseCases.CloneProduct(brandA, usecase.CloneProductRequest{ProductID: productA})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, cloneResp.ProductID)
+	})
+	_, err = fixture.Queries.GetProduct(brandA, query.GetProductRequest{ProductID: cloneResp.ProductID})
+	require.NoError(t, err)
+	_, err = fixture.Queries.GetProduct(brandB, query.GetProductRequest{ProductID: cloneResp.ProductID})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+
+	// Verify: The events of each product carry its tenant
+	events := fixture.GetOutboxEvents(t, productA)
+	require.NotEmpty(t, events)
+	for _, e := range events {
+		assert.Equal(t, "brand-a", e.TenantID, e.EventType)
+	}
+
+	// Verify: Campaigns and price lists belong to the tenant that created them
+	now := fixture.Now()
+	campaignResp, err := fixture.UseCases.CreateCampaign(brandA, usecase.CreateCampaignRequest{
+		Name:               "Brand A Sale",
+		ProductIDs:         []string{productA},
+		DiscountPercentage: 10,
+		StartDate:          now,
+		EndDate:            now.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupCampaign(t, campaignResp.CampaignID)
+	})
+	_, err = fixture.UseCases.ActivateCampaign(brandB, usecase.ActivateCampaignRequest{CampaignID: campaignResp.CampaignID})
+	assert.ErrorIs(t, err, domain.ErrCampaignNotFound)
+
+	listResp, err := fixture.UseCases.CreatePriceList(brandA, usecase.CreatePriceListRequest{
+		Name:       "Brand A Wholesale",
+		Currency:   "USD",
+		ValidFrom:  now,
+		ValidUntil: now.AddDate(0, 0, 30),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupPriceList(t, listResp.PriceListID)
+	})
+	_, err = fixture.Queries.GetPriceListPrice(brandB, query.GetPriceListPriceRequest{
+		PriceListID: listResp.PriceListID,
+		ProductID:   productB,
+	})
+	assert.ErrorIs(t, err, domain.ErrPriceListNotFound)
+	err = fixture.UseCases.SetPriceListEntries(brandB, usecase.SetPriceListEntriesRequest{
+		PriceListID: listResp.PriceListID,
+		Entries:     []usecase.PriceListEntryRequest{{ProductID: productB, Numerator: 900, Denominator: 100}},
+	})
+	assert.ErrorIs(t, err, domain.ErrPriceListNotFound)
+
+	// Test: A price list cannot price a product of another tenant
+	err = fixture.UseCases.SetPriceListEntries(brandA, usecase.SetPriceListEntriesRequest{
+		PriceListID: listResp.PriceListID,
+		Entries:     []usecase.PriceListEntryRequest{{ProductID: productB, Numerator: 900, Denominator: 100}},
+	})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	err = fixture.UseCases.SetPriceListEntries(brandA, usecase.SetPriceListEntriesRequest{
+		PriceListID: listResp.PriceListID,
+		Entries:     []usecase.PriceListEntryRequest{{ProductID: productA, Numerator: 900, Denominator: 100}},
+	})
+	require.NoError(t, err)
+}
+
+func TestProductCloneFlow(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+
+	// Setup: An active product with attributes, tags and a discount
+	name := "Chair " + uuid.New().String()[:8]
+	source, err := fixture.UseCases.CreateProduct(ctx, usecase.CreateProductRequest{
+		Name:                 name,
+		Description:          "A chair",
+		Category:             "Furniture",
+		BasePriceNumerator:   4500,
+		BasePriceDenominator: 100,
+		Currency:             "EUR",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, source.ProductID)
+	})
+	require.NoError(t, fixture.UseCases.SetAttribute(ctx, usecase.SetAttributeRequest{ProductID: source.ProductID, Name: "material", Value: "oak"}))
+	require.NoError(t, fixture.UseCases.AddTag(ctx, usecase.TagRequest{ProductID: source.ProductID, Tag: "sale"}))
+	require.NoError(t, fixture.UseCases.ActivateProduct(ctx, usecase.ActivateProductRequest{ProductID: source.ProductID}))
+
+	// Test: Clone the product
+	clone, err := fixture.UseCases.CloneProduct(ctx, usecase.CloneProductRequest{ProductID: source.ProductID})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		fixture.CleanupProduct(t, clone.ProductID)
+	})
+	assert.NotEqual(t, source.ProductID, clone.ProductID)
+	assert.Equal(t, domain.Slugify(name+" (copy)"), clone.Slug)
+
+	// Verify: The clone is a draft copy of the copied fields only
+	product, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: clone.ProductID})
+	require.NoError(t, err)
+	assert.Equal(t, name+" (copy)", product.Name)
+	assert.Equal(t, "A chair", product.Description)
+	assert.Equal(t, "Furniture", product.Category)
+	assert.Equal(t, int64(4500), product.BasePriceNumerator)
+	assert.Equal(t, int64(100), product.BasePriceDenominator)
+	assert.Equal(t, "EUR", product.Currency)
+	assert.Equal(t, map[string]string{"material": "oak"}, product.Attributes)
+	assert.Empty(t, product.Tags)
+	assert.Equal(t, "draft", product.Status)
+
+	// Verify: The clone has its own creation event
+	events := fixture.GetOutboxEvents(t, clone.ProductID)
+	require.Len(t, events, 1)
+	assert.Equal(t, "product.created", events[0].EventType)
+
+	// Test: Unknown products cannot be cloned
+	_, err = fixture.UseCases.CloneProduct(ctx, usecase.CloneProductRequest{ProductID: uuid.New().String()})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+}
+
+func TestGoldenDataset(t *testing.T) {
+	fixture := SetupTestFixture(t)
+	ctx := fixture.Context()
+	fixture.SetTime(seed.Epoch)
+
+	// Setup: Load the golden dataset
+	dataset := fixture.SeedGolden(t)
+	assert.Equal(t, seed.Expected(), dataset)
+
+	// Verify: Exact IDs and values
+	laptop, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: "601de000-0000-4000-8000-000000000001"})
+	require.NoError(t, err)
+	assert.Equal(t, "Golden Laptop", laptop.Name)
+	assert.Equal(t, "active", laptop.Status)
+	assert.True(t, seed.Epoch.Equal(laptop.CreatedAt))
+	assert.Equal(t, "999.99", laptop.BasePriceDisplay)
+	assert.Equal(t, "899.99", laptop.EffectivePriceDisplay)
+	require.Len(t, laptop.Discounts, 2)
+	assert.Equal(t, "601de000-0000-4000-8000-000000000002", laptop.Discounts[0].ID)
+	assert.Equal(t, "601de000-0000-4000-8000-000000000003", laptop.Discounts[1].ID)
+
+	headphones, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: dataset.Products["headphones"]})
+	require.NoError(t, err)
+	assert.Equal(t, "149.00", headphones.EffectivePriceDisplay)
+	require.Len(t, headphones.Discounts, 1)
+	assert.Equal(t, "buy_x_get_y", headphones.Discounts[0].Kind)
+	assert.Equal(t, 2, headphones.Discounts[0].BuyQuantity)
+	assert.Equal(t, 1, headphones.Discounts[0].GetQuantity)
+
+	novel, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: dataset.Products["novel"]})
+	require.NoError(t, err)
+	assert.Equal(t, "reduced", novel.TaxClass)
+
+	mug, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: dataset.Products["mug"]})
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", mug.Currency)
+	assert.Equal(t, "draft", mug.Status)
+
+	lamp, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: dataset.Products["lamp"]})
+	require.NoError(t, err)
+	assert.Len(t, lamp.PriceTiers, 2)
+
+	poster, err := fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: dataset.Products["poster"]})
+	require.NoError(t, err)
+	assert.Equal(t, "archived", poster.Status)
+
+	// Verify: The weekend discount takes over on day 5
+	fixture.SetTime(seed.Epoch.AddDate(0, 0, 5))
+	laptop, err = fixture.Queries.GetProduct(ctx, query.GetProductRequest{ProductID: dataset.Products["laptop"]})
+	require.NoError(t, err)
+	assert.Equal(t, "749.99", laptop.EffectivePriceDisplay)
 }
@@ -8,17 +8,24 @@ import (
 	"time"
 
 	"cloud.google.com/go/spanner"
+	"github.com/product-catalog-service/internal/contract"
+	"github.com/product-catalog-service/internal/domain"
 	"github.com/product-catalog-service/internal/query"
 	"github.com/product-catalog-service/internal/repository"
 	"github.com/product-catalog-service/internal/usecase"
 	"github.com/product-catalog-service/internal/clock"
 	"github.com/product-catalog-service/internal/committer"
+	"github.com/product-catalog-service/internal/eventbus"
+	"github.com/product-catalog-service/internal/seed"
 )
 
 const (
 	testProject  = "test-project"
 	testInstance = "test-instance"
 	testDatabase = "test-database"
+
+	// ageRestrictedCategory is the category age-restricted products may be activated in.
+	ageRestrictedCategory = "Wine"
 )
 
 // TestFixture holds all test dependencies.
@@ -33,6 +40,30 @@ type TestFixture struct {
 	OutboxRepo  *repository.OutboxRepo
 	ReadModel   *repository.ProductReadModel
 
+	// Notification subscriptions fanned out by the use cases
+	Subscriptions *repository.NotificationSubscriptionRepo
+
+	// Merchandising ranks ingested through the admin endpoint
+	Merchandising *repository.MerchandisingRepo
+
+	// Freeze windows scheduled through the admin endpoint
+	Freezes *repository.FreezeWindowRepo
+
+	// Discount blackouts scheduled through the admin endpoint
+	Blackouts *repository.DiscountBlackoutRepo
+
+	// Category attribute schemas stored through the admin endpoint
+	AttributeSchemas *repository.AttributeSchemaRepo
+
+	// Campaigns applying a discount to many products
+	Campaigns *repository.CampaignRepo
+
+	// B2B price lists
+	PriceLists *repository.PriceListRepo
+
+	// In-process event bus the use cases publish committed events to
+	Bus *eventbus.Bus
+
 	// Use Cases
 	UseCases *usecase.ProductUseCases
 
@@ -70,8 +101,19 @@ func SetupTestFixture(t *testing.T) *TestFixture {
 
 	// Repositories
 	productRepo := repository.NewProductRepo(spannerClient)
-	outboxRepo := repository.NewOutboxRepo()
+	outboxRepo := repository.NewOutboxRepo(spannerClient)
 	readModel := repository.NewProductReadModel(spannerClient)
+	subscriptions := repository.NewNotificationSubscriptionRepo(spannerClient)
+	freezes := repository.NewFreezeWindowRepo(spannerClient)
+	blackouts := repository.NewDiscountBlackoutRepo(spannerClient)
+	schemas := repository.NewAttributeSchemaRepo(spannerClient)
+	campaigns := repository.NewCampaignRepo(spannerClient)
+	priceLists := repository.NewPriceListRepo(spannerClient)
+	bus := eventbus.NewBus()
+	compliancePolicy, err := domain.ParseCompliancePolicy(ageRestrictedCategory)
+	if err != nil {
+		t.Fatalf("Failed to parse the compliance policy: %v", err)
+	}
 
 	fixture := &TestFixture{
 		ctx:           ctx,
@@ -82,12 +124,37 @@ func SetupTestFixture(t *testing.T) *TestFixture {
 		ProductRepo: productRepo,
 		OutboxRepo:  outboxRepo,
 		ReadModel:   readModel,
+		Bus:         bus,
+
+		Subscriptions: subscriptions,
+		Merchandising: repository.NewMerchandisingRepo(spannerClient),
+		Freezes:       freezes,
+		Blackouts:     blackouts,
+		AttributeSchemas: schemas,
+		Campaigns:     campaigns,
+		PriceLists:    priceLists,
 
 		// Use Cases (consolidated)
-		UseCases: usecase.NewProductUseCases(productRepo, outboxRepo, comm, fixedClock),
+		UseCases: usecase.NewProductUseCases(productRepo, outboxRepo, comm, fixedClock,
+			usecase.WithEventPublisher(bus),
+			usecase.WithNotifications(subscriptions),
+			usecase.WithFreezeWindows(freezes),
+			usecase.WithDiscountBlackouts(blackouts),
+			usecase.WithCampaigns(campaigns),
+			usecase.WithPriceLists(priceLists),
+			usecase.WithVariants(repository.NewProductVariantRepo(spannerClient)),
+			usecase.WithStock(repository.NewStockRepo(spannerClient)),
+			usecase.WithImages(repository.NewProductImageRepo(spannerClient)),
+			usecase.WithAttachments(repository.NewProductAttachmentRepo(spannerClient)),
+			usecase.WithRelations(repository.NewProductRelationRepo(spannerClient)),
+			usecase.WithTranslations(repository.NewProductTranslationRepo(spannerClient)),
+			usecase.WithAttributeSchemas(schemas),
+			usecase.WithRatings(repository.NewRatingRepo(spannerClient)),
+			usecase.WithCompliancePolicy(compliancePolicy),
+		),
 
 		// Queries (consolidated)
-		Queries: query.NewProductQueries(readModel, fixedClock),
+		Queries: query.NewProductQueries(readModel, fixedClock, query.WithPriceLists(priceLists)),
 	}
 
 	t.Cleanup(func() {
@@ -117,47 +184,40 @@ func (f *TestFixture) Context() context.Context {
 	return f.ctx
 }
 
-// GetOutboxEvents retrieves outbox events for a given aggregate ID.
-func (f *TestFixture) GetOutboxEvents(t *testing.T, aggregateID string) []OutboxEventRow {
+// GetOutboxEvents retrieves outbox events for a given aggregate ID in creation order.
+func (f *TestFixture) GetOutboxEvents(t *testing.T, aggregateID string) []*contract.StoredOutboxEvent {
 	t.Helper()
 
-	stmt := spanner.Statement{
-		SQL: `SELECT event_id, event_type, aggregate_id, status, created_at 
-		      FROM outbox_events 
-		      WHERE aggregate_id = @aggregate_id 
-		      ORDER BY created_at`,
-		Params: map[string]interface{}{
-			"aggregate_id": aggregateID,
-		},
+	events, err := f.OutboxRepo.FindByAggregateID(f.ctx, aggregateID)
+	if err != nil {
+		t.Fatalf("Failed to read outbox events: %v", err)
 	}
+	return events
+}
 
-	iter := f.spannerClient.Single().Query(f.ctx, stmt)
-	defer iter.Stop()
+// SeedGolden loads the golden dataset (see package seed) and removes it when the test
+// ends. Leftovers of an interrupted run are removed first; child rows are deleted with
+// their product.
+func (f *TestFixture) SeedGolden(t *testing.T) *seed.Dataset {
+	t.Helper()
 
-	var events []OutboxEventRow
-	for {
-		row, err := iter.Next()
-		if err != nil {
-			break
-		}
+	for _, id := range seed.Expected().ProductIDs() {
+		f.CleanupProduct(t, id)
+	}
 
-		var event OutboxEventRow
-		if err := row.Columns(&event.EventID, &event.EventType, &event.AggregateID, &event.Status, &event.CreatedAt); err != nil {
-			t.Fatalf("Failed to read outbox event: %v", err)
+	useCases := usecase.NewProductUseCases(f.ProductRepo, f.OutboxRepo, f.committer, seed.NewClock(),
+		usecase.WithIDGenerator(seed.NewIDGenerator()),
+	)
+	dataset, err := seed.Load(f.ctx, useCases)
+	t.Cleanup(func() {
+		for _, id := range seed.Expected().ProductIDs() {
+			f.CleanupProduct(t, id)
 		}
-		events = append(events, event)
+	})
+	if err != nil {
+		t.Fatalf("Failed to load golden dataset: %v", err)
 	}
-
-	return events
-}
-
-// OutboxEventRow represents a row from the outbox_events table.
-type OutboxEventRow struct {
-	EventID     string
-	EventType   string
-	AggregateID string
-	Status      string
-	CreatedAt   time.Time
+	return dataset
 }
 
 // CleanupProduct deletes a product by ID (for test cleanup).
@@ -171,24 +231,56 @@ func (f *TestFixture) CleanupProduct(t *testing.T, productID string) {
 	}
 
 	// Also cleanup outbox events
-	stmt := spanner.Statement{
-		SQL: `SELECT event_id FROM outbox_events WHERE aggregate_id = @aggregate_id`,
-		Params: map[string]interface{}{
-			"aggregate_id": productID,
-		},
+	f.cleanupOutboxEvents(t, productID)
+}
+
+// CleanupCampaign deletes a campaign by ID (for test cleanup).
+func (f *TestFixture) CleanupCampaign(t *testing.T, campaignID string) {
+	t.Helper()
+
+	mut := spanner.Delete("campaigns", spanner.Key{campaignID})
+	_, err := f.spannerClient.Apply(f.ctx, []*spanner.Mutation{mut})
+	if err != nil {
+		t.Logf("Warning: failed to cleanup campaign %s: %v", campaignID, err)
+	}
+
+	f.cleanupOutboxEvents(t, campaignID)
+}
+
+// CleanupPriceList deletes a price list and its entries by ID (for test cleanup).
+func (f *TestFixture) CleanupPriceList(t *testing.T, priceListID string) {
+	t.Helper()
+
+	mut := spanner.Delete("price_lists", spanner.Key{priceListID})
+	_, err := f.spannerClient.Apply(f.ctx, []*spanner.Mutation{mut})
+	if err != nil {
+		t.Logf("Warning: failed to cleanup price list %s: %v", priceListID, err)
+	}
+
+	f.cleanupOutboxEvents(t, priceListID)
+}
+
+// CleanupVariantEvents deletes the outbox events of a variant (for test cleanup); the
+// variant itself is deleted with its product.
+func (f *TestFixture) CleanupVariantEvents(t *testing.T, variantID string) {
+	t.Helper()
+
+	f.cleanupOutboxEvents(t, variantID)
+}
+
+// cleanupOutboxEvents deletes the outbox events of an aggregate.
+func (f *TestFixture) cleanupOutboxEvents(t *testing.T, aggregateID string) {
+	t.Helper()
+
+	events, err := f.OutboxRepo.FindByAggregateID(f.ctx, aggregateID)
+	if err != nil {
+		t.Logf("Warning: failed to read outbox events of %s: %v", aggregateID, err)
+		return
 	}
-	iter := f.spannerClient.Single().Query(f.ctx, stmt)
-	defer iter.Stop()
 
 	var keys []spanner.Key
-	for {
-		row, err := iter.Next()
-		if err != nil {
-			break
-		}
-		var eventID string
-		row.Columns(&eventID)
-		keys = append(keys, spanner.Key{eventID})
+	for _, event := range events {
+		keys = append(keys, spanner.Key{event.EventID})
 	}
 
 	if len(keys) > 0 {